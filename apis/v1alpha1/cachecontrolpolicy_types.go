@@ -0,0 +1,128 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=ccpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// CacheControlPolicy is a Direct Attached Policy. It provides a way to set the caching headers of the responses
+// for the requests that match a Route, overriding the caching headers set by the backends.
+type CacheControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CacheControlPolicy.
+	Spec CacheControlPolicySpec `json:"spec"`
+
+	// Status defines the state of the CacheControlPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CacheControlPolicyList contains a list of CacheControlPolicies.
+type CacheControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheControlPolicy `json:"items"`
+}
+
+// CacheControlPolicySpec defines the desired state of the CacheControlPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of expires or cacheControl must be set",rule="has(self.expires) || has(self.cacheControl)"
+//
+//nolint:lll
+type CacheControlPolicySpec struct {
+	// Expires configures the Expires response header and the max-age directive of the Cache-Control
+	// response header. The Expires and Cache-Control headers of the backend responses are replaced.
+	// NGINX sets these headers only for the responses with the 200, 201, 204, 206, 301, 302, 303, 304, 307,
+	// or 308 status code.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_headers_module.html#expires
+	//
+	// +optional
+	Expires *CacheExpires `json:"expires,omitempty"`
+
+	// CacheControl is a list of directives of the Cache-Control response header. For example, "public",
+	// "no-store" or "stale-while-revalidate=60". The Cache-Control header of the backend responses is replaced.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_headers_module.html#add_header
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	CacheControl []CacheControlDirective `json:"cacheControl,omitempty"`
+
+	// Always adds the Cache-Control header configured by the CacheControl field to the responses with
+	// any status code. By default, the header is only added to the responses with the 200, 201, 204, 206,
+	// 301, 302, 303, 304, 307, or 308 status code.
+	//
+	// +optional
+	Always *bool `json:"always,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries in the CacheControlPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// CacheExpires defines the value of the Expires response header.
+//
+// +kubebuilder:validation:XValidation:message="duration is required when type is Duration",rule="self.type != 'Duration' || has(self.duration)"
+// +kubebuilder:validation:XValidation:message="duration can only be set when type is Duration",rule="self.type == 'Duration' || !has(self.duration)"
+//
+//nolint:lll
+type CacheExpires struct {
+	// Duration is the time after the response is sent when the response expires.
+	// The duration must be specified in seconds (s), minutes (m), or hours (h).
+	// Cache-Control max-age is set to the duration in seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:message="duration must be specified in seconds, minutes, or hours",rule="!self.endsWith('ms')"
+	//nolint:lll
+	Duration *Duration `json:"duration,omitempty"`
+
+	// Type is the type of the expiration.
+	Type CacheExpiresType `json:"type"`
+}
+
+// CacheExpiresType is the type of the expiration of a response.
+//
+// +kubebuilder:validation:Enum=Duration;Epoch;Max
+type CacheExpiresType string
+
+const (
+	// CacheExpiresDuration makes a response expire after the configured duration.
+	CacheExpiresDuration CacheExpiresType = "Duration"
+
+	// CacheExpiresEpoch makes a response already expired. The Expires header is set to
+	// "Thu, 01 Jan 1970 00:00:01 GMT" and the Cache-Control header to "no-cache".
+	CacheExpiresEpoch CacheExpiresType = "Epoch"
+
+	// CacheExpiresMax makes a response never expire. The Expires header is set to
+	// "Thu, 31 Dec 2037 23:55:55 GMT" and the Cache-Control max-age to 10 years.
+	CacheExpiresMax CacheExpiresType = "Max"
+)
+
+// CacheControlDirective is a directive of the Cache-Control response header. The directive is a name
+// optionally followed by "=" and an unquoted value, for example, "public" or "max-age=60".
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=128
+// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9-]*(=[A-Za-z0-9-]+)?$`
+type CacheControlDirective string
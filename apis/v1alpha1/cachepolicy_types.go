@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=cpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// CachePolicy is an Inherited Attached Policy. It provides a way to cache the responses of the backends
+// in NGINX. Every CachePolicy has its own cache. The settings of a policy attached to a Route override
+// the settings of a policy attached to the Gateway.
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CachePolicy.
+	Spec CachePolicySpec `json:"spec"`
+
+	// Status defines the state of the CachePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies.
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicySpec defines the desired state of the CachePolicy.
+type CachePolicySpec struct {
+	// Zone configures the storage of the cache.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path
+	//
+	// +optional
+	Zone *CacheZone `json:"zone,omitempty"`
+
+	// Key is the key of the cached responses. It can contain NGINX variables.
+	// If not specified, the key is "$scheme$proxy_host$request_uri".
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_key
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Key *string `json:"key,omitempty"`
+
+	// StatusHeader is the name of the response header with the cache status of the response,
+	// for example, HIT, MISS, or BYPASS. If not specified, the header is "X-Cache-Status".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	StatusHeader *string `json:"statusHeader,omitempty"`
+
+	// Valid sets the caching times of the responses by their status codes.
+	// If not specified, the caching times are taken from the response headers of the backends,
+	// and the responses without them are not cached.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_valid
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Valid []CacheValidity `json:"valid,omitempty"`
+
+	// Bypass are the NGINX variables, for example, "$cookie_nocache" or "$http_authorization",
+	// that bypass the cache. If any of the variables is not empty and not "0", the response is taken
+	// from the backend and is not saved to the cache.
+	// Directives: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_bypass,
+	// https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_no_cache
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Bypass []NginxVariable `json:"bypass,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries
+	// in the CachePolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// CacheZone defines the storage of a cache.
+type CacheZone struct {
+	// MaxSize is the maximum size of the cached responses on disk. When the size is exceeded,
+	// the least recently used responses are removed. If not specified, the size is not limited.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+
+	// KeysZoneSize is the size of the shared memory zone with the keys of the cached responses.
+	// One megabyte can store about 8 thousand keys. If not specified, the size is 10m.
+	//
+	// +optional
+	KeysZoneSize *Size `json:"keysZoneSize,omitempty"`
+
+	// Inactive is the time after which the cached responses that are not accessed are removed,
+	// regardless of their freshness. If not specified, the time is 10m.
+	//
+	// +optional
+	Inactive *Duration `json:"inactive,omitempty"`
+}
+
+// CacheValidity defines the caching time of the responses with the status codes.
+type CacheValidity struct {
+	// Duration is the caching time of the responses.
+	Duration Duration `json:"duration"`
+
+	// Codes are the status codes of the cached responses. The special value "any" matches any status code.
+	// If not specified, the codes are 200, 301, and 302.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Codes []CacheStatusCode `json:"codes,omitempty"`
+}
+
+// CacheStatusCode is an HTTP status code of the cached responses, or "any".
+//
+// +kubebuilder:validation:Pattern=`^([1-5][0-9]{2}|any)$`
+type CacheStatusCode string
+
+// NginxVariable is an NGINX variable, for example, "$cookie_nocache".
+//
+// +kubebuilder:validation:MaxLength=64
+// +kubebuilder:validation:Pattern=`^\$[A-Za-z_][A-Za-z0-9_]*$`
+type NginxVariable string
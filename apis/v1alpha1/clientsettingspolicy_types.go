@@ -36,6 +36,10 @@ type ClientSettingsPolicyList struct {
 }
 
 // ClientSettingsPolicySpec defines the desired state of ClientSettingsPolicy.
+//
+// +kubebuilder:validation:XValidation:message="stream can only be set if the targetRef kind is Gateway",rule="!has(self.stream) || self.targetRef.kind == 'Gateway'"
+//
+//nolint:lll
 type ClientSettingsPolicySpec struct {
 	// Body defines the client request body settings.
 	//
@@ -47,6 +51,24 @@ type ClientSettingsPolicySpec struct {
 	// +optional
 	KeepAlive *ClientKeepAlive `json:"keepAlive,omitempty"`
 
+	// Disconnect defines the handling of the requests whose client closes the connection before the response is sent.
+	// NGINX always propagates the cancellation of the gRPC calls to the backends, and the propagation can't be
+	// configured, so Disconnect can't be set for GRPCRoutes and doesn't apply to the GRPCRoutes of a Gateway.
+	//
+	// +optional
+	Disconnect *ClientDisconnect `json:"disconnect,omitempty"`
+
+	// Buffering defines the buffering of the responses of the backends.
+	//
+	// +optional
+	Buffering *ProxyBuffering `json:"buffering,omitempty"`
+
+	// Stream defines the timeouts of the connections of the TLS passthrough, TCP, and UDP Listeners.
+	// Can only be set if the policy targets a Gateway.
+	//
+	// +optional
+	Stream *ClientStream `json:"stream,omitempty"`
+
 	// TargetRef identifies an API object to apply the policy to.
 	// Object must be in the same namespace as the policy.
 	// Support: Gateway, HTTPRoute, GRPCRoute.
@@ -78,6 +100,94 @@ type ClientBody struct {
 	Timeout *Duration `json:"timeout,omitempty"`
 }
 
+// ClientDisconnect defines the handling of the requests whose client closes the connection
+// before the response is sent.
+type ClientDisconnect struct {
+	// PropagateAbort defines whether NGINX aborts the request to the backend when the client closes the connection.
+	// If false, NGINX lets the backend complete the request, for example, for the requests that modify data and
+	// must not be interrupted. Keeping the default true frees the backend capacity used by the requests of the
+	// clients that are gone, like abandoned long-polling requests.
+	// Default: true.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_ignore_client_abort.
+	//
+	// +optional
+	PropagateAbort *bool `json:"propagateAbort,omitempty"`
+}
+
+// ClientStream defines the timeouts of the connections of the TLS passthrough, TCP, and UDP Listeners, which are
+// proxied by TLSRoutes, TCPRoutes, and UDPRoutes.
+type ClientStream struct {
+	// ConnectTimeout defines a timeout for establishing a connection with a backend.
+	// Default: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_connect_timeout.
+	//
+	// +optional
+	ConnectTimeout *Duration `json:"connectTimeout,omitempty"`
+
+	// Timeout defines a timeout between two successive read or write operations on the connections with
+	// the client or the backend. If no data is transmitted within this time, the connection is closed.
+	// Default: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_timeout.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// PrereadTimeout defines a timeout for reading the TLS ClientHello of the connections of the TLS passthrough
+	// Listeners, which selects the TLSRoute of the connection. If the ClientHello is not received within this time,
+	// the connection is closed.
+	// Default: https://nginx.org/en/docs/stream/ngx_stream_core_module.html#preread_timeout.
+	//
+	// +optional
+	PrereadTimeout *Duration `json:"prereadTimeout,omitempty"`
+}
+
+// ProxyBuffering defines the buffering of the responses of the backends.
+// The sizes are validated against the other sizes of the same policy, but not against the sizes inherited
+// from a policy attached to the Gateway.
+// Buffering is not supported for GRPCRoutes.
+type ProxyBuffering struct {
+	// Enable enables or disables the buffering of the responses. When enabled, NGINX reads the response
+	// from the backend as soon as possible, freeing the backend, and sends it to the client at the client's pace.
+	// When disabled, NGINX sends the response to the client synchronously, as it is received, which is required
+	// for streaming responses like Server-Sent Events.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffering.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// BufferSize sets the size of the buffer for the first part of the response, which contains the response
+	// headers. Increase it for the backends that send large headers, like large cookies. It applies even if
+	// the buffering is disabled.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffer_size.
+	//
+	// +optional
+	BufferSize *Size `json:"bufferSize,omitempty"`
+
+	// Buffers sets the number and the size of the buffers for the response, for a single connection.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffers.
+	//
+	// +optional
+	Buffers *ProxyBuffers `json:"buffers,omitempty"`
+
+	// BusyBuffersSize limits the total size of the buffers that can be busy sending the response to the client
+	// while the response is not yet fully read. It must be at least the size of BufferSize and of one of Buffers,
+	// and less than the total size of Buffers minus one buffer.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_busy_buffers_size.
+	//
+	// +optional
+	BusyBuffersSize *Size `json:"busyBuffersSize,omitempty"`
+}
+
+// ProxyBuffers defines the number and the size of the buffers for the response.
+type ProxyBuffers struct {
+	// Size is the size of each buffer.
+	Size Size `json:"size"`
+
+	// Number is the number of the buffers.
+	//
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=1024
+	Number int32 `json:"number"`
+}
+
 // ClientKeepAlive defines the keep-alive settings for clients.
 type ClientKeepAlive struct {
 	// Requests sets the maximum number of requests that can be served through one keep-alive connection.
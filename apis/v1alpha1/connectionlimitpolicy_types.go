@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=clpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ConnectionLimitPolicy is an Inherited Attached Policy. It provides a way to limit the number of the concurrent
+// connections of the clients or of the servers in NGINX. Every ConnectionLimitPolicy has its own limit.
+// A policy attached to a Route overrides a policy attached to the Gateway.
+type ConnectionLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ConnectionLimitPolicy.
+	Spec ConnectionLimitPolicySpec `json:"spec"`
+
+	// Status defines the state of the ConnectionLimitPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionLimitPolicyList contains a list of ConnectionLimitPolicies.
+type ConnectionLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectionLimitPolicy `json:"items"`
+}
+
+// ConnectionLimitPolicySpec defines the desired state of the ConnectionLimitPolicy.
+type ConnectionLimitPolicySpec struct {
+	// Key is the key that the connections are limited by. If not specified, the connections are limited
+	// by the IP address of the client.
+	//
+	// +optional
+	Key *ConnectionLimitKey `json:"key,omitempty"`
+
+	// ZoneSize is the size of the shared memory zone with the states of the keys.
+	// If not specified, the size is 10m.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_zone
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// RejectCode is the status code of the response to the rejected requests. If not specified, the code is 503.
+	// Only applies to HTTPRoutes, GRPCRoutes, and the HTTP and HTTPS Listeners of the Gateway.
+	// The rejected connections of TLSRoutes and TCPRoutes are closed.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_status
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute, GRPCRoute, TLSRoute, TCPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries
+	// in the ConnectionLimitPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway, HTTPRoute, GRPCRoute, TLSRoute, or TCPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute' || t.kind=='GRPCRoute' || t.kind=='TLSRoute' || t.kind=='TCPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+
+	// MaxConnections is the maximum number of the concurrent connections of a key.
+	// For HTTP/2 and HTTP/3, every concurrent request is counted as a separate connection.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100000
+	MaxConnections int32 `json:"maxConnections"`
+}
+
+// ConnectionLimitKey is the key that the connections are limited by.
+//
+// +kubebuilder:validation:Enum=ClientIP;Server
+type ConnectionLimitKey string
+
+const (
+	// ConnectionLimitKeyClientIP limits the connections of every client IP address.
+	ConnectionLimitKeyClientIP ConnectionLimitKey = "ClientIP"
+
+	// ConnectionLimitKeyServer limits the connections of every server. For the HTTP and HTTPS Listeners and the
+	// TLSRoutes, a server is a hostname of a port. For the TCPRoutes, a server is a listening address and port.
+	ConnectionLimitKeyServer ConnectionLimitKey = "Server"
+)
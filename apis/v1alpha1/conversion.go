@@ -0,0 +1,13 @@
+package v1alpha1
+
+// The v1alpha1 API types are the conversion hubs for the gateway.nginx.org API group.
+// Newer versions of a type (for example, v1alpha2 ObservabilityPolicy) implement
+// sigs.k8s.io/controller-runtime/pkg/conversion.Convertible and convert to and from the hub types.
+// The hub lives in v1alpha1 because newer API packages import v1alpha1 for shared types,
+// so v1alpha1 cannot import them back.
+//
+// When adding a new version of a type, the hub type must be able to represent every field of every
+// served version, so that objects can round-trip through the hub without losing data.
+
+// Hub marks ObservabilityPolicy as a conversion hub.
+func (*ObservabilityPolicy) Hub() {}
@@ -0,0 +1,99 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=directresponse
+// +kubebuilder:printcolumn:name="Status Code",type=integer,JSONPath=`.spec.statusCode`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DirectResponse is a filter that responds to the requests of HTTPRoute rules with a fixed status code and body
+// instead of proxying them to the backends, for example, to serve a maintenance page or to block requests.
+// It is referenced by the ExtensionRef filter of an HTTPRoute rule.
+type DirectResponse struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the DirectResponse.
+	Spec DirectResponseSpec `json:"spec"`
+
+	// Status defines the state of the DirectResponse.
+	Status DirectResponseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectResponseList contains a list of DirectResponses.
+type DirectResponseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectResponse `json:"items"`
+}
+
+// DirectResponseSpec defines the desired state of the DirectResponse.
+//
+// +kubebuilder:validation:XValidation:message="redirect status codes are not supported, use the RequestRedirect filter instead",rule="!(self.statusCode in [301, 302, 303, 307, 308])"
+//
+//nolint:lll
+type DirectResponseSpec struct {
+	// Body is the body of the response. If not set, the response has an empty body.
+	// The body cannot contain the '$' character, because NGINX would interpret it as a variable.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^[^$]*$`
+	Body *string `json:"body,omitempty"`
+
+	// ContentType is the media type of the body, for example, text/html. Defaults to text/plain.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*/[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*$`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// StatusCode is the status code of the response.
+	//
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int32 `json:"statusCode"`
+}
+
+// DirectResponseStatus defines the state of the DirectResponse.
+type DirectResponseStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the DirectResponse
+	// and the status of the DirectResponse with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// DirectResponseConditionType is a type of condition associated with DirectResponse.
+type DirectResponseConditionType string
+
+// DirectResponseConditionReason is a reason for a DirectResponse condition type.
+type DirectResponseConditionReason string
+
+const (
+	// DirectResponseConditionTypeAccepted indicates that the DirectResponse is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	DirectResponseConditionTypeAccepted DirectResponseConditionType = "Accepted"
+
+	// DirectResponseConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	DirectResponseConditionReasonAccepted DirectResponseConditionReason = "Accepted"
+
+	// DirectResponseConditionReasonInvalid is used with the Accepted condition type when
+	// the DirectResponse is invalid.
+	DirectResponseConditionReasonInvalid DirectResponseConditionReason = "Invalid"
+)
@@ -0,0 +1,135 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=eppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// ErrorPagePolicy is a Direct Attached Policy. It provides a way to replace the responses of the backends
+// with the selected status codes with custom responses or redirects for the requests that match a Route,
+// for example, to hide the stack traces of the backends behind branded error pages.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// ErrorPages are the error pages. The status codes of the error pages must be distinct.
+	// Directives: https://nginx.org/en/docs/http/ngx_http_core_module.html#error_page,
+	// https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_intercept_errors
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ErrorPages []ErrorPage `json:"errorPages"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries in the ErrorPagePolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ErrorPage replaces the responses of the backends with the status codes with a custom response or a redirect.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of response or redirect must be set",rule="has(self.response) != has(self.redirect)"
+//
+//nolint:lll
+type ErrorPage struct {
+	// Response is the custom response that replaces the responses of the backends.
+	//
+	// +optional
+	Response *ErrorPageResponse `json:"response,omitempty"`
+
+	// Redirect is the redirect that replaces the responses of the backends.
+	//
+	// +optional
+	Redirect *ErrorPageRedirect `json:"redirect,omitempty"`
+
+	// Codes are the status codes of the responses of the backends that are replaced.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Codes []ErrorPageStatusCode `json:"codes"`
+}
+
+// ErrorPageStatusCode is the status code of a response of a backend that is replaced by an error page.
+//
+// +kubebuilder:validation:Minimum=300
+// +kubebuilder:validation:Maximum=599
+type ErrorPageStatusCode int32
+
+// ErrorPageResponse is a custom response that replaces the responses of the backends.
+type ErrorPageResponse struct {
+	// StatusCode is the status code of the response. If not specified, the status code of the response
+	// of the backend is kept.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// ContentType is the Content-Type of the response. Default is "text/html".
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#default_type
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*$`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Body is the body of the response. The body can't contain the '$' character, since NGINX
+	// would interpret it as a variable.
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^[^$]*$`
+	Body string `json:"body"`
+}
+
+// ErrorPageRedirect is a redirect that replaces the responses of the backends.
+type ErrorPageRedirect struct {
+	// StatusCode is the status code of the redirect. Default is 302.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// URL is the absolute HTTP or HTTPS URL that the clients are redirected to.
+	// For example, "https://errors.example.com/500.html".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s"$\\]+$`
+	URL string `json:"url"`
+}
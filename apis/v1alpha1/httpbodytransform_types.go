@@ -0,0 +1,126 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=bodytransform
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HTTPBodyTransform is a filter that transforms the bodies of the requests and responses of HTTPRoute rules
+// with njs scripts. It is referenced by the ExtensionRef filter of an HTTPRoute rule.
+type HTTPBodyTransform struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the HTTPBodyTransform.
+	Spec HTTPBodyTransformSpec `json:"spec"`
+
+	// Status defines the state of the HTTPBodyTransform.
+	Status HTTPBodyTransformStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPBodyTransformList contains a list of HTTPBodyTransforms.
+type HTTPBodyTransformList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPBodyTransform `json:"items"`
+}
+
+// HTTPBodyTransformSpec defines the desired state of the HTTPBodyTransform.
+//
+// +kubebuilder:validation:XValidation:message="at least one of request or response must be set",rule="has(self.request) || has(self.response)"
+//
+//nolint:lll
+type HTTPBodyTransformSpec struct {
+	// Request transforms the bodies of the requests before they are proxied to the backends.
+	//
+	// +optional
+	Request *RequestBodyTransform `json:"request,omitempty"`
+
+	// Response transforms the bodies of the responses before they are sent to the clients.
+	// The responses with a Content-Encoding header are not transformed.
+	//
+	// +optional
+	Response *ResponseBodyTransform `json:"response,omitempty"`
+}
+
+// RequestBodyTransform transforms the bodies of the requests.
+type RequestBodyTransform struct {
+	// MaxBodySize is the maximum size of the request bodies that are transformed. NGINX buffers the request
+	// bodies up to this size in memory, so that the script receives the whole body. The requests with larger
+	// bodies are proxied untransformed. Defaults to 1m.
+	//
+	// +optional
+	MaxBodySize *Size `json:"maxBodySize,omitempty"`
+
+	// Script is the script that transforms the body of a request.
+	Script BodyTransformScript `json:"script"`
+}
+
+// ResponseBodyTransform transforms the bodies of the responses.
+type ResponseBodyTransform struct {
+	// MaxBodySize is the maximum size of the response bodies that are transformed. The response body is buffered
+	// until it is complete, so that the script receives the whole body. The responses with larger bodies are sent
+	// untransformed. Defaults to 1m.
+	//
+	// +optional
+	MaxBodySize *Size `json:"maxBodySize,omitempty"`
+
+	// Script is the script that transforms the body of a response.
+	Script BodyTransformScript `json:"script"`
+}
+
+// BodyTransformScript is the body of an njs function that transforms a body. The function receives the body
+// as the string variable body and must return the transformed body as a string, for example:
+// return body.replace(/foo/g, 'bar');
+//
+// The script runs in a sandbox: it cannot import modules, access the request, the njs and ngx objects
+// or the global object, evaluate code, or run asynchronous code. If the script throws an error or doesn't
+// return a string, the body is not transformed and the error is logged.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=16384
+type BodyTransformScript string
+
+// HTTPBodyTransformStatus defines the state of the HTTPBodyTransform.
+type HTTPBodyTransformStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the HTTPBodyTransform
+	// and the status of the HTTPBodyTransform with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// HTTPBodyTransformConditionType is a type of condition associated with HTTPBodyTransform.
+type HTTPBodyTransformConditionType string
+
+// HTTPBodyTransformConditionReason is a reason for an HTTPBodyTransform condition type.
+type HTTPBodyTransformConditionReason string
+
+const (
+	// HTTPBodyTransformConditionTypeAccepted indicates that the HTTPBodyTransform is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	HTTPBodyTransformConditionTypeAccepted HTTPBodyTransformConditionType = "Accepted"
+
+	// HTTPBodyTransformConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	HTTPBodyTransformConditionReasonAccepted HTTPBodyTransformConditionReason = "Accepted"
+
+	// HTTPBodyTransformConditionReasonInvalid is used with the Accepted condition type when
+	// the HTTPBodyTransform is invalid.
+	HTTPBodyTransformConditionReasonInvalid HTTPBodyTransformConditionReason = "Invalid"
+)
@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=ltlspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// ListenerTLSPolicy is a Direct Attached Policy. It provides a way to restrict the TLS settings of the servers
+// of the hostnames of the HTTPS Listeners of a Gateway, for example, to only allow TLSv1.3 for one hostname
+// on a port that is shared with other hostnames. The settings must be allowed by the TLS profile of the NginxProxy.
+//
+// NGINX selects the settings of a hostname using the server name (SNI) that the client sends in the TLS handshake,
+// which requires NGINX built with OpenSSL 1.1.1 or later.
+type ListenerTLSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ListenerTLSPolicy.
+	Spec ListenerTLSPolicySpec `json:"spec"`
+
+	// Status defines the state of the ListenerTLSPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerTLSPolicyList contains a list of ListenerTLSPolicies.
+type ListenerTLSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerTLSPolicy `json:"items"`
+}
+
+// ListenerTLSPolicySpec defines the desired state of the ListenerTLSPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of protocols, ciphers, or preferServerCiphers must be set",rule="has(self.protocols) || has(self.ciphers) || has(self.preferServerCiphers)"
+//
+//nolint:lll
+type ListenerTLSPolicySpec struct {
+	// Hostnames are the hostnames that the policy applies to. A hostname matches the servers of the hostname
+	// in the HTTPS Listeners of the Gateway. A wildcard hostname, for example "*.example.com", also matches
+	// the servers of its subdomains, for example "admin.example.com".
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Hostnames []gatewayv1.Hostname `json:"hostnames"`
+
+	// Protocols are the TLS protocols that NGINX allows for the hostnames.
+	// The protocols must be allowed by the TLS profile of the NginxProxy.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_protocols
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=set
+	Protocols []TLSProtocolType `json:"protocols,omitempty"`
+
+	// Ciphers are the ciphers that NGINX allows for the hostnames, in the format of the OpenSSL library.
+	// The ciphers only apply to TLSv1.2.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_ciphers
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	Ciphers *string `json:"ciphers,omitempty"`
+
+	// PreferServerCiphers specifies whether the ciphers of NGINX are preferred over the ciphers of the client
+	// for the hostnames when TLSv1.2 is used.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_prefer_server_ciphers
+	//
+	// +optional
+	PreferServerCiphers *bool `json:"preferServerCiphers,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries in the ListenerTLSPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be: Gateway",rule="self.all(t, t.kind=='Gateway')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
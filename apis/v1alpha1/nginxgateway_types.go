@@ -1,6 +1,9 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
 
 // +genclient
 // +kubebuilder:object:root=true
@@ -36,8 +39,140 @@ type NginxGatewaySpec struct {
 	//
 	// +optional
 	Logging *Logging `json:"logging,omitempty"`
+
+	// ApplyMode defines how the changes of the configuration are applied to the control plane.
+	// In the Automatic mode, the changes are applied as soon as they are made.
+	// In the Manual mode, the changes are validated and reported in the Applied condition of the status,
+	// but they are not applied until the mode is set to Automatic. This allows reviewing the effect
+	// of the changes before they take effect. When the control plane starts, or the NginxGateway is deleted,
+	// the configuration is applied regardless of the mode.
+	//
+	// +optional
+	// +kubebuilder:default=Automatic
+	ApplyMode *ControlPlaneApplyMode `json:"applyMode,omitempty"`
+
+	// SmokeTest configures the synthetic requests that are sent through NGINX after every successful update
+	// of the NGINX configuration, so that a configuration that is applied but breaks the traffic is detected
+	// right away. The failed requests are reported in the SmokeTestFailed condition of the Gateway and in the metrics.
+	// If not specified, no requests are sent.
+	//
+	// +optional
+	SmokeTest *SmokeTest `json:"smokeTest,omitempty"`
+
+	// ReloadGating delays the reloads of NGINX that are caused by the changes of the resources until the number
+	// of in-flight requests drops below a threshold or a maximum delay expires, so that the reloads reset fewer
+	// connections during traffic peaks. The reloads that apply the changes of the endpoints are not delayed.
+	// The in-flight requests are counted with the NGINX Plus API, so the reloads are only delayed with NGINX Plus.
+	// If not specified, the reloads are not delayed.
+	//
+	// +optional
+	ReloadGating *ReloadGating `json:"reloadGating,omitempty"`
+}
+
+// ReloadGating defines when a delayed reload of NGINX happens.
+type ReloadGating struct {
+	// MaxDelay is the maximum time that a reload is delayed for. If not specified, the maximum delay is 30s.
+	//
+	// +optional
+	// +kubebuilder:default="30s"
+	MaxDelay *Duration `json:"maxDelay,omitempty"`
+
+	// InFlightRequestsThreshold is the number of in-flight requests that NGINX must be below to be reloaded.
+	//
+	// +kubebuilder:validation:Minimum=1
+	InFlightRequestsThreshold int32 `json:"inFlightRequestsThreshold"`
+}
+
+// SmokeTest defines the synthetic requests that are sent through NGINX after the NGINX configuration is updated.
+type SmokeTest struct {
+	// Probes are the requests that are sent through NGINX and their expected responses.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	Probes []SmokeTestProbe `json:"probes"`
+}
+
+// SmokeTestProbe defines a request that is sent through NGINX and its expected response.
+type SmokeTestProbe struct {
+	// Path is the path of the request. If not specified, the path is "/".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Scheme is the scheme of the request. With HTTPS, the certificate of the Listener is not verified.
+	// If not specified, the scheme is HTTP.
+	//
+	// +optional
+	// +kubebuilder:default=HTTP
+	Scheme *SmokeTestScheme `json:"scheme,omitempty"`
+
+	// ExpectedStatus is the expected status code of the response. If not specified, the status code is 200.
+	//
+	// +optional
+	// +kubebuilder:default=200
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	ExpectedStatus *int32 `json:"expectedStatus,omitempty"`
+
+	// Name identifies the probe in the conditions and the metrics.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Hostname is the host of the request. It is sent in the Host header and, with HTTPS, as the server name
+	// of the TLS handshake.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Hostname string `json:"hostname"`
+
+	// ExpectedHeaders are the names of the headers that the response is expected to have.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	ExpectedHeaders []gatewayv1.HTTPHeaderName `json:"expectedHeaders,omitempty"`
+
+	// Port is the port of the Listener that receives the request.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
 }
 
+// SmokeTestScheme is the scheme of the request of a smoke test probe.
+//
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+type SmokeTestScheme string
+
+const (
+	// SmokeTestSchemeHTTP sends the request of a probe over plain HTTP.
+	SmokeTestSchemeHTTP SmokeTestScheme = "HTTP"
+
+	// SmokeTestSchemeHTTPS sends the request of a probe over HTTPS.
+	SmokeTestSchemeHTTPS SmokeTestScheme = "HTTPS"
+)
+
+// ControlPlaneApplyMode defines how the changes of the control plane configuration are applied.
+//
+// +kubebuilder:validation:Enum=Automatic;Manual
+type ControlPlaneApplyMode string
+
+const (
+	// ControlPlaneApplyModeAutomatic applies the changes of the configuration as soon as they are made.
+	ControlPlaneApplyModeAutomatic ControlPlaneApplyMode = "Automatic"
+
+	// ControlPlaneApplyModeManual holds the changes of the configuration until the mode is set to Automatic.
+	ControlPlaneApplyModeManual ControlPlaneApplyMode = "Manual"
+)
+
 // Logging defines logging related settings for the control plane.
 type Logging struct {
 	// Level defines the logging level.
@@ -90,4 +225,16 @@ const (
 
 	// NginxGatewayReasonInvalid is a reason that is used with the "Valid" condition when the condition is False.
 	NginxGatewayReasonInvalid NginxGatewayConditionReason = "Invalid"
+
+	// NginxGatewayConditionApplied is a condition that is true when the valid NginxGateway
+	// configuration is applied to the control plane, and false when the configuration has changes
+	// that are pending in the Manual apply mode.
+	NginxGatewayConditionApplied NginxGatewayConditionType = "Applied"
+
+	// NginxGatewayReasonApplied is a reason that is used with the "Applied" condition when the condition is True.
+	NginxGatewayReasonApplied NginxGatewayConditionReason = "Applied"
+
+	// NginxGatewayReasonPending is a reason that is used with the "Applied" condition when the condition is False
+	// because the changes of the configuration are pending in the Manual apply mode.
+	NginxGatewayReasonPending NginxGatewayConditionReason = "Pending"
 )
@@ -1,11 +1,16 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
 
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Cluster
+// +kubebuilder:printcolumn:name="Base",type=string,JSONPath=`.spec.baseRef.name`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // NginxProxy is a configuration object that is attached to a GatewayClass parametersRef. It provides a way
@@ -16,6 +21,9 @@ type NginxProxy struct { //nolint:govet // standard field alignment, don't chang
 
 	// Spec defines the desired state of the NginxProxy.
 	Spec NginxProxySpec `json:"spec"`
+
+	// Status defines the state of the NginxProxy.
+	Status NginxProxyStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -53,11 +61,203 @@ type NginxProxySpec struct {
 	//
 	// +optional
 	NginxPlus *NginxPlus `json:"nginxPlus,omitempty"`
+	// TemplateOverrides references a ConfigMap containing templates that override parts of the
+	// generated NGINX configuration. This is intended for advanced use cases only. Template overrides must
+	// be enabled in the configuration of NGINX Gateway Fabric, and the templates must pass validation;
+	// otherwise, the NginxProxy is invalid. A template override that fails rendering is ignored, and the
+	// default template is used instead.
+	//
+	// +optional
+	TemplateOverrides *TemplateOverrides `json:"templateOverrides,omitempty"`
+	// HashTables specifies the sizes of the NGINX hash tables for server names and maps.
+	// By default, the sizes are computed from the number and the length of the hostnames
+	// in the generated configuration.
+	//
+	// +optional
+	HashTables *HashTables `json:"hashTables,omitempty"`
+	// HTTPMatchMode defines how NGINX evaluates the method, header, and query parameter matches of Routes.
+	// Default is NJS.
+	//
+	// +optional
+	HTTPMatchMode *HTTPMatchMode `json:"httpMatchMode,omitempty"`
+	// Hardening specifies the settings that protect NGINX from slow clients and malformed requests.
+	// If specified, the settings that are not set use secure defaults.
+	//
+	// +optional
+	Hardening *Hardening `json:"hardening,omitempty"`
+	// SocketOptions specifies the options of the listening sockets and the client connections of the Listeners.
+	//
+	// +optional
+	SocketOptions *SocketOptions `json:"socketOptions,omitempty"`
+	// Worker specifies the NGINX worker processes: their user, number and connections, and how NGINX binds
+	// to the privileged ports of the Listeners.
+	//
+	// +optional
+	Worker *NginxWorker `json:"worker,omitempty"`
+	// TLSPassthrough specifies the settings of the TLS Listeners in passthrough mode.
+	//
+	// +optional
+	TLSPassthrough *TLSPassthrough `json:"tlsPassthrough,omitempty"`
+	// TLS specifies the TLS profile of the HTTPS Listeners. ListenerTLSPolicies can restrict the TLS settings
+	// of the hostnames of the Listeners further, but can't loosen them.
+	//
+	// +optional
+	TLS *NginxTLS `json:"tls,omitempty"`
+	// AddressPublication specifies which addresses of the LoadBalancer Service of the Gateway are published
+	// in the status of the Gateway.
+	// Default is All.
+	//
+	// +optional
+	AddressPublication *AddressPublicationType `json:"addressPublication,omitempty"`
+	// Resolver specifies the nameservers that NGINX uses to resolve the hostnames of the upstreams,
+	// such as the external names of ExternalName Services. NGINX re-resolves the hostnames at runtime
+	// and honors their DNS TTLs, so the upstreams follow the DNS changes without reloads.
+	// By default, the nameservers of the NGINX container are used.
+	//
+	// +optional
+	Resolver *NginxResolver `json:"resolver,omitempty"`
+	// HTTP2 specifies the settings of the HTTP/2 connections of the clients, such as the limit of
+	// the concurrent streams, for the deployments with many concurrent gRPC calls.
+	// The settings don't apply if HTTP/2 is disabled.
+	//
+	// +optional
+	HTTP2 *HTTP2Settings `json:"http2,omitempty"`
+	// Status specifies the read-only status endpoint that NGINX exposes on a dedicated port,
+	// so that external monitoring can scrape NGINX directly.
+	//
+	// +optional
+	Status *NginxStatus `json:"status,omitempty"`
+	// BaseRef references the base NginxProxy that the NginxProxy inherits the settings from.
+	// The settings that are set in the NginxProxy override the settings of the base, except for
+	// the hardening settings, which override the settings of the base one by one. The boolean settings
+	// are enabled if they are enabled in the NginxProxy or in any of its bases.
+	// The base may reference its own base. The chain of bases must not contain cycles.
+	//
+	// +optional
+	BaseRef *NginxProxyReference `json:"baseRef,omitempty"`
 	// DisableHTTP2 defines if http2 should be disabled for all servers.
 	// Default is false, meaning http2 will be enabled for all servers.
 	DisableHTTP2 bool `json:"disableHTTP2,omitempty"`
+	// EnableHTTP3 defines if HTTP/3 over QUIC should be enabled for all HTTPS Listeners.
+	// NGINX listens for QUIC connections on the UDP port of the Listener and advertises HTTP/3
+	// to clients with the Alt-Svc response header.
+	// The NGINX image must support QUIC, otherwise the HTTPS Listeners are not accepted.
+	// Default is false.
+	EnableHTTP3 bool `json:"enableHTTP3,omitempty"`
+	// DisableRegexPathMatch defines if the RegularExpression path match type should be disabled for all Routes.
+	// Default is false, meaning HTTPRoutes can match request paths against regular expressions.
+	// The regular expression must match the whole request path and must be compatible with RE2.
+	// Regular expression matches have a lower precedence than Exact matches, and a higher precedence
+	// than PathPrefix matches. Among regular expression matches, the longer expression has the higher precedence.
+	// It also disables the RegularExpression method match type of GRPCRoutes.
+	DisableRegexPathMatch bool `json:"disableRegexPathMatch,omitempty"`
+}
+
+// NginxProxyReference references an NginxProxy.
+type NginxProxyReference struct {
+	// Name is the name of the NginxProxy.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// NginxProxyStatus defines the state of the NginxProxy.
+type NginxProxyStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the NginxProxy
+	// and the status of the NginxProxy with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []NginxProxyControllerStatus `json:"controllers,omitempty"`
+}
+
+// NginxProxyControllerStatus is the status of the NginxProxy with respect to a controller.
+type NginxProxyControllerStatus struct {
+	// ControllerName is a domain/path string that indicates the name of the
+	// controller that wrote this status. This corresponds with the
+	// controllerName field on GatewayClass.
+	//
+	// Example: "example.net/gateway-controller".
+	//
+	// The format of this field is DOMAIN "/" PATH, where DOMAIN and PATH are
+	// valid Kubernetes names
+	// (https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names).
+	//
+	// Controllers MUST populate this field when writing status. Controllers should ensure that
+	// entries to status populated with their ControllerName are cleaned up when they are no
+	// longer necessary.
+	ControllerName v1.GatewayController `json:"controllerName"`
+
+	// Conditions describe the status of the NginxProxy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Bases are the names of the base NginxProxies that the NginxProxy inherits the settings from,
+	// starting with the base referenced by the NginxProxy. If a base doesn't exist, its name ends the list.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Bases []string `json:"bases,omitempty"`
+
+	// EffectiveValues report the NginxProxy that provides the effective value of every setting
+	// that is set in the NginxProxy or in its bases.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	EffectiveValues []NginxProxyEffectiveValue `json:"effectiveValues,omitempty"`
+}
+
+// NginxProxyEffectiveValue reports the NginxProxy that provides the effective value of a setting.
+type NginxProxyEffectiveValue struct {
+	// Field is the path of the setting in the spec, for example, "telemetry" or "hardening.sendTimeout".
+	Field string `json:"field"`
+
+	// Source is the name of the NginxProxy that provides the value of the setting.
+	Source string `json:"source"`
 }
 
+// NginxProxyConditionType is a type of condition associated with NginxProxy.
+type NginxProxyConditionType string
+
+// NginxProxyConditionReason is a reason for an NginxProxy condition type.
+type NginxProxyConditionReason string
+
+const (
+	// NginxProxyConditionTypeAccepted indicates that the NginxProxy is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid
+	// * BaseNotFound
+	// * BaseCycle.
+	NginxProxyConditionTypeAccepted NginxProxyConditionType = "Accepted"
+
+	// NginxProxyConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	NginxProxyConditionReasonAccepted NginxProxyConditionReason = "Accepted"
+
+	// NginxProxyConditionReasonInvalid is used with the Accepted condition type when
+	// the NginxProxy, with the settings inherited from its bases, is invalid.
+	NginxProxyConditionReasonInvalid NginxProxyConditionReason = "Invalid"
+
+	// NginxProxyConditionReasonBaseNotFound is used with the Accepted condition type when
+	// a base NginxProxy in the chain of bases doesn't exist.
+	NginxProxyConditionReasonBaseNotFound NginxProxyConditionReason = "BaseNotFound"
+
+	// NginxProxyConditionReasonBaseCycle is used with the Accepted condition type when
+	// the chain of bases of the NginxProxy contains a cycle.
+	NginxProxyConditionReasonBaseCycle NginxProxyConditionReason = "BaseCycle"
+)
+
 // NginxPlus specifies NGINX Plus additional settings. These will only be applied if NGINX Plus is being used.
 type NginxPlus struct {
 	// AllowedAddresses specifies IPAddresses or CIDR blocks to the allow list for accessing the NGINX Plus API.
@@ -66,6 +266,525 @@ type NginxPlus struct {
 	AllowedAddresses []NginxPlusAllowAddress `json:"allowedAddresses,omitempty"`
 }
 
+// HashTables specifies the sizes of the NGINX hash tables. A size that is not specified is computed
+// from the number and the length of the hostnames in the generated configuration.
+type HashTables struct {
+	// ServerNamesMaxSize sets the maximum size of the server names hash tables.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#server_names_hash_max_size
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ServerNamesMaxSize *int32 `json:"serverNamesMaxSize,omitempty"`
+
+	// ServerNamesBucketSize sets the bucket size of the server names hash tables.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#server_names_hash_bucket_size
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ServerNamesBucketSize *int32 `json:"serverNamesBucketSize,omitempty"`
+
+	// MapMaxSize sets the maximum size of the map variables hash tables.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_map_module.html#map_hash_max_size
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MapMaxSize *int32 `json:"mapMaxSize,omitempty"`
+
+	// MapBucketSize sets the bucket size for the map variables hash tables.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_map_module.html#map_hash_bucket_size
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MapBucketSize *int32 `json:"mapBucketSize,omitempty"`
+}
+
+// Hardening specifies the settings that protect NGINX from slow clients, such as Slowloris attacks,
+// and from malformed requests. The settings apply to all HTTP and HTTPS Listeners.
+type Hardening struct {
+	// ClientHeaderTimeout sets the timeout for reading the header of a client request.
+	// If a client does not send the entire header within this time, the request is terminated
+	// with the 408 (Request Time-out) error.
+	// Default is 10s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#client_header_timeout
+	//
+	// +optional
+	ClientHeaderTimeout *Duration `json:"clientHeaderTimeout,omitempty"`
+
+	// ClientBodyTimeout sets the timeout for reading the body of a client request. The timeout is set only
+	// for a period between two successive read operations, not for the transmission of the whole body.
+	// If a client does not transmit anything within this time, the request is terminated
+	// with the 408 (Request Time-out) error.
+	// The timeout of a ClientSettingsPolicy takes precedence over this setting.
+	// Default is 10s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#client_body_timeout
+	//
+	// +optional
+	ClientBodyTimeout *Duration `json:"clientBodyTimeout,omitempty"`
+
+	// SendTimeout sets the timeout for transmitting a response to a client. The timeout is set only
+	// between two successive write operations, not for the transmission of the whole response.
+	// If a client does not receive anything within this time, the connection is closed.
+	// Default is 10s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#send_timeout
+	//
+	// +optional
+	SendTimeout *Duration `json:"sendTimeout,omitempty"`
+
+	// ResetTimedOutConnection enables resetting the timed out connections, which frees all the memory
+	// associated with the socket immediately instead of keeping it in the FIN_WAIT1 state.
+	// Default is true.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#reset_timedout_connection
+	//
+	// +optional
+	ResetTimedOutConnection *bool `json:"resetTimedOutConnection,omitempty"`
+
+	// RequestSmugglingProtection enables the protections against HTTP request smuggling:
+	// NGINX ignores the request headers with invalid names, including the names with underscores,
+	// and reads the whole request body before proxying it, so that the backends always receive
+	// requests with unambiguous framing.
+	// Default is true.
+	//
+	// +optional
+	RequestSmugglingProtection *bool `json:"requestSmugglingProtection,omitempty"`
+}
+
+// SocketOptions specifies the options of the listening sockets and the client connections of the Listeners.
+// NGINX configures the listening socket of a port once, so the options of a Listener apply to all Listeners
+// with the same port.
+type SocketOptions struct {
+	// Default specifies the options for all Listeners.
+	//
+	// +optional
+	Default *SocketSettings `json:"default,omitempty"`
+
+	// Listeners specifies the options for particular Listeners, which override the default options.
+	// If multiple Listeners with the same port have overrides, the overrides of the Listener that comes
+	// first in the Gateway take precedence.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []ListenerSocketSettings `json:"listeners,omitempty"`
+}
+
+// ListenerSocketSettings specifies the socket options of a Listener.
+type ListenerSocketSettings struct {
+	// Settings are the socket options of the Listener.
+	Settings SocketSettings `json:"settings"`
+
+	// Name is the name of the Listener.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// SocketSettings specifies the options of a listening socket and its client connections.
+// The options that are not specified use the NGINX defaults.
+type SocketSettings struct {
+	// KeepAlive configures the TCP keepalive of the client connections.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#listen (so_keepalive)
+	//
+	// +optional
+	KeepAlive *TCPKeepAlive `json:"keepAlive,omitempty"`
+
+	// Backlog sets the maximum length of the queue of pending connections.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#listen (backlog)
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Backlog *int32 `json:"backlog,omitempty"`
+
+	// ReusePort creates a separate listening socket for each worker process, so that the kernel
+	// distributes the incoming connections between the worker processes.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#listen (reuseport)
+	//
+	// +optional
+	ReusePort *bool `json:"reusePort,omitempty"`
+
+	// DeferredAccept defers accepting a connection until the client sends data, on Linux.
+	// It doesn't apply to UDP Listeners.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#listen (deferred)
+	//
+	// +optional
+	DeferredAccept *bool `json:"deferredAccept,omitempty"`
+
+	// TCPNoDelay enables the TCP_NODELAY option of the client connections. NGINX enables it by default.
+	// It doesn't apply to UDP Listeners.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#tcp_nodelay
+	//
+	// +optional
+	TCPNoDelay *bool `json:"tcpNoDelay,omitempty"`
+
+	// TCPNoPush enables the TCP_NOPUSH (TCP_CORK on Linux) option of the client connections
+	// when sendfile is used. It only applies to HTTP and HTTPS Listeners, for which it is enabled by default.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#tcp_nopush
+	//
+	// +optional
+	TCPNoPush *bool `json:"tcpNoPush,omitempty"`
+}
+
+// NginxWorker specifies the NGINX worker processes: their user, number and connections, and how NGINX binds
+// to the privileged ports.
+//
+// +kubebuilder:validation:XValidation:message="user is required if group is set",rule="!has(self.group) || has(self.user)"
+//
+//nolint:lll
+type NginxWorker struct {
+	// User is the user of the NGINX worker processes. It only takes effect if the NGINX master process
+	// runs as root, which is the case for the images that drop the privileges of the worker processes
+	// instead of running NGINX as a non-root user. The user must exist in the NGINX image.
+	// Default is the user of the NGINX master process.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#user
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_-]*$`
+	// +kubebuilder:validation:MaxLength=32
+	User *string `json:"user,omitempty"`
+
+	// Group is the group of the NGINX worker processes. The group must exist in the NGINX image.
+	// Default is the group with the same name as the user.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#user
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_-]*$`
+	// +kubebuilder:validation:MaxLength=32
+	Group *string `json:"group,omitempty"`
+
+	// Processes is the number of the NGINX worker processes. The value "auto" sets it to the number
+	// of the available CPU cores.
+	// Default is auto.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#worker_processes
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(auto|[1-9][0-9]{0,3})$`
+	Processes *string `json:"processes,omitempty"`
+
+	// Connections is the maximum number of simultaneous connections of a worker process. The number includes
+	// the connections with the clients and with the backends, so a proxied request takes two connections.
+	// Default is 1024.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#worker_connections
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1048576
+	Connections *int32 `json:"connections,omitempty"`
+
+	// RlimitNofile is the limit on the number of open files of the worker processes. It raises the limit
+	// of the NGINX container, which can be lower than the number of the connections. The limit must not
+	// exceed the hard limit of the container.
+	// Default is the limit of the NGINX container.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#worker_rlimit_nofile
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1048576
+	RlimitNofile *int32 `json:"rlimitNofile,omitempty"`
+
+	// MultiAccept makes a worker process accept all new connections at a time, instead of one
+	// new connection at a time.
+	// Default is false.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#multi_accept
+	//
+	// +optional
+	MultiAccept *bool `json:"multiAccept,omitempty"`
+
+	// PortBinding specifies how NGINX binds to the privileged ports (below 1024) of the Listeners,
+	// and which ports of the Listeners NGINX listens on in the container.
+	//
+	// +optional
+	PortBinding *PortBinding `json:"portBinding,omitempty"`
+}
+
+// PortBinding specifies how NGINX binds to the privileged ports of the Listeners.
+type PortBinding struct {
+	// Strategy is the strategy for binding to the privileged ports.
+	// Default is Capability.
+	//
+	// +optional
+	Strategy *PortBindingStrategy `json:"strategy,omitempty"`
+
+	// PortOffset is added to a privileged port of a Listener to get the port that NGINX listens on when
+	// the strategy is HighPorts. For example, with the default offset of 8000, NGINX listens on the port 8080
+	// for a Listener with the port 80. The offset must not map a privileged port to the port of another Listener.
+	// Default is 8000.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=64512
+	PortOffset *int32 `json:"portOffset,omitempty"`
+
+	// PortMappings maps the ports of the Listeners to the ports that NGINX listens on in the container.
+	// A mapping applies to the port of a Listener of any protocol, and takes precedence over the port offset
+	// of the HighPorts strategy. The Service of NGINX must map the ports of the Listeners to the container ports.
+	// A container port must not be the port of another Listener.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=listenerPort
+	// +kubebuilder:validation:MaxItems=64
+	PortMappings []PortMapping `json:"portMappings,omitempty"`
+}
+
+// PortMapping maps the port of a Listener to the port that NGINX listens on in the container.
+type PortMapping struct {
+	// ListenerPort is the port of the Listener.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ListenerPort int32 `json:"listenerPort"`
+
+	// ContainerPort is the port that NGINX listens on in the container.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ContainerPort int32 `json:"containerPort"`
+}
+
+// PortBindingStrategy is the strategy for binding to the privileged ports.
+//
+// +kubebuilder:validation:Enum=Capability;HighPorts
+type PortBindingStrategy string
+
+const (
+	// PortBindingStrategyCapability binds NGINX to the privileged ports directly. NGINX requires the
+	// CAP_NET_BIND_SERVICE capability.
+	PortBindingStrategyCapability PortBindingStrategy = "Capability"
+	// PortBindingStrategyHighPorts binds NGINX to the privileged ports shifted by the port offset, so that NGINX
+	// doesn't require any capabilities. The Service of NGINX must map the ports of the Listeners to the shifted
+	// ports.
+	PortBindingStrategyHighPorts PortBindingStrategy = "HighPorts"
+)
+
+// TCPKeepAlive configures the TCP keepalive of the client connections.
+// The settings that are not specified use the system defaults.
+type TCPKeepAlive struct {
+	// Idle is the time the connection stays idle before NGINX starts sending keepalive probes.
+	// It must be in seconds, minutes, or hours.
+	//
+	// +optional
+	Idle *Duration `json:"idle,omitempty"`
+
+	// Interval is the time between the keepalive probes.
+	// It must be in seconds, minutes, or hours.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Count is the number of the unacknowledged keepalive probes before the connection is dropped.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int32 `json:"count,omitempty"`
+
+	// Enabled enables the TCP keepalive.
+	Enabled bool `json:"enabled"`
+}
+
+// TLSPassthrough specifies the settings of the TLS Listeners in passthrough mode.
+type TLSPassthrough struct {
+	// SendProxyProtocol enables sending the PROXY protocol header to the backends of TLSRoutes, so that
+	// the backends receive the address of the client. If the client address is rewritten from the PROXY protocol
+	// of the incoming connections, the rewritten address is sent.
+	// The backends must accept the PROXY protocol, otherwise they can't establish the TLS connections.
+	// Default is false.
+	//
+	// +optional
+	SendProxyProtocol *bool `json:"sendProxyProtocol,omitempty"`
+}
+
+// NginxResolver specifies the nameservers that NGINX uses to resolve the hostnames of the upstreams.
+// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#resolver
+type NginxResolver struct {
+	// Valid overrides the DNS TTLs of the resolved hostnames, so that NGINX caches the answers for this time.
+	// By default, the TTLs of the answers are honored.
+	//
+	// +optional
+	Valid *Duration `json:"valid,omitempty"`
+
+	// IPv6 enables resolving the hostnames to IPv6 addresses.
+	// Default is true.
+	//
+	// +optional
+	IPv6 *bool `json:"ipv6,omitempty"`
+
+	// Addresses are the IP addresses of the nameservers, with an optional port.
+	// The default port is 53. IPv6 addresses with a port must be enclosed in square brackets.
+	// Examples: 10.96.0.10, 10.96.0.10:5353, [fd00::10]:53.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Addresses []string `json:"addresses"`
+}
+
+// HTTP2Settings specifies the settings of the HTTP/2 connections of the clients.
+// The settings that are not specified use the NGINX defaults.
+type HTTP2Settings struct {
+	// MaxConcurrentStreams sets the maximum number of concurrent HTTP/2 streams in a connection.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_v2_module.html#http2_max_concurrent_streams
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentStreams *int32 `json:"maxConcurrentStreams,omitempty"`
+
+	// RecvBufferSize sets the size of the per-worker input buffer, which limits the size of
+	// the data frames that are read at once.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_v2_module.html#http2_recv_buffer_size
+	//
+	// +optional
+	RecvBufferSize *Size `json:"recvBufferSize,omitempty"`
+
+	// ChunkSize sets the maximum size of the chunks into which the response body is sliced.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_v2_module.html#http2_chunk_size
+	//
+	// +optional
+	ChunkSize *Size `json:"chunkSize,omitempty"`
+
+	// BodyPrereadSize sets the size of the buffer per stream, in which the request body is saved
+	// before it is processed.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_v2_module.html#http2_body_preread_size
+	//
+	// +optional
+	BodyPrereadSize *Size `json:"bodyPrereadSize,omitempty"`
+}
+
+// NginxStatus specifies the read-only status endpoint of NGINX. With NGINX OSS, the endpoint serves
+// the basic status information at /stub_status. With NGINX Plus, the endpoint serves the read-only
+// NGINX Plus API at /api and the dashboard at /dashboard.html.
+// Directives: https://nginx.org/en/docs/http/ngx_http_stub_status_module.html#stub_status,
+// https://nginx.org/en/docs/http/ngx_http_api_module.html#api
+type NginxStatus struct {
+	// AllowedAddresses are the IP addresses and CIDR blocks of the clients that are allowed to access
+	// the endpoint. The requests of the other clients are denied.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	AllowedAddresses []NginxPlusAllowAddress `json:"allowedAddresses"`
+
+	// Port is the port of the endpoint. The Listeners that use the port are not accepted.
+	// The port must not be used by NGINX Gateway Fabric either, for example, for the NGINX Plus API (8765).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// NginxTLS specifies the TLS profile of the HTTPS Listeners.
+type NginxTLS struct {
+	// Ciphers are the ciphers that NGINX allows for the HTTPS Listeners, in the format of the OpenSSL library,
+	// for example, "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384". The ciphers only apply to TLSv1.2;
+	// the ciphers of TLSv1.3 are configured by the OpenSSL library. Default is the NGINX default.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_ciphers
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	Ciphers *string `json:"ciphers,omitempty"`
+
+	// PreferServerCiphers specifies whether the ciphers of NGINX are preferred over the ciphers of the client
+	// when TLSv1.2 is used.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_prefer_server_ciphers
+	//
+	// +optional
+	PreferServerCiphers *bool `json:"preferServerCiphers,omitempty"`
+
+	// Session specifies the settings of the reuse of the TLS sessions.
+	//
+	// +optional
+	Session *TLSSession `json:"session,omitempty"`
+
+	// Protocols are the TLS protocols that NGINX allows for the HTTPS Listeners.
+	// Default is TLSv1.2 and TLSv1.3.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_protocols
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Protocols []TLSProtocolType `json:"protocols,omitempty"`
+}
+
+// TLSSession specifies the settings of the reuse of the TLS sessions.
+type TLSSession struct {
+	// CacheSize is the size of the session cache that all worker processes share. One megabyte stores
+	// about 4000 sessions. If not set, NGINX doesn't cache the sessions.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_session_cache
+	//
+	// +optional
+	CacheSize *Size `json:"cacheSize,omitempty"`
+
+	// Timeout is the time during which a client can reuse a session.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_session_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// Tickets specifies whether the sessions can be reused through TLS session tickets.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_session_tickets
+	//
+	// +optional
+	Tickets *bool `json:"tickets,omitempty"`
+}
+
+// TLSProtocolType is a TLS protocol.
+//
+// +kubebuilder:validation:Enum=TLSv1.2;TLSv1.3
+type TLSProtocolType string
+
+const (
+	// TLSProtocolTLSv12 is the TLSv1.2 protocol.
+	TLSProtocolTLSv12 TLSProtocolType = "TLSv1.2"
+
+	// TLSProtocolTLSv13 is the TLSv1.3 protocol.
+	TLSProtocolTLSv13 TLSProtocolType = "TLSv1.3"
+)
+
+// TemplateOverrides references a ConfigMap containing NGINX configuration template overrides.
+//
+// The ConfigMap may contain the following keys, each holding a Go text/template:
+//   - "server": replaces the template for the http server blocks.
+//     It is executed for every server with the server configuration, which holds the list with that server,
+//     and may use {{ template "location" $l }} to render a location.
+//   - "upstream": replaces the template for the http upstream blocks.
+//     It is executed with the list of upstreams.
+//   - "location": replaces the template for a single location block within a server.
+//     It is executed with the location.
+//
+// Templates may only use the built-in text/template actions and functions, excluding "call".
+type TemplateOverrides struct {
+	// ConfigMapRef references the ConfigMap that holds the templates.
+	ConfigMapRef ConfigMapReference `json:"configMapRef"`
+}
+
+// ConfigMapReference references a ConfigMap in a given namespace.
+type ConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// TemplateOverrideKey is a key in the template overrides ConfigMap.
+type TemplateOverrideKey string
+
+const (
+	// TemplateOverrideKeyServer is the key for the http server blocks template.
+	TemplateOverrideKeyServer TemplateOverrideKey = "server"
+
+	// TemplateOverrideKeyUpstream is the key for the http upstream blocks template.
+	TemplateOverrideKeyUpstream TemplateOverrideKey = "upstream"
+
+	// TemplateOverrideKeyLocation is the key for the location block template.
+	TemplateOverrideKeyLocation TemplateOverrideKey = "location"
+)
+
 // Telemetry specifies the OpenTelemetry configuration.
 type Telemetry struct {
 	// Exporter specifies OpenTelemetry export parameters.
@@ -179,6 +898,35 @@ const (
 	RewriteClientIPModeXForwardedFor RewriteClientIPModeType = "XForwardedFor"
 )
 
+// HTTPMatchMode defines how NGINX evaluates the method, header, and query parameter matches of Routes.
+//
+// +kubebuilder:validation:Enum=NJS;Native
+type HTTPMatchMode string
+
+const (
+	// HTTPMatchModeNJS evaluates the matches with the NGINX JavaScript module.
+	HTTPMatchModeNJS HTTPMatchMode = "NJS"
+	// HTTPMatchModeNative evaluates the matches with NGINX map blocks when the matches of a path only
+	// contain methods and Exact header matches. The other matches are evaluated with the
+	// NGINX JavaScript module.
+	HTTPMatchModeNative HTTPMatchMode = "Native"
+)
+
+// AddressPublicationType specifies which addresses of the LoadBalancer Service are published in the status
+// of the Gateway.
+//
+// +kubebuilder:validation:Enum=All;PreferHostname
+type AddressPublicationType string
+
+const (
+	// AddressPublicationAll publishes both the IP addresses and the hostnames of the LoadBalancer Service.
+	AddressPublicationAll AddressPublicationType = "All"
+	// AddressPublicationPreferHostname only publishes the hostnames of the LoadBalancer Service, so that DNS
+	// providers can create CNAME records for the Gateway. If the LoadBalancer Service has no hostnames,
+	// its IP addresses are published.
+	AddressPublicationPreferHostname AddressPublicationType = "PreferHostname"
+)
+
 // IPFamilyType specifies the IP family to be used by NGINX.
 //
 // +kubebuilder:validation:Enum=dual;ipv4;ipv6
@@ -248,8 +996,179 @@ type NginxLogging struct {
 	// +optional
 	// +kubebuilder:default=info
 	ErrorLevel *NginxErrorLogLevel `json:"errorLevel,omitempty"`
+
+	// AccessLog defines the access log of the HTTP and HTTPS Listeners.
+	// If not specified, the requests are logged in the predefined "combined" format to the default NGINX access log.
+	//
+	// +optional
+	AccessLog *NginxAccessLog `json:"accessLog,omitempty"`
+}
+
+// NginxAccessLog defines the access log of the HTTP and HTTPS Listeners.
+type NginxAccessLog struct {
+	// Default specifies the access log of all Listeners.
+	//
+	// +optional
+	Default *AccessLogSettings `json:"default,omitempty"`
+
+	// Formats are the named log formats that the access logs can use.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_log_module.html#log_format
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Formats []NginxLogFormat `json:"formats,omitempty"`
+
+	// Listeners specifies the access logs of particular Listeners, which override the default access log.
+	// The settings that an override doesn't specify are inherited from the default access log.
+	// The requests that don't match the hostname of any Listener of a port that multiple Listeners share
+	// are logged to the default access log.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []ListenerAccessLog `json:"listeners,omitempty"`
+}
+
+// ListenerAccessLog specifies the access log of a Listener.
+type ListenerAccessLog struct {
+	// Settings are the access log settings of the Listener.
+	Settings AccessLogSettings `json:"settings"`
+
+	// Name is the name of the Listener.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// AccessLogSettings specifies an access log.
+// Directive: https://nginx.org/en/docs/http/ngx_http_log_module.html#access_log
+type AccessLogSettings struct {
+	// Format is the name of the log format of the access log. It is either "combined", the predefined
+	// NGINX format, or the name of one of the formats of the access log.
+	// Default is "combined".
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]{1,64}$`
+	Format *string `json:"format,omitempty"`
+
+	// Destination is the destination of the access log.
+	// Default is the standard output of the NGINX container.
+	//
+	// +optional
+	Destination *AccessLogDestination `json:"destination,omitempty"`
+
+	// Disable disables the access log.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+}
+
+// AccessLogDestination is the destination of an access log.
+//
+// +kubebuilder:validation:XValidation:message="file is required when type is File",rule="self.type != 'File' || has(self.file)"
+// +kubebuilder:validation:XValidation:message="syslog is required when type is Syslog",rule="self.type != 'Syslog' || has(self.syslog)"
+//
+//nolint:lll
+type AccessLogDestination struct {
+	// File is the absolute path of the file that the requests are logged to. Required when Type is File.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^/[^\s;{}'"$\\]*$`
+	File *string `json:"file,omitempty"`
+
+	// Syslog is the syslog server that the requests are logged to. Required when Type is Syslog.
+	//
+	// +optional
+	Syslog *SyslogDestination `json:"syslog,omitempty"`
+
+	// Type is the type of the destination.
+	Type AccessLogDestinationType `json:"type"`
 }
 
+// AccessLogDestinationType is the type of the destination of an access log.
+//
+// +kubebuilder:validation:Enum=Stdout;Stderr;File;Syslog
+type AccessLogDestinationType string
+
+const (
+	// AccessLogDestinationStdout logs the requests to the standard output of the NGINX container.
+	AccessLogDestinationStdout AccessLogDestinationType = "Stdout"
+
+	// AccessLogDestinationStderr logs the requests to the standard error of the NGINX container.
+	AccessLogDestinationStderr AccessLogDestinationType = "Stderr"
+
+	// AccessLogDestinationFile logs the requests to a file.
+	AccessLogDestinationFile AccessLogDestinationType = "File"
+
+	// AccessLogDestinationSyslog logs the requests to a syslog server.
+	AccessLogDestinationSyslog AccessLogDestinationType = "Syslog"
+)
+
+// SyslogDestination is a syslog server that the requests are logged to.
+// Directive: https://nginx.org/en/docs/syslog.html
+type SyslogDestination struct {
+	// Tag is the tag of the syslog messages.
+	// Default is "nginx".
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]{1,32}$`
+	Tag *string `json:"tag,omitempty"`
+
+	// Server is the address of the syslog server in the format "host:port", for example, "syslog.example.com:514".
+	// If the port is not specified, UDP port 514 is used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^(\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9.-]+)(:[0-9]{1,5})?$`
+	Server string `json:"server"`
+}
+
+// NginxLogFormat is a named log format.
+type NginxLogFormat struct {
+	// Escape is the escaping of the characters of the variables in the log format.
+	// Default is Default, which escapes the characters '"', '\', and the characters with the values less than 32
+	// and greater than 126 as '\xXX'.
+	//
+	// +optional
+	Escape *NginxLogFormatEscape `json:"escape,omitempty"`
+
+	// Name is the name of the log format. The name "combined" is reserved for the predefined NGINX format.
+	//
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]{1,64}$`
+	// +kubebuilder:validation:XValidation:message="name combined is reserved",rule="self != 'combined'"
+	Name string `json:"name"`
+
+	// Format is the format of the log entries, which contains text and NGINX variables, for example,
+	// '$remote_addr - "$request" $status $request_time'. The format can't contain the ' and \ characters.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^[^'\\]*$`
+	Format string `json:"format"`
+}
+
+// NginxLogFormatEscape is the escaping of the characters of the variables in a log format.
+//
+// +kubebuilder:validation:Enum=Default;JSON;None
+type NginxLogFormatEscape string
+
+const (
+	// NginxLogFormatEscapeDefault escapes the characters '"', '\', and the characters with the values less than 32
+	// and greater than 126 as '\xXX'.
+	NginxLogFormatEscapeDefault NginxLogFormatEscape = "Default"
+
+	// NginxLogFormatEscapeJSON escapes the characters that aren't allowed in the JSON strings.
+	NginxLogFormatEscapeJSON NginxLogFormatEscape = "JSON"
+
+	// NginxLogFormatEscapeNone disables the escaping.
+	NginxLogFormatEscapeNone NginxLogFormatEscape = "None"
+)
+
 // NginxErrorLogLevel type defines the log level of error logs for NGINX.
 //
 // +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
@@ -0,0 +1,153 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=rlpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// RateLimitPolicy is an Inherited Attached Policy. It provides a way to limit the rate of the requests
+// of the clients in NGINX. Every RateLimitPolicy has its own limit. A policy attached to a Route overrides
+// a policy attached to the Gateway.
+type RateLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RateLimitPolicy.
+	Spec RateLimitPolicySpec `json:"spec"`
+
+	// Status defines the state of the RateLimitPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RateLimitPolicyList contains a list of RateLimitPolicies.
+type RateLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimitPolicy `json:"items"`
+}
+
+// RateLimitPolicySpec defines the desired state of the RateLimitPolicy.
+//
+// +kubebuilder:validation:XValidation:message="delay and noDelay can't both be set",rule="!(has(self.delay) && has(self.noDelay))"
+//
+//nolint:lll
+type RateLimitPolicySpec struct {
+	// Key is the key that the requests are limited by. If not specified, the requests are limited
+	// by the IP address of the client.
+	//
+	// +optional
+	Key *RateLimitKey `json:"key,omitempty"`
+
+	// ZoneSize is the size of the shared memory zone with the states of the keys.
+	// One megabyte can store about 16 thousand states of IP addresses. If not specified, the size is 10m.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_zone
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// Burst is the number of the requests in excess of the rate that are queued instead of rejected.
+	// If not specified, the requests in excess of the rate are rejected.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	Burst *int32 `json:"burst,omitempty"`
+
+	// Delay is the number of the queued requests that are proxied without a delay. The rest of the queued requests
+	// are delayed to match the rate. If not specified, all queued requests are delayed.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	Delay *int32 `json:"delay,omitempty"`
+
+	// NoDelay proxies all queued requests without a delay.
+	//
+	// +optional
+	NoDelay *bool `json:"noDelay,omitempty"`
+
+	// RejectCode is the status code of the response to the rejected requests. If not specified, the code is 503.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_status
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+
+	// Rate is the maximum rate of the requests of a key, in requests per second or per minute.
+	// Examples: 10r/s, 30r/m.
+	//
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]{0,4}r/(s|m)$`
+	Rate string `json:"rate"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries
+	// in the RateLimitPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// RateLimitKey defines the key that the requests are limited by.
+//
+// +kubebuilder:validation:XValidation:message="header is required when type is Header",rule="self.type != 'Header' || has(self.header)"
+// +kubebuilder:validation:XValidation:message="jwtClaim is required when type is JWTClaim",rule="self.type != 'JWTClaim' || has(self.jwtClaim)"
+//
+//nolint:lll
+type RateLimitKey struct {
+	// Header is the name of the request header whose value is the key. Requires the Header type.
+	// The requests without the header are not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Header *string `json:"header,omitempty"`
+
+	// JWTClaim is the name of the claim of the JSON Web Token of the request whose value is the key.
+	// Requires the JWTClaim type. The requests without the claim are not limited.
+	// Only supported by NGINX Plus, and the JWT must be validated in the location, for example, by the auth_jwt
+	// directive in a SnippetsFilter.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	JWTClaim *string `json:"jwtClaim,omitempty"`
+
+	// Type is the type of the key.
+	Type RateLimitKeyType `json:"type"`
+}
+
+// RateLimitKeyType is the type of the key that the requests are limited by.
+//
+// +kubebuilder:validation:Enum=ClientIP;Header;JWTClaim
+type RateLimitKeyType string
+
+const (
+	// RateLimitKeyClientIP limits the requests by the IP address of the client.
+	RateLimitKeyClientIP RateLimitKeyType = "ClientIP"
+
+	// RateLimitKeyHeader limits the requests by the value of a request header.
+	RateLimitKeyHeader RateLimitKeyType = "Header"
+
+	// RateLimitKeyJWTClaim limits the requests by the value of a claim of the JSON Web Token of the request.
+	RateLimitKeyJWTClaim RateLimitKeyType = "JWTClaim"
+)
@@ -44,6 +44,28 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SnippetsFilterList{},
 		&UpstreamSettingsPolicy{},
 		&UpstreamSettingsPolicyList{},
+		&CacheControlPolicy{},
+		&CacheControlPolicyList{},
+		&StaticContentPolicy{},
+		&StaticContentPolicyList{},
+		&ListenerTLSPolicy{},
+		&ListenerTLSPolicyList{},
+		&ErrorPagePolicy{},
+		&ErrorPagePolicyList{},
+		&ResponseCompressionPolicy{},
+		&ResponseCompressionPolicyList{},
+		&CachePolicy{},
+		&CachePolicyList{},
+		&RateLimitPolicy{},
+		&RateLimitPolicyList{},
+		&ConnectionLimitPolicy{},
+		&ConnectionLimitPolicyList{},
+		&RouteTest{},
+		&RouteTestList{},
+		&HTTPBodyTransform{},
+		&HTTPBodyTransformList{},
+		&DirectResponse{},
+		&DirectResponseList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
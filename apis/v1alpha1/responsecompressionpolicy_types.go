@@ -0,0 +1,150 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=rcpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ResponseCompressionPolicy is an Inherited Attached Policy. It provides a way to compress the responses
+// to the clients with gzip or Brotli. The settings of a policy attached to a Route override the settings
+// of a policy attached to the Gateway.
+type ResponseCompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ResponseCompressionPolicy.
+	Spec ResponseCompressionPolicySpec `json:"spec"`
+
+	// Status defines the state of the ResponseCompressionPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponseCompressionPolicyList contains a list of ResponseCompressionPolicies.
+type ResponseCompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResponseCompressionPolicy `json:"items"`
+}
+
+// ResponseCompressionPolicySpec defines the desired state of the ResponseCompressionPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of gzip or brotli must be set",rule="has(self.gzip) || has(self.brotli)"
+//
+//nolint:lll
+type ResponseCompressionPolicySpec struct {
+	// Gzip configures the gzip compression of the responses.
+	// Directives: https://nginx.org/en/docs/http/ngx_http_gzip_module.html
+	//
+	// +optional
+	Gzip *GzipCompression `json:"gzip,omitempty"`
+
+	// Brotli configures the Brotli compression of the responses. Brotli compression requires the
+	// ngx_brotli module (https://github.com/google/ngx_brotli) to be installed in the NGINX image. If the module
+	// is not installed, the policy is invalid. If a client supports both gzip and Brotli, Brotli is preferred.
+	//
+	// +optional
+	Brotli *BrotliCompression `json:"brotli,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries
+	// in the ResponseCompressionPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// GzipCompression defines the settings of the gzip compression.
+type GzipCompression struct {
+	// Enable enables or disables the gzip compression. If not specified, the compression is enabled.
+	// Disabling the compression in a policy attached to a Route overrides the policy attached to the Gateway.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Level is the compression level, from 1 (fastest) to 9 (smallest).
+	// Directive: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_comp_level
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *int32 `json:"level,omitempty"`
+
+	// MinLength is the minimum length in bytes of the responses that are compressed,
+	// determined from the Content-Length response header.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_min_length
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Vary enables the Vary: Accept-Encoding response header.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_vary
+	//
+	// +optional
+	Vary *bool `json:"vary,omitempty"`
+
+	// Types are the MIME types of the responses that are compressed, in addition to "text/html",
+	// which is always compressed. The special value "*" matches any MIME type.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_types
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Types []MIMEType `json:"types,omitempty"`
+}
+
+// BrotliCompression defines the settings of the Brotli compression.
+type BrotliCompression struct {
+	// Enable enables or disables the Brotli compression. If not specified, the compression is enabled.
+	// Disabling the compression in a policy attached to a Route overrides the policy attached to the Gateway.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Level is the compression level, from 0 (fastest) to 11 (smallest).
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=11
+	Level *int32 `json:"level,omitempty"`
+
+	// MinLength is the minimum length in bytes of the responses that are compressed,
+	// determined from the Content-Length response header.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Types are the MIME types of the responses that are compressed, in addition to "text/html",
+	// which is always compressed. The special value "*" matches any MIME type.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Types []MIMEType `json:"types,omitempty"`
+}
+
+// MIMEType is a MIME type in the format 'type/subtype', for example, "application/json", or "*".
+//
+// +kubebuilder:validation:MaxLength=255
+// +kubebuilder:validation:Pattern=`^(\*|[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*)$`
+type MIMEType string
@@ -0,0 +1,262 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=routetest
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RouteTest declares requests and the routing outcomes that are expected for them. NGINX Gateway Fabric
+// evaluates the tests against the HTTPRoutes and GRPCRoutes attached to its Gateway every time the routing
+// configuration changes and reports the results in the status, so that the routing configuration can be
+// continuously validated.
+type RouteTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RouteTest.
+	Spec RouteTestSpec `json:"spec"`
+
+	// Status defines the state of the RouteTest.
+	Status RouteTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouteTestList contains a list of RouteTests.
+type RouteTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RouteTest `json:"items"`
+}
+
+// RouteTestSpec defines the desired state of the RouteTest.
+type RouteTestSpec struct {
+	// Tests is a list of the tests.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Tests []RouteTestCase `json:"tests"`
+}
+
+// RouteTestCase is a request and the routing outcome that is expected for it.
+type RouteTestCase struct {
+	// Name is the name of the test. It must be unique within the RouteTest.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Request is the request of the test.
+	Request RouteTestRequest `json:"request"`
+
+	// Expect is the expected routing outcome of the request.
+	Expect RouteTestExpectation `json:"expect"`
+}
+
+// RouteTestRequest is a request of a test.
+type RouteTestRequest struct {
+	// Method is the method of the request. Defaults to GET.
+	//
+	// +optional
+	// +kubebuilder:default=GET
+	Method *v1.HTTPMethod `json:"method,omitempty"`
+
+	// Port is the port of the Listeners that receive the request. Defaults to 80.
+	//
+	// +optional
+	// +kubebuilder:default=80
+	Port *v1.PortNumber `json:"port,omitempty"`
+
+	// Hostname is the hostname of the request, which is sent in the Host header.
+	Hostname v1.PreciseHostname `json:"hostname"`
+
+	// Path is the path of the request. It can include a query string, for example, /search?q=nginx.
+	// Defaults to /.
+	//
+	// +optional
+	// +kubebuilder:default=/
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Headers are the headers of the request.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []v1.HTTPHeader `json:"headers,omitempty"`
+}
+
+// RouteTestExpectation is the expected routing outcome of a request.
+//
+// +kubebuilder:validation:XValidation:message="at least one expectation must be set",rule="(has(self.unmatched) && self.unmatched) || has(self.route) || has(self.backend) || has(self.filters)"
+// +kubebuilder:validation:XValidation:message="unmatched cannot be combined with route, backend or filters",rule="!(has(self.unmatched) && self.unmatched) || (!has(self.route) && !has(self.backend) && !has(self.filters))"
+//
+//nolint:lll
+type RouteTestExpectation struct {
+	// Route is the Route that is expected to match the request.
+	//
+	// +optional
+	Route *RouteTestRouteReference `json:"route,omitempty"`
+
+	// Backend is a backend that the matched rule is expected to route the request to.
+	//
+	// +optional
+	Backend *RouteTestBackendReference `json:"backend,omitempty"`
+
+	// Filters are the types of the filters that the matched rule is expected to apply to the request.
+	// The test passes if the matched rule has the filters of exactly these types, in any order.
+	// The SnippetsFilters are of the ExtensionRef type.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Enum=RequestHeaderModifier;ResponseHeaderModifier;RequestMirror;RequestRedirect;URLRewrite;ExtensionRef
+	//nolint:lll
+	Filters []v1.HTTPRouteFilterType `json:"filters,omitempty"`
+
+	// Unmatched indicates that no Route is expected to match the request.
+	//
+	// +optional
+	Unmatched bool `json:"unmatched,omitempty"`
+}
+
+// RouteTestRouteReference references a Route.
+type RouteTestRouteReference struct {
+	// Kind is the kind of the Route. Defaults to HTTPRoute.
+	//
+	// +optional
+	// +kubebuilder:default=HTTPRoute
+	// +kubebuilder:validation:Enum=HTTPRoute;GRPCRoute
+	Kind *v1.Kind `json:"kind,omitempty"`
+
+	// Namespace is the namespace of the Route. Defaults to the namespace of the RouteTest.
+	//
+	// +optional
+	Namespace *v1.Namespace `json:"namespace,omitempty"`
+
+	// Name is the name of the Route.
+	Name v1.ObjectName `json:"name"`
+}
+
+// RouteTestBackendReference references a Service backend.
+type RouteTestBackendReference struct {
+	// Namespace is the namespace of the Service. Defaults to the namespace of the RouteTest.
+	//
+	// +optional
+	Namespace *v1.Namespace `json:"namespace,omitempty"`
+
+	// Port is the port of the Service. If not set, any port of the Service matches.
+	//
+	// +optional
+	Port *v1.PortNumber `json:"port,omitempty"`
+
+	// Name is the name of the Service.
+	Name v1.ObjectName `json:"name"`
+}
+
+// RouteTestStatus defines the state of the RouteTest.
+type RouteTestStatus struct {
+	// Controllers is a list of Gateway API controllers that evaluated the RouteTest
+	// and the results of the tests with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []RouteTestControllerStatus `json:"controllers,omitempty"`
+}
+
+// RouteTestControllerStatus is the status of the RouteTest with respect to a controller.
+type RouteTestControllerStatus struct {
+	// ControllerName is a domain/path string that indicates the name of the
+	// controller that wrote this status. This corresponds with the
+	// controllerName field on GatewayClass.
+	//
+	// Example: "example.net/gateway-controller".
+	//
+	// The format of this field is DOMAIN "/" PATH, where DOMAIN and PATH are
+	// valid Kubernetes names
+	// (https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names).
+	//
+	// Controllers MUST populate this field when writing status. Controllers should ensure that
+	// entries to status populated with their ControllerName are cleaned up when they are no
+	// longer necessary.
+	ControllerName v1.GatewayController `json:"controllerName"`
+
+	// Conditions describe the status of the RouteTest.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Results are the results of the tests, in the order of the tests.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Results []RouteTestResult `json:"results,omitempty"`
+}
+
+// RouteTestResult is the result of a test.
+type RouteTestResult struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+
+	// Outcome is the outcome of the test.
+	Outcome RouteTestOutcome `json:"outcome"`
+
+	// Message describes the routing of the request. For a failed test, it also describes
+	// the expectations that are not met.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RouteTestOutcome is the outcome of a test.
+//
+// +kubebuilder:validation:Enum=Passed;Failed
+type RouteTestOutcome string
+
+const (
+	// RouteTestOutcomePassed indicates that the routing outcome of the request meets the expectations.
+	RouteTestOutcomePassed RouteTestOutcome = "Passed"
+
+	// RouteTestOutcomeFailed indicates that the routing outcome of the request doesn't meet the expectations.
+	RouteTestOutcomeFailed RouteTestOutcome = "Failed"
+)
+
+// RouteTestConditionType is a type of condition associated with RouteTest.
+type RouteTestConditionType string
+
+// RouteTestConditionReason is a reason for a RouteTest condition type.
+type RouteTestConditionReason string
+
+const (
+	// RouteTestConditionTypePassed indicates that all tests of the RouteTest passed.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Passed
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Failed.
+	RouteTestConditionTypePassed RouteTestConditionType = "Passed"
+
+	// RouteTestConditionReasonPassed is used with the Passed condition type when
+	// all tests passed.
+	RouteTestConditionReasonPassed RouteTestConditionReason = "Passed"
+
+	// RouteTestConditionReasonFailed is used with the Passed condition type when
+	// at least one test failed.
+	RouteTestConditionReasonFailed RouteTestConditionReason = "Failed"
+)
@@ -26,3 +26,105 @@ type SpanAttribute struct {
 	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
 	Value string `json:"value"`
 }
+
+// OCIArtifact references an artifact in an OCI registry by the digest of its manifest.
+// The content of the artifact is verified against the digest, so it can't change once it's fetched.
+type OCIArtifact struct {
+	// PullSecret is the name of the Secret of type kubernetes.io/dockerconfigjson with the credentials
+	// for the registry. The Secret must be in the same namespace as the resource that references the artifact.
+	// If not specified, the artifact is fetched anonymously.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	PullSecret *string `json:"pullSecret,omitempty"`
+
+	// Verification configures the verification of the cosign signatures of the artifact.
+	// If specified, the artifact is used only if one of its signatures is verified.
+	//
+	// +optional
+	Verification *ArtifactVerification `json:"verification,omitempty"`
+
+	// Repository is the repository of the artifact, including the registry host.
+	// For example, "registry.example.com/team/snippets".
+	//
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)+$`
+	Repository string `json:"repository"`
+
+	// Digest is the digest of the manifest of the artifact.
+	// For example, "sha256:4f3f8a39c5f0c2d7e0b1b8e0f5c9a6d2e1b7c3a4f5e6d7c8b9a0f1e2d3c4b5a6".
+	//
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	Digest string `json:"digest"`
+}
+
+// ArtifactVerification configures the verification of the cosign signatures of an OCI artifact.
+// The signatures are fetched from the sha256-<digest>.sig tag of the repository of the artifact.
+// Exactly one of Key or Keyless must be specified.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of key or keyless must be specified",rule="has(self.key) != has(self.keyless)"
+//
+//nolint:lll
+type ArtifactVerification struct {
+	// Key verifies the signatures made with a key pair.
+	//
+	// +optional
+	Key *CosignKey `json:"key,omitempty"`
+
+	// Keyless verifies the keyless signatures made with a certificate issued by Fulcio
+	// and recorded in the Rekor transparency log.
+	//
+	// +optional
+	Keyless *CosignKeyless `json:"keyless,omitempty"`
+}
+
+// CosignKey configures the verification of the signatures made with a key pair.
+type CosignKey struct {
+	// SecretName is the name of the Secret with the PEM-encoded public key in the cosign.pub key.
+	// The Secret must be in the same namespace as the resource that references the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName"`
+}
+
+// CosignKeyless configures the verification of the keyless signatures made with a certificate issued by Fulcio.
+// Exactly one of Subject or SubjectRegex must be specified.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of subject or subjectRegex must be specified",rule="has(self.subject) != has(self.subjectRegex)"
+//
+//nolint:lll
+type CosignKeyless struct {
+	// Subject is the subject of the identity that signed the artifact, which is the email or the URI
+	// in the signing certificate.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Subject *string `json:"subject,omitempty"`
+
+	// SubjectRegex is a regular expression in the RE2 syntax that matches the subject of the identity
+	// that signed the artifact.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	SubjectRegex *string `json:"subjectRegex,omitempty"`
+
+	// TrustRootSecretName is the name of the Secret with the trust root of the verification:
+	// the PEM-encoded root and intermediate certificates of Fulcio in the ca.crt key,
+	// and the PEM-encoded public key of the Rekor transparency log in the rekor.pub key.
+	// The Secret must be in the same namespace as the resource that references the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	TrustRootSecretName string `json:"trustRootSecretName"`
+
+	// Issuer is the OIDC issuer of the identity that signed the artifact.
+	// For example, "https://token.actions.githubusercontent.com".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Issuer string `json:"issuer"`
+}
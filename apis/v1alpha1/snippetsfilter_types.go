@@ -38,27 +38,40 @@ type SnippetsFilterList struct {
 type SnippetsFilterSpec struct {
 	// Snippets is a list of NGINX configuration snippets.
 	// There can only be one snippet per context.
-	// Allowed contexts: main, http, http.server, http.server.location.
+	// Allowed contexts: main, http, http.server, http.server.location, http.upstream, http.map.
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=4
+	// +kubebuilder:validation:MaxItems=6
 	// +kubebuilder:validation:XValidation:message="Only one snippet allowed per context",rule="self.all(s1, self.exists_one(s2, s1.context == s2.context))"
 	//nolint:lll
 	Snippets []Snippet `json:"snippets"`
 }
 
 // Snippet represents an NGINX configuration snippet.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of value or artifact must be set",rule="has(self.value) != has(self.artifact)"
+//
+//nolint:lll
 type Snippet struct {
+	// Artifact is the OCI artifact that holds the NGINX configuration snippet, for snippets
+	// that are too large to be inlined in the SnippetsFilter. The artifact must have a single layer
+	// with the snippet. Until the artifact is fetched from the registry, the SnippetsFilter is not accepted.
+	//
+	// +optional
+	Artifact *OCIArtifact `json:"artifact,omitempty"`
+
 	// Context is the NGINX context to insert the snippet into.
 	Context NginxContext `json:"context"`
 
 	// Value is the NGINX configuration snippet.
+	//
+	// +optional
 	// +kubebuilder:validation:MinLength=1
-	Value string `json:"value"`
+	Value string `json:"value,omitempty"`
 }
 
 // NginxContext represents the NGINX configuration context.
 //
-// +kubebuilder:validation:Enum=main;http;http.server;http.server.location
+// +kubebuilder:validation:Enum=main;http;http.server;http.server.location;http.upstream;http.map
 type NginxContext string
 
 const (
@@ -76,6 +89,19 @@ const (
 	// NginxContextHTTPServerLocation is the location context of the NGINX configuration.
 	// https://nginx.org/en/docs/http/ngx_http_core_module.html#location
 	NginxContextHTTPServerLocation NginxContext = "http.server.location"
+
+	// NginxContextHTTPUpstream is the upstream context of the NGINX configuration.
+	// The snippet is inserted into the upstreams of the backends of the routing rules that reference the
+	// SnippetsFilter, in place of the default load balancing method, so it can set the load balancing method.
+	// The routing rules with different upstream snippets use separate upstreams, even for the same backend.
+	// https://nginx.org/en/docs/http/ngx_http_upstream_module.html#upstream
+	NginxContextHTTPUpstream NginxContext = "http.upstream"
+
+	// NginxContextHTTPMap is the http context of the NGINX configuration, for map blocks.
+	// The snippet must contain one or more complete map blocks. The variables defined by the map blocks
+	// must be unique across all SnippetsFilters.
+	// https://nginx.org/en/docs/http/ngx_http_map_module.html#map
+	NginxContextHTTPMap NginxContext = "http.map"
 )
 
 // SnippetsFilterStatus defines the state of SnippetsFilter.
@@ -128,7 +154,9 @@ const (
 	//
 	// Possible reasons for this condition to be False:
 	//
-	// * Invalid.
+	// * Invalid
+	// * ArtifactVerificationFailed
+	// * ArtifactPending.
 	SnippetsFilterConditionTypeAccepted SnippetsFilterConditionType = "Accepted"
 
 	// SnippetsFilterConditionReasonAccepted is used with the Accepted condition type when
@@ -138,4 +166,12 @@ const (
 	// SnippetsFilterConditionReasonInvalid is used with the Accepted condition type when
 	// SnippetsFilter is invalid.
 	SnippetsFilterConditionReasonInvalid SnippetsFilterConditionReason = "Invalid"
+
+	// SnippetsFilterConditionReasonArtifactPending is used with the Accepted condition type when
+	// the OCI artifact of a snippet of the SnippetsFilter is not fetched yet.
+	SnippetsFilterConditionReasonArtifactPending SnippetsFilterConditionReason = "ArtifactPending"
+
+	// SnippetsFilterConditionReasonArtifactVerificationFailed is used with the Accepted condition type when
+	// the signatures of the OCI artifact of a snippet of the SnippetsFilter are not verified.
+	SnippetsFilterConditionReasonArtifactVerificationFailed SnippetsFilterConditionReason = "ArtifactVerificationFailed"
 )
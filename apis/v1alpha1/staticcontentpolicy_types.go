@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=scpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// StaticContentPolicy is a Direct Attached Policy. It provides a way to serve static content, like maintenance
+// pages, .well-known files, or small single-page applications, directly from NGINX for the requests that match
+// a Route, instead of proxying the requests to the backends of the Route.
+type StaticContentPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the StaticContentPolicy.
+	Spec StaticContentPolicySpec `json:"spec"`
+
+	// Status defines the state of the StaticContentPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticContentPolicyList contains a list of StaticContentPolicies.
+type StaticContentPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticContentPolicy `json:"items"`
+}
+
+// StaticContentPolicySpec defines the desired state of the StaticContentPolicy.
+type StaticContentPolicySpec struct {
+	// Source is the source of the static content.
+	// NGINX serves the file at the path of the request relative to the root of the content. For example, for the
+	// request "/index.html", NGINX serves the file "index.html" of the content. The URLRewrite filter of the Route
+	// rule can be used to rewrite the path of the request before the file is looked up.
+	Source StaticContentSource `json:"source"`
+
+	// Index is the name of the file that NGINX serves for the requests for a directory of the content, like "/".
+	// Default is "index.html".
+	// Directive: https://nginx.org/en/docs/http/ngx_http_index_module.html#index
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-][A-Za-z0-9._-]*$`
+	Index *string `json:"index,omitempty"`
+
+	// Fallback is the path of the file relative to the root of the content that NGINX serves when the requested file
+	// doesn't exist. For example, "index.html" for a single-page application, or "maintenance.html" for a
+	// maintenance page. If not specified, NGINX responds with the 404 status code when the requested file
+	// doesn't exist.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#try_files
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-][A-Za-z0-9._-]*(/[A-Za-z0-9_-][A-Za-z0-9._-]*)*$`
+	Fallback *string `json:"fallback,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute
+	//
+	// TargetRefs must be _distinct_. The `name` field must be unique for all targetRef entries in the StaticContentPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Name must be unique",rule="self.all(p1, self.exists_one(p2, p1.name == p2.name))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// StaticContentSource defines the source of the static content.
+//
+// +kubebuilder:validation:XValidation:message="configMap is required when type is ConfigMap",rule="self.type != 'ConfigMap' || has(self.configMap)"
+// +kubebuilder:validation:XValidation:message="configMap can only be set when type is ConfigMap",rule="self.type == 'ConfigMap' || !has(self.configMap)"
+// +kubebuilder:validation:XValidation:message="volume is required when type is Volume",rule="self.type != 'Volume' || has(self.volume)"
+// +kubebuilder:validation:XValidation:message="volume can only be set when type is Volume",rule="self.type == 'Volume' || !has(self.volume)"
+//
+//nolint:lll
+type StaticContentSource struct {
+	// ConfigMap is the ConfigMap with the static content.
+	// Every key of the data and binaryData fields of the ConfigMap is the name of a file of the content.
+	//
+	// +optional
+	ConfigMap *StaticContentConfigMap `json:"configMap,omitempty"`
+
+	// Volume is the directory with the static content in a volume mounted to the NGINX container.
+	//
+	// +optional
+	Volume *StaticContentVolume `json:"volume,omitempty"`
+
+	// Type is the type of the source.
+	Type StaticContentSourceType `json:"type"`
+}
+
+// StaticContentSourceType is the type of the source of the static content.
+//
+// +kubebuilder:validation:Enum=ConfigMap;Volume
+type StaticContentSourceType string
+
+const (
+	// StaticContentSourceConfigMap sources the static content from a ConfigMap.
+	StaticContentSourceConfigMap StaticContentSourceType = "ConfigMap"
+
+	// StaticContentSourceVolume sources the static content from a volume mounted to the NGINX container.
+	StaticContentSourceVolume StaticContentSourceType = "Volume"
+)
+
+// StaticContentConfigMap references a ConfigMap with static content.
+type StaticContentConfigMap struct {
+	// Name is the name of the ConfigMap. The ConfigMap must be in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// StaticContentVolumePathPrefix is the directory of the NGINX container that the volumes with static content
+// must be mounted under.
+const StaticContentVolumePathPrefix = "/usr/share/nginx/static/"
+
+// StaticContentVolume references a directory with static content in a volume mounted to the NGINX container.
+type StaticContentVolume struct {
+	// Path is the absolute path to the directory with the static content in the NGINX container.
+	// The directory must be under /usr/share/nginx/static/. For example, "/usr/share/nginx/static/maintenance".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/usr/share/nginx/static(/[A-Za-z0-9_-][A-Za-z0-9._-]*)+$`
+	Path string `json:"path"`
+}
@@ -51,6 +51,12 @@ type UpstreamSettingsPolicySpec struct {
 	// +optional
 	KeepAlive *UpstreamKeepAlive `json:"keepAlive,omitempty"`
 
+	// GRPCHealthCheck defines the health check of the gRPC backends with the gRPC Health Checking Protocol
+	// (grpc.health.v1). It only applies to the backends of GRPCRoutes.
+	//
+	// +optional
+	GRPCHealthCheck *GRPCHealthCheck `json:"grpcHealthCheck,omitempty"`
+
 	// TargetRefs identifies API object(s) to apply the policy to.
 	// Objects must be in the same namespace as the policy.
 	// Support: Service
@@ -71,6 +77,7 @@ type UpstreamKeepAlive struct {
 	// Connections sets the maximum number of idle keep-alive connections to upstream servers that are preserved
 	// in the cache of each nginx worker process. When this number is exceeded, the least recently used
 	// connections are closed.
+	// Default: 16.
 	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive
 	//
 	// +optional
@@ -98,3 +105,35 @@ type UpstreamKeepAlive struct {
 	// +optional
 	Timeout *Duration `json:"timeout,omitempty"`
 }
+
+// GRPCHealthCheck defines the health check of gRPC backends with the gRPC Health Checking Protocol.
+// See https://github.com/grpc/grpc/blob/master/doc/health-checking.md.
+type GRPCHealthCheck struct {
+	// Service is the name of the gRPC service whose health is checked, for example, "helloworld.Greeter".
+	// If not specified, the overall health of the backend is checked.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`
+	Service *string `json:"service,omitempty"`
+
+	// Path is the path of a plain HTTP endpoint that NGINX exposes on the servers of the GRPCRoutes that use
+	// the backend. For every request to the endpoint, NGINX checks the health of the backend and responds with
+	// the 200 status code if the backend is serving, or with the 503 status code otherwise. This allows
+	// external HTTP health checks, like the health checks of load balancers, to verify gRPC services.
+	// If not specified, the endpoint is not exposed.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._~/-]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Interval is the interval between the active health checks that NGINX Plus performs.
+	// NGINX Plus marks the backend endpoints that fail the health check as unhealthy and stops sending requests
+	// to them. Active health checks are only supported by NGINX Plus, so this field is ignored for NGINX.
+	// Default: 5s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+}
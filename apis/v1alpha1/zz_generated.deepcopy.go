@@ -7,91 +7,127 @@ package v1alpha1
 import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientBody) DeepCopyInto(out *ClientBody) {
+func (in *AccessLogDestination) DeepCopyInto(out *AccessLogDestination) {
 	*out = *in
-	if in.MaxSize != nil {
-		in, out := &in.MaxSize, &out.MaxSize
-		*out = new(Size)
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(string)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(Duration)
-		**out = **in
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(SyslogDestination)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientBody.
-func (in *ClientBody) DeepCopy() *ClientBody {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogDestination.
+func (in *AccessLogDestination) DeepCopy() *AccessLogDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientBody)
+	out := new(AccessLogDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientKeepAlive) DeepCopyInto(out *ClientKeepAlive) {
+func (in *AccessLogSettings) DeepCopyInto(out *AccessLogSettings) {
 	*out = *in
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = new(int32)
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
 		**out = **in
 	}
-	if in.Time != nil {
-		in, out := &in.Time, &out.Time
-		*out = new(Duration)
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AccessLogDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(ClientKeepAliveTimeout)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogSettings.
+func (in *AccessLogSettings) DeepCopy() *AccessLogSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactVerification) DeepCopyInto(out *ArtifactVerification) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(CosignKey)
+		**out = **in
+	}
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(CosignKeyless)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAlive.
-func (in *ClientKeepAlive) DeepCopy() *ClientKeepAlive {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactVerification.
+func (in *ArtifactVerification) DeepCopy() *ArtifactVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientKeepAlive)
+	out := new(ArtifactVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientKeepAliveTimeout) DeepCopyInto(out *ClientKeepAliveTimeout) {
+func (in *BrotliCompression) DeepCopyInto(out *BrotliCompression) {
 	*out = *in
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
-		*out = new(Duration)
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Header != nil {
-		in, out := &in.Header, &out.Header
-		*out = new(Duration)
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
 		**out = **in
 	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]MIMEType, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAliveTimeout.
-func (in *ClientKeepAliveTimeout) DeepCopy() *ClientKeepAliveTimeout {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrotliCompression.
+func (in *BrotliCompression) DeepCopy() *BrotliCompression {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientKeepAliveTimeout)
+	out := new(BrotliCompression)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
+func (in *CacheControlPolicy) DeepCopyInto(out *CacheControlPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -99,18 +135,18 @@ func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicy.
-func (in *ClientSettingsPolicy) DeepCopy() *ClientSettingsPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheControlPolicy.
+func (in *CacheControlPolicy) DeepCopy() *CacheControlPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientSettingsPolicy)
+	out := new(CacheControlPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
+func (in *CacheControlPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -118,31 +154,31 @@ func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicyList) DeepCopyInto(out *ClientSettingsPolicyList) {
+func (in *CacheControlPolicyList) DeepCopyInto(out *CacheControlPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClientSettingsPolicy, len(*in))
+		*out = make([]CacheControlPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicyList.
-func (in *ClientSettingsPolicyList) DeepCopy() *ClientSettingsPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheControlPolicyList.
+func (in *CacheControlPolicyList) DeepCopy() *CacheControlPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientSettingsPolicyList)
+	out := new(CacheControlPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
+func (in *CacheControlPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -150,75 +186,64 @@ func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSettingsPolicySpec) DeepCopyInto(out *ClientSettingsPolicySpec) {
+func (in *CacheControlPolicySpec) DeepCopyInto(out *CacheControlPolicySpec) {
 	*out = *in
-	if in.Body != nil {
-		in, out := &in.Body, &out.Body
-		*out = new(ClientBody)
+	if in.Expires != nil {
+		in, out := &in.Expires, &out.Expires
+		*out = new(CacheExpires)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KeepAlive != nil {
-		in, out := &in.KeepAlive, &out.KeepAlive
-		*out = new(ClientKeepAlive)
-		(*in).DeepCopyInto(*out)
+	if in.CacheControl != nil {
+		in, out := &in.CacheControl, &out.CacheControl
+		*out = make([]CacheControlDirective, len(*in))
+		copy(*out, *in)
 	}
-	in.TargetRef.DeepCopyInto(&out.TargetRef)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicySpec.
-func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
-	if in == nil {
-		return nil
+	if in.Always != nil {
+		in, out := &in.Always, &out.Always
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(ClientSettingsPolicySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
-func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheControlPolicySpec.
+func (in *CacheControlPolicySpec) DeepCopy() *CacheControlPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerStatus)
+	out := new(CacheControlPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Logging) DeepCopyInto(out *Logging) {
+func (in *CacheExpires) DeepCopyInto(out *CacheExpires) {
 	*out = *in
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = new(ControllerLogLevel)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheExpires.
+func (in *CacheExpires) DeepCopy() *CacheExpires {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(CacheExpires)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -226,18 +251,18 @@ func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
-func (in *NginxGateway) DeepCopy() *NginxGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicy.
+func (in *CachePolicy) DeepCopy() *CachePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGateway)
+	out := new(CachePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGateway) DeepCopyObject() runtime.Object {
+func (in *CachePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -245,31 +270,31 @@ func (in *NginxGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+func (in *CachePolicyList) DeepCopyInto(out *CachePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxGateway, len(*in))
+		*out = make([]CachePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
-func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyList.
+func (in *CachePolicyList) DeepCopy() *CachePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayList)
+	out := new(CachePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+func (in *CachePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -277,154 +302,2377 @@ func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
 	*out = *in
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(Logging)
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(CacheZone)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
-func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
-	if in == nil {
-		return nil
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
 	}
-	out := new(NginxGatewaySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.StatusHeader != nil {
+		in, out := &in.StatusHeader, &out.StatusHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = make([]CacheValidity, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = make([]NginxVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
-func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicySpec.
+func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayStatus)
+	out := new(CachePolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxLogging) DeepCopyInto(out *NginxLogging) {
+func (in *CacheValidity) DeepCopyInto(out *CacheValidity) {
 	*out = *in
-	if in.ErrorLevel != nil {
-		in, out := &in.ErrorLevel, &out.ErrorLevel
-		*out = new(NginxErrorLogLevel)
-		**out = **in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]CacheStatusCode, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxLogging.
-func (in *NginxLogging) DeepCopy() *NginxLogging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheValidity.
+func (in *CacheValidity) DeepCopy() *CacheValidity {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxLogging)
+	out := new(CacheValidity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxPlus) DeepCopyInto(out *NginxPlus) {
+func (in *CacheZone) DeepCopyInto(out *CacheZone) {
 	*out = *in
-	if in.AllowedAddresses != nil {
-		in, out := &in.AllowedAddresses, &out.AllowedAddresses
-		*out = make([]NginxPlusAllowAddress, len(*in))
-		copy(*out, *in)
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.KeysZoneSize != nil {
+		in, out := &in.KeysZoneSize, &out.KeysZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Inactive != nil {
+		in, out := &in.Inactive, &out.Inactive
+		*out = new(Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxPlus.
-func (in *NginxPlus) DeepCopy() *NginxPlus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheZone.
+func (in *CacheZone) DeepCopy() *CacheZone {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxPlus)
+	out := new(CacheZone)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxPlusAllowAddress) DeepCopyInto(out *NginxPlusAllowAddress) {
+func (in *ClientBody) DeepCopyInto(out *ClientBody) {
 	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxPlusAllowAddress.
-func (in *NginxPlusAllowAddress) DeepCopy() *NginxPlusAllowAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientBody.
+func (in *ClientBody) DeepCopy() *ClientBody {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxPlusAllowAddress)
+	out := new(ClientBody)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+func (in *ClientDisconnect) DeepCopyInto(out *ClientDisconnect) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.PropagateAbort != nil {
+		in, out := &in.PropagateAbort, &out.PropagateAbort
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
-func (in *NginxProxy) DeepCopy() *NginxProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientDisconnect.
+func (in *ClientDisconnect) DeepCopy() *ClientDisconnect {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxy)
+	out := new(ClientDisconnect)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+func (in *ClientKeepAlive) DeepCopyInto(out *ClientKeepAlive) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NginxProxy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(ClientKeepAliveTimeout)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
-func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAlive.
+func (in *ClientKeepAlive) DeepCopy() *ClientKeepAlive {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxyList)
+	out := new(ClientKeepAlive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientKeepAliveTimeout) DeepCopyInto(out *ClientKeepAliveTimeout) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientKeepAliveTimeout.
+func (in *ClientKeepAliveTimeout) DeepCopy() *ClientKeepAliveTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientKeepAliveTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicy.
+func (in *ClientSettingsPolicy) DeepCopy() *ClientSettingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientSettingsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicyList) DeepCopyInto(out *ClientSettingsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientSettingsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicyList.
+func (in *ClientSettingsPolicyList) DeepCopy() *ClientSettingsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSettingsPolicySpec) DeepCopyInto(out *ClientSettingsPolicySpec) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(ClientBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(ClientKeepAlive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disconnect != nil {
+		in, out := &in.Disconnect, &out.Disconnect
+		*out = new(ClientDisconnect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(ProxyBuffering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(ClientStream)
+		(*in).DeepCopyInto(*out)
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicySpec.
+func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSettingsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientStream) DeepCopyInto(out *ClientStream) {
+	*out = *in
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.PrereadTimeout != nil {
+		in, out := &in.PrereadTimeout, &out.PrereadTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientStream.
+func (in *ClientStream) DeepCopy() *ClientStream {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicy) DeepCopyInto(out *ConnectionLimitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicy.
+func (in *ConnectionLimitPolicy) DeepCopy() *ConnectionLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionLimitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicyList) DeepCopyInto(out *ConnectionLimitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectionLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicyList.
+func (in *ConnectionLimitPolicyList) DeepCopy() *ConnectionLimitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionLimitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLimitPolicySpec) DeepCopyInto(out *ConnectionLimitPolicySpec) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(ConnectionLimitKey)
+		**out = **in
+	}
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimitPolicySpec.
+func (in *ConnectionLimitPolicySpec) DeepCopy() *ConnectionLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
+func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignKey) DeepCopyInto(out *CosignKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignKey.
+func (in *CosignKey) DeepCopy() *CosignKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignKeyless) DeepCopyInto(out *CosignKeyless) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubjectRegex != nil {
+		in, out := &in.SubjectRegex, &out.SubjectRegex
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignKeyless.
+func (in *CosignKeyless) DeepCopy() *CosignKeyless {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignKeyless)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponse) DeepCopyInto(out *DirectResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponse.
+func (in *DirectResponse) DeepCopy() *DirectResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseList) DeepCopyInto(out *DirectResponseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectResponse, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseList.
+func (in *DirectResponseList) DeepCopy() *DirectResponseList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectResponseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseSpec) DeepCopyInto(out *DirectResponseSpec) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseSpec.
+func (in *DirectResponseSpec) DeepCopy() *DirectResponseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseStatus) DeepCopyInto(out *DirectResponseStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseStatus.
+func (in *DirectResponseStatus) DeepCopy() *DirectResponseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
+	*out = *in
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(ErrorPageResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(ErrorPageRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorPageStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPage.
+func (in *ErrorPage) DeepCopy() *ErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicy) DeepCopyInto(out *ErrorPagePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicy.
+func (in *ErrorPagePolicy) DeepCopy() *ErrorPagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicyList) DeepCopyInto(out *ErrorPagePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ErrorPagePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicyList.
+func (in *ErrorPagePolicyList) DeepCopy() *ErrorPagePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicySpec) DeepCopyInto(out *ErrorPagePolicySpec) {
+	*out = *in
+	if in.ErrorPages != nil {
+		in, out := &in.ErrorPages, &out.ErrorPages
+		*out = make([]ErrorPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicySpec.
+func (in *ErrorPagePolicySpec) DeepCopy() *ErrorPagePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageRedirect) DeepCopyInto(out *ErrorPageRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageRedirect.
+func (in *ErrorPageRedirect) DeepCopy() *ErrorPageRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageResponse) DeepCopyInto(out *ErrorPageResponse) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageResponse.
+func (in *ErrorPageResponse) DeepCopy() *ErrorPageResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCHealthCheck) DeepCopyInto(out *GRPCHealthCheck) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCHealthCheck.
+func (in *GRPCHealthCheck) DeepCopy() *GRPCHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GzipCompression) DeepCopyInto(out *GzipCompression) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Vary != nil {
+		in, out := &in.Vary, &out.Vary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]MIMEType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GzipCompression.
+func (in *GzipCompression) DeepCopy() *GzipCompression {
+	if in == nil {
+		return nil
+	}
+	out := new(GzipCompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP2Settings) DeepCopyInto(out *HTTP2Settings) {
+	*out = *in
+	if in.MaxConcurrentStreams != nil {
+		in, out := &in.MaxConcurrentStreams, &out.MaxConcurrentStreams
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RecvBufferSize != nil {
+		in, out := &in.RecvBufferSize, &out.RecvBufferSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.ChunkSize != nil {
+		in, out := &in.ChunkSize, &out.ChunkSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.BodyPrereadSize != nil {
+		in, out := &in.BodyPrereadSize, &out.BodyPrereadSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP2Settings.
+func (in *HTTP2Settings) DeepCopy() *HTTP2Settings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP2Settings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBodyTransform) DeepCopyInto(out *HTTPBodyTransform) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBodyTransform.
+func (in *HTTPBodyTransform) DeepCopy() *HTTPBodyTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBodyTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPBodyTransform) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBodyTransformList) DeepCopyInto(out *HTTPBodyTransformList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPBodyTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBodyTransformList.
+func (in *HTTPBodyTransformList) DeepCopy() *HTTPBodyTransformList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBodyTransformList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPBodyTransformList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBodyTransformSpec) DeepCopyInto(out *HTTPBodyTransformSpec) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(RequestBodyTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(ResponseBodyTransform)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBodyTransformSpec.
+func (in *HTTPBodyTransformSpec) DeepCopy() *HTTPBodyTransformSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBodyTransformSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBodyTransformStatus) DeepCopyInto(out *HTTPBodyTransformStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBodyTransformStatus.
+func (in *HTTPBodyTransformStatus) DeepCopy() *HTTPBodyTransformStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBodyTransformStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hardening) DeepCopyInto(out *Hardening) {
+	*out = *in
+	if in.ClientHeaderTimeout != nil {
+		in, out := &in.ClientHeaderTimeout, &out.ClientHeaderTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ClientBodyTimeout != nil {
+		in, out := &in.ClientBodyTimeout, &out.ClientBodyTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.SendTimeout != nil {
+		in, out := &in.SendTimeout, &out.SendTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ResetTimedOutConnection != nil {
+		in, out := &in.ResetTimedOutConnection, &out.ResetTimedOutConnection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequestSmugglingProtection != nil {
+		in, out := &in.RequestSmugglingProtection, &out.RequestSmugglingProtection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hardening.
+func (in *Hardening) DeepCopy() *Hardening {
+	if in == nil {
+		return nil
+	}
+	out := new(Hardening)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashTables) DeepCopyInto(out *HashTables) {
+	*out = *in
+	if in.ServerNamesMaxSize != nil {
+		in, out := &in.ServerNamesMaxSize, &out.ServerNamesMaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServerNamesBucketSize != nil {
+		in, out := &in.ServerNamesBucketSize, &out.ServerNamesBucketSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MapMaxSize != nil {
+		in, out := &in.MapMaxSize, &out.MapMaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MapBucketSize != nil {
+		in, out := &in.MapBucketSize, &out.MapBucketSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashTables.
+func (in *HashTables) DeepCopy() *HashTables {
+	if in == nil {
+		return nil
+	}
+	out := new(HashTables)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerAccessLog) DeepCopyInto(out *ListenerAccessLog) {
+	*out = *in
+	in.Settings.DeepCopyInto(&out.Settings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerAccessLog.
+func (in *ListenerAccessLog) DeepCopy() *ListenerAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerSocketSettings) DeepCopyInto(out *ListenerSocketSettings) {
+	*out = *in
+	in.Settings.DeepCopyInto(&out.Settings)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerSocketSettings.
+func (in *ListenerSocketSettings) DeepCopy() *ListenerSocketSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerSocketSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSPolicy) DeepCopyInto(out *ListenerTLSPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSPolicy.
+func (in *ListenerTLSPolicy) DeepCopy() *ListenerTLSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerTLSPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSPolicyList) DeepCopyInto(out *ListenerTLSPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ListenerTLSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSPolicyList.
+func (in *ListenerTLSPolicyList) DeepCopy() *ListenerTLSPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerTLSPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTLSPolicySpec) DeepCopyInto(out *ListenerTLSPolicySpec) {
+	*out = *in
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]apisv1.Hostname, len(*in))
+		copy(*out, *in)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]TLSProtocolType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferServerCiphers != nil {
+		in, out := &in.PreferServerCiphers, &out.PreferServerCiphers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTLSPolicySpec.
+func (in *ListenerTLSPolicySpec) DeepCopy() *ListenerTLSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerTLSPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(ControllerLogLevel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLog) DeepCopyInto(out *NginxAccessLog) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(AccessLogSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]NginxLogFormat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerAccessLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLog.
+func (in *NginxAccessLog) DeepCopy() *NginxAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
+func (in *NginxGateway) DeepCopy() *NginxGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
+func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyMode != nil {
+		in, out := &in.ApplyMode, &out.ApplyMode
+		*out = new(ControlPlaneApplyMode)
+		**out = **in
+	}
+	if in.SmokeTest != nil {
+		in, out := &in.SmokeTest, &out.SmokeTest
+		*out = new(SmokeTest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReloadGating != nil {
+		in, out := &in.ReloadGating, &out.ReloadGating
+		*out = new(ReloadGating)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
+func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
+func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxLogFormat) DeepCopyInto(out *NginxLogFormat) {
+	*out = *in
+	if in.Escape != nil {
+		in, out := &in.Escape, &out.Escape
+		*out = new(NginxLogFormatEscape)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxLogFormat.
+func (in *NginxLogFormat) DeepCopy() *NginxLogFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxLogFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxLogging) DeepCopyInto(out *NginxLogging) {
+	*out = *in
+	if in.ErrorLevel != nil {
+		in, out := &in.ErrorLevel, &out.ErrorLevel
+		*out = new(NginxErrorLogLevel)
+		**out = **in
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(NginxAccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxLogging.
+func (in *NginxLogging) DeepCopy() *NginxLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxPlus) DeepCopyInto(out *NginxPlus) {
+	*out = *in
+	if in.AllowedAddresses != nil {
+		in, out := &in.AllowedAddresses, &out.AllowedAddresses
+		*out = make([]NginxPlusAllowAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxPlus.
+func (in *NginxPlus) DeepCopy() *NginxPlus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxPlus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxPlusAllowAddress) DeepCopyInto(out *NginxPlusAllowAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxPlusAllowAddress.
+func (in *NginxPlusAllowAddress) DeepCopy() *NginxPlusAllowAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxPlusAllowAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
+func (in *NginxProxy) DeepCopy() *NginxProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyControllerStatus) DeepCopyInto(out *NginxProxyControllerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bases != nil {
+		in, out := &in.Bases, &out.Bases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EffectiveValues != nil {
+		in, out := &in.EffectiveValues, &out.EffectiveValues
+		*out = make([]NginxProxyEffectiveValue, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyControllerStatus.
+func (in *NginxProxyControllerStatus) DeepCopy() *NginxProxyControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyEffectiveValue) DeepCopyInto(out *NginxProxyEffectiveValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyEffectiveValue.
+func (in *NginxProxyEffectiveValue) DeepCopy() *NginxProxyEffectiveValue {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyEffectiveValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
+func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyReference) DeepCopyInto(out *NginxProxyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyReference.
+func (in *NginxProxyReference) DeepCopy() *NginxProxyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+	*out = *in
+	if in.IPFamily != nil {
+		in, out := &in.IPFamily, &out.IPFamily
+		*out = new(IPFamilyType)
+		**out = **in
+	}
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(Telemetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RewriteClientIP != nil {
+		in, out := &in.RewriteClientIP, &out.RewriteClientIP
+		*out = new(RewriteClientIP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(NginxLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NginxPlus != nil {
+		in, out := &in.NginxPlus, &out.NginxPlus
+		*out = new(NginxPlus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateOverrides != nil {
+		in, out := &in.TemplateOverrides, &out.TemplateOverrides
+		*out = new(TemplateOverrides)
+		**out = **in
+	}
+	if in.HashTables != nil {
+		in, out := &in.HashTables, &out.HashTables
+		*out = new(HashTables)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPMatchMode != nil {
+		in, out := &in.HTTPMatchMode, &out.HTTPMatchMode
+		*out = new(HTTPMatchMode)
+		**out = **in
+	}
+	if in.Hardening != nil {
+		in, out := &in.Hardening, &out.Hardening
+		*out = new(Hardening)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SocketOptions != nil {
+		in, out := &in.SocketOptions, &out.SocketOptions
+		*out = new(SocketOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(NginxWorker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSPassthrough != nil {
+		in, out := &in.TLSPassthrough, &out.TLSPassthrough
+		*out = new(TLSPassthrough)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(NginxTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddressPublication != nil {
+		in, out := &in.AddressPublication, &out.AddressPublication
+		*out = new(AddressPublicationType)
+		**out = **in
+	}
+	if in.Resolver != nil {
+		in, out := &in.Resolver, &out.Resolver
+		*out = new(NginxResolver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP2 != nil {
+		in, out := &in.HTTP2, &out.HTTP2
+		*out = new(HTTP2Settings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(NginxStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BaseRef != nil {
+		in, out := &in.BaseRef, &out.BaseRef
+		*out = new(NginxProxyReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
+func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyStatus) DeepCopyInto(out *NginxProxyStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]NginxProxyControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyStatus.
+func (in *NginxProxyStatus) DeepCopy() *NginxProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxResolver) DeepCopyInto(out *NginxResolver) {
+	*out = *in
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxResolver.
+func (in *NginxResolver) DeepCopy() *NginxResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxStatus) DeepCopyInto(out *NginxStatus) {
+	*out = *in
+	if in.AllowedAddresses != nil {
+		in, out := &in.AllowedAddresses, &out.AllowedAddresses
+		*out = make([]NginxPlusAllowAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxStatus.
+func (in *NginxStatus) DeepCopy() *NginxStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxTLS) DeepCopyInto(out *NginxTLS) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferServerCiphers != nil {
+		in, out := &in.PreferServerCiphers, &out.PreferServerCiphers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(TLSSession)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]TLSProtocolType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxTLS.
+func (in *NginxTLS) DeepCopy() *NginxTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxWorker) DeepCopyInto(out *NginxWorker) {
+	*out = *in
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(string)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.Processes != nil {
+		in, out := &in.Processes, &out.Processes
+		*out = new(string)
+		**out = **in
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RlimitNofile != nil {
+		in, out := &in.RlimitNofile, &out.RlimitNofile
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MultiAccept != nil {
+		in, out := &in.MultiAccept, &out.MultiAccept
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PortBinding != nil {
+		in, out := &in.PortBinding, &out.PortBinding
+		*out = new(PortBinding)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxWorker.
+func (in *NginxWorker) DeepCopy() *NginxWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+	if in.PullSecret != nil {
+		in, out := &in.PullSecret, &out.PullSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ArtifactVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
+func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObservabilityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
+func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+	*out = *in
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
+func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortBinding) DeepCopyInto(out *PortBinding) {
+	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PortBindingStrategy)
+		**out = **in
+	}
+	if in.PortOffset != nil {
+		in, out := &in.PortOffset, &out.PortOffset
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PortMappings != nil {
+		in, out := &in.PortMappings, &out.PortMappings
+		*out = make([]PortMapping, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortBinding.
+func (in *PortBinding) DeepCopy() *PortBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(PortBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortMapping) DeepCopyInto(out *PortMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortMapping.
+func (in *PortMapping) DeepCopy() *PortMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(PortMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyBuffering) DeepCopyInto(out *ProxyBuffering) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Buffers != nil {
+		in, out := &in.Buffers, &out.Buffers
+		*out = new(ProxyBuffers)
+		**out = **in
+	}
+	if in.BusyBuffersSize != nil {
+		in, out := &in.BusyBuffersSize, &out.BusyBuffersSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffering.
+func (in *ProxyBuffering) DeepCopy() *ProxyBuffering {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyBuffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyBuffers) DeepCopyInto(out *ProxyBuffers) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffers.
+func (in *ProxyBuffers) DeepCopy() *ProxyBuffers {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyBuffers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitKey) DeepCopyInto(out *RateLimitKey) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.JWTClaim != nil {
+		in, out := &in.JWTClaim, &out.JWTClaim
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitKey.
+func (in *RateLimitKey) DeepCopy() *RateLimitKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
+func (in *RateLimitPolicy) DeepCopy() *RateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicyList) DeepCopyInto(out *RateLimitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RateLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicyList.
+func (in *RateLimitPolicyList) DeepCopy() *RateLimitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitPolicySpec) DeepCopyInto(out *RateLimitPolicySpec) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(RateLimitKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoDelay != nil {
+		in, out := &in.NoDelay, &out.NoDelay
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicySpec.
+func (in *RateLimitPolicySpec) DeepCopy() *RateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReloadGating) DeepCopyInto(out *ReloadGating) {
+	*out = *in
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReloadGating.
+func (in *ReloadGating) DeepCopy() *ReloadGating {
+	if in == nil {
+		return nil
+	}
+	out := new(ReloadGating)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestBodyTransform) DeepCopyInto(out *RequestBodyTransform) {
+	*out = *in
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestBodyTransform.
+func (in *RequestBodyTransform) DeepCopy() *RequestBodyTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestBodyTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseBodyTransform) DeepCopyInto(out *ResponseBodyTransform) {
+	*out = *in
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseBodyTransform.
+func (in *ResponseBodyTransform) DeepCopy() *ResponseBodyTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseBodyTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCompressionPolicy) DeepCopyInto(out *ResponseCompressionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCompressionPolicy.
+func (in *ResponseCompressionPolicy) DeepCopy() *ResponseCompressionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCompressionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponseCompressionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCompressionPolicyList) DeepCopyInto(out *ResponseCompressionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResponseCompressionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCompressionPolicyList.
+func (in *ResponseCompressionPolicyList) DeepCopy() *ResponseCompressionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCompressionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponseCompressionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCompressionPolicySpec) DeepCopyInto(out *ResponseCompressionPolicySpec) {
+	*out = *in
+	if in.Gzip != nil {
+		in, out := &in.Gzip, &out.Gzip
+		*out = new(GzipCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Brotli != nil {
+		in, out := &in.Brotli, &out.Brotli
+		*out = new(BrotliCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCompressionPolicySpec.
+func (in *ResponseCompressionPolicySpec) DeepCopy() *ResponseCompressionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCompressionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RewriteClientIP) DeepCopyInto(out *RewriteClientIP) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RewriteClientIPModeType)
+		**out = **in
+	}
+	if in.SetIPRecursively != nil {
+		in, out := &in.SetIPRecursively, &out.SetIPRecursively
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustedAddresses != nil {
+		in, out := &in.TrustedAddresses, &out.TrustedAddresses
+		*out = make([]RewriteClientIPAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIP.
+func (in *RewriteClientIP) DeepCopy() *RewriteClientIP {
+	if in == nil {
+		return nil
+	}
+	out := new(RewriteClientIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RewriteClientIPAddress) DeepCopyInto(out *RewriteClientIPAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIPAddress.
+func (in *RewriteClientIPAddress) DeepCopy() *RewriteClientIPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(RewriteClientIPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTest) DeepCopyInto(out *RouteTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTest.
+func (in *RouteTest) DeepCopy() *RouteTest {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+func (in *RouteTest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -432,47 +2680,334 @@ func (in *NginxProxyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+func (in *RouteTestBackendReference) DeepCopyInto(out *RouteTestBackendReference) {
 	*out = *in
-	if in.IPFamily != nil {
-		in, out := &in.IPFamily, &out.IPFamily
-		*out = new(IPFamilyType)
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
 		**out = **in
 	}
-	if in.Telemetry != nil {
-		in, out := &in.Telemetry, &out.Telemetry
-		*out = new(Telemetry)
-		(*in).DeepCopyInto(*out)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(apisv1.PortNumber)
+		**out = **in
 	}
-	if in.RewriteClientIP != nil {
-		in, out := &in.RewriteClientIP, &out.RewriteClientIP
-		*out = new(RewriteClientIP)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestBackendReference.
+func (in *RouteTestBackendReference) DeepCopy() *RouteTestBackendReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestBackendReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestCase) DeepCopyInto(out *RouteTestCase) {
+	*out = *in
+	in.Request.DeepCopyInto(&out.Request)
+	in.Expect.DeepCopyInto(&out.Expect)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestCase.
+func (in *RouteTestCase) DeepCopy() *RouteTestCase {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestControllerStatus) DeepCopyInto(out *RouteTestControllerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]RouteTestResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestControllerStatus.
+func (in *RouteTestControllerStatus) DeepCopy() *RouteTestControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestExpectation) DeepCopyInto(out *RouteTestExpectation) {
+	*out = *in
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(RouteTestRouteReference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(NginxLogging)
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(RouteTestBackendReference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NginxPlus != nil {
-		in, out := &in.NginxPlus, &out.NginxPlus
-		*out = new(NginxPlus)
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]apisv1.HTTPRouteFilterType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestExpectation.
+func (in *RouteTestExpectation) DeepCopy() *RouteTestExpectation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestExpectation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestList) DeepCopyInto(out *RouteTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestList.
+func (in *RouteTestList) DeepCopy() *RouteTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestRequest) DeepCopyInto(out *RouteTestRequest) {
+	*out = *in
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(apisv1.HTTPMethod)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(apisv1.PortNumber)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestRequest.
+func (in *RouteTestRequest) DeepCopy() *RouteTestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestResult) DeepCopyInto(out *RouteTestResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestResult.
+func (in *RouteTestResult) DeepCopy() *RouteTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestRouteReference) DeepCopyInto(out *RouteTestRouteReference) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(apisv1.Kind)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestRouteReference.
+func (in *RouteTestRouteReference) DeepCopy() *RouteTestRouteReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestRouteReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestSpec) DeepCopyInto(out *RouteTestSpec) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]RouteTestCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestSpec.
+func (in *RouteTestSpec) DeepCopy() *RouteTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTestStatus) DeepCopyInto(out *RouteTestStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]RouteTestControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTestStatus.
+func (in *RouteTestStatus) DeepCopy() *RouteTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmokeTest) DeepCopyInto(out *SmokeTest) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]SmokeTestProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmokeTest.
+func (in *SmokeTest) DeepCopy() *SmokeTest {
+	if in == nil {
+		return nil
+	}
+	out := new(SmokeTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmokeTestProbe) DeepCopyInto(out *SmokeTestProbe) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(SmokeTestScheme)
+		**out = **in
+	}
+	if in.ExpectedStatus != nil {
+		in, out := &in.ExpectedStatus, &out.ExpectedStatus
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExpectedHeaders != nil {
+		in, out := &in.ExpectedHeaders, &out.ExpectedHeaders
+		*out = make([]apisv1.HTTPHeaderName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmokeTestProbe.
+func (in *SmokeTestProbe) DeepCopy() *SmokeTestProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(SmokeTestProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snippet) DeepCopyInto(out *Snippet) {
+	*out = *in
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(OCIArtifact)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
-func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snippet.
+func (in *Snippet) DeepCopy() *Snippet {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxySpec)
+	out := new(Snippet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+func (in *SnippetsFilter) DeepCopyInto(out *SnippetsFilter) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -480,18 +3015,18 @@ func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
-func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilter.
+func (in *SnippetsFilter) DeepCopy() *SnippetsFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicy)
+	out := new(SnippetsFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
+func (in *SnippetsFilter) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -499,31 +3034,31 @@ func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+func (in *SnippetsFilterList) DeepCopyInto(out *SnippetsFilterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityPolicy, len(*in))
+		*out = make([]SnippetsFilter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
-func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterList.
+func (in *SnippetsFilterList) DeepCopy() *SnippetsFilterList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicyList)
+	out := new(SnippetsFilterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+func (in *SnippetsFilterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -531,94 +3066,153 @@ func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+func (in *SnippetsFilterSpec) DeepCopyInto(out *SnippetsFilterSpec) {
 	*out = *in
-	if in.Tracing != nil {
-		in, out := &in.Tracing, &out.Tracing
-		*out = new(Tracing)
+	if in.Snippets != nil {
+		in, out := &in.Snippets, &out.Snippets
+		*out = make([]Snippet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterSpec.
+func (in *SnippetsFilterSpec) DeepCopy() *SnippetsFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnippetsFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetsFilterStatus) DeepCopyInto(out *SnippetsFilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterStatus.
+func (in *SnippetsFilterStatus) DeepCopy() *SnippetsFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnippetsFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SocketOptions) DeepCopyInto(out *SocketOptions) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(SocketSettings)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TargetRefs != nil {
-		in, out := &in.TargetRefs, &out.TargetRefs
-		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerSocketSettings, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
-func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketOptions.
+func (in *SocketOptions) DeepCopy() *SocketOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicySpec)
+	out := new(SocketOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RewriteClientIP) DeepCopyInto(out *RewriteClientIP) {
+func (in *SocketSettings) DeepCopyInto(out *SocketSettings) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(RewriteClientIPModeType)
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(TCPKeepAlive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backlog != nil {
+		in, out := &in.Backlog, &out.Backlog
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SetIPRecursively != nil {
-		in, out := &in.SetIPRecursively, &out.SetIPRecursively
+	if in.ReusePort != nil {
+		in, out := &in.ReusePort, &out.ReusePort
 		*out = new(bool)
 		**out = **in
 	}
-	if in.TrustedAddresses != nil {
-		in, out := &in.TrustedAddresses, &out.TrustedAddresses
-		*out = make([]RewriteClientIPAddress, len(*in))
-		copy(*out, *in)
+	if in.DeferredAccept != nil {
+		in, out := &in.DeferredAccept, &out.DeferredAccept
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TCPNoDelay != nil {
+		in, out := &in.TCPNoDelay, &out.TCPNoDelay
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TCPNoPush != nil {
+		in, out := &in.TCPNoPush, &out.TCPNoPush
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIP.
-func (in *RewriteClientIP) DeepCopy() *RewriteClientIP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketSettings.
+func (in *SocketSettings) DeepCopy() *SocketSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(RewriteClientIP)
+	out := new(SocketSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RewriteClientIPAddress) DeepCopyInto(out *RewriteClientIPAddress) {
+func (in *SpanAttribute) DeepCopyInto(out *SpanAttribute) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIPAddress.
-func (in *RewriteClientIPAddress) DeepCopy() *RewriteClientIPAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttribute.
+func (in *SpanAttribute) DeepCopy() *SpanAttribute {
 	if in == nil {
 		return nil
 	}
-	out := new(RewriteClientIPAddress)
+	out := new(SpanAttribute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Snippet) DeepCopyInto(out *Snippet) {
+func (in *StaticContentConfigMap) DeepCopyInto(out *StaticContentConfigMap) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snippet.
-func (in *Snippet) DeepCopy() *Snippet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentConfigMap.
+func (in *StaticContentConfigMap) DeepCopy() *StaticContentConfigMap {
 	if in == nil {
 		return nil
 	}
-	out := new(Snippet)
+	out := new(StaticContentConfigMap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SnippetsFilter) DeepCopyInto(out *SnippetsFilter) {
+func (in *StaticContentPolicy) DeepCopyInto(out *StaticContentPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -626,18 +3220,18 @@ func (in *SnippetsFilter) DeepCopyInto(out *SnippetsFilter) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilter.
-func (in *SnippetsFilter) DeepCopy() *SnippetsFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicy.
+func (in *StaticContentPolicy) DeepCopy() *StaticContentPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(SnippetsFilter)
+	out := new(StaticContentPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SnippetsFilter) DeepCopyObject() runtime.Object {
+func (in *StaticContentPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -645,31 +3239,31 @@ func (in *SnippetsFilter) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SnippetsFilterList) DeepCopyInto(out *SnippetsFilterList) {
+func (in *StaticContentPolicyList) DeepCopyInto(out *StaticContentPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SnippetsFilter, len(*in))
+		*out = make([]StaticContentPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterList.
-func (in *SnippetsFilterList) DeepCopy() *SnippetsFilterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicyList.
+func (in *StaticContentPolicyList) DeepCopy() *StaticContentPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(SnippetsFilterList)
+	out := new(StaticContentPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SnippetsFilterList) DeepCopyObject() runtime.Object {
+func (in *StaticContentPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -677,58 +3271,174 @@ func (in *SnippetsFilterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SnippetsFilterSpec) DeepCopyInto(out *SnippetsFilterSpec) {
+func (in *StaticContentPolicySpec) DeepCopyInto(out *StaticContentPolicySpec) {
 	*out = *in
-	if in.Snippets != nil {
-		in, out := &in.Snippets, &out.Snippets
-		*out = make([]Snippet, len(*in))
-		copy(*out, *in)
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fallback != nil {
+		in, out := &in.Fallback, &out.Fallback
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterSpec.
-func (in *SnippetsFilterSpec) DeepCopy() *SnippetsFilterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicySpec.
+func (in *StaticContentPolicySpec) DeepCopy() *StaticContentPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SnippetsFilterSpec)
+	out := new(StaticContentPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SnippetsFilterStatus) DeepCopyInto(out *SnippetsFilterStatus) {
+func (in *StaticContentSource) DeepCopyInto(out *StaticContentSource) {
 	*out = *in
-	if in.Controllers != nil {
-		in, out := &in.Controllers, &out.Controllers
-		*out = make([]ControllerStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(StaticContentConfigMap)
+		**out = **in
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(StaticContentVolume)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterStatus.
-func (in *SnippetsFilterStatus) DeepCopy() *SnippetsFilterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentSource.
+func (in *StaticContentSource) DeepCopy() *StaticContentSource {
 	if in == nil {
 		return nil
 	}
-	out := new(SnippetsFilterStatus)
+	out := new(StaticContentSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpanAttribute) DeepCopyInto(out *SpanAttribute) {
+func (in *StaticContentVolume) DeepCopyInto(out *StaticContentVolume) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttribute.
-func (in *SpanAttribute) DeepCopy() *SpanAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentVolume.
+func (in *StaticContentVolume) DeepCopy() *StaticContentVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(SpanAttribute)
+	out := new(StaticContentVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogDestination) DeepCopyInto(out *SyslogDestination) {
+	*out = *in
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogDestination.
+func (in *SyslogDestination) DeepCopy() *SyslogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPKeepAlive) DeepCopyInto(out *TCPKeepAlive) {
+	*out = *in
+	if in.Idle != nil {
+		in, out := &in.Idle, &out.Idle
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPKeepAlive.
+func (in *TCPKeepAlive) DeepCopy() *TCPKeepAlive {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPKeepAlive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPassthrough) DeepCopyInto(out *TLSPassthrough) {
+	*out = *in
+	if in.SendProxyProtocol != nil {
+		in, out := &in.SendProxyProtocol, &out.SendProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPassthrough.
+func (in *TLSPassthrough) DeepCopy() *TLSPassthrough {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPassthrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSession) DeepCopyInto(out *TLSSession) {
+	*out = *in
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Tickets != nil {
+		in, out := &in.Tickets, &out.Tickets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSession.
+func (in *TLSSession) DeepCopy() *TLSSession {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSession)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -793,6 +3503,22 @@ func (in *TelemetryExporter) DeepCopy() *TelemetryExporter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateOverrides) DeepCopyInto(out *TemplateOverrides) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateOverrides.
+func (in *TemplateOverrides) DeepCopy() *TemplateOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tracing) DeepCopyInto(out *Tracing) {
 	*out = *in
@@ -935,6 +3661,11 @@ func (in *UpstreamSettingsPolicySpec) DeepCopyInto(out *UpstreamSettingsPolicySp
 		*out = new(UpstreamKeepAlive)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPCHealthCheck != nil {
+		in, out := &in.GRPCHealthCheck, &out.GRPCHealthCheck
+		*out = new(GRPCHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetRefs != nil {
 		in, out := &in.TargetRefs, &out.TargetRefs
 		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
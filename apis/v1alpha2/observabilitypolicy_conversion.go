@@ -0,0 +1,73 @@
+package v1alpha2
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+// ConvertTo converts this ObservabilityPolicy to the hub version (v1alpha1).
+func (p *ObservabilityPolicy) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*ngfAPIv1alpha1.ObservabilityPolicy)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", dstRaw)
+	}
+
+	dst.ObjectMeta = *p.ObjectMeta.DeepCopy()
+	dst.Spec.TargetRefs = p.DeepCopy().Spec.TargetRefs
+	dst.Spec.Tracing = nil
+
+	if p.Spec.Tracing != nil {
+		tracing := p.Spec.Tracing.DeepCopy()
+
+		dst.Spec.Tracing = &ngfAPIv1alpha1.Tracing{
+			Strategy:       ngfAPIv1alpha1.TraceStrategy(tracing.Strategy),
+			Ratio:          tracing.Ratio,
+			SpanName:       tracing.SpanName,
+			SpanAttributes: tracing.SpanAttributes,
+		}
+
+		if tracing.Context != nil {
+			ctx := ngfAPIv1alpha1.TraceContext(*tracing.Context)
+			dst.Spec.Tracing.Context = &ctx
+		}
+	}
+
+	dst.Status = *p.Status.DeepCopy()
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1alpha1) to this ObservabilityPolicy.
+func (p *ObservabilityPolicy) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*ngfAPIv1alpha1.ObservabilityPolicy)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", srcRaw)
+	}
+
+	p.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	p.Spec.TargetRefs = src.DeepCopy().Spec.TargetRefs
+	p.Spec.Tracing = nil
+
+	if src.Spec.Tracing != nil {
+		tracing := src.Spec.Tracing.DeepCopy()
+
+		p.Spec.Tracing = &Tracing{
+			Strategy:       TraceStrategy(tracing.Strategy),
+			Ratio:          tracing.Ratio,
+			SpanName:       tracing.SpanName,
+			SpanAttributes: tracing.SpanAttributes,
+		}
+
+		if tracing.Context != nil {
+			ctx := TraceContext(*tracing.Context)
+			p.Spec.Tracing.Context = &ctx
+		}
+	}
+
+	p.Status = *src.Status.DeepCopy()
+
+	return nil
+}
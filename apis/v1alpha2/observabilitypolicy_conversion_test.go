@@ -0,0 +1,127 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestObservabilityPolicyConversionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	meta := metav1.ObjectMeta{
+		Name:            "policy",
+		Namespace:       "test",
+		Generation:      3,
+		ResourceVersion: "42",
+		Labels:          map[string]string{"app": "test"},
+	}
+
+	targetRefs := []gatewayv1alpha2.LocalPolicyTargetReference{
+		{
+			Group: v1.GroupName,
+			Kind:  "HTTPRoute",
+			Name:  "route",
+		},
+	}
+
+	status := gatewayv1alpha2.PolicyStatus{
+		Ancestors: []gatewayv1alpha2.PolicyAncestorStatus{
+			{
+				AncestorRef:    v1.ParentReference{Name: "route"},
+				ControllerName: "gateway.nginx.org/nginx-gateway-controller",
+				Conditions: []metav1.Condition{
+					{Type: "Accepted", Status: metav1.ConditionTrue, Reason: "Accepted"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		policy *ObservabilityPolicy
+		name   string
+	}{
+		{
+			name: "empty tracing",
+			policy: &ObservabilityPolicy{
+				ObjectMeta: meta,
+				Spec: ObservabilityPolicySpec{
+					TargetRefs: targetRefs,
+				},
+				Status: status,
+			},
+		},
+		{
+			name: "all fields set",
+			policy: &ObservabilityPolicy{
+				ObjectMeta: meta,
+				Spec: ObservabilityPolicySpec{
+					TargetRefs: targetRefs,
+					Tracing: &Tracing{
+						Strategy: TraceStrategyRatio,
+						Ratio:    helpers.GetPointer[int32](25),
+						Context:  helpers.GetPointer(TraceContextPropagate),
+						SpanName: helpers.GetPointer("span"),
+						SpanAttributes: []ngfAPIv1alpha1.SpanAttribute{
+							{Key: "key", Value: "value"},
+						},
+					},
+				},
+				Status: status,
+			},
+		},
+		{
+			name: "parent strategy without optional fields",
+			policy: &ObservabilityPolicy{
+				ObjectMeta: meta,
+				Spec: ObservabilityPolicySpec{
+					TargetRefs: targetRefs,
+					Tracing: &Tracing{
+						Strategy: TraceStrategyParent,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			hub := &ngfAPIv1alpha1.ObservabilityPolicy{}
+			g.Expect(test.policy.ConvertTo(hub)).To(Succeed())
+
+			result := &ObservabilityPolicy{}
+			g.Expect(result.ConvertFrom(hub)).To(Succeed())
+
+			g.Expect(result).To(Equal(test.policy))
+		})
+	}
+}
+
+func TestObservabilityPolicyConvertToDoesNotShareMemory(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := &ObservabilityPolicy{
+		Spec: ObservabilityPolicySpec{
+			Tracing: &Tracing{
+				Strategy: TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](10),
+			},
+		},
+	}
+
+	hub := &ngfAPIv1alpha1.ObservabilityPolicy{}
+	g.Expect(policy.ConvertTo(hub)).To(Succeed())
+
+	*hub.Spec.Tracing.Ratio = 50
+	g.Expect(*policy.Spec.Tracing.Ratio).To(Equal(int32(10)))
+}
@@ -91,3 +91,52 @@ Expand leader election lock name.
 {{- printf "%s-%s" (include "nginx-gateway.fullname" .) "leader-election" -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Create the name of the ConfigMap the NGINX configuration is published to.
+*/}}
+{{- define "nginx-gateway.nginxConfigDumpName" -}}
+{{- if .Values.nginxGateway.nginxConfigDump.configMapName -}}
+{{ .Values.nginxGateway.nginxConfigDump.configMapName }}
+{{- else -}}
+{{- printf "%s-%s" (include "nginx-gateway.fullname" .) "nginx-config" -}}
+{{- end -}}
+{{- end -}}
+
+{{/*
+The offset of the privileged ports that NGINX listens on, if NGINX binds to the high ports instead of
+the privileged ports. Zero means that NGINX binds to the privileged ports with the NET_BIND_SERVICE capability.
+*/}}
+{{- define "nginx-gateway.nginxPortOffset" -}}
+{{- $portBinding := dig "worker" "portBinding" dict .Values.nginx.config -}}
+{{- if eq (get $portBinding "strategy") "HighPorts" -}}
+{{ $portBinding.portOffset | default 8000 }}
+{{- else -}}
+0
+{{- end -}}
+{{- end -}}
+
+{{/*
+The port mappings of the ports of the Listeners to the ports that NGINX listens on in the container.
+*/}}
+{{- define "nginx-gateway.nginxPortMappings" -}}
+{{- dig "worker" "portBinding" "portMappings" list .Values.nginx.config | toYaml -}}
+{{- end -}}
+
+{{/*
+The port that NGINX listens on in the container for the port of a Listener. A port mapping takes precedence over
+the offset of the privileged ports. Expects a dict with the port and the root context.
+*/}}
+{{- define "nginx-gateway.nginxContainerPort" -}}
+{{- $port := int .port -}}
+{{- $containerPort := $port -}}
+{{- if lt $port 1024 -}}
+{{- $containerPort = add $port (include "nginx-gateway.nginxPortOffset" .context | int) -}}
+{{- end -}}
+{{- range (include "nginx-gateway.nginxPortMappings" .context | fromYamlArray) -}}
+{{- if eq (int .listenerPort) $port -}}
+{{- $containerPort = int .containerPort -}}
+{{- end -}}
+{{- end -}}
+{{ $containerPort }}
+{{- end -}}
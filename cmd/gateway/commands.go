@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -11,6 +12,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/klog/v2"
@@ -19,12 +22,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	ctlrZap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	embeddedfiles "github.com/nginx/nginx-gateway-fabric"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/storageversion"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/provisioner"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/licensing"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/preflight"
 )
 
 // These flags are shared by multiple commands.
@@ -56,6 +62,7 @@ func createStaticModeCommand() *cobra.Command {
 	// flag names
 	const (
 		gatewayFlag                    = "gateway"
+		gatewaySelectorFlag            = "gateway-selector"
 		configFlag                     = "config"
 		serviceFlag                    = "service"
 		updateGCStatusFlag             = "update-gatewayclass-status"
@@ -75,6 +82,27 @@ func createStaticModeCommand() *cobra.Command {
 		usageReportClientSSLSecretFlag = "usage-report-client-ssl-secret" //nolint:gosec // not credentials
 		usageReportCASecretFlag        = "usage-report-ca-secret"         //nolint:gosec // not credentials
 		snippetsFiltersFlag            = "snippets-filters"
+		snippetsFiltersSignaturesFlag  = "snippets-filters-require-artifact-signatures"
+		conversionWebhookFlag          = "conversion-webhook"
+		conversionWebhookPortFlag      = "conversion-webhook-port"
+		conversionWebhookCertDirFlag   = "conversion-webhook-cert-dir"
+		conversionWebhookServiceFlag   = "conversion-webhook-service"
+		nginxConfigDumpFlag            = "nginx-config-dump"
+		nginxConfigDumpConfigMapFlag   = "nginx-config-dump-configmap"
+		nginxValidatorFlag             = "nginx-validator"
+		nginxValidatorPortFlag         = "nginx-validator-port"
+		externalNameServicesFlag       = "external-name-services"
+		templateOverridesFlag          = "template-overrides"
+		certExpiryWarningDaysFlag      = "certificate-expiry-warning-days"
+		namespaceEventRateFlag         = "namespace-event-rate"
+		namespaceEventBurstFlag        = "namespace-event-burst"
+		dataPlaneAPIFlag               = "dataplane-api"
+		dataPlaneAPIPortFlag           = "dataplane-api-port"
+		dataPlaneAPICertDirFlag        = "dataplane-api-cert-dir"
+		nginxErrorLogParserFlag        = "nginx-error-log-parser"
+		nginxErrorLogEventsFlag        = "nginx-error-log-events"
+		coldStartSnapshotFlag          = "cold-start-snapshot"
+		coldStartSnapshotDirFlag       = "cold-start-snapshot-dir"
 	)
 
 	// flag values
@@ -87,9 +115,10 @@ func createStaticModeCommand() *cobra.Command {
 			validator: validateResourceName,
 		}
 
-		updateGCStatus bool
-		gateway        = namespacedNameValue{}
-		configName     = stringValidatingValue{
+		updateGCStatus  bool
+		gateway         = namespacedNameValue{}
+		gatewaySelector = labelSelectorValue{}
+		configName      = stringValidatingValue{
 			validator: validateResourceName,
 		}
 		serviceName = stringValidatingValue{
@@ -117,7 +146,63 @@ func createStaticModeCommand() *cobra.Command {
 
 		disableProductTelemetry bool
 
-		snippetsFilters bool
+		snippetsFilters                  bool
+		snippetsFiltersRequireSignatures bool
+
+		externalNameServices bool
+
+		templateOverrides bool
+
+		conversionWebhook     bool
+		conversionWebhookPort = intValidatingValue{
+			validator: validatePort,
+			value:     9443,
+		}
+		conversionWebhookCertDir = "/var/run/secrets/nginx-gateway/webhook"
+		conversionWebhookService = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx-gateway-webhook",
+		}
+
+		nginxConfigDump          bool
+		nginxConfigDumpConfigMap = stringValidatingValue{
+			validator: validateResourceName,
+			value:     "nginx-gateway-nginx-config",
+		}
+
+		nginxValidator     bool
+		nginxValidatorPort = intValidatingValue{
+			validator: validatePort,
+			value:     8095,
+		}
+
+		certExpiryWarningDays = intValidatingValue{
+			validator: validateNonNegative,
+			value:     30,
+		}
+
+		namespaceEventRate = intValidatingValue{
+			validator: validateNonNegative,
+			value:     0,
+		}
+
+		namespaceEventBurst = intValidatingValue{
+			validator: validatePositive,
+			value:     20,
+		}
+
+		dataPlaneAPI     bool
+		dataPlaneAPIPort = intValidatingValue{
+			validator: validatePort,
+			value:     9445,
+		}
+		dataPlaneAPICertDir = "/var/run/secrets/nginx-gateway/dataplane-api"
+
+		nginxErrorLogParser bool
+		nginxErrorLogEvents bool
+
+		coldStartSnapshot    bool
+		coldStartSnapshotDir = "/var/lib/nginx-gateway/snapshot"
 
 		plus                  bool
 		usageReportSkipVerify bool
@@ -158,7 +243,18 @@ func createStaticModeCommand() *cobra.Command {
 			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if conversionWebhook {
+				ports = append(ports, conversionWebhookPort.value)
+			}
+			if nginxValidator {
+				ports = append(ports, nginxValidatorPort.value)
+			}
+			if dataPlaneAPI {
+				ports = append(ports, dataPlaneAPIPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
@@ -193,6 +289,10 @@ func createStaticModeCommand() *cobra.Command {
 				return errors.New("usage-report-secret is required when using NGINX Plus")
 			}
 
+			if nginxErrorLogEvents && !nginxErrorLogParser {
+				return fmt.Errorf("%s requires %s", nginxErrorLogEventsFlag, nginxErrorLogParserFlag)
+			}
+
 			if plus {
 				usageReportConfig = config.UsageReportConfig{
 					SecretName:          usageReportSecretName.value,
@@ -218,6 +318,7 @@ func createStaticModeCommand() *cobra.Command {
 				AtomicLevel:              atom,
 				GatewayClassName:         gatewayClassName.value,
 				GatewayNsName:            gwNsName,
+				GatewaySelector:          gatewaySelector.value,
 				UpdateGatewayClassStatus: updateGCStatus,
 				GatewayPodConfig:         podConfig,
 				HealthConfig: config.HealthConfig{
@@ -229,6 +330,20 @@ func createStaticModeCommand() *cobra.Command {
 					Port:    metricsListenPort.value,
 					Secure:  metricsSecure,
 				},
+				WebhookConfig: config.WebhookConfig{
+					Enabled:     conversionWebhook,
+					Port:        conversionWebhookPort.value,
+					CertDir:     conversionWebhookCertDir,
+					ServiceName: conversionWebhookService.value,
+				},
+				NginxConfigDumpConfig: config.NginxConfigDumpConfig{
+					Enabled:       nginxConfigDump,
+					ConfigMapName: nginxConfigDumpConfigMap.value,
+				},
+				NginxValidatorConfig: config.NginxValidatorConfig{
+					Enabled: nginxValidator,
+					Port:    nginxValidatorPort.value,
+				},
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
 					LockName: leaderElectionLockName.String(),
@@ -249,7 +364,28 @@ func createStaticModeCommand() *cobra.Command {
 					Names:  flagKeys,
 					Values: flagValues,
 				},
-				SnippetsFilters: snippetsFilters,
+				SnippetsFilters:              snippetsFilters,
+				RequireArtifactSignatures:    snippetsFiltersRequireSignatures,
+				ExternalNameServices:         externalNameServices,
+				TemplateOverrides:            templateOverrides,
+				CertificateExpiryWarningDays: certExpiryWarningDays.value,
+				EventRateLimitConfig: config.EventRateLimitConfig{
+					EventsPerSecond: namespaceEventRate.value,
+					Burst:           namespaceEventBurst.value,
+				},
+				DataPlaneAPIConfig: config.DataPlaneAPIConfig{
+					Enabled: dataPlaneAPI,
+					Port:    dataPlaneAPIPort.value,
+					CertDir: dataPlaneAPICertDir,
+				},
+				NginxErrorLogConfig: config.NginxErrorLogConfig{
+					Enabled: nginxErrorLogParser,
+					Events:  nginxErrorLogEvents,
+				},
+				ColdStartSnapshotConfig: config.ColdStartSnapshotConfig{
+					Enabled: coldStartSnapshot,
+					Dir:     coldStartSnapshotDir,
+				},
 			}
 
 			if err := static.StartManager(conf); err != nil {
@@ -284,6 +420,16 @@ func createStaticModeCommand() *cobra.Command {
 			"equal, it will choose the resource that appears first in alphabetical order by {namespace}/{name}.",
 	)
 
+	cmd.Flags().Var(
+		&gatewaySelector,
+		gatewaySelectorFlag,
+		"The label selector of the Gateway resources to use, for example, 'ngf-instance=blue'. "+
+			"If specified, the control plane will only process the Gateways for the configured GatewayClass "+
+			"with the labels that match the selector, and the Routes attached to them. This allows multiple "+
+			"control planes to share the same GatewayClass, for example, to migrate Gateways between versions. "+
+			"In that case, only one of the control planes should update the status of the GatewayClass.",
+	)
+
 	cmd.Flags().VarP(
 		&configName,
 		configFlag,
@@ -425,13 +571,191 @@ func createStaticModeCommand() *cobra.Command {
 		snippetsFiltersFlag,
 		false,
 		"Enable SnippetsFilters feature. SnippetsFilters allow inserting NGINX configuration into the "+
-			"generated NGINX config for HTTPRoute and GRPCRoute resources.",
+			"generated NGINX config for HTTPRoute and GRPCRoute resources. It also enables HTTPBodyTransforms, "+
+			"which transform the bodies of the requests and responses of HTTPRoute resources with njs scripts.",
+	)
+
+	cmd.Flags().BoolVar(
+		&snippetsFiltersRequireSignatures,
+		snippetsFiltersSignaturesFlag,
+		false,
+		"Require the OCI artifacts of the snippets of SnippetsFilters to have verified cosign signatures. "+
+			"SnippetsFilters with artifacts that have no signature verification are not accepted.",
+	)
+
+	cmd.Flags().BoolVar(
+		&conversionWebhook,
+		conversionWebhookFlag,
+		false,
+		"Enable the webhook server. The conversion webhook converts NGINX Gateway Fabric custom resources "+
+			"between their served API versions, and the defaulting webhooks set the default values of the "+
+			"ObservabilityPolicies and NginxProxies. Requires the CRDs to be configured with the Webhook conversion strategy.",
+	)
+
+	cmd.Flags().Var(
+		&conversionWebhookPort,
+		conversionWebhookPortFlag,
+		"Set the port where the conversion webhook server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().StringVar(
+		&conversionWebhookCertDir,
+		conversionWebhookCertDirFlag,
+		conversionWebhookCertDir,
+		"The directory containing the TLS certificate (tls.crt) and key (tls.key) for the conversion webhook server.",
+	)
+
+	cmd.Flags().Var(
+		&conversionWebhookService,
+		conversionWebhookServiceFlag,
+		"The name of the Service of the webhook server in the same Namespace as the controller. The controller "+
+			"configures the CRDs served in more than one version to convert their objects with the webhook through "+
+			"this Service, and verifies the serving certificate with the CA certificate (ca.crt) in the certificate "+
+			"directory.",
+	)
+
+	cmd.Flags().BoolVar(
+		&nginxConfigDump,
+		nginxConfigDumpFlag,
+		false,
+		"Publish the generated NGINX configuration to a ConfigMap after every successful reload, so that it can be "+
+			"inspected without access to the NGINX container. The content of secret files is redacted. "+
+			"The ConfigMap also holds a snapshot of the data plane configuration in a versioned JSON schema. "+
+			"With several replicas, the ConfigMap holds the configuration of the leader.",
+	)
+
+	cmd.Flags().Var(
+		&nginxConfigDumpConfigMap,
+		nginxConfigDumpConfigMapFlag,
+		"The name of the ConfigMap that the NGINX configuration is published to. "+
+			"The ConfigMap is created in the same Namespace as the controller.",
+	)
+
+	cmd.Flags().BoolVar(
+		&nginxValidator,
+		nginxValidatorFlag,
+		false,
+		"Load every NGINX configuration into a separate validator NGINX instance before it is applied to the "+
+			"live NGINX instance. This catches the errors that NGINX only reports when it loads the configuration. "+
+			"The validator NGINX instance runs in the nginx-validator container of the NGINX image, which shares "+
+			"the /var/run/nginx volume with the NGINX Gateway Fabric container.",
+	)
+
+	cmd.Flags().Var(
+		&nginxValidatorPort,
+		nginxValidatorPortFlag,
+		"Set the dummy port on the loopback interface that the validator NGINX instance listens on. "+
+			"Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().BoolVar(
+		&externalNameServices,
+		externalNameServicesFlag,
+		false,
+		"Allow the backendRefs of HTTPRoute and GRPCRoute resources to reference Services of type ExternalName. "+
+			"NGINX resolves the external names at runtime, so enabling this allows Route owners to proxy traffic "+
+			"to any host, including hosts outside of the cluster.",
+	)
+
+	cmd.Flags().BoolVar(
+		&templateOverrides,
+		templateOverridesFlag,
+		false,
+		"Allow NginxProxy resources to override the templates of the server, upstream and location blocks of the "+
+			"generated NGINX config with the templates of a ConfigMap. NginxProxy resources that reference "+
+			"template overrides are not accepted unless this is enabled.",
+	)
+
+	cmd.Flags().Var(
+		&certExpiryWarningDays,
+		certExpiryWarningDaysFlag,
+		"The number of days before the certificate of a Listener expires when a Warning event is emitted for "+
+			"its Gateway. Set to 0 to disable the Warning events.",
+	)
+
+	cmd.Flags().Var(
+		&namespaceEventRate,
+		namespaceEventRateFlag,
+		"The number of events per second of the resources of a namespace that are processed right away. "+
+			"The events in excess of the rate are deferred, so that a namespace that changes its resources very often "+
+			"doesn't delay the reconfiguration for the other namespaces. Set to 0 to disable the rate limiting.",
+	)
+
+	cmd.Flags().Var(
+		&namespaceEventBurst,
+		namespaceEventBurstFlag,
+		"The number of events of the resources of a namespace that are processed right away in excess of "+
+			"the namespace event rate. Only used if the namespace event rate is set.",
+	)
+
+	cmd.Flags().BoolVar(
+		&dataPlaneAPI,
+		dataPlaneAPIFlag,
+		false,
+		"Enable the data plane API, a gRPC API that serves the generated NGINX configuration to NGINX instances "+
+			"outside of the cluster, like NGINX fleets on VMs. The API requires the client certificates of "+
+			"the data plane nodes, because the configuration includes the private keys of the Listeners.",
+	)
+
+	cmd.Flags().Var(
+		&dataPlaneAPIPort,
+		dataPlaneAPIPortFlag,
+		"Set the port where the data plane API server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().StringVar(
+		&dataPlaneAPICertDir,
+		dataPlaneAPICertDirFlag,
+		dataPlaneAPICertDir,
+		"The directory containing the TLS certificate (tls.crt) and key (tls.key) for the data plane API server, "+
+			"and the CA certificate (ca.crt) that verifies the client certificates of the data plane nodes.",
+	)
+
+	cmd.Flags().BoolVar(
+		&nginxErrorLogParser,
+		nginxErrorLogParserFlag,
+		false,
+		"Send the NGINX error log to the controller, which parses the upstream timeouts, the failed SSL handshakes "+
+			"and the limited requests and connections into structured logs and the nginx_errors_total metric.",
+	)
+
+	cmd.Flags().BoolVar(
+		&nginxErrorLogEvents,
+		nginxErrorLogEventsFlag,
+		false,
+		"Emit a Warning event for the Gateway for the parsed NGINX errors, at most one per minute for every kind "+
+			"of the errors. Requires the NGINX error log parser.",
+	)
+
+	cmd.Flags().BoolVar(
+		&coldStartSnapshot,
+		coldStartSnapshotFlag,
+		false,
+		"Persist the last applied NGINX configuration, and, on startup, program NGINX from it before the controller "+
+			"syncs with the cluster, so that NGINX serves traffic and the Pod is ready right away. The configuration "+
+			"is then reconciled with the cluster. The snapshot includes the private keys of the Listeners.",
+	)
+
+	cmd.Flags().StringVar(
+		&coldStartSnapshotDir,
+		coldStartSnapshotDirFlag,
+		coldStartSnapshotDir,
+		"The directory the cold start snapshot is persisted to. It must be on a persistent volume, "+
+			"so that the snapshot outlives the Pod.",
 	)
 
 	return cmd
 }
 
 func createProvisionerModeCommand() *cobra.Command {
+	// flag names
+	const (
+		safeToEvictFlag       = "safe-to-evict"
+		priorityClassNameFlag = "priority-class-name"
+		pdbMaxUnavailableFlag = "pdb-max-unavailable"
+	)
+
+	// flag values
 	var (
 		gatewayCtlrName = stringValidatingValue{
 			validator: validateGatewayControllerName,
@@ -439,6 +763,13 @@ func createProvisionerModeCommand() *cobra.Command {
 		gatewayClassName = stringValidatingValue{
 			validator: validateResourceName,
 		}
+		safeToEvict       bool
+		priorityClassName = stringValidatingValue{
+			validator: validateResourceName,
+		}
+		pdbMaxUnavailable = intValidatingValue{
+			validator: validateNonNegative,
+		}
 	)
 
 	cmd := &cobra.Command{
@@ -460,6 +791,11 @@ func createProvisionerModeCommand() *cobra.Command {
 				Logger:           logger,
 				GatewayClassName: gatewayClassName.value,
 				GatewayCtlrName:  gatewayCtlrName.value,
+				Disruption: provisioner.DisruptionConfig{
+					SafeToEvict:       safeToEvict,
+					PriorityClassName: priorityClassName.value,
+					MaxUnavailable:    int32(pdbMaxUnavailable.value), //nolint:gosec // parsed as a 32-bit integer
+				},
 			})
 		},
 	}
@@ -478,6 +814,27 @@ func createProvisionerModeCommand() *cobra.Command {
 	)
 	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
 
+	cmd.Flags().BoolVar(
+		&safeToEvict,
+		safeToEvictFlag,
+		false,
+		"Annotate the provisioned Pods as safe to evict for the cluster autoscaler. Without the annotation, "+
+			"the cluster autoscaler doesn't scale down the nodes of the Pods, because the Pods have local storage.",
+	)
+
+	cmd.Flags().Var(
+		&priorityClassName,
+		priorityClassNameFlag,
+		"The name of the PriorityClass of the provisioned Pods.",
+	)
+
+	cmd.Flags().Var(
+		&pdbMaxUnavailable,
+		pdbMaxUnavailableFlag,
+		"The maximum number of the unavailable Pods of the PodDisruptionBudget that is created for every "+
+			"provisioned Deployment. If 0, no PodDisruptionBudget is created.",
+	)
+
 	return cmd
 }
 
@@ -560,7 +917,7 @@ func createInitializeCommand() *cobra.Command {
 
 			return initialize(initializeConfig{
 				fileManager:   file.NewStdLibOSFileManager(),
-				fileGenerator: ngxConfig.NewGeneratorImpl(plus, nil, logger.WithName("generator")),
+				fileGenerator: ngxConfig.NewGeneratorImpl(plus, nil, nil, logger.WithName("generator")),
 				logger:        logger,
 				plus:          plus,
 				collector:     dcc,
@@ -598,6 +955,135 @@ func createInitializeCommand() *cobra.Command {
 	return cmd
 }
 
+func createMigrateStorageVersionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-storage-versions",
+		Short: "Migrate the stored NGINX Gateway Fabric custom resources to the storage version of their CRDs",
+		Long: "Rewrites all NGINX Gateway Fabric custom resources so that they are stored in the current storage " +
+			"version of their CustomResourceDefinition, and then removes the previous versions from the " +
+			"status.storedVersions of the CustomResourceDefinition. Run it after upgrading the CRDs, " +
+			"before a previous version is removed from a CRD.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := ctlrZap.New()
+			klog.SetLogger(logger)
+			log.SetLogger(logger)
+
+			logger.Info("Starting storage version migration", "group", domain)
+
+			scheme := runtime.NewScheme()
+			if err := apiext.AddToScheme(scheme); err != nil {
+				return fmt.Errorf("error adding CRD types to the scheme: %w", err)
+			}
+
+			k8sClient, err := client.New(ctlr.GetConfigOrDie(), client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("unable to initialize k8s client: %w", err)
+			}
+
+			migrator := storageversion.NewMigrator(k8sClient, logger.WithName("storageVersionMigrator"))
+
+			return migrator.MigrateGroup(cmd.Context(), domain)
+		},
+	}
+
+	return cmd
+}
+
+func createPreflightCommand() *cobra.Command {
+	// flag names
+	const installedConfigurationFlag = "installed-configuration"
+	const targetConfigurationFlag = "target-configuration"
+
+	// flag values
+	var installedConfigurationFile, targetConfigurationFile string
+
+	cmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Check the cluster before upgrading NGINX Gateway Fabric to this version",
+		Long: "Checks the installed Gateway API CRDs, the deprecated versions and fields of the NGINX Gateway Fabric " +
+			"CRDs and the changed defaults of this version against the cluster, and optionally compares the NGINX " +
+			"configuration generated by the installed version and this version. Prints the report in JSON " +
+			"and fails if any of the findings blocks the upgrade.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			targetCRDs, err := preflight.ParseCRDs(embeddedfiles.CRDsYAML)
+			if err != nil {
+				return fmt.Errorf("error parsing the CRDs of this version: %w", err)
+			}
+
+			var installedConfiguration, targetConfiguration []byte
+			if installedConfigurationFile != "" {
+				if installedConfiguration, err = os.ReadFile(installedConfigurationFile); err != nil {
+					return fmt.Errorf("error reading the installed configuration: %w", err)
+				}
+			}
+			if targetConfigurationFile != "" {
+				if targetConfiguration, err = os.ReadFile(targetConfigurationFile); err != nil {
+					return fmt.Errorf("error reading the target configuration: %w", err)
+				}
+			}
+
+			scheme := runtime.NewScheme()
+			if err := apiext.AddToScheme(scheme); err != nil {
+				return fmt.Errorf("error adding CRD types to the scheme: %w", err)
+			}
+
+			k8sReader, err := client.New(ctlr.GetConfigOrDie(), client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("unable to initialize k8s client: %w", err)
+			}
+
+			checker := preflight.NewChecker(preflight.Config{
+				K8sReader:              k8sReader,
+				InstalledConfiguration: installedConfiguration,
+				TargetConfiguration:    targetConfiguration,
+				TargetVersion:          version,
+				Group:                  domain,
+				TargetCRDs:             targetCRDs,
+			})
+
+			report, err := checker.Run(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			data, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return fmt.Errorf("error marshaling the report: %w", err)
+			}
+
+			if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(data)); err != nil {
+				return fmt.Errorf("error writing the report: %w", err)
+			}
+
+			if !report.Passed {
+				return errors.New("preflight checks failed")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&installedConfigurationFile,
+		installedConfigurationFlag,
+		"",
+		"The file with the NGINX configuration generated by the installed version, as served by the "+
+			"/debug/rendered-configuration endpoint of its metrics server. Requires --"+targetConfigurationFlag,
+	)
+
+	cmd.Flags().StringVar(
+		&targetConfigurationFile,
+		targetConfigurationFlag,
+		"",
+		"The file with the NGINX configuration generated by this version, as served by the "+
+			"/debug/rendered-configuration endpoint of its metrics server. Requires --"+installedConfigurationFlag,
+	)
+
+	cmd.MarkFlagsRequiredTogether(installedConfigurationFlag, targetConfigurationFlag)
+
+	return cmd
+}
+
 func parseFlags(flags *pflag.FlagSet) ([]string, []string) {
 	var flagKeys, flagValues []string
 
@@ -142,6 +142,7 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
 				"--gatewayclass=nginx",                                // common and required flag
 				"--gateway=nginx-gateway/nginx",
+				"--gateway-selector=ngf-instance=blue",
 				"--config=nginx-gateway-config",
 				"--service=nginx-gateway",
 				"--update-gatewayclass-status=true",
@@ -159,6 +160,27 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--usage-report-ca-secret=ca-secret",
 				"--usage-report-client-ssl-secret=client-secret",
 				"--snippets-filters",
+				"--snippets-filters-require-artifact-signatures",
+				"--conversion-webhook",
+				"--conversion-webhook-port=9444",
+				"--conversion-webhook-cert-dir=/tmp/webhook",
+				"--conversion-webhook-service=my-webhook",
+				"--nginx-config-dump",
+				"--nginx-config-dump-configmap=my-nginx-config",
+				"--nginx-validator",
+				"--nginx-validator-port=8096",
+				"--external-name-services",
+				"--template-overrides",
+				"--certificate-expiry-warning-days=14",
+				"--namespace-event-rate=10",
+				"--namespace-event-burst=50",
+				"--dataplane-api",
+				"--dataplane-api-port=9446",
+				"--dataplane-api-cert-dir=/tmp/dataplane-api",
+				"--nginx-error-log-parser",
+				"--nginx-error-log-events",
+				"--cold-start-snapshot",
+				"--cold-start-snapshot-dir=/tmp/snapshot",
 			},
 			wantErr: false,
 		},
@@ -187,6 +209,22 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "nginx-gateway" for "--gateway" flag: invalid format; ` +
 				"must be NAMESPACE/NAME",
 		},
+		{
+			name: "gateway-selector is set to empty string",
+			args: []string{
+				"--gateway-selector=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--gateway-selector" flag: must be set`,
+		},
+		{
+			name: "gateway-selector is invalid",
+			args: []string{
+				"--gateway-selector=ngf-instance in",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "ngf-instance in" for "--gateway-selector" flag: invalid format`,
+		},
 		{
 			name: "config is set to empty string",
 			args: []string{
@@ -405,6 +443,139 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "snippets-filters-require-artifact-signatures is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--snippets-filters-require-artifact-signatures" ` +
+				`flag: strconv.ParseBool: parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--snippets-filters-require-artifact-signatures=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "conversion-webhook is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--conversion-webhook" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--conversion-webhook=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "conversion-webhook-port is outside of range",
+			args: []string{
+				"--conversion-webhook-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--conversion-webhook-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "conversion-webhook-service is set to invalid string",
+			args: []string{
+				"--conversion-webhook-service=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--conversion-webhook-service" flag: invalid format`,
+		},
+		{
+			name: "nginx-config-dump is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--nginx-config-dump" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--nginx-config-dump=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "nginx-config-dump-configmap is set to invalid string",
+			args: []string{
+				"--nginx-config-dump-configmap=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--nginx-config-dump-configmap" flag: invalid format`,
+		},
+		{
+			name: "nginx-validator is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--nginx-validator" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--nginx-validator=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "external-name-services is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--external-name-services" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--external-name-services=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "template-overrides is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--template-overrides" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--template-overrides=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "nginx-validator-port is outside of the valid port range",
+			args: []string{
+				"--nginx-validator-port=999",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--nginx-validator-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "certificate-expiry-warning-days is negative",
+			args: []string{
+				"--certificate-expiry-warning-days=-1",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "-1" for "--certificate-expiry-warning-days" flag:` +
+				` value must not be negative: -1`,
+		},
+		{
+			name: "namespace-event-rate is negative",
+			args: []string{
+				"--namespace-event-rate=-1",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "-1" for "--namespace-event-rate" flag:` +
+				` value must not be negative: -1`,
+		},
+		{
+			name: "namespace-event-burst is zero",
+			args: []string{
+				"--namespace-event-burst=0",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "0" for "--namespace-event-burst" flag:` +
+				` value must be positive: 0`,
+		},
+		{
+			name: "dataplane-api-port is outside of the valid port range",
+			args: []string{
+				"--dataplane-api-port=999",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--dataplane-api-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "nginx-error-log-parser is not a bool",
+			args: []string{
+				"--nginx-error-log-parser=not-a-bool",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--nginx-error-log-parser" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+		},
 	}
 
 	// common flags validation is tested separately
@@ -420,18 +591,57 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 
 func TestProvisionerModeCmdFlagValidation(t *testing.T) {
 	t.Parallel()
-	testCase := flagTestCase{
-		name: "valid flags",
-		args: []string{
-			"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
-			"--gatewayclass=nginx",                                // common and required flag
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid disruption flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--safe-to-evict",
+				"--priority-class-name=high-priority",
+				"--pdb-max-unavailable=1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "priority-class-name is invalid",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--priority-class-name=!@#$",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--priority-class-name" flag: invalid format`,
+		},
+		{
+			name: "pdb-max-unavailable is negative",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--pdb-max-unavailable=-1",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-1" for "--pdb-max-unavailable" flag: value must not be negative`,
 		},
-		wantErr: false,
 	}
 
 	// common flags validation is tested separately
 
-	testFlag(t, createProvisionerModeCommand(), testCase)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createProvisionerModeCommand()
+			testFlag(t, cmd, test)
+		})
+	}
 }
 
 func TestSleepCmdFlagValidation(t *testing.T) {
@@ -522,6 +732,42 @@ func TestInitializeCmdFlagValidation(t *testing.T) {
 	}
 }
 
+func TestPreflightCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--installed-configuration=/installed.json",
+				"--target-configuration=/target.json",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "omitted flags",
+			args:    nil,
+			wantErr: false,
+		},
+		{
+			name: "installed configuration set without target configuration",
+			args: []string{
+				"--installed-configuration=/installed.json",
+			},
+			wantErr: true,
+			expectedErrPrefix: "if any flags in the group [installed-configuration target-configuration] " +
+				"are set they must all be set; missing [target-configuration]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createPreflightCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
+
 func TestParseFlags(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -25,6 +25,8 @@ func main() {
 		createProvisionerModeCommand(),
 		createInitializeCommand(),
 		createSleepCommand(),
+		createMigrateStorageVersionsCommand(),
+		createPreflightCommand(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
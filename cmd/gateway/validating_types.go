@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -84,3 +85,30 @@ func (v *namespacedNameValue) Set(param string) error {
 func (v *namespacedNameValue) Type() string {
 	return "string"
 }
+
+// labelSelectorValue is a string flag value that represents a label selector.
+// it implements the pflag.Value interface.
+type labelSelectorValue struct {
+	value labels.Selector
+}
+
+func (v *labelSelectorValue) String() string {
+	if v.value == nil {
+		return ""
+	}
+	return v.value.String()
+}
+
+func (v *labelSelectorValue) Set(param string) error {
+	selector, err := parseLabelSelector(param)
+	if err != nil {
+		return err
+	}
+
+	v.value = selector
+	return nil
+}
+
+func (v *labelSelectorValue) Type() string {
+	return "string"
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -89,6 +90,19 @@ func parseNamespacedResourceName(value string) (types.NamespacedName, error) {
 	}, nil
 }
 
+func parseLabelSelector(value string) (labels.Selector, error) {
+	if value == "" {
+		return nil, errors.New("must be set")
+	}
+
+	selector, err := labels.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format: %w", err)
+	}
+
+	return selector, nil
+}
+
 func validateQualifiedName(name string) error {
 	if len(name) == 0 {
 		return errors.New("must be set")
@@ -192,6 +206,22 @@ func validatePort(port int) error {
 	return nil
 }
 
+// validateNonNegative makes sure a given value is not negative.
+func validateNonNegative(value int) error {
+	if value < 0 {
+		return fmt.Errorf("value must not be negative: %v", value)
+	}
+	return nil
+}
+
+// validatePositive makes sure a given value is positive.
+func validatePositive(value int) error {
+	if value < 1 {
+		return fmt.Errorf("value must be positive: %v", value)
+	}
+	return nil
+}
+
 // ensureNoPortCollisions checks if the same port has been defined multiple times.
 func ensureNoPortCollisions(ports ...int) error {
 	seen := make(map[int]struct{})
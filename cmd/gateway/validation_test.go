@@ -263,6 +263,59 @@ func TestParseNamespacedResourceName(t *testing.T) {
 	}
 }
 
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		value             string
+		expectedErrPrefix string
+		expectedSelector  string
+		expectErr         bool
+	}{
+		{
+			name:             "valid equality-based",
+			value:            "ngf-instance=blue",
+			expectedSelector: "ngf-instance=blue",
+			expectErr:        false,
+		},
+		{
+			name:             "valid set-based",
+			value:            "ngf-instance in (blue,green),!canary",
+			expectedSelector: "!canary,ngf-instance in (blue,green)",
+			expectErr:        false,
+		},
+		{
+			name:              "empty",
+			value:             "",
+			expectErr:         true,
+			expectedErrPrefix: "must be set",
+		},
+		{
+			name:              "invalid",
+			value:             "ngf-instance in",
+			expectErr:         true,
+			expectedErrPrefix: "invalid format",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			selector, err := parseLabelSelector(test.value)
+
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(HavePrefix(test.expectedErrPrefix))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(selector.String()).To(Equal(test.expectedSelector))
+			}
+		})
+	}
+}
+
 func TestValidateQualifiedName(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -546,6 +599,24 @@ func TestValidatePort(t *testing.T) {
 	}
 }
 
+func TestValidateNonNegative(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateNonNegative(0)).To(Succeed())
+	g.Expect(validateNonNegative(1)).To(Succeed())
+	g.Expect(validateNonNegative(-1)).ToNot(Succeed())
+}
+
+func TestValidatePositive(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validatePositive(1)).To(Succeed())
+	g.Expect(validatePositive(0)).ToNot(Succeed())
+	g.Expect(validatePositive(-1)).ToNot(Succeed())
+}
+
 func TestEnsureNoPortCollisions(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
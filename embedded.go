@@ -9,3 +9,9 @@ import _ "embed"
 //
 //go:embed config/tests/static-deployment.yaml
 var StaticModeDeploymentYAML []byte
+
+// CRDsYAML contains the YAML manifests of the NGINX Gateway Fabric CustomResourceDefinitions of this version.
+// The preflight command compares them with the installed CRDs.
+//
+//go:embed deploy/crds.yaml
+var CRDsYAML []byte
@@ -0,0 +1,43 @@
+package predicate
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// LabelSelectorPredicate implements a predicate function based on the labels of a resource.
+//
+// This predicate will skip the following events:
+// 1. Create events for resources whose labels don't match the Selector.
+// 2. Update events where neither the old nor the new labels of the resource match the Selector.
+//
+// Update events where only the old labels match are not skipped, so that the resource can be excluded from
+// processing once its labels no longer match. Delete events are never skipped, because the last known labels of
+// the resource might not match the Selector even though the resource was processed before.
+type LabelSelectorPredicate struct {
+	predicate.Funcs
+	Selector labels.Selector
+}
+
+// Create filters CreateEvents based on the labels of the resource.
+func (lp LabelSelectorPredicate) Create(e event.CreateEvent) bool {
+	if e.Object == nil {
+		return false
+	}
+
+	return lp.Selector.Matches(labels.Set(e.Object.GetLabels()))
+}
+
+// Update filters UpdateEvents based on the old and the new labels of the resource.
+func (lp LabelSelectorPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld != nil && lp.Selector.Matches(labels.Set(e.ObjectOld.GetLabels())) {
+		return true
+	}
+
+	if e.ObjectNew != nil && lp.Selector.Matches(labels.Set(e.ObjectNew.GetLabels())) {
+		return true
+	}
+
+	return false
+}
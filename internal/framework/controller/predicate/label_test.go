@@ -0,0 +1,42 @@
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestLabelSelectorPredicate(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	p := LabelSelectorPredicate{Selector: labels.SelectorFromSet(labels.Set{"instance": "blue"})}
+
+	blue := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"instance": "blue"},
+		},
+	}
+	green := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"instance": "green"},
+		},
+	}
+
+	g.Expect(p.Create(event.CreateEvent{Object: blue})).To(BeTrue())
+	g.Expect(p.Create(event.CreateEvent{Object: green})).To(BeFalse())
+	g.Expect(p.Create(event.CreateEvent{Object: nil})).To(BeFalse())
+
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: blue, ObjectNew: blue})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: blue, ObjectNew: green})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: green, ObjectNew: blue})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: green, ObjectNew: green})).To(BeFalse())
+	g.Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+
+	g.Expect(p.Delete(event.DeleteEvent{Object: blue})).To(BeTrue())
+	g.Expect(p.Delete(event.DeleteEvent{Object: green})).To(BeTrue())
+}
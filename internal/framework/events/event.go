@@ -23,3 +23,16 @@ type DeleteEvent struct {
 	// NamespacedName is the namespace & name of the deleted resource.
 	NamespacedName types.NamespacedName
 }
+
+// ArtifactUpdateEvent represents an update of the OCI artifacts that are fetched in the background.
+// It doesn't carry the artifacts: the handler of the event requests them again from the fetcher.
+type ArtifactUpdateEvent struct{}
+
+// SmokeTestDoneEvent represents the completion of the smoke test probes that are sent in the background after
+// the NGINX configuration is updated.
+type SmokeTestDoneEvent struct {
+	// Failures are the failures of the probes that didn't get the expected responses.
+	Failures []string
+	// Version is the version of the NGINX configuration that the probes were sent to.
+	Version int
+}
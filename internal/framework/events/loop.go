@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 )
@@ -22,11 +23,16 @@ import (
 // FIXME(pleshakov): better document the side effects and how to prevent and mitigate them.
 // So when the EventLoop have 100 saved events, it is better to process them at once rather than one by one.
 // https://github.com/nginx/nginx-gateway-fabric/issues/551
+//
+// If the EventLoop has a NamespaceRateLimiter, the events of the namespaces that exceed their rate are not added
+// to the next batch right away. They are added to a later batch when the NamespaceRateLimiter releases them.
 type EventLoop struct {
 	handler  EventHandler
 	preparer FirstEventBatchPreparer
 	eventCh  <-chan interface{}
 	logger   logr.Logger
+	// limiter limits the rate of the events of every namespace. If nil, the events are not limited.
+	limiter *NamespaceRateLimiter
 
 	// The EventLoop uses double buffering to handle event batch processing.
 	// The goroutine that handles the batch will always read from the currentBatch slice.
@@ -39,18 +45,20 @@ type EventLoop struct {
 	currentBatchID int
 }
 
-// NewEventLoop creates a new EventLoop.
+// NewEventLoop creates a new EventLoop. The limiter is optional.
 func NewEventLoop(
 	eventCh <-chan interface{},
 	logger logr.Logger,
 	handler EventHandler,
 	preparer FirstEventBatchPreparer,
+	limiter *NamespaceRateLimiter,
 ) *EventLoop {
 	return &EventLoop{
 		eventCh:      eventCh,
 		logger:       logger,
 		handler:      handler,
 		preparer:     preparer,
+		limiter:      limiter,
 		currentBatch: make(EventBatch, 0),
 		nextBatch:    make(EventBatch, 0),
 	}
@@ -63,6 +71,8 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	var handling bool
 	// handlingDone is used to signal the completion of handling a batch.
 	handlingDone := make(chan struct{})
+	// releaseTimer signals that the limiter can release deferred events. It is nil if there are no deferred events.
+	var releaseTimer <-chan time.Time
 
 	handleBatch := func() {
 		go func(batch EventBatch) {
@@ -117,6 +127,13 @@ func (el *EventLoop) Start(ctx context.Context) error {
 			}
 			return nil
 		case e := <-el.eventCh:
+			if el.limiter != nil && !el.limiter.Admit(e, time.Now()) {
+				el.logger.V(1).Info("deferred an event of a throttled namespace", "type", fmt.Sprintf("%T", e))
+
+				releaseTimer = el.newReleaseTimer()
+				continue
+			}
+
 			// Add the event to the current batch.
 			el.nextBatch = append(el.nextBatch, e)
 
@@ -130,6 +147,20 @@ func (el *EventLoop) Start(ctx context.Context) error {
 			if !handling {
 				swapAndHandleBatch()
 			}
+		case <-releaseTimer:
+			released := el.limiter.Release(time.Now())
+			el.nextBatch = append(el.nextBatch, released...)
+			releaseTimer = el.newReleaseTimer()
+
+			el.logger.V(1).Info(
+				"added the released events of throttled namespaces to the next batch",
+				"released", len(released),
+				"total", len(el.nextBatch),
+			)
+
+			if !handling && len(el.nextBatch) > 0 {
+				swapAndHandleBatch()
+			}
 		case <-handlingDone:
 			handling = false
 
@@ -141,6 +172,17 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	}
 }
 
+// newReleaseTimer returns a channel that receives when the limiter can release the next deferred event,
+// or nil if there are no deferred events.
+func (el *EventLoop) newReleaseTimer() <-chan time.Time {
+	delay, ok := el.limiter.NextRelease(time.Now())
+	if !ok {
+		return nil
+	}
+
+	return time.After(delay)
+}
+
 // swapBatches swaps the current and next batches.
 func (el *EventLoop) swapBatches() {
 	el.currentBatch, el.nextBatch = el.nextBatch, el.currentBatch
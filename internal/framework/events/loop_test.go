@@ -8,6 +8,8 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events/eventsfakes"
@@ -27,7 +29,7 @@ var _ = Describe("EventLoop", func() {
 		eventCh = make(chan interface{})
 		fakePreparer = &eventsfakes.FakeFirstEventBatchPreparer{}
 
-		eventLoop = events.NewEventLoop(eventCh, logr.Discard(), fakeHandler, fakePreparer)
+		eventLoop = events.NewEventLoop(eventCh, logr.Discard(), fakeHandler, fakePreparer, nil)
 
 		errorCh = make(chan error)
 	})
@@ -119,6 +121,42 @@ var _ = Describe("EventLoop", func() {
 		})
 	})
 
+	Describe("Namespace rate limiting", func() {
+		It("should defer the events of a namespace that exceeds its rate to a later batch", func(ctx SpecContext) {
+			fakeMetrics := &eventsfakes.FakeThrottleMetricsCollector{}
+			limiter := events.NewNamespaceRateLimiter(20, 1, fakeMetrics)
+			eventLoop = events.NewEventLoop(eventCh, logr.Discard(), fakeHandler, fakePreparer, limiter)
+			fakePreparer.PrepareReturns(events.EventBatch{}, nil)
+
+			loopCtx, cancel := context.WithCancel(ctx)
+			go func() {
+				errorCh <- eventLoop.Start(loopCtx)
+			}()
+			DeferCleanup(func(dctx SpecContext) {
+				cancel()
+				Eventually(errorCh).WithContext(dctx).Should(Receive(BeNil()))
+			}, NodeTimeout(time.Second*10))
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(1))
+
+			e1 := &events.UpsertEvent{Resource: &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "r1"}}}
+			e2 := &events.UpsertEvent{Resource: &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "r2"}}}
+
+			eventCh <- e1
+			eventCh <- e2
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(3))
+
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+			Expect(batch).To(Equal(events.EventBatch{e1}))
+
+			_, _, batch = fakeHandler.HandleEventBatchArgsForCall(2)
+			Expect(batch).To(Equal(events.EventBatch{e2}))
+
+			Expect(fakeMetrics.IncThrottledEventsCallCount()).To(Equal(1))
+		})
+	})
+
 	Describe("Edge cases", func() {
 		It("should return error when preparer returns error without blocking", func(ctx SpecContext) {
 			preparerError := errors.New("test")
@@ -0,0 +1,199 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+//counterfeiter:generate . ThrottleMetricsCollector
+
+// ThrottleMetricsCollector collects the metrics of the namespaces whose events are throttled.
+type ThrottleMetricsCollector interface {
+	// IncThrottledEvents increments the number of the deferred events of the namespace.
+	IncThrottledEvents(namespace string)
+	// SetThrottledNamespaces sets the number of the namespaces with deferred events.
+	SetThrottledNamespaces(count int)
+}
+
+// NamespaceRateLimiter limits the rate of the events of every namespace, so that a namespace that changes its
+// resources very often doesn't delay the handling of the events of the other namespaces.
+//
+// The events of a namespace that exceed its rate are deferred. The deferred events are released in a round-robin
+// order of the namespaces as the rate of every namespace allows. While an event of a resource is deferred, a newer
+// event of the same resource replaces it, so the deferred events of a namespace don't grow beyond the number of
+// its resources. The events of cluster-scoped resources and the events without a resource are never deferred.
+type NamespaceRateLimiter struct {
+	metrics  ThrottleMetricsCollector
+	limiters map[string]*rate.Limiter
+	deferred map[string]*deferredEvents
+	// namespaces holds the namespaces with deferred events in the order of their release.
+	namespaces []string
+	limit      rate.Limit
+	burst      int
+}
+
+// deferredEvents are the deferred events of a namespace.
+type deferredEvents struct {
+	// positions maps the keys of the resources to the positions of their events in the events slice.
+	positions map[string]int
+	events    []interface{}
+	// head is the position of the first event that is not released yet.
+	head int
+}
+
+// NewNamespaceRateLimiter creates a new NamespaceRateLimiter that allows eventsPerSecond events of every namespace
+// with bursts of up to burst events.
+func NewNamespaceRateLimiter(
+	eventsPerSecond float64,
+	burst int,
+	metrics ThrottleMetricsCollector,
+) *NamespaceRateLimiter {
+	return &NamespaceRateLimiter{
+		metrics:  metrics,
+		limiters: make(map[string]*rate.Limiter),
+		deferred: make(map[string]*deferredEvents),
+		limit:    rate.Limit(eventsPerSecond),
+		burst:    burst,
+	}
+}
+
+// Admit returns true if the event can be handled now. Otherwise, the event is deferred until it is released
+// by Release.
+// The events of a namespace with deferred events are always deferred, so that the order of the events is preserved.
+func (l *NamespaceRateLimiter) Admit(event interface{}, now time.Time) bool {
+	namespace, key := eventKey(event)
+	if namespace == "" {
+		return true
+	}
+
+	if _, exists := l.deferred[namespace]; !exists && l.limiter(namespace).AllowN(now, 1) {
+		return true
+	}
+
+	l.deferEvent(namespace, key, event)
+	l.metrics.IncThrottledEvents(namespace)
+	l.metrics.SetThrottledNamespaces(len(l.deferred))
+
+	return false
+}
+
+// Release returns the deferred events that the rates of their namespaces allow to handle now.
+// The events are taken from the namespaces one by one in a round-robin order.
+func (l *NamespaceRateLimiter) Release(now time.Time) EventBatch {
+	var batch EventBatch
+
+	for released := true; released; {
+		released = false
+
+		for _, namespace := range l.namespaces {
+			deferred := l.deferred[namespace]
+			if deferred.empty() || !l.limiter(namespace).AllowN(now, 1) {
+				continue
+			}
+
+			batch = append(batch, deferred.pop())
+			released = true
+		}
+	}
+
+	namespaces := l.namespaces[:0]
+	for _, namespace := range l.namespaces {
+		if l.deferred[namespace].empty() {
+			delete(l.deferred, namespace)
+			continue
+		}
+
+		namespaces = append(namespaces, namespace)
+	}
+
+	l.namespaces = namespaces
+	l.metrics.SetThrottledNamespaces(len(l.deferred))
+
+	return batch
+}
+
+// NextRelease returns the time until the next deferred event can be released.
+// It returns false if there are no deferred events.
+func (l *NamespaceRateLimiter) NextRelease(now time.Time) (time.Duration, bool) {
+	if len(l.namespaces) == 0 {
+		return 0, false
+	}
+
+	var next time.Duration
+	for i, namespace := range l.namespaces {
+		reservation := l.limiter(namespace).ReserveN(now, 1)
+		delay := reservation.DelayFrom(now)
+		reservation.CancelAt(now)
+
+		if i == 0 || delay < next {
+			next = delay
+		}
+	}
+
+	return next, true
+}
+
+func (l *NamespaceRateLimiter) limiter(namespace string) *rate.Limiter {
+	limiter, exists := l.limiters[namespace]
+	if !exists {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[namespace] = limiter
+	}
+
+	return limiter
+}
+
+func (l *NamespaceRateLimiter) deferEvent(namespace, key string, event interface{}) {
+	deferred, exists := l.deferred[namespace]
+	if !exists {
+		deferred = &deferredEvents{positions: make(map[string]int)}
+		l.deferred[namespace] = deferred
+		l.namespaces = append(l.namespaces, namespace)
+	}
+
+	deferred.push(key, event)
+}
+
+// push adds the event to the end of the deferred events, or replaces the deferred event of the same resource.
+func (d *deferredEvents) push(key string, event interface{}) {
+	if pos, exists := d.positions[key]; exists {
+		d.events[pos] = event
+		return
+	}
+
+	d.positions[key] = len(d.events)
+	d.events = append(d.events, event)
+}
+
+// pop removes the first deferred event and returns it.
+func (d *deferredEvents) pop() interface{} {
+	event := d.events[d.head]
+	d.events[d.head] = nil
+	d.head++
+
+	_, key := eventKey(event)
+	delete(d.positions, key)
+
+	return event
+}
+
+func (d *deferredEvents) empty() bool {
+	return d.head == len(d.events)
+}
+
+// eventKey returns the namespace of the resource of the event and the key of the resource, which is unique
+// among the resources of all types. For the events of cluster-scoped resources and the events without a resource,
+// the namespace is empty.
+func eventKey(event interface{}) (namespace, key string) {
+	switch e := event.(type) {
+	case *UpsertEvent:
+		namespace = e.Resource.GetNamespace()
+		return namespace, fmt.Sprintf("%T/%s/%s", e.Resource, namespace, e.Resource.GetName())
+	case *DeleteEvent:
+		return e.NamespacedName.Namespace, fmt.Sprintf("%T/%s", e.Type, e.NamespacedName)
+	default:
+		return "", ""
+	}
+}
@@ -0,0 +1,110 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/events/eventsfakes"
+)
+
+func createUpsertEvent(namespace, name string, generation int64) *events.UpsertEvent {
+	return &events.UpsertEvent{
+		Resource: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: generation},
+		},
+	}
+}
+
+func TestNamespaceRateLimiter_Admit(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now()
+	fakeMetrics := &eventsfakes.FakeThrottleMetricsCollector{}
+	limiter := events.NewNamespaceRateLimiter(1, 2, fakeMetrics)
+
+	g.Expect(limiter.Admit(createUpsertEvent("test", "route1", 1), now)).To(BeTrue())
+	g.Expect(limiter.Admit(createUpsertEvent("test", "route2", 1), now)).To(BeTrue())
+	g.Expect(fakeMetrics.IncThrottledEventsCallCount()).To(BeZero())
+
+	// the burst of the namespace is exhausted
+	g.Expect(limiter.Admit(createUpsertEvent("test", "route3", 1), now)).To(BeFalse())
+	g.Expect(fakeMetrics.IncThrottledEventsCallCount()).To(Equal(1))
+	g.Expect(fakeMetrics.IncThrottledEventsArgsForCall(0)).To(Equal("test"))
+	g.Expect(fakeMetrics.SetThrottledNamespacesArgsForCall(0)).To(Equal(1))
+
+	// the events of the other namespaces, of cluster-scoped resources, and without a resource are not limited
+	g.Expect(limiter.Admit(createUpsertEvent("other", "route1", 1), now)).To(BeTrue())
+	g.Expect(limiter.Admit(&events.UpsertEvent{Resource: &v1.GatewayClass{}}, now)).To(BeTrue())
+	g.Expect(limiter.Admit(&events.ArtifactUpdateEvent{}, now)).To(BeTrue())
+
+	// the events of a namespace with deferred events are deferred even if the rate allows them
+	later := now.Add(time.Second)
+	g.Expect(limiter.Admit(createUpsertEvent("test", "route4", 1), later)).To(BeFalse())
+	g.Expect(fakeMetrics.IncThrottledEventsCallCount()).To(Equal(2))
+
+	released := limiter.Release(later)
+	g.Expect(released).To(Equal(events.EventBatch{createUpsertEvent("test", "route3", 1)}))
+}
+
+func TestNamespaceRateLimiter_Release(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now()
+	fakeMetrics := &eventsfakes.FakeThrottleMetricsCollector{}
+	limiter := events.NewNamespaceRateLimiter(1, 1, fakeMetrics)
+
+	_, ok := limiter.NextRelease(now)
+	g.Expect(ok).To(BeFalse())
+	g.Expect(limiter.Release(now)).To(BeEmpty())
+
+	deleteEvent := &events.DeleteEvent{
+		Type:           &v1.HTTPRoute{},
+		NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "route2"},
+	}
+
+	for _, e := range []interface{}{
+		createUpsertEvent("ns1", "route1", 1),
+		createUpsertEvent("ns1", "route1", 2),
+		createUpsertEvent("ns1", "route1", 3),
+		createUpsertEvent("ns2", "route1", 1),
+		createUpsertEvent("ns1", "route2", 1),
+		createUpsertEvent("ns2", "route2", 1),
+		deleteEvent,
+	} {
+		limiter.Admit(e, now)
+	}
+
+	// the first event of every namespace is admitted, the rest are deferred
+	g.Expect(fakeMetrics.IncThrottledEventsCallCount()).To(Equal(5))
+
+	delay, ok := limiter.NextRelease(now)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(delay).To(Equal(time.Second))
+
+	g.Expect(limiter.Release(now)).To(BeEmpty())
+
+	// one event of every namespace is released, and the deferred events of a resource are replaced by the newest one
+	released := limiter.Release(now.Add(time.Second))
+	g.Expect(released).To(Equal(events.EventBatch{
+		createUpsertEvent("ns1", "route1", 3),
+		createUpsertEvent("ns2", "route2", 1),
+	}))
+	g.Expect(fakeMetrics.SetThrottledNamespacesArgsForCall(fakeMetrics.SetThrottledNamespacesCallCount() - 1)).
+		To(Equal(1))
+
+	released = limiter.Release(now.Add(2 * time.Second))
+	g.Expect(released).To(Equal(events.EventBatch{deleteEvent}))
+	g.Expect(fakeMetrics.SetThrottledNamespacesArgsForCall(fakeMetrics.SetThrottledNamespacesCallCount() - 1)).
+		To(Equal(0))
+
+	_, ok = limiter.NextRelease(now.Add(2 * time.Second))
+	g.Expect(ok).To(BeFalse())
+}
@@ -22,8 +22,11 @@ var gatewayCRDs = map[string]apiVersion{
 	"httproutes.gateway.networking.k8s.io":         {},
 	"referencegrants.gateway.networking.k8s.io":    {},
 	"backendtlspolicies.gateway.networking.k8s.io": {},
+	"backendlbpolicies.gateway.networking.k8s.io":  {},
 	"grpcroutes.gateway.networking.k8s.io":         {},
 	"tlsroutes.gateway.networking.k8s.io":          {},
+	"tcproutes.gateway.networking.k8s.io":          {},
+	"udproutes.gateway.networking.k8s.io":          {},
 }
 
 type apiVersion struct {
@@ -21,6 +21,12 @@ const (
 	GRPCRoute = "GRPCRoute"
 	// TLSRoute is the TLSRoute kind.
 	TLSRoute = "TLSRoute"
+	// TCPRoute is the TCPRoute kind.
+	TCPRoute = "TCPRoute"
+	// UDPRoute is the UDPRoute kind.
+	UDPRoute = "UDPRoute"
+	// BackendTLSPolicy is the BackendTLSPolicy kind.
+	BackendTLSPolicy = "BackendTLSPolicy"
 )
 
 // Core API Kinds.
@@ -39,8 +45,30 @@ const (
 	NginxProxy = "NginxProxy"
 	// SnippetsFilter is the SnippetsFilter kind.
 	SnippetsFilter = "SnippetsFilter"
+	// HTTPBodyTransform is the HTTPBodyTransform kind.
+	HTTPBodyTransform = "HTTPBodyTransform"
+	// DirectResponse is the DirectResponse kind.
+	DirectResponse = "DirectResponse"
+	// RouteTest is the RouteTest kind.
+	RouteTest = "RouteTest"
 	// UpstreamSettingsPolicy is the UpstreamSettingsPolicy kind.
 	UpstreamSettingsPolicy = "UpstreamSettingsPolicy"
+	// CacheControlPolicy is the CacheControlPolicy kind.
+	CacheControlPolicy = "CacheControlPolicy"
+	// StaticContentPolicy is the StaticContentPolicy kind.
+	StaticContentPolicy = "StaticContentPolicy"
+	// ListenerTLSPolicy is the ListenerTLSPolicy kind.
+	ListenerTLSPolicy = "ListenerTLSPolicy"
+	// ErrorPagePolicy is the ErrorPagePolicy kind.
+	ErrorPagePolicy = "ErrorPagePolicy"
+	// ResponseCompressionPolicy is the ResponseCompressionPolicy kind.
+	ResponseCompressionPolicy = "ResponseCompressionPolicy"
+	// CachePolicy is the CachePolicy kind.
+	CachePolicy = "CachePolicy"
+	// RateLimitPolicy is the RateLimitPolicy kind.
+	RateLimitPolicy = "RateLimitPolicy"
+	// ConnectionLimitPolicy is the ConnectionLimitPolicy kind.
+	ConnectionLimitPolicy = "ConnectionLimitPolicy"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.
@@ -46,7 +46,7 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 		)
 
 		BeforeAll(func() {
-			updater = NewLeaderAwareGroupUpdater(NewUpdater(k8sClient, logr.Discard()))
+			updater = NewLeaderAwareGroupUpdater(NewUpdater(k8sClient, logr.Discard(), NewNoopMetricsCollector()))
 
 			for _, name := range allGCNames {
 				gc := createGC(name)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -16,6 +17,19 @@ import (
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
 )
 
+// StatusPausedAnnotation pauses the status updates of the annotated resource when set to "true".
+// It allows users to temporarily stop NGF from writing the status of a resource, for example,
+// when the resource is migrated or imported by a GitOps tool that also manages its status.
+const StatusPausedAnnotation = "gateway.nginx.org/status-paused"
+
+//counterfeiter:generate . MetricsCollector
+
+// MetricsCollector collects the metrics of the status updates.
+type MetricsCollector interface {
+	// SetStatusPausedResources sets the number of the resources of the kind with paused status updates.
+	SetStatusPausedResources(kind string, count int)
+}
+
 // UpdateRequest is a request to update the status of a resource.
 type UpdateRequest struct {
 	ResourceType ngftypes.ObjectType
@@ -50,17 +64,23 @@ type Setter func(client.Object) (wasSet bool)
 // result of processing some other new change to a resource(s).
 // FIXME(pleshakov): https://github.com/nginx/nginx-gateway-fabric/issues/1813
 type Updater struct {
-	client client.Client
-	logger logr.Logger
+	client           client.Client
+	metricsCollector MetricsCollector
+	// pausedResources holds the resources with paused status updates by kind.
+	pausedResources map[string]map[types.NamespacedName]struct{}
+	logger          logr.Logger
+	lock            sync.Mutex
 }
 
 var ErrFailedAssert = errors.New("type assertion failed")
 
 // NewUpdater creates a new Updater.
-func NewUpdater(c client.Client, logger logr.Logger) *Updater {
+func NewUpdater(c client.Client, logger logr.Logger, metricsCollector MetricsCollector) *Updater {
 	return &Updater{
-		client: c,
-		logger: logger,
+		client:           c,
+		logger:           logger,
+		metricsCollector: metricsCollector,
+		pausedResources:  make(map[string]map[types.NamespacedName]struct{}),
 	}
 }
 
@@ -116,6 +136,61 @@ func (u *Updater) writeStatuses(
 			"name", nsname.Name,
 			"kind", resourceType.GetObjectKind().GroupVersionKind().Kind)
 	}
+
+	gvk, err := u.client.GroupVersionKindFor(obj)
+	if err != nil {
+		u.logger.Error(err, "Failed to get the kind of the resource to record if its status updates are paused")
+		return
+	}
+
+	// obj holds the latest version of the resource, which was fetched by the update function.
+	u.recordPaused(gvk.Kind, nsname, IsStatusPaused(obj))
+}
+
+// ResourceDeleted forgets the deleted resource, so that it is no longer counted in the metrics of the resources
+// with paused status updates.
+func (u *Updater) ResourceDeleted(resourceType ngftypes.ObjectType, nsname types.NamespacedName) {
+	gvk, err := u.client.GroupVersionKindFor(resourceType)
+	if err != nil {
+		u.logger.Error(err, "Failed to get the kind of the deleted resource to forget if its status updates are paused")
+		return
+	}
+
+	u.recordPaused(gvk.Kind, nsname, false)
+}
+
+// recordPaused records if the status updates of the resource are paused and updates the metrics.
+func (u *Updater) recordPaused(kind string, nsname types.NamespacedName, paused bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	resources, exists := u.pausedResources[kind]
+	if !exists {
+		if !paused {
+			return
+		}
+
+		resources = make(map[types.NamespacedName]struct{})
+		u.pausedResources[kind] = resources
+	}
+
+	_, wasPaused := resources[nsname]
+	if paused == wasPaused {
+		return
+	}
+
+	if paused {
+		resources[nsname] = struct{}{}
+	} else {
+		delete(resources, nsname)
+	}
+
+	u.metricsCollector.SetStatusPausedResources(kind, len(resources))
+}
+
+// IsStatusPaused returns true if the status updates of the resource are paused by the StatusPausedAnnotation.
+func IsStatusPaused(obj client.Object) bool {
+	return obj.GetAnnotations()[StatusPausedAnnotation] == "true"
 }
 
 // NewRetryUpdateFunc returns a function which will be used in wait.ExponentialBackoffWithContext.
@@ -160,6 +235,17 @@ func NewRetryUpdateFunc(
 			return false, nil
 		}
 
+		if IsStatusPaused(obj) {
+			logger.V(1).Info(
+				"Skipping status update because status updates are paused",
+				"namespace", nsname.Namespace,
+				"name", nsname.Name,
+				"kind", obj.GetObjectKind().GroupVersionKind().Kind,
+			)
+
+			return true, nil
+		}
+
 		if !statusSetter(obj) {
 			logger.V(1).Info(
 				"Skipping status update because there's no change",
@@ -186,3 +272,14 @@ func NewRetryUpdateFunc(
 		return true, nil
 	}
 }
+
+// NoopMetricsCollector is used to initialize the Updater when metrics are disabled, to avoid nil pointer errors.
+type NoopMetricsCollector struct{}
+
+// NewNoopMetricsCollector returns an instance of the NoopMetricsCollector.
+func NewNoopMetricsCollector() *NoopMetricsCollector {
+	return &NoopMetricsCollector{}
+}
+
+// SetStatusPausedResources implements a no-op SetStatusPausedResources.
+func (c *NoopMetricsCollector) SetStatusPausedResources(_ string, _ int) {}
@@ -8,6 +8,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +24,7 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 	tests := []struct {
 		getReturns          error
 		updateReturns       error
+		annotations         map[string]string
 		name                string
 		expUpdateCallCount  int
 		statusSetterReturns bool
@@ -60,6 +62,15 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 			name:                "status not set",
 			expConditionPassed:  true,
 		},
+		{
+			getReturns:          nil,
+			updateReturns:       nil,
+			annotations:         map[string]string{status.StatusPausedAnnotation: "true"},
+			statusSetterReturns: true,
+			expUpdateCallCount:  0,
+			name:                "status updates are paused",
+			expConditionPassed:  true,
+		},
 		{
 			getReturns:          nil,
 			updateReturns:       nil,
@@ -85,7 +96,7 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 				fakeGetter,
 				fakeStatusUpdater,
 				types.NamespacedName{},
-				&v1.GatewayClass{},
+				&v1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}},
 				logr.Discard(),
 				func(client.Object) bool { return test.statusSetterReturns },
 			)
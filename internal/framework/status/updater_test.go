@@ -116,7 +116,7 @@ var _ = Describe("Updater", func() {
 		)
 
 		BeforeAll(func() {
-			updater = NewUpdater(k8sClient, logr.Discard())
+			updater = NewUpdater(k8sClient, logr.Discard(), NewNoopMetricsCollector())
 
 			for _, name := range gcNames {
 				gc := createGC(name)
@@ -202,4 +202,73 @@ var _ = Describe("Updater", func() {
 			})
 		})
 	})
+
+	Describe("Paused status updates", Ordered, func() {
+		var (
+			updater   *Updater
+			collector *pausedResourcesRecorder
+		)
+
+		BeforeAll(func() {
+			collector = &pausedResourcesRecorder{counts: make(map[string]int)}
+			updater = NewUpdater(k8sClient, logr.Discard(), collector)
+
+			gc := createGC("paused")
+			gc.Annotations = map[string]string{StatusPausedAnnotation: "true"}
+			Expect(k8sClient.Create(context.Background(), gc)).Should(Succeed())
+		})
+
+		getStatus := func() v1.GatewayClassStatus {
+			var gc v1.GatewayClass
+
+			err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "paused"}, &gc)
+			Expect(err).ToNot(HaveOccurred())
+
+			return gc.Status
+		}
+
+		It("should not update the status of a GatewayClass with paused status updates", func() {
+			updater.Update(context.Background(), prepareReq("paused", "TestPaused", updateNeeded))
+
+			Expect(getStatus()).To(Equal(v1.GatewayClassStatus{}))
+			Expect(collector.counts).To(Equal(map[string]int{kinds.GatewayClass: 1}))
+		})
+
+		It("should update the status of a GatewayClass after status updates are resumed", func() {
+			var gc v1.GatewayClass
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "paused"}, &gc)).To(Succeed())
+
+			gc.Annotations = nil
+			Expect(k8sClient.Update(context.Background(), &gc)).To(Succeed())
+
+			updater.Update(context.Background(), prepareReq("paused", "TestResumed", updateNeeded))
+
+			Expect(getStatus()).To(Equal(createGCStatus("TestResumed")))
+			Expect(collector.counts).To(Equal(map[string]int{kinds.GatewayClass: 0}))
+		})
+
+		It("should forget a deleted GatewayClass with paused status updates", func() {
+			var gc v1.GatewayClass
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "paused"}, &gc)).To(Succeed())
+
+			gc.Annotations = map[string]string{StatusPausedAnnotation: "true"}
+			Expect(k8sClient.Update(context.Background(), &gc)).To(Succeed())
+
+			updater.Update(context.Background(), prepareReq("paused", "TestPausedAgain", updateNeeded))
+			Expect(collector.counts).To(Equal(map[string]int{kinds.GatewayClass: 1}))
+
+			Expect(k8sClient.Delete(context.Background(), &gc)).To(Succeed())
+
+			updater.ResourceDeleted(&v1.GatewayClass{}, types.NamespacedName{Name: "paused"})
+			Expect(collector.counts).To(Equal(map[string]int{kinds.GatewayClass: 0}))
+		})
+	})
 })
+
+type pausedResourcesRecorder struct {
+	counts map[string]int
+}
+
+func (r *pausedResourcesRecorder) SetStatusPausedResources(kind string, count int) {
+	r.counts[kind] = count
+}
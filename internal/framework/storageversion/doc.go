@@ -0,0 +1,10 @@
+/*
+Package storageversion contains the logic for migrating the stored objects of CustomResourceDefinitions
+to their current storage version.
+
+When a new version of a CRD becomes the storage version, the objects that already exist in etcd remain
+encoded in the previous version until they are written again. Before a previous version can be removed
+from a CRD, every object must be rewritten in the new storage version and the previous version must be
+removed from the CRD's status.storedVersions.
+*/
+package storageversion
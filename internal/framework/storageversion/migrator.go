@@ -0,0 +1,164 @@
+package storageversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listPageSize is the maximum number of objects to fetch from the API server in a single List request.
+const listPageSize = 500
+
+// Migrator migrates the stored objects of CustomResourceDefinitions to their storage version.
+type Migrator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+// NewMigrator creates a new Migrator.
+func NewMigrator(k8sClient client.Client, logger logr.Logger) *Migrator {
+	return &Migrator{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+// MigrateGroup migrates the stored objects of all CustomResourceDefinitions that belong to the API group.
+func (m *Migrator) MigrateGroup(ctx context.Context, group string) error {
+	var crds apiext.CustomResourceDefinitionList
+	if err := m.k8sClient.List(ctx, &crds); err != nil {
+		return fmt.Errorf("error listing CustomResourceDefinitions: %w", err)
+	}
+
+	var errs []error
+
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if crd.Spec.Group != group {
+			continue
+		}
+
+		if err := m.Migrate(ctx, crd); err != nil {
+			errs = append(errs, fmt.Errorf("error migrating %s: %w", crd.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Migrate rewrites all objects of the CustomResourceDefinition in its storage version and, once all objects
+// are rewritten, sets the status.storedVersions of the CustomResourceDefinition to the storage version only.
+func (m *Migrator) Migrate(ctx context.Context, crd *apiext.CustomResourceDefinition) error {
+	storageVersion, err := getStorageVersion(crd)
+	if err != nil {
+		return err
+	}
+
+	logger := m.logger.WithValues("crd", crd.Name, "storageVersion", storageVersion)
+
+	if isMigrated(crd, storageVersion) {
+		logger.V(1).Info("Objects are already stored in the storage version")
+		return nil
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   crd.Spec.Group,
+		Version: storageVersion,
+		Kind:    crd.Spec.Names.ListKind,
+	}
+
+	count, err := m.rewriteObjects(ctx, gvk)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Migrated objects to the storage version", "count", count)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest apiext.CustomResourceDefinition
+		if err := m.k8sClient.Get(ctx, client.ObjectKeyFromObject(crd), &latest); err != nil {
+			return err
+		}
+
+		latest.Status.StoredVersions = []string{storageVersion}
+
+		return m.k8sClient.Status().Update(ctx, &latest)
+	})
+}
+
+// rewriteObjects writes every object of the list kind back to the API server without changes.
+// The API server encodes the objects in the storage version when it persists them.
+func (m *Migrator) rewriteObjects(ctx context.Context, listGVK schema.GroupVersionKind) (int, error) {
+	var count int
+	var continueToken string
+
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(listGVK)
+
+		if err := m.k8sClient.List(ctx, list, client.Limit(listPageSize), client.Continue(continueToken)); err != nil {
+			return count, fmt.Errorf("error listing %s: %w", listGVK.Kind, err)
+		}
+
+		for i := range list.Items {
+			if err := m.rewriteObject(ctx, &list.Items[i]); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return count, nil
+		}
+	}
+}
+
+func (m *Migrator) rewriteObject(ctx context.Context, obj *unstructured.Unstructured) error {
+	key := client.ObjectKeyFromObject(obj)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := m.k8sClient.Update(ctx, obj); err != nil {
+			if apierrors.IsConflict(err) {
+				// refresh the object so that the next attempt uses the latest resourceVersion
+				if getErr := m.k8sClient.Get(ctx, key, obj); getErr != nil {
+					return getErr
+				}
+			}
+
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error rewriting %s %s: %w", obj.GetKind(), key, err)
+	}
+
+	return nil
+}
+
+func getStorageVersion(crd *apiext.CustomResourceDefinition) (string, error) {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("CustomResourceDefinition %s has no storage version", crd.Name)
+}
+
+func isMigrated(crd *apiext.CustomResourceDefinition, storageVersion string) bool {
+	stored := crd.Status.StoredVersions
+
+	return len(stored) == 1 && stored[0] == storageVersion
+}
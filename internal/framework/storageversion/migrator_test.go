@@ -0,0 +1,145 @@
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+)
+
+func createCRD(group string, storedVersions ...string) *apiext.CustomResourceDefinition {
+	return &apiext.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "observabilitypolicies." + group,
+		},
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Group: group,
+			Names: apiext.CustomResourceDefinitionNames{
+				Kind:     "ObservabilityPolicy",
+				ListKind: "ObservabilityPolicyList",
+			},
+			Versions: []apiext.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1alpha2", Served: true, Storage: true},
+			},
+		},
+		Status: apiext.CustomResourceDefinitionStatus{
+			StoredVersions: storedVersions,
+		},
+	}
+}
+
+func TestMigrateGroup(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+	g.Expect(ngfAPIv1alpha2.AddToScheme(scheme)).To(Succeed())
+
+	crd := createCRD(ngfAPIv1alpha2.GroupName, "v1alpha1", "v1alpha2")
+	otherCRD := createCRD("example.com", "v1alpha1", "v1alpha2")
+
+	policies := []*ngfAPIv1alpha2.ObservabilityPolicy{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy-2"}},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(crd, otherCRD, policies[0], policies[1]).
+		WithStatusSubresource(&apiext.CustomResourceDefinition{}).
+		Build()
+
+	migrator := NewMigrator(k8sClient, logr.Discard())
+	g.Expect(migrator.MigrateGroup(context.Background(), ngfAPIv1alpha2.GroupName)).To(Succeed())
+
+	var migratedCRD apiext.CustomResourceDefinition
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: crd.Name}, &migratedCRD)).To(Succeed())
+	g.Expect(migratedCRD.Status.StoredVersions).To(Equal([]string{"v1alpha2"}))
+
+	var untouchedCRD apiext.CustomResourceDefinition
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: otherCRD.Name}, &untouchedCRD)).
+		To(Succeed())
+	g.Expect(untouchedCRD.Status.StoredVersions).To(Equal([]string{"v1alpha1", "v1alpha2"}))
+
+	for _, p := range policies {
+		var rewritten ngfAPIv1alpha2.ObservabilityPolicy
+		key := types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+
+		g.Expect(k8sClient.Get(context.Background(), key, &rewritten)).To(Succeed())
+		// the fake client starts at resourceVersion 999 and increments it on every write
+		g.Expect(rewritten.ResourceVersion).To(Equal("1000"))
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		crd              *apiext.CustomResourceDefinition
+		name             string
+		expStoredVersion []string
+		expErr           bool
+	}{
+		{
+			name:             "already migrated",
+			crd:              createCRD(ngfAPIv1alpha2.GroupName, "v1alpha2"),
+			expStoredVersion: []string{"v1alpha2"},
+		},
+		{
+			name:             "stored in previous version",
+			crd:              createCRD(ngfAPIv1alpha2.GroupName, "v1alpha1"),
+			expStoredVersion: []string{"v1alpha2"},
+		},
+		{
+			name: "no storage version",
+			crd: func() *apiext.CustomResourceDefinition {
+				crd := createCRD(ngfAPIv1alpha2.GroupName, "v1alpha1")
+				crd.Spec.Versions[1].Storage = false
+				return crd
+			}(),
+			expStoredVersion: []string{"v1alpha1"},
+			expErr:           true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+			g.Expect(ngfAPIv1alpha2.AddToScheme(scheme)).To(Succeed())
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(test.crd).
+				WithStatusSubresource(&apiext.CustomResourceDefinition{}).
+				Build()
+
+			migrator := NewMigrator(k8sClient, logr.Discard())
+
+			err := migrator.Migrate(context.Background(), test.crd)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			var result apiext.CustomResourceDefinition
+			g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: test.crd.Name}, &result)).
+				To(Succeed())
+			g.Expect(result.Status.StoredVersions).To(Equal(test.expStoredVersion))
+		})
+	}
+}
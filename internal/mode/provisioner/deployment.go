@@ -5,15 +5,32 @@ import (
 	"strings"
 
 	v1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 )
 
+// safeToEvictAnnotation tells the cluster autoscaler if it can evict a Pod when it scales down the node of the Pod.
+// Without the annotation, the cluster autoscaler doesn't evict the Pods with local storage, such as the emptyDir
+// volumes of the static mode Deployment, which prevents the node from being scaled down.
+const safeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+
 // prepareDeployment prepares a new the static mode Deployment based on the YAML manifest.
 // It will use the specified id to set unique parts of the deployment, so it must be unique among all Deployments for
 // Gateways.
-// It will configure the Deployment to use the Gateway with the given NamespacedName.
-func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName) (*v1.Deployment, error) {
+// It will configure the Deployment to use the Gateway with the given NamespacedName and the Service with the same name
+// as the Deployment, which is only created if the Gateway requests an IP address. Without the Service, the static mode
+// reports the IP of its Pod as the address of the Gateway.
+func prepareDeployment(
+	depYAML []byte,
+	id string,
+	gwNsName types.NamespacedName,
+	disruption DisruptionConfig,
+) (*v1.Deployment, error) {
 	dep := &v1.Deployment{}
 	if err := yaml.Unmarshal(depYAML, dep); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal deployment: %w", err)
@@ -23,6 +40,17 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 	dep.Spec.Selector.MatchLabels["app"] = id
 	dep.Spec.Template.ObjectMeta.Labels["app"] = id
 
+	if disruption.SafeToEvict {
+		if dep.Spec.Template.ObjectMeta.Annotations == nil {
+			dep.Spec.Template.ObjectMeta.Annotations = make(map[string]string)
+		}
+		dep.Spec.Template.ObjectMeta.Annotations[safeToEvictAnnotation] = "true"
+	}
+
+	if disruption.PriorityClassName != "" {
+		dep.Spec.Template.Spec.PriorityClassName = disruption.PriorityClassName
+	}
+
 	finalArgs := []string{
 		"--gateway=" + gwNsName.String(),
 		"--update-gatewayclass-status=false",
@@ -32,6 +60,8 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 		if strings.Contains(arg, "leader-election-lock-name") {
 			lockNameArg := "--leader-election-lock-name=" + gwNsName.Name
 			finalArgs = append(finalArgs, lockNameArg)
+		} else if strings.HasPrefix(arg, "--service=") {
+			finalArgs = append(finalArgs, "--service="+id)
 		} else {
 			finalArgs = append(finalArgs, arg)
 		}
@@ -41,3 +71,22 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 
 	return dep, nil
 }
+
+// preparePodDisruptionBudget prepares the PodDisruptionBudget for the Pods of the created Deployment.
+// The Deployment owns the PodDisruptionBudget, so that the PodDisruptionBudget is garbage collected
+// when the Deployment is deleted.
+func preparePodDisruptionBudget(dep *v1.Deployment, maxUnavailable int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dep.Namespace,
+			Name:      dep.Name,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(dep, v1.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       dep.Spec.Selector.DeepCopy(),
+			MaxUnavailable: helpers.GetPointer(intstr.FromInt32(maxUnavailable)),
+		},
+	}
+}
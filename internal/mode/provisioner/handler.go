@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
 type timeNowFunc func() metav1.Time
@@ -30,6 +32,8 @@ type eventHandler struct {
 
 	// provisions maps NamespacedName of Gateway to its corresponding Deployment
 	provisions map[types.NamespacedName]*v1.Deployment
+	// services maps NamespacedName of Gateway to the Service that requests the addresses of the Gateway
+	services map[types.NamespacedName]*corev1.Service
 
 	statusUpdater *status.Updater
 	k8sClient     client.Client
@@ -37,6 +41,8 @@ type eventHandler struct {
 
 	staticModeDeploymentYAML []byte
 
+	disruption DisruptionConfig
+
 	gatewayNextID int64
 }
 
@@ -45,15 +51,18 @@ func newEventHandler(
 	statusUpdater *status.Updater,
 	k8sClient client.Client,
 	staticModeDeploymentYAML []byte,
+	disruption DisruptionConfig,
 	timeNow timeNowFunc,
 ) *eventHandler {
 	return &eventHandler{
 		store:                    newStore(),
 		provisions:               make(map[types.NamespacedName]*v1.Deployment),
+		services:                 make(map[types.NamespacedName]*corev1.Service),
 		statusUpdater:            statusUpdater,
 		gcName:                   gcName,
 		k8sClient:                k8sClient,
 		staticModeDeploymentYAML: staticModeDeploymentYAML,
+		disruption:               disruption,
 		gatewayNextID:            1,
 		timeNow:                  timeNow,
 	}
@@ -133,7 +142,12 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 	// Create new deployments
 
 	for _, nsname := range gwsWithoutDeps {
-		deployment, err := prepareDeployment(h.staticModeDeploymentYAML, h.generateDeploymentID(), nsname)
+		deployment, err := prepareDeployment(
+			h.staticModeDeploymentYAML,
+			h.generateDeploymentID(),
+			nsname,
+			h.disruption,
+		)
 		if err != nil {
 			panic(fmt.Errorf("failed to prepare deployment: %w", err))
 		}
@@ -149,6 +163,20 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 			"deployment", client.ObjectKeyFromObject(deployment),
 			"gateway", nsname,
 		)
+
+		if h.disruption.MaxUnavailable > 0 {
+			pdb := preparePodDisruptionBudget(deployment, h.disruption.MaxUnavailable)
+
+			if err = h.k8sClient.Create(ctx, pdb); err != nil {
+				panic(fmt.Errorf("failed to create pod disruption budget: %w", err))
+			}
+
+			logger.Info(
+				"Created pod disruption budget",
+				"podDisruptionBudget", client.ObjectKeyFromObject(pdb),
+				"gateway", nsname,
+			)
+		}
 	}
 
 	// Remove unnecessary deployments
@@ -161,6 +189,7 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 		}
 
 		delete(h.provisions, nsname)
+		delete(h.services, nsname)
 
 		logger.Info(
 			"Deleted deployment",
@@ -170,10 +199,76 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 	}
 }
 
+// ensureServicesMatchGateways ensures that every provisioned Deployment of a Gateway that requests an IP address
+// has a LoadBalancer Service that requests the same IP address, and that the Deployments of the other Gateways
+// don't have a Service.
+func (h *eventHandler) ensureServicesMatchGateways(ctx context.Context, logger logr.Logger) {
+	for nsname, deployment := range h.provisions {
+		gw := h.store.gateways[nsname]
+		ip := getRequestedIP(gw)
+		svc, exists := h.services[nsname]
+
+		var worker dataplane.Worker
+		if np := h.store.getNginxProxy(gw); np != nil {
+			worker = dataplane.NewWorker(np.Spec.Worker)
+		}
+
+		switch {
+		case ip == "" && exists:
+			if err := h.k8sClient.Delete(ctx, svc); err != nil {
+				panic(fmt.Errorf("failed to delete service: %w", err))
+			}
+
+			delete(h.services, nsname)
+
+			logger.Info(
+				"Deleted service",
+				"service", client.ObjectKeyFromObject(svc),
+				"gateway", nsname,
+			)
+		case ip != "" && !exists:
+			svc = prepareService(deployment, gw, ip, worker)
+
+			if err := h.k8sClient.Create(ctx, svc); err != nil {
+				panic(fmt.Errorf("failed to create service: %w", err))
+			}
+
+			h.services[nsname] = svc
+
+			logger.Info(
+				"Created service",
+				"service", client.ObjectKeyFromObject(svc),
+				"gateway", nsname,
+				"loadBalancerIP", ip,
+			)
+		case ip != "":
+			desired := prepareService(deployment, gw, ip, worker)
+			if svc.Spec.LoadBalancerIP == ip && servicePortsEqual(svc.Spec.Ports, desired.Spec.Ports) {
+				continue
+			}
+
+			svc.Spec.LoadBalancerIP = desired.Spec.LoadBalancerIP
+			svc.Spec.Ports = desired.Spec.Ports
+
+			if err := h.k8sClient.Update(ctx, svc); err != nil {
+				panic(fmt.Errorf("failed to update service: %w", err))
+			}
+
+			logger.Info(
+				"Updated service",
+				"service", client.ObjectKeyFromObject(svc),
+				"gateway", nsname,
+				"loadBalancerIP", ip,
+			)
+		}
+	}
+}
+
 func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger, batch events.EventBatch) {
 	h.store.update(batch)
 	h.setGatewayClassStatuses(ctx)
 	h.ensureDeploymentsMatchGateways(ctx, logger)
+	h.ensureServicesMatchGateways(ctx, logger)
 }
 
 func (h *eventHandler) generateDeploymentID() string {
@@ -7,10 +7,14 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -18,10 +22,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	embeddedfiles "github.com/nginx/nginx-gateway-fabric"
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 )
 
@@ -45,6 +51,8 @@ var _ = Describe("handler", func() {
 
 		Expect(gatewayv1.Install(scheme)).Should(Succeed())
 		Expect(v1.AddToScheme(scheme)).Should(Succeed())
+		Expect(corev1.AddToScheme(scheme)).Should(Succeed())
+		Expect(policyv1.AddToScheme(scheme)).Should(Succeed())
 		Expect(apiext.AddToScheme(scheme)).Should(Succeed())
 
 		k8sclient = fake.NewClientBuilder().
@@ -60,7 +68,7 @@ var _ = Describe("handler", func() {
 			return fakeTime
 		}
 
-		statusUpdater = status.NewUpdater(k8sclient, logr.Discard())
+		statusUpdater = status.NewUpdater(k8sclient, logr.Discard(), status.NewNoopMetricsCollector())
 
 		// Add GatewayClass CRD to the cluster
 		crd = &metav1.PartialObjectMetadata{
@@ -172,6 +180,8 @@ var _ = Describe("handler", func() {
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--update-gatewayclass-status=false"))
 		expectedLockFlag := fmt.Sprintf("--leader-election-lock-name=%s", gwNsName.Name)
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedLockFlag))
+		expectedSvcFlag := fmt.Sprintf("--service=%s", depNsName.Name)
+		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedSvcFlag))
 	}
 
 	itShouldUpsertCRD := func(version string, accepted bool) {
@@ -273,6 +283,7 @@ var _ = Describe("handler", func() {
 				statusUpdater,
 				k8sclient,
 				embeddedfiles.StaticModeDeploymentYAML,
+				DisruptionConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -433,6 +444,196 @@ var _ = Describe("handler", func() {
 		})
 	})
 
+	Describe("Disruption settings", func() {
+		It("should configure the Deployment and create a PodDisruptionBudget", func() {
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				embeddedfiles.StaticModeDeploymentYAML,
+				DisruptionConfig{
+					PriorityClassName: "high-priority",
+					MaxUnavailable:    1,
+					SafeToEvict:       true,
+				},
+				fakeTimeNow,
+			)
+
+			itShouldUpsertGatewayClass()
+			itShouldUpsertGateway(types.NamespacedName{Namespace: "test-ns", Name: "test-gw"}, 1)
+
+			depNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-1"}
+
+			dep := &v1.Deployment{}
+			Expect(k8sclient.Get(context.Background(), depNsName, dep)).To(Succeed())
+
+			Expect(dep.Spec.Template.Annotations).To(HaveKeyWithValue(safeToEvictAnnotation, "true"))
+			Expect(dep.Spec.Template.Spec.PriorityClassName).To(Equal("high-priority"))
+
+			pdb := &policyv1.PodDisruptionBudget{}
+			Expect(k8sclient.Get(context.Background(), depNsName, pdb)).To(Succeed())
+
+			Expect(pdb.Spec.Selector).To(Equal(dep.Spec.Selector))
+			Expect(pdb.Spec.MaxUnavailable.IntValue()).To(Equal(1))
+			Expect(pdb.OwnerReferences).To(HaveLen(1))
+			Expect(pdb.OwnerReferences[0].Kind).To(Equal("Deployment"))
+			Expect(pdb.OwnerReferences[0].Name).To(Equal(dep.Name))
+		})
+
+		It("should not create a PodDisruptionBudget by default", func() {
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				embeddedfiles.StaticModeDeploymentYAML,
+				DisruptionConfig{},
+				fakeTimeNow,
+			)
+
+			itShouldUpsertGatewayClass()
+			itShouldUpsertGateway(types.NamespacedName{Namespace: "test-ns", Name: "test-gw"}, 1)
+
+			depNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-1"}
+
+			dep := &v1.Deployment{}
+			Expect(k8sclient.Get(context.Background(), depNsName, dep)).To(Succeed())
+			Expect(dep.Spec.Template.Annotations).ToNot(HaveKey(safeToEvictAnnotation))
+			Expect(dep.Spec.Template.Spec.PriorityClassName).To(BeEmpty())
+
+			pdbs := &policyv1.PodDisruptionBudgetList{}
+			Expect(k8sclient.List(context.Background(), pdbs)).To(Succeed())
+			Expect(pdbs.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("Gateway addresses", Ordered, func() {
+		gwNsName := types.NamespacedName{Namespace: "test-ns", Name: "test-gw"}
+		svcNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-1"}
+
+		upsertGatewayWithAddresses := func(addresses ...string) {
+			gw := createGateway(gwNsName)
+			gw.Spec.Listeners = []gatewayv1.Listener{
+				{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+				{Name: "udp", Port: 53, Protocol: gatewayv1.UDPProtocolType},
+			}
+			for _, addr := range addresses {
+				gw.Spec.Addresses = append(gw.Spec.Addresses, gatewayv1.GatewayAddress{Value: addr})
+			}
+
+			batch := []interface{}{
+				&events.UpsertEvent{
+					Resource: gw,
+				},
+			}
+
+			handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+		}
+
+		BeforeAll(func() {
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				embeddedfiles.StaticModeDeploymentYAML,
+				DisruptionConfig{},
+				fakeTimeNow,
+			)
+		})
+
+		When("upserting Gateway that requests an address", func() {
+			It("should create a Service that requests the address", func() {
+				itShouldUpsertGatewayClass()
+				upsertGatewayWithAddresses("10.0.0.1", "10.0.0.2")
+
+				svc := &corev1.Service{}
+				Expect(k8sclient.Get(context.Background(), svcNsName, svc)).To(Succeed())
+
+				Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+				Expect(svc.Spec.LoadBalancerIP).To(Equal("10.0.0.1"))
+				Expect(svc.Spec.Selector).To(HaveKeyWithValue("app", "nginx-gateway-1"))
+				Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{
+					{Name: "tcp-80", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(80)},
+					{Name: "tcp-443", Protocol: corev1.ProtocolTCP, Port: 443, TargetPort: intstr.FromInt32(443)},
+					{Name: "udp-53", Protocol: corev1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt32(53)},
+				}))
+				Expect(svc.OwnerReferences).To(HaveLen(1))
+				Expect(svc.OwnerReferences[0].Kind).To(Equal("Deployment"))
+				Expect(svc.OwnerReferences[0].Name).To(Equal(svcNsName.Name))
+			})
+		})
+
+		When("upserting Gateway that requests a different address", func() {
+			It("should update the Service", func() {
+				upsertGatewayWithAddresses("10.0.0.3")
+
+				svc := &corev1.Service{}
+				Expect(k8sclient.Get(context.Background(), svcNsName, svc)).To(Succeed())
+				Expect(svc.Spec.LoadBalancerIP).To(Equal("10.0.0.3"))
+			})
+		})
+
+		When("upserting NginxProxy with port mappings that the Gateway references", func() {
+			It("should target the container ports in the Service", func() {
+				np := &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-np"},
+					Spec: ngfAPI.NginxProxySpec{
+						Worker: &ngfAPI.NginxWorker{
+							PortBinding: &ngfAPI.PortBinding{
+								Strategy:   helpers.GetPointer(ngfAPI.PortBindingStrategyHighPorts),
+								PortOffset: helpers.GetPointer[int32](8000),
+								PortMappings: []ngfAPI.PortMapping{
+									{ListenerPort: 443, ContainerPort: 8443},
+								},
+							},
+						},
+					},
+				}
+
+				gw := createGateway(gwNsName)
+				gw.Spec.Listeners = []gatewayv1.Listener{
+					{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+					{Name: "https", Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+				}
+				gw.Spec.Addresses = []gatewayv1.GatewayAddress{{Value: "10.0.0.3"}}
+				gw.Spec.Infrastructure = &gatewayv1.GatewayInfrastructure{
+					ParametersRef: &gatewayv1.LocalParametersReference{
+						Group: ngfAPI.GroupName,
+						Kind:  gatewayv1.Kind(kinds.NginxProxy),
+						Name:  np.Name,
+					},
+				}
+
+				batch := []interface{}{
+					&events.UpsertEvent{Resource: np},
+					&events.UpsertEvent{Resource: gw},
+				}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				svc := &corev1.Service{}
+				Expect(k8sclient.Get(context.Background(), svcNsName, svc)).To(Succeed())
+				Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{
+					{Name: "tcp-80", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(8080)},
+					{Name: "tcp-443", Protocol: corev1.ProtocolTCP, Port: 443, TargetPort: intstr.FromInt32(8443)},
+				}))
+			})
+		})
+
+		When("upserting Gateway that doesn't request an address", func() {
+			It("should delete the Service", func() {
+				upsertGatewayWithAddresses()
+
+				svc := &corev1.Service{}
+				err := k8sclient.Get(context.Background(), svcNsName, svc)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+				dep := &v1.Deployment{}
+				Expect(k8sclient.Get(context.Background(), svcNsName, dep)).To(Succeed())
+			})
+		})
+	})
+
 	Describe("Edge cases", func() {
 		var gwNsName types.NamespacedName
 
@@ -447,6 +648,7 @@ var _ = Describe("handler", func() {
 				statusUpdater,
 				k8sclient,
 				embeddedfiles.StaticModeDeploymentYAML,
+				DisruptionConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -559,6 +761,7 @@ var _ = Describe("handler", func() {
 					statusUpdater,
 					k8sclient,
 					[]byte("broken YAML"),
+					DisruptionConfig{},
 					fakeTimeNow,
 				)
 
@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,6 +18,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	embeddedfiles "github.com/nginx/nginx-gateway-fabric"
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller/predicate"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
@@ -29,6 +32,19 @@ type Config struct {
 	Logger           logr.Logger
 	GatewayClassName string
 	GatewayCtlrName  string
+	Disruption       DisruptionConfig
+}
+
+// DisruptionConfig configures how the provisioned Deployments handle the voluntary disruptions, such as
+// the evictions of the Pods when the cluster autoscaler scales down their nodes.
+type DisruptionConfig struct {
+	// PriorityClassName is the name of the PriorityClass of the Pods. Empty means no PriorityClass.
+	PriorityClassName string
+	// MaxUnavailable is the maximum number of the unavailable Pods of the PodDisruptionBudget of a Deployment.
+	// Zero means that no PodDisruptionBudget is created.
+	MaxUnavailable int32
+	// SafeToEvict annotates the Pods as safe to evict for the cluster autoscaler.
+	SafeToEvict bool
 }
 
 // StartManager starts a Manager for the provisioner mode, which provisions
@@ -43,7 +59,10 @@ func StartManager(cfg Config) error {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(gatewayv1.Install(scheme))
 	utilruntime.Must(v1.AddToScheme(scheme))
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(policyv1.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
+	utilruntime.Must(ngfAPI.AddToScheme(scheme))
 
 	options := manager.Options{
 		Scheme: scheme,
@@ -76,6 +95,9 @@ func StartManager(cfg Config) error {
 		{
 			objectType: &gatewayv1.Gateway{},
 		},
+		{
+			objectType: &ngfAPI.NginxProxy{},
+		},
 		{
 			objectType: &crdWithGVK,
 			options: []controller.Option{
@@ -119,6 +141,7 @@ func StartManager(cfg Config) error {
 		},
 		[]client.ObjectList{
 			&gatewayv1.GatewayList{},
+			&ngfAPI.NginxProxyList{},
 			partialObjectMetadataList,
 		},
 	)
@@ -126,6 +149,7 @@ func StartManager(cfg Config) error {
 	statusUpdater := status.NewUpdater(
 		mgr.GetClient(),
 		cfg.Logger.WithName("statusUpdater"),
+		status.NewNoopMetricsCollector(),
 	)
 
 	handler := newEventHandler(
@@ -133,6 +157,7 @@ func StartManager(cfg Config) error {
 		statusUpdater,
 		mgr.GetClient(),
 		embeddedfiles.StaticModeDeploymentYAML,
+		cfg.Disruption,
 		metav1.Now,
 	)
 
@@ -141,6 +166,7 @@ func StartManager(cfg Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		handler,
 		firstBatchPreparer,
+		nil,
 	)
 
 	if err := mgr.Add(eventLoop); err != nil {
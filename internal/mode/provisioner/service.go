@@ -0,0 +1,101 @@
+package provisioner
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// getRequestedIP returns the first IP address requested in the addresses of the Gateway, or an empty string if
+// the Gateway doesn't request any. A LoadBalancer Service can only request a single IP address, so the other
+// addresses are not assigned, which the static mode reports in the status of the Gateway.
+func getRequestedIP(gw *gatewayv1.Gateway) string {
+	for _, addr := range gw.Spec.Addresses {
+		if addr.Type == nil || *addr.Type == gatewayv1.IPAddressType {
+			return addr.Value
+		}
+	}
+
+	return ""
+}
+
+// prepareService prepares the LoadBalancer Service for the Pods of the Deployment of the Gateway, which requests
+// the IP address from the load balancer. The Service exposes the ports of the Listeners of the Gateway, and targets
+// the ports that NGINX listens on for them, according to the worker settings of the NginxProxy of the Gateway.
+// The Deployment owns the Service, so that the Service is garbage collected when the Deployment is deleted.
+func prepareService(
+	dep *v1.Deployment,
+	gw *gatewayv1.Gateway,
+	loadBalancerIP string,
+	worker dataplane.Worker,
+) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dep.Namespace,
+			Name:      dep.Name,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(dep, v1.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeLoadBalancer,
+			LoadBalancerIP: loadBalancerIP, //nolint:staticcheck // the only way to request the IP that is not specific to a provider
+			Selector:       maps.Clone(dep.Spec.Selector.MatchLabels),
+			Ports:          getServicePorts(gw.Spec.Listeners, worker),
+		},
+	}
+}
+
+func getServicePorts(listeners []gatewayv1.Listener, worker dataplane.Worker) []corev1.ServicePort {
+	var ports []corev1.ServicePort
+
+	seen := make(map[string]struct{})
+
+	for _, l := range listeners {
+		protocol := corev1.ProtocolTCP
+		if l.Protocol == gatewayv1.UDPProtocolType {
+			protocol = corev1.ProtocolUDP
+		}
+
+		name := fmt.Sprintf("%s-%d", strings.ToLower(string(protocol)), l.Port)
+		if _, exists := seen[name]; exists {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		ports = append(ports, corev1.ServicePort{
+			Name:       name,
+			Protocol:   protocol,
+			Port:       int32(l.Port),
+			TargetPort: intstr.FromInt32(worker.ListenPort(int32(l.Port))),
+		})
+	}
+
+	return ports
+}
+
+// servicePortsEqual compares the ports of the Services without the node ports, which are allocated by Kubernetes.
+func servicePortsEqual(ports1, ports2 []corev1.ServicePort) bool {
+	if len(ports1) != len(ports2) {
+		return false
+	}
+
+	for i := range ports1 {
+		if ports1[i].Name != ports2[i].Name ||
+			ports1[i].Protocol != ports2[i].Protocol ||
+			ports1[i].Port != ports2[i].Port ||
+			ports1[i].TargetPort != ports2[i].TargetPort {
+			return false
+		}
+	}
+
+	return true
+}
@@ -8,7 +8,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 )
 
 // store stores the cluster state needed by the provisioner and allows to update it from the events.
@@ -16,6 +18,7 @@ type store struct {
 	gatewayClasses map[types.NamespacedName]*v1.GatewayClass
 	gateways       map[types.NamespacedName]*v1.Gateway
 	crdMetadata    map[types.NamespacedName]*metav1.PartialObjectMetadata
+	nginxProxies   map[types.NamespacedName]*ngfAPI.NginxProxy
 }
 
 func newStore() *store {
@@ -23,6 +26,7 @@ func newStore() *store {
 		gatewayClasses: make(map[types.NamespacedName]*v1.GatewayClass),
 		gateways:       make(map[types.NamespacedName]*v1.Gateway),
 		crdMetadata:    make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		nginxProxies:   make(map[types.NamespacedName]*ngfAPI.NginxProxy),
 	}
 }
 
@@ -37,6 +41,8 @@ func (s *store) update(batch events.EventBatch) {
 				s.gateways[client.ObjectKeyFromObject(obj)] = obj
 			case *metav1.PartialObjectMetadata:
 				s.crdMetadata[client.ObjectKeyFromObject(obj)] = obj
+			case *ngfAPI.NginxProxy:
+				s.nginxProxies[client.ObjectKeyFromObject(obj)] = obj
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Resource))
 			}
@@ -48,6 +54,8 @@ func (s *store) update(batch events.EventBatch) {
 				delete(s.gateways, e.NamespacedName)
 			case *metav1.PartialObjectMetadata:
 				delete(s.crdMetadata, e.NamespacedName)
+			case *ngfAPI.NginxProxy:
+				delete(s.nginxProxies, e.NamespacedName)
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Type))
 			}
@@ -56,3 +64,29 @@ func (s *store) update(batch events.EventBatch) {
 		}
 	}
 }
+
+// getNginxProxy returns the NginxProxy that configures the data plane of the Gateway: the NginxProxy referenced by
+// the Gateway or, if the Gateway doesn't reference one, the NginxProxy referenced by the GatewayClass of the Gateway.
+// Returns nil if there is no such NginxProxy.
+func (s *store) getNginxProxy(gw *v1.Gateway) *ngfAPI.NginxProxy {
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		ref := gw.Spec.Infrastructure.ParametersRef
+		if isNginxProxyRef(ref.Group, ref.Kind) {
+			return s.nginxProxies[types.NamespacedName{Name: ref.Name}]
+		}
+	}
+
+	gc := s.gatewayClasses[types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}]
+	if gc != nil && gc.Spec.ParametersRef != nil {
+		ref := gc.Spec.ParametersRef
+		if isNginxProxyRef(ref.Group, ref.Kind) {
+			return s.nginxProxies[types.NamespacedName{Name: ref.Name}]
+		}
+	}
+
+	return nil
+}
+
+func isNginxProxyRef(group v1.Group, kind v1.Kind) bool {
+	return group == ngfAPI.GroupName && kind == v1.Kind(kinds.NginxProxy)
+}
@@ -0,0 +1,76 @@
+package artifacts
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Credentials are the credentials for a registry.
+type Credentials struct {
+	// Username is the username.
+	Username string
+	// Password is the password or the identity token.
+	Password string
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// CredentialsFromDockerConfig returns the credentials for the registry of the repository from the content of
+// a .dockerconfigjson file, which is the format of the Secrets of type kubernetes.io/dockerconfigjson.
+// It returns nil if the file has no credentials for the registry.
+func CredentialsFromDockerConfig(data []byte, repository string) (*Credentials, error) {
+	var cfg dockerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse docker config: %w", err)
+	}
+
+	reg := registry(repository)
+
+	for server, auth := range cfg.Auths {
+		if normalizeRegistry(server) != reg {
+			continue
+		}
+
+		if auth.Auth == "" {
+			return &Credentials{Username: auth.Username, Password: auth.Password}, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode auth of registry %q: %w", server, err)
+		}
+
+		username, password, found := strings.Cut(string(decoded), ":")
+		if !found {
+			return nil, fmt.Errorf("auth of registry %q must be in the format 'username:password'", server)
+		}
+
+		return &Credentials{Username: username, Password: password}, nil
+	}
+
+	return nil, nil //nolint:nilnil // no credentials for the registry is not an error
+}
+
+// normalizeRegistry converts a server of a docker config, which can be a URL, to the registry host
+// as it is written in repositories.
+func normalizeRegistry(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	server, _, _ = strings.Cut(server, "/")
+
+	if server == "index.docker.io" || server == dockerHubRegistryHost {
+		return dockerHubRegistry
+	}
+
+	return server
+}
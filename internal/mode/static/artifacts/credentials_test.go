@@ -0,0 +1,71 @@
+package artifacts
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCredentialsFromDockerConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expCredentials *Credentials
+		name           string
+		repository     string
+		config         string
+		expErr         bool
+	}{
+		{
+			name:           "username and password",
+			repository:     "registry.example.com/team/snippets",
+			config:         `{"auths":{"registry.example.com":{"username":"user","password":"pass"}}}`,
+			expCredentials: &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:           "auth of URL server",
+			repository:     "registry.example.com:5000/snippets",
+			config:         `{"auths":{"https://registry.example.com:5000/v2/":{"auth":"dXNlcjpwYXNz"}}}`,
+			expCredentials: &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:           "docker hub",
+			repository:     "docker.io/team/snippets",
+			config:         `{"auths":{"https://index.docker.io/v1/":{"auth":"dXNlcjpwYXNz"}}}`,
+			expCredentials: &Credentials{Username: "user", Password: "pass"},
+		},
+		{
+			name:       "no credentials for registry",
+			repository: "registry.example.com/team/snippets",
+			config:     `{"auths":{"other.example.com":{"username":"user","password":"pass"}}}`,
+		},
+		{
+			name:       "invalid auth",
+			repository: "registry.example.com/team/snippets",
+			config:     `{"auths":{"registry.example.com":{"auth":"dXNlcg=="}}}`,
+			expErr:     true,
+		},
+		{
+			name:       "invalid config",
+			repository: "registry.example.com/team/snippets",
+			config:     `{"auths":`,
+			expErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			credentials, err := CredentialsFromDockerConfig([]byte(test.config), test.repository)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(credentials).To(Equal(test.expCredentials))
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// DefaultMaxArtifactSize is the default maximum size of the content of an artifact.
+	DefaultMaxArtifactSize = 16 * 1024 * 1024
+	// DefaultMaxCacheSize is the default maximum total size of the contents of the cached artifacts.
+	DefaultMaxCacheSize = 128 * 1024 * 1024
+	// DefaultRetryInterval is the default interval between the fetches of an artifact that failed to be fetched.
+	DefaultRetryInterval = 30 * time.Second
+	// DefaultTimeout is the default timeout of a fetch of an artifact.
+	DefaultTimeout = 60 * time.Second
+)
+
+var (
+	// ErrPending is returned by Fetcher.Get when the artifact is being fetched.
+	ErrPending = errors.New("artifact is being fetched")
+	// ErrVerificationRequired is returned by Fetcher.Get when the Fetcher requires the verification of
+	// the signatures of the artifacts, but the Reference has no Verification.
+	ErrVerificationRequired = errors.New("artifact signature verification is required")
+)
+
+// FetcherConfig holds configuration parameters for the Fetcher.
+type FetcherConfig struct {
+	// HTTPClient is the client for the requests to the registries. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+	// OnUpdate is called when a fetch of an artifact completes, and when a failed fetch can be retried,
+	// so that the artifacts are requested again.
+	OnUpdate func()
+	// Logger is the logger for the Fetcher.
+	Logger logr.Logger
+	// MaxArtifactSize is the maximum size of the content of an artifact. If zero, DefaultMaxArtifactSize is used.
+	MaxArtifactSize int64
+	// MaxCacheSize is the maximum total size of the contents of the cached artifacts.
+	// If zero, DefaultMaxCacheSize is used.
+	MaxCacheSize int64
+	// RetryInterval is the interval between the fetches of an artifact that failed to be fetched.
+	// If zero, DefaultRetryInterval is used.
+	RetryInterval time.Duration
+	// Timeout is the timeout of a fetch of an artifact. If zero, DefaultTimeout is used.
+	Timeout time.Duration
+	// RequireVerification requires the References to have a Verification of the signatures of the artifacts.
+	RequireVerification bool
+}
+
+// Fetcher fetches OCI artifacts from registries in the background and caches their contents.
+// Because the artifacts are referenced by digest, a cached content never becomes stale.
+type Fetcher struct {
+	entries map[string]*entry
+	cfg     FetcherConfig
+	// cacheSize is the total size of the contents of the cached artifacts.
+	cacheSize int64
+	lock      sync.Mutex
+	// updated tells if a fetch completed or a failed fetch can be retried since the last TakeUpdated call.
+	updated bool
+}
+
+type entry struct {
+	err      error
+	lastUsed time.Time
+	// failedAt is the time when the last fetch failed.
+	failedAt time.Time
+	content  []byte
+	pending  bool
+}
+
+// NewFetcher creates a new Fetcher.
+func NewFetcher(cfg FetcherConfig) *Fetcher {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	if cfg.MaxArtifactSize == 0 {
+		cfg.MaxArtifactSize = DefaultMaxArtifactSize
+	}
+	if cfg.MaxCacheSize == 0 {
+		cfg.MaxCacheSize = DefaultMaxCacheSize
+	}
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = DefaultRetryInterval
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	return &Fetcher{
+		cfg:     cfg,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Get returns the content of the artifact. If the artifact is not cached, Get starts fetching it in the background
+// and returns ErrPending. If the last fetch of the artifact failed, Get returns the error of the fetch, and starts
+// a new fetch once RetryInterval has passed since the failure. If the Fetcher requires verification and the
+// Reference has no Verification, Get returns ErrVerificationRequired.
+func (f *Fetcher) Get(ref Reference) ([]byte, error) {
+	if f.cfg.RequireVerification && ref.Verification == nil {
+		return nil, ErrVerificationRequired
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	key := ref.String()
+	if ref.Verification != nil {
+		// the same artifact verified differently is a different entry, so that a content verified
+		// with one Verification isn't returned for another
+		key += "#" + ref.Verification.id
+	}
+
+	e, exists := f.entries[key]
+	if !exists {
+		e = &entry{}
+		f.entries[key] = e
+	}
+
+	e.lastUsed = time.Now()
+
+	switch {
+	case e.pending:
+		return nil, ErrPending
+	case e.content != nil:
+		return e.content, nil
+	case e.err != nil && time.Since(e.failedAt) < f.cfg.RetryInterval:
+		return nil, e.err
+	}
+
+	e.pending = true
+	go f.fetch(key, ref)
+
+	return nil, ErrPending
+}
+
+// TakeUpdated reports whether a fetch completed or a failed fetch can be retried since the last call.
+func (f *Fetcher) TakeUpdated() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	updated := f.updated
+	f.updated = false
+
+	return updated
+}
+
+func (f *Fetcher) fetch(key string, ref Reference) {
+	ctx, cancel := context.WithTimeout(context.Background(), f.cfg.Timeout)
+	defer cancel()
+
+	content, err := pull(ctx, f.cfg.HTTPClient, ref, f.cfg.MaxArtifactSize)
+
+	f.lock.Lock()
+
+	e := f.entries[key]
+	e.pending = false
+	f.updated = true
+
+	if err != nil {
+		f.cfg.Logger.Error(err, "Failed to fetch OCI artifact", "artifact", key)
+
+		e.err = err
+		e.failedAt = time.Now()
+
+		time.AfterFunc(f.cfg.RetryInterval, f.setUpdated)
+	} else {
+		f.cfg.Logger.V(1).Info("Fetched OCI artifact", "artifact", key, "size", len(content))
+
+		e.err = nil
+		e.content = content
+		f.cacheSize += int64(len(content))
+		f.evict(key)
+	}
+
+	f.lock.Unlock()
+
+	if f.cfg.OnUpdate != nil {
+		f.cfg.OnUpdate()
+	}
+}
+
+func (f *Fetcher) setUpdated() {
+	f.lock.Lock()
+	f.updated = true
+	f.lock.Unlock()
+
+	if f.cfg.OnUpdate != nil {
+		f.cfg.OnUpdate()
+	}
+}
+
+// evict removes the least recently used artifacts from the cache, except the one with the key, until the total
+// size of the contents of the cached artifacts doesn't exceed MaxCacheSize. It also removes the failed fetches
+// that weren't requested since the last retry interval.
+// Must be called with the lock held.
+func (f *Fetcher) evict(keep string) {
+	for key, e := range f.entries {
+		if !e.pending && e.err != nil && time.Since(e.lastUsed) > f.cfg.RetryInterval {
+			delete(f.entries, key)
+		}
+	}
+
+	for f.cacheSize > f.cfg.MaxCacheSize {
+		var (
+			oldestKey string
+			oldest    *entry
+		)
+
+		for key, e := range f.entries {
+			if key == keep || e.content == nil {
+				continue
+			}
+
+			if oldest == nil || e.lastUsed.Before(oldest.lastUsed) {
+				oldestKey, oldest = key, e
+			}
+		}
+
+		if oldest == nil {
+			return
+		}
+
+		f.cacheSize -= int64(len(oldest.content))
+		delete(f.entries, oldestKey)
+	}
+}
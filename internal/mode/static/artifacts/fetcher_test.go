@@ -0,0 +1,237 @@
+package artifacts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const testToken = "test-token"
+
+var errFailed = errors.New("failed")
+
+func digestOf(content []byte) string {
+	sum := sha256.Sum256(content)
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
+// newTestRegistry starts a registry that serves an artifact with the content in the team/snippets repository.
+// The registry requires a token, which is issued for the user:pass credentials.
+func newTestRegistry(t *testing.T, content []byte) (*httptest.Server, string) {
+	t.Helper()
+
+	layerDigest := digestOf(content)
+	manifestContent, err := json.Marshal(manifest{
+		Layers: []descriptor{
+			{MediaType: "text/plain", Digest: layerDigest, Size: int64(len(content))},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var server *httptest.Server
+
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") == "Bearer "+testToken {
+			return true
+		}
+
+		challenge := fmt.Sprintf(`Bearer realm="%s/token",service="test"`, server.URL)
+		w.Header().Set("WWW-Authenticate", challenge)
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return false
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "pass" ||
+			r.URL.Query().Get("scope") != "repository:team/snippets:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"token":"` + testToken + `"}`))
+	})
+	mux.HandleFunc("/v2/team/snippets/manifests/", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			_, _ = w.Write(manifestContent)
+		}
+	})
+	mux.HandleFunc("/v2/team/snippets/blobs/"+layerDigest, func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			_, _ = w.Write(content)
+		}
+	})
+
+	server = httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, digestOf(manifestContent)
+}
+
+func TestFetcher_Get(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("location /test { return 200; }")
+	server, manifestDigest := newTestRegistry(t, content)
+	repository := strings.TrimPrefix(server.URL, "https://") + "/team/snippets"
+
+	tests := []struct {
+		expErr     string
+		name       string
+		ref        Reference
+		expContent []byte
+	}{
+		{
+			name: "valid credentials",
+			ref: Reference{
+				Credentials: &Credentials{Username: "user", Password: "pass"},
+				Repository:  repository,
+				Digest:      manifestDigest,
+			},
+			expContent: content,
+		},
+		{
+			name: "invalid credentials",
+			ref: Reference{
+				Credentials: &Credentials{Username: "user", Password: "wrong"},
+				Repository:  repository,
+				Digest:      manifestDigest,
+			},
+			expErr: "failed to fetch token: unexpected status code 401 from token server",
+		},
+		{
+			name: "digest mismatch",
+			ref: Reference{
+				Credentials: &Credentials{Username: "user", Password: "pass"},
+				Repository:  repository,
+				Digest:      digestOf([]byte("other")),
+			},
+			expErr: "failed to verify manifest",
+		},
+		{
+			name: "repository not found",
+			ref: Reference{
+				Credentials: &Credentials{Username: "user", Password: "pass"},
+				Repository:  strings.TrimPrefix(server.URL, "https://") + "/team/other",
+				Digest:      manifestDigest,
+			},
+			expErr: "unexpected status code 404",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var updates atomic.Int32
+
+			fetcher := NewFetcher(FetcherConfig{
+				HTTPClient:    server.Client(),
+				OnUpdate:      func() { updates.Add(1) },
+				RetryInterval: time.Hour,
+			})
+
+			_, err := fetcher.Get(test.ref)
+			g.Expect(err).To(MatchError(ErrPending))
+
+			g.Eventually(updates.Load).Should(BeEquivalentTo(1))
+			g.Expect(fetcher.TakeUpdated()).To(BeTrue())
+			g.Expect(fetcher.TakeUpdated()).To(BeFalse())
+
+			fetched, err := fetcher.Get(test.ref)
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+				g.Expect(fetched).To(BeNil())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(fetched).To(Equal(test.expContent))
+			}
+
+			// the result is cached
+			g.Consistently(updates.Load, 100*time.Millisecond).Should(BeEquivalentTo(1))
+			g.Expect(fetcher.TakeUpdated()).To(BeFalse())
+		})
+	}
+}
+
+func TestFetcher_GetRetriesFailedFetch(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server, manifestDigest := newTestRegistry(t, []byte("snippet"))
+
+	var updates atomic.Int32
+
+	fetcher := NewFetcher(FetcherConfig{
+		HTTPClient:    server.Client(),
+		OnUpdate:      func() { updates.Add(1) },
+		RetryInterval: 50 * time.Millisecond,
+	})
+
+	ref := Reference{
+		Repository: strings.TrimPrefix(server.URL, "https://") + "/team/snippets",
+		Digest:     manifestDigest,
+	}
+
+	_, err := fetcher.Get(ref)
+	g.Expect(err).To(MatchError(ErrPending))
+
+	// one update for the failed fetch, and one when the fetch can be retried
+	g.Eventually(updates.Load).Should(BeEquivalentTo(2))
+
+	_, err = fetcher.Get(ref)
+	g.Expect(err).To(MatchError(ErrPending))
+}
+
+func TestFetcher_Evict(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now()
+
+	fetcher := NewFetcher(FetcherConfig{MaxCacheSize: 10, RetryInterval: time.Minute})
+	fetcher.entries = map[string]*entry{
+		"old":     {content: []byte("12345"), lastUsed: now.Add(-3 * time.Second)},
+		"recent":  {content: []byte("12345"), lastUsed: now.Add(-2 * time.Second)},
+		"new":     {content: []byte("12345"), lastUsed: now.Add(-4 * time.Second)},
+		"pending": {pending: true, lastUsed: now.Add(-time.Hour)},
+		"failed":  {err: errFailed, lastUsed: now.Add(-time.Hour)},
+		"retried": {err: errFailed, lastUsed: now},
+	}
+	fetcher.cacheSize = 15
+
+	fetcher.evict("new")
+
+	g.Expect(fetcher.entries).To(HaveLen(4))
+	g.Expect(fetcher.entries).To(HaveKey("recent"))
+	g.Expect(fetcher.entries).To(HaveKey("new"))
+	g.Expect(fetcher.entries).To(HaveKey("pending"))
+	g.Expect(fetcher.entries).To(HaveKey("retried"))
+	g.Expect(fetcher.cacheSize).To(BeEquivalentTo(10))
+}
+
+func TestFetcher_GetRequiresVerification(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fetcher := NewFetcher(FetcherConfig{RequireVerification: true})
+
+	_, err := fetcher.Get(Reference{Repository: "registry.example.com/team/snippets", Digest: digestOf(nil)})
+	g.Expect(err).To(MatchError(ErrVerificationRequired))
+	g.Expect(fetcher.entries).To(BeEmpty())
+}
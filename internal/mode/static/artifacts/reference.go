@@ -0,0 +1,50 @@
+package artifacts
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	dockerHubRegistry     = "docker.io"
+	dockerHubRegistryHost = "registry-1.docker.io"
+)
+
+// Reference references an OCI artifact by the digest of its manifest.
+type Reference struct {
+	// Credentials are the credentials for the registry. If nil, the artifact is fetched anonymously.
+	Credentials *Credentials
+	// Verification verifies the signatures of the artifact. If nil, the signatures are not verified.
+	Verification *Verification
+	// Repository is the repository of the artifact, including the registry host.
+	// For example, "registry.example.com/team/snippets".
+	Repository string
+	// Digest is the digest of the manifest of the artifact.
+	Digest string
+}
+
+// String returns the reference in the repository@digest format.
+func (r Reference) String() string {
+	return r.Repository + "@" + r.Digest
+}
+
+// registryAndName splits the repository of the reference into the host of the registry and the name of
+// the repository in the registry.
+func (r Reference) registryAndName() (host, name string, err error) {
+	registry, name, found := strings.Cut(r.Repository, "/")
+	if !found || registry == "" || name == "" {
+		return "", "", errors.New("repository must include the registry host and the name of the repository")
+	}
+
+	if registry == dockerHubRegistry {
+		return dockerHubRegistryHost, name, nil
+	}
+
+	return registry, name, nil
+}
+
+// registry returns the registry of the repository as it is written in the repository.
+func registry(repository string) string {
+	registry, _, _ := strings.Cut(repository, "/")
+	return registry
+}
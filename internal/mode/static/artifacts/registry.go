@@ -0,0 +1,312 @@
+package artifacts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxManifestSize is the maximum size of a manifest, as recommended by the OCI distribution spec.
+	maxManifestSize = 4 * 1024 * 1024
+	// maxTokenResponseSize is the maximum size of a response of a token server.
+	maxTokenResponseSize = 64 * 1024
+
+	digestPrefix = "sha256:"
+)
+
+// errCredentialsRequired is returned when the registry requires credentials, but the Reference doesn't have them.
+var errCredentialsRequired = errors.New("registry requires credentials")
+
+type manifest struct {
+	Layers []descriptor `json:"layers"`
+}
+
+type descriptor struct {
+	Annotations map[string]string `json:"annotations"`
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+}
+
+type tokenResponse struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// session pulls the manifest and the blobs of a repository of a registry using the OCI distribution API.
+// https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests
+type session struct {
+	client        *http.Client
+	credentials   *Credentials
+	host          string
+	name          string
+	authorization string
+}
+
+// pull fetches the content of the single layer of the artifact and verifies the manifest and the content
+// against their digests. If the Reference has a Verification, pull also verifies the cosign signatures
+// of the artifact before fetching its content.
+func pull(ctx context.Context, client *http.Client, ref Reference, maxSize int64) ([]byte, error) {
+	host, name, err := ref.registryAndName()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &session{
+		client:      client,
+		credentials: ref.Credentials,
+		host:        host,
+		name:        name,
+	}
+
+	accept := mediaTypeOCIManifest + ", " + mediaTypeDockerManifest
+
+	manifestContent, err := s.get(ctx, "manifests/"+ref.Digest, accept, maxManifestSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	}
+
+	if err := verifyDigest(manifestContent, ref.Digest); err != nil {
+		return nil, fmt.Errorf("failed to verify manifest: %w", err)
+	}
+
+	if ref.Verification != nil {
+		if err := s.verifySignatures(ctx, ref.Digest, ref.Verification); err != nil {
+			return nil, err
+		}
+	}
+
+	var m manifest
+	if err := json.Unmarshal(manifestContent, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	if len(m.Layers) != 1 {
+		return nil, fmt.Errorf("artifact must have exactly one layer, but it has %d", len(m.Layers))
+	}
+
+	layer := m.Layers[0]
+
+	if layer.Size > maxSize {
+		return nil, fmt.Errorf("layer size %d exceeds the maximum size %d", layer.Size, maxSize)
+	}
+
+	content, err := s.get(ctx, "blobs/"+layer.Digest, "", maxSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch layer: %w", err)
+	}
+
+	if err := verifyDigest(content, layer.Digest); err != nil {
+		return nil, fmt.Errorf("failed to verify layer: %w", err)
+	}
+
+	return content, nil
+}
+
+// get gets the resource of the repository with the path relative to the repository. If the registry
+// responds that authorization is required, get authorizes the session and retries once.
+func (s *session) get(ctx context.Context, path, accept string, maxSize int64) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", s.host, s.name, path)
+
+	resp, err := s.do(ctx, u, accept, s.authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && s.authorization == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		if err := s.authorize(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		if resp, err = s.do(ctx, u, accept, s.authorization); err != nil {
+			return nil, err
+		}
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, u)
+	}
+
+	return readAll(resp.Body, maxSize)
+}
+
+func (s *session) do(ctx context.Context, u, accept, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return s.client.Do(req)
+}
+
+// authorize sets the authorization of the session according to the challenge of the registry.
+// It supports the Basic scheme and the Bearer scheme of the token authentication of Docker registries.
+// https://distribution.github.io/distribution/spec/auth/token/
+func (s *session) authorize(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if s.credentials == nil {
+			return errCredentialsRequired
+		}
+
+		s.authorization = "Basic " + basicAuth(s.credentials)
+
+		return nil
+	case "bearer":
+		token, err := s.fetchToken(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to fetch token: %w", err)
+		}
+
+		s.authorization = "Bearer " + token
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+func (s *session) fetchToken(ctx context.Context, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme != "https" || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm %q", params["realm"])
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + s.name + ":pull"
+	}
+
+	query := realm.Query()
+	query.Set("scope", scope)
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	realm.RawQuery = query.Encode()
+
+	var authorization string
+	if s.credentials != nil {
+		authorization = "Basic " + basicAuth(s.credentials)
+	}
+
+	resp, err := s.do(ctx, realm.String(), "", authorization)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d from token server", resp.StatusCode)
+	}
+
+	body, err := readAll(resp.Body, maxTokenResponseSize)
+	if err != nil {
+		return "", err
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return "", fmt.Errorf("failed to parse token response: %w", err)
+	}
+
+	if tr.Token != "" {
+		return tr.Token, nil
+	}
+
+	if tr.AccessToken != "" {
+		return tr.AccessToken, nil
+	}
+
+	return "", errors.New("token response has no token")
+}
+
+// parseChallenge parses a WWW-Authenticate header like `Bearer realm="https://auth.example.com/token",scope="..."`
+// into the scheme and the parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+
+			params[key] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+		}
+
+		rest = strings.TrimLeft(rest, ", ")
+	}
+
+	return scheme, params
+}
+
+func basicAuth(credentials *Credentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+}
+
+// readAll reads the body up to the maximum size, and returns an error if the body is larger.
+func readAll(body io.Reader, maxSize int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("response exceeds the maximum size %d", maxSize)
+	}
+
+	return content, nil
+}
+
+// verifyDigest verifies that the content matches the digest. Only sha256 digests are supported.
+func verifyDigest(content []byte, digest string) error {
+	if !strings.HasPrefix(digest, digestPrefix) {
+		return fmt.Errorf("unsupported digest %q", digest)
+	}
+
+	sum := sha256.Sum256(content)
+	if actual := digestPrefix + hex.EncodeToString(sum[:]); actual != digest {
+		return fmt.Errorf("digest %s doesn't match the expected digest %s", actual, digest)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package artifacts
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseChallenge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expParams map[string]string
+		name      string
+		challenge string
+		expScheme string
+	}{
+		{
+			name: "bearer",
+			challenge: `Bearer realm="https://auth.example.com/token",service="registry.example.com",` +
+				`scope="repository:a/b:pull,push"`,
+			expScheme: "Bearer",
+			expParams: map[string]string{
+				"realm":   "https://auth.example.com/token",
+				"service": "registry.example.com",
+				"scope":   "repository:a/b:pull,push",
+			},
+		},
+		{
+			name:      "basic with unquoted param",
+			challenge: `Basic realm=registry`,
+			expScheme: "Basic",
+			expParams: map[string]string{"realm": "registry"},
+		},
+		{
+			name:      "scheme only",
+			challenge: "Basic",
+			expScheme: "Basic",
+			expParams: map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			scheme, params := parseChallenge(test.challenge)
+			g.Expect(scheme).To(Equal(test.expScheme))
+			g.Expect(params).To(Equal(test.expParams))
+		})
+	}
+}
+
+func TestVerifyDigest(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	content := []byte("hello")
+	digest := "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	g.Expect(verifyDigest(content, digest)).To(Succeed())
+	g.Expect(verifyDigest([]byte("hello!"), digest)).ToNot(Succeed())
+	g.Expect(verifyDigest(content, "sha512:abc")).ToNot(Succeed())
+}
@@ -0,0 +1,469 @@
+package artifacts
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	mediaTypeSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	signatureAnnotation   = "dev.cosignproject.cosign/signature"
+	certificateAnnotation = "dev.sigstore.cosign/certificate"
+	chainAnnotation       = "dev.sigstore.cosign/chain"
+	bundleAnnotation      = "dev.sigstore.cosign/bundle"
+
+	// simpleSigningType is the type of the payload of the signatures of cosign.
+	simpleSigningType = "cosign container image signature"
+
+	// maxSignaturePayloadSize is the maximum size of the payload of a signature.
+	maxSignaturePayloadSize = 64 * 1024
+)
+
+var (
+	// oidcIssuerOID is the OID of the extension of Fulcio certificates with the OIDC issuer
+	// as a DER-encoded UTF8String.
+	oidcIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	// legacyOIDCIssuerOID is the OID of the deprecated extension of Fulcio certificates with the OIDC issuer
+	// as a raw string.
+	legacyOIDCIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+)
+
+// errNoSignatures is returned when the artifact has no cosign signatures.
+var errNoSignatures = errors.New("artifact has no cosign signatures")
+
+// Verification verifies the cosign signatures of an artifact. An artifact is verified if one of its signatures
+// is verified. Create it with NewKeyVerification or NewKeylessVerification.
+type Verification struct {
+	// publicKey is the public key that signed the artifact. Nil for the keyless verification.
+	publicKey crypto.PublicKey
+	// keyless is the keyless verification. Nil for the verification with a public key.
+	keyless *keylessVerification
+	// id identifies the verification, so that the artifacts verified differently are cached separately.
+	id string
+}
+
+type keylessVerification struct {
+	roots    *x509.CertPool
+	rekorKey crypto.PublicKey
+	identity Identity
+	// rekorLogID is the ID of the Rekor log, which is the hex-encoded SHA-256 digest of its public key.
+	rekorLogID string
+}
+
+// Identity is the identity that signed an artifact with the keyless signing of cosign.
+type Identity struct {
+	// SubjectRegexp matches the subject, if Subject is empty.
+	SubjectRegexp *regexp.Regexp
+	// Issuer is the OIDC issuer of the identity.
+	Issuer string
+	// Subject is the subject of the identity, which is the email or the URI in the certificate.
+	Subject string
+}
+
+// TrustRoot holds the PEM-encoded trust material of the keyless verification.
+type TrustRoot struct {
+	// FulcioCertificates are the root and the intermediate certificates of Fulcio.
+	FulcioCertificates []byte
+	// RekorPublicKey is the public key of the Rekor transparency log.
+	RekorPublicKey []byte
+}
+
+// NewKeyVerification returns a Verification of the signatures made with the PEM-encoded public key.
+func NewKeyVerification(publicKeyPEM []byte) (*Verification, error) {
+	key, err := parsePublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verification{
+		publicKey: key,
+		id:        hashOf([]byte("key"), publicKeyPEM),
+	}, nil
+}
+
+// NewKeylessVerification returns a Verification of the keyless signatures made by the identity, with
+// the certificates issued by Fulcio and recorded in the Rekor transparency log.
+func NewKeylessVerification(trustRoot TrustRoot, identity Identity) (*Verification, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(trustRoot.FulcioCertificates) {
+		return nil, errors.New("no valid PEM-encoded Fulcio certificates")
+	}
+
+	rekorKey, err := parsePublicKey(trustRoot.RekorPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Rekor public key: %w", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(rekorKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Rekor public key: %w", err)
+	}
+
+	logID := sha256.Sum256(der)
+
+	subject := identity.Subject
+	if subject == "" && identity.SubjectRegexp != nil {
+		subject = identity.SubjectRegexp.String()
+	}
+
+	return &Verification{
+		keyless: &keylessVerification{
+			roots:      roots,
+			rekorKey:   rekorKey,
+			rekorLogID: hex.EncodeToString(logID[:]),
+			identity:   identity,
+		},
+		id: hashOf(
+			[]byte("keyless"),
+			trustRoot.FulcioCertificates,
+			trustRoot.RekorPublicKey,
+			[]byte(identity.Issuer),
+			[]byte(subject),
+		),
+	}, nil
+}
+
+// VerificationError is returned when the signatures of an artifact can't be verified.
+type VerificationError struct {
+	Err error
+}
+
+func (e *VerificationError) Error() string {
+	return "signature verification failed: " + e.Err.Error()
+}
+
+func (e *VerificationError) Unwrap() error {
+	return e.Err
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// rekorBundle is the bundle of cosign with the entry of the signature in the Rekor transparency log.
+type rekorBundle struct {
+	SignedEntryTimestamp []byte           `json:"SignedEntryTimestamp"`
+	Payload              rekorBundleEntry `json:"Payload"`
+}
+
+// rekorBundleEntry is the entry in the Rekor log. The fields are in the order of the canonical JSON encoding
+// that Rekor signs.
+type rekorBundleEntry struct { //nolint:govet // the order of the fields is the order of the JSON keys
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+type hashedRekord struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// verifySignatures fetches the cosign signatures of the artifact from the tag that cosign attaches them to,
+// and verifies that one of them signs the digest of the manifest of the artifact.
+func (s *session) verifySignatures(ctx context.Context, digest string, v *Verification) error {
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+
+	manifestContent, err := s.get(ctx, "manifests/"+tag, mediaTypeOCIManifest, maxManifestSize)
+	if err != nil {
+		return &VerificationError{Err: fmt.Errorf("failed to fetch signatures: %w", err)}
+	}
+
+	var m manifest
+	if err := json.Unmarshal(manifestContent, &m); err != nil {
+		return &VerificationError{Err: fmt.Errorf("failed to parse signatures manifest: %w", err)}
+	}
+
+	errs := []error{errNoSignatures}
+
+	for _, layer := range m.Layers {
+		if layer.MediaType != mediaTypeSimpleSigning {
+			continue
+		}
+
+		if err := s.verifySignature(ctx, layer, digest, v); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return nil
+	}
+
+	if len(errs) > 1 {
+		// some signatures were found, so report why they aren't verified
+		errs = errs[1:]
+	}
+
+	return &VerificationError{Err: errors.Join(errs...)}
+}
+
+func (s *session) verifySignature(ctx context.Context, layer descriptor, digest string, v *Verification) error {
+	signature, err := base64.StdEncoding.DecodeString(layer.Annotations[signatureAnnotation])
+	if err != nil || len(signature) == 0 {
+		return errors.New("signature annotation is missing or invalid")
+	}
+
+	payload, err := s.get(ctx, "blobs/"+layer.Digest, "", maxSignaturePayloadSize)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signature payload: %w", err)
+	}
+
+	if err := verifyDigest(payload, layer.Digest); err != nil {
+		return fmt.Errorf("failed to verify signature payload: %w", err)
+	}
+
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed to parse signature payload: %w", err)
+	}
+
+	if p.Critical.Type != simpleSigningType {
+		return fmt.Errorf("unsupported signature payload type %q", p.Critical.Type)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for the digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+
+	if v.keyless == nil {
+		return verifySignature(v.publicKey, payload, signature)
+	}
+
+	return v.keyless.verify(layer.Annotations, payload, signature)
+}
+
+// verify verifies the signature with the certificate issued by Fulcio to the identity, at the time when the
+// signature was integrated into the Rekor log, according to the signed entry timestamp of the log.
+func (k *keylessVerification) verify(annotations map[string]string, payload, signature []byte) error {
+	cert, err := parseCertificate([]byte(annotations[certificateAnnotation]))
+	if err != nil {
+		return fmt.Errorf("invalid signing certificate: %w", err)
+	}
+
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(annotations[chainAnnotation]))
+
+	integratedTime, err := k.verifyBundle([]byte(annotations[bundleAnnotation]), cert, payload, signature)
+	if err != nil {
+		return fmt.Errorf("failed to verify Rekor bundle: %w", err)
+	}
+
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         k.roots,
+		Intermediates: intermediates,
+		CurrentTime:   integratedTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("failed to verify signing certificate: %w", err)
+	}
+
+	if err := k.identity.verify(cert); err != nil {
+		return err
+	}
+
+	return verifySignature(cert.PublicKey, payload, signature)
+}
+
+// verifyBundle verifies that the Rekor log signed the entry of the signature, and returns the time when
+// the entry was integrated into the log.
+func (k *keylessVerification) verifyBundle(
+	bundleJSON []byte,
+	cert *x509.Certificate,
+	payload,
+	signature []byte,
+) (time.Time, error) {
+	if len(bundleJSON) == 0 {
+		return time.Time{}, errors.New("bundle annotation is missing")
+	}
+
+	var bundle rekorBundle
+	if err := json.Unmarshal(bundleJSON, &bundle); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+
+	if bundle.Payload.LogID != k.rekorLogID {
+		return time.Time{}, fmt.Errorf("entry is from the unknown log %s", bundle.Payload.LogID)
+	}
+
+	canonical, err := json.Marshal(bundle.Payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := verifySignature(k.rekorKey, canonical, bundle.SignedEntryTimestamp); err != nil {
+		return time.Time{}, fmt.Errorf("invalid signed entry timestamp: %w", err)
+	}
+
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid entry body: %w", err)
+	}
+
+	var entry hashedRekord
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse entry body: %w", err)
+	}
+
+	if entry.Kind != "hashedrekord" || entry.Spec.Data.Hash.Algorithm != "sha256" {
+		return time.Time{}, fmt.Errorf("unsupported entry kind %q", entry.Kind)
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	if entry.Spec.Data.Hash.Value != hex.EncodeToString(payloadHash[:]) ||
+		!bytes.Equal(entry.Spec.Signature.Content, signature) {
+		return time.Time{}, errors.New("entry doesn't match the signature")
+	}
+
+	entryCert, err := parseCertificate(entry.Spec.Signature.PublicKey.Content)
+	if err != nil || !entryCert.Equal(cert) {
+		return time.Time{}, errors.New("entry doesn't match the signing certificate")
+	}
+
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+// verify verifies that the Fulcio certificate is issued to the identity.
+func (i Identity) verify(cert *x509.Certificate) error {
+	issuer, err := oidcIssuer(cert)
+	if err != nil {
+		return err
+	}
+
+	if issuer != i.Issuer {
+		return fmt.Errorf("signing certificate is issued for the OIDC issuer %q", issuer)
+	}
+
+	subjects := make([]string, 0, len(cert.EmailAddresses)+len(cert.URIs))
+	subjects = append(subjects, cert.EmailAddresses...)
+
+	for _, u := range cert.URIs {
+		subjects = append(subjects, u.String())
+	}
+
+	for _, subject := range subjects {
+		if i.Subject != "" && subject == i.Subject {
+			return nil
+		}
+
+		if i.Subject == "" && i.SubjectRegexp != nil && i.SubjectRegexp.MatchString(subject) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("signing certificate is issued for the subjects %q", subjects)
+}
+
+func oidcIssuer(cert *x509.Certificate) (string, error) {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidcIssuerOID):
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err != nil {
+				return "", fmt.Errorf("invalid OIDC issuer extension: %w", err)
+			}
+
+			return issuer, nil
+		case ext.Id.Equal(legacyOIDCIssuerOID):
+			return string(ext.Value), nil
+		}
+	}
+
+	return "", errors.New("signing certificate has no OIDC issuer")
+}
+
+// verifySignature verifies the signature of the message with the public key. ECDSA and RSA signatures
+// are of the SHA-256 digest of the message.
+func verifySignature(publicKey crypto.PublicKey, message, signature []byte) error {
+	digest := sha256.Sum256(message)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("invalid RSA signature: %w", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, signature) {
+			return errors.New("invalid Ed25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return nil
+}
+
+func parsePublicKey(publicKeyPEM []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("no PEM-encoded public key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	return key, nil
+}
+
+func parseCertificate(certificatePEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM-encoded certificate")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// hashOf returns the hex-encoded SHA-256 digest of the values, separated so that different values
+// can't produce the same digest.
+func hashOf(values ...[]byte) string {
+	h := sha256.New()
+
+	for _, v := range values {
+		fmt.Fprintf(h, "%d:", len(v))
+		h.Write(v)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
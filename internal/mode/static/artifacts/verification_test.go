@@ -0,0 +1,471 @@
+package artifacts
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testIssuer  = "https://issuer.example.com"
+	testSubject = "dev@example.com"
+)
+
+// testSigner signs artifacts with a key, and with a certificate issued by a test Fulcio and recorded
+// in a test Rekor log.
+type testSigner struct {
+	// integratedTime is the time when the signatures are integrated into the Rekor log.
+	// The signing certificate is valid only around this time.
+	integratedTime time.Time
+	key            *ecdsa.PrivateKey
+	rekorKey       *ecdsa.PrivateKey
+	rekorLogID     string
+	trustRoot      TrustRoot
+	certificate    []byte
+	publicKeyPEM   []byte
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	t.Helper()
+
+	s := &testSigner{
+		key:            generateKey(t),
+		rekorKey:       generateKey(t),
+		integratedTime: time.Now().Add(-time.Hour).Truncate(time.Second),
+	}
+
+	s.publicKeyPEM = publicKeyPEM(t, &s.key.PublicKey)
+
+	caKey := generateKey(t)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer, err := asn1.MarshalWithParams(testIssuer, "utf8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafTemplate := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       s.integratedTime.Add(-time.Minute),
+		NotAfter:        s.integratedTime.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{testSubject},
+		ExtraExtensions: []pkix.Extension{{Id: oidcIssuerOID, Value: issuer}},
+	}
+
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, ca, &s.key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.certificate = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+	s.trustRoot = TrustRoot{
+		FulcioCertificates: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		RekorPublicKey:     publicKeyPEM(t, &s.rekorKey.PublicKey),
+	}
+
+	rekorDER, err := x509.MarshalPKIXPublicKey(&s.rekorKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logID := sha256.Sum256(rekorDER)
+	s.rekorLogID = hex.EncodeToString(logID[:])
+
+	return s
+}
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, message []byte) []byte {
+	t.Helper()
+
+	digest := sha256.Sum256(message)
+
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signature
+}
+
+func signaturePayload(digest string) []byte {
+	return []byte(`{"critical":{"identity":{"docker-reference":"registry.example.com/team/snippets"},` +
+		`"image":{"docker-manifest-digest":"` + digest + `"},"type":"` + simpleSigningType + `"},"optional":null}`)
+}
+
+// annotations returns the annotations of the signature layer of the payload signed by the signer.
+// If keyless is true, the annotations include the signing certificate and the Rekor bundle.
+func (s *testSigner) annotations(t *testing.T, payload []byte, keyless bool) map[string]string {
+	t.Helper()
+
+	signature := sign(t, s.key, payload)
+
+	annotations := map[string]string{
+		signatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}
+
+	if !keyless {
+		return annotations
+	}
+
+	var body hashedRekord
+	body.Kind = "hashedrekord"
+	payloadHash := sha256.Sum256(payload)
+	body.Spec.Data.Hash.Algorithm = "sha256"
+	body.Spec.Data.Hash.Value = hex.EncodeToString(payloadHash[:])
+	body.Spec.Signature.Content = signature
+	body.Spec.Signature.PublicKey.Content = s.certificate
+
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := rekorBundleEntry{
+		Body:           base64.StdEncoding.EncodeToString(bodyJSON),
+		IntegratedTime: s.integratedTime.Unix(),
+		LogID:          s.rekorLogID,
+		LogIndex:       1,
+	}
+
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := json.Marshal(rekorBundle{
+		SignedEntryTimestamp: sign(t, s.rekorKey, entryJSON),
+		Payload:              entry,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	annotations[certificateAnnotation] = string(s.certificate)
+	annotations[bundleAnnotation] = string(bundle)
+
+	return annotations
+}
+
+// newTestSignedRegistry starts a registry that serves an artifact with the content in the team/snippets
+// repository, and a signature of the artifact with the payload and the annotations. The registry
+// doesn't require credentials.
+func newTestSignedRegistry(
+	t *testing.T,
+	content []byte,
+	signature func(digest string) ([]byte, map[string]string),
+) (*httptest.Server, string) {
+	t.Helper()
+
+	marshal := func(m manifest) []byte {
+		b, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return b
+	}
+
+	manifestContent := marshal(manifest{
+		Layers: []descriptor{{MediaType: "text/plain", Digest: digestOf(content), Size: int64(len(content))}},
+	})
+	manifestDigest := digestOf(manifestContent)
+
+	payload, annotations := signature(manifestDigest)
+	signatureManifest := marshal(manifest{
+		Layers: []descriptor{
+			{
+				Annotations: annotations,
+				MediaType:   mediaTypeSimpleSigning,
+				Digest:      digestOf(payload),
+				Size:        int64(len(payload)),
+			},
+		},
+	})
+
+	responses := map[string][]byte{
+		"manifests/" + manifestDigest:                                        manifestContent,
+		"manifests/" + strings.Replace(manifestDigest, ":", "-", 1) + ".sig": signatureManifest,
+		"blobs/" + digestOf(content):                                         content,
+		"blobs/" + digestOf(payload):                                         payload,
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[strings.TrimPrefix(r.URL.Path, "/v2/team/snippets/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, manifestDigest
+}
+
+func TestPull_Verification(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestSigner(t)
+	content := []byte("location /test { return 200; }")
+
+	keyVerification, err := NewKeyVerification(signer.publicKeyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherKeyVerification, err := NewKeyVerification(publicKeyPEM(t, &generateKey(t).PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keylessVerification := func(identity Identity) *Verification {
+		v, err := NewKeylessVerification(signer.trustRoot, identity)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return v
+	}
+
+	signed := func(keyless bool) func(string) ([]byte, map[string]string) {
+		return func(digest string) ([]byte, map[string]string) {
+			payload := signaturePayload(digest)
+			return payload, signer.annotations(t, payload, keyless)
+		}
+	}
+
+	tests := []struct {
+		signature    func(digest string) ([]byte, map[string]string)
+		verification *Verification
+		name         string
+		expErr       string
+	}{
+		{
+			name:         "signed with the key",
+			signature:    signed(false),
+			verification: keyVerification,
+		},
+		{
+			name:         "signed with another key",
+			signature:    signed(false),
+			verification: otherKeyVerification,
+			expErr:       "invalid ECDSA signature",
+		},
+		{
+			name: "signature of another artifact",
+			signature: func(string) ([]byte, map[string]string) {
+				payload := signaturePayload(digestOf([]byte("other")))
+				return payload, signer.annotations(t, payload, false)
+			},
+			verification: keyVerification,
+			expErr:       "signature is for the digest",
+		},
+		{
+			name: "signature is missing",
+			signature: func(digest string) ([]byte, map[string]string) {
+				return signaturePayload(digest), nil
+			},
+			verification: keyVerification,
+			expErr:       "signature annotation is missing or invalid",
+		},
+		{
+			name:         "signed with a certificate of the identity",
+			signature:    signed(true),
+			verification: keylessVerification(Identity{Issuer: testIssuer, Subject: testSubject}),
+		},
+		{
+			name:      "signed with a certificate of an identity matching the regex",
+			signature: signed(true),
+			verification: keylessVerification(Identity{
+				Issuer:        testIssuer,
+				SubjectRegexp: regexp.MustCompile(`^.*@example\.com$`),
+			}),
+		},
+		{
+			name:         "signed with a certificate of another subject",
+			signature:    signed(true),
+			verification: keylessVerification(Identity{Issuer: testIssuer, Subject: "other@example.com"}),
+			expErr:       "signing certificate is issued for the subjects",
+		},
+		{
+			name:         "signed with a certificate of another issuer",
+			signature:    signed(true),
+			verification: keylessVerification(Identity{Issuer: "https://other.example.com", Subject: testSubject}),
+			expErr:       "signing certificate is issued for the OIDC issuer",
+		},
+		{
+			name: "signed with a certificate without a Rekor bundle",
+			signature: func(digest string) ([]byte, map[string]string) {
+				payload := signaturePayload(digest)
+				annotations := signer.annotations(t, payload, true)
+				delete(annotations, bundleAnnotation)
+
+				return payload, annotations
+			},
+			verification: keylessVerification(Identity{Issuer: testIssuer, Subject: testSubject}),
+			expErr:       "bundle annotation is missing",
+		},
+		{
+			name: "signed with a certificate with a tampered Rekor bundle",
+			signature: func(digest string) ([]byte, map[string]string) {
+				payload := signaturePayload(digest)
+				annotations := signer.annotations(t, payload, true)
+				annotations[bundleAnnotation] = strings.Replace(
+					annotations[bundleAnnotation],
+					`"logIndex":1`,
+					`"logIndex":2`,
+					1,
+				)
+
+				return payload, annotations
+			},
+			verification: keylessVerification(Identity{Issuer: testIssuer, Subject: testSubject}),
+			expErr:       "invalid signed entry timestamp",
+		},
+		{
+			name:         "signed with a key instead of a certificate",
+			signature:    signed(false),
+			verification: keylessVerification(Identity{Issuer: testIssuer, Subject: testSubject}),
+			expErr:       "invalid signing certificate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			server, manifestDigest := newTestSignedRegistry(t, content, test.signature)
+
+			ref := Reference{
+				Verification: test.verification,
+				Repository:   strings.TrimPrefix(server.URL, "https://") + "/team/snippets",
+				Digest:       manifestDigest,
+			}
+
+			fetched, err := pull(context.Background(), server.Client(), ref, DefaultMaxArtifactSize)
+			if test.expErr != "" {
+				var verificationErr *VerificationError
+				g.Expect(err).To(BeAssignableToTypeOf(verificationErr))
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+				g.Expect(fetched).To(BeNil())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(fetched).To(Equal(content))
+			}
+		})
+	}
+}
+
+func TestPull_VerificationNoSignatures(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	signer := newTestSigner(t)
+
+	// the registry serves the manifest of the artifact for any tag, so the signatures manifest has no signatures
+	server, manifestDigest := newTestRegistry(t, []byte("snippet"))
+
+	verification, err := NewKeyVerification(signer.publicKeyPEM)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ref := Reference{
+		Credentials:  &Credentials{Username: "user", Password: "pass"},
+		Verification: verification,
+		Repository:   strings.TrimPrefix(server.URL, "https://") + "/team/snippets",
+		Digest:       manifestDigest,
+	}
+
+	_, err = pull(context.Background(), server.Client(), ref, DefaultMaxArtifactSize)
+	g.Expect(err).To(MatchError(ContainSubstring(errNoSignatures.Error())))
+}
+
+func TestNewVerification(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	signer := newTestSigner(t)
+
+	_, err := NewKeyVerification([]byte("invalid"))
+	g.Expect(err).To(MatchError("no PEM-encoded public key"))
+
+	_, err = NewKeylessVerification(TrustRoot{RekorPublicKey: signer.trustRoot.RekorPublicKey}, Identity{})
+	g.Expect(err).To(MatchError("no valid PEM-encoded Fulcio certificates"))
+
+	_, err = NewKeylessVerification(TrustRoot{FulcioCertificates: signer.trustRoot.FulcioCertificates}, Identity{})
+	g.Expect(err).To(MatchError(ContainSubstring("invalid Rekor public key")))
+
+	first, err := NewKeylessVerification(signer.trustRoot, Identity{Issuer: testIssuer, Subject: testSubject})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	second, err := NewKeylessVerification(signer.trustRoot, Identity{Issuer: testIssuer, Subject: "other"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	key, err := NewKeyVerification(signer.publicKeyPEM)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(first.id).ToNot(Equal(second.id))
+	g.Expect(first.id).ToNot(Equal(key.id))
+}
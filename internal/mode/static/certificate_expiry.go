@@ -0,0 +1,201 @@
+package static
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// certificateExpiryCheckPeriod is the period of checking the expiry of the certificates of the Listeners.
+	certificateExpiryCheckPeriod = time.Minute
+
+	// certificateExpiringReason is the reason of the Warning event for a certificate that expires soon.
+	certificateExpiringReason = "CertificateExpiring"
+	// certificateExpiredReason is the reason of the Warning event for a certificate that has expired.
+	certificateExpiredReason = "CertificateExpired"
+)
+
+type certificateExpiryMetricsCollector interface {
+	SetCertificateExpiries(expiries []collectors.CertificateExpiry)
+}
+
+type graphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// certificateExpiryKey identifies the certificate of a Listener.
+type certificateExpiryKey struct {
+	gateway  types.NamespacedName
+	secret   types.NamespacedName
+	listener string
+}
+
+// certificateExpiryWarning is the Warning event that was emitted for the certificate of a Listener.
+type certificateExpiryWarning struct {
+	notAfter time.Time
+	reason   string
+}
+
+// certificateExpiryMonitor tracks the expiry of the certificates of the Listeners. It exposes the number of days until
+// the certificates expire as a metric, and emits a Warning event for the Gateway once the certificate of a Listener
+// expires within the warning threshold, and once more when it has expired.
+type certificateExpiryMonitor struct {
+	graphGetter      graphGetter
+	metricsCollector certificateExpiryMetricsCollector
+	eventRecorder    record.EventRecorder
+	// warnings holds the Warning events that were emitted, so that they are not emitted again
+	// until the certificate changes.
+	warnings map[certificateExpiryKey]certificateExpiryWarning
+	logger   logr.Logger
+	// warningThreshold is the time before the expiry of a certificate when the Warning event is emitted.
+	// If zero, the Warning events are disabled.
+	warningThreshold time.Duration
+}
+
+func newCertificateExpiryMonitor(
+	graphGetter graphGetter,
+	metricsCollector certificateExpiryMetricsCollector,
+	eventRecorder record.EventRecorder,
+	logger logr.Logger,
+	warningThreshold time.Duration,
+) *certificateExpiryMonitor {
+	return &certificateExpiryMonitor{
+		graphGetter:      graphGetter,
+		metricsCollector: metricsCollector,
+		eventRecorder:    eventRecorder,
+		warnings:         make(map[certificateExpiryKey]certificateExpiryWarning),
+		logger:           logger,
+		warningThreshold: warningThreshold,
+	}
+}
+
+// worker returns the worker of the cronjob that runs the monitor.
+func (m *certificateExpiryMonitor) worker() func(context.Context) {
+	return func(_ context.Context) {
+		m.check(time.Now())
+	}
+}
+
+// check updates the metrics and emits the Warning events of the certificates of the Listeners of the latest Graph.
+func (m *certificateExpiryMonitor) check(now time.Time) {
+	var gateways []*graph.Gateway
+	var secrets map[types.NamespacedName]*graph.Secret
+
+	if gr := m.graphGetter.GetLatestGraph(); gr != nil && gr.Gateway != nil {
+		gateways = append(gateways, gr.Gateway)
+		for _, gw := range gr.MergedGateways {
+			gateways = append(gateways, gw)
+		}
+
+		secrets = gr.ReferencedSecrets
+	}
+
+	expiries := make([]collectors.CertificateExpiry, 0)
+	seen := make(map[certificateExpiryKey]struct{})
+
+	for _, gw := range gateways {
+		for _, l := range gw.Listeners {
+			if l.ResolvedSecret == nil {
+				continue
+			}
+
+			secret, exists := secrets[*l.ResolvedSecret]
+			if !exists || secret.CertBundle == nil {
+				continue
+			}
+
+			notAfter, err := getCertificateNotAfter(secret.CertBundle.Cert.TLSCert)
+			if err != nil {
+				m.logger.Error(err, "Failed to get the expiry of the certificate", "secret", l.ResolvedSecret.String())
+				continue
+			}
+
+			key := certificateExpiryKey{
+				gateway:  l.GatewayName,
+				secret:   *l.ResolvedSecret,
+				listener: l.Name,
+			}
+			seen[key] = struct{}{}
+
+			expiries = append(expiries, collectors.CertificateExpiry{
+				Gateway:  key.gateway.String(),
+				Listener: key.listener,
+				Secret:   key.secret.String(),
+				Days:     notAfter.Sub(now).Hours() / 24,
+			})
+
+			m.warn(gw, key, notAfter, now)
+		}
+	}
+
+	for key := range m.warnings {
+		if _, exists := seen[key]; !exists {
+			delete(m.warnings, key)
+		}
+	}
+
+	m.metricsCollector.SetCertificateExpiries(expiries)
+}
+
+// warn emits a Warning event for the Gateway if the certificate of the Listener expires within the warning threshold
+// and the event wasn't emitted yet for the certificate.
+func (m *certificateExpiryMonitor) warn(gw *graph.Gateway, key certificateExpiryKey, notAfter, now time.Time) {
+	if m.warningThreshold == 0 || notAfter.Sub(now) >= m.warningThreshold {
+		return
+	}
+
+	var msg string
+	reason := certificateExpiringReason
+
+	if notAfter.After(now) {
+		msg = fmt.Sprintf(
+			"Certificate of Secret %s of Listener %s expires in %d days on %s",
+			key.secret,
+			key.listener,
+			int(notAfter.Sub(now).Hours()/24),
+			notAfter.UTC().Format(time.RFC3339),
+		)
+	} else {
+		reason = certificateExpiredReason
+		msg = fmt.Sprintf(
+			"Certificate of Secret %s of Listener %s expired on %s",
+			key.secret,
+			key.listener,
+			notAfter.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if w, exists := m.warnings[key]; exists && w.reason == reason && w.notAfter.Equal(notAfter) {
+		return
+	}
+
+	m.warnings[key] = certificateExpiryWarning{notAfter: notAfter, reason: reason}
+	m.eventRecorder.Event(gw.Source, v1.EventTypeWarning, reason, msg)
+}
+
+// getCertificateNotAfter returns the time when the first certificate of the chain expires.
+func getCertificateNotAfter(tlsCert []byte) (time.Time, error) {
+	block, _ := pem.Decode(tlsCert)
+	if block == nil {
+		return time.Time{}, errors.New("failed to decode the certificate PEM block")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse the certificate: %w", err)
+	}
+
+	return cert.NotAfter, nil
+}
@@ -0,0 +1,220 @@
+package static
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+type latestGraphGetter struct {
+	graph *graph.Graph
+}
+
+func (g *latestGraphGetter) GetLatestGraph() *graph.Graph {
+	return g.graph
+}
+
+type certificateExpiriesRecorder struct {
+	expiries []collectors.CertificateExpiry
+}
+
+func (r *certificateExpiriesRecorder) SetCertificateExpiries(expiries []collectors.CertificateExpiry) {
+	r.expiries = expiries
+}
+
+func createCertificate(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cafe.example.com"},
+		DNSNames:     []string{"cafe.example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCertificateExpiryMonitor(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	expiringSecret := types.NamespacedName{Namespace: "test", Name: "expiring"}
+	validSecret := types.NamespacedName{Namespace: "test", Name: "valid"}
+
+	createSecret := func(notAfter time.Time) *graph.Secret {
+		return &graph.Secret{
+			CertBundle: graph.NewCertificateBundle(
+				expiringSecret,
+				"Secret",
+				&graph.Certificate{TLSCert: createCertificate(t, notAfter)},
+			),
+		}
+	}
+
+	gr := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+			},
+			Listeners: []*graph.Listener{
+				{Name: "https-expiring", GatewayName: gwNsName, ResolvedSecret: &expiringSecret},
+				{Name: "https-valid", GatewayName: gwNsName, ResolvedSecret: &validSecret},
+				{Name: "http", GatewayName: gwNsName},
+			},
+		},
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+			expiringSecret: createSecret(now.Add(10 * day)),
+			validSecret:    createSecret(now.Add(100 * day)),
+		},
+	}
+
+	metricsRecorder := &certificateExpiriesRecorder{}
+	eventRecorder := record.NewFakeRecorder(10)
+
+	monitor := newCertificateExpiryMonitor(
+		&latestGraphGetter{graph: gr},
+		metricsRecorder,
+		eventRecorder,
+		logr.Discard(),
+		30*day,
+	)
+
+	monitor.check(now)
+
+	g.Expect(metricsRecorder.expiries).To(ConsistOf(
+		collectors.CertificateExpiry{
+			Gateway:  "test/gateway",
+			Listener: "https-expiring",
+			Secret:   "test/expiring",
+			Days:     10,
+		},
+		collectors.CertificateExpiry{
+			Gateway:  "test/gateway",
+			Listener: "https-valid",
+			Secret:   "test/valid",
+			Days:     100,
+		},
+	))
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	g.Expect(<-eventRecorder.Events).To(Equal(
+		"Warning CertificateExpiring Certificate of Secret test/expiring of Listener https-expiring " +
+			"expires in 10 days on 2025-01-11T00:00:00Z",
+	))
+
+	// the Warning event is not emitted again for the same certificate
+	monitor.check(now.Add(day))
+	g.Expect(eventRecorder.Events).To(BeEmpty())
+
+	monitor.check(now.Add(11 * day))
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	g.Expect(<-eventRecorder.Events).To(Equal(
+		"Warning CertificateExpired Certificate of Secret test/expiring of Listener https-expiring " +
+			"expired on 2025-01-11T00:00:00Z",
+	))
+
+	// a renewed certificate that still expires soon is warned about again
+	gr.ReferencedSecrets[expiringSecret] = createSecret(now.Add(20 * day))
+
+	monitor.check(now.Add(11 * day))
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	g.Expect(<-eventRecorder.Events).To(ContainSubstring("expires in 9 days"))
+
+	// the metrics of the certificates are removed once the Listeners no longer reference them
+	gr.Gateway.Listeners = gr.Gateway.Listeners[2:]
+
+	monitor.check(now.Add(11 * day))
+	g.Expect(metricsRecorder.expiries).To(BeEmpty())
+	g.Expect(monitor.warnings).To(BeEmpty())
+}
+
+func TestCertificateExpiryMonitor_WarningsDisabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+
+	gr := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &gatewayv1.Gateway{},
+			Listeners: []*graph.Listener{
+				{Name: "https", ResolvedSecret: &secretNsName},
+			},
+		},
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+			secretNsName: {
+				CertBundle: graph.NewCertificateBundle(
+					secretNsName,
+					"Secret",
+					&graph.Certificate{TLSCert: createCertificate(t, now.Add(-time.Hour))},
+				),
+			},
+		},
+	}
+
+	metricsRecorder := &certificateExpiriesRecorder{}
+	eventRecorder := record.NewFakeRecorder(1)
+
+	monitor := newCertificateExpiryMonitor(
+		&latestGraphGetter{graph: gr},
+		metricsRecorder,
+		eventRecorder,
+		logr.Discard(),
+		0,
+	)
+
+	monitor.check(now)
+
+	g.Expect(metricsRecorder.expiries).To(HaveLen(1))
+	g.Expect(metricsRecorder.expiries[0].Days).To(BeNumerically("<", 0))
+	g.Expect(eventRecorder.Events).To(BeEmpty())
+}
+
+func TestCertificateExpiryMonitor_NoGraph(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	metricsRecorder := &certificateExpiriesRecorder{}
+
+	monitor := newCertificateExpiryMonitor(
+		&latestGraphGetter{},
+		metricsRecorder,
+		record.NewFakeRecorder(1),
+		logr.Discard(),
+		time.Hour,
+	)
+
+	monitor.check(time.Now())
+
+	g.Expect(metricsRecorder.expiries).ToNot(BeNil())
+	g.Expect(metricsRecorder.expiries).To(BeEmpty())
+}
@@ -0,0 +1,235 @@
+package static
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+)
+
+const (
+	// coldStartSnapshotFileName is the name of the file of the cold start snapshot in the snapshot directory.
+	coldStartSnapshotFileName = "nginx-config-snapshot.json.gz"
+
+	// coldStartSnapshotFileMode is the mode of the file of the cold start snapshot. The snapshot includes
+	// the secret files of the configuration, so only the controller can read it.
+	coldStartSnapshotFileMode = 0o600
+)
+
+// coldStartSnapshot is the snapshot of the files of the last applied NGINX configuration.
+type coldStartSnapshot struct {
+	// ControllerVersion is the version of the controller that generated the files.
+	ControllerVersion string `json:"controllerVersion"`
+	// Files are the files of the configuration.
+	Files []coldStartSnapshotFile `json:"files"`
+	// ConfigVersion is the version of the configuration.
+	ConfigVersion int `json:"configVersion"`
+}
+
+// coldStartSnapshotFile is a file of the cold start snapshot.
+type coldStartSnapshotFile struct {
+	Path    string    `json:"path"`
+	Content []byte    `json:"content"`
+	Type    file.Type `json:"type"`
+}
+
+// coldStartSnapshotStore persists the snapshot of the last applied NGINX configuration, so that, on startup,
+// NGINX can be programmed from the snapshot before the controller syncs with the cluster, instead of staying
+// unconfigured until the first configuration is built. The snapshot is expected to be on a persistent volume.
+//
+// The snapshot holds the generated files rather than the data plane configuration, because the files don't
+// depend on the code that generates them, so they can be applied as is.
+type coldStartSnapshotStore struct {
+	logger logr.Logger
+	// path is the path of the file of the snapshot.
+	path string
+	// controllerVersion is the version of this controller. The snapshots of other versions are not restored,
+	// because their files might not be compatible with the NGINX image of this version.
+	controllerVersion string
+	// folders are the NGINX configuration folders. The snapshots with files outside of them are not restored.
+	folders []string
+}
+
+func newColdStartSnapshotStore(
+	dir string,
+	controllerVersion string,
+	folders []string,
+	logger logr.Logger,
+) *coldStartSnapshotStore {
+	return &coldStartSnapshotStore{
+		logger:            logger,
+		path:              filepath.Join(dir, coldStartSnapshotFileName),
+		controllerVersion: controllerVersion,
+		folders:           folders,
+	}
+}
+
+// save persists the files of the applied configuration of the version.
+// Errors are logged rather than returned, since failing to persist the snapshot does not affect NGINX.
+func (s *coldStartSnapshotStore) save(configVersion int, files []file.File) {
+	snapshot := coldStartSnapshot{
+		ControllerVersion: s.controllerVersion,
+		ConfigVersion:     configVersion,
+		Files:             make([]coldStartSnapshotFile, 0, len(files)),
+	}
+
+	for _, f := range files {
+		snapshot.Files = append(snapshot.Files, coldStartSnapshotFile(f))
+	}
+
+	if err := s.write(snapshot); err != nil {
+		s.logger.Error(err, "Failed to persist the cold start snapshot", "path", s.path)
+		return
+	}
+
+	s.logger.V(1).Info("Persisted the cold start snapshot", "path", s.path, "version", configVersion)
+}
+
+// write writes the snapshot to a temporary file, which then replaces the file of the snapshot, so that
+// a partially written snapshot is never restored.
+func (s *coldStartSnapshotStore) write(snapshot coldStartSnapshot) error {
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(snapshot); err != nil {
+		return fmt.Errorf("failed to marshal the snapshot: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to compress the snapshot: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), coldStartSnapshotFileName+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(coldStartSnapshotFileMode); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set the mode of the temporary file: %w", err)
+	}
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the temporary file: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync the temporary file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close the temporary file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace the snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// load reads the persisted snapshot. It returns an error that wraps os.ErrNotExist if no snapshot is persisted.
+// The snapshots of other controller versions and the snapshots with files outside of the configuration folders
+// are rejected.
+func (s *coldStartSnapshotStore) load() (coldStartSnapshot, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return coldStartSnapshot{}, err
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return coldStartSnapshot{}, fmt.Errorf("failed to decompress the snapshot: %w", err)
+	}
+
+	var snapshot coldStartSnapshot
+	if err := json.NewDecoder(zr).Decode(&snapshot); err != nil {
+		return coldStartSnapshot{}, fmt.Errorf("failed to unmarshal the snapshot: %w", err)
+	}
+
+	// reading the rest of the data verifies the checksum of the compressed snapshot
+	if _, err := io.Copy(io.Discard, zr); err != nil {
+		return coldStartSnapshot{}, fmt.Errorf("failed to decompress the snapshot: %w", err)
+	}
+
+	if snapshot.ControllerVersion != s.controllerVersion {
+		return coldStartSnapshot{}, fmt.Errorf(
+			"the snapshot was persisted by the controller version %q, not %q",
+			snapshot.ControllerVersion,
+			s.controllerVersion,
+		)
+	}
+
+	for _, sf := range snapshot.Files {
+		if !s.inConfigFolders(sf.Path) {
+			return coldStartSnapshot{}, fmt.Errorf("the file %q is outside of the NGINX configuration folders", sf.Path)
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (s *coldStartSnapshotStore) inConfigFolders(path string) bool {
+	cleaned := filepath.Clean(path)
+
+	return slices.ContainsFunc(s.folders, func(folder string) bool {
+		return strings.HasPrefix(cleaned, filepath.Clean(folder)+string(filepath.Separator))
+	})
+}
+
+// restoreColdStartSnapshot programs NGINX with the persisted snapshot of the last applied configuration.
+// It returns true if NGINX was programmed. NGINX serves the restored configuration until the controller applies
+// the configuration built from the current state of the cluster.
+func restoreColdStartSnapshot(
+	ctx context.Context,
+	store *coldStartSnapshotStore,
+	fileMgr file.Manager,
+	runtimeMgr runtime.Manager,
+	logger logr.Logger,
+) bool {
+	snapshot, err := store.load()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Info("No cold start snapshot to restore", "path", store.path)
+		} else {
+			logger.Error(err, "Failed to load the cold start snapshot", "path", store.path)
+		}
+
+		return false
+	}
+
+	files := make([]file.File, 0, len(snapshot.Files))
+	for _, sf := range snapshot.Files {
+		files = append(files, file.File(sf))
+	}
+
+	if err := fileMgr.ReplaceFiles(files); err != nil {
+		logger.Error(err, "Failed to write the files of the cold start snapshot")
+		return false
+	}
+
+	if err := runtimeMgr.Reload(ctx, snapshot.ConfigVersion); err != nil {
+		logger.Error(err, "Failed to reload NGINX with the cold start snapshot")
+		return false
+	}
+
+	logger.Info("Restored the cold start snapshot", "path", store.path, "version", snapshot.ConfigVersion)
+
+	return true
+}
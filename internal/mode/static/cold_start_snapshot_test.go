@@ -0,0 +1,208 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file/filefakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
+)
+
+var coldStartSnapshotFolders = []string{"/etc/nginx/conf.d", "/etc/nginx/secrets"}
+
+func TestColdStartSnapshotStore(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("server {}"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+			Content: []byte("private key"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	dir := t.TempDir()
+	store := newColdStartSnapshotStore(dir, "1.0.0", coldStartSnapshotFolders, logr.Discard())
+
+	_, err := store.load()
+	g.Expect(err).To(MatchError(os.ErrNotExist))
+
+	store.save(1, files)
+	store.save(2, files)
+
+	info, err := os.Stat(filepath.Join(dir, coldStartSnapshotFileName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(coldStartSnapshotFileMode)))
+
+	entries, err := os.ReadDir(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(entries).To(HaveLen(1))
+
+	snapshot, err := store.load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot).To(Equal(coldStartSnapshot{
+		ControllerVersion: "1.0.0",
+		ConfigVersion:     2,
+		Files: []coldStartSnapshotFile{
+			coldStartSnapshotFile(files[0]),
+			coldStartSnapshotFile(files[1]),
+		},
+	}))
+}
+
+func TestColdStartSnapshotStore_LoadRejected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		version   string
+		expErr    string
+		files     []file.File
+		corrupted bool
+	}{
+		{
+			name:    "other controller version",
+			version: "0.9.0",
+			expErr:  `the snapshot was persisted by the controller version "0.9.0", not "1.0.0"`,
+		},
+		{
+			name:    "file outside of the configuration folders",
+			version: "1.0.0",
+			files: []file.File{
+				{Path: "/etc/nginx/conf.d/../../passwd", Type: file.TypeRegular},
+			},
+			expErr: `the file "/etc/nginx/conf.d/../../passwd" is outside of the NGINX configuration folders`,
+		},
+		{
+			name:    "file in a folder with the same prefix",
+			version: "1.0.0",
+			files: []file.File{
+				{Path: "/etc/nginx/conf.d-other/http.conf", Type: file.TypeRegular},
+			},
+			expErr: `the file "/etc/nginx/conf.d-other/http.conf" is outside of the NGINX configuration folders`,
+		},
+		{
+			name:      "corrupted snapshot",
+			version:   "1.0.0",
+			corrupted: true,
+			expErr:    "failed to decompress the snapshot: gzip: invalid header",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+
+			writer := newColdStartSnapshotStore(dir, test.version, coldStartSnapshotFolders, logr.Discard())
+			writer.save(1, test.files)
+
+			if test.corrupted {
+				path := filepath.Join(dir, coldStartSnapshotFileName)
+				g.Expect(os.WriteFile(path, []byte("corrupted snapshot"), coldStartSnapshotFileMode)).To(Succeed())
+			}
+
+			store := newColdStartSnapshotStore(dir, "1.0.0", coldStartSnapshotFolders, logr.Discard())
+
+			_, err := store.load()
+			g.Expect(err).To(MatchError(test.expErr))
+		})
+	}
+}
+
+func TestRestoreColdStartSnapshot(t *testing.T) {
+	t.Parallel()
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("server {}"),
+			Type:    file.TypeRegular,
+		},
+	}
+
+	tests := []struct {
+		replaceErr      error
+		reloadErr       error
+		name            string
+		persisted       bool
+		expReload       bool
+		expRestored     bool
+		expReplaceFiles bool
+	}{
+		{
+			name: "no snapshot",
+		},
+		{
+			name:            "snapshot",
+			persisted:       true,
+			expReplaceFiles: true,
+			expReload:       true,
+			expRestored:     true,
+		},
+		{
+			name:            "failed to write the files",
+			persisted:       true,
+			replaceErr:      errors.New("replace error"),
+			expReplaceFiles: true,
+		},
+		{
+			name:            "failed to reload",
+			persisted:       true,
+			reloadErr:       errors.New("reload error"),
+			expReplaceFiles: true,
+			expReload:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			store := newColdStartSnapshotStore(t.TempDir(), "1.0.0", coldStartSnapshotFolders, logr.Discard())
+			if test.persisted {
+				store.save(3, files)
+			}
+
+			fileMgr := &filefakes.FakeManager{}
+			fileMgr.ReplaceFilesReturns(test.replaceErr)
+
+			runtimeMgr := &runtimefakes.FakeManager{}
+			runtimeMgr.ReloadReturns(test.reloadErr)
+
+			restored := restoreColdStartSnapshot(context.Background(), store, fileMgr, runtimeMgr, logr.Discard())
+			g.Expect(restored).To(Equal(test.expRestored))
+
+			if test.expReplaceFiles {
+				g.Expect(fileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				g.Expect(fileMgr.ReplaceFilesArgsForCall(0)).To(Equal(files))
+			} else {
+				g.Expect(fileMgr.ReplaceFilesCallCount()).To(BeZero())
+			}
+
+			if test.expReload {
+				g.Expect(runtimeMgr.ReloadCallCount()).To(Equal(1))
+				_, version := runtimeMgr.ReloadArgsForCall(0)
+				g.Expect(version).To(Equal(3))
+			} else {
+				g.Expect(runtimeMgr.ReloadCallCount()).To(BeZero())
+			}
+		})
+	}
+}
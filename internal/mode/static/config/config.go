@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -22,6 +23,9 @@ type Config struct {
 	// GatewayNsName is the namespaced name of a Gateway resource that the Gateway will use.
 	// The Gateway will ignore all other Gateway resources.
 	GatewayNsName *types.NamespacedName
+	// GatewaySelector selects the Gateway resources of the GatewayClass by their labels.
+	// The Gateway will ignore all Gateway resources that don't match the selector. If nil, all Gateways are selected.
+	GatewaySelector labels.Selector
 	// GatewayPodConfig contains information about this Pod.
 	GatewayPodConfig GatewayPodConfig
 	// Logger is the Zap Logger used by all components.
@@ -34,12 +38,29 @@ type Config struct {
 	GatewayClassName string
 	// LeaderElection contains the configuration for leader election.
 	LeaderElection LeaderElectionConfig
+	// NginxConfigDumpConfig specifies the config for publishing the NGINX configuration to a ConfigMap.
+	NginxConfigDumpConfig NginxConfigDumpConfig
+	// ColdStartSnapshotConfig specifies the persistence of the last applied NGINX configuration.
+	ColdStartSnapshotConfig ColdStartSnapshotConfig
 	// ProductTelemetryConfig contains the configuration for collecting product telemetry.
 	ProductTelemetryConfig ProductTelemetryConfig
+	// DataPlaneAPIConfig specifies the config of the data plane API server.
+	DataPlaneAPIConfig DataPlaneAPIConfig
 	// MetricsConfig specifies the metrics config.
 	MetricsConfig MetricsConfig
 	// HealthConfig specifies the health probe config.
 	HealthConfig HealthConfig
+	// WebhookConfig specifies the conversion and defaulting webhook server config.
+	WebhookConfig WebhookConfig
+	// NginxValidatorConfig specifies the config for validating NGINX configuration using a separate NGINX instance.
+	NginxValidatorConfig NginxValidatorConfig
+	// EventRateLimitConfig specifies the rate limiting of the events of every namespace.
+	EventRateLimitConfig EventRateLimitConfig
+	// NginxErrorLogConfig specifies the parsing of the NGINX error log.
+	NginxErrorLogConfig NginxErrorLogConfig
+	// CertificateExpiryWarningDays is the number of days before the expiry of the certificate of a Listener when
+	// a Warning event is emitted for the Gateway. If zero, the Warning events are disabled.
+	CertificateExpiryWarningDays int
 	// UpdateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	UpdateGatewayClassStatus bool
 	// Plus indicates whether NGINX Plus is being used.
@@ -48,6 +69,12 @@ type Config struct {
 	ExperimentalFeatures bool
 	// SnippetsFilters indicates if SnippetsFilters are enabled.
 	SnippetsFilters bool
+	// RequireArtifactSignatures indicates if the OCI artifacts of SnippetsFilters must have verified signatures.
+	RequireArtifactSignatures bool
+	// ExternalNameServices indicates if the backendRefs of Routes can reference ExternalName Services.
+	ExternalNameServices bool
+	// TemplateOverrides indicates if NginxProxies can override the templates of the generated NGINX config.
+	TemplateOverrides bool
 }
 
 // GatewayPodConfig contains information about this Pod.
@@ -82,6 +109,76 @@ type HealthConfig struct {
 	Enabled bool
 }
 
+// NginxConfigDumpConfig specifies the config for publishing the NGINX configuration to a ConfigMap.
+type NginxConfigDumpConfig struct {
+	// ConfigMapName is the name of the ConfigMap that the NGINX configuration is published to.
+	// The ConfigMap is created in the namespace of this Pod.
+	ConfigMapName string
+	// Enabled is the flag for toggling publishing the NGINX configuration on or off.
+	Enabled bool
+}
+
+// NginxValidatorConfig specifies the config for validating NGINX configuration using a separate NGINX instance.
+type NginxValidatorConfig struct {
+	// Port is the dummy port that the validator NGINX instance listens on.
+	Port int
+	// Enabled is the flag for toggling the validator NGINX instance on or off.
+	Enabled bool
+}
+
+// WebhookConfig specifies the conversion and defaulting webhook server config.
+type WebhookConfig struct {
+	// CertDir is the directory that contains the serving certificate (tls.crt) and key (tls.key), and the CA
+	// certificate (ca.crt) that signs the serving certificate.
+	CertDir string
+	// ServiceName is the name of the Service of the webhook server in the Namespace of this Pod.
+	ServiceName string
+	// Port is the port that the webhook server listens on.
+	Port int
+	// Enabled is the flag for toggling the webhook server on or off.
+	Enabled bool
+}
+
+// DataPlaneAPIConfig specifies the config of the data plane API server, which serves the generated NGINX
+// configuration to NGINX instances outside of the cluster.
+type DataPlaneAPIConfig struct {
+	// CertDir is the directory that contains the serving certificate (tls.crt) and key (tls.key), and the CA
+	// certificate (ca.crt) that verifies the client certificates of the data plane nodes.
+	CertDir string
+	// Port is the port that the data plane API server listens on.
+	Port int
+	// Enabled is the flag for toggling the data plane API server on or off.
+	Enabled bool
+}
+
+// ColdStartSnapshotConfig specifies the persistence of the last applied NGINX configuration, so that NGINX is
+// programmed from it on startup, before the controller syncs with the cluster.
+type ColdStartSnapshotConfig struct {
+	// Dir is the directory the snapshot is persisted to. It is expected to be on a persistent volume.
+	Dir string
+	// Enabled is the flag for toggling the cold start snapshot on or off.
+	Enabled bool
+}
+
+// EventRateLimitConfig specifies the rate limiting of the events of every namespace, so that a namespace that
+// changes its resources very often doesn't delay the reconfiguration for the other namespaces.
+type EventRateLimitConfig struct {
+	// EventsPerSecond is the number of events of a namespace per second that are handled right away.
+	// The events that exceed the rate are deferred. If zero, the events are not limited.
+	EventsPerSecond int
+	// Burst is the number of events of a namespace that are handled right away in excess of the rate.
+	Burst int
+}
+
+// NginxErrorLogConfig specifies the parsing of the NGINX error log, which NGINX sends to the controller
+// over a Unix socket.
+type NginxErrorLogConfig struct {
+	// Enabled is the flag for toggling the parsing of the NGINX error log into logs and metrics on or off.
+	Enabled bool
+	// Events is the flag for toggling the Warning events of the errors for the Gateway on or off.
+	Events bool
+}
+
 // LeaderElectionConfig contains the configuration for leader election.
 type LeaderElectionConfig struct {
 	// LockName holds the name of the leader election lock.
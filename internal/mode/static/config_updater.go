@@ -3,6 +3,7 @@ package static
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/go-logr/logr"
 	apiv1 "k8s.io/api/core/v1"
@@ -15,20 +16,36 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 )
 
+// controlPlaneUpdate describes the outcome of an update of the control plane configuration.
+type controlPlaneUpdate struct {
+	// applied is the configuration that is applied to the control plane after the update.
+	applied *ngfAPI.NginxGatewaySpec
+	// changes are the changes of the configuration, in the format "<field>: <old value> -> <new value>".
+	// If pending is false, the changes were applied.
+	changes []string
+	// pending indicates that the changes are not applied, because the configuration is in the Manual apply mode.
+	pending bool
+}
+
 // updateControlPlane updates the control plane configuration with the given user spec.
 // If any fields are not set within the user spec, the default configuration values are used.
+// The applied configuration is the configuration that is currently applied to the control plane, or nil if
+// no configuration has been applied yet. If the user spec is in the Manual apply mode, the changes from
+// the applied configuration are validated and reported, but not applied, unless nothing has been applied yet.
 func updateControlPlane(
 	cfg *ngfAPI.NginxGateway,
+	applied *ngfAPI.NginxGatewaySpec,
 	logger logr.Logger,
 	eventRecorder record.EventRecorder,
 	configNSName types.NamespacedName,
 	logLevelSetter logLevelSetter,
-) error {
+) (controlPlaneUpdate, error) {
 	// build up default configuration
 	controlConfig := ngfAPI.NginxGatewaySpec{
 		Logging: &ngfAPI.Logging{
 			Level: helpers.GetPointer(ngfAPI.ControllerLogLevelInfo),
 		},
+		ApplyMode: helpers.GetPointer(ngfAPI.ControlPlaneApplyModeAutomatic),
 	}
 
 	// by marshaling the user config and then unmarshaling on top of the default config,
@@ -36,11 +53,11 @@ func updateControlPlane(
 	if cfg != nil {
 		cfgBytes, err := json.Marshal(cfg.Spec)
 		if err != nil {
-			return fmt.Errorf("error marshaling control config: %w", err)
+			return controlPlaneUpdate{applied: applied}, fmt.Errorf("error marshaling control config: %w", err)
 		}
 
 		if err := json.Unmarshal(cfgBytes, &controlConfig); err != nil {
-			return fmt.Errorf("error unmarshaling control config: %w", err)
+			return controlPlaneUpdate{applied: applied}, fmt.Errorf("error unmarshaling control config: %w", err)
 		}
 	} else {
 		msg := "NginxGateway configuration was deleted; using defaults"
@@ -61,18 +78,108 @@ func updateControlPlane(
 	level := *controlConfig.Logging.Level
 
 	if err := validateLogLevel(level); err != nil {
-		return err
+		return controlPlaneUpdate{applied: applied}, err
+	}
+
+	if err := validateApplyMode(*controlConfig.ApplyMode); err != nil {
+		return controlPlaneUpdate{applied: applied}, err
+	}
+
+	if err := validateReloadGating(controlConfig.ReloadGating); err != nil {
+		return controlPlaneUpdate{applied: applied}, err
+	}
+
+	update := controlPlaneUpdate{
+		applied: applied,
+		changes: getControlConfigChanges(applied, &controlConfig),
+	}
+
+	// the deleted configuration can't be reviewed, so the defaults are applied right away.
+	if applied != nil && cfg != nil && *controlConfig.ApplyMode == ngfAPI.ControlPlaneApplyModeManual {
+		update.pending = len(update.changes) > 0
+		return update, nil
 	}
 
 	if err := logLevelSetter.SetLevel(string(level)); err != nil {
-		return field.Invalid(
+		return update, field.Invalid(
 			field.NewPath("logging.level"),
 			level,
 			err.Error(),
 		)
 	}
 
-	return nil
+	update.applied = &controlConfig
+
+	return update, nil
+}
+
+// getControlConfigChanges returns the changes from the applied to the desired configuration. The apply mode is not
+// a part of the changes, because it is not applied to the control plane. If nothing is applied yet, all the
+// settings of the desired configuration are changes.
+func getControlConfigChanges(applied, desired *ngfAPI.NginxGatewaySpec) []string {
+	var changes []string
+
+	var appliedLevel ngfAPI.ControllerLogLevel
+	if applied != nil {
+		appliedLevel = *applied.Logging.Level
+	}
+
+	if desiredLevel := *desired.Logging.Level; desiredLevel != appliedLevel {
+		changes = append(changes, formatControlConfigChange("logging.level", string(appliedLevel), string(desiredLevel)))
+	}
+
+	var appliedSmokeTest *ngfAPI.SmokeTest
+	if applied != nil {
+		appliedSmokeTest = applied.SmokeTest
+	}
+
+	if !reflect.DeepEqual(appliedSmokeTest, desired.SmokeTest) {
+		changes = append(
+			changes,
+			formatControlConfigChange("smokeTest", formatJSON(appliedSmokeTest), formatJSON(desired.SmokeTest)),
+		)
+	}
+
+	var appliedReloadGating *ngfAPI.ReloadGating
+	if applied != nil {
+		appliedReloadGating = applied.ReloadGating
+	}
+
+	if !reflect.DeepEqual(appliedReloadGating, desired.ReloadGating) {
+		changes = append(
+			changes,
+			formatControlConfigChange(
+				"reloadGating",
+				formatJSON(appliedReloadGating),
+				formatJSON(desired.ReloadGating),
+			),
+		)
+	}
+
+	return changes
+}
+
+// formatJSON formats the setting of the control plane configuration as JSON, or returns an empty string
+// if it is nil.
+func formatJSON[T any](setting *T) string {
+	if setting == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(setting)
+	if err != nil {
+		return fmt.Sprintf("%v", *setting)
+	}
+
+	return string(data)
+}
+
+func formatControlConfigChange(path, oldValue, newValue string) string {
+	if oldValue == "" {
+		oldValue = "<unset>"
+	}
+
+	return fmt.Sprintf("%s: %s -> %s", path, oldValue, newValue)
 }
 
 func validateLogLevel(level ngfAPI.ControllerLogLevel) error {
@@ -91,3 +198,41 @@ func validateLogLevel(level ngfAPI.ControllerLogLevel) error {
 
 	return nil
 }
+
+func validateApplyMode(mode ngfAPI.ControlPlaneApplyMode) error {
+	switch mode {
+	case ngfAPI.ControlPlaneApplyModeAutomatic, ngfAPI.ControlPlaneApplyModeManual:
+	default:
+		return field.NotSupported(
+			field.NewPath("applyMode"),
+			mode,
+			[]string{
+				string(ngfAPI.ControlPlaneApplyModeAutomatic),
+				string(ngfAPI.ControlPlaneApplyModeManual),
+			})
+	}
+
+	return nil
+}
+
+func validateReloadGating(gating *ngfAPI.ReloadGating) error {
+	if gating == nil {
+		return nil
+	}
+
+	if gating.InFlightRequestsThreshold < 1 {
+		return field.Invalid(
+			field.NewPath("reloadGating.inFlightRequestsThreshold"),
+			gating.InFlightRequestsThreshold,
+			"must be greater than or equal to 1",
+		)
+	}
+
+	if gating.MaxDelay != nil {
+		if _, err := parseDuration(*gating.MaxDelay); err != nil {
+			return field.Invalid(field.NewPath("reloadGating.maxDelay"), *gating.MaxDelay, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -33,21 +33,141 @@ func TestUpdateControlPlane(t *testing.T) {
 		},
 	}
 
+	manualDebugLogCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			Logging: &ngfAPI.Logging{
+				Level: helpers.GetPointer(ngfAPI.ControllerLogLevelDebug),
+			},
+			ApplyMode: helpers.GetPointer(ngfAPI.ControlPlaneApplyModeManual),
+		},
+	}
+
+	invalidApplyModeConfig := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			ApplyMode: helpers.GetPointer[ngfAPI.ControlPlaneApplyMode]("invalid"),
+		},
+	}
+
+	smokeTestCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			Logging: &ngfAPI.Logging{
+				Level: helpers.GetPointer(ngfAPI.ControllerLogLevelInfo),
+			},
+			SmokeTest: &ngfAPI.SmokeTest{
+				Probes: []ngfAPI.SmokeTestProbe{
+					{
+						Name:     "cafe",
+						Hostname: "cafe.example.com",
+						Port:     80,
+					},
+				},
+			},
+		},
+	}
+
+	reloadGatingCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			ReloadGating: &ngfAPI.ReloadGating{
+				InFlightRequestsThreshold: 100,
+				MaxDelay:                  helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+	}
+
+	invalidReloadGatingCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			ReloadGating: &ngfAPI.ReloadGating{
+				InFlightRequestsThreshold: 100,
+				MaxDelay:                  helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			},
+		},
+	}
+
+	infoApplied := &ngfAPI.NginxGatewaySpec{
+		Logging: &ngfAPI.Logging{
+			Level: helpers.GetPointer(ngfAPI.ControllerLogLevelInfo),
+		},
+		ApplyMode: helpers.GetPointer(ngfAPI.ControlPlaneApplyModeAutomatic),
+	}
+
 	logger := logr.Discard()
 	nsname := types.NamespacedName{Namespace: "test", Name: "test"}
 
 	tests := []struct {
 		setLevelErr          error
 		nginxGateway         *ngfAPI.NginxGateway
+		applied              *ngfAPI.NginxGatewaySpec
 		name                 string
 		expErrString         string
+		expChanges           []string
 		expSetLevelCallCount int
 		expEvent             bool
+		expPending           bool
+		expApplied           bool
 	}{
 		{
 			name:                 "change log level",
 			nginxGateway:         debugLogCfg,
+			applied:              infoApplied,
+			expChanges:           []string{"logging.level: info -> debug"},
+			expSetLevelCallCount: 1,
+			expApplied:           true,
+		},
+		{
+			name:         "add smoke test",
+			nginxGateway: smokeTestCfg,
+			applied:      infoApplied,
+			expChanges: []string{
+				`smokeTest: <unset> -> {"probes":[{"name":"cafe","hostname":"cafe.example.com","port":80}]}`,
+			},
+			expSetLevelCallCount: 1,
+			expApplied:           true,
+		},
+		{
+			name:         "add reload gating",
+			nginxGateway: reloadGatingCfg,
+			applied:      infoApplied,
+			expChanges: []string{
+				`reloadGating: <unset> -> {"maxDelay":"10s","inFlightRequestsThreshold":100}`,
+			},
+			expSetLevelCallCount: 1,
+			expApplied:           true,
+		},
+		{
+			name:                 "invalid reload gating",
+			nginxGateway:         invalidReloadGatingCfg,
+			applied:              infoApplied,
+			expErrString:         `reloadGating.maxDelay: Invalid value: "invalid"`,
+			expSetLevelCallCount: 0,
+		},
+		{
+			name:                 "initial configuration",
+			nginxGateway:         debugLogCfg,
+			expChanges:           []string{"logging.level: <unset> -> debug"},
+			expSetLevelCallCount: 1,
+			expApplied:           true,
+		},
+		{
+			name:                 "manual apply mode with pending changes",
+			nginxGateway:         manualDebugLogCfg,
+			applied:              infoApplied,
+			expChanges:           []string{"logging.level: info -> debug"},
+			expSetLevelCallCount: 0,
+			expPending:           true,
+		},
+		{
+			name:                 "manual apply mode applies the initial configuration",
+			nginxGateway:         manualDebugLogCfg,
+			expChanges:           []string{"logging.level: <unset> -> debug"},
 			expSetLevelCallCount: 1,
+			expApplied:           true,
+		},
+		{
+			name:                 "invalid apply mode",
+			nginxGateway:         invalidApplyModeConfig,
+			applied:              infoApplied,
+			expErrString:         `Unsupported value: "invalid"`,
+			expSetLevelCallCount: 0,
 		},
 		{
 			name:                 "invalid log level",
@@ -58,8 +178,10 @@ func TestUpdateControlPlane(t *testing.T) {
 		{
 			name:                 "nil NginxGateway",
 			nginxGateway:         nil,
+			applied:              infoApplied,
 			expEvent:             true,
 			expSetLevelCallCount: 1,
+			expApplied:           true,
 		},
 		{
 			name:                 "set log level fails",
@@ -83,13 +205,29 @@ func TestUpdateControlPlane(t *testing.T) {
 
 			fakeEventRecorder := record.NewFakeRecorder(1)
 
-			err := updateControlPlane(test.nginxGateway, logger, fakeEventRecorder, nsname, fakeLogSetter)
+			update, err := updateControlPlane(
+				test.nginxGateway,
+				test.applied,
+				logger,
+				fakeEventRecorder,
+				nsname,
+				fakeLogSetter,
+			)
 
 			if test.expErrString != "" {
 				g.Expect(err).To(HaveOccurred())
 				g.Expect(err.Error()).To(ContainSubstring(test.expErrString))
 			} else {
 				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(update.changes).To(Equal(test.expChanges))
+			}
+
+			g.Expect(update.pending).To(Equal(test.expPending))
+
+			if test.expApplied {
+				g.Expect(update.applied).ToNot(BeIdenticalTo(test.applied))
+			} else {
+				g.Expect(update.applied).To(BeIdenticalTo(test.applied))
 			}
 
 			if test.expEvent {
@@ -0,0 +1,50 @@
+package static
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane/snapshot"
+)
+
+// configurationSnapshotPath is the path of the debug endpoint of the metrics server that serves the snapshot of
+// the latest data plane configuration.
+const configurationSnapshotPath = "/debug/configuration"
+
+// configurationGetter gets the latest data plane configuration.
+type configurationGetter interface {
+	GetLatestConfiguration() *dataplane.Configuration
+}
+
+// newConfigurationSnapshotHandler returns the handler of the debug endpoint that serves the snapshot of the latest
+// data plane configuration in the versioned JSON schema of the snapshot package.
+// It responds with the 503 status code until the first configuration is built.
+func newConfigurationSnapshotHandler(getter configurationGetter, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		conf := getter.GetLatestConfiguration()
+		if conf == nil {
+			http.Error(w, "configuration is not built yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		data, err := snapshot.Marshal(*conf)
+		if err != nil {
+			logger.Error(err, "Failed to serve the snapshot of the configuration")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			logger.Error(err, "Failed to write the snapshot of the configuration")
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane/snapshot"
+)
+
+type fakeConfigurationGetter struct {
+	conf *dataplane.Configuration
+}
+
+func (g fakeConfigurationGetter) GetLatestConfiguration() *dataplane.Configuration {
+	return g.conf
+}
+
+func TestConfigurationSnapshotHandler(t *testing.T) {
+	t.Parallel()
+
+	conf := &dataplane.Configuration{Version: 2}
+
+	expSnapshot, err := snapshot.Marshal(*conf)
+	if err != nil {
+		t.Fatalf("failed to marshal configuration: %v", err)
+	}
+
+	tests := []struct {
+		conf    *dataplane.Configuration
+		name    string
+		method  string
+		expBody string
+		expCode int
+		expJSON bool
+	}{
+		{
+			name:    "latest configuration",
+			conf:    conf,
+			method:  http.MethodGet,
+			expCode: http.StatusOK,
+			expBody: string(expSnapshot),
+			expJSON: true,
+		},
+		{
+			name:    "configuration is not built yet",
+			method:  http.MethodGet,
+			expCode: http.StatusServiceUnavailable,
+			expBody: "configuration is not built yet\n",
+		},
+		{
+			name:    "method not allowed",
+			conf:    conf,
+			method:  http.MethodPost,
+			expCode: http.StatusMethodNotAllowed,
+			expBody: "Method Not Allowed\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			handler := newConfigurationSnapshotHandler(fakeConfigurationGetter{conf: test.conf}, logr.Discard())
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(test.method, configurationSnapshotPath, nil))
+
+			g.Expect(rec.Code).To(Equal(test.expCode))
+			g.Expect(rec.Body.String()).To(Equal(test.expBody))
+
+			if test.expJSON {
+				g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+const (
+	// conversionWebhookPath is the path that controller-runtime serves the conversion webhook on.
+	conversionWebhookPath = "/convert"
+	// conversionWebhookServicePort is the port of the Service of the webhook server.
+	conversionWebhookServicePort = 443
+	// conversionWebhookCAFile is the name of the file of the CA certificate in the certificate directory
+	// of the webhook server. The API server verifies the serving certificate of the webhook with it.
+	conversionWebhookCAFile = "ca.crt"
+	// crdConversionCheckPeriod is the period of checking that the CRDs convert their objects with the webhook.
+	// Applying the CRDs, for example, when upgrading, resets their conversion to the None strategy.
+	crdConversionCheckPeriod = time.Minute
+)
+
+// convertibleType is an NGF API type that is served in more than one version.
+type convertibleType struct {
+	// obj is the object of a version of the type that is not the hub version.
+	obj client.Object
+	// crdName is the name of the CRD of the type.
+	crdName string
+}
+
+// convertibleTypes are the NGF API types that the conversion webhook converts between their versions.
+var convertibleTypes = []convertibleType{
+	{
+		obj:     &ngfAPIv1alpha2.ObservabilityPolicy{},
+		crdName: "observabilitypolicies.gateway.nginx.org",
+	},
+}
+
+// crdConversionConfigurer configures the CRDs of the convertible types to convert their objects with
+// the conversion webhook of this controller. The CRDs are installed without the conversion, because the Service
+// of the webhook and the CA certificate that signs its serving certificate are only known at installation.
+type crdConversionConfigurer struct {
+	k8sReader client.Reader
+	k8sClient client.Client
+	logger    logr.Logger
+	// caFile is the path of the CA certificate of the serving certificate of the webhook.
+	caFile string
+	// service is the Service of the webhook server.
+	service types.NamespacedName
+	// crdNames are the names of the CRDs to configure.
+	crdNames []string
+}
+
+func newCRDConversionConfigurer(
+	k8sReader client.Reader,
+	k8sClient client.Client,
+	service types.NamespacedName,
+	certDir string,
+	logger logr.Logger,
+) *crdConversionConfigurer {
+	crdNames := make([]string, 0, len(convertibleTypes))
+	for _, t := range convertibleTypes {
+		crdNames = append(crdNames, t.crdName)
+	}
+
+	return &crdConversionConfigurer{
+		k8sReader: k8sReader,
+		k8sClient: k8sClient,
+		logger:    logger,
+		caFile:    filepath.Join(certDir, conversionWebhookCAFile),
+		service:   service,
+		crdNames:  crdNames,
+	}
+}
+
+// worker returns the worker of the cronjob that keeps the CRDs configured.
+func (c *crdConversionConfigurer) worker() func(context.Context) {
+	return func(ctx context.Context) {
+		if err := c.configure(ctx); err != nil {
+			c.logger.Error(err, "Failed to configure the conversion of the CRDs")
+		}
+	}
+}
+
+// configure sets the Webhook conversion strategy in the CRDs that don't have it set to this webhook server.
+func (c *crdConversionConfigurer) configure(ctx context.Context) error {
+	caBundle, err := os.ReadFile(c.caFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the CA certificate of the webhook: %w", err)
+	}
+
+	conversion := &apiext.CustomResourceConversion{
+		Strategy: apiext.WebhookConverter,
+		Webhook: &apiext.WebhookConversion{
+			ClientConfig: &apiext.WebhookClientConfig{
+				Service: &apiext.ServiceReference{
+					Namespace: c.service.Namespace,
+					Name:      c.service.Name,
+					Path:      helpers.GetPointer(conversionWebhookPath),
+					Port:      helpers.GetPointer[int32](conversionWebhookServicePort),
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+
+	var errs []error
+
+	for _, name := range c.crdNames {
+		if err := c.configureCRD(ctx, name, conversion); err != nil {
+			errs = append(errs, fmt.Errorf("failed to configure the conversion of the CRD %s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *crdConversionConfigurer) configureCRD(
+	ctx context.Context,
+	name string,
+	conversion *apiext.CustomResourceConversion,
+) error {
+	var crd apiext.CustomResourceDefinition
+	if err := c.k8sReader.Get(ctx, types.NamespacedName{Name: name}, &crd); err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(crd.Spec.Conversion, conversion) {
+		return nil
+	}
+
+	patch := client.MergeFrom(crd.DeepCopy())
+	crd.Spec.Conversion = conversion
+
+	if err := c.k8sClient.Patch(ctx, &crd, patch); err != nil {
+		return err
+	}
+
+	c.logger.Info("Configured the CRD to convert its objects with the conversion webhook", "crd", name)
+
+	return nil
+}
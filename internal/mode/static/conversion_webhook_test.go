@@ -0,0 +1,83 @@
+package static
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestCRDConversionConfigurer(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+
+	crdName := "observabilitypolicies.gateway.nginx.org"
+	crd := &apiext.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: crdName},
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Conversion: &apiext.CustomResourceConversion{Strategy: apiext.NoneConverter},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()
+
+	certDir := t.TempDir()
+	service := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway-webhook"}
+
+	configurer := newCRDConversionConfigurer(k8sClient, k8sClient, service, certDir, logr.Discard())
+	g.Expect(configurer.crdNames).To(ConsistOf(crdName))
+
+	err := configurer.configure(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("failed to read the CA certificate of the webhook")))
+
+	caBundle := []byte("ca")
+	g.Expect(os.WriteFile(filepath.Join(certDir, conversionWebhookCAFile), caBundle, 0o600)).To(Succeed())
+
+	expConversion := &apiext.CustomResourceConversion{
+		Strategy: apiext.WebhookConverter,
+		Webhook: &apiext.WebhookConversion{
+			ClientConfig: &apiext.WebhookClientConfig{
+				Service: &apiext.ServiceReference{
+					Namespace: "nginx-gateway",
+					Name:      "nginx-gateway-webhook",
+					Path:      helpers.GetPointer("/convert"),
+					Port:      helpers.GetPointer[int32](443),
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+
+	g.Expect(configurer.configure(context.Background())).To(Succeed())
+
+	var configured apiext.CustomResourceDefinition
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: crdName}, &configured)).To(Succeed())
+	g.Expect(configured.Spec.Conversion).To(Equal(expConversion))
+
+	// the CRD is not patched again when it is already configured
+	g.Expect(configurer.configure(context.Background())).To(Succeed())
+
+	var unchanged apiext.CustomResourceDefinition
+	g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: crdName}, &unchanged)).To(Succeed())
+	g.Expect(unchanged.ResourceVersion).To(Equal(configured.ResourceVersion))
+
+	configurer.crdNames = append(configurer.crdNames, "missing.gateway.nginx.org")
+	err = configurer.configure(context.Background())
+	g.Expect(err).To(MatchError(
+		ContainSubstring("failed to configure the conversion of the CRD missing.gateway.nginx.org"),
+	))
+}
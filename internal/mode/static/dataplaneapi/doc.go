@@ -0,0 +1,5 @@
+/*
+Package dataplaneapi implements the server of the data plane API, which distributes the NGINX configuration
+generated by the static mode to NGINX instances outside of the cluster. The API is defined in the v1 package.
+*/
+package dataplaneapi
@@ -0,0 +1,396 @@
+package dataplaneapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi/v1"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+const (
+	// certFile is the name of the serving certificate in the certificate directory.
+	certFile = "tls.crt"
+	// keyFile is the name of the key of the serving certificate in the certificate directory.
+	keyFile = "tls.key"
+	// caFile is the name of the CA certificate that verifies the client certificates of the data plane nodes.
+	caFile = "ca.crt"
+)
+
+// ServerConfig holds the configuration of the Server.
+type ServerConfig struct {
+	// Logger is the logger of the Server.
+	Logger logr.Logger
+	// CertDir is the directory with the serving certificate (tls.crt) and key (tls.key), and the CA certificate
+	// (ca.crt) that verifies the client certificates of the data plane nodes.
+	CertDir string
+	// Port is the port that the Server listens on.
+	Port int
+}
+
+// Server serves the data plane API. It sends the latest NGINX configuration to the data plane nodes and tracks
+// their states.
+//
+// The configuration includes the secret files, like the private keys of the Listeners, so the Server only accepts
+// the data plane nodes with the client certificates signed by the CA certificate.
+type Server struct {
+	latest  *v1.Config
+	nodes   map[string]*node
+	logger  logr.Logger
+	certDir string
+	port    int
+	lock    sync.Mutex
+}
+
+// node is a data plane node.
+type node struct {
+	// notify is signaled when a new configuration is published or the result of the pending configuration
+	// is reported. It is nil while the node is not connected.
+	notify chan struct{}
+	status v1.NodeStatus
+	// sentVersion is the version of the last configuration sent to the node on its current stream.
+	sentVersion int
+}
+
+// NewServer creates a new Server.
+func NewServer(cfg ServerConfig) *Server {
+	return &Server{
+		nodes:   make(map[string]*node),
+		logger:  cfg.Logger,
+		certDir: cfg.CertDir,
+		port:    cfg.Port,
+	}
+}
+
+// Start starts the Server and blocks until the context is canceled.
+func (s *Server) Start(ctx context.Context) error {
+	tlsConfig, err := loadTLSConfig(s.certDir)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS configuration of the data plane API: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", s.port, err)
+	}
+
+	return s.serve(ctx, listener, grpc.Creds(credentials.NewTLS(tlsConfig)))
+}
+
+func (s *Server) serve(ctx context.Context, listener net.Listener, opts ...grpc.ServerOption) error {
+	srv := grpc.NewServer(append(opts, v1.ServerCodecOption())...)
+	v1.RegisterDataPlaneServer(srv, s)
+
+	go func() {
+		<-ctx.Done()
+		// the ApplyConfig streams stay open until the nodes disconnect, so the server is not stopped gracefully
+		srv.Stop()
+	}()
+
+	s.logger.Info("Starting the data plane API server", "address", listener.Addr().String())
+
+	if err := srv.Serve(listener); err != nil {
+		return fmt.Errorf("data plane API server failed: %w", err)
+	}
+
+	return nil
+}
+
+// Publish makes the files the latest configuration of the data plane nodes and sends it to the connected nodes
+// that don't have a pending configuration.
+func (s *Server) Publish(version int, files []file.File) {
+	config := &v1.Config{
+		Version: version,
+		Files:   make([]v1.File, 0, len(files)),
+	}
+
+	for _, f := range files {
+		config.Files = append(config.Files, v1.File{
+			Path:    f.Path,
+			Content: f.Content,
+			Secret:  f.Type == file.TypeSecret,
+		})
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.latest = config
+
+	for _, n := range s.nodes {
+		n.signal()
+	}
+}
+
+// ApplyConfig implements v1.DataPlaneServer.
+func (s *Server) ApplyConfig(stream grpc.BidiStreamingServer[v1.ApplyConfigRequest, v1.Config]) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if req.NodeID == "" {
+		return status.Error(codes.InvalidArgument, "the first request must have the node ID")
+	}
+
+	if req.Result != nil {
+		return status.Error(codes.InvalidArgument, "the first request must not have a result")
+	}
+
+	n, err := s.connect(req.NodeID)
+	if err != nil {
+		return err
+	}
+	defer s.disconnect(n)
+
+	logger := s.logger.WithValues("node", req.NodeID)
+	logger.Info("Data plane node connected")
+
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveResults(stream, n, logger)
+	}()
+
+	for {
+		if config := s.nextConfig(n); config != nil {
+			if err := stream.Send(config); err != nil {
+				return err
+			}
+
+			logger.V(1).Info("Sent configuration to data plane node", "version", config.Version)
+		}
+
+		select {
+		case <-n.notify:
+		case err := <-recvErr:
+			logger.Info("Data plane node disconnected")
+			return err
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// receiveResults receives the results of the configurations from the node until the node closes the stream.
+func (s *Server) receiveResults(
+	stream grpc.BidiStreamingServer[v1.ApplyConfigRequest, v1.Config],
+	n *node,
+	logger logr.Logger,
+) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.Result == nil {
+			return status.Error(codes.InvalidArgument, "the requests after the first one must have a result")
+		}
+
+		if err := s.recordResult(n, *req.Result); err != nil {
+			return err
+		}
+
+		if req.Result.Error != "" {
+			logger.Error(
+				errors.New(req.Result.Error),
+				"Data plane node failed to apply configuration",
+				"version", req.Result.Version,
+			)
+		}
+	}
+}
+
+// GetStatus implements v1.DataPlaneServer.
+func (s *Server) GetStatus(context.Context, *v1.GetStatusRequest) (*v1.Status, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	result := &v1.Status{
+		APIVersion: v1.APIVersion,
+	}
+
+	if s.latest != nil {
+		result.LatestVersion = s.latest.Version
+	}
+
+	for _, n := range s.nodes {
+		nodeStatus := n.status
+		if nodeStatus.Telemetry != nil {
+			telemetry := *nodeStatus.Telemetry
+			nodeStatus.Telemetry = &telemetry
+		}
+
+		result.Nodes = append(result.Nodes, nodeStatus)
+	}
+
+	slices.SortFunc(result.Nodes, func(a, b v1.NodeStatus) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return result, nil
+}
+
+// StreamTelemetry implements v1.DataPlaneServer.
+func (s *Server) StreamTelemetry(stream grpc.ClientStreamingServer[v1.Telemetry, v1.TelemetrySummary]) error {
+	var received int
+
+	for {
+		telemetry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&v1.TelemetrySummary{Received: received})
+		}
+		if err != nil {
+			return err
+		}
+
+		if telemetry.NodeID == "" {
+			return status.Error(codes.InvalidArgument, "the telemetry must have the node ID")
+		}
+
+		s.recordTelemetry(*telemetry)
+		received++
+	}
+}
+
+func (s *Server) connect(id string) (*node, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	n, exists := s.nodes[id]
+	if !exists {
+		n = &node{status: v1.NodeStatus{ID: id}}
+		s.nodes[id] = n
+	}
+
+	if n.status.Connected {
+		return nil, status.Errorf(codes.AlreadyExists, "node %q is already connected", id)
+	}
+
+	// the node might have been restarted, so the latest configuration is sent again
+	n.notify = make(chan struct{}, 1)
+	n.sentVersion = 0
+	n.status.Connected = true
+	n.status.LastSeen = time.Now()
+
+	return n, nil
+}
+
+func (s *Server) disconnect(n *node) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	n.notify = nil
+	n.status.Connected = false
+	n.status.PendingVersion = 0
+}
+
+// nextConfig returns the latest configuration if it is not sent to the node yet and the node doesn't have
+// a pending configuration. Otherwise, it returns nil.
+func (s *Server) nextConfig(n *node) *v1.Config {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.latest == nil || n.status.PendingVersion != 0 || n.sentVersion >= s.latest.Version {
+		return nil
+	}
+
+	n.sentVersion = s.latest.Version
+	n.status.PendingVersion = s.latest.Version
+
+	return s.latest
+}
+
+func (s *Server) recordResult(n *node, result v1.ApplyResult) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if result.Version != n.status.PendingVersion {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"got the result of the configuration version %d, but the pending version is %d",
+			result.Version,
+			n.status.PendingVersion,
+		)
+	}
+
+	n.status.PendingVersion = 0
+	n.status.LastSeen = time.Now()
+
+	if result.Error != "" {
+		n.status.Error = result.Error
+	} else {
+		n.status.AppliedVersion = result.Version
+		n.status.Error = ""
+	}
+
+	n.signal()
+
+	return nil
+}
+
+func (s *Server) recordTelemetry(telemetry v1.Telemetry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	n, exists := s.nodes[telemetry.NodeID]
+	if !exists {
+		n = &node{status: v1.NodeStatus{ID: telemetry.NodeID}}
+		s.nodes[telemetry.NodeID] = n
+	}
+
+	n.status.Telemetry = &telemetry
+	n.status.LastSeen = time.Now()
+}
+
+// signal wakes up the stream of the node without blocking. It does nothing if the node is not connected.
+func (n *node) signal() {
+	select {
+	case n.notify <- struct{}{}:
+	default:
+	}
+}
+
+func loadTLSConfig(certDir string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certDir, certFile), filepath.Join(certDir, keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load serving certificate: %w", err)
+	}
+
+	caCert, err := os.ReadFile(filepath.Join(certDir, caFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,271 @@
+package dataplaneapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	v1 "github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi/v1"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+func startServer(t *testing.T, server *Server) *v1.DataPlaneClient {
+	t.Helper()
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := bufconn.Listen(1024 * 1024)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.serve(ctx, listener)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	t.Cleanup(func() {
+		g.Expect(conn.Close()).To(Succeed())
+		cancel()
+		g.Expect(<-errCh).To(Succeed())
+	})
+
+	return v1.NewDataPlaneClient(conn)
+}
+
+func TestCodecNotRegisteredGlobally(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// the other gRPC services of the process must keep their own codecs
+	g.Expect(encoding.GetCodecV2("json")).To(BeNil())
+	g.Expect(encoding.GetCodecV2(v1.CodecName)).To(BeNil())
+}
+
+func TestServerApplyConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := NewServer(ServerConfig{Logger: logr.Discard()})
+	client := startServer(t, server)
+
+	server.Publish(1, []file.File{
+		{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("http"), Type: file.TypeRegular},
+		{Path: "/etc/nginx/secrets/key.pem", Content: []byte("key"), Type: file.TypeSecret},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.ApplyConfig(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stream.Send(&v1.ApplyConfigRequest{NodeID: "vm-1"})).To(Succeed())
+
+	config, err := stream.Recv()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config).To(Equal(&v1.Config{
+		Version: 1,
+		Files: []v1.File{
+			{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("http")},
+			{Path: "/etc/nginx/secrets/key.pem", Content: []byte("key"), Secret: true},
+		},
+	}))
+
+	// the configurations published while the result is pending are coalesced into the latest one
+	server.Publish(2, []file.File{{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("v2")}})
+	server.Publish(3, []file.File{{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("v3")}})
+
+	getNode := func() v1.NodeStatus {
+		st, err := client.GetStatus(context.Background(), &v1.GetStatusRequest{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(st.APIVersion).To(Equal(v1.APIVersion))
+		g.Expect(st.LatestVersion).To(Equal(3))
+		g.Expect(st.Nodes).To(HaveLen(1))
+
+		return st.Nodes[0]
+	}
+
+	node := getNode()
+	g.Expect(node.ID).To(Equal("vm-1"))
+	g.Expect(node.Connected).To(BeTrue())
+	g.Expect(node.PendingVersion).To(Equal(1))
+
+	g.Expect(stream.Send(&v1.ApplyConfigRequest{Result: &v1.ApplyResult{Version: 1}})).To(Succeed())
+
+	config, err = stream.Recv()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.Version).To(Equal(3))
+	g.Expect(config.Files).To(Equal([]v1.File{{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("v3")}}))
+
+	node = getNode()
+	g.Expect(node.AppliedVersion).To(Equal(1))
+	g.Expect(node.PendingVersion).To(Equal(3))
+
+	g.Expect(stream.Send(&v1.ApplyConfigRequest{
+		Result: &v1.ApplyResult{Version: 3, Error: "nginx: [emerg] unknown directive"},
+	})).To(Succeed())
+
+	g.Eventually(getNode).Should(And(
+		HaveField("AppliedVersion", 1),
+		HaveField("PendingVersion", 0),
+		HaveField("Error", "nginx: [emerg] unknown directive"),
+	))
+
+	g.Expect(stream.CloseSend()).To(Succeed())
+	_, err = stream.Recv()
+	g.Expect(err).To(MatchError(io.EOF))
+
+	g.Eventually(getNode).Should(HaveField("Connected", false))
+}
+
+func TestServerApplyConfigInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		requests []*v1.ApplyConfigRequest
+		expCode  codes.Code
+	}{
+		{
+			name:     "no node ID",
+			requests: []*v1.ApplyConfigRequest{{}},
+			expCode:  codes.InvalidArgument,
+		},
+		{
+			name: "result in first request",
+			requests: []*v1.ApplyConfigRequest{
+				{NodeID: "vm-1", Result: &v1.ApplyResult{Version: 1}},
+			},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name: "no result in second request",
+			requests: []*v1.ApplyConfigRequest{
+				{NodeID: "vm-1"},
+				{},
+			},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name: "result of a version that is not pending",
+			requests: []*v1.ApplyConfigRequest{
+				{NodeID: "vm-1"},
+				{Result: &v1.ApplyResult{Version: 5}},
+			},
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			client := startServer(t, NewServer(ServerConfig{Logger: logr.Discard()}))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream, err := client.ApplyConfig(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, req := range test.requests {
+				g.Expect(stream.Send(req)).To(Succeed())
+			}
+
+			_, err = stream.Recv()
+			g.Expect(status.Code(err)).To(Equal(test.expCode))
+		})
+	}
+}
+
+func TestServerApplyConfigNodeAlreadyConnected(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := NewServer(ServerConfig{Logger: logr.Discard()})
+	client := startServer(t, server)
+	server.Publish(1, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := client.ApplyConfig(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(first.Send(&v1.ApplyConfigRequest{NodeID: "vm-1"})).To(Succeed())
+
+	// once the configuration is received, the node is connected
+	_, err = first.Recv()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	second, err := client.ApplyConfig(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(second.Send(&v1.ApplyConfigRequest{NodeID: "vm-1"})).To(Succeed())
+
+	_, err = second.Recv()
+	g.Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+}
+
+func TestServerStreamTelemetry(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := NewServer(ServerConfig{Logger: logr.Discard()})
+	client := startServer(t, server)
+
+	stream, err := client.StreamTelemetry(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(stream.Send(&v1.Telemetry{NodeID: "vm-1", ActiveConnections: 5, Requests: 100})).To(Succeed())
+	g.Expect(stream.Send(&v1.Telemetry{NodeID: "vm-1", ActiveConnections: 3, Requests: 150})).To(Succeed())
+
+	summary, err := stream.CloseAndRecv()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(summary).To(Equal(&v1.TelemetrySummary{Received: 2}))
+
+	st, err := client.GetStatus(context.Background(), &v1.GetStatusRequest{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(st.LatestVersion).To(BeZero())
+	g.Expect(st.Nodes).To(HaveLen(1))
+	g.Expect(st.Nodes[0].ID).To(Equal("vm-1"))
+	g.Expect(st.Nodes[0].Connected).To(BeFalse())
+	g.Expect(st.Nodes[0].Telemetry).To(Equal(&v1.Telemetry{NodeID: "vm-1", ActiveConnections: 3, Requests: 150}))
+}
+
+func TestServerStreamTelemetryNoNodeID(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	client := startServer(t, NewServer(ServerConfig{Logger: logr.Discard()}))
+
+	stream, err := client.StreamTelemetry(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(stream.Send(&v1.Telemetry{Requests: 1})).To(Succeed())
+
+	_, err = stream.CloseAndRecv()
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	_, err := loadTLSConfig(t.TempDir())
+	g.Expect(err).To(MatchError(ContainSubstring("failed to load serving certificate")))
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+// CodecName is the name of the Codec and the gRPC content-subtype of the data plane API. It is scoped to the API,
+// so that the Codec doesn't collide with the codecs of other gRPC services.
+const CodecName = "nginx-dataplane-v1-json"
+
+// Codec encodes the messages of the data plane API in JSON. The Codec is not registered with the gRPC encoding
+// registry, so that it doesn't replace the codecs of the other gRPC clients and servers of the process. Instead,
+// the server and the clients of the data plane API are configured to use it with ServerCodecOption and
+// NewDataPlaneClient.
+type Codec struct{}
+
+// ServerCodecOption returns the option of a gRPC server that makes it encode all messages with the Codec.
+// The server must only serve the data plane API.
+func ServerCodecOption() grpc.ServerOption {
+	return grpc.ForceServerCodec(Codec{})
+}
+
+// Marshal returns the JSON encoding of the message.
+func (Codec) Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T: %w", v, err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal parses the JSON encoding of the message into v.
+func (Codec) Unmarshal(data []byte, v any) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %T: %w", v, err)
+	}
+
+	return nil
+}
+
+// Name returns the name of the Codec.
+func (Codec) Name() string {
+	return CodecName
+}
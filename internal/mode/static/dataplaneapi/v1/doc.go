@@ -0,0 +1,23 @@
+/*
+Package v1 defines the version v1 of the data plane API, a gRPC API that the static mode serves, so that NGINX
+instances outside of the cluster, like NGINX fleets on VMs, can run the same generated configuration as the NGINX
+of the control plane.
+
+The DataPlane service has the following methods:
+
+  - ApplyConfig is a bidirectional stream. The data plane opens it with a request with its node ID. The control
+    plane sends the latest configuration, and every newer configuration once it is generated. A configuration
+    contains all files of the NGINX configuration, so the data plane replaces its configuration with it as one
+    transaction: it writes the files, reloads NGINX and, if that fails, restores the previous files. The data plane
+    reports the result of every configuration with a request with the Result field. The control plane doesn't send
+    the next configuration until it gets the result of the previous one; if several configurations are generated
+    meanwhile, only the latest one is sent.
+  - GetStatus returns the version of the latest configuration and the states of the data plane nodes.
+  - StreamTelemetry is a client stream, on which the data plane reports the telemetry of its NGINX.
+
+The messages are encoded in JSON by the Codec, which uses the CodecName content-subtype of gRPC. The Codec is not
+registered globally, so the server must be created with ServerCodecOption, and the clients must call the methods
+with the grpc.ForceCodec(Codec{}) call option. NewDataPlaneClient creates a client that does that. Within a version, fields are only ever added to the messages; removing, renaming or
+changing the meaning of a field requires a new version of the API.
+*/
+package v1
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// ServiceName is the full name of the DataPlane service.
+	ServiceName = "gateway.nginx.org.dataplane.v1.DataPlane"
+
+	// ApplyConfigMethod is the full name of the ApplyConfig method.
+	ApplyConfigMethod = "/" + ServiceName + "/ApplyConfig"
+	// GetStatusMethod is the full name of the GetStatus method.
+	GetStatusMethod = "/" + ServiceName + "/GetStatus"
+	// StreamTelemetryMethod is the full name of the StreamTelemetry method.
+	StreamTelemetryMethod = "/" + ServiceName + "/StreamTelemetry"
+)
+
+// DataPlaneServer is the server of the DataPlane service.
+type DataPlaneServer interface {
+	// ApplyConfig sends the configurations to the data plane node and receives their results.
+	ApplyConfig(grpc.BidiStreamingServer[ApplyConfigRequest, Config]) error
+	// GetStatus returns the status of the data plane API.
+	GetStatus(context.Context, *GetStatusRequest) (*Status, error)
+	// StreamTelemetry receives the telemetry of the data plane node.
+	StreamTelemetry(grpc.ClientStreamingServer[Telemetry, TelemetrySummary]) error
+}
+
+// RegisterDataPlaneServer registers the DataPlane service with the gRPC server.
+func RegisterDataPlaneServer(registrar grpc.ServiceRegistrar, srv DataPlaneServer) {
+	registrar.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*DataPlaneServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStatus",
+			Handler:    getStatusHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ApplyConfig",
+			Handler:       applyConfigHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamTelemetry",
+			Handler:       streamTelemetryHandler,
+			ClientStreams: true,
+		},
+	},
+}
+
+func getStatusHandler(
+	srv any,
+	ctx context.Context,
+	dec func(any) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	req := &GetStatusRequest{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(DataPlaneServer).GetStatus(ctx, req)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GetStatusMethod,
+	}
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(DataPlaneServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+
+	return interceptor(ctx, req, info, handler)
+}
+
+func applyConfigHandler(srv any, stream grpc.ServerStream) error {
+	return srv.(DataPlaneServer).ApplyConfig(&grpc.GenericServerStream[ApplyConfigRequest, Config]{
+		ServerStream: stream,
+	})
+}
+
+func streamTelemetryHandler(srv any, stream grpc.ServerStream) error {
+	return srv.(DataPlaneServer).StreamTelemetry(&grpc.GenericServerStream[Telemetry, TelemetrySummary]{
+		ServerStream: stream,
+	})
+}
+
+// DataPlaneClient is the client of the DataPlane service.
+type DataPlaneClient struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewDataPlaneClient creates a new DataPlaneClient, which encodes the messages with the Codec.
+func NewDataPlaneClient(conn grpc.ClientConnInterface) *DataPlaneClient {
+	return &DataPlaneClient{conn: conn}
+}
+
+// ApplyConfig opens the ApplyConfig stream.
+func (c *DataPlaneClient) ApplyConfig(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (grpc.BidiStreamingClient[ApplyConfigRequest, Config], error) {
+	stream, err := c.conn.NewStream(ctx, &serviceDesc.Streams[0], ApplyConfigMethod, c.callOptions(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ApplyConfig stream: %w", err)
+	}
+
+	return &grpc.GenericClientStream[ApplyConfigRequest, Config]{ClientStream: stream}, nil
+}
+
+// GetStatus returns the status of the data plane API.
+func (c *DataPlaneClient) GetStatus(
+	ctx context.Context,
+	req *GetStatusRequest,
+	opts ...grpc.CallOption,
+) (*Status, error) {
+	status := &Status{}
+	if err := c.conn.Invoke(ctx, GetStatusMethod, req, status, c.callOptions(opts)...); err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+
+	return status, nil
+}
+
+// StreamTelemetry opens the StreamTelemetry stream.
+func (c *DataPlaneClient) StreamTelemetry(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (grpc.ClientStreamingClient[Telemetry, TelemetrySummary], error) {
+	stream, err := c.conn.NewStream(ctx, &serviceDesc.Streams[1], StreamTelemetryMethod, c.callOptions(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open StreamTelemetry stream: %w", err)
+	}
+
+	return &grpc.GenericClientStream[Telemetry, TelemetrySummary]{ClientStream: stream}, nil
+}
+
+func (c *DataPlaneClient) callOptions(opts []grpc.CallOption) []grpc.CallOption {
+	return append([]grpc.CallOption{grpc.ForceCodec(Codec{})}, opts...)
+}
@@ -0,0 +1,94 @@
+package v1
+
+import "time"
+
+// APIVersion is the version of the data plane API.
+const APIVersion = "v1"
+
+// ApplyConfigRequest is a request of the data plane on the ApplyConfig stream.
+// The first request identifies the node. The following requests report the results of the configurations.
+type ApplyConfigRequest struct {
+	// Result is the result of applying a configuration. It must not be set in the first request.
+	Result *ApplyResult `json:"result,omitempty"`
+	// NodeID is the unique ID of the data plane node. It is required in the first request.
+	NodeID string `json:"nodeId,omitempty"`
+}
+
+// ApplyResult is the result of applying a configuration by the data plane.
+type ApplyResult struct {
+	// Error is the error of applying the configuration. If empty, the configuration was applied.
+	Error string `json:"error,omitempty"`
+	// Version is the version of the configuration.
+	Version int `json:"version"`
+}
+
+// Config is a configuration sent by the control plane on the ApplyConfig stream.
+type Config struct {
+	// Files are all files of the NGINX configuration. The files of the previous configuration that are not
+	// among them must be removed.
+	Files []File `json:"files"`
+	// Version is the version of the configuration. The versions of the configurations increase.
+	Version int `json:"version"`
+}
+
+// File is a file of the NGINX configuration.
+type File struct {
+	// Path is the absolute path of the file.
+	Path string `json:"path"`
+	// Content is the content of the file.
+	Content []byte `json:"content"`
+	// Secret indicates that the file contains secrets, like private keys, so it must only be readable
+	// by NGINX.
+	Secret bool `json:"secret,omitempty"`
+}
+
+// GetStatusRequest is the request of GetStatus.
+type GetStatusRequest struct{}
+
+// Status is the status of the data plane API.
+type Status struct {
+	// APIVersion is the version of the data plane API.
+	APIVersion string `json:"apiVersion"`
+	// Nodes are the data plane nodes, sorted by their IDs.
+	Nodes []NodeStatus `json:"nodes,omitempty"`
+	// LatestVersion is the version of the latest configuration. It is zero until the first configuration
+	// is generated.
+	LatestVersion int `json:"latestVersion"`
+}
+
+// NodeStatus is the state of a data plane node.
+type NodeStatus struct {
+	// LastSeen is the time of the last request of the node.
+	LastSeen time.Time `json:"lastSeen"`
+	// Telemetry is the latest telemetry reported by the node.
+	Telemetry *Telemetry `json:"telemetry,omitempty"`
+	// ID is the unique ID of the node.
+	ID string `json:"id"`
+	// Error is the error of the last configuration that the node failed to apply.
+	// It is cleared once the node applies a configuration.
+	Error string `json:"error,omitempty"`
+	// AppliedVersion is the version of the last configuration that the node applied.
+	AppliedVersion int `json:"appliedVersion,omitempty"`
+	// PendingVersion is the version of the configuration sent to the node, whose result is not reported yet.
+	PendingVersion int `json:"pendingVersion,omitempty"`
+	// Connected indicates that the node has an open ApplyConfig stream.
+	Connected bool `json:"connected"`
+}
+
+// Telemetry is the telemetry of the NGINX of a data plane node.
+type Telemetry struct {
+	// Time is the time when the telemetry was collected.
+	Time time.Time `json:"time"`
+	// NodeID is the unique ID of the data plane node.
+	NodeID string `json:"nodeId"`
+	// ActiveConnections is the number of the active client connections.
+	ActiveConnections int64 `json:"activeConnections"`
+	// Requests is the total number of the client requests.
+	Requests int64 `json:"requests"`
+}
+
+// TelemetrySummary is the response of StreamTelemetry.
+type TelemetrySummary struct {
+	// Received is the number of the telemetry reports received on the stream.
+	Received int `json:"received"`
+}
@@ -0,0 +1,182 @@
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// toBeDeletedByClusterAutoscalerTaint is the taint that the cluster autoscaler adds to a node before it evicts
+// the Pods of the node to scale it down.
+const toBeDeletedByClusterAutoscalerTaint = "ToBeDeletedByClusterAutoscaler"
+
+// evictionWatch watches this Pod and its node to detect the eviction of the Pod. While the Pod is being evicted,
+// it is marked as not ready, so that it stops receiving new connections while NGINX finishes processing
+// the existing ones. The Pod becomes ready again if the eviction is canceled, for example, when the cluster
+// autoscaler removes its taint from the node because it no longer scales the node down.
+// Implements the controller-runtime manager.Runnable interface.
+type evictionWatch struct {
+	// k8sReader reads the Pod before the watch starts to get the name of its node.
+	k8sReader    client.Reader
+	restConfig   *rest.Config
+	scheme       *runtime.Scheme
+	nginxChecker *nginxConfiguredOnStartChecker
+	logger       logr.Logger
+	podNsName    types.NamespacedName
+	// reason is the reason of the eviction of the Pod, or an empty string if the Pod is not being evicted.
+	reason string
+}
+
+func newEvictionWatch(
+	k8sReader client.Reader,
+	restConfig *rest.Config,
+	scheme *runtime.Scheme,
+	podNsName types.NamespacedName,
+	nginxChecker *nginxConfiguredOnStartChecker,
+	logger logr.Logger,
+) *evictionWatch {
+	return &evictionWatch{
+		k8sReader:    k8sReader,
+		restConfig:   restConfig,
+		scheme:       scheme,
+		nginxChecker: nginxChecker,
+		logger:       logger,
+		podNsName:    podNsName,
+	}
+}
+
+// Start watches the Pod and its node until the context is canceled. The watch only caches these two objects,
+// and the eviction is checked whenever one of them changes.
+func (w *evictionWatch) Start(ctx context.Context) error {
+	var pod apiv1.Pod
+	if err := w.k8sReader.Get(ctx, w.podNsName, &pod); err != nil {
+		return fmt.Errorf("failed to get the Pod: %w", err)
+	}
+
+	byObject := map[client.Object]cache.ByObject{
+		&apiv1.Pod{}: {
+			Namespaces: map[string]cache.Config{w.podNsName.Namespace: {}},
+			Field:      fields.OneTermEqualSelector("metadata.name", w.podNsName.Name),
+		},
+		&apiv1.Node{}: {
+			Field: fields.OneTermEqualSelector("metadata.name", pod.Spec.NodeName),
+		},
+	}
+
+	objCache, err := cache.New(w.restConfig, cache.Options{Scheme: w.scheme, ByObject: byObject})
+	if err != nil {
+		return fmt.Errorf("failed to create the cache of the Pod and its node: %w", err)
+	}
+
+	// the checks run in this goroutine, so a change that arrives during a check is coalesced into the next check
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { notify() },
+		UpdateFunc: func(any, any) { notify() },
+	}
+
+	for _, obj := range []client.Object{&apiv1.Pod{}, &apiv1.Node{}} {
+		informer, err := objCache.GetInformer(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get the informer of %T: %w", obj, err)
+		}
+
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return fmt.Errorf("failed to add the event handler to the informer of %T: %w", obj, err)
+		}
+	}
+
+	cacheErrCh := make(chan error, 1)
+	go func() {
+		cacheErrCh <- objCache.Start(ctx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-cacheErrCh:
+			if err != nil {
+				return fmt.Errorf("failed to watch the Pod and its node: %w", err)
+			}
+
+			return nil
+		case <-changed:
+			w.check(ctx, objCache)
+		}
+	}
+}
+
+// check drains the Pod if it is being evicted, and brings it back if its eviction was canceled.
+func (w *evictionWatch) check(ctx context.Context, k8sReader client.Reader) {
+	reason, err := getEvictionReason(ctx, k8sReader, w.podNsName)
+	if err != nil {
+		w.logger.Error(err, "Failed to check if the Pod is being evicted")
+		return
+	}
+
+	if reason == w.reason {
+		return
+	}
+
+	w.reason = reason
+	w.nginxChecker.setDraining(reason != "")
+
+	if reason != "" {
+		w.logger.Info("Pod is being evicted, draining connections", "reason", reason)
+	} else {
+		w.logger.Info("Pod is no longer being evicted, accepting new connections")
+	}
+}
+
+// getEvictionReason returns the reason why the Pod is being evicted, or an empty string if it is not:
+//   - the Pod has the DisruptionTarget condition, which Kubernetes adds before it terminates the Pod because of
+//     an eviction, a preemption, or a taint of the node.
+//   - the node of the Pod has the taint that the cluster autoscaler adds before it evicts the Pods of the node.
+//     The cluster autoscaler evicts the Pods of the node one by one, so the Pod can stop receiving new connections
+//     before its eviction.
+func getEvictionReason(ctx context.Context, k8sReader client.Reader, podNsName types.NamespacedName) (string, error) {
+	var pod apiv1.Pod
+	if err := k8sReader.Get(ctx, podNsName, &pod); err != nil {
+		return "", err
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == apiv1.DisruptionTarget && cond.Status == apiv1.ConditionTrue {
+			return cond.Reason, nil
+		}
+	}
+
+	if pod.Spec.NodeName == "" {
+		return "", nil
+	}
+
+	var node apiv1.Node
+	if err := k8sReader.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, &node); err != nil {
+		return "", err
+	}
+
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == toBeDeletedByClusterAutoscalerTaint {
+			return toBeDeletedByClusterAutoscalerTaint, nil
+		}
+	}
+
+	return "", nil
+}
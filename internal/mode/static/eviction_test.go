@@ -0,0 +1,154 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEvictionWatchCheck(t *testing.T) {
+	t.Parallel()
+
+	podNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "ngf-pod"}
+
+	createPod := func(conditions ...apiv1.PodCondition) *apiv1.Pod {
+		return &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: podNsName.Namespace, Name: podNsName.Name},
+			Spec:       apiv1.PodSpec{NodeName: "node"},
+			Status:     apiv1.PodStatus{Conditions: conditions},
+		}
+	}
+
+	createNode := func(taints ...apiv1.Taint) *apiv1.Node {
+		return &apiv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node"},
+			Spec:       apiv1.NodeSpec{Taints: taints},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		expDraining bool
+	}{
+		{
+			name:        "pod is not evicted",
+			objects:     []client.Object{createPod(), createNode()},
+			expDraining: false,
+		},
+		{
+			name: "pod has the DisruptionTarget condition",
+			objects: []client.Object{
+				createPod(apiv1.PodCondition{
+					Type:   apiv1.DisruptionTarget,
+					Status: apiv1.ConditionTrue,
+					Reason: "EvictionByEvictionAPI",
+				}),
+				createNode(),
+			},
+			expDraining: true,
+		},
+		{
+			name: "pod has the DisruptionTarget condition with the False status",
+			objects: []client.Object{
+				createPod(apiv1.PodCondition{
+					Type:   apiv1.DisruptionTarget,
+					Status: apiv1.ConditionFalse,
+				}),
+				createNode(),
+			},
+			expDraining: false,
+		},
+		{
+			name: "node is being scaled down by the cluster autoscaler",
+			objects: []client.Object{
+				createPod(),
+				createNode(apiv1.Taint{
+					Key:    toBeDeletedByClusterAutoscalerTaint,
+					Effect: apiv1.TaintEffectNoSchedule,
+				}),
+			},
+			expDraining: true,
+		},
+		{
+			name: "node has other taints",
+			objects: []client.Object{
+				createPod(),
+				createNode(apiv1.Taint{
+					Key:    "DeletionCandidateOfClusterAutoscaler",
+					Effect: apiv1.TaintEffectPreferNoSchedule,
+				}),
+			},
+			expDraining: false,
+		},
+		{
+			name:        "pod does not exist",
+			objects:     []client.Object{createNode()},
+			expDraining: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			k8sClient := fake.NewClientBuilder().WithObjects(test.objects...).Build()
+
+			nginxChecker := newNginxConfiguredOnStartChecker()
+			nginxChecker.setAsReady()
+
+			watch := newEvictionWatch(nil, nil, nil, podNsName, nginxChecker, logr.Discard())
+			watch.check(context.Background(), k8sClient)
+
+			g.Expect(nginxChecker.draining).To(Equal(test.expDraining))
+			if test.expDraining {
+				g.Expect(nginxChecker.readyCheck(nil)).ToNot(Succeed())
+			} else {
+				g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+			}
+		})
+	}
+}
+
+func TestEvictionWatchCheck_Canceled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	podNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "ngf-pod"}
+
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: podNsName.Namespace, Name: podNsName.Name},
+		Spec:       apiv1.PodSpec{NodeName: "node"},
+	}
+	node := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Spec: apiv1.NodeSpec{
+			Taints: []apiv1.Taint{{Key: toBeDeletedByClusterAutoscalerTaint, Effect: apiv1.TaintEffectNoSchedule}},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithObjects(pod, node).Build()
+
+	nginxChecker := newNginxConfiguredOnStartChecker()
+	nginxChecker.setAsReady()
+
+	watch := newEvictionWatch(nil, nil, nil, podNsName, nginxChecker, logr.Discard())
+
+	watch.check(context.Background(), k8sClient)
+	g.Expect(nginxChecker.readyCheck(nil)).ToNot(Succeed())
+
+	// the cluster autoscaler removes its taint when it no longer scales the node down
+	node.Spec.Taints = nil
+	g.Expect(k8sClient.Update(context.Background(), node)).To(Succeed())
+
+	watch.check(context.Background(), k8sClient)
+	g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
+	"slices"
 	"sync"
 	"time"
 
@@ -21,11 +23,13 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	frameworkStatus "github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 	ngfConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/licensing"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
@@ -34,6 +38,24 @@ import (
 
 type handlerMetricsCollector interface {
 	ObserveLastEventBatchProcessTime(time.Duration)
+	SetShadowedRouteMatches(int)
+	SetCertificateMismatches(int)
+	SetHashTableSize(string, int32)
+	SetUpstreamEndpoints(map[string]resolver.EndpointSummary)
+	AddCollectedOrphans(string, int)
+	ObserveSmokeTestProbe(string, bool)
+}
+
+type listenerMetricsCollector interface {
+	SetStatusZones(map[string]dataplane.ListenerStatusZone)
+}
+
+type cacheMetricsCollector interface {
+	SetCacheZones([]dataplane.CacheZone)
+}
+
+type upstreamServerMetricsCollector interface {
+	SetUpstreams(upstreams, streamUpstreams []dataplane.Upstream)
 }
 
 // eventHandlerConfig holds configuration parameters for eventHandlerImpl.
@@ -42,10 +64,19 @@ type eventHandlerConfig struct {
 	nginxFileMgr file.Manager
 	// metricsCollector collects metrics for this controller.
 	metricsCollector handlerMetricsCollector
+	// listenerMetricsCollector collects the metrics of the Gateway Listeners.
+	listenerMetricsCollector listenerMetricsCollector
+	// cacheMetricsCollector collects the metrics of the caches of the CachePolicies.
+	cacheMetricsCollector cacheMetricsCollector
+	// upstreamServerMetricsCollector collects the metrics of the servers of the upstreams.
+	upstreamServerMetricsCollector upstreamServerMetricsCollector
 	// nginxRuntimeMgr manages nginx runtime.
 	nginxRuntimeMgr runtime.Manager
 	// statusUpdater updates statuses on Kubernetes resources.
 	statusUpdater frameworkStatus.GroupUpdater
+	// statusPausedTracker tracks the resources with paused status updates, which it forgets when they are deleted.
+	// If nil, the deleted resources are not forgotten.
+	statusPausedTracker *frameworkStatus.Updater
 	// processor is the state ChangeProcessor.
 	processor state.ChangeProcessor
 	// serviceResolver resolves Services to Endpoints.
@@ -62,6 +93,23 @@ type eventHandlerConfig struct {
 	eventRecorder record.EventRecorder
 	// deployCtxCollector collects the deployment context for N+ licensing
 	deployCtxCollector licensing.Collector
+	// nginxConfigDumper publishes the NGINX configuration to a ConfigMap. If nil, the configuration is not published.
+	nginxConfigDumper *nginxConfigDumper
+	// dataPlaneAPIServer serves the NGINX configuration to the data plane nodes outside of the cluster.
+	// If nil, the configuration is not served.
+	dataPlaneAPIServer *dataplaneapi.Server
+	// coldStartSnapshotStore persists the applied configuration, so that NGINX is programmed from it on the next start.
+	// If nil, the configuration is not persisted.
+	coldStartSnapshotStore *coldStartSnapshotStore
+	// orphanCollector removes the orphaned files and NGINX Plus upstream servers after the configuration is applied.
+	// If nil, the orphans are not collected.
+	orphanCollector *orphanCollector
+	// smokeTester sends the smoke test probes of the NginxGateway through NGINX after the configuration is updated.
+	// If nil, the probes are not sent.
+	smokeTester *smokeTester
+	// reloadGate delays the reloads of NGINX according to the reload gating of the NginxGateway.
+	// If nil, the reloads are not delayed.
+	reloadGate *reloadGate
 	// nginxConfiguredOnStartChecker sets the health of the Pod to Ready once we've written out our initial config.
 	nginxConfiguredOnStartChecker *nginxConfiguredOnStartChecker
 	// gatewayPodConfig contains information about this Pod.
@@ -70,8 +118,13 @@ type eventHandlerConfig struct {
 	controlConfigNSName types.NamespacedName
 	// gatewayCtlrName is the name of the NGF controller.
 	gatewayCtlrName string
+	// nginxErrorLogSocket is the Unix socket that NGINX sends its error log to, so that the controller parses it.
+	// If empty, the error log is not sent.
+	nginxErrorLogSocket string
 	// updateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	updateGatewayClassStatus bool
+	// selectsGateways indicates whether this instance only processes the Gateways selected by a label selector.
+	selectsGateways bool
 	// plus is whether or not we are running NGINX Plus.
 	plus bool
 }
@@ -83,6 +136,13 @@ const (
 	groupControlPlane      = "control-plane"
 )
 
+// peerStateDraining is the state of an NGINX Plus upstream server that is in the draining mode.
+const peerStateDraining = "draining"
+
+// plusAPIErrorBudget is the number of consecutive failed updates of the upstream servers via the NGINX Plus API,
+// after which the upstream servers are updated by reloading NGINX instead.
+const plusAPIErrorBudget = 3
+
 // filterKey is the `kind_namespace_name" of an object being filtered.
 type filterKey string
 
@@ -104,11 +164,26 @@ type eventHandlerImpl struct {
 	// latestConfiguration is the latest Configuration generation.
 	latestConfiguration *dataplane.Configuration
 
+	// appliedControlConfig is the control plane configuration that is applied to the control plane.
+	// It is nil until the first NginxGateway event is handled.
+	appliedControlConfig *ngfAPI.NginxGatewaySpec
+
 	// objectFilters contains all created objectFilters, with the key being a filterKey
 	objectFilters map[filterKey]objectFilter
 
+	// statusGraph is the graph of the latest status update. The parent and ancestor statuses of its Gateways that
+	// are not in the next graph are removed in the next status update.
+	statusGraph *graph.Graph
+
+	// shadowedRouteMatches holds the messages of the Events about the shadowed matches of the Routes,
+	// so that an Event is only emitted when the shadowed matches of a Route change.
+	shadowedRouteMatches map[graph.RouteKey]string
+
 	latestReloadResult status.NginxReloadResult
 
+	// plusAPIFailures is the number of consecutive failed updates of the upstream servers via the NGINX Plus API.
+	plusAPIFailures int
+
 	cfg  eventHandlerConfig
 	lock sync.Mutex
 
@@ -180,11 +255,17 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 			logger.Error(getErr, "error getting deployment context for usage reporting")
 		}
 		cfg.DeploymentContext = depCtx
+		cfg.Logging.ErrorLogSocket = h.cfg.nginxErrorLogSocket
 
 		h.setLatestConfiguration(&cfg)
+		h.setHashTableSizeMetrics(cfg.HashSizes)
+		h.cfg.metricsCollector.SetUpstreamEndpoints(getEndpointSummaries(cfg))
+		h.cfg.listenerMetricsCollector.SetStatusZones(cfg.NginxPlus.StatusZones)
+		h.cfg.cacheMetricsCollector.SetCacheZones(cfg.CacheZones)
+		h.cfg.upstreamServerMetricsCollector.SetUpstreams(cfg.Upstreams, cfg.StreamUpstreams)
 
 		if h.cfg.plus {
-			err = h.updateUpstreamServers(cfg)
+			err = h.updateUpstreamServersWithFallback(ctx, logger, cfg)
 		} else {
 			err = h.updateNginxConf(ctx, cfg)
 		}
@@ -196,13 +277,25 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 			logger.Error(getErr, "error getting deployment context for usage reporting")
 		}
 		cfg.DeploymentContext = depCtx
+		cfg.Logging.ErrorLogSocket = h.cfg.nginxErrorLogSocket
 
 		h.setLatestConfiguration(&cfg)
+		h.setHashTableSizeMetrics(cfg.HashSizes)
+		h.cfg.metricsCollector.SetUpstreamEndpoints(getEndpointSummaries(cfg))
+		h.cfg.listenerMetricsCollector.SetStatusZones(cfg.NginxPlus.StatusZones)
+		h.cfg.cacheMetricsCollector.SetCacheZones(cfg.CacheZones)
+		h.cfg.upstreamServerMetricsCollector.SetUpstreams(cfg.Upstreams, cfg.StreamUpstreams)
+
+		cfg.NginxPlus.UpstreamServersInConfig = h.plusAPIErrorBudgetExhausted()
+
+		h.waitForReloadGate(ctx)
 
 		err = h.updateNginxConf(ctx, cfg)
 	}
 
-	var nginxReloadRes status.NginxReloadResult
+	nginxReloadRes := status.NginxReloadResult{
+		PlusAPIFallback: h.plusAPIErrorBudgetExhausted(),
+	}
 	if err != nil {
 		logger.Error(err, "Failed to update NGINX configuration")
 		nginxReloadRes.Error = err
@@ -214,15 +307,151 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 		if !h.cfg.nginxConfiguredOnStartChecker.ready {
 			h.cfg.nginxConfiguredOnStartChecker.setAsReady()
 		}
+
+		// the failures are kept until the probes of the updated configuration report theirs
+		if h.startSmokeTest(ctx) {
+			nginxReloadRes.SmokeTestFailures = h.latestReloadResult.SmokeTestFailures
+		}
 	}
 
 	h.latestReloadResult = nginxReloadRes
 
+	h.cfg.metricsCollector.SetShadowedRouteMatches(gr.ShadowedRouteMatches)
+	h.recordShadowedRouteMatches(gr)
+	h.cfg.metricsCollector.SetCertificateMismatches(gr.CertificateMismatches)
+
 	h.updateStatuses(ctx, logger, gr)
 }
 
+// recordShadowedRouteMatches emits a warning Event for each Route with matches that are shadowed by identical
+// matches with a higher precedence, which is also reported by the Conflicted condition of the Route.
+// An Event is only emitted when the shadowed matches of a Route change, rather than for every batch.
+func (h *eventHandlerImpl) recordShadowedRouteMatches(gr *graph.Graph) {
+	shadowed := make(map[graph.RouteKey]string)
+
+	for key, r := range gr.Routes {
+		for _, cond := range r.Conditions {
+			if cond.Reason != string(staticConds.RouteReasonRulesShadowed) {
+				continue
+			}
+
+			shadowed[key] = cond.Message
+
+			if h.shadowedRouteMatches[key] != cond.Message {
+				h.cfg.eventRecorder.Event(r.Source, v1.EventTypeWarning, cond.Reason, cond.Message)
+			}
+		}
+	}
+
+	h.shadowedRouteMatches = shadowed
+}
+
+// waitForReloadGate delays the reload of NGINX according to the reload gating of the applied NginxGateway
+// configuration. The first configuration is applied without delay, since NGINX is not ready until it is applied.
+func (h *eventHandlerImpl) waitForReloadGate(ctx context.Context) {
+	if h.cfg.reloadGate == nil || !h.cfg.nginxConfiguredOnStartChecker.ready ||
+		h.appliedControlConfig == nil || h.appliedControlConfig.ReloadGating == nil {
+		return
+	}
+
+	h.cfg.reloadGate.wait(ctx, h.appliedControlConfig.ReloadGating)
+}
+
+// startSmokeTest sends the smoke test probes of the applied NginxGateway configuration through NGINX
+// in the background and returns whether the probes were started. The failures of the probes are reported
+// in a SmokeTestDoneEvent.
+func (h *eventHandlerImpl) startSmokeTest(ctx context.Context) bool {
+	if h.cfg.smokeTester == nil || h.appliedControlConfig == nil || h.appliedControlConfig.SmokeTest == nil {
+		return false
+	}
+
+	var worker dataplane.Worker
+	if cfg := h.GetLatestConfiguration(); cfg != nil {
+		worker = cfg.Worker
+	}
+
+	h.cfg.smokeTester.start(ctx, h.appliedControlConfig.SmokeTest, worker, h.version)
+
+	return true
+}
+
+// smokeTestDone reports the failures of the smoke test probes in the statuses of the Gateways.
+// The failures of the probes that were sent to an outdated version of the NGINX configuration are ignored.
+func (h *eventHandlerImpl) smokeTestDone(ctx context.Context, logger logr.Logger, e *events.SmokeTestDoneEvent) {
+	if e.Version != h.version {
+		logger.V(1).Info(
+			"Ignoring the smoke test result of an outdated NGINX configuration",
+			"version", e.Version,
+			"latestVersion", h.version,
+		)
+		return
+	}
+
+	h.latestReloadResult.SmokeTestFailures = e.Failures
+
+	gr := h.cfg.processor.GetLatestGraph()
+	if gr == nil {
+		return
+	}
+
+	gwAddresses, err := getGatewayAddresses(
+		ctx,
+		h.cfg.k8sClient,
+		nil,
+		h.cfg.gatewayPodConfig,
+		getAddressPublication(gr),
+	)
+	if err != nil {
+		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
+	}
+
+	gatewayStatuses := status.PrepareGatewayRequests(
+		gr.Gateway,
+		gr.MergedGateways,
+		metav1.Now(),
+		gwAddresses,
+		h.latestReloadResult,
+	)
+	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gatewayStatuses...)
+}
+
+func (h *eventHandlerImpl) setHashTableSizeMetrics(sizes dataplane.HashSizes) {
+	h.cfg.metricsCollector.SetHashTableSize("server_names_hash_max_size", sizes.ServerNamesMaxSize)
+	h.cfg.metricsCollector.SetHashTableSize("server_names_hash_bucket_size", sizes.ServerNamesBucketSize)
+	h.cfg.metricsCollector.SetHashTableSize("map_hash_max_size", sizes.MapMaxSize)
+	h.cfg.metricsCollector.SetHashTableSize("map_hash_bucket_size", sizes.MapBucketSize)
+	h.cfg.metricsCollector.SetHashTableSize("stream_map_hash_max_size", sizes.StreamMapMaxSize)
+	h.cfg.metricsCollector.SetHashTableSize("stream_map_hash_bucket_size", sizes.StreamMapBucketSize)
+}
+
+// getEndpointSummaries returns the summaries of the endpoints of the HTTP and stream upstreams, keyed by
+// the name of the upstream. The upstreams of ExternalName Services are skipped, because they don't have
+// any endpoints in the cluster.
+func getEndpointSummaries(cfg dataplane.Configuration) map[string]resolver.EndpointSummary {
+	summaries := make(map[string]resolver.EndpointSummary, len(cfg.Upstreams)+len(cfg.StreamUpstreams))
+
+	for _, up := range cfg.Upstreams {
+		if up.ExternalName != "" {
+			continue
+		}
+		summaries[up.Name] = up.EndpointSummary
+	}
+
+	for _, up := range cfg.StreamUpstreams {
+		summaries[up.Name] = up.EndpointSummary
+	}
+
+	return summaries
+}
+
 func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logger, gr *graph.Graph) {
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, h.cfg.gatewayPodConfig)
+	gwAddresses, err := getGatewayAddresses(
+		ctx,
+		h.cfg.k8sClient,
+		nil,
+		h.cfg.gatewayPodConfig,
+		getAddressPublication(gr),
+	)
 	if err != nil {
 		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
 	}
@@ -233,32 +462,79 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 	if h.cfg.updateGatewayClassStatus {
 		gcReqs = status.PrepareGatewayClassRequests(gr.GatewayClass, gr.IgnoredGatewayClasses, transitionTime)
 	}
+	var endpointSummaries map[string]resolver.EndpointSummary
+	if cfg := h.GetLatestConfiguration(); cfg != nil {
+		endpointSummaries = getEndpointSummaries(*cfg)
+	}
+
+	ownsGateway := status.NewGatewayOwner(gr, h.statusGraph, h.cfg.selectsGateways)
+
 	routeReqs := status.PrepareRouteRequests(
 		gr.L4Routes,
 		gr.Routes,
 		transitionTime,
 		h.latestReloadResult,
 		h.cfg.gatewayCtlrName,
+		ownsGateway,
+		endpointSummaries,
+	)
+	routeReqs = append(
+		routeReqs,
+		status.PrepareDetachedRouteRequests(gr, h.statusGraph, h.cfg.gatewayCtlrName, ownsGateway)...,
 	)
+	h.statusGraph = gr
 
-	polReqs := status.PrepareBackendTLSPolicyRequests(gr.BackendTLSPolicies, transitionTime, h.cfg.gatewayCtlrName)
-	ngfPolReqs := status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, h.cfg.gatewayCtlrName)
+	polReqs := status.PrepareBackendTLSPolicyRequests(
+		gr.BackendTLSPolicies,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+		ownsGateway,
+	)
+	lbPolReqs := status.PrepareBackendLBPolicyRequests(
+		gr.BackendLBPolicies,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+		ownsGateway,
+	)
+	ngfPolReqs := status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, h.cfg.gatewayCtlrName, ownsGateway)
 	snippetsFilterReqs := status.PrepareSnippetsFilterRequests(
 		gr.SnippetsFilters,
 		transitionTime,
 		h.cfg.gatewayCtlrName,
 	)
+	routeTestReqs := status.PrepareRouteTestRequests(gr.RouteTests, transitionTime, h.cfg.gatewayCtlrName)
+	bodyTransformReqs := status.PrepareHTTPBodyTransformRequests(
+		gr.HTTPBodyTransforms,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	directResponseReqs := status.PrepareDirectResponseRequests(
+		gr.DirectResponses,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	nginxProxyReqs := status.PrepareNginxProxyRequests(
+		gr.ReferencedNginxProxies,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
 
 	reqs := make(
 		[]frameworkStatus.UpdateRequest,
 		0,
-		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+len(snippetsFilterReqs),
+		len(gcReqs)+len(routeReqs)+len(polReqs)+len(lbPolReqs)+len(ngfPolReqs)+len(snippetsFilterReqs)+
+			len(routeTestReqs)+len(bodyTransformReqs)+len(directResponseReqs)+len(nginxProxyReqs),
 	)
 	reqs = append(reqs, gcReqs...)
 	reqs = append(reqs, routeReqs...)
 	reqs = append(reqs, polReqs...)
+	reqs = append(reqs, lbPolReqs...)
 	reqs = append(reqs, ngfPolReqs...)
 	reqs = append(reqs, snippetsFilterReqs...)
+	reqs = append(reqs, routeTestReqs...)
+	reqs = append(reqs, bodyTransformReqs...)
+	reqs = append(reqs, directResponseReqs...)
+	reqs = append(reqs, nginxProxyReqs...)
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, reqs...)
 
@@ -266,7 +542,7 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 	// to update them separately from the rest of the graph whenever the public IP of NGF changes.
 	gwReqs := status.PrepareGatewayRequests(
 		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.MergedGateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
@@ -298,6 +574,15 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 		}
 
 		h.cfg.processor.CaptureDeleteChange(e.Type, e.NamespacedName)
+
+		if h.cfg.statusPausedTracker != nil {
+			h.cfg.statusPausedTracker.ResourceDeleted(e.Type, e.NamespacedName)
+		}
+	case *events.ArtifactUpdateEvent:
+		// the processor picks up the fetched artifacts when it processes the batch
+		logger.V(1).Info("OCI artifacts were updated")
+	case *events.SmokeTestDoneEvent:
+		h.smokeTestDone(ctx, logger, e)
 	default:
 		panic(fmt.Errorf("unknown event type %T", e))
 	}
@@ -309,6 +594,12 @@ func (h *eventHandlerImpl) updateNginxConf(
 	conf dataplane.Configuration,
 ) error {
 	files := h.cfg.generator.Generate(conf)
+
+	// validate the configuration before it is written, so that the live NGINX instance keeps the last valid one
+	if err := h.cfg.nginxRuntimeMgr.Validate(ctx, files, conf.Version); err != nil {
+		return fmt.Errorf("failed to validate NGINX configuration: %w", err)
+	}
+
 	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
 		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
 	}
@@ -317,14 +608,115 @@ func (h *eventHandlerImpl) updateNginxConf(
 		return fmt.Errorf("failed to reload NGINX: %w", err)
 	}
 
-	// If using NGINX Plus, update upstream servers using the API.
+	// If using NGINX Plus, update upstream servers using the API, unless the servers are written in the configuration.
+	if !conf.NginxPlus.UpstreamServersInConfig {
+		if err := h.updateUpstreamServersViaAPI(conf); err != nil {
+			return fmt.Errorf("failed to update upstream servers: %w", err)
+		}
+	}
+
+	if h.cfg.orphanCollector != nil {
+		h.cfg.orphanCollector.collect(files, conf)
+	}
+
+	if h.cfg.nginxConfigDumper != nil {
+		h.cfg.nginxConfigDumper.dump(files, conf)
+	}
+
+	h.publishToDataPlaneAPI(files, conf)
+	h.saveColdStartSnapshot(files, conf)
+
+	return nil
+}
+
+// saveColdStartSnapshot persists the applied configuration for the next start of the controller.
+// NGINX is programmed from the snapshot before the upstream servers can be updated via the NGINX Plus API, so,
+// if the files were generated without the servers, the files are generated again with the servers written
+// in the configuration.
+func (h *eventHandlerImpl) saveColdStartSnapshot(files []file.File, conf dataplane.Configuration) {
+	if h.cfg.coldStartSnapshotStore == nil {
+		return
+	}
+
+	if h.cfg.plus && !conf.NginxPlus.UpstreamServersInConfig {
+		conf.NginxPlus.UpstreamServersInConfig = true
+		files = h.cfg.generator.Generate(conf)
+	}
+
+	h.cfg.coldStartSnapshotStore.save(conf.Version, files)
+}
+
+// publishToDataPlaneAPI publishes the applied configuration to the data plane nodes outside of the cluster.
+// The upstream servers of the nodes are not updated via the NGINX Plus API, so, if the files were generated
+// without them, the files are generated again with the servers written in the configuration.
+// The nodes don't run the controller, so the files are also generated again without the error log socket.
+func (h *eventHandlerImpl) publishToDataPlaneAPI(files []file.File, conf dataplane.Configuration) {
+	if h.cfg.dataPlaneAPIServer == nil {
+		return
+	}
+
+	regenerate := conf.Logging.ErrorLogSocket != ""
+	conf.Logging.ErrorLogSocket = ""
+
+	if h.cfg.plus && !conf.NginxPlus.UpstreamServersInConfig {
+		conf.NginxPlus.UpstreamServersInConfig = true
+		regenerate = true
+	}
+
+	if regenerate {
+		files = h.cfg.generator.Generate(conf)
+	}
+
+	h.cfg.dataPlaneAPIServer.Publish(conf.Version, files)
+}
+
+// updateUpstreamServersWithFallback updates the upstream servers using the NGINX Plus API. Once the updates fail
+// plusAPIErrorBudget times in a row, the servers are written in the configuration and NGINX is reloaded instead,
+// so that the upstreams don't stay stale until the next change of the configuration. The API is retried with every
+// update, and the fallback stops once the API succeeds.
+func (h *eventHandlerImpl) updateUpstreamServersWithFallback(
+	ctx context.Context,
+	logger logr.Logger,
+	conf dataplane.Configuration,
+) error {
+	err := h.updateUpstreamServersViaAPI(conf)
+	if err == nil {
+		h.publishToDataPlaneAPI(nil, conf)
+		return nil
+	}
+
+	if !h.plusAPIErrorBudgetExhausted() {
+		return err
+	}
+
+	logger.Error(
+		err,
+		"Failed to update upstream servers using the NGINX Plus API; falling back to reloading NGINX",
+		"consecutiveFailures", h.plusAPIFailures,
+	)
+
+	conf.NginxPlus.UpstreamServersInConfig = true
+
+	return h.updateNginxConf(ctx, conf)
+}
+
+// updateUpstreamServersViaAPI updates the upstream servers using the NGINX Plus API and counts the consecutive
+// failed updates, both the updates after a reload of NGINX and the updates on their own.
+func (h *eventHandlerImpl) updateUpstreamServersViaAPI(conf dataplane.Configuration) error {
 	if err := h.updateUpstreamServers(conf); err != nil {
-		return fmt.Errorf("failed to update upstream servers: %w", err)
+		h.plusAPIFailures++
+		return err
 	}
 
+	h.plusAPIFailures = 0
+
 	return nil
 }
 
+func (h *eventHandlerImpl) plusAPIErrorBudgetExhausted() bool {
+	return h.plusAPIFailures >= plusAPIErrorBudget
+}
+
 // updateUpstreamServers determines which servers have changed and uses the NGINX Plus API to update them.
 // Only applicable when using NGINX Plus.
 func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) error {
@@ -344,13 +736,22 @@ func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) e
 	var upstreams []upstream
 
 	for _, u := range conf.Upstreams {
+		// the servers of ExternalName Services are resolved by NGINX and are not managed via the API.
+		if u.ExternalName != "" {
+			continue
+		}
+
 		confUpstream := upstream{
 			name:    u.Name,
-			servers: ngxConfig.ConvertEndpoints(u.Endpoints),
+			servers: ngxConfig.ConvertEndpoints(u.Endpoints, u.Draining),
 		}
 
-		if u, ok := prevUpstreams[confUpstream.name]; ok {
-			if !serversEqual(confUpstream.servers, u.Peers) {
+		if prevUpstream, ok := prevUpstreams[confUpstream.name]; ok {
+			if !u.Draining {
+				undrainServers(confUpstream.servers, prevUpstream.Peers)
+			}
+
+			if !serversEqual(confUpstream.servers, prevUpstream.Peers) {
 				upstreams = append(upstreams, confUpstream)
 			}
 		}
@@ -393,8 +794,25 @@ func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) e
 	return updateErr
 }
 
+// undrainServers brings the servers that are draining in NGINX back into service. The draining mode of a server
+// can't be turned off with the drain parameter, so the server is marked as up instead.
+func undrainServers(servers []ngxclient.UpstreamServer, peers []ngxclient.Peer) {
+	draining := make(map[string]struct{})
+	for _, p := range peers {
+		if p.State == peerStateDraining {
+			draining[p.Server] = struct{}{}
+		}
+	}
+
+	for i := range servers {
+		if _, ok := draining[servers[i].Server]; ok {
+			servers[i].Down = helpers.GetPointer(false)
+		}
+	}
+}
+
 // serversEqual accepts lists of either UpstreamServer/Peer or StreamUpstreamServer/StreamPeer and determines
-// if the server names within these lists are equal.
+// if the server names and the draining modes of the servers within these lists are equal.
 func serversEqual[
 	upstreamServer ngxclient.UpstreamServer | ngxclient.StreamUpstreamServer,
 	peer ngxclient.Peer | ngxclient.StreamPeer,
@@ -403,22 +821,27 @@ func serversEqual[
 		return false
 	}
 
-	getServerVal := func(T any) string {
-		var server string
+	type serverVal struct {
+		server   string
+		draining bool
+	}
+
+	getServerVal := func(T any) serverVal {
+		var val serverVal
 		switch t := T.(type) {
 		case ngxclient.UpstreamServer:
-			server = t.Server
+			val = serverVal{server: t.Server, draining: t.Drain}
 		case ngxclient.StreamUpstreamServer:
-			server = t.Server
+			val = serverVal{server: t.Server}
 		case ngxclient.Peer:
-			server = t.Server
+			val = serverVal{server: t.Server, draining: t.State == peerStateDraining}
 		case ngxclient.StreamPeer:
-			server = t.Server
+			val = serverVal{server: t.Server}
 		}
-		return server
+		return val
 	}
 
-	diff := make(map[string]struct{}, len(newServers))
+	diff := make(map[serverVal]struct{}, len(newServers))
 	for _, s := range newServers {
 		diff[getServerVal(s)] = struct{}{}
 	}
@@ -441,13 +864,17 @@ func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
 ) {
 	var cpUpdateRes status.ControlPlaneUpdateResult
 
-	if err := updateControlPlane(
+	update, err := updateControlPlane(
 		cfg,
+		h.appliedControlConfig,
 		logger,
 		h.cfg.eventRecorder,
 		h.cfg.controlConfigNSName,
 		h.cfg.logLevelSetter,
-	); err != nil {
+	)
+	h.appliedControlConfig = update.applied
+
+	if err != nil {
 		msg := "Failed to update control plane configuration"
 		logger.Error(err, msg)
 		h.cfg.eventRecorder.Eventf(
@@ -458,6 +885,8 @@ func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
 			err.Error(),
 		)
 		cpUpdateRes.Error = err
+	} else if update.pending {
+		cpUpdateRes.PendingChanges = update.changes
 	}
 
 	var reqs []frameworkStatus.UpdateRequest
@@ -469,15 +898,32 @@ func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupControlPlane, reqs...)
 
-	logger.Info("Reconfigured control plane.")
+	if err != nil {
+		return
+	}
+
+	if update.pending {
+		logger.Info(
+			"Control plane configuration changes are pending; apply mode is Manual",
+			"changes", update.changes,
+		)
+		return
+	}
+
+	logger.Info("Reconfigured control plane.", "changes", update.changes)
 }
 
 // getGatewayAddresses gets the addresses for the Gateway.
+// The addresses of the LoadBalancer Service are deduplicated and ordered: the IPv4 addresses first, then the IPv6
+// addresses, then the hostnames, so that the status of the Gateway doesn't change when the load balancer
+// reports the same addresses in a different order. If the publication prefers the hostnames, the IP addresses
+// are only published if the Service has no hostnames.
 func getGatewayAddresses(
 	ctx context.Context,
 	k8sClient client.Client,
 	svc *v1.Service,
 	podConfig ngfConfig.GatewayPodConfig,
+	publication ngfAPI.AddressPublicationType,
 ) ([]gatewayv1.GatewayStatusAddress, error) {
 	podAddress := []gatewayv1.GatewayStatusAddress{
 		{
@@ -496,22 +942,41 @@ func getGatewayAddresses(
 		gwSvc = *svc
 	}
 
-	var addresses, hostnames []string
+	var addresses []netip.Addr
+	var hostnames []string
 	if gwSvc.Spec.Type == v1.ServiceTypeLoadBalancer {
 		for _, ingress := range gwSvc.Status.LoadBalancer.Ingress {
-			if ingress.IP != "" {
-				addresses = append(addresses, ingress.IP)
-			} else if ingress.Hostname != "" {
-				hostnames = append(hostnames, ingress.Hostname)
+			// Some load balancers report the IP address in the hostname field, so the values are typed by parsing.
+			for _, value := range []string{ingress.IP, ingress.Hostname} {
+				if value == "" {
+					continue
+				}
+
+				if addr, err := netip.ParseAddr(value); err == nil {
+					addresses = append(addresses, addr.Unmap())
+				} else {
+					hostnames = append(hostnames, value)
+				}
 			}
 		}
 	}
 
+	// netip.Addr.Compare orders the IPv4 addresses before the IPv6 addresses.
+	slices.SortFunc(addresses, netip.Addr.Compare)
+	addresses = slices.Compact(addresses)
+
+	slices.Sort(hostnames)
+	hostnames = slices.Compact(hostnames)
+
+	if publication == ngfAPI.AddressPublicationPreferHostname && len(hostnames) > 0 {
+		addresses = nil
+	}
+
 	gwAddresses := make([]gatewayv1.GatewayStatusAddress, 0, len(addresses)+len(hostnames))
 	for _, addr := range addresses {
 		statusAddr := gatewayv1.GatewayStatusAddress{
 			Type:  helpers.GetPointer(gatewayv1.IPAddressType),
-			Value: addr,
+			Value: addr.String(),
 		}
 		gwAddresses = append(gwAddresses, statusAddr)
 	}
@@ -527,6 +992,15 @@ func getGatewayAddresses(
 	return gwAddresses, nil
 }
 
+// getAddressPublication returns the address publication of the effective NginxProxy of the Gateway.
+func getAddressPublication(gr *graph.Graph) ngfAPI.AddressPublicationType {
+	if gr == nil || gr.NginxProxy == nil || !gr.NginxProxy.Valid || gr.NginxProxy.Source.Spec.AddressPublication == nil {
+		return ngfAPI.AddressPublicationAll
+	}
+
+	return *gr.NginxProxy.Source.Spec.AddressPublication
+}
+
 // getDeploymentContext gets the deployment context metadata for N+ reporting.
 func (h *eventHandlerImpl) getDeploymentContext(ctx context.Context) (dataplane.DeploymentContext, error) {
 	if !h.cfg.plus {
@@ -588,20 +1062,26 @@ func (h *eventHandlerImpl) nginxGatewayServiceUpsert(ctx context.Context, logger
 		panic(fmt.Errorf("obj type mismatch: got %T, expected %T", svc, &v1.Service{}))
 	}
 
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, svc, h.cfg.gatewayPodConfig)
-	if err != nil {
-		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
-	}
-
 	gr := h.cfg.processor.GetLatestGraph()
 	if gr == nil {
 		return
 	}
 
+	gwAddresses, err := getGatewayAddresses(
+		ctx,
+		h.cfg.k8sClient,
+		svc,
+		h.cfg.gatewayPodConfig,
+		getAddressPublication(gr),
+	)
+	if err != nil {
+		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
+	}
+
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
 		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.MergedGateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
@@ -614,20 +1094,26 @@ func (h *eventHandlerImpl) nginxGatewayServiceDelete(
 	logger logr.Logger,
 	_ types.NamespacedName,
 ) {
-	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, h.cfg.gatewayPodConfig)
-	if err != nil {
-		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
-	}
-
 	gr := h.cfg.processor.GetLatestGraph()
 	if gr == nil {
 		return
 	}
 
+	gwAddresses, err := getGatewayAddresses(
+		ctx,
+		h.cfg.k8sClient,
+		nil,
+		h.cfg.gatewayPodConfig,
+		getAddressPublication(gr),
+	)
+	if err != nil {
+		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
+	}
+
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
 		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.MergedGateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
@@ -19,10 +20,13 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/status/statusfakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi"
+	dataplaneapiv1 "github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi/v1"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/licensing/licensingfakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
@@ -30,6 +34,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file/filefakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/statefakes"
@@ -113,8 +118,11 @@ var _ = Describe("eventHandler", func() {
 				ServiceName: "nginx-gateway",
 				Namespace:   "nginx-gateway",
 			},
-			metricsCollector:         collectors.NewControllerNoopCollector(),
-			updateGatewayClassStatus: true,
+			metricsCollector:               collectors.NewControllerNoopCollector(),
+			listenerMetricsCollector:       collectors.NewListenerNoopCollector(),
+			cacheMetricsCollector:          collectors.NewCacheNoopCollector(),
+			upstreamServerMetricsCollector: collectors.NewUpstreamServerNoopCollector(),
+			updateGatewayClassStatus:       true,
 		})
 		Expect(handler.cfg.nginxConfiguredOnStartChecker.ready).To(BeFalse())
 	})
@@ -178,6 +186,20 @@ var _ = Describe("eventHandler", func() {
 				expectReconfig(dcfg, fakeCfgFiles)
 				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dcfg)).To(BeEmpty())
 			})
+
+			It("should process ArtifactUpdate", func() {
+				batch := []interface{}{&events.ArtifactUpdateEvent{}}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				dcfg := dataplane.GetDefaultConfiguration(&graph.Graph{}, 1)
+
+				Expect(fakeProcessor.CaptureUpsertChangeCallCount()).To(BeZero())
+				Expect(fakeProcessor.CaptureDeleteChangeCallCount()).To(BeZero())
+				Expect(fakeProcessor.ProcessCallCount()).To(Equal(1))
+				expectReconfig(dcfg, fakeCfgFiles)
+				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dcfg)).To(BeEmpty())
+			})
 		})
 
 		When("a batch has multiple events", func() {
@@ -200,6 +222,89 @@ var _ = Describe("eventHandler", func() {
 				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dcfg)).To(BeEmpty())
 			})
 		})
+
+		When("publishing the NGINX configuration is enabled", func() {
+			It("should queue the configuration without writing the ConfigMap", func() {
+				cmNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-config"}
+				dumper := newNginxConfigDumper(fakeK8sClient, cmNsName, logr.Discard())
+				handler.cfg.nginxConfigDumper = dumper
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				batch := []interface{}{e}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(dumper.pending).ToNot(BeNil())
+				Expect(dumper.pending.conf.Version).To(Equal(1))
+				Expect(dumper.pending.files).To(ContainElement(HaveField("Path", "test.conf")))
+
+				// the ConfigMap is written by the leader, not by the handler
+				cm := &v1.ConfigMap{}
+				Expect(fakeK8sClient.Get(context.Background(), cmNsName, cm)).ToNot(Succeed())
+			})
+		})
+
+		When("the cold start snapshot is enabled", func() {
+			It("should persist the applied configuration", func() {
+				store := newColdStartSnapshotStore(
+					GinkgoT().TempDir(),
+					"1.0.0",
+					[]string{"/etc/nginx/conf.d"},
+					logr.Discard(),
+				)
+				handler.cfg.coldStartSnapshotStore = store
+
+				httpConf := file.File{
+					Type:    file.TypeRegular,
+					Path:    "/etc/nginx/conf.d/http.conf",
+					Content: []byte("server {}"),
+				}
+				fakeGenerator.GenerateReturns([]file.File{httpConf})
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				batch := []interface{}{e}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				snapshot, err := store.load()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshot.ConfigVersion).To(Equal(1))
+				Expect(snapshot.Files).To(ConsistOf(coldStartSnapshotFile(httpConf)))
+			})
+		})
+
+		When("the data plane API is enabled", func() {
+			It("should publish the configuration to the data plane API server", func() {
+				server := dataplaneapi.NewServer(dataplaneapi.ServerConfig{Logger: logr.Discard()})
+				handler.cfg.dataPlaneAPIServer = server
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				batch := []interface{}{e}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				st, err := server.GetStatus(context.Background(), &dataplaneapiv1.GetStatusRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(st.LatestVersion).To(Equal(1))
+			})
+
+			It("should publish the configuration without the error log socket", func() {
+				server := dataplaneapi.NewServer(dataplaneapi.ServerConfig{Logger: logr.Discard()})
+				handler.cfg.dataPlaneAPIServer = server
+				handler.cfg.nginxErrorLogSocket = "/var/run/nginx/nginx-error-log.sock"
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				batch := []interface{}{e}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(2))
+				Expect(fakeGenerator.GenerateArgsForCall(0).Logging.ErrorLogSocket).To(Equal(
+					"/var/run/nginx/nginx-error-log.sock",
+				))
+				Expect(fakeGenerator.GenerateArgsForCall(1).Logging.ErrorLogSocket).To(BeEmpty())
+			})
+		})
 	})
 
 	DescribeTable(
@@ -308,6 +413,36 @@ var _ = Describe("eventHandler", func() {
 			Expect(zapLogLevelSetter.Enabled(zap.InfoLevel)).To(BeTrue())
 		})
 
+		It("holds the changes of a config in the Manual apply mode", func() {
+			manualCfg := func(level ngfAPI.ControllerLogLevel) *ngfAPI.NginxGateway {
+				conf := cfg(level)
+				conf.Spec.ApplyMode = helpers.GetPointer(ngfAPI.ControlPlaneApplyModeManual)
+				return conf
+			}
+
+			batch := []interface{}{&events.UpsertEvent{Resource: manualCfg(ngfAPI.ControllerLogLevelError)}}
+			handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+			Expect(zapLogLevelSetter.Enabled(zap.InfoLevel)).To(BeFalse())
+			Expect(zapLogLevelSetter.Enabled(zap.ErrorLevel)).To(BeTrue())
+
+			batch = []interface{}{&events.UpsertEvent{Resource: manualCfg(ngfAPI.ControllerLogLevelDebug)}}
+			handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+			Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(2))
+			_, name, reqs := fakeStatusUpdater.UpdateGroupArgsForCall(1)
+			Expect(name).To(Equal(groupControlPlane))
+			Expect(reqs).To(HaveLen(1))
+
+			Expect(zapLogLevelSetter.Enabled(zap.DebugLevel)).To(BeFalse())
+			Expect(zapLogLevelSetter.Enabled(zap.ErrorLevel)).To(BeTrue())
+
+			batch = []interface{}{&events.UpsertEvent{Resource: cfg(ngfAPI.ControllerLogLevelDebug)}}
+			handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+			Expect(zapLogLevelSetter.Enabled(zap.DebugLevel)).To(BeTrue())
+		})
+
 		It("handles a deleted config", func() {
 			batch := []interface{}{
 				&events.DeleteEvent{
@@ -449,6 +584,73 @@ var _ = Describe("eventHandler", func() {
 				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
 				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(1))
 			})
+
+			It("should fall back to reloading NGINX when the NGINX Plus API fails repeatedly", func() {
+				handler.cfg.plus = true
+				fakeNginxRuntimeMgr.GetUpstreamsReturns(nil, nil, errors.New("api error"))
+
+				for range plusAPIErrorBudget - 1 {
+					handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+				}
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(0))
+				Expect(handler.latestReloadResult.Error).To(HaveOccurred())
+				Expect(handler.latestReloadResult.PlusAPIFallback).To(BeFalse())
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeGenerator.GenerateArgsForCall(0).NginxPlus.UpstreamServersInConfig).To(BeTrue())
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(plusAPIErrorBudget))
+				Expect(handler.latestReloadResult.Error).ToNot(HaveOccurred())
+				Expect(handler.latestReloadResult.PlusAPIFallback).To(BeTrue())
+
+				fakeNginxRuntimeMgr.GetUpstreamsReturns(ngxclient.Upstreams{}, ngxclient.StreamUpstreams{}, nil)
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(handler.latestReloadResult.PlusAPIFallback).To(BeFalse())
+			})
+
+			It("should count the failed updates of the NGINX Plus API after the reloads of NGINX", func() {
+				handler.cfg.plus = true
+				fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+				fakeNginxRuntimeMgr.GetUpstreamsReturns(nil, nil, errors.New("api error"))
+
+				for range plusAPIErrorBudget {
+					handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+				}
+
+				Expect(handler.plusAPIFailures).To(Equal(plusAPIErrorBudget))
+				Expect(fakeGenerator.GenerateArgsForCall(plusAPIErrorBudget - 1).NginxPlus.UpstreamServersInConfig).
+					To(BeFalse())
+				Expect(handler.latestReloadResult.PlusAPIFallback).To(BeTrue())
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(fakeGenerator.GenerateArgsForCall(plusAPIErrorBudget).NginxPlus.UpstreamServersInConfig).
+					To(BeTrue())
+				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(plusAPIErrorBudget))
+			})
+
+			It("should publish the configuration with the upstream servers to the data plane API server", func() {
+				handler.cfg.plus = true
+				server := dataplaneapi.NewServer(dataplaneapi.ServerConfig{Logger: logr.Discard()})
+				handler.cfg.dataPlaneAPIServer = server
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeGenerator.GenerateArgsForCall(0).NginxPlus.UpstreamServersInConfig).To(BeTrue())
+
+				st, err := server.GetStatus(context.Background(), &dataplaneapiv1.GetStatusRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(st.LatestVersion).To(Equal(1))
+			})
 		})
 
 		When("not running NGINX Plus", func() {
@@ -601,6 +803,193 @@ var _ = Describe("eventHandler", func() {
 		Expect(handler.cfg.nginxConfiguredOnStartChecker.readyCheck(nil)).To(Succeed())
 	})
 
+	It("should not apply the configuration rejected by the validator", func() {
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+		fakeNginxRuntimeMgr.ValidateReturns(errors.New("validation error"))
+
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeNginxRuntimeMgr.ValidateCallCount()).To(Equal(1))
+		_, _, version := fakeNginxRuntimeMgr.ValidateArgsForCall(0)
+		Expect(version).To(Equal(1))
+
+		Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(BeZero())
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(BeZero())
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.readyCheck(nil)).ToNot(Succeed())
+	})
+
+	It("should delay the reloads after the first configuration according to the reload gating", func() {
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		handler.cfg.reloadGate = newReloadGate(fakeNginxRuntimeMgr, logr.Discard(), time.Millisecond)
+		handler.appliedControlConfig = &ngfAPI.NginxGatewaySpec{
+			ReloadGating: &ngfAPI.ReloadGating{
+				InFlightRequestsThreshold: 10,
+			},
+		}
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+		fakeNginxRuntimeMgr.GetInFlightRequestsReturnsOnCall(0, 20, nil)
+		fakeNginxRuntimeMgr.GetInFlightRequestsReturnsOnCall(1, 5, nil)
+
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeNginxRuntimeMgr.GetInFlightRequestsCallCount()).To(BeZero())
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeNginxRuntimeMgr.GetInFlightRequestsCallCount()).To(Equal(2))
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+	})
+
+	It("should apply the first configuration without delay after the cold start snapshot is restored", func() {
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		handler.cfg.nginxConfiguredOnStartChecker.setAsRestored()
+		handler.cfg.reloadGate = newReloadGate(fakeNginxRuntimeMgr, logr.Discard(), time.Millisecond)
+		handler.appliedControlConfig = &ngfAPI.NginxGatewaySpec{
+			ReloadGating: &ngfAPI.ReloadGating{
+				InFlightRequestsThreshold: 10,
+			},
+		}
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+		fakeNginxRuntimeMgr.GetInFlightRequestsReturns(20, nil)
+
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.readyCheck(nil)).To(Succeed())
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.getReadyCh()).ToNot(BeClosed())
+
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeNginxRuntimeMgr.GetInFlightRequestsCallCount()).To(BeZero())
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.getReadyCh()).To(BeClosed())
+	})
+
+	It("should stay ready with the cold start snapshot if the first configuration fails to apply", func() {
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		handler.cfg.nginxConfiguredOnStartChecker.setAsRestored()
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+		fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.readyCheck(nil)).To(Succeed())
+		Expect(handler.cfg.nginxConfiguredOnStartChecker.getReadyCh()).ToNot(BeClosed())
+	})
+
+	It("should emit an Event when the shadowed matches of a Route change", func() {
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		hr := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+		}
+		routeKey := graph.RouteKey{
+			NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"},
+			RouteType:      graph.RouteTypeHTTP,
+		}
+
+		getGraph := func(msg string) *graph.Graph {
+			return &graph.Graph{
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					routeKey: {
+						Source:     hr,
+						RouteType:  graph.RouteTypeHTTP,
+						Conditions: []conditions.Condition{staticConds.NewRouteRulesShadowed(msg)},
+					},
+				},
+			}
+		}
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, getGraph("shadowed match"))
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeEventRecorder.Events).To(HaveLen(1))
+		event := <-fakeEventRecorder.Events
+		Expect(event).To(Equal("Warning RulesShadowed shadowed match"))
+
+		// the same shadowed matches don't result in another Event
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+		Expect(fakeEventRecorder.Events).To(BeEmpty())
+
+		fakeProcessor.ProcessReturns(state.ClusterStateChange, getGraph("another shadowed match"))
+		handler.HandleEventBatch(context.Background(), logr.Discard(), batch)
+
+		Expect(fakeEventRecorder.Events).To(HaveLen(1))
+		event = <-fakeEventRecorder.Events
+		Expect(event).To(Equal("Warning RulesShadowed another shadowed match"))
+	})
+
+	When("the smoke test is configured", func() {
+		var reports chan *events.SmokeTestDoneEvent
+
+		BeforeEach(func() {
+			reports = make(chan *events.SmokeTestDoneEvent, 1)
+			handler.cfg.smokeTester = newSmokeTester(
+				collectors.NewControllerNoopCollector(),
+				logr.Discard(),
+				"127.0.0.1",
+				time.Second,
+				func(_ context.Context, event *events.SmokeTestDoneEvent) {
+					reports <- event
+				},
+			)
+			handler.appliedControlConfig = &ngfAPI.NginxGatewaySpec{
+				SmokeTest: &ngfAPI.SmokeTest{
+					// nothing listens on the port, so the probe fails
+					Probes: []ngfAPI.SmokeTestProbe{{Name: "cafe", Hostname: "cafe.example.com", Port: 1}},
+				},
+			}
+			fakeProcessor.GetLatestGraphReturns(&graph.Graph{})
+		})
+
+		It("should send the probes in the background and report their failures in a later batch", func() {
+			handler.latestReloadResult.SmokeTestFailures = []string{"previous failure"}
+
+			fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+			e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+			handler.HandleEventBatch(context.Background(), logr.Discard(), []interface{}{e})
+
+			// the failures are kept until the probes of the updated configuration are done
+			Expect(handler.latestReloadResult.SmokeTestFailures).To(Equal([]string{"previous failure"}))
+
+			var done *events.SmokeTestDoneEvent
+			Eventually(reports).Should(Receive(&done))
+			Expect(done.Version).To(Equal(1))
+			Expect(done.Failures).To(HaveLen(1))
+
+			fakeProcessor.ProcessReturns(state.NoChange, &graph.Graph{})
+			updateCount := fakeStatusUpdater.UpdateGroupCallCount()
+
+			handler.HandleEventBatch(context.Background(), logr.Discard(), []interface{}{done})
+
+			Expect(handler.latestReloadResult.SmokeTestFailures).To(Equal(done.Failures))
+			Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(updateCount + 1))
+			_, name, _ := fakeStatusUpdater.UpdateGroupArgsForCall(updateCount)
+			Expect(name).To(Equal(groupGateways))
+		})
+
+		It("should ignore the failures of the probes of an outdated configuration", func() {
+			handler.version = 2
+
+			done := &events.SmokeTestDoneEvent{Failures: []string{"probe cafe: request failed"}, Version: 1}
+			handler.HandleEventBatch(context.Background(), logr.Discard(), []interface{}{done})
+
+			Expect(handler.latestReloadResult.SmokeTestFailures).To(BeEmpty())
+			Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(BeZero())
+		})
+	})
+
 	It("should panic for an unknown event type", func() {
 		e := &struct{}{}
 
@@ -652,6 +1041,33 @@ var _ = Describe("serversEqual", func() {
 			},
 			true,
 		),
+		Entry("same draining elements",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1", State: peerStateDraining},
+			},
+			true,
+		),
+		Entry("server to drain",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1", State: "up"},
+			},
+			false,
+		),
+		Entry("draining server to bring back",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1", State: peerStateDraining},
+			},
+			false,
+		),
 	)
 	DescribeTable("determines if stream server lists are equal",
 		func(newServers []ngxclient.StreamUpstreamServer, oldServers []ngxclient.StreamPeer, equal bool) {
@@ -692,6 +1108,26 @@ var _ = Describe("serversEqual", func() {
 	)
 })
 
+var _ = Describe("undrainServers", func() {
+	It("marks the draining servers as up", func() {
+		servers := []ngxclient.UpstreamServer{
+			{Server: "server1"},
+			{Server: "server2"},
+		}
+		peers := []ngxclient.Peer{
+			{Server: "server1", State: peerStateDraining},
+			{Server: "server2", State: "up"},
+		}
+
+		undrainServers(servers, peers)
+
+		Expect(servers).To(Equal([]ngxclient.UpstreamServer{
+			{Server: "server1", Down: helpers.GetPointer(false)},
+			{Server: "server2"},
+		}))
+	})
+})
+
 var _ = Describe("getGatewayAddresses", func() {
 	It("gets gateway addresses from a Service", func() {
 		fakeClient := fake.NewFakeClient()
@@ -702,7 +1138,13 @@ var _ = Describe("getGatewayAddresses", func() {
 		}
 
 		// no Service exists yet, should get error and Pod Address
-		addrs, err := getGatewayAddresses(context.Background(), fakeClient, nil, podConfig)
+		addrs, err := getGatewayAddresses(
+			context.Background(),
+			fakeClient,
+			nil,
+			podConfig,
+			ngfAPI.AddressPublicationAll,
+		)
 		Expect(err).To(HaveOccurred())
 		Expect(addrs).To(HaveLen(1))
 		Expect(addrs[0].Value).To(Equal("1.2.3.4"))
@@ -732,12 +1174,118 @@ var _ = Describe("getGatewayAddresses", func() {
 
 		Expect(fakeClient.Create(context.Background(), &svc)).To(Succeed())
 
-		addrs, err = getGatewayAddresses(context.Background(), fakeClient, &svc, podConfig)
+		addrs, err = getGatewayAddresses(
+			context.Background(),
+			fakeClient,
+			&svc,
+			podConfig,
+			ngfAPI.AddressPublicationAll,
+		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(addrs).To(HaveLen(2))
 		Expect(addrs[0].Value).To(Equal("34.35.36.37"))
 		Expect(addrs[1].Value).To(Equal("myhost"))
 	})
+
+	Describe("addresses of a LoadBalancer Service", func() {
+		svc := &v1.Service{
+			Spec: v1.ServiceSpec{
+				Type: v1.ServiceTypeLoadBalancer,
+			},
+			Status: v1.ServiceStatus{
+				LoadBalancer: v1.LoadBalancerStatus{
+					Ingress: []v1.LoadBalancerIngress{
+						{Hostname: "b.example.com"},
+						{IP: "2001:db8::1"},
+						{IP: "10.0.0.2", Hostname: "a.example.com"},
+						{IP: "10.0.0.1"},
+						{Hostname: "10.0.0.3"},
+						{IP: "10.0.0.1"},
+						{Hostname: "a.example.com"},
+					},
+				},
+			},
+		}
+
+		ipAddress := func(value string) gatewayv1.GatewayStatusAddress {
+			return gatewayv1.GatewayStatusAddress{Type: helpers.GetPointer(gatewayv1.IPAddressType), Value: value}
+		}
+
+		hostname := func(value string) gatewayv1.GatewayStatusAddress {
+			return gatewayv1.GatewayStatusAddress{Type: helpers.GetPointer(gatewayv1.HostnameAddressType), Value: value}
+		}
+
+		It("dedupes, orders, and types the addresses", func() {
+			addrs, err := getGatewayAddresses(
+				context.Background(),
+				nil,
+				svc,
+				config.GatewayPodConfig{},
+				ngfAPI.AddressPublicationAll,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addrs).To(Equal([]gatewayv1.GatewayStatusAddress{
+				ipAddress("10.0.0.1"),
+				ipAddress("10.0.0.2"),
+				ipAddress("10.0.0.3"),
+				ipAddress("2001:db8::1"),
+				hostname("a.example.com"),
+				hostname("b.example.com"),
+			}))
+		})
+
+		It("only publishes the hostnames if the publication prefers the hostnames", func() {
+			addrs, err := getGatewayAddresses(
+				context.Background(),
+				nil,
+				svc,
+				config.GatewayPodConfig{},
+				ngfAPI.AddressPublicationPreferHostname,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addrs).To(Equal([]gatewayv1.GatewayStatusAddress{
+				hostname("a.example.com"),
+				hostname("b.example.com"),
+			}))
+		})
+
+		It("publishes the IP addresses if the publication prefers the hostnames but there are no hostnames", func() {
+			ipOnlySvc := svc.DeepCopy()
+			ipOnlySvc.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{IP: "10.0.0.1"}}
+
+			addrs, err := getGatewayAddresses(
+				context.Background(),
+				nil,
+				ipOnlySvc,
+				config.GatewayPodConfig{},
+				ngfAPI.AddressPublicationPreferHostname,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addrs).To(Equal([]gatewayv1.GatewayStatusAddress{ipAddress("10.0.0.1")}))
+		})
+	})
+})
+
+var _ = Describe("getAddressPublication", func() {
+	It("returns All by default", func() {
+		Expect(getAddressPublication(nil)).To(Equal(ngfAPI.AddressPublicationAll))
+		Expect(getAddressPublication(&graph.Graph{})).To(Equal(ngfAPI.AddressPublicationAll))
+	})
+
+	It("returns the address publication of the NginxProxy", func() {
+		gr := &graph.Graph{
+			NginxProxy: &graph.NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						AddressPublication: helpers.GetPointer(ngfAPI.AddressPublicationPreferHostname),
+					},
+				},
+				Valid: true,
+			},
+		}
+
+		Expect(getAddressPublication(gr)).To(Equal(ngfAPI.AddressPublicationPreferHostname))
+	})
 })
 
 var _ = Describe("getDeploymentContext", func() {
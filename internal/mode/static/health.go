@@ -21,20 +21,33 @@ type nginxConfiguredOnStartChecker struct {
 	// readyCh is a channel that is initialized in newNginxConfiguredOnStartChecker and represents if the NGF Pod is ready.
 	readyCh chan struct{}
 	lock    sync.RWMutex
-	ready   bool
+	// ready is set when the handler applied the first configuration built from the cluster.
+	ready bool
+	// restored is set when NGINX was programmed with the cold start snapshot before the manager started.
+	// The Pod is ready while NGINX serves the restored configuration, but ready is not set and readyCh is not closed
+	// until the first configuration built from the cluster is applied.
+	restored bool
+	// draining is set when the Pod is being evicted, so that the Pod stops receiving new connections.
+	draining bool
 }
 
 // readyCheck returns the ready-state of the Pod. It satisfies the controller-runtime Checker type.
 // We are considered ready after the handler processed the first batch. In case there is NGINX configuration
-// to write, it must be written and NGINX must be reloaded successfully.
+// to write, it must be written and NGINX must be reloaded successfully. If NGINX was programmed with the cold start
+// snapshot, we are considered ready right away, because NGINX serves the last applied configuration; if the first
+// configuration built from the cluster fails to apply, NGINX keeps serving the restored one.
 func (h *nginxConfiguredOnStartChecker) readyCheck(_ *http.Request) error {
 	h.lock.RLock()
 	defer h.lock.RUnlock()
 
-	if !h.ready {
+	if !h.ready && !h.restored {
 		return errors.New("nginx has not yet become ready to accept traffic")
 	}
 
+	if h.draining {
+		return errors.New("the Pod is being evicted, nginx no longer accepts new traffic")
+	}
+
 	return nil
 }
 
@@ -48,6 +61,23 @@ func (h *nginxConfiguredOnStartChecker) setAsReady() {
 	close(h.readyCh)
 }
 
+// setAsRestored marks the health check as ready, because NGINX was programmed with the cold start snapshot.
+// Unlike setAsReady, it doesn't mark the first configuration built from the cluster as applied.
+func (h *nginxConfiguredOnStartChecker) setAsRestored() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.restored = true
+}
+
+// setDraining marks the health check as not ready while the Pod is being evicted.
+func (h *nginxConfiguredOnStartChecker) setDraining(draining bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.draining = draining
+}
+
 // getReadyCh returns a read-only channel, which determines if the NGF Pod is ready.
 func (h *nginxConfiguredOnStartChecker) getReadyCh() <-chan struct{} {
 	return h.readyCh
@@ -14,4 +14,31 @@ func TestReadyCheck(t *testing.T) {
 
 	nginxChecker.ready = true
 	g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+
+	nginxChecker.setDraining(true)
+	g.Expect(nginxChecker.readyCheck(nil)).ToNot(Succeed())
+
+	nginxChecker.setDraining(false)
+	g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+}
+
+func TestReadyCheck_Restored(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	nginxChecker := newNginxConfiguredOnStartChecker()
+
+	nginxChecker.setAsRestored()
+	g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+
+	// the first configuration built from the cluster is not applied yet
+	g.Expect(nginxChecker.ready).To(BeFalse())
+	g.Expect(nginxChecker.getReadyCh()).ToNot(BeClosed())
+
+	nginxChecker.setDraining(true)
+	g.Expect(nginxChecker.readyCheck(nil)).ToNot(Succeed())
+
+	nginxChecker.setDraining(false)
+	nginxChecker.setAsReady()
+	g.Expect(nginxChecker.readyCheck(nil)).To(Succeed())
+	g.Expect(nginxChecker.getReadyCh()).To(BeClosed())
 }
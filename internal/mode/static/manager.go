@@ -29,6 +29,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
@@ -47,15 +49,26 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/runnables"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/artifacts"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/dataplaneapi"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/licensing"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	ngxcfg "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cachecontrol"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/listenertls"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/ratelimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/responsecompression"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	ngxvalidation "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/errorlog"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	ngxruntime "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
@@ -128,28 +141,12 @@ func StartManager(cfg config.Config) error {
 	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
 
 	genericValidator := ngxvalidation.GenericValidator{}
-	policyManager := createPolicyManager(mustExtractGVK, genericValidator)
 
 	plusSecrets, err := createPlusSecretMetadata(cfg, mgr.GetAPIReader())
 	if err != nil {
 		return err
 	}
 
-	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
-		GatewayCtlrName:  cfg.GatewayCtlrName,
-		GatewayClassName: cfg.GatewayClassName,
-		Logger:           cfg.Logger.WithName("changeProcessor"),
-		Validators: validation.Validators{
-			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
-			GenericValidator:    genericValidator,
-			PolicyValidator:     policyManager,
-		},
-		EventRecorder:  recorder,
-		MustExtractGVK: mustExtractGVK,
-		ProtectedPorts: protectedPorts,
-		PlusSecrets:    plusSecrets,
-	})
-
 	// Clear the configuration folders to ensure that no files are left over in case the control plane was restarted
 	// (this assumes the folders are in a shared volume).
 	removedPaths, err := file.ClearFolders(file.NewStdLibOSFileManager(), ngxcfg.ConfigFolders)
@@ -169,9 +166,70 @@ func StartManager(cfg config.Config) error {
 	}
 	cfg.Logger.V(1).Info("NGINX is running with PID", "pid", p)
 
+	quicSupported, err := ngxruntime.SupportsQUIC(os.ReadFile)
+	if err != nil {
+		cfg.Logger.Error(err, "Cannot determine if NGINX supports QUIC, HTTP/3 is disabled")
+	}
+
+	brotliSupported, err := ngxruntime.SupportsBrotli(os.ReadFile)
+	if err != nil {
+		cfg.Logger.Error(err, "Cannot determine if NGINX supports Brotli, Brotli compression is disabled")
+	}
+
+	policyManager := createPolicyManager(mustExtractGVK, genericValidator, brotliSupported, cfg.Plus)
+
+	var externalNameResolvers []string
+	if cfg.ExternalNameServices {
+		externalNameResolvers, err = ngxruntime.GetNameservers(os.ReadFile)
+		if err != nil {
+			cfg.Logger.Error(err, "Cannot determine the nameservers for ExternalName Services, they are disabled")
+		}
+	}
+
+	artifactFetcher := artifacts.NewFetcher(artifacts.FetcherConfig{
+		Logger:              cfg.Logger.WithName("artifactFetcher"),
+		RequireVerification: cfg.RequireArtifactSignatures,
+		OnUpdate: func() {
+			select {
+			case eventCh <- &events.ArtifactUpdateEvent{}:
+			case <-ctx.Done():
+			}
+		},
+	})
+
+	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+		GatewayCtlrName:  cfg.GatewayCtlrName,
+		GatewayClassName: cfg.GatewayClassName,
+		GatewaySelector:  cfg.GatewaySelector,
+		Logger:           cfg.Logger.WithName("changeProcessor"),
+		Validators: validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    genericValidator,
+			PolicyValidator:     policyManager,
+			PolicyDefaulter:     policyManager,
+		},
+		EventRecorder:   recorder,
+		MustExtractGVK:  mustExtractGVK,
+		ProtectedPorts:  protectedPorts,
+		PlusSecrets:     plusSecrets,
+		QUICSupported:   quicSupported,
+		ArtifactFetcher: artifactFetcher,
+		// NGINX can only resolve the names of ExternalName Services if the nameservers are known.
+		AllowExternalNameServices: len(externalNameResolvers) > 0,
+		AllowTemplateOverrides:    cfg.TemplateOverrides,
+	})
+
 	var (
 		ngxruntimeCollector ngxruntime.MetricsCollector = collectors.NewManagerNoopCollector()
 		handlerCollector    handlerMetricsCollector     = collectors.NewControllerNoopCollector()
+		listenerCollector   listenerMetricsCollector    = collectors.NewListenerNoopCollector()
+		cacheCollector      cacheMetricsCollector       = collectors.NewCacheNoopCollector()
+		statusCollector     status.MetricsCollector     = status.NewNoopMetricsCollector()
+
+		certificateExpiryCollector certificateExpiryMetricsCollector = collectors.NewControllerNoopCollector()
+		throttleCollector          events.ThrottleMetricsCollector   = collectors.NewControllerNoopCollector()
+		upstreamServerCollector    upstreamServerMetricsCollector    = collectors.NewUpstreamServerNoopCollector()
+		nginxErrorCollector        nginxErrorMetricsCollector        = collectors.NewControllerNoopCollector()
 	)
 
 	var ngxPlusClient ngxruntime.NginxPlusClient
@@ -195,7 +253,12 @@ func StartManager(cfg config.Config) error {
 		}
 
 		ngxruntimeCollector = collectors.NewManagerMetricsCollector(constLabels)
-		handlerCollector = collectors.NewControllerCollector(constLabels)
+		controllerCollector := collectors.NewControllerCollector(constLabels)
+		handlerCollector = controllerCollector
+		statusCollector = controllerCollector
+		certificateExpiryCollector = controllerCollector
+		throttleCollector = controllerCollector
+		nginxErrorCollector = controllerCollector
 
 		ngxruntimeCollector, ok := ngxruntimeCollector.(prometheus.Collector)
 		if !ok {
@@ -211,11 +274,36 @@ func StartManager(cfg config.Config) error {
 			ngxruntimeCollector,
 			handlerCollector,
 		)
+
+		if cfg.Plus {
+			plusListenerCollector, err := collectors.NewListenerCollector(
+				ngxPlusClient,
+				constLabels,
+				cfg.Logger.WithName("listenerMetricsCollector"),
+			)
+			if err != nil {
+				return fmt.Errorf("cannot create listener metrics collector: %w", err)
+			}
+			listenerCollector = plusListenerCollector
+
+			plusCacheCollector := collectors.NewCacheCollector(
+				ngxPlusClient,
+				constLabels,
+				cfg.Logger.WithName("cacheMetricsCollector"),
+			)
+			cacheCollector = plusCacheCollector
+
+			plusUpstreamServerCollector := collectors.NewUpstreamServerCollector(constLabels)
+			upstreamServerCollector = plusUpstreamServerCollector
+
+			metrics.Registry.MustRegister(plusListenerCollector, plusCacheCollector, plusUpstreamServerCollector)
+		}
 	}
 
 	statusUpdater := status.NewUpdater(
 		mgr.GetClient(),
 		cfg.Logger.WithName("statusUpdater"),
+		statusCollector,
 	)
 
 	groupStatusUpdater := status.NewLeaderAwareGroupUpdater(statusUpdater)
@@ -225,58 +313,307 @@ func StartManager(cfg config.Config) error {
 		Logger:          cfg.Logger.WithName("deployCtxCollector"),
 	})
 
+	var nginxConfigDumper *nginxConfigDumper
+	if cfg.NginxConfigDumpConfig.Enabled {
+		nginxConfigDumper = newNginxConfigDumper(
+			mgr.GetClient(),
+			types.NamespacedName{
+				Namespace: cfg.GatewayPodConfig.Namespace,
+				Name:      cfg.NginxConfigDumpConfig.ConfigMapName,
+			},
+			cfg.Logger.WithName("nginxConfigDumper"),
+		)
+	}
+
+	var nginxValidator ngxruntime.ConfigValidator
+	if cfg.NginxValidatorConfig.Enabled {
+		nginxValidator = ngxruntime.NewNginxValidator(ngxruntime.NginxValidatorConfig{
+			Logger:        cfg.Logger.WithName("nginxValidator"),
+			Dir:           ngxruntime.ValidatorDir,
+			ConfigFolders: ngxcfg.ConfigFolders,
+			Port:          cfg.NginxValidatorConfig.Port,
+		})
+	}
+
+	nginxRuntimeMgr := ngxruntime.NewManagerImpl(
+		ngxPlusClient,
+		ngxruntimeCollector,
+		cfg.Logger.WithName("nginxRuntimeManager"),
+		processHandler,
+		ngxruntime.NewVerifyClient(ngxruntime.NginxReloadTimeout),
+		nginxValidator,
+	)
+
+	orphanCollector := newOrphanCollector(
+		file.NewStdLibOSFileManager(),
+		nginxRuntimeMgr,
+		handlerCollector,
+		cfg.Logger.WithName("orphanCollector"),
+		ngxcfg.ConfigFolders,
+		cfg.Plus,
+	)
+
+	smokeTester := newSmokeTester(
+		handlerCollector,
+		cfg.Logger.WithName("smokeTester"),
+		smokeTestAddress,
+		smokeTestProbeTimeout,
+		func(ctx context.Context, event *events.SmokeTestDoneEvent) {
+			select {
+			case eventCh <- event:
+			case <-ctx.Done():
+			}
+		},
+	)
+
+	// the in-flight requests are counted with the NGINX Plus API
+	var gate *reloadGate
+	if cfg.Plus {
+		gate = newReloadGate(nginxRuntimeMgr, cfg.Logger.WithName("reloadGate"), reloadGatingPollInterval)
+	}
+
+	var dataPlaneAPIServer *dataplaneapi.Server
+	if cfg.DataPlaneAPIConfig.Enabled {
+		dataPlaneAPIServer = dataplaneapi.NewServer(dataplaneapi.ServerConfig{
+			Logger:  cfg.Logger.WithName("dataPlaneAPIServer"),
+			CertDir: cfg.DataPlaneAPIConfig.CertDir,
+			Port:    cfg.DataPlaneAPIConfig.Port,
+		})
+	}
+
+	var nginxErrorLogSocket string
+	if cfg.NginxErrorLogConfig.Enabled {
+		nginxErrorLogSocket = errorlog.SocketPath
+	}
+
+	serviceResolver := resolver.NewServiceResolverImpl(mgr.GetClient())
+	generator := ngxcfg.NewGeneratorImpl(
+		cfg.Plus,
+		&cfg.UsageReportConfig,
+		externalNameResolvers,
+		cfg.Logger.WithName("generator"),
+	)
+
+	nginxFileMgr := file.NewManagerImpl(
+		cfg.Logger.WithName("nginxFileManager"),
+		file.NewStdLibOSFileManager(),
+	)
+
+	var coldStartSnapshotStore *coldStartSnapshotStore
+	if cfg.ColdStartSnapshotConfig.Enabled {
+		coldStartSnapshotLogger := cfg.Logger.WithName("coldStartSnapshot")
+		coldStartSnapshotStore = newColdStartSnapshotStore(
+			cfg.ColdStartSnapshotConfig.Dir,
+			cfg.Version,
+			ngxcfg.ConfigFolders,
+			coldStartSnapshotLogger,
+		)
+
+		// NGINX is programmed before the manager starts, so that the Pod is ready and serves traffic while the caches
+		// sync. The event handler then replaces the restored configuration with the one built from the cluster.
+		if restoreColdStartSnapshot(ctx, coldStartSnapshotStore, nginxFileMgr, nginxRuntimeMgr, coldStartSnapshotLogger) {
+			nginxChecker.setAsRestored()
+		}
+	}
+
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
-		nginxFileMgr: file.NewManagerImpl(
-			cfg.Logger.WithName("nginxFileManager"),
-			file.NewStdLibOSFileManager(),
-		),
-		metricsCollector: handlerCollector,
-		nginxRuntimeMgr: ngxruntime.NewManagerImpl(
-			ngxPlusClient,
-			ngxruntimeCollector,
-			cfg.Logger.WithName("nginxRuntimeManager"),
-			processHandler,
-			ngxruntime.NewVerifyClient(ngxruntime.NginxReloadTimeout),
-		),
-		statusUpdater:   groupStatusUpdater,
-		processor:       processor,
-		serviceResolver: resolver.NewServiceResolverImpl(mgr.GetClient()),
-		generator: ngxcfg.NewGeneratorImpl(
-			cfg.Plus,
-			&cfg.UsageReportConfig,
-			cfg.Logger.WithName("generator"),
-		),
-		k8sClient:                     mgr.GetClient(),
-		k8sReader:                     mgr.GetAPIReader(),
-		logLevelSetter:                logLevelSetter,
-		eventRecorder:                 recorder,
-		deployCtxCollector:            deployCtxCollector,
-		nginxConfiguredOnStartChecker: nginxChecker,
-		gatewayPodConfig:              cfg.GatewayPodConfig,
-		controlConfigNSName:           controlConfigNSName,
-		gatewayCtlrName:               cfg.GatewayCtlrName,
-		updateGatewayClassStatus:      cfg.UpdateGatewayClassStatus,
-		plus:                          cfg.Plus,
+		nginxFileMgr:                   nginxFileMgr,
+		metricsCollector:               handlerCollector,
+		listenerMetricsCollector:       listenerCollector,
+		cacheMetricsCollector:          cacheCollector,
+		upstreamServerMetricsCollector: upstreamServerCollector,
+		nginxRuntimeMgr:                nginxRuntimeMgr,
+		statusUpdater:                  groupStatusUpdater,
+		statusPausedTracker:            statusUpdater,
+		processor:                      processor,
+		serviceResolver:                serviceResolver,
+		generator:                      generator,
+		k8sClient:                      mgr.GetClient(),
+		k8sReader:                      mgr.GetAPIReader(),
+		logLevelSetter:                 logLevelSetter,
+		eventRecorder:                  recorder,
+		deployCtxCollector:             deployCtxCollector,
+		nginxConfigDumper:              nginxConfigDumper,
+		dataPlaneAPIServer:             dataPlaneAPIServer,
+		coldStartSnapshotStore:         coldStartSnapshotStore,
+		orphanCollector:                orphanCollector,
+		smokeTester:                    smokeTester,
+		reloadGate:                     gate,
+		nginxConfiguredOnStartChecker:  nginxChecker,
+		gatewayPodConfig:               cfg.GatewayPodConfig,
+		controlConfigNSName:            controlConfigNSName,
+		gatewayCtlrName:                cfg.GatewayCtlrName,
+		nginxErrorLogSocket:            nginxErrorLogSocket,
+		updateGatewayClassStatus:       cfg.UpdateGatewayClassStatus,
+		selectsGateways:                cfg.GatewaySelector != nil,
+		plus:                           cfg.Plus,
 	})
 
+	if cfg.MetricsConfig.Enabled {
+		snapshotHandler := newConfigurationSnapshotHandler(eventHandler, cfg.Logger.WithName("configurationSnapshot"))
+		if err = mgr.AddMetricsServerExtraHandler(configurationSnapshotPath, snapshotHandler); err != nil {
+			return fmt.Errorf("cannot register configuration snapshot handler: %w", err)
+		}
+
+		inventoryHandler := newRouteInventoryHandler(processor, cfg.Logger.WithName("routeInventory"))
+		if err = mgr.AddMetricsServerExtraHandler(routeInventoryPath, inventoryHandler); err != nil {
+			return fmt.Errorf("cannot register route inventory handler: %w", err)
+		}
+
+		renderer := configurationRenderer{
+			graphGetter:         processor,
+			configurationGetter: eventHandler,
+			serviceResolver:     serviceResolver,
+			generator:           generator,
+			plus:                cfg.Plus,
+		}
+
+		renderedConfigurationHandler := newRenderedConfigurationHandler(
+			renderer,
+			cfg.Logger.WithName("renderedConfiguration"),
+		)
+		if err = mgr.AddMetricsServerExtraHandler(renderedConfigurationPath, renderedConfigurationHandler); err != nil {
+			return fmt.Errorf("cannot register rendered configuration handler: %w", err)
+		}
+
+		provenanceHandler := newProvenanceHandler(renderer, cfg.Logger.WithName("provenance"))
+		if err = mgr.AddMetricsServerExtraHandler(provenancePath, provenanceHandler); err != nil {
+			return fmt.Errorf("cannot register provenance handler: %w", err)
+		}
+	}
+
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(cfg)
 
+	var eventLimiter *events.NamespaceRateLimiter
+	if cfg.EventRateLimitConfig.EventsPerSecond > 0 {
+		eventLimiter = events.NewNamespaceRateLimiter(
+			float64(cfg.EventRateLimitConfig.EventsPerSecond),
+			cfg.EventRateLimitConfig.Burst,
+			throttleCollector,
+		)
+	}
+
 	firstBatchPreparer := events.NewFirstEventBatchPreparerImpl(mgr.GetCache(), objects, objectLists)
 	eventLoop := events.NewEventLoop(
 		eventCh,
 		cfg.Logger.WithName("eventLoop"),
 		eventHandler,
 		firstBatchPreparer,
+		eventLimiter,
 	)
 
 	if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: eventLoop}); err != nil {
 		return fmt.Errorf("cannot register event loop: %w", err)
 	}
 
+	if dataPlaneAPIServer != nil {
+		// every replica handles the events, so every replica can serve the configuration
+		if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: dataPlaneAPIServer}); err != nil {
+			return fmt.Errorf("cannot register data plane API server: %w", err)
+		}
+	}
+
+	if nginxConfigDumper != nil {
+		// the replicas share the ConfigMap, so only the leader writes it
+		if err = mgr.Add(&runnables.Leader{Runnable: nginxConfigDumper}); err != nil {
+			return fmt.Errorf("cannot register NGINX config dumper: %w", err)
+		}
+	}
+
+	if cfg.NginxValidatorConfig.Enabled {
+		// the NGINX runtime manager prepares the validator NGINX instance of the nginx-validator container
+		if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: nginxRuntimeMgr}); err != nil {
+			return fmt.Errorf("cannot register NGINX runtime manager: %w", err)
+		}
+	}
+
 	if err = mgr.Add(runnables.NewEnableAfterBecameLeader(groupStatusUpdater.Enable)); err != nil {
 		return fmt.Errorf("cannot register status updater: %w", err)
 	}
 
+	if cfg.HealthConfig.Enabled {
+		// the eviction watch drains the Pod through its readiness, so it is only useful with the health probe
+		evictionWatch := newEvictionWatch(
+			mgr.GetAPIReader(),
+			mgr.GetConfig(),
+			mgr.GetScheme(),
+			types.NamespacedName{Namespace: cfg.GatewayPodConfig.Namespace, Name: cfg.GatewayPodConfig.Name},
+			nginxChecker,
+			cfg.Logger.WithName("evictionWatch"),
+		)
+
+		if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: evictionWatch}); err != nil {
+			return fmt.Errorf("cannot register eviction watch: %w", err)
+		}
+	}
+
+	if cfg.WebhookConfig.Enabled {
+		crdConversionLogger := cfg.Logger.WithName("crdConversionConfigurer")
+		crdConversionConfigurer := newCRDConversionConfigurer(
+			mgr.GetAPIReader(),
+			mgr.GetClient(),
+			types.NamespacedName{Namespace: cfg.GatewayPodConfig.Namespace, Name: cfg.WebhookConfig.ServiceName},
+			cfg.WebhookConfig.CertDir,
+			crdConversionLogger,
+		)
+
+		// the webhook server doesn't depend on NGINX, so the CRDs are configured right away
+		crdConversionReadyCh := make(chan struct{})
+		close(crdConversionReadyCh)
+
+		crdConversionJob := runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  crdConversionConfigurer.worker(),
+			Logger:  crdConversionLogger,
+			Period:  crdConversionCheckPeriod,
+			ReadyCh: crdConversionReadyCh,
+		})
+
+		if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: crdConversionJob}); err != nil {
+			return fmt.Errorf("cannot register CRD conversion configurer: %w", err)
+		}
+	}
+
+	certificateExpiryLogger := cfg.Logger.WithName("certificateExpiryMonitor")
+	certificateExpiryMonitor := newCertificateExpiryMonitor(
+		processor,
+		certificateExpiryCollector,
+		recorder,
+		certificateExpiryLogger,
+		time.Duration(cfg.CertificateExpiryWarningDays)*24*time.Hour,
+	)
+	// the monitor only runs on the leader, so that the Warning events are not duplicated
+	certificateExpiryJob := runnables.NewCronJob(runnables.CronJobConfig{
+		Worker:  certificateExpiryMonitor.worker(),
+		Logger:  certificateExpiryLogger,
+		Period:  certificateExpiryCheckPeriod,
+		ReadyCh: nginxChecker.getReadyCh(),
+	})
+
+	if err = mgr.Add(certificateExpiryJob); err != nil {
+		return fmt.Errorf("cannot register certificate expiry monitor: %w", err)
+	}
+
+	if cfg.NginxErrorLogConfig.Enabled {
+		var nginxErrorRecorder record.EventRecorder
+		if cfg.NginxErrorLogConfig.Events {
+			nginxErrorRecorder = recorder
+		}
+
+		nginxErrorLogLogger := cfg.Logger.WithName("nginxErrorLog")
+		nginxErrorLogMonitor := newNginxErrorLogMonitor(
+			processor,
+			nginxErrorCollector,
+			nginxErrorRecorder,
+			nginxErrorLogLogger,
+		)
+
+		// every replica parses the error log of its own NGINX
+		nginxErrorLogListener := errorlog.NewListener(errorlog.SocketPath, nginxErrorLogMonitor.handle, nginxErrorLogLogger)
+		if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: nginxErrorLogListener}); err != nil {
+			return fmt.Errorf("cannot register NGINX error log listener: %w", err)
+		}
+	}
+
 	if cfg.ProductTelemetryConfig.Enabled {
 		dataCollector := telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
 			K8sClientReader:     mgr.GetAPIReader(),
@@ -313,6 +650,8 @@ func StartManager(cfg config.Config) error {
 func createPolicyManager(
 	mustExtractGVK kinds.MustExtractGVK,
 	validator validation.GenericValidator,
+	brotliSupported bool,
+	plus bool,
 ) *policies.CompositeValidator {
 	cfgs := []policies.ManagerConfig{
 		{
@@ -322,11 +661,44 @@ func createPolicyManager(
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha2.ObservabilityPolicy{}),
 			Validator: observability.NewValidator(validator),
+			Defaulter: observability.NewDefaulter(),
 		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 			Validator: upstreamsettings.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.CacheControlPolicy{}),
+			Validator: cachecontrol.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.StaticContentPolicy{}),
+			Validator: staticcontent.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ListenerTLSPolicy{}),
+			Validator: listenertls.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+			Validator: errorpage.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ResponseCompressionPolicy{}),
+			Validator: responsecompression.NewValidator(brotliSupported),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+			Validator: cache.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.RateLimitPolicy{}),
+			Validator: ratelimit.NewValidator(validator, plus),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ConnectionLimitPolicy{}),
+			Validator: connectionlimit.NewValidator(validator),
+		},
 	}
 
 	return policies.NewManager(mustExtractGVK, cfgs...)
@@ -358,6 +730,13 @@ func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecke
 		options.HealthProbeBindAddress = fmt.Sprintf(":%d", cfg.HealthConfig.Port)
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		options.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    cfg.WebhookConfig.Port,
+			CertDir: cfg.WebhookConfig.CertDir,
+		})
+	}
+
 	clusterCfg := ctlr.GetConfigOrDie()
 	clusterCfg.Timeout = clusterTimeout
 
@@ -372,9 +751,89 @@ func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecke
 		}
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		if err := registerConversionWebhooks(mgr); err != nil {
+			return nil, err
+		}
+
+		if err := registerDefaultingWebhooks(mgr); err != nil {
+			return nil, err
+		}
+	}
+
 	return mgr, nil
 }
 
+// registerConversionWebhooks registers the conversion webhooks for the NGF API types that are served
+// in more than one version. The webhook converts objects between versions through the hub version.
+// See apis/v1alpha1/conversion.go.
+func registerConversionWebhooks(mgr manager.Manager) error {
+	for _, t := range convertibleTypes {
+		if err := ctlr.NewWebhookManagedBy(mgr).For(t.obj).Complete(); err != nil {
+			return fmt.Errorf("cannot register conversion webhook for %T: %w", t.obj, err)
+		}
+	}
+
+	return nil
+}
+
+// registerDefaultingWebhooks registers the defaulting webhooks for the NGF Policies and the NginxProxy.
+// The webhooks use the same defaults as the Graph, so that resources admitted before the webhooks were enabled
+// get the same defaults.
+func registerDefaultingWebhooks(mgr manager.Manager) error {
+	webhooks := []struct {
+		obj       client.Object
+		defaulter admission.CustomDefaulter
+	}{
+		{
+			obj:       &ngfAPIv1alpha2.ObservabilityPolicy{},
+			defaulter: policies.NewAdmissionDefaulter(observability.NewDefaulter()),
+		},
+		{
+			obj:       &ngfAPIv1alpha1.NginxProxy{},
+			defaulter: nginxProxyDefaulter{},
+		},
+	}
+
+	for _, wh := range webhooks {
+		err := ctlr.NewWebhookManagedBy(mgr).
+			For(wh.obj).
+			WithDefaulter(wh.defaulter).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("cannot register defaulting webhook for %T: %w", wh.obj, err)
+		}
+	}
+
+	return nil
+}
+
+// nginxProxyDefaulter sets the default values of the NginxProxies received by the defaulting webhook.
+// Implements admission.CustomDefaulter interface.
+type nginxProxyDefaulter struct{}
+
+// Default sets the default values of the NginxProxy.
+func (nginxProxyDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	npCfg, ok := obj.(*ngfAPIv1alpha1.NginxProxy)
+	if !ok {
+		return fmt.Errorf("expected an NginxProxy but got %T", obj)
+	}
+
+	graph.SetNginxProxyDefaults(npCfg)
+
+	return nil
+}
+
+// newStatusResourcePredicate returns the predicate of the resources that NGF writes the status of. Besides
+// the changes of the generation, it passes the changes of the StatusPausedAnnotation, which don't change
+// the generation, so that the status of a resource is written as soon as its status updates are resumed.
+func newStatusResourcePredicate() k8spredicate.Predicate {
+	return k8spredicate.Or(
+		k8spredicate.GenerationChangedPredicate{},
+		predicate.AnnotationPredicate{Annotation: status.StatusPausedAnnotation},
+	)
+}
+
 func registerControllers(
 	ctx context.Context,
 	cfg config.Config,
@@ -403,7 +862,7 @@ func registerControllers(
 			options: []controller.Option{
 				controller.WithK8sPredicate(
 					k8spredicate.And(
-						k8spredicate.GenerationChangedPredicate{},
+						newStatusResourcePredicate(),
 						predicate.GatewayClassPredicate{ControllerName: cfg.GatewayCtlrName},
 					),
 				),
@@ -412,8 +871,21 @@ func registerControllers(
 		{
 			objectType: &gatewayv1.Gateway{},
 			options: func() []controller.Option {
+				gwPredicate := newStatusResourcePredicate()
+				if cfg.GatewaySelector != nil {
+					// the labels of a Gateway determine whether it is selected, so their changes must be processed
+					// even though they don't change the generation of the Gateway.
+					gwPredicate = k8spredicate.And(
+						k8spredicate.Or(
+							newStatusResourcePredicate(),
+							k8spredicate.LabelChangedPredicate{},
+						),
+						predicate.LabelSelectorPredicate{Selector: cfg.GatewaySelector},
+					)
+				}
+
 				options := []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(gwPredicate),
 				}
 				if cfg.GatewayNsName != nil {
 					options = append(
@@ -427,7 +899,13 @@ func registerControllers(
 		{
 			objectType: &gatewayv1.HTTPRoute{},
 			options: []controller.Option{
-				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				// the path match options of HTTPRoutes are set with annotations
+				controller.WithK8sPredicate(
+					k8spredicate.Or(
+						k8spredicate.GenerationChangedPredicate{},
+						k8spredicate.AnnotationChangedPredicate{},
+					),
+				),
 			},
 		},
 		{
@@ -493,27 +971,92 @@ func registerControllers(
 		{
 			objectType: &gatewayv1.GRPCRoute{},
 			options: []controller.Option{
-				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
 			},
 		},
 		{
 			objectType: &ngfAPIv1alpha1.ClientSettingsPolicy{},
 			options: []controller.Option{
-				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
 			},
 		},
 		{
 			objectType: &ngfAPIv1alpha2.ObservabilityPolicy{},
 			options: []controller.Option{
-				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
 			},
 		},
 		{
 			objectType: &ngfAPIv1alpha1.UpstreamSettingsPolicy{},
 			options: []controller.Option{
-				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.CacheControlPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.StaticContentPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ListenerTLSPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ErrorPagePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ResponseCompressionPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.CachePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.RateLimitPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ConnectionLimitPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.RouteTest{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.DirectResponse{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(newStatusResourcePredicate()),
+			},
+		},
+		{
+			// FIXME(ciarams87): If possible, use only metadata predicate
+			// https://github.com/nginx/nginx-gateway-fabric/issues/1545
+			objectType: &apiv1.ConfigMap{},
+		},
 	}
 
 	if cfg.ExperimentalFeatures {
@@ -521,18 +1064,31 @@ func registerControllers(
 			{
 				objectType: &gatewayv1alpha3.BackendTLSPolicy{},
 				options: []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
 				},
 			},
 			{
-				// FIXME(ciarams87): If possible, use only metadata predicate
-				// https://github.com/nginx/nginx-gateway-fabric/issues/1545
-				objectType: &apiv1.ConfigMap{},
+				objectType: &gatewayv1alpha2.BackendLBPolicy{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
+				},
 			},
 			{
 				objectType: &gatewayv1alpha2.TLSRoute{},
 				options: []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
+				},
+			},
+			{
+				objectType: &gatewayv1alpha2.TCPRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
+				},
+			},
+			{
+				objectType: &gatewayv1alpha2.UDPRoute{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
 				},
 			},
 		}
@@ -563,7 +1119,13 @@ func registerControllers(
 			ctlrCfg{
 				objectType: &ngfAPIv1alpha1.SnippetsFilter{},
 				options: []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
+				},
+			},
+			ctlrCfg{
+				objectType: &ngfAPIv1alpha1.HTTPBodyTransform{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(newStatusResourcePredicate()),
 				},
 			},
 		)
@@ -743,6 +1305,7 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		&apiv1.ServiceList{},
 		&apiv1.SecretList{},
 		&apiv1.NamespaceList{},
+		&apiv1.ConfigMapList{},
 		&discoveryV1.EndpointSliceList{},
 		&gatewayv1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
@@ -751,6 +1314,16 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 		&ngfAPIv1alpha2.ObservabilityPolicyList{},
 		&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+		&ngfAPIv1alpha1.CacheControlPolicyList{},
+		&ngfAPIv1alpha1.StaticContentPolicyList{},
+		&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+		&ngfAPIv1alpha1.ErrorPagePolicyList{},
+		&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+		&ngfAPIv1alpha1.CachePolicyList{},
+		&ngfAPIv1alpha1.RateLimitPolicyList{},
+		&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+		&ngfAPIv1alpha1.RouteTestList{},
+		&ngfAPIv1alpha1.DirectResponseList{},
 		partialObjectMetadataList,
 	}
 
@@ -758,8 +1331,10 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		objectLists = append(
 			objectLists,
 			&gatewayv1alpha3.BackendTLSPolicyList{},
-			&apiv1.ConfigMapList{},
+			&gatewayv1alpha2.BackendLBPolicyList{},
 			&gatewayv1alpha2.TLSRouteList{},
+			&gatewayv1alpha2.TCPRouteList{},
+			&gatewayv1alpha2.UDPRouteList{},
 		)
 	}
 
@@ -767,6 +1342,7 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		objectLists = append(
 			objectLists,
 			&ngfAPIv1alpha1.SnippetsFilterList{},
+			&ngfAPIv1alpha1.HTTPBodyTransformList{},
 		)
 	}
 
@@ -815,7 +1391,8 @@ func setInitialConfig(
 
 	// status is not updated until the status updater's cache is started and the
 	// resource is processed by the controller
-	return updateControlPlane(&conf, logger, eventRecorder, configName, logLevelSetter)
+	_, err := updateControlPlane(&conf, nil, logger, eventRecorder, configName, logLevelSetter)
+	return err
 }
 
 func getMetricsOptions(cfg config.MetricsConfig) metricsserver.Options {
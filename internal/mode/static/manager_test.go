@@ -59,6 +59,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1.GatewayList{},
@@ -69,6 +70,16 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.CacheControlPolicyList{},
+				&ngfAPIv1alpha1.StaticContentPolicyList{},
+				&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.RouteTestList{},
+				&ngfAPIv1alpha1.DirectResponseList{},
 			},
 		},
 		{
@@ -90,6 +101,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
@@ -99,6 +111,16 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.CacheControlPolicyList{},
+				&ngfAPIv1alpha1.StaticContentPolicyList{},
+				&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.RouteTestList{},
+				&ngfAPIv1alpha1.DirectResponseList{},
 			},
 		},
 		{
@@ -127,11 +149,24 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.NginxProxyList{},
 				partialObjectMetadataList,
 				&gatewayv1alpha3.BackendTLSPolicyList{},
+				&gatewayv1alpha2.BackendLBPolicyList{},
 				&gatewayv1alpha2.TLSRouteList{},
+				&gatewayv1alpha2.TCPRouteList{},
+				&gatewayv1alpha2.UDPRouteList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.CacheControlPolicyList{},
+				&ngfAPIv1alpha1.StaticContentPolicyList{},
+				&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.RouteTestList{},
+				&ngfAPIv1alpha1.DirectResponseList{},
 			},
 		},
 		{
@@ -153,6 +188,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
@@ -162,7 +198,18 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
+				&ngfAPIv1alpha1.HTTPBodyTransformList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.CacheControlPolicyList{},
+				&ngfAPIv1alpha1.StaticContentPolicyList{},
+				&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.RouteTestList{},
+				&ngfAPIv1alpha1.DirectResponseList{},
 			},
 		},
 		{
@@ -191,12 +238,26 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.NginxProxyList{},
 				partialObjectMetadataList,
 				&gatewayv1alpha3.BackendTLSPolicyList{},
+				&gatewayv1alpha2.BackendLBPolicyList{},
 				&gatewayv1alpha2.TLSRouteList{},
+				&gatewayv1alpha2.TCPRouteList{},
+				&gatewayv1alpha2.UDPRouteList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
+				&ngfAPIv1alpha1.HTTPBodyTransformList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.CacheControlPolicyList{},
+				&ngfAPIv1alpha1.StaticContentPolicyList{},
+				&ngfAPIv1alpha1.ListenerTLSPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ResponseCompressionPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.ConnectionLimitPolicyList{},
+				&ngfAPIv1alpha1.RouteTestList{},
+				&ngfAPIv1alpha1.DirectResponseList{},
 			},
 		},
 	}
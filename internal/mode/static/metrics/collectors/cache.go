@@ -0,0 +1,141 @@
+package collectors
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/nginxinc/nginx-plus-go-client/client"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var cacheLabels = []string{"namespace", "policy"}
+
+// cacheStatsClient gets the stats of the caches from the NGINX Plus API.
+type cacheStatsClient interface {
+	GetCaches() (*client.Caches, error)
+}
+
+// CacheCollector collects the hit metrics of the caches of the CachePolicies from the stats of the caches
+// in the NGINX Plus API.
+// Implements the prometheus.Collector interface.
+type CacheCollector struct {
+	client    cacheStatsClient
+	logger    logr.Logger
+	hits      *prometheus.Desc
+	responses *prometheus.Desc
+	hitRatio  *prometheus.Desc
+	zones     []dataplane.CacheZone
+	lock      sync.RWMutex
+}
+
+// NewCacheCollector creates a new CacheCollector which fetches stats from the NGINX Plus API.
+func NewCacheCollector(
+	plusClient runtime.NginxPlusClient,
+	constLabels map[string]string,
+	logger logr.Logger,
+) *CacheCollector {
+	statsClient, ok := plusClient.(cacheStatsClient)
+	if !ok {
+		panic(fmt.Sprintf("expected a client that gets the stats of the caches, got %T", plusClient))
+	}
+
+	return &CacheCollector{
+		client: statsClient,
+		logger: logger,
+		hits: newCacheMetric(
+			"hits_total",
+			"Total responses of the CachePolicy that were taken from the cache, including the stale, "+
+				"updating, and revalidated responses",
+			constLabels,
+		),
+		responses: newCacheMetric(
+			"responses_total",
+			"Total responses of the CachePolicy that were looked up in the cache",
+			constLabels,
+		),
+		hitRatio: newCacheMetric(
+			"hit_ratio",
+			"Ratio of the responses of the CachePolicy that were taken from the cache to all looked up responses",
+			constLabels,
+		),
+	}
+}
+
+func newCacheMetric(name, help string, constLabels map[string]string) *prometheus.Desc {
+	return prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "cache", name),
+		help,
+		cacheLabels,
+		constLabels,
+	)
+}
+
+// SetCacheZones sets the caches of the CachePolicies.
+func (c *CacheCollector) SetCacheZones(zones []dataplane.CacheZone) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.zones = zones
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *CacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.responses
+	ch <- c.hitRatio
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *CacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	zones := c.zones
+	c.lock.RUnlock()
+
+	if len(zones) == 0 {
+		return
+	}
+
+	caches, err := c.client.GetCaches()
+	if err != nil {
+		c.logger.Error(err, "Error getting the stats of the caches for the cache metrics")
+		return
+	}
+
+	for _, zone := range zones {
+		cache, ok := (*caches)[zone.Name]
+		if !ok {
+			continue
+		}
+
+		hits := cache.Hit.Responses + cache.Stale.Responses + cache.Updating.Responses + cache.Revalidated.Responses
+		responses := hits + cache.Miss.Responses + cache.Expired.Responses + cache.Bypass.Responses
+
+		var hitRatio float64
+		if responses > 0 {
+			hitRatio = float64(hits) / float64(responses)
+		}
+
+		labels := []string{zone.Policy.Namespace, zone.Policy.Name}
+
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(hits), labels...)
+		ch <- prometheus.MustNewConstMetric(c.responses, prometheus.CounterValue, float64(responses), labels...)
+		ch <- prometheus.MustNewConstMetric(c.hitRatio, prometheus.GaugeValue, hitRatio, labels...)
+	}
+}
+
+// CacheNoopCollector is used to initialize the CacheCollector when metrics are disabled
+// or NGINX Plus is not used, to avoid nil pointer errors.
+type CacheNoopCollector struct{}
+
+// NewCacheNoopCollector returns an instance of the CacheNoopCollector.
+func NewCacheNoopCollector() *CacheNoopCollector {
+	return &CacheNoopCollector{}
+}
+
+// SetCacheZones implements a no-op SetCacheZones.
+func (c *CacheNoopCollector) SetCacheZones(_ []dataplane.CacheZone) {}
@@ -1,18 +1,43 @@
 package collectors
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
+// CertificateExpiry is the number of days until the certificate of a Listener expires.
+type CertificateExpiry struct {
+	// Gateway is the namespace and the name of the Gateway of the Listener.
+	Gateway string
+	// Listener is the name of the Listener.
+	Listener string
+	// Secret is the namespace and the name of the Secret of the certificate.
+	Secret string
+	// Days is the number of days until the certificate expires. It is negative if the certificate has expired.
+	Days float64
+}
+
 // ControllerCollector collects metrics for the NGF controller.
 // Implements the prometheus.Collector interface.
 type ControllerCollector struct {
 	// Metrics
 	eventBatchProcessDuration prometheus.Histogram
+	shadowedRouteMatches      prometheus.Gauge
+	certificateMismatches     prometheus.Gauge
+	certificateExpiryDays     *prometheus.GaugeVec
+	hashTableSizes            *prometheus.GaugeVec
+	upstreamEndpoints         *prometheus.GaugeVec
+	statusPausedResources     *prometheus.GaugeVec
+	orphansCollected          *prometheus.CounterVec
+	throttledEvents           *prometheus.CounterVec
+	throttledNamespaces       prometheus.Gauge
+	smokeTestProbes           *prometheus.CounterVec
+	nginxErrors               *prometheus.CounterVec
 }
 
 // NewControllerCollector creates a new ControllerCollector.
@@ -27,6 +52,102 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		shadowedRouteMatches: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "shadowed_route_matches",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Route matches that are shadowed by identical matches with a higher precedence",
+				ConstLabels: constLabels,
+			},
+		),
+		certificateMismatches: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "listener_certificate_mismatches",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Listeners with certificates that don't cover the hostnames of the Listeners",
+				ConstLabels: constLabels,
+			},
+		),
+		certificateExpiryDays: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "certificate_expiry_days",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of days until the certificate of a Listener expires, labeled by the Listener and its Secret",
+				ConstLabels: constLabels,
+			},
+			[]string{"gateway", "listener", "secret"},
+		),
+		hashTableSizes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "nginx_hash_table_size",
+				Namespace:   metrics.Namespace,
+				Help:        "Size of an NGINX hash table in the generated configuration, labeled by the directive",
+				ConstLabels: constLabels,
+			},
+			[]string{"directive"},
+		),
+		upstreamEndpoints: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "upstream_endpoints",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of endpoints of an upstream, labeled by the zone and the readiness of the endpoints",
+				ConstLabels: constLabels,
+			},
+			[]string{"upstream", "zone", "ready"},
+		),
+		statusPausedResources: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "status_paused_resources",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of resources with paused status updates, labeled by the kind of the resources",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
+		orphansCollected: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "orphans_collected_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of orphaned generated files and NGINX Plus upstreams that were collected, labeled by the kind",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
+		throttledEvents: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "throttled_events_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of events that were deferred because their namespace exceeded its event rate",
+				ConstLabels: constLabels,
+			},
+			[]string{"namespace"},
+		),
+		throttledNamespaces: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "throttled_namespaces",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of namespaces with events that are deferred because of their event rate",
+				ConstLabels: constLabels,
+			},
+		),
+		smokeTestProbes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "smoke_test_probes_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of smoke test probes sent through NGINX after configuration updates, labeled by the result",
+				ConstLabels: constLabels,
+			},
+			[]string{"probe", "result"},
+		),
+		nginxErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "nginx_errors_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of the errors in the NGINX error log, labeled by the kind",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind"},
+		),
 	}
 	return nc
 }
@@ -36,14 +157,115 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// SetShadowedRouteMatches sets the number of Route matches that are shadowed by identical matches with
+// a higher precedence.
+func (c *ControllerCollector) SetShadowedRouteMatches(count int) {
+	c.shadowedRouteMatches.Set(float64(count))
+}
+
+// SetCertificateMismatches sets the number of Listeners with certificates that don't cover the hostnames of
+// the Listeners.
+func (c *ControllerCollector) SetCertificateMismatches(count int) {
+	c.certificateMismatches.Set(float64(count))
+}
+
+// SetCertificateExpiries sets the number of days until the certificates of the Listeners expire.
+// The metrics of the certificates that are no longer referenced are removed.
+func (c *ControllerCollector) SetCertificateExpiries(expiries []CertificateExpiry) {
+	c.certificateExpiryDays.Reset()
+
+	for _, e := range expiries {
+		c.certificateExpiryDays.WithLabelValues(e.Gateway, e.Listener, e.Secret).Set(e.Days)
+	}
+}
+
+// SetHashTableSize sets the size of an NGINX hash table, as configured by the directive.
+func (c *ControllerCollector) SetHashTableSize(directive string, size int32) {
+	c.hashTableSizes.WithLabelValues(directive).Set(float64(size))
+}
+
+// SetUpstreamEndpoints sets the number of the endpoints of the upstreams by zone and readiness.
+// The metrics of the upstreams that no longer exist are removed.
+func (c *ControllerCollector) SetUpstreamEndpoints(summaries map[string]resolver.EndpointSummary) {
+	c.upstreamEndpoints.Reset()
+
+	for upstream, summary := range summaries {
+		setCounts := func(counts map[string]int, ready bool) {
+			for zone, count := range counts {
+				c.upstreamEndpoints.WithLabelValues(upstream, zone, strconv.FormatBool(ready)).Set(float64(count))
+			}
+		}
+
+		setCounts(summary.Ready, true)
+		setCounts(summary.NotReady, false)
+	}
+}
+
+// SetStatusPausedResources sets the number of the resources of the kind with paused status updates.
+func (c *ControllerCollector) SetStatusPausedResources(kind string, count int) {
+	c.statusPausedResources.WithLabelValues(kind).Set(float64(count))
+}
+
+// AddCollectedOrphans adds the number of the collected orphans of the kind.
+func (c *ControllerCollector) AddCollectedOrphans(kind string, count int) {
+	c.orphansCollected.WithLabelValues(kind).Add(float64(count))
+}
+
+// IncThrottledEvents increments the number of the deferred events of the namespace.
+func (c *ControllerCollector) IncThrottledEvents(namespace string) {
+	c.throttledEvents.WithLabelValues(namespace).Inc()
+}
+
+// SetThrottledNamespaces sets the number of the namespaces with deferred events.
+func (c *ControllerCollector) SetThrottledNamespaces(count int) {
+	c.throttledNamespaces.Set(float64(count))
+}
+
+// ObserveSmokeTestProbe increments the number of the smoke test probes with the result.
+func (c *ControllerCollector) ObserveSmokeTestProbe(probe string, passed bool) {
+	result := "failed"
+	if passed {
+		result = "passed"
+	}
+
+	c.smokeTestProbes.WithLabelValues(probe, result).Inc()
+}
+
+// IncNginxErrors increments the number of the errors of the kind in the NGINX error log.
+func (c *ControllerCollector) IncNginxErrors(kind string) {
+	c.nginxErrors.WithLabelValues(kind).Inc()
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.shadowedRouteMatches.Describe(ch)
+	c.certificateMismatches.Describe(ch)
+	c.certificateExpiryDays.Describe(ch)
+	c.hashTableSizes.Describe(ch)
+	c.upstreamEndpoints.Describe(ch)
+	c.statusPausedResources.Describe(ch)
+	c.orphansCollected.Describe(ch)
+	c.throttledEvents.Describe(ch)
+	c.throttledNamespaces.Describe(ch)
+	c.smokeTestProbes.Describe(ch)
+	c.nginxErrors.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.shadowedRouteMatches.Collect(ch)
+	c.certificateMismatches.Collect(ch)
+	c.certificateExpiryDays.Collect(ch)
+	c.hashTableSizes.Collect(ch)
+	c.upstreamEndpoints.Collect(ch)
+	c.statusPausedResources.Collect(ch)
+	c.orphansCollected.Collect(ch)
+	c.throttledEvents.Collect(ch)
+	c.throttledNamespaces.Collect(ch)
+	c.smokeTestProbes.Collect(ch)
+	c.nginxErrors.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +278,23 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) SetShadowedRouteMatches(_ int) {}
+
+func (c *ControllerNoopCollector) SetCertificateMismatches(_ int) {}
+
+func (c *ControllerNoopCollector) SetCertificateExpiries(_ []CertificateExpiry) {}
+
+func (c *ControllerNoopCollector) SetHashTableSize(_ string, _ int32) {}
+
+func (c *ControllerNoopCollector) SetUpstreamEndpoints(_ map[string]resolver.EndpointSummary) {}
+
+func (c *ControllerNoopCollector) AddCollectedOrphans(_ string, _ int) {}
+
+func (c *ControllerNoopCollector) IncThrottledEvents(_ string) {}
+
+func (c *ControllerNoopCollector) SetThrottledNamespaces(_ int) {}
+
+func (c *ControllerNoopCollector) ObserveSmokeTestProbe(_ string, _ bool) {}
+
+func (c *ControllerNoopCollector) IncNginxErrors(_ string) {}
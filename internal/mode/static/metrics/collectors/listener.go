@@ -0,0 +1,410 @@
+package collectors
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/nginxinc/nginx-plus-go-client/client"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// The reasons of the failed TLS handshakes.
+const (
+	handshakeFailureProtocol    = "protocol"
+	handshakeFailureCipher      = "cipher"
+	handshakeFailureTimeout     = "timeout"
+	handshakeFailureCert        = "cert"
+	handshakeFailureSNIMismatch = "sni_mismatch"
+	handshakeFailureOther       = "other"
+)
+
+var handshakeFailureReasons = []string{
+	handshakeFailureProtocol,
+	handshakeFailureCipher,
+	handshakeFailureTimeout,
+	handshakeFailureCert,
+	handshakeFailureSNIMismatch,
+	handshakeFailureOther,
+}
+
+// The reasons of the rejected requests.
+const (
+	rejectedRequestMalformed        = "malformed"
+	rejectedRequestBodyTooLarge     = "body_too_large"
+	rejectedRequestURITooLong       = "uri_too_long"
+	rejectedRequestHeaderTooLarge   = "header_too_large"
+	rejectedRequestMethodNotAllowed = "method_not_allowed"
+	rejectedRequestClientCert       = "client_cert"
+	rejectedRequestHTTPToHTTPS      = "http_to_https"
+	rejectedRequestTLSHandshake     = "tls_handshake"
+)
+
+var rejectedRequestReasons = []string{
+	rejectedRequestMalformed,
+	rejectedRequestBodyTooLarge,
+	rejectedRequestURITooLong,
+	rejectedRequestHeaderTooLarge,
+	rejectedRequestMethodNotAllowed,
+	rejectedRequestClientCert,
+	rejectedRequestHTTPToHTTPS,
+	rejectedRequestTLSHandshake,
+}
+
+var listenerLabels = []string{"gateway", "listener"}
+
+// listenerStatsClient gets the stats of the server zones from the NGINX Plus API.
+type listenerStatsClient interface {
+	GetServerZones() (*client.ServerZones, error)
+	GetStreamServerZones() (*client.StreamServerZones, error)
+}
+
+// ListenerCollector collects the connection, timeout, and TLS handshake metrics of the Gateway Listeners from the
+// stats of the NGINX Plus status zones of the servers that belong to the Listeners.
+// NGINX OSS doesn't have per-server stats, so the metrics are only collected when NGINX Plus is used.
+// A plain HTTP connection belongs to a Listener only after NGINX has chosen its server by the Host header
+// of a request, so the connection metrics are not collected for the HTTP Listeners. The accepted and handled
+// connections of the HTTPS Listeners are derived from their TLS handshakes, because their servers are chosen
+// by the SNI of the handshake.
+// Implements the prometheus.Collector interface.
+type ListenerCollector struct {
+	client listenerStatsClient
+	zones  map[string]dataplane.ListenerStatusZone
+
+	requests            *prometheus.Desc
+	activeRequests      *prometheus.Desc
+	clientTimeouts      *prometheus.Desc
+	clientAborts        *prometheus.Desc
+	acceptedConnections *prometheus.Desc
+	handledConnections  *prometheus.Desc
+	activeConnections   *prometheus.Desc
+	sslHandshakes       *prometheus.Desc
+	sslHandshakesFailed *prometheus.Desc
+	rejectedRequests    *prometheus.Desc
+	logger              logr.Logger
+	lock                sync.RWMutex
+}
+
+// NewListenerCollector creates a new ListenerCollector which fetches stats from the NGINX Plus API.
+func NewListenerCollector(
+	plusClient runtime.NginxPlusClient,
+	constLabels map[string]string,
+	logger logr.Logger,
+) (*ListenerCollector, error) {
+	statsClient, ok := plusClient.(listenerStatsClient)
+	if !ok {
+		return nil, fmt.Errorf("expected a client that gets the stats of the server zones, got %T", plusClient)
+	}
+
+	return &ListenerCollector{
+		client: statsClient,
+		logger: logger,
+		requests: newListenerMetric(
+			"requests_total",
+			"Total client requests handled by the HTTP and HTTPS Listener",
+			constLabels,
+		),
+		activeRequests: newListenerMetric(
+			"requests_active",
+			"Client requests that are currently being processed by the HTTP and HTTPS Listener",
+			constLabels,
+		),
+		clientTimeouts: newListenerMetric(
+			"client_timeouts_total",
+			"Total client requests of the HTTP and HTTPS Listener that were terminated with the 408 (Request Time-out) "+
+				"error, because the client didn't send the request header or body in time",
+			constLabels,
+		),
+		clientAborts: newListenerMetric(
+			"client_aborted_requests_total",
+			"Total client requests of the HTTP and HTTPS Listener that were aborted, because the client closed "+
+				"the connection before the response was sent",
+			constLabels,
+		),
+		acceptedConnections: newListenerMetric(
+			"connections_accepted_total",
+			"Total client connections accepted by the HTTPS, TLS, TCP, and UDP Listener. The connections of "+
+				"the HTTPS Listener are its successful and failed TLS handshakes",
+			constLabels,
+		),
+		handledConnections: newListenerMetric(
+			"connections_handled_total",
+			"Total client connections handled by the HTTPS, TLS, TCP, and UDP Listener. The connections of "+
+				"the HTTPS Listener are its successful TLS handshakes",
+			constLabels,
+		),
+		activeConnections: newListenerMetric(
+			"connections_active",
+			"Client connections that are currently being processed by the TLS, TCP, and UDP Listener",
+			constLabels,
+		),
+		sslHandshakes: newListenerMetric(
+			"ssl_handshakes_total",
+			"Successful TLS handshakes of the Listener",
+			constLabels,
+		),
+		sslHandshakesFailed: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "listener", "ssl_handshakes_failed_total"),
+			"Failed TLS handshakes of the Listener, labeled by the reason",
+			[]string{"gateway", "listener", "reason"},
+			constLabels,
+		),
+		rejectedRequests: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "listener", "rejected_requests_total"),
+			"Total client requests and TLS handshakes of the HTTP and HTTPS Listener that were rejected because they "+
+				"were malformed, too large, used a method that is not allowed, or failed the TLS checks, "+
+				"labeled by the reason",
+			[]string{"gateway", "listener", "reason"},
+			constLabels,
+		),
+	}, nil
+}
+
+func newListenerMetric(name, help string, constLabels map[string]string) *prometheus.Desc {
+	return prometheus.NewDesc(
+		prometheus.BuildFQName(metrics.Namespace, "listener", name),
+		help,
+		listenerLabels,
+		constLabels,
+	)
+}
+
+// SetStatusZones sets the status zones of the servers that belong to the Listeners.
+func (c *ListenerCollector) SetStatusZones(zones map[string]dataplane.ListenerStatusZone) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.zones = zones
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *ListenerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requests
+	ch <- c.activeRequests
+	ch <- c.clientTimeouts
+	ch <- c.clientAborts
+	ch <- c.acceptedConnections
+	ch <- c.handledConnections
+	ch <- c.activeConnections
+	ch <- c.sslHandshakes
+	ch <- c.sslHandshakesFailed
+	ch <- c.rejectedRequests
+}
+
+// listenerKey holds the values of the labels of the metrics of a Listener.
+type listenerKey struct {
+	gateway  string
+	listener string
+}
+
+// listenerStats are the stats of a Listener, aggregated from the status zones of its servers.
+type listenerStats struct {
+	handshakesFailed    map[string]uint64
+	rejectedRequests    map[string]uint64
+	requests            uint64
+	activeRequests      uint64
+	clientTimeouts      uint64
+	clientAborts        uint64
+	acceptedConnections uint64
+	handledConnections  uint64
+	activeConnections   uint64
+	handshakes          uint64
+	http                bool
+	connections         bool
+	stream              bool
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *ListenerCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	zones := c.zones
+	c.lock.RUnlock()
+
+	if len(zones) == 0 {
+		return
+	}
+
+	stats := make(map[listenerKey]*listenerStats)
+
+	getStats := func(zone dataplane.ListenerStatusZone) *listenerStats {
+		key := listenerKey{gateway: zone.Gateway.String(), listener: zone.Listener}
+		s, exists := stats[key]
+		if !exists {
+			s = &listenerStats{
+				handshakesFailed: make(map[string]uint64, len(handshakeFailureReasons)),
+				rejectedRequests: make(map[string]uint64, len(rejectedRequestReasons)),
+			}
+			stats[key] = s
+		}
+
+		return s
+	}
+
+	serverZones, err := c.client.GetServerZones()
+	if err != nil {
+		c.logger.Error(err, "Error getting the stats of the server zones for the Listener metrics")
+	} else {
+		for name, serverZone := range *serverZones {
+			zone, ok := zones[name]
+			if !ok {
+				continue
+			}
+
+			s := getStats(zone)
+			s.http = true
+			s.requests += serverZone.Requests
+			s.activeRequests += serverZone.Processing
+			s.clientTimeouts += serverZone.Responses.Codes.HTTPRequestTimeOut
+			s.clientAborts += serverZone.Responses.Codes.HTTPClientClosedRequest
+			s.addRejectedRequests(serverZone.Responses.Codes, serverZone.SSL)
+			s.addSSL(serverZone.SSL, zone.Default)
+
+			// every connection of an HTTPS server starts with a TLS handshake
+			if zone.HTTPS {
+				s.connections = true
+				s.acceptedConnections += serverZone.SSL.Handshakes + serverZone.SSL.HandshakesFailed
+				s.handledConnections += serverZone.SSL.Handshakes
+			}
+		}
+	}
+
+	streamServerZones, err := c.client.GetStreamServerZones()
+	if err != nil {
+		c.logger.Error(err, "Error getting the stats of the stream server zones for the Listener metrics")
+	} else {
+		for name, streamServerZone := range *streamServerZones {
+			zone, ok := zones[name]
+			if !ok {
+				continue
+			}
+
+			s := getStats(zone)
+			s.stream = true
+			s.connections = true
+			s.acceptedConnections += streamServerZone.Connections
+			s.handledConnections += streamServerZone.Sessions.Total
+			s.activeConnections += streamServerZone.Processing
+			s.addSSL(streamServerZone.SSL, zone.Default)
+		}
+	}
+
+	for key, s := range stats {
+		c.collectListenerStats(ch, key, s)
+	}
+}
+
+func (c *ListenerCollector) collectListenerStats(ch chan<- prometheus.Metric, key listenerKey, s *listenerStats) {
+	if s.http {
+		ch <- prometheus.MustNewConstMetric(
+			c.requests, prometheus.CounterValue, float64(s.requests), key.gateway, key.listener,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.activeRequests, prometheus.GaugeValue, float64(s.activeRequests), key.gateway, key.listener,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.clientTimeouts, prometheus.CounterValue, float64(s.clientTimeouts), key.gateway, key.listener,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.clientAborts, prometheus.CounterValue, float64(s.clientAborts), key.gateway, key.listener,
+		)
+
+		for _, reason := range rejectedRequestReasons {
+			ch <- prometheus.MustNewConstMetric(
+				c.rejectedRequests,
+				prometheus.CounterValue,
+				float64(s.rejectedRequests[reason]),
+				key.gateway,
+				key.listener,
+				reason,
+			)
+		}
+	}
+
+	if s.connections {
+		ch <- prometheus.MustNewConstMetric(
+			c.acceptedConnections, prometheus.CounterValue, float64(s.acceptedConnections), key.gateway, key.listener,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.handledConnections, prometheus.CounterValue, float64(s.handledConnections), key.gateway, key.listener,
+		)
+	}
+
+	if s.stream {
+		ch <- prometheus.MustNewConstMetric(
+			c.activeConnections, prometheus.GaugeValue, float64(s.activeConnections), key.gateway, key.listener,
+		)
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		c.sslHandshakes, prometheus.CounterValue, float64(s.handshakes), key.gateway, key.listener,
+	)
+
+	for _, reason := range handshakeFailureReasons {
+		ch <- prometheus.MustNewConstMetric(
+			c.sslHandshakesFailed,
+			prometheus.CounterValue,
+			float64(s.handshakesFailed[reason]),
+			key.gateway,
+			key.listener,
+			reason,
+		)
+	}
+}
+
+// addRejectedRequests adds the requests of a status zone that NGINX rejected before they were proxied
+// to the stats of the Listener. NGINX responds with 400 to the requests with too large headers, but counts them
+// with its special 494 code. The 495 and 496 codes are the requests with invalid or missing client certificates,
+// and the 497 code is the plain HTTP requests sent to an HTTPS port. The zones count the responses of the backends
+// with the same codes too, so the metrics are the upper bounds of the requests rejected by NGINX.
+func (s *listenerStats) addRejectedRequests(codes client.HTTPCodes, ssl client.SSL) {
+	s.rejectedRequests[rejectedRequestMalformed] += codes.HTTPBadRequest
+	s.rejectedRequests[rejectedRequestBodyTooLarge] += codes.HTTPRequestEntityTooLarge
+	s.rejectedRequests[rejectedRequestURITooLong] += codes.HTTPRequestURITooLarge
+	s.rejectedRequests[rejectedRequestHeaderTooLarge] += codes.HTTPRequestHeaderTooLarge
+	s.rejectedRequests[rejectedRequestMethodNotAllowed] += codes.HTTPNotAllowed
+	s.rejectedRequests[rejectedRequestClientCert] += codes.HTTPSCertError + codes.HTTPSNoCert
+	s.rejectedRequests[rejectedRequestHTTPToHTTPS] += codes.HTTPToHTTPS
+	s.rejectedRequests[rejectedRequestTLSHandshake] += ssl.HandshakesFailed
+}
+
+// addSSL adds the TLS handshake stats of a status zone to the stats of the Listener.
+// The default server of a port rejects the TLS handshakes for unknown hostnames, so all failed handshakes
+// of its zone are caused by the mismatch of the SNI.
+func (s *listenerStats) addSSL(ssl client.SSL, isDefault bool) {
+	s.handshakes += ssl.Handshakes
+
+	if isDefault {
+		s.handshakesFailed[handshakeFailureSNIMismatch] += ssl.HandshakesFailed
+		return
+	}
+
+	verifyFailures := ssl.VerifyFailures
+	cert := ssl.PeerRejectedCert + verifyFailures.NoCert + verifyFailures.ExpiredCert +
+		verifyFailures.RevokedCert + verifyFailures.HostnameMismatch + verifyFailures.Other
+
+	s.handshakesFailed[handshakeFailureProtocol] += ssl.NoCommonProtocol
+	s.handshakesFailed[handshakeFailureCipher] += ssl.NoCommonCipher
+	s.handshakesFailed[handshakeFailureTimeout] += ssl.HandshakeTimeout
+	s.handshakesFailed[handshakeFailureCert] += cert
+
+	known := ssl.NoCommonProtocol + ssl.NoCommonCipher + ssl.HandshakeTimeout + cert
+	if ssl.HandshakesFailed > known {
+		s.handshakesFailed[handshakeFailureOther] += ssl.HandshakesFailed - known
+	}
+}
+
+// ListenerNoopCollector is used to initialize the ListenerCollector when metrics are disabled
+// or NGINX Plus is not used, to avoid nil pointer errors.
+type ListenerNoopCollector struct{}
+
+// NewListenerNoopCollector returns an instance of the ListenerNoopCollector.
+func NewListenerNoopCollector() *ListenerNoopCollector {
+	return &ListenerNoopCollector{}
+}
+
+// SetStatusZones implements a no-op SetStatusZones.
+func (c *ListenerNoopCollector) SetStatusZones(_ map[string]dataplane.ListenerStatusZone) {}
@@ -0,0 +1,104 @@
+package collectors
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics"
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var upstreamServerLabels = []string{"upstream", "server", "pod"}
+
+// UpstreamServerCollector collects the info metrics of the upstream servers, which map the servers of the upstreams
+// to the Pods of their endpoints. The servers are identified by the same upstream and server labels as the upstream
+// server metrics of NGINX Plus, so that these metrics can be joined with the Pod names, which stay the same
+// for the lifetime of a Pod, unlike the order and the IDs of the servers in NGINX Plus.
+// Implements the prometheus.Collector interface.
+type UpstreamServerCollector struct {
+	info            *prometheus.Desc
+	streamInfo      *prometheus.Desc
+	upstreams       []dataplane.Upstream
+	streamUpstreams []dataplane.Upstream
+	lock            sync.RWMutex
+}
+
+// NewUpstreamServerCollector creates a new UpstreamServerCollector.
+func NewUpstreamServerCollector(constLabels map[string]string) *UpstreamServerCollector {
+	return &UpstreamServerCollector{
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "upstream_server", "info"),
+			"Pod of the server of the upstream",
+			upstreamServerLabels,
+			constLabels,
+		),
+		streamInfo: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "stream_upstream_server", "info"),
+			"Pod of the server of the stream upstream",
+			upstreamServerLabels,
+			constLabels,
+		),
+	}
+}
+
+// SetUpstreams sets the HTTP and stream upstreams.
+func (c *UpstreamServerCollector) SetUpstreams(upstreams, streamUpstreams []dataplane.Upstream) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.upstreams = upstreams
+	c.streamUpstreams = streamUpstreams
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *UpstreamServerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.info
+	ch <- c.streamInfo
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *UpstreamServerCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.RLock()
+	upstreams := c.upstreams
+	streamUpstreams := c.streamUpstreams
+	c.lock.RUnlock()
+
+	for _, u := range upstreams {
+		// the servers of ExternalName Services are resolved by NGINX and don't belong to Pods.
+		if u.ExternalName != "" {
+			continue
+		}
+
+		for i, server := range ngxConfig.ConvertEndpoints(u.Endpoints, false) {
+			collectUpstreamServerInfo(ch, c.info, u.Name, server.Server, u.Endpoints[i].PodName)
+		}
+	}
+
+	for _, u := range streamUpstreams {
+		for i, server := range ngxConfig.ConvertStreamEndpoints(u.Endpoints) {
+			collectUpstreamServerInfo(ch, c.streamInfo, u.Name, server.Server, u.Endpoints[i].PodName)
+		}
+	}
+}
+
+func collectUpstreamServerInfo(ch chan<- prometheus.Metric, desc *prometheus.Desc, upstream, server, pod string) {
+	if pod == "" {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, 1, upstream, server, pod)
+}
+
+// UpstreamServerNoopCollector is used to initialize the UpstreamServerCollector when metrics are disabled
+// or NGINX Plus is not used, to avoid nil pointer errors.
+type UpstreamServerNoopCollector struct{}
+
+// NewUpstreamServerNoopCollector returns an instance of the UpstreamServerNoopCollector.
+func NewUpstreamServerNoopCollector() *UpstreamServerNoopCollector {
+	return &UpstreamServerNoopCollector{}
+}
+
+// SetUpstreams implements a no-op SetUpstreams.
+func (c *UpstreamServerNoopCollector) SetUpstreams(_, _ []dataplane.Upstream) {}
@@ -11,16 +11,31 @@ import (
 var baseHTTPTemplate = gotemplate.Must(gotemplate.New("baseHttp").Parse(baseHTTPTemplateText))
 
 type httpConfig struct {
-	Includes []shared.Include
-	HTTP2    bool
+	Hardening     *dataplane.Hardening
+	HTTP2Settings *dataplane.HTTP2Settings
+	TLS           *dataplane.TLSSettings
+	// AccessLog is the value of the access_log directive, for example, "/dev/stdout main" or "off".
+	// If empty, the default access log of NGINX is used.
+	AccessLog  string
+	Includes   []shared.Include
+	LogFormats []dataplane.LogFormat
+	HashSizes  dataplane.HashSizes
+	HTTP2      bool
 }
 
 func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
 	includes := createIncludesFromSnippets(conf.BaseHTTPConfig.Snippets)
+	includes = append(includes, createIncludesFromSnippets(conf.BaseHTTPConfig.MapSnippets)...)
 
 	hc := httpConfig{
-		HTTP2:    conf.BaseHTTPConfig.HTTP2,
-		Includes: includes,
+		HTTP2:         conf.BaseHTTPConfig.HTTP2,
+		HTTP2Settings: conf.BaseHTTPConfig.HTTP2Settings,
+		Includes:      includes,
+		HashSizes:     conf.HashSizes,
+		Hardening:     conf.BaseHTTPConfig.Hardening,
+		TLS:           conf.BaseHTTPConfig.TLS,
+		LogFormats:    conf.Logging.LogFormats,
+		AccessLog:     createAccessLog(conf.Logging.AccessLog),
 	}
 
 	results := make([]executeResult, 0, len(includes)+1)
@@ -32,3 +47,17 @@ func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
 
 	return results
 }
+
+// createAccessLog creates the value of the access_log directive for the access log.
+// If the access log is nil, it returns an empty string.
+func createAccessLog(accessLog *dataplane.AccessLog) string {
+	if accessLog == nil {
+		return ""
+	}
+
+	if accessLog.Disable {
+		return "off"
+	}
+
+	return accessLog.Destination + " " + accessLog.Format
+}
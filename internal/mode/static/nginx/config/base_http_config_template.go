@@ -2,6 +2,71 @@ package config
 
 const baseHTTPTemplateText = `
 {{- if .HTTP2 }}http2 on;{{ end }}
+{{- with .HTTP2Settings }}
+{{- if .MaxConcurrentStreams }}
+http2_max_concurrent_streams {{ .MaxConcurrentStreams }};
+{{- end }}
+{{- if .RecvBufferSize }}
+http2_recv_buffer_size {{ .RecvBufferSize }};
+{{- end }}
+{{- if .ChunkSize }}
+http2_chunk_size {{ .ChunkSize }};
+{{- end }}
+{{- if .BodyPrereadSize }}
+http2_body_preread_size {{ .BodyPrereadSize }};
+{{- end }}
+{{- end }}
+{{ with .HashSizes }}
+{{- if .ServerNamesMaxSize }}
+server_names_hash_max_size {{ .ServerNamesMaxSize }};
+{{- end }}
+{{- if .ServerNamesBucketSize }}
+server_names_hash_bucket_size {{ .ServerNamesBucketSize }};
+{{- end }}
+{{- if .MapMaxSize }}
+map_hash_max_size {{ .MapMaxSize }};
+{{- end }}
+{{- if .MapBucketSize }}
+map_hash_bucket_size {{ .MapBucketSize }};
+{{- end }}
+{{- end }}
+{{- with .TLS }}
+{{- if .Protocols }}
+ssl_protocols{{ range .Protocols }} {{ . }}{{ end }};
+{{- end }}
+{{- if .Ciphers }}
+ssl_ciphers {{ .Ciphers }};
+{{- end }}
+{{- if .PreferServerCiphers }}
+ssl_prefer_server_ciphers {{ .PreferServerCiphers }};
+{{- end }}
+{{- if .SessionCache }}
+ssl_session_cache {{ .SessionCache }};
+{{- end }}
+{{- if .SessionTimeout }}
+ssl_session_timeout {{ .SessionTimeout }};
+{{- end }}
+{{- if .SessionTickets }}
+ssl_session_tickets {{ .SessionTickets }};
+{{- end }}
+{{- end }}
+{{- range .LogFormats }}
+log_format {{ .Name }}{{ if .Escape }} escape={{ .Escape }}{{ end }} '{{ .Format }}';
+{{- end }}
+{{- if .AccessLog }}
+access_log {{ .AccessLog }};
+{{- end }}
+{{ with .Hardening }}
+client_header_timeout {{ .ClientHeaderTimeout }};
+client_body_timeout {{ .ClientBodyTimeout }};
+send_timeout {{ .SendTimeout }};
+reset_timedout_connection {{ if .ResetTimedOutConnection }}on{{ else }}off{{ end }};
+{{- if .RequestSmugglingProtection }}
+ignore_invalid_headers on;
+underscores_in_headers off;
+proxy_request_buffering on;
+{{- end }}
+{{- end }}
 
 # Set $gw_api_compliant_host variable to the value of $http_host unless $http_host is empty, then set it to the value
 # of $host. We prefer $http_host because it contains the original value of the host header, which is required by the
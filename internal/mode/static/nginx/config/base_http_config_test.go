@@ -58,6 +58,323 @@ func TestExecuteBaseHttp_HTTP2(t *testing.T) {
 	}
 }
 
+func TestExecuteBaseHttp_HTTP2Settings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		settings      *dataplane.HTTP2Settings
+		expSubStrings map[string]int
+		name          string
+	}{
+		{
+			name: "all settings",
+			settings: &dataplane.HTTP2Settings{
+				MaxConcurrentStreams: 512,
+				RecvBufferSize:       "512k",
+				ChunkSize:            "16k",
+				BodyPrereadSize:      "128k",
+			},
+			expSubStrings: map[string]int{
+				"http2_max_concurrent_streams 512;": 1,
+				"http2_recv_buffer_size 512k;":      1,
+				"http2_chunk_size 16k;":             1,
+				"http2_body_preread_size 128k;":     1,
+			},
+		},
+		{
+			name: "some settings",
+			settings: &dataplane.HTTP2Settings{
+				MaxConcurrentStreams: 256,
+			},
+			expSubStrings: map[string]int{
+				"http2_max_concurrent_streams 256;": 1,
+				"http2_recv_buffer_size":            0,
+				"http2_chunk_size":                  0,
+				"http2_body_preread_size":           0,
+			},
+		},
+		{
+			name: "settings not set",
+			expSubStrings: map[string]int{
+				"http2_max_concurrent_streams": 0,
+				"http2_recv_buffer_size":       0,
+				"http2_chunk_size":             0,
+				"http2_body_preread_size":      0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					HTTP2:         true,
+					HTTP2Settings: test.settings,
+				},
+			}
+
+			res := executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_HashSizes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expSubStrings map[string]int
+		name          string
+		hashSizes     dataplane.HashSizes
+	}{
+		{
+			name: "hash sizes set",
+			hashSizes: dataplane.HashSizes{
+				ServerNamesMaxSize:    2048,
+				ServerNamesBucketSize: 512,
+				MapMaxSize:            4096,
+				MapBucketSize:         256,
+			},
+			expSubStrings: map[string]int{
+				"server_names_hash_max_size 2048;":   1,
+				"server_names_hash_bucket_size 512;": 1,
+				"map_hash_max_size 4096;":            1,
+				"map_hash_bucket_size 256;":          1,
+			},
+		},
+		{
+			name: "hash sizes not set",
+			expSubStrings: map[string]int{
+				"server_names_hash_max_size":    0,
+				"server_names_hash_bucket_size": 0,
+				"map_hash_max_size":             0,
+				"map_hash_bucket_size":          0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeBaseHTTPConfig(dataplane.Configuration{HashSizes: test.hashSizes})
+			g.Expect(res).To(HaveLen(1))
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_Hardening(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		hardening     *dataplane.Hardening
+		expSubStrings map[string]int
+		name          string
+	}{
+		{
+			name: "all protections enabled",
+			hardening: &dataplane.Hardening{
+				ClientHeaderTimeout:        "5s",
+				ClientBodyTimeout:          "10s",
+				SendTimeout:                "15s",
+				ResetTimedOutConnection:    true,
+				RequestSmugglingProtection: true,
+			},
+			expSubStrings: map[string]int{
+				"client_header_timeout 5s;":     1,
+				"client_body_timeout 10s;":      1,
+				"send_timeout 15s;":             1,
+				"reset_timedout_connection on;": 1,
+				"ignore_invalid_headers on;":    1,
+				"underscores_in_headers off;":   1,
+				"proxy_request_buffering on;":   1,
+			},
+		},
+		{
+			name: "protections disabled",
+			hardening: &dataplane.Hardening{
+				ClientHeaderTimeout: "10s",
+				ClientBodyTimeout:   "10s",
+				SendTimeout:         "10s",
+			},
+			expSubStrings: map[string]int{
+				"client_header_timeout 10s;":     1,
+				"reset_timedout_connection off;": 1,
+				"ignore_invalid_headers":         0,
+				"underscores_in_headers":         0,
+				"proxy_request_buffering":        0,
+			},
+		},
+		{
+			name: "hardening not set",
+			expSubStrings: map[string]int{
+				"client_header_timeout":     0,
+				"client_body_timeout":       0,
+				"send_timeout":              0,
+				"reset_timedout_connection": 0,
+				"ignore_invalid_headers":    0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{Hardening: test.hardening},
+			}
+
+			res := executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_TLS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tls           *dataplane.TLSSettings
+		name          string
+		expSubStrings []string
+		notExpStrings []string
+	}{
+		{
+			name: "all settings set",
+			tls: &dataplane.TLSSettings{
+				Protocols:           []string{"TLSv1.2", "TLSv1.3"},
+				Ciphers:             "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384",
+				PreferServerCiphers: "on",
+				SessionCache:        "shared:SSL:10m",
+				SessionTimeout:      "1h",
+				SessionTickets:      "off",
+			},
+			expSubStrings: []string{
+				"ssl_protocols TLSv1.2 TLSv1.3;",
+				"ssl_ciphers ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384;",
+				"ssl_prefer_server_ciphers on;",
+				"ssl_session_cache shared:SSL:10m;",
+				"ssl_session_timeout 1h;",
+				"ssl_session_tickets off;",
+			},
+		},
+		{
+			name: "only protocols set",
+			tls: &dataplane.TLSSettings{
+				Protocols: []string{"TLSv1.3"},
+			},
+			expSubStrings: []string{"ssl_protocols TLSv1.3;"},
+			notExpStrings: []string{"ssl_ciphers", "ssl_prefer_server_ciphers", "ssl_session_"},
+		},
+		{
+			name:          "not set",
+			notExpStrings: []string{"ssl_"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{TLS: test.tls},
+			}
+
+			res := executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+			for _, expSubString := range test.expSubStrings {
+				g.Expect(string(res[0].data)).To(ContainSubstring(expSubString))
+			}
+			for _, notExpString := range test.notExpStrings {
+				g.Expect(string(res[0].data)).ToNot(ContainSubstring(notExpString))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_AccessLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		logging       dataplane.Logging
+		expSubStrings []string
+		notExpStrings []string
+	}{
+		{
+			name: "formats and access log set",
+			logging: dataplane.Logging{
+				LogFormats: []dataplane.LogFormat{
+					{Name: "json", Format: `{"status": "$status"}`, Escape: "json"},
+					{Name: "main", Format: "$remote_addr $status"},
+				},
+				AccessLog: &dataplane.AccessLog{
+					Destination: "syslog:server=syslog.example.com:514",
+					Format:      "json",
+				},
+			},
+			expSubStrings: []string{
+				`log_format json escape=json '{"status": "$status"}';`,
+				"log_format main '$remote_addr $status';",
+				"access_log syslog:server=syslog.example.com:514 json;",
+			},
+		},
+		{
+			name: "access log disabled",
+			logging: dataplane.Logging{
+				AccessLog: &dataplane.AccessLog{
+					Destination: "/dev/stdout",
+					Format:      "combined",
+					Disable:     true,
+				},
+			},
+			expSubStrings: []string{"access_log off;"},
+			notExpStrings: []string{"log_format"},
+		},
+		{
+			name:          "access log not set",
+			notExpStrings: []string{"log_format", "access_log"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeBaseHTTPConfig(dataplane.Configuration{Logging: test.logging})
+			g.Expect(res).To(HaveLen(1))
+
+			for _, str := range test.expSubStrings {
+				g.Expect(string(res[0].data)).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(string(res[0].data)).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
 func TestExecuteBaseHttp_Snippets(t *testing.T) {
 	t.Parallel()
 
@@ -73,13 +390,19 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 					Contents: "contents2",
 				},
 			},
+			MapSnippets: []dataplane.Snippet{
+				{
+					Name:     "snippet3",
+					Contents: "map $uri $is_api { default 0; }",
+				},
+			},
 		},
 	}
 
 	g := NewWithT(t)
 
 	res := executeBaseHTTPConfig(conf)
-	g.Expect(res).To(HaveLen(3))
+	g.Expect(res).To(HaveLen(4))
 
 	sort.Slice(
 		res, func(i, j int) bool {
@@ -92,6 +415,7 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 		/etc/nginx/conf.d/http.conf
 		/etc/nginx/includes/snippet1.conf
 		/etc/nginx/includes/snippet2.conf
+		/etc/nginx/includes/snippet3.conf
 	*/
 
 	httpRes := string(res[0].data)
@@ -100,10 +424,14 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 	g.Expect(httpRes).To(ContainSubstring("map $request_uri $request_uri_path {"))
 	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/snippet1.conf;"))
 	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/snippet2.conf;"))
+	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/snippet3.conf;"))
 
 	snippet1IncludeRes := string(res[1].data)
 	g.Expect(snippet1IncludeRes).To(ContainSubstring("contents1"))
 
 	snippet2IncludeRes := string(res[2].data)
 	g.Expect(snippet2IncludeRes).To(ContainSubstring("contents2"))
+
+	snippet3IncludeRes := string(res[3].data)
+	g.Expect(snippet3IncludeRes).To(ContainSubstring("map $uri $is_api { default 0; }"))
 }
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var (
+	bodyTransformsTemplate = gotemplate.Must(
+		gotemplate.New("bodyTransforms").Parse(bodyTransformsTemplateText),
+	)
+	bodyTransformModuleTemplate = gotemplate.Must(
+		gotemplate.New("bodyTransformModule").Parse(bodyTransformModuleTemplateText),
+	)
+)
+
+// bodyTransformModule is the njs module of a BodyTransform.
+type bodyTransformModule struct {
+	// Name is the name of the module.
+	Name string
+	// Path is the path of the module file.
+	Path string
+	// RequestBodyVariable is the variable that holds the transformed request body.
+	// Empty if the request bodies are not transformed.
+	RequestBodyVariable string
+	// Source is the namespace and name of the HTTPBodyTransform, which is included in the errors of the scripts.
+	Source string
+	// RequestScript is the script that transforms the request bodies.
+	RequestScript string
+	// ResponseScript is the script that transforms the response bodies.
+	ResponseScript string
+	// MaxRequestBodySize is the maximum size in bytes of the request bodies that are transformed.
+	MaxRequestBodySize int64
+	// MaxResponseBodySize is the maximum size in bytes of the response bodies that are transformed.
+	MaxResponseBodySize int64
+}
+
+func executeBodyTransforms(conf dataplane.Configuration) []executeResult {
+	if len(conf.BodyTransforms) == 0 {
+		return nil
+	}
+
+	modules := make([]bodyTransformModule, 0, len(conf.BodyTransforms))
+	for _, bt := range conf.BodyTransforms {
+		modules = append(modules, createBodyTransformModule(bt))
+	}
+
+	results := make([]executeResult, 0, len(modules)+1)
+	results = append(results, executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(bodyTransformsTemplate, modules),
+	})
+
+	for _, module := range modules {
+		results = append(results, executeResult{
+			dest: module.Path,
+			data: helpers.MustExecuteTemplate(bodyTransformModuleTemplate, module),
+		})
+	}
+
+	return results
+}
+
+func createBodyTransformModule(bt dataplane.BodyTransform) bodyTransformModule {
+	module := bodyTransformModule{
+		Name:                bt.ID,
+		Path:                filepath.Join(bodyTransformsFolder, bt.ID+".js"),
+		Source:              bt.Source.String(),
+		RequestScript:       bt.RequestScript,
+		ResponseScript:      bt.ResponseScript,
+		MaxRequestBodySize:  bt.MaxRequestBodySize,
+		MaxResponseBodySize: bt.MaxResponseBodySize,
+	}
+
+	if bt.RequestScript != "" {
+		module.RequestBodyVariable = createBodyTransformRequestBodyVariable(bt.ID)
+	}
+
+	return module
+}
+
+// createBodyTransformRequestBodyVariable returns the variable that holds the request body transformed by
+// the njs module of a BodyTransform.
+func createBodyTransformRequestBodyVariable(id string) string {
+	return fmt.Sprintf("$%s_request_body", id)
+}
+
+// createBodyTransform returns the configuration of a location that transforms the bodies with
+// the njs module of the BodyTransform.
+func createBodyTransform(bt *dataplane.BodyTransform) *http.BodyTransform {
+	if bt == nil {
+		return nil
+	}
+
+	result := &http.BodyTransform{}
+
+	if bt.RequestScript != "" {
+		result.RequestBody = createBodyTransformRequestBodyVariable(bt.ID)
+	}
+
+	if bt.ResponseScript != "" {
+		result.ResponseModule = bt.ID
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package config
+
+const bodyTransformsTemplateText = `
+{{- range $m := . }}
+js_import {{ $m.Name }} from {{ $m.Path }};
+    {{- if $m.RequestBodyVariable }}
+js_set {{ $m.RequestBodyVariable }} {{ $m.Name }}.request;
+    {{- end }}
+{{- end }}
+`
+
+// bodyTransformModuleTemplateText is the njs module of an HTTPBodyTransform. The scripts are the bodies of
+// the functions that transform the bodies, which only have access to the body. If a script throws an error or
+// doesn't return a string, the body is not transformed.
+//
+// The request bodies are buffered in memory up to the maximum size. The larger request bodies, which NGINX
+// writes to a file, are proxied untransformed.
+//
+// The response bodies are buffered until they are complete. The responses that are compressed or larger than
+// the maximum size are sent untransformed.
+const bodyTransformModuleTemplateText = `
+{{- if $.RequestScript -}}
+import __fs from 'fs';
+
+function __transformRequestBody(body) {
+{{ $.RequestScript }}
+}
+{{ end }}
+{{- if $.ResponseScript }}
+function __transformResponseBody(body) {
+{{ $.ResponseScript }}
+}
+
+let __passthrough = false;
+let __size = 0;
+const __chunks = [];
+{{ end }}
+function __transform(r, transform, body) {
+    try {
+        const result = transform(body);
+        if (typeof result === 'string') {
+            return result;
+        }
+
+        r.error('HTTPBodyTransform {{ $.Source }}: the script did not return a string');
+    } catch (e) {
+        r.error('HTTPBodyTransform {{ $.Source }}: ' + e);
+    }
+
+    return body;
+}
+{{- if $.RequestScript }}
+
+function __request(r) {
+    const body = r.requestText;
+    if (body === undefined) {
+        const file = r.variables.request_body_file;
+        return file ? __fs.readFileSync(file, 'utf8') : '';
+    }
+
+    if (body.length > {{ $.MaxRequestBodySize }}) {
+        return body;
+    }
+
+    return __transform(r, __transformRequestBody, body);
+}
+{{- end }}
+{{- if $.ResponseScript }}
+
+function __responseHeaders(r) {
+    if (r.headersOut['Content-Encoding']) {
+        __passthrough = true;
+        return;
+    }
+
+    delete r.headersOut['Content-Length'];
+}
+
+function __responseBody(r, data, flags) {
+    if (__passthrough) {
+        r.sendBuffer(data, flags);
+        return;
+    }
+
+    __chunks.push(data);
+    __size += data.length;
+
+    if (__size > {{ $.MaxResponseBodySize }}) {
+        __passthrough = true;
+        r.sendBuffer(Buffer.concat(__chunks), flags);
+        return;
+    }
+
+    if (flags.last) {
+        const body = Buffer.concat(__chunks).toString();
+        r.sendBuffer(__transform(r, __transformResponseBody, body), flags);
+    }
+}
+{{- end }}
+
+export default {
+{{- if $.RequestScript }}
+    request: __request,
+{{- end }}
+{{- if $.ResponseScript }}
+    responseHeaders: __responseHeaders,
+    responseBody: __responseBody,
+{{- end }}
+};
+`
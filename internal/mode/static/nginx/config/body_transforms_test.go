@@ -0,0 +1,89 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteBodyTransforms(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		BodyTransforms: []dataplane.BodyTransform{
+			{
+				Source:              types.NamespacedName{Namespace: "test", Name: "both"},
+				ID:                  "body_transform_test_both",
+				RequestScript:       "return body.toUpperCase();",
+				ResponseScript:      "return body.replace(/foo/g, 'bar');",
+				MaxRequestBodySize:  4096,
+				MaxResponseBodySize: 1024,
+			},
+			{
+				Source:              types.NamespacedName{Namespace: "test", Name: "response"},
+				ID:                  "body_transform_test_response",
+				ResponseScript:      "return body.toLowerCase();",
+				MaxResponseBodySize: 2048,
+			},
+		},
+	}
+
+	results := executeBodyTransforms(conf)
+	g.Expect(results).To(HaveLen(3))
+
+	files := make(map[string]string)
+	for _, res := range results {
+		files[res.dest] = string(res.data)
+	}
+
+	expFiles := map[string]map[string]int{
+		httpConfigFile: {
+			"js_import body_transform_test_both from " +
+				"/etc/nginx/includes/body-transforms/body_transform_test_both.js;": 1,
+			"js_set $body_transform_test_both_request_body body_transform_test_both.request;": 1,
+			"js_import body_transform_test_response from " +
+				"/etc/nginx/includes/body-transforms/body_transform_test_response.js;": 1,
+			"js_set": 1,
+		},
+		"/etc/nginx/includes/body-transforms/body_transform_test_both.js": {
+			"import __fs from 'fs';": 1,
+			"function __transformRequestBody(body) {\nreturn body.toUpperCase();\n}":           1,
+			"function __transformResponseBody(body) {\nreturn body.replace(/foo/g, 'bar');\n}": 1,
+			"HTTPBodyTransform test/both: ":                                                    2,
+			"if (__size > 1024) {":                                                             1,
+			"if (body.length > 4096) {":                                                        1,
+			"request: __request,":                                                              1,
+			"responseHeaders: __responseHeaders,":                                              1,
+			"responseBody: __responseBody,":                                                    1,
+		},
+		"/etc/nginx/includes/body-transforms/body_transform_test_response.js": {
+			"import __fs from 'fs';": 0,
+			"__transformRequestBody": 0,
+			"body.length":            0,
+			"function __transformResponseBody(body) {\nreturn body.toLowerCase();\n}": 1,
+			"if (__size > 2048) {":          1,
+			"request: __request,":           0,
+			"responseBody: __responseBody,": 1,
+		},
+	}
+
+	for dest, expSubStrings := range expFiles {
+		g.Expect(files).To(HaveKey(dest))
+
+		for expSubStr, expCount := range expSubStrings {
+			g.Expect(strings.Count(files[dest], expSubStr)).To(Equal(expCount), expSubStr)
+		}
+	}
+}
+
+func TestExecuteBodyTransformsNil(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(executeBodyTransforms(dataplane.Configuration{})).To(BeEmpty())
+}
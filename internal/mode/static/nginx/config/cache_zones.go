@@ -0,0 +1,23 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var cacheZonesTemplate = gotemplate.Must(gotemplate.New("cacheZones").Parse(cacheZonesTemplateText))
+
+func executeCacheZones(conf dataplane.Configuration) []executeResult {
+	if len(conf.CacheZones) == 0 {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(cacheZonesTemplate, conf.CacheZones),
+	}
+
+	return []executeResult{result}
+}
@@ -0,0 +1,8 @@
+package config
+
+const cacheZonesTemplateText = `
+{{- range $z := . }}
+proxy_cache_path {{ $z.Path }} levels=1:2 keys_zone={{ $z.Name }}:{{ $z.KeysZoneSize }}
+	{{- if $z.MaxSize }} max_size={{ $z.MaxSize }}{{ end }} inactive={{ $z.Inactive }};
+{{- end }}
+`
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteCacheZones(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		CacheZones: []dataplane.CacheZone{
+			{
+				Name:         "cache_test_custom",
+				Path:         "/var/cache/nginx/cache_test_custom",
+				KeysZoneSize: "1m",
+				MaxSize:      "1g",
+				Inactive:     "1h",
+			},
+			{
+				Name:         "cache_test_default",
+				Path:         "/var/cache/nginx/cache_test_default",
+				KeysZoneSize: "10m",
+				Inactive:     "10m",
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	expSubStrings := map[string]int{
+		"proxy_cache_path /var/cache/nginx/cache_test_custom levels=1:2 keys_zone=cache_test_custom:1m " +
+			"max_size=1g inactive=1h;": 1,
+		"proxy_cache_path /var/cache/nginx/cache_test_default levels=1:2 keys_zone=cache_test_default:10m " +
+			"inactive=10m;": 1,
+		"max_size": 1,
+	}
+
+	res := executeCacheZones(conf)
+	g.Expect(res).To(HaveLen(1))
+	g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteCacheZonesNil(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	res := executeCacheZones(dataplane.Configuration{})
+	g.Expect(res).To(BeEmpty())
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var connectionLimitZonesTemplate = gotemplate.Must(
+	gotemplate.New("connectionLimitZones").Parse(connectionLimitZonesTemplateText),
+)
+
+func executeConnectionLimitZones(conf dataplane.Configuration) []executeResult {
+	var results []executeResult
+
+	if len(conf.ConnectionLimitZones) > 0 {
+		results = append(results, executeResult{
+			dest: httpConfigFile,
+			data: helpers.MustExecuteTemplate(connectionLimitZonesTemplate, conf.ConnectionLimitZones),
+		})
+	}
+
+	if len(conf.StreamConnectionLimitZones) > 0 {
+		results = append(results, executeResult{
+			dest: streamConfigFile,
+			data: helpers.MustExecuteTemplate(connectionLimitZonesTemplate, conf.StreamConnectionLimitZones),
+		})
+	}
+
+	return results
+}
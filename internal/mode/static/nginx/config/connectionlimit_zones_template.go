@@ -0,0 +1,7 @@
+package config
+
+const connectionLimitZonesTemplateText = `
+{{- range $z := . }}
+limit_conn_zone {{ $z.Key }} zone={{ $z.Name }}:{{ $z.Size }};
+{{- end }}
+`
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteConnectionLimitZones(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		ConnectionLimitZones: []dataplane.ConnectionLimitZone{
+			{
+				Name: "connlimit_test_client",
+				Key:  "$binary_remote_addr",
+				Size: "10m",
+			},
+			{
+				Name: "connlimit_test_server",
+				Key:  "$server_name",
+				Size: "1m",
+			},
+		},
+		StreamConnectionLimitZones: []dataplane.ConnectionLimitZone{
+			{
+				Name: "stream_connlimit_test_server",
+				Key:  "$server_addr:$server_port",
+				Size: "1m",
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	res := executeConnectionLimitZones(conf)
+	g.Expect(res).To(HaveLen(2))
+
+	g.Expect(res[0].dest).To(Equal(httpConfigFile))
+	httpConf := string(res[0].data)
+	g.Expect(strings.Count(httpConf, "limit_conn_zone $binary_remote_addr zone=connlimit_test_client:10m;")).To(Equal(1))
+	g.Expect(strings.Count(httpConf, "limit_conn_zone $server_name zone=connlimit_test_server:1m;")).To(Equal(1))
+	g.Expect(httpConf).ToNot(ContainSubstring("stream_connlimit"))
+
+	g.Expect(res[1].dest).To(Equal(streamConfigFile))
+	g.Expect(string(res[1].data)).To(Equal(
+		"\nlimit_conn_zone $server_addr:$server_port zone=stream_connlimit_test_server:1m;\n",
+	))
+}
+
+func TestExecuteConnectionLimitZonesNil(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	res := executeConnectionLimitZones(dataplane.Configuration{})
+	g.Expect(res).To(BeEmpty())
+}
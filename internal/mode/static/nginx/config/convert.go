@@ -9,7 +9,9 @@ import (
 )
 
 // ConvertEndpoints converts a list of Endpoints into a list of NGINX Plus SDK UpstreamServers.
-func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
+// If drain is true, the servers are put into the draining mode, so that they don't receive new requests
+// except for the requests bound to them by session persistence.
+func ConvertEndpoints(eps []resolver.Endpoint, drain bool) []ngxclient.UpstreamServer {
 	servers := make([]ngxclient.UpstreamServer, 0, len(eps))
 
 	for _, ep := range eps {
@@ -17,6 +19,7 @@ func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
 
 		server := ngxclient.UpstreamServer{
 			Server: fmt.Sprintf(format, ep.Address, port),
+			Drain:  drain,
 		}
 
 		servers = append(servers, server)
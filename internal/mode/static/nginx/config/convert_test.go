@@ -40,7 +40,12 @@ func TestConvertEndpoints(t *testing.T) {
 	}
 
 	g := NewWithT(t)
-	g.Expect(ConvertEndpoints(endpoints)).To(Equal(expUpstreams))
+	g.Expect(ConvertEndpoints(endpoints, false /* drain */)).To(Equal(expUpstreams))
+
+	for i := range expUpstreams {
+		expUpstreams[i].Drain = true
+	}
+	g.Expect(ConvertEndpoints(endpoints, true /* drain */)).To(Equal(expUpstreams))
 }
 
 func TestConvertStreamEndpoints(t *testing.T) {
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var (
+	debugCaptureTemplate       = gotemplate.Must(gotemplate.New("debugCapture").Parse(debugCaptureTemplateText))
+	debugCaptureModuleTemplate = gotemplate.Must(
+		gotemplate.New("debugCaptureModule").Parse(debugCaptureModuleTemplateText),
+	)
+)
+
+const (
+	// debugCaptureModuleFile is the njs module that decides which requests are captured.
+	debugCaptureModuleFile = includesFolder + "/debug-capture.js"
+
+	// defaultAccessLog is the access log that NGINX uses when the access_log directive is not set.
+	defaultAccessLog = "/var/log/nginx/access.log combined"
+)
+
+// debugCaptureEntry is a debug capture in the njs module of the debug captures.
+type debugCaptureEntry struct {
+	Route    string `json:"route"`
+	Location string `json:"location"`
+	Expires  int64  `json:"expires"`
+	Requests int32  `json:"requests"`
+}
+
+func createDebugCapture(capture *dataplane.DebugCapture) *http.DebugCapture {
+	if capture == nil {
+		return nil
+	}
+
+	return &http.DebugCapture{
+		Route:    capture.Route.String(),
+		Expires:  capture.Expires.UnixMilli(),
+		Requests: capture.Requests,
+	}
+}
+
+// setDebugCaptures sets the IDs of the debug captures of the locations of the servers and the access logs
+// that the locations inherit. It returns the debug captures by their IDs.
+func setDebugCaptures(servers []http.Server, httpAccessLog string) map[string]debugCaptureEntry {
+	captures := make(map[string]debugCaptureEntry)
+
+	for i := range servers {
+		accessLog := getEffectiveAccessLog(servers[i].AccessLog, httpAccessLog)
+
+		for j := range servers[i].Locations {
+			loc := &servers[i].Locations[j]
+			if loc.DebugCapture == nil {
+				continue
+			}
+
+			// copy the capture, because the locations can share it with the locations of other servers
+			capture := *loc.DebugCapture
+			capture.ID = fmt.Sprintf("capture%d", len(captures))
+			capture.AccessLog = accessLog
+			loc.DebugCapture = &capture
+
+			captures[capture.ID] = debugCaptureEntry{
+				Route:    capture.Route,
+				Location: loc.Path,
+				Expires:  capture.Expires,
+				Requests: capture.Requests,
+			}
+		}
+	}
+
+	return captures
+}
+
+// getEffectiveAccessLog returns the access log that the locations of a server inherit. It returns an empty
+// string if the access log is off.
+func getEffectiveAccessLog(serverAccessLog, httpAccessLog string) string {
+	accessLog := defaultAccessLog
+
+	switch {
+	case serverAccessLog != "":
+		accessLog = serverAccessLog
+	case httpAccessLog != "":
+		accessLog = httpAccessLog
+	}
+
+	if accessLog == "off" {
+		return ""
+	}
+
+	return accessLog
+}
+
+func createDebugCaptureExecuteResults(captures map[string]debugCaptureEntry) []executeResult {
+	if len(captures) == 0 {
+		return nil
+	}
+
+	capturesJSON, err := json.Marshal(captures)
+	if err != nil {
+		// panic is safe here because we should never fail to marshal the captures unless we constructed them
+		// incorrectly.
+		panic(fmt.Errorf("could not marshal debug captures: %w", err))
+	}
+
+	return []executeResult{
+		{
+			dest: httpConfigFile,
+			data: helpers.MustExecuteTemplate(debugCaptureTemplate, debugCaptureModuleFile),
+		},
+		{
+			dest: debugCaptureModuleFile,
+			data: helpers.MustExecuteTemplate(debugCaptureModuleTemplate, string(capturesJSON)),
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+// debugCaptureTemplateText configures the capture of the routing decisions of the requests. The capturing locations
+// log the requests for which $debug_capture is not empty with the debug_capture log format.
+// The numbers of captured requests are counted in a shared dictionary, so that they are preserved across reloads.
+const debugCaptureTemplateText = `
+js_import debug_capture from {{ . }};
+js_shared_dict_zone zone=debug_capture:1m type=number evict;
+js_set $debug_capture debug_capture.capture;
+js_set $debug_capture_route debug_capture.route;
+js_set $debug_capture_location debug_capture.location;
+log_format debug_capture escape=json '{"time":"$time_iso8601","route":"$debug_capture_route",'
+    '"request_id":"$request_id","request":"$request","host":"$host","server_name":"$server_name",'
+    '"server_port":"$server_port","location":"$debug_capture_location","upstream_addr":"$upstream_addr",'
+    '"upstream_status":"$upstream_status","status":"$status","request_time":"$request_time",'
+    '"upstream_connect_time":"$upstream_connect_time","upstream_header_time":"$upstream_header_time",'
+    '"upstream_response_time":"$upstream_response_time"}';
+`
+
+// debugCaptureModuleTemplateText is the njs module that decides which requests are captured. A request is captured
+// if the capture of its location hasn't expired and fewer than the maximum number of requests of the Route were
+// captured. The count is reset when the expiration time of the capture changes.
+const debugCaptureModuleTemplateText = `const captures = {{ . }};
+
+function __get(r) {
+    return captures[r.variables.debug_capture_id];
+}
+
+function capture(r) {
+    const c = __get(r);
+    if (!c || Date.now() >= c.expires) {
+        return '';
+    }
+
+    const count = ngx.shared.debug_capture.incr(c.route + '@' + c.expires, 1, 0);
+
+    return count <= c.requests ? '1' : '';
+}
+
+function route(r) {
+    const c = __get(r);
+    return c ? c.route : '';
+}
+
+function location(r) {
+    const c = __get(r);
+    return c ? c.location : '';
+}
+
+export default { capture, route, location };
+`
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteServers_DebugCapture(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	capture := &dataplane.DebugCapture{
+		Route:    types.NamespacedName{Namespace: "test", Name: "coffee"},
+		Requests: 10,
+		Expires:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	createPathRule := func(path string, capture *dataplane.DebugCapture) dataplane.PathRule {
+		return dataplane.PathRule{
+			Path:     path,
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{DebugCapture: capture},
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					createPathRule("/coffee", capture),
+					createPathRule("/tea", nil),
+				},
+			},
+			{
+				Hostname:  "off.example.com",
+				Port:      8080,
+				AccessLog: &dataplane.AccessLog{Disable: true},
+				PathRules: []dataplane.PathRule{
+					createPathRule("/coffee", capture),
+				},
+			},
+		},
+		Logging: dataplane.Logging{
+			AccessLog: &dataplane.AccessLog{Destination: "/dev/stdout", Format: "main"},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	files := getResultFiles(results)
+
+	expFiles := map[string]map[string]int{
+		httpConfigFile: {
+			"set $debug_capture_id capture0;":                                    1,
+			"set $debug_capture_id capture1;":                                    1,
+			"access_log /dev/stdout debug_capture if=$debug_capture;":            2,
+			"access_log /dev/stdout main;":                                       1,
+			"js_import debug_capture from /etc/nginx/includes/debug-capture.js;": 1,
+			"js_shared_dict_zone zone=debug_capture:1m type=number evict;":       1,
+			"js_set $debug_capture debug_capture.capture;":                       1,
+			"log_format debug_capture escape=json '{\"time\":\"$time_iso8601\",": 1,
+		},
+		debugCaptureModuleFile: {
+			`const captures = {"capture0":{"route":"test/coffee","location":"= /coffee","expires":1767323045000,` +
+				`"requests":10},"capture1":{"route":"test/coffee","location":"= /coffee","expires":1767323045000,` +
+				`"requests":10}};`: 1,
+			"export default { capture, route, location };": 1,
+		},
+	}
+
+	for dest, expSubStrings := range expFiles {
+		g.Expect(files).To(HaveKey(dest))
+
+		for expSubStr, expCount := range expSubStrings {
+			g.Expect(strings.Count(files[dest], expSubStr)).To(Equal(expCount), expSubStr)
+		}
+	}
+
+	// the log format must be defined before the servers that use it
+	g.Expect(strings.Index(files[httpConfigFile], "log_format debug_capture")).
+		To(BeNumerically("<", strings.Index(files[httpConfigFile], "server {")))
+}
+
+func TestExecuteServers_NoDebugCapture(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/coffee",
+						PathType:   dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{{}},
+					},
+				},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	for _, res := range results {
+		g.Expect(res.dest).ToNot(Equal(debugCaptureModuleFile))
+		g.Expect(string(res.data)).ToNot(ContainSubstring("debug_capture"))
+	}
+}
+
+func TestGetEffectiveAccessLog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		serverAccessLog string
+		httpAccessLog   string
+		expAccessLog    string
+	}{
+		{
+			name:         "default",
+			expAccessLog: defaultAccessLog,
+		},
+		{
+			name:          "http",
+			httpAccessLog: "/dev/stdout main",
+			expAccessLog:  "/dev/stdout main",
+		},
+		{
+			name:            "server overrides http",
+			serverAccessLog: "/dev/stdout json",
+			httpAccessLog:   "/dev/stdout main",
+			expAccessLog:    "/dev/stdout json",
+		},
+		{
+			name:          "http off",
+			httpAccessLog: "off",
+		},
+		{
+			name:            "server off",
+			serverAccessLog: "off",
+			httpAccessLog:   "/dev/stdout main",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getEffectiveAccessLog(test.serverAccessLog, test.httpAccessLog)).To(Equal(test.expAccessLog))
+		})
+	}
+}
@@ -10,8 +10,14 @@ import (
 	ngfConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cachecontrol"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/listenertls"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/ratelimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/responsecompression"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -35,12 +41,25 @@ const (
 	// For example, these files include load_module directives and snippets that target the main context.
 	mainIncludesFolder = configFolder + "/main-includes"
 
+	// eventsIncludesFolder is the folder where NGINX events context configuration files are stored.
+	eventsIncludesFolder = configFolder + "/events-includes"
+
 	// secretsFolder is the folder where secrets (like TLS certs/keys) are stored.
 	secretsFolder = configFolder + "/secrets"
 
 	// includesFolder is the folder where are all include files are stored.
 	includesFolder = configFolder + "/includes"
 
+	// staticContentFolder is the folder where the static content sourced from ConfigMaps is stored.
+	staticContentFolder = includesFolder + "/static"
+
+	// bodyTransformsFolder is the folder where the njs modules of the HTTPBodyTransforms are stored.
+	bodyTransformsFolder = includesFolder + "/body-transforms"
+
+	// httpServersFolder is the folder where the configuration files of the HTTP servers are stored.
+	// The folder is not a part of ConfigFolders, because it is inside httpFolder.
+	httpServersFolder = httpFolder + "/servers"
+
 	// httpConfigFile is the path to the configuration file with HTTP configuration.
 	httpConfigFile = httpFolder + "/http.conf"
 
@@ -56,6 +75,9 @@ const (
 	// mainIncludesConfigFile is the path to the file containing NGINX configuration in the main context.
 	mainIncludesConfigFile = mainIncludesFolder + "/main.conf"
 
+	// eventsIncludesConfigFile is the path to the file containing NGINX configuration in the events context.
+	eventsIncludesConfigFile = eventsIncludesFolder + "/events.conf"
+
 	// mgmtIncludesFile is the path to the file containing the NGINX Plus mgmt config.
 	mgmtIncludesFile = mainIncludesFolder + "/mgmt.conf"
 
@@ -65,7 +87,14 @@ const (
 
 // ConfigFolders is a list of folders where NGINX configuration files are stored.
 // Volumes here also need to be added to our crossplane ephemeral test container.
-var ConfigFolders = []string{httpFolder, secretsFolder, includesFolder, mainIncludesFolder, streamFolder}
+var ConfigFolders = []string{
+	httpFolder,
+	secretsFolder,
+	includesFolder,
+	mainIncludesFolder,
+	eventsIncludesFolder,
+	streamFolder,
+}
 
 // Generator generates NGINX configuration files.
 // This interface is used for testing purposes only.
@@ -84,20 +113,30 @@ type Generator interface {
 // includes (https://nginx.org/en/docs/ngx_core_module.html#include) the files from other folders.
 type GeneratorImpl struct {
 	usageReportConfig *ngfConfig.UsageReportConfig
-	logger            logr.Logger
-	plus              bool
+	// resolver holds the nameservers of the NginxProxy, which take precedence over the externalNameResolvers.
+	resolver *dataplane.Resolver
+	logger   logr.Logger
+	// externalNameResolvers are the addresses of the nameservers that resolve the names of the upstreams
+	// of ExternalName Services.
+	externalNameResolvers []string
+	plus                  bool
+	// upstreamServersInConfig specifies that the servers of the NGINX Plus upstreams are written in
+	// the configuration instead of loaded from the state files.
+	upstreamServersInConfig bool
 }
 
 // NewGeneratorImpl creates a new GeneratorImpl.
 func NewGeneratorImpl(
 	plus bool,
 	usageReportConfig *ngfConfig.UsageReportConfig,
+	externalNameResolvers []string,
 	logger logr.Logger,
 ) GeneratorImpl {
 	return GeneratorImpl{
-		plus:              plus,
-		usageReportConfig: usageReportConfig,
-		logger:            logger,
+		plus:                  plus,
+		usageReportConfig:     usageReportConfig,
+		externalNameResolvers: externalNameResolvers,
+		logger:                logger,
 	}
 }
 
@@ -116,24 +155,41 @@ type executeFunc func(configuration dataplane.Configuration) []executeResult
 func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 	files := make([]file.File, 0)
 
+	g.upstreamServersInConfig = conf.NginxPlus.UpstreamServersInConfig
+	g.resolver = conf.BaseHTTPConfig.Resolver
+
 	for id, pair := range conf.SSLKeyPairs {
 		files = append(files, generatePEM(id, pair.Cert, pair.Key))
 	}
 
-	policyGenerator := policies.NewCompositeGenerator(
-		clientsettings.NewGenerator(),
-		observability.NewGenerator(conf.Telemetry),
-	)
-
-	files = append(files, g.executeConfigTemplates(conf, policyGenerator)...)
+	files = append(files, g.executeConfigTemplates(conf, NewPolicyGenerator(conf.Telemetry))...)
 
 	for id, bundle := range conf.CertBundles {
 		files = append(files, generateCertBundle(id, bundle))
 	}
 
+	for id, content := range conf.StaticContents {
+		files = append(files, generateStaticContentFiles(id, content)...)
+	}
+
 	return files
 }
 
+// NewPolicyGenerator returns the generator of the configuration of all NGF Policies that apply to the servers and
+// the locations.
+func NewPolicyGenerator(telemetry dataplane.Telemetry) *policies.CompositeGenerator {
+	return policies.NewCompositeGenerator(
+		clientsettings.NewGenerator(),
+		observability.NewGenerator(telemetry),
+		cachecontrol.NewGenerator(),
+		listenertls.NewGenerator(),
+		responsecompression.NewGenerator(),
+		cache.NewGenerator(),
+		ratelimit.NewGenerator(),
+		connectionlimit.NewGenerator(),
+	)
+}
+
 // GenerateDeploymentContext generates the deployment_ctx.json file needed for N+ licensing.
 // It's exported since it's used by the init container process.
 func (g GeneratorImpl) GenerateDeploymentContext(depCtx dataplane.DeploymentContext) (file.File, error) {
@@ -159,8 +215,9 @@ func (g GeneratorImpl) executeConfigTemplates(
 
 	httpUpstreams := g.createUpstreams(conf.Upstreams, upstreamsettings.NewProcessor())
 	keepAliveCheck := newKeepAliveChecker(httpUpstreams)
+	sessionCookieGet := newSessionCookieGetter(httpUpstreams)
 
-	for _, execute := range g.getExecuteFuncs(generator, httpUpstreams, keepAliveCheck) {
+	for _, execute := range g.getExecuteFuncs(generator, httpUpstreams, keepAliveCheck, sessionCookieGet) {
 		results := execute(conf)
 		for _, res := range results {
 			fileBytes[res.dest] = append(fileBytes[res.dest], res.data...)
@@ -189,20 +246,28 @@ func (g GeneratorImpl) getExecuteFuncs(
 	generator policies.Generator,
 	upstreams []http.Upstream,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) []executeFunc {
 	return []executeFunc{
 		executeMainConfig,
+		executeEventsConfig,
 		executeBaseHTTPConfig,
-		g.newExecuteServersFunc(generator, keepAliveCheck),
-		newExecuteUpstreamsFunc(upstreams),
+		g.newExecuteServersFunc(generator, keepAliveCheck, sessionCookieGet),
+		g.newExecuteUpstreamsFunc(upstreams),
 		executeSplitClients,
-		executeMaps,
+		newExecuteMapsFunc(upstreams),
 		executeTelemetry,
+		executeCacheZones,
+		executeRateLimitZones,
+		executeConnectionLimitZones,
+		executeBodyTransforms,
 		g.executeStreamServers,
 		g.executeStreamUpstreams,
 		executeStreamMaps,
 		executeVersion,
 		executePlusAPI,
+		g.executeStatusEndpoint,
+		g.executeGRPCHealthChecks,
 	}
 }
 
@@ -234,3 +299,22 @@ func generateCertBundle(id dataplane.CertBundleID, cert []byte) file.File {
 func generateCertBundleFileName(id dataplane.CertBundleID) string {
 	return filepath.Join(secretsFolder, string(id)+".crt")
 }
+
+func generateStaticContentFiles(id dataplane.StaticContentID, content dataplane.StaticContentFiles) []file.File {
+	folder := generateStaticContentFolderName(id)
+	files := make([]file.File, 0, len(content))
+
+	for name, data := range content {
+		files = append(files, file.File{
+			Content: data,
+			Path:    filepath.Join(folder, name),
+			Type:    file.TypeRegular,
+		})
+	}
+
+	return files
+}
+
+func generateStaticContentFolderName(id dataplane.StaticContentID) string {
+	return filepath.Join(staticContentFolder, string(id))
+}
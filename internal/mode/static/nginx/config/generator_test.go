@@ -140,12 +140,13 @@ func TestGenerate(t *testing.T) {
 	generator := config.NewGeneratorImpl(
 		plus,
 		&ngfConfig.UsageReportConfig{Endpoint: "test-endpoint"},
+		nil,
 		logr.Discard(),
 	)
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(18))
+	g.Expect(files).To(HaveLen(23))
 	arrange := func(i, j int) bool {
 		return files[i].Path < files[j].Path
 	}
@@ -157,6 +158,11 @@ func TestGenerate(t *testing.T) {
 		/etc/nginx/conf.d/http.conf
 		/etc/nginx/conf.d/matches.json
 		/etc/nginx/conf.d/plus-api.conf
+		/etc/nginx/conf.d/servers/http__default_80.conf
+		/etc/nginx/conf.d/servers/http_example.com_80.conf
+		/etc/nginx/conf.d/servers/https__default_443.conf
+		/etc/nginx/conf.d/servers/https_example.com_443.conf
+		/etc/nginx/events-includes/events.conf
 		/etc/nginx/includes/http_snippet1.conf
 		/etc/nginx/includes/http_snippet2.conf
 		/etc/nginx/includes/main_snippet1.conf
@@ -183,8 +189,10 @@ func TestGenerate(t *testing.T) {
 	httpCfg := string(files[1].Content) // converting to string so that on failure gomega prints strings not byte arrays
 	// Note: this only verifies that Generate() returns a byte array with upstream, server, and split_client blocks.
 	// It does not test the correctness of those blocks. That functionality is covered by other tests in this package.
-	g.Expect(httpCfg).To(ContainSubstring("listen 80"))
-	g.Expect(httpCfg).To(ContainSubstring("listen unix:/var/run/nginx/https443.sock"))
+	g.Expect(httpCfg).To(ContainSubstring("include /etc/nginx/conf.d/servers/http__default_80.conf;"))
+	g.Expect(httpCfg).To(ContainSubstring("include /etc/nginx/conf.d/servers/http_example.com_80.conf;"))
+	g.Expect(httpCfg).To(ContainSubstring("include /etc/nginx/conf.d/servers/https__default_443.conf;"))
+	g.Expect(httpCfg).To(ContainSubstring("include /etc/nginx/conf.d/servers/https_example.com_443.conf;"))
 	g.Expect(httpCfg).To(ContainSubstring("upstream"))
 	g.Expect(httpCfg).To(ContainSubstring("split_clients"))
 
@@ -215,28 +223,41 @@ func TestGenerate(t *testing.T) {
 	g.Expect(httpCfg).To(ContainSubstring("deny all;"))
 	g.Expect(httpCfg).To(ContainSubstring("location = /dashboard.html {}"))
 
+	// server files
+	g.Expect(files[4].Path).To(Equal("/etc/nginx/conf.d/servers/http__default_80.conf"))
+	g.Expect(string(files[4].Content)).To(ContainSubstring("listen 80 default_server"))
+	g.Expect(files[5].Path).To(Equal("/etc/nginx/conf.d/servers/http_example.com_80.conf"))
+	g.Expect(string(files[5].Content)).To(ContainSubstring("server_name example.com;"))
+	g.Expect(files[6].Path).To(Equal("/etc/nginx/conf.d/servers/https__default_443.conf"))
+	g.Expect(string(files[6].Content)).To(ContainSubstring("ssl_reject_handshake on;"))
+	g.Expect(files[7].Path).To(Equal("/etc/nginx/conf.d/servers/https_example.com_443.conf"))
+	g.Expect(string(files[7].Content)).To(ContainSubstring("listen unix:/var/run/nginx/https443.sock"))
+
 	// snippet include files
 	// content is not checked in this test.
-	g.Expect(files[4].Path).To(Equal("/etc/nginx/includes/http_snippet1.conf"))
-	g.Expect(files[5].Path).To(Equal("/etc/nginx/includes/http_snippet2.conf"))
-	g.Expect(files[6].Path).To(Equal("/etc/nginx/includes/main_snippet1.conf"))
-	g.Expect(files[7].Path).To(Equal("/etc/nginx/includes/main_snippet2.conf"))
+	g.Expect(files[8].Path).To(Equal("/etc/nginx/events-includes/events.conf"))
+	g.Expect(string(files[8].Content)).To(ContainSubstring("worker_connections 1024;"))
+
+	g.Expect(files[9].Path).To(Equal("/etc/nginx/includes/http_snippet1.conf"))
+	g.Expect(files[10].Path).To(Equal("/etc/nginx/includes/http_snippet2.conf"))
+	g.Expect(files[11].Path).To(Equal("/etc/nginx/includes/main_snippet1.conf"))
+	g.Expect(files[12].Path).To(Equal("/etc/nginx/includes/main_snippet2.conf"))
 
-	g.Expect(files[8].Path).To(Equal("/etc/nginx/main-includes/deployment_ctx.json"))
-	deploymentCtx := string(files[8].Content)
+	g.Expect(files[13].Path).To(Equal("/etc/nginx/main-includes/deployment_ctx.json"))
+	deploymentCtx := string(files[13].Content)
 	g.Expect(deploymentCtx).To(ContainSubstring("\"integration\":\"ngf\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"cluster_id\":\"test-uid\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"installation_id\":\"test-uid-replicaSet\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"cluster_node_count\":1"))
 
-	g.Expect(files[9].Path).To(Equal("/etc/nginx/main-includes/main.conf"))
-	mainConfStr := string(files[9].Content)
+	g.Expect(files[14].Path).To(Equal("/etc/nginx/main-includes/main.conf"))
+	mainConfStr := string(files[14].Content)
 	g.Expect(mainConfStr).To(ContainSubstring("load_module modules/ngx_otel_module.so;"))
 	g.Expect(mainConfStr).To(ContainSubstring("include /etc/nginx/includes/main_snippet1.conf;"))
 	g.Expect(mainConfStr).To(ContainSubstring("include /etc/nginx/includes/main_snippet2.conf;"))
 
-	g.Expect(files[10].Path).To(Equal("/etc/nginx/main-includes/mgmt.conf"))
-	mgmtConf := string(files[10].Content)
+	g.Expect(files[15].Path).To(Equal("/etc/nginx/main-includes/mgmt.conf"))
+	mgmtConf := string(files[15].Content)
 	g.Expect(mgmtConf).To(ContainSubstring("usage_report endpoint=test-endpoint"))
 	g.Expect(mgmtConf).To(ContainSubstring("license_token /etc/nginx/secrets/license.jwt"))
 	g.Expect(mgmtConf).To(ContainSubstring("deployment_context /etc/nginx/main-includes/deployment_ctx.json"))
@@ -244,33 +265,69 @@ func TestGenerate(t *testing.T) {
 	g.Expect(mgmtConf).To(ContainSubstring("ssl_certificate /etc/nginx/secrets/mgmt-tls.crt"))
 	g.Expect(mgmtConf).To(ContainSubstring("ssl_certificate_key /etc/nginx/secrets/mgmt-tls.key"))
 
-	g.Expect(files[11].Path).To(Equal("/etc/nginx/secrets/license.jwt"))
-	g.Expect(string(files[11].Content)).To(Equal("license"))
+	g.Expect(files[16].Path).To(Equal("/etc/nginx/secrets/license.jwt"))
+	g.Expect(string(files[16].Content)).To(Equal("license"))
 
-	g.Expect(files[12].Path).To(Equal("/etc/nginx/secrets/mgmt-ca.crt"))
-	g.Expect(string(files[12].Content)).To(Equal("ca"))
+	g.Expect(files[17].Path).To(Equal("/etc/nginx/secrets/mgmt-ca.crt"))
+	g.Expect(string(files[17].Content)).To(Equal("ca"))
 
-	g.Expect(files[13].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.crt"))
-	g.Expect(string(files[13].Content)).To(Equal("cert"))
+	g.Expect(files[18].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.crt"))
+	g.Expect(string(files[18].Content)).To(Equal("cert"))
 
-	g.Expect(files[14].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.key"))
-	g.Expect(string(files[14].Content)).To(Equal("key"))
+	g.Expect(files[19].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.key"))
+	g.Expect(string(files[19].Content)).To(Equal("key"))
 
-	g.Expect(files[15].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
-	certBundle := string(files[15].Content)
+	g.Expect(files[20].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
+	certBundle := string(files[20].Content)
 	g.Expect(certBundle).To(Equal("test-cert"))
 
-	g.Expect(files[16]).To(Equal(file.File{
+	g.Expect(files[21]).To(Equal(file.File{
 		Type:    file.TypeSecret,
 		Path:    "/etc/nginx/secrets/test-keypair.pem",
 		Content: []byte("test-cert\ntest-key"),
 	}))
 
-	g.Expect(files[17].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
-	g.Expect(files[17].Type).To(Equal(file.TypeRegular))
-	streamCfg := string(files[17].Content)
+	g.Expect(files[22].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
+	g.Expect(files[22].Type).To(Equal(file.TypeRegular))
+	streamCfg := string(files[22].Content)
 	g.Expect(streamCfg).To(ContainSubstring("listen unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("listen 443"))
 	g.Expect(streamCfg).To(ContainSubstring("app.example.com unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("example.com unix:/var/run/nginx/https443.sock"))
 }
+
+func TestGenerate_StaticContent(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		StaticContents: map[dataplane.StaticContentID]dataplane.StaticContentFiles{
+			"test_content": {
+				"index.html":  []byte("<html></html>"),
+				"favicon.ico": {0x00, 0x01},
+			},
+		},
+	}
+	g := NewWithT(t)
+
+	generator := config.NewGeneratorImpl(false, nil, nil, logr.Discard())
+
+	var staticFiles []file.File
+	for _, f := range generator.Generate(conf) {
+		if f.Path == "/etc/nginx/includes/static/test_content/index.html" ||
+			f.Path == "/etc/nginx/includes/static/test_content/favicon.ico" {
+			staticFiles = append(staticFiles, f)
+		}
+	}
+
+	g.Expect(staticFiles).To(ConsistOf(
+		file.File{
+			Type:    file.TypeRegular,
+			Path:    "/etc/nginx/includes/static/test_content/index.html",
+			Content: []byte("<html></html>"),
+		},
+		file.File{
+			Type:    file.TypeRegular,
+			Path:    "/etc/nginx/includes/static/test_content/favicon.ico",
+			Content: []byte{0x00, 0x01},
+		},
+	))
+}
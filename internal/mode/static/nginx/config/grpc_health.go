@@ -0,0 +1,87 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+const (
+	// grpcHealthSocket is the socket of the server that checks the health of gRPC upstreams.
+	grpcHealthSocket = "unix:/var/run/nginx/nginx-grpc-health.sock"
+	// grpcHealthCheckPathPrefix is the path prefix of the internal locations that send the health check requests
+	// to gRPC upstreams. It must match the prefix in the grpchealth njs module.
+	grpcHealthCheckPathPrefix = http.InternalRoutePathPrefix + "-grpc-health-check"
+)
+
+var grpcHealthTemplate = gotemplate.Must(gotemplate.New("grpcHealth").Parse(grpcHealthTemplateText))
+
+type grpcHealthServer struct {
+	Socket       string
+	HealthChecks []http.GRPCHealthCheck
+	Plus         bool
+}
+
+func (g GeneratorImpl) executeGRPCHealthChecks(conf dataplane.Configuration) []executeResult {
+	if len(conf.GRPCHealthChecks) == 0 {
+		return nil
+	}
+
+	server := grpcHealthServer{
+		Socket:       grpcHealthSocket,
+		HealthChecks: createGRPCHealthChecks(conf.GRPCHealthChecks),
+		Plus:         g.plus,
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(grpcHealthTemplate, server),
+	}
+
+	return []executeResult{result}
+}
+
+func createGRPCHealthChecks(checks []dataplane.GRPCHealthCheck) []http.GRPCHealthCheck {
+	healthChecks := make([]http.GRPCHealthCheck, 0, len(checks))
+
+	for _, check := range checks {
+		proxySSLVerify := createProxySSLVerify(check.VerifyTLS)
+
+		healthChecks = append(healthChecks, http.GRPCHealthCheck{
+			Path:           createGRPCHealthPath(check.UpstreamName),
+			CheckPath:      grpcHealthCheckPathPrefix + createGRPCHealthPath(check.UpstreamName),
+			Service:        check.Service,
+			GRPCPass:       generateProtocolString(proxySSLVerify, true) + "://" + check.UpstreamName,
+			Interval:       check.Interval,
+			ProxySSLVerify: proxySSLVerify,
+		})
+	}
+
+	return healthChecks
+}
+
+// createGRPCHealthLocations creates the locations that expose the gRPC health checks of a server
+// as plain HTTP endpoints. The locations proxy the requests to the server that checks the health of the upstreams.
+func createGRPCHealthLocations(checks []dataplane.GRPCHealthCheck) []http.Location {
+	if len(checks) == 0 {
+		return nil
+	}
+
+	locs := make([]http.Location, 0, len(checks))
+
+	for _, check := range checks {
+		locs = append(locs, http.Location{
+			Path:      "= " + check.Path,
+			Type:      http.ExternalLocationType,
+			ProxyPass: "http://" + grpcHealthSocket + ":" + createGRPCHealthPath(check.UpstreamName),
+		})
+	}
+
+	return locs
+}
+
+func createGRPCHealthPath(upstreamName string) string {
+	return "/" + upstreamName
+}
@@ -0,0 +1,31 @@
+package config
+
+const grpcHealthTemplateText = `
+server {
+    listen {{ $.Socket }};
+    access_log off;
+{{ range $c := .HealthChecks }}
+    location = {{ $c.Path }} {
+        set $grpc_health_service "{{ $c.Service }}";
+        js_content grpchealth.check;
+    }
+
+    location = {{ $c.CheckPath }} {
+        internal;
+        rewrite ^ /grpc.health.v1.Health/Check break;
+        grpc_set_header Content-Type application/grpc;
+        grpc_set_header TE trailers;
+        grpc_pass {{ $c.GRPCPass }};
+        {{- if $c.ProxySSLVerify }}
+        grpc_ssl_server_name on;
+        grpc_ssl_verify on;
+        grpc_ssl_name {{ $c.ProxySSLVerify.Name }};
+        grpc_ssl_trusted_certificate {{ $c.ProxySSLVerify.TrustedCertificate }};
+        {{- end }}
+        {{- if $.Plus }}
+        health_check type=grpc{{ if $c.Service }} grpc_service={{ $c.Service }}{{ end }} interval={{ $c.Interval }};
+        {{- end }}
+    }
+{{ end }}
+}
+`
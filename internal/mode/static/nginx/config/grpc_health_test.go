@@ -0,0 +1,132 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteGRPCHealthChecks(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		GRPCHealthChecks: []dataplane.GRPCHealthCheck{
+			{
+				UpstreamName: "test_grpc_8080",
+				Service:      "helloworld.Greeter",
+				Path:         "/grpc-health",
+				Interval:     "10s",
+			},
+			{
+				UpstreamName: "test_grpcs_8443",
+				Interval:     "5s",
+				VerifyTLS: &dataplane.VerifyTLS{
+					CertBundleID: "bundle",
+					Hostname:     "grpc.example.com",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expSubStrings map[string]int
+		name          string
+		plus          bool
+	}{
+		{
+			name: "oss",
+			expSubStrings: map[string]int{
+				"listen unix:/var/run/nginx/nginx-grpc-health.sock;": 1,
+				"access_log off;":                                               1,
+				"location = /test_grpc_8080 {":                                  1,
+				"set $grpc_health_service \"helloworld.Greeter\";":              1,
+				"location = /test_grpcs_8443 {":                                 1,
+				"set $grpc_health_service \"\";":                                1,
+				"js_content grpchealth.check;":                                  2,
+				"location = /_ngf-internal-grpc-health-check/test_grpc_8080 {":  1,
+				"location = /_ngf-internal-grpc-health-check/test_grpcs_8443 {": 1,
+				"internal;": 2,
+				"rewrite ^ /grpc.health.v1.Health/Check break;":               2,
+				"grpc_set_header Content-Type application/grpc;":              2,
+				"grpc_set_header TE trailers;":                                2,
+				"grpc_pass grpc://test_grpc_8080;":                            1,
+				"grpc_pass grpcs://test_grpcs_8443;":                          1,
+				"grpc_ssl_verify on;":                                         1,
+				"grpc_ssl_name grpc.example.com;":                             1,
+				"grpc_ssl_trusted_certificate /etc/nginx/secrets/bundle.crt;": 1,
+				"health_check": 0,
+			},
+		},
+		{
+			name: "plus",
+			plus: true,
+			expSubStrings: map[string]int{
+				"listen unix:/var/run/nginx/nginx-grpc-health.sock;":                   1,
+				"health_check type=grpc grpc_service=helloworld.Greeter interval=10s;": 1,
+				"health_check type=grpc interval=5s;":                                  1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{plus: test.plus}
+
+			res := gen.executeGRPCHealthChecks(conf)
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteGRPCHealthChecks_NoHealthChecks(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{plus: true}
+
+	g.Expect(gen.executeGRPCHealthChecks(dataplane.Configuration{})).To(BeEmpty())
+}
+
+func TestCreateGRPCHealthLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	checks := []dataplane.GRPCHealthCheck{
+		{
+			UpstreamName: "test_grpc_8080",
+			Path:         "/grpc-health",
+		},
+		{
+			UpstreamName: "test_other_8080",
+			Path:         "/other/health",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "= /grpc-health",
+			Type:      http.ExternalLocationType,
+			ProxyPass: "http://unix:/var/run/nginx/nginx-grpc-health.sock:/test_grpc_8080",
+		},
+		{
+			Path:      "= /other/health",
+			Type:      http.ExternalLocationType,
+			ProxyPass: "http://unix:/var/run/nginx/nginx-grpc-health.sock:/test_other_8080",
+		},
+	}
+
+	g.Expect(createGRPCHealthLocations(checks)).To(Equal(expLocations))
+	g.Expect(createGRPCHealthLocations(nil)).To(BeNil())
+}
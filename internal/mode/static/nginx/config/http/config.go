@@ -11,9 +11,29 @@ const (
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Listen        string
+	SSL *SSL
+	// SharedLocations is the include file with the Locations of the server, which the server shares with
+	// the other servers with identical Locations. If set, the Locations are rendered in the include file
+	// instead of the server.
+	SharedLocations *shared.Include
+	// IPFamily overrides the IP family of the ServerConfig for the server. Set for the servers of the Gateways
+	// that override the settings of the data plane with their own NginxProxy.
+	IPFamily *shared.IPFamily
+	// RewriteClientIP overrides the rewriting client IP settings of the ServerConfig for the server. Set for
+	// the servers of the Gateways that override the settings of the data plane with their own NginxProxy.
+	RewriteClientIP *shared.RewriteClientIPSettings
+	ServerName      string
+	Listen          string
+	// Port is the port of the Listener that the server belongs to, which clients connect to. It differs from
+	// the port in Listen if NGINX binds to the high ports instead of the privileged ports.
+	Port          string
+	ListenOptions string
+	TCPNoDelay    string
+	TCPNoPush     string
+	StatusZone    string
+	// AccessLog is the value of the access_log directive of the server, for example, "/dev/stdout main" or "off".
+	// If empty, the server uses the access log of the http context.
+	AccessLog     string
 	Locations     []Location
 	Includes      []shared.Include
 	IsDefaultHTTP bool
@@ -32,17 +52,43 @@ const (
 
 // Location holds all configuration for an HTTP location.
 type Location struct {
-	Path            string
-	ProxyPass       string
-	HTTPMatchKey    string
-	Type            LocationType
-	ProxySetHeaders []Header
-	ProxySSLVerify  *ProxySSLVerify
-	Return          *Return
-	ResponseHeaders ResponseHeaders
-	Rewrites        []string
-	Includes        []shared.Include
-	GRPC            bool
+	Path                           string
+	ProxyPass                      string
+	HTTPMatchKey                   string
+	HTTPMatchVariable              string
+	MirrorSplitClientsVariableName string
+	ProxyConnectTimeout            string
+	ProxyTimeout                   string
+	ProxyBuffering                 string
+	DefaultType                    string
+	Type                           LocationType
+	ProxySetHeaders                []Header
+	ProxySSLVerify                 *ProxySSLVerify
+	ProxyNextUpstream              *ProxyNextUpstream
+	StaticContent                  *StaticContent
+	BodyTransform                  *BodyTransform
+	DebugCapture                   *DebugCapture
+	Return                         *Return
+	ResponseHeaders                ResponseHeaders
+	Rewrites                       []string
+	MirrorPaths                    []string
+	ErrorPages                     []ErrorPage
+	Includes                       []shared.Include
+	RequestBodyBufferSize          int64
+	GRPC                           bool
+}
+
+// GRPCHealthCheck holds the configuration of the health check of a gRPC upstream
+// with the gRPC Health Checking Protocol.
+type GRPCHealthCheck struct {
+	ProxySSLVerify *ProxySSLVerify
+	// Path is the path of the location that checks the health of the upstream.
+	Path string
+	// CheckPath is the path of the internal location that sends the health check request to the upstream.
+	CheckPath string
+	Service   string
+	GRPCPass  string
+	Interval  string
 }
 
 // Header defines an HTTP header to be passed to the proxied server.
@@ -58,6 +104,49 @@ type ResponseHeaders struct {
 	Remove []string
 }
 
+// StaticContent holds the configuration for serving static content from a location.
+type StaticContent struct {
+	Root     string
+	Index    string
+	TryFiles []string
+}
+
+// BodyTransform holds the njs handlers that transform the request and response bodies of a location.
+type BodyTransform struct {
+	// RequestBody is the variable with the transformed request body. Empty if the request bodies are not
+	// transformed.
+	RequestBody string
+	// ResponseModule is the njs module with the filters that transform the response bodies. Empty if the response
+	// bodies are not transformed.
+	ResponseModule string
+}
+
+// DebugCapture holds the configuration of a location that captures the routing decisions of a bounded number
+// of requests of a Route.
+type DebugCapture struct {
+	// Route is the namespace and name of the Route.
+	Route string
+	// ID identifies the capture in the njs module of the debug captures. It is set once all servers are created.
+	ID string
+	// AccessLog is the value of the access_log directive that the location inherits from its server, for example,
+	// "/dev/stdout main". Because the location captures the requests with its own access_log directive,
+	// the inherited one must be repeated in the location. Empty if the access log is off.
+	AccessLog string
+	// Expires is the time, in milliseconds since the Unix epoch, after which the requests are no longer captured.
+	Expires int64
+	// Requests is the maximum number of captured requests.
+	Requests int32
+}
+
+// ErrorPage replaces the responses of the proxied server with the status codes with the response for the URI.
+type ErrorPage struct {
+	// URI is the path of the internal location with the custom response, or the URL of the redirect.
+	URI   string
+	Codes []int
+	// ResponseCode is the status code of the response. Zero if the status code of the replaced response is kept.
+	ResponseCode int
+}
+
 // Return represents an HTTP return.
 type Return struct {
 	Body string
@@ -68,12 +157,21 @@ type Return struct {
 type SSL struct {
 	Certificate    string
 	CertificateKey string
+	// OCSPTrustedCertificate is the file of the CA certificates that verify the stapled OCSP responses.
+	// If empty, the OCSP responses are not verified.
+	OCSPTrustedCertificate string
+	// OCSPStapling specifies whether the OCSP responses of the certificate are stapled.
+	OCSPStapling bool
 }
 
 // StatusCode is an HTTP status code.
 type StatusCode int
 
 const (
+	// StatusOK is the HTTP 200 status code.
+	StatusOK StatusCode = 200
+	// StatusMovedPermanently is the HTTP 301 status code.
+	StatusMovedPermanently StatusCode = 301
 	// StatusFound is the HTTP 302 status code.
 	StatusFound StatusCode = 302
 	// StatusNotFound is the HTTP 404 status code.
@@ -84,11 +182,24 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name      string
-	ZoneSize  string // format: 512k, 1m
-	StateFile string
-	KeepAlive UpstreamKeepAlive
-	Servers   []UpstreamServer
+	SessionPersistence *UpstreamSessionPersistence
+	Name               string
+	ZoneSize           string // format: 512k, 1m
+	StateFile          string
+	Resolver           string
+	KeepAlive          UpstreamKeepAlive
+	Servers            []UpstreamServer
+	Includes           []shared.Include
+}
+
+// UpstreamSessionPersistence holds the cookie-based session persistence configuration for an HTTP upstream.
+// NGINX Plus uses the sticky directive. NGINX OSS selects the upstream server by consistent hashing of
+// the session key stored in the HashKey variable, and sets the session cookie stored in the CookieVariable.
+type UpstreamSessionPersistence struct {
+	Name           string
+	HashKey        string
+	CookieVariable string
+	Expiry         int64 // in seconds
 }
 
 // UpstreamKeepAlive holds the keepalive configuration for an HTTP upstream.
@@ -102,6 +213,8 @@ type UpstreamKeepAlive struct {
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
 	Address string
+	Down    bool
+	Resolve bool
 }
 
 // SplitClient holds all configuration for an HTTP split client.
@@ -122,10 +235,18 @@ type ProxySSLVerify struct {
 	Name               string
 }
 
+// ProxyNextUpstream holds the configuration for passing a failed request to the next proxied server.
+type ProxyNextUpstream struct {
+	Timeout    string
+	Conditions []string
+	Tries      int
+}
+
 // ServerConfig holds configuration for an HTTP server and IP family to be used by NGINX.
 type ServerConfig struct {
 	Servers         []Server
 	RewriteClientIP shared.RewriteClientIPSettings
 	IPFamily        shared.IPFamily
 	Plus            bool
+	HTTP3           bool
 }
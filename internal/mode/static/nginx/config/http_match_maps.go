@@ -0,0 +1,225 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// nativeHeaderNameRegexp matches the header names that can be matched with the $http_<name> variables of NGINX.
+// NGINX converts the dashes of a header name to underscores in the variable name, so a header name with
+// underscores would be ambiguous. Besides, NGINX ignores the headers with underscores by default.
+var nativeHeaderNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// nginxStringEscaper escapes a string so that it can be used in a double-quoted NGINX string.
+var nginxStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// httpMatchCondition is a condition of a match, like a method or a header match, that is evaluated with a map.
+type httpMatchCondition struct {
+	// source is the variable that the condition is evaluated against.
+	source string
+	// regex is the regular expression that the source must match to satisfy the condition.
+	regex string
+}
+
+// nativeMatch is a match of a path rule that is evaluated with maps.
+type nativeMatch struct {
+	// path is the path of the internal location of the match.
+	path string
+	// conditions are the indexes of the conditions of the match.
+	conditions []int
+}
+
+// canMatchNatively returns whether the matches of the path rule can be evaluated with maps instead of njs.
+// This is the case when the matches only include methods and Exact header matches, and the first match
+// isn't a path-only match.
+func canMatchNatively(rule dataplane.PathRule) bool {
+	if len(rule.MatchRules) == 0 || isPathOnlyMatch(rule.MatchRules[0].Match) {
+		return false
+	}
+
+	for _, mr := range rule.MatchRules {
+		if len(mr.Match.QueryParams) > 0 {
+			return false
+		}
+
+		for _, h := range mr.Match.Headers {
+			if h.Type != dataplane.MatchTypeExact || !nativeHeaderNameRegexp.MatchString(h.Name) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// setHTTPMatchVariable configures the redirect locations of a path rule to redirect the requests to the internal
+// location held by the HTTP match variable of the path rule.
+func setHTTPMatchVariable(locations []http.Location, httpMatchKey string) {
+	for i := range locations {
+		locations[i].HTTPMatchVariable = "$" + generateHTTPMatchVariableName(httpMatchKey)
+	}
+}
+
+// createHTTPMatchMaps creates the maps that evaluate the matches of the path rules that can be evaluated natively.
+func createHTTPMatchMaps(conf dataplane.Configuration) []shared.Map {
+	if !conf.BaseHTTPConfig.NativeHTTPMatches {
+		return nil
+	}
+
+	var maps []shared.Map
+
+	for idx, s := range conf.HTTPServers {
+		maps = append(maps, createHTTPMatchMapsForServer(s, createHTTPServerID(idx))...)
+	}
+
+	for idx, s := range conf.SSLServers {
+		maps = append(maps, createHTTPMatchMapsForServer(s, createSSLServerID(idx))...)
+	}
+
+	return maps
+}
+
+func createHTTPMatchMapsForServer(server dataplane.VirtualServer, serverID string) []shared.Map {
+	if server.IsDefault {
+		return nil
+	}
+
+	var maps []shared.Map
+
+	for pathRuleIdx, rule := range server.PathRules {
+		if !needsInternalLocations(rule) || !canMatchNatively(rule) {
+			continue
+		}
+
+		httpMatchKey := createHTTPMatchKey(serverID, pathRuleIdx)
+		maps = append(maps, createHTTPMatchMapsForPathRule(rule, pathRuleIdx, httpMatchKey)...)
+	}
+
+	return maps
+}
+
+// createHTTPMatchMapsForPathRule creates the maps that evaluate the matches of a path rule.
+// Every distinct condition of the matches is evaluated by a map to "1" if the request satisfies the condition,
+// and to "0" otherwise. The last map evaluates the concatenated results of the conditions against the matches
+// in order, and holds the path of the internal location of the first match that the request satisfies.
+// If the request doesn't satisfy any match, the last map holds an empty string.
+func createHTTPMatchMapsForPathRule(rule dataplane.PathRule, pathRuleIdx int, httpMatchKey string) []shared.Map {
+	var conditions []httpMatchCondition
+	conditionIndexes := make(map[httpMatchCondition]int)
+
+	addCondition := func(cond httpMatchCondition) int {
+		idx, exists := conditionIndexes[cond]
+		if !exists {
+			idx = len(conditions)
+			conditionIndexes[cond] = idx
+			conditions = append(conditions, cond)
+		}
+
+		return idx
+	}
+
+	matches := make([]nativeMatch, 0, len(rule.MatchRules))
+	defaultResult := `""`
+
+	for matchRuleIdx, mr := range rule.MatchRules {
+		path := createInternalLocationPath(pathRuleIdx, matchRuleIdx)
+
+		// a path-only match is satisfied by any request, so the subsequent matches are never evaluated
+		if isPathOnlyMatch(mr.Match) {
+			defaultResult = path
+			break
+		}
+
+		match := nativeMatch{path: path}
+
+		if mr.Match.Method != nil {
+			match.conditions = append(match.conditions, addCondition(createMethodCondition(*mr.Match.Method)))
+		}
+
+		headerNames := make(map[string]struct{})
+		for _, h := range mr.Match.Headers {
+			// only the first entry for every header name (case-insensitive) is matched, like in createRouteMatch
+			lowerName := strings.ToLower(h.Name)
+			if _, ok := headerNames[lowerName]; ok {
+				continue
+			}
+			headerNames[lowerName] = struct{}{}
+
+			match.conditions = append(match.conditions, addCondition(createHeaderCondition(h)))
+		}
+
+		matches = append(matches, match)
+	}
+
+	varName := generateHTTPMatchVariableName(httpMatchKey)
+	maps := make([]shared.Map, 0, len(conditions)+1)
+
+	var source strings.Builder
+	for idx, cond := range conditions {
+		condVarName := fmt.Sprintf("$%s_cond%d", varName, idx)
+		source.WriteString(condVarName)
+
+		maps = append(maps, shared.Map{
+			Source:   cond.source,
+			Variable: condVarName,
+			Parameters: []shared.MapParameter{
+				{Value: `"~` + nginxStringEscaper.Replace(cond.regex) + `"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		})
+	}
+
+	params := make([]shared.MapParameter, 0, len(matches)+1)
+	patterns := make(map[string]struct{}, len(matches))
+
+	for _, match := range matches {
+		pattern := []byte(strings.Repeat(".", len(conditions)))
+		for _, idx := range match.conditions {
+			pattern[idx] = '1'
+		}
+
+		// a match with the same conditions as a previous match is never satisfied first
+		if _, exists := patterns[string(pattern)]; exists {
+			continue
+		}
+		patterns[string(pattern)] = struct{}{}
+
+		params = append(params, shared.MapParameter{
+			Value:  `"~^` + string(pattern) + `"`,
+			Result: match.path,
+		})
+	}
+
+	params = append(params, shared.MapParameter{Value: "default", Result: defaultResult})
+
+	maps = append(maps, shared.Map{
+		Source:     `"` + source.String() + `"`,
+		Variable:   "$" + varName,
+		Parameters: params,
+	})
+
+	return maps
+}
+
+// createMethodCondition creates the condition of a method match. The method is matched case-sensitively.
+func createMethodCondition(method string) httpMatchCondition {
+	return httpMatchCondition{
+		source: "$request_method",
+		regex:  "^" + regexp.QuoteMeta(method) + "$",
+	}
+}
+
+// createHeaderCondition creates the condition of an Exact header match. The value is matched case-sensitively
+// against every value of the header, because NGINX combines the values of a header that occurs multiple times
+// in a request into a comma-separated list.
+func createHeaderCondition(h dataplane.HTTPHeaderMatch) httpMatchCondition {
+	return httpMatchCondition{
+		source: "$http_" + strings.ToLower(convertStringToSafeVariableName(h.Name)),
+		regex:  `(?:^|,\s*)` + regexp.QuoteMeta(h.Value) + `(?:\s*,|$)`,
+	}
+}
@@ -0,0 +1,265 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestCanMatchNatively(t *testing.T) {
+	t.Parallel()
+
+	methodMatch := dataplane.MatchRule{
+		Match: dataplane.Match{Method: helpers.GetPointer("GET")},
+	}
+
+	tests := []struct {
+		name   string
+		rule   dataplane.PathRule
+		expect bool
+	}{
+		{
+			name:   "no match rules",
+			rule:   dataplane.PathRule{},
+			expect: false,
+		},
+		{
+			name: "method and exact header matches",
+			rule: dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{
+					methodMatch,
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{Name: "X-Version", Value: "v1", Type: dataplane.MatchTypeExact},
+							},
+						},
+					},
+					{},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "first match is path-only",
+			rule: dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{{}, methodMatch},
+			},
+			expect: false,
+		},
+		{
+			name: "query param match",
+			rule: dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{
+					methodMatch,
+					{
+						Match: dataplane.Match{
+							QueryParams: []dataplane.HTTPQueryParamMatch{
+								{Name: "green", Value: "true", Type: dataplane.MatchTypeExact},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "regular expression header match",
+			rule: dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{Name: "X-Version", Value: "v[0-9]", Type: dataplane.MatchTypeRegularExpression},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "header name with underscore",
+			rule: dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{Name: "X_Version", Value: "v1", Type: dataplane.MatchTypeExact},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(canMatchNatively(test.rule)).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestSetHTTPMatchVariable(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	locations := []http.Location{
+		{Path: "/coffee/", Type: http.RedirectLocationType},
+		{Path: "= /coffee", Type: http.RedirectLocationType},
+	}
+
+	setHTTPMatchVariable(locations, "SSL_1_2")
+
+	g.Expect(locations).To(Equal([]http.Location{
+		{Path: "/coffee/", Type: http.RedirectLocationType, HTTPMatchVariable: "$ngf_match_ssl_1_2"},
+		{Path: "= /coffee", Type: http.RedirectLocationType, HTTPMatchVariable: "$ngf_match_ssl_1_2"},
+	}))
+}
+
+func TestCreateHTTPMatchMaps(t *testing.T) {
+	t.Parallel()
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path: "/coffee",
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+						Headers: []dataplane.HTTPHeaderMatch{
+							{Name: "X-Version", Value: `v1.0 "beta"`, Type: dataplane.MatchTypeExact},
+							{Name: "x-version", Value: "ignored", Type: dataplane.MatchTypeExact},
+						},
+					},
+				},
+				{
+					Match: dataplane.Match{Method: helpers.GetPointer("GET")},
+				},
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{Name: "X-Version", Value: `v1.0 "beta"`, Type: dataplane.MatchTypeExact},
+						},
+					},
+				},
+				{
+					Match: dataplane.Match{Method: helpers.GetPointer("GET")},
+				},
+				{},
+			},
+		},
+		{
+			Path: "/tea",
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						QueryParams: []dataplane.HTTPQueryParamMatch{
+							{Name: "green", Value: "true", Type: dataplane.MatchTypeExact},
+						},
+					},
+				},
+			},
+		},
+		{
+			Path:       "/",
+			MatchRules: []dataplane.MatchRule{{}},
+		},
+		{
+			Path: "/latte",
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{Method: helpers.GetPointer("POST")},
+				},
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true},
+			{PathRules: pathRules},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{PathRules: pathRules[3:]},
+		},
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$request_method",
+			Variable: "$ngf_match_1_0_cond0",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^GET$"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   "$http_x_version",
+			Variable: "$ngf_match_1_0_cond1",
+			Parameters: []shared.MapParameter{
+				{Value: `"~(?:^|,\\s*)v1\\.0 \"beta\"(?:\\s*,|$)"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   `"$ngf_match_1_0_cond0$ngf_match_1_0_cond1"`,
+			Variable: "$ngf_match_1_0",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^11"`, Result: "/_ngf-internal-rule0-route0"},
+				{Value: `"~^1."`, Result: "/_ngf-internal-rule0-route1"},
+				{Value: `"~^.1"`, Result: "/_ngf-internal-rule0-route2"},
+				{Value: "default", Result: "/_ngf-internal-rule0-route4"},
+			},
+		},
+		{
+			Source:   "$request_method",
+			Variable: "$ngf_match_1_3_cond0",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^POST$"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   `"$ngf_match_1_3_cond0"`,
+			Variable: "$ngf_match_1_3",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^1"`, Result: "/_ngf-internal-rule3-route0"},
+				{Value: "default", Result: `""`},
+			},
+		},
+		{
+			Source:   "$request_method",
+			Variable: "$ngf_match_ssl_0_0_cond0",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^POST$"`, Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:   `"$ngf_match_ssl_0_0_cond0"`,
+			Variable: "$ngf_match_ssl_0_0",
+			Parameters: []shared.MapParameter{
+				{Value: `"~^1"`, Result: "/_ngf-internal-rule0-route0"},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(createHTTPMatchMaps(conf)).To(BeEmpty())
+
+	conf.BaseHTTPConfig.NativeHTTPMatches = true
+	g.Expect(createHTTPMatchMaps(conf)).To(Equal(expMaps))
+}
@@ -20,6 +20,10 @@ func createIncludeExecuteResultsFromServers(servers []http.Server) []executeResu
 			uniqueIncludes[include.Name] = include.Content
 		}
 
+		if server.SharedLocations != nil {
+			uniqueIncludes[server.SharedLocations.Name] = server.SharedLocations.Content
+		}
+
 		for _, loc := range server.Locations {
 			for _, include := range loc.Includes {
 				uniqueIncludes[include.Name] = include.Content
@@ -126,7 +130,7 @@ func createIncludesFromServerSnippetsFilters(server dataplane.VirtualServer) []s
 }
 
 // createIncludesFromSnippets converts a list of Snippets to a list of Includes.
-// Used for main and http snippets only. Server and location snippets are handled by other functions above.
+// Used for main, http, map, and upstream snippets. Server and location snippets are handled by other functions above.
 func createIncludesFromSnippets(snippets []dataplane.Snippet) []shared.Include {
 	if len(snippets) == 0 {
 		return nil
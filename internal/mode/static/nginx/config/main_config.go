@@ -11,21 +11,36 @@ import (
 )
 
 var (
-	mainConfigTemplate = gotemplate.Must(gotemplate.New("main").Parse(mainConfigTemplateText))
-	mgmtConfigTemplate = gotemplate.Must(gotemplate.New("mgmt").Parse(mgmtConfigTemplateText))
+	mainConfigTemplate   = gotemplate.Must(gotemplate.New("main").Parse(mainConfigTemplateText))
+	eventsConfigTemplate = gotemplate.Must(gotemplate.New("events").Parse(eventsConfigTemplateText))
+	mgmtConfigTemplate   = gotemplate.Must(gotemplate.New("mgmt").Parse(mgmtConfigTemplateText))
+)
+
+const (
+	// defaultWorkerProcesses is the number of the worker processes if the NginxProxy doesn't specify it.
+	defaultWorkerProcesses = "auto"
+	// defaultWorkerConnections is the maximum number of connections of a worker process
+	// if the NginxProxy doesn't specify it.
+	defaultWorkerConnections = 1024
 )
 
 type mainConfig struct {
-	Includes []shared.Include
-	Conf     dataplane.Configuration
+	Includes        []shared.Include
+	WorkerProcesses string
+	Conf            dataplane.Configuration
 }
 
 func executeMainConfig(conf dataplane.Configuration) []executeResult {
 	includes := createIncludesFromSnippets(conf.MainSnippets)
 
 	mc := mainConfig{
-		Conf:     conf,
-		Includes: includes,
+		Conf:            conf,
+		Includes:        includes,
+		WorkerProcesses: defaultWorkerProcesses,
+	}
+
+	if conf.Worker.Processes != "" {
+		mc.WorkerProcesses = conf.Worker.Processes
 	}
 
 	results := make([]executeResult, 0, len(includes)+1)
@@ -38,6 +53,29 @@ func executeMainConfig(conf dataplane.Configuration) []executeResult {
 	return results
 }
 
+type eventsConfig struct {
+	WorkerConnections int32
+	MultiAccept       bool
+}
+
+func executeEventsConfig(conf dataplane.Configuration) []executeResult {
+	ec := eventsConfig{
+		WorkerConnections: defaultWorkerConnections,
+		MultiAccept:       conf.Worker.MultiAccept,
+	}
+
+	if conf.Worker.Connections > 0 {
+		ec.WorkerConnections = conf.Worker.Connections
+	}
+
+	return []executeResult{
+		{
+			dest: eventsIncludesConfigFile,
+			data: helpers.MustExecuteTemplate(eventsConfigTemplate, ec),
+		},
+	}
+}
+
 type mgmtConf struct {
 	Endpoint          string
 	Resolver          string
@@ -1,17 +1,37 @@
 package config
 
 const mainConfigTemplateText = `
+{{ if .Conf.Worker.User -}}
+user {{ .Conf.Worker.User }}{{ if .Conf.Worker.Group }} {{ .Conf.Worker.Group }}{{ end }};
+{{ end -}}
 {{ if .Conf.Telemetry.Endpoint -}}
 load_module modules/ngx_otel_module.so;
 {{ end -}}
+{{ if .Conf.LoadBrotliModule -}}
+load_module modules/ngx_http_brotli_filter_module.so;
+{{ end -}}
 
 error_log stderr {{ .Conf.Logging.ErrorLevel }};
+{{ if .Conf.Logging.ErrorLogSocket -}}
+error_log syslog:server=unix:{{ .Conf.Logging.ErrorLogSocket }},nohostname info;
+{{ end -}}
 
+worker_processes {{ .WorkerProcesses }};
+{{ if .Conf.Worker.RlimitNofile -}}
+worker_rlimit_nofile {{ .Conf.Worker.RlimitNofile }};
+{{ end }}
 {{ range $i := .Includes -}}
 include {{ $i.Name }};
 {{ end -}}
 `
 
+const eventsConfigTemplateText = `
+worker_connections {{ .WorkerConnections }};
+{{ if .MultiAccept -}}
+multi_accept on;
+{{ end -}}
+`
+
 const mgmtConfigTemplateText = `
 mgmt {
 	{{- if .Endpoint }}
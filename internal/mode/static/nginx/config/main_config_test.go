@@ -56,6 +56,45 @@ func TestExecuteMainConfig_Telemetry(t *testing.T) {
 	}
 }
 
+func TestExecuteMainConfig_Brotli(t *testing.T) {
+	t.Parallel()
+
+	loadModuleDirective := "load_module modules/ngx_http_brotli_filter_module.so;"
+
+	tests := []struct {
+		name                   string
+		conf                   dataplane.Configuration
+		expLoadModuleDirective bool
+	}{
+		{
+			name:                   "brotli off",
+			conf:                   dataplane.Configuration{},
+			expLoadModuleDirective: false,
+		},
+		{
+			name:                   "brotli on",
+			conf:                   dataplane.Configuration{LoadBrotliModule: true},
+			expLoadModuleDirective: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeMainConfig(test.conf)
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(mainIncludesConfigFile))
+			if test.expLoadModuleDirective {
+				g.Expect(res[0].data).To(ContainSubstring(loadModuleDirective))
+			} else {
+				g.Expect(res[0].data).ToNot(ContainSubstring(loadModuleDirective))
+			}
+		})
+	}
+}
+
 func TestExecuteMainConfig_Logging(t *testing.T) {
 	t.Parallel()
 
@@ -72,6 +111,132 @@ func TestExecuteMainConfig_Logging(t *testing.T) {
 	g.Expect(res[0].dest).To(Equal(mainIncludesConfigFile))
 
 	g.Expect(string(res[0].data)).To(ContainSubstring("error_log stderr info"))
+	g.Expect(string(res[0].data)).ToNot(ContainSubstring("syslog"))
+}
+
+func TestExecuteMainConfig_ErrorLogSocket(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		Logging: dataplane.Logging{
+			ErrorLevel:     "warn",
+			ErrorLogSocket: "/var/run/nginx/nginx-error-log.sock",
+		},
+	}
+
+	g := NewWithT(t)
+
+	res := executeMainConfig(conf)
+	g.Expect(res).To(HaveLen(1))
+
+	data := string(res[0].data)
+	g.Expect(data).To(ContainSubstring("error_log stderr warn;"))
+	g.Expect(data).To(ContainSubstring(
+		"error_log syslog:server=unix:/var/run/nginx/nginx-error-log.sock,nohostname info;",
+	))
+}
+
+func TestExecuteMainConfig_Worker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg                 string
+		expUserLine         string
+		expProcessesLine    string
+		expRlimitNofileLine string
+		worker              dataplane.Worker
+	}{
+		{
+			msg:              "no user",
+			worker:           dataplane.Worker{PortOffset: 8000},
+			expProcessesLine: "worker_processes auto;",
+		},
+		{
+			msg:              "user",
+			worker:           dataplane.Worker{User: "nginx"},
+			expUserLine:      "user nginx;",
+			expProcessesLine: "worker_processes auto;",
+		},
+		{
+			msg:              "user and group",
+			worker:           dataplane.Worker{User: "nginx", Group: "nogroup"},
+			expUserLine:      "user nginx nogroup;",
+			expProcessesLine: "worker_processes auto;",
+		},
+		{
+			msg:                 "processes and open files limit",
+			worker:              dataplane.Worker{Processes: "4", RlimitNofile: 16384},
+			expProcessesLine:    "worker_processes 4;",
+			expRlimitNofileLine: "worker_rlimit_nofile 16384;",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeMainConfig(dataplane.Configuration{Worker: test.worker})
+			g.Expect(res).To(HaveLen(1))
+
+			data := string(res[0].data)
+			if test.expUserLine == "" {
+				g.Expect(data).ToNot(ContainSubstring("user "))
+			} else {
+				g.Expect(data).To(ContainSubstring(test.expUserLine))
+			}
+
+			g.Expect(data).To(ContainSubstring(test.expProcessesLine))
+
+			if test.expRlimitNofileLine == "" {
+				g.Expect(data).ToNot(ContainSubstring("worker_rlimit_nofile"))
+			} else {
+				g.Expect(data).To(ContainSubstring(test.expRlimitNofileLine))
+			}
+		})
+	}
+}
+
+func TestExecuteEventsConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg                string
+		expConnectionsLine string
+		worker             dataplane.Worker
+		expMultiAccept     bool
+	}{
+		{
+			msg:                "defaults",
+			expConnectionsLine: "worker_connections 1024;",
+		},
+		{
+			msg:                "connections and multi accept",
+			worker:             dataplane.Worker{Connections: 8192, MultiAccept: true},
+			expConnectionsLine: "worker_connections 8192;",
+			expMultiAccept:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeEventsConfig(dataplane.Configuration{Worker: test.worker})
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(eventsIncludesConfigFile))
+
+			data := string(res[0].data)
+			g.Expect(data).To(ContainSubstring(test.expConnectionsLine))
+
+			if test.expMultiAccept {
+				g.Expect(data).To(ContainSubstring("multi_accept on;"))
+			} else {
+				g.Expect(data).ToNot(ContainSubstring("multi_accept"))
+			}
+		})
+	}
 }
 
 func TestExecuteMainConfig_Snippets(t *testing.T) {
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -24,8 +26,16 @@ const (
 	connectionClosedStreamServerSocket = "unix:/var/run/nginx/connection-closed-server.sock"
 )
 
-func executeMaps(conf dataplane.Configuration) []executeResult {
+func newExecuteMapsFunc(upstreams []http.Upstream) executeFunc {
+	return func(conf dataplane.Configuration) []executeResult {
+		return executeMaps(conf, upstreams)
+	}
+}
+
+func executeMaps(conf dataplane.Configuration, upstreams []http.Upstream) []executeResult {
 	maps := buildAddHeaderMaps(append(conf.HTTPServers, conf.SSLServers...))
+	maps = append(maps, createSessionPersistenceMaps(upstreams)...)
+	maps = append(maps, createHTTPMatchMaps(conf)...)
 	result := executeResult{
 		dest: httpConfigFile,
 		data: helpers.MustExecuteTemplate(mapsTemplate, maps),
@@ -177,3 +187,46 @@ func createAddHeadersMap(name string) shared.Map {
 		Parameters: params,
 	}
 }
+
+// createSessionPersistenceMaps creates the maps for the upstreams that implement session persistence by hashing
+// the session key (NGINX OSS). If the request includes the session cookie, the session key is the value of the
+// cookie. Otherwise, the session key is the request ID, and the session cookie is set to the request ID
+// in the response, so that the subsequent requests of the client are sent to the same upstream server.
+func createSessionPersistenceMaps(upstreams []http.Upstream) []shared.Map {
+	var maps []shared.Map
+
+	for _, u := range upstreams {
+		sp := u.SessionPersistence
+		if sp == nil || sp.HashKey == "" {
+			continue
+		}
+
+		cookieVarSource := "$cookie_" + sp.Name
+
+		cookie := sp.Name + "=$request_id; Path=/"
+		if sp.Expiry > 0 {
+			cookie += fmt.Sprintf("; Max-Age=%d", sp.Expiry)
+		}
+
+		maps = append(maps,
+			shared.Map{
+				Source:   cookieVarSource,
+				Variable: sp.HashKey,
+				Parameters: []shared.MapParameter{
+					{Value: `""`, Result: "$request_id"},
+					{Value: "default", Result: cookieVarSource},
+				},
+			},
+			shared.Map{
+				Source:   cookieVarSource,
+				Variable: sp.CookieVariable,
+				Parameters: []shared.MapParameter{
+					{Value: `""`, Result: `"` + cookie + `"`},
+					{Value: "default", Result: `""`},
+				},
+			},
+		)
+	}
+
+	return maps
+}
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/gomega"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
@@ -88,7 +89,7 @@ func TestExecuteMaps(t *testing.T) {
 		"map ${http_my_set_header} $my_set_header_header_var {":               0,
 	}
 
-	mapResult := executeMaps(conf)
+	mapResult := executeMaps(conf, nil)
 	g.Expect(mapResult).To(HaveLen(1))
 	maps := string(mapResult[0].data)
 	g.Expect(mapResult[0].dest).To(Equal(httpConfigFile))
@@ -191,6 +192,77 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 	g.Expect(maps).To(ConsistOf(expectedMap))
 }
 
+func TestCreateSessionPersistenceMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	upstreams := []http.Upstream{
+		{
+			Name: "no-session-persistence",
+		},
+		{
+			Name: "plus",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name: "session",
+			},
+		},
+		{
+			Name: "session-cookie",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name:           "session",
+				HashKey:        "$ngf_session_key_session_cookie",
+				CookieVariable: "$ngf_session_cookie_session_cookie",
+			},
+		},
+		{
+			Name: "permanent-cookie",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name:           "permanent",
+				HashKey:        "$ngf_session_key_permanent_cookie",
+				CookieVariable: "$ngf_session_cookie_permanent_cookie",
+				Expiry:         3600,
+			},
+		},
+	}
+
+	expectedMaps := []shared.Map{
+		{
+			Source:   "$cookie_session",
+			Variable: "$ngf_session_key_session_cookie",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_session"},
+			},
+		},
+		{
+			Source:   "$cookie_session",
+			Variable: "$ngf_session_cookie_session_cookie",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: `"session=$request_id; Path=/"`},
+				{Value: "default", Result: `""`},
+			},
+		},
+		{
+			Source:   "$cookie_permanent",
+			Variable: "$ngf_session_key_permanent_cookie",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_permanent"},
+			},
+		},
+		{
+			Source:   "$cookie_permanent",
+			Variable: "$ngf_session_cookie_permanent_cookie",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: `"permanent=$request_id; Path=/; Max-Age=3600"`},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+
+	g.Expect(createSessionPersistenceMaps(upstreams)).To(Equal(expectedMaps))
+}
+
 func TestExecuteStreamMaps(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// defaultStatusHeader is the response header with the cache status if the policy doesn't set one.
+const defaultStatusHeader = "X-Cache-Status"
+
+var tmpl = template.Must(template.New("cache policy").Parse(cacheTemplate))
+
+const cacheTemplate = `
+proxy_cache {{ .Zone }};
+{{- if .Key }}
+proxy_cache_key {{ .Key }};
+{{- end }}
+{{- range $v := .Valid }}
+proxy_cache_valid {{ $v }};
+{{- end }}
+{{- if .Bypass }}
+proxy_cache_bypass {{ .Bypass }};
+proxy_no_cache {{ .Bypass }};
+{{- end }}
+add_header {{ .StatusHeader }} $upstream_cache_status always;
+`
+
+// Generator generates nginx configuration based on a cache policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// A location that redirects to an internal location doesn't proxy the request,
+// so the configuration is only generated for the internal location.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		cp, ok := pol.(*ngfAPI.CachePolicy)
+		if !ok {
+			continue
+		}
+
+		statusHeader := defaultStatusHeader
+		if cp.Spec.StatusHeader != nil {
+			statusHeader = *cp.Spec.StatusHeader
+		}
+
+		var key string
+		if cp.Spec.Key != nil {
+			key = *cp.Spec.Key
+		}
+
+		fields := map[string]any{
+			"Zone":         dataplane.CacheZoneName(types.NamespacedName{Namespace: cp.Namespace, Name: cp.Name}),
+			"Key":          key,
+			"Valid":        getValid(cp.Spec.Valid),
+			"Bypass":       getBypass(cp.Spec.Bypass),
+			"StatusHeader": statusHeader,
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("CachePolicy_%s_%s.conf", cp.Namespace, cp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, fields),
+		})
+	}
+
+	return files
+}
+
+// getValid returns the parameters of the proxy_cache_valid directives.
+func getValid(valid []ngfAPI.CacheValidity) []string {
+	params := make([]string, 0, len(valid))
+
+	for _, v := range valid {
+		values := make([]string, 0, len(v.Codes)+1)
+		for _, code := range v.Codes {
+			values = append(values, string(code))
+		}
+
+		params = append(params, strings.Join(append(values, string(v.Duration)), " "))
+	}
+
+	return params
+}
+
+func getBypass(bypass []ngfAPI.NginxVariable) string {
+	values := make([]string, 0, len(bypass))
+	for _, v := range bypass {
+		values = append(values, string(v))
+	}
+
+	return strings.Join(values, " ")
+}
@@ -0,0 +1,143 @@
+package cache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cache"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.CachePolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "defaults",
+			spec: ngfAPI.CachePolicySpec{},
+			expStrings: []string{
+				"proxy_cache cache_test_cp;",
+				"add_header X-Cache-Status $upstream_cache_status always;",
+			},
+			notExpStrings: []string{"proxy_cache_key", "proxy_cache_valid", "proxy_cache_bypass", "proxy_no_cache"},
+		},
+		{
+			name: "key and status header",
+			spec: ngfAPI.CachePolicySpec{
+				Key:          helpers.GetPointer("$host$request_uri$cookie_user"),
+				StatusHeader: helpers.GetPointer("X-Proxy-Cache"),
+			},
+			expStrings: []string{
+				"proxy_cache_key $host$request_uri$cookie_user;",
+				"add_header X-Proxy-Cache $upstream_cache_status always;",
+			},
+			notExpStrings: []string{"X-Cache-Status"},
+		},
+		{
+			name: "validity",
+			spec: ngfAPI.CachePolicySpec{
+				Valid: []ngfAPI.CacheValidity{
+					{Codes: []ngfAPI.CacheStatusCode{"200", "302"}, Duration: "10m"},
+					{Codes: []ngfAPI.CacheStatusCode{"any"}, Duration: "1m"},
+					{Duration: "5m"},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache_valid 200 302 10m;",
+				"proxy_cache_valid any 1m;",
+				"proxy_cache_valid 5m;",
+			},
+		},
+		{
+			name: "bypass",
+			spec: ngfAPI.CachePolicySpec{
+				Bypass: []ngfAPI.NginxVariable{"$cookie_nocache", "$http_authorization"},
+			},
+			expStrings: []string{
+				"proxy_cache_bypass $cookie_nocache $http_authorization;",
+				"proxy_no_cache $cookie_nocache $http_authorization;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("CachePolicy_test_cp.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.CachePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cp", Namespace: "test"},
+				Spec:       test.spec,
+			}
+
+			generator := cache.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.ExternalLocationType},
+			)
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.RedirectLocationType},
+			)
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := cache.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
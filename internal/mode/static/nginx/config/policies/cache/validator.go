@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+const (
+	keyFmt    = `[^\s;{}"'\\]+`
+	keyErrMsg = "must not contain whitespace, ';', '{', '}', quotes, or '\\'"
+
+	variableFmt    = `\$[A-Za-z_][A-Za-z0-9_]*`
+	variableErrMsg = "must be an NGINX variable, for example, '$cookie_nocache'"
+
+	headerNameFmt    = `[A-Za-z0-9-]+`
+	headerNameErrMsg = "must contain only alphanumeric characters or '-'"
+
+	statusCodeFmt    = `[1-5][0-9]{2}|any`
+	statusCodeErrMsg = "must be an HTTP status code or 'any'"
+)
+
+var (
+	keyFmtRegexp        = regexp.MustCompile("^" + keyFmt + "$")
+	variableFmtRegexp   = regexp.MustCompile("^" + variableFmt + "$")
+	headerNameFmtRegexp = regexp.MustCompile("^" + headerNameFmt + "$")
+	statusCodeFmtRegexp = regexp.MustCompile("^(" + statusCodeFmt + ")$")
+)
+
+// Validator validates a CachePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a CachePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CachePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range cp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(cp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two CachePolicies conflict.
+// A location can only use one cache, so any two CachePolicies conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.CachePolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Zone != nil {
+		allErrs = append(allErrs, v.validateZone(*spec.Zone, fieldPath.Child("zone"))...)
+	}
+
+	if spec.Key != nil && !keyFmtRegexp.MatchString(*spec.Key) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("key"),
+			*spec.Key,
+			fmt.Sprintf("%s (regex used for validation is '%s')", keyErrMsg, keyFmt),
+		))
+	}
+
+	if spec.StatusHeader != nil && !headerNameFmtRegexp.MatchString(*spec.StatusHeader) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("statusHeader"),
+			*spec.StatusHeader,
+			fmt.Sprintf("%s (regex used for validation is '%s')", headerNameErrMsg, headerNameFmt),
+		))
+	}
+
+	for i, valid := range spec.Valid {
+		allErrs = append(allErrs, v.validateValidity(valid, fieldPath.Child("valid").Index(i))...)
+	}
+
+	for i, variable := range spec.Bypass {
+		if !variableFmtRegexp.MatchString(string(variable)) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("bypass").Index(i),
+				variable,
+				fmt.Sprintf("%s (regex used for validation is '%s')", variableErrMsg, variableFmt),
+			))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateZone(zone ngfAPI.CacheZone, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if zone.MaxSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*zone.MaxSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("maxSize"), *zone.MaxSize, err.Error()))
+		}
+	}
+
+	if zone.KeysZoneSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*zone.KeysZoneSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("keysZoneSize"), *zone.KeysZoneSize, err.Error()))
+		}
+	}
+
+	if zone.Inactive != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*zone.Inactive)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("inactive"), *zone.Inactive, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func (v *Validator) validateValidity(valid ngfAPI.CacheValidity, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, code := range valid.Codes {
+		if !statusCodeFmtRegexp.MatchString(string(code)) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("codes").Index(i),
+				code,
+				fmt.Sprintf("%s (regex used for validation is '%s')", statusCodeErrMsg, statusCodeFmt),
+			))
+		}
+	}
+
+	if err := v.genericValidator.ValidateNginxDuration(string(valid.Duration)); err != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("duration"), valid.Duration, err.Error()))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,190 @@
+package cache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.CachePolicy) *ngfAPI.CachePolicy
+
+func createValidPolicy() *ngfAPI.CachePolicy {
+	return &ngfAPI.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.CachePolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Zone: &ngfAPI.CacheZone{
+				MaxSize:      helpers.GetPointer[ngfAPI.Size]("1g"),
+				KeysZoneSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+				Inactive:     helpers.GetPointer[ngfAPI.Duration]("1h"),
+			},
+			Key:          helpers.GetPointer("$scheme$host$request_uri"),
+			StatusHeader: helpers.GetPointer("X-Cache-Status"),
+			Valid: []ngfAPI.CacheValidity{
+				{Codes: []ngfAPI.CacheStatusCode{"200", "any"}, Duration: "10m"},
+			},
+			Bypass: []ngfAPI.NginxVariable{"$cookie_nocache"},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.CachePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.CachePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.TargetRefs[1].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid key, status header, and bypass",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Key = helpers.GetPointer("$host; proxy_pass http://evil")
+				p.Spec.StatusHeader = helpers.GetPointer("X-Cache status")
+				p.Spec.Bypass = []ngfAPI.NginxVariable{"cookie_nocache"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.key: Invalid value: \"$host; proxy_pass http://evil\": " +
+					"must not contain whitespace, ';', '{', '}', quotes, or '\\' " +
+					"(regex used for validation is '[^\\s;{}\"'\\\\]+'), " +
+					"spec.statusHeader: Invalid value: \"X-Cache status\": " +
+					"must contain only alphanumeric characters or '-' (regex used for validation is '[A-Za-z0-9-]+'), " +
+					"spec.bypass[0]: Invalid value: \"cookie_nocache\": " +
+					"must be an NGINX variable, for example, '$cookie_nocache' " +
+					"(regex used for validation is '\\$[A-Za-z_][A-Za-z0-9_]*')]"),
+			},
+		},
+		{
+			name: "invalid status code",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Valid[0].Codes = []ngfAPI.CacheStatusCode{"600"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.valid[0].codes[0]: Invalid value: \"600\": " +
+					"must be an HTTP status code or 'any' (regex used for validation is '[1-5][0-9]{2}|any')"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid without optional fields",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec = ngfAPI.CachePolicySpec{TargetRefs: p.Spec.TargetRefs}
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := cache.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidateZone(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := cache.NewValidator(validation.GenericValidator{})
+
+	policy := createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+		p.Spec.Zone.MaxSize = helpers.GetPointer[ngfAPI.Size]("1t")
+		p.Spec.Zone.KeysZoneSize = helpers.GetPointer[ngfAPI.Size]("10mb")
+		p.Spec.Zone.Inactive = helpers.GetPointer[ngfAPI.Duration]("1d")
+		p.Spec.Valid[0].Duration = "1w"
+		return p
+	})
+
+	conds := v.Validate(policy, nil)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.zone.maxSize: Invalid value: \"1t\""))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.zone.keysZoneSize: Invalid value: \"10mb\""))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.zone.inactive: Invalid value: \"1d\""))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.valid[0].duration: Invalid value: \"1w\""))
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := cache.NewValidator(validation.GenericValidator{})
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := cache.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.Conflicts(&ngfAPI.CachePolicy{}, &ngfAPI.CachePolicy{})).To(BeTrue())
+}
@@ -0,0 +1,107 @@
+package cachecontrol
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+var tmpl = template.Must(template.New("cache control policy").Parse(cacheControlTemplate))
+
+const cacheControlTemplate = `
+{{- if .Expires }}
+proxy_hide_header Expires;
+{{- end }}
+{{- if or .Expires .CacheControl }}
+proxy_hide_header Cache-Control;
+{{- end }}
+{{- if .Expires }}
+expires {{ .Expires }};
+{{- end }}
+{{- if .CacheControl }}
+add_header Cache-Control "{{ .CacheControl }}"{{ if .Always }} always{{ end }};
+{{- end }}
+`
+
+// Generator generates nginx configuration based on a cache control policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// A location that redirects to an internal location doesn't send the response to the client,
+// so the configuration is only generated for the internal location.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		ccp, ok := pol.(*ngfAPI.CacheControlPolicy)
+		if !ok {
+			continue
+		}
+
+		fields := map[string]any{
+			"Expires":      getExpires(ccp.Spec.Expires),
+			"CacheControl": getCacheControl(ccp.Spec.CacheControl),
+			"Always":       ccp.Spec.Always != nil && *ccp.Spec.Always,
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("CacheControlPolicy_%s_%s.conf", ccp.Namespace, ccp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, fields),
+		})
+	}
+
+	return files
+}
+
+func getExpires(expires *ngfAPI.CacheExpires) string {
+	if expires == nil {
+		return ""
+	}
+
+	switch expires.Type {
+	case ngfAPI.CacheExpiresDuration:
+		if expires.Duration != nil {
+			return string(*expires.Duration)
+		}
+	case ngfAPI.CacheExpiresEpoch:
+		return "epoch"
+	case ngfAPI.CacheExpiresMax:
+		return "max"
+	}
+
+	return ""
+}
+
+func getCacheControl(directives []ngfAPI.CacheControlDirective) string {
+	values := make([]string, 0, len(directives))
+	for _, d := range directives {
+		values = append(values, string(d))
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,156 @@
+package cachecontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cachecontrol"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.CacheControlPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "expires duration",
+			spec: ngfAPI.CacheControlPolicySpec{
+				Expires: &ngfAPI.CacheExpires{
+					Type:     ngfAPI.CacheExpiresDuration,
+					Duration: helpers.GetPointer[ngfAPI.Duration]("1h"),
+				},
+			},
+			expStrings: []string{
+				"proxy_hide_header Expires;",
+				"proxy_hide_header Cache-Control;",
+				"expires 1h;",
+			},
+			notExpStrings: []string{"add_header"},
+		},
+		{
+			name: "expires epoch",
+			spec: ngfAPI.CacheControlPolicySpec{
+				Expires: &ngfAPI.CacheExpires{Type: ngfAPI.CacheExpiresEpoch},
+			},
+			expStrings: []string{"expires epoch;"},
+		},
+		{
+			name: "expires max",
+			spec: ngfAPI.CacheControlPolicySpec{
+				Expires: &ngfAPI.CacheExpires{Type: ngfAPI.CacheExpiresMax},
+			},
+			expStrings: []string{"expires max;"},
+		},
+		{
+			name: "cache control",
+			spec: ngfAPI.CacheControlPolicySpec{
+				CacheControl: []ngfAPI.CacheControlDirective{"public", "stale-while-revalidate=60"},
+			},
+			expStrings: []string{
+				"proxy_hide_header Cache-Control;",
+				`add_header Cache-Control "public, stale-while-revalidate=60";`,
+			},
+			notExpStrings: []string{"proxy_hide_header Expires;", "expires"},
+		},
+		{
+			name: "cache control always",
+			spec: ngfAPI.CacheControlPolicySpec{
+				CacheControl: []ngfAPI.CacheControlDirective{"no-store"},
+				Always:       helpers.GetPointer(true),
+			},
+			expStrings: []string{`add_header Cache-Control "no-store" always;`},
+		},
+		{
+			name: "all fields populated",
+			spec: ngfAPI.CacheControlPolicySpec{
+				Expires: &ngfAPI.CacheExpires{
+					Type:     ngfAPI.CacheExpiresDuration,
+					Duration: helpers.GetPointer[ngfAPI.Duration]("30m"),
+				},
+				CacheControl: []ngfAPI.CacheControlDirective{"public"},
+				Always:       helpers.GetPointer(false),
+			},
+			expStrings: []string{
+				"proxy_hide_header Expires;",
+				"proxy_hide_header Cache-Control;",
+				"expires 30m;",
+				`add_header Cache-Control "public";`,
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("CacheControlPolicy_test_ccp.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.CacheControlPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "ccp", Namespace: "test"},
+				Spec:       test.spec,
+			}
+
+			generator := cachecontrol.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.ExternalLocationType},
+			)
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.RedirectLocationType},
+			)
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := cachecontrol.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,134 @@
+package cachecontrol
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+const (
+	directiveFmt    = `[A-Za-z][A-Za-z0-9-]*(=[A-Za-z0-9-]+)?`
+	directiveErrMsg = "must be a name optionally followed by '=' and a value, " +
+		"containing only alphanumeric characters or '-'"
+)
+
+var directiveFmtRegexp = regexp.MustCompile("^" + directiveFmt + "$")
+
+// Validator validates a CacheControlPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a CacheControlPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	ccp := helpers.MustCastObject[*ngfAPI.CacheControlPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range ccp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(ccp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two CacheControlPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.CacheControlPolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.CacheControlPolicy](polB)
+
+	if a.Spec.Expires != nil && b.Spec.Expires != nil {
+		return true
+	}
+
+	return len(a.Spec.CacheControl) > 0 && len(b.Spec.CacheControl) > 0
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.CacheControlPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Expires != nil {
+		allErrs = append(allErrs, v.validateExpires(*spec.Expires, fieldPath.Child("expires"))...)
+	}
+
+	for i, d := range spec.CacheControl {
+		if !directiveFmtRegexp.MatchString(string(d)) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("cacheControl").Index(i),
+				d,
+				fmt.Sprintf("%s (regex used for validation is '%s')", directiveErrMsg, directiveFmt),
+			))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateExpires(expires ngfAPI.CacheExpires, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	durationPath := fieldPath.Child("duration")
+
+	switch expires.Type {
+	case ngfAPI.CacheExpiresDuration:
+		if expires.Duration == nil {
+			return append(allErrs, field.Required(durationPath, "duration is required when type is Duration"))
+		}
+
+		duration := string(*expires.Duration)
+
+		if err := v.genericValidator.ValidateNginxDuration(duration); err != nil {
+			allErrs = append(allErrs, field.Invalid(durationPath, duration, err.Error()))
+		} else if strings.HasSuffix(duration, "ms") {
+			allErrs = append(allErrs, field.Invalid(
+				durationPath,
+				duration,
+				"must be specified in seconds, minutes, or hours",
+			))
+		}
+	case ngfAPI.CacheExpiresEpoch, ngfAPI.CacheExpiresMax:
+		if expires.Duration != nil {
+			allErrs = append(allErrs, field.Forbidden(durationPath, "duration can only be set when type is Duration"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			fieldPath.Child("type"),
+			expires.Type,
+			[]string{
+				string(ngfAPI.CacheExpiresDuration),
+				string(ngfAPI.CacheExpiresEpoch),
+				string(ngfAPI.CacheExpiresMax),
+			},
+		))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,269 @@
+package cachecontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/cachecontrol"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy
+
+func createValidPolicy() *ngfAPI.CacheControlPolicy {
+	return &ngfAPI.CacheControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.CacheControlPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Expires: &ngfAPI.CacheExpires{
+				Type:     ngfAPI.CacheExpiresDuration,
+				Duration: helpers.GetPointer[ngfAPI.Duration]("1h"),
+			},
+			CacheControl: []ngfAPI.CacheControlDirective{"public", "stale-while-revalidate=60"},
+			Always:       helpers.GetPointer(true),
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.CacheControlPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.CacheControlPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid expires duration",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires.Duration = helpers.GetPointer[ngfAPI.Duration]("1d")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.expires.duration: Invalid value: \"1d\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
+		{
+			name: "expires duration in milliseconds",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires.Duration = helpers.GetPointer[ngfAPI.Duration]("500ms")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.expires.duration: Invalid value: \"500ms\": " +
+					"must be specified in seconds, minutes, or hours"),
+			},
+		},
+		{
+			name: "expires duration not set",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires.Duration = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.expires.duration: Required value: " +
+					"duration is required when type is Duration"),
+			},
+		},
+		{
+			name: "expires duration set for epoch",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires.Type = ngfAPI.CacheExpiresEpoch
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.expires.duration: Forbidden: " +
+					"duration can only be set when type is Duration"),
+			},
+		},
+		{
+			name: "invalid expires type",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires.Type = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.expires.type: Unsupported value: \"invalid\": " +
+					"supported values: \"Duration\", \"Epoch\", \"Max\""),
+			},
+		},
+		{
+			name: "invalid cache control directive",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.CacheControl[1] = `private="set-cookie"`
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.cacheControl[1]: Invalid value: \"private=\\\"set-cookie\\\"\": " +
+					"must be a name optionally followed by '=' and a value, containing only alphanumeric " +
+					"characters or '-' (regex used for validation is '[A-Za-z][A-Za-z0-9-]*(=[A-Za-z0-9-]+)?')"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid max expires without cache control",
+			policy: createModifiedPolicy(func(p *ngfAPI.CacheControlPolicy) *ngfAPI.CacheControlPolicy {
+				p.Spec.Expires = &ngfAPI.CacheExpires{Type: ngfAPI.CacheExpiresMax}
+				p.Spec.CacheControl = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := cachecontrol.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := cachecontrol.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	expires := &ngfAPI.CacheExpires{Type: ngfAPI.CacheExpiresEpoch}
+	cacheControl := []ngfAPI.CacheControlDirective{"no-store"}
+
+	tests := []struct {
+		polA      *ngfAPI.CacheControlPolicy
+		polB      *ngfAPI.CacheControlPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					Expires: expires,
+				},
+			},
+			polB: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					CacheControl: cacheControl,
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "expires conflicts",
+			polA: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					Expires: expires,
+				},
+			},
+			polB: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					Expires:      expires,
+					CacheControl: cacheControl,
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "cache control conflicts",
+			polA: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					CacheControl: cacheControl,
+				},
+			},
+			polB: &ngfAPI.CacheControlPolicy{
+				Spec: ngfAPI.CacheControlPolicySpec{
+					CacheControl: cacheControl,
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := cachecontrol.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := cachecontrol.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -36,8 +36,34 @@ keepalive_timeout {{ .KeepAlive.Timeout.Server }};
         {{- end }}
     {{- end }}
 {{- end }}
+{{- if .IgnoreClientAbort }}
+proxy_ignore_client_abort {{ .IgnoreClientAbort }};
+{{- end }}
+{{- if .Buffering }}
+	{{- if .ProxyBuffering }}
+proxy_buffering {{ .ProxyBuffering }};
+	{{- end }}
+	{{- if .Buffering.BufferSize }}
+proxy_buffer_size {{ .Buffering.BufferSize }};
+	{{- end }}
+	{{- if .Buffering.Buffers }}
+proxy_buffers {{ .Buffering.Buffers.Number }} {{ .Buffering.Buffers.Size }};
+	{{- end }}
+	{{- if .Buffering.BusyBuffersSize }}
+proxy_busy_buffers_size {{ .Buffering.BusyBuffersSize }};
+	{{- end }}
+{{- end }}
 `
 
+// templateData is the data of the client settings template.
+type templateData struct {
+	// IgnoreClientAbort is the value of the proxy_ignore_client_abort directive. Empty if not set.
+	IgnoreClientAbort string
+	// ProxyBuffering is the value of the proxy_buffering directive. Empty if not set.
+	ProxyBuffering string
+	ngfAPI.ClientSettingsPolicySpec
+}
+
 // Generator generates nginx configuration based on a clientsettings policy.
 type Generator struct{}
 
@@ -72,9 +98,30 @@ func generate(pols []policies.Policy) policies.GenerateResultFiles {
 
 		files = append(files, policies.File{
 			Name:    fmt.Sprintf("ClientSettingsPolicy_%s_%s.conf", csp.Namespace, csp.Name),
-			Content: helpers.MustExecuteTemplate(tmpl, csp.Spec),
+			Content: helpers.MustExecuteTemplate(tmpl, newTemplateData(csp.Spec)),
 		})
 	}
 
 	return files
 }
+
+func newTemplateData(spec ngfAPI.ClientSettingsPolicySpec) templateData {
+	data := templateData{ClientSettingsPolicySpec: spec}
+
+	if spec.Disconnect != nil && spec.Disconnect.PropagateAbort != nil {
+		// propagating the abort of the client to the backend means not ignoring it
+		data.IgnoreClientAbort = "on"
+		if *spec.Disconnect.PropagateAbort {
+			data.IgnoreClientAbort = "off"
+		}
+	}
+
+	if spec.Buffering != nil && spec.Buffering.Enable != nil {
+		data.ProxyBuffering = "off"
+		if *spec.Buffering.Enable {
+			data.ProxyBuffering = "on"
+		}
+	}
+
+	return data
+}
@@ -123,6 +123,34 @@ func TestGenerate(t *testing.T) {
 			},
 			expStrings: []string{}, // header timeout is ignored if server timeout is not populated
 		},
+		{
+			name: "disconnect propagate abort disabled",
+			policy: &ngfAPIv1alpha1.ClientSettingsPolicy{
+				Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+					Disconnect: &ngfAPIv1alpha1.ClientDisconnect{
+						PropagateAbort: helpers.GetPointer(false),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_ignore_client_abort on;",
+			},
+		},
+		{
+			name: "buffering disabled",
+			policy: &ngfAPIv1alpha1.ClientSettingsPolicy{
+				Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Enable:     helpers.GetPointer(false),
+						BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffering off;",
+				"proxy_buffer_size 16k;",
+			},
+		},
 		{
 			name: "all fields populated",
 			policy: &ngfAPIv1alpha1.ClientSettingsPolicy{
@@ -139,6 +167,18 @@ func TestGenerate(t *testing.T) {
 							Header: keepaliveHeaderTimeout,
 						},
 					},
+					Disconnect: &ngfAPIv1alpha1.ClientDisconnect{
+						PropagateAbort: helpers.GetPointer(true),
+					},
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Enable:     helpers.GetPointer(true),
+						BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("8k"),
+						Buffers: &ngfAPIv1alpha1.ProxyBuffers{
+							Number: 16,
+							Size:   "8k",
+						},
+						BusyBuffersSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("32k"),
+					},
 				},
 			},
 			expStrings: []string{
@@ -147,6 +187,11 @@ func TestGenerate(t *testing.T) {
 				"keepalive_requests 900;",
 				"keepalive_time 50s;",
 				"keepalive_timeout 30s 60s;",
+				"proxy_ignore_client_abort off;",
+				"proxy_buffering on;",
+				"proxy_buffer_size 8k;",
+				"proxy_buffers 16 8k;",
+				"proxy_busy_buffers_size 32k;",
 			},
 		},
 	}
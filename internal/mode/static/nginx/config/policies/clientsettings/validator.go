@@ -1,6 +1,9 @@
 package clientsettings
 
 import (
+	"strconv"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -40,6 +43,18 @@ func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings)
 		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
 	}
 
+	if err := validateDisconnect(csp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := validateBufferingTarget(csp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := validateStreamTarget(csp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
 	return nil
 }
 
@@ -76,9 +91,51 @@ func conflicts(a, b ngfAPI.ClientSettingsPolicySpec) bool {
 		}
 	}
 
+	if a.Disconnect != nil && b.Disconnect != nil {
+		if a.Disconnect.PropagateAbort != nil && b.Disconnect.PropagateAbort != nil {
+			return true
+		}
+	}
+
+	if a.Stream != nil && b.Stream != nil && streamConflicts(*a.Stream, *b.Stream) {
+		return true
+	}
+
+	if a.Buffering != nil && b.Buffering != nil {
+		return bufferingConflicts(*a.Buffering, *b.Buffering)
+	}
+
 	return false
 }
 
+func streamConflicts(a, b ngfAPI.ClientStream) bool {
+	if a.ConnectTimeout != nil && b.ConnectTimeout != nil {
+		return true
+	}
+
+	if a.Timeout != nil && b.Timeout != nil {
+		return true
+	}
+
+	return a.PrereadTimeout != nil && b.PrereadTimeout != nil
+}
+
+func bufferingConflicts(a, b ngfAPI.ProxyBuffering) bool {
+	if a.Enable != nil && b.Enable != nil {
+		return true
+	}
+
+	if a.BufferSize != nil && b.BufferSize != nil {
+		return true
+	}
+
+	if a.Buffers != nil && b.Buffers != nil {
+		return true
+	}
+
+	return a.BusyBuffersSize != nil && b.BusyBuffersSize != nil
+}
+
 // validateSettings performs validation on fields in the spec that are vulnerable to code injection.
 // For all other fields, we rely on the CRD validation.
 func (v *Validator) validateSettings(spec ngfAPI.ClientSettingsPolicySpec) error {
@@ -93,9 +150,49 @@ func (v *Validator) validateSettings(spec ngfAPI.ClientSettingsPolicySpec) error
 		allErrs = append(allErrs, v.validateClientKeepAlive(*spec.KeepAlive, fieldPath.Child("keepAlive"))...)
 	}
 
+	if spec.Buffering != nil {
+		allErrs = append(allErrs, v.validateBuffering(*spec.Buffering, fieldPath.Child("buffering"))...)
+	}
+
+	if spec.Stream != nil {
+		allErrs = append(allErrs, v.validateStream(*spec.Stream, fieldPath.Child("stream"))...)
+	}
+
 	return allErrs.ToAggregate()
 }
 
+// validateDisconnect validates that the disconnect settings are supported by the target of the policy.
+// NGINX always propagates the cancellation of the gRPC calls to the backends, and the propagation can't be
+// configured, so the disconnect settings can't be set for GRPCRoutes.
+func validateDisconnect(spec ngfAPI.ClientSettingsPolicySpec) error {
+	if spec.Disconnect != nil && spec.TargetRef.Kind == kinds.GRPCRoute {
+		return field.Forbidden(field.NewPath("spec").Child("disconnect"), "cannot be set for a GRPCRoute")
+	}
+
+	return nil
+}
+
+// validateBufferingTarget validates that the buffering settings are supported by the target of the policy.
+// NGINX doesn't buffer the responses of the gRPC calls, so the buffering can't be configured for GRPCRoutes.
+func validateBufferingTarget(spec ngfAPI.ClientSettingsPolicySpec) error {
+	if spec.Buffering != nil && spec.TargetRef.Kind == kinds.GRPCRoute {
+		return field.Forbidden(field.NewPath("spec").Child("buffering"), "cannot be set for a GRPCRoute")
+	}
+
+	return nil
+}
+
+// validateStreamTarget validates that the stream settings are supported by the target of the policy.
+// The stream servers of the TLS passthrough, TCP, and UDP Listeners belong to the Gateway, so the stream settings
+// can only be set for a Gateway.
+func validateStreamTarget(spec ngfAPI.ClientSettingsPolicySpec) error {
+	if spec.Stream != nil && spec.TargetRef.Kind != kinds.Gateway {
+		return field.Forbidden(field.NewPath("spec").Child("stream"), "can only be set for a Gateway")
+	}
+
+	return nil
+}
+
 func (v *Validator) validateClientBody(body ngfAPI.ClientBody, fieldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	if body.Timeout != nil {
@@ -172,3 +269,143 @@ func (v *Validator) validateClientKeepAlive(keepAlive ngfAPI.ClientKeepAlive, fi
 
 	return allErrs
 }
+
+func (v *Validator) validateBuffering(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if buffering.BufferSize != nil {
+		allErrs = append(allErrs, v.validateSize(*buffering.BufferSize, fieldPath.Child("bufferSize"))...)
+	}
+
+	if buffering.Buffers != nil {
+		allErrs = append(allErrs, v.validateSize(buffering.Buffers.Size, fieldPath.Child("buffers").Child("size"))...)
+	}
+
+	if buffering.BusyBuffersSize != nil {
+		allErrs = append(allErrs, v.validateSize(*buffering.BusyBuffersSize, fieldPath.Child("busyBuffersSize"))...)
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return validateBufferSizes(buffering, fieldPath)
+}
+
+func (v *Validator) validateStream(stream ngfAPI.ClientStream, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	durations := []struct {
+		duration *ngfAPI.Duration
+		name     string
+	}{
+		{duration: stream.ConnectTimeout, name: "connectTimeout"},
+		{duration: stream.Timeout, name: "timeout"},
+		{duration: stream.PrereadTimeout, name: "prereadTimeout"},
+	}
+
+	for _, d := range durations {
+		if d.duration == nil {
+			continue
+		}
+
+		if err := v.genericValidator.ValidateNginxDuration(string(*d.duration)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child(d.name), *d.duration, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func (v *Validator) validateSize(size ngfAPI.Size, fieldPath *field.Path) field.ErrorList {
+	if err := v.genericValidator.ValidateNginxSize(string(size)); err != nil {
+		return field.ErrorList{field.Invalid(fieldPath, size, err.Error())}
+	}
+
+	return nil
+}
+
+// validateBufferSizes validates the relations between the buffer sizes that NGINX requires, so that an invalid
+// combination doesn't fail the reload of NGINX. Only the sizes in the same policy are validated.
+func validateBufferSizes(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	bufferSize, _ := sizeInBytes(buffering.BufferSize)
+	busySize, busySizeSet := sizeInBytes(buffering.BusyBuffersSize)
+
+	var buffersSize, buffersNumber int64
+	if buffering.Buffers != nil {
+		buffersSize, _ = sizeInBytes(&buffering.Buffers.Size)
+		buffersNumber = int64(buffering.Buffers.Number)
+	}
+
+	busyPath := fieldPath.Child("busyBuffersSize")
+
+	if busySizeSet && busySize < max(bufferSize, buffersSize) {
+		allErrs = append(allErrs, field.Invalid(
+			busyPath,
+			*buffering.BusyBuffersSize,
+			"must be at least the size of bufferSize and of one of buffers",
+		))
+	}
+
+	if buffering.Buffers == nil {
+		return allErrs
+	}
+
+	if !busySizeSet {
+		// NGINX uses twice the largest of the buffer size and the size of one of the buffers by default.
+		// The default buffer size depends on the platform, so it is only accounted for if it is set.
+		busySize = 2 * max(bufferSize, buffersSize)
+	}
+
+	if busySize >= (buffersNumber-1)*buffersSize {
+		if busySizeSet {
+			allErrs = append(allErrs, field.Invalid(
+				busyPath,
+				*buffering.BusyBuffersSize,
+				"must be less than the total size of buffers minus one buffer",
+			))
+		} else {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("buffers").Child("number"),
+				buffering.Buffers.Number,
+				"the total size of buffers minus one buffer must be greater than the default busyBuffersSize, "+
+					"which is twice the size of bufferSize or of one of buffers, whichever is larger",
+			))
+		}
+	}
+
+	return allErrs
+}
+
+// sizeInBytes returns the size in bytes, and false if the size is not set.
+// The size must be validated with ValidateNginxSize.
+func sizeInBytes(size *ngfAPI.Size) (int64, bool) {
+	if size == nil {
+		return 0, false
+	}
+
+	s := string(*size)
+	multiplier := int64(1)
+
+	switch {
+	case strings.HasSuffix(s, "k"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(s, "m"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(s, "g"):
+		multiplier = 1 << 30
+	}
+
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value * multiplier, true
+}
@@ -43,6 +43,9 @@ func createValidPolicy() *ngfAPI.ClientSettingsPolicy {
 					Header: helpers.GetPointer[ngfAPI.Duration]("60s"),
 				},
 			},
+			Disconnect: &ngfAPI.ClientDisconnect{
+				PropagateAbort: helpers.GetPointer(false),
+			},
 		},
 		Status: v1alpha2.PolicyStatus{},
 	}
@@ -129,11 +132,171 @@ func TestValidator_Validate(t *testing.T) {
 					"server timeout must be set if header timeout is set"),
 			},
 		},
+		{
+			name: "invalid disconnect; propagate abort disabled for GRPCRoute",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.disconnect: Forbidden: cannot be set for a GRPCRoute"),
+			},
+		},
+		{
+			name: "invalid disconnect; propagate abort enabled for GRPCRoute",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				p.Spec.Disconnect.PropagateAbort = helpers.GetPointer(true)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.disconnect: Forbidden: cannot be set for a GRPCRoute"),
+			},
+		},
+		{
+			name: "invalid buffering sizes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					BufferSize: helpers.GetPointer[ngfAPI.Size]("invalid"),
+					Buffers:    &ngfAPI.ProxyBuffers{Number: 8, Size: "8K"},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.buffering.bufferSize: Invalid value: \"invalid\": " +
+					"^\\d{1,4}(k|m|g)?$ (e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is " +
+					"'must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+					"spec.buffering.buffers.size: Invalid value: \"8K\": ^\\d{1,4}(k|m|g)?$ (e.g. '1024',  or '8k',  " +
+					"or '20m',  or '1g', regex used for validation is 'must contain a number. May be followed by " +
+					"'k', 'm', or 'g', otherwise bytes are assumed')]"),
+			},
+		},
+		{
+			name: "invalid buffering; busy buffers size less than buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					BufferSize:      helpers.GetPointer[ngfAPI.Size]("16k"),
+					Buffers:         &ngfAPI.ProxyBuffers{Number: 8, Size: "4k"},
+					BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"8k\": " +
+					"must be at least the size of bufferSize and of one of buffers"),
+			},
+		},
+		{
+			name: "invalid buffering; busy buffers size not less than buffers minus one buffer",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					Buffers:         &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"},
+					BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("24k"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"24k\": " +
+					"must be less than the total size of buffers minus one buffer"),
+			},
+		},
+		{
+			name: "invalid buffering; too few buffers for default busy buffers size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					Buffers: &ngfAPI.ProxyBuffers{Number: 3, Size: "1m"},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.buffers.number: Invalid value: 3: " +
+					"the total size of buffers minus one buffer must be greater than the default busyBuffersSize, " +
+					"which is twice the size of bufferSize or of one of buffers, whichever is larger"),
+			},
+		},
+		{
+			name: "invalid buffering; GRPCRoute",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				p.Spec.Disconnect = nil
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{Enable: helpers.GetPointer(false)}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering: Forbidden: cannot be set for a GRPCRoute"),
+			},
+		},
+		{
+			name: "invalid stream durations",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Stream = &ngfAPI.ClientStream{
+					ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+					Timeout:        helpers.GetPointer[ngfAPI.Duration]("10m"),
+					PrereadTimeout: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.stream.connectTimeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.stream.prereadTimeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name: "invalid stream; HTTPRoute",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.Stream = &ngfAPI.ClientStream{Timeout: helpers.GetPointer[ngfAPI.Duration]("10m")}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.stream: Forbidden: can only be set for a Gateway"),
+			},
+		},
 		{
 			name:          "valid",
 			policy:        createValidPolicy(),
 			expConditions: nil,
 		},
+		{
+			name: "valid stream",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Stream = &ngfAPI.ClientStream{
+					ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					Timeout:        helpers.GetPointer[ngfAPI.Duration]("10m"),
+					PrereadTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid buffering",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					Enable:          helpers.GetPointer(true),
+					BufferSize:      helpers.GetPointer[ngfAPI.Size]("16k"),
+					Buffers:         &ngfAPI.ProxyBuffers{Number: 8, Size: "8k"},
+					BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid buffering; default busy buffers size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					Buffers: &ngfAPI.ProxyBuffers{Number: 4, Size: "1m"},
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
 	}
 
 	v := clientsettings.NewValidator(validation.GenericValidator{})
@@ -260,6 +423,91 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "disconnect propagate abort conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Disconnect: &ngfAPI.ClientDisconnect{
+						PropagateAbort: helpers.GetPointer(true),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffering no conflicts",
+			polA: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Enable: helpers.GetPointer(false),
+					},
+				},
+			},
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						BufferSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "buffering buffers conflicts",
+			polA: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers: &ngfAPI.ProxyBuffers{Number: 8, Size: "8k"},
+					},
+				},
+			},
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers: &ngfAPI.ProxyBuffers{Number: 16, Size: "4k"},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "stream no conflicts",
+			polA: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Stream: &ngfAPI.ClientStream{
+						ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			},
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Stream: &ngfAPI.ClientStream{
+						Timeout:        helpers.GetPointer[ngfAPI.Duration]("10m"),
+						PrereadTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "stream timeout conflicts",
+			polA: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Stream: &ngfAPI.ClientStream{
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("10m"),
+					},
+				},
+			},
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Stream: &ngfAPI.ClientStream{
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("1h"),
+					},
+				},
+			},
+			conflicts: true,
+		},
 	}
 
 	v := clientsettings.NewValidator(nil)
@@ -0,0 +1,84 @@
+package connectionlimit
+
+import (
+	"fmt"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var tmpl = template.Must(template.New("connection limit policy").Parse(connectionLimitTemplate))
+
+const connectionLimitTemplate = `
+limit_conn {{ .Zone }} {{ .MaxConnections }};
+{{- if .RejectCode }}
+limit_conn_status {{ .RejectCode }};
+{{- end }}
+`
+
+// Generator generates nginx configuration based on a connection limit policy.
+// The configuration of the stream servers is generated from the dataplane configuration.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// A location that redirects to an internal location doesn't proxy the request,
+// so the configuration is only generated for the internal location.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		clp, ok := pol.(*ngfAPI.ConnectionLimitPolicy)
+		if !ok {
+			continue
+		}
+
+		var rejectCode int32
+		if clp.Spec.RejectCode != nil {
+			rejectCode = *clp.Spec.RejectCode
+		}
+
+		fields := map[string]any{
+			"Zone": dataplane.ConnectionLimitZoneName(
+				types.NamespacedName{Namespace: clp.Namespace, Name: clp.Name},
+			),
+			"MaxConnections": clp.Spec.MaxConnections,
+			"RejectCode":     rejectCode,
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ConnectionLimitPolicy_%s_%s.conf", clp.Namespace, clp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, fields),
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,114 @@
+package connectionlimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/connectionlimit"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.ConnectionLimitPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name:          "defaults",
+			spec:          ngfAPI.ConnectionLimitPolicySpec{MaxConnections: 10},
+			expStrings:    []string{"limit_conn connlimit_test_clp 10;"},
+			notExpStrings: []string{"limit_conn_status"},
+		},
+		{
+			name: "reject code",
+			spec: ngfAPI.ConnectionLimitPolicySpec{
+				MaxConnections: 100,
+				RejectCode:     helpers.GetPointer[int32](429),
+			},
+			expStrings: []string{
+				"limit_conn connlimit_test_clp 100;",
+				"limit_conn_status 429;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("ConnectionLimitPolicy_test_clp.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ConnectionLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "clp", Namespace: "test"},
+				Spec:       test.spec,
+			}
+
+			generator := connectionlimit.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.ExternalLocationType},
+			)
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.RedirectLocationType},
+			)
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := connectionlimit.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,79 @@
+package connectionlimit
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a ConnectionLimitPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ConnectionLimitPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	clp := helpers.MustCastObject[*ngfAPI.ConnectionLimitPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute, kinds.TLSRoute, kinds.TCPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range clp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(clp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ConnectionLimitPolicies conflict.
+// A server or location can only use the limit of one policy, so any two ConnectionLimitPolicies conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ConnectionLimitPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Key != nil {
+		switch *spec.Key {
+		case ngfAPI.ConnectionLimitKeyClientIP, ngfAPI.ConnectionLimitKeyServer:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				fieldPath.Child("key"),
+				*spec.Key,
+				[]string{string(ngfAPI.ConnectionLimitKeyClientIP), string(ngfAPI.ConnectionLimitKeyServer)},
+			))
+		}
+	}
+
+	if spec.ZoneSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*spec.ZoneSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("zoneSize"), *spec.ZoneSize, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,158 @@
+package connectionlimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/connectionlimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy
+
+func createValidPolicy() *ngfAPI.ConnectionLimitPolicy {
+	return &ngfAPI.ConnectionLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ConnectionLimitPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.TLSRoute,
+					Name:  "route",
+				},
+			},
+			Key:            helpers.GetPointer(ngfAPI.ConnectionLimitKeyServer),
+			ZoneSize:       helpers.GetPointer[ngfAPI.Size]("10m"),
+			RejectCode:     helpers.GetPointer[int32](429),
+			MaxConnections: 10,
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ConnectionLimitPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ConnectionLimitPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.TargetRefs[1].Kind = "UDPRoute"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"UDPRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\", \"TLSRoute\", \"TCPRoute\""),
+			},
+		},
+		{
+			name: "unsupported key",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec.Key = helpers.GetPointer[ngfAPI.ConnectionLimitKey]("Header")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key: Unsupported value: \"Header\": " +
+					"supported values: \"ClientIP\", \"Server\""),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid without optional fields",
+			policy: createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+				p.Spec = ngfAPI.ConnectionLimitPolicySpec{TargetRefs: p.Spec.TargetRefs, MaxConnections: 1}
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidateZoneSize(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+	policy := createModifiedPolicy(func(p *ngfAPI.ConnectionLimitPolicy) *ngfAPI.ConnectionLimitPolicy {
+		p.Spec.ZoneSize = helpers.GetPointer[ngfAPI.Size]("10mb")
+		return p
+	})
+
+	conds := v.Validate(policy, nil)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.zoneSize: Invalid value: \"10mb\""))
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := connectionlimit.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.Conflicts(&ngfAPI.ConnectionLimitPolicy{}, &ngfAPI.ConnectionLimitPolicy{})).To(BeTrue())
+}
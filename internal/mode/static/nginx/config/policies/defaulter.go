@@ -0,0 +1,44 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Defaulter sets the default values of an NGF Policy.
+//
+// The same Defaulter is used by the defaulting webhook, when a Policy is admitted, and by the Graph,
+// when a Policy is processed. This way, Policies that were created before the webhook was enabled get
+// the same defaults, and the defaults are only defined in one place.
+//
+//counterfeiter:generate . Defaulter
+type Defaulter interface {
+	// Default sets the default values of the unset fields of the Policy. It modifies the Policy in place.
+	Default(policy Policy)
+}
+
+// admissionDefaulter adapts a Defaulter to the admission.CustomDefaulter interface of controller-runtime.
+type admissionDefaulter struct {
+	defaulter Defaulter
+}
+
+// NewAdmissionDefaulter returns an admission.CustomDefaulter that applies the Defaulter to the Policies
+// received by the defaulting webhook.
+func NewAdmissionDefaulter(defaulter Defaulter) admission.CustomDefaulter {
+	return admissionDefaulter{defaulter: defaulter}
+}
+
+// Default sets the default values of the Policy.
+func (d admissionDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	policy, ok := obj.(Policy)
+	if !ok {
+		return fmt.Errorf("expected a Policy but got %T", obj)
+	}
+
+	d.defaulter.Default(policy)
+
+	return nil
+}
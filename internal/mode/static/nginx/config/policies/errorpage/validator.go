@@ -0,0 +1,171 @@
+package errorpage
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	contentTypeFmt    = `[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*`
+	contentTypeErrMsg = "must be a media type in the format 'type/subtype', for example, 'text/html'"
+
+	// the characters that can't be escaped in the NGINX configuration, or that break out of a quoted value.
+	invalidURLChars = "$\"\\"
+)
+
+var contentTypeFmtRegexp = regexp.MustCompile("^" + contentTypeFmt + "$")
+
+var redirectStatusCodes = []int32{301, 302, 303, 307, 308}
+
+// Validator validates an ErrorPagePolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an ErrorPagePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range epp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(epp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ErrorPagePolicies conflict.
+// The error pages of a Route are configured by a single policy, so two policies always conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection
+// or would result in an invalid NGINX configuration. For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.ErrorPagePolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("errorPages")
+
+	codes := make(map[ngfAPI.ErrorPageStatusCode]struct{})
+
+	for i, page := range spec.ErrorPages {
+		pagePath := fieldPath.Index(i)
+
+		for j, code := range page.Codes {
+			codePath := pagePath.Child("codes").Index(j)
+
+			if code < 300 || code > 599 {
+				allErrs = append(allErrs, field.Invalid(codePath, code, "must be between 300 and 599"))
+				continue
+			}
+
+			if _, exists := codes[code]; exists {
+				allErrs = append(allErrs, field.Duplicate(codePath, code))
+				continue
+			}
+
+			codes[code] = struct{}{}
+		}
+
+		switch {
+		case page.Response != nil && page.Redirect != nil:
+			allErrs = append(allErrs, field.Forbidden(pagePath, "only one of response or redirect can be set"))
+		case page.Response != nil:
+			allErrs = append(allErrs, validateResponse(*page.Response, pagePath.Child("response"))...)
+		case page.Redirect != nil:
+			allErrs = append(allErrs, validateRedirect(*page.Redirect, pagePath.Child("redirect"))...)
+		default:
+			allErrs = append(allErrs, field.Required(pagePath, "one of response or redirect must be set"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateResponse(response ngfAPI.ErrorPageResponse, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if response.StatusCode != nil && (*response.StatusCode < 200 || *response.StatusCode > 599) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("statusCode"),
+			*response.StatusCode,
+			"must be between 200 and 599",
+		))
+	}
+
+	if response.ContentType != nil && !contentTypeFmtRegexp.MatchString(*response.ContentType) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("contentType"),
+			*response.ContentType,
+			fmt.Sprintf("%s (regex used for validation is '%s')", contentTypeErrMsg, contentTypeFmt),
+		))
+	}
+
+	if strings.Contains(response.Body, "$") {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("body"),
+			response.Body,
+			"must not contain the '$' character",
+		))
+	}
+
+	return allErrs
+}
+
+func validateRedirect(redirect ngfAPI.ErrorPageRedirect, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if redirect.StatusCode != nil && !slices.Contains(redirectStatusCodes, *redirect.StatusCode) {
+		allErrs = append(allErrs, field.NotSupported(
+			fieldPath.Child("statusCode"),
+			*redirect.StatusCode,
+			[]string{"301", "302", "303", "307", "308"},
+		))
+	}
+
+	urlPath := fieldPath.Child("url")
+
+	if strings.ContainsAny(redirect.URL, invalidURLChars) || strings.ContainsFunc(redirect.URL, unicode.IsSpace) {
+		allErrs = append(allErrs, field.Invalid(
+			urlPath,
+			redirect.URL,
+			"must not contain whitespace, '$', '\"', or '\\' characters",
+		))
+
+		return allErrs
+	}
+
+	u, err := url.Parse(redirect.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(urlPath, redirect.URL, "must be an absolute HTTP or HTTPS URL"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,226 @@
+package errorpage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy
+
+func createValidPolicy() *ngfAPI.ErrorPagePolicy {
+	return &ngfAPI.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ErrorPagePolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			ErrorPages: []ngfAPI.ErrorPage{
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{500, 502},
+					Response: &ngfAPI.ErrorPageResponse{
+						StatusCode:  helpers.GetPointer[int32](503),
+						ContentType: helpers.GetPointer("application/problem+json"),
+						Body:        `{"title": "Service Unavailable"}`,
+					},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{404},
+					Redirect: &ngfAPI.ErrorPageRedirect{
+						StatusCode: helpers.GetPointer[int32](301),
+						URL:        "https://errors.example.com/404.html?from=gateway",
+					},
+				},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ErrorPagePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ErrorPagePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid and duplicate codes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Codes = []ngfAPI.ErrorPageStatusCode{200, 502}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.errorPages[1].codes[0]: Invalid value: 200: " +
+					"must be between 300 and 599, spec.errorPages[1].codes[1]: Duplicate value: 502]"),
+			},
+		},
+		{
+			name: "neither response nor redirect",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Response = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[0]: Required value: " +
+					"one of response or redirect must be set"),
+			},
+		},
+		{
+			name: "both response and redirect",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Redirect = p.Spec.ErrorPages[1].Redirect
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[0]: Forbidden: " +
+					"only one of response or redirect can be set"),
+			},
+		},
+		{
+			name: "invalid response",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Response = &ngfAPI.ErrorPageResponse{
+					StatusCode:  helpers.GetPointer[int32](100),
+					ContentType: helpers.GetPointer("text/html; return 200"),
+					Body:        "$host",
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.errorPages[0].response.statusCode: Invalid value: 100: " +
+					"must be between 200 and 599, spec.errorPages[0].response.contentType: Invalid value: " +
+					"\"text/html; return 200\": must be a media type in the format 'type/subtype', for example, " +
+					"'text/html' (regex used for validation is " +
+					"'[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*'), " +
+					"spec.errorPages[0].response.body: Invalid value: \"$host\": " +
+					"must not contain the '$' character]"),
+			},
+		},
+		{
+			name: "invalid redirect status code",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.StatusCode = helpers.GetPointer[int32](300)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[1].redirect.statusCode: Unsupported value: 300: " +
+					"supported values: \"301\", \"302\", \"303\", \"307\", \"308\""),
+			},
+		},
+		{
+			name: "redirect URL with invalid characters",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.URL = `https://example.com/"; return 200 "`
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[1].redirect.url: Invalid value: " +
+					"\"https://example.com/\\\"; return 200 \\\"\": " +
+					"must not contain whitespace, '$', '\"', or '\\' characters"),
+			},
+		},
+		{
+			name: "redirect URL not absolute",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.URL = "/errors/404.html"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[1].redirect.url: Invalid value: " +
+					"\"/errors/404.html\": must be an absolute HTTP or HTTPS URL"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid without optional fields",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Response = &ngfAPI.ErrorPageResponse{Body: "<h1>Something went wrong</h1>"}
+				p.Spec.ErrorPages[1].Redirect.StatusCode = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := errorpage.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := errorpage.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := errorpage.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
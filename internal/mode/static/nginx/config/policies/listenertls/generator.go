@@ -0,0 +1,106 @@
+package listenertls
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+var tmpl = template.Must(template.New("listener tls policy").Parse(listenerTLSTemplate))
+
+const listenerTLSTemplate = `
+{{- if .Protocols }}
+ssl_protocols{{ range .Protocols }} {{ . }}{{ end }};
+{{- end }}
+{{- if .Ciphers }}
+ssl_ciphers {{ .Ciphers }};
+{{- end }}
+{{- if .PreferServerCiphers }}
+ssl_prefer_server_ciphers {{ .PreferServerCiphers }};
+{{- end }}
+`
+
+// tlsSettings holds the values of the directives of a listener TLS policy.
+type tlsSettings struct {
+	Ciphers             string
+	PreferServerCiphers string
+	Protocols           []ngfAPI.TLSProtocolType
+}
+
+// Generator generates nginx configuration based on a listener TLS policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// The configuration is only generated for the SSL servers of the hostnames of the policy.
+func (g Generator) GenerateForServer(pols []policies.Policy, server http.Server) policies.GenerateResultFiles {
+	if server.SSL == nil {
+		return nil
+	}
+
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		ltp, ok := pol.(*ngfAPI.ListenerTLSPolicy)
+		if !ok || !matchesAnyHostname(ltp.Spec.Hostnames, server.ServerName) {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ListenerTLSPolicy_%s_%s.conf", ltp.Namespace, ltp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, getTLSSettings(ltp.Spec)),
+		})
+	}
+
+	return files
+}
+
+func getTLSSettings(spec ngfAPI.ListenerTLSPolicySpec) tlsSettings {
+	settings := tlsSettings{Protocols: spec.Protocols}
+
+	if spec.Ciphers != nil {
+		settings.Ciphers = *spec.Ciphers
+	}
+
+	if spec.PreferServerCiphers != nil {
+		settings.PreferServerCiphers = "off"
+		if *spec.PreferServerCiphers {
+			settings.PreferServerCiphers = "on"
+		}
+	}
+
+	return settings
+}
+
+func matchesAnyHostname[T ~string](hostnames []T, serverName string) bool {
+	for _, h := range hostnames {
+		if hostnameMatches(string(h), serverName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hostnameMatches returns true if the hostname of the policy matches the hostname of a server.
+// A wildcard hostname matches itself and any of its subdomains, including the wildcard ones.
+func hostnameMatches(policyHostname, serverHostname string) bool {
+	if policyHostname == serverHostname {
+		return true
+	}
+
+	suffix, wildcard := strings.CutPrefix(policyHostname, "*")
+
+	return wildcard && strings.HasSuffix(serverHostname, suffix)
+}
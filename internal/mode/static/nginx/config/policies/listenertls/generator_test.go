@@ -0,0 +1,155 @@
+package listenertls_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/listenertls"
+)
+
+func TestGenerateForServer(t *testing.T) {
+	t.Parallel()
+
+	policy := &ngfAPI.ListenerTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "ltp", Namespace: "test"},
+		Spec: ngfAPI.ListenerTLSPolicySpec{
+			Hostnames: []gatewayv1.Hostname{"admin.example.com", "*.internal.example.com"},
+			Protocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		server     http.Server
+		expApplied bool
+	}{
+		{
+			name:       "exact hostname",
+			server:     http.Server{ServerName: "admin.example.com", SSL: &http.SSL{}},
+			expApplied: true,
+		},
+		{
+			name:       "wildcard hostname",
+			server:     http.Server{ServerName: "*.internal.example.com", SSL: &http.SSL{}},
+			expApplied: true,
+		},
+		{
+			name:       "subdomain of wildcard hostname",
+			server:     http.Server{ServerName: "api.internal.example.com", SSL: &http.SSL{}},
+			expApplied: true,
+		},
+		{
+			name:   "other hostname",
+			server: http.Server{ServerName: "cafe.example.com", SSL: &http.SSL{}},
+		},
+		{
+			name:   "parent of wildcard hostname",
+			server: http.Server{ServerName: "internal.example.com", SSL: &http.SSL{}},
+		},
+		{
+			name:   "non-SSL server",
+			server: http.Server{ServerName: "admin.example.com"},
+		},
+	}
+
+	generator := listenertls.NewGenerator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, test.server)
+
+			if !test.expApplied {
+				g.Expect(resFiles).To(BeEmpty())
+				return
+			}
+
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("ListenerTLSPolicy_test_ltp.conf"))
+			g.Expect(string(resFiles[0].Content)).To(Equal("\nssl_protocols TLSv1.3;\n"))
+		})
+	}
+}
+
+func TestGenerateForServer_Settings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expContent string
+		spec       ngfAPI.ListenerTLSPolicySpec
+	}{
+		{
+			name: "all settings",
+			spec: ngfAPI.ListenerTLSPolicySpec{
+				Protocols:           []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv12, ngfAPI.TLSProtocolTLSv13},
+				Ciphers:             helpers.GetPointer("ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384"),
+				PreferServerCiphers: helpers.GetPointer(true),
+			},
+			expContent: `
+ssl_protocols TLSv1.2 TLSv1.3;
+ssl_ciphers ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384;
+ssl_prefer_server_ciphers on;
+`,
+		},
+		{
+			name: "only ciphers",
+			spec: ngfAPI.ListenerTLSPolicySpec{
+				Ciphers:             helpers.GetPointer("HIGH:!aNULL"),
+				PreferServerCiphers: helpers.GetPointer(false),
+			},
+			expContent: `
+ssl_ciphers HIGH:!aNULL;
+ssl_prefer_server_ciphers off;
+`,
+		},
+	}
+
+	generator := listenertls.NewGenerator()
+	server := http.Server{ServerName: "admin.example.com", SSL: &http.SSL{}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			spec := test.spec
+			spec.Hostnames = []gatewayv1.Hostname{"admin.example.com"}
+
+			policy := &ngfAPI.ListenerTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "ltp", Namespace: "test"},
+				Spec:       spec,
+			}
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, server)
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := listenertls.NewGenerator()
+	server := http.Server{ServerName: "admin.example.com", SSL: &http.SSL{}}
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, server)
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, server)
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ListenerTLSPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,123 @@
+package listenertls
+
+import (
+	"slices"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// defaultTLSProtocols are the TLS protocols of the TLS profile if the NginxProxy doesn't specify them.
+var defaultTLSProtocols = []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv12, ngfAPI.TLSProtocolTLSv13}
+
+// Validator validates a ListenerTLSPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ListenerTLSPolicy.
+func (v *Validator) Validate(policy policies.Policy, globalSettings *policies.GlobalSettings) []conditions.Condition {
+	ltp := helpers.MustCastObject[*ngfAPI.ListenerTLSPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range ltp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(ltp.Spec, getProfileProtocols(globalSettings)); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ListenerTLSPolicies conflict.
+// The policies conflict if a server can match the hostnames of both policies.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.ListenerTLSPolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.ListenerTLSPolicy](polB)
+
+	for _, hostnameA := range a.Spec.Hostnames {
+		for _, hostnameB := range b.Spec.Hostnames {
+			if hostnameMatches(string(hostnameA), string(hostnameB)) ||
+				hostnameMatches(string(hostnameB), string(hostnameA)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// getProfileProtocols returns the TLS protocols of the TLS profile of the NginxProxy.
+// If the NginxProxy is invalid, its TLS profile is not applied, so the default protocols are returned.
+func getProfileProtocols(globalSettings *policies.GlobalSettings) []ngfAPI.TLSProtocolType {
+	if globalSettings == nil || !globalSettings.NginxProxyValid || len(globalSettings.TLSProtocols) == 0 {
+		return defaultTLSProtocols
+	}
+
+	return globalSettings.TLSProtocols
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection,
+// and checks that the protocols are allowed by the TLS profile. For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(
+	spec ngfAPI.ListenerTLSPolicySpec,
+	profileProtocols []ngfAPI.TLSProtocolType,
+) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	for i, hostname := range spec.Hostnames {
+		subdomain := strings.TrimPrefix(string(hostname), "*.")
+
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(subdomain) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("hostnames").Index(i), hostname, msg))
+		}
+	}
+
+	supportedProtocols := []string{string(ngfAPI.TLSProtocolTLSv12), string(ngfAPI.TLSProtocolTLSv13)}
+
+	for i, protocol := range spec.Protocols {
+		protocolPath := fieldPath.Child("protocols").Index(i)
+
+		switch {
+		case !slices.Contains(supportedProtocols, string(protocol)):
+			allErrs = append(allErrs, field.NotSupported(protocolPath, protocol, supportedProtocols))
+		case !slices.Contains(profileProtocols, protocol):
+			allErrs = append(allErrs, field.Forbidden(
+				protocolPath,
+				string(protocol)+" is not allowed by the TLS profile of the NginxProxy",
+			))
+		}
+	}
+
+	if spec.Ciphers != nil {
+		if err := v.genericValidator.ValidateSSLCiphers(*spec.Ciphers); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("ciphers"), *spec.Ciphers, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,257 @@
+package listenertls_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/listenertls"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy
+
+func createValidPolicy() *ngfAPI.ListenerTLSPolicy {
+	return &ngfAPI.ListenerTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ListenerTLSPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Hostnames: []gatewayv1.Hostname{"admin.example.com", "*.internal.example.com"},
+			Protocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ListenerTLSPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		globalSettings *policies.GlobalSettings
+		policy         *ngfAPI.ListenerTLSPolicy
+		name           string
+		expConditions  []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.HTTPRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"HTTPRoute\": " +
+					"supported values: \"Gateway\""),
+			},
+		},
+		{
+			name: "invalid hostname",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Hostnames[1] = "admin.example.com;"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.hostnames[1]: Invalid value: \"admin.example.com;\": " +
+					"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', " +
+					"and must start and end with an alphanumeric character (e.g. 'example.com', regex used for " +
+					"validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		{
+			name: "invalid protocol",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Protocols = []ngfAPI.TLSProtocolType{"TLSv1.1"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.protocols[0]: Unsupported value: \"TLSv1.1\": " +
+					"supported values: \"TLSv1.2\", \"TLSv1.3\""),
+			},
+		},
+		{
+			name: "invalid ciphers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Ciphers = helpers.GetPointer("HIGH; ssl_protocols TLSv1")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.ciphers: Invalid value: \"HIGH; ssl_protocols TLSv1\": " +
+					"must be a list of OpenSSL ciphers or cipher strings separated by ':' (e.g. 'HIGH:!aNULL:!MD5',  " +
+					"or 'ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384', regex used for validation is " +
+					"'[a-zA-Z0-9!+@=_.-]+(:[a-zA-Z0-9!+@=_.-]+)*')"),
+			},
+		},
+		{
+			name: "protocol not allowed by the TLS profile",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Protocols = []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv12, ngfAPI.TLSProtocolTLSv13}
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{
+				NginxProxyValid: true,
+				TLSProtocols:    []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13},
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.protocols[0]: Forbidden: " +
+					"TLSv1.2 is not allowed by the TLS profile of the NginxProxy"),
+			},
+		},
+		{
+			name:   "valid; TLS profile of the NginxProxy",
+			policy: createValidPolicy(),
+			globalSettings: &policies.GlobalSettings{
+				NginxProxyValid: true,
+				TLSProtocols:    []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13},
+			},
+			expConditions: nil,
+		},
+		{
+			name: "valid; TLS profile of an invalid NginxProxy is not applied",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Protocols = []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv12}
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{
+				TLSProtocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13},
+			},
+			expConditions: nil,
+		},
+		{
+			name: "valid; ciphers without protocols",
+			policy: createModifiedPolicy(func(p *ngfAPI.ListenerTLSPolicy) *ngfAPI.ListenerTLSPolicy {
+				p.Spec.Protocols = nil
+				p.Spec.Ciphers = helpers.GetPointer("ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384")
+				p.Spec.PreferServerCiphers = helpers.GetPointer(true)
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid; default TLS profile",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := listenertls.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, test.globalSettings)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := listenertls.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(hostnames ...gatewayv1.Hostname) *ngfAPI.ListenerTLSPolicy {
+		return &ngfAPI.ListenerTLSPolicy{
+			Spec: ngfAPI.ListenerTLSPolicySpec{Hostnames: hostnames},
+		}
+	}
+
+	tests := []struct {
+		polA      *ngfAPI.ListenerTLSPolicy
+		polB      *ngfAPI.ListenerTLSPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name:      "no conflicts",
+			polA:      createPolicy("admin.example.com"),
+			polB:      createPolicy("cafe.example.com", "*.internal.example.com"),
+			conflicts: false,
+		},
+		{
+			name:      "same hostname",
+			polA:      createPolicy("admin.example.com"),
+			polB:      createPolicy("cafe.example.com", "admin.example.com"),
+			conflicts: true,
+		},
+		{
+			name:      "wildcard hostname matches hostname",
+			polA:      createPolicy("admin.example.com"),
+			polB:      createPolicy("*.example.com"),
+			conflicts: true,
+		},
+		{
+			name:      "wildcard hostname matches wildcard hostname",
+			polA:      createPolicy("*.example.com"),
+			polB:      createPolicy("*.internal.example.com"),
+			conflicts: true,
+		},
+	}
+
+	v := listenertls.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := listenertls.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,30 @@
+package observability
+
+import (
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// DefaultTracingRatio is the percentage of requests that are traced when the ratio strategy is used
+// without a ratio.
+const DefaultTracingRatio int32 = 100
+
+// Defaulter sets the default values of an ObservabilityPolicy.
+// Implements policies.Defaulter interface.
+type Defaulter struct{}
+
+// NewDefaulter returns a new Defaulter.
+func NewDefaulter() Defaulter {
+	return Defaulter{}
+}
+
+// Default sets the default values of the unset fields of the ObservabilityPolicy.
+func (d Defaulter) Default(policy policies.Policy) {
+	obs := helpers.MustCastObject[*ngfAPIv1alpha2.ObservabilityPolicy](policy)
+
+	tracing := obs.Spec.Tracing
+	if tracing != nil && tracing.Strategy == ngfAPIv1alpha2.TraceStrategyRatio && tracing.Ratio == nil {
+		tracing.Ratio = helpers.GetPointer(DefaultTracingRatio)
+	}
+}
@@ -0,0 +1,73 @@
+package observability_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+)
+
+func TestDefaulter_Default(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tracing    *ngfAPIv1alpha2.Tracing
+		expTracing *ngfAPIv1alpha2.Tracing
+		name       string
+	}{
+		{
+			name: "no tracing",
+		},
+		{
+			name: "ratio strategy without ratio",
+			tracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+			},
+			expTracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer(observability.DefaultTracingRatio),
+			},
+		},
+		{
+			name: "ratio strategy with ratio",
+			tracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](0),
+			},
+			expTracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+				Ratio:    helpers.GetPointer[int32](0),
+			},
+		},
+		{
+			name: "parent strategy",
+			tracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyParent,
+			},
+			expTracing: &ngfAPIv1alpha2.Tracing{
+				Strategy: ngfAPIv1alpha2.TraceStrategyParent,
+			},
+		},
+	}
+
+	d := observability.NewDefaulter()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					Tracing: test.tracing,
+				},
+			}
+
+			d.Default(policy)
+			g.Expect(policy.Spec.Tracing).To(Equal(test.expTracing))
+		})
+	}
+}
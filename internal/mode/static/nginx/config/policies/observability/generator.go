@@ -147,11 +147,12 @@ func getStrategy(obs *ngfAPIv1alpha2.ObservabilityPolicy) string {
 			strategy = "$otel_parent_sampled"
 		case ngfAPIv1alpha2.TraceStrategyRatio:
 			strategy = "on"
-			if obs.Spec.Tracing.Ratio != nil {
-				if *obs.Spec.Tracing.Ratio > 0 {
-					strategy = dataplane.CreateRatioVarName(*obs.Spec.Tracing.Ratio)
-				} else {
+			if ratio := obs.Spec.Tracing.Ratio; ratio != nil {
+				switch {
+				case *ratio == 0:
 					strategy = "off"
+				case *ratio < DefaultTracingRatio:
+					strategy = dataplane.CreateRatioVarName(*ratio)
 				}
 			}
 		default:
@@ -73,6 +73,26 @@ func TestGenerate(t *testing.T) {
 				"otel_span_name $request_uri_path;",
 			},
 		},
+		{
+			name: "strategy set to full ratio",
+			policy: &ngfAPIv1alpha2.ObservabilityPolicy{
+				Spec: ngfAPIv1alpha2.ObservabilityPolicySpec{
+					Tracing: &ngfAPIv1alpha2.Tracing{
+						Strategy: ngfAPIv1alpha2.TraceStrategyRatio,
+						Ratio:    helpers.GetPointer(observability.DefaultTracingRatio),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"otel_trace on;",
+			},
+			expRedirectStrings: []string{
+				"otel_trace on;",
+			},
+			expInternalStrings: []string{
+				"otel_span_name $request_uri_path;",
+			},
+		},
 		{
 			name: "strategy set to zero ratio",
 			policy: &ngfAPIv1alpha2.ObservabilityPolicy{
@@ -0,0 +1,76 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package policiesfakes
+
+import (
+	"sync"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+type FakeDefaulter struct {
+	DefaultStub        func(policies.Policy)
+	defaultMutex       sync.RWMutex
+	defaultArgsForCall []struct {
+		arg1 policies.Policy
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDefaulter) Default(arg1 policies.Policy) {
+	fake.defaultMutex.Lock()
+	fake.defaultArgsForCall = append(fake.defaultArgsForCall, struct {
+		arg1 policies.Policy
+	}{arg1})
+	stub := fake.DefaultStub
+	fake.recordInvocation("Default", []interface{}{arg1})
+	fake.defaultMutex.Unlock()
+	if stub != nil {
+		fake.DefaultStub(arg1)
+	}
+}
+
+func (fake *FakeDefaulter) DefaultCallCount() int {
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	return len(fake.defaultArgsForCall)
+}
+
+func (fake *FakeDefaulter) DefaultCalls(stub func(policies.Policy)) {
+	fake.defaultMutex.Lock()
+	defer fake.defaultMutex.Unlock()
+	fake.DefaultStub = stub
+}
+
+func (fake *FakeDefaulter) DefaultArgsForCall(i int) policies.Policy {
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	argsForCall := fake.defaultArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDefaulter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDefaulter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ policies.Defaulter = new(FakeDefaulter)
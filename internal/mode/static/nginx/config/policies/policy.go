@@ -7,6 +7,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -24,6 +26,9 @@ type Policy interface {
 // GlobalSettings contains global settings from the current state of the graph that may be
 // needed for policy validation or generation if certain policies rely on those global settings.
 type GlobalSettings struct {
+	// TLSProtocols are the TLS protocols of the TLS profile of the NginxProxy resource.
+	// If empty, the profile allows the default protocols.
+	TLSProtocols []ngfAPI.TLSProtocolType
 	// NginxProxyValid is whether the NginxProxy resource is valid.
 	NginxProxyValid bool
 	// TelemetryEnabled is whether telemetry is enabled in the NginxProxy resource.
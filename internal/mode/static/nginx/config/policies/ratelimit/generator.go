@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var tmpl = template.Must(template.New("rate limit policy").Parse(rateLimitTemplate))
+
+const rateLimitTemplate = `
+limit_req zone={{ .Zone }}{{ range $p := .Params }} {{ $p }}{{ end }};
+{{- if .RejectCode }}
+limit_req_status {{ .RejectCode }};
+{{- end }}
+`
+
+// Generator generates nginx configuration based on a rate limit policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// A location that redirects to an internal location doesn't proxy the request,
+// so the configuration is only generated for the internal location, and the requests are only counted once.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		rlp, ok := pol.(*ngfAPI.RateLimitPolicy)
+		if !ok {
+			continue
+		}
+
+		var rejectCode string
+		if rlp.Spec.RejectCode != nil {
+			rejectCode = strconv.Itoa(int(*rlp.Spec.RejectCode))
+		}
+
+		fields := map[string]any{
+			"Zone":       dataplane.RateLimitZoneName(types.NamespacedName{Namespace: rlp.Namespace, Name: rlp.Name}),
+			"Params":     getParams(rlp.Spec),
+			"RejectCode": rejectCode,
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("RateLimitPolicy_%s_%s.conf", rlp.Namespace, rlp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, fields),
+		})
+	}
+
+	return files
+}
+
+// getParams returns the parameters of the limit_req directive after the zone.
+func getParams(spec ngfAPI.RateLimitPolicySpec) []string {
+	var params []string
+
+	if spec.Burst != nil {
+		params = append(params, fmt.Sprintf("burst=%d", *spec.Burst))
+	}
+
+	switch {
+	case spec.NoDelay != nil && *spec.NoDelay:
+		params = append(params, "nodelay")
+	case spec.Delay != nil:
+		params = append(params, fmt.Sprintf("delay=%d", *spec.Delay))
+	}
+
+	return params
+}
@@ -0,0 +1,142 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/ratelimit"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.RateLimitPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name:          "defaults",
+			spec:          ngfAPI.RateLimitPolicySpec{Rate: "10r/s"},
+			expStrings:    []string{"limit_req zone=ratelimit_test_rlp;"},
+			notExpStrings: []string{"burst", "delay", "limit_req_status"},
+		},
+		{
+			name: "burst and delay",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Rate:  "10r/s",
+				Burst: helpers.GetPointer[int32](20),
+				Delay: helpers.GetPointer[int32](5),
+			},
+			expStrings:    []string{"limit_req zone=ratelimit_test_rlp burst=20 delay=5;"},
+			notExpStrings: []string{"nodelay"},
+		},
+		{
+			name: "burst and nodelay",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Rate:    "10r/s",
+				Burst:   helpers.GetPointer[int32](20),
+				NoDelay: helpers.GetPointer(true),
+			},
+			expStrings: []string{"limit_req zone=ratelimit_test_rlp burst=20 nodelay;"},
+		},
+		{
+			name: "nodelay disabled",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Rate:    "10r/s",
+				NoDelay: helpers.GetPointer(false),
+			},
+			expStrings:    []string{"limit_req zone=ratelimit_test_rlp;"},
+			notExpStrings: []string{"nodelay"},
+		},
+		{
+			name: "reject code",
+			spec: ngfAPI.RateLimitPolicySpec{
+				Rate:       "10r/s",
+				RejectCode: helpers.GetPointer[int32](429),
+			},
+			expStrings: []string{
+				"limit_req zone=ratelimit_test_rlp;",
+				"limit_req_status 429;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("RateLimitPolicy_test_rlp.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.RateLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "rlp", Namespace: "test"},
+				Spec:       test.spec,
+			}
+
+			generator := ratelimit.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.ExternalLocationType},
+			)
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.RedirectLocationType},
+			)
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := ratelimit.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+const (
+	rateFmt    = `[1-9][0-9]{0,4}r/(s|m)`
+	rateErrMsg = "must be a number of requests per second or per minute, for example, '10r/s' or '30r/m'"
+
+	headerNameFmt    = `[A-Za-z0-9-]+`
+	headerNameErrMsg = "must contain only alphanumeric characters or '-'"
+
+	jwtClaimFmt    = `[A-Za-z0-9_]+`
+	jwtClaimErrMsg = "must contain only alphanumeric characters or '_'"
+)
+
+var (
+	rateFmtRegexp       = regexp.MustCompile("^" + rateFmt + "$")
+	headerNameFmtRegexp = regexp.MustCompile("^" + headerNameFmt + "$")
+	jwtClaimFmtRegexp   = regexp.MustCompile("^" + jwtClaimFmt + "$")
+)
+
+// Validator validates a RateLimitPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+	// plus tells if NGINX Plus is used, which is required for the JWT claim keys.
+	plus bool
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator, plus bool) *Validator {
+	return &Validator{genericValidator: genericValidator, plus: plus}
+}
+
+// Validate validates the spec of a RateLimitPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	rlp := helpers.MustCastObject[*ngfAPI.RateLimitPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range rlp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(rlp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two RateLimitPolicies conflict.
+// A location can only use the limit of one policy, so any two RateLimitPolicies conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection
+// or that require NGINX Plus. For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.RateLimitPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if !rateFmtRegexp.MatchString(spec.Rate) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("rate"),
+			spec.Rate,
+			fmt.Sprintf("%s (regex used for validation is '%s')", rateErrMsg, rateFmt),
+		))
+	}
+
+	if spec.Key != nil {
+		allErrs = append(allErrs, v.validateKey(*spec.Key, fieldPath.Child("key"))...)
+	}
+
+	if spec.ZoneSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*spec.ZoneSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("zoneSize"), *spec.ZoneSize, err.Error()))
+		}
+	}
+
+	if spec.Delay != nil && spec.NoDelay != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("delay"), "cannot be set together with noDelay"))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateKey(key ngfAPI.RateLimitKey, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch key.Type {
+	case ngfAPI.RateLimitKeyClientIP:
+	case ngfAPI.RateLimitKeyHeader:
+		switch {
+		case key.Header == nil:
+			allErrs = append(allErrs, field.Required(fieldPath.Child("header"), "is required when type is Header"))
+		case !headerNameFmtRegexp.MatchString(*key.Header):
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("header"),
+				*key.Header,
+				fmt.Sprintf("%s (regex used for validation is '%s')", headerNameErrMsg, headerNameFmt),
+			))
+		}
+	case ngfAPI.RateLimitKeyJWTClaim:
+		switch {
+		case !v.plus:
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("type"), "JWTClaim is only supported by NGINX Plus"))
+		case key.JWTClaim == nil:
+			allErrs = append(allErrs, field.Required(fieldPath.Child("jwtClaim"), "is required when type is JWTClaim"))
+		case !jwtClaimFmtRegexp.MatchString(*key.JWTClaim):
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("jwtClaim"),
+				*key.JWTClaim,
+				fmt.Sprintf("%s (regex used for validation is '%s')", jwtClaimErrMsg, jwtClaimFmt),
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			fieldPath.Child("type"),
+			key.Type,
+			[]string{
+				string(ngfAPI.RateLimitKeyClientIP),
+				string(ngfAPI.RateLimitKeyHeader),
+				string(ngfAPI.RateLimitKeyJWTClaim),
+			},
+		))
+	}
+
+	return allErrs
+}
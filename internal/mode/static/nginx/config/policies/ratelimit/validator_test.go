@@ -0,0 +1,239 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/ratelimit"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy
+
+func createValidPolicy() *ngfAPI.RateLimitPolicy {
+	return &ngfAPI.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.RateLimitPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Key: &ngfAPI.RateLimitKey{
+				Type:   ngfAPI.RateLimitKeyHeader,
+				Header: helpers.GetPointer("X-API-Key"),
+			},
+			ZoneSize:   helpers.GetPointer[ngfAPI.Size]("10m"),
+			Burst:      helpers.GetPointer[int32](20),
+			Delay:      helpers.GetPointer[int32](5),
+			RejectCode: helpers.GetPointer[int32](429),
+			Rate:       "10r/s",
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.RateLimitPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.RateLimitPolicy
+		expConditions []conditions.Condition
+		plus          bool
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.TargetRefs[1].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid rate and header",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Rate = "10r/s; allow all"
+				p.Spec.Key.Header = helpers.GetPointer("X-API Key")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.rate: Invalid value: \"10r/s; allow all\": " +
+					"must be a number of requests per second or per minute, for example, '10r/s' or '30r/m' " +
+					"(regex used for validation is '[1-9][0-9]{0,4}r/(s|m)'), " +
+					"spec.key.header: Invalid value: \"X-API Key\": " +
+					"must contain only alphanumeric characters or '-' (regex used for validation is '[A-Za-z0-9-]+')]"),
+			},
+		},
+		{
+			name: "missing header",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Key.Header = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key.header: Required value: is required when type is Header"),
+			},
+		},
+		{
+			name: "delay and nodelay",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.NoDelay = helpers.GetPointer(true)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.delay: Forbidden: cannot be set together with noDelay"),
+			},
+		},
+		{
+			name: "jwt claim without NGINX Plus",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Key = &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key.type: Forbidden: JWTClaim is only supported by NGINX Plus"),
+			},
+		},
+		{
+			name: "invalid jwt claim",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Key = &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub;"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key.jwtClaim: Invalid value: \"sub;\": " +
+					"must contain only alphanumeric characters or '_' (regex used for validation is '[A-Za-z0-9_]+')"),
+			},
+		},
+		{
+			name: "unsupported key type",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Key.Type = "Cookie"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key.type: Unsupported value: \"Cookie\": " +
+					"supported values: \"ClientIP\", \"Header\", \"JWTClaim\""),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid jwt claim with NGINX Plus",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.Key = &ngfAPI.RateLimitKey{
+					Type:     ngfAPI.RateLimitKeyJWTClaim,
+					JWTClaim: helpers.GetPointer("sub"),
+				}
+				return p
+			}),
+			plus:          true,
+			expConditions: nil,
+		},
+		{
+			name: "valid without optional fields",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec = ngfAPI.RateLimitPolicySpec{TargetRefs: p.Spec.TargetRefs, Rate: "30r/m"}
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := ratelimit.NewValidator(validation.GenericValidator{}, test.plus)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidateZoneSize(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := ratelimit.NewValidator(validation.GenericValidator{}, false)
+
+	policy := createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+		p.Spec.ZoneSize = helpers.GetPointer[ngfAPI.Size]("10mb")
+		return p
+	})
+
+	conds := v.Validate(policy, nil)
+	g.Expect(conds).To(HaveLen(1))
+	g.Expect(conds[0].Message).To(ContainSubstring("spec.zoneSize: Invalid value: \"10mb\""))
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := ratelimit.NewValidator(validation.GenericValidator{}, false)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := ratelimit.NewValidator(validation.GenericValidator{}, false)
+
+	g.Expect(v.Conflicts(&ngfAPI.RateLimitPolicy{}, &ngfAPI.RateLimitPolicy{})).To(BeTrue())
+}
@@ -0,0 +1,178 @@
+package responsecompression
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// alwaysCompressedType is the MIME type that NGINX always compresses. Listing it in the types directives
+// makes NGINX log a duplicate MIME type warning, so it is filtered out.
+const alwaysCompressedType = "text/html"
+
+var tmpl = template.Must(template.New("response compression policy").Parse(responseCompressionTemplate))
+
+const responseCompressionTemplate = `
+{{- if .Gzip }}
+	{{- if .Gzip.Enable }}
+gzip on;
+		{{- if .Gzip.Level }}
+gzip_comp_level {{ .Gzip.Level }};
+		{{- end }}
+		{{- if .Gzip.MinLength }}
+gzip_min_length {{ .Gzip.MinLength }};
+		{{- end }}
+		{{- if .Gzip.Types }}
+gzip_types {{ .Gzip.Types }};
+		{{- end }}
+		{{- if .Gzip.Vary }}
+gzip_vary {{ .Gzip.Vary }};
+		{{- end }}
+	{{- else }}
+gzip off;
+	{{- end }}
+{{- end }}
+{{- if .Brotli }}
+	{{- if .Brotli.Enable }}
+brotli on;
+		{{- if .Brotli.Level }}
+brotli_comp_level {{ .Brotli.Level }};
+		{{- end }}
+		{{- if .Brotli.MinLength }}
+brotli_min_length {{ .Brotli.MinLength }};
+		{{- end }}
+		{{- if .Brotli.Types }}
+brotli_types {{ .Brotli.Types }};
+		{{- end }}
+	{{- else }}
+brotli off;
+	{{- end }}
+{{- end }}
+`
+
+// compression is the data of the template for one compression method.
+type compression struct {
+	// Level is the compression level. Empty if not set.
+	Level string
+	// MinLength is the minimum length of the compressed responses. Empty if not set.
+	MinLength string
+	// Types is the space-separated list of the compressed MIME types. Empty if not set.
+	Types string
+	// Vary is the value of the gzip_vary directive. Empty if not set.
+	Vary string
+	// Enable tells if the compression is enabled.
+	Enable bool
+}
+
+// Generator generates nginx configuration based on a response compression policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// A location that redirects to an internal location doesn't send the response to the client,
+// so the configuration is only generated for the internal location.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		rcp, ok := pol.(*ngfAPI.ResponseCompressionPolicy)
+		if !ok {
+			continue
+		}
+
+		fields := map[string]any{
+			"Gzip":   getGzip(rcp.Spec.Gzip),
+			"Brotli": getBrotli(rcp.Spec.Brotli),
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ResponseCompressionPolicy_%s_%s.conf", rcp.Namespace, rcp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, fields),
+		})
+	}
+
+	return files
+}
+
+func getGzip(gzip *ngfAPI.GzipCompression) *compression {
+	if gzip == nil {
+		return nil
+	}
+
+	c := &compression{
+		Enable:    gzip.Enable == nil || *gzip.Enable,
+		Level:     formatInt(gzip.Level),
+		MinLength: formatInt(gzip.MinLength),
+		Types:     getTypes(gzip.Types),
+	}
+
+	if gzip.Vary != nil {
+		c.Vary = "off"
+		if *gzip.Vary {
+			c.Vary = "on"
+		}
+	}
+
+	return c
+}
+
+func getBrotli(brotli *ngfAPI.BrotliCompression) *compression {
+	if brotli == nil {
+		return nil
+	}
+
+	return &compression{
+		Enable:    brotli.Enable == nil || *brotli.Enable,
+		Level:     formatInt(brotli.Level),
+		MinLength: formatInt(brotli.MinLength),
+		Types:     getTypes(brotli.Types),
+	}
+}
+
+func getTypes(types []ngfAPI.MIMEType) string {
+	values := make([]string, 0, len(types))
+	for _, t := range types {
+		if strings.EqualFold(string(t), alwaysCompressedType) {
+			continue
+		}
+
+		values = append(values, string(t))
+	}
+
+	return strings.Join(values, " ")
+}
+
+func formatInt(value *int32) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*value)
+}
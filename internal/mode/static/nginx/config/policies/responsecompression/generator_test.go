@@ -0,0 +1,195 @@
+package responsecompression_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/responsecompression"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.ResponseCompressionPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "gzip with defaults",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip: &ngfAPI.GzipCompression{},
+			},
+			expStrings: []string{"gzip on;"},
+			notExpStrings: []string{
+				"gzip_comp_level",
+				"gzip_min_length",
+				"gzip_types",
+				"gzip_vary",
+				"brotli",
+			},
+		},
+		{
+			name: "gzip with all fields",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip: &ngfAPI.GzipCompression{
+					Enable:    helpers.GetPointer(true),
+					Level:     helpers.GetPointer[int32](5),
+					MinLength: helpers.GetPointer[int32](1024),
+					Vary:      helpers.GetPointer(true),
+					Types:     []ngfAPI.MIMEType{"text/html", "application/json", "text/css"},
+				},
+			},
+			expStrings: []string{
+				"gzip on;",
+				"gzip_comp_level 5;",
+				"gzip_min_length 1024;",
+				"gzip_types application/json text/css;",
+				"gzip_vary on;",
+			},
+			notExpStrings: []string{"text/html", "brotli"},
+		},
+		{
+			name: "gzip vary off",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip: &ngfAPI.GzipCompression{Vary: helpers.GetPointer(false)},
+			},
+			expStrings: []string{"gzip on;", "gzip_vary off;"},
+		},
+		{
+			name: "gzip only text/html type",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip: &ngfAPI.GzipCompression{Types: []ngfAPI.MIMEType{"text/html"}},
+			},
+			expStrings:    []string{"gzip on;"},
+			notExpStrings: []string{"gzip_types"},
+		},
+		{
+			name: "gzip disabled",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip: &ngfAPI.GzipCompression{
+					Enable: helpers.GetPointer(false),
+					Level:  helpers.GetPointer[int32](5),
+				},
+			},
+			expStrings:    []string{"gzip off;"},
+			notExpStrings: []string{"gzip on;", "gzip_comp_level"},
+		},
+		{
+			name: "brotli with all fields",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Brotli: &ngfAPI.BrotliCompression{
+					Level:     helpers.GetPointer[int32](0),
+					MinLength: helpers.GetPointer[int32](256),
+					Types:     []ngfAPI.MIMEType{"*"},
+				},
+			},
+			expStrings: []string{
+				"brotli on;",
+				"brotli_comp_level 0;",
+				"brotli_min_length 256;",
+				"brotli_types *;",
+			},
+			notExpStrings: []string{"gzip"},
+		},
+		{
+			name: "brotli disabled",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Brotli: &ngfAPI.BrotliCompression{Enable: helpers.GetPointer(false)},
+			},
+			expStrings:    []string{"brotli off;"},
+			notExpStrings: []string{"brotli on;"},
+		},
+		{
+			name: "gzip and brotli",
+			spec: ngfAPI.ResponseCompressionPolicySpec{
+				Gzip:   &ngfAPI.GzipCompression{Level: helpers.GetPointer[int32](6)},
+				Brotli: &ngfAPI.BrotliCompression{Level: helpers.GetPointer[int32](4)},
+			},
+			expStrings: []string{
+				"gzip on;",
+				"gzip_comp_level 6;",
+				"brotli on;",
+				"brotli_comp_level 4;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("ResponseCompressionPolicy_test_rcp.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ResponseCompressionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "rcp", Namespace: "test"},
+				Spec:       test.spec,
+			}
+
+			generator := responsecompression.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.ExternalLocationType},
+			)
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation(
+				[]policies.Policy{policy},
+				http.Location{Type: http.RedirectLocationType},
+			)
+			g.Expect(resFiles).To(BeEmpty())
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := responsecompression.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,110 @@
+package responsecompression
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	mimeTypeFmt    = `\*|[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*`
+	mimeTypeErrMsg = "must be '*' or a MIME type in the format 'type/subtype', for example, 'application/json'"
+)
+
+var mimeTypeFmtRegexp = regexp.MustCompile("^(" + mimeTypeFmt + ")$")
+
+// Validator validates a ResponseCompressionPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	// brotliSupported tells if the NGINX image includes the Brotli module.
+	brotliSupported bool
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(brotliSupported bool) *Validator {
+	return &Validator{brotliSupported: brotliSupported}
+}
+
+// Validate validates the spec of a ResponseCompressionPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	rcp := helpers.MustCastObject[*ngfAPI.ResponseCompressionPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range rcp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(rcp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ResponseCompressionPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.ResponseCompressionPolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.ResponseCompressionPolicy](polB)
+
+	if a.Spec.Gzip != nil && b.Spec.Gzip != nil {
+		return true
+	}
+
+	return a.Spec.Brotli != nil && b.Spec.Brotli != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection
+// or that require an NGINX module. For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ResponseCompressionPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Gzip != nil {
+		allErrs = append(allErrs, validateTypes(spec.Gzip.Types, fieldPath.Child("gzip").Child("types"))...)
+	}
+
+	if spec.Brotli != nil {
+		brotliPath := fieldPath.Child("brotli")
+
+		if !v.brotliSupported {
+			allErrs = append(allErrs, field.Forbidden(
+				brotliPath,
+				"Brotli compression requires the ngx_brotli module, which is not installed in the NGINX image",
+			))
+		}
+
+		allErrs = append(allErrs, validateTypes(spec.Brotli.Types, brotliPath.Child("types"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateTypes(types []ngfAPI.MIMEType, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, t := range types {
+		if !mimeTypeFmtRegexp.MatchString(string(t)) {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Index(i),
+				t,
+				fmt.Sprintf("%s (regex used for validation is '%s')", mimeTypeErrMsg, mimeTypeFmt),
+			))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,213 @@
+package responsecompression_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/responsecompression"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ResponseCompressionPolicy) *ngfAPI.ResponseCompressionPolicy
+
+func createValidPolicy() *ngfAPI.ResponseCompressionPolicy {
+	return &ngfAPI.ResponseCompressionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ResponseCompressionPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Gzip: &ngfAPI.GzipCompression{
+				Level:     helpers.GetPointer[int32](5),
+				MinLength: helpers.GetPointer[int32](1024),
+				Vary:      helpers.GetPointer(true),
+				Types:     []ngfAPI.MIMEType{"application/json", "image/svg+xml"},
+			},
+			Brotli: &ngfAPI.BrotliCompression{
+				Level: helpers.GetPointer[int32](6),
+				Types: []ngfAPI.MIMEType{"*"},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ResponseCompressionPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		policy          *ngfAPI.ResponseCompressionPolicy
+		expConditions   []conditions.Condition
+		brotliSupported bool
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ResponseCompressionPolicy) *ngfAPI.ResponseCompressionPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			brotliSupported: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ResponseCompressionPolicy) *ngfAPI.ResponseCompressionPolicy {
+				p.Spec.TargetRefs[1].Kind = kinds.GRPCRoute
+				return p
+			}),
+			brotliSupported: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid types",
+			policy: createModifiedPolicy(func(p *ngfAPI.ResponseCompressionPolicy) *ngfAPI.ResponseCompressionPolicy {
+				p.Spec.Gzip.Types = []ngfAPI.MIMEType{"application/json; gzip off"}
+				p.Spec.Brotli.Types = []ngfAPI.MIMEType{"text/*"}
+				return p
+			}),
+			brotliSupported: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.gzip.types[0]: Invalid value: \"application/json; gzip off\": " +
+					"must be '*' or a MIME type in the format 'type/subtype', for example, 'application/json' " +
+					"(regex used for validation is '\\*|[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*'), " +
+					"spec.brotli.types[0]: Invalid value: \"text/*\": " +
+					"must be '*' or a MIME type in the format 'type/subtype', for example, 'application/json' " +
+					"(regex used for validation is '\\*|[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*')]"),
+			},
+		},
+		{
+			name:            "brotli not supported",
+			policy:          createValidPolicy(),
+			brotliSupported: false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.brotli: Forbidden: Brotli compression requires the ngx_brotli " +
+					"module, which is not installed in the NGINX image"),
+			},
+		},
+		{
+			name: "gzip only; brotli not supported",
+			policy: createModifiedPolicy(func(p *ngfAPI.ResponseCompressionPolicy) *ngfAPI.ResponseCompressionPolicy {
+				p.Spec.Brotli = nil
+				return p
+			}),
+			brotliSupported: false,
+			expConditions:   nil,
+		},
+		{
+			name:            "valid",
+			policy:          createValidPolicy(),
+			brotliSupported: true,
+			expConditions:   nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := responsecompression.NewValidator(test.brotliSupported)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := responsecompression.NewValidator(true)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA        *ngfAPI.ResponseCompressionPolicy
+		polB        *ngfAPI.ResponseCompressionPolicy
+		name        string
+		expConflict bool
+	}{
+		{
+			name: "no conflict",
+			polA: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{Gzip: &ngfAPI.GzipCompression{}},
+			},
+			polB: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{Brotli: &ngfAPI.BrotliCompression{}},
+			},
+			expConflict: false,
+		},
+		{
+			name: "gzip conflicts",
+			polA: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{Gzip: &ngfAPI.GzipCompression{}},
+			},
+			polB: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{
+					Gzip:   &ngfAPI.GzipCompression{},
+					Brotli: &ngfAPI.BrotliCompression{},
+				},
+			},
+			expConflict: true,
+		},
+		{
+			name: "brotli conflicts",
+			polA: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{Brotli: &ngfAPI.BrotliCompression{}},
+			},
+			polB: &ngfAPI.ResponseCompressionPolicy{
+				Spec: ngfAPI.ResponseCompressionPolicySpec{Brotli: &ngfAPI.BrotliCompression{}},
+			},
+			expConflict: true,
+		},
+	}
+
+	v := responsecompression.NewValidator(true)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.expConflict))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package staticcontent
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	// the segments of the paths can't start with '.', so that the paths can't refer to parent directories
+	// or to hidden files.
+	segmentFmt = `[A-Za-z0-9_-][A-Za-z0-9._-]*`
+
+	fileNameFmt    = segmentFmt
+	fileNameErrMsg = "must be a file name containing only alphanumeric characters, '_', '-', or '.', " +
+		"and not starting with '.'"
+
+	filePathFmt    = segmentFmt + `(/` + segmentFmt + `)*`
+	filePathErrMsg = "must be a relative path of a file, where every segment contains only alphanumeric " +
+		"characters, '_', '-', or '.', and doesn't start with '.'"
+
+	volumePathFmt    = `/usr/share/nginx/static(/` + segmentFmt + `)+`
+	volumePathErrMsg = "must be an absolute path of a directory under " + ngfAPI.StaticContentVolumePathPrefix +
+		", where every segment contains only alphanumeric characters, '_', '-', or '.', and doesn't start with '.'"
+)
+
+var (
+	fileNameFmtRegexp   = regexp.MustCompile("^" + fileNameFmt + "$")
+	filePathFmtRegexp   = regexp.MustCompile("^" + filePathFmt + "$")
+	volumePathFmtRegexp = regexp.MustCompile("^" + volumePathFmt + "$")
+)
+
+// Validator validates a StaticContentPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a StaticContentPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	scp := helpers.MustCastObject[*ngfAPI.StaticContentPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range scp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(scp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two StaticContentPolicies conflict.
+// The static content of a Route can only come from a single source, so two policies always conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection
+// or could expose the files of NGINX. For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.StaticContentPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validateSource(spec.Source, fieldPath.Child("source"))...)
+
+	if spec.Index != nil && !fileNameFmtRegexp.MatchString(*spec.Index) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("index"),
+			*spec.Index,
+			fmt.Sprintf("%s (regex used for validation is '%s')", fileNameErrMsg, fileNameFmt),
+		))
+	}
+
+	if spec.Fallback != nil && !filePathFmtRegexp.MatchString(*spec.Fallback) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("fallback"),
+			*spec.Fallback,
+			fmt.Sprintf("%s (regex used for validation is '%s')", filePathErrMsg, filePathFmt),
+		))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateSource(source ngfAPI.StaticContentSource, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	configMapPath := fieldPath.Child("configMap")
+	volumePath := fieldPath.Child("volume")
+
+	switch source.Type {
+	case ngfAPI.StaticContentSourceConfigMap:
+		if source.ConfigMap == nil {
+			allErrs = append(allErrs, field.Required(configMapPath, "configMap is required when type is ConfigMap"))
+		}
+
+		if source.Volume != nil {
+			allErrs = append(allErrs, field.Forbidden(volumePath, "volume can only be set when type is Volume"))
+		}
+	case ngfAPI.StaticContentSourceVolume:
+		if source.Volume == nil {
+			allErrs = append(allErrs, field.Required(volumePath, "volume is required when type is Volume"))
+		} else if !volumePathFmtRegexp.MatchString(source.Volume.Path) {
+			allErrs = append(allErrs, field.Invalid(
+				volumePath.Child("path"),
+				source.Volume.Path,
+				fmt.Sprintf("%s (regex used for validation is '%s')", volumePathErrMsg, volumePathFmt),
+			))
+		}
+
+		if source.ConfigMap != nil {
+			allErrs = append(allErrs, field.Forbidden(configMapPath, "configMap can only be set when type is ConfigMap"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			fieldPath.Child("type"),
+			source.Type,
+			[]string{string(ngfAPI.StaticContentSourceConfigMap), string(ngfAPI.StaticContentSourceVolume)},
+		))
+	}
+
+	return allErrs
+}
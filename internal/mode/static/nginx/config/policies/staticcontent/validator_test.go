@@ -0,0 +1,230 @@
+package staticcontent_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy
+
+func createValidPolicy() *ngfAPI.StaticContentPolicy {
+	return &ngfAPI.StaticContentPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.StaticContentPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Source: ngfAPI.StaticContentSource{
+				Type:      ngfAPI.StaticContentSourceConfigMap,
+				ConfigMap: &ngfAPI.StaticContentConfigMap{Name: "content"},
+			},
+			Index:    helpers.GetPointer("home.html"),
+			Fallback: helpers.GetPointer("errors/maintenance.html"),
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.StaticContentPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.StaticContentPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "configMap not set",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source.ConfigMap = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.configMap: Required value: " +
+					"configMap is required when type is ConfigMap"),
+			},
+		},
+		{
+			name: "volume set for ConfigMap type",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source.Volume = &ngfAPI.StaticContentVolume{Path: "/usr/share/nginx/static/content"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.volume: Forbidden: " +
+					"volume can only be set when type is Volume"),
+			},
+		},
+		{
+			name: "volume not set",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source = ngfAPI.StaticContentSource{Type: ngfAPI.StaticContentSourceVolume}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.volume: Required value: " +
+					"volume is required when type is Volume"),
+			},
+		},
+		{
+			name: "configMap set for Volume type",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source.Type = ngfAPI.StaticContentSourceVolume
+				p.Spec.Source.Volume = &ngfAPI.StaticContentVolume{Path: "/usr/share/nginx/static/content"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.configMap: Forbidden: " +
+					"configMap can only be set when type is ConfigMap"),
+			},
+		},
+		{
+			name: "volume path outside of the static directory",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source = ngfAPI.StaticContentSource{
+					Type:   ngfAPI.StaticContentSourceVolume,
+					Volume: &ngfAPI.StaticContentVolume{Path: "/usr/share/nginx/static/../../../etc"},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.volume.path: Invalid value: " +
+					"\"/usr/share/nginx/static/../../../etc\": must be an absolute path of a directory under " +
+					"/usr/share/nginx/static/, where every segment contains only alphanumeric characters, '_', '-', " +
+					"or '.', and doesn't start with '.' (regex used for validation is " +
+					"'/usr/share/nginx/static(/[A-Za-z0-9_-][A-Za-z0-9._-]*)+')"),
+			},
+		},
+		{
+			name: "invalid source type",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source.Type = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.source.type: Unsupported value: \"invalid\": " +
+					"supported values: \"ConfigMap\", \"Volume\""),
+			},
+		},
+		{
+			name: "invalid index",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Index = helpers.GetPointer("index.html; autoindex on")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.index: Invalid value: \"index.html; autoindex on\": " +
+					"must be a file name containing only alphanumeric characters, '_', '-', or '.', " +
+					"and not starting with '.' (regex used for validation is '[A-Za-z0-9_-][A-Za-z0-9._-]*')"),
+			},
+		},
+		{
+			name: "invalid fallback",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Fallback = helpers.GetPointer("../index.html")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.fallback: Invalid value: \"../index.html\": " +
+					"must be a relative path of a file, where every segment contains only alphanumeric " +
+					"characters, '_', '-', or '.', and doesn't start with '.' (regex used for validation is " +
+					"'[A-Za-z0-9_-][A-Za-z0-9._-]*(/[A-Za-z0-9_-][A-Za-z0-9._-]*)*')"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid volume without index and fallback",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Source = ngfAPI.StaticContentSource{
+					Type:   ngfAPI.StaticContentSourceVolume,
+					Volume: &ngfAPI.StaticContentVolume{Path: "/usr/share/nginx/static/my-app/v1.0"},
+				}
+				p.Spec.Index = nil
+				p.Spec.Fallback = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := staticcontent.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := staticcontent.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := staticcontent.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
@@ -6,6 +6,21 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 )
 
+const (
+	// DefaultKeepAliveConnections is the number of idle keep-alive connections that are preserved for an upstream
+	// when the keepAlive settings are specified without the number of connections.
+	// Without the keepalive directive, NGINX does not keep connections to the upstream alive, and the other
+	// keep-alive settings have no effect.
+	// The default is applied when the policies are merged rather than to each policy, because a default number
+	// of connections in every policy would make the policies that target the same Service conflict.
+	DefaultKeepAliveConnections int32 = 16
+
+	// DefaultOSSZoneSize is the upstream zone size for NGINX Open Source when no policy sets it.
+	DefaultOSSZoneSize = "512k"
+	// DefaultPlusZoneSize is the upstream zone size for NGINX Plus when no policy sets it.
+	DefaultPlusZoneSize = "1m"
+)
+
 // Processor processes UpstreamSettingsPolicies.
 type Processor struct{}
 
@@ -24,13 +39,15 @@ func NewProcessor() Processor {
 
 // Process processes policies into an UpstreamSettings object. The policies are already validated and are guaranteed
 // to not contain overlapping settings. This method merges all fields in the policies into a single UpstreamSettings
-// object.
+// object. If the keepAlive settings are specified without the number of connections,
+// the number of connections is set to DefaultKeepAliveConnections.
 func (g Processor) Process(pols []policies.Policy) UpstreamSettings {
 	return processPolicies(pols)
 }
 
 func processPolicies(pols []policies.Policy) UpstreamSettings {
 	upstreamSettings := UpstreamSettings{}
+	var keepAliveSet bool
 
 	for _, pol := range pols {
 		usp, ok := pol.(*ngfAPI.UpstreamSettingsPolicy)
@@ -45,6 +62,8 @@ func processPolicies(pols []policies.Policy) UpstreamSettings {
 		}
 
 		if usp.Spec.KeepAlive != nil {
+			keepAliveSet = true
+
 			if usp.Spec.KeepAlive.Connections != nil {
 				upstreamSettings.KeepAlive.Connections = *usp.Spec.KeepAlive.Connections
 			}
@@ -63,5 +82,9 @@ func processPolicies(pols []policies.Policy) UpstreamSettings {
 		}
 	}
 
+	if keepAliveSet && upstreamSettings.KeepAlive.Connections == 0 {
+		upstreamSettings.KeepAlive.Connections = DefaultKeepAliveConnections
+	}
+
 	return upstreamSettings
 }
@@ -89,7 +89,7 @@ func TestProcess(t *testing.T) {
 			},
 		},
 		{
-			name: "keep alive requests set",
+			name: "keep alive requests set, connections defaulted",
 			policies: []policies.Policy{
 				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
 					ObjectMeta: metav1.ObjectMeta{
@@ -105,12 +105,13 @@ func TestProcess(t *testing.T) {
 			},
 			expUpstreamSettings: UpstreamSettings{
 				KeepAlive: http.UpstreamKeepAlive{
-					Requests: 1,
+					Connections: DefaultKeepAliveConnections,
+					Requests:    1,
 				},
 			},
 		},
 		{
-			name: "keep alive time set",
+			name: "keep alive time set, connections defaulted",
 			policies: []policies.Policy{
 				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
 					ObjectMeta: metav1.ObjectMeta{
@@ -126,12 +127,13 @@ func TestProcess(t *testing.T) {
 			},
 			expUpstreamSettings: UpstreamSettings{
 				KeepAlive: http.UpstreamKeepAlive{
-					Time: "5s",
+					Connections: DefaultKeepAliveConnections,
+					Time:        "5s",
 				},
 			},
 		},
 		{
-			name: "keep alive timeout set",
+			name: "keep alive timeout set, connections defaulted",
 			policies: []policies.Policy{
 				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
 					ObjectMeta: metav1.ObjectMeta{
@@ -147,7 +149,8 @@ func TestProcess(t *testing.T) {
 			},
 			expUpstreamSettings: UpstreamSettings{
 				KeepAlive: http.UpstreamKeepAlive{
-					Timeout: "10s",
+					Connections: DefaultKeepAliveConnections,
+					Timeout:     "10s",
 				},
 			},
 		},
@@ -1,6 +1,9 @@
 package upstreamsettings
 
 import (
+	"fmt"
+	"regexp"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -13,6 +16,20 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
 
+const (
+	grpcServiceFmt    = `[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*`
+	grpcServiceErrMsg = "must be a gRPC service name, where every dot-separated segment starts with a letter or '_' " +
+		"and contains only alphanumeric characters or '_'"
+
+	grpcHealthPathFmt    = `/[A-Za-z0-9._~/-]*`
+	grpcHealthPathErrMsg = "must start with '/' and contain only alphanumeric characters, '.', '_', '~', '/', or '-'"
+)
+
+var (
+	grpcServiceFmtRegexp    = regexp.MustCompile("^" + grpcServiceFmt + "$")
+	grpcHealthPathFmtRegexp = regexp.MustCompile("^" + grpcHealthPathFmt + "$")
+)
+
 // Validator validates an UpstreamSettingsPolicy.
 // Implements policies.Validator interface.
 type Validator struct {
@@ -59,26 +76,33 @@ func conflicts(a, b ngfAPI.UpstreamSettingsPolicySpec) bool {
 		return true
 	}
 
-	if a.KeepAlive != nil && b.KeepAlive != nil {
-		if a.KeepAlive.Connections != nil && b.KeepAlive.Connections != nil {
-			return true
-		}
-		if a.KeepAlive.Requests != nil && b.KeepAlive.Requests != nil {
-			return true
-		}
-
-		if a.KeepAlive.Time != nil && b.KeepAlive.Time != nil {
-			return true
-		}
+	if a.KeepAlive != nil && b.KeepAlive != nil && keepAliveConflicts(*a.KeepAlive, *b.KeepAlive) {
+		return true
+	}
 
-		if a.KeepAlive.Timeout != nil && b.KeepAlive.Timeout != nil {
-			return true
-		}
+	if a.GRPCHealthCheck != nil && b.GRPCHealthCheck != nil {
+		return true
 	}
 
 	return false
 }
 
+func keepAliveConflicts(a, b ngfAPI.UpstreamKeepAlive) bool {
+	if a.Connections != nil && b.Connections != nil {
+		return true
+	}
+
+	if a.Requests != nil && b.Requests != nil {
+		return true
+	}
+
+	if a.Time != nil && b.Time != nil {
+		return true
+	}
+
+	return a.Timeout != nil && b.Timeout != nil
+}
+
 // validateSettings performs validation on fields in the spec that are vulnerable to code injection.
 // For all other fields, we rely on the CRD validation.
 func (v Validator) validateSettings(spec ngfAPI.UpstreamSettingsPolicySpec) error {
@@ -96,6 +120,10 @@ func (v Validator) validateSettings(spec ngfAPI.UpstreamSettingsPolicySpec) erro
 		allErrs = append(allErrs, v.validateUpstreamKeepAlive(*spec.KeepAlive, fieldPath.Child("keepAlive"))...)
 	}
 
+	if spec.GRPCHealthCheck != nil {
+		allErrs = append(allErrs, v.validateGRPCHealthCheck(*spec.GRPCHealthCheck, fieldPath.Child("grpcHealthCheck"))...)
+	}
+
 	return allErrs.ToAggregate()
 }
 
@@ -123,3 +151,36 @@ func (v Validator) validateUpstreamKeepAlive(
 
 	return allErrs
 }
+
+func (v Validator) validateGRPCHealthCheck(
+	healthCheck ngfAPI.GRPCHealthCheck,
+	fieldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if healthCheck.Service != nil && !grpcServiceFmtRegexp.MatchString(*healthCheck.Service) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("service"),
+			*healthCheck.Service,
+			fmt.Sprintf("%s (regex used for validation is '%s')", grpcServiceErrMsg, grpcServiceFmt),
+		))
+	}
+
+	if healthCheck.Path != nil && !grpcHealthPathFmtRegexp.MatchString(*healthCheck.Path) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("path"),
+			*healthCheck.Path,
+			fmt.Sprintf("%s (regex used for validation is '%s')", grpcHealthPathErrMsg, grpcHealthPathFmt),
+		))
+	}
+
+	if healthCheck.Interval != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*healthCheck.Interval)); err != nil {
+			path := fieldPath.Child("interval")
+
+			allErrs = append(allErrs, field.Invalid(path, *healthCheck.Interval, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -39,6 +39,11 @@ func createValidPolicy() *ngfAPI.UpstreamSettingsPolicy {
 				Timeout:     helpers.GetPointer[ngfAPI.Duration]("30s"),
 				Connections: helpers.GetPointer[int32](100),
 			},
+			GRPCHealthCheck: &ngfAPI.GRPCHealthCheck{
+				Service:  helpers.GetPointer("helloworld.Greeter"),
+				Path:     helpers.GetPointer("/grpc-health"),
+				Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
 		},
 		Status: v1alpha2.PolicyStatus{},
 	}
@@ -118,6 +123,28 @@ func TestValidator_Validate(t *testing.T) {
 						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
 			},
 		},
+		{
+			name: "invalid grpc health check",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.GRPCHealthCheck.Service = helpers.GetPointer("helloworld.Greeter;")
+				p.Spec.GRPCHealthCheck.Path = helpers.GetPointer("/health{")
+				p.Spec.GRPCHealthCheck.Interval = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.grpcHealthCheck.service: Invalid value: \"helloworld.Greeter;\": must be a gRPC service " +
+						"name, where every dot-separated segment starts with a letter or '_' and contains only " +
+						"alphanumeric characters or '_' (regex used for validation is " +
+						"'[A-Za-z_][A-Za-z0-9_]*(\\.[A-Za-z_][A-Za-z0-9_]*)*'), " +
+						"spec.grpcHealthCheck.path: Invalid value: \"/health{\": must start with '/' and contain " +
+						"only alphanumeric characters, '.', '_', '~', '/', or '-' (regex used for validation is " +
+						"'/[A-Za-z0-9._~/-]*'), " +
+						"spec.grpcHealthCheck.interval: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
 		{
 			name:          "valid",
 			policy:        createValidPolicy(),
@@ -238,6 +265,16 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "grpc health check conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					GRPCHealthCheck: &ngfAPI.GRPCHealthCheck{},
+				},
+			},
+			conflicts: true,
+		},
 	}
 
 	v := upstreamsettings.NewValidator(nil)
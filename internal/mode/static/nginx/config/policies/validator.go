@@ -21,9 +21,10 @@ type Validator interface {
 	Conflicts(a, b Policy) bool
 }
 
-// CompositeValidator manages the validators for NGF Policies.
+// CompositeValidator manages the validators and defaulters for NGF Policies.
 type CompositeValidator struct {
 	validators     map[schema.GroupVersionKind]Validator
+	defaulters     map[schema.GroupVersionKind]Defaulter
 	mustExtractGVK kinds.MustExtractGVK
 }
 
@@ -31,6 +32,8 @@ type CompositeValidator struct {
 type ManagerConfig struct {
 	// Validator is the Validator for the Policy.
 	Validator Validator
+	// Defaulter is the Defaulter for the Policy. Optional.
+	Defaulter Defaulter
 	// GVK is the GroupVersionKind of the Policy.
 	GVK schema.GroupVersionKind
 }
@@ -43,11 +46,16 @@ func NewManager(
 ) *CompositeValidator {
 	v := &CompositeValidator{
 		validators:     make(map[schema.GroupVersionKind]Validator),
+		defaulters:     make(map[schema.GroupVersionKind]Defaulter),
 		mustExtractGVK: mustExtractGVK,
 	}
 
 	for _, cfg := range configs {
 		v.validators[cfg.GVK] = cfg.Validator
+
+		if cfg.Defaulter != nil {
+			v.defaulters[cfg.GVK] = cfg.Defaulter
+		}
 	}
 
 	return v
@@ -76,3 +84,26 @@ func (m *CompositeValidator) Conflicts(polA, polB Policy) bool {
 
 	return validator.Conflicts(polA, polB)
 }
+
+// Default returns a copy of the policy with the default values set.
+// Policies without a registered Defaulter are returned as is.
+// Implements validation.PolicyDefaulter.
+func (m *CompositeValidator) Default(policy Policy) Policy {
+	gvk := m.mustExtractGVK(policy)
+
+	defaulter, ok := m.defaulters[gvk]
+	if !ok {
+		return policy
+	}
+
+	obj := policy.DeepCopyObject()
+
+	defaulted, ok := obj.(Policy)
+	if !ok {
+		panic(fmt.Sprintf("expected a Policy but got %T", obj))
+	}
+
+	defaulter.Default(defaulted)
+
+	return defaulted
+}
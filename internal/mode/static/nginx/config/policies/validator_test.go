@@ -3,6 +3,7 @@ package policies_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +39,8 @@ var _ = Describe("Policy CompositeValidator", func() {
 		}
 	}
 
+	appleDefaulter := &policiesfakes.FakeDefaulter{}
+
 	mgr := policies.NewManager(
 		mustExtractGVK,
 		policies.ManagerConfig{
@@ -47,7 +50,8 @@ var _ = Describe("Policy CompositeValidator", func() {
 				},
 				ConflictsStub: func(_ policies.Policy, _ policies.Policy) bool { return true },
 			},
-			GVK: appleGVK,
+			Defaulter: appleDefaulter,
+			GVK:       appleGVK,
 		},
 		policies.ManagerConfig{
 			Validator: &policiesfakes.FakeValidator{
@@ -93,4 +97,30 @@ var _ = Describe("Policy CompositeValidator", func() {
 			})
 		})
 	})
+	Context("Defaulting", func() {
+		When("Policy has a registered defaulter", func() {
+			It("Defaults a copy of the policy", func() {
+				appleCopy := &policiesfakes.FakePolicy{}
+				policy := &policiesfakes.FakePolicy{
+					GetNameStub: func() string {
+						return "apple"
+					},
+					DeepCopyObjectStub: func() runtime.Object {
+						return appleCopy
+					},
+				}
+
+				defaulted := mgr.Default(policy)
+				Expect(defaulted).To(BeIdenticalTo(appleCopy))
+
+				Expect(appleDefaulter.DefaultCallCount()).To(Equal(1))
+				Expect(appleDefaulter.DefaultArgsForCall(0)).To(BeIdenticalTo(appleCopy))
+			})
+		})
+		When("Policy does not have a registered defaulter", func() {
+			It("Returns the policy as is", func() {
+				Expect(mgr.Default(orangePolicy)).To(BeIdenticalTo(orangePolicy))
+			})
+		})
+	})
 })
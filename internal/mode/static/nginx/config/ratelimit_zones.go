@@ -0,0 +1,23 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var rateLimitZonesTemplate = gotemplate.Must(gotemplate.New("rateLimitZones").Parse(rateLimitZonesTemplateText))
+
+func executeRateLimitZones(conf dataplane.Configuration) []executeResult {
+	if len(conf.RateLimitZones) == 0 {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(rateLimitZonesTemplate, conf.RateLimitZones),
+	}
+
+	return []executeResult{result}
+}
@@ -0,0 +1,7 @@
+package config
+
+const rateLimitZonesTemplateText = `
+{{- range $z := . }}
+limit_req_zone {{ $z.Key }} zone={{ $z.Name }}:{{ $z.Size }} rate={{ $z.Rate }};
+{{- end }}
+`
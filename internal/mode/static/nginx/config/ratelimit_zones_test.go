@@ -0,0 +1,52 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteRateLimitZones(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		RateLimitZones: []dataplane.RateLimitZone{
+			{
+				Name: "ratelimit_test_default",
+				Key:  "$binary_remote_addr",
+				Size: "10m",
+				Rate: "10r/s",
+			},
+			{
+				Name: "ratelimit_test_header",
+				Key:  "$http_x_api_key",
+				Size: "1m",
+				Rate: "30r/m",
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	expSubStrings := map[string]int{
+		"limit_req_zone $binary_remote_addr zone=ratelimit_test_default:10m rate=10r/s;": 1,
+		"limit_req_zone $http_x_api_key zone=ratelimit_test_header:1m rate=30r/m;":       1,
+	}
+
+	res := executeRateLimitZones(conf)
+	g.Expect(res).To(HaveLen(1))
+	g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteRateLimitZonesNil(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	res := executeRateLimitZones(dataplane.Configuration{})
+	g.Expect(res).To(BeEmpty())
+}
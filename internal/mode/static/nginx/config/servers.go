@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	gotemplate "text/template"
@@ -15,12 +17,16 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var serversTemplate = gotemplate.Must(gotemplate.New("servers").Parse(serversTemplateText))
+var serversTemplate = gotemplate.Must(newServersTemplate(serversTemplateText, locationTemplateText))
+
+var httpServersTemplate = gotemplate.Must(gotemplate.New("httpServers").Parse(httpServersTemplateText))
 
 const (
 	// HeaderMatchSeparator is the separator for constructing header-based match for NJS.
 	HeaderMatchSeparator = ":"
 	rootPath             = "/"
+	// sharedLocationsTemplateName is the name of the template that renders the locations shared by multiple servers.
+	sharedLocationsTemplateName = "shared-locations"
 )
 
 var grpcAuthorityHeader = http.Header{
@@ -43,12 +49,32 @@ var httpUpgradeHeader = http.Header{
 	Value: "$http_upgrade",
 }
 
+// newServersTemplate parses the servers template along with the location template it executes for each location.
+// The template set also includes the template that renders the locations shared by multiple servers.
+func newServersTemplate(serversText, locationText string) (*gotemplate.Template, error) {
+	tmpl, err := gotemplate.New("servers").Parse(serversText)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tmpl.New("location").Parse(locationText); err != nil {
+		return nil, err
+	}
+
+	if _, err := tmpl.New(sharedLocationsTemplateName).Parse(sharedLocationsTemplateText); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
 func (g GeneratorImpl) newExecuteServersFunc(
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) executeFunc {
 	return func(configuration dataplane.Configuration) []executeResult {
-		return g.executeServers(configuration, generator, keepAliveCheck)
+		return g.executeServers(configuration, generator, keepAliveCheck, sessionCookieGet)
 	}
 }
 
@@ -56,19 +82,75 @@ func (g GeneratorImpl) executeServers(
 	conf dataplane.Configuration,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) []executeResult {
-	servers, httpMatchPairs := createServers(conf, generator, keepAliveCheck)
+	servers, httpMatchPairs := createServers(conf, generator, keepAliveCheck, sessionCookieGet)
+	debugCaptures := setDebugCaptures(servers, createAccessLog(conf.Logging.AccessLog))
+
+	serversTemplateOverride := g.parseServersTemplateOverride(
+		conf.TemplateOverrides.Server,
+		conf.TemplateOverrides.Location,
+	)
+
+	shareLocations(
+		servers,
+		slices.Concat(conf.HTTPServers, conf.SSLServers),
+		httpMatchPairs,
+		func(locations []http.Location) []byte {
+			var override *gotemplate.Template
+			if serversTemplateOverride != nil {
+				override = serversTemplateOverride.Lookup(sharedLocationsTemplateName)
+			}
+
+			return g.executeTemplateWithOverride(
+				override,
+				serversTemplate.Lookup(sharedLocationsTemplateName),
+				locations,
+			)
+		},
+	)
 
 	serverConfig := http.ServerConfig{
-		Servers:         servers,
 		IPFamily:        getIPFamily(conf.BaseHTTPConfig),
 		Plus:            g.plus,
+		HTTP3:           conf.BaseHTTPConfig.HTTP3,
 		RewriteClientIP: getRewriteClientIPSettings(conf.BaseHTTPConfig.RewriteClientIPSettings),
 	}
 
-	serverResult := executeResult{
+	// Every server is rendered into its own file, so that a change of a server only changes its file.
+	serverResults := make([]executeResult, 0, len(servers))
+	serverFiles := make([]string, 0, len(servers))
+	usedFileNames := make(map[string]int, len(servers))
+
+	for _, s := range servers {
+		serverConf := serverConfig
+		serverConf.Servers = []http.Server{s}
+
+		// the servers of the Gateways with their own NginxProxy override the settings of the data plane
+		if s.IPFamily != nil {
+			serverConf.IPFamily = *s.IPFamily
+		}
+
+		if s.RewriteClientIP != nil {
+			serverConf.RewriteClientIP = *s.RewriteClientIP
+		}
+
+		dest := createServerFilePath(s, usedFileNames)
+
+		serverResults = append(serverResults, executeResult{
+			dest: dest,
+			data: g.executeTemplateWithOverride(
+				serversTemplateOverride,
+				serversTemplate,
+				serverConf,
+			),
+		})
+		serverFiles = append(serverFiles, dest)
+	}
+
+	httpServersResult := executeResult{
 		dest: httpConfigFile,
-		data: helpers.MustExecuteTemplate(serversTemplate, serverConfig),
+		data: helpers.MustExecuteTemplate(httpServersTemplate, serverFiles),
 	}
 
 	// create httpMatchPair conf
@@ -84,17 +166,50 @@ func (g GeneratorImpl) executeServers(
 	}
 
 	includeFileResults := createIncludeExecuteResultsFromServers(servers)
+	debugCaptureResults := createDebugCaptureExecuteResults(debugCaptures)
 
-	allResults := make([]executeResult, 0, len(includeFileResults)+2)
+	allResults := make(
+		[]executeResult,
+		0,
+		len(includeFileResults)+len(debugCaptureResults)+len(serverResults)+2,
+	)
 	allResults = append(allResults, includeFileResults...)
-	allResults = append(allResults, serverResult, httpMatchResult)
+	// the log format of the debug captures must be defined before the servers that use it
+	allResults = append(allResults, debugCaptureResults...)
+	allResults = append(allResults, serverResults...)
+	allResults = append(allResults, httpServersResult, httpMatchResult)
 
 	return allResults
 }
 
+// createServerFilePath returns the path of the configuration file of the server. The name of the file is derived
+// from the status zone of the server, which is unique per port and hostname, so that the file of a server doesn't
+// change when other servers are added or removed. The wildcard in hostnames is replaced with '_', which hostnames
+// can't contain. usedFileNames tracks the names of the files to guarantee that the paths are unique.
+func createServerFilePath(s http.Server, usedFileNames map[string]int) string {
+	prefix := "http"
+	if s.IsDefaultSSL || s.SSL != nil {
+		prefix = "https"
+	}
+
+	name := prefix + "_" + strings.ReplaceAll(s.StatusZone, "*", "_")
+
+	usedFileNames[name]++
+	if count := usedFileNames[name]; count > 1 {
+		name = fmt.Sprintf("%s_%d", name, count)
+	}
+
+	return fmt.Sprintf("%s/%s.conf", httpServersFolder, name)
+}
+
 // getIPFamily returns whether the server should be configured for IPv4, IPv6, or both.
 func getIPFamily(baseHTTPConfig dataplane.BaseHTTPConfig) shared.IPFamily {
-	switch baseHTTPConfig.IPFamily {
+	return convertIPFamily(baseHTTPConfig.IPFamily)
+}
+
+// convertIPFamily converts the IP family of the dataplane configuration.
+func convertIPFamily(ipFamily dataplane.IPFamilyType) shared.IPFamily {
+	switch ipFamily {
 	case dataplane.IPv4:
 		return shared.IPFamily{IPv4: true}
 	case dataplane.IPv6:
@@ -108,6 +223,7 @@ func createServers(
 	conf dataplane.Configuration,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) ([]http.Server, httpMatchPairs) {
 	servers := make([]http.Server, 0, len(conf.HTTPServers)+len(conf.SSLServers))
 	finalMatchPairs := make(httpMatchPairs)
@@ -117,21 +233,43 @@ func createServers(
 		sharedTLSPorts[passthroughServer.Port] = struct{}{}
 	}
 
+	nativeMatches := conf.BaseHTTPConfig.NativeHTTPMatches
+
 	for idx, s := range conf.HTTPServers {
-		serverID := fmt.Sprintf("%d", idx)
-		httpServer, matchPairs := createServer(s, serverID, generator, keepAliveCheck)
+		serverID := createHTTPServerID(idx)
+		httpServer, matchPairs := createServer(
+			s,
+			serverID,
+			conf.Worker.ListenPort(s.Port),
+			nativeMatches,
+			generator,
+			keepAliveCheck,
+			sessionCookieGet,
+		)
+		setSocketOptions(&httpServer, conf.SocketOptions[s.Port])
+		setGatewaySettings(&httpServer, s.GatewaySettings)
 		servers = append(servers, httpServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
 
 	for idx, s := range conf.SSLServers {
-		serverID := fmt.Sprintf("SSL_%d", idx)
-
-		sslServer, matchPairs := createSSLServer(s, serverID, generator, keepAliveCheck)
+		serverID := createSSLServerID(idx)
+
+		sslServer, matchPairs := createSSLServer(
+			s,
+			serverID,
+			conf.Worker.ListenPort(s.Port),
+			nativeMatches,
+			generator,
+			keepAliveCheck,
+			sessionCookieGet,
+		)
 		if _, portInUse := sharedTLSPorts[s.Port]; portInUse {
 			sslServer.Listen = getSocketNameHTTPS(s.Port)
 			sslServer.IsSocket = true
 		}
+		setSocketOptions(&sslServer, conf.SocketOptions[s.Port])
+		setGatewaySettings(&sslServer, s.GatewaySettings)
 		servers = append(servers, sslServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
@@ -139,31 +277,112 @@ func createServers(
 	return servers, finalMatchPairs
 }
 
+// setSocketOptions sets the socket options of the port of the server. The options of the listening socket
+// can only be specified once for a port, so they are set only for the default server, which listens on the port
+// for all hostnames.
+func setSocketOptions(server *http.Server, opts dataplane.SocketOptions) {
+	if server.IsSocket {
+		return
+	}
+
+	if server.IsDefaultHTTP || server.IsDefaultSSL {
+		server.ListenOptions = createListenOptions(opts, false /* udp */)
+	}
+
+	server.TCPNoDelay = opts.TCPNoDelay
+	server.TCPNoPush = opts.TCPNoPush
+}
+
+// setGatewaySettings sets the settings of the Gateway of the server, which override the settings of
+// the ServerConfig.
+func setGatewaySettings(server *http.Server, settings *dataplane.GatewaySettings) {
+	if settings == nil {
+		return
+	}
+
+	ipFamily := convertIPFamily(settings.IPFamily)
+	rewriteClientIP := getRewriteClientIPSettings(settings.RewriteClientIPSettings)
+
+	server.IPFamily = &ipFamily
+	server.RewriteClientIP = &rewriteClientIP
+}
+
+// createListenOptions creates the parameters of the listen directive for the socket options, for example
+// " backlog=1024 reuseport deferred so_keepalive=on". Only reuseport applies to UDP sockets.
+func createListenOptions(opts dataplane.SocketOptions, udp bool) string {
+	var sb strings.Builder
+
+	if opts.Backlog > 0 && !udp {
+		sb.WriteString(" backlog=" + strconv.Itoa(int(opts.Backlog)))
+	}
+
+	if opts.ReusePort {
+		sb.WriteString(" reuseport")
+	}
+
+	if opts.DeferredAccept && !udp {
+		sb.WriteString(" deferred")
+	}
+
+	if opts.KeepAlive != "" && !udp {
+		sb.WriteString(" so_keepalive=" + opts.KeepAlive)
+	}
+
+	return sb.String()
+}
+
+// createHTTPServerID creates the ID of the HTTP server at the given index of the HTTP servers.
+func createHTTPServerID(idx int) string {
+	return strconv.Itoa(idx)
+}
+
+// createSSLServerID creates the ID of the SSL server at the given index of the SSL servers.
+func createSSLServerID(idx int) string {
+	return "SSL_" + strconv.Itoa(idx)
+}
+
+// createHTTPMatchKey creates the key that identifies the matches of the path rule at the given index of a server.
+func createHTTPMatchKey(serverID string, pathRuleIdx int) string {
+	return serverID + "_" + strconv.Itoa(pathRuleIdx)
+}
+
 func createSSLServer(
 	virtualServer dataplane.VirtualServer,
 	serverID string,
+	listenPort int32,
+	nativeMatches bool,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) (http.Server, httpMatchPairs) {
-	listen := fmt.Sprint(virtualServer.Port)
+	listen := fmt.Sprint(listenPort)
 	if virtualServer.IsDefault {
 		return http.Server{
 			IsDefaultSSL: true,
 			Listen:       listen,
+			StatusZone:   dataplane.DefaultServerStatusZoneName(virtualServer.Port),
+			AccessLog:    createAccessLog(virtualServer.AccessLog),
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs, matchPairs, grpc := createLocations(
+		&virtualServer,
+		serverID,
+		nativeMatches,
+		generator,
+		keepAliveCheck,
+		sessionCookieGet,
+	)
 
 	server := http.Server{
 		ServerName: virtualServer.Hostname,
-		SSL: &http.SSL{
-			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
-			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
-		},
-		Locations: locs,
-		GRPC:      grpc,
-		Listen:    listen,
+		SSL:        createSSL(virtualServer.SSL),
+		Locations:  locs,
+		GRPC:       grpc,
+		Listen:     listen,
+		Port:       fmt.Sprint(virtualServer.Port),
+		StatusZone: dataplane.StatusZoneName(virtualServer.Hostname, virtualServer.Port),
+		AccessLog:  createAccessLog(virtualServer.AccessLog),
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -181,25 +400,39 @@ func createSSLServer(
 func createServer(
 	virtualServer dataplane.VirtualServer,
 	serverID string,
+	listenPort int32,
+	nativeMatches bool,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) (http.Server, httpMatchPairs) {
-	listen := fmt.Sprint(virtualServer.Port)
+	listen := fmt.Sprint(listenPort)
 
 	if virtualServer.IsDefault {
 		return http.Server{
 			IsDefaultHTTP: true,
 			Listen:        listen,
+			StatusZone:    dataplane.DefaultServerStatusZoneName(virtualServer.Port),
+			AccessLog:     createAccessLog(virtualServer.AccessLog),
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs, matchPairs, grpc := createLocations(
+		&virtualServer,
+		serverID,
+		nativeMatches,
+		generator,
+		keepAliveCheck,
+		sessionCookieGet,
+	)
 
 	server := http.Server{
 		ServerName: virtualServer.Hostname,
 		Locations:  locs,
 		Listen:     listen,
 		GRPC:       grpc,
+		StatusZone: dataplane.StatusZoneName(virtualServer.Hostname, virtualServer.Port),
+		AccessLog:  createAccessLog(virtualServer.AccessLog),
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -223,13 +456,50 @@ type rewriteConfig struct {
 	MainRewrite string
 }
 
+// rewriteMatch is the path of a rule that the rewrites of the filters of the rule match.
+type rewriteMatch struct {
+	// path is the path of the rule. For a RegularExpression rule, it is the regular expression.
+	path string
+	// regex specifies whether the path is a regular expression.
+	regex bool
+	// caseInsensitive specifies whether the path is matched case-insensitively.
+	caseInsensitive bool
+}
+
+// prefixRegex returns the regular expression that matches the path of a Prefix rule at the start of the request path.
+// The trailing slash of the path is not part of the expression, so that the request for the path without
+// the trailing slash is rewritten as well.
+func (m rewriteMatch) prefixRegex() string {
+	regex := regexp.QuoteMeta(strings.TrimSuffix(m.path, "/"))
+	if m.caseInsensitive {
+		return "(?i)" + regex
+	}
+
+	return regex
+}
+
+// fullPathRegex returns the quoted regular expression of a RegularExpression rule that matches the whole
+// request path, so that the replacement of a rewrite can reference its capture groups.
+func (m rewriteMatch) fullPathRegex() string {
+	regex := fmt.Sprintf("^(?:%s)$", m.path)
+	if m.caseInsensitive {
+		regex = "(?i)" + regex
+	}
+
+	return fmt.Sprintf(`"%s"`, nginxStringEscaper.Replace(regex))
+}
+
 type httpMatchPairs map[string][]routeMatch
 
+// createLocations creates the locations of a server. If nativeMatches is true, the matches of the path rules
+// that can be evaluated natively are evaluated with the maps created by createHTTPMatchMaps instead of njs.
 func createLocations(
 	server *dataplane.VirtualServer,
 	serverID string,
+	nativeMatches bool,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) ([]http.Location, httpMatchPairs, bool) {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(server.PathRules)
 	locs := make([]http.Location, 0, maxLocs)
@@ -241,10 +511,14 @@ func createLocations(
 	for pathRuleIdx, rule := range server.PathRules {
 		matches := make([]routeMatch, 0, len(rule.MatchRules))
 
-		if rule.Path == rootPath {
+		if isRootLocation(rule) {
 			rootPathExists = true
 		}
 
+		if loc, ok := createTrailingSlashLocation(rule, pathsAndTypes); ok {
+			locs = append(locs, loc)
+		}
+
 		if rule.GRPC {
 			grpc = true
 		}
@@ -263,9 +537,10 @@ func createLocations(
 					extLocations,
 					r,
 					server.Port,
-					rule.Path,
+					rewritePath(rule),
 					rule.GRPC,
 					keepAliveCheck,
+					sessionCookieGet,
 				)
 			}
 
@@ -286,22 +561,27 @@ func createLocations(
 				intLocation,
 				r,
 				server.Port,
-				rule.Path,
+				rewritePath(rule),
 				rule.GRPC,
 				keepAliveCheck,
+				sessionCookieGet,
 			)
 
 			internalLocations = append(internalLocations, intLocation)
 			matches = append(matches, match)
 		}
 
-		httpMatchKey := serverID + "_" + strconv.Itoa(pathRuleIdx)
-		for i := range extLocations {
-			// FIXME(sberman): De-dupe matches and associated locations
-			// so we don't need nginx/njs to perform unnecessary matching.
-			// https://github.com/nginx/nginx-gateway-fabric/issues/662
-			extLocations[i].HTTPMatchKey = httpMatchKey
-			matchPairs[extLocations[i].HTTPMatchKey] = matches
+		httpMatchKey := createHTTPMatchKey(serverID, pathRuleIdx)
+		if nativeMatches && canMatchNatively(rule) {
+			setHTTPMatchVariable(extLocations, httpMatchKey)
+		} else {
+			for i := range extLocations {
+				// FIXME(sberman): De-dupe matches and associated locations
+				// so we don't need nginx/njs to perform unnecessary matching.
+				// https://github.com/nginx/nginx-gateway-fabric/issues/662
+				extLocations[i].HTTPMatchKey = httpMatchKey
+				matchPairs[extLocations[i].HTTPMatchKey] = matches
+			}
 		}
 
 		locs = append(locs, extLocations...)
@@ -312,6 +592,10 @@ func createLocations(
 		locs = append(locs, createDefaultRootLocation())
 	}
 
+	locs = append(locs, createMirrorLocations(server.PathRules, keepAliveCheck)...)
+	locs = append(locs, createErrorPageLocations(server.PathRules)...)
+	locs = append(locs, createGRPCHealthLocations(server.GRPCHealthChecks)...)
+
 	return locs, matchPairs, grpc
 }
 
@@ -322,27 +606,36 @@ func needsInternalLocations(rule dataplane.PathRule) bool {
 	return len(rule.MatchRules) == 1 && !isPathOnlyMatch(rule.MatchRules[0].Match)
 }
 
+// pathKey identifies a path of the path rules that are matched with the same case sensitivity.
+type pathKey struct {
+	path            string
+	caseInsensitive bool
+}
+
 // pathAndTypeMap contains a map of paths and any path types defined for that path
-// for example, {/foo: {exact: {}, prefix: {}}}.
-type pathAndTypeMap map[string]map[dataplane.PathType]struct{}
+// for example, {{path: /foo}: {exact: {}, prefix: {}}}.
+type pathAndTypeMap map[pathKey]map[dataplane.PathType]struct{}
 
 // To calculate the maximum number of locations, we need to take into account the following:
 // 1. Each match rule for a path rule will have one location.
 // 2. Each path rule may have an additional location if it contains non-path-only matches.
 // 3. Each prefix path rule may have an additional location if it doesn't contain trailing slash.
-// 4. There may be an additional location for the default root path.
+// 4. Each path rule may have an additional location for the path with an added or a removed trailing slash.
+// 5. There may be an additional location for the default root path.
 // We also return a map of all paths and their types.
 func getMaxLocationCountAndPathMap(pathRules []dataplane.PathRule) (int, pathAndTypeMap) {
 	maxLocs := 1
 	pathsAndTypes := make(pathAndTypeMap)
 	for _, rule := range pathRules {
-		maxLocs += len(rule.MatchRules) + 2
-		if pathsAndTypes[rule.Path] == nil {
-			pathsAndTypes[rule.Path] = map[dataplane.PathType]struct{}{
+		maxLocs += len(rule.MatchRules) + 3
+
+		key := pathKey{path: rule.Path, caseInsensitive: rule.CaseInsensitive}
+		if pathsAndTypes[key] == nil {
+			pathsAndTypes[key] = map[dataplane.PathType]struct{}{
 				rule.PathType: {},
 			}
 		} else {
-			pathsAndTypes[rule.Path][rule.PathType] = struct{}{}
+			pathsAndTypes[key][rule.PathType] = struct{}{}
 		}
 	}
 
@@ -355,18 +648,19 @@ func initializeExternalLocations(
 ) []http.Location {
 	extLocations := make([]http.Location, 0, 2)
 	locType := getLocationTypeForPathRule(rule)
-	externalLocPath := createPath(rule)
+	externalLocPath := createPath(rule, pathsAndTypes)
 
 	// If the path type is Prefix and doesn't contain a trailing slash, then we need a second location
 	// that handles the Exact prefix case (if it doesn't already exist), and the first location is updated
-	// to handle the trailing slash prefix case (if it doesn't already exist)
-	if isNonSlashedPrefixPath(rule.PathType, externalLocPath) {
+	// to handle the trailing slash prefix case (if it doesn't already exist).
+	// A case-insensitive path is matched by a single regular expression location that handles both cases.
+	if !rule.CaseInsensitive && isNonSlashedPrefixPath(rule.PathType, externalLocPath) {
 		// if Exact path and/or trailing slash Prefix path already exists, this means some routing rule
 		// configures it. The routing rule location has priority over this location, so we don't try to
 		// overwrite it and we don't add a duplicate location to NGINX because that will cause an NGINX config error.
-		_, exactPathExists := pathsAndTypes[rule.Path][dataplane.PathTypeExact]
+		_, exactPathExists := pathsAndTypes[pathKey{path: rule.Path}][dataplane.PathTypeExact]
 		var trailingSlashPrefixPathExists bool
-		if pathTypes, exists := pathsAndTypes[rule.Path+"/"]; exists {
+		if pathTypes, exists := pathsAndTypes[pathKey{path: rule.Path + "/"}]; exists {
 			_, trailingSlashPrefixPathExists = pathTypes[dataplane.PathTypePrefix]
 		}
 
@@ -413,20 +707,28 @@ func initializeInternalLocation(
 	match dataplane.Match,
 	grpc bool,
 ) (http.Location, routeMatch) {
-	path := fmt.Sprintf("%s-rule%d-route%d", http.InternalRoutePathPrefix, pathruleIdx, matchRuleIdx)
+	path := createInternalLocationPath(pathruleIdx, matchRuleIdx)
 	return createMatchLocation(path, grpc), createRouteMatch(match, path)
 }
 
+// createInternalLocationPath creates the path of the internal location of a match rule of a path rule.
+func createInternalLocationPath(pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("%s-rule%d-route%d", http.InternalRoutePathPrefix, pathRuleIdx, matchRuleIdx)
+}
+
 // updateLocation updates a location with any relevant configurations, like proxy_pass, filters, tls settings, etc.
 func updateLocation(
 	filters dataplane.HTTPFilters,
 	location http.Location,
 	matchRule dataplane.MatchRule,
 	listenerPort int32,
-	path string,
+	path rewriteMatch,
 	grpc bool,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) http.Location {
+	location.DebugCapture = createDebugCapture(matchRule.DebugCapture)
+
 	if filters.InvalidFilter != nil {
 		location.Return = &http.Return{Code: http.StatusInternalServerError}
 		return location
@@ -434,6 +736,10 @@ func updateLocation(
 
 	location.Includes = append(location.Includes, createIncludesFromLocationSnippetsFilters(filters.SnippetsFilters)...)
 
+	if filters.DirectResponse != nil {
+		return updateLocationForDirectResponse(location, filters)
+	}
+
 	if filters.RequestRedirect != nil {
 		ret, rewrite := createReturnAndRewriteConfigForRedirectFilter(filters.RequestRedirect, listenerPort, path)
 		if rewrite.MainRewrite != "" {
@@ -443,6 +749,10 @@ func updateLocation(
 		return location
 	}
 
+	for _, mirror := range filters.RequestMirrors {
+		location.MirrorPaths = append(location.MirrorPaths, createMirrorLocationPath(mirror))
+	}
+
 	rewrites := createRewritesValForRewriteFilter(filters.RequestURLRewrite, path)
 
 	extraHeaders := make([]http.Header, 0, 3)
@@ -455,6 +765,10 @@ func updateLocation(
 
 	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters, createBaseProxySetHeaders(extraHeaders...))
 	responseHeaders := generateResponseHeaders(&matchRule.Filters)
+	responseHeaders.Add = append(
+		responseHeaders.Add,
+		createSessionCookieHeaders(sessionCookieGet, matchRule.BackendGroup.Backends)...,
+	)
 
 	if rewrites != nil {
 		if location.Type == http.InternalLocationType && rewrites.InternalRewrite != "" {
@@ -465,6 +779,10 @@ func updateLocation(
 		}
 	}
 
+	if matchRule.StaticContent != nil {
+		return updateLocationForStaticContent(location, matchRule, rewrites)
+	}
+
 	location.ProxySetHeaders = proxySetHeaders
 	location.ProxySSLVerify = createProxyTLSFromBackends(matchRule.BackendGroup.Backends)
 	proxyPass := createProxyPass(
@@ -476,11 +794,84 @@ func updateLocation(
 
 	location.ResponseHeaders = responseHeaders
 	location.ProxyPass = proxyPass
+	location.ProxyConnectTimeout = getProxyConnectTimeout(matchRule.Timeouts)
+	location.ProxyTimeout = getProxyTimeout(matchRule.Timeouts)
+	location.ProxyNextUpstream = createProxyNextUpstream(matchRule.Retry)
+	location.ProxyBuffering = getProxyBuffering(matchRule.Streaming, grpc)
+	location.ErrorPages = createErrorPages(matchRule.ErrorPages)
+	location.BodyTransform = createBodyTransform(filters.BodyTransform)
+	location.RequestBodyBufferSize = getRequestBodyBufferSize(filters)
 	location.GRPC = grpc
 
 	return location
 }
 
+// getProxyBuffering returns the value of the proxy_buffering directive of a location. It is empty if the responses
+// are buffered by default. NGINX doesn't buffer the responses of gRPC calls.
+func getProxyBuffering(streaming, grpc bool) string {
+	if !streaming || grpc {
+		return ""
+	}
+
+	return "off"
+}
+
+// updateLocationForDirectResponse configures the location to respond with the DirectResponse of the filters
+// instead of proxying the requests to the backends.
+func updateLocationForDirectResponse(location http.Location, filters dataplane.HTTPFilters) http.Location {
+	dr := filters.DirectResponse
+
+	location.Return = &http.Return{
+		Code: http.StatusCode(dr.StatusCode),
+		Body: directResponseBodyEscaper.Replace(dr.Body),
+	}
+	location.DefaultType = dr.ContentType
+	location.ResponseHeaders = generateResponseHeaders(&filters)
+
+	return location
+}
+
+// directResponseBodyEscaper escapes the body of a DirectResponse for a double-quoted NGINX string.
+var directResponseBodyEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// updateLocationForStaticContent configures the location to serve the static content of the MatchRule
+// instead of proxying the requests to the backends.
+func updateLocationForStaticContent(
+	location http.Location,
+	matchRule dataplane.MatchRule,
+	rewrites *rewriteConfig,
+) http.Location {
+	// The files are looked up by the URI of the request. For an internal location, the URI must be restored
+	// to the original URI, unless the URLRewrite filter already does it. The break flag prevents NGINX
+	// from searching for a location for the restored URI.
+	if location.Type == http.InternalLocationType && (rewrites == nil || rewrites.MainRewrite == "") {
+		location.Rewrites = append(location.Rewrites, "^ $request_uri break")
+	}
+
+	content := matchRule.StaticContent
+
+	root := content.Path
+	if content.ID != "" {
+		root = generateStaticContentFolderName(content.ID)
+	}
+
+	// The index file is tried explicitly, so that NGINX doesn't redirect the requests for a directory
+	// to the index file, which would restart the matching of the request.
+	tryFiles := []string{"$uri/" + content.Index, "$uri"}
+	if content.Fallback != "" {
+		tryFiles = append(tryFiles, "/"+content.Fallback)
+	}
+
+	location.StaticContent = &http.StaticContent{
+		Root:     root,
+		Index:    content.Index,
+		TryFiles: tryFiles,
+	}
+	location.ResponseHeaders = generateResponseHeaders(&matchRule.Filters)
+
+	return location
+}
+
 // updateLocations updates the existing locations with any relevant configurations, like proxy_pass,
 // filters, tls settings, etc.
 func updateLocations(
@@ -488,14 +879,24 @@ func updateLocations(
 	buildLocations []http.Location,
 	matchRule dataplane.MatchRule,
 	listenerPort int32,
-	path string,
+	path rewriteMatch,
 	grpc bool,
 	keepAliveCheck keepAliveChecker,
+	sessionCookieGet sessionCookieGetter,
 ) []http.Location {
 	updatedLocations := make([]http.Location, len(buildLocations))
 
 	for i, loc := range buildLocations {
-		updatedLocations[i] = updateLocation(filters, loc, matchRule, listenerPort, path, grpc, keepAliveCheck)
+		updatedLocations[i] = updateLocation(
+			filters,
+			loc,
+			matchRule,
+			listenerPort,
+			path,
+			grpc,
+			keepAliveCheck,
+			sessionCookieGet,
+		)
 	}
 
 	return updatedLocations
@@ -514,6 +915,20 @@ func generateProtocolString(ssl *http.ProxySSLVerify, grpc bool) string {
 	return "grpc"
 }
 
+func createSSL(ssl *dataplane.SSL) *http.SSL {
+	s := &http.SSL{
+		Certificate:    generatePEMFileName(ssl.KeyPairID),
+		CertificateKey: generatePEMFileName(ssl.KeyPairID),
+		OCSPStapling:   ssl.OCSPStapling,
+	}
+
+	if ssl.OCSPTrustedCertBundleID != "" {
+		s.OCSPTrustedCertificate = generateCertBundleFileName(ssl.OCSPTrustedCertBundleID)
+	}
+
+	return s
+}
+
 func createProxyTLSFromBackends(backends []dataplane.Backend) *http.ProxySSLVerify {
 	if len(backends) == 0 {
 		return nil
@@ -549,7 +964,7 @@ func createProxySSLVerify(v *dataplane.VerifyTLS) *http.ProxySSLVerify {
 func createReturnAndRewriteConfigForRedirectFilter(
 	filter *dataplane.HTTPRequestRedirectFilter,
 	listenerPort int32,
-	path string,
+	path rewriteMatch,
 ) (*http.Return, *rewriteConfig) {
 	if filter == nil {
 		return nil, nil
@@ -603,7 +1018,7 @@ func createReturnAndRewriteConfigForRedirectFilter(
 	}, rewrites
 }
 
-func createMainRewriteForFilters(pathModifier *dataplane.HTTPPathModifier, path string) string {
+func createMainRewriteForFilters(pathModifier *dataplane.HTTPPathModifier, path rewriteMatch) string {
 	var mainRewrite string
 	switch pathModifier.Type {
 	case dataplane.ReplaceFullPath:
@@ -614,33 +1029,27 @@ func createMainRewriteForFilters(pathModifier *dataplane.HTTPPathModifier, path
 			filterPrefix = "/"
 		}
 
-		// capture everything following the configured prefix up to the first ?, if present.
-		regex := fmt.Sprintf("^%s([^?]*)?", path)
-		// replace the configured prefix with the filter prefix, append the captured segment,
-		// and include the request arguments stored in nginx variable $args.
-		// https://nginx.org/en/docs/http/ngx_http_core_module.html#var_args
-		replacement := fmt.Sprintf("%s$1?$args?", filterPrefix)
+		// capture the slash and everything following the configured prefix up to the first ?, if present.
+		// The configured prefix matches the request path with or without its trailing slash.
+		regex := fmt.Sprintf("^%s(/[^?]*)?", path.prefixRegex())
 
-		// if configured prefix does not end in /, but replacement prefix does end in /,
-		// then make sure that we *require* but *don't capture* a trailing slash in the request,
-		// otherwise we'll get duplicate slashes in the full replacement
-		if strings.HasSuffix(filterPrefix, "/") && !strings.HasSuffix(path, "/") {
-			regex = fmt.Sprintf("^%s(?:/([^?]*))?", path)
+		// if the replacement prefix ends in /, then make sure that we *require* but *don't capture*
+		// the slash that follows the configured prefix in the request, otherwise we'll get duplicate slashes
+		// in the full replacement
+		if strings.HasSuffix(filterPrefix, "/") {
+			regex = fmt.Sprintf("^%s(?:/([^?]*))?", path.prefixRegex())
 		}
 
-		// if configured prefix ends in / we won't capture it for a request (since it's not in the regex),
-		// so append it to the replacement prefix if the replacement prefix doesn't already end in /
-		if strings.HasSuffix(path, "/") && !strings.HasSuffix(filterPrefix, "/") {
-			replacement = fmt.Sprintf("%s/$1?$args?", filterPrefix)
-		}
-
-		mainRewrite = fmt.Sprintf("%s %s", regex, replacement)
+		// replace the configured prefix with the filter prefix, append the captured segment,
+		// and include the request arguments stored in nginx variable $args.
+		// https://nginx.org/en/docs/http/ngx_http_core_module.html#var_args
+		mainRewrite = fmt.Sprintf("%s %s$1?$args?", regex, filterPrefix)
 	}
 
 	return mainRewrite
 }
 
-func createRewritesValForRewriteFilter(filter *dataplane.HTTPURLRewriteFilter, path string) *rewriteConfig {
+func createRewritesValForRewriteFilter(filter *dataplane.HTTPURLRewriteFilter, path rewriteMatch) *rewriteConfig {
 	if filter == nil {
 		return nil
 	}
@@ -649,8 +1058,21 @@ func createRewritesValForRewriteFilter(filter *dataplane.HTTPURLRewriteFilter, p
 	if filter.Path != nil {
 		rewrites.InternalRewrite = "^ $request_uri"
 
+		mainRewrite := createMainRewriteForFilters(filter.Path, path)
+
+		// the full path of a RegularExpression rule can reference the capture groups of the expression,
+		// so the rewrite matches the expression instead of any path. The replacement is quoted,
+		// because the references like ${name} contain braces.
+		if filter.Path.Type == dataplane.ReplaceFullPath && path.regex {
+			mainRewrite = fmt.Sprintf(
+				`%s "%s"`,
+				path.fullPathRegex(),
+				nginxStringEscaper.Replace(filter.Path.Replacement),
+			)
+		}
+
 		// for URLRewriteFilter, we add a break to the rewrite to prevent further processing of the request.
-		rewrites.MainRewrite = fmt.Sprintf("%s break", createMainRewriteForFilters(filter.Path, path))
+		rewrites.MainRewrite = fmt.Sprintf("%s break", mainRewrite)
 	}
 
 	return rewrites
@@ -760,6 +1182,192 @@ func createProxyPass(
 	return protocol + "://" + backendName + requestURI
 }
 
+// createMirrorLocations creates the internal locations that the requests of the server are mirrored to.
+// Mirror filters with the same backend and percentage share a single location.
+func createMirrorLocations(pathRules []dataplane.PathRule, keepAliveCheck keepAliveChecker) []http.Location {
+	var locs []http.Location
+	seen := make(map[string]struct{})
+
+	for _, rule := range pathRules {
+		for _, r := range rule.MatchRules {
+			if r.Filters.InvalidFilter != nil || r.Filters.RequestRedirect != nil {
+				continue
+			}
+
+			for _, mirror := range r.Filters.RequestMirrors {
+				path := createMirrorLocationPath(mirror)
+				if _, exists := seen[path]; exists {
+					continue
+				}
+				seen[path] = struct{}{}
+
+				backends := []dataplane.Backend{{UpstreamName: mirror.UpstreamName}}
+				proxySSLVerify := createProxySSLVerify(mirror.VerifyTLS)
+				protocol := generateProtocolString(proxySSLVerify, false)
+
+				locs = append(locs, http.Location{
+					Path: path,
+					Type: http.InternalLocationType,
+					ProxySetHeaders: createBaseProxySetHeaders(
+						httpUpgradeHeader,
+						getConnectionHeader(keepAliveCheck, backends),
+					),
+					ProxySSLVerify:                 proxySSLVerify,
+					ProxyPass:                      protocol + "://" + mirror.UpstreamName + "$request_uri",
+					MirrorSplitClientsVariableName: createMirrorSplitClientsVariableName(mirror),
+				})
+			}
+		}
+	}
+
+	return locs
+}
+
+// createErrorPages creates the error pages of a location that proxies the requests to the backends.
+// A custom response is served by the internal location of the error page, while a redirect is sent
+// to the client directly.
+func createErrorPages(errorPages []dataplane.ErrorPage) []http.ErrorPage {
+	if len(errorPages) == 0 {
+		return nil
+	}
+
+	result := make([]http.ErrorPage, 0, len(errorPages))
+
+	for _, ep := range errorPages {
+		errorPage := http.ErrorPage{Codes: ep.Codes}
+
+		switch {
+		case ep.Response != nil:
+			errorPage.URI = createErrorPageLocationPath(ep)
+			errorPage.ResponseCode = ep.Response.StatusCode
+		case ep.Redirect != nil:
+			errorPage.URI = ep.Redirect.URL
+			errorPage.ResponseCode = ep.Redirect.StatusCode
+		default:
+			continue
+		}
+
+		result = append(result, errorPage)
+	}
+
+	return result
+}
+
+// createErrorPageLocations creates the internal locations that serve the custom responses of the error pages
+// of the path rules.
+func createErrorPageLocations(pathRules []dataplane.PathRule) []http.Location {
+	var locs []http.Location
+	seen := make(map[string]struct{})
+
+	for _, rule := range pathRules {
+		for _, r := range rule.MatchRules {
+			if r.Filters.InvalidFilter != nil || r.Filters.RequestRedirect != nil || r.StaticContent != nil {
+				continue
+			}
+
+			for _, ep := range r.ErrorPages {
+				if ep.Response == nil {
+					continue
+				}
+
+				path := createErrorPageLocationPath(ep)
+				if _, exists := seen[path]; exists {
+					continue
+				}
+				seen[path] = struct{}{}
+
+				// The status code of the response is set by the error_page directive, so that the status code
+				// of the replaced response can be kept.
+				locs = append(locs, http.Location{
+					Path:        path,
+					Type:        http.InternalLocationType,
+					DefaultType: ep.Response.ContentType,
+					Return: &http.Return{
+						Code: http.StatusOK,
+						Body: nginxStringEscaper.Replace(ep.Response.Body),
+					},
+				})
+			}
+		}
+	}
+
+	return locs
+}
+
+// createErrorPageLocationPath returns the path of the internal location that serves the custom response
+// of the error page.
+func createErrorPageLocationPath(errorPage dataplane.ErrorPage) string {
+	return fmt.Sprintf("%s-error-page_%s", http.InternalRoutePathPrefix, errorPage.Name)
+}
+
+// createMirrorLocationPath returns the path of the internal location that requests are mirrored to.
+func createMirrorLocationPath(mirror dataplane.HTTPRequestMirrorFilter) string {
+	path := fmt.Sprintf("%s-mirror-%s", http.InternalRoutePathPrefix, mirror.UpstreamName)
+	if mirror.Percent != nil {
+		path += "-" + formatMirrorPercent(*mirror.Percent)
+	}
+
+	return path
+}
+
+func formatMirrorPercent(percent float64) string {
+	return fmt.Sprintf("%.2f", percent)
+}
+
+// getProxyConnectTimeout returns the timeout for connecting to a backend. Only the backendRequest timeout
+// limits the connection, because it applies to a single request to a backend, while establishing a connection
+// shouldn't take as long as the whole request. Returns an empty string if the backendRequest timeout is not set,
+// so that the NGINX default is used.
+func getProxyConnectTimeout(timeouts *dataplane.HTTPTimeouts) string {
+	if timeouts == nil {
+		return ""
+	}
+
+	return timeouts.BackendRequest
+}
+
+// getProxyTimeout returns the timeout for sending a request to, and reading a response from a backend.
+// NGINX doesn't have a timeout for an entire request, so the timeouts of a single proxied request
+// are the closest equivalent. The backendRequest timeout is preferred because it applies to a single request
+// to a backend.
+func getProxyTimeout(timeouts *dataplane.HTTPTimeouts) string {
+	if timeouts == nil {
+		return ""
+	}
+
+	if timeouts.BackendRequest != "" {
+		return timeouts.BackendRequest
+	}
+
+	return timeouts.Request
+}
+
+// getRequestBodyBufferSize returns the size of the buffer of the request bodies of a location, so that the filters
+// that inspect the request bodies receive the whole bodies up to the largest of their maximum sizes in memory.
+// The larger bodies are written to a file, and the filters pass them through uninspected.
+// Returns 0 if no filter inspects the request bodies.
+func getRequestBodyBufferSize(filters dataplane.HTTPFilters) int64 {
+	var size int64
+
+	if bt := filters.BodyTransform; bt != nil && bt.RequestScript != "" {
+		size = max(size, bt.MaxRequestBodySize)
+	}
+
+	return size
+}
+
+func createProxyNextUpstream(retry *dataplane.HTTPRetry) *http.ProxyNextUpstream {
+	if retry == nil {
+		return nil
+	}
+
+	return &http.ProxyNextUpstream{
+		Conditions: retry.Conditions,
+		Tries:      retry.Tries,
+		Timeout:    retry.Timeout,
+	}
+}
+
 func createMatchLocation(path string, grpc bool) http.Location {
 	var rewrites []string
 	if grpc {
@@ -833,6 +1441,26 @@ func generateResponseHeaders(filters *dataplane.HTTPFilters) http.ResponseHeader
 	}
 }
 
+// createSessionCookieHeaders creates the Set-Cookie response headers for the session cookies of the backends
+// with session persistence.
+func createSessionCookieHeaders(sessionCookieGet sessionCookieGetter, backends []dataplane.Backend) []http.Header {
+	var headers []http.Header
+
+	for _, backend := range backends {
+		cookieVar := sessionCookieGet(backend.UpstreamName)
+		if cookieVar == "" {
+			continue
+		}
+
+		header := http.Header{Name: "Set-Cookie", Value: cookieVar}
+		if !slices.Contains(headers, header) {
+			headers = append(headers, header)
+		}
+	}
+
+	return headers
+}
+
 func createHeadersWithVarName(headers []dataplane.HTTPHeader) []http.Header {
 	locHeaders := make([]http.Header, 0, len(headers))
 	for _, h := range headers {
@@ -860,16 +1488,166 @@ func exactPath(path string) string {
 	return fmt.Sprintf("= %s", path)
 }
 
+// regexPath builds the path of a regular expression location that matches the whole request path.
+// The expression never matches the internal locations, so that it doesn't intercept the requests NGINX
+// redirects to them. Because the expression is quoted, backslashes and double quotes are escaped.
+func regexPath(regex string, caseInsensitive bool) string {
+	modifier := "~"
+	if caseInsensitive {
+		modifier = "~*"
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(regex)
+
+	return fmt.Sprintf(`%s "^(?!%s)(?:%s)$"`, modifier, http.InternalRoutePathPrefix, escaped)
+}
+
+// caseInsensitivePathRegex builds the regular expression that matches an Exact or a Prefix path case-insensitively.
+// Because NGINX checks regular expression locations after the prefix locations, the expression of a Prefix path
+// excludes the requests for the case-sensitive Prefix paths that start with the path, so that
+// the longer or the case-sensitive Prefix path keeps the precedence.
+func caseInsensitivePathRegex(rule dataplane.PathRule, pathsAndTypes pathAndTypeMap) string {
+	regex := regexp.QuoteMeta(rule.Path)
+	if rule.PathType != dataplane.PathTypePrefix {
+		return regex
+	}
+
+	if strings.HasSuffix(rule.Path, "/") {
+		regex += ".*"
+	} else {
+		regex += "(?:/.*)?"
+	}
+
+	var exclusions []string
+	for key, pathTypes := range pathsAndTypes {
+		if _, prefix := pathTypes[dataplane.PathTypePrefix]; !prefix || key.caseInsensitive {
+			continue
+		}
+
+		if !strings.HasPrefix(strings.ToLower(key.path), strings.ToLower(rule.Path)) {
+			continue
+		}
+
+		// a case-sensitive Prefix path without a trailing slash matches the path and its subpaths
+		exclusion := fmt.Sprintf("(?-i:%s)", regexp.QuoteMeta(key.path))
+		if !strings.HasSuffix(key.path, "/") {
+			exclusion += "(?:/|$)"
+		}
+
+		exclusions = append(exclusions, exclusion)
+	}
+
+	if len(exclusions) == 0 {
+		return regex
+	}
+
+	slices.Sort(exclusions)
+
+	return fmt.Sprintf("(?!%s)%s", strings.Join(exclusions, "|"), regex)
+}
+
 // createPath builds the location path depending on the path type.
-func createPath(rule dataplane.PathRule) string {
-	switch rule.PathType {
-	case dataplane.PathTypeExact:
+func createPath(rule dataplane.PathRule, pathsAndTypes pathAndTypeMap) string {
+	switch {
+	case rule.PathType == dataplane.PathTypeRegularExpression:
+		return regexPath(rule.Path, rule.CaseInsensitive)
+	case rule.CaseInsensitive:
+		return regexPath(caseInsensitivePathRegex(rule, pathsAndTypes), true)
+	case rule.PathType == dataplane.PathTypeExact:
 		return exactPath(rule.Path)
 	default:
 		return rule.Path
 	}
 }
 
+// rewritePath returns the path that the rewrites of the filters of the rule match.
+func rewritePath(rule dataplane.PathRule) rewriteMatch {
+	return rewriteMatch{
+		path:            rule.Path,
+		regex:           rule.PathType == dataplane.PathTypeRegularExpression,
+		caseInsensitive: rule.CaseInsensitive,
+	}
+}
+
+// createTrailingSlashLocation creates the location for the requests for the path of the rule with an added or
+// a removed trailing slash, if the rule is configured to handle such requests. The location is not created if
+// another rule configures the path of the location, because that rule has priority.
+func createTrailingSlashLocation(rule dataplane.PathRule, pathsAndTypes pathAndTypeMap) (http.Location, bool) {
+	if rule.TrailingSlash == "" || rule.Path == rootPath {
+		return http.Location{}, false
+	}
+
+	var altPath string
+
+	switch rule.PathType {
+	case dataplane.PathTypeExact:
+		if strings.HasSuffix(rule.Path, "/") {
+			altPath = strings.TrimSuffix(rule.Path, "/")
+		} else {
+			altPath = rule.Path + "/"
+		}
+	case dataplane.PathTypePrefix:
+		// a Prefix path without a trailing slash already matches the path with a trailing slash
+		if !strings.HasSuffix(rule.Path, "/") {
+			return http.Location{}, false
+		}
+		altPath = strings.TrimSuffix(rule.Path, "/")
+	default:
+		return http.Location{}, false
+	}
+
+	altKey := pathKey{path: altPath, caseInsensitive: rule.CaseInsensitive}
+	if pathConfigured(pathsAndTypes[altKey]) {
+		return http.Location{}, false
+	}
+
+	// a Prefix path without a trailing slash also matches the path with a trailing slash
+	if strings.HasSuffix(altPath, "/") {
+		trimmedKey := pathKey{path: strings.TrimSuffix(altPath, "/"), caseInsensitive: rule.CaseInsensitive}
+		if _, exists := pathsAndTypes[trimmedKey][dataplane.PathTypePrefix]; exists {
+			return http.Location{}, false
+		}
+	}
+
+	loc := http.Location{
+		Path: createPath(
+			dataplane.PathRule{
+				Path:            altPath,
+				PathType:        dataplane.PathTypeExact,
+				CaseInsensitive: rule.CaseInsensitive,
+			},
+			pathsAndTypes,
+		),
+		Type: http.ExternalLocationType,
+	}
+
+	switch rule.TrailingSlash {
+	case dataplane.TrailingSlashModeRedirect:
+		loc.Return = &http.Return{
+			Code: http.StatusMovedPermanently,
+			Body: rule.Path + "$is_args$args",
+		}
+	case dataplane.TrailingSlashModeNormalize:
+		// the rewritten request is handled by the location of the path
+		loc.Rewrites = []string{fmt.Sprintf("^ %s last", rule.Path)}
+	}
+
+	return loc, true
+}
+
+// pathConfigured returns true if the path types contain an Exact or a Prefix path.
+func pathConfigured(pathTypes map[dataplane.PathType]struct{}) bool {
+	_, exact := pathTypes[dataplane.PathTypeExact]
+	_, prefix := pathTypes[dataplane.PathTypePrefix]
+
+	return exact || prefix
+}
+
+// isRootLocation returns true if the rule configures a location for the root path that is not a regular expression.
+func isRootLocation(rule dataplane.PathRule) bool {
+	return rule.Path == rootPath && rule.PathType != dataplane.PathTypeRegularExpression && !rule.CaseInsensitive
+}
+
 func createDefaultRootLocation() http.Location {
 	return http.Location{
 		Path:   "/",
@@ -1,18 +1,37 @@
 package config
 
 const serversTemplateText = `
-js_preload_object matches from /etc/nginx/conf.d/matches.json;
-
 {{- range $s := .Servers -}}
     {{ if $s.IsDefaultSSL -}}
 server {
         {{- if or ($.IPFamily.IPv4) ($s.IsSocket) }}
-    listen {{ $s.Listen }} ssl default_server{{ $.RewriteClientIP.ProxyProtocol }};
+    listen {{ $s.Listen }} ssl default_server{{ $s.ListenOptions }}{{ $.RewriteClientIP.ProxyProtocol }};
         {{- end }}
         {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
-    listen [::]:{{ $s.Listen }} ssl default_server{{ $.RewriteClientIP.ProxyProtocol }};
+    listen [::]:{{ $s.Listen }} ssl default_server{{ $s.ListenOptions }}{{ $.RewriteClientIP.ProxyProtocol }};
+        {{- end }}
+        {{- if and ($.HTTP3) (not $s.IsSocket) }}
+          {{- if $.IPFamily.IPv4 }}
+    listen {{ $s.Listen }} quic reuseport default_server;
+          {{- end }}
+          {{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ $s.Listen }} quic reuseport default_server;
+          {{- end }}
+    http3 on;
+        {{- end }}
+        {{- if $s.TCPNoDelay }}
+    tcp_nodelay {{ $s.TCPNoDelay }};
+        {{- end }}
+        {{- if $s.TCPNoPush }}
+    tcp_nopush {{ $s.TCPNoPush }};
         {{- end }}
     ssl_reject_handshake on;
+        {{- if $.Plus }}
+    status_zone {{ $s.StatusZone }};
+        {{- end }}
+        {{- if $s.AccessLog }}
+    access_log {{ $s.AccessLog }};
+        {{- end }}
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
         {{- end}}
@@ -26,10 +45,22 @@ server {
     {{- else if $s.IsDefaultHTTP }}
 server {
         {{- if $.IPFamily.IPv4 }}
-    listen {{ $s.Listen }} default_server{{ $.RewriteClientIP.ProxyProtocol }};
+    listen {{ $s.Listen }} default_server{{ $s.ListenOptions }}{{ $.RewriteClientIP.ProxyProtocol }};
         {{- end }}
         {{- if $.IPFamily.IPv6 }}
-    listen [::]:{{ $s.Listen }} default_server{{ $.RewriteClientIP.ProxyProtocol }};
+    listen [::]:{{ $s.Listen }} default_server{{ $s.ListenOptions }}{{ $.RewriteClientIP.ProxyProtocol }};
+        {{- end }}
+        {{- if $s.TCPNoDelay }}
+    tcp_nodelay {{ $s.TCPNoDelay }};
+        {{- end }}
+        {{- if $s.TCPNoPush }}
+    tcp_nopush {{ $s.TCPNoPush }};
+        {{- end }}
+        {{- if $.Plus }}
+    status_zone {{ $s.StatusZone }};
+        {{- end }}
+        {{- if $s.AccessLog }}
+    access_log {{ $s.AccessLog }};
         {{- end }}
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
@@ -52,8 +83,25 @@ server {
           {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
     listen [::]:{{ $s.Listen }} ssl{{ $.RewriteClientIP.ProxyProtocol }};
           {{- end }}
+          {{- if and ($.HTTP3) (not $s.IsSocket) }}
+            {{- if $.IPFamily.IPv4 }}
+    listen {{ $s.Listen }} quic;
+            {{- end }}
+            {{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ $s.Listen }} quic;
+            {{- end }}
+    http3 on;
+    add_header Alt-Svc 'h3=":{{ $s.Port }}"; ma=86400' always;
+          {{- end }}
     ssl_certificate {{ $s.SSL.Certificate }};
     ssl_certificate_key {{ $s.SSL.CertificateKey }};
+          {{- if $s.SSL.OCSPStapling }}
+    ssl_stapling on;
+            {{- if $s.SSL.OCSPTrustedCertificate }}
+    ssl_stapling_verify on;
+    ssl_trusted_certificate {{ $s.SSL.OCSPTrustedCertificate }};
+            {{- end }}
+          {{- end }}
 
     if ($ssl_server_name != $host) {
         return 421;
@@ -68,9 +116,18 @@ server {
         {{- end }}
 
     server_name {{ $s.ServerName }};
+        {{- if $s.TCPNoDelay }}
+    tcp_nodelay {{ $s.TCPNoDelay }};
+        {{- end }}
+        {{- if $s.TCPNoPush }}
+    tcp_nopush {{ $s.TCPNoPush }};
+        {{- end }}
 
         {{- if $.Plus }}
-    status_zone {{ $s.ServerName }};
+    status_zone {{ $s.StatusZone }};
+        {{- end }}
+        {{- if $s.AccessLog }}
+    access_log {{ $s.AccessLog }};
         {{- end }}
 
         {{- range $i := $s.Includes }}
@@ -87,78 +144,192 @@ server {
     real_ip_recursive on;
         {{- end }}
 
-        {{ range $l := $s.Locations }}
-    location {{ $l.Path }} {
-        {{ if eq $l.Type "internal" -}}
+        {{ if $s.SharedLocations }}
+    include {{ $s.SharedLocations.Name }};
+        {{- else }}
+            {{- range $l := $s.Locations }}
+                {{- template "location" $l }}
+            {{- end }}
+        {{- end }}
+
+        {{- if $s.GRPC }}
+        include /etc/nginx/grpc-error-locations.conf;
+        {{- end }}
+}
+    {{- end }}
+{{ end }}
+`
+
+// httpServersTemplateText renders the http context part of the servers: it includes the file of every server
+// and defines the servers that the upstreams without endpoints use.
+const httpServersTemplateText = `
+js_preload_object matches from /etc/nginx/conf.d/matches.json;
+{{ range $file := . }}
+include {{ $file }};
+{{- end }}
+
+server {
+    listen unix:/var/run/nginx/nginx-503-server.sock;
+    access_log off;
+
+    return 503;
+}
+
+server {
+    listen unix:/var/run/nginx/nginx-500-server.sock;
+    access_log off;
+
+    return 500;
+}
+`
+
+// sharedLocationsTemplateText renders the locations shared by multiple servers into an include file.
+const sharedLocationsTemplateText = `
+{{- range $l := . }}
+    {{- template "location" $l }}
+{{- end }}
+`
+
+// locationTemplateText is executed for every location of a server. It is defined separately from the servers template
+// so that it can be overridden on its own.
+const locationTemplateText = `
+    location {{ $.Path }} {
+        {{ if eq $.Type "internal" -}}
         internal;
         {{ end }}
 
-        {{- range $i := $l.Includes }}
+        {{- if $.DebugCapture }}
+        set $debug_capture_id {{ $.DebugCapture.ID }};
+        access_log /dev/stdout debug_capture if=$debug_capture;
+            {{- if $.DebugCapture.AccessLog }}
+        access_log {{ $.DebugCapture.AccessLog }};
+            {{- end }}
+        {{- end }}
+
+        {{- range $i := $.Includes }}
         include {{ $i.Name }};
         {{- end -}}
 
-        {{ range $r := $l.Rewrites }}
+        {{ range $r := $.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
 
-        {{- if $l.Return }}
-        return {{ $l.Return.Code }} "{{ $l.Return.Body }}";
+        {{- if $.DefaultType }}
+        default_type {{ $.DefaultType }};
+        {{- end }}
+
+        {{- if $.Return }}
+            {{- range $h := $.ResponseHeaders.Add }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+            {{- range $h := $.ResponseHeaders.Set }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+        return {{ $.Return.Code }} "{{ $.Return.Body }}";
         {{- end }}
 
-        {{- if eq $l.Type "redirect" }}
-        set $match_key {{ $l.HTTPMatchKey }};
+        {{- if eq $.Type "redirect" }}
+            {{- if $.HTTPMatchVariable }}
+        if ({{ $.HTTPMatchVariable }} = "") {
+            return 404;
+        }
+        rewrite ^ {{ $.HTTPMatchVariable }} last;
+            {{- else }}
+        set $match_key {{ $.HTTPMatchKey }};
         js_content httpmatches.redirect;
+            {{- end }}
         {{- end }}
 
-        {{ $proxyOrGRPC := "proxy" }}{{ if $l.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
+        {{- if $.MirrorSplitClientsVariableName }}
+        if (${{ $.MirrorSplitClientsVariableName }} = "off") {
+            return 204;
+        }
+        {{- end }}
 
-        {{- if $l.GRPC }}
+        {{- range $m := $.MirrorPaths }}
+        mirror {{ $m }};
+        {{- end }}
+        {{- if $.MirrorPaths }}
+        mirror_request_body on;
+        {{- end }}
+        {{- if $.RequestBodyBufferSize }}
+        client_body_buffer_size {{ $.RequestBodyBufferSize }};
+        {{- end }}
+
+        {{- if $.StaticContent }}
+        root {{ $.StaticContent.Root }};
+        index {{ $.StaticContent.Index }};
+        try_files{{ range $f := $.StaticContent.TryFiles }} {{ $f }}{{ end }} =404;
+            {{- range $h := $.ResponseHeaders.Add }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+            {{- range $h := $.ResponseHeaders.Set }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+        {{- end }}
+
+        {{ $proxyOrGRPC := "proxy" }}{{ if $.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
+
+        {{- if $.GRPC }}
         include /etc/nginx/grpc-error-pages.conf;
         {{- end }}
 
         proxy_http_version 1.1;
-        {{- if $l.ProxyPass -}}
-            {{ range $h := $l.ProxySetHeaders }}
+        {{- if $.ProxyPass -}}
+            {{ range $h := $.ProxySetHeaders }}
         {{ $proxyOrGRPC }}_set_header {{ $h.Name }} "{{ $h.Value }}";
             {{- end }}
-        {{ $proxyOrGRPC }}_pass {{ $l.ProxyPass }};
-            {{ range $h := $l.ResponseHeaders.Add }}
+            {{- if $.ProxyConnectTimeout }}
+        {{ $proxyOrGRPC }}_connect_timeout {{ $.ProxyConnectTimeout }};
+            {{- end }}
+            {{- if $.ProxyTimeout }}
+        {{ $proxyOrGRPC }}_send_timeout {{ $.ProxyTimeout }};
+        {{ $proxyOrGRPC }}_read_timeout {{ $.ProxyTimeout }};
+            {{- end }}
+            {{- if $.ProxyNextUpstream }}
+        {{ $proxyOrGRPC }}_next_upstream{{ range $c := $.ProxyNextUpstream.Conditions }} {{ $c }}{{ end }};
+                {{- if $.ProxyNextUpstream.Tries }}
+        {{ $proxyOrGRPC }}_next_upstream_tries {{ $.ProxyNextUpstream.Tries }};
+                {{- end }}
+                {{- if $.ProxyNextUpstream.Timeout }}
+        {{ $proxyOrGRPC }}_next_upstream_timeout {{ $.ProxyNextUpstream.Timeout }};
+                {{- end }}
+            {{- end }}
+        {{ $proxyOrGRPC }}_pass {{ $.ProxyPass }};
+            {{- if $.ProxyBuffering }}
+        proxy_buffering {{ $.ProxyBuffering }};
+            {{- end }}
+            {{- if $.BodyTransform }}
+                {{- if $.BodyTransform.RequestBody }}
+        proxy_set_body {{ $.BodyTransform.RequestBody }};
+                {{- end }}
+                {{- if $.BodyTransform.ResponseModule }}
+        js_header_filter {{ $.BodyTransform.ResponseModule }}.responseHeaders;
+        js_body_filter {{ $.BodyTransform.ResponseModule }}.responseBody buffer_type=buffer;
+                {{- end }}
+            {{- end }}
+            {{- if $.ErrorPages }}
+        {{ $proxyOrGRPC }}_intercept_errors on;
+                {{- range $e := $.ErrorPages }}
+        error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}
+                    {{- if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} "{{ $e.URI }}";
+                {{- end }}
+            {{- end }}
+            {{ range $h := $.ResponseHeaders.Add }}
         add_header {{ $h.Name }} "{{ $h.Value }}" always;
             {{- end }}
-            {{ range $h := $l.ResponseHeaders.Set }}
-        proxy_hide_header {{ $h.Name }};
+            {{ range $h := $.ResponseHeaders.Set }}
+        {{ $proxyOrGRPC }}_hide_header {{ $h.Name }};
         add_header {{ $h.Name }} "{{ $h.Value }}" always;
             {{- end }}
-            {{ range $h := $l.ResponseHeaders.Remove }}
-        proxy_hide_header {{ $h }};
+            {{ range $h := $.ResponseHeaders.Remove }}
+        {{ $proxyOrGRPC }}_hide_header {{ $h }};
             {{- end }}
-            {{- if $l.ProxySSLVerify }}
+            {{- if $.ProxySSLVerify }}
         {{ $proxyOrGRPC }}_ssl_server_name on;
         {{ $proxyOrGRPC }}_ssl_verify on;
-        {{ $proxyOrGRPC }}_ssl_name {{ $l.ProxySSLVerify.Name }};
-        {{ $proxyOrGRPC }}_ssl_trusted_certificate {{ $l.ProxySSLVerify.TrustedCertificate }};
+        {{ $proxyOrGRPC }}_ssl_name {{ $.ProxySSLVerify.Name }};
+        {{ $proxyOrGRPC }}_ssl_trusted_certificate {{ $.ProxySSLVerify.TrustedCertificate }};
             {{- end }}
         {{- end }}
-    }
-        {{- end }}
-
-        {{- if $s.GRPC }}
-        include /etc/nginx/grpc-error-locations.conf;
-        {{- end }}
-}
-    {{- end }}
-{{ end }}
-server {
-    listen unix:/var/run/nginx/nginx-503-server.sock;
-    access_log off;
-
-    return 503;
-}
-
-server {
-    listen unix:/var/run/nginx/nginx-500-server.sock;
-    access_log off;
-
-    return 500;
-}
-`
+    }`
@@ -18,11 +18,34 @@ import (
 )
 
 var (
-	httpBaseHeaders             = createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader)
-	grpcBaseHeaders             = createBaseProxySetHeaders(grpcAuthorityHeader)
-	alwaysFalseKeepAliveChecker = func(_ string) bool { return false }
+	httpBaseHeaders                = createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader)
+	grpcBaseHeaders                = createBaseProxySetHeaders(grpcAuthorityHeader)
+	alwaysFalseKeepAliveChecker    = func(_ string) bool { return false }
+	alwaysEmptySessionCookieGetter = func(_ string) string { return "" }
 )
 
+// getResultFiles returns the data of the results by their destination. The files of the servers are inlined
+// into the http configuration file where they are included, the way NGINX reads them.
+func getResultFiles(results []executeResult) map[string]string {
+	files := make(map[string]string)
+	for _, res := range results {
+		files[res.dest] += string(res.data)
+	}
+
+	for dest, data := range files {
+		if strings.HasPrefix(dest, httpServersFolder+"/") {
+			files[httpConfigFile] = strings.ReplaceAll(files[httpConfigFile], "include "+dest+";", data)
+		}
+	}
+
+	return files
+}
+
+// getServersConf returns the http configuration of the results with the files of the servers inlined.
+func getServersConf(results []executeResult) string {
+	return getResultFiles(results)[httpConfigFile]
+}
+
 func TestExecuteServers(t *testing.T) {
 	t.Parallel()
 
@@ -169,6 +192,28 @@ func TestExecuteServers(t *testing.T) {
 		includesFolder + "/server-snippet.conf": func(g *WithT, data string) {
 			g.Expect(data).To(Equal("server snippet contents"))
 		},
+		httpServersFolder + "/http__default_8080.conf": func(g *WithT, data string) {
+			g.Expect(data).To(ContainSubstring("listen 8080 default_server;"))
+		},
+		httpServersFolder + "/http_example.com_8080.conf": func(g *WithT, data string) {
+			g.Expect(strings.Count(data, "server {")).To(Equal(1))
+			g.Expect(data).To(ContainSubstring("server_name example.com;"))
+		},
+		httpServersFolder + "/http_cafe.example.com_8080.conf": func(g *WithT, data string) {
+			g.Expect(strings.Count(data, "server {")).To(Equal(1))
+			g.Expect(data).To(ContainSubstring("server_name cafe.example.com;"))
+		},
+		httpServersFolder + "/https__default_8443.conf": func(g *WithT, data string) {
+			g.Expect(data).To(ContainSubstring("listen 8443 ssl default_server;"))
+		},
+		httpServersFolder + "/https_example.com_8443.conf": func(g *WithT, data string) {
+			g.Expect(strings.Count(data, "server {")).To(Equal(1))
+			g.Expect(data).To(ContainSubstring("server_name example.com;"))
+		},
+		httpServersFolder + "/https_cafe.example.com_8443.conf": func(g *WithT, data string) {
+			g.Expect(strings.Count(data, "server {")).To(Equal(1))
+			g.Expect(data).To(ContainSubstring("server_name cafe.example.com;"))
+		},
 	}
 
 	g := NewWithT(t)
@@ -177,146 +222,1372 @@ func TestExecuteServers(t *testing.T) {
 	fakeGenerator.GenerateForServerReturns(
 		policies.GenerateResultFiles{
 			{
-				Name:    "include-1.conf",
-				Content: []byte("include-1"),
+				Name:    "include-1.conf",
+				Content: []byte("include-1"),
+			},
+			{
+				Name:    "include-2.conf",
+				Content: []byte("include-2"),
+			},
+		},
+	)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, fakeGenerator, alwaysFalseKeepAliveChecker, alwaysEmptySessionCookieGetter)
+	files := getResultFiles(results)
+	g.Expect(files).To(HaveLen(len(expectedResults)))
+
+	for dest, data := range files {
+		g.Expect(expectedResults).To(HaveKey(dest), "executeServers returned unexpected result destination")
+
+		assertData := expectedResults[dest]
+		assertData(g, data)
+	}
+}
+
+func TestExecuteServers_RequestMirror(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Filters: dataplane.HTTPFilters{
+									RequestMirrors: []dataplane.HTTPRequestMirrorFilter{
+										{
+											UpstreamName: "test_mirror_80",
+										},
+										{
+											UpstreamName: "test_mirror-tls_443",
+											Percent:      helpers.GetPointer(25.5),
+											VerifyTLS: &dataplane.VerifyTLS{
+												CertBundleID: "test-mirror",
+												Hostname:     "mirror.example.com",
+											},
+										},
+									},
+								},
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_coffee_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := []string{
+		"mirror /_ngf-internal-mirror-test_mirror_80;",
+		"mirror /_ngf-internal-mirror-test_mirror-tls_443-25.50;",
+		"mirror_request_body on;",
+		"location /_ngf-internal-mirror-test_mirror_80 {",
+		"proxy_pass http://test_mirror_80$request_uri;",
+		"location /_ngf-internal-mirror-test_mirror-tls_443-25.50 {",
+		"if ($mirror_test_mirror_tls_443_25_50 = \"off\") {",
+		"proxy_pass https://test_mirror-tls_443$request_uri;",
+		"proxy_ssl_name mirror.example.com;",
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for _, expSubString := range expSubStrings {
+		g.Expect(serverConf).To(ContainSubstring(expSubString))
+	}
+
+	g.Expect(strings.Count(serverConf, "internal;")).To(Equal(2))
+}
+
+func TestExecuteServers_Timeouts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Timeouts: &dataplane.HTTPTimeouts{
+									Request:        "10000ms",
+									BackendRequest: "5000ms",
+								},
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_coffee_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Timeouts: &dataplane.HTTPTimeouts{
+									Request: "20000ms",
+								},
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_tea_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := []string{
+		"proxy_connect_timeout 5000ms;",
+		"proxy_send_timeout 5000ms;",
+		"proxy_read_timeout 5000ms;",
+		"proxy_send_timeout 20000ms;",
+		"proxy_read_timeout 20000ms;",
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for _, expSubString := range expSubStrings {
+		g.Expect(serverConf).To(ContainSubstring(expSubString))
+	}
+
+	g.Expect(serverConf).ToNot(ContainSubstring("10000ms"))
+	// the request timeout doesn't limit the time of establishing a connection
+	g.Expect(strings.Count(serverConf, "proxy_connect_timeout")).To(Equal(1))
+}
+
+func TestExecuteServers_Streaming(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg      string
+		expCount int
+		grpc     bool
+	}{
+		{
+			msg:      "http",
+			expCount: 1,
+		},
+		{
+			msg:  "grpc",
+			grpc: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						Hostname: "cafe.example.com",
+						Port:     8080,
+						PathRules: []dataplane.PathRule{
+							{
+								Path:     "/coffee",
+								PathType: dataplane.PathTypeExact,
+								GRPC:     test.grpc,
+								MatchRules: []dataplane.MatchRule{
+									{
+										Streaming: true,
+										BackendGroup: dataplane.BackendGroup{
+											Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+											Backends: []dataplane.Backend{
+												{
+													UpstreamName: "test_coffee_80",
+													Valid:        true,
+													Weight:       1,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(
+				conf,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+
+			serverConf := getServersConf(results)
+
+			g.Expect(strings.Count(serverConf, "proxy_buffering off;")).To(Equal(test.expCount))
+		})
+	}
+}
+
+func TestExecuteServers_Retry(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createMatchRule := func(retry *dataplane.HTTPRetry) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			Retry: retry,
+			BackendGroup: dataplane.BackendGroup{
+				Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "test_coffee_80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.HTTPRetry{
+								Conditions: []string{"error", "timeout", "http_503"},
+								Tries:      3,
+								Timeout:    "10000ms",
+							}),
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.HTTPRetry{
+								Conditions: []string{"off"},
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_next_upstream error timeout http_503;": 1,
+		"proxy_next_upstream_tries 3;":                1,
+		"proxy_next_upstream_timeout 10000ms;":        1,
+		"proxy_next_upstream off;":                    1,
+		"proxy_next_upstream_tries":                   1,
+		"proxy_next_upstream_timeout":                 1,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServers_NativeHTTPMatches(t *testing.T) {
+	t.Parallel()
+
+	backendGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_coffee_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{Method: helpers.GetPointer("POST")},
+								BackendGroup: backendGroup,
+							},
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{
+									QueryParams: []dataplane.HTTPQueryParamMatch{
+										{Name: "green", Value: "true", Type: dataplane.MatchTypeExact},
+									},
+								},
+								BackendGroup: backendGroup,
+							},
+						},
+					},
+				},
+			},
+		},
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{NativeHTTPMatches: true},
+	}
+
+	expSubStrings := map[string]int{
+		`if ($ngf_match_0_0 = "") {`:             1,
+		"rewrite ^ $ngf_match_0_0 last;":         1,
+		"set $match_key 0_1;":                    1,
+		"js_content httpmatches.redirect;":       1,
+		"location /_ngf-internal-rule0-route0 {": 1,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	g := NewWithT(t)
+
+	files := getResultFiles(results)
+	serverConf := files[httpConfigFile]
+	httpMatchConf := files[httpMatchVarsFile]
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	g.Expect(httpMatchConf).To(ContainSubstring(`"0_1"`))
+	g.Expect(httpMatchConf).ToNot(ContainSubstring(`"0_0"`))
+}
+
+func TestExecuteServers_GRPCHeaderFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "grpc.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/helloworld.Greeter/SayHello",
+						PathType: dataplane.PathTypeExact,
+						GRPC:     true,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Filters: dataplane.HTTPFilters{
+									RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Set:    []dataplane.HTTPHeader{{Name: "X-Set", Value: "set-value"}},
+										Remove: []string{"X-Remove"},
+									},
+									ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Add:    []dataplane.HTTPHeader{{Name: "X-Resp-Add", Value: "add-value"}},
+										Set:    []dataplane.HTTPHeader{{Name: "X-Resp-Set", Value: "set-value"}},
+										Remove: []string{"X-Resp-Remove"},
+									},
+								},
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_grpc_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := []string{
+		`grpc_set_header X-Set "set-value";`,
+		`grpc_set_header X-Remove "";`,
+		`add_header X-Resp-Add "add-value" always;`,
+		"grpc_hide_header X-Resp-Set;",
+		`add_header X-Resp-Set "set-value" always;`,
+		"grpc_hide_header X-Resp-Remove;",
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for _, expSubString := range expSubStrings {
+		g.Expect(serverConf).To(ContainSubstring(expSubString))
+	}
+
+	g.Expect(serverConf).ToNot(ContainSubstring("proxy_set_header"))
+	g.Expect(serverConf).ToNot(ContainSubstring("proxy_hide_header"))
+}
+
+func TestExecuteServers_StaticContent(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "static.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Filters: dataplane.HTTPFilters{
+									ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Set: []dataplane.HTTPHeader{{Name: "X-Served-By", Value: "gateway"}},
+									},
+								},
+								StaticContent: &dataplane.StaticContent{
+									ID:    "test_content",
+									Index: "index.html",
+								},
+							},
+						},
+					},
+					{
+						Path:     "/maintenance",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{Method: helpers.GetPointer("GET")},
+								StaticContent: &dataplane.StaticContent{
+									Path:     "/usr/share/nginx/static/maintenance",
+									Index:    "home.html",
+									Fallback: "maintenance.html",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := []string{
+		"root /etc/nginx/includes/static/test_content;",
+		"index index.html;",
+		"try_files $uri/index.html $uri =404;",
+		`add_header X-Served-By "gateway" always;`,
+		"rewrite ^ $request_uri break;",
+		"root /usr/share/nginx/static/maintenance;",
+		"index home.html;",
+		"try_files $uri/home.html $uri /maintenance.html =404;",
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for _, expSubString := range expSubStrings {
+		g.Expect(serverConf).To(ContainSubstring(expSubString))
+	}
+
+	g.Expect(serverConf).ToNot(ContainSubstring("proxy_pass"))
+	g.Expect(serverConf).ToNot(ContainSubstring("proxy_hide_header"))
+}
+
+func TestExecuteServers_DirectResponse(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "direct.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/maintenance",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Filters: dataplane.HTTPFilters{
+									DirectResponse: &dataplane.DirectResponse{
+										StatusCode:  503,
+										Body:        `<h1 class="maintenance">Down\for maintenance</h1>`,
+										ContentType: "text/html",
+									},
+									ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Set: []dataplane.HTTPHeader{{Name: "Retry-After", Value: "120"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		`return 503 "<h1 class=\"maintenance\">Down\\for maintenance</h1>";`: 1,
+		"default_type text/html;":              1,
+		`add_header Retry-After "120" always;`: 1,
+		"proxy_pass":                           0,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for expSubString, count := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubString)).To(Equal(count), expSubString)
+	}
+}
+
+func TestExecuteServers_ErrorPages(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	errorPages := []dataplane.ErrorPage{
+		{
+			Name:  "test_epp_0",
+			Codes: []int{500, 502},
+			Response: &dataplane.ErrorPageResponse{
+				ContentType: "text/html",
+				Body:        `<html><body class="error">Oops</body></html>`,
+			},
+		},
+		{
+			Name:  "test_epp_1",
+			Codes: []int{503},
+			Response: &dataplane.ErrorPageResponse{
+				ContentType: "application/json",
+				Body:        `{"status": "maintenance"}`,
+				StatusCode:  200,
+			},
+		},
+		{
+			Name:  "test_epp_2",
+			Codes: []int{404},
+			Redirect: &dataplane.ErrorPageRedirect{
+				URL:        "https://example.com/404.html",
+				StatusCode: 301,
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "errors.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								ErrorPages: errorPages,
+							},
+						},
+					},
+					{
+						Path:     "/api",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:      dataplane.Match{Method: helpers.GetPointer("GET")},
+								ErrorPages: errorPages,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_intercept_errors on;":                                   2,
+		`error_page 500 502 "/_ngf-internal-error-page_test_epp_0";`:   2,
+		`error_page 503 =200 "/_ngf-internal-error-page_test_epp_1";`:  2,
+		`error_page 404 =301 "https://example.com/404.html";`:          2,
+		"location /_ngf-internal-error-page_test_epp_0 {":              1,
+		"default_type text/html;":                                      1,
+		`return 200 "<html><body class=\"error\">Oops</body></html>";`: 1,
+		"location /_ngf-internal-error-page_test_epp_1 {":              1,
+		"default_type application/json;":                               1,
+		`return 200 "{\"status\": \"maintenance\"}";`:                  1,
+		"_ngf-internal-error-page_test_epp_2":                          0,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for expSubString, count := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubString)).To(Equal(count), expSubString)
+	}
+}
+
+func TestExecuteServers_BodyTransform(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createMatchRule := func(bodyTransform *dataplane.BodyTransform) dataplane.MatchRule {
+		return dataplane.MatchRule{
+			Filters: dataplane.HTTPFilters{BodyTransform: bodyTransform},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "transform.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/both",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.BodyTransform{
+								ID:                  "body_transform_test_both",
+								RequestScript:       "return body;",
+								ResponseScript:      "return body;",
+								MaxRequestBodySize:  1 << 20,
+								MaxResponseBodySize: 1 << 20,
+							}),
+						},
+					},
+					{
+						Path:     "/request",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(&dataplane.BodyTransform{
+								ID:                 "body_transform_test_request",
+								RequestScript:      "return body;",
+								MaxRequestBodySize: 8192,
+							}),
+						},
+					},
+					{
+						Path:     "/none",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							createMatchRule(nil),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_set_body $body_transform_test_both_request_body;":                   1,
+		"js_header_filter body_transform_test_both.responseHeaders;":               1,
+		"js_body_filter body_transform_test_both.responseBody buffer_type=buffer;": 1,
+		"proxy_set_body $body_transform_test_request_request_body;":                1,
+		"body_transform_test_request.response":                                     0,
+		"proxy_set_body":                                                           2,
+		"js_body_filter":                                                           1,
+		"client_body_buffer_size 1048576;":                                         1,
+		"client_body_buffer_size 8192;":                                            1,
+		"client_body_buffer_size":                                                  2,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for expSubString, count := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubString)).To(Equal(count), expSubString)
+	}
+}
+
+func TestGetRequestBodyBufferSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg     string
+		filters dataplane.HTTPFilters
+		expSize int64
+	}{
+		{
+			msg: "no filters",
+		},
+		{
+			msg: "body transform of the responses",
+			filters: dataplane.HTTPFilters{
+				BodyTransform: &dataplane.BodyTransform{
+					ResponseScript:     "return body;",
+					MaxRequestBodySize: 1 << 20,
+				},
+			},
+		},
+		{
+			msg: "body transform of the requests",
+			filters: dataplane.HTTPFilters{
+				BodyTransform: &dataplane.BodyTransform{
+					RequestScript:      "return body;",
+					MaxRequestBodySize: 64 << 10,
+				},
+			},
+			expSize: 64 << 10,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getRequestBodyBufferSize(test.filters)).To(Equal(test.expSize))
+		})
+	}
+}
+
+func TestExecuteServers_IPFamily(t *testing.T) {
+	t.Parallel()
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8080,
+		},
+		{
+			Hostname: "example.com",
+			Port:     8080,
+		},
+	}
+	sslServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+		{
+			Hostname: "example.com",
+			SSL: &dataplane.SSL{
+				KeyPairID: "test-keypair",
+			},
+			Port: 8443,
+		},
+	}
+	sslServers443 := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      443,
+		},
+		{
+			Hostname: "example.com",
+			SSL: &dataplane.SSL{
+				KeyPairID: "test-keypair",
+			},
+			Port: 443,
+		},
+	}
+	passThroughServers := []dataplane.Layer4VirtualServer{
+		{
+			IsDefault: true,
+			Hostname:  "*.example.com",
+			Port:      8443,
+		},
+	}
+	tests := []struct {
+		msg                string
+		expectedHTTPConfig map[string]int
+		config             dataplane.Configuration
+	}{
+		{
+			msg: "http and ssl servers with IPv4 IP family",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8080 default_server;":                              1,
+				"listen 8080;":                                             1,
+				"listen 8443 ssl default_server;":                          1,
+				"listen 8443 ssl;":                                         1,
+				"server_name example.com;":                                 2,
+				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     1,
+				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 1,
+				"ssl_reject_handshake on;":                                 1,
+			},
+		},
+		{
+			msg: "http, ssl servers, and tls servers with IPv6 IP family",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  append(sslServers, sslServers443...),
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv6,
+				},
+				TLSPassthroughServers: passThroughServers,
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen [::]:8080 default_server;":                              1,
+				"listen [::]:8080;":                                             1,
+				"listen [::]:443 ssl default_server;":                           1,
+				"listen [::]:443 ssl;":                                          1,
+				"listen unix:/var/run/nginx/https8443.sock ssl;":                1,
+				"listen unix:/var/run/nginx/https8443.sock ssl default_server;": 1,
+				"server_name example.com;":                                      3,
+				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":          2,
+				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;":      2,
+				"ssl_reject_handshake on;":                                      2,
+			},
+		},
+		{
+			msg: "http and ssl servers with Dual IP family",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.Dual,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8080 default_server;":                              1,
+				"listen 8080;":                                             1,
+				"listen 8443 ssl default_server;":                          1,
+				"listen 8443 ssl;":                                         1,
+				"server_name example.com;":                                 2,
+				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     1,
+				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 1,
+				"ssl_reject_handshake on;":                                 1,
+				"listen [::]:8080 default_server;":                         1,
+				"listen [::]:8080;":                                        1,
+				"listen [::]:8443 ssl default_server;":                     1,
+				"listen [::]:8443 ssl;":                                    1,
+				"status_zone":                                              0,
+				"real_ip_header proxy-protocol;":                           0,
+				"real_ip_recursive on;":                                    0,
+			},
+		},
+		{
+			msg: "server with the IP family and rewrite client IP settings of its Gateway",
+			config: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      8080,
+					},
+					{
+						Hostname: "example.com",
+						Port:     8080,
+						GatewaySettings: &dataplane.GatewaySettings{
+							IPFamily: dataplane.IPv6,
+							RewriteClientIPSettings: dataplane.RewriteClientIPSettings{
+								Mode:             dataplane.RewriteIPModeXForwardedFor,
+								TrustedAddresses: []string{"10.1.1.3/32"},
+							},
+						},
+					},
+				},
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8080 default_server;":      1,
+				"listen 8080;":                     0,
+				"listen [::]:8080 default_server;": 0,
+				"listen [::]:8080;":                1,
+				"server_name example.com;":         1,
+				"set_real_ip_from 10.1.1.3/32;":    1,
+				"real_ip_header X-Forwarded-For;":  1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(
+				test.config,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+
+			files := getResultFiles(results)
+			serverConf := files[httpConfigFile]
+			httpMatchConf := files[httpMatchVarsFile]
+			g.Expect(httpMatchConf).To(Equal("{}"))
+
+			for expSubStr, expCount := range test.expectedHTTPConfig {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
+
+func TestExecuteServers_HTTP3(t *testing.T) {
+	t.Parallel()
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8080,
+		},
+	}
+	sslServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+		{
+			Hostname: "example.com",
+			SSL: &dataplane.SSL{
+				KeyPairID: "test-keypair",
+			},
+			Port: 8443,
+		},
+		{
+			IsDefault: true,
+			Port:      443,
+		},
+		{
+			Hostname: "example.com",
+			SSL: &dataplane.SSL{
+				KeyPairID: "test-keypair",
+			},
+			Port: 443,
+		},
+	}
+	tests := []struct {
+		msg                string
+		expectedHTTPConfig map[string]int
+		config             dataplane.Configuration
+	}{
+		{
+			msg: "http3 disabled",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.Dual,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"quic":      0,
+				"http3 on;": 0,
+				"Alt-Svc":   0,
+			},
+		},
+		{
+			msg: "http3 enabled with Dual IP family and proxy protocol",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.Dual,
+					HTTP3:    true,
+					RewriteClientIPSettings: dataplane.RewriteClientIPSettings{
+						Mode:             dataplane.RewriteIPModeProxyProtocol,
+						TrustedAddresses: []string{"10.56.73.51/32"},
+					},
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8443 quic reuseport default_server;":        1,
+				"listen [::]:8443 quic reuseport default_server;":   1,
+				"listen 8443 quic;":                                 1,
+				"listen [::]:8443 quic;":                            1,
+				"listen 443 quic reuseport default_server;":         1,
+				"listen [::]:443 quic reuseport default_server;":    1,
+				"listen 443 quic;":                                  1,
+				"listen [::]:443 quic;":                             1,
+				"listen 8443 ssl proxy_protocol;":                   1,
+				"listen 8080 quic":                                  0,
+				"quic proxy_protocol":                               0,
+				"http3 on;":                                         4,
+				`add_header Alt-Svc 'h3=":8443"; ma=86400' always;`: 1,
+				`add_header Alt-Svc 'h3=":443"; ma=86400' always;`:  1,
+			},
+		},
+		{
+			msg: "http3 enabled with ssl servers that share the port with tls servers",
+			config: dataplane.Configuration{
+				SSLServers: sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+					HTTP3:    true,
+				},
+				TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+					{
+						Hostname: "*.example.com",
+						Port:     8443,
+					},
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 443 quic reuseport default_server;": 1,
+				"listen 443 quic;":                          1,
+				"listen [::]:443 quic":                      0,
+				"listen 8443 quic":                          0,
+				"http3 on;":                                 2,
+				"Alt-Svc":                                   1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(
+				test.config,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+
+			serverConf := getServersConf(results)
+
+			for expSubStr, expCount := range test.expectedHTTPConfig {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteServers_OCSPStapling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ssl                *dataplane.SSL
+		expectedHTTPConfig map[string]int
+		msg                string
+	}{
+		{
+			msg: "stapling disabled",
+			ssl: &dataplane.SSL{KeyPairID: "test-keypair"},
+			expectedHTTPConfig: map[string]int{
+				"ssl_stapling":            0,
+				"ssl_trusted_certificate": 0,
+			},
+		},
+		{
+			msg: "stapling without verification",
+			ssl: &dataplane.SSL{KeyPairID: "test-keypair", OCSPStapling: true},
+			expectedHTTPConfig: map[string]int{
+				"ssl_stapling on;":        1,
+				"ssl_stapling_verify":     0,
+				"ssl_trusted_certificate": 0,
+			},
+		},
+		{
+			msg: "stapling with verification",
+			ssl: &dataplane.SSL{
+				KeyPairID:               "test-keypair",
+				OCSPStapling:            true,
+				OCSPTrustedCertBundleID: "test-bundle",
+			},
+			expectedHTTPConfig: map[string]int{
+				"ssl_stapling on;":        1,
+				"ssl_stapling_verify on;": 1,
+				"ssl_trusted_certificate /etc/nginx/secrets/test-bundle.crt;": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				SSLServers: []dataplane.VirtualServer{
+					{
+						Hostname: "example.com",
+						SSL:      test.ssl,
+						Port:     8443,
+					},
+				},
+			}
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(
+				conf,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+
+			serverConf := getServersConf(results)
+
+			for expSubStr, expCount := range test.expectedHTTPConfig {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteServers_PortOffset(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
+			{
+				Hostname: "example.com",
+				Port:     80,
+			},
+			{
+				IsDefault: true,
+				Port:      9000,
 			},
+		},
+		SSLServers: []dataplane.VirtualServer{
 			{
-				Name:    "include-2.conf",
-				Content: []byte("include-2"),
+				IsDefault: true,
+				Port:      443,
+			},
+			{
+				Hostname: "example.com",
+				SSL: &dataplane.SSL{
+					KeyPairID: "test-keypair",
+				},
+				Port: 443,
 			},
 		},
-	)
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			IPFamily: dataplane.IPv4,
+			HTTP3:    true,
+		},
+		Worker: dataplane.Worker{PortOffset: 8000},
+	}
 
-	gen := GeneratorImpl{}
-	results := gen.executeServers(conf, fakeGenerator, alwaysFalseKeepAliveChecker)
-	g.Expect(results).To(HaveLen(len(expectedResults)))
+	expSubStrings := map[string]int{
+		"listen 8080 default_server;":                      1,
+		"listen 8080;":                                     1,
+		"listen 9000 default_server;":                      1,
+		"listen 8443 ssl default_server;":                  1,
+		"listen 8443 quic reuseport default_server;":       1,
+		"listen 8443 ssl;":                                 1,
+		"listen 8443 quic;":                                1,
+		`add_header Alt-Svc 'h3=":443"; ma=86400' always;`: 1,
+		"listen 80 ":                                       0,
+		"listen 80;":                                       0,
+		"listen 443":                                       0,
+	}
 
-	for _, res := range results {
-		g.Expect(expectedResults).To(HaveKey(res.dest), "executeServers returned unexpected result destination")
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+	serverConf := getServersConf(results)
 
-		assertData := expectedResults[res.dest]
-		assertData(g, string(res.data))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
 	}
 }
 
-func TestExecuteServers_IPFamily(t *testing.T) {
+func TestExecuteServers_SocketOptions(t *testing.T) {
 	t.Parallel()
-	httpServers := []dataplane.VirtualServer{
-		{
-			IsDefault: true,
-			Port:      8080,
-		},
-		{
-			Hostname: "example.com",
-			Port:     8080,
-		},
-	}
-	sslServers := []dataplane.VirtualServer{
-		{
-			IsDefault: true,
-			Port:      8443,
-		},
-		{
-			Hostname: "example.com",
-			SSL: &dataplane.SSL{
-				KeyPairID: "test-keypair",
-			},
-			Port: 8443,
-		},
-	}
-	sslServers443 := []dataplane.VirtualServer{
-		{
-			IsDefault: true,
-			Port:      443,
-		},
-		{
-			Hostname: "example.com",
-			SSL: &dataplane.SSL{
-				KeyPairID: "test-keypair",
-			},
-			Port: 443,
+
+	socketOptions := map[int32]dataplane.SocketOptions{
+		8080: {
+			KeepAlive:  "30m:10s:5",
+			Backlog:    1024,
+			ReusePort:  true,
+			TCPNoDelay: "on",
+			TCPNoPush:  "off",
 		},
-	}
-	passThroughServers := []dataplane.Layer4VirtualServer{
-		{
-			IsDefault: true,
-			Hostname:  "*.example.com",
-			Port:      8443,
+		8443: {
+			KeepAlive:      "on",
+			DeferredAccept: true,
+			TCPNoDelay:     "off",
 		},
 	}
+
 	tests := []struct {
 		msg                string
 		expectedHTTPConfig map[string]int
 		config             dataplane.Configuration
 	}{
 		{
-			msg: "http and ssl servers with IPv4 IP family",
+			msg: "socket options of http and ssl servers",
 			config: dataplane.Configuration{
-				HTTPServers: httpServers,
-				SSLServers:  sslServers,
-				BaseHTTPConfig: dataplane.BaseHTTPConfig{
-					IPFamily: dataplane.IPv4,
+				HTTPServers: []dataplane.VirtualServer{
+					{IsDefault: true, Port: 8080},
+					{Hostname: "example.com", Port: 8080},
+				},
+				SSLServers: []dataplane.VirtualServer{
+					{IsDefault: true, Port: 8443},
+					{
+						Hostname: "example.com",
+						SSL:      &dataplane.SSL{KeyPairID: "test-keypair"},
+						Port:     8443,
+					},
 				},
+				SocketOptions: socketOptions,
 			},
 			expectedHTTPConfig: map[string]int{
-				"listen 8080 default_server;":                              1,
-				"listen 8080;":                                             1,
-				"listen 8443 ssl default_server;":                          1,
-				"listen 8443 ssl;":                                         1,
-				"server_name example.com;":                                 2,
-				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     1,
-				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 1,
-				"ssl_reject_handshake on;":                                 1,
-			},
-		},
-		{
-			msg: "http, ssl servers, and tls servers with IPv6 IP family",
+				"listen 8080 default_server backlog=1024 reuseport so_keepalive=30m:10s:5;":      1,
+				"listen [::]:8080 default_server backlog=1024 reuseport so_keepalive=30m:10s:5;": 1,
+				"listen 8080;":      1,
+				"listen [::]:8080;": 1,
+				"listen 8443 ssl default_server deferred so_keepalive=on;":      1,
+				"listen [::]:8443 ssl default_server deferred so_keepalive=on;": 1,
+				"listen 8443 ssl;":      1,
+				"listen [::]:8443 ssl;": 1,
+				"tcp_nodelay on;":       2,
+				"tcp_nopush off;":       2,
+				"tcp_nodelay off;":      2,
+				"tcp_nopush on;":        0,
+			},
+		},
+		{
+			msg: "socket options of ssl servers that share the port with tls servers",
 			config: dataplane.Configuration{
-				HTTPServers: httpServers,
-				SSLServers:  append(sslServers, sslServers443...),
-				BaseHTTPConfig: dataplane.BaseHTTPConfig{
-					IPFamily: dataplane.IPv6,
+				SSLServers: []dataplane.VirtualServer{
+					{IsDefault: true, Port: 8443},
+					{
+						Hostname: "example.com",
+						SSL:      &dataplane.SSL{KeyPairID: "test-keypair"},
+						Port:     8443,
+					},
 				},
-				TLSPassthroughServers: passThroughServers,
+				TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+					{Hostname: "*.example.com", Port: 8443},
+				},
+				SocketOptions: socketOptions,
 			},
 			expectedHTTPConfig: map[string]int{
-				"listen [::]:8080 default_server;":                              1,
-				"listen [::]:8080;":                                             1,
-				"listen [::]:443 ssl default_server;":                           1,
-				"listen [::]:443 ssl;":                                          1,
-				"listen unix:/var/run/nginx/https8443.sock ssl;":                1,
-				"listen unix:/var/run/nginx/https8443.sock ssl default_server;": 1,
-				"server_name example.com;":                                      3,
-				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":          2,
-				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;":      2,
-				"ssl_reject_handshake on;":                                      2,
+				"deferred":     0,
+				"so_keepalive": 0,
+				"tcp_nodelay":  0,
 			},
 		},
 		{
-			msg: "http and ssl servers with Dual IP family",
+			msg: "no socket options",
 			config: dataplane.Configuration{
-				HTTPServers: httpServers,
-				SSLServers:  sslServers,
-				BaseHTTPConfig: dataplane.BaseHTTPConfig{
-					IPFamily: dataplane.Dual,
+				HTTPServers: []dataplane.VirtualServer{
+					{IsDefault: true, Port: 8080},
+					{Hostname: "example.com", Port: 8080},
 				},
 			},
 			expectedHTTPConfig: map[string]int{
-				"listen 8080 default_server;":                              1,
-				"listen 8080;":                                             1,
-				"listen 8443 ssl default_server;":                          1,
-				"listen 8443 ssl;":                                         1,
-				"server_name example.com;":                                 2,
-				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     1,
-				"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 1,
-				"ssl_reject_handshake on;":                                 1,
-				"listen [::]:8080 default_server;":                         1,
-				"listen [::]:8080;":                                        1,
-				"listen [::]:8443 ssl default_server;":                     1,
-				"listen [::]:8443 ssl;":                                    1,
-				"status_zone":                                              0,
-				"real_ip_header proxy-protocol;":                           0,
-				"real_ip_recursive on;":                                    0,
+				"listen 8080 default_server;": 1,
+				"tcp_nodelay":                 0,
+				"tcp_nopush":                  0,
 			},
 		},
 	}
@@ -327,20 +1598,118 @@ func TestExecuteServers_IPFamily(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeServers(test.config, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+			results := gen.executeServers(
+				test.config,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
 
-			g.Expect(results).To(HaveLen(2))
-			serverConf := string(results[0].data)
-			httpMatchConf := string(results[1].data)
-			g.Expect(httpMatchConf).To(Equal("{}"))
+			serverConf := getServersConf(results)
 
 			for expSubStr, expCount := range test.expectedHTTPConfig {
-				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount))
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
 			}
 		})
 	}
 }
 
+func TestExecuteServers_AccessLog(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
+				AccessLog: &dataplane.AccessLog{Destination: "/dev/stdout", Format: "combined", Disable: true},
+			},
+			{
+				Hostname:  "cafe.example.com",
+				Port:      8080,
+				AccessLog: &dataplane.AccessLog{Destination: "/var/log/nginx/cafe.log", Format: "main"},
+			},
+			{Hostname: "tea.example.com", Port: 8080},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 8443},
+			{
+				Hostname:  "cafe.example.com",
+				SSL:       &dataplane.SSL{KeyPairID: "test-keypair"},
+				Port:      8443,
+				AccessLog: &dataplane.AccessLog{Destination: "syslog:server=syslog.example.com", Format: "json"},
+			},
+		},
+	}
+
+	// the internal servers of the unavailable and the invalid backends always disable the access log
+	expSubStrings := map[string]int{
+		"access_log off;":                                   1 + 2,
+		"access_log /var/log/nginx/cafe.log main;":          1,
+		"access_log syslog:server=syslog.example.com json;": 1,
+		"access_log ":                                       3 + 2,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	serverConf := getServersConf(results)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateListenOptions(t *testing.T) {
+	t.Parallel()
+
+	opts := dataplane.SocketOptions{
+		KeepAlive:      "on",
+		Backlog:        511,
+		ReusePort:      true,
+		DeferredAccept: true,
+		TCPNoDelay:     "on",
+	}
+
+	tests := []struct {
+		msg      string
+		expected string
+		opts     dataplane.SocketOptions
+		udp      bool
+	}{
+		{
+			msg:      "no options",
+			expected: "",
+		},
+		{
+			msg:      "tcp",
+			opts:     opts,
+			expected: " backlog=511 reuseport deferred so_keepalive=on",
+		},
+		{
+			msg:      "udp",
+			opts:     opts,
+			udp:      true,
+			expected: " reuseport",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createListenOptions(test.opts, test.udp)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestExecuteServers_RewriteClientIP(t *testing.T) {
 	t.Parallel()
 	httpServers := []dataplane.VirtualServer{
@@ -446,10 +1815,15 @@ func TestExecuteServers_RewriteClientIP(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeServers(test.config, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
-			g.Expect(results).To(HaveLen(2))
-			serverConf := string(results[0].data)
-			httpMatchConf := string(results[1].data)
+			results := gen.executeServers(
+				test.config,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+			files := getResultFiles(results)
+			serverConf := files[httpConfigFile]
+			httpMatchConf := files[httpMatchVarsFile]
 			g.Expect(httpMatchConf).To(Equal("{}"))
 
 			for expSubStr, expCount := range test.expectedHTTPConfig {
@@ -463,35 +1837,54 @@ func TestExecuteServers_Plus(t *testing.T) {
 	t.Parallel()
 	config := dataplane.Configuration{
 		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
 			{
 				Hostname: "example.com",
+				Port:     80,
 			},
 			{
 				Hostname: "example2.com",
+				Port:     80,
 			},
 		},
 		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      443,
+			},
 			{
 				Hostname: "example.com",
 				SSL: &dataplane.SSL{
 					KeyPairID: "test-keypair",
 				},
+				Port: 443,
 			},
 		},
 	}
 
 	expectedHTTPConfig := map[string]int{
-		"status_zone example.com;":  2,
-		"status_zone example2.com;": 1,
+		"status_zone _default_80;":      1,
+		"status_zone example.com_80;":   1,
+		"status_zone example2.com_80;":  1,
+		"status_zone _default_443;":     1,
+		"status_zone example.com_443;":  1,
+		"status_zone example2.com_443;": 0,
 	}
 
 	g := NewWithT(t)
 
 	gen := GeneratorImpl{plus: true}
-	results := gen.executeServers(config, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
-	g.Expect(results).To(HaveLen(2))
+	results := gen.executeServers(
+		config,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
 
-	serverConf := string(results[0].data)
+	serverConf := getServersConf(results)
 
 	for expSubStr, expCount := range expectedHTTPConfig {
 		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount))
@@ -572,10 +1965,15 @@ func TestExecuteForDefaultServers(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			serverResults := gen.executeServers(tc.conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
-			g.Expect(serverResults).To(HaveLen(2))
-			serverConf := string(serverResults[0].data)
-			httpMatchConf := string(serverResults[1].data)
+			serverResults := gen.executeServers(
+				tc.conf,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+			files := getResultFiles(serverResults)
+			serverConf := files[httpConfigFile]
+			httpMatchConf := files[httpMatchVarsFile]
 			g.Expect(httpMatchConf).To(Equal("{}"))
 
 			for _, expPort := range tc.httpPorts {
@@ -589,6 +1987,50 @@ func TestExecuteForDefaultServers(t *testing.T) {
 	}
 }
 
+func TestCreateServerFilePath(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	servers := []http.Server{
+		{
+			IsDefaultHTTP: true,
+			StatusZone:    "_default_80",
+		},
+		{
+			StatusZone: "*.example.com_80",
+		},
+		{
+			IsDefaultSSL: true,
+			StatusZone:   "_default_443",
+		},
+		{
+			SSL:        &http.SSL{},
+			StatusZone: "*.example.com_443",
+		},
+		{
+			SSL:        &http.SSL{},
+			StatusZone: "*.example.com_443",
+		},
+	}
+
+	expPaths := []string{
+		"/etc/nginx/conf.d/servers/http__default_80.conf",
+		"/etc/nginx/conf.d/servers/http__.example.com_80.conf",
+		"/etc/nginx/conf.d/servers/https__default_443.conf",
+		"/etc/nginx/conf.d/servers/https__.example.com_443.conf",
+		"/etc/nginx/conf.d/servers/https__.example.com_443_2.conf",
+	}
+
+	usedFileNames := make(map[string]int)
+	paths := make([]string, 0, len(servers))
+
+	for _, s := range servers {
+		paths = append(paths, createServerFilePath(s, usedFileNames))
+	}
+
+	g.Expect(paths).To(Equal(expPaths))
+}
+
 func TestCreateServers(t *testing.T) {
 	t.Parallel()
 	const (
@@ -1336,7 +2778,7 @@ func TestCreateServers(t *testing.T) {
 			},
 			{
 				Path:            "/_ngf-internal-rule8-route0",
-				Rewrites:        []string{"^ $request_uri", "^/rewrite-with-headers([^?]*)? /prefix-replacement$1?$args? break"},
+				Rewrites:        []string{"^ $request_uri", "^/rewrite-with-headers(/[^?]*)? /prefix-replacement$1?$args? break"},
 				ProxyPass:       "http://test_foo_80",
 				ProxySetHeaders: rewriteProxySetHeaders,
 				Type:            http.InternalLocationType,
@@ -1519,17 +2961,20 @@ func TestCreateServers(t *testing.T) {
 		{
 			IsDefaultHTTP: true,
 			Listen:        "8080",
+			StatusZone:    "_default_8080",
 		},
 		{
 			ServerName: "cafe.example.com",
 			Locations:  getExpectedLocations(false),
 			Includes:   []shared.Include{},
 			Listen:     "8080",
+			StatusZone: "cafe.example.com_8080",
 			GRPC:       true,
 		},
 		{
 			IsDefaultSSL: true,
 			Listen:       getSocketNameHTTPS(8443),
+			StatusZone:   "_default_8443",
 			IsSocket:     true,
 		},
 		{
@@ -1538,11 +2983,13 @@ func TestCreateServers(t *testing.T) {
 				Certificate:    expectedPEMPath,
 				CertificateKey: expectedPEMPath,
 			},
-			Locations: getExpectedLocations(true),
-			Includes:  []shared.Include{},
-			Listen:    getSocketNameHTTPS(8443),
-			IsSocket:  true,
-			GRPC:      true,
+			Locations:  getExpectedLocations(true),
+			Includes:   []shared.Include{},
+			Listen:     getSocketNameHTTPS(8443),
+			Port:       "8443",
+			StatusZone: "cafe.example.com_8443",
+			IsSocket:   true,
+			GRPC:       true,
 		},
 	}
 
@@ -1570,7 +3017,7 @@ func TestCreateServers(t *testing.T) {
 	}
 	keepAliveCheck := newKeepAliveChecker([]http.Upstream{keepAliveEnabledUpstream})
 
-	result, httpMatchPair := createServers(conf, fakeGenerator, keepAliveCheck)
+	result, httpMatchPair := createServers(conf, fakeGenerator, keepAliveCheck, alwaysEmptySessionCookieGetter)
 
 	g.Expect(httpMatchPair).To(Equal(allExpMatchPair))
 	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
@@ -1777,11 +3224,13 @@ func TestCreateServersConflicts(t *testing.T) {
 				{
 					IsDefaultHTTP: true,
 					Listen:        "8080",
+					StatusZone:    "_default_8080",
 				},
 				{
 					ServerName: "cafe.example.com",
 					Locations:  test.expLocs,
 					Listen:     "8080",
+					StatusZone: "cafe.example.com_8080",
 					Includes:   []shared.Include{},
 				},
 			}
@@ -1792,6 +3241,7 @@ func TestCreateServersConflicts(t *testing.T) {
 				dataplane.Configuration{HTTPServers: httpServers},
 				&policiesfakes.FakeGenerator{},
 				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
 			)
 			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
 		})
@@ -1942,7 +3392,12 @@ func TestCreateServers_Includes(t *testing.T) {
 
 	conf := dataplane.Configuration{HTTPServers: httpServers, SSLServers: sslServers}
 
-	actualServers, matchPairs := createServers(conf, fakeGenerator, alwaysFalseKeepAliveChecker)
+	actualServers, matchPairs := createServers(
+		conf,
+		fakeGenerator,
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
 	g.Expect(matchPairs).To(BeEmpty())
 	g.Expect(actualServers).To(HaveLen(len(expServers)))
 
@@ -2103,7 +3558,14 @@ func TestCreateLocations_Includes(t *testing.T) {
 		},
 	})
 
-	locations, matches, grpc := createLocations(&httpServer, "1", fakeGenerator, alwaysFalseKeepAliveChecker)
+	locations, matches, grpc := createLocations(
+		&httpServer,
+		"1",
+		false,
+		fakeGenerator,
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
 
 	g := NewWithT(t)
 	g.Expect(grpc).To(BeFalse())
@@ -2254,16 +3716,45 @@ func TestCreateLocationsRootPath(t *testing.T) {
 					Type:            http.ExternalLocationType,
 				},
 				{
-					Path:            "/path-2",
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+			},
+		},
+		{
+			name: "regular expression root path should generate a default 404 root location",
+			pathRules: []dataplane.PathRule{
+				{
+					Path:     "/",
+					PathType: dataplane.PathTypeRegularExpression,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+			},
+			expLocations: []http.Location{
+				{
+					Path:            `~ "^(?!/_ngf-internal)(?:/)$"`,
 					ProxyPass:       "http://test_foo_80$request_uri",
 					ProxySetHeaders: httpBaseHeaders,
 					Type:            http.ExternalLocationType,
 				},
 				{
-					Path:            "/",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
 				},
 			},
 		},
@@ -2292,8 +3783,10 @@ func TestCreateLocationsRootPath(t *testing.T) {
 					Port:      80,
 				},
 				"1",
+				false,
 				&policiesfakes.FakeGenerator{},
 				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
 			)
 			g.Expect(locs).To(Equal(test.expLocations))
 			g.Expect(httpMatchPair).To(BeEmpty())
@@ -2302,6 +3795,228 @@ func TestCreateLocationsRootPath(t *testing.T) {
 	}
 }
 
+func TestCreatePath(t *testing.T) {
+	t.Parallel()
+
+	pathsAndTypes := pathAndTypeMap{
+		{path: "/coffee/beans"}:                        {dataplane.PathTypePrefix: {}},
+		{path: "/COFFEE/"}:                             {dataplane.PathTypePrefix: {}},
+		{path: "/coffee/exact"}:                        {dataplane.PathTypeExact: {}},
+		{path: "/tea"}:                                 {dataplane.PathTypePrefix: {}},
+		{path: "/coffee/latte", caseInsensitive: true}: {dataplane.PathTypePrefix: {}},
+	}
+
+	tests := []struct {
+		name    string
+		expPath string
+		rule    dataplane.PathRule
+	}{
+		{
+			name:    "prefix",
+			rule:    dataplane.PathRule{Path: "/coffee", PathType: dataplane.PathTypePrefix},
+			expPath: "/coffee",
+		},
+		{
+			name:    "exact",
+			rule:    dataplane.PathRule{Path: "/coffee", PathType: dataplane.PathTypeExact},
+			expPath: "= /coffee",
+		},
+		{
+			name:    "regular expression",
+			rule:    dataplane.PathRule{Path: "/coffee/[0-9]+", PathType: dataplane.PathTypeRegularExpression},
+			expPath: `~ "^(?!/_ngf-internal)(?:/coffee/[0-9]+)$"`,
+		},
+		{
+			name:    "regular expression with backslashes and quotes",
+			rule:    dataplane.PathRule{Path: `/coffee/\d+/"tea"`, PathType: dataplane.PathTypeRegularExpression},
+			expPath: `~ "^(?!/_ngf-internal)(?:/coffee/\\d+/\"tea\")$"`,
+		},
+		{
+			name: "case-insensitive regular expression",
+			rule: dataplane.PathRule{
+				Path:            "/coffee/[a-z]+",
+				PathType:        dataplane.PathTypeRegularExpression,
+				CaseInsensitive: true,
+			},
+			expPath: `~* "^(?!/_ngf-internal)(?:/coffee/[a-z]+)$"`,
+		},
+		{
+			name:    "case-insensitive exact",
+			rule:    dataplane.PathRule{Path: "/coffee.v1", PathType: dataplane.PathTypeExact, CaseInsensitive: true},
+			expPath: `~* "^(?!/_ngf-internal)(?:/coffee\\.v1)$"`,
+		},
+		{
+			name:    "case-insensitive prefix without trailing slash excludes case-sensitive prefixes",
+			rule:    dataplane.PathRule{Path: "/coffee", PathType: dataplane.PathTypePrefix, CaseInsensitive: true},
+			expPath: `~* "^(?!/_ngf-internal)(?:(?!(?-i:/COFFEE/)|(?-i:/coffee/beans)(?:/|$))/coffee(?:/.*)?)$"`,
+		},
+		{
+			name:    "case-insensitive prefix with trailing slash",
+			rule:    dataplane.PathRule{Path: "/tea/", PathType: dataplane.PathTypePrefix, CaseInsensitive: true},
+			expPath: `~* "^(?!/_ngf-internal)(?:/tea/.*)$"`,
+		},
+		{
+			name:    "case-insensitive root prefix excludes all case-sensitive prefixes",
+			rule:    dataplane.PathRule{Path: "/", PathType: dataplane.PathTypePrefix, CaseInsensitive: true},
+			expPath: `~* "^(?!/_ngf-internal)(?:(?!(?-i:/COFFEE/)|(?-i:/coffee/beans)(?:/|$)|(?-i:/tea)(?:/|$))/.*)$"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createPath(test.rule, pathsAndTypes)).To(Equal(test.expPath))
+		})
+	}
+}
+
+func TestCreateTrailingSlashLocation(t *testing.T) {
+	t.Parallel()
+
+	pathsAndTypes := pathAndTypeMap{
+		{path: "/tea"}:                          {dataplane.PathTypeExact: {}},
+		{path: "/latte"}:                        {dataplane.PathTypePrefix: {}},
+		{path: "/mocha", caseInsensitive: true}: {dataplane.PathTypeExact: {}},
+	}
+
+	tests := []struct {
+		expLoc *http.Location
+		name   string
+		rule   dataplane.PathRule
+	}{
+		{
+			name: "no trailing slash mode",
+			rule: dataplane.PathRule{Path: "/coffee", PathType: dataplane.PathTypeExact},
+		},
+		{
+			name: "exact path redirects the path with a trailing slash",
+			rule: dataplane.PathRule{
+				Path:          "/coffee",
+				PathType:      dataplane.PathTypeExact,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+			expLoc: &http.Location{
+				Path: "= /coffee/",
+				Type: http.ExternalLocationType,
+				Return: &http.Return{
+					Code: http.StatusMovedPermanently,
+					Body: "/coffee$is_args$args",
+				},
+			},
+		},
+		{
+			name: "exact path with a trailing slash normalizes the path without a trailing slash",
+			rule: dataplane.PathRule{
+				Path:          "/coffee/",
+				PathType:      dataplane.PathTypeExact,
+				TrailingSlash: dataplane.TrailingSlashModeNormalize,
+			},
+			expLoc: &http.Location{
+				Path:     "= /coffee",
+				Type:     http.ExternalLocationType,
+				Rewrites: []string{"^ /coffee/ last"},
+			},
+		},
+		{
+			name: "prefix path with a trailing slash redirects the path without a trailing slash",
+			rule: dataplane.PathRule{
+				Path:          "/coffee/",
+				PathType:      dataplane.PathTypePrefix,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+			expLoc: &http.Location{
+				Path: "= /coffee",
+				Type: http.ExternalLocationType,
+				Return: &http.Return{
+					Code: http.StatusMovedPermanently,
+					Body: "/coffee/$is_args$args",
+				},
+			},
+		},
+		{
+			name: "case-insensitive exact path",
+			rule: dataplane.PathRule{
+				Path:            "/coffee",
+				PathType:        dataplane.PathTypeExact,
+				TrailingSlash:   dataplane.TrailingSlashModeNormalize,
+				CaseInsensitive: true,
+			},
+			expLoc: &http.Location{
+				Path:     `~* "^(?!/_ngf-internal)(?:/coffee/)$"`,
+				Type:     http.ExternalLocationType,
+				Rewrites: []string{"^ /coffee last"},
+			},
+		},
+		{
+			name: "prefix path without a trailing slash already matches the path with a trailing slash",
+			rule: dataplane.PathRule{
+				Path:          "/coffee",
+				PathType:      dataplane.PathTypePrefix,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "root path",
+			rule: dataplane.PathRule{
+				Path:          "/",
+				PathType:      dataplane.PathTypeExact,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "regular expression path",
+			rule: dataplane.PathRule{
+				Path:          "/coffee/.*",
+				PathType:      dataplane.PathTypeRegularExpression,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "path is configured by another exact path",
+			rule: dataplane.PathRule{
+				Path:          "/tea/",
+				PathType:      dataplane.PathTypeExact,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "path is matched by another prefix path",
+			rule: dataplane.PathRule{
+				Path:          "/latte",
+				PathType:      dataplane.PathTypeExact,
+				TrailingSlash: dataplane.TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "path is configured by another case-insensitive exact path",
+			rule: dataplane.PathRule{
+				Path:            "/mocha/",
+				PathType:        dataplane.PathTypePrefix,
+				TrailingSlash:   dataplane.TrailingSlashModeRedirect,
+				CaseInsensitive: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			loc, ok := createTrailingSlashLocation(test.rule, pathsAndTypes)
+			if test.expLoc == nil {
+				g.Expect(ok).To(BeFalse())
+				return
+			}
+
+			g.Expect(ok).To(BeTrue())
+			g.Expect(loc).To(Equal(*test.expLoc))
+		})
+	}
+}
+
 func TestCreateReturnValForRedirectFilter(t *testing.T) {
 	t.Parallel()
 	const listenerPortCustom = 123
@@ -2486,7 +4201,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Body: "http://foo.example.com$uri$is_args$args",
 			},
 			expectedRewrite: &rewriteConfig{
-				MainRewrite: "^/original([^?]*)? /prefix-path$1?$args?",
+				MainRewrite: "^/original(/[^?]*)? /prefix-path$1?$args?",
 			},
 			msg: "scheme is http, port http, prefix path with no trailing slashes",
 		},
@@ -2532,7 +4247,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Body: "http://foo.example.com$uri$is_args$args",
 			},
 			expectedRewrite: &rewriteConfig{
-				MainRewrite: "^/original/([^?]*)? /trailing/$1?$args?",
+				MainRewrite: "^/original(/[^?]*)? /trailing$1?$args?",
 			},
 			msg: "scheme is http, port http, prefix path original with trailing /",
 		},
@@ -2555,7 +4270,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Body: "http://foo.example.com$uri$is_args$args",
 			},
 			expectedRewrite: &rewriteConfig{
-				MainRewrite: "^/original/([^?]*)? /trailing/$1?$args?",
+				MainRewrite: "^/original(?:/([^?]*))? /trailing/$1?$args?",
 			},
 			msg: "scheme is http, port http, prefix path both with trailing slashes",
 		},
@@ -2566,7 +4281,11 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			result, rewriteConfig := createReturnAndRewriteConfigForRedirectFilter(test.filter, test.listenerPort, test.path)
+			result, rewriteConfig := createReturnAndRewriteConfigForRedirectFilter(
+				test.filter,
+				test.listenerPort,
+				rewriteMatch{path: test.path},
+			)
 			g.Expect(helpers.Diff(test.expectedReturn, result)).To(BeEmpty())
 			g.Expect(helpers.Diff(test.expectedRewrite, rewriteConfig)).To(BeEmpty())
 		})
@@ -2579,7 +4298,7 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 		filter   *dataplane.HTTPURLRewriteFilter
 		expected *rewriteConfig
 		msg      string
-		path     string
+		path     rewriteMatch
 	}{
 		{
 			filter:   nil,
@@ -2605,7 +4324,7 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			msg: "full path",
 		},
 		{
-			path: "/original",
+			path: rewriteMatch{path: "/original"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2614,12 +4333,12 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			},
 			expected: &rewriteConfig{
 				InternalRewrite: "^ $request_uri",
-				MainRewrite:     "^/original([^?]*)? /prefix-path$1?$args? break",
+				MainRewrite:     "^/original(/[^?]*)? /prefix-path$1?$args? break",
 			},
 			msg: "prefix path no trailing slashes",
 		},
 		{
-			path: "/original",
+			path: rewriteMatch{path: "/original"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2633,7 +4352,7 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			msg: "prefix path empty string",
 		},
 		{
-			path: "/original",
+			path: rewriteMatch{path: "/original"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2647,7 +4366,7 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			msg: "prefix path /",
 		},
 		{
-			path: "/original",
+			path: rewriteMatch{path: "/original"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2661,7 +4380,7 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			msg: "prefix path replacement with trailing /",
 		},
 		{
-			path: "/original/",
+			path: rewriteMatch{path: "/original/"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2670,12 +4389,12 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			},
 			expected: &rewriteConfig{
 				InternalRewrite: "^ $request_uri",
-				MainRewrite:     "^/original/([^?]*)? /prefix-path/$1?$args? break",
+				MainRewrite:     "^/original(/[^?]*)? /prefix-path$1?$args? break",
 			},
 			msg: "prefix path original with trailing /",
 		},
 		{
-			path: "/original/",
+			path: rewriteMatch{path: "/original/"},
 			filter: &dataplane.HTTPURLRewriteFilter{
 				Path: &dataplane.HTTPPathModifier{
 					Type:        dataplane.ReplacePrefixMatch,
@@ -2684,10 +4403,52 @@ func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 			},
 			expected: &rewriteConfig{
 				InternalRewrite: "^ $request_uri",
-				MainRewrite:     "^/original/([^?]*)? /trailing/$1?$args? break",
+				MainRewrite:     "^/original(?:/([^?]*))? /trailing/$1?$args? break",
 			},
 			msg: "prefix path both with trailing slashes",
 		},
+		{
+			path: rewriteMatch{path: "/v1.0/(beta)", caseInsensitive: true},
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/v2",
+				},
+			},
+			expected: &rewriteConfig{
+				InternalRewrite: "^ $request_uri",
+				MainRewrite:     `^(?i)/v1\.0/\(beta\)(/[^?]*)? /v2$1?$args? break`,
+			},
+			msg: "case-insensitive prefix path with special characters",
+		},
+		{
+			path: rewriteMatch{path: `/users/(\d+)/(?P<tab>[a-z]+)`, regex: true},
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplaceFullPath,
+					Replacement: "/profiles/$1/${tab}",
+				},
+			},
+			expected: &rewriteConfig{
+				InternalRewrite: "^ $request_uri",
+				MainRewrite:     `"^(?:/users/(\\d+)/(?P<tab>[a-z]+))$" "/profiles/$1/${tab}" break`,
+			},
+			msg: "full path with capture references of regular expression path",
+		},
+		{
+			path: rewriteMatch{path: "/users/.*", regex: true, caseInsensitive: true},
+			filter: &dataplane.HTTPURLRewriteFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplaceFullPath,
+					Replacement: "/users",
+				},
+			},
+			expected: &rewriteConfig{
+				InternalRewrite: "^ $request_uri",
+				MainRewrite:     `"(?i)^(?:/users/.*)$" "/users" break`,
+			},
+			msg: "full path of case-insensitive regular expression path",
+		},
 	}
 
 	for _, test := range tests {
@@ -3079,6 +4840,77 @@ func TestCreateProxyPass(t *testing.T) {
 	}
 }
 
+func TestCreateMirrorLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mirror := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_mirror_80",
+	}
+	mirrorWithPercent := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_mirror_80",
+		Percent:      helpers.GetPointer(10.0),
+	}
+	skippedMirror := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_skipped_80",
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path: "/coffee",
+			MatchRules: []dataplane.MatchRule{
+				{
+					Filters: dataplane.HTTPFilters{
+						RequestMirrors: []dataplane.HTTPRequestMirrorFilter{mirror, mirrorWithPercent},
+					},
+				},
+				{
+					Filters: dataplane.HTTPFilters{
+						InvalidFilter:  &dataplane.InvalidHTTPFilter{},
+						RequestMirrors: []dataplane.HTTPRequestMirrorFilter{skippedMirror},
+					},
+				},
+			},
+		},
+		{
+			Path: "/tea",
+			MatchRules: []dataplane.MatchRule{
+				{
+					Filters: dataplane.HTTPFilters{
+						RequestMirrors: []dataplane.HTTPRequestMirrorFilter{mirror}, // shares the location with /coffee
+					},
+				},
+				{
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{},
+						RequestMirrors:  []dataplane.HTTPRequestMirrorFilter{skippedMirror},
+					},
+				},
+			},
+		},
+	}
+
+	expProxySetHeaders := createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader)
+
+	expLocations := []http.Location{
+		{
+			Path:            "/_ngf-internal-mirror-test_mirror_80",
+			Type:            http.InternalLocationType,
+			ProxySetHeaders: expProxySetHeaders,
+			ProxyPass:       "http://test_mirror_80$request_uri",
+		},
+		{
+			Path:                           "/_ngf-internal-mirror-test_mirror_80-10.00",
+			Type:                           http.InternalLocationType,
+			ProxySetHeaders:                expProxySetHeaders,
+			ProxyPass:                      "http://test_mirror_80$request_uri",
+			MirrorSplitClientsVariableName: "mirror_test_mirror_80_10_00",
+		},
+	}
+
+	g.Expect(createMirrorLocations(pathRules, alwaysFalseKeepAliveChecker)).To(Equal(expLocations))
+}
+
 func TestCreateMatchLocation(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -3325,6 +5157,100 @@ func TestCreateBaseProxySetHeaders(t *testing.T) {
 	}
 }
 
+func TestGetProxyTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *dataplane.HTTPTimeouts
+		msg      string
+		expected string
+	}{
+		{
+			msg:      "no timeouts",
+			timeouts: nil,
+			expected: "",
+		},
+		{
+			msg: "request timeout",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: "10000ms",
+			},
+			expected: "10000ms",
+		},
+		{
+			msg: "backendRequest timeout",
+			timeouts: &dataplane.HTTPTimeouts{
+				BackendRequest: "5000ms",
+			},
+			expected: "5000ms",
+		},
+		{
+			msg: "request and backendRequest timeouts",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        "10000ms",
+				BackendRequest: "5000ms",
+			},
+			expected: "5000ms",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getProxyTimeout(tc.timeouts)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestGetProxyConnectTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *dataplane.HTTPTimeouts
+		msg      string
+		expected string
+	}{
+		{
+			msg:      "no timeouts",
+			timeouts: nil,
+			expected: "",
+		},
+		{
+			msg: "request timeout",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request: "10000ms",
+			},
+			expected: "",
+		},
+		{
+			msg: "backendRequest timeout",
+			timeouts: &dataplane.HTTPTimeouts{
+				BackendRequest: "5000ms",
+			},
+			expected: "5000ms",
+		},
+		{
+			msg: "request and backendRequest timeouts",
+			timeouts: &dataplane.HTTPTimeouts{
+				Request:        "10000ms",
+				BackendRequest: "5000ms",
+			},
+			expected: "5000ms",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getProxyConnectTimeout(tc.timeouts)).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestGetConnectionHeader(t *testing.T) {
 	t.Parallel()
 
@@ -3544,6 +5470,33 @@ func TestConvertBackendTLSFromGroup(t *testing.T) {
 	}
 }
 
+func TestCreateSessionCookieHeaders(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	getSessionCookie := newSessionCookieGetter([]http.Upstream{
+		{
+			Name: "session",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name:           "session",
+				HashKey:        "$ngf_session_key_session",
+				CookieVariable: "$ngf_session_cookie_session",
+			},
+		},
+	})
+
+	backends := []dataplane.Backend{
+		{UpstreamName: "session"},
+		{UpstreamName: "no-session"},
+		{UpstreamName: "session"},
+	}
+
+	g.Expect(createSessionCookieHeaders(getSessionCookie, backends)).To(Equal([]http.Header{
+		{Name: "Set-Cookie", Value: "$ngf_session_cookie_session"},
+	}))
+	g.Expect(createSessionCookieHeaders(alwaysEmptySessionCookieGetter, backends)).To(BeEmpty())
+}
+
 func TestGenerateResponseHeaders(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// locationsGroup is a group of servers with identical locations.
+type locationsGroup struct {
+	// pathRules are the path rules of the VirtualServer of the first server of the group.
+	pathRules []dataplane.PathRule
+	// serverIndexes are the indexes of the servers of the group. The first server owns the shared locations.
+	serverIndexes []int
+}
+
+// shareLocations finds the servers with identical locations, which is the case, for example, when a Route attaches
+// to both an HTTP and an HTTPS Listener with the same hostname. Instead of rendering the same locations in every
+// server, the locations of the first server of a group are rendered once by executeLocations into an include file,
+// which all the servers of the group include.
+//
+// The locations of the servers only differ in the keys of the HTTP matches, which include the ID of the server.
+// The shared locations use the keys of the first server of a group, so the HTTP match pairs of the other servers
+// are removed. The virtualServers are the VirtualServers that the servers were created from, in the same order.
+// The servers only share the locations if their path rules are equal too, because the locations don't include
+// the matches that select the internal locations.
+func shareLocations(
+	servers []http.Server,
+	virtualServers []dataplane.VirtualServer,
+	matchPairs httpMatchPairs,
+	executeLocations func([]http.Location) []byte,
+) {
+	groups := groupServersByLocations(servers, virtualServers)
+
+	var sharedIdx int
+
+	for _, group := range groups {
+		if len(group.serverIndexes) < 2 {
+			continue
+		}
+
+		owner := servers[group.serverIndexes[0]]
+
+		include := &shared.Include{
+			Name:    includesFolder + "/shared-locations-" + strconv.Itoa(sharedIdx) + ".conf",
+			Content: executeLocations(owner.Locations),
+		}
+		sharedIdx++
+
+		for i, serverIdx := range group.serverIndexes {
+			if i > 0 {
+				for _, loc := range servers[serverIdx].Locations {
+					if loc.HTTPMatchKey != "" {
+						delete(matchPairs, loc.HTTPMatchKey)
+					}
+				}
+
+				servers[serverIdx].Locations = owner.Locations
+			}
+
+			servers[serverIdx].SharedLocations = include
+		}
+	}
+}
+
+// groupServersByLocations groups the servers with identical locations and path rules, in the order of the first
+// server of each group.
+func groupServersByLocations(servers []http.Server, virtualServers []dataplane.VirtualServer) []*locationsGroup {
+	var groups []*locationsGroup
+	groupsByFingerprint := make(map[string][]*locationsGroup)
+
+	for idx, server := range servers {
+		pathRules := virtualServers[idx].PathRules
+
+		// the servers without path rules only have the default root location, which isn't worth sharing.
+		if server.IsDefaultHTTP || server.IsDefaultSSL || len(pathRules) == 0 {
+			continue
+		}
+
+		fingerprint := createLocationsFingerprint(server.Locations)
+
+		var found bool
+
+		for _, group := range groupsByFingerprint[fingerprint] {
+			if reflect.DeepEqual(group.pathRules, pathRules) {
+				group.serverIndexes = append(group.serverIndexes, idx)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			group := &locationsGroup{
+				pathRules:     pathRules,
+				serverIndexes: []int{idx},
+			}
+
+			groupsByFingerprint[fingerprint] = append(groupsByFingerprint[fingerprint], group)
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// createLocationsFingerprint creates a string that is equal for the locations that only differ in the ID of
+// the server in the keys of their HTTP matches.
+func createLocationsFingerprint(locations []http.Location) string {
+	normalized := make([]http.Location, 0, len(locations))
+
+	for _, loc := range locations {
+		loc.HTTPMatchKey = trimServerID(loc.HTTPMatchKey)
+		loc.HTTPMatchVariable = trimServerID(loc.HTTPMatchVariable)
+
+		normalized = append(normalized, loc)
+	}
+
+	fingerprint, err := json.Marshal(normalized)
+	if err != nil {
+		// panic is safe here because we should never fail to marshal the locations unless we constructed them
+		// incorrectly.
+		panic(fmt.Errorf("could not marshal locations: %w", err))
+	}
+
+	return string(fingerprint)
+}
+
+// trimServerID trims the ID of the server from an HTTP match key, or the name of the HTTP match variable,
+// leaving the index of the path rule, which is the part after the last underscore.
+func trimServerID(httpMatchKey string) string {
+	return httpMatchKey[strings.LastIndex(httpMatchKey, "_")+1:]
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestShareLocations(t *testing.T) {
+	t.Parallel()
+
+	createLocations := func(serverID string) []http.Location {
+		return []http.Location{
+			{
+				Path:         "/coffee",
+				Type:         http.RedirectLocationType,
+				HTTPMatchKey: serverID + "_0",
+			},
+			{
+				Path:      "/_ngf-internal-rule0-route0",
+				Type:      http.InternalLocationType,
+				ProxyPass: "http://test_coffee_80$request_uri",
+			},
+		}
+	}
+
+	coffeeRules := []dataplane.PathRule{{Path: "/coffee", PathType: dataplane.PathTypePrefix}}
+	teaRules := []dataplane.PathRule{{Path: "/tea", PathType: dataplane.PathTypePrefix}}
+
+	executeLocations := func(locations []http.Location) []byte {
+		return []byte(strconv.Itoa(len(locations)))
+	}
+
+	tests := []struct {
+		matchPairs         httpMatchPairs
+		expMatchPairs      httpMatchPairs
+		name               string
+		servers            []http.Server
+		virtualServers     []dataplane.VirtualServer
+		expSharedLocations []*shared.Include
+		expLocations       [][]http.Location
+	}{
+		{
+			name: "servers with identical locations and path rules",
+			servers: []http.Server{
+				{Locations: createLocations("0")},
+				{Locations: createLocations("SSL_0"), SSL: &http.SSL{}},
+			},
+			virtualServers: []dataplane.VirtualServer{
+				{PathRules: coffeeRules},
+				{PathRules: coffeeRules},
+			},
+			matchPairs: httpMatchPairs{
+				"0_0":     {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+				"SSL_0_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+			},
+			expMatchPairs: httpMatchPairs{
+				"0_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+			},
+			expSharedLocations: []*shared.Include{
+				{Name: includesFolder + "/shared-locations-0.conf", Content: []byte("2")},
+				{Name: includesFolder + "/shared-locations-0.conf", Content: []byte("2")},
+			},
+			expLocations: [][]http.Location{
+				createLocations("0"),
+				createLocations("0"),
+			},
+		},
+		{
+			name: "servers with identical locations and different path rules",
+			servers: []http.Server{
+				{Locations: createLocations("0")},
+				{Locations: createLocations("1")},
+			},
+			virtualServers: []dataplane.VirtualServer{
+				{PathRules: coffeeRules},
+				{PathRules: teaRules},
+			},
+			matchPairs: httpMatchPairs{
+				"0_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+				"1_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+			},
+			expMatchPairs: httpMatchPairs{
+				"0_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+				"1_0": {{RedirectPath: "/_ngf-internal-rule0-route0"}},
+			},
+			expSharedLocations: []*shared.Include{nil, nil},
+			expLocations: [][]http.Location{
+				createLocations("0"),
+				createLocations("1"),
+			},
+		},
+		{
+			name: "servers with different locations",
+			servers: []http.Server{
+				{Locations: createLocations("0")},
+				{Locations: []http.Location{{Path: "/coffee", Return: &http.Return{Code: http.StatusFound}}}},
+			},
+			virtualServers: []dataplane.VirtualServer{
+				{PathRules: coffeeRules},
+				{PathRules: coffeeRules},
+			},
+			matchPairs:         httpMatchPairs{},
+			expMatchPairs:      httpMatchPairs{},
+			expSharedLocations: []*shared.Include{nil, nil},
+			expLocations: [][]http.Location{
+				createLocations("0"),
+				{{Path: "/coffee", Return: &http.Return{Code: http.StatusFound}}},
+			},
+		},
+		{
+			name: "default servers and servers without path rules",
+			servers: []http.Server{
+				{IsDefaultHTTP: true},
+				{IsDefaultSSL: true},
+				{Locations: []http.Location{createDefaultRootLocation()}},
+				{Locations: []http.Location{createDefaultRootLocation()}},
+			},
+			virtualServers: []dataplane.VirtualServer{
+				{IsDefault: true},
+				{IsDefault: true},
+				{},
+				{},
+			},
+			matchPairs:         httpMatchPairs{},
+			expMatchPairs:      httpMatchPairs{},
+			expSharedLocations: []*shared.Include{nil, nil, nil, nil},
+			expLocations: [][]http.Location{
+				nil,
+				nil,
+				{createDefaultRootLocation()},
+				{createDefaultRootLocation()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			shareLocations(test.servers, test.virtualServers, test.matchPairs, executeLocations)
+
+			g.Expect(test.matchPairs).To(Equal(test.expMatchPairs))
+
+			for i, server := range test.servers {
+				g.Expect(server.SharedLocations).To(Equal(test.expSharedLocations[i]))
+				g.Expect(server.Locations).To(Equal(test.expLocations[i]))
+			}
+		})
+	}
+}
+
+func TestExecuteServers_SharedLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/coffee",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "version",
+								Value: "v2",
+								Type:  dataplane.MatchTypeExact,
+							},
+						},
+					},
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_coffee_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname:  "cafe.example.com",
+				Port:      8080,
+				PathRules: pathRules,
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				Hostname:  "cafe.example.com",
+				Port:      8443,
+				PathRules: pathRules,
+				SSL:       &dataplane.SSL{KeyPairID: "test-keypair"},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		conf,
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		alwaysEmptySessionCookieGetter,
+	)
+
+	const sharedLocationsFile = includesFolder + "/shared-locations-0.conf"
+
+	files := getResultFiles(results)
+
+	g.Expect(files).To(HaveKey(sharedLocationsFile))
+	g.Expect(files[sharedLocationsFile]).To(ContainSubstring("location /coffee/ {"))
+	g.Expect(files[sharedLocationsFile]).To(ContainSubstring("set $match_key 0_0;"))
+	g.Expect(files[sharedLocationsFile]).To(ContainSubstring("location /_ngf-internal-rule0-route0 {"))
+
+	serverConf := files[httpConfigFile]
+	g.Expect(strings.Count(serverConf, "include "+sharedLocationsFile+";")).To(Equal(2))
+	g.Expect(serverConf).ToNot(ContainSubstring("location /coffee/ {"))
+
+	g.Expect(files[httpMatchVarsFile]).To(ContainSubstring(`"0_0"`))
+	g.Expect(files[httpMatchVarsFile]).ToNot(ContainSubstring("SSL_0_0"))
+}
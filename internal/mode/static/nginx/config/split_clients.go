@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
@@ -14,6 +15,7 @@ var splitClientsTemplate = gotemplate.Must(gotemplate.New("split_clients").Parse
 
 func executeSplitClients(conf dataplane.Configuration) []executeResult {
 	splitClients := createSplitClients(conf.BackendGroups)
+	splitClients = append(splitClients, createMirrorSplitClients(conf.HTTPServers, conf.SSLServers)...)
 
 	result := executeResult{
 		dest: httpConfigFile,
@@ -52,6 +54,62 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 	return splitClients
 }
 
+// createMirrorSplitClients creates a split client for every unique backend and percentage of the RequestMirror
+// filters that mirror only a percentage of requests. The internal mirror location uses the variable of the split
+// client to decide whether to send the mirrored request.
+func createMirrorSplitClients(serverGroups ...[]dataplane.VirtualServer) []http.SplitClient {
+	var splitClients []http.SplitClient
+	seen := make(map[string]struct{})
+
+	for _, servers := range serverGroups {
+		for _, s := range servers {
+			for _, rule := range s.PathRules {
+				for _, r := range rule.MatchRules {
+					for _, mirror := range r.Filters.RequestMirrors {
+						variableName := createMirrorSplitClientsVariableName(mirror)
+						if variableName == "" {
+							continue
+						}
+
+						if _, exists := seen[variableName]; exists {
+							continue
+						}
+						seen[variableName] = struct{}{}
+
+						splitClients = append(splitClients, http.SplitClient{
+							VariableName: variableName,
+							Distributions: []http.SplitClientDistribution{
+								{
+									Percent: formatMirrorPercent(*mirror.Percent),
+									Value:   "on",
+								},
+								{
+									Percent: formatMirrorPercent(100 - *mirror.Percent),
+									Value:   "off",
+								},
+							},
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return splitClients
+}
+
+// createMirrorSplitClientsVariableName returns the name of the split clients variable for a RequestMirror filter.
+// If all requests are mirrored, no split client is needed and an empty string is returned.
+func createMirrorSplitClientsVariableName(mirror dataplane.HTTPRequestMirrorFilter) string {
+	if mirror.Percent == nil {
+		return ""
+	}
+
+	percent := strings.ReplaceAll(formatMirrorPercent(*mirror.Percent), ".", "_")
+
+	return convertStringToSafeVariableName(fmt.Sprintf("mirror_%s_%s", mirror.UpstreamName, percent))
+}
+
 func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitClientDistribution {
 	if !backendGroupNeedsSplit(group) {
 		return nil
@@ -73,33 +131,37 @@ func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitCl
 		}
 	}
 
-	distributions := make([]http.SplitClientDistribution, 0, len(backends))
+	// The last backend with a non-zero weight gets the remaining percentage.
+	// This is done to guarantee that the sum of all percentages is 100, without sending any traffic
+	// to the backends with a zero weight, which are draining.
+	lastWeightedIdx := len(backends) - 1
+	for backends[lastWeightedIdx].Weight == 0 {
+		lastWeightedIdx--
+	}
+
+	distributions := make([]http.SplitClientDistribution, len(backends))
 
 	// The percentage of all backends cannot exceed 100.
 	availablePercentage := float64(100)
 
-	// Iterate over all backends except the last one.
-	// The last backend will get the remaining percentage.
-	for i := range len(backends) - 1 {
-		b := backends[i]
+	for i, b := range backends {
+		if i == lastWeightedIdx {
+			continue
+		}
 
 		percentage := percentOf(b.Weight, totalWeight)
 		availablePercentage -= percentage
 
-		distributions = append(distributions, http.SplitClientDistribution{
+		distributions[i] = http.SplitClientDistribution{
 			Percent: fmt.Sprintf("%.2f", percentage),
 			Value:   getSplitClientValue(b),
-		})
+		}
 	}
 
-	// The last backend gets the remaining percentage.
-	// This is done to guarantee that the sum of all percentages is 100.
-	lastBackend := backends[len(backends)-1]
-
-	distributions = append(distributions, http.SplitClientDistribution{
+	distributions[lastWeightedIdx] = http.SplitClientDistribution{
 		Percent: fmt.Sprintf("%.2f", availablePercentage),
-		Value:   getSplitClientValue(lastBackend),
-	})
+		Value:   getSplitClientValue(backends[lastWeightedIdx]),
+	}
 
 	return distributions
 }
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -252,6 +253,74 @@ func TestCreateSplitClients(t *testing.T) {
 	}
 }
 
+func TestCreateMirrorSplitClients(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createServer := func(mirrors ...dataplane.HTTPRequestMirrorFilter) dataplane.VirtualServer {
+		return dataplane.VirtualServer{
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						{
+							Filters: dataplane.HTTPFilters{
+								RequestMirrors: mirrors,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	allRequests := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_all_80",
+	}
+	quarter := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_quarter_80",
+		Percent:      helpers.GetPointer(25.0),
+	}
+	third := dataplane.HTTPRequestMirrorFilter{
+		UpstreamName: "test_third_80",
+		Percent:      helpers.GetPointer(33.33),
+	}
+
+	httpServers := []dataplane.VirtualServer{createServer(allRequests, quarter)}
+	sslServers := []dataplane.VirtualServer{createServer(quarter, third)} // quarter is only added once
+
+	expSplitClients := []http.SplitClient{
+		{
+			VariableName: "mirror_test_quarter_80_25_00",
+			Distributions: []http.SplitClientDistribution{
+				{
+					Percent: "25.00",
+					Value:   "on",
+				},
+				{
+					Percent: "75.00",
+					Value:   "off",
+				},
+			},
+		},
+		{
+			VariableName: "mirror_test_third_80_33_33",
+			Distributions: []http.SplitClientDistribution{
+				{
+					Percent: "33.33",
+					Value:   "on",
+				},
+				{
+					Percent: "66.67",
+					Value:   "off",
+				},
+			},
+		},
+	}
+
+	g.Expect(createMirrorSplitClients(httpServers, sslServers)).To(Equal(expSplitClients))
+	g.Expect(createMirrorSplitClients(nil, nil)).To(BeNil())
+}
+
 func TestCreateSplitClientDistributions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -389,6 +458,40 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "three backends; the last backend has a zero weight",
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "one",
+					Valid:        true,
+					Weight:       2,
+				},
+				{
+					UpstreamName: "two",
+					Valid:        true,
+					Weight:       1,
+				},
+				{
+					UpstreamName: "three",
+					Valid:        true,
+					Weight:       0,
+				},
+			},
+			expDistributions: []http.SplitClientDistribution{
+				{
+					Percent: "66.66",
+					Value:   "one",
+				},
+				{
+					Percent: "33.34", // the last backend with a non-zero weight gets the remainder.
+					Value:   "two",
+				},
+				{
+					Percent: "0.00",
+					Value:   "three",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
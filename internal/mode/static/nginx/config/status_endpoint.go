@@ -0,0 +1,40 @@
+package config
+
+import (
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var statusEndpointTemplate = gotemplate.Must(gotemplate.New("statusEndpoint").Parse(statusEndpointTemplateText))
+
+type statusEndpointServer struct {
+	AllowedAddresses []string
+	Port             int32
+	IPFamily         shared.IPFamily
+	Plus             bool
+}
+
+// executeStatusEndpoint generates the server of the read-only status endpoint. With NGINX OSS, it serves
+// stub_status. With NGINX Plus, it serves the read-only NGINX Plus API and the dashboard.
+func (g GeneratorImpl) executeStatusEndpoint(conf dataplane.Configuration) []executeResult {
+	if conf.StatusEndpoint == nil {
+		return nil
+	}
+
+	server := statusEndpointServer{
+		AllowedAddresses: conf.StatusEndpoint.AllowedAddresses,
+		Port:             conf.StatusEndpoint.Port,
+		IPFamily:         getIPFamily(conf.BaseHTTPConfig),
+		Plus:             g.plus,
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(statusEndpointTemplate, server),
+	}
+
+	return []executeResult{result}
+}
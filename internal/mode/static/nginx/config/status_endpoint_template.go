@@ -0,0 +1,34 @@
+package config
+
+const statusEndpointTemplateText = `
+server {
+    {{- if .IPFamily.IPv4 }}
+    listen {{ .Port }};
+    {{- end }}
+    {{- if .IPFamily.IPv6 }}
+    listen [::]:{{ .Port }};
+    {{- end }}
+    access_log off;
+    {{ range $address := .AllowedAddresses }}
+    allow {{ $address }};
+    {{- end }}
+    deny all;
+    {{ if .Plus }}
+    root /usr/share/nginx/html;
+
+    location = /dashboard.html {}
+
+    location /api {
+      api write=off;
+    }
+    {{- else }}
+    location = /stub_status {
+      stub_status;
+    }
+    {{- end }}
+
+    location / {
+      return 404;
+    }
+}
+`
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteStatusEndpoint(t *testing.T) {
+	t.Parallel()
+
+	statusEndpoint := &dataplane.StatusEndpoint{
+		AllowedAddresses: []string{"10.0.0.0/8", "fd00::10"},
+		Port:             8080,
+	}
+
+	tests := []struct {
+		expSubStrings map[string]int
+		name          string
+		ipFamily      dataplane.IPFamilyType
+		plus          bool
+	}{
+		{
+			name:     "NGINX OSS",
+			ipFamily: dataplane.Dual,
+			expSubStrings: map[string]int{
+				"listen 8080;":               1,
+				"listen [::]:8080;":          1,
+				"allow 10.0.0.0/8;":          1,
+				"allow fd00::10;":            1,
+				"deny all;":                  1,
+				"location = /stub_status {":  1,
+				"stub_status;":               1,
+				"api write=off;":             0,
+				"location = /dashboard.html": 0,
+			},
+		},
+		{
+			name:     "NGINX Plus",
+			ipFamily: dataplane.Dual,
+			plus:     true,
+			expSubStrings: map[string]int{
+				"listen 8080;":                  1,
+				"allow 10.0.0.0/8;":             1,
+				"deny all;":                     1,
+				"root /usr/share/nginx/html;":   1,
+				"location = /dashboard.html {}": 1,
+				"api write=off;":                1,
+				"stub_status;":                  0,
+			},
+		},
+		{
+			name:     "IPv4",
+			ipFamily: dataplane.IPv4,
+			expSubStrings: map[string]int{
+				"listen 8080;":      1,
+				"listen [::]:8080;": 0,
+			},
+		},
+		{
+			name:     "IPv6",
+			ipFamily: dataplane.IPv6,
+			expSubStrings: map[string]int{
+				"listen 8080;":      0,
+				"listen [::]:8080;": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				StatusEndpoint: statusEndpoint,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: test.ipFamily},
+			}
+
+			gen := GeneratorImpl{plus: test.plus}
+
+			res := gen.executeStatusEndpoint(conf)
+			g.Expect(res).To(HaveLen(1))
+			g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteStatusEndpoint_Disabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+
+	g.Expect(gen.executeStatusEndpoint(dataplane.Configuration{})).To(BeEmpty())
+}
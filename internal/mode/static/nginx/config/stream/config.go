@@ -4,13 +4,32 @@ import "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/
 
 // Server holds all configuration for a stream server.
 type Server struct {
-	Listen          string
-	StatusZone      string
-	ProxyPass       string
-	Pass            string
+	Listen        string
+	ListenOptions string
+	TCPNoDelay    string
+	StatusZone    string
+	ProxyPass     string
+	Pass          string
+	// ProxyConnectTimeout, ProxyTimeout and PrereadTimeout override the timeouts of the ServerConfig for the server.
+	// Set for the servers of the Gateways that override the timeouts with their own ClientSettingsPolicies.
+	ProxyConnectTimeout string
+	ProxyTimeout        string
+	PrereadTimeout      string
+	ConnectionLimit     *ConnectionLimit
+	// IPFamily overrides the IP family of the ServerConfig for the server. Set for the servers of the Gateways
+	// that override the settings of the data plane with their own NginxProxy.
+	IPFamily        *shared.IPFamily
 	RewriteClientIP shared.RewriteClientIPSettings
 	SSLPreread      bool
 	IsSocket        bool
+	UDP             bool
+	ProxyProtocol   bool
+}
+
+// ConnectionLimit holds the configuration of the limit of the concurrent connections of a stream server.
+type ConnectionLimit struct {
+	Zone           string
+	MaxConnections int32
 }
 
 // Upstream holds all configuration for a stream upstream.
@@ -28,7 +47,12 @@ type UpstreamServer struct {
 
 // ServerConfig holds configuration for a stream server and IP family to be used by NGINX.
 type ServerConfig struct {
-	Servers  []Server
-	IPFamily shared.IPFamily
-	Plus     bool
+	ProxyConnectTimeout string
+	ProxyTimeout        string
+	PrereadTimeout      string
+	Servers             []Server
+	IPFamily            shared.IPFamily
+	MapHashMaxSize      int32
+	MapHashBucketSize   int32
+	Plus                bool
 }
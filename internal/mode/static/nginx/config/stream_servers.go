@@ -16,9 +16,14 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 	streamServers := createStreamServers(conf)
 
 	streamServerConfig := stream.ServerConfig{
-		Servers:  streamServers,
-		IPFamily: getIPFamily(conf.BaseHTTPConfig),
-		Plus:     g.plus,
+		Servers:             streamServers,
+		ProxyConnectTimeout: conf.StreamSettings.ProxyConnectTimeout,
+		ProxyTimeout:        conf.StreamSettings.ProxyTimeout,
+		PrereadTimeout:      conf.StreamSettings.PrereadTimeout,
+		IPFamily:            getIPFamily(conf.BaseHTTPConfig),
+		MapHashMaxSize:      conf.HashSizes.StreamMapMaxSize,
+		MapHashBucketSize:   conf.HashSizes.StreamMapBucketSize,
+		Plus:                g.plus,
 	}
 
 	streamServerResult := executeResult{
@@ -32,11 +37,15 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 }
 
 func createStreamServers(conf dataplane.Configuration) []stream.Server {
-	if len(conf.TLSPassthroughServers) == 0 {
+	if len(conf.TLSPassthroughServers) == 0 && len(conf.TCPServers) == 0 && len(conf.UDPServers) == 0 {
 		return nil
 	}
 
-	streamServers := make([]stream.Server, 0, len(conf.TLSPassthroughServers)*2)
+	streamServers := make(
+		[]stream.Server,
+		0,
+		len(conf.TLSPassthroughServers)*2+len(conf.TCPServers)+len(conf.UDPServers),
+	)
 	portSet := make(map[int32]struct{})
 	upstreams := make(map[string]dataplane.Upstream)
 
@@ -44,19 +53,31 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 		upstreams[u.Name] = u
 	}
 
+	passthroughIPFamilies := getPassthroughPortIPFamilies(conf)
+	passthroughPrereadTimeouts := getPassthroughPortPrereadTimeouts(conf)
+
 	for _, server := range conf.TLSPassthroughServers {
 		if u, ok := upstreams[server.UpstreamName]; ok && server.UpstreamName != "" {
 			if server.Hostname != "" && len(u.Endpoints) > 0 {
 				streamServer := stream.Server{
-					Listen:     getSocketNameTLS(server.Port, server.Hostname),
-					StatusZone: server.Hostname,
-					ProxyPass:  server.UpstreamName,
-					IsSocket:   true,
+					Listen:          getSocketNameTLS(server.Port, server.Hostname),
+					StatusZone:      dataplane.StatusZoneName(server.Hostname, server.Port),
+					ProxyPass:       server.UpstreamName,
+					ConnectionLimit: createStreamConnectionLimit(server.ConnectionLimit),
+					IsSocket:        true,
+					ProxyProtocol:   server.SendProxyProtocol,
+				}
+				// the TLS ClientHello is read by the server of the port, so only the proxy timeouts are set here
+				if server.StreamSettings != nil {
+					streamServer.ProxyConnectTimeout = server.StreamSettings.ProxyConnectTimeout
+					streamServer.ProxyTimeout = server.StreamSettings.ProxyTimeout
 				}
 				// set rewriteClientIP settings as this is a socket stream server
-				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
-					conf.BaseHTTPConfig.RewriteClientIPSettings,
-				)
+				rewriteClientIPSettings := conf.BaseHTTPConfig.RewriteClientIPSettings
+				if server.GatewaySettings != nil {
+					rewriteClientIPSettings = server.GatewaySettings.RewriteClientIPSettings
+				}
+				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(rewriteClientIPSettings)
 				streamServers = append(streamServers, streamServer)
 			}
 		}
@@ -67,18 +88,174 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 
 		portSet[server.Port] = struct{}{}
 
+		socketOptions := conf.SocketOptions[server.Port]
+
 		// we do not evaluate rewriteClientIP settings for non-socket stream servers
 		streamServer := stream.Server{
-			Listen:     fmt.Sprint(server.Port),
-			StatusZone: server.Hostname,
-			Pass:       getTLSPassthroughVarName(server.Port),
-			SSLPreread: true,
+			Listen:         fmt.Sprint(conf.Worker.ListenPort(server.Port)),
+			ListenOptions:  createListenOptions(socketOptions, false /* udp */),
+			TCPNoDelay:     socketOptions.TCPNoDelay,
+			StatusZone:     server.Hostname,
+			Pass:           getTLSPassthroughVarName(server.Port),
+			SSLPreread:     true,
+			IPFamily:       passthroughIPFamilies[server.Port],
+			PrereadTimeout: passthroughPrereadTimeouts[server.Port],
+		}
+		streamServers = append(streamServers, streamServer)
+	}
+
+	for _, server := range conf.TCPServers {
+		// if the upstream has no endpoints, we close the connection instead of passing it to an empty upstream
+		proxyPass := connectionClosedStreamServerSocket
+		if u, ok := upstreams[server.UpstreamName]; ok && server.UpstreamName != "" && len(u.Endpoints) > 0 {
+			proxyPass = server.UpstreamName
+		}
+
+		var statusZone string
+		if server.UpstreamName != "" {
+			statusZone = dataplane.StatusZoneName(server.UpstreamName, server.Port)
+		}
+
+		socketOptions := conf.SocketOptions[server.Port]
+
+		streamServer := stream.Server{
+			Listen:          fmt.Sprint(conf.Worker.ListenPort(server.Port)),
+			ListenOptions:   createListenOptions(socketOptions, false /* udp */),
+			TCPNoDelay:      socketOptions.TCPNoDelay,
+			StatusZone:      statusZone,
+			ProxyPass:       proxyPass,
+			ConnectionLimit: createStreamConnectionLimit(server.ConnectionLimit),
+			IPFamily:        getGatewayIPFamily(server.GatewaySettings),
+		}
+		setStreamSettings(&streamServer, server.StreamSettings)
+		streamServers = append(streamServers, streamServer)
+	}
+
+	for _, server := range conf.UDPServers {
+		// datagrams can't be passed to the connection closed server, so the port is not listened on
+		// until the upstream has endpoints
+		if u, ok := upstreams[server.UpstreamName]; !ok || server.UpstreamName == "" || len(u.Endpoints) == 0 {
+			continue
+		}
+
+		streamServer := stream.Server{
+			Listen:        fmt.Sprint(conf.Worker.ListenPort(server.Port)),
+			ListenOptions: createListenOptions(conf.SocketOptions[server.Port], true /* udp */),
+			StatusZone:    dataplane.StatusZoneName(server.UpstreamName, server.Port),
+			ProxyPass:     server.UpstreamName,
+			UDP:           true,
+			IPFamily:      getGatewayIPFamily(server.GatewaySettings),
 		}
+		setStreamSettings(&streamServer, server.StreamSettings)
 		streamServers = append(streamServers, streamServer)
 	}
+
 	return streamServers
 }
 
+// getGatewayIPFamily returns the IP family of the Gateway of a server, which overrides the IP family of
+// the ServerConfig. Returns nil if the server uses the IP family of the ServerConfig.
+func getGatewayIPFamily(settings *dataplane.GatewaySettings) *shared.IPFamily {
+	if settings == nil {
+		return nil
+	}
+
+	ipFamily := convertIPFamily(settings.IPFamily)
+
+	return &ipFamily
+}
+
+// getPassthroughPortIPFamilies returns the IP families of the ports of the TLS passthrough servers, which override
+// the IP family of the ServerConfig. The server of a port is shared by the TLS passthrough servers of the port,
+// so it listens on the IP families of all of them. The ports of the servers that use the IP family of
+// the ServerConfig are omitted, unless they are shared with the servers of the Gateways with their own IP family.
+func getPassthroughPortIPFamilies(conf dataplane.Configuration) map[int32]*shared.IPFamily {
+	ipFamilies := make(map[int32]*shared.IPFamily)
+	overridden := make(map[int32]bool)
+
+	for _, server := range conf.TLSPassthroughServers {
+		ipFamily := getGatewayIPFamily(server.GatewaySettings)
+		if ipFamily != nil {
+			overridden[server.Port] = true
+		} else {
+			baseIPFamily := getIPFamily(conf.BaseHTTPConfig)
+			ipFamily = &baseIPFamily
+		}
+
+		portIPFamily, exists := ipFamilies[server.Port]
+		if !exists {
+			ipFamilies[server.Port] = ipFamily
+			continue
+		}
+
+		portIPFamily.IPv4 = portIPFamily.IPv4 || ipFamily.IPv4
+		portIPFamily.IPv6 = portIPFamily.IPv6 || ipFamily.IPv6
+	}
+
+	for port := range ipFamilies {
+		if !overridden[port] {
+			delete(ipFamilies, port)
+		}
+	}
+
+	return ipFamilies
+}
+
+// setStreamSettings sets the timeouts of the Gateway of a server, which override the timeouts of the ServerConfig.
+func setStreamSettings(server *stream.Server, settings *dataplane.StreamSettings) {
+	if settings == nil {
+		return
+	}
+
+	server.ProxyConnectTimeout = settings.ProxyConnectTimeout
+	server.ProxyTimeout = settings.ProxyTimeout
+	server.PrereadTimeout = settings.PrereadTimeout
+}
+
+// getPassthroughPortPrereadTimeouts returns the preread timeouts of the ports of the TLS passthrough servers, which
+// override the preread timeout of the ServerConfig. The server of a port reads the TLS ClientHello for all
+// the TLS passthrough servers of the port, so a port only gets the preread timeout of a Gateway if all of its
+// servers set the same one. Otherwise, the port is omitted and uses the preread timeout of the ServerConfig.
+func getPassthroughPortPrereadTimeouts(conf dataplane.Configuration) map[int32]string {
+	timeouts := make(map[int32]string)
+	sameTimeout := make(map[int32]bool)
+
+	for _, server := range conf.TLSPassthroughServers {
+		var timeout string
+		if server.StreamSettings != nil {
+			timeout = server.StreamSettings.PrereadTimeout
+		}
+
+		portTimeout, exists := timeouts[server.Port]
+		if !exists {
+			timeouts[server.Port] = timeout
+			sameTimeout[server.Port] = true
+			continue
+		}
+
+		sameTimeout[server.Port] = sameTimeout[server.Port] && portTimeout == timeout
+	}
+
+	for port, timeout := range timeouts {
+		if !sameTimeout[port] || timeout == "" {
+			delete(timeouts, port)
+		}
+	}
+
+	return timeouts
+}
+
+func createStreamConnectionLimit(limit *dataplane.ConnectionLimit) *stream.ConnectionLimit {
+	if limit == nil {
+		return nil
+	}
+
+	return &stream.ConnectionLimit{
+		Zone:           limit.Zone,
+		MaxConnections: limit.MaxConnections,
+	}
+}
+
 func getRewriteClientIPSettingsForStream(
 	rewriteConfig dataplane.RewriteClientIPSettings,
 ) shared.RewriteClientIPSettings {
@@ -1,13 +1,35 @@
 package config
 
 const streamServersTemplateText = `
+{{- if .MapHashMaxSize }}
+map_hash_max_size {{ .MapHashMaxSize }};
+{{- end }}
+{{- if .MapHashBucketSize }}
+map_hash_bucket_size {{ .MapHashBucketSize }};
+{{- end }}
+{{- if .ProxyConnectTimeout }}
+proxy_connect_timeout {{ .ProxyConnectTimeout }};
+{{- end }}
+{{- if .ProxyTimeout }}
+proxy_timeout {{ .ProxyTimeout }};
+{{- end }}
+{{- if .PrereadTimeout }}
+preread_timeout {{ .PrereadTimeout }};
+{{- end }}
 {{- range $s := .Servers }}
+	{{- $ipFamily := $.IPFamily }}
+	{{- if $s.IPFamily }}
+		{{- $ipFamily = $s.IPFamily }}
+	{{- end }}
 server {
-	{{- if or ($.IPFamily.IPv4) ($s.IsSocket) }}
-    listen {{ $s.Listen }}{{ $s.RewriteClientIP.ProxyProtocol }};
+	{{- if or ($ipFamily.IPv4) ($s.IsSocket) }}
+    listen {{ $s.Listen }}{{ if $s.UDP }} udp{{ end }}{{ $s.ListenOptions }}{{ $s.RewriteClientIP.ProxyProtocol }};
 	{{- end }}
-	{{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
-    listen [::]:{{ $s.Listen }};
+	{{- if and ($ipFamily.IPv6) (not $s.IsSocket) }}
+    listen [::]:{{ $s.Listen }}{{ if $s.UDP }} udp{{ end }}{{ $s.ListenOptions }};
+	{{- end }}
+	{{- if $s.TCPNoDelay }}
+    tcp_nodelay {{ $s.TCPNoDelay }};
 	{{- end }}
 
     {{- range $address := $s.RewriteClientIP.RealIPFrom }}
@@ -16,9 +38,24 @@ server {
 	{{- if and $.Plus $s.StatusZone }}
     status_zone {{ $s.StatusZone }};
     {{- end }}
+	{{- if $s.ProxyConnectTimeout }}
+    proxy_connect_timeout {{ $s.ProxyConnectTimeout }};
+	{{- end }}
+	{{- if $s.ProxyTimeout }}
+    proxy_timeout {{ $s.ProxyTimeout }};
+	{{- end }}
+	{{- if $s.PrereadTimeout }}
+    preread_timeout {{ $s.PrereadTimeout }};
+	{{- end }}
+	{{- if $s.ConnectionLimit }}
+    limit_conn {{ $s.ConnectionLimit.Zone }} {{ $s.ConnectionLimit.MaxConnections }};
+	{{- end }}
 
 	{{- if $s.ProxyPass }}
     proxy_pass {{ $s.ProxyPass }};
+	{{- end }}
+	{{- if $s.ProxyProtocol }}
+    proxy_protocol on;
 	{{- end }}
 	{{- if $s.Pass }}
     pass {{ $s.Pass }};
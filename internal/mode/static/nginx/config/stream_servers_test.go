@@ -148,6 +148,34 @@ func TestCreateStreamServers(t *testing.T) {
 				UpstreamName: "no-endpoints",
 			},
 		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         9000,
+				UpstreamName: "backend1",
+			},
+			{
+				Port:         9001,
+				UpstreamName: "no-endpoints",
+			},
+			{
+				Port:         9002,
+				UpstreamName: "",
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         53,
+				UpstreamName: "backend2",
+			},
+			{
+				Port:         54,
+				UpstreamName: "no-endpoints",
+			},
+			{
+				Port:         55,
+				UpstreamName: "dne",
+			},
+		},
 		StreamUpstreams: []dataplane.Upstream{
 			{
 				Name: "backend1",
@@ -180,23 +208,32 @@ func TestCreateStreamServers(t *testing.T) {
 
 	expectedStreamServers := []stream.Server{
 		{
-			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[0].Port, conf.TLSPassthroughServers[0].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[0].UpstreamName,
-			StatusZone: conf.TLSPassthroughServers[0].Hostname,
+			Listen:    getSocketNameTLS(conf.TLSPassthroughServers[0].Port, conf.TLSPassthroughServers[0].Hostname),
+			ProxyPass: conf.TLSPassthroughServers[0].UpstreamName,
+			StatusZone: dataplane.StatusZoneName(
+				conf.TLSPassthroughServers[0].Hostname,
+				conf.TLSPassthroughServers[0].Port,
+			),
 			SSLPreread: false,
 			IsSocket:   true,
 		},
 		{
-			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[1].Port, conf.TLSPassthroughServers[1].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[1].UpstreamName,
-			StatusZone: conf.TLSPassthroughServers[1].Hostname,
+			Listen:    getSocketNameTLS(conf.TLSPassthroughServers[1].Port, conf.TLSPassthroughServers[1].Hostname),
+			ProxyPass: conf.TLSPassthroughServers[1].UpstreamName,
+			StatusZone: dataplane.StatusZoneName(
+				conf.TLSPassthroughServers[1].Hostname,
+				conf.TLSPassthroughServers[1].Port,
+			),
 			SSLPreread: false,
 			IsSocket:   true,
 		},
 		{
-			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[2].Port, conf.TLSPassthroughServers[2].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[2].UpstreamName,
-			StatusZone: conf.TLSPassthroughServers[2].Hostname,
+			Listen:    getSocketNameTLS(conf.TLSPassthroughServers[2].Port, conf.TLSPassthroughServers[2].Hostname),
+			ProxyPass: conf.TLSPassthroughServers[2].UpstreamName,
+			StatusZone: dataplane.StatusZoneName(
+				conf.TLSPassthroughServers[2].Hostname,
+				conf.TLSPassthroughServers[2].Port,
+			),
 			SSLPreread: false,
 			IsSocket:   true,
 		},
@@ -212,10 +249,437 @@ func TestCreateStreamServers(t *testing.T) {
 			StatusZone: "example.com",
 			SSLPreread: true,
 		},
+		{
+			Listen:     fmt.Sprint(9000),
+			ProxyPass:  "backend1",
+			StatusZone: "backend1_9000",
+		},
+		{
+			Listen:     fmt.Sprint(9001),
+			ProxyPass:  connectionClosedStreamServerSocket,
+			StatusZone: "no-endpoints_9001",
+		},
+		{
+			Listen:    fmt.Sprint(9002),
+			ProxyPass: connectionClosedStreamServerSocket,
+		},
+		{
+			Listen:     fmt.Sprint(53),
+			ProxyPass:  "backend2",
+			StatusZone: "backend2_53",
+			UDP:        true,
+		},
 	}
 	g.Expect(streamServers).To(ConsistOf(expectedStreamServers))
 }
 
+func TestExecuteStreamServers_TCP(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         9000,
+				UpstreamName: "backend1",
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "backend1",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "1.1.1.1",
+						Port:    80,
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 9000;":         1,
+		"proxy_pass backend1;": 1,
+		"ssl_preread on;":      0,
+		"pass $dest":           0,
+	}
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+	result := results[0]
+
+	g.Expect(result.dest).To(Equal(streamConfigFile))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(result.data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteStreamServers_SendProxyProtocol(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:          "app.example.com",
+				Port:              8443,
+				UpstreamName:      "backend1",
+				SendProxyProtocol: true,
+			},
+			{
+				Hostname:     "other.example.com",
+				Port:         8443,
+				UpstreamName: "backend2",
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend1",
+				Endpoints: []resolver.Endpoint{{Address: "1.1.1.1", Port: 443}},
+			},
+			{
+				Name:      "backend2",
+				Endpoints: []resolver.Endpoint{{Address: "2.2.2.2", Port: 443}},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	data := string(results[0].data)
+	g.Expect(data).To(ContainSubstring("proxy_pass backend1;\n    proxy_protocol on;"))
+	g.Expect(strings.Count(data, "proxy_protocol on;")).To(Equal(1))
+}
+
+func TestExecuteStreamServers_ConnectionLimit(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:     "app.example.com",
+				Port:         8443,
+				UpstreamName: "backend1",
+				ConnectionLimit: &dataplane.ConnectionLimit{
+					Zone:           "stream_connlimit_test_tls",
+					MaxConnections: 10,
+				},
+			},
+			{
+				Hostname:     "other.example.com",
+				Port:         8443,
+				UpstreamName: "backend1",
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         9000,
+				UpstreamName: "backend1",
+				ConnectionLimit: &dataplane.ConnectionLimit{
+					Zone:           "stream_connlimit_test_tcp",
+					MaxConnections: 5,
+				},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend1",
+				Endpoints: []resolver.Endpoint{{Address: "1.1.1.1", Port: 443}},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	data := string(results[0].data)
+	g.Expect(strings.Count(data, "limit_conn ")).To(Equal(2))
+	g.Expect(data).To(ContainSubstring("limit_conn stream_connlimit_test_tls 10;"))
+	g.Expect(data).To(ContainSubstring("limit_conn stream_connlimit_test_tcp 5;"))
+}
+
+func TestExecuteStreamServers_HashSizes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HashSizes: dataplane.HashSizes{
+			ServerNamesMaxSize:    1024,
+			ServerNamesBucketSize: 256,
+			MapMaxSize:            2048,
+			MapBucketSize:         256,
+			StreamMapMaxSize:      4096,
+			StreamMapBucketSize:   512,
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	data := string(results[0].data)
+	g.Expect(data).To(ContainSubstring("map_hash_max_size 4096;"))
+	g.Expect(data).To(ContainSubstring("map_hash_bucket_size 512;"))
+	g.Expect(data).ToNot(ContainSubstring("server_names_hash"))
+
+	results = gen.executeStreamServers(dataplane.Configuration{})
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(string(results[0].data)).ToNot(ContainSubstring("map_hash"))
+}
+
+func TestExecuteStreamServers_StreamSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		StreamSettings: dataplane.StreamSettings{
+			ProxyConnectTimeout: "5s",
+			ProxyTimeout:        "10m",
+			PrereadTimeout:      "10s",
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	data := string(results[0].data)
+	g.Expect(data).To(ContainSubstring("proxy_connect_timeout 5s;"))
+	g.Expect(data).To(ContainSubstring("proxy_timeout 10m;"))
+	g.Expect(data).To(ContainSubstring("preread_timeout 10s;"))
+
+	results = gen.executeStreamServers(dataplane.Configuration{})
+	g.Expect(results).To(HaveLen(1))
+
+	data = string(results[0].data)
+	g.Expect(data).ToNot(ContainSubstring("proxy_connect_timeout"))
+	g.Expect(data).ToNot(ContainSubstring("proxy_timeout"))
+	g.Expect(data).ToNot(ContainSubstring("preread_timeout"))
+}
+
+func TestExecuteStreamServers_GatewayStreamSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwSettings := &dataplane.StreamSettings{
+		ProxyConnectTimeout: "5s",
+		ProxyTimeout:        "1h",
+		PrereadTimeout:      "30s",
+	}
+
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.IPv4},
+		StreamSettings: dataplane.StreamSettings{ProxyTimeout: "10m"},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:       "app.example.com",
+				UpstreamName:   "backend",
+				Port:           8443,
+				StreamSettings: gwSettings,
+			},
+			{
+				Hostname:       "shared.example.com",
+				UpstreamName:   "backend",
+				Port:           9443,
+				StreamSettings: gwSettings,
+			},
+			{
+				Hostname:     "other.example.com",
+				UpstreamName: "backend",
+				Port:         9443,
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				UpstreamName:   "backend",
+				Port:           5432,
+				StreamSettings: gwSettings,
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "backend",
+				Endpoints: []resolver.Endpoint{{Address: "1.1.1.1"}},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	data := string(results[0].data)
+	g.Expect(strings.Count(data, "proxy_timeout 10m;")).To(Equal(1))
+	// the socket servers of the TLS passthrough servers and the TCP server of the Gateway
+	g.Expect(strings.Count(data, "proxy_timeout 1h;")).To(Equal(3))
+	g.Expect(strings.Count(data, "proxy_connect_timeout 5s;")).To(Equal(3))
+	// the server of the port 8443 and the TCP server, but not the server of the port 9443
+	// that is shared with the servers that use the StreamSettings of the Configuration
+	g.Expect(strings.Count(data, "preread_timeout 30s;")).To(Equal(2))
+}
+
+func TestExecuteStreamServers_UDP(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.Dual},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         53,
+				UpstreamName: "backend1",
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "backend1",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "1.1.1.1",
+						Port:    80,
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 53 udp;":       1,
+		"listen [::]:53 udp;":  1,
+		"proxy_pass backend1;": 1,
+		"ssl_preread on;":      0,
+		"pass $dest":           0,
+	}
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+	result := results[0]
+
+	g.Expect(result.dest).To(Equal(streamConfigFile))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(result.data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteStreamServers_SocketOptions(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.IPv4},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:     "example.com",
+				Port:         8443,
+				UpstreamName: "backend1",
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         9000,
+				UpstreamName: "backend1",
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         53,
+				UpstreamName: "backend1",
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "backend1",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "1.1.1.1",
+						Port:    80,
+					},
+				},
+			},
+		},
+		SocketOptions: map[int32]dataplane.SocketOptions{
+			8443: {KeepAlive: "on", ReusePort: true, TCPNoDelay: "off"},
+			9000: {KeepAlive: "1m::", Backlog: 100, DeferredAccept: true, TCPNoDelay: "on"},
+			53:   {KeepAlive: "on", Backlog: 100, ReusePort: true},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 8443 reuseport so_keepalive=on;":              1,
+		"listen 9000 backlog=100 deferred so_keepalive=1m::;": 1,
+		"listen 53 udp reuseport;":                            1,
+		"tcp_nodelay off;":                                    1,
+		"tcp_nodelay on;":                                     1,
+		"so_keepalive=on;":                                    1,
+	}
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(results[0].data), expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteStreamServers_PortOffset(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.IPv4},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:     "example.com",
+				Port:         443,
+				UpstreamName: "backend1",
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         9000,
+				UpstreamName: "backend1",
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:         53,
+				UpstreamName: "backend1",
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "backend1",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "1.1.1.1",
+						Port:    80,
+					},
+				},
+			},
+		},
+		Worker: dataplane.Worker{PortOffset: 8000},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 8443;":     1,
+		"listen 9000;":     1,
+		"listen 8053 udp;": 1,
+		"listen 443":       0,
+		"listen 53":        0,
+	}
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(results[0].data), expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
 func TestExecuteStreamServersForIPFamily(t *testing.T) {
 	t.Parallel()
 	passThroughServers := []dataplane.Layer4VirtualServer{
@@ -283,6 +747,30 @@ func TestExecuteStreamServersForIPFamily(t *testing.T) {
 				"listen unix:/var/run/nginx/cafe.example.com-8443.sock;": 1,
 			},
 		},
+		{
+			msg: "tls servers with the IP family of their Gateway",
+			config: dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+				},
+				TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+					{
+						UpstreamName: "backend1",
+						Hostname:     "cafe.example.com",
+						Port:         8443,
+						GatewaySettings: &dataplane.GatewaySettings{
+							IPFamily: dataplane.IPv6,
+						},
+					},
+				},
+				StreamUpstreams: streamUpstreams,
+			},
+			expectedServerConfig: map[string]int{
+				"listen 8443;":      0,
+				"listen [::]:8443;": 1,
+				"listen unix:/var/run/nginx/cafe.example.com-8443.sock;": 1,
+			},
+		},
 	}
 
 	for _, test := range tests {
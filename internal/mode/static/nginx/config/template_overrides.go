@@ -0,0 +1,209 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	gotemplate "text/template"
+	"text/template/parse"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+// maxTemplateOverrideSize is the maximum size in bytes of a single template override.
+const maxTemplateOverrideSize = 64 * 1024
+
+// templateOverrideAllowedTemplates are the templates that the template overrides can execute, keyed by the name of
+// the template override.
+var templateOverrideAllowedTemplates = map[string][]string{
+	"server": {"location"},
+}
+
+// parseServersTemplateOverride returns the servers template built from the server and location template overrides.
+// An invalid override is ignored and the corresponding default template is used in its place.
+// If neither template is overridden, or both overrides are invalid, nil is returned.
+func (g GeneratorImpl) parseServersTemplateOverride(serverOverride, locationOverride string) *gotemplate.Template {
+	serverText := g.validOverrideOrDefault("server", serverOverride, serversTemplateText)
+	locationText := g.validOverrideOrDefault("location", locationOverride, locationTemplateText)
+
+	if serverText == serversTemplateText && locationText == locationTemplateText {
+		return nil
+	}
+
+	tmpl, err := newServersTemplate(serverText, locationText)
+	if err != nil {
+		g.logger.Error(err, "Failed to parse template override, using default template", "template", "server")
+		return nil
+	}
+
+	return tmpl
+}
+
+// parseUpstreamsTemplateOverride returns the upstreams template built from the upstream template override.
+// If the template is not overridden, or the override is invalid, nil is returned.
+func (g GeneratorImpl) parseUpstreamsTemplateOverride(upstreamOverride string) *gotemplate.Template {
+	upstreamText := g.validOverrideOrDefault("upstream", upstreamOverride, upstreamsTemplateText)
+	if upstreamText == upstreamsTemplateText {
+		return nil
+	}
+
+	return gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamText))
+}
+
+// validOverrideOrDefault returns the override if it is set and passes validation, otherwise the default text.
+func (g GeneratorImpl) validOverrideOrDefault(name, override, defaultText string) string {
+	if override == "" {
+		return defaultText
+	}
+
+	if err := ValidateTemplateOverride(name, override); err != nil {
+		g.logger.Error(err, "Invalid template override, using default template", "template", name)
+		return defaultText
+	}
+
+	return override
+}
+
+// ValidateTemplateOverride validates the template override with the name, which is its key in the
+// template overrides ConfigMap.
+func ValidateTemplateOverride(name, text string) error {
+	return validateTemplateOverride(name, text, templateOverrideAllowedTemplates[name])
+}
+
+// validateTemplateOverride parses the template override and ensures it only uses the sandboxed subset of
+// text/template. Templates may not define other templates, invoke the "call" function, use number literals
+// other than for comparing, formatting, or indexing, or execute any template other than the allowedTemplates.
+func validateTemplateOverride(name, text string, allowedTemplates []string) error {
+	if len(text) > maxTemplateOverrideSize {
+		return fmt.Errorf("template exceeds the maximum size of %d bytes", maxTemplateOverrideSize)
+	}
+
+	tmpl, err := gotemplate.New(name).Parse(text)
+	if err != nil {
+		return err
+	}
+
+	if len(tmpl.Templates()) > 1 {
+		return errors.New("template must not define other templates")
+	}
+
+	return validateTemplateNode(tmpl.Tree.Root, allowedTemplates)
+}
+
+func validateTemplateNode(node parse.Node, allowedTemplates []string) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, child := range n.Nodes {
+			if err := validateTemplateNode(child, allowedTemplates); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return validateTemplateNode(n.Pipe, allowedTemplates)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, cmd := range n.Cmds {
+			if err := validateTemplateNode(cmd, allowedTemplates); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		if err := validateNumberArgs(n); err != nil {
+			return err
+		}
+
+		for _, arg := range n.Args {
+			if err := validateTemplateNode(arg, allowedTemplates); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return validateTemplateNode(n.Node, allowedTemplates)
+	case *parse.IdentifierNode:
+		if n.Ident == "call" {
+			return errors.New(`function "call" is not allowed`)
+		}
+	case *parse.IfNode:
+		return validateBranchNode(&n.BranchNode, allowedTemplates)
+	case *parse.WithNode:
+		return validateBranchNode(&n.BranchNode, allowedTemplates)
+	case *parse.RangeNode:
+		return validateBranchNode(&n.BranchNode, allowedTemplates)
+	case *parse.TemplateNode:
+		if !slices.Contains(allowedTemplates, n.Name) {
+			return fmt.Errorf("template %q is not allowed", n.Name)
+		}
+
+		return validateTemplateNode(n.Pipe, allowedTemplates)
+	}
+
+	return nil
+}
+
+// validateNumberArgs ensures that number literals are only used for comparing, formatting, or indexing.
+// This prevents templates from ranging over an arbitrary number, which could make rendering run indefinitely.
+func validateNumberArgs(cmd *parse.CommandNode) error {
+	var fn string
+	if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok {
+		fn = ident.Ident
+	}
+
+	for i, arg := range cmd.Args {
+		if _, isNumber := arg.(*parse.NumberNode); !isNumber {
+			continue
+		}
+
+		switch fn {
+		case "eq", "ne", "lt", "le", "gt", "ge", "print", "printf", "println":
+			continue
+		case "index", "slice":
+			if i > 1 {
+				continue
+			}
+		}
+
+		return fmt.Errorf("number %s is not allowed here; numbers may only be compared, formatted, or used as an index",
+			arg.String())
+	}
+
+	return nil
+}
+
+func validateBranchNode(n *parse.BranchNode, allowedTemplates []string) error {
+	for _, child := range []parse.Node{n.Pipe, n.List, n.ElseList} {
+		if err := validateTemplateNode(child, allowedTemplates); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeTemplateWithOverride renders the data with the override template. If there is no override, or it fails
+// to render against the data, the default template is used instead.
+func (g GeneratorImpl) executeTemplateWithOverride(
+	override *gotemplate.Template,
+	defaultTemplate *gotemplate.Template,
+	data interface{},
+) []byte {
+	if override != nil {
+		var buf bytes.Buffer
+
+		err := override.Execute(&buf, data)
+		if err == nil {
+			return buf.Bytes()
+		}
+
+		g.logger.Error(err, "Failed to render template override, using default template", "template", override.Name())
+	}
+
+	return helpers.MustExecuteTemplate(defaultTemplate, data)
+}
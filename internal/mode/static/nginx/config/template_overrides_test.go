@@ -0,0 +1,289 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestValidateTemplateOverride(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		text             string
+		expErr           string
+		allowedTemplates []string
+	}{
+		{
+			name:             "default servers template",
+			text:             serversTemplateText,
+			allowedTemplates: []string{"location"},
+		},
+		{
+			name: "default location template",
+			text: locationTemplateText,
+		},
+		{
+			name: "default upstreams template",
+			text: upstreamsTemplateText,
+		},
+		{
+			name: "numbers used for comparing, formatting, and indexing",
+			text: `{{ if eq .Code 301 }}{{ printf "%d" 1 }}{{ index .Values 0 }}{{ end }}`,
+		},
+		{
+			name:   "parse error",
+			text:   "{{ .Path ",
+			expErr: "unclosed action",
+		},
+		{
+			name:   "too large",
+			text:   strings.Repeat("a", maxTemplateOverrideSize+1),
+			expErr: "template exceeds the maximum size of 65536 bytes",
+		},
+		{
+			name:   "defines another template",
+			text:   `{{ define "other" }}other{{ end }}`,
+			expErr: "template must not define other templates",
+		},
+		{
+			name:   "uses call",
+			text:   `{{ if .Path }}{{ call .Func }}{{ end }}`,
+			expErr: `function "call" is not allowed`,
+		},
+		{
+			name:   "ranges over a number",
+			text:   `{{ range 1000000 }}a{{ end }}`,
+			expErr: "number 1000000 is not allowed here",
+		},
+		{
+			name:   "assigns a number to a variable",
+			text:   `{{ $n := 1000000 }}{{ range $n }}a{{ end }}`,
+			expErr: "number 1000000 is not allowed here",
+		},
+		{
+			name:   "passes a number through index",
+			text:   `{{ with index 1000000 }}{{ range . }}a{{ end }}{{ end }}`,
+			expErr: "number 1000000 is not allowed here",
+		},
+		{
+			name:   "executes a template that is not allowed",
+			text:   `{{ range .Servers }}{{ template "location" . }}{{ end }}`,
+			expErr: `template "location" is not allowed`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateTemplateOverride("test", test.text, test.allowedTemplates)
+			if test.expErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+			}
+		})
+	}
+}
+
+func TestValidateTemplateOverride_AllowedTemplates(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(ValidateTemplateOverride("server", serversTemplateText)).To(Succeed())
+	g.Expect(ValidateTemplateOverride("location", locationTemplateText)).To(Succeed())
+	g.Expect(ValidateTemplateOverride("upstream", upstreamsTemplateText)).To(Succeed())
+
+	locationInLocation := `{{ template "location" . }}`
+	g.Expect(ValidateTemplateOverride("location", locationInLocation)).To(MatchError(ContainSubstring("not allowed")))
+}
+
+func TestExecuteServers_TemplateOverrides(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match:        dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	serverOverride := `{{ range $s := .Servers }}
+server {
+    listen {{ $s.Listen }};
+    server_name {{ $s.ServerName }};
+    {{- range $l := $s.Locations }}
+    {{ template "location" $l }}
+    {{- end }}
+}
+{{ end }}`
+	locationOverride := `location {{ .Path }} { return 204; }`
+
+	tests := []struct {
+		expStrings    []string
+		notExpStrings []string
+		overrides     dataplane.TemplateOverrides
+		msg           string
+	}{
+		{
+			msg: "no overrides",
+			expStrings: []string{
+				"location / {",
+				"listen unix:/var/run/nginx/nginx-503-server.sock;",
+			},
+			notExpStrings: []string{
+				"return 204;",
+			},
+		},
+		{
+			msg: "location override",
+			overrides: dataplane.TemplateOverrides{
+				Location: locationOverride,
+			},
+			expStrings: []string{
+				"location / { return 204; }",
+				"listen unix:/var/run/nginx/nginx-503-server.sock;",
+			},
+		},
+		{
+			msg: "server and location override",
+			overrides: dataplane.TemplateOverrides{
+				Server:   serverOverride,
+				Location: locationOverride,
+			},
+			expStrings: []string{
+				"server_name example.com;",
+				"location / { return 204; }",
+				"listen unix:/var/run/nginx/nginx-503-server.sock;",
+			},
+		},
+		{
+			msg: "invalid server override falls back to the default server template",
+			overrides: dataplane.TemplateOverrides{
+				Server:   `{{ define "other" }}{{ end }}`,
+				Location: locationOverride,
+			},
+			expStrings: []string{
+				"location / { return 204; }",
+				"listen unix:/var/run/nginx/nginx-503-server.sock;",
+			},
+		},
+		{
+			msg: "server override that fails to render falls back to the default templates",
+			overrides: dataplane.TemplateOverrides{
+				Server:   `{{ .DoesNotExist }}`,
+				Location: locationOverride,
+			},
+			expStrings: []string{
+				"location / {",
+				"listen unix:/var/run/nginx/nginx-503-server.sock;",
+			},
+			notExpStrings: []string{
+				"return 204;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			testConf := conf
+			testConf.TemplateOverrides = test.overrides
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(
+				testConf,
+				&policiesfakes.FakeGenerator{},
+				alwaysFalseKeepAliveChecker,
+				alwaysEmptySessionCookieGetter,
+			)
+
+			serverConf := getServersConf(results)
+
+			for _, expString := range test.expStrings {
+				g.Expect(serverConf).To(ContainSubstring(expString))
+			}
+
+			for _, notExpString := range test.notExpStrings {
+				g.Expect(serverConf).ToNot(ContainSubstring(notExpString))
+			}
+		})
+	}
+}
+
+func TestExecuteUpstreams_TemplateOverride(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []http.Upstream{
+		{
+			Name: "up1",
+			Servers: []http.UpstreamServer{
+				{Address: "10.0.0.1:80"},
+			},
+		},
+	}
+
+	tests := []struct {
+		msg       string
+		override  string
+		expString string
+	}{
+		{
+			msg:       "no override",
+			expString: "random two least_conn;",
+		},
+		{
+			msg: "valid override",
+			override: `{{ range $u := . }}upstream {{ $u.Name }} {
+    {{- range $server := $u.Servers }} server {{ $server.Address }}; {{ end -}}
+}{{ end }}`,
+			expString: "upstream up1 { server 10.0.0.1:80; }",
+		},
+		{
+			msg:       "invalid override falls back to the default template",
+			override:  `{{ range 10 }}{{ end }}`,
+			expString: "random two least_conn;",
+		},
+		{
+			msg:       "override that fails to render falls back to the default template",
+			override:  `{{ range $u := . }}{{ $u.DoesNotExist }}{{ end }}`,
+			expString: "random two least_conn;",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			results := gen.executeUpstreams(upstreams, test.override)
+			g.Expect(results).To(HaveLen(1))
+			g.Expect(string(results[0].data)).To(ContainSubstring(test.expString))
+		})
+	}
+}
@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -24,9 +27,9 @@ const (
 	// invalidBackendRef is used as an upstream name for invalid backend references.
 	invalidBackendRef = "invalid-backend-ref"
 	// ossZoneSize is the upstream zone size for nginx open source.
-	ossZoneSize = "512k"
+	ossZoneSize = upstreamsettings.DefaultOSSZoneSize
 	// plusZoneSize is the upstream zone size for nginx plus.
-	plusZoneSize = "1m"
+	plusZoneSize = upstreamsettings.DefaultPlusZoneSize
 	// ossZoneSize is the upstream zone size for nginx open source.
 	ossZoneSizeStream = "512k"
 	// plusZoneSize is the upstream zone size for nginx plus.
@@ -35,6 +38,25 @@ const (
 	stateDir = "/var/lib/nginx/state"
 )
 
+// sessionCookieGetter takes an upstream name and returns the variable that holds the value of the Set-Cookie
+// response header for the session cookie of the upstream. It returns an empty string if the upstream doesn't
+// need the Set-Cookie header.
+type sessionCookieGetter func(upstreamName string) string
+
+func newSessionCookieGetter(upstreams []http.Upstream) sessionCookieGetter {
+	cookieVars := make(map[string]string)
+
+	for _, upstream := range upstreams {
+		if upstream.SessionPersistence != nil && upstream.SessionPersistence.CookieVariable != "" {
+			cookieVars[upstream.Name] = upstream.SessionPersistence.CookieVariable
+		}
+	}
+
+	return func(upstreamName string) string {
+		return cookieVars[upstreamName]
+	}
+}
+
 // keepAliveChecker takes an upstream name and returns if it has keep alive settings enabled.
 type keepAliveChecker func(upstreamName string) bool
 
@@ -54,19 +76,32 @@ func newKeepAliveChecker(upstreams []http.Upstream) keepAliveChecker {
 	}
 }
 
-func newExecuteUpstreamsFunc(upstreams []http.Upstream) executeFunc {
-	return func(_ dataplane.Configuration) []executeResult {
-		return executeUpstreams(upstreams)
+func (g GeneratorImpl) newExecuteUpstreamsFunc(upstreams []http.Upstream) executeFunc {
+	return func(conf dataplane.Configuration) []executeResult {
+		return g.executeUpstreams(upstreams, conf.TemplateOverrides.Upstream)
 	}
 }
 
-func executeUpstreams(upstreams []http.Upstream) []executeResult {
+func (g GeneratorImpl) executeUpstreams(upstreams []http.Upstream, upstreamOverride string) []executeResult {
 	result := executeResult{
 		dest: httpConfigFile,
-		data: helpers.MustExecuteTemplate(upstreamsTemplate, upstreams),
+		data: g.executeTemplateWithOverride(
+			g.parseUpstreamsTemplateOverride(upstreamOverride),
+			upstreamsTemplate,
+			upstreams,
+		),
 	}
 
-	return []executeResult{result}
+	var includes []shared.Include
+	for _, u := range upstreams {
+		includes = append(includes, u.Includes...)
+	}
+
+	results := make([]executeResult, 0, len(includes)+1)
+	results = append(results, result)
+	results = append(results, createIncludeExecuteResults(deduplicateIncludes(includes))...)
+
+	return results
 }
 
 func (g GeneratorImpl) executeStreamUpstreams(conf dataplane.Configuration) []executeResult {
@@ -97,6 +132,9 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 	zoneSize := ossZoneSizeStream
 	if g.plus {
 		zoneSize = plusZoneSizeStream
+	}
+
+	if g.useStateFiles() {
 		stateFile = fmt.Sprintf("%s/%s.conf", stateDir, up.Name)
 	}
 
@@ -119,6 +157,12 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 	}
 }
 
+// useStateFiles returns whether the servers of the upstreams are loaded from the state files, which the NGINX Plus API
+// writes.
+func (g GeneratorImpl) useStateFiles() bool {
+	return g.plus && !g.upstreamServersInConfig
+}
+
 func (g GeneratorImpl) createUpstreams(
 	upstreams []dataplane.Upstream,
 	processor upstreamsettings.Processor,
@@ -145,6 +189,9 @@ func (g GeneratorImpl) createUpstream(
 	zoneSize := ossZoneSize
 	if g.plus {
 		zoneSize = plusZoneSize
+	}
+
+	if g.useStateFiles() {
 		stateFile = fmt.Sprintf("%s/%s.conf", stateDir, up.Name)
 	}
 
@@ -152,6 +199,17 @@ func (g GeneratorImpl) createUpstream(
 		zoneSize = upstreamPolicySettings.ZoneSize
 	}
 
+	includes := createIncludesFromSnippets(up.Snippets)
+	sessionPersistence := g.createUpstreamSessionPersistence(up)
+
+	// The server of an ExternalName Service is resolved by NGINX, so it can't be loaded from the state file,
+	// which is written by the NGINX Plus API.
+	var resolverAddresses string
+	if up.ExternalName != "" {
+		stateFile = ""
+		resolverAddresses = g.getUpstreamResolver()
+	}
+
 	if len(up.Endpoints) == 0 {
 		return http.Upstream{
 			Name:      up.Name,
@@ -162,6 +220,8 @@ func (g GeneratorImpl) createUpstream(
 					Address: nginx503Server,
 				},
 			},
+			Includes:           includes,
+			SessionPersistence: sessionPersistence,
 		}
 	}
 
@@ -171,18 +231,65 @@ func (g GeneratorImpl) createUpstream(
 		if ep.IPv6 {
 			format = "[%s]:%d"
 		}
+		// the servers of a draining upstream are marked as down, so that they don't receive new requests.
+		// NGINX Plus drains them via the API instead, because the servers are loaded from the state file.
 		upstreamServers[idx] = http.UpstreamServer{
 			Address: fmt.Sprintf(format, ep.Address, ep.Port),
+			Down:    up.Draining && (!g.useStateFiles() || up.ExternalName != ""),
+			Resolve: up.ExternalName != "",
 		}
 	}
 
 	return http.Upstream{
-		Name:      up.Name,
-		ZoneSize:  zoneSize,
-		StateFile: stateFile,
-		Servers:   upstreamServers,
-		KeepAlive: upstreamPolicySettings.KeepAlive,
+		Name:               up.Name,
+		ZoneSize:           zoneSize,
+		StateFile:          stateFile,
+		Resolver:           resolverAddresses,
+		Servers:            upstreamServers,
+		KeepAlive:          upstreamPolicySettings.KeepAlive,
+		Includes:           includes,
+		SessionPersistence: sessionPersistence,
+	}
+}
+
+// getUpstreamResolver returns the parameters of the resolver directive of the upstreams with the servers
+// that NGINX resolves.
+func (g GeneratorImpl) getUpstreamResolver() string {
+	if g.resolver == nil {
+		return strings.Join(g.externalNameResolvers, " ")
+	}
+
+	params := slices.Clone(g.resolver.Addresses)
+
+	if g.resolver.Valid != "" {
+		params = append(params, "valid="+g.resolver.Valid)
 	}
+
+	if g.resolver.DisableIPv6 {
+		params = append(params, "ipv6=off")
+	}
+
+	return strings.Join(params, " ")
+}
+
+func (g GeneratorImpl) createUpstreamSessionPersistence(up dataplane.Upstream) *http.UpstreamSessionPersistence {
+	if up.SessionPersistence == nil {
+		return nil
+	}
+
+	sp := &http.UpstreamSessionPersistence{
+		Name:   up.SessionPersistence.Name,
+		Expiry: up.SessionPersistence.Expiry,
+	}
+
+	// NGINX OSS doesn't support the sticky directive, so we hash the session key instead.
+	// See createSessionPersistenceMaps for how the session key and cookie variables are set.
+	if !g.plus {
+		sp.HashKey = "$" + generateSessionKeyVariableName(up.Name)
+		sp.CookieVariable = "$" + generateSessionCookieVariableName(up.Name)
+	}
+
+	return sp
 }
 
 func createInvalidBackendRefUpstream() http.Upstream {
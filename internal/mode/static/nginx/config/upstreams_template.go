@@ -7,21 +7,39 @@ package config
 // https://github.com/nginx/nginx-gateway-fabric/issues/483
 //
 // if the keepalive directive is present, it is necessary to activate the load balancing method before the directive.
+// The includes of the upstream snippets replace the default load balancing method, and are placed before
+// the keepalive directives for the same reason, so that the snippets can set the load balancing method.
+// The session persistence of the upstreams with such snippets is dropped when the graph is built, so the hash
+// directive is never generated alongside a load balancing method of a snippet.
 const upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
+    {{- if and $u.SessionPersistence $u.SessionPersistence.HashKey }}
+    hash {{ $u.SessionPersistence.HashKey }} consistent;
+    {{- else if not $u.Includes }}
     random two least_conn;
+    {{- end }}
+    {{- range $i := $u.Includes }}
+    include {{ $i.Name }};
+    {{- end }}
     {{ if $u.ZoneSize -}}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ end -}}
+    {{ if $u.Resolver -}}
+    resolver {{ $u.Resolver }};
+    {{ end -}}
 
     {{- if $u.StateFile }}
     state {{ $u.StateFile }};
     {{- else }}
         {{ range $server := $u.Servers }}
-    server {{ $server.Address }};
+    server {{ $server.Address }}{{ if $server.Resolve }} resolve{{ end }}{{ if $server.Down }} down{{ end }};
         {{- end }}
     {{- end }}
+    {{- if and $u.SessionPersistence (not $u.SessionPersistence.HashKey) }}
+    sticky cookie {{ $u.SessionPersistence.Name }}
+        {{- if $u.SessionPersistence.Expiry }} expires={{ $u.SessionPersistence.Expiry }}s{{ end }} path=/;
+    {{- end }}
     {{ if $u.KeepAlive.Connections -}}
     keepalive {{ $u.KeepAlive.Connections }};
     {{- end }}
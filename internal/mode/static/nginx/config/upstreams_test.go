@@ -18,7 +18,7 @@ import (
 
 func TestExecuteUpstreams(t *testing.T) {
 	t.Parallel()
-	gen := GeneratorImpl{}
+	gen := GeneratorImpl{externalNameResolvers: []string{"10.96.0.10", "[fd00::a]"}}
 	stateUpstreams := []dataplane.Upstream{
 		{
 			Name: "up1",
@@ -78,6 +78,53 @@ func TestExecuteUpstreams(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "up6-snippets",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "13.0.0.0",
+					Port:    80,
+				},
+			},
+			Snippets: []dataplane.Snippet{
+				{
+					Name:     "SnippetsFilter_http.upstream_test_hash",
+					Contents: "hash $request_uri consistent;",
+				},
+			},
+		},
+		{
+			Name: "up7-session",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "14.0.0.0",
+					Port:    80,
+				},
+			},
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name: "session",
+			},
+		},
+		{
+			Name: "up8-draining",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "15.0.0.0",
+					Port:    80,
+				},
+			},
+			Draining: true,
+		},
+		{
+			Name: "up9-external-name",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "api.example.com",
+					Port:    443,
+				},
+			},
+			ExternalName: "api.example.com",
+		},
 	}
 
 	expectedSubStrings := []string{
@@ -99,13 +146,28 @@ func TestExecuteUpstreams(t *testing.T) {
 		"keepalive_time 5s;",
 		"keepalive_timeout 10s;",
 		"zone up5-usp 2m;",
+
+		"upstream up6-snippets {\n    include /etc/nginx/includes/SnippetsFilter_http.upstream_test_hash.conf;",
+		"server 13.0.0.0:80;",
+
+		"upstream up7-session {\n    hash $ngf_session_key_up7_session consistent;",
+		"server 14.0.0.0:80;",
+
+		"upstream up8-draining",
+		"server 15.0.0.0:80 down;",
+
+		"zone up9-external-name 512k;\n    resolver 10.96.0.10 [fd00::a];",
+		"server api.example.com:443 resolve;",
 	}
 
 	upstreams := gen.createUpstreams(stateUpstreams, upstreamsettings.NewProcessor())
 
-	upstreamResults := executeUpstreams(upstreams)
+	upstreamResults := gen.executeUpstreams(upstreams, "")
 	g := NewWithT(t)
-	g.Expect(upstreamResults).To(HaveLen(1))
+	g.Expect(upstreamResults).To(HaveLen(2))
+	g.Expect(upstreamResults[1].dest).To(Equal("/etc/nginx/includes/SnippetsFilter_http.upstream_test_hash.conf"))
+	g.Expect(string(upstreamResults[1].data)).To(Equal("hash $request_uri consistent;"))
+
 	nginxUpstreams := string(upstreamResults[0].data)
 
 	g.Expect(upstreamResults[0].dest).To(Equal(httpConfigFile))
@@ -538,9 +600,51 @@ func TestCreateUpstream(t *testing.T) {
 	}
 }
 
+func TestGetUpstreamResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		resolver    *dataplane.Resolver
+		msg         string
+		expResolver string
+	}{
+		{
+			msg:         "nameservers of the container",
+			expResolver: "10.96.0.10 [fd00::a]",
+		},
+		{
+			msg: "resolver of the NginxProxy",
+			resolver: &dataplane.Resolver{
+				Addresses: []string{"10.0.0.53", "[fd00::53]:5353"},
+			},
+			expResolver: "10.0.0.53 [fd00::53]:5353",
+		},
+		{
+			msg: "resolver of the NginxProxy with valid and without IPv6",
+			resolver: &dataplane.Resolver{
+				Addresses:   []string{"10.0.0.53"},
+				Valid:       "30s",
+				DisableIPv6: true,
+			},
+			expResolver: "10.0.0.53 valid=30s ipv6=off",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{externalNameResolvers: []string{"10.96.0.10", "[fd00::a]"}, resolver: test.resolver}
+
+			g.Expect(gen.getUpstreamResolver()).To(Equal(test.expResolver))
+		})
+	}
+}
+
 func TestCreateUpstreamPlus(t *testing.T) {
 	t.Parallel()
-	gen := GeneratorImpl{plus: true}
+	gen := GeneratorImpl{plus: true, externalNameResolvers: []string{"10.96.0.10"}}
 
 	tests := []struct {
 		msg              string
@@ -569,6 +673,55 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "draining",
+			stateUpstream: dataplane.Upstream{
+				Name: "draining",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				Draining: true,
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "draining",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/draining.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80", // NGINX Plus drains the servers via the API
+					},
+				},
+			},
+		},
+		{
+			msg: "external name",
+			stateUpstream: dataplane.Upstream{
+				Name: "external-name",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "api.example.com",
+						Port:    443,
+					},
+				},
+				ExternalName: "api.example.com",
+				Draining:     true,
+			},
+			expectedUpstream: http.Upstream{
+				Name:     "external-name",
+				ZoneSize: plusZoneSize,
+				Resolver: "10.96.0.10",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "api.example.com:443",
+						Resolve: true,
+						Down:    true, // the servers of ExternalName Services are not managed via the API
+					},
+				},
+			},
+		},
 		{
 			msg: "no endpoints",
 			stateUpstream: dataplane.Upstream{
@@ -586,6 +739,36 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "session persistence",
+			stateUpstream: dataplane.Upstream{
+				Name: "session-persistence",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name:   "session",
+					Expiry: 3600,
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "session-persistence",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/session-persistence.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+				},
+				SessionPersistence: &http.UpstreamSessionPersistence{
+					Name:   "session",
+					Expiry: 3600,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -598,6 +781,138 @@ func TestCreateUpstreamPlus(t *testing.T) {
 	}
 }
 
+func TestCreateUpstreamPlus_UpstreamServersInConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{plus: true, upstreamServersInConfig: true}
+
+	up := dataplane.Upstream{
+		Name: "draining",
+		Endpoints: []resolver.Endpoint{
+			{
+				Address: "10.0.0.1",
+				Port:    80,
+			},
+		},
+		Draining: true,
+	}
+
+	g.Expect(gen.createUpstream(up, upstreamsettings.NewProcessor())).To(Equal(http.Upstream{
+		Name:     "draining",
+		ZoneSize: plusZoneSize,
+		Servers: []http.UpstreamServer{
+			{
+				Address: "10.0.0.1:80",
+				Down:    true,
+			},
+		},
+	}))
+
+	g.Expect(gen.createStreamUpstream(up)).To(Equal(stream.Upstream{
+		Name:     "draining",
+		ZoneSize: plusZoneSizeStream,
+		Servers: []stream.UpstreamServer{
+			{
+				Address: "10.0.0.1:80",
+			},
+		},
+	}))
+}
+
+func TestExecuteUpstreams_SessionPersistence(t *testing.T) {
+	t.Parallel()
+
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "test_session_80",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "10.0.0.1",
+					Port:    80,
+				},
+			},
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name:   "session",
+				Expiry: 3600,
+			},
+		},
+	}
+
+	tests := []struct {
+		msg                  string
+		expSubStrings        []string
+		notExpectedSubString string
+		plus                 bool
+	}{
+		{
+			msg: "NGINX OSS",
+			expSubStrings: []string{
+				"hash $ngf_session_key_test_session_80 consistent;",
+			},
+			notExpectedSubString: "sticky",
+		},
+		{
+			msg: "NGINX Plus",
+			expSubStrings: []string{
+				"random two least_conn;",
+				"sticky cookie session expires=3600s path=/;",
+			},
+			notExpectedSubString: "hash",
+			plus:                 true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{plus: test.plus}
+			upstreams := gen.createUpstreams(stateUpstreams, upstreamsettings.NewProcessor())
+
+			results := gen.executeUpstreams(upstreams, "")
+			g.Expect(results).To(HaveLen(1))
+
+			nginxUpstreams := string(results[0].data)
+			for _, expSubString := range test.expSubStrings {
+				g.Expect(nginxUpstreams).To(ContainSubstring(expSubString))
+			}
+			g.Expect(nginxUpstreams).ToNot(ContainSubstring(test.notExpectedSubString))
+		})
+	}
+}
+
+func TestSessionCookieGetter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	getSessionCookie := newSessionCookieGetter([]http.Upstream{
+		{
+			Name: "oss",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name:           "session",
+				HashKey:        "$ngf_session_key_oss",
+				CookieVariable: "$ngf_session_cookie_oss",
+			},
+		},
+		{
+			Name: "plus",
+			SessionPersistence: &http.UpstreamSessionPersistence{
+				Name: "session",
+			},
+		},
+		{
+			Name: "no-session-persistence",
+		},
+	})
+
+	g.Expect(getSessionCookie("oss")).To(Equal("$ngf_session_cookie_oss"))
+	g.Expect(getSessionCookie("plus")).To(BeEmpty())
+	g.Expect(getSessionCookie("no-session-persistence")).To(BeEmpty())
+	g.Expect(getSessionCookie("unknown")).To(BeEmpty())
+}
+
 func TestExecuteStreamUpstreams(t *testing.T) {
 	t.Parallel()
 	gen := GeneratorImpl{}
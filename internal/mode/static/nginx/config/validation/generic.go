@@ -5,6 +5,8 @@ import (
 	"regexp"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
 )
 
 // GenericValidator validates values for generic cases in the nginx conf.
@@ -106,3 +108,30 @@ func (GenericValidator) ValidateEndpoint(endpoint string) error {
 
 	return nil
 }
+
+const (
+	sslCiphersStringFmt    = `[a-zA-Z0-9!+@=_.-]+(:[a-zA-Z0-9!+@=_.-]+)*`
+	sslCiphersStringErrMsg = "must be a list of OpenSSL ciphers or cipher strings separated by ':'"
+)
+
+var sslCiphersStringFmtRegexp = regexp.MustCompile("^" + sslCiphersStringFmt + "$")
+
+// ValidateSSLCiphers validates a list of ciphers in the format of the OpenSSL library.
+func (GenericValidator) ValidateSSLCiphers(ciphers string) error {
+	if !sslCiphersStringFmtRegexp.MatchString(ciphers) {
+		examples := []string{
+			"HIGH:!aNULL:!MD5",
+			"ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384",
+		}
+
+		return errors.New(k8svalidation.RegexError(sslCiphersStringErrMsg, sslCiphersStringFmt, examples...))
+	}
+
+	return nil
+}
+
+// ValidateTemplateOverride validates a template override of the generated NGINX config. The name is the key of
+// the template override in the template overrides ConfigMap.
+func (GenericValidator) ValidateTemplateOverride(name, text string) error {
+	return config.ValidateTemplateOverride(name, text)
+}
@@ -112,3 +112,47 @@ func TestValidateEndpoint(t *testing.T) {
 		`my$endpoint`,
 	)
 }
+
+func TestValidateSSLCiphers(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateSSLCiphers,
+		`HIGH:!aNULL:!MD5`,
+		`ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384`,
+		`DEFAULT:@STRENGTH`,
+		`EECDH+AESGCM`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateSSLCiphers,
+		`HIGH;`,
+		`HIGH !aNULL`,
+		`$ciphers`,
+		`HIGH::MD5`,
+	)
+}
+
+func TestValidateTemplateOverride(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		func(text string) error { return validator.ValidateTemplateOverride("upstream", text) },
+		`{{ range . }}upstream {{ .Name }} {}{{ end }}`,
+		`{{ if eq (len .) 0 }}{{ end }}`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		func(text string) error { return validator.ValidateTemplateOverride("upstream", text) },
+		`{{ .Name `,
+		`{{ call .Func }}`,
+		`{{ range 1000 }}a{{ end }}`,
+		`{{ template "location" . }}`,
+	)
+}
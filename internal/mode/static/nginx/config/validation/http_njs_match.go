@@ -3,7 +3,9 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"unicode"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
@@ -29,6 +31,25 @@ func (HTTPNJSMatchValidator) ValidatePathInMatch(path string) error {
 	return nil
 }
 
+// ValidatePathRegexInMatch validates a regular expression path used in the location directive.
+// The expression must be compatible with RE2, which NGINX PCRE also supports, so that the behavior of
+// the expression doesn't depend on the data-plane implementation.
+func (HTTPNJSMatchValidator) ValidatePathRegexInMatch(path string) error {
+	if path == "" {
+		return errors.New("cannot be empty")
+	}
+
+	if strings.IndexFunc(path, unicode.IsControl) != -1 {
+		return errors.New("cannot contain control characters")
+	}
+
+	if _, err := regexp.Compile(path); err != nil {
+		return fmt.Errorf("must be a valid RE2 regular expression: %w", err)
+	}
+
+	return nil
+}
+
 func (HTTPNJSMatchValidator) ValidateHeaderNameInMatch(name string) error {
 	if err := k8svalidation.IsHTTPHeaderName(name); err != nil {
 		return errors.New(err[0])
@@ -58,6 +79,29 @@ func (HTTPNJSMatchValidator) ValidateQueryParamValueInMatch(value string) error
 	return validateCommonNJSMatchPart(value)
 }
 
+// inlineFlagsRegexp matches the groups with inline flags, like (?i), and the Perl named groups, like (?P<name>),
+// which RE2 supports, but JavaScript doesn't.
+var inlineFlagsRegexp = regexp.MustCompile(`(^|[^\\])\(\?[^:<]`)
+
+// ValidateQueryParamRegexInMatch validates a regular expression used to match the value of a query parameter.
+// The expression is evaluated by JavaScript in NJS. To keep the behavior of the expression consistent with
+// the RegularExpression path matches, the expression must be compatible with both RE2 and JavaScript.
+func (HTTPNJSMatchValidator) ValidateQueryParamRegexInMatch(value string) error {
+	if err := validateCommonNJSMatchPart(value); err != nil {
+		return err
+	}
+
+	if _, err := regexp.Compile(value); err != nil {
+		return fmt.Errorf("must be a valid RE2 regular expression: %w", err)
+	}
+
+	if inlineFlagsRegexp.MatchString(value) {
+		return errors.New("cannot contain inline flags or Perl named groups, which JavaScript doesn't support")
+	}
+
+	return nil
+}
+
 // validateCommonNJSMatchPart validates a string value used in NJS-based matching.
 func validateCommonNJSMatchPart(value string) error {
 	// empty values do not make sense, so we don't allow them.
@@ -28,6 +28,53 @@ func TestValidatePathInMatch(t *testing.T) {
 	)
 }
 
+func TestValidatePathRegexInMatch(t *testing.T) {
+	t.Parallel()
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/",
+		"/coffee/[0-9]+",
+		`/tea/\d{2,3}/(green|black)`,
+		`.*\.php`,
+		`/path with "quotes" and spaces;`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"",
+		"/coffee/[0-9",
+		"/coffee(?=tea)",
+		"/coffee\n",
+	)
+}
+
+func TestValidateQueryParamRegexInMatch(t *testing.T) {
+	t.Parallel()
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateQueryParamRegexInMatch,
+		"v[0-9]+",
+		"^(alpha|beta)",
+		`(?:v1|v2)\.\d+`,
+		`\(?literal`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateQueryParamRegexInMatch,
+		"",
+		"v[0-9",
+		"(?i)version",
+		"(?P<version>v[0-9]+)",
+		"v(?=1)",
+		"$version",
+	)
+}
+
 func TestValidateHeaderNameInMatch(t *testing.T) {
 	t.Parallel()
 	validator := HTTPNJSMatchValidator{}
@@ -10,6 +10,24 @@ func convertStringToSafeVariableName(s string) string {
 	return strings.ReplaceAll(s, "-", "_")
 }
 
+// generateSessionKeyVariableName generates the name of the variable that holds the session key of an upstream
+// with session persistence.
+func generateSessionKeyVariableName(upstreamName string) string {
+	return "ngf_session_key_" + convertStringToSafeVariableName(upstreamName)
+}
+
+// generateSessionCookieVariableName generates the name of the variable that holds the value of the Set-Cookie
+// response header for the session cookie of an upstream with session persistence.
+func generateSessionCookieVariableName(upstreamName string) string {
+	return "ngf_session_cookie_" + convertStringToSafeVariableName(upstreamName)
+}
+
+// generateHTTPMatchVariableName generates the name of the variable that holds the path of the internal location
+// of the first match of a path rule that the request satisfies, when the matches are evaluated with maps.
+func generateHTTPMatchVariableName(httpMatchKey string) string {
+	return "ngf_match_" + strings.ToLower(httpMatchKey)
+}
+
 // generateAddHeaderMapVariableName Generate the variable name for a proxy add header map.
 // We have increased the proxy_headers_hash_bucket_size and variables_hash_bucket_size to 512; and
 // proxy_headers_hash_max_size and variables_hash_max_size to 1024 to support the longest header name as allowed
@@ -0,0 +1,83 @@
+package errorlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// SocketPath is the path of the Unix datagram socket that NGINX sends the error log to.
+	SocketPath = "/var/run/nginx/nginx-error-log.sock"
+
+	// maxMessageSize is the maximum size of a syslog message of NGINX, which truncates the messages
+	// of the error log to 2048 bytes and adds the syslog header.
+	maxMessageSize = 4096
+)
+
+// Listener receives the error log that NGINX sends to a Unix datagram socket in the syslog format,
+// and passes the errors of the known kinds to a handler.
+// Implements the controller-runtime manager.Runnable interface.
+type Listener struct {
+	handle     func(Entry)
+	logger     logr.Logger
+	socketPath string
+}
+
+// NewListener creates a new Listener. The handler is called from a single goroutine.
+func NewListener(socketPath string, handle func(Entry), logger logr.Logger) *Listener {
+	return &Listener{
+		handle:     handle,
+		logger:     logger,
+		socketPath: socketPath,
+	}
+}
+
+// Start listens on the socket until the context is canceled.
+func (l *Listener) Start(ctx context.Context) error {
+	// the socket of the previous run of the controller is left behind if the controller was killed
+	if err := os.Remove(l.socketPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove the stale error log socket: %w", err)
+	}
+
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: l.socketPath, Net: "unixgram"})
+	if err != nil {
+		return fmt.Errorf("failed to listen on the error log socket: %w", err)
+	}
+	defer os.Remove(l.socketPath)
+
+	// NGINX runs as a different user in the same group as the controller
+	if err := os.Chmod(l.socketPath, 0o660); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set the permissions of the error log socket: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	l.logger.Info("Listening for the NGINX error log", "socket", l.socketPath)
+
+	buf := make([]byte, maxMessageSize)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to read the error log: %w", err)
+		}
+
+		if entry, ok := Parse(string(buf[:n])); ok {
+			l.handle(entry)
+		}
+	}
+}
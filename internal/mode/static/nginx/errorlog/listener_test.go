@@ -0,0 +1,92 @@
+package errorlog
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+)
+
+func TestListener(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	socketPath := filepath.Join(t.TempDir(), "error-log.sock")
+
+	entries := make(chan Entry, 1)
+	listener := NewListener(socketPath, func(e Entry) { entries <- e }, logr.Discard())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- listener.Start(ctx)
+	}()
+
+	var conn net.Conn
+	g.Eventually(func() error {
+		var err error
+		conn, err = net.Dial("unixgram", socketPath)
+		return err
+	}).WithTimeout(5 * time.Second).WithPolling(10 * time.Millisecond).Should(Succeed())
+
+	info, err := os.Stat(socketPath)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o660)))
+
+	for _, msg := range []string{
+		"<166>Jan  2 03:04:05 nginx: 7#7: *3 client 10.0.0.1 closed keepalive connection",
+		"<163>Jan  2 03:04:05 nginx: 7#7: *5 upstream timed out (110: Connection timed out), client: 10.0.0.1",
+	} {
+		_, err = conn.Write([]byte(msg))
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(conn.Close()).To(Succeed())
+
+	// only the error of a known kind is passed to the handler
+	g.Eventually(entries).WithTimeout(5 * time.Second).Should(Receive(Equal(Entry{
+		Kind:    KindUpstreamTimedOut,
+		Level:   "error",
+		Message: "upstream timed out (110: Connection timed out)",
+		Client:  "10.0.0.1",
+	})))
+
+	cancel()
+
+	g.Eventually(errCh).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+	g.Expect(socketPath).ToNot(BeAnExistingFile())
+}
+
+func TestListener_StaleSocket(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	socketPath := filepath.Join(t.TempDir(), "error-log.sock")
+	g.Expect(os.WriteFile(socketPath, nil, 0o600)).To(Succeed())
+
+	listener := NewListener(socketPath, func(Entry) {}, logr.Discard())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- listener.Start(ctx)
+	}()
+
+	g.Eventually(func() error {
+		conn, err := net.Dial("unixgram", socketPath)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}).WithTimeout(5 * time.Second).WithPolling(10 * time.Millisecond).Should(Succeed())
+
+	cancel()
+
+	g.Eventually(errCh).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+}
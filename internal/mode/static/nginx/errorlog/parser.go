@@ -0,0 +1,150 @@
+package errorlog
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of an error in the NGINX error log.
+type Kind string
+
+const (
+	// KindUpstreamTimedOut is the kind of the errors of the upstreams that didn't respond in time.
+	KindUpstreamTimedOut Kind = "UpstreamTimedOut"
+	// KindSSLHandshakeFailed is the kind of the errors of the failed TLS handshakes with the clients
+	// and the upstreams.
+	KindSSLHandshakeFailed Kind = "SSLHandshakeFailed"
+	// KindLimitingRequests is the kind of the errors of the requests that were rejected or delayed
+	// by a request rate limit.
+	KindLimitingRequests Kind = "LimitingRequests"
+	// KindLimitingConnections is the kind of the errors of the connections that were rejected by a connection limit.
+	KindLimitingConnections Kind = "LimitingConnections"
+)
+
+// kindMatchers map the messages of the errors to their kinds. The first matching substring wins.
+var kindMatchers = []struct {
+	substring string
+	kind      Kind
+}{
+	{substring: "upstream timed out", kind: KindUpstreamTimedOut},
+	{substring: "SSL_do_handshake() failed", kind: KindSSLHandshakeFailed},
+	{substring: "limiting requests", kind: KindLimitingRequests},
+	{substring: "limiting connections", kind: KindLimitingConnections},
+}
+
+// syslogSeverityLevels are the NGINX error log levels of the syslog severities.
+var syslogSeverityLevels = []string{"emerg", "alert", "crit", "error", "warn", "notice", "info", "debug"}
+
+var (
+	// syslogHeaderRegexp matches the header of a syslog message that NGINX sends without the hostname,
+	// for example, "<163>Jan  2 03:04:05 nginx: ". The first group is the priority.
+	syslogHeaderRegexp = regexp.MustCompile(`^<(\d{1,3})>[A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2} \S+: `)
+	// errorLogPrefixRegexp matches the optional time, the optional level, the process and thread IDs and
+	// the optional connection number that prefix the messages of the error log,
+	// for example, "2025/01/02 03:04:05 [error] 7#7: *5 ". The first group is the level.
+	errorLogPrefixRegexp = regexp.MustCompile(
+		`^(?:\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2} )?(?:\[(\w+)\] )?\d+#\d+: (?:\*\d+ )?`,
+	)
+	// contextFieldRegexp matches a field of the context that NGINX appends to the messages,
+	// for example, `request: "GET / HTTP/1.1"`.
+	contextFieldRegexp = regexp.MustCompile(`(\w+): ("(?:[^"\\]|\\.)*"|[^,]*)`)
+	// zoneRegexp matches the zone of a limit in the messages of the limiting errors.
+	zoneRegexp = regexp.MustCompile(`by zone "([^"]+)"`)
+)
+
+// contextSeparator separates the message of an error from its context, which starts with the client.
+const contextSeparator = ", client: "
+
+// Entry is an error of the NGINX error log.
+type Entry struct {
+	// Kind is the kind of the error.
+	Kind Kind
+	// Level is the level of the error, for example, error.
+	Level string
+	// Message is the message of the error without its context.
+	Message string
+	// Client is the address of the client.
+	Client string
+	// Server is the name of the server or the address of the listening socket.
+	Server string
+	// Request is the request line.
+	Request string
+	// Upstream is the URL of the upstream server.
+	Upstream string
+	// Host is the Host header of the request.
+	Host string
+	// Zone is the zone of the limit of the limiting errors.
+	Zone string
+}
+
+// Parse parses a syslog message of the NGINX error log. It returns false if the message is not an error
+// of a known kind.
+func Parse(msg string) (Entry, bool) {
+	msg = strings.TrimRight(msg, "\n")
+
+	var entry Entry
+
+	if header := syslogHeaderRegexp.FindStringSubmatch(msg); header != nil {
+		msg = msg[len(header[0]):]
+
+		// the priority is the facility multiplied by 8 plus the severity
+		if pri, err := strconv.Atoi(header[1]); err == nil {
+			entry.Level = syslogSeverityLevels[pri%8]
+		}
+	}
+
+	if prefix := errorLogPrefixRegexp.FindStringSubmatch(msg); prefix != nil {
+		msg = msg[len(prefix[0]):]
+
+		if entry.Level == "" {
+			entry.Level = prefix[1]
+		}
+	}
+
+	for _, m := range kindMatchers {
+		if strings.Contains(msg, m.substring) {
+			entry.Kind = m.kind
+			break
+		}
+	}
+
+	if entry.Kind == "" {
+		return Entry{}, false
+	}
+
+	entry.Message = msg
+
+	if idx := strings.Index(msg, contextSeparator); idx != -1 {
+		entry.Message = msg[:idx]
+		setContextFields(&entry, msg[idx+len(", "):])
+	}
+
+	if zone := zoneRegexp.FindStringSubmatch(entry.Message); zone != nil {
+		entry.Zone = zone[1]
+	}
+
+	return entry, true
+}
+
+func setContextFields(entry *Entry, context string) {
+	for _, field := range contextFieldRegexp.FindAllStringSubmatch(context, -1) {
+		value := field[2]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		switch field[1] {
+		case "client":
+			entry.Client = value
+		case "server":
+			entry.Server = value
+		case "request":
+			entry.Request = value
+		case "upstream":
+			entry.Upstream = value
+		case "host":
+			entry.Host = value
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package errorlog
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		msg      string
+		expEntry Entry
+		expOK    bool
+	}{
+		{
+			name: "upstream timed out",
+			msg: "<163>Jan  2 03:04:05 nginx: 2025/01/02 03:04:05 [error] 7#7: *5 upstream timed out " +
+				"(110: Connection timed out) while reading response header from upstream, client: 10.0.0.1, " +
+				`server: cafe.example.com, request: "GET /coffee HTTP/1.1", ` +
+				`upstream: "http://10.244.0.5:8080/coffee", host: "cafe.example.com"`,
+			expEntry: Entry{
+				Kind:     KindUpstreamTimedOut,
+				Level:    "error",
+				Message:  "upstream timed out (110: Connection timed out) while reading response header from upstream",
+				Client:   "10.0.0.1",
+				Server:   "cafe.example.com",
+				Request:  "GET /coffee HTTP/1.1",
+				Upstream: "http://10.244.0.5:8080/coffee",
+				Host:     "cafe.example.com",
+			},
+			expOK: true,
+		},
+		{
+			name: "SSL handshake failed",
+			msg: "<166>Jan 12 13:14:15 nginx: 2025/01/12 13:14:15 [info] 7#7: *9 SSL_do_handshake() failed " +
+				"(SSL: error:0A00006C:SSL routines::bad key share) while SSL handshaking, client: 10.0.0.2, " +
+				"server: 0.0.0.0:443\n",
+			expEntry: Entry{
+				Kind:    KindSSLHandshakeFailed,
+				Level:   "info",
+				Message: "SSL_do_handshake() failed (SSL: error:0A00006C:SSL routines::bad key share) while SSL handshaking",
+				Client:  "10.0.0.2",
+				Server:  "0.0.0.0:443",
+			},
+			expOK: true,
+		},
+		{
+			name: "limiting requests",
+			msg: `<163>Jan  2 03:04:05 nginx: 7#7: *11 limiting requests, excess: 10.500 by zone "default_rl", ` +
+				`client: 10.0.0.3, server: cafe.example.com, request: "POST /tea HTTP/1.1", host: "cafe.example.com"`,
+			expEntry: Entry{
+				Kind:    KindLimitingRequests,
+				Level:   "error",
+				Message: `limiting requests, excess: 10.500 by zone "default_rl"`,
+				Client:  "10.0.0.3",
+				Server:  "cafe.example.com",
+				Request: "POST /tea HTTP/1.1",
+				Host:    "cafe.example.com",
+				Zone:    "default_rl",
+			},
+			expOK: true,
+		},
+		{
+			name: "limiting connections without syslog header",
+			msg: `2025/01/02 03:04:05 [error] 7#7: *12 limiting connections by zone "conn", client: 10.0.0.4, ` +
+				`server: cafe.example.com, request: "GET / HTTP/1.1", host: "cafe.example.com"`,
+			expEntry: Entry{
+				Kind:    KindLimitingConnections,
+				Level:   "error",
+				Message: `limiting connections by zone "conn"`,
+				Client:  "10.0.0.4",
+				Server:  "cafe.example.com",
+				Request: "GET / HTTP/1.1",
+				Host:    "cafe.example.com",
+				Zone:    "conn",
+			},
+			expOK: true,
+		},
+		{
+			name: "unknown error",
+			msg: "<166>Jan  2 03:04:05 nginx: 2025/01/02 03:04:05 [info] 7#7: *3 client 10.0.0.1 closed " +
+				"keepalive connection",
+		},
+		{
+			name: "empty message",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			entry, ok := Parse(test.msg)
+			g.Expect(ok).To(Equal(test.expOK))
+			g.Expect(entry).To(Equal(test.expEntry))
+		})
+	}
+}
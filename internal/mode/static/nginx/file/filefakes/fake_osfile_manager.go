@@ -48,6 +48,18 @@ type FakeOSFileManager struct {
 		result1 *os.File
 		result2 error
 	}
+	MkdirAllStub        func(string, os.FileMode) error
+	mkdirAllMutex       sync.RWMutex
+	mkdirAllArgsForCall []struct {
+		arg1 string
+		arg2 os.FileMode
+	}
+	mkdirAllReturns struct {
+		result1 error
+	}
+	mkdirAllReturnsOnCall map[int]struct {
+		result1 error
+	}
 	OpenStub        func(string) (*os.File, error)
 	openMutex       sync.RWMutex
 	openArgsForCall []struct {
@@ -289,6 +301,68 @@ func (fake *FakeOSFileManager) CreateReturnsOnCall(i int, result1 *os.File, resu
 	}{result1, result2}
 }
 
+func (fake *FakeOSFileManager) MkdirAll(arg1 string, arg2 os.FileMode) error {
+	fake.mkdirAllMutex.Lock()
+	ret, specificReturn := fake.mkdirAllReturnsOnCall[len(fake.mkdirAllArgsForCall)]
+	fake.mkdirAllArgsForCall = append(fake.mkdirAllArgsForCall, struct {
+		arg1 string
+		arg2 os.FileMode
+	}{arg1, arg2})
+	stub := fake.MkdirAllStub
+	fakeReturns := fake.mkdirAllReturns
+	fake.recordInvocation("MkdirAll", []interface{}{arg1, arg2})
+	fake.mkdirAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOSFileManager) MkdirAllCallCount() int {
+	fake.mkdirAllMutex.RLock()
+	defer fake.mkdirAllMutex.RUnlock()
+	return len(fake.mkdirAllArgsForCall)
+}
+
+func (fake *FakeOSFileManager) MkdirAllCalls(stub func(string, os.FileMode) error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = stub
+}
+
+func (fake *FakeOSFileManager) MkdirAllArgsForCall(i int) (string, os.FileMode) {
+	fake.mkdirAllMutex.RLock()
+	defer fake.mkdirAllMutex.RUnlock()
+	argsForCall := fake.mkdirAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOSFileManager) MkdirAllReturns(result1 error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = nil
+	fake.mkdirAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOSFileManager) MkdirAllReturnsOnCall(i int, result1 error) {
+	fake.mkdirAllMutex.Lock()
+	defer fake.mkdirAllMutex.Unlock()
+	fake.MkdirAllStub = nil
+	if fake.mkdirAllReturnsOnCall == nil {
+		fake.mkdirAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.mkdirAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeOSFileManager) Open(arg1 string) (*os.File, error) {
 	fake.openMutex.Lock()
 	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
@@ -554,6 +628,8 @@ func (fake *FakeOSFileManager) Invocations() map[string][][]interface{} {
 	defer fake.copyMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
+	fake.mkdirAllMutex.RLock()
+	defer fake.mkdirAllMutex.RUnlock()
 	fake.openMutex.RLock()
 	defer fake.openMutex.RUnlock()
 	fake.readDirMutex.RLock()
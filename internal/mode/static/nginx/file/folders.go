@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 )
 
 //counterfeiter:generate io/fs.DirEntry
@@ -18,6 +19,8 @@ type ClearFoldersOSFileManager interface {
 	ReadDir(dirname string) ([]os.DirEntry, error)
 	// Remove removes the file with given name.
 	Remove(name string) error
+	// RemoveAll removes the directory with given path and its contents.
+	RemoveAll(path string) error
 }
 
 // These files are needed on startup, so skip deleting them.
@@ -29,7 +32,7 @@ const (
 
 var ignoreFilePaths = []string{mainConf, mgmtConf, deployCtx}
 
-// ClearFolders removes all files in the given folders and returns the removed files' full paths.
+// ClearFolders removes all files and directories in the given folders and returns the removed full paths.
 func ClearFolders(fileMgr ClearFoldersOSFileManager, paths []string) (removedFiles []string, e error) {
 	for _, path := range paths {
 		entries, err := fileMgr.ReadDir(path)
@@ -44,13 +47,106 @@ func ClearFolders(fileMgr ClearFoldersOSFileManager, paths []string) (removedFil
 				continue
 			}
 
-			if err := fileMgr.Remove(entryPath); err != nil {
+			remove := fileMgr.Remove
+			if entry.IsDir() {
+				remove = fileMgr.RemoveAll
+			}
+
+			if err := remove(entryPath); err != nil {
+				return removedFiles, fmt.Errorf("failed to remove %q: %w", entryPath, err)
+			}
+
+			removedFiles = append(removedFiles, entryPath)
+		}
+	}
+
+	return removedFiles, nil
+}
+
+// RemoveOrphanedFiles removes the files in the given folders and their subdirectories that are not among the given
+// files, and returns the removed full paths. Such files are left over, for example, when the control plane is
+// restarted in the middle of replacing the files. The subdirectories without any of the given files are removed
+// as a whole.
+func RemoveOrphanedFiles(fileMgr ClearFoldersOSFileManager, paths []string, files []File) ([]string, error) {
+	expected := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		expected[f.Path] = struct{}{}
+	}
+
+	var removedFiles []string
+
+	for _, path := range paths {
+		removed, err := removeOrphanedFilesInDir(fileMgr, path, expected)
+		removedFiles = append(removedFiles, removed...)
+
+		if err != nil {
+			return removedFiles, err
+		}
+	}
+
+	return removedFiles, nil
+}
+
+func removeOrphanedFilesInDir(
+	fileMgr ClearFoldersOSFileManager,
+	dir string,
+	expected map[string]struct{},
+) (removedFiles []string, e error) {
+	entries, err := fileMgr.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %q: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(dir, entry.Name())
+
+		if slices.Contains(ignoreFilePaths, entryPath) {
+			continue
+		}
+
+		if entry.IsDir() {
+			if containsExpectedFile(entryPath, expected) {
+				removed, err := removeOrphanedFilesInDir(fileMgr, entryPath, expected)
+				removedFiles = append(removedFiles, removed...)
+
+				if err != nil {
+					return removedFiles, err
+				}
+
+				continue
+			}
+
+			if err := fileMgr.RemoveAll(entryPath); err != nil {
 				return removedFiles, fmt.Errorf("failed to remove %q: %w", entryPath, err)
 			}
 
 			removedFiles = append(removedFiles, entryPath)
+
+			continue
 		}
+
+		if _, ok := expected[entryPath]; ok {
+			continue
+		}
+
+		if err := fileMgr.Remove(entryPath); err != nil {
+			return removedFiles, fmt.Errorf("failed to remove %q: %w", entryPath, err)
+		}
+
+		removedFiles = append(removedFiles, entryPath)
 	}
 
 	return removedFiles, nil
 }
+
+func containsExpectedFile(dir string, expected map[string]struct{}) bool {
+	prefix := dir + string(filepath.Separator)
+
+	for path := range expected {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -31,10 +31,15 @@ func TestClearFoldersRemoves(t *testing.T) {
 	path2 := filepath.Join(tempDir, "path2")
 	writeFile(t, path2, []byte("test"))
 
+	dir := filepath.Join(tempDir, "dir")
+	//nolint:gosec // the directory permission is ok for unit testing
+	g.Expect(os.Mkdir(dir, 0o755)).To(Succeed())
+	writeFile(t, filepath.Join(dir, "path3"), []byte("test"))
+
 	removedFiles, err := file.ClearFolders(file.NewStdLibOSFileManager(), []string{tempDir})
 
 	g.Expect(err).ToNot(HaveOccurred())
-	g.Expect(removedFiles).To(ConsistOf(path1, path2))
+	g.Expect(removedFiles).To(ConsistOf(path1, path2, dir))
 
 	entries, err := os.ReadDir(tempDir)
 	g.Expect(err).ToNot(HaveOccurred())
@@ -113,6 +118,26 @@ func TestClearFoldersFails(t *testing.T) {
 			},
 			name: "Remove fails",
 		},
+		{
+			fileMgr: &filefakes.FakeClearFoldersOSFileManager{
+				ReadDirStub: func(_ string) ([]os.DirEntry, error) {
+					return []os.DirEntry{
+						&filefakes.FakeDirEntry{
+							NameStub: func() string {
+								return "dir"
+							},
+							IsDirStub: func() bool {
+								return true
+							},
+						},
+					}, nil
+				},
+				RemoveAllStub: func(_ string) error {
+					return testErr
+				},
+			},
+			name: "RemoveAll fails",
+		},
 	}
 
 	for _, test := range tests {
@@ -127,3 +152,82 @@ func TestClearFoldersFails(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveOrphanedFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	tempDir := t.TempDir()
+
+	mkdir := func(name string) string {
+		dir := filepath.Join(tempDir, name)
+		//nolint:gosec // the directory permission is ok for unit testing
+		g.Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+		return dir
+	}
+
+	confDir := mkdir("conf.d")
+	staticDir := mkdir("includes/static")
+	orphanedDir := mkdir("includes/static-orphaned")
+
+	httpConf := filepath.Join(confDir, "http.conf")
+	writeFile(t, httpConf, []byte("server {}"))
+	orphanedConf := filepath.Join(confDir, "orphaned.conf")
+	writeFile(t, orphanedConf, []byte("server {}"))
+	indexFile := filepath.Join(staticDir, "index.html")
+	writeFile(t, indexFile, []byte("index"))
+	orphanedIndexFile := filepath.Join(staticDir, "orphaned.html")
+	writeFile(t, orphanedIndexFile, []byte("orphaned"))
+	writeFile(t, filepath.Join(orphanedDir, "index.html"), []byte("orphaned"))
+
+	files := []file.File{
+		{Path: httpConf, Type: file.TypeRegular},
+		{Path: indexFile, Type: file.TypeRegular},
+	}
+
+	removed, err := file.RemoveOrphanedFiles(
+		file.NewStdLibOSFileManager(),
+		[]string{confDir, filepath.Join(tempDir, "includes")},
+		files,
+	)
+
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(ConsistOf(orphanedConf, orphanedIndexFile, orphanedDir))
+
+	for _, path := range []string{httpConf, indexFile} {
+		_, err := os.Stat(path)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	for _, path := range removed {
+		_, err := os.Stat(path)
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+	}
+}
+
+func TestRemoveOrphanedFilesFails(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testErr := errors.New("test error")
+
+	fakeFileMgr := &filefakes.FakeClearFoldersOSFileManager{
+		ReadDirStub: func(_ string) ([]os.DirEntry, error) {
+			return []os.DirEntry{
+				&filefakes.FakeDirEntry{
+					NameStub: func() string {
+						return "orphaned.conf"
+					},
+				},
+			}, nil
+		},
+		RemoveStub: func(_ string) error {
+			return testErr
+		},
+	}
+
+	removed, err := file.RemoveOrphanedFiles(fakeFileMgr, []string{"/etc/nginx/conf.d"}, nil)
+
+	g.Expect(err).To(MatchError(testErr))
+	g.Expect(removed).To(BeEmpty())
+}
@@ -1,11 +1,14 @@
 package file
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/go-logr/logr"
 )
@@ -17,6 +20,8 @@ const (
 	regularFileMode = 0o644
 	// secretFileMode defines the default file mode for files with secrets.
 	secretFileMode = 0o640
+	// dirMode defines the default file mode for the directories created for files.
+	dirMode = 0o755
 )
 
 // Type is the type of File.
@@ -56,6 +61,8 @@ type OSFileManager interface {
 	ReadDir(dirname string) ([]fs.DirEntry, error)
 	// Remove file with given name.
 	Remove(name string) error
+	// MkdirAll creates the directory with the given path, along with any necessary parents.
+	MkdirAll(path string, perm os.FileMode) error
 	// Create file at the provided filepath.
 	Create(name string) (*os.File, error)
 	// Chmod sets the mode of the file.
@@ -72,16 +79,24 @@ type OSFileManager interface {
 
 // Manager manages NGINX configuration files.
 type Manager interface {
-	// ReplaceFiles replaces the files on the file system with the given files removing any previous files.
+	// ReplaceFiles replaces the files on the file system with the given files removing any previous files
+	// that are not among the given files.
 	ReplaceFiles(files []File) error
 }
 
+// writtenFile describes the written content of a file.
+type writtenFile struct {
+	checksum [sha256.Size]byte
+	fileType Type
+}
+
 // ManagerImpl is an implementation of Manager.
 // Note: It is not thread safe.
 type ManagerImpl struct {
+	osFileManager OSFileManager
+	// lastWrittenFiles holds the files written by the previous ReplaceFiles calls by their paths.
+	lastWrittenFiles map[string]writtenFile
 	logger           logr.Logger
-	osFileManager    OSFileManager
-	lastWrittenPaths []string
 }
 
 // NewManagerImpl creates a new NewManagerImpl.
@@ -92,37 +107,70 @@ func NewManagerImpl(logger logr.Logger, osFileManager OSFileManager) *ManagerImp
 	}
 }
 
-// ReplaceFiles replaces the files on the file system with the given files removing any previous files.
+// ReplaceFiles replaces the files on the file system with the given files removing any previous files
+// that are not among the given files. The files whose content and type haven't changed since they were
+// written are not written again, so that a change of the configuration only touches the affected files.
+// The directories of the files are created if they don't exist. The directories are not removed with
+// the files, because the folders are cleared on startup.
 // It panics if a file type is unknown.
 func (m *ManagerImpl) ReplaceFiles(files []File) error {
-	for _, path := range m.lastWrittenPaths {
+	paths := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		paths[file.Path] = struct{}{}
+	}
+
+	removedPaths := make([]string, 0, len(m.lastWrittenFiles))
+	for path := range m.lastWrittenFiles {
+		if _, exists := paths[path]; !exists {
+			removedPaths = append(removedPaths, path)
+		}
+	}
+	slices.Sort(removedPaths)
+
+	for _, path := range removedPaths {
 		if err := m.osFileManager.Remove(path); err != nil {
-			if os.IsNotExist(err) {
-				m.logger.Info(
-					"File not found when attempting to delete",
-					"path", path,
-					"error", err,
-				)
-				continue
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("failed to delete file %q: %w", path, err)
 			}
-			return fmt.Errorf("failed to delete file %q: %w", path, err)
+
+			m.logger.Info(
+				"File not found when attempting to delete",
+				"path", path,
+				"error", err,
+			)
+		} else {
+			m.logger.V(1).Info("Deleted file", "path", path)
 		}
 
-		m.logger.V(1).Info("Deleted file", "path", path)
+		delete(m.lastWrittenFiles, path)
 	}
 
 	// In some cases, NGINX reads files in runtime, like a JWK. If you remove such file, NGINX will fail
 	// any request (return 500 status code) that involves reading the file.
 	// However, we don't have such files yet, so we're not considering this case.
 
-	m.lastWrittenPaths = make([]string, 0, len(files))
+	if m.lastWrittenFiles == nil {
+		m.lastWrittenFiles = make(map[string]writtenFile, len(files))
+	}
 
 	for _, file := range files {
+		written := writtenFile{
+			checksum: sha256.Sum256(file.Content),
+			fileType: file.Type,
+		}
+
+		if last, exists := m.lastWrittenFiles[file.Path]; exists && last == written {
+			continue
+		}
+
+		// if the write fails, the content of the file is unknown, so the file must be written again next time
+		delete(m.lastWrittenFiles, file.Path)
+
 		if err := WriteFile(m.osFileManager, file); err != nil {
 			return fmt.Errorf("failed to write file %q of type %v: %w", file.Path, file.Type, err)
 		}
 
-		m.lastWrittenPaths = append(m.lastWrittenPaths, file.Path)
+		m.lastWrittenFiles[file.Path] = written
 		m.logger.V(1).Info("Wrote file", "path", file.Path)
 	}
 
@@ -132,6 +180,11 @@ func (m *ManagerImpl) ReplaceFiles(files []File) error {
 func WriteFile(fileMgr OSFileManager, file File) error {
 	ensureType(file.Type)
 
+	dir := filepath.Dir(file.Path)
+	if err := fileMgr.MkdirAll(dir, dirMode); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", dir, err)
+	}
+
 	f, err := fileMgr.Create(file.Path)
 	if err != nil {
 		return fmt.Errorf("failed to create file %q: %w", file.Path, err)
@@ -114,6 +114,26 @@ var _ = Describe("EventHandler", func() {
 
 			ensureNotExist(regular2, regular3, secret)
 		})
+
+		It("should write and remove files in nested directories", func() {
+			nested := file.File{
+				Type:    file.TypeRegular,
+				Path:    filepath.Join(tmpDir, "static", "content", "index.html"),
+				Content: []byte("nested"),
+			}
+
+			err := mgr.ReplaceFiles([]file.File{nested})
+			Expect(err).ToNot(HaveOccurred())
+
+			bytes, err := os.ReadFile(nested.Path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(nested.Content))
+
+			err = mgr.ReplaceFiles(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ensureNotExist(nested)
+		})
 	})
 
 	When("file does not exist", func() {
@@ -132,7 +152,67 @@ var _ = Describe("EventHandler", func() {
 			Expect(mgr.ReplaceFiles(files)).ToNot(HaveOccurred())
 
 			fakeOSMgr.RemoveReturns(os.ErrNotExist)
+			Expect(mgr.ReplaceFiles(nil)).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.RemoveCallCount()).To(Equal(1))
+		})
+	})
+
+	When("files are unchanged", func() {
+		It("should only write the changed files", func() {
+			fakeOSMgr := &filefakes.FakeOSFileManager{}
+			mgr := file.NewManagerImpl(logr.Discard(), fakeOSMgr)
+
+			regular := file.File{
+				Type:    file.TypeRegular,
+				Path:    "regular.conf",
+				Content: []byte("regular"),
+			}
+			secret := file.File{
+				Type:    file.TypeSecret,
+				Path:    "secret.conf",
+				Content: []byte("secret"),
+			}
+
+			Expect(mgr.ReplaceFiles([]file.File{regular, secret})).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.CreateCallCount()).To(Equal(2))
+
+			Expect(mgr.ReplaceFiles([]file.File{regular, secret})).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.CreateCallCount()).To(Equal(2))
+
+			secret.Content = []byte("changed")
+			Expect(mgr.ReplaceFiles([]file.File{regular, secret})).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.CreateCallCount()).To(Equal(3))
+			Expect(fakeOSMgr.CreateArgsForCall(2)).To(Equal("secret.conf"))
+
+			regular.Type = file.TypeSecret
+			Expect(mgr.ReplaceFiles([]file.File{regular, secret})).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.CreateCallCount()).To(Equal(4))
+			Expect(fakeOSMgr.CreateArgsForCall(3)).To(Equal("regular.conf"))
+
+			Expect(fakeOSMgr.RemoveCallCount()).To(BeZero())
+		})
+
+		It("should write a file again after it failed to be written", func() {
+			fakeOSMgr := &filefakes.FakeOSFileManager{}
+			mgr := file.NewManagerImpl(logr.Discard(), fakeOSMgr)
+
+			files := []file.File{
+				{
+					Type:    file.TypeRegular,
+					Path:    "regular.conf",
+					Content: []byte("regular"),
+				},
+			}
+
+			Expect(mgr.ReplaceFiles(files)).ToNot(HaveOccurred())
+
+			fakeOSMgr.WriteReturns(errors.New("test error"))
+			files[0].Content = []byte("changed")
+			Expect(mgr.ReplaceFiles(files)).To(HaveOccurred())
+
+			fakeOSMgr.WriteReturns(nil)
 			Expect(mgr.ReplaceFiles(files)).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.WriteCallCount()).To(Equal(3))
 		})
 	})
 
@@ -177,14 +257,19 @@ var _ = Describe("EventHandler", func() {
 			func(fakeOSMgr *filefakes.FakeOSFileManager) {
 				mgr := file.NewManagerImpl(logr.Discard(), fakeOSMgr)
 
+				newFiles := files
+
 				// special case for Remove
 				// to kick off removing, we need to successfully write files beforehand
+				// and then replace them with no files
 				if fakeOSMgr.RemoveStub != nil {
 					err := mgr.ReplaceFiles(files)
 					Expect(err).ToNot(HaveOccurred())
+
+					newFiles = nil
 				}
 
-				err := mgr.ReplaceFiles(files)
+				err := mgr.ReplaceFiles(newFiles)
 				Expect(err).Should(HaveOccurred())
 				Expect(err).To(MatchError(errTest))
 			},
@@ -196,6 +281,14 @@ var _ = Describe("EventHandler", func() {
 					},
 				},
 			),
+			Entry(
+				"MkdirAll",
+				&filefakes.FakeOSFileManager{
+					MkdirAllStub: func(_ string, _ os.FileMode) error {
+						return errTest
+					},
+				},
+			),
 			Entry(
 				"Create",
 				&filefakes.FakeOSFileManager{
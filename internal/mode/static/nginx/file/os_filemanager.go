@@ -26,6 +26,16 @@ func (s *StdLibOSFileManager) Remove(name string) error {
 	return os.Remove(name)
 }
 
+// RemoveAll wraps os.RemoveAll.
+func (s *StdLibOSFileManager) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+// MkdirAll wraps os.MkdirAll.
+func (s *StdLibOSFileManager) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
 // Write wraps os.File.Write.
 func (s *StdLibOSFileManager) Write(file *os.File, contents []byte) error {
 	_, err := file.Write(contents)
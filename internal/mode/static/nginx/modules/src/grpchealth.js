@@ -0,0 +1,100 @@
+const SERVICE_KEY = 'grpc_health_service';
+const CHECK_LOCATION_PREFIX = '/_ngf-internal-grpc-health-check';
+const HTTP_CODES = {
+	ok: 200,
+	serviceUnavailable: 503,
+};
+
+// The length-prefixed gRPC message starts with a 1-byte compressed flag followed by the 4-byte length of the message.
+const GRPC_MESSAGE_HEADER_LENGTH = 5;
+// The tag of the field 1 (service) with the wire type 2 (length-delimited) of grpc.health.v1.HealthCheckRequest.
+const SERVICE_FIELD_TAG = 0x0a;
+// The tag of the field 1 (status) with the wire type 0 (varint) of grpc.health.v1.HealthCheckResponse.
+const STATUS_FIELD_TAG = 0x08;
+// The SERVING value of grpc.health.v1.HealthCheckResponse.ServingStatus.
+const SERVING_STATUS = 1;
+
+async function check(r) {
+	let reply;
+	try {
+		reply = await r.subrequest(CHECK_LOCATION_PREFIX + r.uri, {
+			method: 'POST',
+			body: createHealthCheckRequest(r.variables[SERVICE_KEY] || ''),
+		});
+	} catch (e) {
+		r.error(`cannot check the health of the gRPC backend: ${e.message}`);
+		r.return(HTTP_CODES.serviceUnavailable);
+		return;
+	}
+
+	// The gRPC status is sent in the trailers, which are not available for subrequests. If the check fails,
+	// the response doesn't contain a HealthCheckResponse message, so the backend is not considered serving.
+	if (reply.status === HTTP_CODES.ok && isServing(reply.responseBuffer)) {
+		r.return(HTTP_CODES.ok, 'SERVING\n');
+		return;
+	}
+
+	r.return(HTTP_CODES.serviceUnavailable, 'NOT_SERVING\n');
+}
+
+// createHealthCheckRequest creates the length-prefixed gRPC message of grpc.health.v1.HealthCheckRequest
+// for the service. An empty service checks the overall health of the backend.
+function createHealthCheckRequest(service) {
+	let message = Buffer.alloc(0);
+	if (service) {
+		const name = Buffer.from(service);
+		message = Buffer.concat([
+			Buffer.from([SERVICE_FIELD_TAG].concat(encodeVarint(name.length))),
+			name,
+		]);
+	}
+
+	// the compressed flag is 0, because the message is not compressed.
+	const header = Buffer.alloc(GRPC_MESSAGE_HEADER_LENGTH);
+	header.writeUInt32BE(message.length, 1);
+
+	return Buffer.concat([header, message]);
+}
+
+// isServing returns true if the body is the length-prefixed gRPC message of grpc.health.v1.HealthCheckResponse
+// with the SERVING status.
+function isServing(body) {
+	if (!body || body.length < GRPC_MESSAGE_HEADER_LENGTH) {
+		return false;
+	}
+
+	// compressed messages are not supported, because the request doesn't accept any encoding.
+	if (body[0] !== 0) {
+		return false;
+	}
+
+	const length = body.readUInt32BE(1);
+	if (body.length < GRPC_MESSAGE_HEADER_LENGTH + length) {
+		return false;
+	}
+
+	const message = body.slice(GRPC_MESSAGE_HEADER_LENGTH, GRPC_MESSAGE_HEADER_LENGTH + length);
+
+	return message.length === 2 && message[0] === STATUS_FIELD_TAG && message[1] === SERVING_STATUS;
+}
+
+function encodeVarint(value) {
+	const bytes = [];
+	while (value > 0x7f) {
+		bytes.push((value & 0x7f) | 0x80);
+		value >>>= 7;
+	}
+	bytes.push(value);
+
+	return bytes;
+}
+
+export default {
+	check,
+	createHealthCheckRequest,
+	isServing,
+	encodeVarint,
+	SERVICE_KEY,
+	CHECK_LOCATION_PREFIX,
+	HTTP_CODES,
+};
@@ -0,0 +1,153 @@
+import { default as gh } from '../src/grpchealth.js';
+import { describe, expect, it } from 'vitest';
+
+// Creates a NGINX HTTP Request Object for testing.
+// See documentation for all properties available: http://nginx.org/en/docs/njs/reference.html
+function createRequest({ uri = '/default_grpc-svc_8080', service = '', reply, replyError } = {}) {
+	let r = {
+		// Test mocks
+		return(statusCode, body) {
+			r.testReturned = statusCode;
+			r.testReturnedBody = body;
+		},
+		subrequest(uri, options) {
+			r.testSubrequestURI = uri;
+			r.testSubrequestOptions = options;
+			if (replyError) {
+				return Promise.reject(replyError);
+			}
+			return Promise.resolve(reply);
+		},
+		error(msg) {
+			console.log('\tngx_error:', msg);
+		},
+		uri,
+		variables: {},
+	};
+
+	if (service) {
+		r.variables[gh.SERVICE_KEY] = service;
+	}
+
+	return r;
+}
+
+const servingResponse = Buffer.from([0, 0, 0, 0, 2, 0x08, 1]);
+const notServingResponse = Buffer.from([0, 0, 0, 0, 2, 0x08, 2]);
+
+describe('createHealthCheckRequest', () => {
+	const tests = [
+		{
+			name: 'creates an empty message for the overall health',
+			service: '',
+			expected: [0, 0, 0, 0, 0],
+		},
+		{
+			name: 'creates a message with the service',
+			service: 'foo',
+			expected: [0, 0, 0, 0, 5, 0x0a, 3, 0x66, 0x6f, 0x6f],
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect([...gh.createHealthCheckRequest(test.service)]).to.deep.equal(test.expected);
+		});
+	});
+
+	it('encodes the length of a long service name as a multi-byte varint', () => {
+		const request = gh.createHealthCheckRequest('a'.repeat(200));
+		expect(request.readUInt32BE(1)).to.equal(203);
+		expect([...request.slice(5, 8)]).to.deep.equal([0x0a, 0xc8, 0x01]);
+	});
+});
+
+describe('isServing', () => {
+	const tests = [
+		{
+			name: 'returns true for the SERVING status',
+			body: servingResponse,
+			expected: true,
+		},
+		{
+			name: 'returns false for the NOT_SERVING status',
+			body: notServingResponse,
+			expected: false,
+		},
+		{
+			name: 'returns false for an empty body',
+			body: Buffer.alloc(0),
+			expected: false,
+		},
+		{
+			name: 'returns false for an undefined body',
+			body: undefined,
+			expected: false,
+		},
+		{
+			name: 'returns false for a compressed message',
+			body: Buffer.from([1, 0, 0, 0, 2, 0x08, 1]),
+			expected: false,
+		},
+		{
+			name: 'returns false for a truncated message',
+			body: Buffer.from([0, 0, 0, 0, 2, 0x08]),
+			expected: false,
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(gh.isServing(test.body)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('check', () => {
+	const tests = [
+		{
+			name: 'returns OK if the backend is serving',
+			request: createRequest({ reply: { status: 200, responseBuffer: servingResponse } }),
+			expectedReturn: gh.HTTP_CODES.ok,
+		},
+		{
+			name: 'returns Service Unavailable if the backend is not serving',
+			request: createRequest({ reply: { status: 200, responseBuffer: notServingResponse } }),
+			expectedReturn: gh.HTTP_CODES.serviceUnavailable,
+		},
+		{
+			name: 'returns Service Unavailable if the backend is unavailable',
+			request: createRequest({ reply: { status: 502, responseBuffer: Buffer.alloc(0) } }),
+			expectedReturn: gh.HTTP_CODES.serviceUnavailable,
+		},
+		{
+			name: 'returns Service Unavailable if the subrequest fails',
+			request: createRequest({ replyError: new Error('failed') }),
+			expectedReturn: gh.HTTP_CODES.serviceUnavailable,
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, async () => {
+			await gh.check(test.request);
+			expect(test.request.testReturned).to.equal(test.expectedReturn);
+		});
+	});
+
+	it('sends the health check request for the service to the internal location', async () => {
+		const request = createRequest({
+			service: 'helloworld.Greeter',
+			reply: { status: 200, responseBuffer: servingResponse },
+		});
+
+		await gh.check(request);
+
+		expect(request.testSubrequestURI).to.equal(
+			gh.CHECK_LOCATION_PREFIX + '/default_grpc-svc_8080',
+		);
+		expect(request.testSubrequestOptions.method).to.equal('POST');
+		expect([...request.testSubrequestOptions.body]).to.deep.equal([
+			...gh.createHealthCheckRequest('helloworld.Greeter'),
+		]);
+	});
+});
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -14,6 +15,8 @@ import (
 	"github.com/go-logr/logr"
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -25,6 +28,20 @@ const (
 	PidFileTimeout = 10000 * time.Millisecond
 	// NginxReloadTimeout sets the timeout duration for reloading the Nginx configuration.
 	NginxReloadTimeout = 60000 * time.Millisecond
+	// BuildInfoFile specifies the location of the file that the NGINX container writes the output of `nginx -V` to
+	// before starting NGINX.
+	BuildInfoFile = "/var/run/nginx/nginx-build-info"
+	// ModulesFile specifies the location of the file that the NGINX container writes the list of the installed
+	// dynamic modules to before starting NGINX.
+	ModulesFile = "/var/run/nginx/nginx-modules"
+	// ResolvConfFile is the resolver configuration file of the Pod. The NGINX container shares the DNS configuration
+	// of the Pod with the control plane container.
+	ResolvConfFile = "/etc/resolv.conf"
+
+	// http3ModuleBuildFlag is the configure argument of NGINX that is built with the support for HTTP/3 over QUIC.
+	http3ModuleBuildFlag = "--with-http_v3_module"
+	// brotliModuleFile is the file of the dynamic module of ngx_brotli that compresses the responses.
+	brotliModuleFile = "ngx_http_brotli_filter_module.so"
 )
 
 type (
@@ -57,12 +74,16 @@ type NginxPlusClient interface {
 		err error,
 	)
 	GetStreamUpstreams() (*ngxclient.StreamUpstreams, error)
+	GetHTTPRequests() (*ngxclient.HTTPRequests, error)
 }
 
 //counterfeiter:generate . Manager
 
 // Manager manages the runtime of NGINX.
 type Manager interface {
+	// Validate validates NGINX configuration files using the validator NGINX instance before they are applied to
+	// the live NGINX instance. It is a no-op if the validator is not enabled.
+	Validate(ctx context.Context, files []file.File, configVersion int) error
 	// Reload reloads NGINX configuration. It is a blocking operation.
 	Reload(ctx context.Context, configVersion int) error
 	// IsPlus returns whether or not we are running NGINX plus.
@@ -76,6 +97,9 @@ type Manager interface {
 	// UpdateStreamServers uses the NGINX Plus API to update stream upstream servers.
 	// Only usable if running NGINX Plus.
 	UpdateStreamServers(string, []ngxclient.StreamUpstreamServer) error
+	// GetInFlightRequests uses the NGINX Plus API to get the number of the client requests that NGINX is processing.
+	// Only usable if running NGINX Plus.
+	GetInFlightRequests() (uint64, error)
 }
 
 // MetricsCollector is an interface for the metrics of the NGINX runtime manager.
@@ -93,6 +117,7 @@ type ManagerImpl struct {
 	metricsCollector MetricsCollector
 	verifyClient     nginxConfigVerifier
 	ngxPlusClient    NginxPlusClient
+	validator        ConfigValidator
 	logger           logr.Logger
 }
 
@@ -103,16 +128,38 @@ func NewManagerImpl(
 	logger logr.Logger,
 	processHandler ProcessHandler,
 	verifyClient nginxConfigVerifier,
+	validator ConfigValidator,
 ) *ManagerImpl {
 	return &ManagerImpl{
 		processHandler:   processHandler,
 		metricsCollector: collector,
 		verifyClient:     verifyClient,
 		ngxPlusClient:    ngxPlusClient,
+		validator:        validator,
 		logger:           logger,
 	}
 }
 
+// Start starts the validator, if the validator is enabled. It blocks until the context is canceled.
+func (m *ManagerImpl) Start(ctx context.Context) error {
+	if m.validator == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	return m.validator.Start(ctx)
+}
+
+// Validate validates NGINX configuration files using the validator NGINX instance before they are applied to
+// the live NGINX instance. It is a no-op if the validator is not enabled.
+func (m *ManagerImpl) Validate(ctx context.Context, files []file.File, configVersion int) error {
+	if m.validator == nil {
+		return nil
+	}
+
+	return m.validator.Validate(ctx, files, configVersion)
+}
+
 // IsPlus returns whether or not we are running NGINX plus.
 func (m *ManagerImpl) IsPlus() bool {
 	return m.ngxPlusClient != nil
@@ -214,6 +261,25 @@ func (m *ManagerImpl) UpdateStreamServers(upstream string, servers []ngxclient.S
 	return err
 }
 
+// GetInFlightRequests uses the NGINX Plus API to get the number of the client requests that NGINX is processing.
+// Only usable if running NGINX Plus.
+func (m *ManagerImpl) GetInFlightRequests() (uint64, error) {
+	if !m.IsPlus() {
+		panic("cannot get in-flight requests: NGINX Plus not enabled")
+	}
+
+	requests, err := m.ngxPlusClient.GetHTTPRequests()
+	if err != nil {
+		return 0, err
+	}
+
+	if requests == nil {
+		return 0, errors.New("GET http requests returned nil value")
+	}
+
+	return requests.Current, nil
+}
+
 //counterfeiter:generate . ProcessHandler
 
 type ProcessHandler interface {
@@ -275,6 +341,58 @@ func (p *ProcessHandlerImpl) FindMainProcess(
 	return pid, nil
 }
 
+// SupportsQUIC returns whether NGINX is built with the support for HTTP/3 over QUIC, based on the build info
+// in the BuildInfoFile.
+func SupportsQUIC(readFile ReadFileFunc) (bool, error) {
+	content, err := readFile(BuildInfoFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the NGINX build info: %w", err)
+	}
+
+	return strings.Contains(string(content), http3ModuleBuildFlag), nil
+}
+
+// SupportsBrotli returns whether the Brotli module is installed in the NGINX image, based on the list of
+// the dynamic modules in the ModulesFile.
+func SupportsBrotli(readFile ReadFileFunc) (bool, error) {
+	content, err := readFile(ModulesFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the NGINX modules: %w", err)
+	}
+
+	return slices.Contains(strings.Fields(string(content)), brotliModuleFile), nil
+}
+
+// GetNameservers returns the addresses of the nameservers of the Pod from the ResolvConfFile, in the format
+// of the NGINX resolver directive.
+func GetNameservers(readFile ReadFileFunc) ([]string, error) {
+	content, err := readFile(ResolvConfFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the resolver configuration: %w", err)
+	}
+
+	var nameservers []string
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+
+		address := fields[1]
+		if strings.Contains(address, ":") {
+			address = "[" + address + "]"
+		}
+
+		nameservers = append(nameservers, address)
+	}
+
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no nameservers found in %s", ResolvConfFile)
+	}
+
+	return nameservers, nil
+}
+
 func (p *ProcessHandlerImpl) ReadFile(file string) ([]byte, error) {
 	return p.readFile(file)
 }
@@ -13,16 +13,17 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
 )
 
 var _ = Describe("NGINX Runtime Manager", func() {
 	It("returns whether or not we're using NGINX Plus", func() {
-		mgr := runtime.NewManagerImpl(nil, nil, logr.Discard(), nil, nil)
+		mgr := runtime.NewManagerImpl(nil, nil, logr.Discard(), nil, nil, nil)
 		Expect(mgr.IsPlus()).To(BeFalse())
 
-		mgr = runtime.NewManagerImpl(&ngxclient.NginxClient{}, nil, logr.Discard(), nil, nil)
+		mgr = runtime.NewManagerImpl(&ngxclient.NginxClient{}, nil, logr.Discard(), nil, nil, nil)
 		Expect(mgr.IsPlus()).To(BeTrue())
 	})
 
@@ -53,7 +54,7 @@ var _ = Describe("NGINX Runtime Manager", func() {
 			process = &runtimefakes.FakeProcessHandler{}
 			metrics = &runtimefakes.FakeMetricsCollector{}
 			verifyClient = &runtimefakes.FakeVerifyClient{}
-			manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient)
+			manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient, nil)
 		})
 
 		It("Is successful", func() {
@@ -117,7 +118,7 @@ var _ = Describe("NGINX Runtime Manager", func() {
 		When("MetricsCollector is nil", func() {
 			It("panics", func() {
 				metrics = nil
-				manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient)
+				manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient, nil)
 
 				reload := func() {
 					err = manager.Reload(context.Background(), 0)
@@ -132,7 +133,7 @@ var _ = Describe("NGINX Runtime Manager", func() {
 			It("panics", func() {
 				metrics = &runtimefakes.FakeMetricsCollector{}
 				verifyClient = nil
-				manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient)
+				manager = runtime.NewManagerImpl(ngxPlusClient, metrics, logr.Discard(), process, verifyClient, nil)
 
 				reload := func() {
 					err = manager.Reload(context.Background(), 0)
@@ -144,10 +145,41 @@ var _ = Describe("NGINX Runtime Manager", func() {
 		})
 	})
 
+	When("validating configuration", func() {
+		files := []file.File{
+			{
+				Path:    "/etc/nginx/conf.d/http.conf",
+				Content: []byte("http"),
+				Type:    file.TypeRegular,
+			},
+		}
+
+		It("is a no-op when the validator is not enabled", func() {
+			manager = runtime.NewManagerImpl(nil, nil, logr.Discard(), nil, nil, nil)
+
+			Expect(manager.Validate(context.Background(), files, 1)).To(Succeed())
+		})
+
+		It("validates the configuration using the validator", func() {
+			validator := &runtimefakes.FakeConfigValidator{}
+			manager = runtime.NewManagerImpl(nil, nil, logr.Discard(), nil, nil, validator)
+
+			Expect(manager.Validate(context.Background(), files, 1)).To(Succeed())
+
+			Expect(validator.ValidateCallCount()).To(Equal(1))
+			_, validatedFiles, version := validator.ValidateArgsForCall(0)
+			Expect(validatedFiles).To(Equal(files))
+			Expect(version).To(Equal(1))
+
+			validator.ValidateReturns(errors.New("validation error"))
+			Expect(manager.Validate(context.Background(), files, 2)).To(MatchError("validation error"))
+		})
+	})
+
 	When("running NGINX plus", func() {
 		BeforeEach(func() {
 			ngxPlusClient = &runtimefakes.FakeNginxPlusClient{}
-			manager = runtime.NewManagerImpl(ngxPlusClient, nil, logr.Discard(), nil, nil)
+			manager = runtime.NewManagerImpl(ngxPlusClient, nil, logr.Discard(), nil, nil, nil)
 		})
 
 		It("successfully updates HTTP server upstream", func() {
@@ -258,12 +290,37 @@ var _ = Describe("NGINX Runtime Manager", func() {
 			Expect(upstreams).To(BeNil())
 			Expect(streamUpstreams).To(BeNil())
 		})
+
+		It("successfully returns the in-flight requests", func() {
+			ngxPlusClient.GetHTTPRequestsReturns(&ngxclient.HTTPRequests{Total: 100, Current: 3}, nil)
+
+			requests, err := manager.GetInFlightRequests()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requests).To(Equal(uint64(3)))
+		})
+
+		It("returns an error when GetHTTPRequests fails", func() {
+			ngxPlusClient.GetHTTPRequestsReturns(nil, errors.New("failed to get http requests"))
+
+			_, err := manager.GetInFlightRequests()
+
+			Expect(err).To(MatchError("failed to get http requests"))
+		})
+
+		It("returns an error when GetHTTPRequests returns nil", func() {
+			ngxPlusClient.GetHTTPRequestsReturns(nil, nil)
+
+			_, err := manager.GetInFlightRequests()
+
+			Expect(err).To(MatchError("GET http requests returned nil value"))
+		})
 	})
 
 	When("not running NGINX plus", func() {
 		BeforeEach(func() {
 			ngxPlusClient = nil
-			manager = runtime.NewManagerImpl(ngxPlusClient, nil, logr.Discard(), nil, nil)
+			manager = runtime.NewManagerImpl(ngxPlusClient, nil, logr.Discard(), nil, nil, nil)
 		})
 
 		It("should panic when fetching upstream servers", func() {
@@ -292,6 +349,15 @@ var _ = Describe("NGINX Runtime Manager", func() {
 			Expect(updateServers).To(Panic())
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should panic when getting the in-flight requests", func() {
+			getRequests := func() {
+				_, err = manager.GetInFlightRequests()
+			}
+
+			Expect(getRequests).To(Panic())
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 })
 
@@ -401,3 +467,178 @@ func TestFindMainProcess(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportsQUIC(t *testing.T) {
+	t.Parallel()
+	readFileFuncGen := func(content []byte) runtime.ReadFileFunc {
+		return func(name string) ([]byte, error) {
+			if name != runtime.BuildInfoFile {
+				return nil, errors.New("error")
+			}
+			return content, nil
+		}
+	}
+	readFileError := func(string) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+
+	tests := []struct {
+		readFile    runtime.ReadFileFunc
+		name        string
+		expected    bool
+		expectError bool
+	}{
+		{
+			readFile: readFileFuncGen([]byte(
+				"nginx version: nginx/1.27.4\nconfigure arguments: --with-http_ssl_module --with-http_v3_module\n",
+			)),
+			expected: true,
+			name:     "built with the http3 module",
+		},
+		{
+			readFile: readFileFuncGen([]byte(
+				"nginx version: nginx/1.27.4\nconfigure arguments: --with-http_ssl_module --with-http_v2_module\n",
+			)),
+			expected: false,
+			name:     "built without the http3 module",
+		},
+		{
+			readFile:    readFileError,
+			expected:    false,
+			expectError: true,
+			name:        "cannot read file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result, err := runtime.SupportsQUIC(test.readFile)
+
+			if test.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestSupportsBrotli(t *testing.T) {
+	t.Parallel()
+	readFileFuncGen := func(content []byte) runtime.ReadFileFunc {
+		return func(name string) ([]byte, error) {
+			if name != runtime.ModulesFile {
+				return nil, errors.New("error")
+			}
+			return content, nil
+		}
+	}
+	readFileError := func(string) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+
+	tests := []struct {
+		readFile    runtime.ReadFileFunc
+		name        string
+		expected    bool
+		expectError bool
+	}{
+		{
+			readFile: readFileFuncGen([]byte(
+				"ngx_http_brotli_filter_module.so\nngx_http_brotli_static_module.so\nngx_otel_module.so\n",
+			)),
+			expected: true,
+			name:     "brotli module installed",
+		},
+		{
+			readFile: readFileFuncGen([]byte("ngx_http_js_module.so\nngx_otel_module.so\n")),
+			expected: false,
+			name:     "brotli module not installed",
+		},
+		{
+			readFile:    readFileError,
+			expected:    false,
+			expectError: true,
+			name:        "cannot read file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result, err := runtime.SupportsBrotli(test.readFile)
+
+			if test.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetNameservers(t *testing.T) {
+	t.Parallel()
+	readFileFuncGen := func(content []byte) runtime.ReadFileFunc {
+		return func(name string) ([]byte, error) {
+			if name != runtime.ResolvConfFile {
+				return nil, errors.New("error")
+			}
+			return content, nil
+		}
+	}
+	readFileError := func(string) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+
+	tests := []struct {
+		readFile    runtime.ReadFileFunc
+		name        string
+		expected    []string
+		expectError bool
+	}{
+		{
+			readFile: readFileFuncGen([]byte(
+				"search default.svc.cluster.local svc.cluster.local cluster.local\n" +
+					"nameserver 10.96.0.10\n" +
+					"nameserver fd00::a\n" +
+					"options ndots:5\n",
+			)),
+			expected: []string{"10.96.0.10", "[fd00::a]"},
+			name:     "IPv4 and IPv6 nameservers",
+		},
+		{
+			readFile:    readFileFuncGen([]byte("search cluster.local\noptions ndots:5\n")),
+			expectError: true,
+			name:        "no nameservers",
+		},
+		{
+			readFile:    readFileError,
+			expectError: true,
+			name:        "cannot read file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result, err := runtime.GetNameservers(test.readFile)
+
+			if test.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
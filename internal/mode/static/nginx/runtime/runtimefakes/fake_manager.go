@@ -5,11 +5,24 @@ import (
 	"context"
 	"sync"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
 	"github.com/nginxinc/nginx-plus-go-client/client"
 )
 
 type FakeManager struct {
+	GetInFlightRequestsStub        func() (uint64, error)
+	getInFlightRequestsMutex       sync.RWMutex
+	getInFlightRequestsArgsForCall []struct {
+	}
+	getInFlightRequestsReturns struct {
+		result1 uint64
+		result2 error
+	}
+	getInFlightRequestsReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	GetUpstreamsStub        func() (client.Upstreams, client.StreamUpstreams, error)
 	getUpstreamsMutex       sync.RWMutex
 	getUpstreamsArgsForCall []struct {
@@ -70,10 +83,79 @@ type FakeManager struct {
 	updateStreamServersReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateStub        func(context.Context, []file.File, int) error
+	validateMutex       sync.RWMutex
+	validateArgsForCall []struct {
+		arg1 context.Context
+		arg2 []file.File
+		arg3 int
+	}
+	validateReturns struct {
+		result1 error
+	}
+	validateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeManager) GetInFlightRequests() (uint64, error) {
+	fake.getInFlightRequestsMutex.Lock()
+	ret, specificReturn := fake.getInFlightRequestsReturnsOnCall[len(fake.getInFlightRequestsArgsForCall)]
+	fake.getInFlightRequestsArgsForCall = append(fake.getInFlightRequestsArgsForCall, struct {
+	}{})
+	stub := fake.GetInFlightRequestsStub
+	fakeReturns := fake.getInFlightRequestsReturns
+	fake.recordInvocation("GetInFlightRequests", []interface{}{})
+	fake.getInFlightRequestsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetInFlightRequestsCallCount() int {
+	fake.getInFlightRequestsMutex.RLock()
+	defer fake.getInFlightRequestsMutex.RUnlock()
+	return len(fake.getInFlightRequestsArgsForCall)
+}
+
+func (fake *FakeManager) GetInFlightRequestsCalls(stub func() (uint64, error)) {
+	fake.getInFlightRequestsMutex.Lock()
+	defer fake.getInFlightRequestsMutex.Unlock()
+	fake.GetInFlightRequestsStub = stub
+}
+
+func (fake *FakeManager) GetInFlightRequestsReturns(result1 uint64, result2 error) {
+	fake.getInFlightRequestsMutex.Lock()
+	defer fake.getInFlightRequestsMutex.Unlock()
+	fake.GetInFlightRequestsStub = nil
+	fake.getInFlightRequestsReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetInFlightRequestsReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.getInFlightRequestsMutex.Lock()
+	defer fake.getInFlightRequestsMutex.Unlock()
+	fake.GetInFlightRequestsStub = nil
+	if fake.getInFlightRequestsReturnsOnCall == nil {
+		fake.getInFlightRequestsReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.getInFlightRequestsReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) GetUpstreams() (client.Upstreams, client.StreamUpstreams, error) {
 	fake.getUpstreamsMutex.Lock()
 	ret, specificReturn := fake.getUpstreamsReturnsOnCall[len(fake.getUpstreamsArgsForCall)]
@@ -382,9 +464,79 @@ func (fake *FakeManager) UpdateStreamServersReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeManager) Validate(arg1 context.Context, arg2 []file.File, arg3 int) error {
+	var arg2Copy []file.File
+	if arg2 != nil {
+		arg2Copy = make([]file.File, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.validateMutex.Lock()
+	ret, specificReturn := fake.validateReturnsOnCall[len(fake.validateArgsForCall)]
+	fake.validateArgsForCall = append(fake.validateArgsForCall, struct {
+		arg1 context.Context
+		arg2 []file.File
+		arg3 int
+	}{arg1, arg2Copy, arg3})
+	stub := fake.ValidateStub
+	fakeReturns := fake.validateReturns
+	fake.recordInvocation("Validate", []interface{}{arg1, arg2Copy, arg3})
+	fake.validateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) ValidateCallCount() int {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	return len(fake.validateArgsForCall)
+}
+
+func (fake *FakeManager) ValidateCalls(stub func(context.Context, []file.File, int) error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = stub
+}
+
+func (fake *FakeManager) ValidateArgsForCall(i int) (context.Context, []file.File, int) {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	argsForCall := fake.validateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeManager) ValidateReturns(result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	fake.validateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ValidateReturnsOnCall(i int, result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	if fake.validateReturnsOnCall == nil {
+		fake.validateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getInFlightRequestsMutex.RLock()
+	defer fake.getInFlightRequestsMutex.RUnlock()
 	fake.getUpstreamsMutex.RLock()
 	defer fake.getUpstreamsMutex.RUnlock()
 	fake.isPlusMutex.RLock()
@@ -395,6 +547,8 @@ func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	defer fake.updateHTTPServersMutex.RUnlock()
 	fake.updateStreamServersMutex.RLock()
 	defer fake.updateStreamServersMutex.RUnlock()
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
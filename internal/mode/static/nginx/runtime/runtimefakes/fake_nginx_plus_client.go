@@ -9,6 +9,18 @@ import (
 )
 
 type FakeNginxPlusClient struct {
+	GetHTTPRequestsStub        func() (*client.HTTPRequests, error)
+	getHTTPRequestsMutex       sync.RWMutex
+	getHTTPRequestsArgsForCall []struct {
+	}
+	getHTTPRequestsReturns struct {
+		result1 *client.HTTPRequests
+		result2 error
+	}
+	getHTTPRequestsReturnsOnCall map[int]struct {
+		result1 *client.HTTPRequests
+		result2 error
+	}
 	GetStreamUpstreamsStub        func() (*client.StreamUpstreams, error)
 	getStreamUpstreamsMutex       sync.RWMutex
 	getStreamUpstreamsArgsForCall []struct {
@@ -73,6 +85,62 @@ type FakeNginxPlusClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNginxPlusClient) GetHTTPRequests() (*client.HTTPRequests, error) {
+	fake.getHTTPRequestsMutex.Lock()
+	ret, specificReturn := fake.getHTTPRequestsReturnsOnCall[len(fake.getHTTPRequestsArgsForCall)]
+	fake.getHTTPRequestsArgsForCall = append(fake.getHTTPRequestsArgsForCall, struct {
+	}{})
+	stub := fake.GetHTTPRequestsStub
+	fakeReturns := fake.getHTTPRequestsReturns
+	fake.recordInvocation("GetHTTPRequests", []interface{}{})
+	fake.getHTTPRequestsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNginxPlusClient) GetHTTPRequestsCallCount() int {
+	fake.getHTTPRequestsMutex.RLock()
+	defer fake.getHTTPRequestsMutex.RUnlock()
+	return len(fake.getHTTPRequestsArgsForCall)
+}
+
+func (fake *FakeNginxPlusClient) GetHTTPRequestsCalls(stub func() (*client.HTTPRequests, error)) {
+	fake.getHTTPRequestsMutex.Lock()
+	defer fake.getHTTPRequestsMutex.Unlock()
+	fake.GetHTTPRequestsStub = stub
+}
+
+func (fake *FakeNginxPlusClient) GetHTTPRequestsReturns(result1 *client.HTTPRequests, result2 error) {
+	fake.getHTTPRequestsMutex.Lock()
+	defer fake.getHTTPRequestsMutex.Unlock()
+	fake.GetHTTPRequestsStub = nil
+	fake.getHTTPRequestsReturns = struct {
+		result1 *client.HTTPRequests
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNginxPlusClient) GetHTTPRequestsReturnsOnCall(i int, result1 *client.HTTPRequests, result2 error) {
+	fake.getHTTPRequestsMutex.Lock()
+	defer fake.getHTTPRequestsMutex.Unlock()
+	fake.GetHTTPRequestsStub = nil
+	if fake.getHTTPRequestsReturnsOnCall == nil {
+		fake.getHTTPRequestsReturnsOnCall = make(map[int]struct {
+			result1 *client.HTTPRequests
+			result2 error
+		})
+	}
+	fake.getHTTPRequestsReturnsOnCall[i] = struct {
+		result1 *client.HTTPRequests
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNginxPlusClient) GetStreamUpstreams() (*client.StreamUpstreams, error) {
 	fake.getStreamUpstreamsMutex.Lock()
 	ret, specificReturn := fake.getStreamUpstreamsReturnsOnCall[len(fake.getStreamUpstreamsArgsForCall)]
@@ -340,6 +408,8 @@ func (fake *FakeNginxPlusClient) UpdateStreamServersReturnsOnCall(i int, result1
 func (fake *FakeNginxPlusClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getHTTPRequestsMutex.RLock()
+	defer fake.getHTTPRequestsMutex.RUnlock()
 	fake.getStreamUpstreamsMutex.RLock()
 	defer fake.getStreamUpstreamsMutex.RUnlock()
 	fake.getUpstreamsMutex.RLock()
@@ -0,0 +1,439 @@
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+const (
+	// ValidatorDir is the directory where the files of the validator NGINX instance are stored.
+	// It is located in the volume that the control plane shares with the NGINX container.
+	ValidatorDir = "/var/run/nginx/validator"
+	// ValidatorStartTimeout defines the timeout duration for starting the validator NGINX instance.
+	ValidatorStartTimeout = 30000 * time.Millisecond
+
+	// validatorMainConfigFile is the main configuration file of the validator NGINX instance. The nginx-validator
+	// container waits for it to be written before it starts the instance.
+	validatorMainConfigFile = "nginx.conf"
+	// validatorPidFile is the PID file of the main process of the validator NGINX instance.
+	validatorPidFile = "nginx.pid"
+	// validatorErrorLogFile is the error log file of the validator NGINX instance.
+	validatorErrorLogFile = "error.log"
+	// runSocketPrefix is the prefix of the unix sockets that the live NGINX instance listens on.
+	runSocketPrefix = "unix:/var/run/nginx/"
+)
+
+// validatorMainConfigFmt is the main configuration of the validator NGINX instance. It mirrors the main
+// configuration of the live NGINX instance, so that the configuration is loaded with the same settings, like the
+// sizes of the hash tables. The live main configuration is part of the NGINX image, so the tests check that its
+// directives are kept in sync with this copy.
+//
+// The validator instance only listens on the dummy port, which is used to check that the instance is running.
+// It also logs to an error log file in the validator directory, because the control plane can't read the stderr of
+// the nginx-validator container, and NGINX logs the errors of a failed reload to the error logs of the running
+// configuration.
+const validatorMainConfigFmt = `load_module /usr/lib/nginx/modules/ngx_http_js_module.so;
+include %[1]s/etc/nginx/main-includes/*.conf;
+
+daemon off;
+worker_processes 1;
+
+pid %[1]s/nginx.pid;
+error_log %[1]s/error.log notice;
+
+events {
+  include %[1]s/etc/nginx/events-includes/*.conf;
+}
+
+http {
+  include %[1]s/etc/nginx/conf.d/*.conf;
+  include /etc/nginx/mime.types;
+  js_import /usr/lib/nginx/modules/njs/httpmatches.js;
+  js_import /usr/lib/nginx/modules/njs/grpchealth.js;
+
+  default_type application/octet-stream;
+
+  client_body_temp_path %[1]s/client_body_temp;
+  proxy_temp_path %[1]s/proxy_temp;
+  fastcgi_temp_path %[1]s/fastcgi_temp;
+  uwsgi_temp_path %[1]s/uwsgi_temp;
+  scgi_temp_path %[1]s/scgi_temp;
+
+  proxy_headers_hash_bucket_size 512;
+  proxy_headers_hash_max_size 1024;
+  variables_hash_bucket_size 512;
+  variables_hash_max_size 1024;
+
+  sendfile on;
+  tcp_nopush on;
+
+  server_tokens off;
+
+  server {
+    listen 127.0.0.1:%[2]d;
+    access_log off;
+    return 204;
+  }
+}
+
+stream {
+  variables_hash_bucket_size 512;
+  variables_hash_max_size 1024;
+
+  log_format stream-main '$remote_addr [$time_local] '
+                         '$protocol $status $bytes_sent $bytes_received '
+                         '$session_time "$ssl_preread_server_name"';
+  include %[1]s/etc/nginx/stream-conf.d/*.conf;
+}
+`
+
+// listenPortRegexp matches the listen directives with a port, like "listen 80 default_server;" or
+// "listen [::]:443 ssl;".
+var listenPortRegexp = regexp.MustCompile(`(?m)^([ \t]*)listen\s+(\[::\]:)?(\d+)([^;]*);`)
+
+// workerProcessesRegexp matches the worker_processes directive, like "worker_processes auto;".
+var workerProcessesRegexp = regexp.MustCompile(`(?m)^([ \t]*)(worker_processes\s[^;]*;)`)
+
+//counterfeiter:generate . ConfigValidator
+
+// ConfigValidator validates NGINX configuration before it is applied to the live NGINX instance.
+type ConfigValidator interface {
+	// Start starts the validator. It blocks until the context is canceled.
+	Start(ctx context.Context) error
+	// Validate validates the configuration files of the config version.
+	Validate(ctx context.Context, files []file.File, configVersion int) error
+}
+
+// NginxValidatorConfig holds the configuration for the NginxValidator.
+type NginxValidatorConfig struct {
+	// Logger is the logger of the validator.
+	Logger logr.Logger
+	// Dir is the directory where the files of the validator NGINX instance are stored.
+	Dir string
+	// ConfigFolders are the folders where the live NGINX instance stores the configuration files.
+	ConfigFolders []string
+	// Port is the dummy port that the validator NGINX instance listens on.
+	Port int
+}
+
+// NginxValidator is a ConfigValidator that loads the configuration into a separate NGINX instance. This catches the
+// errors that NGINX only reports when it loads the configuration, for example, hash tables that are too small,
+// without affecting the live NGINX instance.
+//
+// The control plane image doesn't include NGINX, so the validator instance runs in the nginx-validator container
+// of the NGINX image, which shares the validator directory and the network and process namespaces with the control
+// plane. The container starts the instance once the main configuration is written to the validator directory.
+//
+// The validator instance doesn't receive traffic. The configuration files are moved to the validator directory,
+// and the listen directives are changed to listen on unix sockets in the validator directory, so that the validator
+// instance doesn't conflict with the live instance.
+type NginxValidator struct {
+	fileMgr       file.Manager
+	verifyClient  *VerifyClient
+	started       chan struct{}
+	signal        func(pid int, sig syscall.Signal) error
+	logger        logr.Logger
+	dir           string
+	configFolders []string
+	port          int
+}
+
+// NewNginxValidator creates a new NginxValidator.
+func NewNginxValidator(cfg NginxValidatorConfig) *NginxValidator {
+	return &NginxValidator{
+		fileMgr: file.NewManagerImpl(cfg.Logger.WithName("fileManager"), file.NewStdLibOSFileManager()),
+		verifyClient: newVerifyClientForSocket(
+			filepath.Join(cfg.Dir, filepath.Base(configVersionURI)),
+			NginxReloadTimeout,
+		),
+		started:       make(chan struct{}),
+		signal:        syscall.Kill,
+		logger:        cfg.Logger,
+		dir:           cfg.Dir,
+		configFolders: cfg.ConfigFolders,
+		port:          cfg.Port,
+	}
+}
+
+// Start writes the main configuration of the validator NGINX instance and waits until the instance, which is started
+// by the nginx-validator container, listens on the dummy port. It blocks until the context is canceled.
+func (v *NginxValidator) Start(ctx context.Context) error {
+	folders := make([]string, 0, len(v.configFolders))
+	for _, folder := range v.configFolders {
+		folders = append(folders, v.dir+folder)
+	}
+
+	for _, folder := range folders {
+		if err := os.MkdirAll(folder, 0o755); err != nil {
+			return fmt.Errorf("failed to create validator folder %q: %w", folder, err)
+		}
+	}
+
+	// the validator instance runs as a different user of the same group, and it creates the PID file, the error log,
+	// the unix sockets and the temp folders in the validator directory
+	if err := os.Chmod(v.dir, 0o775); err != nil {
+		return fmt.Errorf("failed to set the permissions of the validator directory: %w", err)
+	}
+
+	// the files of the previous validator instance are left over if the control plane was restarted
+	if _, err := file.ClearFolders(file.NewStdLibOSFileManager(), folders); err != nil {
+		return fmt.Errorf("cannot clear validator folders: %w", err)
+	}
+
+	// the main configuration is written last, because the nginx-validator container starts the instance
+	// as soon as it exists
+	mainConfig := file.File{
+		Path:    filepath.Join(v.dir, validatorMainConfigFile),
+		Content: []byte(fmt.Sprintf(validatorMainConfigFmt, v.dir, v.port)),
+		Type:    file.TypeRegular,
+	}
+
+	if err := file.WriteFile(file.NewStdLibOSFileManager(), mainConfig); err != nil {
+		return fmt.Errorf("failed to write validator main configuration: %w", err)
+	}
+
+	if err := v.waitForDummyPort(ctx); err != nil {
+		return err
+	}
+
+	close(v.started)
+
+	v.logger.Info("Validator NGINX instance is running")
+
+	<-ctx.Done()
+	return nil
+}
+
+func (v *NginxValidator) waitForDummyPort(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, ValidatorStartTimeout)
+	defer cancel()
+
+	addr := fmt.Sprintf("127.0.0.1:%d", v.port)
+
+	err := wait.PollUntilContextCancel(
+		ctx,
+		100*time.Millisecond,
+		true, /* poll immediately */
+		func(ctx context.Context) (bool, error) {
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, "tcp", addr)
+			if err != nil {
+				return false, nil //nolint:nilerr // the instance is not listening yet
+			}
+
+			return true, conn.Close()
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"validator NGINX instance is not listening on %s, check the logs of the nginx-validator container: %w",
+			addr,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// Validate loads the configuration files into the validator NGINX instance. It returns an error if the instance
+// fails to load the configuration, or if it doesn't start serving the config version within the timeout.
+// Note: It is not thread safe.
+func (v *NginxValidator) Validate(ctx context.Context, files []file.File, configVersion int) error {
+	startCtx, cancel := context.WithTimeout(ctx, ValidatorStartTimeout)
+	defer cancel()
+
+	select {
+	case <-v.started:
+	case <-startCtx.Done():
+		return errors.New("validator NGINX instance is not running")
+	}
+
+	if err := v.fileMgr.ReplaceFiles(v.rewriteFiles(files)); err != nil {
+		return fmt.Errorf("failed to write validator configuration files: %w", err)
+	}
+
+	errorLog := validatorErrorLog{path: filepath.Join(v.dir, validatorErrorLogFile)}
+
+	// only the errors that are logged after the reload belong to this configuration
+	offset, err := errorLog.size()
+	if err != nil {
+		return fmt.Errorf("failed to read the error log of the validator NGINX instance: %w", err)
+	}
+
+	// the PID is read for every validation, because the nginx-validator container might have been restarted
+	pid, err := v.findMainProcess()
+	if err != nil {
+		return err
+	}
+
+	// send HUP signal to the validator NGINX main process to reload configuration
+	if err := v.signal(pid, syscall.SIGHUP); err != nil {
+		return fmt.Errorf("failed to send the HUP signal to validator NGINX main: %w", err)
+	}
+
+	reloadCtx, cancel := context.WithTimeout(ctx, v.verifyClient.timeout)
+	defer cancel()
+
+	err = wait.PollUntilContextCancel(
+		reloadCtx,
+		25*time.Millisecond,
+		true, /* poll immediately */
+		func(_ context.Context) (bool, error) {
+			// NGINX keeps the previous configuration if it fails to load the new one, so the error log is the
+			// only place to learn about the failure.
+			msg, err := errorLog.lastEmergency(offset)
+			if err != nil {
+				return false, err
+			}
+			if msg != "" {
+				return false, errors.New(msg)
+			}
+
+			// the config version socket might not exist yet, so the errors are expected
+			version, err := v.verifyClient.GetConfigVersion()
+			return err == nil && version == configVersion, nil
+		},
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("config version %d was not loaded within the deadline", configVersion)
+		}
+		return fmt.Errorf("validator NGINX instance failed to load the configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (v *NginxValidator) findMainProcess() (int, error) {
+	content, err := os.ReadFile(filepath.Join(v.dir, validatorPidFile))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the PID file of the validator NGINX instance: %w", err)
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid PID file of the validator NGINX instance: %w", err)
+	}
+
+	return pid, nil
+}
+
+// rewriteFiles moves the files to the validator directory and changes their content, so that the validator
+// instance doesn't conflict with the live instance:
+//   - The configuration folders are moved to the validator directory.
+//   - The unix sockets are moved to the validator directory.
+//   - The ports are replaced with unix sockets in the validator directory. The UDP and QUIC listeners get separate
+//     sockets. The IPv6 listen directives are commented out, because they would listen on the same sockets as the
+//     IPv4 ones.
+//   - The worker_processes directive is commented out, so that the validator instance runs a single worker process.
+func (v *NginxValidator) rewriteFiles(files []file.File) []file.File {
+	rewritten := make([]file.File, 0, len(files))
+
+	replacements := make([]string, 0, 2*len(v.configFolders)+2)
+	replacements = append(replacements, runSocketPrefix, "unix:"+v.dir+"/")
+	for _, folder := range v.configFolders {
+		replacements = append(replacements, folder+"/", v.dir+folder+"/")
+	}
+
+	replacer := strings.NewReplacer(replacements...)
+
+	for _, f := range files {
+		content := f.Content
+
+		// secret files only contain certificates and keys
+		if f.Type == file.TypeRegular {
+			content = []byte(replacer.Replace(string(content)))
+			content = listenPortRegexp.ReplaceAllFunc(content, v.rewriteListenPort)
+			content = workerProcessesRegexp.ReplaceAll(content, []byte("$1# $2"))
+		}
+
+		rewritten = append(rewritten, file.File{
+			Path:    v.dir + f.Path,
+			Content: content,
+			Type:    f.Type,
+		})
+	}
+
+	return rewritten
+}
+
+func (v *NginxValidator) rewriteListenPort(listen []byte) []byte {
+	matches := listenPortRegexp.FindSubmatch(listen)
+	indent, ipv6, port, params := matches[1], matches[2], matches[3], matches[4]
+
+	if len(ipv6) > 0 {
+		return []byte(fmt.Sprintf("%s# %s", indent, listen[len(indent):]))
+	}
+
+	// the UDP and QUIC listeners listen on datagram sockets, which can't share the path of the stream socket of the
+	// TCP listener of the same port
+	socket := fmt.Sprintf("%s/listen-%s.sock", v.dir, port)
+	if bytes.Contains(params, []byte(" udp")) || bytes.Contains(params, []byte(" quic")) {
+		socket = fmt.Sprintf("%s/listen-%s-udp.sock", v.dir, port)
+	}
+
+	return []byte(fmt.Sprintf("%slisten unix:%s%s;", indent, socket, params))
+}
+
+// validatorErrorLog is the error log file of the validator NGINX instance.
+type validatorErrorLog struct {
+	path string
+}
+
+// size returns the size of the error log file. The file doesn't exist until NGINX logs the first error.
+func (l validatorErrorLog) size() (int64, error) {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// lastEmergency returns the last emergency message that is logged after the offset. NGINX logs an emergency message
+// when it fails to load the configuration.
+func (l validatorErrorLog) lastEmergency(offset int64) (string, error) {
+	f, err := os.Open(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	var emergency string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.Contains(line, "[emerg]") {
+			emergency = line
+		}
+	}
+
+	return emergency, scanner.Err()
+}
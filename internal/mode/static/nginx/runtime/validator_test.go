@@ -0,0 +1,353 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+func TestNginxValidatorRewriteFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := NewNginxValidator(NginxValidatorConfig{
+		Logger: logr.Discard(),
+		Dir:    "/var/run/nginx/validator",
+		ConfigFolders: []string{
+			"/etc/nginx/conf.d",
+			"/etc/nginx/secrets",
+			"/etc/nginx/stream-conf.d",
+			"/etc/nginx/main-includes",
+		},
+		Port: 8095,
+	})
+
+	httpConf := `server {
+    listen 80 default_server;
+    listen [::]:80 default_server;
+    ssl_certificate /etc/nginx/secrets/cert.pem;
+    include /etc/nginx/grpc-error-pages.conf;
+}
+server {
+    listen unix:/var/run/nginx/https443.sock ssl proxy_protocol;
+    location / {
+        proxy_pass http://unix:/var/run/nginx/nginx-500-server.sock;
+    }
+}
+server {
+    listen 443 ssl default_server;
+    listen [::]:443 ssl default_server;
+    listen 443 quic reuseport default_server;
+    listen [::]:443 quic reuseport default_server;
+    http3 on;
+}
+`
+	expHTTPConf := `server {
+    listen unix:/var/run/nginx/validator/listen-80.sock default_server;
+    # listen [::]:80 default_server;
+    ssl_certificate /var/run/nginx/validator/etc/nginx/secrets/cert.pem;
+    include /etc/nginx/grpc-error-pages.conf;
+}
+server {
+    listen unix:/var/run/nginx/validator/https443.sock ssl proxy_protocol;
+    location / {
+        proxy_pass http://unix:/var/run/nginx/validator/nginx-500-server.sock;
+    }
+}
+server {
+    listen unix:/var/run/nginx/validator/listen-443.sock ssl default_server;
+    # listen [::]:443 ssl default_server;
+    listen unix:/var/run/nginx/validator/listen-443-udp.sock quic reuseport default_server;
+    # listen [::]:443 quic reuseport default_server;
+    http3 on;
+}
+`
+
+	streamConf := `server {
+    listen 53 udp;
+    listen 53;
+}
+`
+	expStreamConf := `server {
+    listen unix:/var/run/nginx/validator/listen-53-udp.sock udp;
+    listen unix:/var/run/nginx/validator/listen-53.sock;
+}
+`
+
+	mainConf := `error_log stderr info;
+
+worker_processes 4;
+worker_rlimit_nofile 8192;
+`
+	expMainConf := `error_log stderr info;
+
+# worker_processes 4;
+worker_rlimit_nofile 8192;
+`
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/main-includes/main.conf",
+			Content: []byte(mainConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte(httpConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/stream-conf.d/stream.conf",
+			Content: []byte(streamConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/cert.pem",
+			Content: []byte("listen 80;"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	expFiles := []file.File{
+		{
+			Path:    "/var/run/nginx/validator/etc/nginx/main-includes/main.conf",
+			Content: []byte(expMainConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/var/run/nginx/validator/etc/nginx/conf.d/http.conf",
+			Content: []byte(expHTTPConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/var/run/nginx/validator/etc/nginx/stream-conf.d/stream.conf",
+			Content: []byte(expStreamConf),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/var/run/nginx/validator/etc/nginx/secrets/cert.pem",
+			Content: []byte("listen 80;"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	g.Expect(v.rewriteFiles(files)).To(Equal(expFiles))
+}
+
+func TestValidatorMainConfigMirrorsLiveMainConfig(t *testing.T) {
+	t.Parallel()
+
+	const dir = "/var/run/nginx/validator"
+
+	// the directives of the live main configuration that the validator main configuration doesn't need
+	ignoredDirectives := map[string]struct{}{
+		"listen unix:/var/run/nginx/nginx-status.sock;": {},
+		"location /stub_status {":                       {},
+		"stub_status;":                                  {},
+		"access_log /dev/stdout stream-main;":           {},
+	}
+
+	replacer := strings.NewReplacer(
+		"/var/run/nginx/nginx.pid", dir+"/nginx.pid",
+		"/etc/nginx/main-includes/", dir+"/etc/nginx/main-includes/",
+		"/etc/nginx/events-includes/", dir+"/etc/nginx/events-includes/",
+		"/etc/nginx/conf.d/", dir+"/etc/nginx/conf.d/",
+		"/etc/nginx/stream-conf.d/", dir+"/etc/nginx/stream-conf.d/",
+	)
+
+	validatorDirectives := strings.Split(fmt.Sprintf(validatorMainConfigFmt, dir, 8095), "\n")
+	for i, directive := range validatorDirectives {
+		validatorDirectives[i] = strings.TrimSpace(directive)
+	}
+
+	for _, liveConfig := range []string{"nginx.conf", "nginx-plus.conf"} {
+		t.Run(liveConfig, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			content, err := os.ReadFile(filepath.Join("..", "conf", liveConfig))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			for _, line := range strings.Split(replacer.Replace(string(content)), "\n") {
+				directive := strings.TrimSpace(line)
+				if directive == "" {
+					continue
+				}
+				if _, ignored := ignoredDirectives[directive]; ignored {
+					continue
+				}
+
+				g.Expect(validatorDirectives).To(ContainElement(directive))
+			}
+		})
+	}
+}
+
+func TestNginxValidator(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// the validator directory is the volume that the control plane shares with the nginx-validator container
+	dir := t.TempDir()
+
+	portListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	port := portListener.Addr().(*net.TCPAddr).Port
+	g.Expect(portListener.Close()).To(Succeed())
+
+	v := NewNginxValidator(NginxValidatorConfig{
+		Logger:        logr.Discard(),
+		Dir:           dir,
+		ConfigFolders: []string{"/etc/nginx/conf.d"},
+		Port:          port,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- v.Start(ctx)
+	}()
+
+	// the nginx-validator container starts the validator instance once the main configuration is written
+	mainConfig := filepath.Join(dir, "nginx.conf")
+	g.Eventually(func() error {
+		_, err := os.Stat(mainConfig)
+		return err
+	}).Should(Succeed())
+
+	info, err := os.Stat(dir)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o775)))
+
+	content, err := os.ReadFile(mainConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring(fmt.Sprintf("listen 127.0.0.1:%d;", port)))
+	g.Expect(string(content)).To(ContainSubstring(fmt.Sprintf("pid %s/nginx.pid;", dir)))
+	g.Expect(string(content)).To(ContainSubstring(fmt.Sprintf("error_log %s/error.log notice;", dir)))
+
+	const pid = 1234
+	g.Expect(os.WriteFile(filepath.Join(dir, "nginx.pid"), []byte("1234\n"), 0o600)).To(Succeed())
+
+	dummyListener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(func() { dummyListener.Close() })
+
+	var loadedVersion atomic.Int64
+
+	versionListener, err := net.Listen("unix", filepath.Join(dir, "nginx-config-version.sock"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	versionServer := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, loadedVersion.Load())
+		}),
+		ReadHeaderTimeout: time.Second,
+	}
+	go versionServer.Serve(versionListener) //nolint:errcheck // the server is closed at the end of the test
+	t.Cleanup(func() { versionServer.Close() })
+
+	// reload loads the configuration the way the validator instance does: it either serves the config version
+	// of the configuration file, or logs an emergency message to the error log and keeps the previous configuration
+	v.signal = func(signalPID int, sig syscall.Signal) error {
+		g.Expect(signalPID).To(Equal(pid))
+		g.Expect(sig).To(Equal(syscall.SIGHUP))
+
+		conf, err := os.ReadFile(filepath.Join(dir, "etc/nginx/conf.d/http.conf"))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		version, err := strconv.Atoi(string(conf))
+		if err != nil {
+			f, err := os.OpenFile(filepath.Join(dir, "error.log"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			g.Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+
+			_, err = fmt.Fprintf(f, "2025/01/01 00:00:00 [emerg] 1#1: unknown directive %q\n", conf)
+			return err
+		}
+
+		loadedVersion.Store(int64(version))
+		return nil
+	}
+
+	getFiles := func(content string) []file.File {
+		return []file.File{
+			{
+				Path:    "/etc/nginx/conf.d/http.conf",
+				Content: []byte(content),
+				Type:    file.TypeRegular,
+			},
+		}
+	}
+
+	g.Expect(v.Validate(ctx, getFiles("1"), 1)).To(Succeed())
+
+	err = v.Validate(ctx, getFiles("invalid"), 2)
+	g.Expect(err).To(MatchError(ContainSubstring(`[emerg] 1#1: unknown directive "invalid"`)))
+
+	// the emergency message of the previous validation doesn't fail the next one
+	g.Expect(v.Validate(ctx, getFiles("3"), 3)).To(Succeed())
+
+	cancel()
+	g.Eventually(startErr).Should(Receive(BeNil()))
+}
+
+func TestValidatorErrorLog(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	l := validatorErrorLog{path: filepath.Join(t.TempDir(), "error.log")}
+
+	// the error log doesn't exist until NGINX logs the first error
+	size, err := l.size()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(size).To(BeZero())
+
+	msg, err := l.lastEmergency(0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(BeEmpty())
+
+	oldEmergency := "2025/01/01 00:00:00 [emerg] 1#1: unknown directive \"foo\""
+	notice := "2025/01/01 00:00:00 [notice] 1#1: signal process started"
+
+	g.Expect(os.WriteFile(l.path, []byte(oldEmergency+"\n"+notice+"\n"), 0o600)).To(Succeed())
+
+	offset, err := l.size()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	msg, err = l.lastEmergency(offset)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(BeEmpty())
+
+	emergency := "2025/01/01 00:00:01 [emerg] 1#1: could not build server_names_hash, " +
+		"you should increase server_names_hash_bucket_size: 256"
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = f.WriteString(emergency + "\n" + notice + "\n")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(f.Close()).To(Succeed())
+
+	msg, err = l.lastEmergency(offset)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(Equal(emergency))
+
+	msg, err = l.lastEmergency(0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(msg).To(Equal(emergency))
+}
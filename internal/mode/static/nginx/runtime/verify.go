@@ -41,11 +41,15 @@ type VerifyClient struct {
 
 // NewVerifyClient returns a new client pointed at the config version socket.
 func NewVerifyClient(timeout time.Duration) *VerifyClient {
+	return newVerifyClientForSocket(configVersionURI, timeout)
+}
+
+func newVerifyClientForSocket(socket string, timeout time.Duration) *VerifyClient {
 	return &VerifyClient{
 		client: &http.Client{
 			Transport: &http.Transport{
 				DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
-					return net.Dial("unix", configVersionURI)
+					return net.Dial("unix", socket)
 				},
 			},
 		},
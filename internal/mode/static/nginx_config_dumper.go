@@ -0,0 +1,211 @@
+package static
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane/snapshot"
+)
+
+const (
+	// nginxConfigVersionAnnotation is the annotation on the dump ConfigMap holding the version of the
+	// NGINX configuration it contains.
+	nginxConfigVersionAnnotation = "gateway.nginx.org/nginx-config-version"
+	// nginxConfigTruncatedAnnotation is the annotation on the dump ConfigMap that is set if some of the
+	// NGINX configuration files were left out because the ConfigMap would exceed maxNginxConfigDumpSize.
+	nginxConfigTruncatedAnnotation = "gateway.nginx.org/nginx-config-truncated"
+	// configurationSchemaVersionAnnotation is the annotation on the dump ConfigMap holding the schema version of
+	// the snapshot of the data plane configuration it contains.
+	configurationSchemaVersionAnnotation = "gateway.nginx.org/configuration-schema-version"
+
+	// configurationSnapshotKey is the key of the snapshot of the data plane configuration in the dump ConfigMap.
+	configurationSnapshotKey = "configuration-snapshot.json"
+
+	// maxNginxConfigDumpSize is the maximum size of the data in the dump ConfigMap.
+	// It leaves room for the metadata below the 1MiB limit of a Kubernetes object.
+	maxNginxConfigDumpSize = 900 * 1024
+
+	// redactedContent replaces the content of secret files in the dump ConfigMap.
+	redactedContent = "<redacted>"
+)
+
+// nginxConfigDumper publishes the NGINX configuration files to a ConfigMap, so that users without exec access
+// to the NGINX container can inspect the configuration NGINX is running.
+// All replicas share the ConfigMap, so it is only written by the leader. The configurations are queued, so that
+// the handler is not blocked by the writes, and only the latest queued configuration is written.
+type nginxConfigDumper struct {
+	k8sClient client.Client
+	logger    logr.Logger
+	// pending is the latest queued configuration that is not written yet.
+	pending *nginxConfigDump
+	// notifyCh notifies the writer that a configuration is queued.
+	notifyCh chan struct{}
+	nsName   types.NamespacedName
+	lock     sync.Mutex
+}
+
+// nginxConfigDump is a configuration queued by the nginxConfigDumper.
+type nginxConfigDump struct {
+	files []file.File
+	conf  dataplane.Configuration
+}
+
+func newNginxConfigDumper(
+	k8sClient client.Client,
+	nsName types.NamespacedName,
+	logger logr.Logger,
+) *nginxConfigDumper {
+	return &nginxConfigDumper{
+		k8sClient: k8sClient,
+		nsName:    nsName,
+		logger:    logger,
+		notifyCh:  make(chan struct{}, 1),
+	}
+}
+
+// dump queues the files and the data plane configuration they were generated from, replacing the queued
+// configuration that is not written yet. It doesn't block.
+func (d *nginxConfigDumper) dump(files []file.File, conf dataplane.Configuration) {
+	d.lock.Lock()
+	d.pending = &nginxConfigDump{files: files, conf: conf}
+	d.lock.Unlock()
+
+	select {
+	case d.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start writes the queued configurations to the ConfigMap until the context is canceled.
+// It must only run on the leader.
+func (d *nginxConfigDumper) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.notifyCh:
+			d.lock.Lock()
+			pending := d.pending
+			d.pending = nil
+			d.lock.Unlock()
+
+			if pending != nil {
+				d.write(ctx, pending.files, pending.conf)
+			}
+		}
+	}
+}
+
+// write writes the files and the snapshot of the data plane configuration they were generated from
+// to the ConfigMap. The content of secret files is redacted.
+// Errors are logged rather than returned, since failing to publish the configuration does not affect NGINX.
+func (d *nginxConfigDumper) write(ctx context.Context, files []file.File, conf dataplane.Configuration) {
+	snapshotData, err := snapshot.Marshal(conf)
+	if err != nil {
+		d.logger.Error(err, "Failed to build the snapshot of the configuration")
+	}
+
+	data, truncated := buildNginxConfigDumpData(files, snapshotData)
+
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.nsName.Name,
+			Namespace: d.nsName.Namespace,
+		},
+	}
+
+	res, err := controllerutil.CreateOrUpdate(ctx, d.k8sClient, cm, func() error {
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string)
+		}
+
+		cm.Annotations[nginxConfigVersionAnnotation] = strconv.Itoa(conf.Version)
+		if _, ok := data[configurationSnapshotKey]; ok {
+			cm.Annotations[configurationSchemaVersionAnnotation] = snapshot.SchemaVersion
+		} else {
+			delete(cm.Annotations, configurationSchemaVersionAnnotation)
+		}
+
+		if truncated {
+			cm.Annotations[nginxConfigTruncatedAnnotation] = "true"
+		} else {
+			delete(cm.Annotations, nginxConfigTruncatedAnnotation)
+		}
+
+		cm.Data = data
+
+		return nil
+	})
+	if err != nil {
+		d.logger.Error(err, "Failed to publish NGINX configuration", "configMap", d.nsName.String())
+		return
+	}
+
+	d.logger.V(1).Info(
+		"Published NGINX configuration",
+		"configMap", d.nsName.String(),
+		"version", conf.Version,
+		"result", res,
+		"truncated", truncated,
+	)
+}
+
+// buildNginxConfigDumpData returns the ConfigMap data for the files, keyed by the file path, and the snapshot of
+// the data plane configuration, keyed by configurationSnapshotKey. The snapshot is added first, if not empty, then
+// the files are added in order of their paths until maxNginxConfigDumpSize is reached; the remaining files are
+// left out, and truncated is set to true.
+func buildNginxConfigDumpData(files []file.File, snapshotData []byte) (data map[string]string, truncated bool) {
+	sorted := slices.Clone(files)
+	slices.SortFunc(sorted, func(a, b file.File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	data = make(map[string]string, len(sorted)+1)
+	size := 0
+
+	if len(snapshotData) > 0 {
+		if len(configurationSnapshotKey)+len(snapshotData) > maxNginxConfigDumpSize {
+			truncated = true
+		} else {
+			data[configurationSnapshotKey] = string(snapshotData)
+			size += len(configurationSnapshotKey) + len(snapshotData)
+		}
+	}
+
+	for _, f := range sorted {
+		key := nginxConfigDumpKey(f.Path)
+
+		content := string(f.Content)
+		if f.Type == file.TypeSecret {
+			content = redactedContent
+		}
+
+		if size+len(key)+len(content) > maxNginxConfigDumpSize {
+			truncated = true
+			continue
+		}
+
+		data[key] = content
+		size += len(key) + len(content)
+	}
+
+	return data, truncated
+}
+
+// nginxConfigDumpKey converts the file path into a valid ConfigMap key.
+// For example, /etc/nginx/conf.d/http.conf becomes etc_nginx_conf.d_http.conf.
+func nginxConfigDumpKey(path string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", "_")
+}
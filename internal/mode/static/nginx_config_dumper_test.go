@@ -0,0 +1,192 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane/snapshot"
+)
+
+func TestBuildNginxConfigDumpData(t *testing.T) {
+	t.Parallel()
+
+	httpConf := file.File{
+		Path:    "/etc/nginx/conf.d/http.conf",
+		Content: []byte("server {}"),
+		Type:    file.TypeRegular,
+	}
+	streamConf := file.File{
+		Path:    "/etc/nginx/stream-conf.d/stream.conf",
+		Content: []byte("upstream {}"),
+		Type:    file.TypeRegular,
+	}
+	secret := file.File{
+		Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+		Content: []byte("private key"),
+		Type:    file.TypeSecret,
+	}
+	large := file.File{
+		Path:    "/etc/nginx/includes/large.conf",
+		Content: []byte(strings.Repeat("a", maxNginxConfigDumpSize)),
+		Type:    file.TypeRegular,
+	}
+
+	tests := []struct {
+		expData      map[string]string
+		name         string
+		files        []file.File
+		snapshot     []byte
+		expTruncated bool
+	}{
+		{
+			name:    "no files",
+			expData: map[string]string{},
+		},
+		{
+			name:  "regular and secret files",
+			files: []file.File{streamConf, secret, httpConf},
+			expData: map[string]string{
+				"etc_nginx_conf.d_http.conf":                    "server {}",
+				"etc_nginx_stream-conf.d_stream.conf":           "upstream {}",
+				"etc_nginx_secrets_ssl_keypair_test_secret.pem": redactedContent,
+			},
+		},
+		{
+			name:  "files exceeding the maximum size are left out",
+			files: []file.File{httpConf, large, streamConf},
+			expData: map[string]string{
+				"etc_nginx_conf.d_http.conf":          "server {}",
+				"etc_nginx_stream-conf.d_stream.conf": "upstream {}",
+			},
+			expTruncated: true,
+		},
+		{
+			name:     "snapshot is added first",
+			files:    []file.File{httpConf, large},
+			snapshot: []byte(`{"schemaVersion":"v1"}`),
+			expData: map[string]string{
+				configurationSnapshotKey:     `{"schemaVersion":"v1"}`,
+				"etc_nginx_conf.d_http.conf": "server {}",
+			},
+			expTruncated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			data, truncated := buildNginxConfigDumpData(test.files, test.snapshot)
+			g.Expect(data).To(Equal(test.expData))
+			g.Expect(truncated).To(Equal(test.expTruncated))
+		})
+	}
+}
+
+func TestNginxConfigDumperWrite(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	cmNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-config"}
+	existing := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cmNsName.Namespace,
+			Name:      cmNsName.Name,
+			Annotations: map[string]string{
+				nginxConfigTruncatedAnnotation: "true",
+			},
+		},
+		Data: map[string]string{
+			"etc_nginx_conf.d_old.conf": "old",
+		},
+	}
+
+	fakeClient := fake.NewFakeClient(existing)
+	dumper := newNginxConfigDumper(fakeClient, cmNsName, logr.Discard())
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("server {}"),
+			Type:    file.TypeRegular,
+		},
+	}
+
+	conf := dataplane.Configuration{Version: 3}
+
+	expSnapshot, err := snapshot.Marshal(conf)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	dumper.write(context.Background(), files, conf)
+
+	cm := &v1.ConfigMap{}
+	g.Expect(fakeClient.Get(context.Background(), cmNsName, cm)).To(Succeed())
+	g.Expect(cm.Annotations).To(Equal(map[string]string{
+		nginxConfigVersionAnnotation:         "3",
+		configurationSchemaVersionAnnotation: snapshot.SchemaVersion,
+	}))
+	g.Expect(cm.Data).To(Equal(map[string]string{
+		"etc_nginx_conf.d_http.conf": "server {}",
+		configurationSnapshotKey:     string(expSnapshot),
+	}))
+}
+
+func TestNginxConfigDumperStart(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	cmNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-config"}
+
+	fakeClient := fake.NewFakeClient()
+	dumper := newNginxConfigDumper(fakeClient, cmNsName, logr.Discard())
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("server {}"),
+			Type:    file.TypeRegular,
+		},
+	}
+
+	// the configurations are queued before this instance becomes the leader, so only the latest one is written
+	dumper.dump(files, dataplane.Configuration{Version: 1})
+	dumper.dump(files, dataplane.Configuration{Version: 2})
+
+	cm := &v1.ConfigMap{}
+	g.Expect(fakeClient.Get(context.Background(), cmNsName, cm)).ToNot(Succeed())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- dumper.Start(ctx)
+	}()
+
+	getVersion := func() string {
+		cm := &v1.ConfigMap{}
+		if err := fakeClient.Get(context.Background(), cmNsName, cm); err != nil {
+			return ""
+		}
+
+		return cm.Annotations[nginxConfigVersionAnnotation]
+	}
+
+	g.Eventually(getVersion).Should(Equal("2"))
+
+	dumper.dump(files, dataplane.Configuration{Version: 3})
+	g.Eventually(getVersion).Should(Equal("3"))
+
+	cancel()
+	g.Eventually(errCh).Should(Receive(BeNil()))
+}
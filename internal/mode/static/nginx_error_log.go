@@ -0,0 +1,111 @@
+package static
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/errorlog"
+)
+
+// nginxErrorEventInterval is the minimum interval between the Warning events of the errors of the same kind,
+// so that a burst of errors doesn't flood the Gateway with events.
+const nginxErrorEventInterval = time.Minute
+
+type nginxErrorMetricsCollector interface {
+	IncNginxErrors(kind string)
+}
+
+// nginxErrorLogMonitor handles the errors of the known kinds in the NGINX error log. It logs the errors
+// as structured logs, counts them in a metric, and optionally emits Warning events for the Gateway.
+type nginxErrorLogMonitor struct {
+	graphGetter      graphGetter
+	metricsCollector nginxErrorMetricsCollector
+	// eventRecorder is nil if the Warning events are disabled.
+	eventRecorder record.EventRecorder
+	// lastEvents holds the time of the last Warning event of every kind.
+	lastEvents map[errorlog.Kind]time.Time
+	logger     logr.Logger
+}
+
+func newNginxErrorLogMonitor(
+	graphGetter graphGetter,
+	metricsCollector nginxErrorMetricsCollector,
+	eventRecorder record.EventRecorder,
+	logger logr.Logger,
+) *nginxErrorLogMonitor {
+	return &nginxErrorLogMonitor{
+		graphGetter:      graphGetter,
+		metricsCollector: metricsCollector,
+		eventRecorder:    eventRecorder,
+		lastEvents:       make(map[errorlog.Kind]time.Time),
+		logger:           logger,
+	}
+}
+
+// handle handles an error of the NGINX error log. It is called by the errorlog.Listener from a single goroutine.
+func (m *nginxErrorLogMonitor) handle(entry errorlog.Entry) {
+	m.record(entry, time.Now())
+}
+
+func (m *nginxErrorLogMonitor) record(entry errorlog.Entry, now time.Time) {
+	m.logger.Info(
+		"NGINX error",
+		"kind", entry.Kind,
+		"level", entry.Level,
+		"message", entry.Message,
+		"client", entry.Client,
+		"server", entry.Server,
+		"request", entry.Request,
+		"upstream", entry.Upstream,
+		"host", entry.Host,
+		"zone", entry.Zone,
+	)
+
+	m.metricsCollector.IncNginxErrors(string(entry.Kind))
+
+	if m.eventRecorder == nil {
+		return
+	}
+
+	gr := m.graphGetter.GetLatestGraph()
+	if gr == nil || gr.Gateway == nil {
+		return
+	}
+
+	if last, exists := m.lastEvents[entry.Kind]; exists && now.Sub(last) < nginxErrorEventInterval {
+		return
+	}
+
+	m.lastEvents[entry.Kind] = now
+	m.eventRecorder.Event(gr.Gateway.Source, v1.EventTypeWarning, string(entry.Kind), nginxErrorEventMessage(entry))
+}
+
+// nginxErrorEventMessage returns the message of the Warning event of an error, which includes the non-empty
+// fields of the context of the error.
+func nginxErrorEventMessage(entry errorlog.Entry) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "NGINX error: %s", entry.Message)
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{name: "client", value: entry.Client},
+		{name: "server", value: entry.Server},
+		{name: "request", value: entry.Request},
+		{name: "upstream", value: entry.Upstream},
+		{name: "host", value: entry.Host},
+	} {
+		if field.value != "" {
+			fmt.Fprintf(&b, ", %s: %s", field.name, field.value)
+		}
+	}
+
+	return b.String()
+}
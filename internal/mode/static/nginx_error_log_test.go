@@ -0,0 +1,123 @@
+package static
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/errorlog"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+type nginxErrorsRecorder struct {
+	counts map[string]int
+}
+
+func (r *nginxErrorsRecorder) IncNginxErrors(kind string) {
+	r.counts[kind]++
+}
+
+func TestNginxErrorLogMonitor(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	gr := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+			},
+		},
+	}
+
+	timedOut := errorlog.Entry{
+		Kind:     errorlog.KindUpstreamTimedOut,
+		Level:    "error",
+		Message:  "upstream timed out (110: Connection timed out) while reading response header from upstream",
+		Client:   "10.0.0.1",
+		Server:   "cafe.example.com",
+		Request:  "GET /coffee HTTP/1.1",
+		Upstream: "http://10.244.0.5:8080/coffee",
+		Host:     "cafe.example.com",
+	}
+	limited := errorlog.Entry{
+		Kind:    errorlog.KindLimitingRequests,
+		Level:   "error",
+		Message: `limiting requests, excess: 10.500 by zone "default_rl"`,
+		Zone:    "default_rl",
+	}
+
+	metricsRecorder := &nginxErrorsRecorder{counts: make(map[string]int)}
+	eventRecorder := record.NewFakeRecorder(10)
+
+	monitor := newNginxErrorLogMonitor(&latestGraphGetter{graph: gr}, metricsRecorder, eventRecorder, logr.Discard())
+
+	monitor.record(timedOut, now)
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	g.Expect(<-eventRecorder.Events).To(Equal(
+		"Warning UpstreamTimedOut NGINX error: upstream timed out (110: Connection timed out) while reading " +
+			"response header from upstream, client: 10.0.0.1, server: cafe.example.com, " +
+			"request: GET /coffee HTTP/1.1, upstream: http://10.244.0.5:8080/coffee, host: cafe.example.com",
+	))
+
+	// the errors of the same kind don't emit another Warning event within the interval
+	monitor.record(timedOut, now.Add(time.Second))
+	g.Expect(eventRecorder.Events).To(BeEmpty())
+
+	// the errors of a different kind emit their own Warning event
+	monitor.record(limited, now.Add(time.Second))
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	g.Expect(<-eventRecorder.Events).To(Equal(
+		`Warning LimitingRequests NGINX error: limiting requests, excess: 10.500 by zone "default_rl"`,
+	))
+
+	monitor.record(timedOut, now.Add(nginxErrorEventInterval))
+	g.Expect(eventRecorder.Events).To(HaveLen(1))
+	<-eventRecorder.Events
+
+	g.Expect(metricsRecorder.counts).To(Equal(map[string]int{
+		"UpstreamTimedOut": 3,
+		"LimitingRequests": 1,
+	}))
+}
+
+func TestNginxErrorLogMonitor_EventsDisabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gr := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &gatewayv1.Gateway{},
+		},
+	}
+
+	metricsRecorder := &nginxErrorsRecorder{counts: make(map[string]int)}
+
+	monitor := newNginxErrorLogMonitor(&latestGraphGetter{graph: gr}, metricsRecorder, nil, logr.Discard())
+
+	monitor.record(errorlog.Entry{Kind: errorlog.KindSSLHandshakeFailed}, time.Now())
+
+	g.Expect(metricsRecorder.counts).To(Equal(map[string]int{"SSLHandshakeFailed": 1}))
+	g.Expect(monitor.lastEvents).To(BeEmpty())
+}
+
+func TestNginxErrorLogMonitor_NoGateway(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	metricsRecorder := &nginxErrorsRecorder{counts: make(map[string]int)}
+	eventRecorder := record.NewFakeRecorder(1)
+
+	monitor := newNginxErrorLogMonitor(&latestGraphGetter{}, metricsRecorder, eventRecorder, logr.Discard())
+
+	monitor.record(errorlog.Entry{Kind: errorlog.KindLimitingConnections}, time.Now())
+
+	g.Expect(metricsRecorder.counts).To(Equal(map[string]int{"LimitingConnections": 1}))
+	g.Expect(eventRecorder.Events).To(BeEmpty())
+}
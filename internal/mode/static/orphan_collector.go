@@ -0,0 +1,154 @@
+package static
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// The kinds of the orphans, used as the label of the metric of the collected orphans.
+const (
+	orphanKindFile           = "file"
+	orphanKindUpstream       = "upstream"
+	orphanKindStreamUpstream = "stream_upstream"
+)
+
+type orphanMetricsCollector interface {
+	AddCollectedOrphans(kind string, count int)
+}
+
+// orphanCollector removes the generated files and the servers of the NGINX Plus upstreams that no longer correspond
+// to the configuration, for example, because the control plane crashed in the middle of applying a configuration.
+type orphanCollector struct {
+	fileMgr          file.ClearFoldersOSFileManager
+	nginxRuntimeMgr  runtime.Manager
+	metricsCollector orphanMetricsCollector
+	logger           logr.Logger
+	folders          []string
+	plus             bool
+}
+
+func newOrphanCollector(
+	fileMgr file.ClearFoldersOSFileManager,
+	nginxRuntimeMgr runtime.Manager,
+	metricsCollector orphanMetricsCollector,
+	logger logr.Logger,
+	folders []string,
+	plus bool,
+) *orphanCollector {
+	return &orphanCollector{
+		fileMgr:          fileMgr,
+		nginxRuntimeMgr:  nginxRuntimeMgr,
+		metricsCollector: metricsCollector,
+		logger:           logger,
+		folders:          folders,
+		plus:             plus,
+	}
+}
+
+// collect removes the orphans of the files and the configuration that were applied.
+// Errors are logged rather than returned, since the orphans don't affect the applied configuration.
+func (c *orphanCollector) collect(files []file.File, conf dataplane.Configuration) {
+	removedFiles, err := file.RemoveOrphanedFiles(c.fileMgr, c.folders, files)
+	if err != nil {
+		c.logger.Error(err, "Failed to remove orphaned files")
+	}
+
+	for _, path := range removedFiles {
+		c.logger.Info("Removed orphaned file", "path", path)
+	}
+
+	c.metricsCollector.AddCollectedOrphans(orphanKindFile, len(removedFiles))
+
+	if !c.plus || conf.NginxPlus.UpstreamServersInConfig {
+		return
+	}
+
+	if err := c.collectUpstreamServers(conf); err != nil {
+		c.logger.Error(err, "Failed to remove the servers of orphaned upstreams")
+	}
+}
+
+// collectUpstreamServers removes the servers of the upstreams that NGINX Plus reports, but that are not in
+// the configuration. Only the upstreams that are named like the upstreams of the Services are collected, so that
+// the upstreams that users define in snippets are left alone.
+func (c *orphanCollector) collectUpstreamServers(conf dataplane.Configuration) error {
+	upstreams, streamUpstreams, err := c.nginxRuntimeMgr.GetUpstreams()
+	if err != nil {
+		return fmt.Errorf("failed to get upstreams from API: %w", err)
+	}
+
+	var collectErr error
+
+	var collected int
+	for name, upstream := range upstreams {
+		if !isOrphanedUpstream(name, len(upstream.Peers), conf.Upstreams) {
+			continue
+		}
+
+		if err := c.nginxRuntimeMgr.UpdateHTTPServers(name, []ngxclient.UpstreamServer{}); err != nil {
+			collectErr = errors.Join(collectErr, fmt.Errorf("couldn't remove servers of upstream %q: %w", name, err))
+			continue
+		}
+
+		c.logger.Info("Removed the servers of orphaned upstream", "upstream", name)
+		collected++
+	}
+
+	c.metricsCollector.AddCollectedOrphans(orphanKindUpstream, collected)
+
+	collected = 0
+	for name, upstream := range streamUpstreams {
+		if !isOrphanedUpstream(name, len(upstream.Peers), conf.StreamUpstreams) {
+			continue
+		}
+
+		if err := c.nginxRuntimeMgr.UpdateStreamServers(name, []ngxclient.StreamUpstreamServer{}); err != nil {
+			collectErr = errors.Join(collectErr, fmt.Errorf("couldn't remove servers of stream upstream %q: %w", name, err))
+			continue
+		}
+
+		c.logger.Info("Removed the servers of orphaned stream upstream", "upstream", name)
+		collected++
+	}
+
+	c.metricsCollector.AddCollectedOrphans(orphanKindStreamUpstream, collected)
+
+	return collectErr
+}
+
+// isOrphanedUpstream returns true if the upstream with servers is not among the upstreams of the configuration
+// and is named like the upstreams of Services, which is <namespace>_<name>_<port>.
+func isOrphanedUpstream(name string, servers int, upstreams []dataplane.Upstream) bool {
+	if servers == 0 {
+		return false
+	}
+
+	for _, u := range upstreams {
+		if u.Name == name {
+			return false
+		}
+	}
+
+	parts := strings.Split(name, "_")
+	if len(parts) != 3 {
+		return false
+	}
+
+	if len(validation.IsDNS1123Label(parts[0])) > 0 || len(validation.IsDNS1123Label(parts[1])) > 0 {
+		return false
+	}
+
+	_, err := strconv.ParseUint(parts[2], 10, 16)
+
+	return err == nil
+}
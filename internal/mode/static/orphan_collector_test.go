@@ -0,0 +1,199 @@
+package static
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+type orphansRecorder struct {
+	counts map[string]int
+}
+
+func (r *orphansRecorder) AddCollectedOrphans(kind string, count int) {
+	r.counts[kind] += count
+}
+
+func TestIsOrphanedUpstream(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []dataplane.Upstream{{Name: "test_coffee_80"}}
+
+	tests := []struct {
+		name     string
+		upstream string
+		servers  int
+		expected bool
+	}{
+		{
+			name:     "upstream of a Service that is not in the configuration",
+			upstream: "test_tea_80",
+			servers:  1,
+			expected: true,
+		},
+		{
+			name:     "upstream in the configuration",
+			upstream: "test_coffee_80",
+			servers:  1,
+		},
+		{
+			name:     "upstream without servers",
+			upstream: "test_tea_80",
+		},
+		{
+			name:     "upstream defined in a snippet",
+			upstream: "my_backend",
+			servers:  1,
+		},
+		{
+			name:     "upstream with an invalid port",
+			upstream: "test_tea_http",
+			servers:  1,
+		},
+		{
+			name:     "upstream with an invalid namespace",
+			upstream: "Test_tea_80",
+			servers:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(isOrphanedUpstream(test.upstream, test.servers, upstreams)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestOrphanCollectorCollect(t *testing.T) {
+	t.Parallel()
+
+	createRuntimeMgr := func() *runtimefakes.FakeManager {
+		fakeRuntimeMgr := &runtimefakes.FakeManager{}
+		fakeRuntimeMgr.GetUpstreamsReturns(
+			ngxclient.Upstreams{
+				"test_coffee_80": {Peers: []ngxclient.Peer{{Server: "10.0.0.1:80"}}},
+				"test_tea_80":    {Peers: []ngxclient.Peer{{Server: "10.0.0.2:80"}}},
+			},
+			ngxclient.StreamUpstreams{
+				"test_db_5432": {Peers: []ngxclient.StreamPeer{{Server: "10.0.0.3:5432"}}},
+			},
+			nil,
+		)
+
+		return fakeRuntimeMgr
+	}
+
+	conf := dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{{Name: "test_coffee_80"}},
+	}
+
+	tests := []struct {
+		runtimeMgr           *runtimefakes.FakeManager
+		expCounts            map[string]int
+		name                 string
+		conf                 dataplane.Configuration
+		expHTTPUpdateCount   int
+		expStreamUpdateCount int
+		plus                 bool
+	}{
+		{
+			name:       "OSS",
+			runtimeMgr: createRuntimeMgr(),
+			conf:       conf,
+			expCounts:  map[string]int{orphanKindFile: 1},
+		},
+		{
+			name:       "Plus",
+			runtimeMgr: createRuntimeMgr(),
+			conf:       conf,
+			plus:       true,
+			expCounts: map[string]int{
+				orphanKindFile:           1,
+				orphanKindUpstream:       1,
+				orphanKindStreamUpstream: 1,
+			},
+			expHTTPUpdateCount:   1,
+			expStreamUpdateCount: 1,
+		},
+		{
+			name:       "Plus with the upstream servers in the configuration",
+			runtimeMgr: createRuntimeMgr(),
+			conf: dataplane.Configuration{
+				Upstreams: conf.Upstreams,
+				NginxPlus: dataplane.NginxPlus{UpstreamServersInConfig: true},
+			},
+			plus:      true,
+			expCounts: map[string]int{orphanKindFile: 1},
+		},
+		{
+			name: "Plus with a failing API",
+			runtimeMgr: func() *runtimefakes.FakeManager {
+				fakeRuntimeMgr := createRuntimeMgr()
+				fakeRuntimeMgr.UpdateHTTPServersReturns(errors.New("test error"))
+				return fakeRuntimeMgr
+			}(),
+			conf: conf,
+			plus: true,
+			expCounts: map[string]int{
+				orphanKindFile:           1,
+				orphanKindUpstream:       0,
+				orphanKindStreamUpstream: 1,
+			},
+			expHTTPUpdateCount:   1,
+			expStreamUpdateCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+			httpConf := filepath.Join(dir, "http.conf")
+			orphanedConf := filepath.Join(dir, "orphaned.conf")
+
+			for _, path := range []string{httpConf, orphanedConf} {
+				//nolint:gosec // the file permission is ok for unit testing
+				g.Expect(os.WriteFile(path, []byte("server {}"), 0o644)).To(Succeed())
+			}
+
+			recorder := &orphansRecorder{counts: make(map[string]int)}
+			collector := newOrphanCollector(
+				file.NewStdLibOSFileManager(),
+				test.runtimeMgr,
+				recorder,
+				logr.Discard(),
+				[]string{dir},
+				test.plus,
+			)
+
+			collector.collect([]file.File{{Path: httpConf, Type: file.TypeRegular}}, test.conf)
+
+			g.Expect(recorder.counts).To(Equal(test.expCounts))
+			g.Expect(httpConf).To(BeAnExistingFile())
+			g.Expect(orphanedConf).ToNot(BeAnExistingFile())
+
+			g.Expect(test.runtimeMgr.UpdateHTTPServersCallCount()).To(Equal(test.expHTTPUpdateCount))
+			if test.expHTTPUpdateCount > 0 {
+				name, servers := test.runtimeMgr.UpdateHTTPServersArgsForCall(0)
+				g.Expect(name).To(Equal("test_tea_80"))
+				g.Expect(servers).To(BeEmpty())
+			}
+
+			g.Expect(test.runtimeMgr.UpdateStreamServersCallCount()).To(Equal(test.expStreamUpdateCount))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Config is the configuration of the Checker.
+type Config struct {
+	// K8sReader reads the CustomResourceDefinitions and the custom resources from the cluster.
+	K8sReader client.Reader
+	// InstalledConfiguration is the NGINX configuration generated by the installed version, in the JSON schema
+	// of the rendered configuration debug endpoint. If empty, the configurations are not compared.
+	InstalledConfiguration []byte
+	// TargetConfiguration is the NGINX configuration generated by the target version, in the JSON schema
+	// of the rendered configuration debug endpoint. If empty, the configurations are not compared.
+	TargetConfiguration []byte
+	// TargetVersion is the target version of NGINX Gateway Fabric.
+	TargetVersion string
+	// Group is the API group of the NGINX Gateway Fabric CRDs.
+	Group string
+	// TargetCRDs are the NGINX Gateway Fabric CRDs of the target version.
+	TargetCRDs []apiext.CustomResourceDefinition
+}
+
+// Checker runs the preflight checks.
+type Checker struct {
+	cfg Config
+}
+
+// NewChecker creates a new Checker.
+func NewChecker(cfg Config) *Checker {
+	return &Checker{cfg: cfg}
+}
+
+// Run runs the preflight checks and returns their Report. It returns an error if the state of the cluster
+// or the configurations cannot be read.
+func (c *Checker) Run(ctx context.Context) (Report, error) {
+	var crds apiext.CustomResourceDefinitionList
+	if err := c.cfg.K8sReader.List(ctx, &crds); err != nil {
+		return Report{}, fmt.Errorf("error listing CustomResourceDefinitions: %w", err)
+	}
+
+	findings := checkGatewayAPIVersion(crds.Items)
+
+	crdFindings, err := c.checkCRDs(ctx, crds.Items)
+	if err != nil {
+		return Report{}, err
+	}
+	findings = append(findings, crdFindings...)
+
+	if len(c.cfg.InstalledConfiguration) > 0 && len(c.cfg.TargetConfiguration) > 0 {
+		configFindings, err := compareConfigurations(c.cfg.InstalledConfiguration, c.cfg.TargetConfiguration)
+		if err != nil {
+			return Report{}, err
+		}
+		findings = append(findings, configFindings...)
+	}
+
+	report := Report{
+		SchemaVersion: SchemaVersion,
+		TargetVersion: c.cfg.TargetVersion,
+		Findings:      findings,
+		Passed: !slices.ContainsFunc(findings, func(f Finding) bool {
+			return f.Severity == SeverityError
+		}),
+	}
+
+	if report.Findings == nil {
+		report.Findings = make([]Finding, 0)
+	}
+
+	return report, nil
+}
+
+// checkCRDs compares the installed NGINX Gateway Fabric CRDs with the target ones.
+func (c *Checker) checkCRDs(ctx context.Context, installed []apiext.CustomResourceDefinition) ([]Finding, error) {
+	installedCRDs := make(map[string]*apiext.CustomResourceDefinition)
+	for i := range installed {
+		if installed[i].Spec.Group == c.cfg.Group {
+			installedCRDs[installed[i].Name] = &installed[i]
+		}
+	}
+
+	var findings []Finding
+
+	for i := range c.cfg.TargetCRDs {
+		target := &c.cfg.TargetCRDs[i]
+
+		// the CRDs that are new in the target version have no objects yet
+		current, exists := installedCRDs[target.Name]
+		if !exists {
+			continue
+		}
+
+		findings = append(findings, checkDeprecatedVersions(current, target)...)
+
+		objectFindings, err := checkObjects(ctx, c.cfg.K8sReader, current, target)
+		if err != nil {
+			return nil, fmt.Errorf("error checking the objects of %s: %w", target.Name, err)
+		}
+		findings = append(findings, objectFindings...)
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,202 @@
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func createGatewayAPICRD(bundleVersion string) *apiext.CustomResourceDefinition {
+	return &apiext.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "httproutes.gateway.networking.k8s.io",
+			Annotations: map[string]string{"gateway.networking.k8s.io/bundle-version": bundleVersion},
+		},
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Group: gatewayAPIGroup,
+		},
+	}
+}
+
+func createNginxProxyCRD(ipFamilyDefault, serviceNameDescription string) *apiext.CustomResourceDefinition {
+	return &apiext.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginxproxies.gateway.nginx.org",
+		},
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Group: ngfAPIv1alpha1.GroupName,
+			Names: apiext.CustomResourceDefinitionNames{
+				Kind:     "NginxProxy",
+				ListKind: "NginxProxyList",
+			},
+			Versions: []apiext.CustomResourceDefinitionVersion{
+				{
+					Name:   "v1alpha0",
+					Served: true,
+				},
+				{
+					Name:    "v1alpha1",
+					Served:  true,
+					Storage: true,
+					Schema: &apiext.CustomResourceValidation{
+						OpenAPIV3Schema: &apiext.JSONSchemaProps{
+							Properties: map[string]apiext.JSONSchemaProps{
+								"spec": {
+									Properties: map[string]apiext.JSONSchemaProps{
+										"ipFamily": {
+											Default: &apiext.JSON{Raw: []byte(`"` + ipFamilyDefault + `"`)},
+										},
+										"telemetry": {
+											Properties: map[string]apiext.JSONSchemaProps{
+												"serviceName": {Description: serviceNameDescription},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: apiext.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1alpha0", "v1alpha1"},
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+	g.Expect(ngfAPIv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	installedCRD := createNginxProxyCRD("dual", "ServiceName is the service name.")
+
+	targetCRD := createNginxProxyCRD("ipv4", "Deprecated: use the resource attributes.")
+	targetCRD.Spec.Versions[0].Deprecated = true
+	targetCRD.Spec.Versions[0].DeprecationWarning = helpers.GetPointer("v1alpha0 is deprecated")
+
+	objects := []client.Object{
+		createGatewayAPICRD("v1.1.0"),
+		installedCRD,
+		&ngfAPIv1alpha1.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "default-ip-family"},
+			Spec: ngfAPIv1alpha1.NginxProxySpec{
+				Telemetry: &ngfAPIv1alpha1.Telemetry{ServiceName: helpers.GetPointer("my-svc")},
+			},
+		},
+		&ngfAPIv1alpha1.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "explicit-ip-family"},
+			Spec: ngfAPIv1alpha1.NginxProxySpec{
+				IPFamily: helpers.GetPointer(ngfAPIv1alpha1.IPv4),
+			},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	checker := NewChecker(Config{
+		K8sReader:              k8sClient,
+		InstalledConfiguration: []byte(`{"files": [{"path": "/etc/nginx/conf.d/http.conf", "content": "a\nb"}]}`),
+		TargetConfiguration:    []byte(`{"files": [{"path": "/etc/nginx/conf.d/http.conf", "content": "a\nc\nd"}]}`),
+		TargetVersion:          "2.0.0",
+		Group:                  ngfAPIv1alpha1.GroupName,
+		TargetCRDs:             []apiext.CustomResourceDefinition{*targetCRD},
+	})
+
+	report, err := checker.Run(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	nginxProxyRef := &ObjectReference{Kind: "NginxProxy", Namespace: "test", Name: "default-ip-family"}
+
+	g.Expect(report).To(Equal(Report{
+		SchemaVersion: SchemaVersion,
+		TargetVersion: "2.0.0",
+		Findings: []Finding{
+			{
+				Check:    CheckGatewayAPIVersion,
+				Severity: SeverityWarning,
+				Message:  "Gateway API CRD versions are not recommended. Recommended version is v1.2.1",
+			},
+			{
+				Object:   &ObjectReference{Kind: "CustomResourceDefinition", Name: "nginxproxies.gateway.nginx.org"},
+				Check:    CheckDeprecatedVersion,
+				Severity: SeverityWarning,
+				Message: "objects are stored in the deprecated version v1alpha0: v1alpha0 is deprecated; " +
+					"run the migrate-storage-versions command",
+			},
+			{
+				Object:   nginxProxyRef,
+				Check:    CheckDefaultChange,
+				Severity: SeverityWarning,
+				Field:    "spec.ipFamily",
+				Message:  `the field is not set, and its default changes from "dual" to "ipv4" in the target version`,
+			},
+			{
+				Object:   nginxProxyRef,
+				Check:    CheckDeprecatedField,
+				Severity: SeverityWarning,
+				Field:    "spec.telemetry.serviceName",
+				Message:  "the field is deprecated in the target version",
+			},
+			{
+				Check:    CheckConfigurationChange,
+				Severity: SeverityInfo,
+				File:     "/etc/nginx/conf.d/http.conf",
+				Message:  "the target version changes the file: 2 lines added, 1 lines removed",
+			},
+		},
+		Passed: true,
+	}))
+}
+
+func TestRun_UnsupportedGatewayAPIVersion(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(createGatewayAPICRD("v2.0.0")).Build()
+
+	report, err := NewChecker(Config{K8sReader: k8sClient}).Run(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(report.Passed).To(BeFalse())
+	g.Expect(report.Findings).To(Equal([]Finding{
+		{
+			Check:    CheckGatewayAPIVersion,
+			Severity: SeverityError,
+			Message:  "Gateway API CRD versions are not supported. Please install version v1.2.1",
+		},
+	}))
+}
+
+func TestRun_NoGatewayAPI(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiext.AddToScheme(scheme)).To(Succeed())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	report, err := NewChecker(Config{K8sReader: k8sClient}).Run(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(report.Passed).To(BeFalse())
+	g.Expect(report.Findings).To(HaveLen(1))
+	g.Expect(report.Findings[0].Message).To(Equal("Gateway API CRDs are not installed; install version v1.2.1"))
+}
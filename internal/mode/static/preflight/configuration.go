@@ -0,0 +1,110 @@
+package preflight
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// renderedConfiguration is the NGINX configuration in the JSON schema of the rendered configuration
+// debug endpoint of the controller.
+type renderedConfiguration struct {
+	Files []renderedFile `json:"files"`
+}
+
+type renderedFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// compareConfigurations reports the NGINX configuration files that the target version adds, removes or changes.
+func compareConfigurations(installedData, targetData []byte) ([]Finding, error) {
+	installed, err := parseRenderedConfiguration(installedData)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the installed configuration: %w", err)
+	}
+
+	target, err := parseRenderedConfiguration(targetData)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the target configuration: %w", err)
+	}
+
+	paths := make([]string, 0, len(installed)+len(target))
+	for path := range installed {
+		paths = append(paths, path)
+	}
+	for path := range target {
+		if _, exists := installed[path]; !exists {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var findings []Finding
+
+	for _, path := range paths {
+		installedContent, inInstalled := installed[path]
+		targetContent, inTarget := target[path]
+
+		var msg string
+
+		switch {
+		case !inInstalled:
+			msg = "the target version adds the file"
+		case !inTarget:
+			msg = "the target version removes the file"
+		case installedContent != targetContent:
+			added, removed := diffLines(installedContent, targetContent)
+			msg = fmt.Sprintf("the target version changes the file: %d lines added, %d lines removed", added, removed)
+		default:
+			continue
+		}
+
+		findings = append(findings, Finding{
+			Check:    CheckConfigurationChange,
+			Severity: SeverityInfo,
+			File:     path,
+			Message:  msg,
+		})
+	}
+
+	return findings, nil
+}
+
+func parseRenderedConfiguration(data []byte) (map[string]string, error) {
+	var conf renderedConfiguration
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string, len(conf.Files))
+	for _, f := range conf.Files {
+		files[f.Path] = f.Content
+	}
+
+	return files, nil
+}
+
+// diffLines counts the lines that are only in the target content and the lines that are only in the installed
+// content. The order of the lines is not taken into account.
+func diffLines(installed, target string) (added, removed int) {
+	counts := make(map[string]int)
+
+	for _, line := range strings.Split(installed, "\n") {
+		counts[line]++
+	}
+	for _, line := range strings.Split(target, "\n") {
+		counts[line]--
+	}
+
+	for _, c := range counts {
+		if c > 0 {
+			removed += c
+		} else {
+			added -= c
+		}
+	}
+
+	return added, removed
+}
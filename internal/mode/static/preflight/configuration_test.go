@@ -0,0 +1,50 @@
+package preflight
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCompareConfigurations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	installed := []byte(`{
+  "files": [
+    {"path": "/etc/nginx/conf.d/http.conf", "type": "Regular", "content": "server {}\n"},
+    {"path": "/etc/nginx/conf.d/removed.conf", "type": "Regular", "content": "a"}
+  ],
+  "version": 1
+}`)
+	target := []byte(`{
+  "files": [
+    {"path": "/etc/nginx/conf.d/added.conf", "type": "Regular", "content": "b"},
+    {"path": "/etc/nginx/conf.d/http.conf", "type": "Regular", "content": "server {}\n"}
+  ],
+  "version": 1
+}`)
+
+	findings, err := compareConfigurations(installed, target)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(findings).To(Equal([]Finding{
+		{
+			Check:    CheckConfigurationChange,
+			Severity: SeverityInfo,
+			File:     "/etc/nginx/conf.d/added.conf",
+			Message:  "the target version adds the file",
+		},
+		{
+			Check:    CheckConfigurationChange,
+			Severity: SeverityInfo,
+			File:     "/etc/nginx/conf.d/removed.conf",
+			Message:  "the target version removes the file",
+		},
+	}))
+
+	_, err = compareConfigurations([]byte("invalid"), target)
+	g.Expect(err).To(MatchError(ContainSubstring("error parsing the installed configuration")))
+
+	_, err = compareConfigurations(installed, []byte("invalid"))
+	g.Expect(err).To(MatchError(ContainSubstring("error parsing the target configuration")))
+}
@@ -0,0 +1,335 @@
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// deprecatedFieldPrefix starts the description of the deprecated fields, following the Go convention
+	// for the deprecated identifiers.
+	deprecatedFieldPrefix = "Deprecated:"
+	// arrayItems denotes the items of an array in the path of a field.
+	arrayItems = "[]"
+	// listPageSize is the maximum number of objects to fetch from the API server in a single List request.
+	listPageSize = 500
+)
+
+// ParseCRDs parses the CustomResourceDefinitions from a multi-document YAML manifest.
+func ParseCRDs(data []byte) ([]apiext.CustomResourceDefinition, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+
+	var crds []apiext.CustomResourceDefinition
+
+	for {
+		var crd apiext.CustomResourceDefinition
+		if err := decoder.Decode(&crd); err != nil {
+			if errors.Is(err, io.EOF) {
+				return crds, nil
+			}
+
+			return nil, fmt.Errorf("error decoding CustomResourceDefinition: %w", err)
+		}
+
+		// empty documents decode into empty objects
+		if crd.Name != "" {
+			crds = append(crds, crd)
+		}
+	}
+}
+
+// checkDeprecatedVersions reports the versions that the target CRD deprecates and that objects are still stored in.
+func checkDeprecatedVersions(installed, target *apiext.CustomResourceDefinition) []Finding {
+	var findings []Finding
+
+	for _, v := range target.Spec.Versions {
+		if !v.Deprecated || !slices.Contains(installed.Status.StoredVersions, v.Name) {
+			continue
+		}
+
+		msg := fmt.Sprintf("objects are stored in the deprecated version %s", v.Name)
+		if v.DeprecationWarning != nil {
+			msg = fmt.Sprintf("%s: %s", msg, *v.DeprecationWarning)
+		}
+
+		findings = append(findings, Finding{
+			Object: &ObjectReference{
+				Kind: "CustomResourceDefinition",
+				Name: target.Name,
+			},
+			Check:    CheckDeprecatedVersion,
+			Severity: SeverityWarning,
+			Message:  msg + "; run the migrate-storage-versions command",
+		})
+	}
+
+	return findings
+}
+
+// schemaField is a field of the schema of a CRD version that the objects are checked against.
+type schemaField struct {
+	// installedDefault is the default of the field in the installed CRD. Nil if the field has no default.
+	installedDefault *apiext.JSON
+	// targetDefault is the default of the field in the target CRD. Nil if the field has no default.
+	targetDefault *apiext.JSON
+	path          []string
+	deprecated    bool
+}
+
+// checkObjects checks the objects of the CRD against the deprecated fields and the changed defaults of the
+// target CRD. The objects are read in the storage version of the target CRD, so the fields of the installed
+// and the target schemas of that version are compared. If the installed CRD doesn't serve that version,
+// the objects are not checked.
+func checkObjects(
+	ctx context.Context,
+	k8sReader client.Reader,
+	installed *apiext.CustomResourceDefinition,
+	target *apiext.CustomResourceDefinition,
+) ([]Finding, error) {
+	targetVersion := getStorageVersion(target)
+	if targetVersion == nil {
+		return nil, nil
+	}
+
+	installedIdx := slices.IndexFunc(installed.Spec.Versions, func(v apiext.CustomResourceDefinitionVersion) bool {
+		return v.Name == targetVersion.Name && v.Served
+	})
+	if installedIdx == -1 {
+		return nil, nil
+	}
+
+	fields := getSchemaFields(installed.Spec.Versions[installedIdx].Schema, targetVersion.Schema)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   target.Spec.Group,
+		Version: targetVersion.Name,
+		Kind:    target.Spec.Names.ListKind,
+	})
+
+	var findings []Finding
+
+	for {
+		if err := k8sReader.List(ctx, list, client.Limit(listPageSize), client.Continue(list.GetContinue())); err != nil {
+			return nil, fmt.Errorf("error listing %s: %w", target.Spec.Names.Kind, err)
+		}
+
+		for _, obj := range list.Items {
+			findings = append(findings, checkObject(obj, target.Spec.Names.Kind, fields)...)
+		}
+
+		if list.GetContinue() == "" {
+			return findings, nil
+		}
+	}
+}
+
+func checkObject(obj unstructured.Unstructured, kind string, fields []schemaField) []Finding {
+	ref := &ObjectReference{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+
+	var findings []Finding
+
+	for _, f := range fields {
+		var set, unset int
+		visitField(obj.Object, f.path, func(exists bool) {
+			if exists {
+				set++
+			} else {
+				unset++
+			}
+		})
+
+		if f.deprecated && set > 0 {
+			findings = append(findings, Finding{
+				Object:   ref,
+				Check:    CheckDeprecatedField,
+				Severity: SeverityWarning,
+				Field:    formatPath(f.path),
+				Message:  "the field is deprecated in the target version",
+			})
+		}
+
+		if !defaultsEqual(f.installedDefault, f.targetDefault) && unset > 0 {
+			findings = append(findings, Finding{
+				Object:   ref,
+				Check:    CheckDefaultChange,
+				Severity: SeverityWarning,
+				Field:    formatPath(f.path),
+				Message: fmt.Sprintf(
+					"the field is not set, and its default changes from %s to %s in the target version",
+					formatDefault(f.installedDefault),
+					formatDefault(f.targetDefault),
+				),
+			})
+		}
+	}
+
+	return findings
+}
+
+// getSchemaFields returns the fields of the spec of the target schema that are deprecated or whose default differs
+// from the installed schema.
+func getSchemaFields(installed, target *apiext.CustomResourceValidation) []schemaField {
+	if target == nil || target.OpenAPIV3Schema == nil {
+		return nil
+	}
+
+	installedDefaults := make(map[string]*apiext.JSON)
+	if installed != nil && installed.OpenAPIV3Schema != nil {
+		if spec, ok := installed.OpenAPIV3Schema.Properties["spec"]; ok {
+			walkSchema(spec, []string{"spec"}, func(path []string, props apiext.JSONSchemaProps) {
+				installedDefaults[formatPath(path)] = props.Default
+			})
+		}
+	}
+
+	spec, ok := target.OpenAPIV3Schema.Properties["spec"]
+	if !ok {
+		return nil
+	}
+
+	var fields []schemaField
+
+	walkSchema(spec, []string{"spec"}, func(path []string, props apiext.JSONSchemaProps) {
+		installedDefault, existed := installedDefaults[formatPath(path)]
+
+		field := schemaField{
+			path:             path,
+			installedDefault: installedDefault,
+			targetDefault:    props.Default,
+			deprecated:       strings.HasPrefix(props.Description, deprecatedFieldPrefix),
+		}
+
+		// a new field with a default doesn't change the behavior of the objects
+		if !existed {
+			field.installedDefault = field.targetDefault
+		}
+
+		if field.deprecated || !defaultsEqual(field.installedDefault, field.targetDefault) {
+			fields = append(fields, field)
+		}
+	})
+
+	return fields
+}
+
+// walkSchema calls visit for every property of the schema in the order of their names.
+func walkSchema(props apiext.JSONSchemaProps, path []string, visit func(path []string, props apiext.JSONSchemaProps)) {
+	names := make([]string, 0, len(props.Properties))
+	for name := range props.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		childPath := append(slices.Clone(path), name)
+		visit(childPath, props.Properties[name])
+		walkSchema(props.Properties[name], childPath, visit)
+	}
+
+	if props.Items != nil && props.Items.Schema != nil {
+		walkSchema(*props.Items.Schema, append(slices.Clone(path), arrayItems), visit)
+	}
+}
+
+// visitField calls visit with true for every occurrence of the field in the value, and with false for every
+// occurrence of the parent of the field that doesn't set the field.
+func visitField(value any, path []string, visit func(exists bool)) {
+	if len(path) == 0 {
+		visit(true)
+		return
+	}
+
+	if path[0] == arrayItems {
+		items, _ := value.([]any)
+		for _, item := range items {
+			visitField(item, path[1:], visit)
+		}
+
+		return
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return
+	}
+
+	child, exists := obj[path[0]]
+	if !exists {
+		if len(path) == 1 {
+			visit(false)
+		}
+
+		return
+	}
+
+	visitField(child, path[1:], visit)
+}
+
+func getStorageVersion(crd *apiext.CustomResourceDefinition) *apiext.CustomResourceDefinitionVersion {
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Storage {
+			return &crd.Spec.Versions[i]
+		}
+	}
+
+	return nil
+}
+
+// formatPath formats the path of a field, for example, spec.rules[].name.
+func formatPath(path []string) string {
+	var b strings.Builder
+
+	for i, p := range path {
+		if i > 0 && p != arrayItems {
+			b.WriteString(".")
+		}
+		b.WriteString(p)
+	}
+
+	return b.String()
+}
+
+func defaultsEqual(a, b *apiext.JSON) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return compactJSON(a.Raw) == compactJSON(b.Raw)
+}
+
+func formatDefault(d *apiext.JSON) string {
+	if d == nil {
+		return "none"
+	}
+
+	return compactJSON(d.Raw)
+}
+
+func compactJSON(raw []byte) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,88 @@
+package preflight
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	embeddedfiles "github.com/nginx/nginx-gateway-fabric"
+)
+
+func TestParseCRDs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	crds, err := ParseCRDs(embeddedfiles.CRDsYAML)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(crds).ToNot(BeEmpty())
+
+	for _, crd := range crds {
+		g.Expect(crd.Spec.Group).To(Equal("gateway.nginx.org"))
+		g.Expect(getStorageVersion(&crd)).ToNot(BeNil(), crd.Name)
+	}
+
+	_, err = ParseCRDs([]byte("kind: ["))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestVisitField(t *testing.T) {
+	t.Parallel()
+
+	obj := map[string]any{
+		"spec": map[string]any{
+			"rules": []any{
+				map[string]any{"name": "a", "weight": 1},
+				map[string]any{"name": "b"},
+				map[string]any{"name": "c"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		path     []string
+		expSet   int
+		expUnset int
+	}{
+		{
+			name:   "set field",
+			path:   []string{"spec", "rules"},
+			expSet: 1,
+		},
+		{
+			name:     "array items",
+			path:     []string{"spec", "rules", arrayItems, "weight"},
+			expSet:   1,
+			expUnset: 2,
+		},
+		{
+			name:     "unset field",
+			path:     []string{"spec", "timeout"},
+			expUnset: 1,
+		},
+		{
+			name: "unset parent",
+			path: []string{"spec", "logging", "level"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var set, unset int
+			visitField(obj, test.path, func(exists bool) {
+				if exists {
+					set++
+				} else {
+					unset++
+				}
+			})
+
+			g.Expect(set).To(Equal(test.expSet))
+			g.Expect(unset).To(Equal(test.expUnset))
+			g.Expect(formatPath(test.path)).ToNot(ContainSubstring(".[]"))
+		})
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package preflight contains the checks that run before upgrading NGINX Gateway Fabric to a target version.
+
+The checks compare the state of the cluster with the target version and report the findings that the upgrade
+automation needs to act on:
+  - the installed Gateway API CRDs that the target version doesn't support;
+  - the deprecated versions of the NGINX Gateway Fabric CRDs that objects are still stored in;
+  - the objects that set the fields that the target version deprecates;
+  - the objects that rely on the defaults that the target version changes;
+  - the differences between the NGINX configuration generated by the installed and the target versions.
+
+The Report is versioned with SchemaVersion. Within a version, fields are only ever added.
+*/
+package preflight
@@ -0,0 +1,58 @@
+package preflight
+
+import (
+	"fmt"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/gatewayclass"
+)
+
+// gatewayAPIGroup is the API group of the Gateway API CRDs.
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+// checkGatewayAPIVersion checks the installed Gateway API CRDs against the Gateway API version that the target
+// version supports, the same way the GatewayClass is validated.
+func checkGatewayAPIVersion(crds []apiext.CustomResourceDefinition) []Finding {
+	crdMetadata := make(map[types.NamespacedName]*metav1.PartialObjectMetadata)
+
+	for i := range crds {
+		if crds[i].Spec.Group != gatewayAPIGroup {
+			continue
+		}
+
+		crdMetadata[types.NamespacedName{Name: crds[i].Name}] = &metav1.PartialObjectMetadata{
+			ObjectMeta: crds[i].ObjectMeta,
+		}
+	}
+
+	if len(crdMetadata) == 0 {
+		return []Finding{
+			{
+				Check:    CheckGatewayAPIVersion,
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("Gateway API CRDs are not installed; install version %s", gatewayclass.SupportedVersion),
+			},
+		}
+	}
+
+	conds, valid := gatewayclass.ValidateCRDVersions(crdMetadata)
+	if len(conds) == 0 {
+		return nil
+	}
+
+	severity := SeverityWarning
+	if !valid {
+		severity = SeverityError
+	}
+
+	return []Finding{
+		{
+			Check:    CheckGatewayAPIVersion,
+			Severity: severity,
+			Message:  conds[0].Message,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package preflight
+
+// SchemaVersion is the version of the schema of the Report.
+const SchemaVersion = "v1"
+
+// Severity is the severity of a Finding.
+type Severity string
+
+const (
+	// SeverityError is the severity of the findings that block the upgrade.
+	SeverityError Severity = "Error"
+	// SeverityWarning is the severity of the findings that need attention, but don't block the upgrade.
+	SeverityWarning Severity = "Warning"
+	// SeverityInfo is the severity of the findings that describe the effects of the upgrade.
+	SeverityInfo Severity = "Info"
+)
+
+// Check is the check that reported a Finding.
+type Check string
+
+const (
+	// CheckGatewayAPIVersion checks that the target version supports the installed Gateway API CRDs.
+	CheckGatewayAPIVersion Check = "GatewayAPIVersion"
+	// CheckDeprecatedVersion checks that no objects are stored in the deprecated versions of the CRDs.
+	CheckDeprecatedVersion Check = "DeprecatedVersion"
+	// CheckDeprecatedField checks that no objects set the fields that the target version deprecates.
+	CheckDeprecatedField Check = "DeprecatedField"
+	// CheckDefaultChange checks for the objects that rely on the defaults that the target version changes.
+	CheckDefaultChange Check = "DefaultChange"
+	// CheckConfigurationChange compares the NGINX configuration generated by the installed and
+	// the target versions.
+	CheckConfigurationChange Check = "ConfigurationChange"
+)
+
+// Report is the report of the preflight checks.
+type Report struct {
+	// SchemaVersion is the version of the schema of the Report.
+	SchemaVersion string `json:"schemaVersion"`
+	// TargetVersion is the version of NGINX Gateway Fabric that the checks ran for.
+	TargetVersion string `json:"targetVersion"`
+	// Findings are the findings of the checks.
+	Findings []Finding `json:"findings"`
+	// Passed is true if none of the findings is an Error.
+	Passed bool `json:"passed"`
+}
+
+// Finding is a finding of a check.
+type Finding struct {
+	// Object is the object that the finding is about. Nil if the finding is not about an object.
+	Object *ObjectReference `json:"object,omitempty"`
+	// Check is the check that reported the finding.
+	Check Check `json:"check"`
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+	// Field is the path of the field of the Object that the finding is about, for example, spec.ipFamily.
+	// Array items are denoted by [].
+	Field string `json:"field,omitempty"`
+	// File is the path of the NGINX configuration file that the finding is about.
+	File string `json:"file,omitempty"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// ObjectReference references a Kubernetes object.
+type ObjectReference struct {
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object. Empty for the cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+}
@@ -0,0 +1,67 @@
+package static
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+const (
+	// provenancePath is the path of the debug endpoint of the metrics server that serves the objects that set
+	// the NGINX directives of the configuration generated from the current cluster state.
+	provenancePath = "/debug/provenance"
+	// provenanceDirectiveParam is the query parameter of the debug endpoint that selects a single directive.
+	provenanceDirectiveParam = "directive"
+)
+
+// newProvenanceHandler returns the handler of the debug endpoint that serves the provenance of the NGINX directives,
+// keyed by the context of the directives. If the directive query parameter is set, only that directive is served.
+// It responds with the 503 status code until the first graph is built.
+func newProvenanceHandler(renderer configurationRenderer, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		conf, gr, ok := renderer.buildConfiguration(r.Context())
+		if !ok {
+			http.Error(w, "graph is not built yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		provenance := dataplane.BuildProvenance(conf, gr, ngxConfig.NewPolicyGenerator(conf.Telemetry))
+
+		if directive := r.URL.Query().Get(provenanceDirectiveParam); directive != "" {
+			provenance = filterProvenance(provenance, directive)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(provenance); err != nil {
+			logger.Error(err, "Failed to serve the provenance")
+		}
+	})
+}
+
+// filterProvenance returns the provenance of the directive only. The contexts without the directive are removed.
+func filterProvenance(provenance dataplane.Provenance, directive string) dataplane.Provenance {
+	filtered := make(dataplane.Provenance)
+
+	for ctx, directives := range provenance {
+		directives = slices.DeleteFunc(slices.Clone(directives), func(d dataplane.DirectiveProvenance) bool {
+			return d.Directive != directive
+		})
+
+		if len(directives) > 0 {
+			filtered[ctx] = directives
+		}
+	}
+
+	return filtered
+}
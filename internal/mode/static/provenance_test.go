@@ -0,0 +1,117 @@
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver/resolverfakes"
+)
+
+func TestProvenanceHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		graph   *graph.Graph
+		name    string
+		method  string
+		target  string
+		expBody string
+		expCode int
+	}{
+		{
+			name:    "all directives",
+			graph:   &graph.Graph{},
+			method:  http.MethodGet,
+			target:  provenancePath,
+			expCode: http.StatusOK,
+			expBody: `{"http":[{"directive":"server_names_hash_max_size","value":"1024"},` +
+				`{"directive":"server_names_hash_bucket_size","value":"256"},` +
+				`{"directive":"map_hash_max_size","value":"2048"},` +
+				`{"directive":"map_hash_bucket_size","value":"256"}],` +
+				`"main":[{"directive":"error_log","value":"stderr info"}]}` + "\n",
+		},
+		{
+			name:    "single directive",
+			graph:   &graph.Graph{},
+			method:  http.MethodGet,
+			target:  provenancePath + "?directive=error_log",
+			expCode: http.StatusOK,
+			expBody: `{"main":[{"directive":"error_log","value":"stderr info"}]}` + "\n",
+		},
+		{
+			name:    "unknown directive",
+			graph:   &graph.Graph{},
+			method:  http.MethodGet,
+			target:  provenancePath + "?directive=proxy_read_timeout",
+			expCode: http.StatusOK,
+			expBody: "{}\n",
+		},
+		{
+			name:    "graph is not built yet",
+			method:  http.MethodGet,
+			target:  provenancePath,
+			expCode: http.StatusServiceUnavailable,
+			expBody: "graph is not built yet\n",
+		},
+		{
+			name:    "method not allowed",
+			graph:   &graph.Graph{},
+			method:  http.MethodPost,
+			target:  provenancePath,
+			expCode: http.StatusMethodNotAllowed,
+			expBody: "Method Not Allowed\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			handler := newProvenanceHandler(
+				configurationRenderer{
+					graphGetter:         &latestGraphGetter{graph: test.graph},
+					configurationGetter: fakeConfigurationGetter{conf: &dataplane.Configuration{}},
+					serviceResolver:     &resolverfakes.FakeServiceResolver{},
+					generator:           &configfakes.FakeGenerator{},
+				},
+				logr.Discard(),
+			)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(test.method, test.target, nil))
+
+			g.Expect(rec.Code).To(Equal(test.expCode))
+			g.Expect(rec.Body.String()).To(Equal(test.expBody))
+		})
+	}
+}
+
+func TestFilterProvenance(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	provenance := dataplane.Provenance{
+		"http": {
+			{Directive: "http2", Value: "on"},
+			{Directive: "send_timeout", Value: "30s"},
+		},
+		"location cafe.example.com:80 /coffee": {
+			{Directive: "proxy_read_timeout", Value: "5s"},
+		},
+	}
+
+	g.Expect(filterProvenance(provenance, "send_timeout")).To(Equal(dataplane.Provenance{
+		"http": {
+			{Directive: "send_timeout", Value: "30s"},
+		},
+	}))
+	g.Expect(provenance["http"]).To(HaveLen(2))
+}
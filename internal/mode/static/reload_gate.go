@@ -0,0 +1,103 @@
+package static
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+const (
+	// defaultReloadGatingMaxDelay is the maximum delay of a reload if the reload gating doesn't specify it.
+	defaultReloadGatingMaxDelay = 30 * time.Second
+	// reloadGatingPollInterval is the interval at which the number of in-flight requests is checked while
+	// a reload is delayed.
+	reloadGatingPollInterval = time.Second
+)
+
+type inFlightRequestsGetter interface {
+	GetInFlightRequests() (uint64, error)
+}
+
+// reloadGate delays the reloads of NGINX until the number of in-flight requests drops below the threshold of
+// the reload gating, or the maximum delay expires, so that the reloads reset fewer connections during traffic peaks.
+type reloadGate struct {
+	requestsGetter inFlightRequestsGetter
+	logger         logr.Logger
+	pollInterval   time.Duration
+}
+
+func newReloadGate(
+	requestsGetter inFlightRequestsGetter,
+	logger logr.Logger,
+	pollInterval time.Duration,
+) *reloadGate {
+	return &reloadGate{
+		requestsGetter: requestsGetter,
+		logger:         logger,
+		pollInterval:   pollInterval,
+	}
+}
+
+// wait blocks until the number of in-flight requests is below the threshold, the maximum delay expires, or
+// the context is canceled. If the number of in-flight requests can't be determined, it doesn't block, so that
+// the reload isn't delayed because of the unavailable NGINX Plus API.
+func (g *reloadGate) wait(ctx context.Context, gating *ngfAPI.ReloadGating) {
+	maxDelay := defaultReloadGatingMaxDelay
+	if gating.MaxDelay != nil {
+		// the max delay is validated when the control plane configuration is updated
+		if d, err := parseDuration(*gating.MaxDelay); err == nil {
+			maxDelay = d
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, maxDelay)
+	defer cancel()
+
+	ticker := time.NewTicker(g.pollInterval)
+	defer ticker.Stop()
+
+	start := time.Now()
+
+	for {
+		requests, err := g.requestsGetter.GetInFlightRequests()
+		if err != nil {
+			g.logger.Error(err, "Failed to get the number of in-flight requests; reloading NGINX without delay")
+			return
+		}
+
+		if requests < uint64(gating.InFlightRequestsThreshold) {
+			if delay := time.Since(start); delay >= g.pollInterval {
+				g.logger.Info("Delayed reload of NGINX", "delay", delay.String(), "inFlightRequests", requests)
+			}
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			g.logger.Info(
+				"Reloading NGINX after the maximum delay even though the number of in-flight requests is not "+
+					"below the threshold",
+				"maxDelay", maxDelay.String(),
+				"inFlightRequests", requests,
+				"threshold", gating.InFlightRequestsThreshold,
+			)
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseDuration parses an NGF API Duration. A value without a suffix is in seconds.
+func parseDuration(duration ngfAPI.Duration) (time.Duration, error) {
+	d := string(duration)
+	if d != "" && d[len(d)-1] >= '0' && d[len(d)-1] <= '9' {
+		d += "s"
+	}
+
+	return time.ParseDuration(d)
+}
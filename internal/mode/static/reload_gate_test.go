@@ -0,0 +1,123 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
+)
+
+func TestReloadGateWait(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		getErr       error
+		maxDelay     *ngfAPI.Duration
+		name         string
+		requests     []uint64
+		expCallCount int
+	}{
+		{
+			name:         "below the threshold",
+			requests:     []uint64{5},
+			expCallCount: 1,
+		},
+		{
+			name:         "drops below the threshold",
+			requests:     []uint64{20, 10, 9},
+			expCallCount: 3,
+		},
+		{
+			name:         "maximum delay expires",
+			requests:     []uint64{20},
+			maxDelay:     helpers.GetPointer[ngfAPI.Duration]("0s"),
+			expCallCount: 1,
+		},
+		{
+			name:         "error getting the in-flight requests",
+			getErr:       errors.New("error"),
+			expCallCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			fakeRuntimeMgr := &runtimefakes.FakeManager{}
+			for i, requests := range test.requests {
+				fakeRuntimeMgr.GetInFlightRequestsReturnsOnCall(i, requests, nil)
+			}
+			if len(test.requests) > 0 {
+				fakeRuntimeMgr.GetInFlightRequestsReturns(test.requests[len(test.requests)-1], nil)
+			}
+			if test.getErr != nil {
+				fakeRuntimeMgr.GetInFlightRequestsReturns(0, test.getErr)
+			}
+
+			gate := newReloadGate(fakeRuntimeMgr, logr.Discard(), time.Millisecond)
+			gate.wait(context.Background(), &ngfAPI.ReloadGating{
+				InFlightRequestsThreshold: 10,
+				MaxDelay:                  test.maxDelay,
+			})
+
+			g.Expect(fakeRuntimeMgr.GetInFlightRequestsCallCount()).To(Equal(test.expCallCount))
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		duration    ngfAPI.Duration
+		expDuration time.Duration
+		expErr      bool
+	}{
+		{
+			name:        "milliseconds",
+			duration:    "500ms",
+			expDuration: 500 * time.Millisecond,
+		},
+		{
+			name:        "minutes",
+			duration:    "2m",
+			expDuration: 2 * time.Minute,
+		},
+		{
+			name:        "no suffix",
+			duration:    "30",
+			expDuration: 30 * time.Second,
+		},
+		{
+			name:     "invalid",
+			duration: "invalid",
+			expErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			d, err := parseDuration(test.duration)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(d).To(Equal(test.expDuration))
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package static
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+const (
+	// renderedConfigurationPath is the path of the debug endpoint of the metrics server that serves the NGINX
+	// configuration files generated from the current cluster state.
+	renderedConfigurationPath = "/debug/rendered-configuration"
+	// renderedConfigurationFileParam is the query parameter of the debug endpoint that selects a single file.
+	renderedConfigurationFileParam = "file"
+)
+
+// renderedConfiguration is the response of the debug endpoint of the rendered configuration.
+type renderedConfiguration struct {
+	Files   []renderedFile `json:"files"`
+	Version int            `json:"version"`
+}
+
+// renderedFile is an NGINX configuration file of the rendered configuration.
+type renderedFile struct {
+	Path    string `json:"path"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// configurationRenderer builds the data plane configuration from the latest graph and generates the NGINX
+// configuration files from it, like the event handler does, but without writing the files or reloading NGINX.
+type configurationRenderer struct {
+	graphGetter         graphGetter
+	configurationGetter configurationGetter
+	serviceResolver     resolver.ServiceResolver
+	generator           ngxConfig.Generator
+	plus                bool
+}
+
+// newRenderedConfigurationHandler returns the handler of the debug endpoint that serves the NGINX configuration
+// files that the file manager would write for the current cluster state. The content of secret files is redacted.
+// If the file query parameter is set, only the content of that file is served as plain text.
+// It responds with the 503 status code until the first graph is built.
+func newRenderedConfigurationHandler(renderer configurationRenderer, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		rendered, ok := renderer.render(r.Context())
+		if !ok {
+			http.Error(w, "graph is not built yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		if path := r.URL.Query().Get(renderedConfigurationFileParam); path != "" {
+			idx := slices.IndexFunc(rendered.Files, func(f renderedFile) bool {
+				return f.Path == path
+			})
+			if idx == -1 {
+				http.Error(w, "file is not part of the configuration", http.StatusNotFound)
+				return
+			}
+
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			if _, err := w.Write([]byte(rendered.Files[idx].Content)); err != nil {
+				logger.Error(err, "Failed to write the rendered configuration file", "file", path)
+			}
+
+			return
+		}
+
+		// the configuration files are full of characters that the HTML escaping would make unreadable
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(rendered); err != nil {
+			logger.Error(err, "Failed to serve the rendered configuration")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			logger.Error(err, "Failed to write the rendered configuration")
+		}
+	})
+}
+
+// render renders the configuration for the latest graph. It returns false if the graph is not built yet.
+// The version, the deployment context and the error log socket are taken from the latest configuration,
+// so that the files are identical to the written ones if the cluster state hasn't changed since.
+func (c configurationRenderer) render(ctx context.Context) (renderedConfiguration, bool) {
+	conf, _, ok := c.buildConfiguration(ctx)
+	if !ok {
+		return renderedConfiguration{}, false
+	}
+
+	files := c.generator.Generate(conf)
+	slices.SortFunc(files, func(a, b file.File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	rendered := renderedConfiguration{
+		Version: conf.Version,
+		Files:   make([]renderedFile, 0, len(files)),
+	}
+
+	for _, f := range files {
+		content := string(f.Content)
+		if f.Type == file.TypeSecret {
+			content = redactedContent
+		}
+
+		rendered.Files = append(rendered.Files, renderedFile{
+			Path:    f.Path,
+			Type:    f.Type.String(),
+			Content: content,
+		})
+	}
+
+	return rendered, true
+}
+
+// buildConfiguration builds the data plane configuration for the latest graph. It returns false if the graph is not
+// built yet.
+func (c configurationRenderer) buildConfiguration(ctx context.Context) (dataplane.Configuration, *graph.Graph, bool) {
+	gr := c.graphGetter.GetLatestGraph()
+	if gr == nil {
+		return dataplane.Configuration{}, nil, false
+	}
+
+	var latest dataplane.Configuration
+	if conf := c.configurationGetter.GetLatestConfiguration(); conf != nil {
+		latest = *conf
+	}
+
+	conf := dataplane.BuildConfiguration(ctx, gr, c.serviceResolver, latest.Version, c.plus)
+	conf.DeploymentContext = latest.DeploymentContext
+	conf.Logging.ErrorLogSocket = latest.Logging.ErrorLogSocket
+
+	return conf, gr, true
+}
@@ -0,0 +1,152 @@
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver/resolverfakes"
+)
+
+func TestRenderedConfigurationHandler(t *testing.T) {
+	t.Parallel()
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+			Content: []byte("key"),
+			Type:    file.TypeSecret,
+		},
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("server {}\n"),
+			Type:    file.TypeRegular,
+		},
+	}
+
+	expJSON := `{
+  "files": [
+    {
+      "path": "/etc/nginx/conf.d/http.conf",
+      "type": "Regular",
+      "content": "server {}\n"
+    },
+    {
+      "path": "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+      "type": "Secret",
+      "content": "<redacted>"
+    }
+  ],
+  "version": 3
+}
+`
+
+	tests := []struct {
+		graph          *graph.Graph
+		name           string
+		method         string
+		target         string
+		expBody        string
+		expContentType string
+		expCode        int
+		expRendered    bool
+	}{
+		{
+			name:           "all files",
+			graph:          &graph.Graph{},
+			method:         http.MethodGet,
+			target:         renderedConfigurationPath,
+			expCode:        http.StatusOK,
+			expBody:        expJSON,
+			expContentType: "application/json",
+			expRendered:    true,
+		},
+		{
+			name:           "single file",
+			graph:          &graph.Graph{},
+			method:         http.MethodGet,
+			target:         renderedConfigurationPath + "?file=/etc/nginx/conf.d/http.conf",
+			expCode:        http.StatusOK,
+			expBody:        "server {}\n",
+			expContentType: "text/plain; charset=utf-8",
+			expRendered:    true,
+		},
+		{
+			name:        "unknown file",
+			graph:       &graph.Graph{},
+			method:      http.MethodGet,
+			target:      renderedConfigurationPath + "?file=/etc/nginx/conf.d/unknown.conf",
+			expCode:     http.StatusNotFound,
+			expBody:     "file is not part of the configuration\n",
+			expRendered: true,
+		},
+		{
+			name:    "graph is not built yet",
+			method:  http.MethodGet,
+			target:  renderedConfigurationPath,
+			expCode: http.StatusServiceUnavailable,
+			expBody: "graph is not built yet\n",
+		},
+		{
+			name:    "method not allowed",
+			graph:   &graph.Graph{},
+			method:  http.MethodPost,
+			target:  renderedConfigurationPath,
+			expCode: http.StatusMethodNotAllowed,
+			expBody: "Method Not Allowed\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := &configfakes.FakeGenerator{}
+			generator.GenerateReturns(append([]file.File(nil), files...))
+
+			handler := newRenderedConfigurationHandler(
+				configurationRenderer{
+					graphGetter: &latestGraphGetter{graph: test.graph},
+					configurationGetter: fakeConfigurationGetter{
+						conf: &dataplane.Configuration{
+							Version: 3,
+							Logging: dataplane.Logging{ErrorLogSocket: "/var/run/nginx/error-log.sock"},
+						},
+					},
+					serviceResolver: &resolverfakes.FakeServiceResolver{},
+					generator:       generator,
+				},
+				logr.Discard(),
+			)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(test.method, test.target, nil))
+
+			g.Expect(rec.Code).To(Equal(test.expCode))
+			g.Expect(rec.Body.String()).To(Equal(test.expBody))
+
+			if test.expContentType != "" {
+				g.Expect(rec.Header().Get("Content-Type")).To(Equal(test.expContentType))
+			}
+
+			if !test.expRendered {
+				g.Expect(generator.GenerateCallCount()).To(BeZero())
+				return
+			}
+
+			g.Expect(generator.GenerateCallCount()).To(Equal(1))
+
+			conf := generator.GenerateArgsForCall(0)
+			g.Expect(conf.Version).To(Equal(3))
+			g.Expect(conf.Logging.ErrorLogSocket).To(Equal("/var/run/nginx/error-log.sock"))
+		})
+	}
+}
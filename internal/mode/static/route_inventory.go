@@ -0,0 +1,45 @@
+package static
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/inventory"
+)
+
+// routeInventoryPath is the path of the debug endpoint of the metrics server that serves the inventory of
+// the HTTP APIs that the Gateways expose.
+const routeInventoryPath = "/debug/inventory"
+
+// newRouteInventoryHandler returns the handler of the debug endpoint that serves the inventory of the hostnames,
+// paths and methods that the Gateways expose, in the versioned JSON schema of the inventory package.
+// The inventory is built from the latest graph, so it is refreshed on every graph build.
+// It responds with the 503 status code until the first graph is built.
+func newRouteInventoryHandler(getter graphGetter, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		gr := getter.GetLatestGraph()
+		if gr == nil {
+			http.Error(w, "graph is not built yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		data, err := inventory.Marshal(gr)
+		if err != nil {
+			logger.Error(err, "Failed to serve the route inventory")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			logger.Error(err, "Failed to write the route inventory")
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package static
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestRouteInventoryHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		graph   *graph.Graph
+		name    string
+		method  string
+		expBody string
+		expCode int
+		expJSON bool
+	}{
+		{
+			name:    "latest graph",
+			graph:   &graph.Graph{},
+			method:  http.MethodGet,
+			expCode: http.StatusOK,
+			expBody: "{\n  \"schemaVersion\": \"v1\",\n  \"gateways\": []\n}",
+			expJSON: true,
+		},
+		{
+			name:    "graph is not built yet",
+			method:  http.MethodGet,
+			expCode: http.StatusServiceUnavailable,
+			expBody: "graph is not built yet\n",
+		},
+		{
+			name:    "method not allowed",
+			graph:   &graph.Graph{},
+			method:  http.MethodPost,
+			expCode: http.StatusMethodNotAllowed,
+			expBody: "Method Not Allowed\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			handler := newRouteInventoryHandler(&latestGraphGetter{graph: test.graph}, logr.Discard())
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(test.method, routeInventoryPath, nil))
+
+			g.Expect(rec.Code).To(Equal(test.expCode))
+			g.Expect(rec.Body.String()).To(Equal(test.expBody))
+
+			if test.expJSON {
+				g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package static
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+const (
+	// smokeTestAddress is the address that the smoke test probes are sent to. NGINX runs in the same Pod
+	// as the control plane, so it is reachable on the loopback interface.
+	smokeTestAddress = "localhost"
+	// smokeTestProbeTimeout is the timeout of the request of a smoke test probe.
+	smokeTestProbeTimeout = 5 * time.Second
+)
+
+type smokeTestMetricsCollector interface {
+	ObserveSmokeTestProbe(probe string, passed bool)
+}
+
+// smokeTester sends the smoke test probes through NGINX after the NGINX configuration is updated, so that
+// a configuration that is applied but breaks the traffic is detected right away.
+// The probes are sent in the background, so that they don't delay the handling of the next events.
+type smokeTester struct {
+	metricsCollector smokeTestMetricsCollector
+	logger           logr.Logger
+	// report reports the result of the probes when they are done. It must return when the context is canceled.
+	report func(ctx context.Context, event *events.SmokeTestDoneEvent)
+	// cancel cancels the probes that are in flight.
+	cancel  context.CancelFunc
+	address string
+	timeout time.Duration
+	lock    sync.Mutex
+}
+
+func newSmokeTester(
+	metricsCollector smokeTestMetricsCollector,
+	logger logr.Logger,
+	address string,
+	timeout time.Duration,
+	report func(ctx context.Context, event *events.SmokeTestDoneEvent),
+) *smokeTester {
+	return &smokeTester{
+		metricsCollector: metricsCollector,
+		logger:           logger,
+		report:           report,
+		address:          address,
+		timeout:          timeout,
+	}
+}
+
+// start sends the probes of the smoke test through NGINX in the background and reports their failures
+// for the version of the NGINX configuration when they are done.
+// The probes of a previous version that are still in flight are canceled and their result is not reported.
+func (t *smokeTester) start(ctx context.Context, smokeTest *ngfAPI.SmokeTest, worker dataplane.Worker, version int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	t.cancel = cancel
+
+	go func() {
+		defer cancel()
+
+		failures := t.run(runCtx, smokeTest, worker)
+		if runCtx.Err() != nil {
+			return
+		}
+
+		t.report(runCtx, &events.SmokeTestDoneEvent{Failures: failures, Version: version})
+	}()
+}
+
+// run sends the probes of the smoke test through NGINX and returns the failures of the probes that didn't get
+// the expected responses, in the order of the probes. The probes are sent concurrently.
+// The ports of the probes are the ports of the Listeners, which are mapped to the ports NGINX listens on
+// by the worker configuration.
+func (t *smokeTester) run(ctx context.Context, smokeTest *ngfAPI.SmokeTest, worker dataplane.Worker) []string {
+	errs := make([]error, len(smokeTest.Probes))
+
+	var wg sync.WaitGroup
+	for i, probe := range smokeTest.Probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = t.probe(ctx, probe, worker.ListenPort(probe.Port))
+		}()
+	}
+	wg.Wait()
+
+	// the probes were canceled, so their errors don't tell anything about the traffic
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	var failures []string
+	for i, probe := range smokeTest.Probes {
+		t.metricsCollector.ObserveSmokeTestProbe(probe.Name, errs[i] == nil)
+
+		if errs[i] != nil {
+			t.logger.Info("Smoke test probe failed", "probe", probe.Name, "error", errs[i].Error())
+			failures = append(failures, fmt.Sprintf("probe %s: %s", probe.Name, errs[i]))
+		}
+	}
+
+	return failures
+}
+
+// probe sends the request of the probe to the port and checks the response.
+func (t *smokeTester) probe(ctx context.Context, probe ngfAPI.SmokeTestProbe, port int32) error {
+	scheme := ngfAPI.SmokeTestSchemeHTTP
+	if probe.Scheme != nil {
+		scheme = *probe.Scheme
+	}
+
+	path := "/"
+	if probe.Path != nil {
+		path = *probe.Path
+	}
+
+	expectedStatus := http.StatusOK
+	if probe.ExpectedStatus != nil {
+		expectedStatus = int(*probe.ExpectedStatus)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	url := fmt.Sprintf(
+		"%s://%s%s",
+		strings.ToLower(string(scheme)),
+		net.JoinHostPort(t.address, strconv.Itoa(int(port))),
+		path,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Host = probe.Hostname
+
+	// A new connection is opened for every probe, so that the request is handled by the NGINX workers
+	// of the updated configuration.
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				ServerName:         probe.Hostname,
+				InsecureSkipVerify: true, //nolint:gosec // the probe checks the traffic, not the certificate
+			},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("expected status %d, got %d", expectedStatus, resp.StatusCode)
+	}
+
+	var missing []string
+	for _, header := range probe.ExpectedHeaders {
+		if len(resp.Header.Values(string(header))) == 0 {
+			missing = append(missing, string(header))
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("missing headers %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
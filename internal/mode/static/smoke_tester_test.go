@@ -0,0 +1,192 @@
+package static
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+type smokeTestRecorder struct {
+	results map[string]bool
+	lock    sync.Mutex
+}
+
+func (r *smokeTestRecorder) ObserveSmokeTestProbe(probe string, passed bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.results[probe] = passed
+}
+
+func TestSmokeTesterRun(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "cafe.example.com":
+			w.Header().Set("X-Version", "v1")
+			if r.URL.Path == "/coffee" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	_, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	g.Expect(err).ToNot(HaveOccurred())
+	port, err := strconv.Atoi(portStr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the Listener port 80 is mapped to the port of the test server
+	worker := dataplane.Worker{PortMappings: map[int32]int32{80: int32(port)}} //nolint:gosec // port fits into int32
+
+	smokeTest := &ngfAPI.SmokeTest{
+		Probes: []ngfAPI.SmokeTestProbe{
+			{
+				Name:            "coffee",
+				Hostname:        "cafe.example.com",
+				Path:            helpers.GetPointer("/coffee"),
+				Port:            80,
+				ExpectedHeaders: []gatewayv1.HTTPHeaderName{"X-Version"},
+			},
+			{
+				Name:           "not-found",
+				Hostname:       "cafe.example.com",
+				Port:           80,
+				ExpectedStatus: helpers.GetPointer[int32](http.StatusNotFound),
+			},
+			{
+				Name:     "tea",
+				Hostname: "tea.example.com",
+				Port:     80,
+			},
+			{
+				Name:            "missing-header",
+				Hostname:        "cafe.example.com",
+				Path:            helpers.GetPointer("/coffee"),
+				Port:            80,
+				ExpectedHeaders: []gatewayv1.HTTPHeaderName{"X-Version", "X-Cache-Status"},
+			},
+		},
+	}
+
+	recorder := &smokeTestRecorder{results: make(map[string]bool)}
+	tester := newSmokeTester(recorder, logr.Discard(), "127.0.0.1", time.Second, nil)
+
+	failures := tester.run(context.Background(), smokeTest, worker)
+	g.Expect(failures).To(Equal([]string{
+		"probe tea: expected status 200, got 502",
+		"probe missing-header: missing headers X-Cache-Status",
+	}))
+
+	g.Expect(recorder.results).To(Equal(map[string]bool{
+		"coffee":         true,
+		"not-found":      true,
+		"tea":            false,
+		"missing-header": false,
+	}))
+}
+
+func TestSmokeTesterRunConnectionFailure(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// a listener that is closed right away provides a port that nothing listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+	port := listener.Addr().(*net.TCPAddr).Port
+	g.Expect(listener.Close()).To(Succeed())
+
+	smokeTest := &ngfAPI.SmokeTest{
+		Probes: []ngfAPI.SmokeTestProbe{
+			{
+				Name:     "https",
+				Hostname: "cafe.example.com",
+				Port:     int32(port), //nolint:gosec // port fits into int32
+				Scheme:   helpers.GetPointer(ngfAPI.SmokeTestSchemeHTTPS),
+			},
+		},
+	}
+
+	recorder := &smokeTestRecorder{results: make(map[string]bool)}
+	tester := newSmokeTester(recorder, logr.Discard(), "127.0.0.1", time.Second, nil)
+
+	failures := tester.run(context.Background(), smokeTest, dataplane.Worker{})
+	g.Expect(failures).To(HaveLen(1))
+	g.Expect(failures[0]).To(HavePrefix("probe https: request failed: "))
+	g.Expect(recorder.results).To(Equal(map[string]bool{"https": false}))
+}
+
+func TestSmokeTesterStart(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// the requests of the first version are held until the test releases them
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "v1.example.com" {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	g.Expect(err).ToNot(HaveOccurred())
+	port, err := strconv.Atoi(portStr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	worker := dataplane.Worker{PortMappings: map[int32]int32{80: int32(port)}} //nolint:gosec // port fits into int32
+
+	getSmokeTest := func(hostname string) *ngfAPI.SmokeTest {
+		return &ngfAPI.SmokeTest{
+			Probes: []ngfAPI.SmokeTestProbe{{Name: "cafe", Hostname: hostname, Port: 80}},
+		}
+	}
+
+	reports := make(chan *events.SmokeTestDoneEvent, 2)
+	report := func(_ context.Context, event *events.SmokeTestDoneEvent) {
+		reports <- event
+	}
+
+	recorder := &smokeTestRecorder{results: make(map[string]bool)}
+	tester := newSmokeTester(recorder, logr.Discard(), "127.0.0.1", 10*time.Second, report)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tester.start(ctx, getSmokeTest("v1.example.com"), worker, 1)
+	// the probes of the first version are still in flight, so they are canceled and not reported
+	tester.start(ctx, getSmokeTest("v2.example.com"), worker, 2)
+
+	var event *events.SmokeTestDoneEvent
+	g.Eventually(reports).Should(Receive(&event))
+	g.Expect(event).To(Equal(&events.SmokeTestDoneEvent{
+		Failures: []string{"probe cafe: expected status 200, got 502"},
+		Version:  2,
+	}))
+	g.Consistently(reports, 100*time.Millisecond).ShouldNot(Receive())
+}
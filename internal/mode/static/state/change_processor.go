@@ -8,6 +8,7 @@ import (
 	discoveryV1 "k8s.io/api/discovery/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -61,6 +62,13 @@ type ChangeProcessor interface {
 	GetLatestGraph() *graph.Graph
 }
 
+// ArtifactFetcher fetches the contents of OCI artifacts in the background.
+type ArtifactFetcher interface {
+	graph.ArtifactFetcher
+	// TakeUpdated reports whether a fetch of an artifact completed since the last call.
+	TakeUpdated() bool
+}
+
 // ChangeProcessorConfig holds configuration parameters for ChangeProcessorImpl.
 type ChangeProcessorConfig struct {
 	// Validators validate resources according to data-plane specific rules.
@@ -73,12 +81,22 @@ type ChangeProcessorConfig struct {
 	ProtectedPorts graph.ProtectedPorts
 	// PlusSecrets is a list of secret files used for NGINX Plus reporting (JWT, client SSL, CA).
 	PlusSecrets map[types.NamespacedName][]graph.PlusSecretFile
+	// GatewaySelector selects the Gateways of the GatewayClass to process. If nil, all Gateways are processed.
+	GatewaySelector labels.Selector
+	// ArtifactFetcher fetches the OCI artifacts referenced by the resources. If nil, OCI artifacts are not supported.
+	ArtifactFetcher ArtifactFetcher
 	// Logger is the logger for this Change Processor.
 	Logger logr.Logger
 	// GatewayCtlrName is the name of the Gateway controller.
 	GatewayCtlrName string
 	// GatewayClassName is the name of the GatewayClass resource.
 	GatewayClassName string
+	// QUICSupported shows whether NGINX supports HTTP/3 over QUIC.
+	QUICSupported bool
+	// AllowExternalNameServices shows whether the backendRefs of Routes can reference ExternalName Services.
+	AllowExternalNameServices bool
+	// AllowTemplateOverrides shows whether NginxProxies can override the templates of the NGINX configuration.
+	AllowTemplateOverrides bool
 }
 
 // ChangeProcessorImpl is an implementation of ChangeProcessor.
@@ -108,12 +126,18 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
 		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
 		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
+		BackendLBPolicies:  make(map[types.NamespacedName]*v1alpha2.BackendLBPolicy),
 		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
 		NginxProxies:       make(map[types.NamespacedName]*ngfAPIv1alpha1.NginxProxy),
 		GRPCRoutes:         make(map[types.NamespacedName]*v1.GRPCRoute),
 		TLSRoutes:          make(map[types.NamespacedName]*v1alpha2.TLSRoute),
+		TCPRoutes:          make(map[types.NamespacedName]*v1alpha2.TCPRoute),
+		UDPRoutes:          make(map[types.NamespacedName]*v1alpha2.UDPRoute),
 		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
 		SnippetsFilters:    make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+		RouteTests:         make(map[types.NamespacedName]*ngfAPIv1alpha1.RouteTest),
+		HTTPBodyTransforms: make(map[types.NamespacedName]*ngfAPIv1alpha1.HTTPBodyTransform),
+		DirectResponses:    make(map[types.NamespacedName]*ngfAPIv1alpha1.DirectResponse),
 	}
 
 	processor := &ChangeProcessorImpl{
@@ -167,6 +191,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.BackendTLSPolicies),
 				predicate: nil,
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&v1alpha2.BackendLBPolicy{}),
+				store:     newObjectStoreMapAdapter(clusterStore.BackendLBPolicies),
+				predicate: nil,
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&v1.GRPCRoute{}),
 				store:     newObjectStoreMapAdapter(clusterStore.GRPCRoutes),
@@ -222,16 +251,81 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     commonPolicyObjectStore,
 				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CacheControlPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.StaticContentPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ListenerTLSPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ResponseCompressionPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.RateLimitPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ConnectionLimitPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&v1alpha2.TLSRoute{}),
 				store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
 				predicate: nil,
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&v1alpha2.TCPRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.TCPRoutes),
+				predicate: nil,
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&v1alpha2.UDPRoute{}),
+				store:     newObjectStoreMapAdapter(clusterStore.UDPRoutes),
+				predicate: nil,
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.SnippetsFilter{}),
 				store:     newObjectStoreMapAdapter(clusterStore.SnippetsFilters),
 				predicate: nil, // we always want to write status to SnippetsFilters so we don't filter them out
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.RouteTest{}),
+				store:     newObjectStoreMapAdapter(clusterStore.RouteTests),
+				predicate: nil, // we always want to write the results to RouteTests so we don't filter them out
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.HTTPBodyTransform{}),
+				store:     newObjectStoreMapAdapter(clusterStore.HTTPBodyTransforms),
+				predicate: nil, // we always want to write status to HTTPBodyTransforms so we don't filter them out
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.DirectResponse{}),
+				store:     newObjectStoreMapAdapter(clusterStore.DirectResponses),
+				predicate: nil, // we always want to write status to DirectResponses so we don't filter them out
+			},
 		},
 	)
 
@@ -275,6 +369,17 @@ func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 	defer c.lock.Unlock()
 
 	changeType := c.getAndResetClusterStateChanged()
+
+	var artifactFetcher graph.ArtifactFetcher
+	if c.cfg.ArtifactFetcher != nil {
+		artifactFetcher = c.cfg.ArtifactFetcher
+
+		// the Graph must be rebuilt to pick up the fetched artifacts
+		if c.cfg.ArtifactFetcher.TakeUpdated() && changeType == NoChange {
+			changeType = ClusterStateChange
+		}
+	}
+
 	if changeType == NoChange {
 		return NoChange, nil
 	}
@@ -283,9 +388,14 @@ func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 		c.clusterState,
 		c.cfg.GatewayCtlrName,
 		c.cfg.GatewayClassName,
+		c.cfg.GatewaySelector,
 		c.cfg.PlusSecrets,
 		c.cfg.Validators,
 		c.cfg.ProtectedPorts,
+		c.cfg.QUICSupported,
+		c.cfg.AllowExternalNameServices,
+		c.cfg.AllowTemplateOverrides,
+		artifactFetcher,
 	)
 
 	return changeType, c.latestGraph
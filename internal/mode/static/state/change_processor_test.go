@@ -1,6 +1,8 @@
 package state_test
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -27,6 +29,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/artifacts"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
@@ -343,6 +346,14 @@ func getListenerByName(gw *graph.Gateway, name string) *graph.Listener {
 	return nil
 }
 
+// addInvalidListenerConditions adds the InvalidListener condition to the attachments of the parentRefs,
+// which are attached to the Gateway, but not to all of its Listeners.
+func addInvalidListenerConditions(parentRefs []graph.ParentRef) {
+	for _, ref := range parentRefs {
+		ref.Attachment.Conditions = append(ref.Attachment.Conditions, staticConds.NewRouteInvalidListener())
+	}
+}
+
 var (
 	cert = []byte(`-----BEGIN CERTIFICATE-----
 MIIDLjCCAhYCCQDAOF9tLsaXWjANBgkqhkiG9w0BAQsFADBaMQswCQYDVQQGEwJV
@@ -393,6 +404,21 @@ cpLlHMAqbLJ8WYGJCkhiWxyal6hYTyWY4cVkC0xtTl/hUE9IeNKo
 -----END RSA PRIVATE KEY-----`)
 )
 
+type fakeArtifactFetcher struct {
+	updated bool
+}
+
+func (f *fakeArtifactFetcher) Get(_ artifacts.Reference) ([]byte, error) {
+	return nil, artifacts.ErrPending
+}
+
+func (f *fakeArtifactFetcher) TakeUpdated() bool {
+	updated := f.updated
+	f.updated = false
+
+	return updated
+}
+
 var _ = Describe("ChangeProcessor", func() {
 	// graph outputs are large, so allow gomega to print everything on test failure
 	format.MaxLength = 0
@@ -432,6 +458,7 @@ var _ = Describe("ChangeProcessor", func() {
 				secretRefGrant, hrServiceRefGrant                          *v1beta1.ReferenceGrant
 				grServiceRefGrant, trServiceRefGrant                       *v1beta1.ReferenceGrant
 				expGraph                                                   *graph.Graph
+				expMergedGw2                                               *graph.Gateway
 				expRouteHR1, expRouteHR2                                   *graph.L7Route
 				expRouteGR1, expRouteGR2                                   *graph.L7Route
 				expRouteTR1, expRouteTR2                                   *graph.L4Route
@@ -919,12 +946,13 @@ var _ = Describe("ChangeProcessor", func() {
 						Source: gw1,
 						Listeners: []*graph.Listener{
 							{
-								Name:       httpListenerName,
-								Source:     gw1.Spec.Listeners[0],
-								Valid:      true,
-								Attachable: true,
-								Routes:     map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
-								L4Routes:   map[graph.L4RouteKey]*graph.L4Route{},
+								Name:        httpListenerName,
+								GatewayName: client.ObjectKeyFromObject(gw1),
+								Source:      gw1.Spec.Listeners[0],
+								Valid:       true,
+								Attachable:  true,
+								Routes:      map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
+								L4Routes:    map[graph.L4RouteKey]*graph.L4Route{},
 								SupportedKinds: []v1.RouteGroupKind{
 									{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 									{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
@@ -932,6 +960,7 @@ var _ = Describe("ChangeProcessor", func() {
 							},
 							{
 								Name:           httpsListenerName,
+								GatewayName:    client.ObjectKeyFromObject(gw1),
 								Source:         gw1.Spec.Listeners[1],
 								Valid:          true,
 								Attachable:     true,
@@ -944,12 +973,13 @@ var _ = Describe("ChangeProcessor", func() {
 								},
 							},
 							{
-								Name:       tlsListenerName,
-								Source:     gw1.Spec.Listeners[2],
-								Valid:      true,
-								Attachable: true,
-								Routes:     map[graph.RouteKey]*graph.L7Route{},
-								L4Routes:   map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
+								Name:        tlsListenerName,
+								GatewayName: client.ObjectKeyFromObject(gw1),
+								Source:      gw1.Spec.Listeners[2],
+								Valid:       true,
+								Attachable:  true,
+								Routes:      map[graph.RouteKey]*graph.L7Route{},
+								L4Routes:    map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
 								SupportedKinds: []v1.RouteGroupKind{
 									{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 								},
@@ -957,7 +987,6 @@ var _ = Describe("ChangeProcessor", func() {
 						},
 						Valid: true,
 					},
-					IgnoredGateways:   map[types.NamespacedName]*v1.Gateway{},
 					L4Routes:          map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
 					Routes:            map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{},
@@ -967,6 +996,66 @@ var _ = Describe("ChangeProcessor", func() {
 						refGRPCSvc: {},
 					},
 				}
+
+				conflictMsg := func(listenerName string, port int) string {
+					return fmt.Sprintf(
+						"Listener %q of Gateway test/gateway-1 uses the same port %d with an overlapping hostname; "+
+							"ensure listeners for the same port across Gateways specify distinct hostnames",
+						listenerName,
+						port,
+					)
+				}
+
+				// The listeners of the second Gateway conflict with the listeners of the first Gateway,
+				// because none of them specify a hostname.
+				expMergedGw2 = &graph.Gateway{
+					Source: gw2,
+					Listeners: []*graph.Listener{
+						{
+							Name:        httpListenerName,
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[0],
+							Valid:       false,
+							Attachable:  true,
+							Conditions:  staticConds.NewListenerHostnameConflict(conflictMsg(httpListenerName, 80)),
+							Routes:      map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:    map[graph.L4RouteKey]*graph.L4Route{},
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+						},
+						{
+							Name:           httpsListenerName,
+							GatewayName:    client.ObjectKeyFromObject(gw2),
+							Source:         gw2.Spec.Listeners[1],
+							Valid:          false,
+							Attachable:     true,
+							Conditions:     staticConds.NewListenerHostnameConflict(conflictMsg(httpsListenerName, 443)),
+							Routes:         map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:       map[graph.L4RouteKey]*graph.L4Route{},
+							ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(sameNsTLSSecret)),
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+						},
+						{
+							Name:        tlsListenerName,
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[2],
+							Valid:       false,
+							Attachable:  true,
+							Conditions:  staticConds.NewListenerHostnameConflict(conflictMsg(tlsListenerName, 8443)),
+							Routes:      map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:    map[graph.L4RouteKey]*graph.L4Route{},
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+						},
+					},
+					Valid: true,
+				}
 			})
 			When("no upsert has occurred", func() {
 				It("returns nil graph", func() {
@@ -1104,6 +1193,7 @@ var _ = Describe("ChangeProcessor", func() {
 						AcceptedHostnames: map[string][]string{
 							httpsListenerName: {"foo.example.com"},
 						},
+						Conditions:   []conditions.Condition{staticConds.NewRouteInvalidListener()},
 						Attached:     true,
 						ListenerPort: 443,
 					}
@@ -1116,7 +1206,6 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// no ref grant exists yet for hr1
 					expGraph.Routes[httpRouteKey1].Conditions = []conditions.Condition{
-						staticConds.NewRouteInvalidListener(),
 						staticConds.NewRouteBackendRefRefNotPermitted(
 							"Backend ref to Service service-ns/service not permitted by any ReferenceGrant",
 						),
@@ -1126,7 +1215,6 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// no ref grant exists yet for gr1
 					expGraph.Routes[grpcRouteKey1].Conditions = []conditions.Condition{
-						staticConds.NewRouteInvalidListener(),
 						staticConds.NewRouteBackendRefRefNotPermitted(
 							"Backend ref to Service grpc-service-ns/grpc-service not permitted by any ReferenceGrant",
 						),
@@ -1443,13 +1531,17 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph using first gateway", func() {
 					processor.CaptureUpsertChange(gw2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
+					expGraph.MergedGateways = map[types.NamespacedName]*graph.Gateway{
+						{Namespace: "test", Name: "gateway-2"}: expMergedGw2,
 					}
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source:     diffNsTLSSecret,
 						CertBundle: diffNsTLSCert,
 					}
+					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
+						Source:     sameNsTLSSecret,
+						CertBundle: sameNsTLSCert,
+					}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1458,22 +1550,24 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(hr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
+					expGraph.MergedGateways = map[types.NamespacedName]*graph.Gateway{
+						{Namespace: "test", Name: "gateway-2"}: expMergedGw2,
 					}
+					// the route only attaches to the conflicted listeners of the second Gateway
+					addInvalidListenerConditions(expRouteHR2.ParentRefs)
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
+
+					expMergedGw2.Listeners[0].Routes[httpRouteKey2] = expRouteHR2
+					expMergedGw2.Listeners[1].Routes[httpRouteKey2] = expRouteHR2
+
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source:     diffNsTLSSecret,
 						CertBundle: diffNsTLSCert,
 					}
+					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
+						Source:     sameNsTLSSecret,
+						CertBundle: sameNsTLSCert,
+					}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1482,33 +1576,28 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(gr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
+					expGraph.MergedGateways = map[types.NamespacedName]*graph.Gateway{
+						{Namespace: "test", Name: "gateway-2"}: expMergedGw2,
 					}
+					// the route only attaches to the conflicted listeners of the second Gateway
+					addInvalidListenerConditions(expRouteHR2.ParentRefs)
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-
+					addInvalidListenerConditions(expRouteGR2.ParentRefs)
 					expGraph.Routes[grpcRouteKey2] = expRouteGR2
-					expGraph.Routes[grpcRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[grpcRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
+
+					expMergedGw2.Listeners[0].Routes[httpRouteKey2] = expRouteHR2
+					expMergedGw2.Listeners[1].Routes[httpRouteKey2] = expRouteHR2
+					expMergedGw2.Listeners[0].Routes[grpcRouteKey2] = expRouteGR2
+					expMergedGw2.Listeners[1].Routes[grpcRouteKey2] = expRouteGR2
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source:     diffNsTLSSecret,
 						CertBundle: diffNsTLSCert,
 					}
+					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
+						Source:     sameNsTLSSecret,
+						CertBundle: sameNsTLSCert,
+					}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1517,39 +1606,31 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(tr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
+					expGraph.MergedGateways = map[types.NamespacedName]*graph.Gateway{
+						{Namespace: "test", Name: "gateway-2"}: expMergedGw2,
 					}
+					// the route only attaches to the conflicted listeners of the second Gateway
+					addInvalidListenerConditions(expRouteHR2.ParentRefs)
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-
+					addInvalidListenerConditions(expRouteGR2.ParentRefs)
 					expGraph.Routes[grpcRouteKey2] = expRouteGR2
-					expGraph.Routes[grpcRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[grpcRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-
+					addInvalidListenerConditions(expRouteTR2.ParentRefs)
 					expGraph.L4Routes[trKey2] = expRouteTR2
-					expGraph.L4Routes[trKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
+
+					expMergedGw2.Listeners[0].Routes[httpRouteKey2] = expRouteHR2
+					expMergedGw2.Listeners[1].Routes[httpRouteKey2] = expRouteHR2
+					expMergedGw2.Listeners[0].Routes[grpcRouteKey2] = expRouteGR2
+					expMergedGw2.Listeners[1].Routes[grpcRouteKey2] = expRouteGR2
+					expMergedGw2.Listeners[2].L4Routes[trKey2] = expRouteTR2
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source:     diffNsTLSSecret,
 						CertBundle: diffNsTLSCert,
 					}
+					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
+						Source:     sameNsTLSSecret,
+						CertBundle: sameNsTLSCert,
+					}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1568,6 +1649,9 @@ var _ = Describe("ChangeProcessor", func() {
 					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
 
 					expGraph.Gateway.Source = gw2
+					listener80.GatewayName = client.ObjectKeyFromObject(gw2)
+					listener443.GatewayName = client.ObjectKeyFromObject(gw2)
+					tlsListener.GatewayName = client.ObjectKeyFromObject(gw2)
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1623,6 +1707,9 @@ var _ = Describe("ChangeProcessor", func() {
 					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
 
 					expGraph.Gateway.Source = gw2
+					listener80.GatewayName = client.ObjectKeyFromObject(gw2)
+					listener443.GatewayName = client.ObjectKeyFromObject(gw2)
+					tlsListener.GatewayName = client.ObjectKeyFromObject(gw2)
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1673,6 +1760,9 @@ var _ = Describe("ChangeProcessor", func() {
 					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
 
 					expGraph.Gateway.Source = gw2
+					listener80.GatewayName = client.ObjectKeyFromObject(gw2)
+					listener443.GatewayName = client.ObjectKeyFromObject(gw2)
+					tlsListener.GatewayName = client.ObjectKeyFromObject(gw2)
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1718,6 +1808,9 @@ var _ = Describe("ChangeProcessor", func() {
 					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
 
 					expGraph.Gateway.Source = gw2
+					listener80.GatewayName = client.ObjectKeyFromObject(gw2)
+					listener443.GatewayName = client.ObjectKeyFromObject(gw2)
+					tlsListener.GatewayName = client.ObjectKeyFromObject(gw2)
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1969,6 +2062,47 @@ var _ = Describe("ChangeProcessor", func() {
 					testUpsertTriggersChange(hr1slice2, state.EndpointsOnlyChange)
 				})
 			})
+			When("the port mapping of an hr1 endpoint slice changes", func() {
+				It("should trigger a cluster state change", func() {
+					slice := hr1slice2.DeepCopy()
+					slice.Ports = []discoveryV1.EndpointPort{
+						{
+							Name: helpers.GetPointer("http"),
+							Port: helpers.GetPointer[int32](8080),
+						},
+					}
+
+					testUpsertTriggersChange(slice, state.ClusterStateChange)
+				})
+			})
+			When("the endpoints of an hr1 endpoint slice change without a change of the port mapping", func() {
+				It("should trigger an endpoints only change", func() {
+					slice := hr1slice2.DeepCopy()
+					slice.Ports = []discoveryV1.EndpointPort{
+						{
+							Name: helpers.GetPointer("http"),
+							Port: helpers.GetPointer[int32](8080),
+						},
+					}
+					slice.Endpoints = []discoveryV1.Endpoint{{Addresses: []string{"10.0.0.1"}}}
+
+					testUpsertTriggersChange(slice, state.EndpointsOnlyChange)
+				})
+			})
+			When("the named port of an hr1 endpoint slice is mapped to another port", func() {
+				It("should trigger a cluster state change", func() {
+					slice := hr1slice2.DeepCopy()
+					slice.Ports = []discoveryV1.EndpointPort{
+						{
+							Name: helpers.GetPointer("http"),
+							Port: helpers.GetPointer[int32](9090),
+						},
+					}
+					slice.Endpoints = []discoveryV1.Endpoint{{Addresses: []string{"10.0.0.1"}}}
+
+					testUpsertTriggersChange(slice, state.ClusterStateChange)
+				})
+			})
 			When("an endpoint slice with a missing svc name label is added", func() {
 				It("should not trigger a change", func() {
 					testUpsertTriggersChange(missingSvcNameSlice, state.NoChange)
@@ -2384,13 +2518,21 @@ var _ = Describe("ChangeProcessor", func() {
 					},
 				},
 			}
+			// the graph defaults the IP family of the NginxProxy of the GatewayClass
+			withDefaultIPFamily := func(np *ngfAPIv1alpha1.NginxProxy) *ngfAPIv1alpha1.NginxProxy {
+				npCopy := np.DeepCopy()
+				npCopy.Spec.IPFamily = helpers.GetPointer(ngfAPIv1alpha1.Dual)
+
+				return npCopy
+			}
+
 			It("handles upserts for an NginxProxy", func() {
 				processor.CaptureUpsertChange(np)
 				processor.CaptureUpsertChange(paramGC)
 
 				changed, graph := processor.Process()
 				Expect(changed).To(Equal(state.ClusterStateChange))
-				Expect(graph.NginxProxy.Source).To(Equal(np))
+				Expect(graph.NginxProxy.Source).To(Equal(withDefaultIPFamily(np)))
 			})
 			It("captures changes for an NginxProxy", func() {
 				processor.CaptureUpsertChange(npUpdated)
@@ -2398,7 +2540,7 @@ var _ = Describe("ChangeProcessor", func() {
 
 				changed, graph := processor.Process()
 				Expect(changed).To(Equal(state.ClusterStateChange))
-				Expect(graph.NginxProxy.Source).To(Equal(npUpdated))
+				Expect(graph.NginxProxy.Source).To(Equal(withDefaultIPFamily(npUpdated)))
 			})
 			It("handles deletes for an NginxProxy", func() {
 				processor.CaptureDeleteChange(np, client.ObjectKeyFromObject(np))
@@ -2411,13 +2553,17 @@ var _ = Describe("ChangeProcessor", func() {
 
 		Describe("NGF Policy resource changes", Ordered, func() {
 			var (
-				gw                     *v1.Gateway
-				route                  *v1.HTTPRoute
-				svc                    *apiv1.Service
-				csp, cspUpdated        *ngfAPIv1alpha1.ClientSettingsPolicy
-				obs, obsUpdated        *ngfAPIv1alpha2.ObservabilityPolicy
-				usp, uspUpdated        *ngfAPIv1alpha1.UpstreamSettingsPolicy
-				cspKey, obsKey, uspKey graph.PolicyKey
+				gw                                                     *v1.Gateway
+				route                                                  *v1.HTTPRoute
+				svc                                                    *apiv1.Service
+				csp, cspUpdated                                        *ngfAPIv1alpha1.ClientSettingsPolicy
+				obs, obsUpdated                                        *ngfAPIv1alpha2.ObservabilityPolicy
+				usp, uspUpdated                                        *ngfAPIv1alpha1.UpstreamSettingsPolicy
+				ccp, ccpUpdated                                        *ngfAPIv1alpha1.CacheControlPolicy
+				scp, scpUpdated                                        *ngfAPIv1alpha1.StaticContentPolicy
+				ltp, ltpUpdated                                        *ngfAPIv1alpha1.ListenerTLSPolicy
+				epp, eppUpdated                                        *ngfAPIv1alpha1.ErrorPagePolicy
+				cspKey, obsKey, uspKey, ccpKey, scpKey, ltpKey, eppKey graph.PolicyKey
 			)
 
 			BeforeAll(func() {
@@ -2539,6 +2685,131 @@ var _ = Describe("ChangeProcessor", func() {
 						Version: "v1alpha1",
 					},
 				}
+
+				ccp = &ngfAPIv1alpha1.CacheControlPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ccp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.CacheControlPolicySpec{
+						CacheControl: []ngfAPIv1alpha1.CacheControlDirective{"public"},
+						TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+							{
+								Group: v1.GroupName,
+								Kind:  kinds.HTTPRoute,
+								Name:  "hr-1",
+							},
+						},
+					},
+				}
+
+				ccpUpdated = ccp.DeepCopy()
+				ccpUpdated.Spec.CacheControl = []ngfAPIv1alpha1.CacheControlDirective{"no-store"}
+
+				ccpKey = graph.PolicyKey{
+					NsName: types.NamespacedName{Name: "ccp", Namespace: "test"},
+					GVK: schema.GroupVersionKind{
+						Group:   ngfAPIv1alpha1.GroupName,
+						Kind:    kinds.CacheControlPolicy,
+						Version: "v1alpha1",
+					},
+				}
+
+				scp = &ngfAPIv1alpha1.StaticContentPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "scp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.StaticContentPolicySpec{
+						Source: ngfAPIv1alpha1.StaticContentSource{
+							Type:   ngfAPIv1alpha1.StaticContentSourceVolume,
+							Volume: &ngfAPIv1alpha1.StaticContentVolume{Path: "/usr/share/nginx/static/content"},
+						},
+						TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+							{
+								Group: v1.GroupName,
+								Kind:  kinds.HTTPRoute,
+								Name:  "hr-1",
+							},
+						},
+					},
+				}
+
+				scpUpdated = scp.DeepCopy()
+				scpUpdated.Spec.Index = helpers.GetPointer("home.html")
+
+				scpKey = graph.PolicyKey{
+					NsName: types.NamespacedName{Name: "scp", Namespace: "test"},
+					GVK: schema.GroupVersionKind{
+						Group:   ngfAPIv1alpha1.GroupName,
+						Kind:    kinds.StaticContentPolicy,
+						Version: "v1alpha1",
+					},
+				}
+
+				ltp = &ngfAPIv1alpha1.ListenerTLSPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ltp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.ListenerTLSPolicySpec{
+						Hostnames: []v1.Hostname{"admin.example.com"},
+						Protocols: []ngfAPIv1alpha1.TLSProtocolType{ngfAPIv1alpha1.TLSProtocolTLSv13},
+						TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+							{
+								Group: v1.GroupName,
+								Kind:  kinds.Gateway,
+								Name:  "gw",
+							},
+						},
+					},
+				}
+
+				ltpUpdated = ltp.DeepCopy()
+				ltpUpdated.Spec.Hostnames = []v1.Hostname{"*.example.com"}
+
+				ltpKey = graph.PolicyKey{
+					NsName: types.NamespacedName{Name: "ltp", Namespace: "test"},
+					GVK: schema.GroupVersionKind{
+						Group:   ngfAPIv1alpha1.GroupName,
+						Kind:    kinds.ListenerTLSPolicy,
+						Version: "v1alpha1",
+					},
+				}
+
+				epp = &ngfAPIv1alpha1.ErrorPagePolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "epp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+						ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+							{
+								Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{500, 502},
+								Response: &ngfAPIv1alpha1.ErrorPageResponse{Body: "Something went wrong"},
+							},
+						},
+						TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+							{
+								Group: v1.GroupName,
+								Kind:  kinds.HTTPRoute,
+								Name:  "hr-1",
+							},
+						},
+					},
+				}
+
+				eppUpdated = epp.DeepCopy()
+				eppUpdated.Spec.ErrorPages[0].Response.StatusCode = helpers.GetPointer[int32](503)
+
+				eppKey = graph.PolicyKey{
+					NsName: types.NamespacedName{Name: "epp", Namespace: "test"},
+					GVK: schema.GroupVersionKind{
+						Group:   ngfAPIv1alpha1.GroupName,
+						Kind:    kinds.ErrorPagePolicy,
+						Version: "v1alpha1",
+					},
+				}
 			})
 
 			/*
@@ -2552,6 +2823,10 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureUpsertChange(csp)
 					processor.CaptureUpsertChange(obs)
 					processor.CaptureUpsertChange(usp)
+					processor.CaptureUpsertChange(ccp)
+					processor.CaptureUpsertChange(scp)
+					processor.CaptureUpsertChange(ltp)
+					processor.CaptureUpsertChange(epp)
 
 					changed, _ := processor.Process()
 					Expect(changed).To(Equal(state.NoChange))
@@ -2565,13 +2840,24 @@ var _ = Describe("ChangeProcessor", func() {
 					Expect(changed).To(Equal(state.ClusterStateChange))
 					Expect(graph.NGFPolicies).To(HaveKey(cspKey))
 					Expect(graph.NGFPolicies[cspKey].Source).To(Equal(csp))
+					Expect(graph.NGFPolicies).To(HaveKey(ltpKey))
+					Expect(graph.NGFPolicies[ltpKey].Source).To(Equal(ltp))
 					Expect(graph.NGFPolicies).ToNot(HaveKey(obsKey))
+					Expect(graph.NGFPolicies).ToNot(HaveKey(ccpKey))
+					Expect(graph.NGFPolicies).ToNot(HaveKey(scpKey))
+					Expect(graph.NGFPolicies).ToNot(HaveKey(eppKey))
 
 					processor.CaptureUpsertChange(route)
 					changed, graph = processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
 					Expect(graph.NGFPolicies).To(HaveKey(obsKey))
 					Expect(graph.NGFPolicies[obsKey].Source).To(Equal(obs))
+					Expect(graph.NGFPolicies).To(HaveKey(ccpKey))
+					Expect(graph.NGFPolicies[ccpKey].Source).To(Equal(ccp))
+					Expect(graph.NGFPolicies).To(HaveKey(scpKey))
+					Expect(graph.NGFPolicies[scpKey].Source).To(Equal(scp))
+					Expect(graph.NGFPolicies).To(HaveKey(eppKey))
+					Expect(graph.NGFPolicies[eppKey].Source).To(Equal(epp))
 
 					processor.CaptureUpsertChange(svc)
 					changed, graph = processor.Process()
@@ -2585,6 +2871,10 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureUpsertChange(cspUpdated)
 					processor.CaptureUpsertChange(obsUpdated)
 					processor.CaptureUpsertChange(uspUpdated)
+					processor.CaptureUpsertChange(ccpUpdated)
+					processor.CaptureUpsertChange(scpUpdated)
+					processor.CaptureUpsertChange(ltpUpdated)
+					processor.CaptureUpsertChange(eppUpdated)
 
 					changed, graph := processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
@@ -2594,6 +2884,14 @@ var _ = Describe("ChangeProcessor", func() {
 					Expect(graph.NGFPolicies[obsKey].Source).To(Equal(obsUpdated))
 					Expect(graph.NGFPolicies).To(HaveKey(uspKey))
 					Expect(graph.NGFPolicies[uspKey].Source).To(Equal(uspUpdated))
+					Expect(graph.NGFPolicies).To(HaveKey(ccpKey))
+					Expect(graph.NGFPolicies[ccpKey].Source).To(Equal(ccpUpdated))
+					Expect(graph.NGFPolicies).To(HaveKey(scpKey))
+					Expect(graph.NGFPolicies[scpKey].Source).To(Equal(scpUpdated))
+					Expect(graph.NGFPolicies).To(HaveKey(ltpKey))
+					Expect(graph.NGFPolicies[ltpKey].Source).To(Equal(ltpUpdated))
+					Expect(graph.NGFPolicies).To(HaveKey(eppKey))
+					Expect(graph.NGFPolicies[eppKey].Source).To(Equal(eppUpdated))
 				})
 			})
 			When("the policy is deleted", func() {
@@ -2601,6 +2899,10 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureDeleteChange(&ngfAPIv1alpha1.ClientSettingsPolicy{}, client.ObjectKeyFromObject(csp))
 					processor.CaptureDeleteChange(&ngfAPIv1alpha2.ObservabilityPolicy{}, client.ObjectKeyFromObject(obs))
 					processor.CaptureDeleteChange(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}, client.ObjectKeyFromObject(usp))
+					processor.CaptureDeleteChange(&ngfAPIv1alpha1.CacheControlPolicy{}, client.ObjectKeyFromObject(ccp))
+					processor.CaptureDeleteChange(&ngfAPIv1alpha1.StaticContentPolicy{}, client.ObjectKeyFromObject(scp))
+					processor.CaptureDeleteChange(&ngfAPIv1alpha1.ListenerTLSPolicy{}, client.ObjectKeyFromObject(ltp))
+					processor.CaptureDeleteChange(&ngfAPIv1alpha1.ErrorPagePolicy{}, client.ObjectKeyFromObject(epp))
 
 					changed, graph := processor.Process()
 					Expect(changed).To(Equal(state.ClusterStateChange))
@@ -3241,6 +3543,38 @@ var _ = Describe("ChangeProcessor", func() {
 			)
 		})
 	})
+	Describe("Processing fetched OCI artifacts", func() {
+		var (
+			processor *state.ChangeProcessorImpl
+			fetcher   *fakeArtifactFetcher
+		)
+
+		BeforeEach(func() {
+			fetcher = &fakeArtifactFetcher{}
+			processor = state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+				GatewayCtlrName:  "test.controller",
+				GatewayClassName: "test-class",
+				Validators:       createAlwaysValidValidators(),
+				MustExtractGVK:   kinds.NewMustExtractGKV(createScheme()),
+				ArtifactFetcher:  fetcher,
+			})
+		})
+
+		It("rebuilds the graph when an artifact is fetched", func() {
+			changed, _ := processor.Process()
+			Expect(changed).To(Equal(state.NoChange))
+
+			fetcher.updated = true
+
+			changed, graph := processor.Process()
+			Expect(changed).To(Equal(state.ClusterStateChange))
+			Expect(graph).ToNot(BeNil())
+			Expect(fetcher.updated).To(BeFalse())
+
+			changed, _ = processor.Process()
+			Expect(changed).To(Equal(state.NoChange))
+		})
+	})
 	Describe("Edge cases with panic", func() {
 		var processor state.ChangeProcessor
 
@@ -3262,7 +3596,7 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&v1alpha2.TCPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tcp"}},
+				&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod"}},
 			),
 			Entry(
 				"nil resource",
@@ -3280,8 +3614,8 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&v1alpha2.TCPRoute{},
-				types.NamespacedName{Namespace: "test", Name: "tcp"},
+				&apiv1.Pod{},
+				types.NamespacedName{Namespace: "test", Name: "pod"},
 			),
 			Entry(
 				"nil resource type",
@@ -2,6 +2,7 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -53,18 +54,91 @@ const (
 	// invalid. Used with ResolvedRefs (false).
 	RouteReasonInvalidFilter v1.RouteConditionReason = "InvalidFilter"
 
-	// GatewayReasonGatewayConflict indicates there are multiple Gateway resources to choose from,
-	// and we ignored the resource in question and picked another Gateway as the winner.
-	// This reason is used with GatewayConditionAccepted (false).
-	GatewayReasonGatewayConflict v1.GatewayConditionReason = "GatewayConflict"
+	// RouteConditionConflicted is a custom condition type that indicates that the Route conflicts with
+	// the configuration of other Routes.
+	RouteConditionConflicted v1.RouteConditionType = "Conflicted"
 
-	// GatewayMessageGatewayConflict is a message that describes GatewayReasonGatewayConflict.
-	GatewayMessageGatewayConflict = "The resource is ignored due to a conflicting Gateway resource"
+	// RouteReasonRulesShadowed is used with the "Conflicted" (true) condition when some matches of the Route rules
+	// are identical to matches with a higher precedence, so they never receive traffic.
+	RouteReasonRulesShadowed v1.RouteConditionReason = "RulesShadowed"
+
+	// RouteConditionSnippetsConflicted is a custom condition type that indicates that the SnippetsFilters of
+	// a Route rule conflict with each other or with the other filters of the rule. It is separate from
+	// the "Conflicted" condition, so that a Route can report both kinds of conflicts.
+	RouteConditionSnippetsConflicted v1.RouteConditionType = "SnippetsConflicted"
+
+	// RouteReasonSnippetsConflicted is used with the "SnippetsConflicted" (true) condition when several
+	// SnippetsFilters of a Route rule set the same NGINX directive in the same context.
+	RouteReasonSnippetsConflicted v1.RouteConditionReason = "SnippetsConflicted"
+
+	// RouteConditionSessionPersistenceIgnored is a custom condition type that indicates that the session
+	// persistence of some backends of the Route rules is ignored.
+	RouteConditionSessionPersistenceIgnored v1.RouteConditionType = "SessionPersistenceIgnored"
+
+	// RouteReasonLoadBalancingMethodOverridden is used with the "SessionPersistenceIgnored" (true) condition when
+	// the upstream snippet of a Route rule sets the load balancing method of the backends that have session
+	// persistence.
+	RouteReasonLoadBalancingMethodOverridden v1.RouteConditionReason = "LoadBalancingMethodOverridden"
+
+	// RouteConditionIgnoredFields is a custom condition type that indicates that some fields of the Route rules
+	// are invalid, so they are ignored. Unlike the dropped rules, the rules with the ignored fields still receive
+	// traffic.
+	RouteConditionIgnoredFields v1.RouteConditionType = "IgnoredFields"
+
+	// RouteReasonInvalidFields is used with the "IgnoredFields" (true) condition when some fields of the Route rules
+	// are invalid.
+	RouteReasonInvalidFields v1.RouteConditionReason = "InvalidFields"
+
+	// RouteConditionDebugCapture is a custom condition type that indicates whether the routing decisions of
+	// the requests of the Route are captured in the NGINX logs.
+	RouteConditionDebugCapture v1.RouteConditionType = "DebugCapture"
+
+	// RouteReasonDebugCaptureEnabled is used with the "DebugCapture" (true) condition when the debug capture
+	// annotations of the Route are valid.
+	RouteReasonDebugCaptureEnabled v1.RouteConditionReason = "Enabled"
+
+	// RouteReasonDebugCaptureInvalid is used with the "DebugCapture" (false) condition when the debug capture
+	// annotations of the Route are invalid.
+	RouteReasonDebugCaptureInvalid v1.RouteConditionReason = "Invalid"
+
+	// RouteConditionAnnotationsDeprecated is a custom condition type that indicates that the Route configures
+	// behaviors with annotations that CRD fields supersede.
+	RouteConditionAnnotationsDeprecated v1.RouteConditionType = "AnnotationsDeprecated"
+
+	// RouteReasonCRDEquivalentAvailable is used with the "AnnotationsDeprecated" (true) condition when some
+	// annotations of the Route configure behaviors that CRD fields support.
+	RouteReasonCRDEquivalentAvailable v1.RouteConditionReason = "CRDEquivalentAvailable"
+
+	// ListenerConditionCertificateMismatch is a custom condition type that indicates that the certificate of
+	// the Listener doesn't cover the hostname of the Listener. The Listener is still programmed, but clients
+	// that verify the certificate reject the connections.
+	ListenerConditionCertificateMismatch v1.ListenerConditionType = "CertificateMismatch"
+
+	// ListenerReasonHostnameNotInCertificate is used with the "CertificateMismatch" (true) condition when
+	// the Subject Alternative Names of the certificate of the Listener don't cover the hostname of the Listener.
+	ListenerReasonHostnameNotInCertificate v1.ListenerConditionReason = "HostnameNotInCertificate"
 
 	// GatewayReasonUnsupportedValue is used with GatewayConditionAccepted (false) when a value of a field in a Gateway
 	// is invalid or not supported.
 	GatewayReasonUnsupportedValue v1.GatewayConditionReason = "UnsupportedValue"
 
+	// GatewayConditionAnnotationsDeprecated is a custom condition type that indicates that the Gateway configures
+	// behaviors with annotations that CRD fields supersede.
+	GatewayConditionAnnotationsDeprecated v1.GatewayConditionType = "AnnotationsDeprecated"
+
+	// GatewayReasonCRDEquivalentAvailable is used with the "AnnotationsDeprecated" (true) condition when some
+	// annotations of the Gateway configure behaviors that CRD fields support.
+	GatewayReasonCRDEquivalentAvailable v1.GatewayConditionReason = "CRDEquivalentAvailable"
+
+	// GatewayConditionNginxProxySettingsIgnored is a custom condition type that indicates that some settings of
+	// the NginxProxy referenced by the Gateway are ignored, because the Gateway shares the NGINX configuration with
+	// the Gateway that has the highest priority.
+	GatewayConditionNginxProxySettingsIgnored v1.GatewayConditionType = "NginxProxySettingsIgnored"
+
+	// GatewayReasonSharedNginxConfiguration is used with the "NginxProxySettingsIgnored" (true) condition when
+	// the NginxProxy of the Gateway sets the settings of the shared NGINX configuration differently.
+	GatewayReasonSharedNginxConfiguration v1.GatewayConditionReason = "SharedNginxConfiguration"
+
 	// GatewayMessageFailedNginxReload is a message used with GatewayConditionProgrammed (false)
 	// when nginx fails to reload.
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
@@ -87,6 +161,31 @@ const (
 	// parametersRef resource does not exist.
 	GatewayClassReasonParamsRefNotFound v1.GatewayClassConditionReason = "ParametersRefNotFound"
 
+	// GatewayResolvedRefs condition indicates whether the controller was able to resolve the
+	// parametersRef on the Gateway.
+	GatewayResolvedRefs v1.GatewayConditionType = "ResolvedRefs"
+
+	// GatewayReasonResolvedRefs is used with the "GatewayResolvedRefs" condition when the condition is true.
+	GatewayReasonResolvedRefs v1.GatewayConditionReason = "ResolvedRefs"
+
+	// GatewayReasonParamsRefNotFound is used with the "GatewayResolvedRefs" condition when the
+	// parametersRef resource does not exist.
+	GatewayReasonParamsRefNotFound v1.GatewayConditionReason = "ParametersRefNotFound"
+
+	// GatewayPlusAPIDegraded condition indicates that the NGINX Plus API repeatedly failed to update
+	// the upstream servers, so NGINX is reloaded to update them instead.
+	GatewayPlusAPIDegraded v1.GatewayConditionType = "PlusAPIDegraded"
+
+	// GatewayReasonPlusAPIUpdateFailed is used with the "GatewayPlusAPIDegraded" condition when the condition is true.
+	GatewayReasonPlusAPIUpdateFailed v1.GatewayConditionReason = "PlusAPIUpdateFailed"
+
+	// GatewaySmokeTestFailed condition indicates that some of the smoke test probes that were sent through NGINX
+	// after the NGINX configuration was updated didn't get the expected responses.
+	GatewaySmokeTestFailed v1.GatewayConditionType = "SmokeTestFailed"
+
+	// GatewayReasonProbesFailed is used with the "GatewaySmokeTestFailed" condition when the condition is true.
+	GatewayReasonProbesFailed v1.GatewayConditionReason = "ProbesFailed"
+
 	// PolicyReasonNginxProxyConfigNotSet is used with the "PolicyAccepted" condition when the
 	// NginxProxy resource is missing or invalid.
 	PolicyReasonNginxProxyConfigNotSet v1alpha2.PolicyConditionReason = "NginxProxyConfigNotSet"
@@ -99,26 +198,19 @@ const (
 	// when telemetry is not enabled in the NginxProxy resource.
 	PolicyMessageTelemetryNotEnabled = "Telemetry is not enabled in the NginxProxy resource"
 
+	// PolicyConditionResolvedRefs is the condition type of a Policy that indicates whether the references
+	// of the Policy to other resources are resolved.
+	PolicyConditionResolvedRefs v1alpha2.PolicyConditionType = "ResolvedRefs"
+
+	// PolicyReasonRefNotPermitted is used with the "ResolvedRefs" condition when the Policy references a resource
+	// in another namespace and the reference is not permitted by any ReferenceGrant.
+	PolicyReasonRefNotPermitted v1alpha2.PolicyConditionReason = "RefNotPermitted"
+
 	// PolicyReasonTargetConflict is used with the "PolicyAccepted" condition when a Route that it targets
 	// has an overlapping hostname:port/path combination with another Route.
 	PolicyReasonTargetConflict v1alpha2.PolicyConditionReason = "TargetConflict"
-
-	// GatewayIgnoredReason is used with v1.RouteConditionAccepted when the route references a Gateway that is ignored
-	// by NGF.
-	GatewayIgnoredReason v1.RouteConditionReason = "GatewayIgnored"
 )
 
-// NewRouteNotAcceptedGatewayIgnored returns a Condition that indicates that the Route is not accepted by the Gateway
-// because the Gateway is ignored by NGF.
-func NewRouteNotAcceptedGatewayIgnored() conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1.RouteConditionAccepted),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(GatewayIgnoredReason),
-		Message: "The Gateway is ignored by the controller",
-	}
-}
-
 // NewDefaultRouteConditions returns the default conditions that must be present in the status of a Route.
 func NewDefaultRouteConditions() []conditions.Condition {
 	return []conditions.Condition{
@@ -206,6 +298,83 @@ func NewRouteHostnameConflict() conditions.Condition {
 	}
 }
 
+// NewRouteRulesShadowed returns a Condition that indicates that some matches of the Route rules are shadowed by
+// identical matches with a higher precedence.
+func NewRouteRulesShadowed(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionConflicted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonRulesShadowed),
+		Message: msg,
+	}
+}
+
+// NewRouteSnippetsConflicted returns a Condition that indicates that several SnippetsFilters of a Route rule set
+// the same NGINX directive in the same context.
+func NewRouteSnippetsConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionSnippetsConflicted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonSnippetsConflicted),
+		Message: msg,
+	}
+}
+
+// NewRouteSessionPersistenceIgnored returns a Condition that indicates that the upstream snippet of a Route rule
+// sets the load balancing method of the backends that have session persistence, so the session persistence
+// is ignored.
+func NewRouteSessionPersistenceIgnored(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionSessionPersistenceIgnored),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonLoadBalancingMethodOverridden),
+		Message: msg,
+	}
+}
+
+// NewRouteIgnoredFields returns a Condition that indicates that some invalid fields of the Route rules are ignored.
+func NewRouteIgnoredFields(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionIgnoredFields),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonInvalidFields),
+		Message: msg,
+	}
+}
+
+// NewRouteDebugCaptureEnabled returns a Condition that indicates that the routing decisions of the requests
+// of the Route are captured.
+func NewRouteDebugCaptureEnabled(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionDebugCapture),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonDebugCaptureEnabled),
+		Message: msg,
+	}
+}
+
+// NewRouteDebugCaptureInvalid returns a Condition that indicates that the debug capture annotations of the Route
+// are invalid, so the routing decisions of its requests are not captured.
+func NewRouteDebugCaptureInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionDebugCapture),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonDebugCaptureInvalid),
+		Message: msg,
+	}
+}
+
+// NewRouteAnnotationsDeprecated returns a Condition that indicates that some annotations of the Route configure
+// behaviors that CRD fields support. The annotations still take effect.
+func NewRouteAnnotationsDeprecated(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteConditionAnnotationsDeprecated),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonCRDEquivalentAvailable),
+		Message: msg,
+	}
+}
+
 // NewRouteResolvedRefs returns a Condition that indicates that all the references on the Route are resolved.
 func NewRouteResolvedRefs() conditions.Condition {
 	return conditions.Condition{
@@ -216,6 +385,17 @@ func NewRouteResolvedRefs() conditions.Condition {
 	}
 }
 
+// NewRouteResolvedRefsNoReadyEndpoints returns a Condition that indicates that all the references on the Route are
+// resolved, but some of the referenced backends don't have any ready endpoints, so the requests to them will fail.
+func NewRouteResolvedRefsNoReadyEndpoints(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1.RouteReasonResolvedRefs),
+		Message: "All references are resolved, but some backends have no ready endpoints: " + msg,
+	}
+}
+
 // NewRouteBackendRefInvalidKind returns a Condition that indicates that the Route has a backendRef with an
 // invalid kind.
 func NewRouteBackendRefInvalidKind(msg string) conditions.Condition {
@@ -420,6 +600,17 @@ func NewListenerInvalidCertificateRef(msg string) []conditions.Condition {
 	}
 }
 
+// NewListenerCertificateMismatch returns a Condition that indicates that the certificate of the Listener doesn't
+// cover the hostname of the Listener.
+func NewListenerCertificateMismatch(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ListenerConditionCertificateMismatch),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ListenerReasonHostnameNotInCertificate),
+		Message: msg,
+	}
+}
+
 // NewListenerInvalidRouteKinds returns Conditions that indicate that an invalid or unsupported Route kind is
 // specified by the Listener.
 func NewListenerInvalidRouteKinds(msg string) []conditions.Condition {
@@ -541,6 +732,51 @@ func NewGatewayClassInvalidParameters(msg string) conditions.Condition {
 	}
 }
 
+// NewGatewayResolvedRefs returns a Condition that indicates that the parametersRef
+// on the Gateway is resolved.
+func NewGatewayResolvedRefs() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayResolvedRefs),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonResolvedRefs),
+		Message: "parametersRef resource is resolved",
+	}
+}
+
+// NewGatewayRefNotFound returns a Condition that indicates that the parametersRef
+// on the Gateway could not be resolved.
+func NewGatewayRefNotFound() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonParamsRefNotFound),
+		Message: "parametersRef resource could not be found",
+	}
+}
+
+// NewGatewayInvalidParameters returns a Condition that indicates that the Gateway has invalid parameters.
+// Like for the GatewayClass, Accepted stays true, so that an invalid parametersRef doesn't nullify the
+// configuration of the Gateway.
+func NewGatewayInvalidParameters(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.GatewayConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1.GatewayReasonInvalidParameters),
+		Message: fmt.Sprintf("Gateway is accepted, but parametersRef is ignored due to an error: %s", msg),
+	}
+}
+
+// NewGatewayNginxProxySettingsIgnored returns a Condition that indicates that some settings of the NginxProxy
+// referenced by the Gateway are ignored. The Gateway stays accepted, and the other settings take effect.
+func NewGatewayNginxProxySettingsIgnored(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionNginxProxySettingsIgnored),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonSharedNginxConfiguration),
+		Message: msg,
+	}
+}
+
 // NewDefaultGatewayConditions returns the default Conditions that must be present in the status of a Gateway.
 func NewDefaultGatewayConditions() []conditions.Condition {
 	return []conditions.Condition{
@@ -559,19 +795,6 @@ func NewGatewayAccepted() conditions.Condition {
 	}
 }
 
-// NewGatewayConflict returns Conditions that indicate the Gateway has a conflict with another Gateway.
-func NewGatewayConflict() []conditions.Condition {
-	return []conditions.Condition{
-		{
-			Type:    string(v1.GatewayConditionAccepted),
-			Status:  metav1.ConditionFalse,
-			Reason:  string(GatewayReasonGatewayConflict),
-			Message: GatewayMessageGatewayConflict,
-		},
-		NewGatewayConflictNotProgrammed(),
-	}
-}
-
 // NewGatewayAcceptedListenersNotValid returns a Condition that indicates the Gateway is accepted,
 // but has at least one listener that is invalid.
 func NewGatewayAcceptedListenersNotValid() conditions.Condition {
@@ -631,6 +854,43 @@ func NewGatewayUnsupportedValue(msg string) []conditions.Condition {
 	}
 }
 
+// NewGatewayAnnotationsDeprecated returns a Condition that indicates that some annotations of the Gateway configure
+// behaviors that CRD fields support. The annotations still take effect.
+func NewGatewayAnnotationsDeprecated(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionAnnotationsDeprecated),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonCRDEquivalentAvailable),
+		Message: msg,
+	}
+}
+
+// NewGatewayUnsupportedAddress returns Conditions that indicate that the Gateway is not accepted and programmed,
+// because it requests an address that is not supported. The provided message contains the details of why
+// the address is not supported.
+func NewGatewayUnsupportedAddress(msg string) []conditions.Condition {
+	return []conditions.Condition{
+		{
+			Type:    string(v1.GatewayConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.GatewayReasonUnsupportedAddress),
+			Message: msg,
+		},
+		NewGatewayNotProgrammedInvalid(msg),
+	}
+}
+
+// NewGatewayNotProgrammedAddressNotUsable returns a Condition that indicates the Gateway is not programmed,
+// because the requested addresses are not assigned to the Service of the Gateway.
+func NewGatewayNotProgrammedAddressNotUsable(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.GatewayReasonAddressNotUsable),
+		Message: msg,
+	}
+}
+
 // NewGatewayProgrammed returns a Condition that indicates the Gateway is programmed.
 func NewGatewayProgrammed() conditions.Condition {
 	return conditions.Condition{
@@ -653,14 +913,26 @@ func NewGatewayNotProgrammedInvalid(msg string) conditions.Condition {
 	}
 }
 
-// NewGatewayConflictNotProgrammed returns a custom Programmed Condition that indicates the Gateway has a
-// conflict with another Gateway.
-func NewGatewayConflictNotProgrammed() conditions.Condition {
+// NewGatewayPlusAPIDegraded returns a Condition that indicates that the NGINX Plus API repeatedly failed to update
+// the upstream servers, so NGINX is reloaded to update them instead.
+func NewGatewayPlusAPIDegraded() conditions.Condition {
 	return conditions.Condition{
-		Type:    string(v1.GatewayConditionProgrammed),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(GatewayReasonGatewayConflict),
-		Message: GatewayMessageGatewayConflict,
+		Type:   string(GatewayPlusAPIDegraded),
+		Status: metav1.ConditionTrue,
+		Reason: string(GatewayReasonPlusAPIUpdateFailed),
+		Message: "The NGINX Plus API repeatedly failed to update the upstream servers; " +
+			"the upstream servers are updated by reloading NGINX instead",
+	}
+}
+
+// NewGatewaySmokeTestFailed returns a Condition that indicates that the smoke test probes with the failures
+// didn't get the expected responses after the NGINX configuration was updated.
+func NewGatewaySmokeTestFailed(failures []string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewaySmokeTestFailed),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonProbesFailed),
+		Message: "Smoke test probes failed after the NGINX configuration was updated: " + strings.Join(failures, "; "),
 	}
 }
 
@@ -684,6 +956,28 @@ func NewNginxGatewayInvalid(msg string) conditions.Condition {
 	}
 }
 
+// NewNginxGatewayApplied returns a Condition that indicates that the NginxGateway config is applied to
+// the control plane.
+func NewNginxGatewayApplied() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxGatewayConditionApplied),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.NginxGatewayReasonApplied),
+		Message: "NginxGateway is applied",
+	}
+}
+
+// NewNginxGatewayPending returns a Condition that indicates that the changes of the NginxGateway config are
+// not applied to the control plane, because the config is in the Manual apply mode.
+func NewNginxGatewayPending(changes []string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxGatewayConditionApplied),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.NginxGatewayReasonPending),
+		Message: "NginxGateway changes are pending: " + strings.Join(changes, "; "),
+	}
+}
+
 // NewPolicyAccepted returns a Condition that indicates that the Policy is accepted.
 func NewPolicyAccepted() conditions.Condition {
 	return conditions.Condition{
@@ -705,6 +999,17 @@ func NewPolicyInvalid(msg string) conditions.Condition {
 	}
 }
 
+// NewPolicyRefNotPermitted returns a Condition that indicates that the Policy references a resource in another
+// namespace and the reference is not permitted by any ReferenceGrant.
+func NewPolicyRefNotPermitted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(PolicyConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonRefNotPermitted),
+		Message: msg,
+	}
+}
+
 // NewPolicyConflicted returns a Condition that indicates that the Policy is not accepted because it conflicts with
 // another Policy and a merge is not possible.
 func NewPolicyConflicted(msg string) conditions.Condition {
@@ -760,6 +1065,28 @@ func NewSnippetsFilterInvalid(msg string) conditions.Condition {
 	}
 }
 
+// NewSnippetsFilterArtifactPending returns a Condition that indicates that the SnippetsFilter is not accepted because
+// the OCI artifacts of its snippets are not fetched yet.
+func NewSnippetsFilterArtifactPending(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.SnippetsFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.SnippetsFilterConditionReasonArtifactPending),
+		Message: msg,
+	}
+}
+
+// NewSnippetsFilterArtifactVerificationFailed returns a Condition that indicates that the SnippetsFilter is not
+// accepted because the signatures of the OCI artifacts of its snippets are not verified.
+func NewSnippetsFilterArtifactVerificationFailed(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.SnippetsFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.SnippetsFilterConditionReasonArtifactVerificationFailed),
+		Message: msg,
+	}
+}
+
 // NewSnippetsFilterAccepted returns a Condition that indicates that the SnippetsFilter is accepted because it is
 // valid.
 func NewSnippetsFilterAccepted() conditions.Condition {
@@ -770,3 +1097,111 @@ func NewSnippetsFilterAccepted() conditions.Condition {
 		Message: "SnippetsFilter is accepted",
 	}
 }
+
+// NewHTTPBodyTransformInvalid returns a Condition that indicates that the HTTPBodyTransform is not accepted because
+// it is syntactically or semantically invalid.
+func NewHTTPBodyTransformInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.HTTPBodyTransformConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.HTTPBodyTransformConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewHTTPBodyTransformAccepted returns a Condition that indicates that the HTTPBodyTransform is accepted because
+// it is valid.
+func NewHTTPBodyTransformAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.HTTPBodyTransformConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.HTTPBodyTransformConditionReasonAccepted),
+		Message: "HTTPBodyTransform is accepted",
+	}
+}
+
+// NewDirectResponseInvalid returns a Condition that indicates that the DirectResponse is not accepted because
+// it is syntactically or semantically invalid.
+func NewDirectResponseInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.DirectResponseConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.DirectResponseConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewDirectResponseAccepted returns a Condition that indicates that the DirectResponse is accepted because
+// it is valid.
+func NewDirectResponseAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.DirectResponseConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.DirectResponseConditionReasonAccepted),
+		Message: "DirectResponse is accepted",
+	}
+}
+
+// NewRouteTestPassed returns a Condition that indicates that all tests of the RouteTest passed.
+func NewRouteTestPassed() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.RouteTestConditionTypePassed),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.RouteTestConditionReasonPassed),
+		Message: "All tests passed",
+	}
+}
+
+// NewRouteTestFailed returns a Condition that indicates that some tests of the RouteTest failed.
+func NewRouteTestFailed(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.RouteTestConditionTypePassed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.RouteTestConditionReasonFailed),
+		Message: msg,
+	}
+}
+
+// NewNginxProxyAccepted returns a Condition that indicates that the NginxProxy is accepted because it is valid,
+// together with the settings inherited from its bases.
+func NewNginxProxyAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxProxyConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.NginxProxyConditionReasonAccepted),
+		Message: "NginxProxy is accepted",
+	}
+}
+
+// NewNginxProxyInvalid returns a Condition that indicates that the NginxProxy is not accepted because it is
+// invalid, together with the settings inherited from its bases.
+func NewNginxProxyInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxProxyConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.NginxProxyConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewNginxProxyBaseNotFound returns a Condition that indicates that the NginxProxy is not accepted because
+// a base NginxProxy in its chain of bases doesn't exist.
+func NewNginxProxyBaseNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxProxyConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.NginxProxyConditionReasonBaseNotFound),
+		Message: msg,
+	}
+}
+
+// NewNginxProxyBaseCycle returns a Condition that indicates that the NginxProxy is not accepted because
+// its chain of bases contains a cycle.
+func NewNginxProxyBaseCycle(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.NginxProxyConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.NginxProxyConditionReasonBaseCycle),
+		Message: msg,
+	}
+}
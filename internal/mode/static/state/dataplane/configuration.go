@@ -4,8 +4,14 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"hash/fnv"
+	"net/netip"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
+	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -15,6 +21,7 @@ import (
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
@@ -24,6 +31,52 @@ const (
 	wildcardHostname     = "~^"
 	alpineSSLRootCAPath  = "/etc/ssl/cert.pem"
 	defaultErrorLogLevel = "info"
+	// defaultStaticContentIndex is the index file of static content if a StaticContentPolicy doesn't specify one.
+	defaultStaticContentIndex = "index.html"
+	// defaultErrorPageContentType is the Content-Type of the custom response of an ErrorPagePolicy
+	// if the policy doesn't specify one.
+	defaultErrorPageContentType = "text/html"
+	// defaultErrorPageRedirectCode is the status code of the redirect of an ErrorPagePolicy
+	// if the policy doesn't specify one.
+	defaultErrorPageRedirectCode = 302
+	// defaultGRPCHealthCheckInterval is the interval of the active gRPC health checks of NGINX Plus
+	// if an UpstreamSettingsPolicy doesn't specify one.
+	defaultGRPCHealthCheckInterval = "5s"
+	// defaultAccessLogDestination is the destination of an access log if the NginxProxy doesn't specify one.
+	defaultAccessLogDestination = "/dev/stdout"
+	// cacheDir is the parent directory of the caches of the CachePolicies. NGINX only creates the last directory
+	// of the path of a cache, so the caches are placed directly in the directory, which exists in the container.
+	cacheDir = "/var/cache/nginx"
+	// defaultCacheKeysZoneSize is the size of the shared memory zone of a cache
+	// if a CachePolicy doesn't specify one.
+	defaultCacheKeysZoneSize = "10m"
+	// defaultCacheInactive is the time after which the cached responses that are not accessed are removed
+	// if a CachePolicy doesn't specify one.
+	defaultCacheInactive = "10m"
+	// defaultRateLimitZoneSize is the size of the shared memory zone of a rate limit
+	// if a RateLimitPolicy doesn't specify one.
+	defaultRateLimitZoneSize = "10m"
+
+	// defaultMaxRequestBodySize is the default maximum size in bytes of the request bodies that are transformed
+	// by an HTTPBodyTransform.
+	defaultMaxRequestBodySize = 1 << 20
+	// defaultMaxResponseBodySize is the default maximum size in bytes of the response bodies that are transformed
+	// by an HTTPBodyTransform.
+	defaultMaxResponseBodySize = 1 << 20
+	// defaultDirectResponseContentType is the media type of the body of a DirectResponse
+	// if the DirectResponse doesn't specify one.
+	defaultDirectResponseContentType = "text/plain"
+	// defaultConnectionLimitZoneSize is the size of the shared memory zone of a connection limit
+	// if a ConnectionLimitPolicy doesn't specify one.
+	defaultConnectionLimitZoneSize = "10m"
+)
+
+// The default sizes of the hash tables. The sizes computed from the hostnames are never lower.
+const (
+	defaultServerNamesHashMaxSize    = 1024
+	defaultServerNamesHashBucketSize = 256
+	defaultMapHashMaxSize            = 2048
+	defaultMapHashBucketSize         = 256
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
@@ -44,12 +97,13 @@ func BuildConfiguration(
 	}
 
 	baseHTTPConfig := buildBaseHTTPConfig(g)
+	listeners := g.GatewayListeners()
 
 	httpServers, sslServers := buildServers(g)
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
 	upstreams := buildUpstreams(
 		ctx,
-		g.Gateway.Listeners,
+		listeners,
 		serviceResolver,
 		g.ReferencedServices,
 		baseHTTPConfig.IPFamily,
@@ -58,27 +112,49 @@ func BuildConfiguration(
 	var nginxPlus NginxPlus
 	if plus {
 		nginxPlus = buildNginxPlus(g)
+		nginxPlus.StatusZones = buildStatusZones(g)
 	}
 
+	passthroughServers := buildPassthroughServers(g)
+	tcpServers := buildLayer4Servers(g, v1.TCPProtocolType)
+	grpcHealthChecks := buildGRPCHealthChecks(upstreams, httpServers, sslServers)
+
 	config := Configuration{
 		HTTPServers:           httpServers,
 		SSLServers:            sslServers,
-		TLSPassthroughServers: buildPassthroughServers(g),
+		TLSPassthroughServers: passthroughServers,
+		TCPServers:            tcpServers,
+		UDPServers:            buildLayer4Servers(g, v1.UDPProtocolType),
 		Upstreams:             upstreams,
-		StreamUpstreams:       buildStreamUpstreams(ctx, g.Gateway.Listeners, serviceResolver, baseHTTPConfig.IPFamily),
+		StreamUpstreams:       buildStreamUpstreams(ctx, listeners, serviceResolver, baseHTTPConfig.IPFamily),
 		BackendGroups:         backendGroups,
-		SSLKeyPairs:           buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners),
+		SSLKeyPairs:           buildSSLKeyPairs(g.ReferencedSecrets, listeners),
 		Version:               configVersion,
 		CertBundles: buildCertBundles(
 			buildRefCertificateBundles(g.ReferencedSecrets, g.ReferencedCaCertConfigMaps),
 			backendGroups,
+			sslServers,
 		),
-		Telemetry:        buildTelemetry(g),
-		BaseHTTPConfig:   baseHTTPConfig,
-		Logging:          buildLogging(g),
-		NginxPlus:        nginxPlus,
-		MainSnippets:     buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextMain),
-		AuxiliarySecrets: buildAuxiliarySecrets(g.PlusSecrets),
+		StaticContents:             buildStaticContents(g.ReferencedStaticContentConfigMaps, append(httpServers, sslServers...)),
+		GRPCHealthChecks:           grpcHealthChecks,
+		Telemetry:                  buildTelemetry(g),
+		BaseHTTPConfig:             baseHTTPConfig,
+		Logging:                    buildLogging(g),
+		NginxPlus:                  nginxPlus,
+		TemplateOverrides:          buildTemplateOverrides(g),
+		MainSnippets:               buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextMain),
+		BodyTransforms:             buildBodyTransforms(g.HTTPBodyTransforms),
+		AuxiliarySecrets:           buildAuxiliarySecrets(g.PlusSecrets),
+		HashSizes:                  buildHashSizes(g, append(httpServers, sslServers...), passthroughServers),
+		SocketOptions:              buildSocketOptions(g, listeners),
+		Worker:                     buildWorker(g),
+		LoadBrotliModule:           loadBrotliModule(g),
+		CacheZones:                 buildCacheZones(g),
+		RateLimitZones:             buildRateLimitZones(g),
+		ConnectionLimitZones:       buildConnectionLimitZones(g),
+		StreamConnectionLimitZones: buildStreamConnectionLimitZones(g, passthroughServers, tcpServers),
+		StreamSettings:             buildStreamSettings(g.Gateway),
+		StatusEndpoint:             buildStatusEndpoint(g),
 	}
 
 	return config
@@ -90,8 +166,11 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 	listenerPassthroughServers := make([]Layer4VirtualServer, 0)
 
 	passthroughServerCount := 0
+	sendProxyProtocol := isProxyProtocolSentToPassthroughBackends(g)
+	gatewaySettings := buildServerGatewaySettings(g)
+	streamSettings := buildMergedGatewayStreamSettings(g)
 
-	for _, l := range g.Gateway.Listeners {
+	for _, l := range g.GatewayListeners() {
 		if !l.Valid || l.Source.Protocol != v1.TLSProtocolType {
 			continue
 		}
@@ -104,6 +183,10 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 			var hostnames []string
 
 			for _, p := range r.ParentRefs {
+				if p.Gateway != l.GatewayName {
+					continue
+				}
+
 				if val, exist := p.Attachment.AcceptedHostnames[l.Name]; exist {
 					hostnames = val
 					break
@@ -121,23 +204,31 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 					foundRouteMatchingListenerHostname = true
 				}
 				passthroughServersMap[key] = append(passthroughServersMap[key], Layer4VirtualServer{
-					Hostname:     h,
-					UpstreamName: r.Spec.BackendRef.ServicePortReference(),
-					Port:         int32(l.Source.Port),
+					Hostname:          h,
+					UpstreamName:      r.Spec.BackendRef.ServicePortReference(),
+					Port:              int32(l.Source.Port),
+					ConnectionLimit:   buildStreamConnectionLimit(g.Gateway, r),
+					GatewaySettings:   gatewaySettings.forListeners([]*graph.Listener{l}),
+					StreamSettings:    streamSettings[l.GatewayName],
+					SendProxyProtocol: sendProxyProtocol,
 				})
 			}
 		}
 		if !foundRouteMatchingListenerHostname {
 			if l.Source.Hostname != nil {
 				listenerPassthroughServers = append(listenerPassthroughServers, Layer4VirtualServer{
-					Hostname:  string(*l.Source.Hostname),
-					IsDefault: true,
-					Port:      int32(l.Source.Port),
+					Hostname:        string(*l.Source.Hostname),
+					IsDefault:       true,
+					Port:            int32(l.Source.Port),
+					GatewaySettings: gatewaySettings.forListeners([]*graph.Listener{l}),
+					StreamSettings:  streamSettings[l.GatewayName],
 				})
 			} else {
 				listenerPassthroughServers = append(listenerPassthroughServers, Layer4VirtualServer{
-					Hostname: "",
-					Port:     int32(l.Source.Port),
+					Hostname:        "",
+					Port:            int32(l.Source.Port),
+					GatewaySettings: gatewaySettings.forListeners([]*graph.Listener{l}),
+					StreamSettings:  streamSettings[l.GatewayName],
 				})
 			}
 		}
@@ -153,6 +244,54 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 	return passthroughServers
 }
 
+// isProxyProtocolSentToPassthroughBackends returns true if the NginxProxy enables sending the PROXY protocol
+// to the backends of TLSRoutes.
+func isProxyProtocolSentToPassthroughBackends(g *graph.Graph) bool {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.TLSPassthrough == nil {
+		return false
+	}
+
+	sendProxyProtocol := g.NginxProxy.Source.Spec.TLSPassthrough.SendProxyProtocol
+
+	return sendProxyProtocol != nil && *sendProxyProtocol
+}
+
+// buildLayer4Servers builds Layer4VirtualServers from the TCPRoutes or UDPRoutes attached to listeners
+// of the given protocol.
+func buildLayer4Servers(g *graph.Graph, protocol v1.ProtocolType) []Layer4VirtualServer {
+	var servers []Layer4VirtualServer
+
+	gatewaySettings := buildServerGatewaySettings(g)
+	streamSettings := buildMergedGatewayStreamSettings(g)
+
+	for _, l := range g.GatewayListeners() {
+		if !l.Valid || l.Source.Protocol != protocol {
+			continue
+		}
+
+		for _, r := range l.L4Routes {
+			if !r.Valid {
+				continue
+			}
+
+			server := Layer4VirtualServer{
+				UpstreamName:    r.Spec.BackendRef.ServicePortReference(),
+				Port:            int32(l.Source.Port),
+				GatewaySettings: gatewaySettings.forListeners([]*graph.Listener{l}),
+				StreamSettings:  streamSettings[l.GatewayName],
+			}
+
+			if protocol == v1.TCPProtocolType {
+				server.ConnectionLimit = buildStreamConnectionLimit(g.Gateway, r)
+			}
+
+			servers = append(servers, server)
+		}
+	}
+
+	return servers
+}
+
 // buildStreamUpstreams builds all stream upstreams.
 func buildStreamUpstreams(
 	ctx context.Context,
@@ -165,7 +304,13 @@ func buildStreamUpstreams(
 	uniqueUpstreams := make(map[string]Upstream)
 
 	for _, l := range listeners {
-		if !l.Valid || l.Source.Protocol != v1.TLSProtocolType {
+		if !l.Valid {
+			continue
+		}
+
+		switch l.Source.Protocol {
+		case v1.TLSProtocolType, v1.TCPProtocolType, v1.UDPProtocolType:
+		default:
 			continue
 		}
 
@@ -190,15 +335,16 @@ func buildStreamUpstreams(
 
 			allowedAddressType := getAllowedAddressType(ipFamily)
 
-			eps, err := serviceResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
+			eps, summary, err := serviceResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
 			if err != nil {
 				errMsg = err.Error()
 			}
 
 			uniqueUpstreams[upstreamName] = Upstream{
-				Name:      upstreamName,
-				Endpoints: eps,
-				ErrorMsg:  errMsg,
+				Name:            upstreamName,
+				Endpoints:       eps,
+				EndpointSummary: summary,
+				ErrorMsg:        errMsg,
 			}
 		}
 	}
@@ -263,14 +409,13 @@ func buildRefCertificateBundles(
 func buildCertBundles(
 	refCertBundles []graph.CertificateBundle,
 	backendGroups []BackendGroup,
+	sslServers []VirtualServer,
 ) map[CertBundleID]CertBundle {
 	bundles := make(map[CertBundleID]CertBundle)
-	refByBG := make(map[CertBundleID]struct{})
+	referenced := make(map[CertBundleID]struct{})
 
-	// We only need to build the cert bundles if there are valid backend groups that reference them.
-	if len(backendGroups) == 0 {
-		return bundles
-	}
+	// We only need to build the cert bundles that valid backend groups or the OCSP stapling
+	// of the SSL servers reference.
 	for _, bg := range backendGroups {
 		if bg.Backends == nil {
 			continue
@@ -279,13 +424,23 @@ func buildCertBundles(
 			if !b.Valid || b.VerifyTLS == nil {
 				continue
 			}
-			refByBG[b.VerifyTLS.CertBundleID] = struct{}{}
+			referenced[b.VerifyTLS.CertBundleID] = struct{}{}
 		}
 	}
 
+	for _, s := range sslServers {
+		if s.SSL != nil && s.SSL.OCSPTrustedCertBundleID != "" {
+			referenced[s.SSL.OCSPTrustedCertBundleID] = struct{}{}
+		}
+	}
+
+	if len(referenced) == 0 {
+		return bundles
+	}
+
 	for _, bundle := range refCertBundles {
 		id := generateCertBundleID(bundle.Name)
-		if _, exists := refByBG[id]; exists {
+		if _, exists := referenced[id]; exists {
 			// the cert could be base64 encoded or plaintext
 			data := make([]byte, base64.StdEncoding.DecodedLen(len(bundle.Cert.CACert)))
 			_, err := base64.StdEncoding.Decode(data, bundle.Cert.CACert)
@@ -337,16 +492,18 @@ func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 	return groups
 }
 
-func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName, ruleIdx int) BackendGroup {
+func newBackendGroup(rule graph.RouteRule, sourceNsName types.NamespacedName, ruleIdx int) BackendGroup {
 	var backends []Backend
 
-	if len(refs) > 0 {
-		backends = make([]Backend, 0, len(refs))
+	if len(rule.BackendRefs) > 0 {
+		backends = make([]Backend, 0, len(rule.BackendRefs))
 	}
 
-	for _, ref := range refs {
+	upstreamSnippets := buildUpstreamSnippets(rule.Filters.Filters)
+
+	for _, ref := range rule.BackendRefs {
 		backends = append(backends, Backend{
-			UpstreamName: ref.ServicePortReference(),
+			UpstreamName: upstreamName(ref, upstreamSnippets),
 			Weight:       ref.Weight,
 			Valid:        ref.Valid,
 			VerifyTLS:    convertBackendTLS(ref.BackendTLSPolicy),
@@ -375,30 +532,16 @@ func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 }
 
 func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
-	rulesForProtocol := map[v1.ProtocolType]portPathRules{
-		v1.HTTPProtocolType:  make(portPathRules),
-		v1.HTTPSProtocolType: make(portPathRules),
-	}
-
-	for _, l := range g.Gateway.Listeners {
-		if l.Source.Protocol == v1.TLSProtocolType {
-			continue
-		}
-		if l.Valid {
-			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
-			if rules == nil {
-				rules = newHostPathRules()
-				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
-			}
-
-			rules.upsertListener(l)
-		}
-	}
+	rulesForProtocol := buildRulesForProtocol(g)
 
 	httpRules := rulesForProtocol[v1.HTTPProtocolType]
 	sslRules := rulesForProtocol[v1.HTTPSProtocolType]
 
-	httpServers, sslServers := httpRules.buildServers(), sslRules.buildServers()
+	accessLogs := buildListenerAccessLogs(g)
+	gatewaySettings := buildServerGatewaySettings(g)
+
+	httpServers := httpRules.buildServers(accessLogs, gatewaySettings)
+	sslServers := sslRules.buildServers(accessLogs, gatewaySettings)
 
 	pols := buildPolicies(g.Gateway.Policies)
 
@@ -413,10 +556,43 @@ func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
 	return httpServers, sslServers
 }
 
+// buildRulesForProtocol builds the portPathRules of the HTTP and HTTPS listeners.
+func buildRulesForProtocol(g *graph.Graph) map[v1.ProtocolType]portPathRules {
+	rulesForProtocol := map[v1.ProtocolType]portPathRules{
+		v1.HTTPProtocolType:  make(portPathRules),
+		v1.HTTPSProtocolType: make(portPathRules),
+	}
+
+	streaming := buildStreamingGateways(g)
+
+	for _, l := range g.GatewayListeners() {
+		// the servers of the TLS, TCP, and UDP listeners are built separately.
+		protocolRules, exists := rulesForProtocol[l.Source.Protocol]
+		if !exists {
+			continue
+		}
+		if l.Valid {
+			rules := protocolRules[l.Source.Port]
+			if rules == nil {
+				rules = newHostPathRules()
+				protocolRules[l.Source.Port] = rules
+			}
+
+			_, gatewayStreaming := streaming[l.GatewayName]
+			rules.upsertListener(l, gatewayStreaming)
+		}
+	}
+
+	return rulesForProtocol
+}
+
 // portPathRules keeps track of hostPathRules per port.
 type portPathRules map[v1.PortNumber]*hostPathRules
 
-func (p portPathRules) buildServers() []VirtualServer {
+func (p portPathRules) buildServers(
+	accessLogs map[string]*AccessLog,
+	gatewaySettings serverGatewaySettings,
+) []VirtualServer {
 	serverCount := 0
 	for _, rules := range p {
 		serverCount += rules.maxServerCount()
@@ -425,21 +601,22 @@ func (p portPathRules) buildServers() []VirtualServer {
 	servers := make([]VirtualServer, 0, serverCount)
 
 	for _, rules := range p {
-		servers = append(servers, rules.buildServers()...)
+		servers = append(servers, rules.buildServers(accessLogs, gatewaySettings)...)
 	}
 
 	return servers
 }
 
 type pathAndType struct {
-	path     string
-	pathType v1.PathMatchType
+	path            string
+	pathType        v1.PathMatchType
+	caseInsensitive bool
 }
 
 type hostPathRules struct {
 	rulesPerHost     map[string]map[pathAndType]PathRule
 	listenersForHost map[string]*graph.Listener
-	httpsListeners   []*graph.Listener
+	listeners        []*graph.Listener
 	port             int32
 	listenersExist   bool
 }
@@ -448,30 +625,31 @@ func newHostPathRules() *hostPathRules {
 	return &hostPathRules{
 		rulesPerHost:     make(map[string]map[pathAndType]PathRule),
 		listenersForHost: make(map[string]*graph.Listener),
-		httpsListeners:   make([]*graph.Listener, 0),
+		listeners:        make([]*graph.Listener, 0),
 	}
 }
 
-func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
+// upsertListener upserts the rules of the Routes of the listener. gatewayStreaming indicates that the Gateway of
+// the listener disables the buffering of the responses of the backends.
+func (hpr *hostPathRules) upsertListener(l *graph.Listener, gatewayStreaming bool) {
 	hpr.listenersExist = true
 	hpr.port = int32(l.Source.Port)
 
-	if l.Source.Protocol == v1.HTTPSProtocolType {
-		hpr.httpsListeners = append(hpr.httpsListeners, l)
-	}
+	hpr.listeners = append(hpr.listeners, l)
 
 	for _, r := range l.Routes {
 		if !r.Valid {
 			continue
 		}
 
-		hpr.upsertRoute(r, l)
+		hpr.upsertRoute(r, l, gatewayStreaming)
 	}
 }
 
 func (hpr *hostPathRules) upsertRoute(
 	route *graph.L7Route,
 	listener *graph.Listener,
+	gatewayStreaming bool,
 ) {
 	var hostnames []string
 	GRPC := route.RouteType == graph.RouteTypeGRPC
@@ -485,6 +663,11 @@ func (hpr *hostPathRules) upsertRoute(
 	}
 
 	for _, p := range route.ParentRefs {
+		// a route can attach to listeners of the same name that belong to different Gateways
+		if p.Gateway != listener.GatewayName {
+			continue
+		}
+
 		if val, exist := p.Attachment.AcceptedHostnames[string(listener.Source.Name)]; exist {
 			hostnames = val
 			break
@@ -506,8 +689,11 @@ func (hpr *hostPathRules) upsertRoute(
 		}
 	}
 
+	// a ClientSettingsPolicy attached to the Route takes precedence over the streaming annotations.
+	streaming := (route.Spec.Streaming || gatewayStreaming) && !configuresBuffering(route.Policies)
+
 	for i, rule := range route.Spec.Rules {
-		if !rule.ValidMatches {
+		if rule.Dropped {
 			continue
 		}
 
@@ -521,20 +707,33 @@ func (hpr *hostPathRules) upsertRoute(
 		}
 
 		pols := buildPolicies(route.Policies)
+		staticContent := buildStaticContent(route.Policies)
+		errorPages := buildErrorPages(route.Policies)
+
+		pathMatchOpts := route.Spec.PathMatchOptions
+		trailingSlash := convertTrailingSlashMode(pathMatchOpts.TrailingSlash)
 
 		for _, h := range hostnames {
 			for _, m := range rule.Matches {
 				path := getPath(m.Path)
 
 				key := pathAndType{
-					path:     path,
-					pathType: *m.Path.Type,
+					path:            path,
+					pathType:        *m.Path.Type,
+					caseInsensitive: pathMatchOpts.CaseInsensitive,
 				}
 
 				hostRule, exist := hpr.rulesPerHost[h][key]
 				if !exist {
 					hostRule.Path = path
 					hostRule.PathType = convertPathType(*m.Path.Type)
+					hostRule.CaseInsensitive = pathMatchOpts.CaseInsensitive
+				}
+
+				// if the Routes of the path configure different modes, redirect takes precedence,
+				// so that the result doesn't depend on the order of the Routes.
+				if trailingSlash != "" && hostRule.TrailingSlash != TrailingSlashModeRedirect {
+					hostRule.TrailingSlash = trailingSlash
 				}
 
 				routeNsName := client.ObjectKeyFromObject(route.Source)
@@ -543,10 +742,16 @@ func (hpr *hostPathRules) upsertRoute(
 				hostRule.Policies = append(hostRule.Policies, pols...)
 
 				hostRule.MatchRules = append(hostRule.MatchRules, MatchRule{
-					Source:       objectSrc,
-					BackendGroup: newBackendGroup(rule.BackendRefs, routeNsName, i),
-					Filters:      filters,
-					Match:        convertMatch(m),
+					Source:        objectSrc,
+					BackendGroup:  newBackendGroup(rule, routeNsName, i),
+					Filters:       filters,
+					Match:         convertMatch(m),
+					Timeouts:      convertHTTPRouteTimeouts(rule.Timeouts),
+					Retry:         convertHTTPRouteRetry(rule.Retry, rule.Timeouts),
+					StaticContent: staticContent,
+					ErrorPages:    errorPages,
+					DebugCapture:  convertDebugCapture(route.DebugCapture, routeNsName),
+					Streaming:     streaming,
 				})
 
 				hpr.rulesPerHost[h][key] = hostRule
@@ -555,8 +760,13 @@ func (hpr *hostPathRules) upsertRoute(
 	}
 }
 
-func (hpr *hostPathRules) buildServers() []VirtualServer {
-	servers := make([]VirtualServer, 0, len(hpr.rulesPerHost)+len(hpr.httpsListeners))
+// buildServers builds the servers of the host path rules. The servers of a Listener use the access log of the Listener,
+// if it overrides the default access log, and the settings of the Gateway of the Listener.
+func (hpr *hostPathRules) buildServers(
+	accessLogs map[string]*AccessLog,
+	gatewaySettings serverGatewaySettings,
+) []VirtualServer {
+	servers := make([]VirtualServer, 0, hpr.maxServerCount())
 
 	for h, rules := range hpr.rulesPerHost {
 		s := VirtualServer{
@@ -570,11 +780,10 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			panic(fmt.Sprintf("no listener found for hostname: %s", h))
 		}
 
-		if l.ResolvedSecret != nil {
-			s.SSL = &SSL{
-				KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-			}
-		}
+		s.AccessLog = accessLogs[l.Name]
+		s.GatewaySettings = gatewaySettings.forListeners([]*graph.Listener{l})
+
+		s.SSL = buildSSL(l)
 
 		for _, r := range rules {
 			sortMatchRules(r.MatchRules)
@@ -584,31 +793,40 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 
 		// We sort the path rules so the order is preserved after reconfiguration.
 		sort.Slice(s.PathRules, func(i, j int) bool {
-			if s.PathRules[i].Path != s.PathRules[j].Path {
-				return s.PathRules[i].Path < s.PathRules[j].Path
-			}
-
-			return s.PathRules[i].PathType < s.PathRules[j].PathType
+			return lessPathRule(s.PathRules[i], s.PathRules[j])
 		})
 
 		servers = append(servers, s)
 	}
 
-	for _, l := range hpr.httpsListeners {
+	isolatedHostnames := make(map[string]struct{})
+
+	for _, l := range hpr.listeners {
 		hostname := getListenerHostname(l.Source.Hostname)
-		// Generate a 404 ssl server block for listeners with no routes or listeners with wildcard (match-all) routes.
-		// This server overrides the default ssl server.
-		if len(l.Routes) == 0 || hostname == wildcardHostname {
+		https := l.Source.Protocol == v1.HTTPSProtocolType
+
+		// Generate a 404 ssl server block for https listeners with no routes or listeners with wildcard (match-all)
+		// routes. This server overrides the default ssl server.
+		defaultSSLServer := https && (len(l.Routes) == 0 || hostname == wildcardHostname)
+
+		// Generate a 404 server block for listeners with a hostname that doesn't have any routing rules.
+		// Otherwise, NGINX handles the requests for the hostname with the server of a listener with
+		// a less specific hostname, which breaks listener isolation.
+		_, hostnameHasRules := hpr.rulesPerHost[hostname]
+		_, hostnameIsolated := isolatedHostnames[hostname]
+		isolationServer := hostname != wildcardHostname && !hostnameHasRules && !hostnameIsolated
+
+		if defaultSSLServer || isolationServer {
+			isolatedHostnames[hostname] = struct{}{}
+
 			s := VirtualServer{
-				Hostname: hostname,
-				Port:     hpr.port,
+				Hostname:        hostname,
+				Port:            hpr.port,
+				AccessLog:       accessLogs[l.Name],
+				GatewaySettings: gatewaySettings.forListeners([]*graph.Listener{l}),
 			}
 
-			if l.ResolvedSecret != nil {
-				s.SSL = &SSL{
-					KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-				}
-			}
+			s.SSL = buildSSL(l)
 
 			servers = append(servers, s)
 		}
@@ -616,10 +834,19 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 
 	// if any listeners exist, we need to generate a default server block.
 	if hpr.listenersExist {
-		servers = append(servers, VirtualServer{
-			IsDefault: true,
-			Port:      hpr.port,
-		})
+		defaultServer := VirtualServer{
+			IsDefault:       true,
+			Port:            hpr.port,
+			GatewaySettings: gatewaySettings.forListeners(hpr.listeners),
+		}
+
+		// the default server is shared by all listeners of the port, so it uses the access log of a listener
+		// only if the listener is the only one.
+		if len(hpr.listeners) == 1 {
+			defaultServer.AccessLog = accessLogs[hpr.listeners[0].Name]
+		}
+
+		servers = append(servers, defaultServer)
 	}
 
 	// We sort the servers so the order is preserved after reconfiguration.
@@ -630,13 +857,36 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 	return servers
 }
 
+// addStatusZones adds the status zones of the servers built from the host path rules to the zones.
+func (hpr *hostPathRules) addStatusZones(zones map[string]ListenerStatusZone) {
+	for h, l := range hpr.listenersForHost {
+		zones[StatusZoneName(h, hpr.port)] = newListenerStatusZone(l, false)
+	}
+
+	// the servers that isolate the hostnames of the listeners without routing rules belong to the first listener
+	// with the hostname.
+	for _, l := range hpr.listeners {
+		zone := StatusZoneName(getListenerHostname(l.Source.Hostname), hpr.port)
+		if _, exists := zones[zone]; !exists {
+			zones[zone] = newListenerStatusZone(l, false)
+		}
+	}
+
+	// the default server is shared by all listeners of the port, so it belongs to a listener
+	// only if the listener is the only one.
+	if len(hpr.listeners) == 1 {
+		zones[DefaultServerStatusZoneName(hpr.port)] = newListenerStatusZone(hpr.listeners[0], true)
+	}
+}
+
 // maxServerCount returns the maximum number of VirtualServers that can be built from the host path rules.
 func (hpr *hostPathRules) maxServerCount() int {
 	// to calculate max # of servers we add up:
 	// - # of hostnames
-	// - # of https listeners - this is to account for https wildcard default servers
+	// - # of listeners - this is to account for https wildcard default servers and the servers
+	//   that isolate the hostnames of listeners without routing rules
 	// - default server - for every hostPathRules we generate 1 default server
-	return len(hpr.rulesPerHost) + len(hpr.httpsListeners) + 1
+	return len(hpr.rulesPerHost) + len(hpr.listeners) + 1
 }
 
 func buildUpstreams(
@@ -649,10 +899,33 @@ func buildUpstreams(
 	// There can be duplicate upstreams if multiple routes reference the same upstream.
 	// We use a map to deduplicate them.
 	uniqueUpstreams := make(map[string]Upstream)
+	// weightedUpstreams holds the names of the upstreams that are referenced by at least one BackendRef
+	// with a non-zero weight. The other upstreams are draining.
+	weightedUpstreams := make(map[string]struct{})
 
 	// We need to build endpoints based on the IPFamily of NGINX.
 	allowedAddressType := getAllowedAddressType(ipFamily)
 
+	addUpstream := func(br graph.BackendRef, snippets []Snippet) {
+		if !br.Valid {
+			return
+		}
+
+		name := upstreamName(br, snippets)
+		if br.Weight > 0 {
+			weightedUpstreams[name] = struct{}{}
+		}
+
+		if _, exist := uniqueUpstreams[name]; exist {
+			return
+		}
+
+		up := buildUpstream(ctx, br, svcResolver, referencedServices, allowedAddressType)
+		up.Name = name
+		up.Snippets = snippets
+		uniqueUpstreams[name] = up
+	}
+
 	for _, l := range listeners {
 		if !l.Valid {
 			continue
@@ -668,34 +941,15 @@ func buildUpstreams(
 					// don't generate upstreams for rules that have invalid matches or filters
 					continue
 				}
+				upstreamSnippets := buildUpstreamSnippets(rule.Filters.Filters)
+
 				for _, br := range rule.BackendRefs {
-					if br.Valid {
-						upstreamName := br.ServicePortReference()
-						_, exist := uniqueUpstreams[upstreamName]
-
-						if exist {
-							continue
-						}
-
-						var errMsg string
-
-						eps, err := svcResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
-						if err != nil {
-							errMsg = err.Error()
-						}
-
-						var upstreamPolicies []policies.Policy
-						if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
-							upstreamPolicies = buildPolicies(graphSvc.Policies)
-						}
-
-						uniqueUpstreams[upstreamName] = Upstream{
-							Name:      upstreamName,
-							Endpoints: eps,
-							ErrorMsg:  errMsg,
-							Policies:  upstreamPolicies,
-						}
-					}
+					addUpstream(br, upstreamSnippets)
+				}
+
+				// the snippets of a rule only apply to its backends, not to the backends of its mirrors
+				for _, br := range getMirrorBackendRefs(rule.Filters.Filters) {
+					addUpstream(br, nil)
 				}
 			}
 		}
@@ -707,12 +961,109 @@ func buildUpstreams(
 
 	upstreams := make([]Upstream, 0, len(uniqueUpstreams))
 
-	for _, up := range uniqueUpstreams {
+	for name, up := range uniqueUpstreams {
+		_, weighted := weightedUpstreams[name]
+		up.Draining = !weighted
+
 		upstreams = append(upstreams, up)
 	}
 	return upstreams
 }
 
+// buildUpstream builds the Upstream for a valid BackendRef. The endpoints of the Service are resolved,
+// unless the Service is of type ExternalName, whose external name is resolved by NGINX.
+func buildUpstream(
+	ctx context.Context,
+	br graph.BackendRef,
+	svcResolver resolver.ServiceResolver,
+	referencedServices map[types.NamespacedName]*graph.ReferencedService,
+	allowedAddressType []discoveryV1.AddressType,
+) Upstream {
+	var errMsg string
+	var eps []resolver.Endpoint
+	var summary resolver.EndpointSummary
+
+	if br.ExternalName != "" {
+		eps = []resolver.Endpoint{{Address: br.ExternalName, Port: br.ServicePort.Port}}
+	} else {
+		var err error
+		eps, summary, err = svcResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
+		if err != nil {
+			errMsg = err.Error()
+		}
+	}
+
+	var upstreamPolicies []policies.Policy
+	if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
+		upstreamPolicies = buildPolicies(graphSvc.Policies)
+	}
+
+	return Upstream{
+		Name:               br.ServicePortReference(),
+		Endpoints:          eps,
+		EndpointSummary:    summary,
+		ErrorMsg:           errMsg,
+		ExternalName:       br.ExternalName,
+		Policies:           upstreamPolicies,
+		SessionPersistence: convertSessionPersistence(br.BackendLBPolicy),
+	}
+}
+
+// getMirrorBackendRefs returns the backendRefs of the RequestMirror filters in the filters of a routing rule.
+func getMirrorBackendRefs(filters []graph.Filter) []graph.BackendRef {
+	var refs []graph.BackendRef
+
+	for _, f := range filters {
+		if f.MirrorBackendRef != nil {
+			refs = append(refs, *f.MirrorBackendRef)
+		}
+	}
+
+	return refs
+}
+
+// buildUpstreamSnippets returns the upstream snippets of the SnippetsFilters in the filters of a routing rule.
+func buildUpstreamSnippets(filters []graph.Filter) []Snippet {
+	var snippets []Snippet
+
+	for _, f := range filters {
+		if f.FilterType != graph.FilterExtensionRef ||
+			f.ResolvedExtensionRef == nil ||
+			f.ResolvedExtensionRef.SnippetsFilter == nil {
+			continue
+		}
+
+		sf := f.ResolvedExtensionRef.SnippetsFilter
+		if snippet, ok := sf.Snippets[ngfAPIv1alpha1.NginxContextHTTPUpstream]; ok {
+			snippets = append(snippets, Snippet{
+				Name:     createSnippetName(ngfAPIv1alpha1.NginxContextHTTPUpstream, client.ObjectKeyFromObject(sf.Source)),
+				Contents: snippet,
+			})
+		}
+	}
+
+	return snippets
+}
+
+// upstreamName returns the name of the upstream of a BackendRef of a routing rule with the upstream snippets.
+// The rules with different upstream snippets use separate upstreams, so that the snippets of a rule don't apply
+// to the requests of the other rules that reference the same backend. The name of such an upstream is suffixed
+// with a hash of the names of the snippets.
+func upstreamName(br graph.BackendRef, snippets []Snippet) string {
+	name := br.ServicePortReference()
+	if len(snippets) == 0 {
+		return name
+	}
+
+	h := fnv.New32a()
+	for _, snippet := range snippets {
+		h.Write([]byte(snippet.Name))
+		h.Write([]byte{0})
+	}
+
+	return fmt.Sprintf("%s_%08x", name, h.Sum32())
+}
+
 func getAllowedAddressType(ipFamily IPFamilyType) []discoveryV1.AddressType {
 	switch ipFamily {
 	case IPv4:
@@ -734,6 +1085,48 @@ func getListenerHostname(h *v1.Hostname) string {
 	return string(*h)
 }
 
+// lessPathRule orders the PathRules of a server. NGINX checks regular expression locations in the order they appear
+// in the configuration, so the PathRules that are configured with regular expressions are ordered by precedence:
+// case-insensitive Exact paths, then regular expression paths, then case-insensitive Prefix paths.
+// Within each group, the longer path comes first, then the paths are ordered alphabetically.
+// The order of the other PathRules doesn't affect routing, so they are ordered by path and type.
+func lessPathRule(a, b PathRule) bool {
+	aRank, bRank := regexPrecedenceRank(a), regexPrecedenceRank(b)
+
+	if aRank != bRank {
+		return aRank < bRank
+	}
+
+	if aRank != 0 && len(a.Path) != len(b.Path) {
+		return len(a.Path) > len(b.Path)
+	}
+
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+
+	if a.PathType != b.PathType {
+		return a.PathType < b.PathType
+	}
+
+	return !a.CaseInsensitive && b.CaseInsensitive
+}
+
+// regexPrecedenceRank returns the rank of the PathRule among the PathRules that are configured with
+// regular expressions. Returns 0 if the PathRule is not configured with a regular expression.
+func regexPrecedenceRank(rule PathRule) int {
+	switch {
+	case rule.PathType == PathTypeRegularExpression:
+		return 2
+	case !rule.CaseInsensitive:
+		return 0
+	case rule.PathType == PathTypeExact:
+		return 1
+	default:
+		return 3
+	}
+}
+
 func getPath(path *v1.HTTPPathMatch) string {
 	if path == nil || path.Value == nil || *path.Value == "" {
 		return "/"
@@ -744,6 +1137,9 @@ func getPath(path *v1.HTTPPathMatch) string {
 func createHTTPFilters(filters []graph.Filter) HTTPFilters {
 	var result HTTPFilters
 
+	// a SnippetsFilter referenced several times by a routing rule is only inserted once.
+	seenSnippetsFilters := make(map[*graph.SnippetsFilter]struct{})
+
 	for _, f := range filters {
 		switch f.FilterType {
 		case graph.FilterRequestRedirect:
@@ -766,13 +1162,51 @@ func createHTTPFilters(filters []graph.Filter) HTTPFilters {
 				// using the first filter
 				result.ResponseHeaderModifiers = convertHTTPHeaderFilter(f.ResponseHeaderModifier)
 			}
+		case graph.FilterRequestMirror:
+			// Requests are not mirrored to an invalid backend.
+			if f.MirrorBackendRef != nil && f.MirrorBackendRef.Valid {
+				mirror := convertHTTPRequestMirrorFilter(f.RequestMirror, *f.MirrorBackendRef)
+				// the same mirror in a routing rule would mirror the requests twice.
+				if mirror != nil && !slices.ContainsFunc(result.RequestMirrors, func(m HTTPRequestMirrorFilter) bool {
+					return m.UpstreamName == mirror.UpstreamName && helpers.EqualPointers(m.Percent, mirror.Percent)
+				}) {
+					result.RequestMirrors = append(result.RequestMirrors, *mirror)
+				}
+			}
 		case graph.FilterExtensionRef:
-			if f.ResolvedExtensionRef != nil && f.ResolvedExtensionRef.SnippetsFilter != nil {
-				result.SnippetsFilters = append(
-					result.SnippetsFilters,
-					convertSnippetsFilter(f.ResolvedExtensionRef.SnippetsFilter),
-				)
+			if f.ResolvedExtensionRef == nil {
+				continue
+			}
+
+			if bt := f.ResolvedExtensionRef.HTTPBodyTransform; bt != nil {
+				if result.BodyTransform == nil {
+					// using the first filter
+					result.BodyTransform = convertHTTPBodyTransform(bt)
+				}
+
+				continue
+			}
+
+			if dr := f.ResolvedExtensionRef.DirectResponse; dr != nil {
+				if result.DirectResponse == nil {
+					// using the first filter
+					result.DirectResponse = convertDirectResponse(dr)
+				}
+
+				continue
+			}
+
+			if f.ResolvedExtensionRef.SnippetsFilter == nil {
+				continue
+			}
+
+			sf := f.ResolvedExtensionRef.SnippetsFilter
+			if _, seen := seenSnippetsFilters[sf]; seen {
+				continue
 			}
+			seenSnippetsFilters[sf] = struct{}{}
+
+			result.SnippetsFilters = append(result.SnippetsFilters, convertSnippetsFilter(sf))
 		}
 	}
 
@@ -793,6 +1227,28 @@ func listenerHostnameMoreSpecific(host1, host2 *v1.Hostname) bool {
 	return graph.GetMoreSpecificHostname(host1Str, host2Str) == host1Str
 }
 
+// buildSSL builds the SSL configuration of the servers of the listener. It returns nil if the listener
+// doesn't have a certificate.
+func buildSSL(l *graph.Listener) *SSL {
+	if l.ResolvedSecret == nil {
+		return nil
+	}
+
+	ssl := &SSL{
+		KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
+	}
+
+	if l.OCSPStapling != nil {
+		ssl.OCSPStapling = true
+
+		if l.OCSPStapling.Verify {
+			ssl.OCSPTrustedCertBundleID = generateCertBundleID(*l.ResolvedSecret)
+		}
+	}
+
+	return ssl
+}
+
 // generateSSLKeyPairID generates an ID for the SSL key pair based on the Secret namespaced name.
 // It is guaranteed to be unique per unique namespaced name.
 // The ID is safe to use as a file name.
@@ -844,7 +1300,9 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 	ratioMap := make(map[string]int32)
 	for _, pol := range g.NGFPolicies {
 		if obsPol, ok := pol.Source.(*ngfAPIv1alpha2.ObservabilityPolicy); ok {
-			if obsPol.Spec.Tracing != nil && obsPol.Spec.Tracing.Ratio != nil && *obsPol.Spec.Tracing.Ratio > 0 {
+			// a ratio of 100 traces every request, so it doesn't need a ratio variable
+			if obsPol.Spec.Tracing != nil && obsPol.Spec.Tracing.Ratio != nil &&
+				*obsPol.Spec.Tracing.Ratio > 0 && *obsPol.Spec.Tracing.Ratio < 100 {
 				ratioName := CreateRatioVarName(*obsPol.Spec.Tracing.Ratio)
 				ratioMap[ratioName] = *obsPol.Spec.Tracing.Ratio
 			}
@@ -859,111 +1317,1306 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 	return tel
 }
 
-func setSpanAttributes(spanAttributes []ngfAPIv1alpha1.SpanAttribute) []SpanAttribute {
-	spanAttrs := make([]SpanAttribute, 0, len(spanAttributes))
-	for _, spanAttr := range spanAttributes {
-		sa := SpanAttribute{
-			Key:   spanAttr.Key,
-			Value: spanAttr.Value,
+// loadBrotliModule returns whether a valid ResponseCompressionPolicy configures the Brotli compression.
+// The brotli directives, including "brotli off", can only be used when the Brotli module is loaded.
+func loadBrotliModule(g *graph.Graph) bool {
+	for _, pol := range g.NGFPolicies {
+		if rcPol, ok := pol.Source.(*ngfAPIv1alpha1.ResponseCompressionPolicy); ok && pol.Valid &&
+			rcPol.Spec.Brotli != nil {
+			return true
 		}
-		spanAttrs = append(spanAttrs, sa)
 	}
 
-	return spanAttrs
+	return false
 }
 
-// CreateRatioVarName builds a variable name for an ObservabilityPolicy to be used with
-// ratio-based trace sampling.
-func CreateRatioVarName(ratio int32) string {
-	return fmt.Sprintf("$otel_ratio_%d", ratio)
-}
+// buildCacheZones builds the caches of the valid CachePolicies that are attached to resources.
+func buildCacheZones(g *graph.Graph) []CacheZone {
+	var zones []CacheZone
 
-// buildBaseHTTPConfig generates the base http context config that should be applied to all servers.
-func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
-	baseConfig := BaseHTTPConfig{
-		// HTTP2 should be enabled by default
-		HTTP2:    true,
-		IPFamily: Dual,
-		Snippets: buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextHTTP),
-	}
-	if g.NginxProxy == nil || !g.NginxProxy.Valid {
-		return baseConfig
-	}
+	for key, pol := range g.NGFPolicies {
+		cp, ok := pol.Source.(*ngfAPIv1alpha1.CachePolicy)
+		if !ok || !pol.Valid || len(pol.Ancestors) == 0 {
+			continue
+		}
 
-	if g.NginxProxy.Source.Spec.DisableHTTP2 {
-		baseConfig.HTTP2 = false
-	}
+		name := CacheZoneName(key.NsName)
 
-	if g.NginxProxy.Source.Spec.IPFamily != nil {
-		switch *g.NginxProxy.Source.Spec.IPFamily {
-		case ngfAPIv1alpha1.IPv4:
-			baseConfig.IPFamily = IPv4
-		case ngfAPIv1alpha1.IPv6:
-			baseConfig.IPFamily = IPv6
+		zone := CacheZone{
+			Policy:       key.NsName,
+			Name:         name,
+			Path:         fmt.Sprintf("%s/%s", cacheDir, name),
+			KeysZoneSize: defaultCacheKeysZoneSize,
+			Inactive:     defaultCacheInactive,
 		}
-	}
 
-	if g.NginxProxy.Source.Spec.RewriteClientIP != nil {
-		if g.NginxProxy.Source.Spec.RewriteClientIP.Mode != nil {
-			switch *g.NginxProxy.Source.Spec.RewriteClientIP.Mode {
-			case ngfAPIv1alpha1.RewriteClientIPModeProxyProtocol:
-				baseConfig.RewriteClientIPSettings.Mode = RewriteIPModeProxyProtocol
-			case ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor:
-				baseConfig.RewriteClientIPSettings.Mode = RewriteIPModeXForwardedFor
+		if cp.Spec.Zone != nil {
+			if cp.Spec.Zone.KeysZoneSize != nil {
+				zone.KeysZoneSize = string(*cp.Spec.Zone.KeysZoneSize)
 			}
-		}
 
-		if len(g.NginxProxy.Source.Spec.RewriteClientIP.TrustedAddresses) > 0 {
-			baseConfig.RewriteClientIPSettings.TrustedAddresses = convertAddresses(
-				g.NginxProxy.Source.Spec.RewriteClientIP.TrustedAddresses,
-			)
-		}
+			if cp.Spec.Zone.MaxSize != nil {
+				zone.MaxSize = string(*cp.Spec.Zone.MaxSize)
+			}
 
-		if g.NginxProxy.Source.Spec.RewriteClientIP.SetIPRecursively != nil {
-			baseConfig.RewriteClientIPSettings.IPRecursive = *g.NginxProxy.Source.Spec.RewriteClientIP.SetIPRecursively
+			if cp.Spec.Zone.Inactive != nil {
+				zone.Inactive = string(*cp.Spec.Zone.Inactive)
+			}
 		}
+
+		zones = append(zones, zone)
 	}
 
-	return baseConfig
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
 }
 
-func createSnippetName(nc ngfAPIv1alpha1.NginxContext, nsname types.NamespacedName) string {
-	return fmt.Sprintf(
-		"SnippetsFilter_%s_%s_%s",
-		nc,
-		nsname.Namespace,
-		nsname.Name,
-	)
+// CacheZoneName returns the name of the shared memory zone of the cache of a CachePolicy.
+// Namespaces and names can't contain '_', so the names of the zones of different policies never conflict.
+func CacheZoneName(policy types.NamespacedName) string {
+	return fmt.Sprintf("cache_%s_%s", policy.Namespace, policy.Name)
 }
 
-func buildSnippetsForContext(
-	snippetFilters map[types.NamespacedName]*graph.SnippetsFilter,
+// buildRateLimitZones builds the rate limits of the valid RateLimitPolicies that are attached to resources.
+func buildRateLimitZones(g *graph.Graph) []RateLimitZone {
+	var zones []RateLimitZone
+
+	for key, pol := range g.NGFPolicies {
+		rlp, ok := pol.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
+		if !ok || !pol.Valid || len(pol.Ancestors) == 0 {
+			continue
+		}
+
+		zone := RateLimitZone{
+			Policy: key.NsName,
+			Name:   RateLimitZoneName(key.NsName),
+			Key:    rateLimitKeyVariable(rlp.Spec.Key),
+			Size:   defaultRateLimitZoneSize,
+			Rate:   rlp.Spec.Rate,
+		}
+
+		if rlp.Spec.ZoneSize != nil {
+			zone.Size = string(*rlp.Spec.ZoneSize)
+		}
+
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
+
+// rateLimitKeyVariable returns the NGINX variable with the value of the key of a RateLimitPolicy.
+// The binary form of the client address takes less memory than the text form.
+func rateLimitKeyVariable(key *ngfAPIv1alpha1.RateLimitKey) string {
+	if key == nil {
+		return "$binary_remote_addr"
+	}
+
+	switch key.Type {
+	case ngfAPIv1alpha1.RateLimitKeyHeader:
+		if key.Header != nil {
+			return "$http_" + strings.ReplaceAll(strings.ToLower(*key.Header), "-", "_")
+		}
+	case ngfAPIv1alpha1.RateLimitKeyJWTClaim:
+		if key.JWTClaim != nil {
+			return "$jwt_claim_" + *key.JWTClaim
+		}
+	}
+
+	return "$binary_remote_addr"
+}
+
+// RateLimitZoneName returns the name of the shared memory zone of the rate limit of a RateLimitPolicy.
+// Namespaces and names can't contain '_', so the names of the zones of different policies never conflict.
+func RateLimitZoneName(policy types.NamespacedName) string {
+	return fmt.Sprintf("ratelimit_%s_%s", policy.Namespace, policy.Name)
+}
+
+// buildConnectionLimitZones builds the connection limits of the HTTP servers of the valid ConnectionLimitPolicies
+// that are attached to resources. The policies that only target TLSRoutes and TCPRoutes don't need them.
+func buildConnectionLimitZones(g *graph.Graph) []ConnectionLimitZone {
+	var zones []ConnectionLimitZone
+
+	for key, pol := range g.NGFPolicies {
+		clp, ok := pol.Source.(*ngfAPIv1alpha1.ConnectionLimitPolicy)
+		if !ok || !pol.Valid || len(pol.Ancestors) == 0 || !targetsHTTP(pol) {
+			continue
+		}
+
+		zones = append(zones, ConnectionLimitZone{
+			Policy: key.NsName,
+			Name:   ConnectionLimitZoneName(key.NsName),
+			Key:    connectionLimitKeyVariable(clp.Spec.Key, false /* stream */),
+			Size:   connectionLimitZoneSize(clp),
+		})
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
+
+// buildStreamConnectionLimitZones builds the connection limits of the ConnectionLimitPolicies that apply
+// to the stream servers.
+func buildStreamConnectionLimitZones(g *graph.Graph, servers ...[]Layer4VirtualServer) []ConnectionLimitZone {
+	used := make(map[types.NamespacedName]struct{})
+
+	for _, group := range servers {
+		for _, server := range group {
+			if server.ConnectionLimit != nil {
+				used[server.ConnectionLimit.Policy] = struct{}{}
+			}
+		}
+	}
+
+	if len(used) == 0 {
+		return nil
+	}
+
+	zones := make([]ConnectionLimitZone, 0, len(used))
+
+	for key, pol := range g.NGFPolicies {
+		clp, ok := pol.Source.(*ngfAPIv1alpha1.ConnectionLimitPolicy)
+		if !ok {
+			continue
+		}
+
+		if _, exists := used[key.NsName]; !exists {
+			continue
+		}
+
+		zones = append(zones, ConnectionLimitZone{
+			Policy: key.NsName,
+			Name:   StreamConnectionLimitZoneName(key.NsName),
+			Key:    connectionLimitKeyVariable(clp.Spec.Key, true /* stream */),
+			Size:   connectionLimitZoneSize(clp),
+		})
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
+
+// buildStreamConnectionLimit builds the connection limit of a stream server of an L4Route.
+// A policy attached to the Route overrides a policy attached to the Gateway.
+func buildStreamConnectionLimit(gw *graph.Gateway, route *graph.L4Route) *ConnectionLimit {
+	clp := findConnectionLimitPolicy(route.Policies)
+	if clp == nil && gw != nil {
+		clp = findConnectionLimitPolicy(gw.Policies)
+	}
+
+	if clp == nil {
+		return nil
+	}
+
+	nsname := types.NamespacedName{Namespace: clp.Namespace, Name: clp.Name}
+
+	return &ConnectionLimit{
+		Policy:         nsname,
+		Zone:           StreamConnectionLimitZoneName(nsname),
+		MaxConnections: clp.Spec.MaxConnections,
+	}
+}
+
+// findConnectionLimitPolicy returns the valid ConnectionLimitPolicy among the policies of a resource.
+// The conflicting policies are invalid, so a resource has at most one valid ConnectionLimitPolicy.
+func findConnectionLimitPolicy(pols []*graph.Policy) *ngfAPIv1alpha1.ConnectionLimitPolicy {
+	for _, pol := range pols {
+		if clp, ok := pol.Source.(*ngfAPIv1alpha1.ConnectionLimitPolicy); ok && pol.Valid {
+			return clp
+		}
+	}
+
+	return nil
+}
+
+// buildStreamSettings builds the StreamSettings from the valid ClientSettingsPolicies attached to the Gateway.
+// The policies that set the same field conflict, so the fields are merged from all of them.
+func buildStreamSettings(gw *graph.Gateway) StreamSettings {
+	var settings StreamSettings
+
+	for _, pol := range gw.Policies {
+		csp, ok := pol.Source.(*ngfAPIv1alpha1.ClientSettingsPolicy)
+		if !ok || !pol.Valid || csp.Spec.Stream == nil {
+			continue
+		}
+
+		stream := csp.Spec.Stream
+
+		if stream.ConnectTimeout != nil {
+			settings.ProxyConnectTimeout = string(*stream.ConnectTimeout)
+		}
+
+		if stream.Timeout != nil {
+			settings.ProxyTimeout = string(*stream.Timeout)
+		}
+
+		if stream.PrereadTimeout != nil {
+			settings.PrereadTimeout = string(*stream.PrereadTimeout)
+		}
+	}
+
+	return settings
+}
+
+// buildMergedGatewayStreamSettings builds the StreamSettings of the merged Gateways that have ClientSettingsPolicies
+// with stream settings attached. The StreamSettings of the winning Gateway configure the stream context, so the
+// servers of the Listeners of a merged Gateway override them with the settings of the Gateway.
+func buildMergedGatewayStreamSettings(g *graph.Graph) map[types.NamespacedName]*StreamSettings {
+	gwSettings := make(map[types.NamespacedName]*StreamSettings)
+
+	for nsname, gw := range g.MergedGateways {
+		if settings := buildStreamSettings(gw); settings != (StreamSettings{}) {
+			gwSettings[nsname] = &settings
+		}
+	}
+
+	return gwSettings
+}
+
+// buildStreamingGateways returns the Gateways that disable the buffering of the responses of the backends of
+// their Routes with the streaming annotation. A ClientSettingsPolicy that configures the buffering of
+// a Gateway takes precedence over the annotation.
+func buildStreamingGateways(g *graph.Graph) map[types.NamespacedName]struct{} {
+	streaming := make(map[types.NamespacedName]struct{})
+
+	gws := make([]*graph.Gateway, 0, 1+len(g.MergedGateways))
+	if g.Gateway != nil {
+		gws = append(gws, g.Gateway)
+	}
+
+	for _, gw := range g.MergedGateways {
+		gws = append(gws, gw)
+	}
+
+	for _, gw := range gws {
+		if gw.Streaming && !configuresBuffering(gw.Policies) {
+			streaming[client.ObjectKeyFromObject(gw.Source)] = struct{}{}
+		}
+	}
+
+	return streaming
+}
+
+// configuresBuffering returns true if a valid ClientSettingsPolicy among the policies configures the buffering
+// of the responses of the backends.
+func configuresBuffering(pols []*graph.Policy) bool {
+	for _, pol := range pols {
+		csp, ok := pol.Source.(*ngfAPIv1alpha1.ClientSettingsPolicy)
+		if ok && pol.Valid && csp.Spec.Buffering != nil && csp.Spec.Buffering.Enable != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func targetsHTTP(pol *graph.Policy) bool {
+	for _, ref := range pol.TargetRefs {
+		switch ref.Kind {
+		case kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute:
+			return true
+		}
+	}
+
+	return false
+}
+
+func connectionLimitZoneSize(clp *ngfAPIv1alpha1.ConnectionLimitPolicy) string {
+	if clp.Spec.ZoneSize != nil {
+		return string(*clp.Spec.ZoneSize)
+	}
+
+	return defaultConnectionLimitZoneSize
+}
+
+// connectionLimitKeyVariable returns the NGINX variable with the value of the key of a ConnectionLimitPolicy.
+// The stream servers have no names, so a stream server is identified by its listening address and port,
+// which is a unix socket for the servers of TLSRoutes.
+func connectionLimitKeyVariable(key *ngfAPIv1alpha1.ConnectionLimitKey, stream bool) string {
+	if key == nil || *key != ngfAPIv1alpha1.ConnectionLimitKeyServer {
+		return "$binary_remote_addr"
+	}
+
+	if stream {
+		return "$server_addr:$server_port"
+	}
+
+	return "$server_name"
+}
+
+// ConnectionLimitZoneName returns the name of the shared memory zone of the connection limit of the HTTP servers
+// of a ConnectionLimitPolicy.
+// Namespaces and names can't contain '_', so the names of the zones of different policies never conflict.
+func ConnectionLimitZoneName(policy types.NamespacedName) string {
+	return fmt.Sprintf("connlimit_%s_%s", policy.Namespace, policy.Name)
+}
+
+// StreamConnectionLimitZoneName returns the name of the shared memory zone of the connection limit of the stream
+// servers of a ConnectionLimitPolicy. The HTTP and stream modules can't share a zone.
+func StreamConnectionLimitZoneName(policy types.NamespacedName) string {
+	return fmt.Sprintf("stream_connlimit_%s_%s", policy.Namespace, policy.Name)
+}
+
+func setSpanAttributes(spanAttributes []ngfAPIv1alpha1.SpanAttribute) []SpanAttribute {
+	spanAttrs := make([]SpanAttribute, 0, len(spanAttributes))
+	for _, spanAttr := range spanAttributes {
+		sa := SpanAttribute{
+			Key:   spanAttr.Key,
+			Value: spanAttr.Value,
+		}
+		spanAttrs = append(spanAttrs, sa)
+	}
+
+	return spanAttrs
+}
+
+// CreateRatioVarName builds a variable name for an ObservabilityPolicy to be used with
+// ratio-based trace sampling.
+func CreateRatioVarName(ratio int32) string {
+	return fmt.Sprintf("$otel_ratio_%d", ratio)
+}
+
+// buildBaseHTTPConfig generates the base http context config that should be applied to all servers.
+func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
+	baseConfig := BaseHTTPConfig{
+		// HTTP2 should be enabled by default
+		HTTP2:       true,
+		IPFamily:    Dual,
+		Snippets:    buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextHTTP),
+		MapSnippets: buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextHTTPMap),
+	}
+	if g.NginxProxy == nil || !g.NginxProxy.Valid {
+		return baseConfig
+	}
+
+	if g.NginxProxy.Source.Spec.DisableHTTP2 {
+		baseConfig.HTTP2 = false
+	}
+
+	baseConfig.HTTP3 = g.NginxProxy.Source.Spec.EnableHTTP3
+	baseConfig.Hardening = buildHardening(g.NginxProxy.Source.Spec.Hardening)
+	baseConfig.Resolver = buildResolver(g.NginxProxy.Source.Spec.Resolver)
+	baseConfig.HTTP2Settings = buildHTTP2Settings(g.NginxProxy.Source.Spec)
+
+	baseConfig.TLS = buildTLSSettings(g.NginxProxy.Source.Spec.TLS)
+
+	if mode := g.NginxProxy.Source.Spec.HTTPMatchMode; mode != nil && *mode == ngfAPIv1alpha1.HTTPMatchModeNative {
+		baseConfig.NativeHTTPMatches = true
+	}
+
+	baseConfig.IPFamily = convertIPFamily(g.NginxProxy.Source.Spec.IPFamily)
+	baseConfig.RewriteClientIPSettings = convertRewriteClientIP(g.NginxProxy.Source.Spec.RewriteClientIP)
+
+	return baseConfig
+}
+
+// convertIPFamily converts the IP family of an NginxProxy. The IP family defaults to dual.
+func convertIPFamily(ipFamily *ngfAPIv1alpha1.IPFamilyType) IPFamilyType {
+	if ipFamily != nil {
+		switch *ipFamily {
+		case ngfAPIv1alpha1.IPv4:
+			return IPv4
+		case ngfAPIv1alpha1.IPv6:
+			return IPv6
+		}
+	}
+
+	return Dual
+}
+
+// convertRewriteClientIP converts the rewriteClientIP settings of an NginxProxy.
+func convertRewriteClientIP(rewriteClientIP *ngfAPIv1alpha1.RewriteClientIP) RewriteClientIPSettings {
+	var settings RewriteClientIPSettings
+
+	if rewriteClientIP == nil {
+		return settings
+	}
+
+	if rewriteClientIP.Mode != nil {
+		switch *rewriteClientIP.Mode {
+		case ngfAPIv1alpha1.RewriteClientIPModeProxyProtocol:
+			settings.Mode = RewriteIPModeProxyProtocol
+		case ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor:
+			settings.Mode = RewriteIPModeXForwardedFor
+		}
+	}
+
+	if len(rewriteClientIP.TrustedAddresses) > 0 {
+		settings.TrustedAddresses = convertAddresses(rewriteClientIP.TrustedAddresses)
+	}
+
+	if rewriteClientIP.SetIPRecursively != nil {
+		settings.IPRecursive = *rewriteClientIP.SetIPRecursively
+	}
+
+	return settings
+}
+
+// serverGatewaySettings holds the settings of the servers of the Gateways.
+type serverGatewaySettings struct {
+	// gateways holds the settings of the merged Gateways that reference their own NginxProxy, keyed by the
+	// NamespacedName of the Gateway.
+	gateways map[types.NamespacedName]*GatewaySettings
+	// base holds the settings of the BaseHTTPConfig, which the servers of the other Gateways use.
+	base GatewaySettings
+}
+
+// buildServerGatewaySettings builds the settings of the servers of the Gateways from the effective NginxProxies of
+// the merged Gateways.
+func buildServerGatewaySettings(g *graph.Graph) serverGatewaySettings {
+	settings := serverGatewaySettings{
+		gateways: make(map[types.NamespacedName]*GatewaySettings),
+		base:     GatewaySettings{IPFamily: Dual},
+	}
+
+	if g.NginxProxy != nil && g.NginxProxy.Valid {
+		settings.base = GatewaySettings{
+			IPFamily:                convertIPFamily(g.NginxProxy.Source.Spec.IPFamily),
+			RewriteClientIPSettings: convertRewriteClientIP(g.NginxProxy.Source.Spec.RewriteClientIP),
+		}
+	}
+
+	for nsname, gw := range g.MergedGateways {
+		if gw.EffectiveNginxProxy == nil {
+			continue
+		}
+
+		spec := gw.EffectiveNginxProxy.Source.Spec
+
+		settings.gateways[nsname] = &GatewaySettings{
+			IPFamily:                convertIPFamily(spec.IPFamily),
+			RewriteClientIPSettings: convertRewriteClientIP(spec.RewriteClientIP),
+		}
+	}
+
+	return settings
+}
+
+// forListeners returns the settings of a server that the listeners share. If the listeners belong to the same
+// Gateway, the server uses the settings of the Gateway. Otherwise, the server uses the base settings, but listens
+// on the IP families of all the Gateways, so that it is the default server of the sockets of all the Gateways.
+// Returns nil if the server uses the settings of the BaseHTTPConfig.
+func (s serverGatewaySettings) forListeners(listeners []*graph.Listener) *GatewaySettings {
+	if len(listeners) == 0 {
+		return nil
+	}
+
+	sameGateway := true
+	overridden := false
+
+	for _, l := range listeners {
+		sameGateway = sameGateway && l.GatewayName == listeners[0].GatewayName
+		_, gwOverridden := s.gateways[l.GatewayName]
+		overridden = overridden || gwOverridden
+	}
+
+	if !overridden {
+		return nil
+	}
+
+	if sameGateway {
+		return s.gateways[listeners[0].GatewayName]
+	}
+
+	settings := s.base
+	settings.IPFamily = ""
+
+	for _, l := range listeners {
+		ipFamily := s.base.IPFamily
+		if gwSettings, exists := s.gateways[l.GatewayName]; exists {
+			ipFamily = gwSettings.IPFamily
+		}
+
+		switch settings.IPFamily {
+		case "":
+			settings.IPFamily = ipFamily
+		case ipFamily:
+		default:
+			settings.IPFamily = Dual
+		}
+	}
+
+	return &settings
+}
+
+// tlsSessionCacheZone is the name of the shared memory zone of the TLS session cache.
+const tlsSessionCacheZone = "SSL"
+
+// buildTLSSettings builds the TLS settings of all SSL servers from the TLS profile of the NginxProxy.
+func buildTLSSettings(tls *ngfAPIv1alpha1.NginxTLS) *TLSSettings {
+	if tls == nil {
+		return nil
+	}
+
+	settings := &TLSSettings{
+		Protocols:           convertTLSProtocols(tls.Protocols),
+		PreferServerCiphers: convertOnOff(tls.PreferServerCiphers),
+	}
+
+	if tls.Ciphers != nil {
+		settings.Ciphers = *tls.Ciphers
+	}
+
+	if session := tls.Session; session != nil {
+		if session.CacheSize != nil {
+			settings.SessionCache = fmt.Sprintf("shared:%s:%s", tlsSessionCacheZone, *session.CacheSize)
+		}
+
+		if session.Timeout != nil {
+			settings.SessionTimeout = string(*session.Timeout)
+		}
+
+		settings.SessionTickets = convertOnOff(session.Tickets)
+	}
+
+	return settings
+}
+
+// convertTLSProtocols converts the TLS protocols into the values of the ssl_protocols directive.
+func convertTLSProtocols(protocols []ngfAPIv1alpha1.TLSProtocolType) []string {
+	result := make([]string, 0, len(protocols))
+
+	for _, protocol := range protocols {
+		result = append(result, string(protocol))
+	}
+
+	return result
+}
+
+// defaultHardeningTimeout is the default of the timeouts of the hardening settings. It is much lower than the
+// NGINX defaults of 60s, so that slow clients can't hold the connections for long.
+const defaultHardeningTimeout = "10s"
+
+// buildHardening builds the hardening settings, using the secure defaults for the settings that are not specified.
+func buildHardening(hardening *ngfAPIv1alpha1.Hardening) *Hardening {
+	if hardening == nil {
+		return nil
+	}
+
+	timeoutOrDefault := func(timeout *ngfAPIv1alpha1.Duration) string {
+		if timeout == nil {
+			return defaultHardeningTimeout
+		}
+
+		return string(*timeout)
+	}
+
+	boolOrDefault := func(b *bool) bool {
+		if b == nil {
+			return true
+		}
+
+		return *b
+	}
+
+	return &Hardening{
+		ClientHeaderTimeout:        timeoutOrDefault(hardening.ClientHeaderTimeout),
+		ClientBodyTimeout:          timeoutOrDefault(hardening.ClientBodyTimeout),
+		SendTimeout:                timeoutOrDefault(hardening.SendTimeout),
+		ResetTimedOutConnection:    boolOrDefault(hardening.ResetTimedOutConnection),
+		RequestSmugglingProtection: boolOrDefault(hardening.RequestSmugglingProtection),
+	}
+}
+
+// buildHTTP2Settings builds the settings of the HTTP/2 connections. It returns nil if HTTP/2 is disabled.
+func buildHTTP2Settings(spec ngfAPIv1alpha1.NginxProxySpec) *HTTP2Settings {
+	if spec.DisableHTTP2 || spec.HTTP2 == nil {
+		return nil
+	}
+
+	sizeOrEmpty := func(size *ngfAPIv1alpha1.Size) string {
+		if size == nil {
+			return ""
+		}
+
+		return string(*size)
+	}
+
+	settings := &HTTP2Settings{
+		RecvBufferSize:  sizeOrEmpty(spec.HTTP2.RecvBufferSize),
+		ChunkSize:       sizeOrEmpty(spec.HTTP2.ChunkSize),
+		BodyPrereadSize: sizeOrEmpty(spec.HTTP2.BodyPrereadSize),
+	}
+
+	if spec.HTTP2.MaxConcurrentStreams != nil {
+		settings.MaxConcurrentStreams = *spec.HTTP2.MaxConcurrentStreams
+	}
+
+	return settings
+}
+
+// buildResolver builds the nameservers of the upstreams. NGINX requires the IPv6 addresses of the nameservers
+// to be enclosed in square brackets, even without a port.
+func buildResolver(npResolver *ngfAPIv1alpha1.NginxResolver) *Resolver {
+	if npResolver == nil {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(npResolver.Addresses))
+	for _, addr := range npResolver.Addresses {
+		if ip, err := netip.ParseAddr(addr); err == nil && ip.Is6() {
+			addr = "[" + addr + "]"
+		}
+
+		addresses = append(addresses, addr)
+	}
+
+	resolver := &Resolver{
+		Addresses:   addresses,
+		DisableIPv6: npResolver.IPv6 != nil && !*npResolver.IPv6,
+	}
+
+	if npResolver.Valid != nil {
+		resolver.Valid = string(*npResolver.Valid)
+	}
+
+	return resolver
+}
+
+// buildSocketOptions builds the socket options of the ports of the valid Listeners. The overrides of the Listeners
+// of a port are applied in the order of the Listeners, so the override of the first Listener takes precedence,
+// and the default options fill in the options that no override sets.
+func buildSocketOptions(g *graph.Graph, listeners []*graph.Listener) map[int32]SocketOptions {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.SocketOptions == nil {
+		return nil
+	}
+
+	socketOptions := g.NginxProxy.Source.Spec.SocketOptions
+
+	overrides := make(map[string]ngfAPIv1alpha1.SocketSettings, len(socketOptions.Listeners))
+	for _, l := range socketOptions.Listeners {
+		overrides[l.Name] = l.Settings
+	}
+
+	portSettings := make(map[int32]ngfAPIv1alpha1.SocketSettings)
+	ports := make([]int32, 0, len(listeners))
+
+	for _, l := range listeners {
+		if !l.Valid {
+			continue
+		}
+
+		port := int32(l.Source.Port)
+
+		settings, exists := portSettings[port]
+		if !exists {
+			ports = append(ports, port)
+		}
+
+		if override, ok := overrides[l.Name]; ok {
+			settings = mergeSocketSettings(settings, override)
+		}
+
+		portSettings[port] = settings
+	}
+
+	var result map[int32]SocketOptions
+
+	for _, port := range ports {
+		settings := portSettings[port]
+		if socketOptions.Default != nil {
+			settings = mergeSocketSettings(settings, *socketOptions.Default)
+		}
+
+		if settings == (ngfAPIv1alpha1.SocketSettings{}) {
+			continue
+		}
+
+		if result == nil {
+			result = make(map[int32]SocketOptions)
+		}
+
+		result[port] = convertSocketSettings(settings)
+	}
+
+	return result
+}
+
+// mergeSocketSettings returns the settings with the options that are not set filled in from the fallback settings.
+func mergeSocketSettings(settings, fallback ngfAPIv1alpha1.SocketSettings) ngfAPIv1alpha1.SocketSettings {
+	if settings.KeepAlive == nil {
+		settings.KeepAlive = fallback.KeepAlive
+	}
+
+	if settings.Backlog == nil {
+		settings.Backlog = fallback.Backlog
+	}
+
+	if settings.ReusePort == nil {
+		settings.ReusePort = fallback.ReusePort
+	}
+
+	if settings.DeferredAccept == nil {
+		settings.DeferredAccept = fallback.DeferredAccept
+	}
+
+	if settings.TCPNoDelay == nil {
+		settings.TCPNoDelay = fallback.TCPNoDelay
+	}
+
+	if settings.TCPNoPush == nil {
+		settings.TCPNoPush = fallback.TCPNoPush
+	}
+
+	return settings
+}
+
+// convertOnOff converts an optional flag into the value of an NGINX directive ("on" or "off").
+// If the flag is nil, it returns an empty string.
+func convertOnOff(b *bool) string {
+	switch {
+	case b == nil:
+		return ""
+	case *b:
+		return "on"
+	default:
+		return "off"
+	}
+}
+
+func convertSocketSettings(settings ngfAPIv1alpha1.SocketSettings) SocketOptions {
+	opts := SocketOptions{
+		TCPNoDelay: convertOnOff(settings.TCPNoDelay),
+		TCPNoPush:  convertOnOff(settings.TCPNoPush),
+	}
+
+	if settings.Backlog != nil {
+		opts.Backlog = *settings.Backlog
+	}
+
+	if settings.ReusePort != nil {
+		opts.ReusePort = *settings.ReusePort
+	}
+
+	if settings.DeferredAccept != nil {
+		opts.DeferredAccept = *settings.DeferredAccept
+	}
+
+	if keepAlive := settings.KeepAlive; keepAlive != nil {
+		opts.KeepAlive = convertOnOff(&keepAlive.Enabled)
+
+		// so_keepalive=[keepidle]:[keepintvl]:[keepcnt] enables the keepalive with the specified timeouts.
+		if keepAlive.Enabled && (keepAlive.Idle != nil || keepAlive.Interval != nil || keepAlive.Count != nil) {
+			var idle, interval, count string
+			if keepAlive.Idle != nil {
+				idle = string(*keepAlive.Idle)
+			}
+			if keepAlive.Interval != nil {
+				interval = string(*keepAlive.Interval)
+			}
+			if keepAlive.Count != nil {
+				count = strconv.Itoa(int(*keepAlive.Count))
+			}
+
+			opts.KeepAlive = fmt.Sprintf("%s:%s:%s", idle, interval, count)
+		}
+	}
+
+	return opts
+}
+
+// buildHashSizes computes the sizes of the hash tables for server names and maps, so that NGINX can build the hash
+// tables for the hostnames of the servers. The sizes are never lower than the defaults, and the sizes specified in
+// the NginxProxy take precedence over the computed ones.
+// Only the sizes of the stream maps are computed, because their keys are the hostnames of the TLS passthrough
+// servers. The keys of the HTTP maps are regular expressions and a few short values, except for the maps of
+// the snippets, which the defaults are meant for.
+func buildHashSizes(g *graph.Graph, servers []VirtualServer, passthroughServers []Layer4VirtualServer) HashSizes {
+	serverNames := make([]string, 0, len(servers))
+	for _, s := range servers {
+		serverNames = append(serverNames, s.Hostname)
+	}
+
+	// the hostnames of the TLS passthrough servers are the keys of the stream maps
+	mapKeys := make([]string, 0, len(passthroughServers))
+	for _, s := range passthroughServers {
+		mapKeys = append(mapKeys, s.Hostname)
+	}
+
+	serverNamesCount, longestServerName := countHashKeys(serverNames)
+	mapKeysCount, longestMapKey := countHashKeys(mapKeys)
+
+	sizes := HashSizes{
+		ServerNamesMaxSize:    hashMaxSize(serverNamesCount, defaultServerNamesHashMaxSize),
+		ServerNamesBucketSize: hashBucketSize(longestServerName, defaultServerNamesHashBucketSize),
+		MapMaxSize:            defaultMapHashMaxSize,
+		MapBucketSize:         defaultMapHashBucketSize,
+		StreamMapMaxSize:      hashMaxSize(mapKeysCount, defaultMapHashMaxSize),
+		StreamMapBucketSize:   hashBucketSize(longestMapKey, defaultMapHashBucketSize),
+	}
+
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.HashTables == nil {
+		return sizes
+	}
+
+	hashTables := g.NginxProxy.Source.Spec.HashTables
+
+	if hashTables.ServerNamesMaxSize != nil {
+		sizes.ServerNamesMaxSize = *hashTables.ServerNamesMaxSize
+	}
+
+	if hashTables.ServerNamesBucketSize != nil {
+		sizes.ServerNamesBucketSize = *hashTables.ServerNamesBucketSize
+	}
+
+	if hashTables.MapMaxSize != nil {
+		sizes.MapMaxSize = *hashTables.MapMaxSize
+		sizes.StreamMapMaxSize = *hashTables.MapMaxSize
+	}
+
+	if hashTables.MapBucketSize != nil {
+		sizes.MapBucketSize = *hashTables.MapBucketSize
+		sizes.StreamMapBucketSize = *hashTables.MapBucketSize
+	}
+
+	return sizes
+}
+
+// countHashKeys returns the number of unique keys that NGINX stores in a hash table and the length of the longest
+// one. Empty keys and regular expressions are not stored in hash tables.
+func countHashKeys(keys []string) (count, longest int) {
+	unique := make(map[string]struct{}, len(keys))
+
+	for _, k := range keys {
+		if k == "" || strings.HasPrefix(k, "~") {
+			continue
+		}
+
+		unique[k] = struct{}{}
+		longest = max(longest, len(k))
+	}
+
+	return len(unique), longest
+}
+
+// hashMaxSize returns a hash table max size with at least twice as many buckets as keys, which keeps the buckets
+// short enough for NGINX to find a hash table size that fits all keys.
+func hashMaxSize(count int, defaultSize int32) int32 {
+	return max(defaultSize, nextPowerOfTwo(2*count))
+}
+
+// hashBucketSize returns a hash bucket size that fits the longest key. NGINX requires a bucket to hold at least one
+// element and a terminating pointer. An element consists of a pointer, plus the length and the key aligned to the
+// size of a pointer.
+func hashBucketSize(longest int, defaultSize int32) int32 {
+	const ptrSize = 8
+
+	elementSize := ptrSize + (longest+2+ptrSize-1)/ptrSize*ptrSize
+
+	return max(defaultSize, nextPowerOfTwo(elementSize+ptrSize))
+}
+
+func nextPowerOfTwo(n int) int32 {
+	size := int32(1)
+	for int(size) < n {
+		size <<= 1
+	}
+
+	return size
+}
+
+func createSnippetName(nc ngfAPIv1alpha1.NginxContext, nsname types.NamespacedName) string {
+	return fmt.Sprintf(
+		"SnippetsFilter_%s_%s_%s",
+		nc,
+		nsname.Namespace,
+		nsname.Name,
+	)
+}
+
+func buildSnippetsForContext(
+	snippetFilters map[types.NamespacedName]*graph.SnippetsFilter,
 	nc ngfAPIv1alpha1.NginxContext,
 ) []Snippet {
 	if len(snippetFilters) == 0 {
 		return nil
 	}
 
-	snippetsForContext := make([]Snippet, 0)
+	snippetsForContext := make([]Snippet, 0)
+
+	for _, filter := range snippetFilters {
+		if !filter.Valid || !filter.Referenced {
+			continue
+		}
+
+		snippetValue, ok := filter.Snippets[nc]
+
+		if !ok {
+			continue
+		}
+
+		snippetsForContext = append(snippetsForContext, Snippet{
+			Name:     createSnippetName(nc, client.ObjectKeyFromObject(filter.Source)),
+			Contents: snippetValue,
+		})
+	}
+
+	return snippetsForContext
+}
+
+// buildBodyTransforms builds the BodyTransforms of the valid HTTPBodyTransforms that are referenced by routing rules.
+func buildBodyTransforms(bodyTransforms map[types.NamespacedName]*graph.HTTPBodyTransform) []BodyTransform {
+	var result []BodyTransform
+
+	for _, bt := range bodyTransforms {
+		if !bt.Valid || !bt.Referenced {
+			continue
+		}
+
+		result = append(result, *convertHTTPBodyTransform(bt))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// bodyTransformID returns the ID of the BodyTransform of an HTTPBodyTransform.
+// The characters of the namespaces and names that are not allowed in variable names are replaced with '_'.
+func bodyTransformID(bodyTransform types.NamespacedName) string {
+	id := fmt.Sprintf("body_transform_%s_%s", bodyTransform.Namespace, bodyTransform.Name)
+
+	return strings.NewReplacer("-", "_", ".", "_").Replace(id)
+}
+
+// sizeInBytes returns the size in bytes, and false if the size is not set.
+// The size must be validated with ValidateNginxSize.
+func sizeInBytes(size *ngfAPIv1alpha1.Size) (int64, bool) {
+	if size == nil {
+		return 0, false
+	}
+
+	s := string(*size)
+	multiplier := int64(1)
+
+	switch {
+	case strings.HasSuffix(s, "k"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(s, "m"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(s, "g"):
+		multiplier = 1 << 30
+	}
+
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value * multiplier, true
+}
+
+// buildStaticContent builds the StaticContent of the valid StaticContentPolicy of a Route.
+// Returns nil if the Route doesn't have a valid StaticContentPolicy.
+func buildStaticContent(graphPolicies []*graph.Policy) *StaticContent {
+	for _, policy := range graphPolicies {
+		if !policy.Valid {
+			continue
+		}
+
+		scp, ok := policy.Source.(*ngfAPIv1alpha1.StaticContentPolicy)
+		if !ok {
+			continue
+		}
+
+		content := &StaticContent{
+			Index: defaultStaticContentIndex,
+		}
+
+		if scp.Spec.Index != nil {
+			content.Index = *scp.Spec.Index
+		}
+
+		if scp.Spec.Fallback != nil {
+			content.Fallback = *scp.Spec.Fallback
+		}
+
+		switch scp.Spec.Source.Type {
+		case ngfAPIv1alpha1.StaticContentSourceConfigMap:
+			content.ID = generateStaticContentID(types.NamespacedName{
+				Namespace: scp.Namespace,
+				Name:      scp.Spec.Source.ConfigMap.Name,
+			})
+		case ngfAPIv1alpha1.StaticContentSourceVolume:
+			content.Path = scp.Spec.Source.Volume.Path
+		}
+
+		// StaticContentPolicies always conflict, so a Route has at most one valid StaticContentPolicy.
+		return content
+	}
+
+	return nil
+}
+
+// buildErrorPages builds the ErrorPages of the valid ErrorPagePolicy of a Route.
+// Returns nil if the Route doesn't have a valid ErrorPagePolicy.
+func buildErrorPages(graphPolicies []*graph.Policy) []ErrorPage {
+	for _, policy := range graphPolicies {
+		if !policy.Valid {
+			continue
+		}
+
+		epp, ok := policy.Source.(*ngfAPIv1alpha1.ErrorPagePolicy)
+		if !ok {
+			continue
+		}
+
+		errorPages := make([]ErrorPage, 0, len(epp.Spec.ErrorPages))
+		for i, page := range epp.Spec.ErrorPages {
+			errorPages = append(errorPages, convertErrorPage(page, fmt.Sprintf("%s_%s_%d", epp.Namespace, epp.Name, i)))
+		}
+
+		// ErrorPagePolicies always conflict, so a Route has at most one valid ErrorPagePolicy.
+		return errorPages
+	}
+
+	return nil
+}
+
+func convertErrorPage(page ngfAPIv1alpha1.ErrorPage, name string) ErrorPage {
+	errorPage := ErrorPage{
+		Name:  name,
+		Codes: make([]int, 0, len(page.Codes)),
+	}
+
+	for _, code := range page.Codes {
+		errorPage.Codes = append(errorPage.Codes, int(code))
+	}
+
+	if page.Response != nil {
+		errorPage.Response = &ErrorPageResponse{
+			ContentType: defaultErrorPageContentType,
+			Body:        page.Response.Body,
+		}
+
+		if page.Response.ContentType != nil {
+			errorPage.Response.ContentType = *page.Response.ContentType
+		}
+
+		if page.Response.StatusCode != nil {
+			errorPage.Response.StatusCode = int(*page.Response.StatusCode)
+		}
+	}
+
+	if page.Redirect != nil {
+		errorPage.Redirect = &ErrorPageRedirect{
+			URL:        page.Redirect.URL,
+			StatusCode: defaultErrorPageRedirectCode,
+		}
+
+		if page.Redirect.StatusCode != nil {
+			errorPage.Redirect.StatusCode = int(*page.Redirect.StatusCode)
+		}
+	}
+
+	return errorPage
+}
+
+// buildStaticContents builds the files of the static contents sourced from the ConfigMaps
+// that are used by the servers.
+func buildStaticContents(
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	servers []VirtualServer,
+) map[StaticContentID]StaticContentFiles {
+	used := make(map[StaticContentID]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.StaticContent != nil && mr.StaticContent.ID != "" {
+					used[mr.StaticContent.ID] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if len(used) == 0 {
+		return nil
+	}
+
+	contents := make(map[StaticContentID]StaticContentFiles, len(used))
 
-	for _, filter := range snippetFilters {
-		if !filter.Valid || !filter.Referenced {
+	for nsname, cm := range configMaps {
+		id := generateStaticContentID(nsname)
+		if _, ok := used[id]; !ok || cm == nil {
 			continue
 		}
 
-		snippetValue, ok := filter.Snippets[nc]
+		files := make(StaticContentFiles, len(cm.Data)+len(cm.BinaryData))
+		for name, data := range cm.Data {
+			files[name] = []byte(data)
+		}
 
-		if !ok {
+		for name, data := range cm.BinaryData {
+			files[name] = data
+		}
+
+		contents[id] = files
+	}
+
+	return contents
+}
+
+// buildGRPCHealthChecks builds the gRPC health checks of the upstreams that are used by the GRPCRoutes
+// of the servers and have a valid UpstreamSettingsPolicy with a gRPC health check.
+// It also sets the health checks that are exposed as plain HTTP endpoints on every server. A health check
+// is not exposed on a server if its path is already used by the server, or by another health check.
+func buildGRPCHealthChecks(upstreams []Upstream, serverGroups ...[]VirtualServer) []GRPCHealthCheck {
+	settings := make(map[string]*ngfAPIv1alpha1.GRPCHealthCheck)
+
+	for _, u := range upstreams {
+		for _, pol := range u.Policies {
+			usp, ok := pol.(*ngfAPIv1alpha1.UpstreamSettingsPolicy)
+			if !ok || usp.Spec.GRPCHealthCheck == nil {
+				continue
+			}
+
+			// UpstreamSettingsPolicies with a gRPC health check always conflict,
+			// so an upstream has at most one gRPC health check.
+			settings[u.Name] = usp.Spec.GRPCHealthCheck
+		}
+	}
+
+	if len(settings) == 0 {
+		return nil
+	}
+
+	checks := make(map[string]GRPCHealthCheck)
+
+	for _, servers := range serverGroups {
+		for i := range servers {
+			serverChecks := buildServerGRPCHealthChecks(servers[i].PathRules, settings)
+			for _, check := range serverChecks {
+				checks[check.UpstreamName] = check
+			}
+
+			servers[i].GRPCHealthChecks = exposeGRPCHealthChecks(servers[i].PathRules, serverChecks)
+		}
+	}
+
+	if len(checks) == 0 {
+		return nil
+	}
+
+	result := make([]GRPCHealthCheck, 0, len(checks))
+	for _, check := range checks {
+		result = append(result, check)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UpstreamName < result[j].UpstreamName
+	})
+
+	return result
+}
+
+// buildServerGRPCHealthChecks builds the gRPC health checks of the upstreams of the gRPC path rules of a server,
+// sorted by the upstream name.
+func buildServerGRPCHealthChecks(
+	pathRules []PathRule,
+	settings map[string]*ngfAPIv1alpha1.GRPCHealthCheck,
+) []GRPCHealthCheck {
+	checks := make(map[string]GRPCHealthCheck)
+
+	for _, rule := range pathRules {
+		if !rule.GRPC {
 			continue
 		}
 
-		snippetsForContext = append(snippetsForContext, Snippet{
-			Name:     createSnippetName(nc, client.ObjectKeyFromObject(filter.Source)),
-			Contents: snippetValue,
-		})
+		for _, mr := range rule.MatchRules {
+			for _, backend := range mr.BackendGroup.Backends {
+				setting, ok := settings[backend.UpstreamName]
+				if !ok || !backend.Valid {
+					continue
+				}
+
+				checks[backend.UpstreamName] = buildGRPCHealthCheck(backend, setting)
+			}
+		}
 	}
 
-	return snippetsForContext
+	result := make([]GRPCHealthCheck, 0, len(checks))
+	for _, check := range checks {
+		result = append(result, check)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UpstreamName < result[j].UpstreamName
+	})
+
+	return result
+}
+
+// exposeGRPCHealthChecks returns the gRPC health checks of a server that are exposed as plain HTTP endpoints.
+// A health check is not exposed if its path is already used by a path rule of the server, or by a previous
+// health check.
+func exposeGRPCHealthChecks(pathRules []PathRule, checks []GRPCHealthCheck) []GRPCHealthCheck {
+	usedPaths := make(map[string]struct{}, len(pathRules))
+	for _, rule := range pathRules {
+		usedPaths[rule.Path] = struct{}{}
+	}
+
+	var exposed []GRPCHealthCheck
+
+	for _, check := range checks {
+		if check.Path == "" {
+			continue
+		}
+
+		if _, exists := usedPaths[check.Path]; exists {
+			continue
+		}
+
+		usedPaths[check.Path] = struct{}{}
+		exposed = append(exposed, check)
+	}
+
+	return exposed
+}
+
+func buildGRPCHealthCheck(backend Backend, setting *ngfAPIv1alpha1.GRPCHealthCheck) GRPCHealthCheck {
+	check := GRPCHealthCheck{
+		UpstreamName: backend.UpstreamName,
+		VerifyTLS:    backend.VerifyTLS,
+		Interval:     defaultGRPCHealthCheckInterval,
+	}
+
+	if setting.Service != nil {
+		check.Service = *setting.Service
+	}
+
+	if setting.Path != nil {
+		check.Path = *setting.Path
+	}
+
+	if setting.Interval != nil {
+		check.Interval = string(*setting.Interval)
+	}
+
+	return check
+}
+
+func generateStaticContentID(configMap types.NamespacedName) StaticContentID {
+	return StaticContentID(fmt.Sprintf("%s_%s", configMap.Namespace, configMap.Name))
 }
 
 func buildPolicies(graphPolicies []*graph.Policy) []policies.Policy {
@@ -992,6 +2645,65 @@ func convertAddresses(addresses []ngfAPIv1alpha1.RewriteClientIPAddress) []strin
 	return trustedAddresses
 }
 
+// defaultPortOffset is the default offset of the privileged ports when NGINX binds to the high ports.
+const defaultPortOffset = 8000
+
+// buildWorker builds the settings of the NGINX worker processes from the NginxProxy.
+func buildWorker(g *graph.Graph) Worker {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid {
+		return Worker{}
+	}
+
+	return NewWorker(g.NginxProxy.Source.Spec.Worker)
+}
+
+// NewWorker creates the Worker from the worker settings of an NginxProxy, which can be nil. The port offset is
+// only set for the HighPorts strategy. The provisioner uses it to map the ports of the Services of the Gateways
+// to the ports that NGINX listens on.
+func NewWorker(spec *ngfAPIv1alpha1.NginxWorker) Worker {
+	if spec == nil {
+		return Worker{}
+	}
+
+	var worker Worker
+	if spec.User != nil {
+		worker.User = *spec.User
+	}
+	if spec.Group != nil {
+		worker.Group = *spec.Group
+	}
+	if spec.Processes != nil {
+		worker.Processes = *spec.Processes
+	}
+	if spec.Connections != nil {
+		worker.Connections = *spec.Connections
+	}
+	if spec.RlimitNofile != nil {
+		worker.RlimitNofile = *spec.RlimitNofile
+	}
+	if spec.MultiAccept != nil {
+		worker.MultiAccept = *spec.MultiAccept
+	}
+
+	portBinding := spec.PortBinding
+	if portBinding != nil && portBinding.Strategy != nil &&
+		*portBinding.Strategy == ngfAPIv1alpha1.PortBindingStrategyHighPorts {
+		worker.PortOffset = defaultPortOffset
+		if portBinding.PortOffset != nil {
+			worker.PortOffset = *portBinding.PortOffset
+		}
+	}
+
+	if portBinding != nil && len(portBinding.PortMappings) > 0 {
+		worker.PortMappings = make(map[int32]int32, len(portBinding.PortMappings))
+		for _, m := range portBinding.PortMappings {
+			worker.PortMappings[m.ListenerPort] = m.ContainerPort
+		}
+	}
+
+	return worker
+}
+
 func buildLogging(g *graph.Graph) Logging {
 	logSettings := Logging{ErrorLevel: defaultErrorLogLevel}
 
@@ -1000,11 +2712,139 @@ func buildLogging(g *graph.Graph) Logging {
 		if ngfProxy.Source.Spec.Logging.ErrorLevel != nil {
 			logSettings.ErrorLevel = string(*ngfProxy.Source.Spec.Logging.ErrorLevel)
 		}
+
+		if accessLog := ngfProxy.Source.Spec.Logging.AccessLog; ngfProxy.Valid && accessLog != nil {
+			logSettings.LogFormats = buildLogFormats(accessLog.Formats)
+
+			if accessLog.Default != nil {
+				logSettings.AccessLog = buildAccessLog(*accessLog.Default, ngfAPIv1alpha1.AccessLogSettings{})
+			}
+		}
 	}
 
 	return logSettings
 }
 
+func buildLogFormats(formats []ngfAPIv1alpha1.NginxLogFormat) []LogFormat {
+	if len(formats) == 0 {
+		return nil
+	}
+
+	logFormats := make([]LogFormat, 0, len(formats))
+
+	for _, f := range formats {
+		logFormat := LogFormat{
+			Name:   f.Name,
+			Format: f.Format,
+		}
+
+		if f.Escape != nil {
+			logFormat.Escape = strings.ToLower(string(*f.Escape))
+		}
+
+		logFormats = append(logFormats, logFormat)
+	}
+
+	return logFormats
+}
+
+// buildListenerAccessLogs builds the access logs of the Listeners that override the default access log,
+// keyed by the name of the Listener.
+func buildListenerAccessLogs(g *graph.Graph) map[string]*AccessLog {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.Logging == nil {
+		return nil
+	}
+
+	accessLog := g.NginxProxy.Source.Spec.Logging.AccessLog
+	if accessLog == nil || len(accessLog.Listeners) == 0 {
+		return nil
+	}
+
+	var defaults ngfAPIv1alpha1.AccessLogSettings
+	if accessLog.Default != nil {
+		defaults = *accessLog.Default
+	}
+
+	accessLogs := make(map[string]*AccessLog, len(accessLog.Listeners))
+	for _, l := range accessLog.Listeners {
+		accessLogs[l.Name] = buildAccessLog(l.Settings, defaults)
+	}
+
+	return accessLogs
+}
+
+// buildAccessLog builds the access log from the settings. The defaults fill in the settings
+// that are not specified.
+func buildAccessLog(settings, defaults ngfAPIv1alpha1.AccessLogSettings) *AccessLog {
+	accessLog := &AccessLog{
+		Destination: defaultAccessLogDestination,
+		Format:      graph.DefaultAccessLogFormat,
+	}
+
+	format := settings.Format
+	if format == nil {
+		format = defaults.Format
+	}
+
+	if format != nil {
+		accessLog.Format = *format
+	}
+
+	dest := settings.Destination
+	if dest == nil {
+		dest = defaults.Destination
+	}
+
+	if dest != nil {
+		accessLog.Destination = convertAccessLogDestination(*dest)
+	}
+
+	disable := settings.Disable
+	if disable == nil {
+		disable = defaults.Disable
+	}
+
+	accessLog.Disable = disable != nil && *disable
+
+	return accessLog
+}
+
+func convertAccessLogDestination(dest ngfAPIv1alpha1.AccessLogDestination) string {
+	switch dest.Type {
+	case ngfAPIv1alpha1.AccessLogDestinationStderr:
+		return "/dev/stderr"
+	case ngfAPIv1alpha1.AccessLogDestinationFile:
+		if dest.File != nil {
+			return *dest.File
+		}
+	case ngfAPIv1alpha1.AccessLogDestinationSyslog:
+		if dest.Syslog != nil {
+			syslog := "syslog:server=" + dest.Syslog.Server
+			if dest.Syslog.Tag != nil {
+				syslog += ",tag=" + *dest.Syslog.Tag
+			}
+
+			return syslog
+		}
+	}
+
+	return defaultAccessLogDestination
+}
+
+func buildTemplateOverrides(g *graph.Graph) TemplateOverrides {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid {
+		return TemplateOverrides{}
+	}
+
+	overrides := g.NginxProxy.TemplateOverrides
+
+	return TemplateOverrides{
+		Server:   overrides[ngfAPIv1alpha1.TemplateOverrideKeyServer],
+		Upstream: overrides[ngfAPIv1alpha1.TemplateOverrideKeyUpstream],
+		Location: overrides[ngfAPIv1alpha1.TemplateOverrideKeyLocation],
+	}
+}
+
 func buildAuxiliarySecrets(
 	secrets map[types.NamespacedName][]graph.PlusSecretFile,
 ) map[graph.SecretFileType][]byte {
@@ -1037,11 +2877,107 @@ func buildNginxPlus(g *graph.Graph) NginxPlus {
 	return nginxPlusSettings
 }
 
+// buildStatusEndpoint builds the read-only status endpoint of the NginxProxy.
+func buildStatusEndpoint(g *graph.Graph) *StatusEndpoint {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.Status == nil {
+		return nil
+	}
+
+	status := g.NginxProxy.Source.Spec.Status
+
+	addresses := make([]string, 0, len(status.AllowedAddresses))
+	for _, addr := range status.AllowedAddresses {
+		addresses = append(addresses, addr.Value)
+	}
+
+	return &StatusEndpoint{
+		AllowedAddresses: addresses,
+		Port:             status.Port,
+	}
+}
+
+// buildStatusZones builds the NGINX Plus status zones of the servers that belong to the listeners.
+func buildStatusZones(g *graph.Graph) map[string]ListenerStatusZone {
+	zones := make(map[string]ListenerStatusZone)
+
+	for _, rules := range buildRulesForProtocol(g) {
+		for _, hpr := range rules {
+			hpr.addStatusZones(zones)
+		}
+	}
+
+	for _, l := range g.GatewayListeners() {
+		if !l.Valid {
+			continue
+		}
+
+		port := int32(l.Source.Port)
+
+		for _, r := range l.L4Routes {
+			if !r.Valid {
+				continue
+			}
+
+			switch l.Source.Protocol {
+			case v1.TLSProtocolType:
+				for _, h := range getL4RouteHostnames(r, l) {
+					zones[StatusZoneName(h, port)] = newListenerStatusZone(l, false)
+				}
+			case v1.TCPProtocolType, v1.UDPProtocolType:
+				zones[StatusZoneName(r.Spec.BackendRef.ServicePortReference(), port)] = newListenerStatusZone(l, false)
+			}
+		}
+	}
+
+	if len(zones) == 0 {
+		return nil
+	}
+
+	return zones
+}
+
+// getL4RouteHostnames returns the hostnames of an L4Route that are accepted by a listener.
+func getL4RouteHostnames(r *graph.L4Route, l *graph.Listener) []string {
+	for _, p := range r.ParentRefs {
+		if p.Gateway != l.GatewayName {
+			continue
+		}
+
+		if hostnames, exist := p.Attachment.AcceptedHostnames[l.Name]; exist {
+			return hostnames
+		}
+	}
+
+	return nil
+}
+
+func newListenerStatusZone(l *graph.Listener, isDefault bool) ListenerStatusZone {
+	return ListenerStatusZone{
+		Gateway:  l.GatewayName,
+		Listener: l.Name,
+		Default:  isDefault,
+		HTTPS:    l.Source.Protocol == v1.HTTPSProtocolType,
+	}
+}
+
+// StatusZoneName returns the name of the NGINX Plus status zone of a server, where the name is
+// the hostname of the server, or the name of the upstream for TCP and UDP servers.
+func StatusZoneName(name string, port int32) string {
+	return fmt.Sprintf("%s_%d", name, port)
+}
+
+// DefaultServerStatusZoneName returns the name of the NGINX Plus status zone of the default server of a port.
+// Hostnames can't contain '_', so the name never conflicts with the names of the zones of other servers.
+func DefaultServerStatusZoneName(port int32) string {
+	return StatusZoneName("_default", port)
+}
+
 func GetDefaultConfiguration(g *graph.Graph, configVersion int) Configuration {
 	return Configuration{
 		Version:          configVersion,
 		Logging:          buildLogging(g),
 		NginxPlus:        NginxPlus{},
 		AuxiliarySecrets: buildAuxiliarySecrets(g.PlusSecrets),
+		HashSizes:        buildHashSizes(g, nil, nil),
 	}
 }
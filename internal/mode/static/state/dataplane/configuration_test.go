@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -191,7 +192,7 @@ func TestBuildConfiguration(t *testing.T) {
 	}
 
 	fakeResolver := &resolverfakes.FakeServiceResolver{}
-	fakeResolver.ResolveReturns(fooEndpoints, nil)
+	fakeResolver.ResolveReturns(fooEndpoints, resolver.EndpointSummary{}, nil)
 
 	validBackendRef := getNormalBackendRef()
 
@@ -233,6 +234,7 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 				BackendRefs:  createBackendRefs(validRule),
 				ValidMatches: validMatches,
+				Dropped:      !validMatches,
 			}
 		}
 
@@ -352,6 +354,7 @@ func TestBuildConfiguration(t *testing.T) {
 			ngfAPIv1alpha1.NginxContextHTTPServer:         "server snippet",
 			ngfAPIv1alpha1.NginxContextMain:               "main snippet",
 			ngfAPIv1alpha1.NginxContextHTTP:               "http snippet",
+			ngfAPIv1alpha1.NginxContextHTTPMap:            "map snippet",
 		},
 	}
 
@@ -2089,6 +2092,292 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "NginxProxy with tracing config and http2 disabled",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							EnableHTTP3: true,
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{HTTP2: true, HTTP3: true, IPFamily: Dual}
+				return conf
+			}),
+			msg: "NginxProxy with http3 enabled",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Hardening: &ngfAPIv1alpha1.Hardening{
+								ClientHeaderTimeout:        helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+								RequestSmugglingProtection: helpers.GetPointer(false),
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:    true,
+					IPFamily: Dual,
+					Hardening: &Hardening{
+						ClientHeaderTimeout:     "5s",
+						ClientBodyTimeout:       "10s",
+						SendTimeout:             "10s",
+						ResetTimedOutConnection: true,
+					},
+				}
+				return conf
+			}),
+			msg: "NginxProxy with hardening settings",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							TLS: &ngfAPIv1alpha1.NginxTLS{
+								Protocols:           []ngfAPIv1alpha1.TLSProtocolType{ngfAPIv1alpha1.TLSProtocolTLSv13},
+								Ciphers:             helpers.GetPointer("HIGH:!aNULL"),
+								PreferServerCiphers: helpers.GetPointer(true),
+								Session: &ngfAPIv1alpha1.TLSSession{
+									CacheSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("10m"),
+									Timeout:   helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+									Tickets:   helpers.GetPointer(false),
+								},
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:    true,
+					IPFamily: Dual,
+					TLS: &TLSSettings{
+						Protocols:           []string{"TLSv1.3"},
+						Ciphers:             "HIGH:!aNULL",
+						PreferServerCiphers: "on",
+						SessionCache:        "shared:SSL:10m",
+						SessionTimeout:      "1h",
+						SessionTickets:      "off",
+					},
+				}
+				return conf
+			}),
+			msg: "NginxProxy with TLS profile",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Resolver: &ngfAPIv1alpha1.NginxResolver{
+								Addresses: []string{"10.96.0.10", "fd00::10", "[fd00::11]:5353"},
+								Valid:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+								IPv6:      helpers.GetPointer(false),
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:    true,
+					IPFamily: Dual,
+					Resolver: &Resolver{
+						Addresses:   []string{"10.96.0.10", "[fd00::10]", "[fd00::11]:5353"},
+						Valid:       "30s",
+						DisableIPv6: true,
+					},
+				}
+				return conf
+			}),
+			msg: "NginxProxy with resolver",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							HTTP2: &ngfAPIv1alpha1.HTTP2Settings{
+								MaxConcurrentStreams: helpers.GetPointer[int32](512),
+								RecvBufferSize:       helpers.GetPointer[ngfAPIv1alpha1.Size]("512k"),
+								ChunkSize:            helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2: true,
+					HTTP2Settings: &HTTP2Settings{
+						MaxConcurrentStreams: 512,
+						RecvBufferSize:       "512k",
+						ChunkSize:            "16k",
+					},
+					IPFamily: Dual,
+				}
+				return conf
+			}),
+			msg: "NginxProxy with HTTP/2 settings",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							HTTP2: &ngfAPIv1alpha1.HTTP2Settings{
+								MaxConcurrentStreams: helpers.GetPointer[int32](512),
+								RecvBufferSize:       helpers.GetPointer[ngfAPIv1alpha1.Size]("512k"),
+								ChunkSize:            helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+							},
+							DisableHTTP2: true,
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					IPFamily: Dual,
+				}
+				return conf
+			}),
+			msg: "NginxProxy with HTTP/2 settings and disabled HTTP/2",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Status: &ngfAPIv1alpha1.NginxStatus{
+								Port: 8080,
+								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
+									{Type: ngfAPIv1alpha1.NginxPlusAllowCIDRAddressType, Value: "10.0.0.0/8"},
+									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "fd00::10"},
+								},
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:    true,
+					IPFamily: Dual,
+				}
+				conf.StatusEndpoint = &StatusEndpoint{
+					AllowedAddresses: []string{"10.0.0.0/8", "fd00::10"},
+					Port:             8080,
+				}
+				return conf
+			}),
+			msg: "NginxProxy with status endpoint",
+		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
@@ -2370,13 +2659,22 @@ func TestBuildConfiguration(t *testing.T) {
 						Contents: "http snippet",
 					},
 				}
+				conf.BaseHTTPConfig.MapSnippets = []Snippet{
+					{
+						Name: createSnippetName(
+							ngfAPIv1alpha1.NginxContextHTTPMap,
+							client.ObjectKeyFromObject(sf1.Source),
+						),
+						Contents: "map snippet",
+					},
+				}
 				conf.HTTPServers = []VirtualServer{}
 				conf.SSLServers = []VirtualServer{}
 				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
 
 				return conf
 			}),
-			msg: "SnippetsFilters with main and http snippet",
+			msg: "SnippetsFilters with main, http, and map snippet",
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
@@ -2453,7 +2751,7 @@ func TestBuildConfiguration_Plus(t *testing.T) {
 	}
 
 	fakeResolver := &resolverfakes.FakeServiceResolver{}
-	fakeResolver.ResolveReturns(fooEndpoints, nil)
+	fakeResolver.ResolveReturns(fooEndpoints, resolver.EndpointSummary{}, nil)
 
 	listener80 := v1.Listener{
 		Name:     "listener-80-1",
@@ -2502,7 +2800,13 @@ func TestBuildConfiguration_Plus(t *testing.T) {
 			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
 				conf.SSLServers = []VirtualServer{}
 				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
-				conf.NginxPlus = NginxPlus{AllowedAddresses: []string{"127.0.0.3", "25.0.0.3"}}
+				conf.NginxPlus = NginxPlus{
+					AllowedAddresses: []string{"127.0.0.3", "25.0.0.3"},
+					StatusZones: map[string]ListenerStatusZone{
+						"~^_80":       {Listener: "listener-80-1"},
+						"_default_80": {Listener: "listener-80-1", Default: true},
+					},
+				}
 				return conf
 			}),
 			msg: "NginxProxy with NginxPlus allowed addresses configured",
@@ -2601,6 +2905,47 @@ func TestGetPath(t *testing.T) {
 	}
 }
 
+func TestLessPathRule(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pathRules := []PathRule{
+		{Path: "/coffee/[0-9]+", PathType: PathTypeRegularExpression},
+		{Path: "/tea", PathType: PathTypePrefix},
+		{Path: "/", PathType: PathTypePrefix, CaseInsensitive: true},
+		{Path: "/coffee", PathType: PathTypePrefix},
+		{Path: "/coffee/.*", PathType: PathTypeRegularExpression},
+		{Path: "/tea", PathType: PathTypeExact, CaseInsensitive: true},
+		{Path: "/coffee", PathType: PathTypeExact},
+		{Path: "/coffee", PathType: PathTypePrefix, CaseInsensitive: true},
+		{Path: "/coffee/[a-z]+", PathType: PathTypeRegularExpression, CaseInsensitive: true},
+		{Path: "/coffee/latte", PathType: PathTypeExact, CaseInsensitive: true},
+		{Path: "/coffee/latte", PathType: PathTypePrefix, CaseInsensitive: true},
+	}
+
+	sort.Slice(pathRules, func(i, j int) bool {
+		return lessPathRule(pathRules[i], pathRules[j])
+	})
+
+	g.Expect(pathRules).To(Equal([]PathRule{
+		// the order of the PathRules that are not configured with regular expressions doesn't affect routing
+		{Path: "/coffee", PathType: PathTypeExact},
+		{Path: "/coffee", PathType: PathTypePrefix},
+		{Path: "/tea", PathType: PathTypePrefix},
+		// case-insensitive Exact paths have the highest precedence among regular expressions
+		{Path: "/coffee/latte", PathType: PathTypeExact, CaseInsensitive: true},
+		{Path: "/tea", PathType: PathTypeExact, CaseInsensitive: true},
+		// then the regular expression paths, the longer expression first
+		{Path: "/coffee/[0-9]+", PathType: PathTypeRegularExpression},
+		{Path: "/coffee/[a-z]+", PathType: PathTypeRegularExpression, CaseInsensitive: true},
+		{Path: "/coffee/.*", PathType: PathTypeRegularExpression},
+		// then the case-insensitive Prefix paths, the longer prefix first
+		{Path: "/coffee/latte", PathType: PathTypePrefix, CaseInsensitive: true},
+		{Path: "/coffee", PathType: PathTypePrefix, CaseInsensitive: true},
+		{Path: "/", PathType: PathTypePrefix, CaseInsensitive: true},
+	}))
+}
+
 func TestCreateFilters(t *testing.T) {
 	t.Parallel()
 
@@ -2755,25 +3100,179 @@ func TestCreateFilters(t *testing.T) {
 		},
 	}
 
-	tests := []struct {
-		expected HTTPFilters
-		msg      string
-		filters  []graph.Filter
-	}{
-		{
-			filters:  []graph.Filter{},
-			expected: HTTPFilters{},
-			msg:      "no filters",
-		},
-		{
-			filters: []graph.Filter{
-				redirect1,
+	createBodyTransformFilter := func(name string) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPIv1alpha1.GroupName,
+				Kind:  kinds.HTTPBodyTransform,
+				Name:  v1.ObjectName(name),
 			},
-			expected: HTTPFilters{
-				RequestRedirect: &expectedRedirect1,
+			ResolvedExtensionRef: &graph.ExtensionRefFilter{
+				Valid: true,
+				HTTPBodyTransform: &graph.HTTPBodyTransform{
+					Source: &ngfAPIv1alpha1.HTTPBodyTransform{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+						Spec: ngfAPIv1alpha1.HTTPBodyTransformSpec{
+							Request: &ngfAPIv1alpha1.RequestBodyTransform{Script: "return body;"},
+						},
+					},
+					Valid:      true,
+					Referenced: true,
+				},
+			},
+		}
+	}
+
+	createDirectResponseFilter := func(name string, spec ngfAPIv1alpha1.DirectResponseSpec) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPIv1alpha1.GroupName,
+				Kind:  kinds.DirectResponse,
+				Name:  v1.ObjectName(name),
+			},
+			ResolvedExtensionRef: &graph.ExtensionRefFilter{
+				Valid: true,
+				DirectResponse: &graph.DirectResponse{
+					Source: &ngfAPIv1alpha1.DirectResponse{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+						Spec: spec,
+					},
+					Valid:      true,
+					Referenced: true,
+				},
+			},
+		}
+	}
+
+	createMirrorFilter := func(svcName string, valid bool, percent *int32) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterRequestMirror,
+			RequestMirror: &v1.HTTPRequestMirrorFilter{
+				BackendRef: v1.BackendObjectReference{
+					Name: v1.ObjectName(svcName),
+				},
+				Percent: percent,
+			},
+			MirrorBackendRef: &graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: svcName},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Valid:       valid,
+			},
+		}
+	}
+
+	tests := []struct {
+		expected HTTPFilters
+		msg      string
+		filters  []graph.Filter
+	}{
+		{
+			filters:  []graph.Filter{},
+			expected: HTTPFilters{},
+			msg:      "no filters",
+		},
+		{
+			filters: []graph.Filter{
+				createMirrorFilter("mirror1", true, nil),
+				createMirrorFilter("invalid", false, nil),
+				createMirrorFilter("mirror2", true, helpers.GetPointer[int32](25)),
+			},
+			expected: HTTPFilters{
+				RequestMirrors: []HTTPRequestMirrorFilter{
+					{
+						UpstreamName: "test_mirror1_80",
+					},
+					{
+						UpstreamName: "test_mirror2_80",
+						Percent:      helpers.GetPointer(25.0),
+					},
+				},
+			},
+			msg: "request mirror filters, filters with invalid backends are ignored",
+		},
+		{
+			filters: []graph.Filter{
+				createMirrorFilter("mirror1", true, nil),
+				createMirrorFilter("mirror1", true, helpers.GetPointer[int32](25)),
+				createMirrorFilter("mirror1", true, nil),
+				createMirrorFilter("mirror1", true, helpers.GetPointer[int32](25)),
+			},
+			expected: HTTPFilters{
+				RequestMirrors: []HTTPRequestMirrorFilter{
+					{
+						UpstreamName: "test_mirror1_80",
+					},
+					{
+						UpstreamName: "test_mirror1_80",
+						Percent:      helpers.GetPointer(25.0),
+					},
+				},
+			},
+			msg: "identical request mirror filters are only included once",
+		},
+		{
+			filters: []graph.Filter{
+				redirect1,
+			},
+			expected: HTTPFilters{
+				RequestRedirect: &expectedRedirect1,
 			},
 			msg: "one request redirect filter",
 		},
+		{
+			filters: []graph.Filter{
+				createBodyTransformFilter("body-transform-1"),
+				createBodyTransformFilter("body-transform-2"),
+			},
+			expected: HTTPFilters{
+				BodyTransform: &BodyTransform{
+					Source:              types.NamespacedName{Namespace: "default", Name: "body-transform-1"},
+					ID:                  "body_transform_default_body_transform_1",
+					RequestScript:       "return body;",
+					MaxRequestBodySize:  1 << 20,
+					MaxResponseBodySize: 1 << 20,
+				},
+			},
+			msg: "body transform filters, using the first filter",
+		},
+		{
+			filters: []graph.Filter{
+				createDirectResponseFilter("direct-response-1", ngfAPIv1alpha1.DirectResponseSpec{
+					StatusCode:  503,
+					Body:        helpers.GetPointer("maintenance"),
+					ContentType: helpers.GetPointer("text/html"),
+				}),
+				createDirectResponseFilter("direct-response-2", ngfAPIv1alpha1.DirectResponseSpec{StatusCode: 403}),
+			},
+			expected: HTTPFilters{
+				DirectResponse: &DirectResponse{
+					StatusCode:  503,
+					Body:        "maintenance",
+					ContentType: "text/html",
+				},
+			},
+			msg: "direct response filters, using the first filter",
+		},
+		{
+			filters: []graph.Filter{
+				createDirectResponseFilter("direct-response", ngfAPIv1alpha1.DirectResponseSpec{StatusCode: 403}),
+			},
+			expected: HTTPFilters{
+				DirectResponse: &DirectResponse{
+					StatusCode:  403,
+					ContentType: "text/plain",
+				},
+			},
+			msg: "direct response filter with defaults",
+		},
 		{
 			filters: []graph.Filter{
 				redirect1,
@@ -2829,6 +3328,33 @@ func TestCreateFilters(t *testing.T) {
 			},
 			msg: "two of each filter, first value for each standard filter wins, all ext ref filters added",
 		},
+		{
+			filters: []graph.Filter{
+				snippetsFilter1,
+				snippetsFilter1,
+			},
+			expected: HTTPFilters{
+				SnippetsFilters: []SnippetsFilter{
+					{
+						LocationSnippet: &Snippet{
+							Name: createSnippetName(
+								ngfAPIv1alpha1.NginxContextHTTPServerLocation,
+								types.NamespacedName{Namespace: "default", Name: "sf1"},
+							),
+							Contents: "location snippet 1",
+						},
+						ServerSnippet: &Snippet{
+							Name: createSnippetName(
+								ngfAPIv1alpha1.NginxContextHTTPServer,
+								types.NamespacedName{Namespace: "default", Name: "sf1"},
+							),
+							Contents: "server snippet 1",
+						},
+					},
+				},
+			},
+			msg: "snippets filter referenced twice is added once",
+		},
 	}
 
 	for _, test := range tests {
@@ -2980,6 +3506,27 @@ func TestBuildUpstreams(t *testing.T) {
 		},
 	}
 
+	snippetsEndpoints := []resolver.Endpoint{
+		{
+			Address: "17.0.0.0",
+			Port:    80,
+		},
+	}
+
+	mirrorEndpoints := []resolver.Endpoint{
+		{
+			Address: "18.0.0.0",
+			Port:    80,
+		},
+	}
+
+	drainingEndpoints := []resolver.Endpoint{
+		{
+			Address: "19.0.0.0",
+			Port:    80,
+		},
+	}
+
 	createBackendRefs := func(serviceNames ...string) []graph.BackendRef {
 		var backends []graph.BackendRef
 		for _, name := range serviceNames {
@@ -2987,6 +3534,7 @@ func TestBuildUpstreams(t *testing.T) {
 				SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
 				ServicePort: apiv1.ServicePort{Port: 80},
 				Valid:       name != "",
+				Weight:      1,
 			})
 		}
 		return backends
@@ -3008,12 +3556,67 @@ func TestBuildUpstreams(t *testing.T) {
 
 	hr5Refs0 := createBackendRefs("ipv6-endpoints")
 
+	// the draining upstream is only referenced with a zero weight, but the bar upstream is also referenced
+	// with a non-zero weight by hr1, so it is not draining
+	zeroWeightRefs := createBackendRefs("draining", "bar")
+	for i := range zeroWeightRefs {
+		zeroWeightRefs[i].Weight = 0
+	}
+
 	nonExistingRefs := createBackendRefs("non-existing")
 
 	invalidHRRefs := createBackendRefs("abc")
 
 	refsWithPolicies := createBackendRefs("policies")
 
+	refsWithSnippets := createBackendRefs("snippets")
+
+	createSnippetsFilter := func(name string, snippets map[ngfAPIv1alpha1.NginxContext]string) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterExtensionRef,
+			ResolvedExtensionRef: &graph.ExtensionRefFilter{
+				Valid: true,
+				SnippetsFilter: &graph.SnippetsFilter{
+					Source: &ngfAPIv1alpha1.SnippetsFilter{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+					},
+					Snippets: snippets,
+					Valid:    true,
+				},
+			},
+		}
+	}
+
+	hashFilter := createSnippetsFilter("hash", map[ngfAPIv1alpha1.NginxContext]string{
+		ngfAPIv1alpha1.NginxContextHTTPUpstream: "hash $request_uri consistent;",
+	})
+	keepaliveFilter := createSnippetsFilter("keepalive", map[ngfAPIv1alpha1.NginxContext]string{
+		ngfAPIv1alpha1.NginxContextHTTPUpstream: "keepalive 32;",
+	})
+	locationFilter := createSnippetsFilter("location", map[ngfAPIv1alpha1.NginxContext]string{
+		ngfAPIv1alpha1.NginxContextHTTPServerLocation: "location snippet",
+	})
+
+	// the rules with different upstream snippets get separate upstreams, and the rules with the same
+	// upstream snippets share an upstream
+	rulesWithSnippets := refsToValidRules(refsWithSnippets, refsWithSnippets, refsWithSnippets)
+	rulesWithSnippets[0].Filters.Filters = []graph.Filter{hashFilter, locationFilter}
+	rulesWithSnippets[1].Filters.Filters = []graph.Filter{hashFilter, keepaliveFilter}
+	rulesWithSnippets[2].Filters.Filters = []graph.Filter{hashFilter}
+
+	// the backend of the mirror filter gets an upstream, but the invalid mirror backend doesn't
+	rulesWithMirror := refsToValidRules(hr3Refs0)
+	rulesWithMirror[0].Filters.Filters = []graph.Filter{
+		{
+			FilterType:       graph.FilterRequestMirror,
+			MirrorBackendRef: &createBackendRefs("mirror")[0],
+		},
+		{
+			FilterType:       graph.FilterRequestMirror,
+			MirrorBackendRef: &createBackendRefs("")[0],
+		},
+	}
+
 	routes := map[graph.RouteKey]*graph.L7Route{
 		{NamespacedName: types.NamespacedName{Name: "hr1", Namespace: "test"}}: {
 			Valid: true,
@@ -3030,7 +3633,7 @@ func TestBuildUpstreams(t *testing.T) {
 		{NamespacedName: types.NamespacedName{Name: "hr3", Namespace: "test"}}: {
 			Valid: true,
 			Spec: graph.L7RouteSpec{
-				Rules: refsToValidRules(hr3Refs0),
+				Rules: rulesWithMirror,
 			},
 		},
 	}
@@ -3048,7 +3651,7 @@ func TestBuildUpstreams(t *testing.T) {
 		{NamespacedName: types.NamespacedName{Name: "hr4", Namespace: "test"}}: {
 			Valid: true,
 			Spec: graph.L7RouteSpec{
-				Rules: refsToValidRules(hr5Refs0, hr2Refs1),
+				Rules: refsToValidRules(hr5Refs0, hr2Refs1, zeroWeightRefs),
 			},
 		},
 	}
@@ -3080,6 +3683,15 @@ func TestBuildUpstreams(t *testing.T) {
 		},
 	}
 
+	routesWithSnippets := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Name: "snippets", Namespace: "test"}}: {
+			Valid: true,
+			Spec: graph.L7RouteSpec{
+				Rules: rulesWithSnippets,
+			},
+		},
+	}
+
 	listeners := []*graph.Listener{
 		{
 			Name:   "invalid-listener",
@@ -3111,6 +3723,11 @@ func TestBuildUpstreams(t *testing.T) {
 			Valid:  true,
 			Routes: routesWithPolicies,
 		},
+		{
+			Name:   "listener-6",
+			Valid:  true,
+			Routes: routesWithSnippets,
+		},
 	}
 
 	validPolicy1 := &policiesfakes.FakePolicy{}
@@ -3144,6 +3761,7 @@ func TestBuildUpstreams(t *testing.T) {
 	}
 
 	emptyEndpointsErrMsg := "empty endpoints error"
+	emptyEndpointsSummary := resolver.EndpointSummary{NotReady: map[string]int{"zone-a": 2}}
 	nilEndpointsErrMsg := "nil endpoints error"
 
 	expUpstreams := []Upstream{
@@ -3160,14 +3778,19 @@ func TestBuildUpstreams(t *testing.T) {
 			Endpoints: bazEndpoints,
 		},
 		{
-			Name:      "test_empty-endpoints_80",
-			Endpoints: []resolver.Endpoint{},
-			ErrorMsg:  emptyEndpointsErrMsg,
+			Name:            "test_empty-endpoints_80",
+			Endpoints:       []resolver.Endpoint{},
+			EndpointSummary: emptyEndpointsSummary,
+			ErrorMsg:        emptyEndpointsErrMsg,
 		},
 		{
 			Name:      "test_foo_80",
 			Endpoints: fooEndpoints,
 		},
+		{
+			Name:      "test_mirror_80",
+			Endpoints: mirrorEndpoints,
+		},
 		{
 			Name:      "test_nil-endpoints_80",
 			Endpoints: nil,
@@ -3182,6 +3805,35 @@ func TestBuildUpstreams(t *testing.T) {
 			Endpoints: policyEndpoints,
 			Policies:  []policies.Policy{validPolicy1, validPolicy2},
 		},
+		{
+			Name:      "test_draining_80",
+			Endpoints: drainingEndpoints,
+			Draining:  true,
+		},
+		{
+			Name:      "test_snippets_80_8f5b428d",
+			Endpoints: snippetsEndpoints,
+			Snippets: []Snippet{
+				{
+					Name:     "SnippetsFilter_http.upstream_test_hash",
+					Contents: "hash $request_uri consistent;",
+				},
+			},
+		},
+		{
+			Name:      "test_snippets_80_6b821fbd",
+			Endpoints: snippetsEndpoints,
+			Snippets: []Snippet{
+				{
+					Name:     "SnippetsFilter_http.upstream_test_hash",
+					Contents: "hash $request_uri consistent;",
+				},
+				{
+					Name:     "SnippetsFilter_http.upstream_test_keepalive",
+					Contents: "keepalive 32;",
+				},
+			},
+		},
 	}
 
 	fakeResolver := &resolverfakes.FakeServiceResolver{}
@@ -3190,28 +3842,34 @@ func TestBuildUpstreams(t *testing.T) {
 		svcNsName types.NamespacedName,
 		_ apiv1.ServicePort,
 		_ []discoveryV1.AddressType,
-	) ([]resolver.Endpoint, error) {
+	) ([]resolver.Endpoint, resolver.EndpointSummary, error) {
 		switch svcNsName.Name {
 		case "bar":
-			return barEndpoints, nil
+			return barEndpoints, resolver.EndpointSummary{}, nil
 		case "baz":
-			return bazEndpoints, nil
+			return bazEndpoints, resolver.EndpointSummary{}, nil
 		case "baz2":
-			return baz2Endpoints, nil
+			return baz2Endpoints, resolver.EndpointSummary{}, nil
 		case "empty-endpoints":
-			return []resolver.Endpoint{}, errors.New(emptyEndpointsErrMsg)
+			return []resolver.Endpoint{}, emptyEndpointsSummary, errors.New(emptyEndpointsErrMsg)
 		case "foo":
-			return fooEndpoints, nil
+			return fooEndpoints, resolver.EndpointSummary{}, nil
 		case "nil-endpoints":
-			return nil, errors.New(nilEndpointsErrMsg)
+			return nil, resolver.EndpointSummary{}, errors.New(nilEndpointsErrMsg)
 		case "abc":
-			return abcEndpoints, nil
+			return abcEndpoints, resolver.EndpointSummary{}, nil
 		case "ipv6-endpoints":
-			return ipv6Endpoints, nil
+			return ipv6Endpoints, resolver.EndpointSummary{}, nil
 		case "policies":
-			return policyEndpoints, nil
+			return policyEndpoints, resolver.EndpointSummary{}, nil
+		case "snippets":
+			return snippetsEndpoints, resolver.EndpointSummary{}, nil
+		case "mirror":
+			return mirrorEndpoints, resolver.EndpointSummary{}, nil
+		case "draining":
+			return drainingEndpoints, resolver.EndpointSummary{}, nil
 		default:
-			return nil, fmt.Errorf("unexpected service %s", svcNsName.Name)
+			return nil, resolver.EndpointSummary{}, fmt.Errorf("unexpected service %s", svcNsName.Name)
 		}
 	})
 
@@ -3221,6 +3879,66 @@ func TestBuildUpstreams(t *testing.T) {
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
 }
 
+func TestBuildUpstream(t *testing.T) {
+	t.Parallel()
+
+	svcEndpoints := []resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}}
+	svcSummary := resolver.EndpointSummary{Ready: map[string]int{"": 1}}
+	addressTypes := []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4}
+
+	tests := []struct {
+		name             string
+		expected         Upstream
+		br               graph.BackendRef
+		expResolverCalls int
+	}{
+		{
+			name: "Service endpoints are resolved",
+			br: graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "svc"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Valid:       true,
+			},
+			expected: Upstream{
+				Name:            "test_svc_80",
+				Endpoints:       svcEndpoints,
+				EndpointSummary: svcSummary,
+			},
+			expResolverCalls: 1,
+		},
+		{
+			name: "ExternalName Service is resolved by NGINX",
+			br: graph.BackendRef{
+				SvcNsName:    types.NamespacedName{Namespace: "test", Name: "external"},
+				ServicePort:  apiv1.ServicePort{Port: 443},
+				ExternalName: "api.example.com",
+				Valid:        true,
+			},
+			expected: Upstream{
+				Name:         "test_external_443",
+				Endpoints:    []resolver.Endpoint{{Address: "api.example.com", Port: 443}},
+				ExternalName: "api.example.com",
+			},
+			expResolverCalls: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			fakeResolver := &resolverfakes.FakeServiceResolver{}
+			fakeResolver.ResolveReturns(svcEndpoints, svcSummary, nil)
+
+			up := buildUpstream(context.TODO(), test.br, fakeResolver, nil, addressTypes)
+
+			g.Expect(up).To(Equal(test.expected))
+			g.Expect(fakeResolver.ResolveCallCount()).To(Equal(test.expResolverCalls))
+		})
+	}
+}
+
 func createBackendGroup(name string, ruleIdx int, backendNames ...string) BackendGroup {
 	backends := make([]Backend, len(backendNames))
 	for i, name := range backendNames {
@@ -3234,6 +3952,49 @@ func createBackendGroup(name string, ruleIdx int, backendNames ...string) Backen
 	}
 }
 
+func TestUpstreamName(t *testing.T) {
+	t.Parallel()
+
+	br := graph.BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "foo"},
+		ServicePort: apiv1.ServicePort{Port: 80},
+		Valid:       true,
+	}
+
+	hash := Snippet{Name: "SnippetsFilter_http.upstream_test_hash", Contents: "hash $request_uri consistent;"}
+	keepalive := Snippet{Name: "SnippetsFilter_http.upstream_test_keepalive", Contents: "keepalive 32;"}
+
+	tests := []struct {
+		name     string
+		expName  string
+		snippets []Snippet
+	}{
+		{
+			name:    "no snippets",
+			expName: "test_foo_80",
+		},
+		{
+			name:     "one snippet",
+			snippets: []Snippet{hash},
+			expName:  "test_foo_80_8f5b428d",
+		},
+		{
+			name:     "multiple snippets",
+			snippets: []Snippet{hash, keepalive},
+			expName:  "test_foo_80_6b821fbd",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(upstreamName(br, test.snippets)).To(Equal(test.expName))
+		})
+	}
+}
+
 func TestBuildBackendGroups(t *testing.T) {
 	t.Parallel()
 
@@ -3443,29 +4204,167 @@ func TestConvertBackendTLS(t *testing.T) {
 	}
 }
 
-func TestBuildTelemetry(t *testing.T) {
+func TestBuildSSL(t *testing.T) {
 	t.Parallel()
-	telemetryConfigured := &graph.NginxProxy{
-		Source: &ngfAPIv1alpha1.NginxProxy{
-			Spec: ngfAPIv1alpha1.NginxProxySpec{
-				Telemetry: &ngfAPIv1alpha1.Telemetry{
-					Exporter: &ngfAPIv1alpha1.TelemetryExporter{
-						Endpoint:   "my-otel.svc:4563",
-						BatchSize:  helpers.GetPointer(int32(512)),
-						BatchCount: helpers.GetPointer(int32(4)),
-						Interval:   helpers.GetPointer(ngfAPIv1alpha1.Duration("5s")),
-					},
-					ServiceName: helpers.GetPointer("my-svc"),
-					SpanAttributes: []ngfAPIv1alpha1.SpanAttribute{
-						{Key: "key", Value: "value"},
-					},
-				},
+
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+
+	tests := []struct {
+		listener *graph.Listener
+		expSSL   *SSL
+		msg      string
+	}{
+		{
+			msg:      "no secret",
+			listener: &graph.Listener{},
+		},
+		{
+			msg:      "no OCSP stapling",
+			listener: &graph.Listener{ResolvedSecret: &secretNsName},
+			expSSL:   &SSL{KeyPairID: "ssl_keypair_test_secret"},
+		},
+		{
+			msg: "OCSP stapling without verification",
+			listener: &graph.Listener{
+				ResolvedSecret: &secretNsName,
+				OCSPStapling:   &graph.OCSPStapling{},
 			},
+			expSSL: &SSL{KeyPairID: "ssl_keypair_test_secret", OCSPStapling: true},
 		},
-		Valid: true,
-	}
-
-	createTelemetry := func() Telemetry {
+		{
+			msg: "OCSP stapling with verification",
+			listener: &graph.Listener{
+				ResolvedSecret: &secretNsName,
+				OCSPStapling:   &graph.OCSPStapling{Verify: true},
+			},
+			expSSL: &SSL{
+				KeyPairID:               "ssl_keypair_test_secret",
+				OCSPStapling:            true,
+				OCSPTrustedCertBundleID: "cert_bundle_test_secret",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSSL(test.listener)).To(Equal(test.expSSL))
+		})
+	}
+}
+
+func TestBuildCertBundles(t *testing.T) {
+	t.Parallel()
+
+	refCertBundles := []graph.CertificateBundle{
+		*graph.NewCertificateBundle(
+			types.NamespacedName{Namespace: "test", Name: "backend-ca"},
+			"ConfigMap",
+			&graph.Certificate{CACert: []byte("backend-ca")},
+		),
+		*graph.NewCertificateBundle(
+			types.NamespacedName{Namespace: "test", Name: "listener-secret"},
+			"Secret",
+			&graph.Certificate{CACert: []byte("listener-ca")},
+		),
+		*graph.NewCertificateBundle(
+			types.NamespacedName{Namespace: "test", Name: "unused"},
+			"ConfigMap",
+			&graph.Certificate{CACert: []byte("unused-ca")},
+		),
+	}
+
+	backendGroups := []BackendGroup{
+		{
+			Backends: []Backend{
+				{Valid: true, VerifyTLS: &VerifyTLS{CertBundleID: "cert_bundle_test_backend-ca"}},
+			},
+		},
+	}
+
+	sslServers := []VirtualServer{
+		{IsDefault: true},
+		{SSL: &SSL{KeyPairID: "ssl_keypair_test_other-secret"}},
+		{
+			SSL: &SSL{
+				KeyPairID:               "ssl_keypair_test_listener-secret",
+				OCSPStapling:            true,
+				OCSPTrustedCertBundleID: "cert_bundle_test_listener-secret",
+			},
+		},
+	}
+
+	tests := []struct {
+		expBundles    map[CertBundleID]CertBundle
+		msg           string
+		backendGroups []BackendGroup
+		sslServers    []VirtualServer
+	}{
+		{
+			msg:        "no references",
+			expBundles: map[CertBundleID]CertBundle{},
+		},
+		{
+			msg:           "referenced by backend groups",
+			backendGroups: backendGroups,
+			expBundles: map[CertBundleID]CertBundle{
+				"cert_bundle_test_backend-ca": CertBundle("backend-ca"),
+			},
+		},
+		{
+			msg:        "referenced by OCSP stapling",
+			sslServers: sslServers,
+			expBundles: map[CertBundleID]CertBundle{
+				"cert_bundle_test_listener-secret": CertBundle("listener-ca"),
+			},
+		},
+		{
+			msg:           "referenced by backend groups and OCSP stapling",
+			backendGroups: backendGroups,
+			sslServers:    sslServers,
+			expBundles: map[CertBundleID]CertBundle{
+				"cert_bundle_test_backend-ca":      CertBundle("backend-ca"),
+				"cert_bundle_test_listener-secret": CertBundle("listener-ca"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			bundles := buildCertBundles(refCertBundles, test.backendGroups, test.sslServers)
+			g.Expect(bundles).To(Equal(test.expBundles))
+		})
+	}
+}
+
+func TestBuildTelemetry(t *testing.T) {
+	t.Parallel()
+	telemetryConfigured := &graph.NginxProxy{
+		Source: &ngfAPIv1alpha1.NginxProxy{
+			Spec: ngfAPIv1alpha1.NginxProxySpec{
+				Telemetry: &ngfAPIv1alpha1.Telemetry{
+					Exporter: &ngfAPIv1alpha1.TelemetryExporter{
+						Endpoint:   "my-otel.svc:4563",
+						BatchSize:  helpers.GetPointer(int32(512)),
+						BatchCount: helpers.GetPointer(int32(4)),
+						Interval:   helpers.GetPointer(ngfAPIv1alpha1.Duration("5s")),
+					},
+					ServiceName: helpers.GetPointer("my-svc"),
+					SpanAttributes: []ngfAPIv1alpha1.SpanAttribute{
+						{Key: "key", Value: "value"},
+					},
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	createTelemetry := func() Telemetry {
 		return Telemetry{
 			Endpoint:    "my-otel.svc:4563",
 			ServiceName: "ngf:ns:gw:my-svc",
@@ -3756,188 +4655,433 @@ func TestBuildPolicies(t *testing.T) {
 	}
 }
 
-func TestGetAllowedAddressType(t *testing.T) {
+func TestBuildStaticContent(t *testing.T) {
 	t.Parallel()
-	test := []struct {
-		msg      string
-		ipFamily IPFamilyType
-		expected []discoveryV1.AddressType
+
+	createPolicy := func(source ngfAPIv1alpha1.StaticContentSource, index, fallback *string) policies.Policy {
+		return &ngfAPIv1alpha1.StaticContentPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "scp"},
+			Spec: ngfAPIv1alpha1.StaticContentPolicySpec{
+				Source:   source,
+				Index:    index,
+				Fallback: fallback,
+			},
+		}
+	}
+
+	configMapSource := ngfAPIv1alpha1.StaticContentSource{
+		Type:      ngfAPIv1alpha1.StaticContentSourceConfigMap,
+		ConfigMap: &ngfAPIv1alpha1.StaticContentConfigMap{Name: "content"},
+	}
+
+	volumeSource := ngfAPIv1alpha1.StaticContentSource{
+		Type:   ngfAPIv1alpha1.StaticContentSourceVolume,
+		Volume: &ngfAPIv1alpha1.StaticContentVolume{Path: "/usr/share/nginx/static/content"},
+	}
+
+	tests := []struct {
+		expContent *StaticContent
+		name       string
+		policies   []*graph.Policy
 	}{
 		{
-			msg:      "dual ip family",
-			ipFamily: Dual,
-			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4, discoveryV1.AddressTypeIPv6},
+			name:       "no policies",
+			expContent: nil,
 		},
 		{
-			msg:      "ipv4 ip family",
-			ipFamily: IPv4,
-			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4},
+			name: "invalid and other policies",
+			policies: []*graph.Policy{
+				{
+					Source: createPolicy(configMapSource, nil, nil),
+					Valid:  false,
+				},
+				{
+					Source: &ngfAPIv1alpha1.CacheControlPolicy{},
+					Valid:  true,
+				},
+			},
+			expContent: nil,
 		},
 		{
-			msg:      "ipv6 ip family",
-			ipFamily: IPv6,
-			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv6},
+			name: "ConfigMap source with defaults",
+			policies: []*graph.Policy{
+				{
+					Source: createPolicy(configMapSource, nil, nil),
+					Valid:  true,
+				},
+			},
+			expContent: &StaticContent{
+				ID:    "test_content",
+				Index: "index.html",
+			},
 		},
 		{
-			msg:      "unknown ip family",
-			ipFamily: "unknown",
-			expected: []discoveryV1.AddressType{},
+			name: "volume source with index and fallback",
+			policies: []*graph.Policy{
+				{
+					Source: createPolicy(volumeSource, helpers.GetPointer("home.html"), helpers.GetPointer("app/index.html")),
+					Valid:  true,
+				},
+			},
+			expContent: &StaticContent{
+				Path:     "/usr/share/nginx/static/content",
+				Index:    "home.html",
+				Fallback: "app/index.html",
+			},
 		},
 	}
 
-	for _, tc := range test {
-		t.Run(tc.msg, func(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(getAllowedAddressType(tc.ipFamily)).To(Equal(tc.expected))
+
+			g.Expect(buildStaticContent(test.policies)).To(Equal(test.expContent))
 		})
 	}
 }
 
-func TestCreateRatioVarName(t *testing.T) {
+func TestBuildErrorPages(t *testing.T) {
 	t.Parallel()
-	g := NewWithT(t)
-	g.Expect(CreateRatioVarName(25)).To(Equal("$otel_ratio_25"))
-}
 
-func TestCreatePassthroughServers(t *testing.T) {
-	t.Parallel()
-	getL4RouteKey := func(name string) graph.L4RouteKey {
-		return graph.L4RouteKey{
-			NamespacedName: types.NamespacedName{
-				Namespace: "default",
-				Name:      name,
+	createPolicy := func(errorPages ...ngfAPIv1alpha1.ErrorPage) policies.Policy {
+		return &ngfAPIv1alpha1.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "epp"},
+			Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+				ErrorPages: errorPages,
 			},
 		}
 	}
-	secureAppKey := getL4RouteKey("secure-app")
-	secureApp2Key := getL4RouteKey("secure-app2")
-	secureApp3Key := getL4RouteKey("secure-app3")
-	testGraph := graph.Graph{
-		Gateway: &graph.Gateway{
-			Listeners: []*graph.Listener{
+
+	tests := []struct {
+		name          string
+		policies      []*graph.Policy
+		expErrorPages []ErrorPage
+	}{
+		{
+			name:          "no policies",
+			expErrorPages: nil,
+		},
+		{
+			name: "invalid and other policies",
+			policies: []*graph.Policy{
 				{
-					Name:  "testingListener",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.TLSProtocolType,
-						Port:     443,
-						Hostname: helpers.GetPointer[v1.Hostname]("*.example.com"),
-					},
-					Routes: make(map[graph.RouteKey]*graph.L7Route),
-					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						secureAppKey: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
-										},
-									},
-								},
-							},
-							ParentRefs: []graph.ParentRef{
-								{
-									Attachment: &graph.ParentRefAttachmentStatus{
-										AcceptedHostnames: map[string][]string{
-											"testingListener": {"app.example.com", "cafe.example.com"},
-										},
-									},
-									SectionName: nil,
-									Port:        nil,
-									Gateway:     types.NamespacedName{},
-									Idx:         0,
-								},
-							},
+					Source: createPolicy(ngfAPIv1alpha1.ErrorPage{
+						Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+						Response: &ngfAPIv1alpha1.ErrorPageResponse{Body: "error"},
+					}),
+					Valid: false,
+				},
+				{
+					Source: &ngfAPIv1alpha1.CacheControlPolicy{},
+					Valid:  true,
+				},
+			},
+			expErrorPages: nil,
+		},
+		{
+			name: "responses and redirects with defaults",
+			policies: []*graph.Policy{
+				{
+					Source: createPolicy(
+						ngfAPIv1alpha1.ErrorPage{
+							Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{500, 502},
+							Response: &ngfAPIv1alpha1.ErrorPageResponse{Body: "error"},
 						},
-						secureApp2Key: {},
+						ngfAPIv1alpha1.ErrorPage{
+							Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+							Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{URL: "https://example.com/404.html"},
+						},
+					),
+					Valid: true,
+				},
+			},
+			expErrorPages: []ErrorPage{
+				{
+					Name:  "test_epp_0",
+					Codes: []int{500, 502},
+					Response: &ErrorPageResponse{
+						ContentType: "text/html",
+						Body:        "error",
 					},
 				},
 				{
-					Name:  "testingListener2",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.TLSProtocolType,
-						Port:     443,
-						Hostname: helpers.GetPointer[v1.Hostname]("cafe.example.com"),
+					Name:  "test_epp_1",
+					Codes: []int{404},
+					Redirect: &ErrorPageRedirect{
+						URL:        "https://example.com/404.html",
+						StatusCode: 302,
 					},
-					Routes: make(map[graph.RouteKey]*graph.L7Route),
-					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						secureApp3Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
-										},
-									},
-								},
+				},
+			},
+		},
+		{
+			name: "responses and redirects with status codes and content type",
+			policies: []*graph.Policy{
+				{
+					Source: createPolicy(
+						ngfAPIv1alpha1.ErrorPage{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{503},
+							Response: &ngfAPIv1alpha1.ErrorPageResponse{
+								StatusCode:  helpers.GetPointer[int32](200),
+								ContentType: helpers.GetPointer("application/json"),
+								Body:        `{"status": "maintenance"}`,
 							},
 						},
+						ngfAPIv1alpha1.ErrorPage{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+							Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{
+								URL:        "https://example.com/404.html",
+								StatusCode: helpers.GetPointer[int32](301),
+							},
+						},
+					),
+					Valid: true,
+				},
+			},
+			expErrorPages: []ErrorPage{
+				{
+					Name:  "test_epp_0",
+					Codes: []int{503},
+					Response: &ErrorPageResponse{
+						ContentType: "application/json",
+						Body:        `{"status": "maintenance"}`,
+						StatusCode:  200,
 					},
 				},
 				{
-					Name:  "httpListener",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.HTTPProtocolType,
+					Name:  "test_epp_1",
+					Codes: []int{404},
+					Redirect: &ErrorPageRedirect{
+						URL:        "https://example.com/404.html",
+						StatusCode: 301,
 					},
 				},
 			},
 		},
 	}
 
-	passthroughServers := buildPassthroughServers(&testGraph)
-
-	expectedPassthroughServers := []Layer4VirtualServer{
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildErrorPages(test.policies)).To(Equal(test.expErrorPages))
+		})
+	}
+}
+
+func TestBuildStaticContents(t *testing.T) {
+	t.Parallel()
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		{Namespace: "test", Name: "used"}: {
+			Data:       map[string]string{"index.html": "<html></html>"},
+			BinaryData: map[string][]byte{"favicon.ico": {0x00, 0x01}},
+		},
+		{Namespace: "test", Name: "unused"}: {
+			Data: map[string]string{"index.html": "unused"},
+		},
+		{Namespace: "test", Name: "missing"}: nil,
+	}
+
+	servers := []VirtualServer{
 		{
-			Hostname:     "app.example.com",
-			UpstreamName: "default_secure-app_8443",
-			Port:         443,
-			IsDefault:    false,
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{
+						{StaticContent: &StaticContent{ID: "test_used", Index: "index.html"}},
+						{StaticContent: &StaticContent{Path: "/usr/share/nginx/static/content", Index: "index.html"}},
+						{StaticContent: &StaticContent{ID: "test_missing", Index: "index.html"}},
+						{},
+					},
+				},
+			},
+		},
+	}
+
+	expContents := map[StaticContentID]StaticContentFiles{
+		"test_used": {
+			"index.html":  []byte("<html></html>"),
+			"favicon.ico": {0x00, 0x01},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildStaticContents(configMaps, servers)).To(Equal(expContents))
+	g.Expect(buildStaticContents(configMaps, nil)).To(BeNil())
+}
+
+func TestBuildGRPCHealthChecks(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(healthCheck *ngfAPIv1alpha1.GRPCHealthCheck) policies.Policy {
+		return &ngfAPIv1alpha1.UpstreamSettingsPolicy{
+			Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{GRPCHealthCheck: healthCheck},
+		}
+	}
+
+	verifyTLS := &VerifyTLS{CertBundleID: "bundle", Hostname: "grpc.example.com"}
+
+	upstreams := []Upstream{
+		{
+			Name: "test_grpc_8080",
+			Policies: []policies.Policy{
+				createPolicy(&ngfAPIv1alpha1.GRPCHealthCheck{
+					Service:  helpers.GetPointer("helloworld.Greeter"),
+					Path:     helpers.GetPointer("/grpc-health"),
+					Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+				}),
+			},
 		},
 		{
-			Hostname:     "cafe.example.com",
-			UpstreamName: "default_secure-app_8443",
-			Port:         443,
-			IsDefault:    false,
+			Name: "test_grpcs_8443",
+			Policies: []policies.Policy{
+				createPolicy(&ngfAPIv1alpha1.GRPCHealthCheck{Path: helpers.GetPointer("/grpc-health")}),
+			},
 		},
 		{
-			Hostname:     "*.example.com",
-			UpstreamName: "",
-			Port:         443,
-			IsDefault:    true,
+			Name: "test_no-path_8080",
+			Policies: []policies.Policy{
+				&ngfAPIv1alpha1.ObservabilityPolicy{},
+				createPolicy(&ngfAPIv1alpha1.GRPCHealthCheck{}),
+			},
 		},
 		{
-			Hostname:     "cafe.example.com",
-			UpstreamName: "",
-			Port:         443,
-			IsDefault:    true,
+			Name:     "test_http_8080",
+			Policies: []policies.Policy{createPolicy(&ngfAPIv1alpha1.GRPCHealthCheck{Path: helpers.GetPointer("/http")})},
+		},
+		{
+			Name:     "test_no-check_8080",
+			Policies: []policies.Policy{createPolicy(nil)},
+		},
+		{
+			Name: "test_unused_8080",
+			Policies: []policies.Policy{
+				createPolicy(&ngfAPIv1alpha1.GRPCHealthCheck{Path: helpers.GetPointer("/unused")}),
+			},
+		},
+	}
+
+	createPathRule := func(path string, grpc bool, backends ...Backend) PathRule {
+		return PathRule{
+			Path: path,
+			GRPC: grpc,
+			MatchRules: []MatchRule{
+				{BackendGroup: BackendGroup{Backends: backends}},
+			},
+		}
+	}
+
+	httpServers := []VirtualServer{
+		{
+			Hostname: "grpc.example.com",
+			PathRules: []PathRule{
+				createPathRule(
+					"/",
+					true,
+					Backend{UpstreamName: "test_grpcs_8443", Valid: true, VerifyTLS: verifyTLS},
+					Backend{UpstreamName: "test_grpc_8080", Valid: true},
+					Backend{UpstreamName: "test_no-path_8080", Valid: true},
+					Backend{UpstreamName: "test_no-check_8080", Valid: true},
+					Backend{UpstreamName: "test_unused_8080", Valid: false},
+				),
+				createPathRule("/", false, Backend{UpstreamName: "test_http_8080", Valid: true}),
+			},
+		},
+		{
+			Hostname:  "conflict.example.com",
+			PathRules: []PathRule{createPathRule("/grpc-health", true, Backend{UpstreamName: "test_grpc_8080", Valid: true})},
+		},
+		{
+			Hostname: "http.example.com",
+		},
+	}
+	sslServers := []VirtualServer{
+		{
+			Hostname:  "grpc.example.com",
+			PathRules: []PathRule{createPathRule("/", true, Backend{UpstreamName: "test_grpc_8080", Valid: true})},
 		},
 	}
 
+	grpcCheck := GRPCHealthCheck{
+		UpstreamName: "test_grpc_8080",
+		Service:      "helloworld.Greeter",
+		Path:         "/grpc-health",
+		Interval:     "10s",
+	}
+	grpcsCheck := GRPCHealthCheck{
+		UpstreamName: "test_grpcs_8443",
+		Path:         "/grpc-health",
+		Interval:     "5s",
+		VerifyTLS:    verifyTLS,
+	}
+	noPathCheck := GRPCHealthCheck{
+		UpstreamName: "test_no-path_8080",
+		Interval:     "5s",
+	}
+
 	g := NewWithT(t)
 
-	g.Expect(passthroughServers).To(Equal(expectedPassthroughServers))
+	checks := buildGRPCHealthChecks(upstreams, httpServers, sslServers)
+	g.Expect(checks).To(Equal([]GRPCHealthCheck{grpcCheck, grpcsCheck, noPathCheck}))
+
+	// the checks of the same path are exposed in the order of the upstream names.
+	g.Expect(httpServers[0].GRPCHealthChecks).To(Equal([]GRPCHealthCheck{grpcCheck}))
+	g.Expect(httpServers[1].GRPCHealthChecks).To(BeNil())
+	g.Expect(httpServers[2].GRPCHealthChecks).To(BeNil())
+	g.Expect(sslServers[0].GRPCHealthChecks).To(Equal([]GRPCHealthCheck{grpcCheck}))
+
+	g.Expect(buildGRPCHealthChecks(nil, httpServers, sslServers)).To(BeNil())
+	g.Expect(buildGRPCHealthChecks(upstreams)).To(BeNil())
 }
 
-func TestBuildStreamUpstreams(t *testing.T) {
+func TestGetAllowedAddressType(t *testing.T) {
+	t.Parallel()
+	test := []struct {
+		msg      string
+		ipFamily IPFamilyType
+		expected []discoveryV1.AddressType
+	}{
+		{
+			msg:      "dual ip family",
+			ipFamily: Dual,
+			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4, discoveryV1.AddressTypeIPv6},
+		},
+		{
+			msg:      "ipv4 ip family",
+			ipFamily: IPv4,
+			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4},
+		},
+		{
+			msg:      "ipv6 ip family",
+			ipFamily: IPv6,
+			expected: []discoveryV1.AddressType{discoveryV1.AddressTypeIPv6},
+		},
+		{
+			msg:      "unknown ip family",
+			ipFamily: "unknown",
+			expected: []discoveryV1.AddressType{},
+		},
+	}
+
+	for _, tc := range test {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(getAllowedAddressType(tc.ipFamily)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateRatioVarName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	g.Expect(CreateRatioVarName(25)).To(Equal("$otel_ratio_25"))
+}
+
+func TestCreatePassthroughServers(t *testing.T) {
 	t.Parallel()
 	getL4RouteKey := func(name string) graph.L4RouteKey {
 		return graph.L4RouteKey{
@@ -3950,8 +5094,6 @@ func TestBuildStreamUpstreams(t *testing.T) {
 	secureAppKey := getL4RouteKey("secure-app")
 	secureApp2Key := getL4RouteKey("secure-app2")
 	secureApp3Key := getL4RouteKey("secure-app3")
-	secureApp4Key := getL4RouteKey("secure-app4")
-	secureApp5Key := getL4RouteKey("secure-app5")
 	testGraph := graph.Graph{
 		Gateway: &graph.Gateway{
 			Listeners: []*graph.Listener{
@@ -3961,6 +5103,7 @@ func TestBuildStreamUpstreams(t *testing.T) {
 					Source: v1.Listener{
 						Protocol: v1.TLSProtocolType,
 						Port:     443,
+						Hostname: helpers.GetPointer[v1.Hostname]("*.example.com"),
 					},
 					Routes: make(map[graph.RouteKey]*graph.L7Route),
 					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
@@ -3982,41 +5125,40 @@ func TestBuildStreamUpstreams(t *testing.T) {
 									},
 								},
 							},
-						},
-						secureApp2Key: {},
-						secureApp3Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames:  []v1.Hostname{"test.example.com"},
-								BackendRef: graph.BackendRef{},
-							},
-						},
-						secureApp4Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+							ParentRefs: []graph.ParentRef{
+								{
+									Attachment: &graph.ParentRefAttachmentStatus{
+										AcceptedHostnames: map[string][]string{
+											"testingListener": {"app.example.com", "cafe.example.com"},
 										},
 									},
+									SectionName: nil,
+									Port:        nil,
+									Gateway:     types.NamespacedName{},
+									Idx:         0,
 								},
 							},
 						},
-						secureApp5Key: {
+						secureApp2Key: {},
+					},
+				},
+				{
+					Name:  "testingListener2",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.TLSProtocolType,
+						Port:     443,
+						Hostname: helpers.GetPointer[v1.Hostname]("cafe.example.com"),
+					},
+					Routes: make(map[graph.RouteKey]*graph.L7Route),
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						secureApp3Key: {
 							Valid: true,
 							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app2.example.com"},
+								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
 								BackendRef: graph.BackendRef{
 									Valid:     true,
-									SvcNsName: secureApp5Key.NamespacedName,
+									SvcNsName: secureAppKey.NamespacedName,
 									ServicePort: apiv1.ServicePort{
 										Name:     "https",
 										Protocol: "TCP",
@@ -4031,615 +5173,3176 @@ func TestBuildStreamUpstreams(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name:  "httpListener",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.HTTPProtocolType,
+					},
+				},
 			},
 		},
 	}
 
-	fakeResolver := resolverfakes.FakeServiceResolver{}
-	fakeEndpoints := []resolver.Endpoint{
-		{Address: "1.1.1.1", Port: 80},
-	}
-
-	fakeResolver.ResolveStub = func(
-		_ context.Context,
-		nsName types.NamespacedName,
-		_ apiv1.ServicePort,
-		_ []discoveryV1.AddressType,
-	) ([]resolver.Endpoint, error) {
-		if nsName == secureAppKey.NamespacedName {
-			return nil, errors.New("error")
-		}
-		return fakeEndpoints, nil
-	}
-
-	streamUpstreams := buildStreamUpstreams(context.Background(), testGraph.Gateway.Listeners, &fakeResolver, Dual)
+	passthroughServers := buildPassthroughServers(&testGraph)
 
-	expectedStreamUpstreams := []Upstream{
+	expectedPassthroughServers := []Layer4VirtualServer{
 		{
-			Name:     "default_secure-app_8443",
-			ErrorMsg: "error",
+			Hostname:     "app.example.com",
+			UpstreamName: "default_secure-app_8443",
+			Port:         443,
+			IsDefault:    false,
 		},
 		{
-			Name:      "default_secure-app5_8443",
-			Endpoints: fakeEndpoints,
+			Hostname:     "cafe.example.com",
+			UpstreamName: "default_secure-app_8443",
+			Port:         443,
+			IsDefault:    false,
+		},
+		{
+			Hostname:     "*.example.com",
+			UpstreamName: "",
+			Port:         443,
+			IsDefault:    true,
+		},
+		{
+			Hostname:     "cafe.example.com",
+			UpstreamName: "",
+			Port:         443,
+			IsDefault:    true,
 		},
 	}
+
 	g := NewWithT(t)
 
-	g.Expect(streamUpstreams).To(ConsistOf(expectedStreamUpstreams))
+	g.Expect(passthroughServers).To(Equal(expectedPassthroughServers))
 }
 
-func TestBuildRewriteIPSettings(t *testing.T) {
+func TestCreatePassthroughServers_SendProxyProtocol(t *testing.T) {
 	t.Parallel()
-	tests := []struct {
-		msg                  string
-		g                    *graph.Graph
-		expRewriteIPSettings RewriteClientIPSettings
-	}{
-		{
-			msg: "no rewrite IP settings configured",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid:  true,
-					Source: &ngfAPIv1alpha1.NginxProxy{},
-				},
-			},
-			expRewriteIPSettings: RewriteClientIPSettings{},
-		},
-		{
-			msg: "rewrite IP settings configured with proxyProtocol",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
-								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeProxyProtocol),
-								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
+
+	routeKey := graph.L4RouteKey{NamespacedName: types.NamespacedName{Namespace: "default", Name: "secure-app"}}
+
+	createGraph := func(np *graph.NginxProxy) *graph.Graph {
+		return &graph.Graph{
+			Gateway: &graph.Gateway{
+				Listeners: []*graph.Listener{
+					{
+						Name:  "tls",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TLSProtocolType,
+							Port:     443,
+							Hostname: helpers.GetPointer[v1.Hostname]("app.example.com"),
+						},
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							routeKey: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									BackendRef: graph.BackendRef{
+										Valid:       true,
+										SvcNsName:   routeKey.NamespacedName,
+										ServicePort: apiv1.ServicePort{Port: 8443},
+									},
+								},
+								ParentRefs: []graph.ParentRef{
 									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "10.9.9.4/32",
+										Attachment: &graph.ParentRefAttachmentStatus{
+											AcceptedHostnames: map[string][]string{"tls": {"app.example.com"}},
+										},
 									},
 								},
-								SetIPRecursively: helpers.GetPointer(true),
 							},
 						},
 					},
 				},
 			},
-			expRewriteIPSettings: RewriteClientIPSettings{
-				Mode:             RewriteIPModeProxyProtocol,
-				TrustedAddresses: []string{"10.9.9.4/32"},
-				IPRecursive:      true,
+			NginxProxy: np,
+		}
+	}
+
+	createNginxProxy := func(tlsPassthrough *ngfAPIv1alpha1.TLSPassthrough, valid bool) *graph.NginxProxy {
+		return &graph.NginxProxy{
+			Source: &ngfAPIv1alpha1.NginxProxy{
+				Spec: ngfAPIv1alpha1.NginxProxySpec{TLSPassthrough: tlsPassthrough},
 			},
+			Valid: valid,
+		}
+	}
+
+	enabled := &ngfAPIv1alpha1.TLSPassthrough{SendProxyProtocol: helpers.GetPointer(true)}
+
+	tests := []struct {
+		np                   *graph.NginxProxy
+		name                 string
+		expSendProxyProtocol bool
+	}{
+		{
+			name: "no NginxProxy",
 		},
 		{
-			msg: "rewrite IP settings configured with xForwardedFor",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
+			np:   createNginxProxy(nil, true),
+			name: "TLS passthrough settings not set",
+		},
+		{
+			np:   createNginxProxy(&ngfAPIv1alpha1.TLSPassthrough{SendProxyProtocol: helpers.GetPointer(false)}, true),
+			name: "sending the PROXY protocol disabled",
+		},
+		{
+			np:   createNginxProxy(enabled, false),
+			name: "invalid NginxProxy",
+		},
+		{
+			np:                   createNginxProxy(enabled, true),
+			name:                 "sending the PROXY protocol enabled",
+			expSendProxyProtocol: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildPassthroughServers(createGraph(test.np))).To(Equal([]Layer4VirtualServer{
+				{
+					Hostname:          "app.example.com",
+					UpstreamName:      "default_secure-app_8443",
+					Port:              443,
+					SendProxyProtocol: test.expSendProxyProtocol,
+				},
+			}))
+		})
+	}
+}
+
+func TestCreatePassthroughServers_WildcardHostnames(t *testing.T) {
+	t.Parallel()
+
+	createRoute := func(name, listenerName string, hostnames ...string) *graph.L4Route {
+		return &graph.L4Route{
+			Valid: true,
+			Spec: graph.L4RouteSpec{
+				BackendRef: graph.BackendRef{
+					Valid:       true,
+					SvcNsName:   types.NamespacedName{Namespace: "default", Name: name},
+					ServicePort: apiv1.ServicePort{Port: 8443},
+				},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{listenerName: hostnames},
+					},
+				},
+			},
+		}
+	}
+
+	createListener := func(name, hostname string, routes ...*graph.L4Route) *graph.Listener {
+		l := &graph.Listener{
+			Name:  name,
+			Valid: true,
+			Source: v1.Listener{
+				Protocol: v1.TLSProtocolType,
+				Port:     443,
+				Hostname: helpers.GetPointer(v1.Hostname(hostname)),
+			},
+			L4Routes: make(map[graph.L4RouteKey]*graph.L4Route),
+		}
+		for _, r := range routes {
+			l.L4Routes[graph.L4RouteKey{NamespacedName: r.Spec.BackendRef.SvcNsName}] = r
+		}
+
+		return l
+	}
+
+	// the wildcard route hostname is the same as the wildcard listener hostname
+	wildcardRoute := createRoute("wildcard", "wildcard-example-com", "*.example.com")
+	// the wildcard route hostname *.example.com was narrowed down to the more specific listener hostname
+	appRoute := createRoute("app", "app-example-com", "app.example.com")
+	// the route hostname is more specific than the wildcard listener hostname
+	cafeRoute := createRoute("cafe", "wildcard-example-org", "cafe.example.org")
+
+	testGraph := graph.Graph{
+		Gateway: &graph.Gateway{
+			Listeners: []*graph.Listener{
+				createListener("wildcard-example-com", "*.example.com", wildcardRoute),
+				createListener("app-example-com", "app.example.com", appRoute),
+				createListener("wildcard-example-org", "*.example.org", cafeRoute),
+			},
+		},
+	}
+
+	passthroughServers := buildPassthroughServers(&testGraph)
+
+	expectedPassthroughServers := []Layer4VirtualServer{
+		{
+			Hostname:     "*.example.com",
+			UpstreamName: "default_wildcard_8443",
+			Port:         443,
+		},
+		{
+			Hostname:     "app.example.com",
+			UpstreamName: "default_app_8443",
+			Port:         443,
+		},
+		{
+			Hostname:     "cafe.example.org",
+			UpstreamName: "default_cafe_8443",
+			Port:         443,
+		},
+		{
+			Hostname:  "*.example.org",
+			Port:      443,
+			IsDefault: true,
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(passthroughServers).To(ConsistOf(expectedPassthroughServers))
+}
+
+func TestBuildServers_MergedGateways(t *testing.T) {
+	t.Parallel()
+
+	gw1NsName := types.NamespacedName{Namespace: "test", Name: "gateway-1"}
+	gw2NsName := types.NamespacedName{Namespace: "test", Name: "gateway-2"}
+
+	hr := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+	}
+
+	// the route attaches to the listeners of both Gateways, which have the same name
+	route := &graph.L7Route{
+		Source:    hr,
+		RouteType: graph.RouteTypeHTTP,
+		Valid:     true,
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: gw1NsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"http": {"foo.example.com"}},
+					Attached:          true,
+				},
+			},
+			{
+				Idx:     1,
+				Gateway: gw2NsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"http": {"bar.example.com"}},
+					Attached:          true,
+				},
+			},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					ValidMatches: true,
+					Filters:      graph.RouteRuleFilters{Valid: true},
+					Matches: []v1.HTTPRouteMatch{
+						{
+							Path: &v1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+								Value: helpers.GetPointer("/"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	createListener := func(gwNsName types.NamespacedName, hostname string) *graph.Listener {
+		return &graph.Listener{
+			Name:        "http",
+			GatewayName: gwNsName,
+			Source: v1.Listener{
+				Name:     "http",
+				Protocol: v1.HTTPProtocolType,
+				Port:     80,
+				Hostname: helpers.GetPointer(v1.Hostname(hostname)),
+			},
+			Valid:  true,
+			Routes: map[graph.RouteKey]*graph.L7Route{graph.CreateRouteKey(hr): route},
+		}
+	}
+
+	g := NewWithT(t)
+
+	httpServers, sslServers := buildServers(&graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gw1NsName.Namespace, Name: gw1NsName.Name},
+			},
+			Listeners: []*graph.Listener{createListener(gw1NsName, "foo.example.com")},
+		},
+		MergedGateways: map[types.NamespacedName]*graph.Gateway{
+			gw2NsName: {
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gw2NsName.Namespace, Name: gw2NsName.Name},
+				},
+				Listeners: []*graph.Listener{createListener(gw2NsName, "bar.example.com")},
+				EffectiveNginxProxy: &graph.NginxProxy{
 					Source: &ngfAPIv1alpha1.NginxProxy{
 						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							IPFamily: helpers.GetPointer(ngfAPIv1alpha1.IPv6),
 							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
 								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor),
-								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
-									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "76.89.90.11/24",
-									},
-								},
-								SetIPRecursively: helpers.GetPointer(true),
 							},
 						},
 					},
+					Valid: true,
 				},
 			},
-			expRewriteIPSettings: RewriteClientIPSettings{
-				Mode:             RewriteIPModeXForwardedFor,
-				TrustedAddresses: []string{"76.89.90.11/24"},
-				IPRecursive:      true,
+		},
+		NginxProxy: &graph.NginxProxy{
+			Source: &ngfAPIv1alpha1.NginxProxy{
+				Spec: ngfAPIv1alpha1.NginxProxySpec{IPFamily: helpers.GetPointer(ngfAPIv1alpha1.IPv4)},
+			},
+			Valid: true,
+		},
+	})
+
+	g.Expect(sslServers).To(BeEmpty())
+
+	// the listeners of both Gateways share the same port, so their routes end up in the same set of servers
+	g.Expect(httpServers).To(HaveLen(3))
+	g.Expect(httpServers[0].IsDefault).To(BeTrue())
+
+	for i, hostname := range []string{"bar.example.com", "foo.example.com"} {
+		g.Expect(httpServers[i+1].Hostname).To(Equal(hostname))
+		g.Expect(httpServers[i+1].Port).To(Equal(int32(80)))
+		g.Expect(httpServers[i+1].PathRules).To(HaveLen(1))
+	}
+
+	// the default server listens on the IP families of both Gateways
+	g.Expect(httpServers[0].GatewaySettings).To(Equal(&GatewaySettings{IPFamily: Dual}))
+	g.Expect(httpServers[1].GatewaySettings).To(Equal(&GatewaySettings{
+		IPFamily:                IPv6,
+		RewriteClientIPSettings: RewriteClientIPSettings{Mode: RewriteIPModeXForwardedFor},
+	}))
+	g.Expect(httpServers[2].GatewaySettings).To(BeNil())
+}
+
+func TestServerGatewaySettingsForListeners(t *testing.T) {
+	t.Parallel()
+
+	winnerNsName := types.NamespacedName{Namespace: "test", Name: "winner"}
+	ipv6NsName := types.NamespacedName{Namespace: "test", Name: "ipv6"}
+	otherIPv6NsName := types.NamespacedName{Namespace: "test", Name: "other-ipv6"}
+
+	ipv6Settings := &GatewaySettings{
+		IPFamily:                IPv6,
+		RewriteClientIPSettings: RewriteClientIPSettings{Mode: RewriteIPModeXForwardedFor},
+	}
+
+	settings := serverGatewaySettings{
+		gateways: map[types.NamespacedName]*GatewaySettings{
+			ipv6NsName:      ipv6Settings,
+			otherIPv6NsName: {IPFamily: IPv6},
+		},
+		base: GatewaySettings{
+			IPFamily:                IPv4,
+			RewriteClientIPSettings: RewriteClientIPSettings{TrustedAddresses: []string{"10.0.0.0/8"}},
+		},
+	}
+
+	listener := func(gwNsName types.NamespacedName) *graph.Listener {
+		return &graph.Listener{GatewayName: gwNsName}
+	}
+
+	tests := []struct {
+		expSettings *GatewaySettings
+		name        string
+		listeners   []*graph.Listener
+	}{
+		{
+			name: "no listeners",
+		},
+		{
+			name:      "listeners of the winning Gateway",
+			listeners: []*graph.Listener{listener(winnerNsName), listener(winnerNsName)},
+		},
+		{
+			name:        "listeners of a Gateway with its own settings",
+			listeners:   []*graph.Listener{listener(ipv6NsName), listener(ipv6NsName)},
+			expSettings: ipv6Settings,
+		},
+		{
+			name:      "listeners of Gateways with different IP families",
+			listeners: []*graph.Listener{listener(winnerNsName), listener(ipv6NsName)},
+			expSettings: &GatewaySettings{
+				IPFamily:                Dual,
+				RewriteClientIPSettings: RewriteClientIPSettings{TrustedAddresses: []string{"10.0.0.0/8"}},
+			},
+		},
+		{
+			name:      "listeners of Gateways with the same IP family",
+			listeners: []*graph.Listener{listener(ipv6NsName), listener(otherIPv6NsName)},
+			expSettings: &GatewaySettings{
+				IPFamily:                IPv6,
+				RewriteClientIPSettings: RewriteClientIPSettings{TrustedAddresses: []string{"10.0.0.0/8"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(settings.forListeners(test.listeners)).To(Equal(test.expSettings))
+		})
+	}
+}
+
+func TestBuildServers_ListenerIsolation(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	hr := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+	}
+
+	// the route attaches to the wildcard listener, but its hostname was isolated to the listener
+	// with the more specific hostname, which doesn't have any routes
+	route := &graph.L7Route{
+		Source:    hr,
+		RouteType: graph.RouteTypeHTTP,
+		Valid:     true,
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: gwNsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"wildcard": {"*.example.com"}},
+					Attached:          true,
+				},
+			},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					ValidMatches: true,
+					Filters:      graph.RouteRuleFilters{Valid: true},
+					Matches: []v1.HTTPRouteMatch{
+						{
+							Path: &v1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+								Value: helpers.GetPointer("/"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	createListener := func(name, hostname string, routes map[graph.RouteKey]*graph.L7Route) *graph.Listener {
+		return &graph.Listener{
+			Name:        name,
+			GatewayName: gwNsName,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: v1.HTTPProtocolType,
+				Port:     80,
+				Hostname: helpers.GetPointer(v1.Hostname(hostname)),
+			},
+			Valid:  true,
+			Routes: routes,
+		}
+	}
+
+	g := NewWithT(t)
+
+	httpServers, _ := buildServers(&graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+			},
+			Listeners: []*graph.Listener{
+				createListener("wildcard", "*.example.com", map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hr): route,
+				}),
+				createListener("foo", "foo.example.com", nil),
+				createListener("empty", "", nil),
+			},
+		},
+	})
+
+	// the server for foo.example.com prevents the routes of *.example.com from receiving its requests
+	g.Expect(httpServers).To(HaveLen(3))
+	g.Expect(httpServers[0].IsDefault).To(BeTrue())
+	g.Expect(httpServers[1].Hostname).To(Equal("*.example.com"))
+	g.Expect(httpServers[1].PathRules).To(HaveLen(1))
+	g.Expect(httpServers[2]).To(Equal(VirtualServer{Hostname: "foo.example.com", Port: 80}))
+}
+
+func TestBuildServers_PathMatchOptions(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createRoute := func(name string, opts graph.PathMatchOptions) *graph.L7Route {
+		return &graph.L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			RouteType: graph.RouteTypeHTTP,
+			Valid:     true,
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"http": {"foo.example.com"}},
+						Attached:          true,
+					},
+				},
+			},
+			Spec: graph.L7RouteSpec{
+				Rules: []graph.RouteRule{
+					{
+						ValidMatches: true,
+						Filters:      graph.RouteRuleFilters{Valid: true},
+						Matches: []v1.HTTPRouteMatch{
+							{
+								Path: &v1.HTTPPathMatch{
+									Type:  helpers.GetPointer(v1.PathMatchExact),
+									Value: helpers.GetPointer("/coffee"),
+								},
+							},
+						},
+					},
+				},
+				PathMatchOptions: opts,
+			},
+		}
+	}
+
+	routes := map[graph.RouteKey]*graph.L7Route{}
+	for name, opts := range map[string]graph.PathMatchOptions{
+		"case-sensitive":   {},
+		"case-insensitive": {CaseInsensitive: true, TrailingSlash: graph.TrailingSlashModeNormalize},
+		"redirect":         {CaseInsensitive: true, TrailingSlash: graph.TrailingSlashModeRedirect},
+	} {
+		r := createRoute(name, opts)
+		routes[graph.CreateRouteKey(r.Source)] = r
+	}
+
+	g := NewWithT(t)
+
+	httpServers, _ := buildServers(&graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+			},
+			Listeners: []*graph.Listener{
+				{
+					Name:        "http",
+					GatewayName: gwNsName,
+					Source: v1.Listener{
+						Name:     "http",
+						Protocol: v1.HTTPProtocolType,
+						Port:     80,
+					},
+					Valid:  true,
+					Routes: routes,
+				},
+			},
+		},
+	})
+
+	g.Expect(httpServers).To(HaveLen(2))
+
+	pathRules := httpServers[1].PathRules
+	g.Expect(pathRules).To(HaveLen(2))
+
+	// the case-sensitive and the case-insensitive matches of the same path get separate PathRules
+	g.Expect(pathRules[0].CaseInsensitive).To(BeFalse())
+	g.Expect(pathRules[0].TrailingSlash).To(BeEmpty())
+	g.Expect(pathRules[0].MatchRules).To(HaveLen(1))
+
+	// redirect takes precedence over normalize
+	g.Expect(pathRules[1].CaseInsensitive).To(BeTrue())
+	g.Expect(pathRules[1].TrailingSlash).To(Equal(TrailingSlashModeRedirect))
+	g.Expect(pathRules[1].MatchRules).To(HaveLen(2))
+}
+
+func TestBuildStreamUpstreams(t *testing.T) {
+	t.Parallel()
+	getL4RouteKey := func(name string) graph.L4RouteKey {
+		return graph.L4RouteKey{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      name,
+			},
+		}
+	}
+	secureAppKey := getL4RouteKey("secure-app")
+	secureApp2Key := getL4RouteKey("secure-app2")
+	secureApp3Key := getL4RouteKey("secure-app3")
+	secureApp4Key := getL4RouteKey("secure-app4")
+	secureApp5Key := getL4RouteKey("secure-app5")
+	dbKey := getL4RouteKey("db")
+	dnsKey := getL4RouteKey("dns")
+	testGraph := graph.Graph{
+		Gateway: &graph.Gateway{
+			Listeners: []*graph.Listener{
+				{
+					Name:  "testingListener",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.TLSProtocolType,
+						Port:     443,
+					},
+					Routes: make(map[graph.RouteKey]*graph.L7Route),
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						secureAppKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+								BackendRef: graph.BackendRef{
+									Valid:     true,
+									SvcNsName: secureAppKey.NamespacedName,
+									ServicePort: apiv1.ServicePort{
+										Name:     "https",
+										Protocol: "TCP",
+										Port:     8443,
+										TargetPort: intstr.IntOrString{
+											Type:   intstr.Int,
+											IntVal: 8443,
+										},
+									},
+								},
+							},
+						},
+						secureApp2Key: {},
+						secureApp3Key: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								Hostnames:  []v1.Hostname{"test.example.com"},
+								BackendRef: graph.BackendRef{},
+							},
+						},
+						secureApp4Key: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+								BackendRef: graph.BackendRef{
+									Valid:     true,
+									SvcNsName: secureAppKey.NamespacedName,
+									ServicePort: apiv1.ServicePort{
+										Name:     "https",
+										Protocol: "TCP",
+										Port:     8443,
+										TargetPort: intstr.IntOrString{
+											Type:   intstr.Int,
+											IntVal: 8443,
+										},
+									},
+								},
+							},
+						},
+						secureApp5Key: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								Hostnames: []v1.Hostname{"app2.example.com"},
+								BackendRef: graph.BackendRef{
+									Valid:     true,
+									SvcNsName: secureApp5Key.NamespacedName,
+									ServicePort: apiv1.ServicePort{
+										Name:     "https",
+										Protocol: "TCP",
+										Port:     8443,
+										TargetPort: intstr.IntOrString{
+											Type:   intstr.Int,
+											IntVal: 8443,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "tcpListener",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.TCPProtocolType,
+						Port:     5432,
+					},
+					Routes: make(map[graph.RouteKey]*graph.L7Route),
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						dbKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								BackendRef: graph.BackendRef{
+									Valid:     true,
+									SvcNsName: dbKey.NamespacedName,
+									ServicePort: apiv1.ServicePort{
+										Name:     "postgres",
+										Protocol: "TCP",
+										Port:     5432,
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Name:  "udpListener",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.UDPProtocolType,
+						Port:     53,
+					},
+					Routes: make(map[graph.RouteKey]*graph.L7Route),
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						dnsKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								BackendRef: graph.BackendRef{
+									Valid:     true,
+									SvcNsName: dnsKey.NamespacedName,
+									ServicePort: apiv1.ServicePort{
+										Name:     "dns",
+										Protocol: "UDP",
+										Port:     53,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fakeResolver := resolverfakes.FakeServiceResolver{}
+	fakeEndpoints := []resolver.Endpoint{
+		{Address: "1.1.1.1", Port: 80},
+	}
+
+	fakeResolver.ResolveStub = func(
+		_ context.Context,
+		nsName types.NamespacedName,
+		_ apiv1.ServicePort,
+		_ []discoveryV1.AddressType,
+	) ([]resolver.Endpoint, resolver.EndpointSummary, error) {
+		if nsName == secureAppKey.NamespacedName {
+			return nil, resolver.EndpointSummary{}, errors.New("error")
+		}
+		return fakeEndpoints, resolver.EndpointSummary{}, nil
+	}
+
+	streamUpstreams := buildStreamUpstreams(context.Background(), testGraph.Gateway.Listeners, &fakeResolver, Dual)
+
+	expectedStreamUpstreams := []Upstream{
+		{
+			Name:     "default_secure-app_8443",
+			ErrorMsg: "error",
+		},
+		{
+			Name:      "default_secure-app5_8443",
+			Endpoints: fakeEndpoints,
+		},
+		{
+			Name:      "default_db_5432",
+			Endpoints: fakeEndpoints,
+		},
+		{
+			Name:      "default_dns_53",
+			Endpoints: fakeEndpoints,
+		},
+	}
+	g := NewWithT(t)
+
+	g.Expect(streamUpstreams).To(ConsistOf(expectedStreamUpstreams))
+}
+
+func TestBuildLayer4Servers(t *testing.T) {
+	t.Parallel()
+	getL4RouteKey := func(name string, routeType graph.RouteType) graph.L4RouteKey {
+		return graph.L4RouteKey{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      name,
+			},
+			RouteType: routeType,
+		}
+	}
+	dbKey := getL4RouteKey("db", graph.RouteTypeTCP)
+	invalidKey := getL4RouteKey("invalid", graph.RouteTypeTCP)
+	cacheKey := getL4RouteKey("cache", graph.RouteTypeTCP)
+	dnsKey := getL4RouteKey("dns", graph.RouteTypeUDP)
+	mergedDBKey := getL4RouteKey("merged-db", graph.RouteTypeTCP)
+
+	createBackendRef := func(key graph.L4RouteKey, port int32) graph.BackendRef {
+		return graph.BackendRef{
+			Valid:       true,
+			SvcNsName:   key.NamespacedName,
+			ServicePort: apiv1.ServicePort{Port: port},
+		}
+	}
+
+	testGraph := graph.Graph{
+		Gateway: &graph.Gateway{
+			Listeners: []*graph.Listener{
+				{
+					Name:  "tcp-5432",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.TCPProtocolType,
+						Port:     5432,
+					},
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						dbKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								BackendRef: createBackendRef(dbKey, 5432),
+							},
+						},
+						invalidKey: {
+							Valid: false,
+						},
+					},
+				},
+				{
+					Name:  "invalid-tcp-6379",
+					Valid: false,
+					Source: v1.Listener{
+						Protocol: v1.TCPProtocolType,
+						Port:     6379,
+					},
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						cacheKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								BackendRef: createBackendRef(cacheKey, 6379),
+							},
+						},
+					},
+				},
+				{
+					Name:  "udp-53",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.UDPProtocolType,
+						Port:     53,
+					},
+					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+						dnsKey: {
+							Valid: true,
+							Spec: graph.L4RouteSpec{
+								BackendRef: createBackendRef(dnsKey, 53),
+							},
+						},
+					},
+				},
+				{
+					Name:  "tls-443",
+					Valid: true,
+					Source: v1.Listener{
+						Protocol: v1.TLSProtocolType,
+						Port:     443,
+					},
+				},
+			},
+		},
+		MergedGateways: map[types.NamespacedName]*graph.Gateway{
+			{Namespace: "test", Name: "merged"}: {
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "merged"},
+				},
+				Listeners: []*graph.Listener{
+					{
+						Name:        "tcp-5433",
+						GatewayName: types.NamespacedName{Namespace: "test", Name: "merged"},
+						Valid:       true,
+						Source: v1.Listener{
+							Protocol: v1.TCPProtocolType,
+							Port:     5433,
+						},
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							mergedDBKey: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									BackendRef: createBackendRef(mergedDBKey, 5433),
+								},
+							},
+						},
+					},
+				},
+				Policies: []*graph.Policy{
+					{
+						Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+							Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+								Stream: &ngfAPIv1alpha1.ClientStream{
+									Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+								},
+							},
+						},
+						Valid: true,
+					},
+				},
+				Valid: true,
+			},
+		},
+	}
+
+	expectedTCPServers := []Layer4VirtualServer{
+		{
+			UpstreamName: "default_db_5432",
+			Port:         5432,
+		},
+		{
+			UpstreamName:   "default_merged-db_5433",
+			Port:           5433,
+			StreamSettings: &StreamSettings{ProxyTimeout: "1h"},
+		},
+	}
+
+	expectedUDPServers := []Layer4VirtualServer{
+		{
+			UpstreamName: "default_dns_53",
+			Port:         53,
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildLayer4Servers(&testGraph, v1.TCPProtocolType)).To(Equal(expectedTCPServers))
+	g.Expect(buildLayer4Servers(&testGraph, v1.UDPProtocolType)).To(Equal(expectedUDPServers))
+}
+
+func TestBuildRewriteIPSettings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg                  string
+		g                    *graph.Graph
+		expRewriteIPSettings RewriteClientIPSettings
+	}{
+		{
+			msg: "no rewrite IP settings configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid:  true,
+					Source: &ngfAPIv1alpha1.NginxProxy{},
+				},
+			},
+			expRewriteIPSettings: RewriteClientIPSettings{},
+		},
+		{
+			msg: "rewrite IP settings configured with proxyProtocol",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
+								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeProxyProtocol),
+								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "10.9.9.4/32",
+									},
+								},
+								SetIPRecursively: helpers.GetPointer(true),
+							},
+						},
+					},
+				},
+			},
+			expRewriteIPSettings: RewriteClientIPSettings{
+				Mode:             RewriteIPModeProxyProtocol,
+				TrustedAddresses: []string{"10.9.9.4/32"},
+				IPRecursive:      true,
+			},
+		},
+		{
+			msg: "rewrite IP settings configured with xForwardedFor",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
+								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor),
+								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "76.89.90.11/24",
+									},
+								},
+								SetIPRecursively: helpers.GetPointer(true),
+							},
+						},
+					},
+				},
+			},
+			expRewriteIPSettings: RewriteClientIPSettings{
+				Mode:             RewriteIPModeXForwardedFor,
+				TrustedAddresses: []string{"76.89.90.11/24"},
+				IPRecursive:      true,
+			},
+		},
+		{
+			msg: "rewrite IP settings configured with recursive set to false and multiple trusted addresses",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
+								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor),
+								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "5.5.5.5/12",
+									},
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "1.1.1.1/26",
+									},
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "2.2.2.2/32",
+									},
+									{
+										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
+										Value: "3.3.3.3/24",
+									},
+								},
+								SetIPRecursively: helpers.GetPointer(false),
+							},
+						},
+					},
+				},
+			},
+			expRewriteIPSettings: RewriteClientIPSettings{
+				Mode:             RewriteIPModeXForwardedFor,
+				TrustedAddresses: []string{"5.5.5.5/12", "1.1.1.1/26", "2.2.2.2/32", "3.3.3.3/24"},
+				IPRecursive:      false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			baseConfig := buildBaseHTTPConfig(tc.g)
+			g.Expect(baseConfig.RewriteClientIPSettings).To(Equal(tc.expRewriteIPSettings))
+		})
+	}
+}
+
+func TestBuildNativeHTTPMatches(t *testing.T) {
+	t.Parallel()
+
+	createGraph := func(mode *ngfAPIv1alpha1.HTTPMatchMode) *graph.Graph {
+		return &graph.Graph{
+			NginxProxy: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPIv1alpha1.NginxProxy{
+					Spec: ngfAPIv1alpha1.NginxProxySpec{
+						HTTPMatchMode: mode,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		g      *graph.Graph
+		msg    string
+		expect bool
+	}{
+		{
+			msg:    "NginxProxy is nil",
+			g:      &graph.Graph{},
+			expect: false,
+		},
+		{
+			msg:    "HTTP match mode is not set",
+			g:      createGraph(nil),
+			expect: false,
+		},
+		{
+			msg:    "HTTP match mode is NJS",
+			g:      createGraph(helpers.GetPointer(ngfAPIv1alpha1.HTTPMatchModeNJS)),
+			expect: false,
+		},
+		{
+			msg:    "HTTP match mode is Native",
+			g:      createGraph(helpers.GetPointer(ngfAPIv1alpha1.HTTPMatchModeNative)),
+			expect: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildBaseHTTPConfig(tc.g).NativeHTTPMatches).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestBuildLogging(t *testing.T) {
+	defaultLogging := Logging{ErrorLevel: defaultErrorLogLevel}
+
+	t.Parallel()
+	tests := []struct {
+		msg                string
+		g                  *graph.Graph
+		expLoggingSettings Logging
+	}{
+		{
+			msg:                "NginxProxy is nil",
+			g:                  &graph.Graph{},
+			expLoggingSettings: defaultLogging,
+		},
+		{
+			msg: "NginxProxy does not specify log level",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{},
+					},
+				},
+			},
+			expLoggingSettings: defaultLogging,
+		},
+		{
+			msg: "NginxProxy log level set to debug",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelDebug)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "debug"},
+		},
+		{
+			msg: "NginxProxy log level set to info",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelInfo)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: defaultErrorLogLevel},
+		},
+		{
+			msg: "NginxProxy log level set to notice",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelNotice)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "notice"},
+		},
+		{
+			msg: "NginxProxy log level set to warn",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelWarn)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "warn"},
+		},
+		{
+			msg: "NginxProxy log level set to error",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelError)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "error"},
+		},
+		{
+			msg: "NginxProxy log level set to crit",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelCrit)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "crit"},
+		},
+		{
+			msg: "NginxProxy log level set to alert",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelAlert)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "alert"},
+		},
+		{
+			msg: "NginxProxy log level set to emerg",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelEmerg)},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{ErrorLevel: "emerg"},
+		},
+		{
+			msg: "NginxProxy specifies access log",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{
+								AccessLog: &ngfAPIv1alpha1.NginxAccessLog{
+									Formats: []ngfAPIv1alpha1.NginxLogFormat{
+										{
+											Name:   "json",
+											Format: `{"status": "$status"}`,
+											Escape: helpers.GetPointer(ngfAPIv1alpha1.NginxLogFormatEscapeJSON),
+										},
+										{
+											Name:   "main",
+											Format: "$remote_addr $status",
+										},
+									},
+									Default: &ngfAPIv1alpha1.AccessLogSettings{
+										Format: helpers.GetPointer("json"),
+										Destination: &ngfAPIv1alpha1.AccessLogDestination{
+											Type: ngfAPIv1alpha1.AccessLogDestinationSyslog,
+											Syslog: &ngfAPIv1alpha1.SyslogDestination{
+												Server: "syslog.example.com:514",
+												Tag:    helpers.GetPointer("gateway"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{
+				ErrorLevel: defaultErrorLogLevel,
+				AccessLog: &AccessLog{
+					Destination: "syslog:server=syslog.example.com:514,tag=gateway",
+					Format:      "json",
+				},
+				LogFormats: []LogFormat{
+					{Name: "json", Format: `{"status": "$status"}`, Escape: "json"},
+					{Name: "main", Format: "$remote_addr $status"},
+				},
+			},
+		},
+		{
+			msg: "NginxProxy disables access log",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{
+								AccessLog: &ngfAPIv1alpha1.NginxAccessLog{
+									Default: &ngfAPIv1alpha1.AccessLogSettings{Disable: helpers.GetPointer(true)},
+								},
+							},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{
+				ErrorLevel: defaultErrorLogLevel,
+				AccessLog: &AccessLog{
+					Destination: defaultAccessLogDestination,
+					Format:      graph.DefaultAccessLogFormat,
+					Disable:     true,
+				},
+			},
+		},
+		{
+			msg: "invalid NginxProxy access log is ignored",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: false,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							Logging: &ngfAPIv1alpha1.NginxLogging{
+								AccessLog: &ngfAPIv1alpha1.NginxAccessLog{
+									Default: &ngfAPIv1alpha1.AccessLogSettings{Format: helpers.GetPointer("invalid")},
+								},
+							},
+						},
+					},
+				},
+			},
+			expLoggingSettings: defaultLogging,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildLogging(tc.g)).To(Equal(tc.expLoggingSettings))
+		})
+	}
+}
+
+func TestBuildServers_AccessLogs(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createListener := func(name, hostname string, port v1.PortNumber) *graph.Listener {
+		l := &graph.Listener{
+			Name:        name,
+			GatewayName: gwNsName,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: v1.HTTPProtocolType,
+				Port:     port,
+			},
+			Valid:  true,
+			Routes: map[graph.RouteKey]*graph.L7Route{},
+		}
+
+		if hostname != "" {
+			l.Source.Hostname = helpers.GetPointer(v1.Hostname(hostname))
+		}
+
+		return l
+	}
+
+	g := NewWithT(t)
+
+	httpServers, _ := buildServers(&graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+			},
+			Listeners: []*graph.Listener{
+				createListener("cafe", "cafe.example.com", 80),
+				createListener("tea", "tea.example.com", 80),
+				createListener("other", "", 8080),
+			},
+		},
+		NginxProxy: &graph.NginxProxy{
+			Valid: true,
+			Source: &ngfAPIv1alpha1.NginxProxy{
+				Spec: ngfAPIv1alpha1.NginxProxySpec{
+					Logging: &ngfAPIv1alpha1.NginxLogging{
+						AccessLog: &ngfAPIv1alpha1.NginxAccessLog{
+							Default: &ngfAPIv1alpha1.AccessLogSettings{
+								Format: helpers.GetPointer("main"),
+							},
+							Listeners: []ngfAPIv1alpha1.ListenerAccessLog{
+								{
+									Name: "cafe",
+									Settings: ngfAPIv1alpha1.AccessLogSettings{
+										Destination: &ngfAPIv1alpha1.AccessLogDestination{
+											Type: ngfAPIv1alpha1.AccessLogDestinationFile,
+											File: helpers.GetPointer("/var/log/nginx/cafe.log"),
+										},
+									},
+								},
+								{
+									Name: "other",
+									Settings: ngfAPIv1alpha1.AccessLogSettings{
+										Disable: helpers.GetPointer(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	accessLogs := make(map[string]*AccessLog, len(httpServers))
+	for _, s := range httpServers {
+		accessLogs[fmt.Sprintf("%s:%d:%t", s.Hostname, s.Port, s.IsDefault)] = s.AccessLog
+	}
+
+	g.Expect(accessLogs).To(Equal(map[string]*AccessLog{
+		"cafe.example.com:80:false": {Destination: "/var/log/nginx/cafe.log", Format: "main"},
+		"tea.example.com:80:false":  nil,
+		// the default server of a port with multiple listeners uses the default access log
+		":80:true":   nil,
+		":8080:true": {Destination: defaultAccessLogDestination, Format: "main", Disable: true},
+	}))
+}
+
+func TestBuildWorker(t *testing.T) {
+	t.Parallel()
+
+	createGraph := func(worker *ngfAPIv1alpha1.NginxWorker) *graph.Graph {
+		return &graph.Graph{
+			NginxProxy: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPIv1alpha1.NginxProxy{
+					Spec: ngfAPIv1alpha1.NginxProxySpec{Worker: worker},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		g         *graph.Graph
+		msg       string
+		expWorker Worker
+	}{
+		{
+			msg:       "NginxProxy is nil",
+			g:         &graph.Graph{},
+			expWorker: Worker{},
+		},
+		{
+			msg:       "worker is not specified",
+			g:         createGraph(nil),
+			expWorker: Worker{},
+		},
+		{
+			msg: "user and group",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				User:  helpers.GetPointer("nginx"),
+				Group: helpers.GetPointer("nogroup"),
+			}),
+			expWorker: Worker{User: "nginx", Group: "nogroup"},
+		},
+		{
+			msg: "processes and connections",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				Processes:    helpers.GetPointer("4"),
+				Connections:  helpers.GetPointer[int32](8192),
+				RlimitNofile: helpers.GetPointer[int32](16384),
+				MultiAccept:  helpers.GetPointer(true),
+			}),
+			expWorker: Worker{Processes: "4", Connections: 8192, RlimitNofile: 16384, MultiAccept: true},
+		},
+		{
+			msg: "capability strategy",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				PortBinding: &ngfAPIv1alpha1.PortBinding{
+					Strategy:   helpers.GetPointer(ngfAPIv1alpha1.PortBindingStrategyCapability),
+					PortOffset: helpers.GetPointer[int32](10000),
+				},
+			}),
+			expWorker: Worker{},
+		},
+		{
+			msg: "high ports strategy with the default offset",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				PortBinding: &ngfAPIv1alpha1.PortBinding{
+					Strategy: helpers.GetPointer(ngfAPIv1alpha1.PortBindingStrategyHighPorts),
+				},
+			}),
+			expWorker: Worker{PortOffset: defaultPortOffset},
+		},
+		{
+			msg: "high ports strategy with a custom offset",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				User: helpers.GetPointer("nginx"),
+				PortBinding: &ngfAPIv1alpha1.PortBinding{
+					Strategy:   helpers.GetPointer(ngfAPIv1alpha1.PortBindingStrategyHighPorts),
+					PortOffset: helpers.GetPointer[int32](10000),
+				},
+			}),
+			expWorker: Worker{User: "nginx", PortOffset: 10000},
+		},
+		{
+			msg: "port mappings",
+			g: createGraph(&ngfAPIv1alpha1.NginxWorker{
+				PortBinding: &ngfAPIv1alpha1.PortBinding{
+					Strategy: helpers.GetPointer(ngfAPIv1alpha1.PortBindingStrategyHighPorts),
+					PortMappings: []ngfAPIv1alpha1.PortMapping{
+						{ListenerPort: 443, ContainerPort: 9443},
+						{ListenerPort: 8443, ContainerPort: 10443},
+					},
+				},
+			}),
+			expWorker: Worker{
+				PortOffset:   defaultPortOffset,
+				PortMappings: map[int32]int32{443: 9443, 8443: 10443},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildWorker(tc.g)).To(Equal(tc.expWorker))
+		})
+	}
+}
+
+func TestWorkerListenPort(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(Worker{}.ListenPort(80)).To(Equal(int32(80)))
+
+	worker := Worker{PortOffset: 8000}
+	g.Expect(worker.ListenPort(80)).To(Equal(int32(8080)))
+	g.Expect(worker.ListenPort(1023)).To(Equal(int32(9023)))
+	g.Expect(worker.ListenPort(1024)).To(Equal(int32(1024)))
+	g.Expect(worker.ListenPort(8443)).To(Equal(int32(8443)))
+
+	worker.PortMappings = map[int32]int32{443: 9443, 8443: 10443}
+	g.Expect(worker.ListenPort(80)).To(Equal(int32(8080)))
+	g.Expect(worker.ListenPort(443)).To(Equal(int32(9443)))
+	g.Expect(worker.ListenPort(8443)).To(Equal(int32(10443)))
+}
+
+func TestCreateSnippetName(t *testing.T) {
+	t.Parallel()
+
+	g := NewWithT(t)
+
+	name := createSnippetName(
+		ngfAPIv1alpha1.NginxContextHTTPServerLocation,
+		types.NamespacedName{Namespace: "some-ns", Name: "some-name"},
+	)
+	g.Expect(name).To(Equal("SnippetsFilter_http.server.location_some-ns_some-name"))
+}
+
+func TestBuildSnippetForContext(t *testing.T) {
+	t.Parallel()
+
+	validUnreferenced := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "valid-unreferenced",
+				Namespace: "default",
+			},
+		},
+		Valid:      true,
+		Referenced: false,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "valid unreferenced",
+		},
+	}
+
+	invalidUnreferenced := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "invalid-unreferenced",
+				Namespace: "default",
+			},
+		},
+		Valid:      false,
+		Referenced: false,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "invalid unreferenced",
+		},
+	}
+
+	invalidReferenced := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "invalid-referenced",
+				Namespace: "default",
+			},
+		},
+		Valid:      false,
+		Referenced: true,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "invalid referenced",
+		},
+	}
+
+	validReferenced1 := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "valid-referenced1",
+				Namespace: "default",
+			},
+		},
+		Valid:      true,
+		Referenced: true,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextHTTP: "http valid referenced 1",
+			ngfAPIv1alpha1.NginxContextMain: "main valid referenced 1",
+		},
+	}
+
+	validReferenced2 := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "valid-referenced2",
+				Namespace: "other-ns",
+			},
+		},
+		Valid:      true,
+		Referenced: true,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextMain: "main valid referenced 2",
+			ngfAPIv1alpha1.NginxContextHTTP: "http valid referenced 2",
+		},
+	}
+
+	validReferenced3 := &graph.SnippetsFilter{
+		Source: &ngfAPIv1alpha1.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "valid-referenced3",
+				Namespace: "other-ns",
+			},
+		},
+		Valid:      true,
+		Referenced: true,
+		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
+			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "location valid referenced 2",
+		},
+	}
+
+	expMainSnippets := []Snippet{
+		{
+			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextMain, client.ObjectKeyFromObject(validReferenced1.Source)),
+			Contents: "main valid referenced 1",
+		},
+		{
+			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextMain, client.ObjectKeyFromObject(validReferenced2.Source)),
+			Contents: "main valid referenced 2",
+		},
+	}
+
+	expHTTPSnippets := []Snippet{
+		{
+			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextHTTP, client.ObjectKeyFromObject(validReferenced1.Source)),
+			Contents: "http valid referenced 1",
+		},
+		{
+			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextHTTP, client.ObjectKeyFromObject(validReferenced2.Source)),
+			Contents: "http valid referenced 2",
+		},
+	}
+
+	getSnippetsFilters := func() map[types.NamespacedName]*graph.SnippetsFilter {
+		return map[types.NamespacedName]*graph.SnippetsFilter{
+			client.ObjectKeyFromObject(validUnreferenced.Source):   validUnreferenced,
+			client.ObjectKeyFromObject(invalidUnreferenced.Source): invalidUnreferenced,
+			client.ObjectKeyFromObject(invalidReferenced.Source):   invalidReferenced,
+			client.ObjectKeyFromObject(validReferenced1.Source):    validReferenced1,
+			client.ObjectKeyFromObject(validReferenced2.Source):    validReferenced2,
+			client.ObjectKeyFromObject(validReferenced3.Source):    validReferenced3,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		snippetsFilters map[types.NamespacedName]*graph.SnippetsFilter
+		ctx             ngfAPIv1alpha1.NginxContext
+		expSnippets     []Snippet
+	}{
+		{
+			name:            "no snippets filters",
+			snippetsFilters: nil,
+			ctx:             ngfAPIv1alpha1.NginxContextMain,
+			expSnippets:     nil,
+		},
+		{
+			name:            "main context: mix of invalid, unreferenced, and valid, referenced snippets filters",
+			snippetsFilters: getSnippetsFilters(),
+			ctx:             ngfAPIv1alpha1.NginxContextMain,
+			expSnippets:     expMainSnippets,
+		},
+		{
+			name:            "http context: mix of invalid, unreferenced, and valid, referenced snippets filters",
+			snippetsFilters: getSnippetsFilters(),
+			ctx:             ngfAPIv1alpha1.NginxContextHTTP,
+			expSnippets:     expHTTPSnippets,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewWithT(t)
+			snippets := buildSnippetsForContext(test.snippetsFilters, test.ctx)
+			g.Expect(snippets).To(ConsistOf(test.expSnippets))
+		})
+	}
+}
+
+func TestBuildAuxiliarySecrets(t *testing.T) {
+	t.Parallel()
+
+	secrets := map[types.NamespacedName][]graph.PlusSecretFile{
+		{Name: "license", Namespace: "ngf"}: {
+			{
+				Type:    graph.PlusReportJWTToken,
+				Content: []byte("license"),
+			},
+		},
+		{Name: "ca", Namespace: "ngf"}: {
+			{
+				Type:    graph.PlusReportCACertificate,
+				Content: []byte("ca"),
+			},
+		},
+		{Name: "client", Namespace: "ngf"}: {
+			{
+				Type:    graph.PlusReportClientSSLCertificate,
+				Content: []byte("cert"),
+			},
+			{
+				Type:    graph.PlusReportClientSSLKey,
+				Content: []byte("key"),
+			},
+		},
+	}
+	expSecrets := map[graph.SecretFileType][]byte{
+		graph.PlusReportJWTToken:             []byte("license"),
+		graph.PlusReportCACertificate:        []byte("ca"),
+		graph.PlusReportClientSSLCertificate: []byte("cert"),
+		graph.PlusReportClientSSLKey:         []byte("key"),
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildAuxiliarySecrets(secrets)).To(Equal(expSecrets))
+}
+
+func TestBuildNginxPlus(t *testing.T) {
+	defaultNginxPlus := NginxPlus{AllowedAddresses: []string{"127.0.0.1"}}
+
+	t.Parallel()
+	tests := []struct {
+		msg          string
+		g            *graph.Graph
+		expNginxPlus NginxPlus
+	}{
+		{
+			msg:          "NginxProxy is nil",
+			g:            &graph.Graph{},
+			expNginxPlus: defaultNginxPlus,
+		},
+		{
+			msg: "NginxPlus default values are used when NginxProxy doesn't specify NginxPlus settings",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{},
+					},
+				},
+			},
+			expNginxPlus: defaultNginxPlus,
+		},
+		{
+			msg: "NginxProxy specifies one allowed address",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
+								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
+									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expNginxPlus: NginxPlus{AllowedAddresses: []string{"127.0.0.3"}},
+		},
+		{
+			msg: "NginxProxy specifies multiple allowed addresses",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
+								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
+									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
+									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "25.0.0.3"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expNginxPlus: NginxPlus{AllowedAddresses: []string{"127.0.0.3", "25.0.0.3"}},
+		},
+		{
+			msg: "NginxProxy specifies 127.0.0.1 as allowed address",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
+								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
+									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expNginxPlus: defaultNginxPlus,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildNginxPlus(tc.g)).To(Equal(tc.expNginxPlus))
+		})
+	}
+}
+
+func TestBuildStatusZones(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	hr := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+	}
+
+	createL7Route := func(listener string, hostnames ...string) *graph.L7Route {
+		return &graph.L7Route{
+			Source:    hr,
+			RouteType: graph.RouteTypeHTTP,
+			Valid:     true,
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{listener: hostnames},
+						Attached:          true,
+					},
+				},
+			},
+		}
+	}
+
+	createListener := func(
+		name string,
+		protocol v1.ProtocolType,
+		port v1.PortNumber,
+		hostname string,
+	) *graph.Listener {
+		l := &graph.Listener{
+			Name:        name,
+			GatewayName: gwNsName,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Valid: true,
+		}
+
+		if hostname != "" {
+			l.Source.Hostname = helpers.GetPointer(v1.Hostname(hostname))
+		}
+
+		return l
+	}
+
+	httpListener := createListener("http", v1.HTTPProtocolType, 80, "*.example.com")
+	httpListener.Routes = map[graph.RouteKey]*graph.L7Route{
+		graph.CreateRouteKey(hr): createL7Route("http", "foo.example.com"),
+	}
+
+	fooListener := createListener("https-foo", v1.HTTPSProtocolType, 443, "foo.example.com")
+	fooListener.Routes = map[graph.RouteKey]*graph.L7Route{
+		graph.CreateRouteKey(hr): createL7Route("https-foo", "foo.example.com"),
+	}
+
+	barListener := createListener("https-bar", v1.HTTPSProtocolType, 443, "bar.example.com")
+
+	tlsKey := graph.L4RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "tls"},
+		RouteType:      graph.RouteTypeTLS,
+	}
+	tlsListener := createListener("tls", v1.TLSProtocolType, 8443, "")
+	tlsListener.L4Routes = map[graph.L4RouteKey]*graph.L4Route{
+		tlsKey: {
+			Valid: true,
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"tls": {"app.example.com"}},
+					},
+				},
+			},
+		},
+	}
+
+	createL4Routes := func(routeType graph.RouteType, name string, port int32) map[graph.L4RouteKey]*graph.L4Route {
+		key := graph.L4RouteKey{
+			NamespacedName: types.NamespacedName{Namespace: "test", Name: name},
+			RouteType:      routeType,
+		}
+
+		return map[graph.L4RouteKey]*graph.L4Route{
+			key: {
+				Valid: true,
+				Spec: graph.L4RouteSpec{
+					BackendRef: graph.BackendRef{
+						Valid:       true,
+						SvcNsName:   key.NamespacedName,
+						ServicePort: apiv1.ServicePort{Port: port},
+					},
+				},
+			},
+		}
+	}
+
+	tcpListener := createListener("tcp", v1.TCPProtocolType, 5432, "")
+	tcpListener.L4Routes = createL4Routes(graph.RouteTypeTCP, "db", 5432)
+
+	udpListener := createListener("udp", v1.UDPProtocolType, 53, "")
+	udpListener.L4Routes = createL4Routes(graph.RouteTypeUDP, "dns", 53)
+
+	invalidListener := createListener("invalid", v1.HTTPProtocolType, 8080, "")
+	invalidListener.Valid = false
+
+	createZone := func(l *graph.Listener, isDefault bool) ListenerStatusZone {
+		return ListenerStatusZone{
+			Gateway:  gwNsName,
+			Listener: l.Name,
+			Default:  isDefault,
+			HTTPS:    l.Source.Protocol == v1.HTTPSProtocolType,
+		}
+	}
+
+	tests := []struct {
+		g        *graph.Graph
+		expected map[string]ListenerStatusZone
+		msg      string
+	}{
+		{
+			msg:      "no gateway",
+			g:        &graph.Graph{},
+			expected: nil,
+		},
+		{
+			msg: "listeners of all protocols",
+			g: &graph.Graph{
+				Gateway: &graph.Gateway{
+					Source: &v1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+					},
+					Listeners: []*graph.Listener{
+						httpListener,
+						fooListener,
+						barListener,
+						tlsListener,
+						tcpListener,
+						udpListener,
+						invalidListener,
+					},
+				},
+			},
+			expected: map[string]ListenerStatusZone{
+				"_default_80":          createZone(httpListener, true),
+				"foo.example.com_80":   createZone(httpListener, false),
+				"*.example.com_80":     createZone(httpListener, false),
+				"foo.example.com_443":  createZone(fooListener, false),
+				"bar.example.com_443":  createZone(barListener, false),
+				"app.example.com_8443": createZone(tlsListener, false),
+				"test_db_5432_5432":    createZone(tcpListener, false),
+				"test_dns_53_53":       createZone(udpListener, false),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildStatusZones(test.g)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildTemplateOverrides(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		g            *graph.Graph
+		expOverrides TemplateOverrides
+		msg          string
+	}{
+		{
+			msg:          "NginxProxy is nil",
+			g:            &graph.Graph{},
+			expOverrides: TemplateOverrides{},
+		},
+		{
+			msg: "NginxProxy is invalid",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: false,
+					TemplateOverrides: map[ngfAPIv1alpha1.TemplateOverrideKey]string{
+						ngfAPIv1alpha1.TemplateOverrideKeyServer: "server",
+					},
+				},
+			},
+			expOverrides: TemplateOverrides{},
+		},
+		{
+			msg: "NginxProxy has template overrides",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					TemplateOverrides: map[ngfAPIv1alpha1.TemplateOverrideKey]string{
+						ngfAPIv1alpha1.TemplateOverrideKeyServer:   "server",
+						ngfAPIv1alpha1.TemplateOverrideKeyUpstream: "upstream",
+						ngfAPIv1alpha1.TemplateOverrideKeyLocation: "location",
+					},
+				},
+			},
+			expOverrides: TemplateOverrides{
+				Server:   "server",
+				Upstream: "upstream",
+				Location: "location",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildTemplateOverrides(tc.g)).To(Equal(tc.expOverrides))
+		})
+	}
+}
+
+func TestBuildHashSizes(t *testing.T) {
+	t.Parallel()
+
+	defaultSizes := HashSizes{
+		ServerNamesMaxSize:    defaultServerNamesHashMaxSize,
+		ServerNamesBucketSize: defaultServerNamesHashBucketSize,
+		MapMaxSize:            defaultMapHashMaxSize,
+		MapBucketSize:         defaultMapHashBucketSize,
+		StreamMapMaxSize:      defaultMapHashMaxSize,
+		StreamMapBucketSize:   defaultMapHashBucketSize,
+	}
+
+	manyServers := make([]VirtualServer, 0, 600)
+	for i := range 600 {
+		manyServers = append(manyServers, VirtualServer{Hostname: fmt.Sprintf("host-%d.example.com", i)})
+	}
+
+	manyPassthroughServers := make([]Layer4VirtualServer, 0, 2000)
+	for i := range 2000 {
+		manyPassthroughServers = append(
+			manyPassthroughServers,
+			Layer4VirtualServer{Hostname: fmt.Sprintf("host-%d.example.com", i)},
+		)
+	}
+
+	longHostname := strings.Repeat("a", 250)
+
+	createGraph := func(valid bool, hashTables *ngfAPIv1alpha1.HashTables) *graph.Graph {
+		return &graph.Graph{
+			NginxProxy: &graph.NginxProxy{
+				Valid: valid,
+				Source: &ngfAPIv1alpha1.NginxProxy{
+					Spec: ngfAPIv1alpha1.NginxProxySpec{
+						HashTables: hashTables,
+					},
+				},
+			},
+		}
+	}
+
+	overrides := &ngfAPIv1alpha1.HashTables{
+		ServerNamesMaxSize: helpers.GetPointer[int32](4096),
+		MapBucketSize:      helpers.GetPointer[int32](128),
+	}
+
+	tests := []struct {
+		g                  *graph.Graph
+		msg                string
+		servers            []VirtualServer
+		passthroughServers []Layer4VirtualServer
+		expSizes           HashSizes
+	}{
+		{
+			msg:      "no servers",
+			g:        &graph.Graph{},
+			expSizes: defaultSizes,
+		},
+		{
+			msg: "few servers",
+			g:   &graph.Graph{},
+			servers: []VirtualServer{
+				{IsDefault: true},
+				{Hostname: "~^"},
+				{Hostname: "foo.example.com"},
+				{Hostname: "foo.example.com"},
+			},
+			passthroughServers: []Layer4VirtualServer{
+				{Hostname: "bar.example.com"},
+			},
+			expSizes: defaultSizes,
+		},
+		{
+			msg:                "many servers",
+			g:                  &graph.Graph{},
+			servers:            manyServers,
+			passthroughServers: manyPassthroughServers,
+			expSizes: HashSizes{
+				ServerNamesMaxSize:    2048,
+				ServerNamesBucketSize: defaultServerNamesHashBucketSize,
+				MapMaxSize:            defaultMapHashMaxSize,
+				MapBucketSize:         defaultMapHashBucketSize,
+				StreamMapMaxSize:      4096,
+				StreamMapBucketSize:   defaultMapHashBucketSize,
+			},
+		},
+		{
+			msg:                "long hostnames",
+			g:                  &graph.Graph{},
+			servers:            []VirtualServer{{Hostname: longHostname}},
+			passthroughServers: []Layer4VirtualServer{{Hostname: longHostname}},
+			expSizes: HashSizes{
+				ServerNamesMaxSize:    defaultServerNamesHashMaxSize,
+				ServerNamesBucketSize: 512,
+				MapMaxSize:            defaultMapHashMaxSize,
+				MapBucketSize:         defaultMapHashBucketSize,
+				StreamMapMaxSize:      defaultMapHashMaxSize,
+				StreamMapBucketSize:   512,
+			},
+		},
+		{
+			msg:                "NginxProxy overrides the computed sizes",
+			g:                  createGraph(true, overrides),
+			servers:            []VirtualServer{{Hostname: longHostname}},
+			passthroughServers: []Layer4VirtualServer{{Hostname: longHostname}},
+			expSizes: HashSizes{
+				ServerNamesMaxSize:    4096,
+				ServerNamesBucketSize: 512,
+				MapMaxSize:            defaultMapHashMaxSize,
+				MapBucketSize:         128,
+				StreamMapMaxSize:      defaultMapHashMaxSize,
+				StreamMapBucketSize:   128,
+			},
+		},
+		{
+			msg:      "invalid NginxProxy is ignored",
+			g:        createGraph(false, overrides),
+			expSizes: defaultSizes,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildHashSizes(test.g, test.servers, test.passthroughServers)).To(Equal(test.expSizes))
+		})
+	}
+}
+
+func TestBuildSocketOptions(t *testing.T) {
+	t.Parallel()
+
+	createGraph := func(valid bool, socketOptions *ngfAPIv1alpha1.SocketOptions) *graph.Graph {
+		return &graph.Graph{
+			NginxProxy: &graph.NginxProxy{
+				Valid: valid,
+				Source: &ngfAPIv1alpha1.NginxProxy{
+					Spec: ngfAPIv1alpha1.NginxProxySpec{
+						SocketOptions: socketOptions,
+					},
+				},
+			},
+		}
+	}
+
+	createListener := func(name string, port v1.PortNumber, valid bool) *graph.Listener {
+		return &graph.Listener{
+			Name:   name,
+			Source: v1.Listener{Name: v1.SectionName(name), Port: port},
+			Valid:  valid,
+		}
+	}
+
+	listeners := []*graph.Listener{
+		createListener("http", 80, true),
+		createListener("http-other", 80, true),
+		createListener("https", 443, true),
+		createListener("tcp", 9000, true),
+		createListener("invalid", 9001, false),
+	}
+
+	defaultSettings := &ngfAPIv1alpha1.SocketSettings{
+		KeepAlive: &ngfAPIv1alpha1.TCPKeepAlive{
+			Enabled:  true,
+			Idle:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("30m"),
+			Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+			Count:    helpers.GetPointer[int32](5),
+		},
+		Backlog:    helpers.GetPointer[int32](1024),
+		TCPNoDelay: helpers.GetPointer(true),
+	}
+
+	tests := []struct {
+		g      *graph.Graph
+		expOpt map[int32]SocketOptions
+		msg    string
+	}{
+		{
+			msg: "no NginxProxy",
+			g:   &graph.Graph{},
+		},
+		{
+			msg: "invalid NginxProxy",
+			g:   createGraph(false, &ngfAPIv1alpha1.SocketOptions{Default: defaultSettings}),
+		},
+		{
+			msg: "no socket options",
+			g:   createGraph(true, nil),
+		},
+		{
+			msg: "default socket options",
+			g:   createGraph(true, &ngfAPIv1alpha1.SocketOptions{Default: defaultSettings}),
+			expOpt: map[int32]SocketOptions{
+				80:   {KeepAlive: "30m:10s:5", Backlog: 1024, TCPNoDelay: "on"},
+				443:  {KeepAlive: "30m:10s:5", Backlog: 1024, TCPNoDelay: "on"},
+				9000: {KeepAlive: "30m:10s:5", Backlog: 1024, TCPNoDelay: "on"},
+			},
+		},
+		{
+			msg: "listener overrides",
+			g: createGraph(true, &ngfAPIv1alpha1.SocketOptions{
+				Default: defaultSettings,
+				Listeners: []ngfAPIv1alpha1.ListenerSocketSettings{
+					{
+						Name: "http-other",
+						Settings: ngfAPIv1alpha1.SocketSettings{
+							ReusePort: helpers.GetPointer(true),
+							Backlog:   helpers.GetPointer[int32](2048),
+						},
+					},
+					{
+						Name: "http",
+						Settings: ngfAPIv1alpha1.SocketSettings{
+							Backlog:   helpers.GetPointer[int32](4096),
+							TCPNoPush: helpers.GetPointer(false),
+						},
+					},
+					{
+						Name: "tcp",
+						Settings: ngfAPIv1alpha1.SocketSettings{
+							KeepAlive:      &ngfAPIv1alpha1.TCPKeepAlive{Enabled: false},
+							DeferredAccept: helpers.GetPointer(true),
+						},
+					},
+					{
+						Name: "invalid",
+						Settings: ngfAPIv1alpha1.SocketSettings{
+							ReusePort: helpers.GetPointer(true),
+						},
+					},
+				},
+			}),
+			expOpt: map[int32]SocketOptions{
+				80: {
+					KeepAlive:  "30m:10s:5",
+					Backlog:    4096,
+					ReusePort:  true,
+					TCPNoDelay: "on",
+					TCPNoPush:  "off",
+				},
+				443: {KeepAlive: "30m:10s:5", Backlog: 1024, TCPNoDelay: "on"},
+				9000: {
+					KeepAlive:      "off",
+					Backlog:        1024,
+					DeferredAccept: true,
+					TCPNoDelay:     "on",
+				},
+			},
+		},
+		{
+			msg: "listener overrides without default",
+			g: createGraph(true, &ngfAPIv1alpha1.SocketOptions{
+				Listeners: []ngfAPIv1alpha1.ListenerSocketSettings{
+					{
+						Name: "https",
+						Settings: ngfAPIv1alpha1.SocketSettings{
+							KeepAlive: &ngfAPIv1alpha1.TCPKeepAlive{
+								Enabled: true,
+								Count:   helpers.GetPointer[int32](3),
+							},
+						},
+					},
+				},
+			}),
+			expOpt: map[int32]SocketOptions{
+				443: {KeepAlive: "::3"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSocketOptions(test.g, listeners)).To(Equal(test.expOpt))
+		})
+	}
+}
+
+func TestLoadBrotliModule(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string, brotli *ngfAPIv1alpha1.BrotliCompression, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ResponseCompressionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec: ngfAPIv1alpha1.ResponseCompressionPolicySpec{
+					Gzip:   &ngfAPIv1alpha1.GzipCompression{},
+					Brotli: brotli,
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	createKey := func(name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ResponseCompressionPolicy},
+		}
+	}
+
+	tests := []struct {
+		g        *graph.Graph
+		msg      string
+		expected bool
+	}{
+		{
+			msg:      "no policies",
+			g:        &graph.Graph{},
+			expected: false,
+		},
+		{
+			msg: "gzip only",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("gzip"): createPolicy("gzip", nil, true),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "invalid policy with brotli",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("invalid"): createPolicy("invalid", &ngfAPIv1alpha1.BrotliCompression{}, false),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "valid policy that disables brotli",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("gzip"): createPolicy("gzip", nil, true),
+					createKey("brotli"): createPolicy(
+						"brotli",
+						&ngfAPIv1alpha1.BrotliCompression{Enable: helpers.GetPointer(false)},
+						true,
+					),
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(loadBrotliModule(test.g)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildBodyTransforms(t *testing.T) {
+	t.Parallel()
+
+	createBodyTransform := func(name string, valid, referenced bool) *graph.HTTPBodyTransform {
+		return &graph.HTTPBodyTransform{
+			Source: &ngfAPIv1alpha1.HTTPBodyTransform{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				Spec: ngfAPIv1alpha1.HTTPBodyTransformSpec{
+					Request: &ngfAPIv1alpha1.RequestBodyTransform{
+						MaxBodySize: helpers.GetPointer[ngfAPIv1alpha1.Size]("8k"),
+						Script:      "return body + 'request';",
+					},
+					Response: &ngfAPIv1alpha1.ResponseBodyTransform{
+						MaxBodySize: helpers.GetPointer[ngfAPIv1alpha1.Size]("64k"),
+						Script:      "return body + 'response';",
+					},
+				},
+			},
+			Valid:      valid,
+			Referenced: referenced,
+		}
+	}
+
+	responseOnly := createBodyTransform("response.only", true, true)
+	responseOnly.Source.Spec.Request = nil
+	responseOnly.Source.Spec.Response.MaxBodySize = nil
+
+	bodyTransforms := map[types.NamespacedName]*graph.HTTPBodyTransform{
+		{Namespace: "test", Name: "both"}:          createBodyTransform("both", true, true),
+		{Namespace: "test", Name: "response.only"}: responseOnly,
+		{Namespace: "test", Name: "invalid"}:       createBodyTransform("invalid", false, true),
+		{Namespace: "test", Name: "unreferenced"}:  createBodyTransform("unreferenced", true, false),
+	}
+
+	expected := []BodyTransform{
+		{
+			Source:              types.NamespacedName{Namespace: "test", Name: "both"},
+			ID:                  "body_transform_test_both",
+			RequestScript:       "return body + 'request';",
+			ResponseScript:      "return body + 'response';",
+			MaxRequestBodySize:  8 << 10,
+			MaxResponseBodySize: 64 << 10,
+		},
+		{
+			Source:              types.NamespacedName{Namespace: "test", Name: "response.only"},
+			ID:                  "body_transform_test_response_only",
+			ResponseScript:      "return body + 'response';",
+			MaxRequestBodySize:  1 << 20,
+			MaxResponseBodySize: 1 << 20,
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(buildBodyTransforms(bodyTransforms)).To(Equal(expected))
+	g.Expect(buildBodyTransforms(nil)).To(BeNil())
+}
+
+func TestBuildCacheZones(t *testing.T) {
+	t.Parallel()
+
+	ancestors := []graph.PolicyAncestor{{Ancestor: v1.ParentReference{Name: "gateway"}}}
+
+	createPolicy := func(name string, zone *ngfAPIv1alpha1.CacheZone, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.CachePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec:       ngfAPIv1alpha1.CachePolicySpec{Zone: zone},
+			},
+			Ancestors: ancestors,
+			Valid:     valid,
+		}
+	}
+
+	createKey := func(name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}
+	}
+
+	unattached := createPolicy("unattached", nil, true)
+	unattached.Ancestors = nil
+
+	tests := []struct {
+		g        *graph.Graph
+		msg      string
+		expected []CacheZone
+	}{
+		{
+			msg:      "no policies",
+			g:        &graph.Graph{},
+			expected: nil,
+		},
+		{
+			msg: "invalid and unattached policies",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("invalid"):    createPolicy("invalid", nil, false),
+					createKey("unattached"): unattached,
+				},
 			},
+			expected: nil,
 		},
 		{
-			msg: "rewrite IP settings configured with recursive set to false and multiple trusted addresses",
+			msg: "valid policies",
 			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							RewriteClientIP: &ngfAPIv1alpha1.RewriteClientIP{
-								Mode: helpers.GetPointer(ngfAPIv1alpha1.RewriteClientIPModeXForwardedFor),
-								TrustedAddresses: []ngfAPIv1alpha1.RewriteClientIPAddress{
-									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "5.5.5.5/12",
-									},
-									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "1.1.1.1/26",
-									},
-									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "2.2.2.2/32",
-									},
-									{
-										Type:  ngfAPIv1alpha1.RewriteClientIPCIDRAddressType,
-										Value: "3.3.3.3/24",
-									},
-								},
-								SetIPRecursively: helpers.GetPointer(false),
-							},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("default"): createPolicy("default", nil, true),
+					createKey("custom"): createPolicy(
+						"custom",
+						&ngfAPIv1alpha1.CacheZone{
+							MaxSize:      helpers.GetPointer[ngfAPIv1alpha1.Size]("1g"),
+							KeysZoneSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("1m"),
+							Inactive:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
 						},
+						true,
+					),
+					createKey("compression"): {
+						Source: &ngfAPIv1alpha1.ResponseCompressionPolicy{},
+						Valid:  true,
 					},
 				},
 			},
-			expRewriteIPSettings: RewriteClientIPSettings{
-				Mode:             RewriteIPModeXForwardedFor,
-				TrustedAddresses: []string{"5.5.5.5/12", "1.1.1.1/26", "2.2.2.2/32", "3.3.3.3/24"},
-				IPRecursive:      false,
+			expected: []CacheZone{
+				{
+					Policy:       types.NamespacedName{Namespace: "test", Name: "custom"},
+					Name:         "cache_test_custom",
+					Path:         "/var/cache/nginx/cache_test_custom",
+					KeysZoneSize: "1m",
+					MaxSize:      "1g",
+					Inactive:     "1h",
+				},
+				{
+					Policy:       types.NamespacedName{Namespace: "test", Name: "default"},
+					Name:         "cache_test_default",
+					Path:         "/var/cache/nginx/cache_test_default",
+					KeysZoneSize: "10m",
+					Inactive:     "10m",
+				},
 			},
 		},
 	}
 
-	for _, tc := range tests {
-		t.Run(tc.msg, func(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			baseConfig := buildBaseHTTPConfig(tc.g)
-			g.Expect(baseConfig.RewriteClientIPSettings).To(Equal(tc.expRewriteIPSettings))
+
+			g.Expect(buildCacheZones(test.g)).To(Equal(test.expected))
 		})
 	}
 }
 
-func TestBuildLogging(t *testing.T) {
-	defaultLogging := Logging{ErrorLevel: defaultErrorLogLevel}
-
+func TestBuildRateLimitZones(t *testing.T) {
 	t.Parallel()
+
+	ancestors := []graph.PolicyAncestor{{Ancestor: v1.ParentReference{Name: "gateway"}}}
+
+	createPolicy := func(name string, spec ngfAPIv1alpha1.RateLimitPolicySpec, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec:       spec,
+			},
+			Ancestors: ancestors,
+			Valid:     valid,
+		}
+	}
+
+	createKey := func(name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+		}
+	}
+
+	unattached := createPolicy("unattached", ngfAPIv1alpha1.RateLimitPolicySpec{Rate: "10r/s"}, true)
+	unattached.Ancestors = nil
+
 	tests := []struct {
-		msg                string
-		g                  *graph.Graph
-		expLoggingSettings Logging
+		g        *graph.Graph
+		msg      string
+		expected []RateLimitZone
 	}{
 		{
-			msg:                "NginxProxy is nil",
-			g:                  &graph.Graph{},
-			expLoggingSettings: defaultLogging,
+			msg:      "no policies",
+			g:        &graph.Graph{},
+			expected: nil,
 		},
 		{
-			msg: "NginxProxy does not specify log level",
+			msg: "invalid and unattached policies",
 			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{},
-					},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("invalid"):    createPolicy("invalid", ngfAPIv1alpha1.RateLimitPolicySpec{}, false),
+					createKey("unattached"): unattached,
 				},
 			},
-			expLoggingSettings: defaultLogging,
+			expected: nil,
 		},
 		{
-			msg: "NginxProxy log level set to debug",
+			msg: "valid policies",
 			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelDebug)},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("default"): createPolicy("default", ngfAPIv1alpha1.RateLimitPolicySpec{Rate: "10r/s"}, true),
+					createKey("header"): createPolicy(
+						"header",
+						ngfAPIv1alpha1.RateLimitPolicySpec{
+							Key: &ngfAPIv1alpha1.RateLimitKey{
+								Type:   ngfAPIv1alpha1.RateLimitKeyHeader,
+								Header: helpers.GetPointer("X-API-Key"),
+							},
+							ZoneSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("1m"),
+							Rate:     "30r/m",
 						},
-					},
-				},
-			},
-			expLoggingSettings: Logging{ErrorLevel: "debug"},
-		},
-		{
-			msg: "NginxProxy log level set to info",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelInfo)},
+						true,
+					),
+					createKey("jwt"): createPolicy(
+						"jwt",
+						ngfAPIv1alpha1.RateLimitPolicySpec{
+							Key: &ngfAPIv1alpha1.RateLimitKey{
+								Type:     ngfAPIv1alpha1.RateLimitKeyJWTClaim,
+								JWTClaim: helpers.GetPointer("sub"),
+							},
+							Rate: "5r/s",
 						},
+						true,
+					),
+					createKey("compression"): {
+						Source: &ngfAPIv1alpha1.ResponseCompressionPolicy{},
+						Valid:  true,
 					},
 				},
 			},
-			expLoggingSettings: Logging{ErrorLevel: defaultErrorLogLevel},
-		},
-		{
-			msg: "NginxProxy log level set to notice",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelNotice)},
-						},
-					},
+			expected: []RateLimitZone{
+				{
+					Policy: types.NamespacedName{Namespace: "test", Name: "default"},
+					Name:   "ratelimit_test_default",
+					Key:    "$binary_remote_addr",
+					Size:   "10m",
+					Rate:   "10r/s",
 				},
-			},
-			expLoggingSettings: Logging{ErrorLevel: "notice"},
-		},
-		{
-			msg: "NginxProxy log level set to warn",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelWarn)},
-						},
-					},
+				{
+					Policy: types.NamespacedName{Namespace: "test", Name: "header"},
+					Name:   "ratelimit_test_header",
+					Key:    "$http_x_api_key",
+					Size:   "1m",
+					Rate:   "30r/m",
+				},
+				{
+					Policy: types.NamespacedName{Namespace: "test", Name: "jwt"},
+					Name:   "ratelimit_test_jwt",
+					Key:    "$jwt_claim_sub",
+					Size:   "10m",
+					Rate:   "5r/s",
 				},
 			},
-			expLoggingSettings: Logging{ErrorLevel: "warn"},
 		},
-		{
-			msg: "NginxProxy log level set to error",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelError)},
-						},
-					},
-				},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildRateLimitZones(test.g)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildConnectionLimitZones(t *testing.T) {
+	t.Parallel()
+
+	ancestors := []graph.PolicyAncestor{{Ancestor: v1.ParentReference{Name: "gateway"}}}
+
+	createPolicy := func(
+		name string,
+		spec ngfAPIv1alpha1.ConnectionLimitPolicySpec,
+		targetKind v1.Kind,
+		valid bool,
+	) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec:       spec,
 			},
-			expLoggingSettings: Logging{ErrorLevel: "error"},
+			TargetRefs: []graph.PolicyTargetRef{{Kind: targetKind}},
+			Ancestors:  ancestors,
+			Valid:      valid,
+		}
+	}
+
+	createKey := func(name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+		}
+	}
+
+	spec := ngfAPIv1alpha1.ConnectionLimitPolicySpec{MaxConnections: 10}
+
+	unattached := createPolicy("unattached", spec, kinds.Gateway, true)
+	unattached.Ancestors = nil
+
+	tests := []struct {
+		g        *graph.Graph
+		msg      string
+		expected []ConnectionLimitZone
+	}{
+		{
+			msg:      "no policies",
+			g:        &graph.Graph{},
+			expected: nil,
 		},
 		{
-			msg: "NginxProxy log level set to crit",
+			msg: "invalid, unattached and stream policies",
 			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelCrit)},
-						},
-					},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("invalid"):    createPolicy("invalid", spec, kinds.Gateway, false),
+					createKey("unattached"): unattached,
+					createKey("tls"):        createPolicy("tls", spec, kinds.TLSRoute, true),
 				},
 			},
-			expLoggingSettings: Logging{ErrorLevel: "crit"},
+			expected: nil,
 		},
 		{
-			msg: "NginxProxy log level set to alert",
+			msg: "valid policies",
 			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelAlert)},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					createKey("client"): createPolicy("client", spec, kinds.Gateway, true),
+					createKey("server"): createPolicy(
+						"server",
+						ngfAPIv1alpha1.ConnectionLimitPolicySpec{
+							Key:            helpers.GetPointer(ngfAPIv1alpha1.ConnectionLimitKeyServer),
+							ZoneSize:       helpers.GetPointer[ngfAPIv1alpha1.Size]("1m"),
+							MaxConnections: 100,
 						},
+						kinds.HTTPRoute,
+						true,
+					),
+					createKey("compression"): {
+						Source: &ngfAPIv1alpha1.ResponseCompressionPolicy{},
+						Valid:  true,
 					},
 				},
 			},
-			expLoggingSettings: Logging{ErrorLevel: "alert"},
-		},
-		{
-			msg: "NginxProxy log level set to emerg",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							Logging: &ngfAPIv1alpha1.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPIv1alpha1.NginxLogLevelEmerg)},
-						},
-					},
+			expected: []ConnectionLimitZone{
+				{
+					Policy: types.NamespacedName{Namespace: "test", Name: "client"},
+					Name:   "connlimit_test_client",
+					Key:    "$binary_remote_addr",
+					Size:   "10m",
+				},
+				{
+					Policy: types.NamespacedName{Namespace: "test", Name: "server"},
+					Name:   "connlimit_test_server",
+					Key:    "$server_name",
+					Size:   "1m",
 				},
 			},
-			expLoggingSettings: Logging{ErrorLevel: "emerg"},
 		},
 	}
 
-	for _, tc := range tests {
-		t.Run(tc.msg, func(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			g.Expect(buildLogging(tc.g)).To(Equal(tc.expLoggingSettings))
+			g.Expect(buildConnectionLimitZones(test.g)).To(Equal(test.expected))
 		})
 	}
 }
 
-func TestCreateSnippetName(t *testing.T) {
+func TestBuildStreamConnectionLimit(t *testing.T) {
 	t.Parallel()
 
-	g := NewWithT(t)
+	createPolicy := func(name string, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec:       ngfAPIv1alpha1.ConnectionLimitPolicySpec{MaxConnections: 10},
+			},
+			Valid: valid,
+		}
+	}
 
-	name := createSnippetName(
-		ngfAPIv1alpha1.NginxContextHTTPServerLocation,
-		types.NamespacedName{Namespace: "some-ns", Name: "some-name"},
-	)
-	g.Expect(name).To(Equal("SnippetsFilter_http.server.location_some-ns_some-name"))
-}
+	createLimit := func(name string) *ConnectionLimit {
+		return &ConnectionLimit{
+			Policy:         types.NamespacedName{Namespace: "test", Name: name},
+			Zone:           "stream_connlimit_test_" + name,
+			MaxConnections: 10,
+		}
+	}
 
-func TestBuildSnippetForContext(t *testing.T) {
-	t.Parallel()
+	gwPolicy := createPolicy("gateway", true)
+	routePolicy := createPolicy("route", true)
 
-	validUnreferenced := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "valid-unreferenced",
-				Namespace: "default",
-			},
-		},
-		Valid:      true,
-		Referenced: false,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "valid unreferenced",
+	tests := []struct {
+		gw       *graph.Gateway
+		route    *graph.L4Route
+		expected *ConnectionLimit
+		msg      string
+	}{
+		{
+			msg:      "no policies",
+			gw:       &graph.Gateway{},
+			route:    &graph.L4Route{},
+			expected: nil,
 		},
-	}
-
-	invalidUnreferenced := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "invalid-unreferenced",
-				Namespace: "default",
-			},
+		{
+			msg:      "invalid route policy",
+			gw:       &graph.Gateway{},
+			route:    &graph.L4Route{Policies: []*graph.Policy{createPolicy("invalid", false)}},
+			expected: nil,
 		},
-		Valid:      false,
-		Referenced: false,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "invalid unreferenced",
+		{
+			msg:      "gateway policy",
+			gw:       &graph.Gateway{Policies: []*graph.Policy{gwPolicy}},
+			route:    &graph.L4Route{},
+			expected: createLimit("gateway"),
 		},
-	}
-
-	invalidReferenced := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "invalid-referenced",
-				Namespace: "default",
-			},
+		{
+			msg:      "route policy overrides gateway policy",
+			gw:       &graph.Gateway{Policies: []*graph.Policy{gwPolicy}},
+			route:    &graph.L4Route{Policies: []*graph.Policy{routePolicy}},
+			expected: createLimit("route"),
 		},
-		Valid:      false,
-		Referenced: true,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "invalid referenced",
+		{
+			msg:      "nil gateway",
+			route:    &graph.L4Route{Policies: []*graph.Policy{routePolicy}},
+			expected: createLimit("route"),
 		},
 	}
 
-	validReferenced1 := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "valid-referenced1",
-				Namespace: "default",
-			},
-		},
-		Valid:      true,
-		Referenced: true,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextHTTP: "http valid referenced 1",
-			ngfAPIv1alpha1.NginxContextMain: "main valid referenced 1",
-		},
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildStreamConnectionLimit(test.gw, test.route)).To(Equal(test.expected))
+		})
 	}
+}
 
-	validReferenced2 := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "valid-referenced2",
-				Namespace: "other-ns",
-			},
-		},
-		Valid:      true,
-		Referenced: true,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextMain: "main valid referenced 2",
-			ngfAPIv1alpha1.NginxContextHTTP: "http valid referenced 2",
-		},
+func TestBuildStreamConnectionLimitZones(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createKey := func(name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+		}
 	}
 
-	validReferenced3 := &graph.SnippetsFilter{
-		Source: &ngfAPIv1alpha1.SnippetsFilter{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "valid-referenced3",
-				Namespace: "other-ns",
+	createPolicy := func(name string, key *ngfAPIv1alpha1.ConnectionLimitKey) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec:       ngfAPIv1alpha1.ConnectionLimitPolicySpec{Key: key, MaxConnections: 10},
 			},
-		},
-		Valid:      true,
-		Referenced: true,
-		Snippets: map[ngfAPIv1alpha1.NginxContext]string{
-			ngfAPIv1alpha1.NginxContextHTTPServerLocation: "location valid referenced 2",
-		},
+			Valid: true,
+		}
 	}
 
-	expMainSnippets := []Snippet{
-		{
-			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextMain, client.ObjectKeyFromObject(validReferenced1.Source)),
-			Contents: "main valid referenced 1",
-		},
-		{
-			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextMain, client.ObjectKeyFromObject(validReferenced2.Source)),
-			Contents: "main valid referenced 2",
+	policyGraph := &graph.Graph{
+		NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+			createKey("tls"):    createPolicy("tls", nil),
+			createKey("tcp"):    createPolicy("tcp", helpers.GetPointer(ngfAPIv1alpha1.ConnectionLimitKeyServer)),
+			createKey("unused"): createPolicy("unused", nil),
 		},
 	}
 
-	expHTTPSnippets := []Snippet{
+	passthroughServers := []Layer4VirtualServer{
+		{ConnectionLimit: &ConnectionLimit{Policy: types.NamespacedName{Namespace: "test", Name: "tls"}}},
+		{},
+	}
+	tcpServers := []Layer4VirtualServer{
+		{ConnectionLimit: &ConnectionLimit{Policy: types.NamespacedName{Namespace: "test", Name: "tcp"}}},
+	}
+
+	g.Expect(buildStreamConnectionLimitZones(policyGraph, nil, nil)).To(BeNil())
+	g.Expect(buildStreamConnectionLimitZones(policyGraph, passthroughServers, tcpServers)).To(Equal([]ConnectionLimitZone{
 		{
-			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextHTTP, client.ObjectKeyFromObject(validReferenced1.Source)),
-			Contents: "http valid referenced 1",
+			Policy: types.NamespacedName{Namespace: "test", Name: "tcp"},
+			Name:   "stream_connlimit_test_tcp",
+			Key:    "$server_addr:$server_port",
+			Size:   "10m",
 		},
 		{
-			Name:     createSnippetName(ngfAPIv1alpha1.NginxContextHTTP, client.ObjectKeyFromObject(validReferenced2.Source)),
-			Contents: "http valid referenced 2",
+			Policy: types.NamespacedName{Namespace: "test", Name: "tls"},
+			Name:   "stream_connlimit_test_tls",
+			Key:    "$binary_remote_addr",
+			Size:   "10m",
 		},
-	}
+	}))
+}
 
-	getSnippetsFilters := func() map[types.NamespacedName]*graph.SnippetsFilter {
-		return map[types.NamespacedName]*graph.SnippetsFilter{
-			client.ObjectKeyFromObject(validUnreferenced.Source):   validUnreferenced,
-			client.ObjectKeyFromObject(invalidUnreferenced.Source): invalidUnreferenced,
-			client.ObjectKeyFromObject(invalidReferenced.Source):   invalidReferenced,
-			client.ObjectKeyFromObject(validReferenced1.Source):    validReferenced1,
-			client.ObjectKeyFromObject(validReferenced2.Source):    validReferenced2,
-			client.ObjectKeyFromObject(validReferenced3.Source):    validReferenced3,
+func TestBuildStreamSettings(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(stream *ngfAPIv1alpha1.ClientStream, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+				Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{Stream: stream},
+			},
+			Valid: valid,
 		}
 	}
 
 	tests := []struct {
-		name            string
-		snippetsFilters map[types.NamespacedName]*graph.SnippetsFilter
-		ctx             ngfAPIv1alpha1.NginxContext
-		expSnippets     []Snippet
+		msg      string
+		gw       *graph.Gateway
+		expected StreamSettings
 	}{
 		{
-			name:            "no snippets filters",
-			snippetsFilters: nil,
-			ctx:             ngfAPIv1alpha1.NginxContextMain,
-			expSnippets:     nil,
+			msg:      "no policies",
+			gw:       &graph.Gateway{},
+			expected: StreamSettings{},
 		},
 		{
-			name:            "main context: mix of invalid, unreferenced, and valid, referenced snippets filters",
-			snippetsFilters: getSnippetsFilters(),
-			ctx:             ngfAPIv1alpha1.NginxContextMain,
-			expSnippets:     expMainSnippets,
+			msg: "policy without stream settings",
+			gw: &graph.Gateway{
+				Policies: []*graph.Policy{createPolicy(nil, true)},
+			},
+			expected: StreamSettings{},
 		},
 		{
-			name:            "http context: mix of invalid, unreferenced, and valid, referenced snippets filters",
-			snippetsFilters: getSnippetsFilters(),
-			ctx:             ngfAPIv1alpha1.NginxContextHTTP,
-			expSnippets:     expHTTPSnippets,
+			msg: "invalid policy",
+			gw: &graph.Gateway{
+				Policies: []*graph.Policy{
+					createPolicy(&ngfAPIv1alpha1.ClientStream{
+						Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10m"),
+					}, false),
+				},
+			},
+			expected: StreamSettings{},
+		},
+		{
+			msg: "settings merged from multiple policies",
+			gw: &graph.Gateway{
+				Policies: []*graph.Policy{
+					createPolicy(&ngfAPIv1alpha1.ClientStream{
+						ConnectTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+						Timeout:        helpers.GetPointer[ngfAPIv1alpha1.Duration]("10m"),
+					}, true),
+					createPolicy(&ngfAPIv1alpha1.ClientStream{
+						PrereadTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+					}, true),
+					{
+						Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{},
+						Valid:  true,
+					},
+				},
+			},
+			expected: StreamSettings{
+				ProxyConnectTimeout: "5s",
+				ProxyTimeout:        "10m",
+				PrereadTimeout:      "10s",
+			},
 		},
 	}
 
 	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
+		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
-
 			g := NewWithT(t)
-			snippets := buildSnippetsForContext(test.snippetsFilters, test.ctx)
-			g.Expect(snippets).To(ConsistOf(test.expSnippets))
+
+			g.Expect(buildStreamSettings(test.gw)).To(Equal(test.expected))
 		})
 	}
 }
 
-func TestBuildAuxiliarySecrets(t *testing.T) {
+func TestBuildMergedGatewayStreamSettings(t *testing.T) {
 	t.Parallel()
+	g := NewWithT(t)
 
-	secrets := map[types.NamespacedName][]graph.PlusSecretFile{
-		{Name: "license", Namespace: "ngf"}: {
-			{
-				Type:    graph.PlusReportJWTToken,
-				Content: []byte("license"),
+	createGateway := func(pols ...*graph.Policy) *graph.Gateway {
+		return &graph.Gateway{Policies: pols, Valid: true}
+	}
+
+	streamPolicy := &graph.Policy{
+		Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+			Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+				Stream: &ngfAPIv1alpha1.ClientStream{
+					ConnectTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+				},
 			},
 		},
-		{Name: "ca", Namespace: "ngf"}: {
-			{
-				Type:    graph.PlusReportCACertificate,
-				Content: []byte("ca"),
+		Valid: true,
+	}
+	bodyPolicy := &graph.Policy{
+		Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+			Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+				Body: &ngfAPIv1alpha1.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("10m"),
+				},
 			},
 		},
-		{Name: "client", Namespace: "ngf"}: {
-			{
-				Type:    graph.PlusReportClientSSLCertificate,
-				Content: []byte("cert"),
-			},
-			{
-				Type:    graph.PlusReportClientSSLKey,
-				Content: []byte("key"),
+		Valid: true,
+	}
+
+	streamGwNsName := types.NamespacedName{Namespace: "test", Name: "stream"}
+
+	testGraph := &graph.Graph{
+		Gateway: createGateway(&graph.Policy{
+			Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+				Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+					Stream: &ngfAPIv1alpha1.ClientStream{
+						Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10m"),
+					},
+				},
 			},
+			Valid: true,
+		}),
+		MergedGateways: map[types.NamespacedName]*graph.Gateway{
+			streamGwNsName:                         createGateway(streamPolicy),
+			{Namespace: "test", Name: "body"}:      createGateway(bodyPolicy),
+			{Namespace: "test", Name: "no-policy"}: createGateway(),
 		},
 	}
-	expSecrets := map[graph.SecretFileType][]byte{
-		graph.PlusReportJWTToken:             []byte("license"),
-		graph.PlusReportCACertificate:        []byte("ca"),
-		graph.PlusReportClientSSLCertificate: []byte("cert"),
-		graph.PlusReportClientSSLKey:         []byte("key"),
-	}
 
+	g.Expect(buildMergedGatewayStreamSettings(testGraph)).To(Equal(map[types.NamespacedName]*StreamSettings{
+		streamGwNsName: {ProxyConnectTimeout: "5s"},
+	}))
+}
+
+func TestBuildStreamingGateways(t *testing.T) {
+	t.Parallel()
 	g := NewWithT(t)
 
-	g.Expect(buildAuxiliarySecrets(secrets)).To(Equal(expSecrets))
-}
+	createGateway := func(name string, streaming bool, pols ...*graph.Policy) *graph.Gateway {
+		return &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			Policies:  pols,
+			Streaming: streaming,
+		}
+	}
 
-func TestBuildNginxPlus(t *testing.T) {
-	defaultNginxPlus := NginxPlus{AllowedAddresses: []string{"127.0.0.1"}}
+	bufferingPolicy := &graph.Policy{
+		Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+			Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{Enable: helpers.GetPointer(true)},
+			},
+		},
+		Valid: true,
+	}
+
+	graphCfg := &graph.Graph{
+		Gateway: createGateway("winner", true),
+		MergedGateways: map[types.NamespacedName]*graph.Gateway{
+			{Namespace: "test", Name: "not-streaming"}: createGateway("not-streaming", false),
+			{Namespace: "test", Name: "policy"}:        createGateway("policy", true, bufferingPolicy),
+			{Namespace: "test", Name: "streaming"}:     createGateway("streaming", true),
+		},
+	}
 
+	g.Expect(buildStreamingGateways(graphCfg)).To(Equal(map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "winner"}:    {},
+		{Namespace: "test", Name: "streaming"}: {},
+	}))
+}
+
+func TestConfiguresBuffering(t *testing.T) {
 	t.Parallel()
+
+	createPolicy := func(buffering *ngfAPIv1alpha1.ProxyBuffering, valid bool) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPIv1alpha1.ClientSettingsPolicy{
+				Spec: ngfAPIv1alpha1.ClientSettingsPolicySpec{Buffering: buffering},
+			},
+			Valid: valid,
+		}
+	}
+
 	tests := []struct {
-		msg          string
-		g            *graph.Graph
-		expNginxPlus NginxPlus
+		msg      string
+		pols     []*graph.Policy
+		expected bool
 	}{
 		{
-			msg:          "NginxProxy is nil",
-			g:            &graph.Graph{},
-			expNginxPlus: defaultNginxPlus,
+			msg: "no policies",
 		},
 		{
-			msg: "NginxPlus default values are used when NginxProxy doesn't specify NginxPlus settings",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{},
-					},
-				},
-			},
-			expNginxPlus: defaultNginxPlus,
+			msg:  "policy without buffering",
+			pols: []*graph.Policy{createPolicy(nil, true)},
 		},
 		{
-			msg: "NginxProxy specifies one allowed address",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
-								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
-									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
-								},
-							},
-						},
-					},
-				},
+			msg: "policy without buffering enable",
+			pols: []*graph.Policy{
+				createPolicy(&ngfAPIv1alpha1.ProxyBuffering{
+					BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("8k"),
+				}, true),
 			},
-			expNginxPlus: NginxPlus{AllowedAddresses: []string{"127.0.0.3"}},
 		},
 		{
-			msg: "NginxProxy specifies multiple allowed addresses",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
-								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
-									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
-									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "25.0.0.3"},
-								},
-							},
-						},
-					},
-				},
-			},
-			expNginxPlus: NginxPlus{AllowedAddresses: []string{"127.0.0.3", "25.0.0.3"}},
+			msg:  "invalid policy",
+			pols: []*graph.Policy{createPolicy(&ngfAPIv1alpha1.ProxyBuffering{Enable: helpers.GetPointer(false)}, false)},
 		},
 		{
-			msg: "NginxProxy specifies 127.0.0.1 as allowed address",
-			g: &graph.Graph{
-				NginxProxy: &graph.NginxProxy{
-					Valid: true,
-					Source: &ngfAPIv1alpha1.NginxProxy{
-						Spec: ngfAPIv1alpha1.NginxProxySpec{
-							NginxPlus: &ngfAPIv1alpha1.NginxPlus{
-								AllowedAddresses: []ngfAPIv1alpha1.NginxPlusAllowAddress{
-									{Type: ngfAPIv1alpha1.NginxPlusAllowIPAddressType, Value: "127.0.0.1"},
-								},
-							},
-						},
-					},
-				},
+			msg: "policy with buffering enable",
+			pols: []*graph.Policy{
+				{Source: &ngfAPIv1alpha1.ConnectionLimitPolicy{}, Valid: true},
+				createPolicy(&ngfAPIv1alpha1.ProxyBuffering{Enable: helpers.GetPointer(false)}, true),
 			},
-			expNginxPlus: defaultNginxPlus,
+			expected: true,
 		},
 	}
 
-	for _, tc := range tests {
-		t.Run(tc.msg, func(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			g.Expect(buildNginxPlus(tc.g)).To(Equal(tc.expNginxPlus))
+			g.Expect(configuresBuffering(test.pols)).To(Equal(test.expected))
 		})
 	}
 }
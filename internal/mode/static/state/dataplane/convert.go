@@ -2,7 +2,10 @@ package dataplane
 
 import (
 	"fmt"
+	"math"
+	"time"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -60,6 +63,124 @@ func convertHTTPURLRewriteFilter(filter *v1.HTTPURLRewriteFilter) *HTTPURLRewrit
 	}
 }
 
+// convertHTTPRequestMirrorFilter returns nil if no requests are mirrored, because the percentage is zero.
+func convertHTTPRequestMirrorFilter(
+	filter *v1.HTTPRequestMirrorFilter,
+	backendRef graph.BackendRef,
+) *HTTPRequestMirrorFilter {
+	result := &HTTPRequestMirrorFilter{
+		UpstreamName: backendRef.ServicePortReference(),
+		VerifyTLS:    convertBackendTLS(backendRef.BackendTLSPolicy),
+	}
+
+	var percent float64
+
+	switch {
+	case filter.Percent != nil:
+		percent = float64(*filter.Percent)
+	case filter.Fraction != nil:
+		denominator := int32(100)
+		if filter.Fraction.Denominator != nil {
+			denominator = *filter.Fraction.Denominator
+		}
+
+		percent = float64(filter.Fraction.Numerator) * 100 / float64(denominator)
+	default:
+		return result
+	}
+
+	// Floor is used so that the percentage is never rounded up to mirror more requests than configured.
+	percent = math.Floor(percent*100) / 100
+
+	switch {
+	case percent <= 0:
+		return nil
+	case percent < 100:
+		result.Percent = &percent
+	}
+
+	return result
+}
+
+// convertHTTPRouteTimeouts converts the timeouts to milliseconds, which NGINX understands.
+// A timeout of zero disables the timeout in Gateway API. NGINX doesn't support disabling proxy timeouts,
+// so zero timeouts are left unset, and the NGINX defaults are used.
+// Returns nil if none of the timeouts are set.
+func convertHTTPRouteTimeouts(timeouts *v1.HTTPRouteTimeouts) *HTTPTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	result := &HTTPTimeouts{
+		Request:        convertDuration(timeouts.Request),
+		BackendRequest: convertDuration(timeouts.BackendRequest),
+	}
+
+	if result.Request == "" && result.BackendRequest == "" {
+		return nil
+	}
+
+	return result
+}
+
+// convertHTTPRouteRetry converts the retry into the NGINX retry configuration.
+// As required by Gateway API, a request is always retried on connection errors and timeouts.
+// If the request timeout is set, it limits the time of all retries of a request.
+// Returns nil if the retry is not set.
+func convertHTTPRouteRetry(retry *v1.HTTPRouteRetry, timeouts *v1.HTTPRouteTimeouts) *HTTPRetry {
+	if retry == nil {
+		return nil
+	}
+
+	if retry.Attempts != nil && *retry.Attempts == 0 {
+		return &HTTPRetry{Conditions: []string{"off"}}
+	}
+
+	conditions := make([]string, 0, 2+len(retry.Codes))
+	conditions = append(conditions, "error", "timeout")
+
+	seen := make(map[v1.HTTPRouteRetryStatusCode]struct{}, len(retry.Codes))
+	for _, code := range retry.Codes {
+		if _, exists := seen[code]; exists {
+			continue
+		}
+		seen[code] = struct{}{}
+
+		conditions = append(conditions, fmt.Sprintf("http_%d", code))
+	}
+
+	result := &HTTPRetry{
+		Conditions: conditions,
+	}
+
+	if retry.Attempts != nil {
+		// the first try is not a retry
+		result.Tries = *retry.Attempts + 1
+	}
+
+	if timeouts != nil {
+		result.Timeout = convertDuration(timeouts.Request)
+	}
+
+	return result
+}
+
+// convertDuration converts a valid Gateway API Duration to milliseconds.
+// Returns an empty string if the duration is nil or zero.
+func convertDuration(duration *v1.Duration) string {
+	if duration == nil {
+		return ""
+	}
+
+	// the duration is validated in the graph package
+	d, err := time.ParseDuration(string(*duration))
+	if err != nil || d == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%dms", d.Milliseconds())
+}
+
 func convertHTTPHeaderFilter(filter *v1.HTTPHeaderFilter) *HTTPHeaderFilter {
 	result := &HTTPHeaderFilter{
 		Remove: filter.Remove,
@@ -82,12 +203,25 @@ func convertHTTPHeaderFilter(filter *v1.HTTPHeaderFilter) *HTTPHeaderFilter {
 	return result
 }
 
+func convertTrailingSlashMode(mode graph.TrailingSlashMode) TrailingSlashMode {
+	switch mode {
+	case graph.TrailingSlashModeRedirect:
+		return TrailingSlashModeRedirect
+	case graph.TrailingSlashModeNormalize:
+		return TrailingSlashModeNormalize
+	default:
+		return ""
+	}
+}
+
 func convertPathType(pathType v1.PathMatchType) PathType {
 	switch pathType {
 	case v1.PathMatchPathPrefix:
 		return PathTypePrefix
 	case v1.PathMatchExact:
 		return PathTypeExact
+	case v1.PathMatchRegularExpression:
+		return PathTypeRegularExpression
 	default:
 		panic(fmt.Sprintf("unsupported path type: %s", pathType))
 	}
@@ -145,3 +279,89 @@ func convertSnippetsFilter(filter *graph.SnippetsFilter) SnippetsFilter {
 
 	return result
 }
+
+func convertDebugCapture(capture *graph.DebugCapture, route types.NamespacedName) *DebugCapture {
+	if capture == nil {
+		return nil
+	}
+
+	return &DebugCapture{
+		Route:    route,
+		Requests: capture.Requests,
+		Expires:  capture.Expires,
+	}
+}
+
+func convertDirectResponse(dr *graph.DirectResponse) *DirectResponse {
+	spec := dr.Source.Spec
+
+	result := &DirectResponse{
+		StatusCode:  int(spec.StatusCode),
+		ContentType: defaultDirectResponseContentType,
+	}
+
+	if spec.Body != nil {
+		result.Body = *spec.Body
+	}
+
+	if spec.ContentType != nil {
+		result.ContentType = *spec.ContentType
+	}
+
+	return result
+}
+
+func convertHTTPBodyTransform(bt *graph.HTTPBodyTransform) *BodyTransform {
+	nsname := client.ObjectKeyFromObject(bt.Source)
+
+	result := &BodyTransform{
+		Source:              nsname,
+		ID:                  bodyTransformID(nsname),
+		MaxRequestBodySize:  defaultMaxRequestBodySize,
+		MaxResponseBodySize: defaultMaxResponseBodySize,
+	}
+
+	if request := bt.Source.Spec.Request; request != nil {
+		result.RequestScript = string(request.Script)
+
+		if size, ok := sizeInBytes(request.MaxBodySize); ok {
+			result.MaxRequestBodySize = size
+		}
+	}
+
+	if response := bt.Source.Spec.Response; response != nil {
+		result.ResponseScript = string(response.Script)
+
+		if size, ok := sizeInBytes(response.MaxBodySize); ok {
+			result.MaxResponseBodySize = size
+		}
+	}
+
+	return result
+}
+
+func convertSessionPersistence(blp *graph.BackendLBPolicy) *SessionPersistence {
+	if blp == nil || !blp.Valid || blp.Source.Spec.SessionPersistence == nil {
+		return nil
+	}
+
+	sp := blp.Source.Spec.SessionPersistence
+
+	result := &SessionPersistence{
+		Name: graph.DefaultSessionName,
+	}
+
+	if sp.SessionName != nil {
+		result.Name = *sp.SessionName
+	}
+
+	// the duration is validated in the graph package
+	if sp.AbsoluteTimeout != nil {
+		if d, err := time.ParseDuration(string(*sp.AbsoluteTimeout)); err == nil {
+			// round up so that sub-second timeouts don't turn into a session cookie
+			result.Expiry = int64((d + time.Second - 1) / time.Second)
+		}
+	}
+
+	return result
+}
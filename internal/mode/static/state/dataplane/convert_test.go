@@ -2,11 +2,16 @@ package dataplane
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 func TestConvertMatch(t *testing.T) {
@@ -366,6 +371,15 @@ func TestConvertHTTPHeaderFilter(t *testing.T) {
 	}
 }
 
+func TestConvertTrailingSlashMode(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(convertTrailingSlashMode(graph.TrailingSlashModeRedirect)).To(Equal(TrailingSlashModeRedirect))
+	g.Expect(convertTrailingSlashMode(graph.TrailingSlashModeNormalize)).To(Equal(TrailingSlashModeNormalize))
+	g.Expect(convertTrailingSlashMode("")).To(BeEmpty())
+}
+
 func TestConvertPathType(t *testing.T) {
 	t.Parallel()
 
@@ -383,7 +397,11 @@ func TestConvertPathType(t *testing.T) {
 			pathType: v1.PathMatchExact,
 		},
 		{
+			expected: PathTypeRegularExpression,
 			pathType: v1.PathMatchRegularExpression,
+		},
+		{
+			pathType: v1.PathMatchType("Unsupported"),
 			panic:    true,
 		},
 	}
@@ -450,3 +468,319 @@ func TestConvertMatchType(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertHTTPRequestMirrorFilter(t *testing.T) {
+	t.Parallel()
+
+	backendRef := graph.BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "mirror"},
+		ServicePort: apiv1.ServicePort{Port: 80},
+		Valid:       true,
+	}
+
+	tests := []struct {
+		filter   *v1.HTTPRequestMirrorFilter
+		expected *HTTPRequestMirrorFilter
+		name     string
+	}{
+		{
+			filter: &v1.HTTPRequestMirrorFilter{},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+			},
+			name: "no percentage",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](30),
+			},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+				Percent:      helpers.GetPointer(30.0),
+			},
+			name: "percent",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](100),
+			},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+			},
+			name: "100 percent",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](0),
+			},
+			expected: nil,
+			name:     "0 percent",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{
+					Numerator:   2,
+					Denominator: helpers.GetPointer[int32](3),
+				},
+			},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+				Percent:      helpers.GetPointer(66.66),
+			},
+			name: "fraction",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{
+					Numerator: 5,
+				},
+			},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+				Percent:      helpers.GetPointer(5.0),
+			},
+			name: "fraction with default denominator",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{
+					Numerator:   1,
+					Denominator: helpers.GetPointer[int32](1),
+				},
+			},
+			expected: &HTTPRequestMirrorFilter{
+				UpstreamName: "test_mirror_80",
+			},
+			name: "fraction of all requests",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result := convertHTTPRequestMirrorFilter(test.filter, backendRef)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertHTTPRouteTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *v1.HTTPRouteTimeouts
+		expected *HTTPTimeouts
+		name     string
+	}{
+		{
+			timeouts: nil,
+			expected: nil,
+			name:     "nil timeouts",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{},
+			expected: nil,
+			name:     "no timeouts",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[v1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[v1.Duration]("0ms"),
+			},
+			expected: nil,
+			name:     "zero timeouts",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request: helpers.GetPointer[v1.Duration]("1h30m"),
+			},
+			expected: &HTTPTimeouts{
+				Request: "5400000ms",
+			},
+			name: "request timeout",
+		},
+		{
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[v1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[v1.Duration]("1s500ms"),
+			},
+			expected: &HTTPTimeouts{
+				BackendRequest: "1500ms",
+			},
+			name: "disabled request timeout and backendRequest timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result := convertHTTPRouteTimeouts(test.timeouts)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertHTTPRouteRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		retry    *v1.HTTPRouteRetry
+		timeouts *v1.HTTPRouteTimeouts
+		expected *HTTPRetry
+		name     string
+	}{
+		{
+			retry:    nil,
+			expected: nil,
+			name:     "nil retry",
+		},
+		{
+			retry: &v1.HTTPRouteRetry{},
+			expected: &HTTPRetry{
+				Conditions: []string{"error", "timeout"},
+			},
+			name: "empty retry",
+		},
+		{
+			retry: &v1.HTTPRouteRetry{
+				Attempts: helpers.GetPointer(0),
+				Codes:    []v1.HTTPRouteRetryStatusCode{503},
+			},
+			expected: &HTTPRetry{
+				Conditions: []string{"off"},
+			},
+			name: "zero attempts",
+		},
+		{
+			retry: &v1.HTTPRouteRetry{
+				Codes:    []v1.HTTPRouteRetryStatusCode{503, 500, 503},
+				Attempts: helpers.GetPointer(2),
+			},
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[v1.Duration]("10s"),
+				BackendRequest: helpers.GetPointer[v1.Duration]("1s"),
+			},
+			expected: &HTTPRetry{
+				Conditions: []string{"error", "timeout", "http_503", "http_500"},
+				Tries:      3,
+				Timeout:    "10000ms",
+			},
+			name: "codes, attempts, and request timeout",
+		},
+		{
+			retry: &v1.HTTPRouteRetry{
+				Codes: []v1.HTTPRouteRetryStatusCode{502},
+			},
+			timeouts: &v1.HTTPRouteTimeouts{
+				Request: helpers.GetPointer[v1.Duration]("0s"),
+			},
+			expected: &HTTPRetry{
+				Conditions: []string{"error", "timeout", "http_502"},
+			},
+			name: "disabled request timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result := convertHTTPRouteRetry(test.retry, test.timeouts)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertSessionPersistence(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(valid bool, sp *v1.SessionPersistence) *graph.BackendLBPolicy {
+		return &graph.BackendLBPolicy{
+			Source: &v1alpha2.BackendLBPolicy{
+				Spec: v1alpha2.BackendLBPolicySpec{
+					SessionPersistence: sp,
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	tests := []struct {
+		policy   *graph.BackendLBPolicy
+		expected *SessionPersistence
+		name     string
+	}{
+		{
+			policy:   nil,
+			expected: nil,
+			name:     "nil policy",
+		},
+		{
+			policy:   createPolicy(false, &v1.SessionPersistence{}),
+			expected: nil,
+			name:     "invalid policy",
+		},
+		{
+			policy:   createPolicy(true, nil),
+			expected: nil,
+			name:     "no session persistence",
+		},
+		{
+			policy: createPolicy(true, &v1.SessionPersistence{}),
+			expected: &SessionPersistence{
+				Name: graph.DefaultSessionName,
+			},
+			name: "default session name",
+		},
+		{
+			policy: createPolicy(true, &v1.SessionPersistence{
+				SessionName:     helpers.GetPointer("session"),
+				AbsoluteTimeout: helpers.GetPointer[v1.Duration]("1h"),
+			}),
+			expected: &SessionPersistence{
+				Name:   "session",
+				Expiry: 3600,
+			},
+			name: "session name and absolute timeout",
+		},
+		{
+			policy: createPolicy(true, &v1.SessionPersistence{
+				AbsoluteTimeout: helpers.GetPointer[v1.Duration]("1500ms"),
+			}),
+			expected: &SessionPersistence{
+				Name:   graph.DefaultSessionName,
+				Expiry: 2,
+			},
+			name: "absolute timeout is rounded up to seconds",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertSessionPersistence(test.policy)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertDebugCapture(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	route := types.NamespacedName{Namespace: "test", Name: "route"}
+	expires := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	g.Expect(convertDebugCapture(nil, route)).To(BeNil())
+	g.Expect(convertDebugCapture(&graph.DebugCapture{Requests: 10, Expires: expires}, route)).To(Equal(&DebugCapture{
+		Route:    route,
+		Requests: 10,
+		Expires:  expires,
+	}))
+}
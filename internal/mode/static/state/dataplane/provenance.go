@@ -0,0 +1,464 @@
+package dataplane
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// Provenance maps the NGINX directives of the configuration to the objects that set them. It is keyed by
+// the context of the directives, for example, "main", "http", "server cafe.example.com:80" or
+// "location cafe.example.com:80 /coffee".
+type Provenance map[string][]DirectiveProvenance
+
+// DirectiveProvenance records the object that sets an NGINX directive.
+type DirectiveProvenance struct {
+	// Source is the object that sets the directive. Nil if the directive has the default value.
+	Source *ProvenanceSource `json:"source,omitempty"`
+	// Directive is the name of the directive.
+	Directive string `json:"directive"`
+	// Value is the value of the directive.
+	Value string `json:"value"`
+}
+
+// ProvenanceSource is the object that sets an NGINX directive.
+type ProvenanceSource struct {
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object. Empty for the cluster-scoped objects.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// Field is the setting of the object that sets the directive. Only set for the NginxProxies,
+	// which are merged setting by setting.
+	Field string `json:"field,omitempty"`
+}
+
+const (
+	// mainContext is the context of the directives in the main context of the NGINX configuration.
+	mainContext = "main"
+	// httpContext is the context of the directives in the http context of the NGINX configuration.
+	httpContext = "http"
+)
+
+// nginxProxyDirective is an NGINX directive that is configured by a setting of the NginxProxy.
+type nginxProxyDirective struct {
+	// values returns the values of the directive in the configuration. If nil, the directive is not
+	// in the configuration.
+	values    func(conf Configuration) []string
+	context   string
+	directive string
+	// field is the setting of the NginxProxy, in the form of the effective values of the NginxProxy status.
+	field string
+}
+
+// nginxProxyDirectives are the directives of the main and http contexts that the NginxProxy configures.
+var nginxProxyDirectives = []nginxProxyDirective{
+	{
+		context:   mainContext,
+		directive: "error_log",
+		field:     "logging",
+		values: func(conf Configuration) []string {
+			return []string{"stderr " + conf.Logging.ErrorLevel}
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "http2",
+		field:     "disableHTTP2",
+		values: func(conf Configuration) []string {
+			return valueIf(conf.BaseHTTPConfig.HTTP2, "on")
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "http2_max_concurrent_streams",
+		field:     "http2",
+		values: func(conf Configuration) []string {
+			settings := conf.BaseHTTPConfig.HTTP2Settings
+			return valueIf(settings != nil && settings.MaxConcurrentStreams != 0, func() string {
+				return fmt.Sprint(settings.MaxConcurrentStreams)
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "http2_recv_buffer_size",
+		field:     "http2",
+		values: func(conf Configuration) []string {
+			settings := conf.BaseHTTPConfig.HTTP2Settings
+			return valueIf(settings != nil && settings.RecvBufferSize != "", func() string {
+				return settings.RecvBufferSize
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "http2_chunk_size",
+		field:     "http2",
+		values: func(conf Configuration) []string {
+			settings := conf.BaseHTTPConfig.HTTP2Settings
+			return valueIf(settings != nil && settings.ChunkSize != "", func() string {
+				return settings.ChunkSize
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "http2_body_preread_size",
+		field:     "http2",
+		values: func(conf Configuration) []string {
+			settings := conf.BaseHTTPConfig.HTTP2Settings
+			return valueIf(settings != nil && settings.BodyPrereadSize != "", func() string {
+				return settings.BodyPrereadSize
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "server_names_hash_max_size",
+		field:     "hashTables",
+		values: func(conf Configuration) []string {
+			return valueIf(conf.HashSizes.ServerNamesMaxSize != 0, func() string {
+				return fmt.Sprint(conf.HashSizes.ServerNamesMaxSize)
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "server_names_hash_bucket_size",
+		field:     "hashTables",
+		values: func(conf Configuration) []string {
+			return valueIf(conf.HashSizes.ServerNamesBucketSize != 0, func() string {
+				return fmt.Sprint(conf.HashSizes.ServerNamesBucketSize)
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "map_hash_max_size",
+		field:     "hashTables",
+		values: func(conf Configuration) []string {
+			return valueIf(conf.HashSizes.MapMaxSize != 0, func() string {
+				return fmt.Sprint(conf.HashSizes.MapMaxSize)
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "map_hash_bucket_size",
+		field:     "hashTables",
+		values: func(conf Configuration) []string {
+			return valueIf(conf.HashSizes.MapBucketSize != 0, func() string {
+				return fmt.Sprint(conf.HashSizes.MapBucketSize)
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_protocols",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return strings.Join(tls.Protocols, " ") })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_ciphers",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return tls.Ciphers })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_prefer_server_ciphers",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return tls.PreferServerCiphers })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_session_cache",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return tls.SessionCache })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_session_timeout",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return tls.SessionTimeout })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ssl_session_tickets",
+		field:     "tls",
+		values: func(conf Configuration) []string {
+			return tlsValue(conf, func(tls *TLSSettings) string { return tls.SessionTickets })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "client_header_timeout",
+		field:     "hardening.clientHeaderTimeout",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil, func() string { return hardening.ClientHeaderTimeout })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "client_body_timeout",
+		field:     "hardening.clientBodyTimeout",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil, func() string { return hardening.ClientBodyTimeout })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "send_timeout",
+		field:     "hardening.sendTimeout",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil, func() string { return hardening.SendTimeout })
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "reset_timedout_connection",
+		field:     "hardening.resetTimedOutConnection",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil, func() string {
+				if hardening.ResetTimedOutConnection {
+					return "on"
+				}
+
+				return "off"
+			})
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "ignore_invalid_headers",
+		field:     "hardening.requestSmugglingProtection",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil && hardening.RequestSmugglingProtection, "on")
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "underscores_in_headers",
+		field:     "hardening.requestSmugglingProtection",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil && hardening.RequestSmugglingProtection, "off")
+		},
+	},
+	{
+		context:   httpContext,
+		directive: "proxy_request_buffering",
+		field:     "hardening.requestSmugglingProtection",
+		values: func(conf Configuration) []string {
+			hardening := conf.BaseHTTPConfig.Hardening
+			return valueIf(hardening != nil && hardening.RequestSmugglingProtection, "on")
+		},
+	},
+}
+
+// valueIf returns the value if the condition is true. The value is either a string or a function that returns
+// the string, so that the value is only computed if the condition is true.
+func valueIf[T string | func() string](cond bool, value T) []string {
+	if !cond {
+		return nil
+	}
+
+	switch v := any(value).(type) {
+	case string:
+		return []string{v}
+	case func() string:
+		return []string{v()}
+	}
+
+	return nil
+}
+
+// tlsValue returns the value of a TLS setting of the SSL servers, if the setting is set.
+func tlsValue(conf Configuration, get func(*TLSSettings) string) []string {
+	tls := conf.BaseHTTPConfig.TLS
+	if tls == nil {
+		return nil
+	}
+
+	value := get(tls)
+
+	return valueIf(value != "", value)
+}
+
+// BuildProvenance returns the provenance of the directives of the configuration that the NginxProxies,
+// their defaults and the NGF Policies of the servers and the locations configure. The directives of the policies
+// are generated by the policy generator, so that they match the generated configuration. The policies of
+// the upstreams are not included.
+func BuildProvenance(conf Configuration, g *graph.Graph, generator policies.Generator) Provenance {
+	provenance := make(Provenance)
+
+	sources := getNginxProxySources(g)
+
+	for _, d := range nginxProxyDirectives {
+		var source *ProvenanceSource
+		if name, exists := sources[d.field]; exists {
+			source = &ProvenanceSource{
+				Kind:  kinds.NginxProxy,
+				Name:  name,
+				Field: d.field,
+			}
+		}
+
+		for _, value := range d.values(conf) {
+			provenance[d.context] = append(provenance[d.context], DirectiveProvenance{
+				Source:    source,
+				Directive: d.directive,
+				Value:     value,
+			})
+		}
+	}
+
+	for _, servers := range [][]VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, server := range servers {
+			if !server.IsDefault {
+				addServerProvenance(provenance, server, generator)
+			}
+		}
+	}
+
+	return provenance
+}
+
+// getNginxProxySources returns the NginxProxy that provides the effective value of every setting of
+// the NginxProxy that configures the data plane. The NginxProxy of the Gateway and its bases override
+// the NginxProxy of the GatewayClass and its bases.
+func getNginxProxySources(g *graph.Graph) map[string]string {
+	sources := make(map[string]string)
+
+	add := func(np *graph.NginxProxy) {
+		if np == nil || !np.Valid {
+			return
+		}
+
+		for _, v := range np.EffectiveValues {
+			if _, exists := sources[v.Field]; !exists {
+				sources[v.Field] = v.Source
+			}
+		}
+	}
+
+	if g.Gateway != nil {
+		add(g.Gateway.NginxProxy)
+	}
+
+	if g.GatewayClass != nil {
+		if ref := g.GatewayClass.Source.Spec.ParametersRef; ref != nil && ref.Group == ngfAPI.GroupName &&
+			ref.Kind == kinds.NginxProxy {
+			add(g.ReferencedNginxProxies[types.NamespacedName{Name: ref.Name}])
+		}
+	}
+
+	return sources
+}
+
+func addServerProvenance(provenance Provenance, server VirtualServer, generator policies.Generator) {
+	serverContext := fmt.Sprintf("server %s:%d", server.Hostname, server.Port)
+
+	httpServer := http.Server{ServerName: server.Hostname}
+	if server.SSL != nil {
+		httpServer.SSL = &http.SSL{}
+	}
+
+	for _, pol := range server.Policies {
+		files := generator.GenerateForServer([]policies.Policy{pol}, httpServer)
+		provenance[serverContext] = append(provenance[serverContext], getPolicyDirectives(pol, files)...)
+	}
+
+	for _, rule := range server.PathRules {
+		location := http.Location{
+			Path: rule.Path,
+			Type: http.ExternalLocationType,
+		}
+
+		locationContext := fmt.Sprintf("location %s:%d %s", server.Hostname, server.Port, getLocationPath(rule))
+
+		for _, pol := range rule.Policies {
+			files := generator.GenerateForLocation([]policies.Policy{pol}, location)
+			provenance[locationContext] = append(provenance[locationContext], getPolicyDirectives(pol, files)...)
+		}
+	}
+}
+
+// getLocationPath returns the path of the location of the PathRule with the modifier of its type,
+// like in the NGINX configuration.
+func getLocationPath(rule PathRule) string {
+	switch rule.PathType {
+	case PathTypeExact:
+		return "= " + rule.Path
+	case PathTypeRegularExpression:
+		return "~ " + rule.Path
+	default:
+		return rule.Path
+	}
+}
+
+// getPolicyDirectives returns the directives of the files generated for the policy.
+func getPolicyDirectives(pol policies.Policy, files policies.GenerateResultFiles) []DirectiveProvenance {
+	source := &ProvenanceSource{
+		Kind:      getObjectKind(pol),
+		Namespace: pol.GetNamespace(),
+		Name:      pol.GetName(),
+	}
+
+	var directives []DirectiveProvenance
+
+	for _, f := range files {
+		for _, line := range strings.Split(string(f.Content), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") || !strings.HasSuffix(line, ";") {
+				continue
+			}
+
+			directive, value, _ := strings.Cut(strings.TrimSuffix(line, ";"), " ")
+
+			directives = append(directives, DirectiveProvenance{
+				Source:    source,
+				Directive: directive,
+				Value:     strings.TrimSpace(value),
+			})
+		}
+	}
+
+	return directives
+}
+
+// getObjectKind returns the kind of the object. The type meta of the typed objects is usually empty,
+// in which case the kind is the name of the type.
+func getObjectKind(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -0,0 +1,227 @@
+package dataplane
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestBuildProvenance(t *testing.T) {
+	t.Parallel()
+
+	serverPolicy := createFakePolicy("server-policy", "ClientSettingsPolicy")
+	locationPolicy := createFakePolicy("location-policy", "ObservabilityPolicy")
+
+	generator := &policiesfakes.FakeGenerator{
+		GenerateForServerStub: func([]policies.Policy, http.Server) policies.GenerateResultFiles {
+			return policies.GenerateResultFiles{
+				{
+					Name:    "server.conf",
+					Content: []byte("# ClientSettingsPolicy default/server-policy\nclient_max_body_size 10m;\n"),
+				},
+			}
+		},
+		GenerateForLocationStub: func([]policies.Policy, http.Location) policies.GenerateResultFiles {
+			return policies.GenerateResultFiles{
+				{
+					Name:    "location.conf",
+					Content: []byte("otel_trace on;\n    otel_span_name \"coffee\";\nif ($a) {\n"),
+				},
+			}
+		},
+	}
+
+	conf := Configuration{
+		Logging: Logging{ErrorLevel: "warn"},
+		BaseHTTPConfig: BaseHTTPConfig{
+			HTTP2: true,
+			Hardening: &Hardening{
+				ClientHeaderTimeout:        "10s",
+				ClientBodyTimeout:          "20s",
+				SendTimeout:                "30s",
+				RequestSmugglingProtection: true,
+			},
+		},
+		HTTPServers: []VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+				Policies:  []policies.Policy{serverPolicy},
+			},
+			{
+				Hostname: "cafe.example.com",
+				Port:     80,
+				Policies: []policies.Policy{serverPolicy},
+				PathRules: []PathRule{
+					{
+						Path:     "/coffee",
+						PathType: PathTypeExact,
+						Policies: []policies.Policy{locationPolicy},
+					},
+					{
+						Path:     "/tea",
+						PathType: PathTypePrefix,
+					},
+				},
+			},
+		},
+	}
+
+	gatewayNP := &graph.NginxProxy{
+		Valid: true,
+		EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+			{Field: "hardening.clientHeaderTimeout", Source: "gateway-np"},
+			{Field: "logging", Source: "gateway-base-np"},
+		},
+	}
+
+	gcNP := &graph.NginxProxy{
+		Valid: true,
+		EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+			{Field: "hardening.clientBodyTimeout", Source: "gc-np"},
+			{Field: "logging", Source: "gc-np"},
+		},
+	}
+
+	gr := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{
+			Source: &v1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+				Spec: v1.GatewayClassSpec{
+					ParametersRef: &v1.ParametersReference{
+						Group: ngfAPI.GroupName,
+						Kind:  kinds.NginxProxy,
+						Name:  "gc-np",
+					},
+				},
+			},
+			Valid: true,
+		},
+		Gateway: &graph.Gateway{
+			NginxProxy: gatewayNP,
+		},
+		ReferencedNginxProxies: map[types.NamespacedName]*graph.NginxProxy{
+			{Name: "gc-np"}: gcNP,
+		},
+	}
+
+	nginxProxySource := func(name, field string) *ProvenanceSource {
+		return &ProvenanceSource{Kind: kinds.NginxProxy, Name: name, Field: field}
+	}
+
+	serverSource := &ProvenanceSource{Kind: "ClientSettingsPolicy", Namespace: "default", Name: "server-policy"}
+	locationSource := &ProvenanceSource{Kind: "ObservabilityPolicy", Namespace: "default", Name: "location-policy"}
+
+	expProvenance := Provenance{
+		mainContext: {
+			{
+				Source:    nginxProxySource("gateway-base-np", "logging"),
+				Directive: "error_log",
+				Value:     "stderr warn",
+			},
+		},
+		httpContext: {
+			{Directive: "http2", Value: "on"},
+			{
+				Source:    nginxProxySource("gateway-np", "hardening.clientHeaderTimeout"),
+				Directive: "client_header_timeout",
+				Value:     "10s",
+			},
+			{
+				Source:    nginxProxySource("gc-np", "hardening.clientBodyTimeout"),
+				Directive: "client_body_timeout",
+				Value:     "20s",
+			},
+			{Directive: "send_timeout", Value: "30s"},
+			{Directive: "reset_timedout_connection", Value: "off"},
+			{Directive: "ignore_invalid_headers", Value: "on"},
+			{Directive: "underscores_in_headers", Value: "off"},
+			{Directive: "proxy_request_buffering", Value: "on"},
+		},
+		"server cafe.example.com:80": {
+			{Source: serverSource, Directive: "client_max_body_size", Value: "10m"},
+		},
+		"location cafe.example.com:80 = /coffee": {
+			{Source: locationSource, Directive: "otel_trace", Value: "on"},
+			{Source: locationSource, Directive: "otel_span_name", Value: `"coffee"`},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(BuildProvenance(conf, gr, generator)).To(Equal(expProvenance))
+
+	g.Expect(generator.GenerateForServerCallCount()).To(Equal(1))
+	pols, server := generator.GenerateForServerArgsForCall(0)
+	g.Expect(pols).To(Equal([]policies.Policy{serverPolicy}))
+	g.Expect(server).To(Equal(http.Server{ServerName: "cafe.example.com"}))
+
+	g.Expect(generator.GenerateForLocationCallCount()).To(Equal(1))
+	pols, location := generator.GenerateForLocationArgsForCall(0)
+	g.Expect(pols).To(Equal([]policies.Policy{locationPolicy}))
+	g.Expect(location).To(Equal(http.Location{Path: "/coffee", Type: http.ExternalLocationType}))
+}
+
+func TestBuildProvenance_Defaults(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := Configuration{
+		Logging: Logging{ErrorLevel: defaultErrorLogLevel},
+		HashSizes: HashSizes{
+			ServerNamesMaxSize: 1024,
+		},
+		BaseHTTPConfig: BaseHTTPConfig{
+			TLS: &TLSSettings{
+				Protocols:      []string{"TLSv1.2", "TLSv1.3"},
+				Ciphers:        "HIGH:!aNULL",
+				SessionTickets: "off",
+			},
+		},
+	}
+
+	invalidNP := &graph.NginxProxy{
+		EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+			{Field: "logging", Source: "invalid-np"},
+		},
+	}
+
+	provenance := BuildProvenance(
+		conf,
+		&graph.Graph{Gateway: &graph.Gateway{NginxProxy: invalidNP}},
+		&policiesfakes.FakeGenerator{},
+	)
+
+	g.Expect(provenance).To(Equal(Provenance{
+		mainContext: {
+			{Directive: "error_log", Value: "stderr info"},
+		},
+		httpContext: {
+			{Directive: "server_names_hash_max_size", Value: "1024"},
+			{Directive: "ssl_protocols", Value: "TLSv1.2 TLSv1.3"},
+			{Directive: "ssl_ciphers", Value: "HIGH:!aNULL"},
+			{Directive: "ssl_session_tickets", Value: "off"},
+		},
+	}))
+}
+
+func TestGetObjectKind(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(getObjectKind(createFakePolicy("policy", "RateLimitPolicy"))).To(Equal("RateLimitPolicy"))
+	g.Expect(getObjectKind(&ngfAPI.ClientSettingsPolicy{})).To(Equal("ClientSettingsPolicy"))
+	g.Expect(getObjectKind(&ngfAPI.ClientSettingsPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "Other"},
+	})).To(Equal("Other"))
+}
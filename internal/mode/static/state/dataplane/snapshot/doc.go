@@ -0,0 +1,12 @@
+/*
+Package snapshot serializes the intermediate representation of the data plane configuration into a versioned JSON
+schema, which external tooling, like diff viewers and policy checkers, can consume.
+
+The types of the dataplane package change whenever the generated NGINX configuration changes, so they are not
+serialized directly. Instead, the configuration is converted into the types of this package, which define
+the schema of the version SchemaVersion. Within a version, fields are only ever added; removing, renaming or
+changing the meaning of a field requires a new version.
+
+The schema doesn't include the contents of Secrets, like the private keys of the SSL key pairs.
+*/
+package snapshot
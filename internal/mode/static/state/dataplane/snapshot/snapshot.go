@@ -0,0 +1,281 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// Build builds the Snapshot of the configuration.
+func Build(conf dataplane.Configuration) Snapshot {
+	return Snapshot{
+		SchemaVersion: SchemaVersion,
+		Configuration: Configuration{
+			Version:               conf.Version,
+			HTTPServers:           convertVirtualServers(conf.HTTPServers),
+			SSLServers:            convertVirtualServers(conf.SSLServers),
+			TLSPassthroughServers: convertLayer4VirtualServers(conf.TLSPassthroughServers),
+			TCPServers:            convertLayer4VirtualServers(conf.TCPServers),
+			UDPServers:            convertLayer4VirtualServers(conf.UDPServers),
+			Upstreams:             convertUpstreams(conf.Upstreams),
+			StreamUpstreams:       convertUpstreams(conf.StreamUpstreams),
+			BackendGroups:         convertBackendGroups(conf.BackendGroups),
+			SSLKeyPairIDs:         sortedIDs(maps.Keys(conf.SSLKeyPairs)),
+			CertBundleIDs:         sortedIDs(maps.Keys(conf.CertBundles)),
+			MainSnippets:          convertSnippets(conf.MainSnippets),
+			HTTPSnippets:          convertSnippets(conf.BaseHTTPConfig.Snippets),
+		},
+	}
+}
+
+// Marshal builds the Snapshot of the configuration and serializes it into indented JSON.
+func Marshal(conf dataplane.Configuration) ([]byte, error) {
+	data, err := json.MarshalIndent(Build(conf), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal configuration snapshot: %w", err)
+	}
+
+	return data, nil
+}
+
+func convertVirtualServers(servers []dataplane.VirtualServer) []VirtualServer {
+	result := make([]VirtualServer, 0, len(servers))
+
+	for _, s := range servers {
+		server := VirtualServer{
+			Hostname:  s.Hostname,
+			Port:      s.Port,
+			IsDefault: s.IsDefault,
+			PathRules: convertPathRules(s.PathRules),
+		}
+
+		if s.SSL != nil {
+			server.SSLKeyPairID = string(s.SSL.KeyPairID)
+		}
+
+		result = append(result, server)
+	}
+
+	return result
+}
+
+func convertPathRules(rules []dataplane.PathRule) []PathRule {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]PathRule, 0, len(rules))
+
+	for _, r := range rules {
+		matchRules := make([]MatchRule, 0, len(r.MatchRules))
+
+		for _, mr := range r.MatchRules {
+			matchRule := MatchRule{
+				BackendGroup: mr.BackendGroup.Name(),
+				Match:        convertMatch(mr.Match),
+				Filters:      convertFilters(mr.Filters),
+			}
+
+			if mr.Source != nil {
+				matchRule.Source = mr.Source.Namespace + "/" + mr.Source.Name
+			}
+
+			matchRules = append(matchRules, matchRule)
+		}
+
+		result = append(result, PathRule{
+			Path:            r.Path,
+			PathType:        string(r.PathType),
+			MatchRules:      matchRules,
+			GRPC:            r.GRPC,
+			CaseInsensitive: r.CaseInsensitive,
+		})
+	}
+
+	return result
+}
+
+func convertMatch(match dataplane.Match) Match {
+	result := Match{Method: match.Method}
+
+	for _, h := range match.Headers {
+		result.Headers = append(result.Headers, ValueMatch{Name: h.Name, Value: h.Value, Type: string(h.Type)})
+	}
+
+	for _, q := range match.QueryParams {
+		result.QueryParams = append(result.QueryParams, ValueMatch{Name: q.Name, Value: q.Value, Type: string(q.Type)})
+	}
+
+	return result
+}
+
+func convertFilters(filters dataplane.HTTPFilters) Filters {
+	result := Filters{
+		Invalid:                filters.InvalidFilter != nil,
+		RequestHeaderModifier:  convertHeaderModifier(filters.RequestHeaderModifiers),
+		ResponseHeaderModifier: convertHeaderModifier(filters.ResponseHeaderModifiers),
+	}
+
+	if redirect := filters.RequestRedirect; redirect != nil {
+		result.RequestRedirect = &RequestRedirect{
+			Scheme:     redirect.Scheme,
+			Hostname:   redirect.Hostname,
+			Port:       redirect.Port,
+			StatusCode: redirect.StatusCode,
+			Path:       convertPathModifier(redirect.Path),
+		}
+	}
+
+	if rewrite := filters.RequestURLRewrite; rewrite != nil {
+		result.URLRewrite = &URLRewrite{
+			Hostname: rewrite.Hostname,
+			Path:     convertPathModifier(rewrite.Path),
+		}
+	}
+
+	for _, m := range filters.RequestMirrors {
+		result.RequestMirrors = append(result.RequestMirrors, RequestMirror{
+			UpstreamName: m.UpstreamName,
+			Percent:      m.Percent,
+		})
+	}
+
+	for _, sf := range filters.SnippetsFilters {
+		if sf.ServerSnippet != nil {
+			result.Snippets = append(result.Snippets, sf.ServerSnippet.Name)
+		}
+
+		if sf.LocationSnippet != nil {
+			result.Snippets = append(result.Snippets, sf.LocationSnippet.Name)
+		}
+	}
+
+	return result
+}
+
+func convertPathModifier(modifier *dataplane.HTTPPathModifier) *PathModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	return &PathModifier{
+		Type:        string(modifier.Type),
+		Replacement: modifier.Replacement,
+	}
+}
+
+func convertHeaderModifier(modifier *dataplane.HTTPHeaderFilter) *HeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	return &HeaderModifier{
+		Set:    convertHeaders(modifier.Set),
+		Add:    convertHeaders(modifier.Add),
+		Remove: modifier.Remove,
+	}
+}
+
+func convertHeaders(headers []dataplane.HTTPHeader) []Header {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make([]Header, 0, len(headers))
+
+	for _, h := range headers {
+		result = append(result, Header{Name: h.Name, Value: h.Value})
+	}
+
+	return result
+}
+
+func convertLayer4VirtualServers(servers []dataplane.Layer4VirtualServer) []Layer4VirtualServer {
+	result := make([]Layer4VirtualServer, 0, len(servers))
+
+	for _, s := range servers {
+		result = append(result, Layer4VirtualServer{
+			Hostname:          s.Hostname,
+			UpstreamName:      s.UpstreamName,
+			Port:              s.Port,
+			IsDefault:         s.IsDefault,
+			SendProxyProtocol: s.SendProxyProtocol,
+		})
+	}
+
+	return result
+}
+
+func convertUpstreams(upstreams []dataplane.Upstream) []Upstream {
+	result := make([]Upstream, 0, len(upstreams))
+
+	for _, u := range upstreams {
+		endpoints := make([]Endpoint, 0, len(u.Endpoints))
+
+		for _, ep := range u.Endpoints {
+			endpoints = append(endpoints, Endpoint{Address: ep.Address, Port: ep.Port, IPv6: ep.IPv6})
+		}
+
+		result = append(result, Upstream{
+			Name:         u.Name,
+			ExternalName: u.ExternalName,
+			ErrorMsg:     u.ErrorMsg,
+			Endpoints:    endpoints,
+			Draining:     u.Draining,
+		})
+	}
+
+	return result
+}
+
+func convertBackendGroups(groups []dataplane.BackendGroup) []BackendGroup {
+	result := make([]BackendGroup, 0, len(groups))
+
+	for _, g := range groups {
+		backends := make([]Backend, 0, len(g.Backends))
+
+		for _, b := range g.Backends {
+			backends = append(backends, Backend{UpstreamName: b.UpstreamName, Weight: b.Weight, Valid: b.Valid})
+		}
+
+		result = append(result, BackendGroup{
+			Name:     g.Name(),
+			Source:   g.Source.String(),
+			Backends: backends,
+			RuleIdx:  g.RuleIdx,
+		})
+	}
+
+	return result
+}
+
+func convertSnippets(snippets []dataplane.Snippet) []Snippet {
+	if len(snippets) == 0 {
+		return nil
+	}
+
+	result := make([]Snippet, 0, len(snippets))
+
+	for _, s := range snippets {
+		result = append(result, Snippet{Name: s.Name, Contents: s.Contents})
+	}
+
+	return result
+}
+
+// sortedIDs returns the IDs in order, so that the Snapshots of equal configurations are equal.
+func sortedIDs[T ~string](ids iter.Seq[T]) []string {
+	result := make([]string, 0)
+
+	for id := range ids {
+		result = append(result, string(id))
+	}
+
+	slices.Sort(result)
+
+	return result
+}
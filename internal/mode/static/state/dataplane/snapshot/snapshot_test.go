@@ -0,0 +1,229 @@
+package snapshot
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+func createConfiguration() dataplane.Configuration {
+	backendGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route"},
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_coffee_80", Weight: 1, Valid: true},
+		},
+	}
+
+	return dataplane.Configuration{
+		Version: 3,
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{
+				Hostname: "cafe.example.com",
+				Port:     80,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source: &metav1.ObjectMeta{Namespace: "test", Name: "route"},
+								Match: dataplane.Match{
+									Method: helpers.GetPointer("GET"),
+									Headers: []dataplane.HTTPHeaderMatch{
+										{Name: "version", Value: "v1", Type: dataplane.MatchTypeExact},
+									},
+								},
+								Filters: dataplane.HTTPFilters{
+									RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Set:    []dataplane.HTTPHeader{{Name: "X-Cafe", Value: "coffee"}},
+										Remove: []string{"X-Internal"},
+									},
+									SnippetsFilters: []dataplane.SnippetsFilter{
+										{LocationSnippet: &dataplane.Snippet{Name: "location-snippet", Contents: "gzip on;"}},
+									},
+								},
+								BackendGroup: backendGroup,
+							},
+						},
+					},
+				},
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     443,
+				SSL:      &dataplane.SSL{KeyPairID: "ssl_keypair_test_cafe-secret"},
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{UpstreamName: "test_db_5432", Port: 5432},
+		},
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "test_coffee_80",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}},
+			},
+		},
+		BackendGroups: []dataplane.BackendGroup{backendGroup},
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_tea-secret":  {Cert: []byte("cert"), Key: []byte("key")},
+			"ssl_keypair_test_cafe-secret": {Cert: []byte("cert"), Key: []byte("key")},
+		},
+		MainSnippets: []dataplane.Snippet{{Name: "main-snippet", Contents: "worker_priority 0;"}},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	expected := Snapshot{
+		SchemaVersion: SchemaVersion,
+		Configuration: Configuration{
+			Version: 3,
+			HTTPServers: []VirtualServer{
+				{IsDefault: true, Port: 80},
+				{
+					Hostname: "cafe.example.com",
+					Port:     80,
+					PathRules: []PathRule{
+						{
+							Path:     "/coffee",
+							PathType: "prefix",
+							MatchRules: []MatchRule{
+								{
+									Source:       "test/route",
+									BackendGroup: "group_test__route_rule0",
+									Match: Match{
+										Method:  helpers.GetPointer("GET"),
+										Headers: []ValueMatch{{Name: "version", Value: "v1", Type: "Exact"}},
+									},
+									Filters: Filters{
+										RequestHeaderModifier: &HeaderModifier{
+											Set:    []Header{{Name: "X-Cafe", Value: "coffee"}},
+											Remove: []string{"X-Internal"},
+										},
+										Snippets: []string{"location-snippet"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			SSLServers: []VirtualServer{
+				{Hostname: "cafe.example.com", Port: 443, SSLKeyPairID: "ssl_keypair_test_cafe-secret"},
+			},
+			TLSPassthroughServers: []Layer4VirtualServer{},
+			TCPServers:            []Layer4VirtualServer{{UpstreamName: "test_db_5432", Port: 5432}},
+			UDPServers:            []Layer4VirtualServer{},
+			Upstreams: []Upstream{
+				{Name: "test_coffee_80", Endpoints: []Endpoint{{Address: "10.0.0.1", Port: 8080}}},
+			},
+			StreamUpstreams: []Upstream{},
+			BackendGroups: []BackendGroup{
+				{
+					Name:     "group_test__route_rule0",
+					Source:   "test/route",
+					Backends: []Backend{{UpstreamName: "test_coffee_80", Weight: 1, Valid: true}},
+				},
+			},
+			SSLKeyPairIDs: []string{"ssl_keypair_test_cafe-secret", "ssl_keypair_test_tea-secret"},
+			CertBundleIDs: []string{},
+			MainSnippets:  []Snippet{{Name: "main-snippet", Contents: "worker_priority 0;"}},
+		},
+	}
+
+	g.Expect(helpers.Diff(expected, Build(createConfiguration()))).To(BeEmpty())
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// the serialized fields must not change within a schema version.
+	expected := `{
+  "schemaVersion": "v1",
+  "configuration": {
+    "httpServers": [],
+    "sslServers": [
+      {
+        "hostname": "cafe.example.com",
+        "sslKeyPairID": "ssl_keypair_test_cafe-secret",
+        "port": 443
+      }
+    ],
+    "tlsPassthroughServers": [],
+    "tcpServers": [],
+    "udpServers": [
+      {
+        "upstreamName": "test_dns_53",
+        "port": 53,
+        "isDefault": true
+      }
+    ],
+    "upstreams": [],
+    "streamUpstreams": [
+      {
+        "name": "test_dns_53",
+        "endpoints": [
+          {
+            "address": "fd00::1",
+            "port": 53,
+            "ipv6": true
+          }
+        ],
+        "draining": true
+      }
+    ],
+    "backendGroups": [],
+    "sslKeyPairIDs": [
+      "ssl_keypair_test_cafe-secret"
+    ],
+    "certBundleIDs": [
+      "cert_bundle_test_ca"
+    ],
+    "version": 1
+  }
+}`
+
+	conf := dataplane.Configuration{
+		Version: 1,
+		SSLServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     443,
+				SSL:      &dataplane.SSL{KeyPairID: "ssl_keypair_test_cafe-secret"},
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{UpstreamName: "test_dns_53", Port: 53, IsDefault: true},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "test_dns_53",
+				Endpoints: []resolver.Endpoint{{Address: "fd00::1", Port: 53, IPv6: true}},
+				Draining:  true,
+			},
+		},
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_cafe-secret": {Cert: []byte("cert"), Key: []byte("private key")},
+		},
+		CertBundles: map[dataplane.CertBundleID]dataplane.CertBundle{
+			"cert_bundle_test_ca": []byte("ca"),
+		},
+	}
+
+	data, err := Marshal(conf)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal(expected))
+	g.Expect(string(data)).ToNot(ContainSubstring("private key"))
+}
@@ -0,0 +1,244 @@
+package snapshot
+
+// SchemaVersion is the version of the schema of the Snapshot.
+const SchemaVersion = "v1"
+
+// Snapshot is the versioned serialization of the data plane configuration.
+type Snapshot struct {
+	// SchemaVersion is the version of the schema of the Snapshot.
+	SchemaVersion string `json:"schemaVersion"`
+	// Configuration is the data plane configuration.
+	Configuration Configuration `json:"configuration"`
+}
+
+// Configuration is the data plane configuration.
+type Configuration struct {
+	// HTTPServers are the servers of the HTTP Listeners.
+	HTTPServers []VirtualServer `json:"httpServers"`
+	// SSLServers are the servers of the HTTPS Listeners.
+	SSLServers []VirtualServer `json:"sslServers"`
+	// TLSPassthroughServers are the servers of the TLS Listeners in passthrough mode.
+	TLSPassthroughServers []Layer4VirtualServer `json:"tlsPassthroughServers"`
+	// TCPServers are the servers of the TCP Listeners.
+	TCPServers []Layer4VirtualServer `json:"tcpServers"`
+	// UDPServers are the servers of the UDP Listeners.
+	UDPServers []Layer4VirtualServer `json:"udpServers"`
+	// Upstreams are the upstreams of the HTTP servers.
+	Upstreams []Upstream `json:"upstreams"`
+	// StreamUpstreams are the upstreams of the Layer 4 servers.
+	StreamUpstreams []Upstream `json:"streamUpstreams"`
+	// BackendGroups are the groups of the backends of the routing rules.
+	BackendGroups []BackendGroup `json:"backendGroups"`
+	// SSLKeyPairIDs are the IDs of the SSL key pairs. The key pairs themselves are not included.
+	SSLKeyPairIDs []string `json:"sslKeyPairIDs"`
+	// CertBundleIDs are the IDs of the certificate bundles.
+	CertBundleIDs []string `json:"certBundleIDs"`
+	// MainSnippets are the snippets of the main context.
+	MainSnippets []Snippet `json:"mainSnippets,omitempty"`
+	// HTTPSnippets are the snippets of the http context.
+	HTTPSnippets []Snippet `json:"httpSnippets,omitempty"`
+	// Version is the version of the configuration.
+	Version int `json:"version"`
+}
+
+// VirtualServer is a server of an HTTP or HTTPS Listener.
+type VirtualServer struct {
+	// Hostname is the hostname of the server.
+	Hostname string `json:"hostname"`
+	// SSLKeyPairID is the ID of the SSL key pair of the server. Empty for the servers of HTTP Listeners.
+	SSLKeyPairID string `json:"sslKeyPairID,omitempty"`
+	// PathRules are the routing rules of the server, grouped by path.
+	PathRules []PathRule `json:"pathRules,omitempty"`
+	// Port is the port of the server.
+	Port int32 `json:"port"`
+	// IsDefault indicates whether the server is the default server of the port.
+	IsDefault bool `json:"isDefault,omitempty"`
+}
+
+// Layer4VirtualServer is a server of a TLS passthrough, TCP or UDP Listener.
+type Layer4VirtualServer struct {
+	// Hostname is the hostname of the server.
+	Hostname string `json:"hostname,omitempty"`
+	// UpstreamName is the name of the upstream of the server.
+	UpstreamName string `json:"upstreamName,omitempty"`
+	// Port is the port of the server.
+	Port int32 `json:"port"`
+	// IsDefault indicates whether the server is the default server of the port.
+	IsDefault bool `json:"isDefault,omitempty"`
+	// SendProxyProtocol indicates whether the PROXY protocol header is sent to the upstream.
+	SendProxyProtocol bool `json:"sendProxyProtocol,omitempty"`
+}
+
+// PathRule is a group of routing rules that share a path.
+type PathRule struct {
+	// Path is the path.
+	Path string `json:"path"`
+	// PathType is the type of the path: prefix, exact or regularExpression.
+	PathType string `json:"pathType"`
+	// MatchRules are the routing rules.
+	MatchRules []MatchRule `json:"matchRules"`
+	// GRPC indicates whether the routing rules are the rules of GRPCRoutes.
+	GRPC bool `json:"grpc,omitempty"`
+	// CaseInsensitive indicates whether the path is matched case-insensitively.
+	CaseInsensitive bool `json:"caseInsensitive,omitempty"`
+}
+
+// MatchRule is a routing rule.
+type MatchRule struct {
+	// Source is the namespace and the name of the Route of the rule.
+	Source string `json:"source,omitempty"`
+	// BackendGroup is the name of the group of the backends that the rule routes to.
+	BackendGroup string `json:"backendGroup"`
+	// Match is the match of the rule.
+	Match Match `json:"match"`
+	// Filters are the filters of the rule.
+	Filters Filters `json:"filters"`
+}
+
+// Match is the match of a routing rule, in addition to the path.
+type Match struct {
+	// Method is the HTTP method.
+	Method *string `json:"method,omitempty"`
+	// Headers are the matches of the HTTP headers.
+	Headers []ValueMatch `json:"headers,omitempty"`
+	// QueryParams are the matches of the HTTP query parameters.
+	QueryParams []ValueMatch `json:"queryParams,omitempty"`
+}
+
+// ValueMatch matches the value of an HTTP header or query parameter.
+type ValueMatch struct {
+	// Name is the name of the header or query parameter.
+	Name string `json:"name"`
+	// Value is the value to match.
+	Value string `json:"value"`
+	// Type is the type of the match: Exact or RegularExpression.
+	Type string `json:"type"`
+}
+
+// Filters are the filters of a routing rule.
+type Filters struct {
+	// RequestRedirect is the request redirect.
+	RequestRedirect *RequestRedirect `json:"requestRedirect,omitempty"`
+	// URLRewrite is the URL rewrite.
+	URLRewrite *URLRewrite `json:"urlRewrite,omitempty"`
+	// RequestHeaderModifier is the modifier of the request headers.
+	RequestHeaderModifier *HeaderModifier `json:"requestHeaderModifier,omitempty"`
+	// ResponseHeaderModifier is the modifier of the response headers.
+	ResponseHeaderModifier *HeaderModifier `json:"responseHeaderModifier,omitempty"`
+	// RequestMirrors are the request mirrors.
+	RequestMirrors []RequestMirror `json:"requestMirrors,omitempty"`
+	// Snippets are the names of the snippets of the SnippetsFilters.
+	Snippets []string `json:"snippets,omitempty"`
+	// Invalid indicates whether the filters are invalid, in which case NGINX responds with the 500 status code.
+	Invalid bool `json:"invalid,omitempty"`
+}
+
+// RequestRedirect redirects the requests.
+type RequestRedirect struct {
+	// Scheme is the scheme of the redirect.
+	Scheme *string `json:"scheme,omitempty"`
+	// Hostname is the hostname of the redirect.
+	Hostname *string `json:"hostname,omitempty"`
+	// Port is the port of the redirect.
+	Port *int32 `json:"port,omitempty"`
+	// StatusCode is the status code of the redirect.
+	StatusCode *int `json:"statusCode,omitempty"`
+	// Path is the modifier of the path.
+	Path *PathModifier `json:"path,omitempty"`
+}
+
+// URLRewrite rewrites the URLs of the requests.
+type URLRewrite struct {
+	// Hostname is the hostname of the rewrite.
+	Hostname *string `json:"hostname,omitempty"`
+	// Path is the modifier of the path.
+	Path *PathModifier `json:"path,omitempty"`
+}
+
+// PathModifier modifies the path of a request.
+type PathModifier struct {
+	// Type is the type of the modifier: ReplaceFullPath or ReplacePrefixMatch.
+	Type string `json:"type"`
+	// Replacement is the replacement of the full path or the prefix match.
+	Replacement string `json:"replacement"`
+}
+
+// HeaderModifier modifies HTTP headers.
+type HeaderModifier struct {
+	// Set are the headers that are set.
+	Set []Header `json:"set,omitempty"`
+	// Add are the headers that are added.
+	Add []Header `json:"add,omitempty"`
+	// Remove are the names of the headers that are removed.
+	Remove []string `json:"remove,omitempty"`
+}
+
+// Header is an HTTP header.
+type Header struct {
+	// Name is the name of the header.
+	Name string `json:"name"`
+	// Value is the value of the header.
+	Value string `json:"value"`
+}
+
+// RequestMirror mirrors the requests to an upstream.
+type RequestMirror struct {
+	// Percent is the percentage of the mirrored requests. If nil, all requests are mirrored.
+	Percent *float64 `json:"percent,omitempty"`
+	// UpstreamName is the name of the upstream.
+	UpstreamName string `json:"upstreamName"`
+}
+
+// Upstream is a pool of endpoints.
+type Upstream struct {
+	// Name is the name of the upstream.
+	Name string `json:"name"`
+	// ExternalName is the DNS name of the ExternalName Service of the upstream.
+	ExternalName string `json:"externalName,omitempty"`
+	// ErrorMsg is the error message if the upstream is invalid.
+	ErrorMsg string `json:"errorMsg,omitempty"`
+	// Endpoints are the endpoints of the upstream.
+	Endpoints []Endpoint `json:"endpoints"`
+	// Draining indicates whether the upstream doesn't receive new requests.
+	Draining bool `json:"draining,omitempty"`
+}
+
+// Endpoint is an endpoint of an upstream.
+type Endpoint struct {
+	// Address is the IP address of the endpoint.
+	Address string `json:"address"`
+	// Port is the port of the endpoint.
+	Port int32 `json:"port"`
+	// IPv6 indicates whether the address is an IPv6 address.
+	IPv6 bool `json:"ipv6,omitempty"`
+}
+
+// BackendGroup is the group of the backends of a routing rule.
+type BackendGroup struct {
+	// Name is the name of the group.
+	Name string `json:"name"`
+	// Source is the namespace and the name of the Route of the routing rule.
+	Source string `json:"source"`
+	// Backends are the backends.
+	Backends []Backend `json:"backends"`
+	// RuleIdx is the index of the routing rule in the Route.
+	RuleIdx int `json:"ruleIdx"`
+}
+
+// Backend is a backend of a routing rule.
+type Backend struct {
+	// UpstreamName is the name of the upstream of the backend.
+	UpstreamName string `json:"upstreamName"`
+	// Weight is the weight of the backend.
+	Weight int32 `json:"weight"`
+	// Valid indicates whether the backend is valid.
+	Valid bool `json:"valid"`
+}
+
+// Snippet is a snippet of NGINX configuration.
+type Snippet struct {
+	// Name is the name of the snippet.
+	Name string `json:"name"`
+	// Contents are the contents of the snippet.
+	Contents string `json:"contents"`
+}
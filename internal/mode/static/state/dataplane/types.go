@@ -2,6 +2,7 @@ package dataplane
 
 import (
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,6 +20,19 @@ const (
 	PathTypePrefix PathType = "prefix"
 	// PathTypeExact indicates that the path is exact.
 	PathTypeExact PathType = "exact"
+	// PathTypeRegularExpression indicates that the path is a regular expression that must match the whole path.
+	PathTypeRegularExpression PathType = "regularExpression"
+)
+
+// TrailingSlashMode defines how the requests for the path of a PathRule with an added or a removed trailing slash
+// are handled.
+type TrailingSlashMode string
+
+const (
+	// TrailingSlashModeRedirect permanently redirects the requests to the path.
+	TrailingSlashModeRedirect TrailingSlashMode = "redirect"
+	// TrailingSlashModeNormalize handles the requests as the requests for the path.
+	TrailingSlashModeNormalize TrailingSlashMode = "normalize"
 )
 
 // Configuration is an intermediate representation of dataplane configuration.
@@ -27,12 +41,33 @@ type Configuration struct {
 	SSLKeyPairs map[SSLKeyPairID]SSLKeyPair
 	// CertBundles holds all unique Certificate Bundles.
 	CertBundles map[CertBundleID]CertBundle
+	// StaticContents holds all unique static contents sourced from ConfigMaps.
+	StaticContents map[StaticContentID]StaticContentFiles
+	// SocketOptions holds the options of the listening sockets and the client connections, keyed by port.
+	// The ports without options are not included.
+	SocketOptions map[int32]SocketOptions
+	// StatusEndpoint holds the read-only status endpoint for external monitoring. If nil, the endpoint is disabled.
+	StatusEndpoint *StatusEndpoint
+	// GRPCHealthChecks holds the gRPC health checks of the upstreams of GRPCRoutes.
+	GRPCHealthChecks []GRPCHealthCheck
+	// CacheZones holds the caches of the CachePolicies.
+	CacheZones []CacheZone
+	// RateLimitZones holds the rate limits of the RateLimitPolicies.
+	RateLimitZones []RateLimitZone
+	// ConnectionLimitZones holds the connection limits of the ConnectionLimitPolicies for the HTTP servers.
+	ConnectionLimitZones []ConnectionLimitZone
+	// StreamConnectionLimitZones holds the connection limits of the ConnectionLimitPolicies for the stream servers.
+	StreamConnectionLimitZones []ConnectionLimitZone
 	// HTTPServers holds all HTTPServers.
 	HTTPServers []VirtualServer
 	// SSLServers holds all SSLServers.
 	SSLServers []VirtualServer
 	// TLSPassthroughServers hold all TLSPassthroughServers
 	TLSPassthroughServers []Layer4VirtualServer
+	// TCPServers hold all TCPServers.
+	TCPServers []Layer4VirtualServer
+	// UDPServers hold all UDPServers.
+	UDPServers []Layer4VirtualServer
 	// Upstreams holds all unique http Upstreams.
 	Upstreams []Upstream
 	// DeploymentContext contains metadata about NGF and the cluster.
@@ -46,16 +81,29 @@ type Configuration struct {
 	BackendGroups []BackendGroup
 	// MainSnippets holds all the snippets that apply to the main context.
 	MainSnippets []Snippet
+	// BodyTransforms holds the BodyTransforms of the HTTPBodyTransforms that are referenced by routing rules.
+	BodyTransforms []BodyTransform
 	// Telemetry holds the Otel configuration.
 	Telemetry Telemetry
 	// Logging defines logging related settings for NGINX.
 	Logging Logging
 	// NginxPlus specifies NGINX Plus additional settings.
 	NginxPlus NginxPlus
+	// TemplateOverrides holds user-provided templates that override parts of the generated configuration.
+	TemplateOverrides TemplateOverrides
+	// StreamSettings holds the settings of the client connections at the stream context.
+	StreamSettings StreamSettings
 	// BaseHTTPConfig holds the configuration options at the http context.
 	BaseHTTPConfig BaseHTTPConfig
+	// Worker holds the settings of the NGINX worker processes.
+	Worker Worker
+	// HashSizes holds the sizes of the hash tables for server names and maps.
+	HashSizes HashSizes
 	// Version represents the version of the generated configuration.
 	Version int
+	// LoadBrotliModule specifies whether the Brotli module must be loaded, because a ResponseCompressionPolicy
+	// configures the Brotli compression.
+	LoadBrotliModule bool
 }
 
 // SSLKeyPairID is a unique identifier for a SSLKeyPair.
@@ -69,6 +117,13 @@ type CertBundleID string
 // CertBundle is a Certificate bundle.
 type CertBundle []byte
 
+// StaticContentID is a unique identifier for static content sourced from a ConfigMap.
+// The ID is safe to use as a directory name.
+type StaticContentID string
+
+// StaticContentFiles are the files of static content. The keys are the names of the files.
+type StaticContentFiles map[string][]byte
+
 // SSLKeyPair is an SSL private/public key pair.
 type SSLKeyPair struct {
 	// Cert is the certificate.
@@ -81,12 +136,20 @@ type SSLKeyPair struct {
 type VirtualServer struct {
 	// SSL holds the SSL configuration for the server.
 	SSL *SSL
+	// AccessLog is the access log of the server, which overrides the default access log.
+	// If nil, the server uses the default access log.
+	AccessLog *AccessLog
+	// GatewaySettings holds the settings of the Gateway of the server, which override the settings of
+	// the BaseHTTPConfig. If nil, the server uses the settings of the BaseHTTPConfig.
+	GatewaySettings *GatewaySettings
 	// Hostname is the hostname of the server.
 	Hostname string
 	// PathRules is a collection of routing rules.
 	PathRules []PathRule
 	// Policies is a list of Policies that apply to the server.
 	Policies []policies.Policy
+	// GRPCHealthChecks holds the gRPC health checks that are exposed as plain HTTP endpoints on the server.
+	GRPCHealthChecks []GRPCHealthCheck
 	// Port is the port of the server.
 	Port int32
 	// IsDefault indicates whether the server is the default server.
@@ -95,6 +158,16 @@ type VirtualServer struct {
 
 // Layer4VirtualServer is a virtual server for Layer 4 traffic.
 type Layer4VirtualServer struct {
+	// ConnectionLimit is the connection limit of the server. Nil if the connections are not limited.
+	// Only set for the servers of TLSRoutes and TCPRoutes.
+	ConnectionLimit *ConnectionLimit
+	// GatewaySettings holds the settings of the Gateway of the server, which override the settings of
+	// the BaseHTTPConfig. If nil, the server uses the settings of the BaseHTTPConfig.
+	GatewaySettings *GatewaySettings
+	// StreamSettings holds the settings of the client connections of the merged Gateway of the server, which
+	// override the StreamSettings of the Configuration. If nil, the server uses the StreamSettings of
+	// the Configuration.
+	StreamSettings *StreamSettings
 	// Hostname is the hostname of the server.
 	Hostname string
 	// UpstreamName refers to the name of the upstream that is used.
@@ -103,24 +176,56 @@ type Layer4VirtualServer struct {
 	Port int32
 	// IsDefault refers to whether this server is created for the default listener hostname.
 	IsDefault bool
+	// SendProxyProtocol indicates whether the PROXY protocol header is sent to the upstream.
+	// Only set for the servers of TLSRoutes.
+	SendProxyProtocol bool
 }
 
 // Upstream is a pool of endpoints to be load balanced.
 type Upstream struct {
+	// SessionPersistence holds the session persistence configuration of the Upstream.
+	// If nil, session persistence is disabled.
+	SessionPersistence *SessionPersistence
+	// EndpointSummary holds the number of the ready and not ready endpoints of the Upstream by zone.
+	EndpointSummary resolver.EndpointSummary
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
 	Name string
 	// ErrorMsg contains the error message if the Upstream is invalid.
 	ErrorMsg string
+	// ExternalName is the external DNS name of the ExternalName Service of the Upstream.
+	// If set, the Upstream has a single endpoint with the name, which NGINX resolves at runtime.
+	ExternalName string
 	// Endpoints are the endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
 	// Policies holds all the valid policies that apply to the Upstream.
 	Policies []policies.Policy
+	// Snippets holds the upstream snippets of the SnippetsFilters referenced by the routing rules
+	// that use the Upstream.
+	Snippets []Snippet
+	// Draining is true if all the BackendRefs that reference the Upstream have a zero weight.
+	// A draining Upstream doesn't receive new requests, but the established connections to its servers
+	// are allowed to complete.
+	Draining bool
+}
+
+// SessionPersistence holds the cookie-based session persistence configuration of an Upstream.
+type SessionPersistence struct {
+	// Name is the name of the session cookie.
+	Name string
+	// Expiry is the lifetime of the session cookie in seconds.
+	// If zero, the cookie is a session cookie that expires when the client session ends.
+	Expiry int64
 }
 
 // SSL is the SSL configuration for a server.
 type SSL struct {
 	// KeyPairID is the ID of the corresponding SSLKeyPair for the server.
 	KeyPairID SSLKeyPairID
+	// OCSPTrustedCertBundleID is the ID of the CertBundle that verifies the stapled OCSP responses.
+	// If empty, the OCSP responses are not verified.
+	OCSPTrustedCertBundleID CertBundleID
+	// OCSPStapling specifies whether the OCSP responses of the certificate are stapled.
+	OCSPStapling bool
 }
 
 // PathRule represents routing rules that share a common path.
@@ -129,18 +234,28 @@ type PathRule struct {
 	Path string
 	// PathType is the type of the path.
 	PathType PathType
+	// TrailingSlash defines how the requests for the path with an added or a removed trailing slash are handled.
+	// If empty, such requests don't match the path.
+	TrailingSlash TrailingSlashMode
 	// MatchRules holds routing rules.
 	MatchRules []MatchRule
 	// Policies contains the list of policies that are applied to this PathRule.
 	Policies []policies.Policy
 	// GRPC indicates if this is a gRPC rule
 	GRPC bool
+	// CaseInsensitive indicates that the path is matched case-insensitively.
+	CaseInsensitive bool
 }
 
 // InvalidHTTPFilter is a special filter for handling the case when configured filters are invalid.
 type InvalidHTTPFilter struct{}
 
 // HTTPFilters hold the filters for a MatchRule.
+//
+// The directives of the filters are composed in a location in a fixed order, regardless of the order of the filters
+// in the routing rule: first the location snippets of the SnippetsFilters, in the order of the filters, then
+// the rewrites, the redirect, the mirrors and the header modifications. The graph reports the SnippetsFilters whose
+// location snippets collide with the directives of the other filters.
 type HTTPFilters struct {
 	// InvalidFilter is a special filter that indicates whether the filters are invalid. If this is the case,
 	// the data plane must return 500 error, and all other filters are nil.
@@ -153,9 +268,16 @@ type HTTPFilters struct {
 	RequestHeaderModifiers *HTTPHeaderFilter
 	// ResponseHeaderModifiers holds the HTTPHeaderFilter.
 	ResponseHeaderModifiers *HTTPHeaderFilter
+	// BodyTransform holds the HTTPBodyTransform.
+	BodyTransform *BodyTransform
+	// DirectResponse holds the DirectResponse.
+	DirectResponse *DirectResponse
 	// SnippetsFilters holds all the SnippetsFilters for the MatchRule.
 	// Unlike the core and extended filters, there can be more than one SnippetsFilters defined on a routing rule.
 	SnippetsFilters []SnippetsFilter
+	// RequestMirrors holds all the HTTPRequestMirrorFilters for the MatchRule.
+	// Like SnippetsFilters, there can be more than one RequestMirror filter defined on a routing rule.
+	RequestMirrors []HTTPRequestMirrorFilter
 }
 
 // SnippetsFilter holds the location and server snippets in a SnippetsFilter.
@@ -167,6 +289,35 @@ type SnippetsFilter struct {
 	ServerSnippet *Snippet
 }
 
+// DebugCapture is the capture of the routing decisions of a bounded number of requests of a Route.
+type DebugCapture struct {
+	// Expires is the time after which the requests are no longer captured.
+	Expires time.Time
+	// Route is the NamespacedName of the Route.
+	Route types.NamespacedName
+	// Requests is the maximum number of captured requests.
+	Requests int32
+}
+
+// BodyTransform holds the njs scripts of an HTTPBodyTransform.
+type BodyTransform struct {
+	// Source is the NamespacedName of the HTTPBodyTransform.
+	Source types.NamespacedName
+	// ID is the name of the njs module of the BodyTransform. It is safe to use as a file name and
+	// in variable names.
+	ID string
+	// RequestScript is the script that transforms the request bodies. Empty if the request bodies are not
+	// transformed.
+	RequestScript string
+	// ResponseScript is the script that transforms the response bodies. Empty if the response bodies are not
+	// transformed.
+	ResponseScript string
+	// MaxRequestBodySize is the maximum size in bytes of the request bodies that are transformed.
+	MaxRequestBodySize int64
+	// MaxResponseBodySize is the maximum size in bytes of the response bodies that are transformed.
+	MaxResponseBodySize int64
+}
+
 // HTTPHeader represents an HTTP header.
 type HTTPHeader struct {
 	// Name is the name of the header.
@@ -185,6 +336,16 @@ type HTTPHeaderFilter struct {
 	Remove []string
 }
 
+// DirectResponse responds to HTTP requests with a fixed status code and body instead of proxying them.
+type DirectResponse struct {
+	// Body is the body of the response.
+	Body string
+	// ContentType is the media type of the body.
+	ContentType string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+}
+
 // HTTPRequestRedirectFilter redirects HTTP requests.
 type HTTPRequestRedirectFilter struct {
 	// Scheme is the scheme of the redirect.
@@ -207,6 +368,38 @@ type HTTPURLRewriteFilter struct {
 	Path *HTTPPathModifier
 }
 
+// HTTPRequestMirrorFilter mirrors HTTP requests to another backend. Responses from the backend are ignored.
+type HTTPRequestMirrorFilter struct {
+	// VerifyTLS holds the backend TLS verification configuration of the mirror backend.
+	VerifyTLS *VerifyTLS
+	// Percent is the percentage of requests to mirror, rounded down to 2 decimal places.
+	// If nil, all requests are mirrored.
+	Percent *float64
+	// UpstreamName is the name of the upstream that requests are mirrored to.
+	UpstreamName string
+}
+
+// HTTPTimeouts holds the timeouts of a routing rule. The timeouts are in a format that NGINX understands.
+// An empty timeout means the timeout is not set.
+type HTTPTimeouts struct {
+	// Request is the timeout for NGINX to respond to a client request.
+	Request string
+	// BackendRequest is the timeout for a single request from NGINX to a backend.
+	BackendRequest string
+}
+
+// HTTPRetry holds the retry configuration of a routing rule in a format that NGINX understands.
+type HTTPRetry struct {
+	// Timeout limits the time during which a request can be retried. An empty timeout means no limit.
+	Timeout string
+	// Conditions are the conditions in which a request is retried. For example, error, timeout, or http_503.
+	// The "off" condition disables retries.
+	Conditions []string
+	// Tries is the maximum number of tries to pass a request to a backend, including the first one.
+	// Zero means no limit.
+	Tries int
+}
+
 // PathModifierType is the type of the PathModifier in a redirect or rewrite rule.
 type PathModifierType string
 
@@ -266,10 +459,85 @@ type MatchRule struct {
 	Filters HTTPFilters
 	// Source is the ObjectMeta of the resource that includes the rule.
 	Source *metav1.ObjectMeta
+	// Timeouts holds the timeouts for the MatchRule. If nil, the NGINX default timeouts are used.
+	Timeouts *HTTPTimeouts
+	// Retry holds the retry configuration for the MatchRule. If nil, the NGINX default retry behavior is used.
+	Retry *HTTPRetry
+	// StaticContent holds the static content that NGINX serves for the MatchRule instead of proxying the requests
+	// to the BackendGroup. If nil, the requests are proxied.
+	StaticContent *StaticContent
+	// DebugCapture holds the capture of the routing decisions of the requests of the rule.
+	// If nil, the routing decisions are not captured.
+	DebugCapture *DebugCapture
+	// ErrorPages are the error pages that replace the responses of the BackendGroup.
+	ErrorPages []ErrorPage
 	// Match holds the match for the rule.
 	Match Match
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
+	// Streaming indicates that the responses of the BackendGroup are passed to the clients as they are received,
+	// without buffering them.
+	Streaming bool
+}
+
+// GRPCHealthCheck is the health check of the upstream of a GRPCRoute with the gRPC Health Checking Protocol.
+type GRPCHealthCheck struct {
+	// VerifyTLS holds the backend TLS verification configuration.
+	VerifyTLS *VerifyTLS
+	// UpstreamName is the name of the upstream whose health is checked.
+	UpstreamName string
+	// Service is the name of the gRPC service whose health is checked.
+	// If empty, the overall health of the backend is checked.
+	Service string
+	// Path is the path of the plain HTTP endpoint that exposes the health check.
+	// If empty, the health check is not exposed.
+	Path string
+	// Interval is the interval between the active health checks of NGINX Plus.
+	Interval string
+}
+
+// StaticContent is the static content that NGINX serves for a MatchRule.
+type StaticContent struct {
+	// ID is the ID of the content sourced from a ConfigMap. Empty if the content is sourced from a volume.
+	ID StaticContentID
+	// Path is the path of the directory with the content sourced from a volume.
+	// Empty if the content is sourced from a ConfigMap.
+	Path string
+	// Index is the name of the file that NGINX serves for the requests for a directory.
+	Index string
+	// Fallback is the path of the file that NGINX serves when the requested file doesn't exist.
+	// Empty if NGINX responds with the 404 status code instead.
+	Fallback string
+}
+
+// ErrorPage replaces the responses of the backends with the status codes with a custom response or a redirect.
+type ErrorPage struct {
+	// Response is the custom response. Nil if the ErrorPage is a redirect.
+	Response *ErrorPageResponse
+	// Redirect is the redirect. Nil if the ErrorPage is a custom response.
+	Redirect *ErrorPageRedirect
+	// Name uniquely identifies the ErrorPage among the ErrorPages of all Routes.
+	Name string
+	// Codes are the status codes of the responses that are replaced.
+	Codes []int
+}
+
+// ErrorPageResponse is a custom response of an ErrorPage.
+type ErrorPageResponse struct {
+	// ContentType is the Content-Type of the response.
+	ContentType string
+	// Body is the body of the response.
+	Body string
+	// StatusCode is the status code of the response. Zero if the status code of the response of the backend is kept.
+	StatusCode int
+}
+
+// ErrorPageRedirect is a redirect of an ErrorPage.
+type ErrorPageRedirect struct {
+	// URL is the URL that the clients are redirected to.
+	URL string
+	// StatusCode is the status code of the redirect.
+	StatusCode int
 }
 
 // Match represents a match for a routing rule which consist of matches against various HTTP request attributes.
@@ -352,14 +620,139 @@ type SpanAttribute struct {
 
 // BaseHTTPConfig holds the configuration options at the http context.
 type BaseHTTPConfig struct {
+	// Hardening holds the settings that protect NGINX from slow clients and malformed requests.
+	// If nil, the NGINX defaults are used.
+	Hardening *Hardening
+	// Resolver holds the nameservers that resolve the hostnames of the upstreams.
+	// If nil, the nameservers of the NGINX container are used.
+	Resolver *Resolver
+	// HTTP2Settings holds the settings of the HTTP/2 connections of the clients.
+	// If nil, the NGINX defaults are used.
+	HTTP2Settings *HTTP2Settings
+	// TLS holds the TLS settings of all SSL servers.
+	// If nil, the NGINX defaults are used.
+	TLS *TLSSettings
 	// IPFamily specifies the IP family for all servers.
 	IPFamily IPFamilyType
 	// Snippets contain the snippets that apply to the http context.
 	Snippets []Snippet
+	// MapSnippets contain the snippets with map blocks that apply to the http context.
+	MapSnippets []Snippet
 	// RewriteIPSettings defines configuration for rewriting the client IP to the original client's IP.
 	RewriteClientIPSettings RewriteClientIPSettings
 	// HTTP2 specifies whether http2 should be enabled for all servers.
 	HTTP2 bool
+	// HTTP3 specifies whether HTTP/3 over QUIC should be enabled for all SSL servers.
+	HTTP3 bool
+	// NativeHTTPMatches specifies whether the method and header matches of Routes should be evaluated with
+	// NGINX map blocks instead of the NGINX JavaScript module when possible.
+	NativeHTTPMatches bool
+}
+
+// Hardening holds the settings that protect NGINX from slow clients and malformed requests.
+type Hardening struct {
+	// ClientHeaderTimeout is the timeout for reading the header of a client request.
+	ClientHeaderTimeout string
+	// ClientBodyTimeout is the timeout for reading the body of a client request.
+	ClientBodyTimeout string
+	// SendTimeout is the timeout for transmitting a response to a client.
+	SendTimeout string
+	// ResetTimedOutConnection specifies whether the timed out connections should be reset.
+	ResetTimedOutConnection bool
+	// RequestSmugglingProtection specifies whether the protections against HTTP request smuggling are enabled.
+	RequestSmugglingProtection bool
+}
+
+// Resolver holds the nameservers that NGINX uses to resolve the hostnames of the upstreams.
+type Resolver struct {
+	// Valid overrides the DNS TTLs of the answers. Empty value means that the TTLs are honored.
+	Valid string
+	// Addresses are the addresses of the nameservers, with the IPv6 addresses enclosed in square brackets.
+	Addresses []string
+	// DisableIPv6 specifies whether resolving the hostnames to IPv6 addresses is disabled.
+	DisableIPv6 bool
+}
+
+// HTTP2Settings holds the settings of the HTTP/2 connections of the clients.
+// Empty and zero values mean that the corresponding directives are not set.
+type HTTP2Settings struct {
+	// RecvBufferSize is the size of the per-worker input buffer.
+	RecvBufferSize string
+	// ChunkSize is the maximum size of the chunks into which the response body is sliced.
+	ChunkSize string
+	// BodyPrereadSize is the size of the buffer per stream, in which the request body is saved.
+	BodyPrereadSize string
+	// MaxConcurrentStreams is the maximum number of concurrent streams in a connection.
+	MaxConcurrentStreams int32
+}
+
+// TLSSettings holds the TLS settings of all SSL servers.
+type TLSSettings struct {
+	// Ciphers is the value of the ssl_ciphers directive.
+	// Empty value means that the directive is not set.
+	Ciphers string
+	// PreferServerCiphers is the value of the ssl_prefer_server_ciphers directive ("on" or "off").
+	// Empty value means that the directive is not set.
+	PreferServerCiphers string
+	// SessionCache is the value of the ssl_session_cache directive, for example "shared:SSL:10m".
+	// Empty value means that the directive is not set.
+	SessionCache string
+	// SessionTimeout is the value of the ssl_session_timeout directive.
+	// Empty value means that the directive is not set.
+	SessionTimeout string
+	// SessionTickets is the value of the ssl_session_tickets directive ("on" or "off").
+	// Empty value means that the directive is not set.
+	SessionTickets string
+	// Protocols are the TLS protocols allowed for all SSL servers. If empty, the NGINX default is used.
+	Protocols []string
+}
+
+// SocketOptions holds the options of a listening socket and its client connections.
+type SocketOptions struct {
+	// KeepAlive is the value of the so_keepalive parameter of the listen directive, for example "on" or "30m::10".
+	// Empty value means that the parameter is not set.
+	KeepAlive string
+	// TCPNoDelay is the value of the tcp_nodelay directive ("on" or "off").
+	// Empty value means that the directive is not set.
+	TCPNoDelay string
+	// TCPNoPush is the value of the tcp_nopush directive ("on" or "off").
+	// Empty value means that the directive is not set.
+	TCPNoPush string
+	// Backlog is the maximum length of the queue of pending connections. Zero means that it is not set.
+	Backlog int32
+	// ReusePort specifies whether a separate listening socket is created for each worker process.
+	ReusePort bool
+	// DeferredAccept specifies whether accepting a connection is deferred until the client sends data.
+	DeferredAccept bool
+}
+
+// HashSizes holds the sizes of the NGINX hash tables for server names and maps.
+type HashSizes struct {
+	// ServerNamesMaxSize is the maximum size of the server names hash tables.
+	ServerNamesMaxSize int32
+	// ServerNamesBucketSize is the bucket size of the server names hash tables.
+	ServerNamesBucketSize int32
+	// MapMaxSize is the maximum size of the map variables hash tables in the http context.
+	MapMaxSize int32
+	// MapBucketSize is the bucket size of the map variables hash tables in the http context.
+	MapBucketSize int32
+	// StreamMapMaxSize is the maximum size of the map variables hash tables in the stream context.
+	StreamMapMaxSize int32
+	// StreamMapBucketSize is the bucket size of the map variables hash tables in the stream context.
+	StreamMapBucketSize int32
+}
+
+// StreamSettings holds the settings of the client connections of the stream servers. They come from
+// the ClientSettingsPolicies attached to the Gateway. A setting is empty if it is not set.
+type StreamSettings struct {
+	// ProxyConnectTimeout is the timeout of establishing a connection with an upstream server.
+	ProxyConnectTimeout string
+	// ProxyTimeout is the timeout between two successive read or write operations on the client or upstream
+	// connection.
+	ProxyTimeout string
+	// PrereadTimeout is the timeout of reading the data from the client during the preread phase,
+	// like reading the TLS ClientHello of a TLSRoute.
+	PrereadTimeout string
 }
 
 // Snippet is a snippet of configuration.
@@ -370,6 +763,16 @@ type Snippet struct {
 	Contents string
 }
 
+// GatewaySettings holds the settings of the servers of a Gateway that references its own NginxProxy, but shares
+// the NGINX configuration with the winning Gateway.
+type GatewaySettings struct {
+	// IPFamily specifies the IP family of the servers.
+	IPFamily IPFamilyType
+	// RewriteClientIPSettings defines configuration for rewriting the client IP to the original client's IP.
+	// The mode enables the PROXY protocol like the mode of the BaseHTTPConfig.
+	RewriteClientIPSettings RewriteClientIPSettings
+}
+
 // RewriteClientIPSettings defines configuration for rewriting the client IP to the original client's IP.
 type RewriteClientIPSettings struct {
 	// Mode specifies the mode for rewriting the client IP.
@@ -413,14 +816,174 @@ type Ratio struct {
 
 // Logging defines logging related settings for NGINX.
 type Logging struct {
+	// AccessLog is the default access log of the HTTP and HTTPS servers.
+	// If nil, the default access log of NGINX is used.
+	AccessLog *AccessLog
 	// ErrorLevel defines the error log level.
 	ErrorLevel string
+	// ErrorLogSocket is the Unix socket of the controller that the error log is also sent to in the syslog format.
+	// If empty, the error log is only written to stderr.
+	ErrorLogSocket string
+	// LogFormats are the named log formats that the access logs can use.
+	LogFormats []LogFormat
+}
+
+// AccessLog is an access log of the HTTP and HTTPS servers.
+type AccessLog struct {
+	// Destination is the path of the file or the address of the syslog server that the requests are logged to,
+	// for example, "/dev/stdout" or "syslog:server=syslog.example.com:514".
+	Destination string
+	// Format is the name of the log format.
+	Format string
+	// Disable indicates that the access log is disabled.
+	Disable bool
+}
+
+// LogFormat is a named log format.
+type LogFormat struct {
+	// Name is the name of the log format.
+	Name string
+	// Format is the format of the log entries.
+	Format string
+	// Escape is the escaping of the variables in the log format: "default", "json", or "none".
+	// If empty, the default escaping of NGINX is used.
+	Escape string
+}
+
+// Worker holds the settings of the NGINX worker processes.
+type Worker struct {
+	// PortMappings maps the ports of the Listeners to the ports that NGINX listens on.
+	// The mappings take precedence over PortOffset.
+	PortMappings map[int32]int32
+	// User is the user of the worker processes. Empty means the user of the NGINX master process.
+	User string
+	// Group is the group of the worker processes. Empty means the group with the same name as the user.
+	Group string
+	// Processes is the number of the worker processes, or "auto". Empty means the default.
+	Processes string
+	// PortOffset is added to the privileged ports of the Listeners to get the ports that NGINX listens on.
+	// Zero means that NGINX binds to the privileged ports directly.
+	PortOffset int32
+	// Connections is the maximum number of simultaneous connections of a worker process. Zero means the default.
+	Connections int32
+	// RlimitNofile is the limit on the number of open files of the worker processes.
+	// Zero means the limit of the NGINX container.
+	RlimitNofile int32
+	// MultiAccept makes a worker process accept all new connections at a time.
+	MultiAccept bool
+}
+
+// maxPrivilegedPort is the highest port that requires the CAP_NET_BIND_SERVICE capability to bind to.
+const maxPrivilegedPort = 1023
+
+// ListenPort returns the port that NGINX listens on for the port of a Listener.
+func (w Worker) ListenPort(port int32) int32 {
+	if containerPort, ok := w.PortMappings[port]; ok {
+		return containerPort
+	}
+
+	if w.PortOffset > 0 && port <= maxPrivilegedPort {
+		return port + w.PortOffset
+	}
+
+	return port
 }
 
 // NginxPlus specifies NGINX Plus additional settings.
 type NginxPlus struct {
+	// StatusZones maps the names of the status zones of the servers to the Listeners that the servers belong to.
+	StatusZones map[string]ListenerStatusZone
 	// AllowedAddresses specifies IPAddresses or CIDR blocks to the allow list for accessing the NGINX Plus API.
 	AllowedAddresses []string
+	// UpstreamServersInConfig specifies that the servers of the upstreams are written in the configuration instead
+	// of loaded from the state files, which the NGINX Plus API writes. It is set when the NGINX Plus API
+	// repeatedly fails to update the servers, so that a reload updates them instead.
+	UpstreamServersInConfig bool
+}
+
+// StatusEndpoint holds the read-only status endpoint of NGINX on a dedicated port.
+type StatusEndpoint struct {
+	// AllowedAddresses are the IP addresses and CIDR blocks of the clients that are allowed to access the endpoint.
+	AllowedAddresses []string
+	// Port is the port of the endpoint.
+	Port int32
+}
+
+// CacheZone is the cache of a CachePolicy.
+type CacheZone struct {
+	// Policy is the NamespacedName of the CachePolicy.
+	Policy types.NamespacedName
+	// Name is the name of the shared memory zone with the keys of the cached responses.
+	Name string
+	// Path is the directory of the cached responses.
+	Path string
+	// KeysZoneSize is the size of the shared memory zone.
+	KeysZoneSize string
+	// MaxSize is the maximum size of the cached responses. Empty if not limited.
+	MaxSize string
+	// Inactive is the time after which the cached responses that are not accessed are removed.
+	Inactive string
+}
+
+// RateLimitZone is the rate limit of a RateLimitPolicy.
+type RateLimitZone struct {
+	// Policy is the NamespacedName of the RateLimitPolicy.
+	Policy types.NamespacedName
+	// Name is the name of the shared memory zone with the states of the keys.
+	Name string
+	// Key is the NGINX variable whose value is the key that the requests are limited by.
+	Key string
+	// Size is the size of the shared memory zone.
+	Size string
+	// Rate is the maximum rate of the requests of a key.
+	Rate string
+}
+
+// ConnectionLimitZone is the connection limit of a ConnectionLimitPolicy.
+type ConnectionLimitZone struct {
+	// Policy is the NamespacedName of the ConnectionLimitPolicy.
+	Policy types.NamespacedName
+	// Name is the name of the shared memory zone with the states of the keys.
+	Name string
+	// Key is the NGINX variable whose value is the key that the connections are limited by.
+	Key string
+	// Size is the size of the shared memory zone.
+	Size string
+}
+
+// ConnectionLimit is the connection limit of a stream server.
+type ConnectionLimit struct {
+	// Policy is the NamespacedName of the ConnectionLimitPolicy.
+	Policy types.NamespacedName
+	// Zone is the name of the shared memory zone of the stream connection limit of the policy.
+	Zone string
+	// MaxConnections is the maximum number of the concurrent connections of a key.
+	MaxConnections int32
+}
+
+// ListenerStatusZone is the NGINX Plus status zone of a server that belongs to a Listener.
+type ListenerStatusZone struct {
+	// Gateway is the NamespacedName of the Gateway of the Listener.
+	Gateway types.NamespacedName
+	// Listener is the name of the Listener.
+	Listener string
+	// Default indicates whether the zone is the zone of the default server of the port of the Listener,
+	// which handles the requests and rejects the TLS handshakes for unknown hostnames.
+	Default bool
+	// HTTPS indicates whether the zone belongs to an HTTPS Listener, whose servers terminate the TLS connections
+	// of the clients.
+	HTTPS bool
+}
+
+// TemplateOverrides holds user-provided templates that override parts of the generated configuration.
+// An empty string means the default template is used.
+type TemplateOverrides struct {
+	// Server is the template for the http server blocks.
+	Server string
+	// Upstream is the template for the http upstream blocks.
+	Upstream string
+	// Location is the template for a single location block.
+	Location string
 }
 
 // DeploymentContext contains metadata about NGF and the cluster.
@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+const (
+	// UseRegexAnnotation is the annotation of an HTTPRoute that makes the PathPrefix path matches of the HTTPRoute
+	// regular expressions. The value is either "true" or "false".
+	UseRegexAnnotation = "gateway.nginx.org/use-regex"
+	// MirrorAnnotation is the annotation of an HTTPRoute that mirrors the requests of all the rules of the HTTPRoute
+	// to a Service in the namespace of the HTTPRoute. The value is the name and the port of the Service,
+	// for example, "mirror-svc:8080".
+	MirrorAnnotation = "gateway.nginx.org/mirror"
+	// StreamingAnnotation is the annotation of an HTTPRoute or a Gateway that makes NGINX pass the responses of
+	// the backends to the clients as they are received, without buffering them. The value is either "true" or "false".
+	// For a Gateway, it applies to all the Routes attached to the Gateway.
+	StreamingAnnotation = "gateway.nginx.org/streaming"
+)
+
+// AnnotationSettings holds the behaviors that the annotations of an HTTPRoute or a Gateway configure.
+// The annotations are an escape hatch for the behaviors that the CRDs don't support yet. Once a CRD supports
+// a behavior, its annotation is deprecated, but still takes effect.
+type AnnotationSettings struct {
+	// Mirror is the backend the requests are mirrored to. Nil if the requests are not mirrored.
+	Mirror *v1.BackendObjectReference
+	// UseRegex indicates that the PathPrefix path matches are regular expressions.
+	UseRegex bool
+	// Streaming indicates that the responses of the backends are not buffered.
+	Streaming bool
+}
+
+// annotationSpec is the schema of an annotation of the escape hatch.
+type annotationSpec struct {
+	// parse validates the value of the annotation and sets the behavior it configures in the settings.
+	parse func(path *field.Path, value string, settings *AnnotationSettings) *field.Error
+	// key is the key of the annotation.
+	key string
+	// replacement is the CRD field that supersedes the annotation. Empty if the CRDs don't support
+	// the behavior yet.
+	replacement string
+	// kinds are the kinds of the resources that support the annotation.
+	kinds []string
+}
+
+// annotationSpecs is the allowlist of the annotations of the escape hatch. New behaviors are added here
+// only until the CRDs support them.
+var annotationSpecs = []annotationSpec{
+	{
+		key:         UseRegexAnnotation,
+		kinds:       []string{kinds.HTTPRoute},
+		replacement: "the RegularExpression type of spec.rules[].matches[].path",
+		parse: func(path *field.Path, value string, settings *AnnotationSettings) *field.Error {
+			return parseBoolAnnotation(path, value, &settings.UseRegex)
+		},
+	},
+	{
+		key:         MirrorAnnotation,
+		kinds:       []string{kinds.HTTPRoute},
+		replacement: "a RequestMirror filter in spec.rules[].filters",
+		parse:       parseMirrorAnnotation,
+	},
+	{
+		key:         StreamingAnnotation,
+		kinds:       []string{kinds.HTTPRoute, kinds.Gateway},
+		replacement: "spec.buffering.enable of a ClientSettingsPolicy",
+		parse: func(path *field.Path, value string, settings *AnnotationSettings) *field.Error {
+			return parseBoolAnnotation(path, value, &settings.Streaming)
+		},
+	},
+}
+
+// buildAnnotationSettings builds the AnnotationSettings from the annotations of a resource of the kind.
+// It returns the validation errors of the annotations and a deprecation message that steers the users towards
+// the CRD fields that supersede the annotations. The message is empty if no annotation is deprecated.
+func buildAnnotationSettings(kind string, annotations map[string]string) (AnnotationSettings, field.ErrorList, string) {
+	var (
+		settings     AnnotationSettings
+		allErrs      field.ErrorList
+		deprecations []string
+	)
+
+	annotationsPath := field.NewPath("metadata").Child("annotations")
+
+	for _, spec := range annotationSpecs {
+		value, exists := annotations[spec.key]
+		if !exists {
+			continue
+		}
+
+		path := annotationsPath.Key(spec.key)
+
+		if !slices.Contains(spec.kinds, kind) {
+			allErrs = append(allErrs, field.Forbidden(path, fmt.Sprintf("not supported for a %s", kind)))
+			continue
+		}
+
+		if err := spec.parse(path, value, &settings); err != nil {
+			allErrs = append(allErrs, err)
+			continue
+		}
+
+		if spec.replacement != "" {
+			deprecations = append(deprecations, fmt.Sprintf("use %s instead of %s", spec.replacement, spec.key))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return AnnotationSettings{}, allErrs, ""
+	}
+
+	var msg string
+	if len(deprecations) > 0 {
+		msg = "Some annotations are deprecated and will be removed in a future release: " +
+			strings.Join(deprecations, "; ")
+	}
+
+	return settings, nil, msg
+}
+
+func parseBoolAnnotation(path *field.Path, value string, result *bool) *field.Error {
+	switch value {
+	case "true":
+		*result = true
+	case "false":
+	default:
+		return field.NotSupported(path, value, []string{"true", "false"})
+	}
+
+	return nil
+}
+
+func parseMirrorAnnotation(path *field.Path, value string, settings *AnnotationSettings) *field.Error {
+	const detail = "must be the name and the port of a Service, for example, mirror-svc:8080"
+
+	name, portValue, found := strings.Cut(value, ":")
+	if !found || len(validation.IsDNS1035Label(name)) > 0 {
+		return field.Invalid(path, value, detail)
+	}
+
+	port, err := strconv.ParseInt(portValue, 10, 32)
+	if err != nil || len(validation.IsValidPortNum(int(port))) > 0 {
+		return field.Invalid(path, value, detail)
+	}
+
+	settings.Mirror = &v1.BackendObjectReference{
+		Group: helpers.GetPointer[v1.Group](""),
+		Kind:  helpers.GetPointer[v1.Kind](kinds.Service),
+		Name:  v1.ObjectName(name),
+		Port:  helpers.GetPointer(v1.PortNumber(port)),
+	}
+
+	return nil
+}
+
+// applyAnnotationSettings returns the rules of an HTTPRoute with the path matches and the filters that
+// the settings translate to. The rules of the HTTPRoute are not modified.
+func applyAnnotationSettings(specRules []v1.HTTPRouteRule, settings AnnotationSettings) []v1.HTTPRouteRule {
+	if !settings.UseRegex && settings.Mirror == nil {
+		return specRules
+	}
+
+	rules := make([]v1.HTTPRouteRule, 0, len(specRules))
+
+	for _, specRule := range specRules {
+		rule := specRule.DeepCopy()
+
+		if settings.UseRegex {
+			for _, match := range rule.Matches {
+				if match.Path != nil && match.Path.Type != nil && *match.Path.Type == v1.PathMatchPathPrefix {
+					*match.Path.Type = v1.PathMatchRegularExpression
+				}
+			}
+		}
+
+		if settings.Mirror != nil {
+			rule.Filters = append(rule.Filters, v1.HTTPRouteFilter{
+				Type:          v1.HTTPRouteFilterRequestMirror,
+				RequestMirror: &v1.HTTPRequestMirrorFilter{BackendRef: *settings.Mirror},
+			})
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	return rules
+}
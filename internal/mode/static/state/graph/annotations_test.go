@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func TestBuildAnnotationSettings(t *testing.T) {
+	t.Parallel()
+
+	mirror := &v1.BackendObjectReference{
+		Group: helpers.GetPointer[v1.Group](""),
+		Kind:  helpers.GetPointer[v1.Kind](kinds.Service),
+		Name:  "mirror-svc",
+		Port:  helpers.GetPointer[v1.PortNumber](8080),
+	}
+
+	tests := []struct {
+		annotations    map[string]string
+		name           string
+		kind           string
+		expErr         string
+		expDeprecation string
+		expSettings    AnnotationSettings
+	}{
+		{
+			name: "no annotations",
+			kind: kinds.HTTPRoute,
+		},
+		{
+			name: "unrelated annotations",
+			kind: kinds.HTTPRoute,
+			annotations: map[string]string{
+				CaseInsensitivePathMatchAnnotation: "true",
+				"example.com/annotation":           "value",
+			},
+		},
+		{
+			name: "all annotations of an HTTPRoute",
+			kind: kinds.HTTPRoute,
+			annotations: map[string]string{
+				UseRegexAnnotation:  "true",
+				MirrorAnnotation:    "mirror-svc:8080",
+				StreamingAnnotation: "true",
+			},
+			expSettings: AnnotationSettings{
+				Mirror:    mirror,
+				UseRegex:  true,
+				Streaming: true,
+			},
+			expDeprecation: "Some annotations are deprecated and will be removed in a future release: " +
+				"use the RegularExpression type of spec.rules[].matches[].path instead of gateway.nginx.org/use-regex; " +
+				"use a RequestMirror filter in spec.rules[].filters instead of gateway.nginx.org/mirror; " +
+				"use spec.buffering.enable of a ClientSettingsPolicy instead of gateway.nginx.org/streaming",
+		},
+		{
+			name: "disabled behaviors",
+			kind: kinds.HTTPRoute,
+			annotations: map[string]string{
+				UseRegexAnnotation:  "false",
+				StreamingAnnotation: "false",
+			},
+			expDeprecation: "Some annotations are deprecated and will be removed in a future release: " +
+				"use the RegularExpression type of spec.rules[].matches[].path instead of gateway.nginx.org/use-regex; " +
+				"use spec.buffering.enable of a ClientSettingsPolicy instead of gateway.nginx.org/streaming",
+		},
+		{
+			name: "streaming for a Gateway",
+			kind: kinds.Gateway,
+			annotations: map[string]string{
+				StreamingAnnotation: "true",
+			},
+			expSettings: AnnotationSettings{
+				Streaming: true,
+			},
+			expDeprecation: "Some annotations are deprecated and will be removed in a future release: " +
+				"use spec.buffering.enable of a ClientSettingsPolicy instead of gateway.nginx.org/streaming",
+		},
+		{
+			name: "annotations not supported for a Gateway",
+			kind: kinds.Gateway,
+			annotations: map[string]string{
+				UseRegexAnnotation:  "true",
+				MirrorAnnotation:    "mirror-svc:8080",
+				StreamingAnnotation: "true",
+			},
+			expErr: "[metadata.annotations[gateway.nginx.org/use-regex]: Forbidden: not supported for a Gateway, " +
+				"metadata.annotations[gateway.nginx.org/mirror]: Forbidden: not supported for a Gateway]",
+		},
+		{
+			name: "invalid values",
+			kind: kinds.HTTPRoute,
+			annotations: map[string]string{
+				UseRegexAnnotation:  "yes",
+				MirrorAnnotation:    "mirror-svc:0",
+				StreamingAnnotation: "on",
+			},
+			expErr: `[metadata.annotations[gateway.nginx.org/use-regex]: ` +
+				`Unsupported value: "yes": supported values: "true", "false", ` +
+				`metadata.annotations[gateway.nginx.org/mirror]: Invalid value: "mirror-svc:0": ` +
+				`must be the name and the port of a Service, for example, mirror-svc:8080, ` +
+				`metadata.annotations[gateway.nginx.org/streaming]: ` +
+				`Unsupported value: "on": supported values: "true", "false"]`,
+		},
+		{
+			name: "invalid mirror Service name",
+			kind: kinds.HTTPRoute,
+			annotations: map[string]string{
+				MirrorAnnotation: "Mirror.svc:8080",
+			},
+			expErr: `metadata.annotations[gateway.nginx.org/mirror]: Invalid value: "Mirror.svc:8080": ` +
+				`must be the name and the port of a Service, for example, mirror-svc:8080`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			settings, errs, deprecation := buildAnnotationSettings(test.kind, test.annotations)
+
+			if test.expErr != "" {
+				g.Expect(errs.ToAggregate()).To(MatchError(test.expErr))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+
+			g.Expect(settings).To(Equal(test.expSettings))
+			g.Expect(deprecation).To(Equal(test.expDeprecation))
+		})
+	}
+}
+
+func TestApplyAnnotationSettings(t *testing.T) {
+	t.Parallel()
+
+	createMatch := func(pathType v1.PathMatchType) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(pathType),
+				Value: helpers.GetPointer("/coffee"),
+			},
+		}
+	}
+
+	redirectFilter := v1.HTTPRouteFilter{
+		Type:            v1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1.HTTPRequestRedirectFilter{},
+	}
+
+	mirror := v1.BackendObjectReference{
+		Name: "mirror-svc",
+		Port: helpers.GetPointer[v1.PortNumber](8080),
+	}
+
+	mirrorFilter := v1.HTTPRouteFilter{
+		Type:          v1.HTTPRouteFilterRequestMirror,
+		RequestMirror: &v1.HTTPRequestMirrorFilter{BackendRef: mirror},
+	}
+
+	specRules := []v1.HTTPRouteRule{
+		{
+			Matches: []v1.HTTPRouteMatch{createMatch(v1.PathMatchPathPrefix), createMatch(v1.PathMatchExact)},
+			Filters: []v1.HTTPRouteFilter{redirectFilter},
+		},
+		{
+			Matches: []v1.HTTPRouteMatch{{}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expRules []v1.HTTPRouteRule
+		settings AnnotationSettings
+	}{
+		{
+			name:     "no settings",
+			expRules: specRules,
+		},
+		{
+			name:     "streaming doesn't change the rules",
+			settings: AnnotationSettings{Streaming: true},
+			expRules: specRules,
+		},
+		{
+			name:     "regex",
+			settings: AnnotationSettings{UseRegex: true},
+			expRules: []v1.HTTPRouteRule{
+				{
+					Matches: []v1.HTTPRouteMatch{
+						createMatch(v1.PathMatchRegularExpression),
+						createMatch(v1.PathMatchExact),
+					},
+					Filters: []v1.HTTPRouteFilter{redirectFilter},
+				},
+				{
+					Matches: []v1.HTTPRouteMatch{{}},
+				},
+			},
+		},
+		{
+			name:     "mirror",
+			settings: AnnotationSettings{Mirror: &mirror},
+			expRules: []v1.HTTPRouteRule{
+				{
+					Matches: []v1.HTTPRouteMatch{createMatch(v1.PathMatchPathPrefix), createMatch(v1.PathMatchExact)},
+					Filters: []v1.HTTPRouteFilter{redirectFilter, mirrorFilter},
+				},
+				{
+					Matches: []v1.HTTPRouteMatch{{}},
+					Filters: []v1.HTTPRouteFilter{mirrorFilter},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(applyAnnotationSettings(specRules, test.settings)).To(Equal(test.expRules))
+			g.Expect(*specRules[0].Matches[0].Path.Type).To(Equal(v1.PathMatchPathPrefix))
+			g.Expect(specRules[0].Filters).To(HaveLen(1))
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// DefaultSessionName is the name of the session cookie when the BackendLBPolicy doesn't specify one.
+const DefaultSessionName = "ngf_session"
+
+const (
+	sessionNameFmt    = `[A-Za-z0-9_]+`
+	sessionNameErrMsg = "must contain only alphanumeric characters or '_'"
+)
+
+var sessionNameFmtRegexp = regexp.MustCompile("^" + sessionNameFmt + "$")
+
+// BackendLBPolicy is the internal representation of a BackendLBPolicy.
+type BackendLBPolicy struct {
+	// Source is the source resource.
+	Source *v1alpha2.BackendLBPolicy
+	// Gateway is the name of the Gateway that is being checked for this BackendLBPolicy.
+	Gateway types.NamespacedName
+	// Conditions include Conditions for the BackendLBPolicy.
+	Conditions []conditions.Condition
+	// Valid shows whether the BackendLBPolicy is valid.
+	Valid bool
+	// IsReferenced shows whether the BackendLBPolicy is referenced by a BackendRef.
+	IsReferenced bool
+	// Ignored shows whether the BackendLBPolicy is ignored.
+	Ignored bool
+}
+
+func processBackendLBPolicies(
+	backendLBPolicies map[types.NamespacedName]*v1alpha2.BackendLBPolicy,
+	ctlrName string,
+	gateway *Gateway,
+) map[types.NamespacedName]*BackendLBPolicy {
+	if len(backendLBPolicies) == 0 || gateway == nil {
+		return nil
+	}
+
+	processedBackendLBPolicies := make(map[types.NamespacedName]*BackendLBPolicy, len(backendLBPolicies))
+	for nsname, backendLBPolicy := range backendLBPolicies {
+		valid, ignored, conds := validateBackendLBPolicy(backendLBPolicy, ctlrName)
+
+		processedBackendLBPolicies[nsname] = &BackendLBPolicy{
+			Source:     backendLBPolicy,
+			Valid:      valid,
+			Conditions: conds,
+			Gateway: types.NamespacedName{
+				Namespace: gateway.Source.Namespace,
+				Name:      gateway.Source.Name,
+			},
+			Ignored: ignored,
+		}
+	}
+
+	return processedBackendLBPolicies
+}
+
+func validateBackendLBPolicy(
+	backendLBPolicy *v1alpha2.BackendLBPolicy,
+	ctlrName string,
+) (valid, ignored bool, conds []conditions.Condition) {
+	valid = true
+
+	if backendTLSPolicyAncestorsFull(backendLBPolicy.Status.Ancestors, ctlrName) {
+		valid = false
+		ignored = true
+	}
+
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	for i, ref := range backendLBPolicy.Spec.TargetRefs {
+		if (ref.Group != "" && ref.Group != "core") || ref.Kind != kinds.Service {
+			refPath := specPath.Child("targetRefs").Index(i)
+			allErrs = append(allErrs, field.NotSupported(
+				refPath.Child("kind"),
+				fmt.Sprintf("%s/%s", ref.Group, ref.Kind),
+				[]string{kinds.Service},
+			))
+		}
+	}
+
+	if sp := backendLBPolicy.Spec.SessionPersistence; sp != nil {
+		allErrs = append(allErrs, validateSessionPersistence(*sp, specPath.Child("sessionPersistence"))...)
+	}
+
+	if len(allErrs) > 0 {
+		valid = false
+		conds = append(conds, staticConds.NewPolicyInvalid(allErrs.ToAggregate().Error()))
+	}
+
+	return valid, ignored, conds
+}
+
+func validateSessionPersistence(sp v1.SessionPersistence, spPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if sp.Type != nil && *sp.Type != v1.CookieBasedSessionPersistence {
+		allErrs = append(allErrs, field.NotSupported(
+			spPath.Child("type"),
+			*sp.Type,
+			[]string{string(v1.CookieBasedSessionPersistence)},
+		))
+	}
+
+	if sp.SessionName != nil && !sessionNameFmtRegexp.MatchString(*sp.SessionName) {
+		allErrs = append(allErrs, field.Invalid(
+			spPath.Child("sessionName"),
+			*sp.SessionName,
+			fmt.Sprintf("%s (regex used for validation is '%s')", sessionNameErrMsg, sessionNameFmt),
+		))
+	}
+
+	if sp.IdleTimeout != nil {
+		allErrs = append(allErrs, field.Forbidden(spPath.Child("idleTimeout"), "idleTimeout is not supported"))
+	}
+
+	permanent := sp.CookieConfig != nil && sp.CookieConfig.LifetimeType != nil &&
+		*sp.CookieConfig.LifetimeType == v1.PermanentCookieLifetimeType
+
+	if sp.AbsoluteTimeout != nil {
+		timeoutPath := spPath.Child("absoluteTimeout")
+
+		switch d, err := parseDuration(sp.AbsoluteTimeout); {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(timeoutPath, *sp.AbsoluteTimeout, err.Error()))
+		case !permanent:
+			allErrs = append(allErrs, field.Forbidden(
+				timeoutPath,
+				"absoluteTimeout is only supported for the Permanent cookie lifetime type",
+			))
+		case d <= 0:
+			allErrs = append(allErrs, field.Invalid(timeoutPath, *sp.AbsoluteTimeout, "must be greater than zero"))
+		}
+	} else if permanent {
+		allErrs = append(allErrs, field.Required(
+			spPath.Child("absoluteTimeout"),
+			"absoluteTimeout is required for the Permanent cookie lifetime type",
+		))
+	}
+
+	return allErrs
+}
+
+func findBackendLBPolicyForService(
+	backendLBPolicies map[types.NamespacedName]*BackendLBPolicy,
+	svcNsName types.NamespacedName,
+) *BackendLBPolicy {
+	var beLBPolicy *BackendLBPolicy
+
+	for _, blp := range backendLBPolicies {
+		if blp.Source.Namespace != svcNsName.Namespace {
+			continue
+		}
+
+		for _, targetRef := range blp.Source.Spec.TargetRefs {
+			if string(targetRef.Name) != svcNsName.Name {
+				continue
+			}
+
+			if beLBPolicy == nil || sort.LessClientObject(blp.Source, beLBPolicy.Source) {
+				beLBPolicy = blp
+			}
+		}
+	}
+
+	if beLBPolicy != nil && !beLBPolicy.IsReferenced {
+		beLBPolicy.IsReferenced = true
+		if beLBPolicy.Valid {
+			beLBPolicy.Conditions = append(beLBPolicy.Conditions, staticConds.NewPolicyAccepted())
+		}
+	}
+
+	return beLBPolicy
+}
+
+// addBackendLBPoliciesToRouteRules sets the BackendLBPolicy of the Services referenced by the backendRefs of the
+// Route rules, including the backendRefs of the Request Mirror filters.
+// The upstream snippet of a rule that sets the load balancing method replaces the load balancing method that
+// implements session persistence, so the BackendLBPolicies with session persistence are not set for the
+// backendRefs of such a rule, and the conflict is reported in the conditions of the Route.
+func addBackendLBPoliciesToRouteRules(
+	routes map[RouteKey]*L7Route,
+	backendLBPolicies map[types.NamespacedName]*BackendLBPolicy,
+) {
+	if len(backendLBPolicies) == 0 {
+		return
+	}
+
+	setPolicy := func(ref *BackendRef) {
+		if ref.Valid {
+			ref.BackendLBPolicy = findBackendLBPolicyForService(backendLBPolicies, ref.SvcNsName)
+		}
+	}
+
+	for _, r := range routes {
+		var conflicts []string
+
+		for ruleIdx := range r.Spec.Rules {
+			rule := &r.Spec.Rules[ruleIdx]
+
+			filterIdx, lbDirective := findLoadBalancingDirective(rule.Filters.Filters)
+
+			for refIdx := range rule.BackendRefs {
+				ref := &rule.BackendRefs[refIdx]
+				setPolicy(ref)
+
+				if filterIdx < 0 || !hasSessionPersistence(ref.BackendLBPolicy) {
+					continue
+				}
+
+				conflict := fmt.Sprintf(
+					"%s: directive %q in context %s sets the load balancing method, "+
+						"so the session persistence of BackendLBPolicy %s for Service %s is ignored",
+					field.NewPath("spec", "rules").Index(ruleIdx).Child("filters").Index(filterIdx).Child("extensionRef"),
+					lbDirective,
+					ngfAPI.NginxContextHTTPUpstream,
+					client.ObjectKeyFromObject(ref.BackendLBPolicy.Source),
+					ref.SvcNsName,
+				)
+				if !slices.Contains(conflicts, conflict) {
+					conflicts = append(conflicts, conflict)
+				}
+
+				ref.BackendLBPolicy = nil
+			}
+
+			for _, filter := range rule.Filters.Filters {
+				if filter.MirrorBackendRef != nil {
+					setPolicy(filter.MirrorBackendRef)
+				}
+			}
+		}
+
+		if len(conflicts) > 0 {
+			r.Conditions = append(
+				r.Conditions,
+				staticConds.NewRouteSessionPersistenceIgnored(strings.Join(conflicts, "; ")),
+			)
+		}
+	}
+}
+
+// hasSessionPersistence returns true if the BackendLBPolicy is valid and configures session persistence.
+func hasSessionPersistence(blp *BackendLBPolicy) bool {
+	return blp != nil && blp.Valid && blp.Source.Spec.SessionPersistence != nil
+}
@@ -0,0 +1,430 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestProcessBackendLBPolicies(t *testing.T) {
+	t.Parallel()
+
+	policy := &v1alpha2.BackendLBPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "lb-policy",
+			Namespace: "test",
+		},
+		Spec: v1alpha2.BackendLBPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Kind: "Service",
+					Name: "service1",
+				},
+			},
+			SessionPersistence: &gatewayv1.SessionPersistence{
+				SessionName: helpers.GetPointer("session"),
+			},
+		},
+	}
+
+	policyNsName := types.NamespacedName{Namespace: "test", Name: "lb-policy"}
+	backendLBPolicies := map[types.NamespacedName]*v1alpha2.BackendLBPolicy{
+		policyNsName: policy,
+	}
+
+	gateway := &Gateway{
+		Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "test"}},
+	}
+
+	tests := []struct {
+		expected          map[types.NamespacedName]*BackendLBPolicy
+		gateway           *Gateway
+		backendLBPolicies map[types.NamespacedName]*v1alpha2.BackendLBPolicy
+		name              string
+	}{
+		{
+			name:              "no policies",
+			expected:          nil,
+			gateway:           gateway,
+			backendLBPolicies: nil,
+		},
+		{
+			name:              "nil gateway",
+			expected:          nil,
+			backendLBPolicies: backendLBPolicies,
+			gateway:           nil,
+		},
+		{
+			name:              "valid policy",
+			gateway:           gateway,
+			backendLBPolicies: backendLBPolicies,
+			expected: map[types.NamespacedName]*BackendLBPolicy{
+				policyNsName: {
+					Source:  policy,
+					Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+					Valid:   true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			processed := processBackendLBPolicies(test.backendLBPolicies, "test", test.gateway)
+
+			g.Expect(processed).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestValidateBackendLBPolicy(t *testing.T) {
+	t.Parallel()
+
+	serviceTargetRefs := []v1alpha2.LocalPolicyTargetReference{
+		{
+			Kind: "Service",
+			Name: "service1",
+		},
+	}
+
+	ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, 16)
+	for range 16 {
+		ancestors = append(ancestors, v1alpha2.PolicyAncestorStatus{
+			ControllerName: "other-controller",
+		})
+	}
+
+	permanent := &gatewayv1.CookieConfig{
+		LifetimeType: helpers.GetPointer(gatewayv1.PermanentCookieLifetimeType),
+	}
+
+	tests := []struct {
+		sessionPersistence *gatewayv1.SessionPersistence
+		name               string
+		targetRefs         []v1alpha2.LocalPolicyTargetReference
+		ancestors          []v1alpha2.PolicyAncestorStatus
+		expConditions      []conditions.Condition
+		expValid           bool
+		expIgnored         bool
+	}{
+		{
+			name:       "no session persistence",
+			targetRefs: serviceTargetRefs,
+			expValid:   true,
+		},
+		{
+			name:               "empty session persistence",
+			targetRefs:         serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{},
+			expValid:           true,
+		},
+		{
+			name:       "cookie session persistence with all supported fields",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				SessionName:     helpers.GetPointer("my_session1"),
+				AbsoluteTimeout: helpers.GetPointer[gatewayv1.Duration]("1h"),
+				Type:            helpers.GetPointer(gatewayv1.CookieBasedSessionPersistence),
+				CookieConfig:    permanent,
+			},
+			expValid: true,
+		},
+		{
+			name:       "invalid target ref",
+			targetRefs: []v1alpha2.LocalPolicyTargetReference{{Group: "apps", Kind: "Deployment", Name: "dep"}},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`spec.targetRefs[0].kind: Unsupported value: "apps/Deployment": supported values: "Service"`,
+				),
+			},
+		},
+		{
+			name:       "unsupported fields",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				SessionName: helpers.GetPointer("my-session"),
+				IdleTimeout: helpers.GetPointer[gatewayv1.Duration]("10s"),
+				Type:        helpers.GetPointer(gatewayv1.HeaderBasedSessionPersistence),
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`[spec.sessionPersistence.type: Unsupported value: "Header": supported values: "Cookie", ` +
+						`spec.sessionPersistence.sessionName: Invalid value: "my-session": must contain only ` +
+						`alphanumeric characters or '_' (regex used for validation is '[A-Za-z0-9_]+'), ` +
+						`spec.sessionPersistence.idleTimeout: Forbidden: idleTimeout is not supported]`,
+				),
+			},
+		},
+		{
+			name:       "absolute timeout with session cookie",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				AbsoluteTimeout: helpers.GetPointer[gatewayv1.Duration]("1h"),
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.sessionPersistence.absoluteTimeout: Forbidden: absoluteTimeout is only supported " +
+						"for the Permanent cookie lifetime type",
+				),
+			},
+		},
+		{
+			name:       "invalid absolute timeout",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				AbsoluteTimeout: helpers.GetPointer[gatewayv1.Duration]("1d"),
+				CookieConfig:    permanent,
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`spec.sessionPersistence.absoluteTimeout: Invalid value: "1d": must match the format ` +
+						durationFmt,
+				),
+			},
+		},
+		{
+			name:       "zero absolute timeout",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				AbsoluteTimeout: helpers.GetPointer[gatewayv1.Duration]("0s"),
+				CookieConfig:    permanent,
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`spec.sessionPersistence.absoluteTimeout: Invalid value: "0s": must be greater than zero`,
+				),
+			},
+		},
+		{
+			name:       "permanent cookie without absolute timeout",
+			targetRefs: serviceTargetRefs,
+			sessionPersistence: &gatewayv1.SessionPersistence{
+				CookieConfig: permanent,
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.sessionPersistence.absoluteTimeout: Required value: absoluteTimeout is required " +
+						"for the Permanent cookie lifetime type",
+				),
+			},
+		},
+		{
+			name:       "too many ancestors",
+			targetRefs: serviceTargetRefs,
+			ancestors:  ancestors,
+			expIgnored: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &v1alpha2.BackendLBPolicy{
+				Spec: v1alpha2.BackendLBPolicySpec{
+					TargetRefs:         test.targetRefs,
+					SessionPersistence: test.sessionPersistence,
+				},
+				Status: v1alpha2.PolicyStatus{
+					Ancestors: test.ancestors,
+				},
+			}
+
+			valid, ignored, conds := validateBackendLBPolicy(policy, "test")
+
+			g.Expect(valid).To(Equal(test.expValid))
+			g.Expect(ignored).To(Equal(test.expIgnored))
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestAddBackendLBPoliciesToRouteRules(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now()
+
+	createPolicy := func(name, svcName string, valid bool, age time.Duration) *BackendLBPolicy {
+		return &BackendLBPolicy{
+			Source: &v1alpha2.BackendLBPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         "test",
+					CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				},
+				Spec: v1alpha2.BackendLBPolicySpec{
+					TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+						{
+							Kind: "Service",
+							Name: gatewayv1.ObjectName(svcName),
+						},
+					},
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	oldPolicy := createPolicy("old", "svc1", true, time.Hour)
+	newPolicy := createPolicy("new", "svc1", true, 0)
+	invalidPolicy := createPolicy("invalid", "svc2", false, 0)
+	unusedPolicy := createPolicy("unused", "svc3", true, 0)
+
+	backendLBPolicies := map[types.NamespacedName]*BackendLBPolicy{
+		{Namespace: "test", Name: "old"}:     oldPolicy,
+		{Namespace: "test", Name: "new"}:     newPolicy,
+		{Namespace: "test", Name: "invalid"}: invalidPolicy,
+		{Namespace: "test", Name: "unused"}:  unusedPolicy,
+	}
+
+	mirrorRef := &BackendRef{SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc2"}, Valid: true}
+
+	route := &L7Route{
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{
+						{SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc1"}, Valid: true},
+						{SvcNsName: types.NamespacedName{Namespace: "other", Name: "svc1"}, Valid: true},
+						{SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc1"}, Valid: false},
+					},
+					Filters: RouteRuleFilters{
+						Filters: []Filter{
+							{
+								FilterType:       FilterRequestMirror,
+								MirrorBackendRef: mirrorRef,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	addBackendLBPoliciesToRouteRules(map[RouteKey]*L7Route{{}: route}, backendLBPolicies)
+
+	backendRefs := route.Spec.Rules[0].BackendRefs
+	g.Expect(backendRefs[0].BackendLBPolicy).To(Equal(oldPolicy))
+	g.Expect(backendRefs[1].BackendLBPolicy).To(BeNil())
+	g.Expect(backendRefs[2].BackendLBPolicy).To(BeNil())
+	g.Expect(mirrorRef.BackendLBPolicy).To(Equal(invalidPolicy))
+
+	g.Expect(oldPolicy.IsReferenced).To(BeTrue())
+	g.Expect(oldPolicy.Conditions).To(Equal([]conditions.Condition{staticConds.NewPolicyAccepted()}))
+	g.Expect(newPolicy.IsReferenced).To(BeFalse())
+	g.Expect(invalidPolicy.IsReferenced).To(BeTrue())
+	g.Expect(invalidPolicy.Conditions).To(BeEmpty())
+	g.Expect(unusedPolicy.IsReferenced).To(BeFalse())
+}
+
+func TestAddBackendLBPoliciesToRouteRules_LoadBalancingSnippet(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createPolicy := func(name, svcName string, sp *v1alpha2.SessionPersistence) *BackendLBPolicy {
+		return &BackendLBPolicy{
+			Source: &v1alpha2.BackendLBPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec: v1alpha2.BackendLBPolicySpec{
+					TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+						{
+							Kind: "Service",
+							Name: gatewayv1.ObjectName(svcName),
+						},
+					},
+					SessionPersistence: sp,
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	createSnippetsFilter := func(upstreamSnippet string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterExtensionRef,
+			ResolvedExtensionRef: &ExtensionRefFilter{
+				SnippetsFilter: &SnippetsFilter{
+					Source: &ngfAPI.SnippetsFilter{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "upstream"},
+					},
+					Snippets: map[ngfAPI.NginxContext]string{ngfAPI.NginxContextHTTPUpstream: upstreamSnippet},
+					Valid:    true,
+				},
+				Valid: true,
+			},
+		}
+	}
+
+	sessionPolicy := createPolicy("session", "svc1", &v1alpha2.SessionPersistence{})
+	noSessionPolicy := createPolicy("no-session", "svc2", nil)
+
+	backendLBPolicies := map[types.NamespacedName]*BackendLBPolicy{
+		{Namespace: "test", Name: "session"}:    sessionPolicy,
+		{Namespace: "test", Name: "no-session"}: noSessionPolicy,
+	}
+
+	svc1 := types.NamespacedName{Namespace: "test", Name: "svc1"}
+	svc2 := types.NamespacedName{Namespace: "test", Name: "svc2"}
+	mirrorRef := &BackendRef{SvcNsName: svc1, Valid: true}
+
+	route := &L7Route{
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					BackendRefs: []BackendRef{{SvcNsName: svc1, Valid: true}},
+					Filters: RouteRuleFilters{
+						Filters: []Filter{createSnippetsFilter("keepalive 16;")},
+					},
+				},
+				{
+					BackendRefs: []BackendRef{
+						{SvcNsName: svc1, Valid: true},
+						{SvcNsName: svc1, Valid: true},
+						{SvcNsName: svc2, Valid: true},
+					},
+					Filters: RouteRuleFilters{
+						Filters: []Filter{
+							{FilterType: FilterRequestMirror, MirrorBackendRef: mirrorRef},
+							createSnippetsFilter("least_conn;"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	addBackendLBPoliciesToRouteRules(map[RouteKey]*L7Route{{}: route}, backendLBPolicies)
+
+	g.Expect(route.Spec.Rules[0].BackendRefs[0].BackendLBPolicy).To(Equal(sessionPolicy))
+
+	backendRefs := route.Spec.Rules[1].BackendRefs
+	g.Expect(backendRefs[0].BackendLBPolicy).To(BeNil())
+	g.Expect(backendRefs[1].BackendLBPolicy).To(BeNil())
+	g.Expect(backendRefs[2].BackendLBPolicy).To(Equal(noSessionPolicy))
+	// the snippets of a rule don't apply to the backends of its mirrors
+	g.Expect(mirrorRef.BackendLBPolicy).To(Equal(sessionPolicy))
+
+	g.Expect(route.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewRouteSessionPersistenceIgnored(
+			`spec.rules[1].filters[1].extensionRef: directive "least_conn" in context http.upstream sets ` +
+				"the load balancing method, so the session persistence of BackendLBPolicy test/session " +
+				"for Service test/svc1 is ignored",
+		),
+	}))
+}
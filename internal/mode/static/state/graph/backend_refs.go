@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,8 +24,13 @@ import (
 type BackendRef struct {
 	// BackendTLSPolicy is the BackendTLSPolicy of the Service which is referenced by the backendRef.
 	BackendTLSPolicy *BackendTLSPolicy
+	// BackendLBPolicy is the BackendLBPolicy of the Service which is referenced by the backendRef.
+	BackendLBPolicy *BackendLBPolicy
 	// SvcNsName is the NamespacedName of the Service referenced by the backendRef.
 	SvcNsName types.NamespacedName
+	// ExternalName is the external DNS name of the Service which is referenced by the backendRef.
+	// Only set if the Service is of type ExternalName.
+	ExternalName string
 	// ServicePort is the ServicePort of the Service which is referenced by the backendRef.
 	ServicePort v1.ServicePort
 	// Weight is the weight of the backendRef.
@@ -48,20 +54,23 @@ func addBackendRefsToRouteRules(
 	services map[types.NamespacedName]*v1.Service,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	npCfg *NginxProxy,
+	allowExternalNameServices bool,
 ) {
 	for _, r := range routes {
-		addBackendRefsToRules(r, refGrantResolver, services, backendTLSPolicies, npCfg)
+		addBackendRefsToRules(r, refGrantResolver, services, backendTLSPolicies, npCfg, allowExternalNameServices)
 	}
 }
 
 // addHTTPBackendRefsToRules iterates over the rules of a Route and adds a list of BackendRef to each rule.
 // If a reference in a rule is invalid, the function will add a condition to the rule.
+// The references to ExternalName Services are only valid if allowExternalNameServices is true.
 func addBackendRefsToRules(
 	route *L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	npCfg *NginxProxy,
+	allowExternalNameServices bool,
 ) {
 	if !route.Valid {
 		return
@@ -75,6 +84,16 @@ func addBackendRefsToRules(
 			continue
 		}
 
+		addMirrorBackendRefsToFilters(
+			route,
+			idx,
+			refGrantResolver,
+			services,
+			backendTLSPolicies,
+			npCfg,
+			allowExternalNameServices,
+		)
+
 		// zero backendRefs is OK. For example, a rule can include a redirect filter.
 		if len(rule.RouteBackendRefs) == 0 {
 			continue
@@ -94,6 +113,7 @@ func addBackendRefsToRules(
 				refPath,
 				backendTLSPolicies,
 				npCfg,
+				allowExternalNameServices,
 			)
 
 			backendRefs = append(backendRefs, ref)
@@ -116,6 +136,48 @@ func addBackendRefsToRules(
 	}
 }
 
+// addMirrorBackendRefsToFilters resolves the backendRefs of the Request Mirror filters of a Route rule.
+// If a reference is invalid, the function will add a condition to the Route.
+func addMirrorBackendRefsToFilters(
+	route *L7Route,
+	ruleIdx int,
+	refGrantResolver *referenceGrantResolver,
+	services map[types.NamespacedName]*v1.Service,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	npCfg *NginxProxy,
+	allowExternalNameServices bool,
+) {
+	filters := route.Spec.Rules[ruleIdx].Filters.Filters
+
+	for filterIdx, filter := range filters {
+		if filter.FilterType != FilterRequestMirror || filter.RequestMirror == nil {
+			continue
+		}
+
+		refPath := field.NewPath("spec").Child("rules").Index(ruleIdx).
+			Child("filters").Index(filterIdx).Child("requestMirror").Child("backendRef")
+		routeNs := route.Source.GetNamespace()
+
+		ref, cond := createBackendRef(
+			RouteBackendRef{
+				BackendRef: gatewayv1.BackendRef{BackendObjectReference: filter.RequestMirror.BackendRef},
+			},
+			routeNs,
+			refGrantResolver.refAllowedFrom(getRefGrantFromResourceForRoute(route.RouteType, routeNs)),
+			services,
+			refPath,
+			backendTLSPolicies,
+			npCfg,
+			allowExternalNameServices,
+		)
+
+		filters[filterIdx].MirrorBackendRef = &ref
+		if cond != nil {
+			route.Conditions = append(route.Conditions, *cond)
+		}
+	}
+}
+
 func createBackendRef(
 	ref RouteBackendRef,
 	sourceNamespace string,
@@ -124,6 +186,7 @@ func createBackendRef(
 	refPath *field.Path,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	npCfg *NginxProxy,
+	allowExternalNameServices bool,
 ) (BackendRef, *conditions.Condition) {
 	// Data plane will handle invalid ref by responding with 500.
 	// Because of that, we always need to add a BackendRef to group.Backends, even if the ref is invalid.
@@ -155,7 +218,13 @@ func createBackendRef(
 		ns = string(*ref.Namespace)
 	}
 	svcNsName := types.NamespacedName{Name: string(ref.BackendRef.Name), Namespace: ns}
-	svcIPFamily, svcPort, err := getIPFamilyAndPortFromRef(ref.BackendRef, svcNsName, services, refPath)
+	svcIPFamily, svcPort, err := getIPFamilyAndPortFromRef(
+		ref.BackendRef,
+		svcNsName,
+		services,
+		v1.ProtocolTCP,
+		refPath,
+	)
 	if err != nil {
 		backendRef = BackendRef{
 			Weight:      weight,
@@ -180,6 +249,19 @@ func createBackendRef(
 		return backendRef, &cond
 	}
 
+	externalName, err := getExternalName(services[svcNsName], allowExternalNameServices, refPath)
+	if err != nil {
+		backendRef = BackendRef{
+			SvcNsName:   svcNsName,
+			ServicePort: svcPort,
+			Weight:      weight,
+			Valid:       false,
+		}
+
+		cond := staticConds.NewRouteBackendRefUnsupportedValue(err.Error())
+		return backendRef, &cond
+	}
+
 	backendTLSPolicy, err := findBackendTLSPolicyForService(
 		backendTLSPolicies,
 		ref.Namespace,
@@ -201,6 +283,7 @@ func createBackendRef(
 	backendRef = BackendRef{
 		SvcNsName:        svcNsName,
 		BackendTLSPolicy: backendTLSPolicy,
+		ExternalName:     externalName,
 		ServicePort:      svcPort,
 		Valid:            true,
 		Weight:           weight,
@@ -293,6 +376,8 @@ func findBackendTLSPolicyForService(
 }
 
 // getIPFamilyAndPortFromRef extracts the IPFamily of the Service and the port from a BackendRef.
+// The port is the ServicePort with the port number of the BackendRef and the protocol, which is the protocol
+// the Route proxies the traffic with.
 // It can return an error and an empty v1.ServicePort in two cases:
 // 1. The Service referenced from the BackendRef does not exist in the cluster/state.
 // 2. The Port on the BackendRef does not match any of the ServicePorts on the Service.
@@ -300,6 +385,7 @@ func getIPFamilyAndPortFromRef(
 	ref gatewayv1.BackendRef,
 	svcNsName types.NamespacedName,
 	services map[types.NamespacedName]*v1.Service,
+	protocol v1.Protocol,
 	refPath *field.Path,
 ) ([]v1.IPFamily, v1.ServicePort, error) {
 	svc, ok := services[svcNsName]
@@ -308,14 +394,38 @@ func getIPFamilyAndPortFromRef(
 	}
 
 	// safe to dereference port here because we already validated that the port is not nil in validateBackendRef.
-	svcPort, err := getServicePort(svc, int32(*ref.Port))
+	svcPort, err := getServicePort(svc, int32(*ref.Port), protocol)
 	if err != nil {
-		return []v1.IPFamily{}, v1.ServicePort{}, err
+		// ExternalName Services don't need to define the ports, because the port of the backendRef
+		// is the port of the external host.
+		if svc.Spec.Type != v1.ServiceTypeExternalName {
+			return []v1.IPFamily{}, v1.ServicePort{}, err
+		}
+		svcPort = v1.ServicePort{Port: int32(*ref.Port)}
 	}
 
 	return svc.Spec.IPFamilies, svcPort, nil
 }
 
+// getExternalName returns the external DNS name of the Service if it is of type ExternalName.
+// Because NGINX proxies the requests to any host that the name resolves to, the ExternalName Services
+// are only allowed if explicitly enabled.
+func getExternalName(svc *v1.Service, allowExternalNameServices bool, refPath *field.Path) (string, error) {
+	if svc.Spec.Type != v1.ServiceTypeExternalName {
+		return "", nil
+	}
+
+	if !allowExternalNameServices {
+		return "", field.Forbidden(
+			refPath.Child("name"),
+			"backendRefs to ExternalName Services are not allowed; "+
+				"they must be enabled in the configuration of NGINX Gateway Fabric",
+		)
+	}
+
+	return svc.Spec.ExternalName, nil
+}
+
 func verifyIPFamily(npCfg *NginxProxy, svcIPFamily []v1.IPFamily) error {
 	if npCfg == nil || npCfg.Source == nil || !npCfg.Valid {
 		return nil
@@ -420,14 +530,50 @@ func validateWeight(weight int32) error {
 	return nil
 }
 
-func getServicePort(svc *v1.Service, port int32) (v1.ServicePort, error) {
+// getServicePort returns the ServicePort of the Service with the port number and the protocol. A multi-port
+// Service can expose the same port number with different protocols, like port 53 of a DNS server. The endpoints of
+// the ServicePort are resolved by its name, which can differ from the name of the container port it targets.
+// The empty protocol of a ServicePort defaults to TCP.
+func getServicePort(svc *v1.Service, port int32, protocol v1.Protocol) (v1.ServicePort, error) {
 	for _, p := range svc.Spec.Ports {
-		if p.Port == port {
+		if p.Port == port && getServicePortProtocol(p) == protocol {
 			return p, nil
 		}
 	}
 
-	return v1.ServicePort{}, fmt.Errorf("no matching port for Service %s and port %d", svc.Name, port)
+	available := make([]string, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		available = append(available, formatServicePort(p))
+	}
+
+	msg := fmt.Sprintf("no matching port for Service %s and port %d", svc.Name, port)
+	if protocol != v1.ProtocolTCP {
+		msg += fmt.Sprintf(" with protocol %s", protocol)
+	}
+
+	if len(available) > 0 {
+		msg += "; available ports: " + strings.Join(available, ", ")
+	}
+
+	return v1.ServicePort{}, errors.New(msg)
+}
+
+func getServicePortProtocol(p v1.ServicePort) v1.Protocol {
+	if p.Protocol == "" {
+		return v1.ProtocolTCP
+	}
+
+	return p.Protocol
+}
+
+// formatServicePort formats the ServicePort as <port>/<protocol>, followed by the name in parentheses, if set.
+func formatServicePort(p v1.ServicePort) string {
+	s := fmt.Sprintf("%d/%s", p.Port, getServicePortProtocol(p))
+	if p.Name != "" {
+		s += fmt.Sprintf(" (%s)", p.Name)
+	}
+
+	return s
 }
 
 func getRefGrantFromResourceForRoute(routeType RouteType, routeNs string) fromResource {
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -310,7 +311,13 @@ func TestGetIPFamilyAndPortFromRef(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			svcIPFamily, servicePort, err := getIPFamilyAndPortFromRef(test.ref, test.svcNsName, services, refPath)
+			svcIPFamily, servicePort, err := getIPFamilyAndPortFromRef(
+				test.ref,
+				test.svcNsName,
+				services,
+				v1.ProtocolTCP,
+				refPath,
+			)
 
 			g.Expect(err != nil).To(Equal(test.expErr))
 			g.Expect(servicePort).To(Equal(test.expServicePort))
@@ -752,7 +759,7 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 
 			g := NewWithT(t)
 			resolver := newReferenceGrantResolver(nil)
-			addBackendRefsToRules(test.route, resolver, services, test.policies, nil)
+			addBackendRefsToRules(test.route, resolver, services, test.policies, nil, false)
 
 			var actual []BackendRef
 			if test.route.Spec.Rules != nil {
@@ -765,6 +772,96 @@ func TestAddBackendRefsToRulesTest(t *testing.T) {
 	}
 }
 
+func TestAddMirrorBackendRefsToFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "mirror-svc",
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Port: 80,
+				},
+			},
+		},
+	}
+	services := map[types.NamespacedName]*v1.Service{
+		client.ObjectKeyFromObject(svc): svc,
+	}
+
+	createMirrorFilter := func(svcName string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterRequestMirror,
+			RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				BackendRef: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(svcName),
+					Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+				},
+			},
+		}
+	}
+
+	headerFilter := Filter{
+		RouteType:             RouteTypeHTTP,
+		FilterType:            FilterRequestHeaderModifier,
+		RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{},
+	}
+
+	route := &L7Route{
+		Source: &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "hr",
+			},
+		},
+		RouteType: RouteTypeHTTP,
+		Valid:     true,
+		Spec: L7RouteSpec{
+			Rules: []RouteRule{
+				{
+					ValidMatches: true,
+					Filters: RouteRuleFilters{
+						Filters: []Filter{
+							headerFilter,
+							createMirrorFilter("mirror-svc"),
+							createMirrorFilter("does-not-exist"),
+						},
+						Valid: true,
+					},
+				},
+			},
+		},
+	}
+
+	addBackendRefsToRules(route, newReferenceGrantResolver(nil), services, nil, nil, false)
+
+	filters := route.Spec.Rules[0].Filters.Filters
+	g.Expect(filters[0].MirrorBackendRef).To(BeNil())
+	g.Expect(filters[1].MirrorBackendRef).To(Equal(&BackendRef{
+		SvcNsName:   client.ObjectKeyFromObject(svc),
+		ServicePort: svc.Spec.Ports[0],
+		Weight:      1,
+		Valid:       true,
+	}))
+	g.Expect(filters[2].MirrorBackendRef).To(Equal(&BackendRef{
+		SvcNsName: types.NamespacedName{Namespace: "test", Name: "does-not-exist"},
+		Weight:    1,
+		Valid:     false,
+	}))
+
+	g.Expect(route.Spec.Rules[0].BackendRefs).To(BeEmpty())
+	g.Expect(route.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewRouteBackendRefRefBackendNotFound(
+			`spec.rules[0].filters[2].requestMirror.backendRef.name: Not found: "does-not-exist"`,
+		),
+	}))
+}
+
 func TestCreateBackend(t *testing.T) {
 	t.Parallel()
 	createService := func(name string) *v1.Service {
@@ -789,6 +886,17 @@ func TestCreateBackend(t *testing.T) {
 	svc1NamespacedName := types.NamespacedName{Namespace: "test", Name: "service1"}
 	svc2NamespacedName := types.NamespacedName{Namespace: "test", Name: "service2"}
 	svc3NamespacedName := types.NamespacedName{Namespace: "test", Name: "service3"}
+	externalSvc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "external",
+			Namespace: "test",
+		},
+		Spec: v1.ServiceSpec{
+			Type:         v1.ServiceTypeExternalName,
+			ExternalName: "api.example.com",
+		},
+	}
+	externalSvcNamespacedName := types.NamespacedName{Namespace: "test", Name: "external"}
 
 	btp := BackendTLSPolicy{
 		Source: &v1alpha3.BackendTLSPolicy{
@@ -850,6 +958,7 @@ func TestCreateBackend(t *testing.T) {
 		expectedServicePortReference string
 		ref                          gatewayv1.HTTPBackendRef
 		expectedBackend              BackendRef
+		allowExternalNameServices    bool
 	}{
 		{
 			ref: gatewayv1.HTTPBackendRef{
@@ -1008,12 +1117,54 @@ func TestCreateBackend(t *testing.T) {
 			),
 			name: "invalid policy",
 		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:    externalSvcNamespacedName,
+				ServicePort:  v1.ServicePort{Port: 80},
+				ExternalName: "api.example.com",
+				Weight:       5,
+				Valid:        true,
+			},
+			allowExternalNameServices:    true,
+			expectedServicePortReference: "test_external_80",
+			expectedCondition:            nil,
+			name:                         "ExternalName service",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:   externalSvcNamespacedName,
+				ServicePort: v1.ServicePort{Port: 80},
+				Weight:      5,
+				Valid:       false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefUnsupportedValue(
+					"test.name: Forbidden: backendRefs to ExternalName Services are not allowed; " +
+						"they must be enabled in the configuration of NGINX Gateway Fabric",
+				),
+			),
+			name: "ExternalName service not allowed",
+		},
 	}
 
 	services := map[types.NamespacedName]*v1.Service{
-		client.ObjectKeyFromObject(svc1): svc1,
-		client.ObjectKeyFromObject(svc2): svc2,
-		client.ObjectKeyFromObject(svc3): svc3,
+		client.ObjectKeyFromObject(svc1):        svc1,
+		client.ObjectKeyFromObject(svc2):        svc2,
+		client.ObjectKeyFromObject(svc3):        svc3,
+		client.ObjectKeyFromObject(externalSvc): externalSvc,
 	}
 	policies := map[types.NamespacedName]*BackendTLSPolicy{
 		client.ObjectKeyFromObject(btp.Source):  &btp,
@@ -1043,6 +1194,7 @@ func TestCreateBackend(t *testing.T) {
 				refPath,
 				policies,
 				test.nginxProxy,
+				test.allowExternalNameServices,
 			)
 
 			g.Expect(helpers.Diff(test.expectedBackend, backend)).To(BeEmpty())
@@ -1074,17 +1226,103 @@ func TestGetServicePort(t *testing.T) {
 	g := NewWithT(t)
 	// ports exist
 	for _, p := range []int32{80, 81, 82} {
-		port, err := getServicePort(svc, p)
+		port, err := getServicePort(svc, p, v1.ProtocolTCP)
 		g.Expect(err).ToNot(HaveOccurred())
 		g.Expect(port.Port).To(Equal(p))
 	}
 
 	// port doesn't exist
-	port, err := getServicePort(svc, 83)
+	port, err := getServicePort(svc, 83, v1.ProtocolTCP)
 	g.Expect(err).Should(HaveOccurred())
 	g.Expect(port.Port).To(Equal(int32(0)))
 }
 
+func TestGetServicePortMultiPortService(t *testing.T) {
+	t.Parallel()
+
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "dns"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name:       "dns-udp",
+					Port:       53,
+					Protocol:   v1.ProtocolUDP,
+					TargetPort: intstr.FromString("dns"),
+				},
+				{
+					Name:       "dns-tcp",
+					Port:       53,
+					Protocol:   v1.ProtocolTCP,
+					TargetPort: intstr.FromString("dns-tcp"),
+				},
+				{
+					Name:       "metrics",
+					Port:       9153,
+					TargetPort: intstr.FromString("metrics"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		protocol  v1.Protocol
+		expName   string
+		expErrMsg string
+		port      int32
+	}{
+		{
+			name:     "TCP port with the same number as a UDP port",
+			port:     53,
+			protocol: v1.ProtocolTCP,
+			expName:  "dns-tcp",
+		},
+		{
+			name:     "UDP port with the same number as a TCP port",
+			port:     53,
+			protocol: v1.ProtocolUDP,
+			expName:  "dns-udp",
+		},
+		{
+			name:     "named port without a protocol",
+			port:     9153,
+			protocol: v1.ProtocolTCP,
+			expName:  "metrics",
+		},
+		{
+			name:     "port with another protocol",
+			port:     9153,
+			protocol: v1.ProtocolUDP,
+			expErrMsg: "no matching port for Service dns and port 9153 with protocol UDP; " +
+				"available ports: 53/UDP (dns-udp), 53/TCP (dns-tcp), 9153/TCP (metrics)",
+		},
+		{
+			name:     "port doesn't exist",
+			port:     8080,
+			protocol: v1.ProtocolTCP,
+			expErrMsg: "no matching port for Service dns and port 8080; " +
+				"available ports: 53/UDP (dns-udp), 53/TCP (dns-tcp), 9153/TCP (metrics)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			port, err := getServicePort(svc, test.port, test.protocol)
+			if test.expErrMsg != "" {
+				g.Expect(err).To(MatchError(test.expErrMsg))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(port.Name).To(Equal(test.expName))
+		})
+	}
+}
+
 func TestValidateBackendTLSPolicyMatchingAllBackends(t *testing.T) {
 	t.Parallel()
 	getBtp := func(name, caCertName string) *BackendTLSPolicy {
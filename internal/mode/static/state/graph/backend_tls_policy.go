@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 
@@ -13,10 +14,20 @@ import (
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
+// CACertificateRefsNamespaceAnnotation is the annotation of a BackendTLSPolicy that specifies the namespace of the
+// resources referenced by the CACertificateRefs of the BackendTLSPolicy. By default, the resources are in the
+// namespace of the BackendTLSPolicy. A reference to another namespace must be permitted by a ReferenceGrant
+// in that namespace.
+const CACertificateRefsNamespaceAnnotation = "gateway.nginx.org/ca-certificate-refs-namespace"
+
+// errCACertRefNotPermitted is returned when the reference to a CA certificate in another namespace
+// is not permitted by any ReferenceGrant.
+var errCACertRefNotPermitted = errors.New("not permitted by any ReferenceGrant")
+
 type BackendTLSPolicy struct {
 	// Source is the source resource.
 	Source *v1alpha3.BackendTLSPolicy
-	// CaCertRef is the name of the ConfigMap that contains the CA certificate.
+	// CaCertRef is the name of the ConfigMap or the Secret that contains the CA certificate.
 	CaCertRef types.NamespacedName
 	// Gateway is the name of the Gateway that is being checked for this BackendTLSPolicy.
 	Gateway types.NamespacedName
@@ -34,6 +45,7 @@ func processBackendTLSPolicies(
 	backendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy,
 	configMapResolver *configMapResolver,
 	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
 	ctlrName string,
 	gateway *Gateway,
 ) map[types.NamespacedName]*BackendTLSPolicy {
@@ -45,11 +57,18 @@ func processBackendTLSPolicies(
 	for nsname, backendTLSPolicy := range backendTLSPolicies {
 		var caCertRef types.NamespacedName
 
-		valid, ignored, conds := validateBackendTLSPolicy(backendTLSPolicy, configMapResolver, secretResolver, ctlrName)
+		valid, ignored, conds := validateBackendTLSPolicy(
+			backendTLSPolicy,
+			configMapResolver,
+			secretResolver,
+			refGrantResolver,
+			ctlrName,
+		)
 
 		if valid && !ignored && backendTLSPolicy.Spec.Validation.CACertificateRefs != nil {
 			caCertRef = types.NamespacedName{
-				Namespace: backendTLSPolicy.Namespace, Name: string(backendTLSPolicy.Spec.Validation.CACertificateRefs[0].Name),
+				Namespace: getCACertRefsNamespace(backendTLSPolicy),
+				Name:      string(backendTLSPolicy.Spec.Validation.CACertificateRefs[0].Name),
 			}
 		}
 
@@ -72,6 +91,7 @@ func validateBackendTLSPolicy(
 	backendTLSPolicy *v1alpha3.BackendTLSPolicy,
 	configMapResolver *configMapResolver,
 	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
 	ctlrName string,
 ) (valid, ignored bool, conds []conditions.Condition) {
 	valid = true
@@ -97,10 +117,15 @@ func validateBackendTLSPolicy(
 		conds = append(conds, staticConds.NewPolicyInvalid(msg))
 
 	case len(caCertRefs) > 0:
-		if err := validateBackendTLSCACertRef(backendTLSPolicy, configMapResolver, secretResolver); err != nil {
+		err := validateBackendTLSCACertRef(backendTLSPolicy, configMapResolver, secretResolver, refGrantResolver)
+		if err != nil {
 			valid = false
-			conds = append(conds, staticConds.NewPolicyInvalid(
-				fmt.Sprintf("invalid CACertificateRef: %s", err.Error())))
+			msg := fmt.Sprintf("invalid CACertificateRef: %s", err.Error())
+			conds = append(conds, staticConds.NewPolicyInvalid(msg))
+
+			if errors.Is(err, errCACertRefNotPermitted) {
+				conds = append(conds, staticConds.NewPolicyRefNotPermitted(msg))
+			}
 		}
 
 	case wellKnownCerts != nil:
@@ -132,6 +157,7 @@ func validateBackendTLSCACertRef(
 	btp *v1alpha3.BackendTLSPolicy,
 	configMapResolver *configMapResolver,
 	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
 ) error {
 	if len(btp.Spec.Validation.CACertificateRefs) != 1 {
 		path := field.NewPath("tls.cacertrefs")
@@ -154,17 +180,27 @@ func validateBackendTLSCACertRef(
 		return valErr
 	}
 	nsName := types.NamespacedName{
-		Namespace: btp.Namespace,
+		Namespace: getCACertRefsNamespace(btp),
 		Name:      string(selectedCertRef.Name),
 	}
 
 	switch selectedCertRef.Kind {
 	case "ConfigMap":
+		if nsName.Namespace != btp.Namespace &&
+			!refGrantResolver.refAllowed(toConfigMap(nsName), fromBackendTLSPolicy(btp.Namespace)) {
+			return fmt.Errorf("reference to ConfigMap %s %w", nsName, errCACertRefNotPermitted)
+		}
+
 		if err := configMapResolver.resolve(nsName); err != nil {
 			path := field.NewPath("tls.cacertrefs[0]")
 			return field.Invalid(path, selectedCertRef, err.Error())
 		}
 	case "Secret":
+		if nsName.Namespace != btp.Namespace &&
+			!refGrantResolver.refAllowed(toSecret(nsName), fromBackendTLSPolicy(btp.Namespace)) {
+			return fmt.Errorf("reference to Secret %s %w", nsName, errCACertRefNotPermitted)
+		}
+
 		if err := secretResolver.resolve(nsName); err != nil {
 			path := field.NewPath("tls.cacertrefs[0]")
 			return field.Invalid(path, selectedCertRef, err.Error())
@@ -175,6 +211,16 @@ func validateBackendTLSCACertRef(
 	return nil
 }
 
+// getCACertRefsNamespace returns the namespace of the resources referenced by the CACertificateRefs
+// of the BackendTLSPolicy.
+func getCACertRefsNamespace(btp *v1alpha3.BackendTLSPolicy) string {
+	if ns := btp.Annotations[CACertificateRefsNamespaceAnnotation]; ns != "" {
+		return ns
+	}
+
+	return btp.Namespace
+}
+
 func validateBackendTLSWellKnownCACerts(btp *v1alpha3.BackendTLSPolicy) error {
 	if *btp.Spec.Validation.WellKnownCACertificates != v1alpha3.WellKnownCACertificatesSystem {
 		path := field.NewPath("tls.wellknowncacertificates")
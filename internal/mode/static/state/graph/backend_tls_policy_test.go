@@ -7,12 +7,16 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
 func TestProcessBackendTLSPoliciesEmpty(t *testing.T) {
@@ -75,7 +79,7 @@ func TestProcessBackendTLSPoliciesEmpty(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processBackendTLSPolicies(test.backendTLSPolicies, nil, nil, "test", test.gateway)
+			processed := processBackendTLSPolicies(test.backendTLSPolicies, nil, nil, nil, "test", test.gateway)
 
 			g.Expect(processed).To(Equal(test.expected))
 		})
@@ -463,7 +467,13 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			valid, ignored, conds := validateBackendTLSPolicy(test.tlsPolicy, configMapResolver, secretMapResolver, "test")
+			valid, ignored, conds := validateBackendTLSPolicy(
+				test.tlsPolicy,
+				configMapResolver,
+				secretMapResolver,
+				newReferenceGrantResolver(nil),
+				"test",
+			)
 
 			if !test.isValid && !test.ignored {
 				g.Expect(conds).To(HaveLen(1))
@@ -475,3 +485,219 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBackendTLSPolicy_CrossNamespaceCACertRefs(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(caNamespace, kind, name string) *v1alpha3.BackendTLSPolicy {
+		btp := &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tls-policy",
+				Namespace: "test",
+			},
+			Spec: v1alpha3.BackendTLSPolicySpec{
+				Validation: v1alpha3.BackendTLSPolicyValidation{
+					CACertificateRefs: []gatewayv1.LocalObjectReference{
+						{
+							Kind: gatewayv1.Kind(kind),
+							Name: gatewayv1.ObjectName(name),
+						},
+					},
+					Hostname: "foo.test.com",
+				},
+			},
+		}
+
+		if caNamespace != "" {
+			btp.Annotations = map[string]string{CACertificateRefsNamespaceAnnotation: caNamespace}
+		}
+
+		return btp
+	}
+
+	createRefGrant := func(kind string) *v1beta1.ReferenceGrant {
+		return &v1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ref-grant",
+				Namespace: "certs",
+			},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{
+					{
+						Group:     gatewayv1.GroupName,
+						Kind:      kinds.BackendTLSPolicy,
+						Namespace: "test",
+					},
+				},
+				To: []v1beta1.ReferenceGrantTo{
+					{
+						Group: "core",
+						Kind:  gatewayv1.Kind(kind),
+					},
+				},
+			},
+		}
+	}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "certs", Name: "configmap"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "configmap",
+				Namespace: "certs",
+			},
+			Data: map[string]string{
+				CAKey: caBlock,
+			},
+		},
+		{Namespace: "test", Name: "configmap"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "configmap",
+				Namespace: "test",
+			},
+			Data: map[string]string{
+				CAKey: caBlock,
+			},
+		},
+	}
+
+	secrets := map[types.NamespacedName]*v1.Secret{
+		{Namespace: "certs", Name: "secret"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "certs",
+			},
+			Type: v1.SecretTypeTLS,
+			Data: map[string][]byte{
+				v1.TLSCertKey:       cert,
+				v1.TLSPrivateKeyKey: key,
+				CAKey:               []byte(caBlock),
+			},
+		},
+	}
+
+	notPermittedMsg := func(kind, name string) string {
+		return "invalid CACertificateRef: reference to " + kind + " certs/" + name +
+			" not permitted by any ReferenceGrant"
+	}
+
+	tests := []struct {
+		tlsPolicy *v1alpha3.BackendTLSPolicy
+		refGrant  *v1beta1.ReferenceGrant
+		name      string
+		expConds  []conditions.Condition
+		isValid   bool
+	}{
+		{
+			name:      "ConfigMap in the namespace of the policy",
+			tlsPolicy: createPolicy("test", "ConfigMap", "configmap"),
+			isValid:   true,
+		},
+		{
+			name:      "ConfigMap in another namespace permitted by a ReferenceGrant",
+			tlsPolicy: createPolicy("certs", "ConfigMap", "configmap"),
+			refGrant:  createRefGrant("ConfigMap"),
+			isValid:   true,
+		},
+		{
+			name:      "Secret in another namespace permitted by a ReferenceGrant",
+			tlsPolicy: createPolicy("certs", "Secret", "secret"),
+			refGrant:  createRefGrant("Secret"),
+			isValid:   true,
+		},
+		{
+			name:      "ConfigMap in another namespace without a ReferenceGrant",
+			tlsPolicy: createPolicy("certs", "ConfigMap", "configmap"),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(notPermittedMsg("ConfigMap", "configmap")),
+				staticConds.NewPolicyRefNotPermitted(notPermittedMsg("ConfigMap", "configmap")),
+			},
+		},
+		{
+			name:      "Secret in another namespace with a ReferenceGrant for ConfigMaps",
+			tlsPolicy: createPolicy("certs", "Secret", "secret"),
+			refGrant:  createRefGrant("ConfigMap"),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(notPermittedMsg("Secret", "secret")),
+				staticConds.NewPolicyRefNotPermitted(notPermittedMsg("Secret", "secret")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			refGrants := map[types.NamespacedName]*v1beta1.ReferenceGrant{}
+			if test.refGrant != nil {
+				refGrants[client.ObjectKeyFromObject(test.refGrant)] = test.refGrant
+			}
+
+			valid, ignored, conds := validateBackendTLSPolicy(
+				test.tlsPolicy,
+				newConfigMapResolver(configMaps),
+				newSecretResolver(secrets),
+				newReferenceGrantResolver(refGrants),
+				"test",
+			)
+
+			g.Expect(valid).To(Equal(test.isValid))
+			g.Expect(ignored).To(BeFalse())
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestProcessBackendTLSPolicies_CrossNamespaceCACertRef(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	btp := &v1alpha3.BackendTLSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tls-policy",
+			Namespace:   "test",
+			Annotations: map[string]string{CACertificateRefsNamespaceAnnotation: "certs"},
+		},
+		Spec: v1alpha3.BackendTLSPolicySpec{
+			Validation: v1alpha3.BackendTLSPolicyValidation{
+				CACertificateRefs: []gatewayv1.LocalObjectReference{{Kind: "ConfigMap", Name: "configmap"}},
+				Hostname:          "foo.test.com",
+			},
+		},
+	}
+
+	refGrants := map[types.NamespacedName]*v1beta1.ReferenceGrant{
+		{Namespace: "certs", Name: "ref-grant"}: {
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{
+					{Group: gatewayv1.GroupName, Kind: kinds.BackendTLSPolicy, Namespace: "test"},
+				},
+				To: []v1beta1.ReferenceGrantTo{
+					{Group: "core", Kind: "ConfigMap", Name: helpers.GetPointer[gatewayv1.ObjectName]("configmap")},
+				},
+			},
+		},
+	}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "certs", Name: "configmap"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: "certs"},
+			Data:       map[string]string{CAKey: caBlock},
+		},
+	}
+
+	processed := processBackendTLSPolicies(
+		map[types.NamespacedName]*v1alpha3.BackendTLSPolicy{client.ObjectKeyFromObject(btp): btp},
+		newConfigMapResolver(configMaps),
+		newSecretResolver(nil),
+		newReferenceGrantResolver(refGrants),
+		"test",
+		&Gateway{Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "test"}}},
+	)
+
+	g.Expect(processed).To(HaveKey(client.ObjectKeyFromObject(btp)))
+	g.Expect(processed[client.ObjectKeyFromObject(btp)].Valid).To(BeTrue())
+	g.Expect(processed[client.ObjectKeyFromObject(btp)].CaCertRef).To(Equal(
+		types.NamespacedName{Namespace: "certs", Name: "configmap"},
+	))
+}
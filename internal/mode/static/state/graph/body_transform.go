@@ -0,0 +1,543 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// HTTPBodyTransform represents a ngfAPI.HTTPBodyTransform.
+type HTTPBodyTransform struct {
+	// Source is the HTTPBodyTransform.
+	Source *ngfAPI.HTTPBodyTransform
+	// Conditions define the conditions to be reported in the status of the HTTPBodyTransform.
+	Conditions []conditions.Condition
+	// Valid indicates whether the HTTPBodyTransform is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the HTTPBodyTransform is referenced by a Route.
+	Referenced bool
+}
+
+// getHTTPBodyTransformResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to an HTTPBodyTransform in the given namespace.
+// If the HTTPBodyTransform exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getHTTPBodyTransformResolverForNamespace(
+	bodyTransforms map[types.NamespacedName]*HTTPBodyTransform,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(bodyTransforms) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.HTTPBodyTransform {
+			return nil
+		}
+
+		bt := bodyTransforms[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if bt == nil {
+			return nil
+		}
+
+		bt.Referenced = true
+
+		return &ExtensionRefFilter{HTTPBodyTransform: bt, Valid: bt.Valid}
+	}
+}
+
+// processHTTPBodyTransforms processes the HTTPBodyTransforms.
+func processHTTPBodyTransforms(
+	bodyTransforms map[types.NamespacedName]*ngfAPI.HTTPBodyTransform,
+	validator validation.GenericValidator,
+) map[types.NamespacedName]*HTTPBodyTransform {
+	if len(bodyTransforms) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*HTTPBodyTransform, len(bodyTransforms))
+
+	for nsname, bt := range bodyTransforms {
+		if errs := validateHTTPBodyTransform(bt, validator); len(errs) > 0 {
+			processed[nsname] = &HTTPBodyTransform{
+				Source:     bt,
+				Conditions: []conditions.Condition{staticConds.NewHTTPBodyTransformInvalid(errs.ToAggregate().Error())},
+				Valid:      false,
+			}
+
+			continue
+		}
+
+		processed[nsname] = &HTTPBodyTransform{
+			Source: bt,
+			Valid:  true,
+		}
+	}
+
+	return processed
+}
+
+// getBodyTransformDirectives returns the directives that are generated in the location context for
+// the HTTPBodyTransform of an ExtensionRef filter.
+func getBodyTransformDirectives(ref *ExtensionRefFilter) []snippetStatement {
+	if ref == nil || ref.HTTPBodyTransform == nil {
+		return nil
+	}
+
+	var directives []snippetStatement
+
+	spec := ref.HTTPBodyTransform.Source.Spec
+
+	if spec.Request != nil {
+		directives = append(
+			directives,
+			snippetStatement{name: "proxy_set_body"},
+			snippetStatement{name: "client_body_buffer_size"},
+		)
+	}
+
+	if spec.Response != nil {
+		directives = append(
+			directives,
+			snippetStatement{name: "js_header_filter"},
+			snippetStatement{name: "js_body_filter"},
+		)
+	}
+
+	return directives
+}
+
+func validateHTTPBodyTransform(
+	bt *ngfAPI.HTTPBodyTransform,
+	validator validation.GenericValidator,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+	spec := bt.Spec
+
+	if spec.Request == nil && spec.Response == nil {
+		return field.ErrorList{field.Required(specPath, "at least one of request or response must be set")}
+	}
+
+	if spec.Request != nil {
+		requestPath := specPath.Child("request")
+
+		if spec.Request.MaxBodySize != nil {
+			if err := validator.ValidateNginxSize(string(*spec.Request.MaxBodySize)); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(requestPath.Child("maxBodySize"), *spec.Request.MaxBodySize, err.Error()),
+				)
+			}
+		}
+
+		if err := validateBodyTransformScript(string(spec.Request.Script)); err != nil {
+			allErrs = append(allErrs, field.Invalid(requestPath.Child("script"), spec.Request.Script, err.Error()))
+		}
+	}
+
+	if spec.Response != nil {
+		responsePath := specPath.Child("response")
+
+		if spec.Response.MaxBodySize != nil {
+			if err := validator.ValidateNginxSize(string(*spec.Response.MaxBodySize)); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(responsePath.Child("maxBodySize"), *spec.Response.MaxBodySize, err.Error()),
+				)
+			}
+		}
+
+		if err := validateBodyTransformScript(string(spec.Response.Script)); err != nil {
+			allErrs = append(allErrs, field.Invalid(responsePath.Child("script"), spec.Response.Script, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// forbiddenScriptIdentifiers are the identifiers that the scripts of HTTPBodyTransforms cannot use, because
+// they give access to the njs runtime outside of the function that transforms the body, or run asynchronous code.
+// The identifiers that start with two underscores are forbidden too, because they are reserved for the module
+// that wraps the scripts.
+var forbiddenScriptIdentifiers = map[string]struct{}{
+	"Function":     {},
+	"Promise":      {},
+	"async":        {},
+	"await":        {},
+	"clearTimeout": {},
+	"constructor":  {},
+	"eval":         {},
+	"export":       {},
+	"globalThis":   {},
+	"import":       {},
+	"ngx":          {},
+	"njs":          {},
+	"process":      {},
+	"require":      {},
+	"setImmediate": {},
+	"setTimeout":   {},
+	"this":         {},
+}
+
+// keywordsBeforeRegex are the keywords after which a slash starts a regular expression literal.
+var keywordsBeforeRegex = map[string]struct{}{
+	"case":       {},
+	"delete":     {},
+	"do":         {},
+	"else":       {},
+	"in":         {},
+	"instanceof": {},
+	"new":        {},
+	"of":         {},
+	"return":     {},
+	"throw":      {},
+	"typeof":     {},
+	"void":       {},
+}
+
+// validateBodyTransformScript validates the script of an HTTPBodyTransform, which is inserted as the body of
+// a function in an njs module. The script must not close the function, so its brackets, strings, template literals,
+// comments and regular expression literals must be terminated, and it must not use the forbidden identifiers,
+// including as property names and string literals, for example, body['constructor'].
+func validateBodyTransformScript(script string) error {
+	tokens, err := scanScript(script)
+	if err != nil {
+		return err
+	}
+
+	var forbidden []string
+
+	for _, id := range tokens {
+		_, isForbidden := forbiddenScriptIdentifiers[id]
+		if (isForbidden || strings.HasPrefix(id, "__")) && !slices.Contains(forbidden, id) {
+			forbidden = append(forbidden, id)
+		}
+	}
+
+	if len(forbidden) > 0 {
+		return fmt.Errorf("script cannot use %s", strings.Join(forbidden, ", "))
+	}
+
+	return nil
+}
+
+// scanScript scans the njs script and returns its identifiers and the values of its string and template literals,
+// in order.
+// It returns an error if the brackets are not balanced, or a string, template literal, comment or regular expression
+// literal is not terminated. The escape sequences are only allowed in strings, template literals and regular
+// expressions, so that the identifiers can't be obfuscated, and they are decoded in the values of the literals.
+func scanScript(script string) ([]string, error) {
+	s := &scriptScanner{runes: []rune(script), regexAllowed: true}
+
+	for s.pos < len(s.runes) {
+		if err := s.scanToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(s.brackets) > 0 {
+		if open := s.brackets[len(s.brackets)-1]; open != templateSubstitution {
+			return nil, fmt.Errorf("unbalanced %q", open)
+		}
+
+		return nil, errors.New("unterminated template literal")
+	}
+
+	return s.tokens, nil
+}
+
+// openingBrackets are the opening brackets of the closing brackets.
+var openingBrackets = map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+// templateSubstitution records a template literal substitution in the open brackets of a scriptScanner.
+const templateSubstitution = '$'
+
+// scriptScanner scans an njs script.
+type scriptScanner struct {
+	// tokens are the identifiers and the values of the string and template literals.
+	tokens []string
+	runes  []rune
+	// brackets are the open brackets and template literal substitutions.
+	brackets []rune
+	pos      int
+	// regexAllowed indicates whether a slash starts a regular expression literal rather than a division.
+	regexAllowed bool
+}
+
+func (s *scriptScanner) peek(offset int) rune {
+	if s.pos+offset >= len(s.runes) {
+		return 0
+	}
+
+	return s.runes[s.pos+offset]
+}
+
+func (s *scriptScanner) scanToken() error {
+	r := s.runes[s.pos]
+
+	switch {
+	case unicode.IsSpace(r):
+		s.pos++
+		return nil
+	case r == '/' && (s.peek(1) == '/' || s.peek(1) == '*'):
+		return s.scanComment()
+	case r == '/' && s.regexAllowed:
+		return s.scanRegex()
+	case r == '\'' || r == '"':
+		return s.scanQuoted()
+	case r == '`':
+		s.pos++
+		return s.scanTemplate()
+	case isScriptIdentifierStart(r):
+		s.scanIdentifier()
+		return nil
+	case unicode.IsDigit(r):
+		for s.pos < len(s.runes) && (isScriptIdentifierPart(s.runes[s.pos]) || s.runes[s.pos] == '.') {
+			s.pos++
+		}
+
+		s.regexAllowed = false
+
+		return nil
+	case r == '\\':
+		return errors.New("escape sequences are only allowed in strings and regular expressions")
+	case r == '(' || r == '[' || r == '{':
+		s.brackets = append(s.brackets, r)
+		s.regexAllowed = true
+		s.pos++
+
+		return nil
+	case r == ')' || r == ']' || r == '}':
+		return s.closeBracket(r)
+	default:
+		s.regexAllowed = true
+		s.pos++
+
+		return nil
+	}
+}
+
+func (s *scriptScanner) scanComment() error {
+	if s.peek(1) == '/' {
+		for s.pos < len(s.runes) && s.runes[s.pos] != '\n' {
+			s.pos++
+		}
+
+		return nil
+	}
+
+	for s.pos += 2; s.pos+1 < len(s.runes); s.pos++ {
+		if s.runes[s.pos] == '*' && s.runes[s.pos+1] == '/' {
+			s.pos += 2
+			return nil
+		}
+	}
+
+	return errors.New("unterminated comment")
+}
+
+func (s *scriptScanner) scanQuoted() error {
+	quote := s.runes[s.pos]
+
+	var value strings.Builder
+
+	for s.pos++; s.pos < len(s.runes); s.pos++ {
+		switch r := s.runes[s.pos]; r {
+		case '\\':
+			if err := s.scanEscape(&value); err != nil {
+				return err
+			}
+		case quote:
+			s.pos++
+			s.tokens = append(s.tokens, value.String())
+			s.regexAllowed = false
+
+			return nil
+		case '\n':
+			return errors.New("unterminated string literal")
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	return errors.New("unterminated string literal")
+}
+
+// scanTemplate scans a template literal from the position after the backtick or the closing brace of
+// a substitution to the end of the literal or the start of the next substitution.
+func (s *scriptScanner) scanTemplate() error {
+	var value strings.Builder
+
+	for ; s.pos < len(s.runes); s.pos++ {
+		switch r := s.runes[s.pos]; r {
+		case '\\':
+			if err := s.scanEscape(&value); err != nil {
+				return err
+			}
+		case '`':
+			s.pos++
+			s.tokens = append(s.tokens, value.String())
+			s.regexAllowed = false
+
+			return nil
+		case '$':
+			if s.peek(1) == '{' {
+				s.pos += 2
+				s.tokens = append(s.tokens, value.String())
+				s.brackets = append(s.brackets, templateSubstitution)
+				s.regexAllowed = true
+
+				return nil
+			}
+
+			value.WriteRune(r)
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	return errors.New("unterminated template literal")
+}
+
+// escapedRunes are the runes of the single character escape sequences.
+var escapedRunes = map[rune]rune{'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v', '0': 0}
+
+// scanEscape decodes the escape sequence at the position of the backslash into the value of a string or
+// template literal, so that the forbidden identifiers can't be hidden in the strings with escape sequences.
+// The position is left at the last rune of the escape sequence.
+func (s *scriptScanner) scanEscape(value *strings.Builder) error {
+	s.pos++
+	if s.pos >= len(s.runes) {
+		return errors.New("unterminated string literal")
+	}
+
+	r := s.runes[s.pos]
+
+	var hex []rune
+
+	switch {
+	case r == 'x':
+		hex = s.runes[s.pos+1 : min(s.pos+3, len(s.runes))]
+		s.pos += len(hex)
+	case r == 'u' && s.peek(1) == '{':
+		end := slices.Index(s.runes[s.pos:], '}')
+		if end < 0 {
+			return errors.New("invalid escape sequence")
+		}
+
+		hex = s.runes[s.pos+2 : s.pos+end]
+		s.pos += end
+	case r == 'u':
+		hex = s.runes[s.pos+1 : min(s.pos+5, len(s.runes))]
+		if len(hex) != 4 {
+			return errors.New("invalid escape sequence")
+		}
+
+		s.pos += len(hex)
+	case r >= '1' && r <= '9':
+		return errors.New("octal escape sequences are not allowed")
+	default:
+		if escaped, ok := escapedRunes[r]; ok {
+			r = escaped
+		}
+
+		value.WriteRune(r)
+
+		return nil
+	}
+
+	code, err := strconv.ParseUint(string(hex), 16, 32)
+	if err != nil || (r == 'x' && len(hex) != 2) || code > unicode.MaxRune {
+		return errors.New("invalid escape sequence")
+	}
+
+	value.WriteRune(rune(code))
+
+	return nil
+}
+
+func (s *scriptScanner) scanRegex() error {
+	inClass := false
+
+	for s.pos++; s.pos < len(s.runes); s.pos++ {
+		switch r := s.runes[s.pos]; {
+		case r == '\\':
+			s.pos++
+		case r == '\n':
+			return errors.New("unterminated regular expression literal")
+		case r == '[':
+			inClass = true
+		case r == ']':
+			inClass = false
+		case r == '/' && !inClass:
+			s.pos++
+			// the flags are not identifiers.
+			for s.pos < len(s.runes) && isScriptIdentifierPart(s.runes[s.pos]) {
+				s.pos++
+			}
+
+			s.regexAllowed = false
+
+			return nil
+		}
+	}
+
+	return errors.New("unterminated regular expression literal")
+}
+
+func (s *scriptScanner) scanIdentifier() {
+	start := s.pos
+	for s.pos < len(s.runes) && isScriptIdentifierPart(s.runes[s.pos]) {
+		s.pos++
+	}
+
+	id := string(s.runes[start:s.pos])
+	s.tokens = append(s.tokens, id)
+
+	_, s.regexAllowed = keywordsBeforeRegex[id]
+}
+
+func (s *scriptScanner) closeBracket(r rune) error {
+	if len(s.brackets) == 0 {
+		return fmt.Errorf("unbalanced %q", r)
+	}
+
+	open := s.brackets[len(s.brackets)-1]
+	s.brackets = s.brackets[:len(s.brackets)-1]
+	s.pos++
+
+	if r == '}' && open == templateSubstitution {
+		return s.scanTemplate()
+	}
+
+	if open != openingBrackets[r] {
+		return fmt.Errorf("unbalanced %q", r)
+	}
+
+	// a closing brace ends a block more often than an object literal.
+	s.regexAllowed = r == '}'
+
+	return nil
+}
+
+func isScriptIdentifierStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isScriptIdentifierPart(r rune) bool {
+	return isScriptIdentifierStart(r) || unicode.IsDigit(r)
+}
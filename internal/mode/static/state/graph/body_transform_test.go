@@ -0,0 +1,356 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessHTTPBodyTransforms(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	valid := &ngfAPI.HTTPBodyTransform{
+		ObjectMeta: metav1.ObjectMeta{Namespace: validNsName.Namespace, Name: validNsName.Name},
+		Spec: ngfAPI.HTTPBodyTransformSpec{
+			Request: &ngfAPI.RequestBodyTransform{Script: "return body.toUpperCase();"},
+			Response: &ngfAPI.ResponseBodyTransform{
+				MaxBodySize: helpers.GetPointer[ngfAPI.Size]("2m"),
+				Script:      "return body.replace(/foo/g, 'bar');",
+			},
+		},
+	}
+
+	invalid := &ngfAPI.HTTPBodyTransform{
+		ObjectMeta: metav1.ObjectMeta{Namespace: invalidNsName.Namespace, Name: invalidNsName.Name},
+		Spec: ngfAPI.HTTPBodyTransformSpec{
+			Request: &ngfAPI.RequestBodyTransform{
+				MaxBodySize: helpers.GetPointer[ngfAPI.Size]("invalid"),
+				Script:      "return eval(body);",
+			},
+			Response: &ngfAPI.ResponseBodyTransform{
+				MaxBodySize: helpers.GetPointer[ngfAPI.Size]("invalid"),
+				Script:      "return body; }",
+			},
+		},
+	}
+
+	validator := &validationfakes.FakeGenericValidator{}
+	validator.ValidateNginxSizeCalls(func(size string) error {
+		if size == "invalid" {
+			return errors.New("invalid size")
+		}
+
+		return nil
+	})
+
+	tests := []struct {
+		bodyTransforms map[types.NamespacedName]*ngfAPI.HTTPBodyTransform
+		expProcessed   map[types.NamespacedName]*HTTPBodyTransform
+		name           string
+	}{
+		{
+			name: "nil body transforms",
+		},
+		{
+			name:           "empty body transforms",
+			bodyTransforms: map[types.NamespacedName]*ngfAPI.HTTPBodyTransform{},
+		},
+		{
+			name: "valid and invalid body transforms",
+			bodyTransforms: map[types.NamespacedName]*ngfAPI.HTTPBodyTransform{
+				validNsName:   valid,
+				invalidNsName: invalid,
+			},
+			expProcessed: map[types.NamespacedName]*HTTPBodyTransform{
+				validNsName: {
+					Source: valid,
+					Valid:  true,
+				},
+				invalidNsName: {
+					Source: invalid,
+					Conditions: []conditions.Condition{
+						staticConds.NewHTTPBodyTransformInvalid(
+							"[spec.request.maxBodySize: Invalid value: \"invalid\": invalid size, " +
+								"spec.request.script: Invalid value: \"return eval(body);\": script cannot use eval, " +
+								"spec.response.maxBodySize: Invalid value: \"invalid\": invalid size, " +
+								"spec.response.script: Invalid value: \"return body; }\": unbalanced '}']",
+						),
+					},
+					Valid: false,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			processed := processHTTPBodyTransforms(test.bodyTransforms, validator)
+			g.Expect(processed).To(BeEquivalentTo(test.expProcessed))
+		})
+	}
+}
+
+func TestValidateBodyTransformScript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		script string
+		expErr string
+	}{
+		{
+			name:   "replace",
+			script: "return body.replace(/foo/g, 'bar');",
+		},
+		{
+			name: "json",
+			script: "const data = JSON.parse(body);\n" +
+				"data.items = data.items.map((item) => ({ ...item, price: item.price / 100 }));\n" +
+				"return JSON.stringify(data);",
+		},
+		{
+			name:   "template literal with substitutions",
+			script: "return `{\"body\": ${JSON.stringify(body)}, \"nested\": ${`${body.length}`}}`;",
+		},
+		{
+			name:   "brackets in strings, comments and regular expressions",
+			script: "// }\n/* ) */\nconst s = '}' + \"]\";\nreturn body.replace(/[}\\/]/g, s);",
+		},
+		{
+			name:   "division",
+			script: "const half = body.length / 2;\nreturn body.slice(0, half) + (half) / 1;",
+		},
+		{
+			name:   "forbidden identifiers",
+			script: "return this.constructor(eval, this);",
+			expErr: "script cannot use this, constructor, eval",
+		},
+		{
+			name:   "forbidden property name in a string",
+			script: "return body['constructor']('return 1')();",
+			expErr: "script cannot use constructor",
+		},
+		{
+			name:   "forbidden property name in a template literal",
+			script: "return body[`constructor`]('return 1')();",
+			expErr: "script cannot use constructor",
+		},
+		{
+			name:   "forbidden property name in a string with escape sequences",
+			script: "return body['\\x63onstruc\\u0074o\\u{72}'];",
+			expErr: "script cannot use constructor",
+		},
+		{
+			name:   "escape sequences in strings",
+			script: "return body.replace('\\n', '\\t\\'\\x41\\u00e9\\u{1F600}\\0');",
+		},
+		{
+			name:   "invalid escape sequence",
+			script: "return body + '\\u00';",
+			expErr: "invalid escape sequence",
+		},
+		{
+			name:   "octal escape sequence",
+			script: "return body + '\\143';",
+			expErr: "octal escape sequences are not allowed",
+		},
+		{
+			name:   "reserved identifiers",
+			script: "return __fs.readFileSync('/etc/passwd');",
+			expErr: "script cannot use __fs",
+		},
+		{
+			name:   "escape sequence in an identifier",
+			script: "return \\u0065val(body);",
+			expErr: "escape sequences are only allowed in strings and regular expressions",
+		},
+		{
+			name:   "function closed",
+			script: "return body; }\nfunction other() {",
+			expErr: "unbalanced '}'",
+		},
+		{
+			name:   "unclosed bracket",
+			script: "return body.replace(/a/g, 'b';",
+			expErr: "unbalanced '('",
+		},
+		{
+			name:   "mismatched brackets",
+			script: "return [body);",
+			expErr: "unbalanced ')'",
+		},
+		{
+			name:   "unterminated string",
+			script: "return 'body;",
+			expErr: "unterminated string literal",
+		},
+		{
+			name:   "unterminated template literal",
+			script: "return `${body}",
+			expErr: "unterminated template literal",
+		},
+		{
+			name:   "unterminated template literal substitution",
+			script: "return `${body",
+			expErr: "unterminated template literal",
+		},
+		{
+			name:   "unterminated comment",
+			script: "return body; /* }",
+			expErr: "unterminated comment",
+		},
+		{
+			name:   "unterminated regular expression",
+			script: "return body.replace(/}, '');",
+			expErr: "unterminated regular expression literal",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateBodyTransformScript(test.script)
+			if test.expErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(test.expErr))
+			}
+		})
+	}
+}
+
+func TestGetHTTPBodyTransformResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	valid := &HTTPBodyTransform{
+		Source: &ngfAPI.HTTPBodyTransform{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "valid"},
+		},
+		Valid: true,
+	}
+	invalid := &HTTPBodyTransform{
+		Source: &ngfAPI.HTTPBodyTransform{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid"},
+		},
+	}
+
+	createRef := func(name string, kind v1.Kind) v1.LocalObjectReference {
+		return v1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kind,
+			Name:  v1.ObjectName(name),
+		}
+	}
+
+	tests := []struct {
+		bodyTransforms map[types.NamespacedName]*HTTPBodyTransform
+		expResolved    *ExtensionRefFilter
+		name           string
+		ref            v1.LocalObjectReference
+	}{
+		{
+			name: "no body transforms",
+			ref:  createRef("valid", kinds.HTTPBodyTransform),
+		},
+		{
+			name: "valid body transform",
+			bodyTransforms: map[types.NamespacedName]*HTTPBodyTransform{
+				{Namespace: "test", Name: "valid"}: valid,
+			},
+			ref:         createRef("valid", kinds.HTTPBodyTransform),
+			expResolved: &ExtensionRefFilter{HTTPBodyTransform: valid, Valid: true},
+		},
+		{
+			name: "invalid body transform",
+			bodyTransforms: map[types.NamespacedName]*HTTPBodyTransform{
+				{Namespace: "test", Name: "invalid"}: invalid,
+			},
+			ref:         createRef("invalid", kinds.HTTPBodyTransform),
+			expResolved: &ExtensionRefFilter{HTTPBodyTransform: invalid, Valid: false},
+		},
+		{
+			name: "body transform in another namespace",
+			bodyTransforms: map[types.NamespacedName]*HTTPBodyTransform{
+				{Namespace: "other", Name: "valid"}: valid,
+			},
+			ref: createRef("valid", kinds.HTTPBodyTransform),
+		},
+		{
+			name: "other kind",
+			bodyTransforms: map[types.NamespacedName]*HTTPBodyTransform{
+				{Namespace: "test", Name: "valid"}: valid,
+			},
+			ref: createRef("valid", kinds.SnippetsFilter),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getHTTPBodyTransformResolverForNamespace(test.bodyTransforms, "test")
+			g.Expect(resolve(test.ref)).To(Equal(test.expResolved))
+		})
+	}
+}
+
+func TestProcessRouteRuleFiltersBodyTransforms(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	bodyTransforms := map[types.NamespacedName]*HTTPBodyTransform{}
+	for _, name := range []string{"first", "second"} {
+		bodyTransforms[types.NamespacedName{Namespace: "test", Name: name}] = &HTTPBodyTransform{
+			Source: &ngfAPI.HTTPBodyTransform{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
+			Valid:  true,
+		}
+	}
+
+	createFilter := func(name string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.HTTPBodyTransform,
+				Name:  v1.ObjectName(name),
+			},
+		}
+	}
+
+	path := field.NewPath("spec").Child("rules").Index(0).Child("filters")
+
+	filters, errs := processRouteRuleFilters(
+		[]Filter{createFilter("first"), createFilter("second")},
+		path,
+		&validationfakes.FakeHTTPFieldsValidator{},
+		getHTTPBodyTransformResolverForNamespace(bodyTransforms, "test"),
+	)
+
+	g.Expect(filters.Valid).To(BeFalse())
+	g.Expect(errs.resolve).To(HaveLen(1))
+	g.Expect(errs.resolve.ToAggregate().Error()).To(Equal(
+		"spec.rules[0].filters[1].extensionRef: Forbidden: only one HTTPBodyTransform can be referenced by a rule",
+	))
+}
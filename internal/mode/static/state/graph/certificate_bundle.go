@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -51,6 +52,35 @@ func validateTLS(tlsCert, tlsPrivateKey []byte) error {
 	return nil
 }
 
+// validateCertificateHostname checks that the Subject Alternative Names of the first certificate of the chain
+// cover the hostname. A wildcard hostname is only covered by the identical wildcard name. The Common Name of
+// the certificate is ignored, as it is by the clients that verify certificates.
+func validateCertificateHostname(tlsCert []byte, hostname string) error {
+	block, _ := pem.Decode(tlsCert)
+	if block == nil {
+		return errors.New("failed to decode the certificate PEM block")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse the certificate: %w", err)
+	}
+
+	if err := cert.VerifyHostname(hostname); err != nil {
+		if len(cert.DNSNames) == 0 {
+			return fmt.Errorf("the certificate has no DNS Subject Alternative Names to cover the hostname %q", hostname)
+		}
+
+		return fmt.Errorf(
+			"the DNS Subject Alternative Names of the certificate %v don't cover the hostname %q",
+			cert.DNSNames,
+			hostname,
+		)
+	}
+
+	return nil
+}
+
 // validateCA validates the ca.crt entry in the Certificate. If it is valid, the function returns nil.
 func validateCA(caData []byte) error {
 	data := make([]byte, base64.StdEncoding.DecodedLen(len(caData)))
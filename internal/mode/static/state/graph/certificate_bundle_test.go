@@ -56,6 +56,66 @@ func TestValidateTLS(t *testing.T) {
 	}
 }
 
+func TestValidateCertificateHostname(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		hostname    string
+		expectedErr string
+		tlsCert     []byte
+	}{
+		{
+			name:     "hostname matches a SAN exactly",
+			tlsCert:  cert,
+			hostname: "example.com",
+		},
+		{
+			name:     "hostname matches a wildcard SAN",
+			tlsCert:  cert,
+			hostname: "cafe.example.com",
+		},
+		{
+			name:     "wildcard hostname matches the identical wildcard SAN",
+			tlsCert:  cert,
+			hostname: "*.example.com",
+		},
+		{
+			name:     "hostname doesn't match any SAN",
+			tlsCert:  cert,
+			hostname: "cafe.example.org",
+			expectedErr: `the DNS Subject Alternative Names of the certificate [example.com *.example.com] ` +
+				`don't cover the hostname "cafe.example.org"`,
+		},
+		{
+			name:     "hostname is too deep for a wildcard SAN",
+			tlsCert:  cert,
+			hostname: "tea.cafe.example.com",
+			expectedErr: `the DNS Subject Alternative Names of the certificate [example.com *.example.com] ` +
+				`don't cover the hostname "tea.cafe.example.com"`,
+		},
+		{
+			name:        "invalid certificate",
+			tlsCert:     []byte("invalid"),
+			hostname:    "example.com",
+			expectedErr: "failed to decode the certificate PEM block",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := NewWithT(t)
+			err := validateCertificateHostname(test.tlsCert, test.hostname)
+			if test.expectedErr != "" {
+				g.Expect(err).To(MatchError(test.expectedErr))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestValidateCA(t *testing.T) {
 	t.Parallel()
 	base64Data := make([]byte, base64.StdEncoding.EncodedLen(len(caBlock)))
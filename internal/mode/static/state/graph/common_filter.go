@@ -49,6 +49,10 @@ type Filter struct {
 	// Will be non-nil if the Extension Ref is non-nil and was resolved successfully.
 	// Can be set on GRPCRoutes and HTTPRoutes.
 	ResolvedExtensionRef *ExtensionRefFilter
+	// MirrorBackendRef holds the backendRef that the Request Mirror filter mirrors requests to.
+	// Will be non-nil if the Request Mirror filter is non-nil and the Route rule is valid.
+	// The backendRef can be invalid; in that case, requests must not be mirrored.
+	MirrorBackendRef *BackendRef
 	// RouteType is the type of Route that this filter is on.
 	RouteType RouteType
 	// FilterType is the type of filter.
@@ -116,6 +120,8 @@ func processRouteRuleFilters(
 ) (RouteRuleFilters, routeRuleErrors) {
 	errors := routeRuleErrors{}
 	valid := true
+	bodyTransformed := false
+	directResponded := false
 
 	for i, f := range filters {
 		filterPath := path.Index(i)
@@ -150,10 +156,55 @@ func processRouteRuleFilters(
 				continue
 			}
 
+			if resolved.HTTPBodyTransform != nil {
+				// the bodies can only be transformed by one njs body filter and one request body in a location.
+				if bodyTransformed {
+					err := field.Forbidden(
+						filterPath.Child("extensionRef"),
+						"only one HTTPBodyTransform can be referenced by a rule",
+					)
+					errors.resolve = append(errors.resolve, err)
+					valid = false
+
+					continue
+				}
+
+				bodyTransformed = true
+			}
+
+			if resolved.DirectResponse != nil {
+				// a location can only respond with one return directive.
+				if directResponded {
+					err := field.Forbidden(
+						filterPath.Child("extensionRef"),
+						"only one DirectResponse can be referenced by a rule",
+					)
+					errors.resolve = append(errors.resolve, err)
+					valid = false
+
+					continue
+				}
+
+				directResponded = true
+			}
+
 			filters[i].ResolvedExtensionRef = resolved
 		}
 	}
 
+	if directResponded && slices.ContainsFunc(filters, func(f Filter) bool {
+		return f.FilterType == FilterRequestRedirect
+	}) {
+		err := field.Forbidden(path, "a DirectResponse cannot be referenced by a rule with a RequestRedirect filter")
+		errors.resolve = append(errors.resolve, err)
+		valid = false
+	}
+
+	errors.conflicts = append(
+		findSnippetsFilterConflicts(filters, path),
+		findFilterDirectiveConflicts(filters, path)...,
+	)
+
 	return RouteRuleFilters{Valid: valid, Filters: filters}, errors
 }
 
@@ -169,6 +220,7 @@ var supportedHTTPFilterTypes = []FilterType{
 	FilterExtensionRef,
 	FilterRequestRedirect,
 	FilterURLRewrite,
+	FilterRequestMirror,
 }
 
 func validateFilterType(filter Filter, filterPath *field.Path) *field.Error {
@@ -200,6 +252,8 @@ func validateFilter(
 		return validateFilterRedirect(validator, filter.RequestRedirect, filterPath)
 	case FilterURLRewrite:
 		return validateFilterRewrite(validator, filter.URLRewrite, filterPath)
+	case FilterRequestMirror:
+		return validateFilterMirror(filter.RequestMirror, filterPath)
 	case FilterRequestHeaderModifier:
 		return validateFilterHeaderModifier(
 			validator,
@@ -213,7 +267,7 @@ func validateFilter(
 			filterPath.Child(string(filter.FilterType)),
 		)
 	case FilterExtensionRef:
-		return validateExtensionRefFilter(filter.ExtensionRef, filterPath)
+		return validateExtensionRefFilter(filter.ExtensionRef, filter.RouteType, filterPath)
 	default:
 		panic(fmt.Sprintf("unexpected filter type %v", filter.FilterType))
 	}
@@ -70,14 +70,32 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 0,
 			name:           "valid HTTP extension ref filter",
 		},
+		{
+			filter: Filter{
+				RouteType:     RouteTypeHTTP,
+				FilterType:    FilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{},
+			},
+			expectErrCount: 0,
+			name:           "valid HTTP request mirror filter",
+		},
 		{
 			filter: Filter{
 				RouteType:  RouteTypeHTTP,
-				FilterType: FilterRequestMirror,
+				FilterType: "CustomFilter",
 			},
 			expectErrCount: 1,
 			name:           "unsupported HTTP filter type",
 		},
+		{
+			filter: Filter{
+				RouteType:     RouteTypeGRPC,
+				FilterType:    FilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{},
+			},
+			expectErrCount: 1,
+			name:           "unsupported GRPC request mirror filter",
+		},
 		{
 			filter: Filter{
 				RouteType:             RouteTypeGRPC,
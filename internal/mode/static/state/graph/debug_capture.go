@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+const (
+	// DebugCaptureRequestsAnnotation is the annotation of a Route that enables the capture of the routing decisions
+	// of its requests. The value is the maximum number of captured requests.
+	DebugCaptureRequestsAnnotation = "gateway.nginx.org/debug-capture-requests"
+	// DebugCaptureExpiresAnnotation is the annotation of a Route that specifies the time, in RFC 3339 format,
+	// after which the requests of the Route are no longer captured. It is required when
+	// DebugCaptureRequestsAnnotation is set.
+	DebugCaptureExpiresAnnotation = "gateway.nginx.org/debug-capture-expires"
+
+	// maxDebugCaptureRequests is the maximum number of requests that can be captured for a Route.
+	maxDebugCaptureRequests = 1000
+)
+
+// DebugCapture is the capture of the routing decisions of a bounded number of requests of a Route.
+// The routing decisions are logged by NGINX until the number of requests is reached or the capture expires.
+type DebugCapture struct {
+	// Expires is the time after which the requests are no longer captured.
+	Expires time.Time
+	// Requests is the maximum number of captured requests.
+	Requests int32
+}
+
+// buildDebugCapture builds the DebugCapture from the annotations of a Route. It returns nil DebugCapture and
+// nil Condition if the annotations are not set. Invalid annotations don't invalidate the Route, they only
+// disable the capture.
+func buildDebugCapture(annotations map[string]string) (*DebugCapture, *conditions.Condition) {
+	requestsValue, requestsExist := annotations[DebugCaptureRequestsAnnotation]
+	expiresValue, expiresExist := annotations[DebugCaptureExpiresAnnotation]
+
+	if !requestsExist && !expiresExist {
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+
+	annotationsPath := field.NewPath("metadata").Child("annotations")
+
+	requests, err := strconv.ParseInt(requestsValue, 10, 32)
+	switch {
+	case !requestsExist:
+		allErrs = append(allErrs, field.Required(annotationsPath.Key(DebugCaptureRequestsAnnotation), ""))
+	case err != nil || requests < 1 || requests > maxDebugCaptureRequests:
+		allErrs = append(allErrs, field.Invalid(
+			annotationsPath.Key(DebugCaptureRequestsAnnotation),
+			requestsValue,
+			fmt.Sprintf("must be an integer between 1 and %d", maxDebugCaptureRequests),
+		))
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresValue)
+	switch {
+	case !expiresExist:
+		allErrs = append(allErrs, field.Required(annotationsPath.Key(DebugCaptureExpiresAnnotation), ""))
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(
+			annotationsPath.Key(DebugCaptureExpiresAnnotation),
+			expiresValue,
+			"must be a time in RFC 3339 format, for example, 2006-01-02T15:04:05Z",
+		))
+	}
+
+	if len(allErrs) > 0 {
+		cond := staticConds.NewRouteDebugCaptureInvalid(allErrs.ToAggregate().Error())
+		return nil, &cond
+	}
+
+	capture := &DebugCapture{
+		Requests: int32(requests),
+		Expires:  expires.UTC(),
+	}
+
+	cond := staticConds.NewRouteDebugCaptureEnabled(fmt.Sprintf(
+		"The routing decisions of up to %d requests are captured until %s",
+		capture.Requests,
+		capture.Expires.Format(time.RFC3339),
+	))
+
+	return capture, &cond
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestBuildDebugCapture(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		annotations map[string]string
+		expCapture  *DebugCapture
+		expCond     *conditions.Condition
+		name        string
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name: "valid",
+			annotations: map[string]string{
+				DebugCaptureRequestsAnnotation: "100",
+				DebugCaptureExpiresAnnotation:  "2026-01-02T04:04:05+01:00",
+			},
+			expCapture: &DebugCapture{
+				Requests: 100,
+				Expires:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			expCond: helpers.GetPointer(staticConds.NewRouteDebugCaptureEnabled(
+				"The routing decisions of up to 100 requests are captured until 2026-01-02T03:04:05Z",
+			)),
+		},
+		{
+			name: "missing expiration",
+			annotations: map[string]string{
+				DebugCaptureRequestsAnnotation: "100",
+			},
+			expCond: helpers.GetPointer(staticConds.NewRouteDebugCaptureInvalid(
+				"metadata.annotations[gateway.nginx.org/debug-capture-expires]: Required value",
+			)),
+		},
+		{
+			name: "missing requests",
+			annotations: map[string]string{
+				DebugCaptureExpiresAnnotation: "2026-01-02T03:04:05Z",
+			},
+			expCond: helpers.GetPointer(staticConds.NewRouteDebugCaptureInvalid(
+				"metadata.annotations[gateway.nginx.org/debug-capture-requests]: Required value",
+			)),
+		},
+		{
+			name: "invalid values",
+			annotations: map[string]string{
+				DebugCaptureRequestsAnnotation: "1001",
+				DebugCaptureExpiresAnnotation:  "tomorrow",
+			},
+			expCond: helpers.GetPointer(staticConds.NewRouteDebugCaptureInvalid(
+				`[metadata.annotations[gateway.nginx.org/debug-capture-requests]: Invalid value: "1001": ` +
+					`must be an integer between 1 and 1000, ` +
+					`metadata.annotations[gateway.nginx.org/debug-capture-expires]: Invalid value: "tomorrow": ` +
+					`must be a time in RFC 3339 format, for example, 2006-01-02T15:04:05Z]`,
+			)),
+		},
+		{
+			name: "zero requests",
+			annotations: map[string]string{
+				DebugCaptureRequestsAnnotation: "0",
+				DebugCaptureExpiresAnnotation:  "2026-01-02T03:04:05Z",
+			},
+			expCond: helpers.GetPointer(staticConds.NewRouteDebugCaptureInvalid(
+				`metadata.annotations[gateway.nginx.org/debug-capture-requests]: Invalid value: "0": ` +
+					`must be an integer between 1 and 1000`,
+			)),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			capture, cond := buildDebugCapture(test.annotations)
+			g.Expect(capture).To(Equal(test.expCapture))
+			g.Expect(cond).To(Equal(test.expCond))
+		})
+	}
+}
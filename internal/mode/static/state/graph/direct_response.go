@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// DirectResponse represents a ngfAPI.DirectResponse.
+type DirectResponse struct {
+	// Source is the DirectResponse.
+	Source *ngfAPI.DirectResponse
+	// Conditions define the conditions to be reported in the status of the DirectResponse.
+	Conditions []conditions.Condition
+	// Valid indicates whether the DirectResponse is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the DirectResponse is referenced by a Route.
+	Referenced bool
+}
+
+const maxDirectResponseBodyLength = 4096
+
+var (
+	directResponseContentTypeRegexp = regexp.MustCompile(
+		`^[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*/[a-zA-Z0-9][a-zA-Z0-9!#&^_.+-]*$`,
+	)
+
+	// redirectStatusCodes are the status codes of the RequestRedirect filter. NGINX interprets the body of
+	// the responses with these status codes as the URL of the redirect.
+	redirectStatusCodes = []int32{301, 302, 303, 307, 308}
+)
+
+// getDirectResponseResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a DirectResponse in the given namespace.
+// If the DirectResponse exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getDirectResponseResolverForNamespace(
+	directResponses map[types.NamespacedName]*DirectResponse,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(directResponses) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.DirectResponse {
+			return nil
+		}
+
+		dr := directResponses[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if dr == nil {
+			return nil
+		}
+
+		dr.Referenced = true
+
+		return &ExtensionRefFilter{DirectResponse: dr, Valid: dr.Valid}
+	}
+}
+
+// processDirectResponses processes the DirectResponses.
+func processDirectResponses(
+	directResponses map[types.NamespacedName]*ngfAPI.DirectResponse,
+) map[types.NamespacedName]*DirectResponse {
+	if len(directResponses) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*DirectResponse, len(directResponses))
+
+	for nsname, dr := range directResponses {
+		if errs := validateDirectResponse(dr); len(errs) > 0 {
+			processed[nsname] = &DirectResponse{
+				Source:     dr,
+				Conditions: []conditions.Condition{staticConds.NewDirectResponseInvalid(errs.ToAggregate().Error())},
+				Valid:      false,
+			}
+
+			continue
+		}
+
+		processed[nsname] = &DirectResponse{
+			Source: dr,
+			Valid:  true,
+		}
+	}
+
+	return processed
+}
+
+func validateDirectResponse(dr *ngfAPI.DirectResponse) field.ErrorList {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+	spec := dr.Spec
+
+	statusCodePath := specPath.Child("statusCode")
+	if spec.StatusCode < 200 || spec.StatusCode > 599 {
+		allErrs = append(allErrs, field.Invalid(statusCodePath, spec.StatusCode, "must be between 200 and 599"))
+	} else if slices.Contains(redirectStatusCodes, spec.StatusCode) {
+		allErrs = append(allErrs, field.Invalid(
+			statusCodePath,
+			spec.StatusCode,
+			"redirect status codes are not supported, use the RequestRedirect filter instead",
+		))
+	}
+
+	if spec.Body != nil {
+		bodyPath := specPath.Child("body")
+
+		if len(*spec.Body) > maxDirectResponseBodyLength {
+			allErrs = append(allErrs, field.TooLong(bodyPath, "", maxDirectResponseBodyLength))
+		}
+
+		// NGINX expands the variables in the body of a return directive.
+		if strings.Contains(*spec.Body, "$") {
+			allErrs = append(allErrs, field.Invalid(bodyPath, "", "cannot contain the '$' character"))
+		}
+	}
+
+	if spec.ContentType != nil && !directResponseContentTypeRegexp.MatchString(*spec.ContentType) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("contentType"),
+			*spec.ContentType,
+			"must be a media type, for example, text/html",
+		))
+	}
+
+	return allErrs
+}
+
+// getDirectResponseDirectives returns the directives that are generated in the location context for
+// the DirectResponse of an ExtensionRef filter.
+func getDirectResponseDirectives(ref *ExtensionRefFilter) []snippetStatement {
+	if ref == nil || ref.DirectResponse == nil {
+		return nil
+	}
+
+	return []snippetStatement{{name: "return"}, {name: "default_type"}}
+}
@@ -0,0 +1,299 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessDirectResponses(t *testing.T) {
+	t.Parallel()
+
+	validNsName := types.NamespacedName{Namespace: "test", Name: "valid"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	valid := &ngfAPI.DirectResponse{
+		ObjectMeta: metav1.ObjectMeta{Namespace: validNsName.Namespace, Name: validNsName.Name},
+		Spec: ngfAPI.DirectResponseSpec{
+			StatusCode:  503,
+			Body:        helpers.GetPointer(`<h1 class="maintenance">Down for maintenance</h1>`),
+			ContentType: helpers.GetPointer("text/html"),
+		},
+	}
+
+	invalid := &ngfAPI.DirectResponse{
+		ObjectMeta: metav1.ObjectMeta{Namespace: invalidNsName.Namespace, Name: invalidNsName.Name},
+		Spec: ngfAPI.DirectResponseSpec{
+			StatusCode:  302,
+			Body:        helpers.GetPointer("$host"),
+			ContentType: helpers.GetPointer("text/html; charset=utf-8"),
+		},
+	}
+
+	tests := []struct {
+		directResponses map[types.NamespacedName]*ngfAPI.DirectResponse
+		expProcessed    map[types.NamespacedName]*DirectResponse
+		name            string
+	}{
+		{
+			name: "nil direct responses",
+		},
+		{
+			name:            "empty direct responses",
+			directResponses: map[types.NamespacedName]*ngfAPI.DirectResponse{},
+		},
+		{
+			name: "valid and invalid direct responses",
+			directResponses: map[types.NamespacedName]*ngfAPI.DirectResponse{
+				validNsName:   valid,
+				invalidNsName: invalid,
+			},
+			expProcessed: map[types.NamespacedName]*DirectResponse{
+				validNsName: {
+					Source: valid,
+					Valid:  true,
+				},
+				invalidNsName: {
+					Source: invalid,
+					Conditions: []conditions.Condition{
+						staticConds.NewDirectResponseInvalid(
+							"[spec.statusCode: Invalid value: 302: redirect status codes are not supported, " +
+								"use the RequestRedirect filter instead, " +
+								"spec.body: Invalid value: \"\": cannot contain the '$' character, " +
+								"spec.contentType: Invalid value: \"text/html; charset=utf-8\": " +
+								"must be a media type, for example, text/html]",
+						),
+					},
+					Valid: false,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			processed := processDirectResponses(test.directResponses)
+			g.Expect(helpers.Diff(test.expProcessed, processed)).To(BeEmpty())
+		})
+	}
+}
+
+func TestValidateDirectResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		expErr string
+		spec   ngfAPI.DirectResponseSpec
+	}{
+		{
+			name: "status code only",
+			spec: ngfAPI.DirectResponseSpec{StatusCode: 403},
+		},
+		{
+			name:   "status code too low",
+			spec:   ngfAPI.DirectResponseSpec{StatusCode: 100},
+			expErr: "spec.statusCode: Invalid value: 100: must be between 200 and 599",
+		},
+		{
+			name:   "status code too high",
+			spec:   ngfAPI.DirectResponseSpec{StatusCode: 600},
+			expErr: "spec.statusCode: Invalid value: 600: must be between 200 and 599",
+		},
+		{
+			name: "body too long",
+			spec: ngfAPI.DirectResponseSpec{
+				StatusCode: 200,
+				Body:       helpers.GetPointer(strings.Repeat("a", maxDirectResponseBodyLength+1)),
+			},
+			expErr: "spec.body: Too long: may not be more than 4096 bytes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			errs := validateDirectResponse(&ngfAPI.DirectResponse{Spec: test.spec})
+			if test.expErr == "" {
+				g.Expect(errs).To(BeEmpty())
+			} else {
+				g.Expect(errs.ToAggregate().Error()).To(Equal(test.expErr))
+			}
+		})
+	}
+}
+
+func TestGetDirectResponseResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	valid := &DirectResponse{
+		Source: &ngfAPI.DirectResponse{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "valid"},
+		},
+		Valid: true,
+	}
+	invalid := &DirectResponse{
+		Source: &ngfAPI.DirectResponse{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid"},
+		},
+	}
+
+	createRef := func(name string, kind v1.Kind) v1.LocalObjectReference {
+		return v1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kind,
+			Name:  v1.ObjectName(name),
+		}
+	}
+
+	tests := []struct {
+		directResponses map[types.NamespacedName]*DirectResponse
+		expResolved     *ExtensionRefFilter
+		name            string
+		ref             v1.LocalObjectReference
+	}{
+		{
+			name: "no direct responses",
+			ref:  createRef("valid", kinds.DirectResponse),
+		},
+		{
+			name: "valid direct response",
+			directResponses: map[types.NamespacedName]*DirectResponse{
+				{Namespace: "test", Name: "valid"}: valid,
+			},
+			ref:         createRef("valid", kinds.DirectResponse),
+			expResolved: &ExtensionRefFilter{DirectResponse: valid, Valid: true},
+		},
+		{
+			name: "invalid direct response",
+			directResponses: map[types.NamespacedName]*DirectResponse{
+				{Namespace: "test", Name: "invalid"}: invalid,
+			},
+			ref:         createRef("invalid", kinds.DirectResponse),
+			expResolved: &ExtensionRefFilter{DirectResponse: invalid, Valid: false},
+		},
+		{
+			name: "direct response in another namespace",
+			directResponses: map[types.NamespacedName]*DirectResponse{
+				{Namespace: "other", Name: "valid"}: valid,
+			},
+			ref: createRef("valid", kinds.DirectResponse),
+		},
+		{
+			name: "other kind",
+			directResponses: map[types.NamespacedName]*DirectResponse{
+				{Namespace: "test", Name: "valid"}: valid,
+			},
+			ref: createRef("valid", kinds.SnippetsFilter),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getDirectResponseResolverForNamespace(test.directResponses, "test")
+			g.Expect(resolve(test.ref)).To(Equal(test.expResolved))
+		})
+	}
+}
+
+func TestProcessRouteRuleFiltersDirectResponses(t *testing.T) {
+	t.Parallel()
+
+	// the resolver marks the DirectResponses as referenced, so every test gets its own DirectResponses.
+	createDirectResponses := func() map[types.NamespacedName]*DirectResponse {
+		directResponses := map[types.NamespacedName]*DirectResponse{}
+		for _, name := range []string{"first", "second"} {
+			directResponses[types.NamespacedName{Namespace: "test", Name: name}] = &DirectResponse{
+				Source: &ngfAPI.DirectResponse{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
+				Valid:  true,
+			}
+		}
+
+		return directResponses
+	}
+
+	createFilter := func(name string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.DirectResponse,
+				Name:  v1.ObjectName(name),
+			},
+		}
+	}
+
+	redirect := Filter{
+		RouteType:       RouteTypeHTTP,
+		FilterType:      FilterRequestRedirect,
+		RequestRedirect: &v1.HTTPRequestRedirectFilter{},
+	}
+
+	tests := []struct {
+		name    string
+		expErr  string
+		filters []Filter
+	}{
+		{
+			name:    "one direct response",
+			filters: []Filter{createFilter("first")},
+		},
+		{
+			name:    "two direct responses",
+			filters: []Filter{createFilter("first"), createFilter("second")},
+			expErr: "spec.rules[0].filters[1].extensionRef: Forbidden: " +
+				"only one DirectResponse can be referenced by a rule",
+		},
+		{
+			name:    "direct response and redirect",
+			filters: []Filter{redirect, createFilter("first")},
+			expErr: "spec.rules[0].filters: Forbidden: " +
+				"a DirectResponse cannot be referenced by a rule with a RequestRedirect filter",
+		},
+	}
+
+	path := field.NewPath("spec").Child("rules").Index(0).Child("filters")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			filters, errs := processRouteRuleFilters(
+				test.filters,
+				path,
+				&validationfakes.FakeHTTPFieldsValidator{},
+				getDirectResponseResolverForNamespace(createDirectResponses(), "test"),
+			)
+
+			if test.expErr == "" {
+				g.Expect(filters.Valid).To(BeTrue())
+				g.Expect(errs.resolve).To(BeEmpty())
+			} else {
+				g.Expect(filters.Valid).To(BeFalse())
+				g.Expect(errs.resolve.ToAggregate().Error()).To(Equal(test.expErr))
+			}
+		})
+	}
+}
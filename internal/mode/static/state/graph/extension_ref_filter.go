@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -12,8 +14,13 @@ import (
 type ExtensionRefFilter struct {
 	// SnippetsFilter contains the SnippetsFilter. Will be non-nil if the Ref.Kind is SnippetsFilter and the
 	// SnippetsFilter exists.
-	// Once we support more filters, we can extend this struct with more filter kinds.
 	SnippetsFilter *SnippetsFilter
+	// HTTPBodyTransform contains the HTTPBodyTransform. Will be non-nil if the Ref.Kind is HTTPBodyTransform and
+	// the HTTPBodyTransform exists.
+	HTTPBodyTransform *HTTPBodyTransform
+	// DirectResponse contains the DirectResponse. Will be non-nil if the Ref.Kind is DirectResponse and
+	// the DirectResponse exists.
+	DirectResponse *DirectResponse
 	// Valid indicates whether the filter is valid.
 	Valid bool
 }
@@ -22,7 +29,29 @@ type ExtensionRefFilter struct {
 // If it cannot be resolved, *ExtensionRefFilter will be nil.
 type resolveExtRefFilter func(ref v1.LocalObjectReference) *ExtensionRefFilter
 
-func validateExtensionRefFilter(ref *v1.LocalObjectReference, path *field.Path) field.ErrorList {
+// chainExtRefFilterResolvers returns a resolveExtRefFilter that resolves a LocalObjectReference with the first
+// resolver that resolves it.
+func chainExtRefFilterResolvers(resolvers ...resolveExtRefFilter) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		for _, resolve := range resolvers {
+			if resolved := resolve(ref); resolved != nil {
+				return resolved
+			}
+		}
+
+		return nil
+	}
+}
+
+var supportedGRPCExtRefKinds = []string{kinds.SnippetsFilter}
+
+var supportedHTTPExtRefKinds = []string{kinds.SnippetsFilter, kinds.HTTPBodyTransform, kinds.DirectResponse}
+
+func validateExtensionRefFilter(
+	ref *v1.LocalObjectReference,
+	routeType RouteType,
+	path *field.Path,
+) field.ErrorList {
 	var allErrs field.ErrorList
 
 	extRefPath := path.Child("extensionRef")
@@ -39,10 +68,13 @@ func validateExtensionRefFilter(ref *v1.LocalObjectReference, path *field.Path)
 		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Group, []string{ngfAPI.GroupName}))
 	}
 
-	switch ref.Kind {
-	case kinds.SnippetsFilter:
-	default:
-		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Kind, []string{kinds.SnippetsFilter}))
+	supportedKinds := supportedHTTPExtRefKinds
+	if routeType == RouteTypeGRPC {
+		supportedKinds = supportedGRPCExtRefKinds
+	}
+
+	if !slices.Contains(supportedKinds, string(ref.Kind)) {
+		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Kind, supportedKinds))
 	}
 
 	return allErrs
@@ -18,6 +18,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 	tests := []struct {
 		ref          *v1.LocalObjectReference
 		name         string
+		routeType    RouteType
 		errSubString []string
 		expErrCount  int
 	}{
@@ -32,11 +33,13 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 		{
 			name:        "empty ref",
 			ref:         &v1.LocalObjectReference{},
+			routeType:   RouteTypeHTTP,
 			expErrCount: 3,
 			errSubString: []string{
 				`test.extensionRef: Required value: name cannot be empty`,
 				`test.extensionRef: Unsupported value: "": supported values: "gateway.nginx.org"`,
-				`test.extensionRef: Unsupported value: "": supported values: "SnippetsFilter"`,
+				`test.extensionRef: Unsupported value: "": supported values: "SnippetsFilter", "HTTPBodyTransform", ` +
+					`"DirectResponse"`,
 			},
 		},
 		{
@@ -69,9 +72,24 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 				Group: ngfAPI.GroupName,
 				Kind:  "unsupported",
 			},
+			routeType:   RouteTypeHTTP,
 			expErrCount: 1,
 			errSubString: []string{
-				`test.extensionRef: Unsupported value: "unsupported": supported values: "SnippetsFilter"`,
+				`test.extensionRef: Unsupported value: "unsupported": supported values: "SnippetsFilter", ` +
+					`"HTTPBodyTransform", "DirectResponse"`,
+			},
+		},
+		{
+			name: "grpc ref unsupported kind",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.HTTPBodyTransform,
+			},
+			routeType:   RouteTypeGRPC,
+			expErrCount: 1,
+			errSubString: []string{
+				`test.extensionRef: Unsupported value: "HTTPBodyTransform": supported values: "SnippetsFilter"`,
 			},
 		},
 		{
@@ -81,6 +99,27 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 				Group: ngfAPI.GroupName,
 				Kind:  kinds.SnippetsFilter,
 			},
+			routeType:   RouteTypeHTTP,
+			expErrCount: 0,
+		},
+		{
+			name: "valid body transform ref",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.HTTPBodyTransform,
+			},
+			routeType:   RouteTypeHTTP,
+			expErrCount: 0,
+		},
+		{
+			name: "valid direct response ref",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.DirectResponse,
+			},
+			routeType:   RouteTypeHTTP,
 			expErrCount: 0,
 		},
 	}
@@ -91,7 +130,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 
 			g := NewWithT(t)
 
-			errs := validateExtensionRefFilter(test.ref, testPath)
+			errs := validateExtensionRefFilter(test.ref, test.routeType, testPath)
 			g.Expect(errs).To(HaveLen(test.expErrCount))
 
 			if len(test.errSubString) > 0 {
@@ -1,22 +1,33 @@
 package graph
 
 import (
+	"fmt"
+	"net"
+	"slices"
 	"sort"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
-// Gateway represents the winning Gateway resource.
+// Gateway represents a Gateway resource that belongs to NGF.
 type Gateway struct {
 	// Source is the corresponding Gateway resource.
 	Source *v1.Gateway
+	// NginxProxy holds the NginxProxy referenced by the infrastructure parametersRef of the Gateway.
+	NginxProxy *NginxProxy
+	// EffectiveNginxProxy holds the NginxProxy that configures the servers of the Listeners of a merged Gateway:
+	// the NginxProxy of the data plane with the per-Gateway settings of the NginxProxy of the Gateway.
+	// Nil if the Gateway is the winning Gateway or it doesn't reference a valid NginxProxy.
+	EffectiveNginxProxy *NginxProxy
 	// Listeners include the listeners of the Gateway.
 	Listeners []*Listener
 	// Conditions holds the conditions for the Gateway.
@@ -25,42 +36,48 @@ type Gateway struct {
 	Policies []*Policy
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
+	// Streaming indicates that the responses of the backends of the Routes attached to the Gateway are passed
+	// to the clients without buffering. It is configured with the StreamingAnnotation.
+	Streaming bool
 }
 
 // processedGateways holds the resources that belong to NGF.
 type processedGateways struct {
-	Winner  *v1.Gateway
-	Ignored map[types.NamespacedName]*v1.Gateway
+	// Winner is the Gateway with the highest priority.
+	Winner *v1.Gateway
+	// Merged holds the rest of the Gateways, ordered by their priority. Their listeners are merged with the
+	// listeners of the Winner into the same NGINX configuration.
+	Merged []*v1.Gateway
 }
 
 // GetAllNsNames returns all the NamespacedNames of the Gateway resources that belong to NGF.
 func (gws processedGateways) GetAllNsNames() []types.NamespacedName {
-	winnerCnt := 0
-	if gws.Winner != nil {
-		winnerCnt = 1
-	}
-
-	length := winnerCnt + len(gws.Ignored)
-	if length == 0 {
+	if gws.Winner == nil {
 		return nil
 	}
 
-	allNsNames := make([]types.NamespacedName, 0, length)
+	allNsNames := make([]types.NamespacedName, 0, 1+len(gws.Merged))
 
-	if gws.Winner != nil {
-		allNsNames = append(allNsNames, client.ObjectKeyFromObject(gws.Winner))
-	}
-	for nsName := range gws.Ignored {
-		allNsNames = append(allNsNames, nsName)
+	allNsNames = append(allNsNames, client.ObjectKeyFromObject(gws.Winner))
+	for _, gw := range gws.Merged {
+		allNsNames = append(allNsNames, client.ObjectKeyFromObject(gw))
 	}
 
 	return allNsNames
 }
 
+// exists returns true if the Gateway belongs to NGF.
+func (gws processedGateways) exists(gwNsName types.NamespacedName) bool {
+	return slices.Contains(gws.GetAllNsNames(), gwNsName)
+}
+
 // processGateways determines which Gateway resource belong to NGF (determined by the Gateway GatewayClassName field).
+// If the selector is not nil, only the Gateways with the labels that match the selector belong to NGF. This allows
+// multiple NGF instances to share the same GatewayClass, each processing a distinct set of Gateways.
 func processGateways(
 	gws map[types.NamespacedName]*v1.Gateway,
 	gcName string,
+	selector labels.Selector,
 ) processedGateways {
 	referencedGws := make([]*v1.Gateway, 0, len(gws))
 
@@ -69,6 +86,10 @@ func processGateways(
 			continue
 		}
 
+		if selector != nil && !selector.Matches(labels.Set(gw.Labels)) {
+			continue
+		}
+
 		referencedGws = append(referencedGws, gw)
 	}
 
@@ -80,15 +101,144 @@ func processGateways(
 		return ngfsort.LessClientObject(referencedGws[i], referencedGws[j])
 	})
 
-	ignoredGws := make(map[types.NamespacedName]*v1.Gateway)
-
-	for _, gw := range referencedGws[1:] {
-		ignoredGws[client.ObjectKeyFromObject(gw)] = gw
+	var mergedGws []*v1.Gateway
+	if len(referencedGws) > 1 {
+		mergedGws = referencedGws[1:]
 	}
 
 	return processedGateways{
-		Winner:  referencedGws[0],
-		Ignored: ignoredGws,
+		Winner: referencedGws[0],
+		Merged: mergedGws,
+	}
+}
+
+// buildGateways builds the winning Gateway and the merged Gateways. Because all the Gateways share the same NGINX
+// configuration, listeners of a merged Gateway that conflict with a listener of a Gateway with a higher priority
+// are invalidated. The listeners of the Gateway with the higher priority are left untouched, so that a newly
+// created Gateway can't break an existing one.
+func buildGateways(
+	processedGws processedGateways,
+	secretResolver *secretResolver,
+	gc *GatewayClass,
+	refGrantResolver *referenceGrantResolver,
+	protectedPorts ProtectedPorts,
+) (*Gateway, map[types.NamespacedName]*Gateway) {
+	gw := buildGateway(processedGws.Winner, secretResolver, gc, refGrantResolver, protectedPorts)
+	if gw == nil || len(processedGws.Merged) == 0 {
+		return gw, nil
+	}
+
+	prioritizedGws := make([]*Gateway, 0, 1+len(processedGws.Merged))
+	prioritizedGws = append(prioritizedGws, gw)
+
+	mergedGws := make(map[types.NamespacedName]*Gateway, len(processedGws.Merged))
+
+	for _, source := range processedGws.Merged {
+		mergedGw := buildGateway(source, secretResolver, gc, refGrantResolver, protectedPorts)
+
+		mergedGws[client.ObjectKeyFromObject(source)] = mergedGw
+		prioritizedGws = append(prioritizedGws, mergedGw)
+	}
+
+	resolveListenerConflictsBetweenGateways(prioritizedGws)
+
+	return gw, mergedGws
+}
+
+// allGateways returns the winning Gateway and the merged Gateways, keyed by their NamespacedNames.
+func allGateways(gw *Gateway, mergedGws map[types.NamespacedName]*Gateway) map[types.NamespacedName]*Gateway {
+	if gw == nil || gw.Source == nil {
+		return nil
+	}
+
+	gws := make(map[types.NamespacedName]*Gateway, 1+len(mergedGws))
+
+	gws[client.ObjectKeyFromObject(gw.Source)] = gw
+	for nsname, mergedGw := range mergedGws {
+		gws[nsname] = mergedGw
+	}
+
+	return gws
+}
+
+// resolveListenerConflictsBetweenGateways invalidates the valid listeners that conflict with a valid listener of
+// a Gateway with a higher priority. The gateways must be ordered by their priority.
+// Conflicts between listeners of the same Gateway are resolved when the listeners are built.
+func resolveListenerConflictsBetweenGateways(gateways []*Gateway) {
+	listenersByPort := make(map[v1.PortNumber][]*Listener)
+
+	for _, gw := range gateways {
+		acceptedListeners := make([]*Listener, 0, len(gw.Listeners))
+
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			var conflictConds []conditions.Condition
+			for _, other := range listenersByPort[l.Source.Port] {
+				if conflictConds = getListenerConflictConditions(l, other); conflictConds != nil {
+					break
+				}
+			}
+
+			if conflictConds != nil {
+				l.Valid = false
+				l.Conditions = append(l.Conditions, conflictConds...)
+				continue
+			}
+
+			acceptedListeners = append(acceptedListeners, l)
+		}
+
+		for _, l := range acceptedListeners {
+			listenersByPort[l.Source.Port] = append(listenersByPort[l.Source.Port], l)
+		}
+	}
+}
+
+// getListenerConflictConditions returns the conditions for the listener if it conflicts with the other listener of
+// a different Gateway on the same port. Listeners conflict if they use incompatible protocols, or if NGINX can't
+// tell their traffic apart by the hostname. Returns nil if the listeners don't conflict.
+func getListenerConflictConditions(l, other *Listener) []conditions.Condition {
+	isUDP := l.Source.Protocol == v1.UDPProtocolType
+	if isUDP != (other.Source.Protocol == v1.UDPProtocolType) {
+		// UDP listeners don't conflict with TCP-based listeners on the same port.
+		return nil
+	}
+
+	isSecure := func(protocol v1.ProtocolType) bool {
+		return protocol == v1.HTTPSProtocolType || protocol == v1.TLSProtocolType
+	}
+
+	switch {
+	case isUDP,
+		l.Source.Protocol == v1.TCPProtocolType,
+		other.Source.Protocol == v1.TCPProtocolType,
+		isSecure(l.Source.Protocol) != isSecure(other.Source.Protocol):
+		msg := fmt.Sprintf(
+			"Listener %q of Gateway %s uses the same port %d with an incompatible protocol; "+
+				"ensure only one protocol per port across Gateways",
+			other.Name,
+			other.GatewayName,
+			l.Source.Port,
+		)
+
+		return staticConds.NewListenerProtocolConflict(msg)
+	case l.Source.Protocol == other.Source.Protocol &&
+		getHostname(l.Source.Hostname) == getHostname(other.Source.Hostname),
+		l.Source.Protocol != other.Source.Protocol && haveOverlap(l.Source.Hostname, other.Source.Hostname):
+		msg := fmt.Sprintf(
+			"Listener %q of Gateway %s uses the same port %d with an overlapping hostname; "+
+				"ensure listeners for the same port across Gateways specify distinct hostnames",
+			other.Name,
+			other.GatewayName,
+			l.Source.Port,
+		)
+
+		return staticConds.NewListenerHostnameConflict(msg)
+	default:
+		return nil
 	}
 }
 
@@ -105,6 +255,11 @@ func buildGateway(
 
 	conds := validateGateway(gw, gc)
 
+	annotationSettings, errs, deprecationMsg := buildAnnotationSettings(kinds.Gateway, gw.Annotations)
+	if len(errs) > 0 {
+		conds = append(conds, staticConds.NewGatewayUnsupportedValue(errs.ToAggregate().Error())...)
+	}
+
 	if len(conds) > 0 {
 		return &Gateway{
 			Source:     gw,
@@ -113,10 +268,16 @@ func buildGateway(
 		}
 	}
 
+	if deprecationMsg != "" {
+		conds = append(conds, staticConds.NewGatewayAnnotationsDeprecated(deprecationMsg))
+	}
+
 	return &Gateway{
-		Source:    gw,
-		Listeners: buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
-		Valid:     true,
+		Source:     gw,
+		Listeners:  buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
+		Conditions: conds,
+		Valid:      true,
+		Streaming:  annotationSettings.Streaming,
 	}
 }
 
@@ -129,12 +290,35 @@ func validateGateway(gw *v1.Gateway, gc *GatewayClass) []conditions.Condition {
 		conds = append(conds, staticConds.NewGatewayInvalid("GatewayClass is invalid")...)
 	}
 
-	if len(gw.Spec.Addresses) > 0 {
-		path := field.NewPath("spec", "addresses")
-		valErr := field.Forbidden(path, "addresses are not supported")
-
-		conds = append(conds, staticConds.NewGatewayUnsupportedValue(valErr.Error())...)
+	if err := validateGatewayAddresses(gw.Spec.Addresses); err != nil {
+		conds = append(conds, staticConds.NewGatewayUnsupportedAddress(err.Error())...)
 	}
 
 	return conds
 }
+
+// validateGatewayAddresses validates the requested addresses of the Gateway. Only static IP addresses
+// are supported, because they can be requested from the LoadBalancer Service of the Gateway.
+func validateGatewayAddresses(addresses []v1.GatewayAddress) error {
+	var allErrs field.ErrorList
+
+	path := field.NewPath("spec", "addresses")
+
+	for i, addr := range addresses {
+		addrPath := path.Index(i)
+
+		if addr.Type != nil && *addr.Type != v1.IPAddressType {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(addrPath.Child("type"), *addr.Type, []string{string(v1.IPAddressType)}),
+			)
+			continue
+		}
+
+		if net.ParseIP(addr.Value) == nil {
+			allErrs = append(allErrs, field.Invalid(addrPath.Child("value"), addr.Value, "must be a valid IP address"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
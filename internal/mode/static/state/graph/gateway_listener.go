@@ -3,12 +3,14 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
@@ -21,18 +23,23 @@ import (
 // For now, we only support HTTP and HTTPS listeners.
 type Listener struct {
 	Name string
+	// GatewayName is the NamespacedName of the Gateway the Listener belongs to.
+	GatewayName types.NamespacedName
 	// Source holds the source of the Listener from the Gateway resource.
 	Source v1.Listener
 	// Routes holds the GRPC/HTTPRoutes attached to the Listener.
 	// Only valid routes are attached.
 	Routes map[RouteKey]*L7Route
-	// L4Routes holds the TLSRoutes attached to the Listener.
+	// L4Routes holds the TLSRoutes and TCPRoutes attached to the Listener.
 	L4Routes map[L4RouteKey]*L4Route
 	// AllowedRouteLabelSelector is the label selector for this Listener's allowed routes, if defined.
 	AllowedRouteLabelSelector labels.Selector
 	// ResolvedSecret is the namespaced name of the Secret resolved for this listener.
 	// Only applicable for HTTPS listeners.
 	ResolvedSecret *types.NamespacedName
+	// OCSPStapling holds the OCSP stapling settings of the listener. If nil, OCSP stapling is disabled.
+	// Only applicable for HTTPS listeners.
+	OCSPStapling *OCSPStapling
 	// Conditions holds the conditions of the Listener.
 	Conditions []conditions.Condition
 	// SupportedKinds is the list of RouteGroupKinds allowed by the listener.
@@ -43,6 +50,8 @@ type Listener struct {
 	// Attachable shows whether Routes can attach to the Listener.
 	// Listener can be invalid but still attachable.
 	Attachable bool
+	// CertificateMismatch shows whether the certificate of the Listener doesn't cover the hostname of the Listener.
+	CertificateMismatch bool
 }
 
 func buildListeners(
@@ -57,14 +66,18 @@ func buildListeners(
 
 	for _, gl := range gw.Spec.Listeners {
 		configurator := listenerFactory.getConfiguratorForListener(gl)
-		listeners = append(listeners, configurator.configure(gl))
+
+		l := configurator.configure(gl)
+		l.GatewayName = client.ObjectKeyFromObject(gw)
+
+		listeners = append(listeners, l)
 	}
 
 	return listeners
 }
 
 type listenerConfiguratorFactory struct {
-	http, https, tls, unsupportedProtocol *listenerConfigurator
+	http, https, tls, tcp, udp, unsupportedProtocol *listenerConfigurator
 }
 
 func (f *listenerConfiguratorFactory) getConfiguratorForListener(l v1.Listener) *listenerConfigurator {
@@ -75,6 +88,10 @@ func (f *listenerConfiguratorFactory) getConfiguratorForListener(l v1.Listener)
 		return f.https
 	case v1.TLSProtocolType:
 		return f.tls
+	case v1.TCPProtocolType:
+		return f.tcp
+	case v1.UDPProtocolType:
+		return f.udp
 	default:
 		return f.unsupportedProtocol
 	}
@@ -87,6 +104,8 @@ func newListenerConfiguratorFactory(
 	protectedPorts ProtectedPorts,
 ) *listenerConfiguratorFactory {
 	sharedPortConflictResolver := createPortConflictResolver()
+	// UDP listeners don't conflict with TCP-based listeners on the same port, so they are resolved separately.
+	udpPortConflictResolver := createPortConflictResolver()
 
 	return &listenerConfiguratorFactory{
 		unsupportedProtocol: &listenerConfigurator{
@@ -95,7 +114,13 @@ func newListenerConfiguratorFactory(
 					valErr := field.NotSupported(
 						field.NewPath("protocol"),
 						listener.Protocol,
-						[]string{string(v1.HTTPProtocolType), string(v1.HTTPSProtocolType), string(v1.TLSProtocolType)},
+						[]string{
+							string(v1.HTTPProtocolType),
+							string(v1.HTTPSProtocolType),
+							string(v1.TLSProtocolType),
+							string(v1.TCPProtocolType),
+							string(v1.UDPProtocolType),
+						},
 					)
 					return staticConds.NewListenerUnsupportedProtocol(valErr.Error()), false /* not attachable */
 				},
@@ -138,6 +163,28 @@ func newListenerConfiguratorFactory(
 			},
 			externalReferenceResolvers: []listenerExternalReferenceResolver{},
 		},
+		tcp: &listenerConfigurator{
+			validators: []listenerValidator{
+				validateListenerAllowedRouteKind,
+				validateListenerLabelSelector,
+				createL4ListenerValidator(v1.TCPProtocolType, protectedPorts),
+			},
+			conflictResolvers: []listenerConflictResolver{
+				sharedPortConflictResolver,
+			},
+			externalReferenceResolvers: []listenerExternalReferenceResolver{},
+		},
+		udp: &listenerConfigurator{
+			validators: []listenerValidator{
+				validateListenerAllowedRouteKind,
+				validateListenerLabelSelector,
+				createL4ListenerValidator(v1.UDPProtocolType, protectedPorts),
+			},
+			conflictResolvers: []listenerConflictResolver{
+				udpPortConflictResolver,
+			},
+			externalReferenceResolvers: []listenerExternalReferenceResolver{},
+		},
 	}
 }
 
@@ -265,6 +312,14 @@ func getAndValidateListenerSupportedKinds(listener v1.Listener) (
 		validKinds = []v1.RouteGroupKind{
 			{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 		}
+	case v1.TCPProtocolType:
+		validKinds = []v1.RouteGroupKind{
+			{Kind: v1.Kind(kinds.TCPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+		}
+	case v1.UDPProtocolType:
+		validKinds = []v1.RouteGroupKind{
+			{Kind: v1.Kind(kinds.UDPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+		}
 	}
 
 	validProtocolRouteKind := func(kind v1.RouteGroupKind) bool {
@@ -282,27 +337,65 @@ func getAndValidateListenerSupportedKinds(listener v1.Listener) (
 
 	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Kinds != nil {
 		supportedKinds = make([]v1.RouteGroupKind, 0, len(listener.AllowedRoutes.Kinds))
+
+		var invalidKinds []string
+
 		for _, kind := range listener.AllowedRoutes.Kinds {
 			if !validProtocolRouteKind(kind) {
 				group := v1.GroupName
 				if kind.Group != nil {
 					group = string(*kind.Group)
 				}
-				msg := fmt.Sprintf("Unsupported route kind for protocol %s \"%s/%s\"", listener.Protocol, group, kind.Kind)
-				conds = append(conds, staticConds.NewListenerInvalidRouteKinds(msg)...)
+				invalidKinds = append(invalidKinds, fmt.Sprintf("%q", group+"/"+string(kind.Kind)))
+				continue
+			}
+
+			// the group of the supported kinds is always the Gateway API group, so the kinds are reported
+			// with the resolved group and without duplicates.
+			if slices.ContainsFunc(supportedKinds, func(k v1.RouteGroupKind) bool { return k.Kind == kind.Kind }) {
 				continue
 			}
-			supportedKinds = append(supportedKinds, kind)
+
+			supportedKinds = append(supportedKinds, v1.RouteGroupKind{
+				Kind:  kind.Kind,
+				Group: helpers.GetPointer[v1.Group](v1.GroupName),
+			})
 		}
+
+		if len(invalidKinds) > 0 {
+			msg := fmt.Sprintf(
+				"Unsupported route kinds for protocol %s: %s; resolved route kinds: %s",
+				listener.Protocol,
+				strings.Join(invalidKinds, ", "),
+				formatRouteKinds(supportedKinds),
+			)
+			conds = append(conds, staticConds.NewListenerInvalidRouteKinds(msg)...)
+		}
+
 		return conds, supportedKinds
 	}
 
 	return conds, validKinds
 }
 
+func formatRouteKinds(routeKinds []v1.RouteGroupKind) string {
+	if len(routeKinds) == 0 {
+		return "none"
+	}
+
+	names := make([]string, 0, len(routeKinds))
+	for _, k := range routeKinds {
+		names = append(names, string(k.Kind))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// validateListenerAllowedRouteKind validates the allowed route kinds of the listener. The listener stays attachable
+// for the Routes of the resolved kinds, even if some of its kinds are invalid.
 func validateListenerAllowedRouteKind(listener v1.Listener) (conds []conditions.Condition, attachable bool) {
-	conds, _ = getAndValidateListenerSupportedKinds(listener)
-	return conds, len(conds) == 0
+	conds, supportedKinds := getAndValidateListenerSupportedKinds(listener)
+	return conds, len(conds) == 0 || len(supportedKinds) > 0
 }
 
 func getListenerSupportedKinds(listener v1.Listener) []v1.RouteGroupKind {
@@ -341,6 +434,24 @@ func createHTTPListenerValidator(protectedPorts ProtectedPorts) listenerValidato
 	}
 }
 
+func createL4ListenerValidator(protocol v1.ProtocolType, protectedPorts ProtectedPorts) listenerValidator {
+	return func(listener v1.Listener) (conds []conditions.Condition, attachable bool) {
+		if err := validateListenerPort(listener.Port, protectedPorts); err != nil {
+			path := field.NewPath("port")
+			valErr := field.Invalid(path, listener.Port, err.Error())
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		if listener.TLS != nil {
+			path := field.NewPath("tls")
+			valErr := field.Forbidden(path, fmt.Sprintf("tls is not supported for %s listener", protocol))
+			conds = append(conds, staticConds.NewListenerUnsupportedValue(valErr.Error())...)
+		}
+
+		return conds, true
+	}
+}
+
 func validateListenerPort(port v1.PortNumber, protectedPorts ProtectedPorts) error {
 	if port < 1 || port > 65535 {
 		return errors.New("port must be between 1-65535")
@@ -435,11 +546,15 @@ func createPortConflictResolver() listenerConflictResolver {
 	const (
 		secureProtocolGroup   int = 0
 		insecureProtocolGroup int = 1
+		tcpProtocolGroup      int = 2
+		udpProtocolGroup      int = 3
 	)
 	protocolGroups := map[v1.ProtocolType]int{
 		v1.TLSProtocolType:   secureProtocolGroup,
 		v1.HTTPProtocolType:  insecureProtocolGroup,
 		v1.HTTPSProtocolType: secureProtocolGroup,
+		v1.TCPProtocolType:   tcpProtocolGroup,
+		v1.UDPProtocolType:   udpProtocolGroup,
 	}
 	conflictedPorts := make(map[v1.PortNumber]bool)
 	portProtocolOwner := make(map[v1.PortNumber]int)
@@ -475,7 +590,10 @@ func createPortConflictResolver() listenerConflictResolver {
 
 		// if protocol group owner doesn't match the listener's protocol group we mark the port as conflicted,
 		// and invalidate all listeners we've seen for this port.
-		if protocolGroup != protocolGroups[l.Source.Protocol] {
+		// TCP and UDP listeners can't be distinguished by hostname, so only one of them is allowed per port.
+		if protocolGroup != protocolGroups[l.Source.Protocol] ||
+			protocolGroup == tcpProtocolGroup ||
+			protocolGroup == udpProtocolGroup {
 			conflictedPorts[port] = true
 			for _, listener := range listenersByPort[port] {
 				listener.Valid = false
@@ -545,8 +663,41 @@ func createExternalReferencesForTLSSecretsResolver(
 			l.Valid = false
 		} else {
 			l.ResolvedSecret = &certRefNsName
+			validateListenerCertificateHostname(l, secretResolver.resolvedSecrets[certRefNsName].CertBundle)
+			configureListenerOCSPStapling(l, secretResolver.resolvedSecrets[certRefNsName].Secret)
+		}
+	}
+}
+
+// validateListenerCertificateHostname adds a warning Condition to the Listener if its certificate doesn't cover
+// its hostname. The Listener stays valid, because NGINX can still serve the certificate, but the mismatch is likely
+// a mistake in a rollout of the certificate that clients reject.
+func validateListenerCertificateHostname(l *Listener, certBundle *CertificateBundle) {
+	if l.Source.Hostname == nil || *l.Source.Hostname == "" || certBundle == nil {
+		return
+	}
+
+	if err := validateCertificateHostname(certBundle.Cert.TLSCert, string(*l.Source.Hostname)); err != nil {
+		msg := fmt.Sprintf("Certificate of Secret %s: %s", certBundle.Name, err)
+		l.Conditions = append(l.Conditions, staticConds.NewListenerCertificateMismatch(msg))
+		l.CertificateMismatch = true
+	}
+}
+
+// countCertificateMismatches returns the number of the valid Listeners with certificates that don't cover
+// their hostnames.
+func countCertificateMismatches(gws map[types.NamespacedName]*Gateway) int {
+	var count int
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if l.Valid && l.CertificateMismatch {
+				count++
+			}
 		}
 	}
+
+	return count
 }
 
 // GetAllowedRouteLabelSelector returns a listener's AllowedRoutes label selector if it exists.
@@ -588,3 +739,78 @@ func haveOverlap(hostname1, hostname2 *v1.Hostname) bool {
 	}
 	return matchesWildcard(h1, h2)
 }
+
+// validateHTTP3Listeners validates the listeners of the Gateways when HTTP/3 is enabled in the NginxProxy.
+// The HTTPS listeners are invalidated if NGINX doesn't support QUIC. Otherwise, because NGINX listens for QUIC
+// connections on the UDP port of an HTTPS listener, the UDP listeners on the same port are invalidated.
+func validateHTTP3Listeners(gws map[types.NamespacedName]*Gateway, npCfg *NginxProxy, quicSupported bool) {
+	if !isHTTP3Enabled(npCfg) {
+		return
+	}
+
+	httpsPorts := make(map[v1.PortNumber]struct{})
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid || l.Source.Protocol != v1.HTTPSProtocolType {
+				continue
+			}
+
+			if !quicSupported {
+				msg := "HTTP/3 is enabled in the NginxProxy, but NGINX doesn't support QUIC"
+				l.Valid = false
+				l.Conditions = append(l.Conditions, staticConds.NewListenerUnsupportedValue(msg)...)
+
+				continue
+			}
+
+			httpsPorts[l.Source.Port] = struct{}{}
+		}
+	}
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid || l.Source.Protocol != v1.UDPProtocolType {
+				continue
+			}
+
+			if _, exists := httpsPorts[l.Source.Port]; exists {
+				msg := fmt.Sprintf(
+					"HTTPS listeners for the same port %d use it for HTTP/3; ensure no UDP listeners for the same port",
+					l.Source.Port,
+				)
+				l.Valid = false
+				l.Conditions = append(l.Conditions, staticConds.NewListenerProtocolConflict(msg)...)
+			}
+		}
+	}
+}
+
+// validateStatusPortListeners invalidates the listeners that use the port of the status endpoint of the NginxProxy.
+func validateStatusPortListeners(gws map[types.NamespacedName]*Gateway, npCfg *NginxProxy) {
+	if npCfg == nil || !npCfg.Valid || npCfg.Source == nil || npCfg.Source.Spec.Status == nil {
+		return
+	}
+
+	statusPort := npCfg.Source.Spec.Status.Port
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid || int32(l.Source.Port) != statusPort {
+				continue
+			}
+
+			msg := "port is already in use as the status port of the NginxProxy"
+			l.Valid = false
+			l.Conditions = append(l.Conditions, staticConds.NewListenerUnsupportedValue(msg)...)
+		}
+	}
+}
+
+func isHTTP3Enabled(npCfg *NginxProxy) bool {
+	if npCfg == nil || !npCfg.Valid || npCfg.Source == nil {
+		return false
+	}
+
+	return npCfg.Source.Spec.EnableHTTP3
+}
@@ -6,8 +6,10 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
@@ -74,6 +76,91 @@ func TestValidateHTTPListener(t *testing.T) {
 	}
 }
 
+func TestValidateL4Listener(t *testing.T) {
+	t.Parallel()
+	protectedPorts := ProtectedPorts{9113: "MetricsPort"}
+
+	tests := []struct {
+		l        v1.Listener
+		name     string
+		protocol v1.ProtocolType
+		expected []conditions.Condition
+	}{
+		{
+			l: v1.Listener{
+				Port: 5432,
+			},
+			protocol: v1.TCPProtocolType,
+			expected: nil,
+			name:     "valid TCP",
+		},
+		{
+			l: v1.Listener{
+				Port: 53,
+			},
+			protocol: v1.UDPProtocolType,
+			expected: nil,
+			name:     "valid UDP",
+		},
+		{
+			l: v1.Listener{
+				Port: 0,
+			},
+			protocol: v1.TCPProtocolType,
+			expected: staticConds.NewListenerUnsupportedValue(`port: Invalid value: 0: port must be between 1-65535`),
+			name:     "invalid port",
+		},
+		{
+			l: v1.Listener{
+				Port: 5432,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+				Name: "tcp-listener",
+			},
+			protocol: v1.TCPProtocolType,
+			expected: staticConds.NewListenerUnsupportedValue(`tls: Forbidden: tls is not supported for TCP listener`),
+			name:     "invalid TCP listener with TLS",
+		},
+		{
+			l: v1.Listener{
+				Port: 53,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModePassthrough),
+				},
+				Name: "udp-listener",
+			},
+			protocol: v1.UDPProtocolType,
+			expected: staticConds.NewListenerUnsupportedValue(`tls: Forbidden: tls is not supported for UDP listener`),
+			name:     "invalid UDP listener with TLS",
+		},
+		{
+			l: v1.Listener{
+				Port: 9113,
+			},
+			protocol: v1.UDPProtocolType,
+			expected: staticConds.NewListenerUnsupportedValue(
+				`port: Invalid value: 9113: port is already in use as MetricsPort`,
+			),
+			name: "invalid protected port",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := createL4ListenerValidator(test.protocol, protectedPorts)
+
+			result, attachable := v(test.l)
+
+			g.Expect(result).To(Equal(test.expected))
+			g.Expect(attachable).To(BeTrue())
+		})
+	}
+}
+
 func TestValidateHTTPSListener(t *testing.T) {
 	t.Parallel()
 	secretNs := "secret-ns"
@@ -306,7 +393,7 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 	}
 	TCPRouteGroupKind := []v1.RouteGroupKind{
 		{
-			Kind:  "TCPRoute",
+			Kind:  kinds.TCPRoute,
 			Group: helpers.GetPointer[v1.Group](v1.GroupName),
 		},
 	}
@@ -314,6 +401,10 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 		Kind:  kinds.TLSRoute,
 		Group: helpers.GetPointer[v1.Group](v1.GroupName),
 	}
+	UDPRouteGroupKind := v1.RouteGroupKind{
+		Kind:  kinds.UDPRoute,
+		Group: helpers.GetPointer[v1.Group](v1.GroupName),
+	}
 	tests := []struct {
 		protocol  v1.ProtocolType
 		name      string
@@ -322,7 +413,7 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 		expectErr bool
 	}{
 		{
-			protocol:  v1.TCPProtocolType,
+			protocol:  v1.ProtocolType("SCTP"),
 			expectErr: false,
 			name:      "unsupported protocol is ignored",
 			expected:  nil,
@@ -346,6 +437,34 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 			name:      "invalid kind",
 			expected:  []v1.RouteGroupKind{},
 		},
+		{
+			protocol:  v1.TCPProtocolType,
+			kind:      TCPRouteGroupKind,
+			expectErr: false,
+			name:      "valid TCP",
+			expected:  TCPRouteGroupKind,
+		},
+		{
+			protocol:  v1.TCPProtocolType,
+			kind:      []v1.RouteGroupKind{TLSRouteGroupKind},
+			expectErr: true,
+			name:      "invalid kind for TCP",
+			expected:  []v1.RouteGroupKind{},
+		},
+		{
+			protocol:  v1.UDPProtocolType,
+			kind:      []v1.RouteGroupKind{UDPRouteGroupKind},
+			expectErr: false,
+			name:      "valid UDP",
+			expected:  []v1.RouteGroupKind{UDPRouteGroupKind},
+		},
+		{
+			protocol:  v1.UDPProtocolType,
+			kind:      TCPRouteGroupKind,
+			expectErr: true,
+			name:      "invalid kind for UDP",
+			expected:  []v1.RouteGroupKind{},
+		},
 		{
 			protocol:  v1.HTTPProtocolType,
 			kind:      []v1.RouteGroupKind{HTTPRouteGroupKind},
@@ -420,6 +539,16 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 			name:     "valid kinds for TLS protocol",
 			expected: []v1.RouteGroupKind{TLSRouteGroupKind},
 		},
+		{
+			protocol: v1.HTTPProtocolType,
+			kind: []v1.RouteGroupKind{
+				{Kind: kinds.GRPCRoute},
+				GRPCRouteGroupKind,
+				HTTPRouteGroupKind,
+			},
+			name:     "duplicate kinds and kind without group",
+			expected: []v1.RouteGroupKind{GRPCRouteGroupKind, HTTPRouteGroupKind},
+		},
 	}
 
 	for _, test := range tests {
@@ -448,6 +577,58 @@ func TestGetAndValidateListenerSupportedKinds(t *testing.T) {
 	}
 }
 
+func TestValidateListenerAllowedRouteKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expMsg        string
+		kinds         []v1.RouteGroupKind
+		expAttachable bool
+	}{
+		{
+			name:          "valid kinds",
+			kinds:         []v1.RouteGroupKind{{Kind: kinds.GRPCRoute}},
+			expAttachable: true,
+		},
+		{
+			name:  "valid and invalid kinds",
+			kinds: []v1.RouteGroupKind{{Kind: kinds.GRPCRoute}, {Kind: kinds.TLSRoute}, {Kind: "bad-kind"}},
+			expMsg: `Unsupported route kinds for protocol HTTP: "gateway.networking.k8s.io/TLSRoute", ` +
+				`"gateway.networking.k8s.io/bad-kind"; resolved route kinds: GRPCRoute`,
+			expAttachable: true,
+		},
+		{
+			name:  "invalid kinds",
+			kinds: []v1.RouteGroupKind{{Kind: kinds.TCPRoute}},
+			expMsg: `Unsupported route kinds for protocol HTTP: "gateway.networking.k8s.io/TCPRoute"; ` +
+				`resolved route kinds: none`,
+			expAttachable: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			listener := v1.Listener{
+				Protocol:      v1.HTTPProtocolType,
+				AllowedRoutes: &v1.AllowedRoutes{Kinds: test.kinds},
+			}
+
+			conds, attachable := validateListenerAllowedRouteKind(listener)
+			g.Expect(attachable).To(Equal(test.expAttachable))
+
+			if test.expMsg == "" {
+				g.Expect(conds).To(BeEmpty())
+			} else {
+				g.Expect(conds).To(Equal(staticConds.NewListenerInvalidRouteKinds(test.expMsg)))
+			}
+		})
+	}
+}
+
 func TestValidateListenerLabelSelector(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -638,3 +819,222 @@ func TestValidateTLSFieldOnTLSListener(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHTTP3Listeners(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(name string, protocol v1.ProtocolType, port v1.PortNumber, valid bool) *Listener {
+		return &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Valid: valid,
+		}
+	}
+
+	createNginxProxy := func(enableHTTP3, valid bool) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{EnableHTTP3: enableHTTP3},
+			},
+			Valid: valid,
+		}
+	}
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	mergedGwNsName := types.NamespacedName{Namespace: "test", Name: "merged"}
+
+	unsupportedConds := staticConds.NewListenerUnsupportedValue(
+		"HTTP/3 is enabled in the NginxProxy, but NGINX doesn't support QUIC",
+	)
+	conflictConds := staticConds.NewListenerProtocolConflict(
+		"HTTPS listeners for the same port 443 use it for HTTP/3; ensure no UDP listeners for the same port",
+	)
+
+	tests := []struct {
+		npCfg              *NginxProxy
+		expectedValid      map[string]bool
+		expectedConditions map[string][]conditions.Condition
+		msg                string
+		quicSupported      bool
+	}{
+		{
+			msg:           "no NginxProxy",
+			npCfg:         nil,
+			quicSupported: false,
+			expectedValid: map[string]bool{
+				"https": true, "https-8443": true, "udp-443": true, "udp-53": true, "http": true, "invalid-https": false,
+			},
+		},
+		{
+			msg:           "http3 disabled",
+			npCfg:         createNginxProxy(false, true),
+			quicSupported: false,
+			expectedValid: map[string]bool{
+				"https": true, "https-8443": true, "udp-443": true, "udp-53": true, "http": true, "invalid-https": false,
+			},
+		},
+		{
+			msg:           "http3 enabled in an invalid NginxProxy",
+			npCfg:         createNginxProxy(true, false),
+			quicSupported: false,
+			expectedValid: map[string]bool{
+				"https": true, "https-8443": true, "udp-443": true, "udp-53": true, "http": true, "invalid-https": false,
+			},
+		},
+		{
+			msg:           "http3 enabled, but QUIC is not supported",
+			npCfg:         createNginxProxy(true, true),
+			quicSupported: false,
+			expectedValid: map[string]bool{
+				"https": false, "https-8443": false, "udp-443": true, "udp-53": true, "http": true, "invalid-https": false,
+			},
+			expectedConditions: map[string][]conditions.Condition{
+				"https":      unsupportedConds,
+				"https-8443": unsupportedConds,
+			},
+		},
+		{
+			msg:           "http3 enabled and QUIC is supported",
+			npCfg:         createNginxProxy(true, true),
+			quicSupported: true,
+			expectedValid: map[string]bool{
+				"https": true, "https-8443": true, "udp-443": false, "udp-53": true, "http": true, "invalid-https": false,
+			},
+			expectedConditions: map[string][]conditions.Condition{
+				"udp-443": conflictConds,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			listeners := []*Listener{
+				createListener("https", v1.HTTPSProtocolType, 443, true),
+				createListener("http", v1.HTTPProtocolType, 80, true),
+				createListener("udp-53", v1.UDPProtocolType, 53, true),
+				createListener("invalid-https", v1.HTTPSProtocolType, 9443, false),
+			}
+			mergedListeners := []*Listener{
+				createListener("https-8443", v1.HTTPSProtocolType, 8443, true),
+				createListener("udp-443", v1.UDPProtocolType, 443, true),
+			}
+
+			gws := map[types.NamespacedName]*Gateway{
+				gwNsName:       {Listeners: listeners},
+				mergedGwNsName: {Listeners: mergedListeners},
+			}
+
+			validateHTTP3Listeners(gws, test.npCfg, test.quicSupported)
+
+			for _, l := range append(listeners, mergedListeners...) {
+				g.Expect(l.Valid).To(Equal(test.expectedValid[l.Name]), l.Name)
+				g.Expect(l.Conditions).To(Equal(test.expectedConditions[l.Name]), l.Name)
+			}
+		})
+	}
+}
+
+func TestValidateStatusPortListeners(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(name string, port v1.PortNumber, valid bool) *Listener {
+		return &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: v1.HTTPProtocolType,
+				Port:     port,
+			},
+			Valid: valid,
+		}
+	}
+
+	createNginxProxy := func(status *ngfAPI.NginxStatus, valid bool) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{Status: status},
+			},
+			Valid: valid,
+		}
+	}
+
+	status := &ngfAPI.NginxStatus{
+		Port: 8080,
+		AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+			{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "10.0.0.0/8"},
+		},
+	}
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	mergedGwNsName := types.NamespacedName{Namespace: "test", Name: "merged"}
+
+	conflictConds := staticConds.NewListenerUnsupportedValue(
+		"port is already in use as the status port of the NginxProxy",
+	)
+
+	tests := []struct {
+		npCfg              *NginxProxy
+		expectedValid      map[string]bool
+		expectedConditions map[string][]conditions.Condition
+		msg                string
+	}{
+		{
+			msg:           "no NginxProxy",
+			expectedValid: map[string]bool{"http": true, "http-8080": true, "merged-8080": true, "invalid-8080": false},
+		},
+		{
+			msg:           "no status endpoint",
+			npCfg:         createNginxProxy(nil, true),
+			expectedValid: map[string]bool{"http": true, "http-8080": true, "merged-8080": true, "invalid-8080": false},
+		},
+		{
+			msg:           "status endpoint in an invalid NginxProxy",
+			npCfg:         createNginxProxy(status, false),
+			expectedValid: map[string]bool{"http": true, "http-8080": true, "merged-8080": true, "invalid-8080": false},
+		},
+		{
+			msg:           "status endpoint",
+			npCfg:         createNginxProxy(status, true),
+			expectedValid: map[string]bool{"http": true, "http-8080": false, "merged-8080": false, "invalid-8080": false},
+			expectedConditions: map[string][]conditions.Condition{
+				"http-8080":   conflictConds,
+				"merged-8080": conflictConds,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			listeners := []*Listener{
+				createListener("http", 80, true),
+				createListener("http-8080", 8080, true),
+				createListener("invalid-8080", 8080, false),
+			}
+			mergedListeners := []*Listener{
+				createListener("merged-8080", 8080, true),
+			}
+
+			gws := map[types.NamespacedName]*Gateway{
+				gwNsName:       {Listeners: listeners},
+				mergedGwNsName: {Listeners: mergedListeners},
+			}
+
+			validateStatusPortListeners(gws, test.npCfg)
+
+			for _, l := range append(listeners, mergedListeners...) {
+				g.Expect(l.Valid).To(Equal(test.expectedValid[l.Name]), l.Name)
+				g.Expect(l.Conditions).To(Equal(test.expectedConditions[l.Name]), l.Name)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -25,7 +26,7 @@ func TestProcessedGatewaysGetAllNsNames(t *testing.T) {
 			Name:      "gateway-1",
 		},
 	}
-	loser := &v1.Gateway{
+	merged := &v1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: "test",
 			Name:      "gateway-2",
@@ -45,15 +46,13 @@ func TestProcessedGatewaysGetAllNsNames(t *testing.T) {
 		{
 			gws: processedGateways{
 				Winner: winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{
-					client.ObjectKeyFromObject(loser): loser,
-				},
+				Merged: []*v1.Gateway{merged},
 			},
 			expected: []types.NamespacedName{
 				client.ObjectKeyFromObject(winner),
-				client.ObjectKeyFromObject(loser),
+				client.ObjectKeyFromObject(merged),
 			},
-			name: "winner and ignored",
+			name: "winner and merged",
 		},
 	}
 
@@ -80,7 +79,7 @@ func TestProcessGateways(t *testing.T) {
 			GatewayClassName: gcName,
 		},
 	}
-	loser := &v1.Gateway{
+	merged := &v1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: "test",
 			Name:      "gateway-2",
@@ -89,9 +88,20 @@ func TestProcessGateways(t *testing.T) {
 			GatewayClassName: gcName,
 		},
 	}
+	selected := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway-3",
+			Labels:    map[string]string{"instance": "blue"},
+		},
+		Spec: v1.GatewaySpec{
+			GatewayClassName: gcName,
+		},
+	}
 
 	tests := []struct {
 		gws      map[types.NamespacedName]*v1.Gateway
+		selector labels.Selector
 		expected processedGateways
 		name     string
 	}{
@@ -114,31 +124,48 @@ func TestProcessGateways(t *testing.T) {
 				{Namespace: "test", Name: "gateway-1"}: winner,
 			},
 			expected: processedGateways{
-				Winner:  winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{},
+				Winner: winner,
 			},
 			name: "one gateway",
 		},
 		{
 			gws: map[types.NamespacedName]*v1.Gateway{
 				{Namespace: "test", Name: "gateway-1"}: winner,
-				{Namespace: "test", Name: "gateway-2"}: loser,
+				{Namespace: "test", Name: "gateway-2"}: merged,
 			},
 			expected: processedGateways{
 				Winner: winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{
-					{Namespace: "test", Name: "gateway-2"}: loser,
-				},
+				Merged: []*v1.Gateway{merged},
 			},
 			name: "multiple gateways",
 		},
+		{
+			gws: map[types.NamespacedName]*v1.Gateway{
+				{Namespace: "test", Name: "gateway-1"}: winner,
+				{Namespace: "test", Name: "gateway-2"}: merged,
+				{Namespace: "test", Name: "gateway-3"}: selected,
+			},
+			selector: labels.SelectorFromSet(labels.Set{"instance": "blue"}),
+			expected: processedGateways{
+				Winner: selected,
+			},
+			name: "gateways filtered by selector",
+		},
+		{
+			gws: map[types.NamespacedName]*v1.Gateway{
+				{Namespace: "test", Name: "gateway-1"}: winner,
+			},
+			selector: labels.SelectorFromSet(labels.Set{"instance": "blue"}),
+			expected: processedGateways{},
+			name:     "no gateways match selector",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := processGateways(test.gws, gcName)
+			result := processGateways(test.gws, gcName, test.selector)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
@@ -254,6 +281,12 @@ func TestBuildGateway(t *testing.T) {
 	createTCPListener := func(name, hostname string, port int) v1.Listener {
 		return createListener(name, hostname, port, v1.TCPProtocolType, nil)
 	}
+	createUDPListener := func(name, hostname string, port int) v1.Listener {
+		return createListener(name, hostname, port, v1.UDPProtocolType, nil)
+	}
+	createSCTPListener := func(name, hostname string, port int) v1.Listener {
+		return createListener(name, hostname, port, v1.ProtocolType("SCTP"), nil)
+	}
 	createTLSListener := func(name, hostname string, port int) v1.Listener {
 		return createListener(
 			name,
@@ -277,6 +310,7 @@ func TestBuildGateway(t *testing.T) {
 	foo80HTTPSListener := createHTTPSListener("foo-80-https", "foo.example.com", 80, gatewayTLSConfigSameNs)
 	foo443HTTPSListener1 := createHTTPSListener("foo-443-https-1", "foo.example.com", 443, gatewayTLSConfigSameNs)
 	foo8443HTTPSListener := createHTTPSListener("foo-8443-https", "foo.example.com", 8443, gatewayTLSConfigSameNs)
+	fooOrg443HTTPSListener := createHTTPSListener("foo-org-443-https", "foo.example.org", 443, gatewayTLSConfigSameNs)
 	splat443HTTPSListener := createHTTPSListener("splat-443-https", "*.example.com", 443, gatewayTLSConfigSameNs)
 
 	// bar http listener
@@ -297,8 +331,18 @@ func TestBuildGateway(t *testing.T) {
 	// tls listeners
 	foo443TLSListener := createTLSListener("foo-443-tls", "foo.example.com", 443)
 
+	// tcp listeners
+	foo5432TCPListener1 := createTCPListener("foo-5432-tcp-1", "foo.example.com", 5432)
+	foo5432TCPListener2 := createTCPListener("foo-5432-tcp-2", "bar.example.com", 5432)
+	foo80TCPListener := createTCPListener("foo-80-tcp", "foo.example.com", 80)
+
+	// udp listeners
+	foo53UDPListener1 := createUDPListener("foo-53-udp-1", "foo.example.com", 53)
+	foo53UDPListener2 := createUDPListener("foo-53-udp-2", "bar.example.com", 53)
+	foo53TCPListener := createTCPListener("foo-53-tcp", "foo.example.com", 53)
+
 	// invalid listeners
-	invalidProtocolListener := createTCPListener("invalid-protocol", "bar.example.com", 80)
+	invalidProtocolListener := createSCTPListener("invalid-protocol", "bar.example.com", 80)
 	invalidPortListener := createHTTPListener("invalid-port", "invalid-port", 0)
 	invalidProtectedPortListener := createHTTPListener("invalid-protected-port", "invalid-protected-port", 9113)
 	invalidHostnameListener := createHTTPListener("invalid-hostname", "$example.com", 80)
@@ -333,20 +377,28 @@ func TestBuildGateway(t *testing.T) {
 		conflict443PortMsg = "Multiple listeners for the same port 443 specify incompatible protocols; " +
 			"ensure only one protocol per port"
 
+		conflict5432PortMsg = "Multiple listeners for the same port 5432 specify incompatible protocols; " +
+			"ensure only one protocol per port"
+
+		conflict53PortMsg = "Multiple listeners for the same port 53 specify incompatible protocols; " +
+			"ensure only one protocol per port"
+
 		conflict443HostnameMsg = "HTTPS and TLS listeners for the same port 443 specify overlapping hostnames; " +
 			"ensure no overlapping hostnames for HTTPS and TLS listeners for the same port"
 	)
 
 	type gatewayCfg struct {
-		listeners []v1.Listener
-		addresses []v1.GatewayAddress
+		annotations map[string]string
+		listeners   []v1.Listener
+		addresses   []v1.GatewayAddress
 	}
 
 	var lastCreatedGateway *v1.Gateway
 	createGateway := func(cfg gatewayCfg) *v1.Gateway {
 		lastCreatedGateway = &v1.Gateway{
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: "test",
+				Namespace:   "test",
+				Annotations: cfg.annotations,
 			},
 			Spec: v1.GatewaySpec{
 				GatewayClassName: gcName,
@@ -387,6 +439,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo80Listener1,
 						Valid:          true,
 						Attachable:     true,
@@ -396,6 +449,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-8080",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo8080Listener,
 						Valid:          true,
 						Attachable:     true,
@@ -418,6 +472,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "foo-443-https-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo443HTTPSListener1,
 						Valid:          true,
 						Attachable:     true,
@@ -428,6 +483,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-8443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
@@ -441,6 +497,37 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "valid https listeners",
 		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{fooOrg443HTTPSListener}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-org-443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:         fooOrg443HTTPSListener,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
+						SupportedKinds: supportedKindsForListeners,
+						Conditions: []conditions.Condition{
+							staticConds.NewListenerCertificateMismatch(
+								"Certificate of Secret test/secret: the DNS Subject Alternative Names of the certificate " +
+									`[example.com *.example.com] don't cover the hostname "foo.example.org"`,
+							),
+						},
+						CertificateMismatch: true,
+					},
+				},
+				Valid: true,
+			},
+			name: "valid https listener with certificate that doesn't cover its hostname",
+		},
 		{
 			gateway:      createGateway(gatewayCfg{listeners: []v1.Listener{listenerAllowedRoutes}}),
 			gatewayClass: validGC,
@@ -449,6 +536,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-with-allowed-routes",
+						GatewayName:               client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:                    listenerAllowedRoutes,
 						Valid:                     true,
 						Attachable:                true,
@@ -496,6 +584,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "listener-cross-ns-secret",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         crossNamespaceSecretListener,
 						Valid:          true,
 						Attachable:     true,
@@ -516,10 +605,11 @@ func TestBuildGateway(t *testing.T) {
 				Source: getLastCreatedGateway(),
 				Listeners: []*Listener{
 					{
-						Name:       "listener-cross-ns-secret",
-						Source:     crossNamespaceSecretListener,
-						Valid:      false,
-						Attachable: true,
+						Name:        "listener-cross-ns-secret",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      crossNamespaceSecretListener,
+						Valid:       false,
+						Attachable:  true,
 						Conditions: staticConds.NewListenerRefNotPermitted(
 							`Certificate ref to secret diff-ns/secret not permitted by any ReferenceGrant`,
 						),
@@ -539,10 +629,11 @@ func TestBuildGateway(t *testing.T) {
 				Source: getLastCreatedGateway(),
 				Listeners: []*Listener{
 					{
-						Name:       "listener-with-invalid-selector",
-						Source:     listenerInvalidSelector,
-						Valid:      false,
-						Attachable: true,
+						Name:        "listener-with-invalid-selector",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      listenerInvalidSelector,
+						Valid:       false,
+						Attachable:  true,
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`invalid label selector: "invalid" is not a valid label selector operator`,
 						),
@@ -564,12 +655,13 @@ func TestBuildGateway(t *testing.T) {
 				Source: getLastCreatedGateway(),
 				Listeners: []*Listener{
 					{
-						Name:       "invalid-protocol",
-						Source:     invalidProtocolListener,
-						Valid:      false,
-						Attachable: false,
+						Name:        "invalid-protocol",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      invalidProtocolListener,
+						Valid:       false,
+						Attachable:  false,
 						Conditions: staticConds.NewListenerUnsupportedProtocol(
-							`protocol: Unsupported value: "TCP": supported values: "HTTP", "HTTPS", "TLS"`,
+							`protocol: Unsupported value: "SCTP": supported values: "HTTP", "HTTPS", "TLS", "TCP", "UDP"`,
 						),
 						Routes:   map[RouteKey]*L7Route{},
 						L4Routes: map[L4RouteKey]*L4Route{},
@@ -594,10 +686,11 @@ func TestBuildGateway(t *testing.T) {
 				Source: getLastCreatedGateway(),
 				Listeners: []*Listener{
 					{
-						Name:       "invalid-port",
-						Source:     invalidPortListener,
-						Valid:      false,
-						Attachable: true,
+						Name:        "invalid-port",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      invalidPortListener,
+						Valid:       false,
+						Attachable:  true,
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 0: port must be between 1-65535`,
 						),
@@ -606,10 +699,11 @@ func TestBuildGateway(t *testing.T) {
 						SupportedKinds: supportedKindsForListeners,
 					},
 					{
-						Name:       "invalid-https-port",
-						Source:     invalidHTTPSPortListener,
-						Valid:      false,
-						Attachable: true,
+						Name:        "invalid-https-port",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      invalidHTTPSPortListener,
+						Valid:       false,
+						Attachable:  true,
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 65536: port must be between 1-65535`,
 						),
@@ -618,10 +712,11 @@ func TestBuildGateway(t *testing.T) {
 						SupportedKinds: supportedKindsForListeners,
 					},
 					{
-						Name:       "invalid-protected-port",
-						Source:     invalidProtectedPortListener,
-						Valid:      false,
-						Attachable: true,
+						Name:        "invalid-protected-port",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      invalidProtectedPortListener,
+						Valid:       false,
+						Attachable:  true,
 						Conditions: staticConds.NewListenerUnsupportedValue(
 							`port: Invalid value: 9113: port is already in use as MetricsPort`,
 						),
@@ -644,6 +739,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "invalid-hostname",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         invalidHostnameListener,
 						Valid:          false,
 						Conditions:     staticConds.NewListenerUnsupportedValue(invalidHostnameMsg),
@@ -653,6 +749,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "invalid-https-hostname",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         invalidHTTPSHostnameListener,
 						Valid:          false,
 						Conditions:     staticConds.NewListenerUnsupportedValue(invalidHostnameMsg),
@@ -672,12 +769,13 @@ func TestBuildGateway(t *testing.T) {
 				Source: getLastCreatedGateway(),
 				Listeners: []*Listener{
 					{
-						Name:       "invalid-tls-config",
-						Source:     invalidTLSConfigListener,
-						Valid:      false,
-						Attachable: true,
-						Routes:     map[RouteKey]*L7Route{},
-						L4Routes:   map[L4RouteKey]*L4Route{},
+						Name:        "invalid-tls-config",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      invalidTLSConfigListener,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
 						Conditions: staticConds.NewListenerInvalidCertificateRef(
 							`tls.certificateRefs[0]: Invalid value: test/does-not-exist: secret does not exist`,
 						),
@@ -709,6 +807,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo80Listener1,
 						Valid:          true,
 						Attachable:     true,
@@ -718,6 +817,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-8080",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo8080Listener,
 						Valid:          true,
 						Attachable:     true,
@@ -727,6 +827,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-8081",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo8081Listener,
 						Valid:          true,
 						Attachable:     true,
@@ -736,6 +837,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-443-https-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo443HTTPSListener1,
 						Valid:          true,
 						Attachable:     true,
@@ -746,6 +848,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-8443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
@@ -756,6 +859,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "bar-80",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar80Listener,
 						Valid:          true,
 						Attachable:     true,
@@ -765,6 +869,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "bar-443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
@@ -775,6 +880,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "bar-8443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar8443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
@@ -807,6 +913,7 @@ func TestBuildGateway(t *testing.T) {
 				Listeners: []*Listener{
 					{
 						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo80Listener1,
 						Valid:          false,
 						Attachable:     true,
@@ -817,6 +924,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "bar-80",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar80Listener,
 						Valid:          false,
 						Attachable:     true,
@@ -827,6 +935,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-443-http",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo443HTTPListener,
 						Valid:          false,
 						Attachable:     true,
@@ -837,6 +946,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-80-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo80HTTPSListener,
 						Valid:          false,
 						Attachable:     true,
@@ -848,6 +958,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "foo-443-https-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo443HTTPSListener1,
 						Valid:          false,
 						Attachable:     true,
@@ -859,6 +970,7 @@ func TestBuildGateway(t *testing.T) {
 					},
 					{
 						Name:           "bar-443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar443HTTPSListener,
 						Valid:          false,
 						Attachable:     true,
@@ -884,11 +996,110 @@ func TestBuildGateway(t *testing.T) {
 			expected: &Gateway{
 				Source: getLastCreatedGateway(),
 				Valid:  false,
-				Conditions: staticConds.NewGatewayUnsupportedValue("spec." +
-					"addresses: Forbidden: addresses are not supported",
+				Conditions: staticConds.NewGatewayUnsupportedAddress(
+					"spec.addresses[0].value: Invalid value: \"\": must be a valid IP address",
+				),
+			},
+			name: "invalid gateway address",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners: []v1.Listener{foo80Listener1},
+					addresses: []v1.GatewayAddress{
+						{Value: "10.0.0.1"},
+						{Type: helpers.GetPointer(v1.HostnameAddressType), Value: "example.com"},
+					},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  false,
+				Conditions: staticConds.NewGatewayUnsupportedAddress(
+					"spec.addresses[1].type: Unsupported value: \"Hostname\": supported values: \"IPAddress\"",
 				),
 			},
-			name: "gateway addresses are not supported",
+			name: "unsupported gateway address type",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners: []v1.Listener{foo80Listener1},
+					addresses: []v1.GatewayAddress{
+						{Value: "10.0.0.1"},
+						{Type: helpers.GetPointer(v1.IPAddressType), Value: "2001:db8::1"},
+					},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Valid: true,
+			},
+			name: "valid gateway addresses",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo80Listener1},
+					annotations: map[string]string{StreamingAnnotation: "true"},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayAnnotationsDeprecated(
+						"Some annotations are deprecated and will be removed in a future release: " +
+							"use spec.buffering.enable of a ClientSettingsPolicy instead of gateway.nginx.org/streaming",
+					),
+				},
+				Valid:     true,
+				Streaming: true,
+			},
+			name: "streaming annotation",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo80Listener1},
+					annotations: map[string]string{UseRegexAnnotation: "true"},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  false,
+				Conditions: staticConds.NewGatewayUnsupportedValue(
+					"metadata.annotations[gateway.nginx.org/use-regex]: Forbidden: not supported for a Gateway",
+				),
+			},
+			name: "annotation not supported for a gateway",
 		},
 		{
 			gateway:  nil,
@@ -929,19 +1140,21 @@ func TestBuildGateway(t *testing.T) {
 				Valid:  true,
 				Listeners: []*Listener{
 					{
-						Name:       "foo-443-tls",
-						Source:     foo443TLSListener,
-						Valid:      false,
-						Attachable: true,
-						Routes:     map[RouteKey]*L7Route{},
-						L4Routes:   map[L4RouteKey]*L4Route{},
-						Conditions: staticConds.NewListenerProtocolConflict(conflict443PortMsg),
+						Name:        "foo-443-tls",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo443TLSListener,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict443PortMsg),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 						},
 					},
 					{
 						Name:           "foo-443-http",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         foo443HTTPListener,
 						Valid:          false,
 						Attachable:     true,
@@ -954,6 +1167,181 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "http listener and tls listener port conflicting",
 		},
+		{
+			gateway:      createGateway(gatewayCfg{listeners: []v1.Listener{foo5432TCPListener1}}),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  true,
+				Listeners: []*Listener{
+					{
+						Name:        "foo-5432-tcp-1",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo5432TCPListener1,
+						Valid:       true,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.TCPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+				},
+			},
+			name: "valid tcp listener",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{foo80TCPListener, foo80Listener1}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  true,
+				Listeners: []*Listener{
+					{
+						Name:        "foo-80-tcp",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo80TCPListener,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict80PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.TCPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+					{
+						Name:           "foo-80-1",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:         foo80Listener1,
+						Valid:          false,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						Conditions:     staticConds.NewListenerProtocolConflict(conflict80PortMsg),
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+			},
+			name: "tcp listener and http listener port conflicting",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{foo5432TCPListener1, foo5432TCPListener2}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  true,
+				Listeners: []*Listener{
+					{
+						Name:        "foo-5432-tcp-1",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo5432TCPListener1,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict5432PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.TCPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+					{
+						Name:        "foo-5432-tcp-2",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo5432TCPListener2,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict5432PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.TCPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+				},
+			},
+			name: "multiple tcp listeners on the same port conflicting",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{foo53UDPListener1, foo53TCPListener}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  true,
+				Listeners: []*Listener{
+					{
+						Name:        "foo-53-udp-1",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo53UDPListener1,
+						Valid:       true,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.UDPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+					{
+						Name:        "foo-53-tcp",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo53TCPListener,
+						Valid:       true,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.TCPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+				},
+			},
+			name: "udp listener and tcp listener on the same port",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{listeners: []v1.Listener{foo53UDPListener1, foo53UDPListener2}},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Valid:  true,
+				Listeners: []*Listener{
+					{
+						Name:        "foo-53-udp-1",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo53UDPListener1,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict53PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.UDPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+					{
+						Name:        "foo-53-udp-2",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo53UDPListener2,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerProtocolConflict(conflict53PortMsg),
+						SupportedKinds: []v1.RouteGroupKind{
+							{Kind: kinds.UDPRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+						},
+					},
+				},
+			},
+			name: "multiple udp listeners on the same port conflicting",
+		},
 		{
 			gateway: createGateway(
 				gatewayCfg{listeners: []v1.Listener{foo443TLSListener, splat443HTTPSListener}},
@@ -964,19 +1352,21 @@ func TestBuildGateway(t *testing.T) {
 				Valid:  true,
 				Listeners: []*Listener{
 					{
-						Name:       "foo-443-tls",
-						Source:     foo443TLSListener,
-						Valid:      false,
-						Attachable: true,
-						Routes:     map[RouteKey]*L7Route{},
-						L4Routes:   map[L4RouteKey]*L4Route{},
-						Conditions: staticConds.NewListenerHostnameConflict(conflict443HostnameMsg),
+						Name:        "foo-443-tls",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo443TLSListener,
+						Valid:       false,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
+						Conditions:  staticConds.NewListenerHostnameConflict(conflict443HostnameMsg),
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 						},
 					},
 					{
 						Name:           "splat-443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         splat443HTTPSListener,
 						Valid:          false,
 						Attachable:     true,
@@ -1000,18 +1390,20 @@ func TestBuildGateway(t *testing.T) {
 				Valid:  true,
 				Listeners: []*Listener{
 					{
-						Name:       "foo-443-tls",
-						Source:     foo443TLSListener,
-						Valid:      true,
-						Attachable: true,
-						Routes:     map[RouteKey]*L7Route{},
-						L4Routes:   map[L4RouteKey]*L4Route{},
+						Name:        "foo-443-tls",
+						GatewayName: client.ObjectKeyFromObject(getLastCreatedGateway()),
+						Source:      foo443TLSListener,
+						Valid:       true,
+						Attachable:  true,
+						Routes:      map[RouteKey]*L7Route{},
+						L4Routes:    map[L4RouteKey]*L4Route{},
 						SupportedKinds: []v1.RouteGroupKind{
 							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[v1.Group](v1.GroupName)},
 						},
 					},
 					{
 						Name:           "bar-443-https",
+						GatewayName:    client.ObjectKeyFromObject(getLastCreatedGateway()),
 						Source:         bar443HTTPSListener,
 						Valid:          true,
 						Attachable:     true,
@@ -1041,3 +1433,149 @@ func TestBuildGateway(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveListenerConflictsBetweenGateways(t *testing.T) {
+	t.Parallel()
+
+	gw1 := types.NamespacedName{Namespace: "test", Name: "gateway-1"}
+	gw2 := types.NamespacedName{Namespace: "test", Name: "gateway-2"}
+
+	createListener := func(
+		gwName types.NamespacedName,
+		name string,
+		protocol v1.ProtocolType,
+		port v1.PortNumber,
+		hostname string,
+	) *Listener {
+		l := &Listener{
+			Name:        name,
+			GatewayName: gwName,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Valid:      true,
+			Attachable: true,
+		}
+
+		if hostname != "" {
+			l.Source.Hostname = helpers.GetPointer(v1.Hostname(hostname))
+		}
+
+		return l
+	}
+
+	protocolConflictMsg := `Listener "listener" of Gateway test/gateway-1 uses the same port 80 with an incompatible ` +
+		"protocol; ensure only one protocol per port across Gateways"
+	hostnameConflictMsg := `Listener "listener" of Gateway test/gateway-1 uses the same port 80 with an overlapping ` +
+		"hostname; ensure listeners for the same port across Gateways specify distinct hostnames"
+
+	tests := []struct {
+		listener1     *Listener
+		listener2     *Listener
+		name          string
+		expConditions []conditions.Condition
+	}{
+		{
+			name:      "http listeners with different hostnames",
+			listener1: createListener(gw1, "listener", v1.HTTPProtocolType, 80, "foo.example.com"),
+			listener2: createListener(gw2, "listener", v1.HTTPProtocolType, 80, "bar.example.com"),
+		},
+		{
+			name:      "http listeners on different ports",
+			listener1: createListener(gw1, "listener", v1.HTTPProtocolType, 80, ""),
+			listener2: createListener(gw2, "listener", v1.HTTPProtocolType, 8080, ""),
+		},
+		{
+			name:      "tcp and udp listeners",
+			listener1: createListener(gw1, "listener", v1.TCPProtocolType, 80, ""),
+			listener2: createListener(gw2, "listener", v1.UDPProtocolType, 80, ""),
+		},
+		{
+			name:      "https and tls listeners with non overlapping hostnames",
+			listener1: createListener(gw1, "listener", v1.HTTPSProtocolType, 80, "foo.example.com"),
+			listener2: createListener(gw2, "listener", v1.TLSProtocolType, 80, "bar.example.com"),
+		},
+		{
+			name:          "http listeners with the same hostname",
+			listener1:     createListener(gw1, "listener", v1.HTTPProtocolType, 80, "foo.example.com"),
+			listener2:     createListener(gw2, "listener", v1.HTTPProtocolType, 80, "foo.example.com"),
+			expConditions: staticConds.NewListenerHostnameConflict(hostnameConflictMsg),
+		},
+		{
+			name:          "http listeners without hostnames",
+			listener1:     createListener(gw1, "listener", v1.HTTPProtocolType, 80, ""),
+			listener2:     createListener(gw2, "listener", v1.HTTPProtocolType, 80, ""),
+			expConditions: staticConds.NewListenerHostnameConflict(hostnameConflictMsg),
+		},
+		{
+			name:          "https and tls listeners with overlapping hostnames",
+			listener1:     createListener(gw1, "listener", v1.HTTPSProtocolType, 80, "*.example.com"),
+			listener2:     createListener(gw2, "listener", v1.TLSProtocolType, 80, "foo.example.com"),
+			expConditions: staticConds.NewListenerHostnameConflict(hostnameConflictMsg),
+		},
+		{
+			name:          "http and https listeners",
+			listener1:     createListener(gw1, "listener", v1.HTTPProtocolType, 80, "foo.example.com"),
+			listener2:     createListener(gw2, "listener", v1.HTTPSProtocolType, 80, "bar.example.com"),
+			expConditions: staticConds.NewListenerProtocolConflict(protocolConflictMsg),
+		},
+		{
+			name:          "http and tcp listeners",
+			listener1:     createListener(gw1, "listener", v1.HTTPProtocolType, 80, ""),
+			listener2:     createListener(gw2, "listener", v1.TCPProtocolType, 80, ""),
+			expConditions: staticConds.NewListenerProtocolConflict(protocolConflictMsg),
+		},
+		{
+			name:          "tcp listeners",
+			listener1:     createListener(gw1, "listener", v1.TCPProtocolType, 80, ""),
+			listener2:     createListener(gw2, "listener", v1.TCPProtocolType, 80, ""),
+			expConditions: staticConds.NewListenerProtocolConflict(protocolConflictMsg),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolveListenerConflictsBetweenGateways([]*Gateway{
+				{Listeners: []*Listener{test.listener1}},
+				{Listeners: []*Listener{test.listener2}},
+			})
+
+			// the listener of the Gateway with the higher priority is never invalidated
+			g.Expect(test.listener1.Valid).To(BeTrue())
+			g.Expect(test.listener1.Conditions).To(BeEmpty())
+
+			g.Expect(test.listener2.Valid).To(Equal(test.expConditions == nil))
+			g.Expect(test.listener2.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestResolveListenerConflictsBetweenGateways_InvalidListener(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	invalid := &Listener{
+		Name:   "invalid",
+		Source: v1.Listener{Protocol: v1.HTTPProtocolType, Port: 80},
+		Valid:  false,
+	}
+	valid := &Listener{
+		Name:   "valid",
+		Source: v1.Listener{Protocol: v1.HTTPProtocolType, Port: 80},
+		Valid:  true,
+	}
+
+	resolveListenerConflictsBetweenGateways([]*Gateway{
+		{Listeners: []*Listener{invalid}},
+		{Listeners: []*Listener{valid}},
+	})
+
+	// an invalid listener doesn't take the port and hostname from listeners of other Gateways
+	g.Expect(valid.Valid).To(BeTrue())
+	g.Expect(valid.Conditions).To(BeEmpty())
+}
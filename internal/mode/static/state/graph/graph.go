@@ -2,13 +2,15 @@ package graph
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 
 	v1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
@@ -19,6 +21,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
 
@@ -28,33 +31,40 @@ type ClusterState struct {
 	Gateways           map[types.NamespacedName]*gatewayv1.Gateway
 	HTTPRoutes         map[types.NamespacedName]*gatewayv1.HTTPRoute
 	TLSRoutes          map[types.NamespacedName]*v1alpha2.TLSRoute
+	TCPRoutes          map[types.NamespacedName]*v1alpha2.TCPRoute
+	UDPRoutes          map[types.NamespacedName]*v1alpha2.UDPRoute
 	Services           map[types.NamespacedName]*v1.Service
 	Namespaces         map[types.NamespacedName]*v1.Namespace
 	ReferenceGrants    map[types.NamespacedName]*v1beta1.ReferenceGrant
 	Secrets            map[types.NamespacedName]*v1.Secret
 	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
 	BackendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
+	BackendLBPolicies  map[types.NamespacedName]*v1alpha2.BackendLBPolicy
 	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
 	NginxProxies       map[types.NamespacedName]*ngfAPI.NginxProxy
 	GRPCRoutes         map[types.NamespacedName]*gatewayv1.GRPCRoute
 	NGFPolicies        map[PolicyKey]policies.Policy
 	SnippetsFilters    map[types.NamespacedName]*ngfAPI.SnippetsFilter
+	RouteTests         map[types.NamespacedName]*ngfAPI.RouteTest
+	HTTPBodyTransforms map[types.NamespacedName]*ngfAPI.HTTPBodyTransform
+	DirectResponses    map[types.NamespacedName]*ngfAPI.DirectResponse
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
 type Graph struct {
 	// GatewayClass holds the GatewayClass resource.
 	GatewayClass *GatewayClass
-	// Gateway holds the winning Gateway resource.
+	// Gateway holds the winning Gateway resource. Its settings, like the attached policies, apply to
+	// the whole NGINX configuration.
 	Gateway *Gateway
+	// MergedGateways holds the rest of the Gateway resources that belong to the NGINX Gateway Fabric (based on the
+	// GatewayClassName field of the resource). Their listeners are merged with the listeners of the winning Gateway
+	// into the same NGINX configuration.
+	MergedGateways map[types.NamespacedName]*Gateway
 	// IgnoredGatewayClasses holds the ignored GatewayClass resources, which reference NGINX Gateway Fabric in the
 	// controllerName, but are not configured via the NGINX Gateway Fabric CLI argument. It doesn't hold the GatewayClass
 	// resources that do not belong to the NGINX Gateway Fabric.
 	IgnoredGatewayClasses map[types.NamespacedName]*gatewayv1.GatewayClass
-	// IgnoredGateways holds the ignored Gateway resources, which belong to the NGINX Gateway Fabric (based on the
-	// GatewayClassName field of the resource) but ignored. It doesn't hold the Gateway resources that do not belong to
-	// the NGINX Gateway Fabric.
-	IgnoredGateways map[types.NamespacedName]*gatewayv1.Gateway
 	// Routes hold Route resources.
 	Routes map[RouteKey]*L7Route
 	// L4Routes hold L4Route resources.
@@ -70,10 +80,22 @@ type Graph struct {
 	ReferencedServices map[types.NamespacedName]*ReferencedService
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// ReferencedStaticContentConfigMaps includes ConfigMaps that have been referenced by any StaticContentPolicies,
+	// including the ConfigMaps that do not exist in the cluster.
+	ReferencedStaticContentConfigMaps map[types.NamespacedName]*v1.ConfigMap
+	// ReferencedArtifactSecrets includes the pull and verification Secrets referenced by the OCI artifacts
+	// of SnippetsFilters, including the Secrets that do not exist in the cluster.
+	ReferencedArtifactSecrets map[types.NamespacedName]*v1.Secret
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
-	// NginxProxy holds the NginxProxy config for the GatewayClass.
+	// BackendLBPolicies holds BackendLBPolicy resources.
+	BackendLBPolicies map[types.NamespacedName]*BackendLBPolicy
+	// NginxProxy holds the NginxProxy config for the GatewayClass, merged with the NginxProxy config referenced by
+	// the Gateway.
 	NginxProxy *NginxProxy
+	// ReferencedNginxProxies holds the NginxProxies referenced by the GatewayClass and the Gateways, and their bases,
+	// including the bases that do not exist in the cluster.
+	ReferencedNginxProxies map[types.NamespacedName]*NginxProxy
 	// NGFPolicies holds all NGF Policies.
 	NGFPolicies map[PolicyKey]*Policy
 	// GlobalSettings contains global settings from the current state of the graph that may be
@@ -81,8 +103,19 @@ type Graph struct {
 	GlobalSettings *policies.GlobalSettings
 	// SnippetsFilters holds all the SnippetsFilters.
 	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
+	// RouteTests holds all the RouteTests with the results of their tests.
+	RouteTests map[types.NamespacedName]*RouteTest
+	// HTTPBodyTransforms holds all the HTTPBodyTransforms.
+	HTTPBodyTransforms map[types.NamespacedName]*HTTPBodyTransform
+	// DirectResponses holds all the DirectResponses.
+	DirectResponses map[types.NamespacedName]*DirectResponse
 	// PlusSecrets holds the secrets related to NGINX Plus licensing.
 	PlusSecrets map[types.NamespacedName][]PlusSecretFile
+	// ShadowedRouteMatches is the number of Route matches that are shadowed by identical matches with
+	// a higher precedence.
+	ShadowedRouteMatches int
+	// CertificateMismatches is the number of the Listeners with certificates that don't cover their hostnames.
+	CertificateMismatches int
 }
 
 // ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of each port.
@@ -92,13 +125,26 @@ type ProtectedPorts map[int32]string
 func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.NamespacedName) bool {
 	switch obj := resourceType.(type) {
 	case *v1.Secret:
-		// Check if secret is a Gateway-referenced Secret, or if it's a Secret used for NGINX Plus reporting.
+		// Check if secret is a Gateway-referenced Secret, a Secret used for NGINX Plus reporting,
+		// or a pull Secret of an OCI artifact.
 		_, exists := g.ReferencedSecrets[nsname]
 		_, plusSecretExists := g.PlusSecrets[nsname]
-		return exists || plusSecretExists
+		_, artifactSecretExists := g.ReferencedArtifactSecrets[nsname]
+		return exists || plusSecretExists || artifactSecretExists
 	case *v1.ConfigMap:
 		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, staticContentExists := g.ReferencedStaticContentConfigMaps[nsname]
+		if exists || staticContentExists || isTemplateOverridesConfigMapReferenced(nsname, g.NginxProxy) {
+			return true
+		}
+
+		for _, gw := range allGateways(g.Gateway, g.MergedGateways) {
+			if isTemplateOverridesConfigMapReferenced(nsname, gw.NginxProxy) {
+				return true
+			}
+		}
+
+		return false
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -113,7 +159,7 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		// `exists` does not cover the case highlighted above by `existed` and vice versa so both are needed.
 
 		_, existed := g.ReferencedNamespaces[nsname]
-		exists := isNamespaceReferenced(obj, g.Gateway)
+		exists := isNamespaceReferenced(obj, allGateways(g.Gateway, g.MergedGateways))
 		return existed || exists
 	// Service reference exists if at least one HTTPRoute references it.
 	case *v1.Service:
@@ -126,9 +172,12 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		// Service Namespace should be the same Namespace as the EndpointSlice
 		_, exists := g.ReferencedServices[types.NamespacedName{Namespace: nsname.Namespace, Name: svcName}]
 		return exists
-	// NginxProxy reference exists if it is linked to a GatewayClass.
+	// NginxProxy reference exists if it is linked to a GatewayClass or a Gateway, or it is a base of a linked
+	// NginxProxy.
 	case *ngfAPI.NginxProxy:
-		return isNginxProxyReferenced(nsname, g.GatewayClass)
+		return isNginxProxyReferenced(nsname, g.GatewayClass) ||
+			isNginxProxyReferencedByGateways(nsname, allGateways(g.Gateway, g.MergedGateways)) ||
+			isNginxProxyReferencedAsBase(nsname, g.ReferencedNginxProxies)
 	default:
 		return false
 	}
@@ -177,28 +226,59 @@ func (g *Graph) gatewayAPIResourceExist(ref v1alpha2.LocalPolicyTargetReference,
 
 	switch kind := ref.Kind; kind {
 	case kinds.Gateway:
-		if g.Gateway == nil {
-			return false
-		}
-
-		return gatewayExists(refNsName, g.Gateway.Source, g.IgnoredGateways)
+		_, exists := allGateways(g.Gateway, g.MergedGateways)[refNsName]
+		return exists
 	case kinds.HTTPRoute, kinds.GRPCRoute:
 		_, exists := g.Routes[routeKeyForKind(kind, refNsName)]
 		return exists
+	case kinds.TLSRoute, kinds.TCPRoute:
+		_, exists := g.L4Routes[l4RouteKeyForKind(kind, refNsName)]
+		return exists
 
 	default:
 		return false
 	}
 }
 
+// GatewayListeners returns the listeners of the winning Gateway followed by the listeners of the merged Gateways,
+// ordered by the priority of their Gateways.
+func (g *Graph) GatewayListeners() []*Listener {
+	if g.Gateway == nil {
+		return nil
+	}
+
+	mergedGws := make([]*Gateway, 0, len(g.MergedGateways))
+	for _, gw := range g.MergedGateways {
+		mergedGws = append(mergedGws, gw)
+	}
+
+	sort.Slice(mergedGws, func(i, j int) bool {
+		return ngfsort.LessClientObject(mergedGws[i].Source, mergedGws[j].Source)
+	})
+
+	listeners := slices.Clone(g.Gateway.Listeners)
+	for _, gw := range mergedGws {
+		listeners = append(listeners, gw.Listeners...)
+	}
+
+	return listeners
+}
+
 // BuildGraph builds a Graph from a state.
+// If the gatewaySelector is not nil, the Graph only includes the Gateways that match it and, as a result, only
+// the Routes and the Policies attached to those Gateways.
 func BuildGraph(
 	state ClusterState,
 	controllerName string,
 	gcName string,
+	gatewaySelector labels.Selector,
 	plusSecrets map[types.NamespacedName][]PlusSecretFile,
 	validators validation.Validators,
 	protectedPorts ProtectedPorts,
+	quicSupported bool,
+	allowExternalNameServices bool,
+	allowTemplateOverrides bool,
+	artifactFetcher ArtifactFetcher,
 ) *Graph {
 	var globalSettings *policies.GlobalSettings
 
@@ -208,34 +288,80 @@ func BuildGraph(
 		return &Graph{}
 	}
 
-	npCfg := buildNginxProxy(state.NginxProxies, processedGwClasses.Winner, validators.GenericValidator)
-	gc := buildGatewayClass(processedGwClasses.Winner, npCfg, state.CRDMetadata)
-	if gc != nil && npCfg != nil && npCfg.Source != nil {
-		spec := npCfg.Source.Spec
-		globalSettings = &policies.GlobalSettings{
-			NginxProxyValid:  npCfg.Valid,
-			TelemetryEnabled: spec.Telemetry != nil && spec.Telemetry.Exporter != nil,
-		}
-	}
+	gcNpCfg := buildNginxProxy(
+		state.NginxProxies,
+		processedGwClasses.Winner,
+		state.ConfigMaps,
+		validators.GenericValidator,
+		allowTemplateOverrides,
+	)
+	gc := buildGatewayClass(processedGwClasses.Winner, gcNpCfg, state.CRDMetadata)
 
 	secretResolver := newSecretResolver(state.Secrets)
 	configMapResolver := newConfigMapResolver(state.ConfigMaps)
 
-	processedGws := processGateways(state.Gateways, gcName)
+	processedGws := processGateways(state.Gateways, gcName, gatewaySelector)
 
 	refGrantResolver := newReferenceGrantResolver(state.ReferenceGrants)
 
-	gw := buildGateway(processedGws.Winner, secretResolver, gc, refGrantResolver, protectedPorts)
+	gw, mergedGws := buildGateways(processedGws, secretResolver, gc, refGrantResolver, protectedPorts)
+	gws := allGateways(gw, mergedGws)
+
+	buildGatewayNginxProxies(
+		gw,
+		mergedGws,
+		state.NginxProxies,
+		state.ConfigMaps,
+		validators.GenericValidator,
+		allowTemplateOverrides,
+	)
+
+	referencedNps := buildReferencedNginxProxies(
+		gcNpCfg,
+		gws,
+		state.NginxProxies,
+		state.ConfigMaps,
+		validators.GenericValidator,
+		allowTemplateOverrides,
+	)
+
+	npCfg := buildEffectiveNginxProxy(gcNpCfg, gw)
+	buildMergedGatewayEffectiveNginxProxies(npCfg, gw, mergedGws)
+	validateHTTP3Listeners(gws, npCfg, quicSupported)
+	validateStatusPortListeners(gws, npCfg)
+	validateOCSPStaplingListeners(gws, npCfg)
+
+	if gc != nil && npCfg != nil && npCfg.Source != nil {
+		spec := npCfg.Source.Spec
+		globalSettings = &policies.GlobalSettings{
+			NginxProxyValid:  npCfg.Valid,
+			TelemetryEnabled: spec.Telemetry != nil && spec.Telemetry.Exporter != nil,
+		}
+
+		if spec.TLS != nil {
+			globalSettings.TLSProtocols = spec.TLS.Protocols
+		}
+	}
 
 	processedBackendTLSPolicies := processBackendTLSPolicies(
 		state.BackendTLSPolicies,
 		configMapResolver,
 		secretResolver,
+		refGrantResolver,
 		controllerName,
 		gw,
 	)
 
-	processedSnippetsFilters := processSnippetsFilters(state.SnippetsFilters)
+	processedBackendLBPolicies := processBackendLBPolicies(state.BackendLBPolicies, controllerName, gw)
+
+	processedSnippetsFilters, referencedArtifactSecrets := processSnippetsFilters(
+		state.SnippetsFilters,
+		state.Secrets,
+		artifactFetcher,
+	)
+
+	processedBodyTransforms := processHTTPBodyTransforms(state.HTTPBodyTransforms, validators.GenericValidator)
+	processedDirectResponses := processDirectResponses(state.DirectResponses)
 
 	routes := buildRoutesForGateways(
 		validators.HTTPFieldsValidator,
@@ -244,52 +370,79 @@ func BuildGraph(
 		processedGws.GetAllNsNames(),
 		npCfg,
 		processedSnippetsFilters,
+		processedBodyTransforms,
+		processedDirectResponses,
 	)
 
 	l4routes := buildL4RoutesForGateways(
 		state.TLSRoutes,
+		state.TCPRoutes,
+		state.UDPRoutes,
 		processedGws.GetAllNsNames(),
 		state.Services,
 		npCfg,
 		refGrantResolver,
 	)
 
-	bindRoutesToListeners(routes, l4routes, gw, state.Namespaces)
-	addBackendRefsToRouteRules(routes, refGrantResolver, state.Services, processedBackendTLSPolicies, npCfg)
+	bindRoutesToListeners(routes, l4routes, gws, state.Namespaces)
+	addBackendRefsToRouteRules(
+		routes,
+		refGrantResolver,
+		state.Services,
+		processedBackendTLSPolicies,
+		npCfg,
+		allowExternalNameServices,
+	)
+	addBackendLBPoliciesToRouteRules(routes, processedBackendLBPolicies)
+	shadowedRouteMatches := detectShadowedRouteMatches(gws)
+	processedRouteTests := processRouteTests(state.RouteTests, gws)
 
-	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gw)
+	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gws)
 
-	referencedServices := buildReferencedServices(routes, l4routes, gw)
+	referencedServices := buildReferencedServices(routes, l4routes, gws)
 
 	// policies must be processed last because they rely on the state of the other resources in the graph
 	processedPolicies := processPolicies(
 		state.NGFPolicies,
 		validators.PolicyValidator,
+		validators.PolicyDefaulter,
 		processedGws,
 		routes,
+		l4routes,
 		referencedServices,
 		globalSettings,
 	)
 
+	referencedStaticContentConfigMaps := resolveStaticContentConfigMaps(processedPolicies, state.ConfigMaps)
+
 	setPlusSecretContent(state.Secrets, plusSecrets)
 
 	g := &Graph{
-		GatewayClass:               gc,
-		Gateway:                    gw,
-		Routes:                     routes,
-		L4Routes:                   l4routes,
-		IgnoredGatewayClasses:      processedGwClasses.Ignored,
-		IgnoredGateways:            processedGws.Ignored,
-		ReferencedSecrets:          secretResolver.getResolvedSecrets(),
-		ReferencedNamespaces:       referencedNamespaces,
-		ReferencedServices:         referencedServices,
-		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
-		BackendTLSPolicies:         processedBackendTLSPolicies,
-		NginxProxy:                 npCfg,
-		NGFPolicies:                processedPolicies,
-		GlobalSettings:             globalSettings,
-		SnippetsFilters:            processedSnippetsFilters,
-		PlusSecrets:                plusSecrets,
+		GatewayClass:                      gc,
+		Gateway:                           gw,
+		MergedGateways:                    mergedGws,
+		Routes:                            routes,
+		L4Routes:                          l4routes,
+		IgnoredGatewayClasses:             processedGwClasses.Ignored,
+		ReferencedSecrets:                 secretResolver.getResolvedSecrets(),
+		ReferencedNamespaces:              referencedNamespaces,
+		ReferencedServices:                referencedServices,
+		ReferencedCaCertConfigMaps:        configMapResolver.getResolvedConfigMaps(),
+		ReferencedStaticContentConfigMaps: referencedStaticContentConfigMaps,
+		ReferencedArtifactSecrets:         referencedArtifactSecrets,
+		BackendTLSPolicies:                processedBackendTLSPolicies,
+		BackendLBPolicies:                 processedBackendLBPolicies,
+		NginxProxy:                        npCfg,
+		ReferencedNginxProxies:            referencedNps,
+		NGFPolicies:                       processedPolicies,
+		GlobalSettings:                    globalSettings,
+		SnippetsFilters:                   processedSnippetsFilters,
+		RouteTests:                        processedRouteTests,
+		HTTPBodyTransforms:                processedBodyTransforms,
+		DirectResponses:                   processedDirectResponses,
+		PlusSecrets:                       plusSecrets,
+		ShadowedRouteMatches:              shadowedRouteMatches,
+		CertificateMismatches:             countCertificateMismatches(gws),
 	}
 
 	g.attachPolicies(controllerName)
@@ -297,24 +450,6 @@ func BuildGraph(
 	return g
 }
 
-func gatewayExists(
-	gwNsName types.NamespacedName,
-	winner *gatewayv1.Gateway,
-	ignored map[types.NamespacedName]*gatewayv1.Gateway,
-) bool {
-	if winner == nil {
-		return false
-	}
-
-	if client.ObjectKeyFromObject(winner) == gwNsName {
-		return true
-	}
-
-	_, exists := ignored[gwNsName]
-
-	return exists
-}
-
 // SecretFileType describes the type of Secret file used for NGINX Plus.
 type SecretFileType int
 
@@ -552,6 +552,15 @@ func TestBuildGraph(t *testing.T) {
 		},
 	}
 
+	processedProxy := &NginxProxy{
+		Source: proxy.DeepCopy(),
+		EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+			{Field: "telemetry", Source: "nginx-proxy"},
+		},
+		Valid: true,
+	}
+	processedProxy.Source.Spec.IPFamily = helpers.GetPointer(ngfAPI.Dual)
+
 	// NGF Policies
 	//
 	// We have to use real policies here instead of a mocks because the Diff function we use in the test fails when
@@ -831,10 +840,11 @@ func TestBuildGraph(t *testing.T) {
 				Source: gw1,
 				Listeners: []*Listener{
 					{
-						Name:       "listener-80-1",
-						Source:     gw1.Spec.Listeners[0],
-						Valid:      true,
-						Attachable: true,
+						Name:        "listener-80-1",
+						GatewayName: client.ObjectKeyFromObject(gw1),
+						Source:      gw1.Spec.Listeners[0],
+						Valid:       true,
+						Attachable:  true,
 						Routes: map[RouteKey]*L7Route{
 							CreateRouteKey(hr1): routeHR1,
 							CreateRouteKey(gr):  routeGR,
@@ -845,6 +855,7 @@ func TestBuildGraph(t *testing.T) {
 					},
 					{
 						Name:           "listener-443-1",
+						GatewayName:    client.ObjectKeyFromObject(gw1),
 						Source:         gw1.Spec.Listeners[1],
 						Valid:          true,
 						Attachable:     true,
@@ -854,23 +865,25 @@ func TestBuildGraph(t *testing.T) {
 						SupportedKinds: supportedKindsForListeners,
 					},
 					{
-						Name:       "listener-443-2",
-						Source:     gw1.Spec.Listeners[2],
-						Valid:      true,
-						Attachable: true,
-						L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
-						Routes:     map[RouteKey]*L7Route{},
+						Name:        "listener-443-2",
+						GatewayName: client.ObjectKeyFromObject(gw1),
+						Source:      gw1.Spec.Listeners[2],
+						Valid:       true,
+						Attachable:  true,
+						L4Routes:    map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
+						Routes:      map[RouteKey]*L7Route{},
 						SupportedKinds: []gatewayv1.RouteGroupKind{
 							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
 						},
 					},
 					{
-						Name:       "listener-8443",
-						Source:     gw1.Spec.Listeners[3],
-						Valid:      true,
-						Attachable: true,
-						L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
-						Routes:     map[RouteKey]*L7Route{},
+						Name:        "listener-8443",
+						GatewayName: client.ObjectKeyFromObject(gw1),
+						Source:      gw1.Spec.Listeners[3],
+						Valid:       true,
+						Attachable:  true,
+						L4Routes:    map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
+						Routes:      map[RouteKey]*L7Route{},
 						SupportedKinds: []gatewayv1.RouteGroupKind{
 							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
 						},
@@ -879,8 +892,79 @@ func TestBuildGraph(t *testing.T) {
 				Valid:    true,
 				Policies: []*Policy{processedGwPolicy},
 			},
-			IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-				{Namespace: testNs, Name: "gateway-2"}: gw2,
+			MergedGateways: map[types.NamespacedName]*Gateway{
+				client.ObjectKeyFromObject(gw2): {
+					Source: gw2,
+					Listeners: []*Listener{
+						{
+							Name:        "listener-80-1",
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[0],
+							Valid:       false,
+							Attachable:  true,
+							Conditions: staticConds.NewListenerHostnameConflict(
+								`Listener "listener-80-1" of Gateway test/gateway-1 uses the same port 80 with an ` +
+									"overlapping hostname; ensure listeners for the same port across Gateways specify " +
+									"distinct hostnames",
+							),
+							Routes:                    map[RouteKey]*L7Route{},
+							L4Routes:                  map[L4RouteKey]*L4Route{},
+							SupportedKinds:            supportedKindsForListeners,
+							AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"app": "allowed"}),
+						},
+						{
+							Name:        "listener-443-1",
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[1],
+							Valid:       false,
+							Attachable:  true,
+							Conditions: staticConds.NewListenerHostnameConflict(
+								`Listener "listener-443-1" of Gateway test/gateway-1 uses the same port 443 with an ` +
+									"overlapping hostname; ensure listeners for the same port across Gateways specify " +
+									"distinct hostnames",
+							),
+							Routes:         map[RouteKey]*L7Route{},
+							L4Routes:       map[L4RouteKey]*L4Route{},
+							ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secret)),
+							SupportedKinds: supportedKindsForListeners,
+						},
+						{
+							Name:        "listener-443-2",
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[2],
+							Valid:       false,
+							Attachable:  true,
+							Conditions: staticConds.NewListenerHostnameConflict(
+								`Listener "listener-443-2" of Gateway test/gateway-1 uses the same port 443 with an ` +
+									"overlapping hostname; ensure listeners for the same port across Gateways specify " +
+									"distinct hostnames",
+							),
+							Routes:   map[RouteKey]*L7Route{},
+							L4Routes: map[L4RouteKey]*L4Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
+						},
+						{
+							Name:        "listener-8443",
+							GatewayName: client.ObjectKeyFromObject(gw2),
+							Source:      gw2.Spec.Listeners[3],
+							Valid:       false,
+							Attachable:  true,
+							Conditions: staticConds.NewListenerHostnameConflict(
+								`Listener "listener-8443" of Gateway test/gateway-1 uses the same port 8443 with an ` +
+									"overlapping hostname; ensure listeners for the same port across Gateways specify " +
+									"distinct hostnames",
+							),
+							Routes:   map[RouteKey]*L7Route{},
+							L4Routes: map[L4RouteKey]*L4Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
+						},
+					},
+					Valid: true,
+				},
 			},
 			Routes: map[RouteKey]*L7Route{
 				CreateRouteKey(hr1): routeHR1,
@@ -918,9 +1002,9 @@ func TestBuildGraph(t *testing.T) {
 			BackendTLSPolicies: map[types.NamespacedName]*BackendTLSPolicy{
 				client.ObjectKeyFromObject(btp.Source): &btp,
 			},
-			NginxProxy: &NginxProxy{
-				Source: proxy,
-				Valid:  true,
+			NginxProxy: processedProxy,
+			ReferencedNginxProxies: map[types.NamespacedName]*NginxProxy{
+				client.ObjectKeyFromObject(proxy): processedProxy,
 			},
 			NGFPolicies: map[PolicyKey]*Policy{
 				hrPolicyKey: processedRoutePolicy,
@@ -998,6 +1082,7 @@ func TestBuildGraph(t *testing.T) {
 				test.store,
 				controllerName,
 				gcName,
+				nil,
 				map[types.NamespacedName][]PlusSecretFile{
 					client.ObjectKeyFromObject(plusSecret): {
 						{
@@ -1012,6 +1097,10 @@ func TestBuildGraph(t *testing.T) {
 					PolicyValidator:     fakePolicyValidator,
 				},
 				protectedPorts,
+				false,
+				false,
+				false,
+				nil,
 			)
 
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
@@ -1096,6 +1185,21 @@ func TestIsReferenced(t *testing.T) {
 	emptyEndpointSlice := &discoveryV1.EndpointSlice{}
 
 	gw := &Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Infrastructure: &gatewayv1.GatewayInfrastructure{
+					ParametersRef: &gatewayv1.LocalParametersReference{
+						Group: ngfAPI.GroupName,
+						Kind:  kinds.NginxProxy,
+						Name:  "nginx-proxy-in-gw",
+					},
+				},
+			},
+		},
 		Listeners: []*Listener{
 			{
 				Name:                      "listener-1",
@@ -1134,6 +1238,20 @@ func TestIsReferenced(t *testing.T) {
 		},
 	}
 
+	templateOverridesConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "templates",
+		},
+	}
+
+	staticContentConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "static-content",
+		},
+	}
+
 	gcWithNginxProxy := &GatewayClass{
 		Source: &gatewayv1.GatewayClass{
 			Spec: gatewayv1.GatewayClassSpec{
@@ -1158,6 +1276,24 @@ func TestIsReferenced(t *testing.T) {
 		},
 	}
 
+	npInGateway := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy-in-gw",
+		},
+	}
+
+	npBase := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy-base",
+		},
+	}
+
+	npMissingBase := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx-proxy-missing-base",
+		},
+	}
+
 	graph := &Graph{
 		Gateway: gw,
 		ReferencedSecrets: map[types.NamespacedName]*Secret{
@@ -1179,6 +1315,28 @@ func TestIsReferenced(t *testing.T) {
 				}),
 			},
 		},
+		ReferencedStaticContentConfigMaps: map[types.NamespacedName]*v1.ConfigMap{
+			client.ObjectKeyFromObject(staticContentConfigMap): nil,
+		},
+		NginxProxy: &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TemplateOverrides: &ngfAPI.TemplateOverrides{
+						ConfigMapRef: ngfAPI.ConfigMapReference{
+							Namespace: testNs,
+							Name:      "templates",
+						},
+					},
+				},
+			},
+		},
+		ReferencedNginxProxies: map[types.NamespacedName]*NginxProxy{
+			{Name: "nginx-proxy-in-gc"}: {
+				Bases: []string{"nginx-proxy-base", "nginx-proxy-missing-base"},
+			},
+			{Name: "nginx-proxy-base"}:         {},
+			{Name: "nginx-proxy-missing-base"}: nil,
+		},
 	}
 
 	tests := []struct {
@@ -1228,6 +1386,16 @@ func TestIsReferenced(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name:     "OCI artifact pull Secret",
+			resource: plusSecret,
+			graph: &Graph{
+				ReferencedArtifactSecrets: map[types.NamespacedName]*v1.Secret{
+					client.ObjectKeyFromObject(plusSecret): nil,
+				},
+			},
+			expected: true,
+		},
 		{
 			name:     "Secret not in ReferencedSecrets with same Namespace and different Name is not referenced",
 			resource: sameNamespaceDifferentNameSecret,
@@ -1306,6 +1474,18 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "ConfigMap referenced by NginxProxy for template overrides is referenced",
+			resource: templateOverridesConfigMap,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "ConfigMap referenced by StaticContentPolicy is referenced",
+			resource: staticContentConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 
 		// NginxProxy tests
 		{
@@ -1322,6 +1502,27 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "NginxProxy is referenced in Gateway",
+			resource: npInGateway,
+			gc:       gcWithNginxProxy,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "NginxProxy is a base of a referenced NginxProxy",
+			resource: npBase,
+			gc:       gcWithNginxProxy,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "NginxProxy is a missing base of a referenced NginxProxy",
+			resource: npMissingBase,
+			gc:       gcWithNginxProxy,
+			graph:    graph,
+			expected: true,
+		},
 
 		// Edge cases
 		{
@@ -1349,6 +1550,8 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 
 	hrKey := RouteKey{RouteType: RouteTypeHTTP, NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"}}
 	grKey := RouteKey{RouteType: RouteTypeGRPC, NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr"}}
+	tlsKey := L4RouteKey{RouteType: RouteTypeTLS, NamespacedName: types.NamespacedName{Namespace: "test", Name: "tls"}}
+	tcpKey := L4RouteKey{RouteType: RouteTypeTCP, NamespacedName: types.NamespacedName{Namespace: "test", Name: "tcp"}}
 
 	getGraph := func() *Graph {
 		return &Graph{
@@ -1360,13 +1563,17 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 					},
 				},
 			},
-			IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-				{Namespace: "test", Name: "ignored"}: {},
+			MergedGateways: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "merged"}: {},
 			},
 			Routes: map[RouteKey]*L7Route{
 				hrKey: {},
 				grKey: {},
 			},
+			L4Routes: map[L4RouteKey]*L4Route{
+				tlsKey: {},
+				tcpKey: {},
+			},
 			NGFPolicies: map[PolicyKey]*Policy{
 				{GVK: policyGVK, NsName: existingPolicyNsName}: {
 					Source: &policiesfakes.FakePolicy{},
@@ -1422,10 +1629,10 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 			expRelevant: true,
 		},
 		{
-			name:        "relevant; policy references an ignored gateway",
+			name:        "relevant; policy references a merged gateway",
 			graph:       getGraph(),
-			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "ignored")),
-			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-ignored"},
+			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "merged")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-merged"},
 			expRelevant: true,
 		},
 		{
@@ -1442,6 +1649,27 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-gr"},
 			expRelevant: true,
 		},
+		{
+			name:        "relevant; policy references a tlsroute in the graph",
+			graph:       getGraph(),
+			policy:      getPolicy(createTestRef(kinds.TLSRoute, gatewayv1.GroupName, "tls")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-tls"},
+			expRelevant: true,
+		},
+		{
+			name:        "relevant; policy references a tcproute in the graph",
+			graph:       getGraph(),
+			policy:      getPolicy(createTestRef(kinds.TCPRoute, gatewayv1.GroupName, "tcp")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-tcp"},
+			expRelevant: true,
+		},
+		{
+			name:        "irrelevant; policy references a tlsroute that is not in the graph",
+			graph:       getGraph(),
+			policy:      getPolicy(createTestRef(kinds.TLSRoute, gatewayv1.GroupName, "diff")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-tls-diff"},
+			expRelevant: false,
+		},
 		{
 			name:        "irrelevant; policy does not reference a relevant gw or route in the graph",
 			graph:       getGraph(),
@@ -1549,3 +1777,73 @@ func TestIsNGFPolicyRelevantPanics(t *testing.T) {
 
 	g.Expect(isRelevant).To(Panic())
 }
+
+func TestGatewayListeners(t *testing.T) {
+	t.Parallel()
+
+	createGateway := func(name string, creationTime metav1.Time, listenerNames ...string) *Gateway {
+		listeners := make([]*Listener, 0, len(listenerNames))
+		for _, l := range listenerNames {
+			listeners = append(listeners, &Listener{Name: l})
+		}
+
+		return &Gateway{
+			Source: &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "test",
+					Name:              name,
+					CreationTimestamp: creationTime,
+				},
+			},
+			Listeners: listeners,
+		}
+	}
+
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(1))
+
+	gw1 := createGateway("gateway-1", now, "listener-1", "listener-2")
+	gw2 := createGateway("gateway-2", now, "listener-3")
+	gw3 := createGateway("gateway-3", later, "listener-4")
+
+	tests := []struct {
+		graph            *Graph
+		name             string
+		expListenerNames []string
+	}{
+		{
+			name:  "no gateway",
+			graph: &Graph{},
+		},
+		{
+			name:             "no merged gateways",
+			graph:            &Graph{Gateway: gw1},
+			expListenerNames: []string{"listener-1", "listener-2"},
+		},
+		{
+			name: "merged gateways",
+			graph: &Graph{
+				Gateway: gw1,
+				MergedGateways: map[types.NamespacedName]*Gateway{
+					client.ObjectKeyFromObject(gw3.Source): gw3,
+					client.ObjectKeyFromObject(gw2.Source): gw2,
+				},
+			},
+			expListenerNames: []string{"listener-1", "listener-2", "listener-3", "listener-4"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var listenerNames []string
+			for _, l := range test.graph.GatewayListeners() {
+				listenerNames = append(listenerNames, l.Name)
+			}
+
+			g.Expect(listenerNames).To(Equal(test.expListenerNames))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -16,6 +18,7 @@ func buildGRPCRoute(
 	ghr *v1.GRPCRoute,
 	gatewayNsNames []types.NamespacedName,
 	http2disabled bool,
+	regexPathMatchDisabled bool,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
 ) *L7Route {
 	r := &L7Route{
@@ -59,6 +62,7 @@ func buildGRPCRoute(
 	rules, valid, conds := processGRPCRouteRules(
 		ghr.Spec.Rules,
 		validator,
+		regexPathMatchDisabled,
 		getSnippetsFilterResolverForNamespace(snippetsFilters, r.Source.GetNamespace()),
 	)
 
@@ -73,6 +77,7 @@ func processGRPCRouteRule(
 	specRule v1.GRPCRouteRule,
 	rulePath *field.Path,
 	validator validation.HTTPFieldsValidator,
+	regexPathMatchDisabled bool,
 	resolveExtRefFunc resolveExtRefFilter,
 ) (RouteRule, routeRuleErrors) {
 	var errors routeRuleErrors
@@ -82,7 +87,7 @@ func processGRPCRouteRule(
 	for j, match := range specRule.Matches {
 		matchPath := rulePath.Child("matches").Index(j)
 
-		matchesErrs := validateGRPCMatch(validator, match, matchPath)
+		matchesErrs := validateGRPCMatch(validator, match, matchPath, regexPathMatchDisabled)
 		if len(matchesErrs) > 0 {
 			validMatches = false
 			errors.invalid = append(errors.invalid, matchesErrs...)
@@ -118,6 +123,7 @@ func processGRPCRouteRule(
 
 	return RouteRule{
 		ValidMatches:     validMatches,
+		Dropped:          !validMatches || len(filterErrors.invalid) > 0,
 		Matches:          ConvertGRPCMatches(specRule.Matches),
 		Filters:          routeFilters,
 		RouteBackendRefs: backendRefs,
@@ -127,49 +133,24 @@ func processGRPCRouteRule(
 func processGRPCRouteRules(
 	specRules []v1.GRPCRouteRule,
 	validator validation.HTTPFieldsValidator,
+	regexPathMatchDisabled bool,
 	resolveExtRefFunc resolveExtRefFilter,
 ) (rules []RouteRule, valid bool, conds []conditions.Condition) {
 	rules = make([]RouteRule, len(specRules))
 
-	var (
-		allRulesErrors  routeRuleErrors
-		atLeastOneValid bool
-	)
+	var allRulesErrors routeRuleErrors
 
 	for i, rule := range specRules {
 		rulePath := field.NewPath("spec").Child("rules").Index(i)
 
-		rr, errors := processGRPCRouteRule(rule, rulePath, validator, resolveExtRefFunc)
-
-		if rr.ValidMatches && rr.Filters.Valid {
-			atLeastOneValid = true
-		}
+		rr, errors := processGRPCRouteRule(rule, rulePath, validator, regexPathMatchDisabled, resolveExtRefFunc)
 
 		allRulesErrors = allRulesErrors.append(errors)
 
 		rules[i] = rr
 	}
 
-	conds = make([]conditions.Condition, 0, 2)
-	valid = true
-
-	if len(allRulesErrors.invalid) > 0 {
-		msg := allRulesErrors.invalid.ToAggregate().Error()
-
-		if atLeastOneValid {
-			conds = append(conds, staticConds.NewRoutePartiallyInvalid(msg))
-		} else {
-			msg = "All rules are invalid: " + msg
-			conds = append(conds, staticConds.NewRouteUnsupportedValue(msg))
-			valid = false
-		}
-	}
-
-	// resolve errors do not invalidate routes
-	if len(allRulesErrors.resolve) > 0 {
-		msg := allRulesErrors.resolve.ToAggregate().Error()
-		conds = append(conds, staticConds.NewRouteResolvedRefsInvalidFilter(msg))
-	}
+	valid, conds = buildRouteRulesConditions(rules, allRulesErrors)
 
 	return rules, valid, conds
 }
@@ -204,9 +185,12 @@ func ConvertGRPCMatches(grpcMatches []v1.GRPCRouteMatch) []v1.HTTPRouteMatch {
 		}
 		hm.Headers = hmHeaders
 
-		if gm.Method != nil && gm.Method.Service != nil && gm.Method.Method != nil {
-			// if method match is provided, service and method are required
-			// as the only method type supported is exact.
+		switch {
+		case isGRPCMethodRegexMatch(gm.Method):
+			pathValue = convertGRPCMethodRegex(gm.Method)
+			pathType = v1.PathMatchRegularExpression
+		case gm.Method != nil && gm.Method.Service != nil && gm.Method.Method != nil:
+			// if an Exact method match is provided, service and method are required.
 			// Validation has already been done at this point, and the condition will
 			// have been added there if required.
 			pathValue = "/" + *gm.Method.Service + "/" + *gm.Method.Method
@@ -222,6 +206,25 @@ func ConvertGRPCMatches(grpcMatches []v1.GRPCRouteMatch) []v1.HTTPRouteMatch {
 	return hms
 }
 
+func isGRPCMethodRegexMatch(method *v1.GRPCMethodMatch) bool {
+	return method != nil && method.Type != nil && *method.Type == v1.GRPCMethodMatchRegularExpression
+}
+
+// convertGRPCMethodRegex converts a RegularExpression method match to the regular expression of the request path
+// "/<service>/<method>". The expressions of the service and the method match their whole path segment, and
+// an empty service or method matches any service or method.
+func convertGRPCMethodRegex(method *v1.GRPCMethodMatch) string {
+	segmentRegex := func(regex *string) string {
+		if regex == nil || *regex == "" {
+			return "[^/]+"
+		}
+
+		return "(?:" + *regex + ")"
+	}
+
+	return "/" + segmentRegex(method.Service) + "/" + segmentRegex(method.Method)
+}
+
 func convertGRPCHeaderMatchType(matchType *v1.GRPCHeaderMatchType) *v1.HeaderMatchType {
 	if matchType == nil {
 		return nil
@@ -240,11 +243,12 @@ func validateGRPCMatch(
 	validator validation.HTTPFieldsValidator,
 	match v1.GRPCRouteMatch,
 	matchPath *field.Path,
+	regexPathMatchDisabled bool,
 ) field.ErrorList {
 	var allErrs field.ErrorList
 
 	methodPath := matchPath.Child("method")
-	allErrs = append(allErrs, validateGRPCMethodMatch(validator, match.Method, methodPath)...)
+	allErrs = append(allErrs, validateGRPCMethodMatch(validator, match.Method, methodPath, regexPathMatchDisabled)...)
 
 	for j, h := range match.Headers {
 		headerPath := matchPath.Child("headers").Index(j)
@@ -258,39 +262,79 @@ func validateGRPCMethodMatch(
 	validator validation.HTTPFieldsValidator,
 	method *v1.GRPCMethodMatch,
 	methodPath *field.Path,
+	regexPathMatchDisabled bool,
 ) field.ErrorList {
+	if method == nil {
+		return nil
+	}
+
+	supportedTypes := []string{string(v1.GRPCMethodMatchExact)}
+	// RegularExpression matches the request path against a regular expression, which can be disabled
+	// in the NginxProxy, like the RegularExpression path match type of HTTPRoutes.
+	if !regexPathMatchDisabled {
+		supportedTypes = append(supportedTypes, string(v1.GRPCMethodMatchRegularExpression))
+	}
+
 	var allErrs field.ErrorList
 
-	if method != nil {
-		methodServicePath := methodPath.Child("service")
-		methodMethodPath := methodPath.Child("method")
-		if method.Type == nil {
-			allErrs = append(allErrs, field.Required(methodPath.Child("type"), "cannot be empty"))
-		} else if *method.Type != v1.GRPCMethodMatchExact {
-			allErrs = append(
-				allErrs,
-				field.NotSupported(methodPath.Child("type"), *method.Type, []string{string(v1.GRPCMethodMatchExact)}),
-			)
+	if method.Type == nil {
+		allErrs = append(allErrs, field.Required(methodPath.Child("type"), "cannot be empty"))
+	} else if !slices.Contains(supportedTypes, string(*method.Type)) {
+		allErrs = append(allErrs, field.NotSupported(methodPath.Child("type"), *method.Type, supportedTypes))
+	} else if *method.Type == v1.GRPCMethodMatchRegularExpression {
+		return validateGRPCMethodRegexMatch(validator, method, methodPath)
+	}
+
+	methodServicePath := methodPath.Child("service")
+	methodMethodPath := methodPath.Child("method")
+
+	if method.Service == nil || *method.Service == "" {
+		allErrs = append(allErrs, field.Required(methodServicePath, "service is required"))
+	} else {
+		pathValue := "/" + *method.Service
+		if err := validator.ValidatePathInMatch(pathValue); err != nil {
+			valErr := field.Invalid(methodServicePath, *method.Service, err.Error())
+			allErrs = append(allErrs, valErr)
 		}
-		if method.Service == nil || *method.Service == "" {
-			allErrs = append(allErrs, field.Required(methodServicePath, "service is required"))
-		} else {
-			pathValue := "/" + *method.Service
-			if err := validator.ValidatePathInMatch(pathValue); err != nil {
-				valErr := field.Invalid(methodServicePath, *method.Service, err.Error())
-				allErrs = append(allErrs, valErr)
-			}
+	}
+	if method.Method == nil || *method.Method == "" {
+		allErrs = append(allErrs, field.Required(methodMethodPath, "method is required"))
+	} else {
+		pathValue := "/" + *method.Method
+		if err := validator.ValidatePathInMatch(pathValue); err != nil {
+			valErr := field.Invalid(methodMethodPath, *method.Method, err.Error())
+			allErrs = append(allErrs, valErr)
 		}
-		if method.Method == nil || *method.Method == "" {
-			allErrs = append(allErrs, field.Required(methodMethodPath, "method is required"))
-		} else {
-			pathValue := "/" + *method.Method
-			if err := validator.ValidatePathInMatch(pathValue); err != nil {
-				valErr := field.Invalid(methodMethodPath, *method.Method, err.Error())
-				allErrs = append(allErrs, valErr)
-			}
+	}
+	return allErrs
+}
+
+// validateGRPCMethodRegexMatch validates a RegularExpression method match. Either the service or the method
+// can be empty, which matches any service or method, but not both.
+func validateGRPCMethodRegexMatch(
+	validator validation.HTTPFieldsValidator,
+	method *v1.GRPCMethodMatch,
+	methodPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	validateRegex := func(regex *string, regexPath *field.Path) {
+		if regex == nil || *regex == "" {
+			return
 		}
+
+		if err := validator.ValidatePathRegexInMatch(*regex); err != nil {
+			allErrs = append(allErrs, field.Invalid(regexPath, *regex, err.Error()))
+		}
+	}
+
+	if (method.Service == nil || *method.Service == "") && (method.Method == nil || *method.Method == "") {
+		allErrs = append(allErrs, field.Required(methodPath, "service or method is required"))
 	}
+
+	validateRegex(method.Service, methodPath.Child("service"))
+	validateRegex(method.Method, methodPath.Child("method"))
+
 	return allErrs
 }
 
@@ -224,6 +224,8 @@ func TestBuildGRPCRoutes(t *testing.T) {
 				test.gwNsNames,
 				npCfg,
 				snippetsFilters,
+				nil,
+				nil,
 			)
 			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
 		})
@@ -285,6 +287,13 @@ func TestBuildGRPCRoute(t *testing.T) {
 	grInvalidHostname := createGRPCRoute("gr-1", gatewayNsName.Name, "", []v1.GRPCRouteRule{methodMatchRule})
 	grNotNGF := createGRPCRoute("gr", "some-gateway", "example.com", []v1.GRPCRouteRule{methodMatchRule})
 
+	grRegexMethodMatch := createGRPCRoute(
+		"gr-1",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1.GRPCRouteRule{createGRPCMethodMatch("helloworld\\.(Greeter|Farewell)", "", "RegularExpression")},
+	)
+
 	grInvalidMatchesEmptyMethodFields := createGRPCRoute(
 		"gr-1",
 		gatewayNsName.Name,
@@ -491,11 +500,12 @@ func TestBuildGRPCRoute(t *testing.T) {
 	}
 
 	tests := []struct {
-		validator     *validationfakes.FakeHTTPFieldsValidator
-		gr            *v1.GRPCRoute
-		expected      *L7Route
-		name          string
-		http2disabled bool
+		validator              *validationfakes.FakeHTTPFieldsValidator
+		gr                     *v1.GRPCRoute
+		expected               *L7Route
+		name                   string
+		http2disabled          bool
+		regexPathMatchDisabled bool
 	}{
 		{
 			validator: createAllValidValidator(),
@@ -629,7 +639,8 @@ func TestBuildGRPCRoute(t *testing.T) {
 				Conditions: []conditions.Condition{
 					staticConds.NewRouteUnsupportedValue(
 						`All rules are invalid: ` +
-							`[spec.rules[0].matches[0].method.type: Unsupported value: "": supported values: "Exact",` +
+							`[spec.rules[0].matches[0].method.type: Unsupported value: "": supported values: "Exact", ` +
+							`"RegularExpression",` +
 							` spec.rules[0].matches[0].method.service: Required value: service is required,` +
 							` spec.rules[0].matches[0].method.method: Required value: method is required]`,
 					),
@@ -639,6 +650,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -651,6 +663,122 @@ func TestBuildGRPCRoute(t *testing.T) {
 			},
 			name: "invalid matches with empty method fields",
 		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grRegexMethodMatch,
+			expected: &L7Route{
+				RouteType:  RouteTypeGRPC,
+				Source:     grRegexMethodMatch,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grRegexMethodMatch.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grRegexMethodMatch.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Filters: RouteRuleFilters{
+								Valid:   true,
+								Filters: []Filter{},
+							},
+							Matches:          ConvertGRPCMatches(grRegexMethodMatch.Spec.Rules[0].Matches),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "valid regular expression method match",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidatePathRegexInMatchReturns(errors.New("invalid regex"))
+				return validator
+			}(),
+			gr: grRegexMethodMatch,
+			expected: &L7Route{
+				RouteType:  RouteTypeGRPC,
+				Source:     grRegexMethodMatch,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grRegexMethodMatch.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: spec.rules[0].matches[0].method.service: ` +
+							`Invalid value: "helloworld\\.(Greeter|Farewell)": invalid regex`,
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grRegexMethodMatch.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: false,
+							Dropped:      true,
+							Filters: RouteRuleFilters{
+								Valid:   true,
+								Filters: []Filter{},
+							},
+							Matches:          ConvertGRPCMatches(grRegexMethodMatch.Spec.Rules[0].Matches),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "invalid regular expression method match",
+		},
+		{
+			validator:              createAllValidValidator(),
+			gr:                     grRegexMethodMatch,
+			regexPathMatchDisabled: true,
+			expected: &L7Route{
+				RouteType:  RouteTypeGRPC,
+				Source:     grRegexMethodMatch,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grRegexMethodMatch.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: [spec.rules[0].matches[0].method.type: ` +
+							`Unsupported value: "RegularExpression": supported values: "Exact", ` +
+							`spec.rules[0].matches[0].method.method: Required value: method is required]`,
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grRegexMethodMatch.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: false,
+							Dropped:      true,
+							Filters: RouteRuleFilters{
+								Valid:   true,
+								Filters: []Filter{},
+							},
+							Matches:          ConvertGRPCMatches(grRegexMethodMatch.Spec.Rules[0].Matches),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "regular expression method match is disabled",
+		},
 		{
 			validator: func() *validationfakes.FakeHTTPFieldsValidator {
 				validator := createAllValidValidator()
@@ -682,6 +810,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -742,7 +871,8 @@ func TestBuildGRPCRoute(t *testing.T) {
 				},
 				Conditions: []conditions.Condition{
 					staticConds.NewRoutePartiallyInvalid(
-						`spec.rules[1].matches[0].headers[0].type: Unsupported value: "": supported values: "Exact", "RegularExpression"`,
+						`1. Invalid fields: spec.rules[1].matches[0].headers[0].type: Unsupported value: "": ` +
+							`supported values: "Exact", "RegularExpression"`,
 					),
 				},
 				Spec: L7RouteSpec{
@@ -759,6 +889,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 						},
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -797,6 +928,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -835,6 +967,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -872,6 +1005,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -911,6 +1045,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   false,
 								Filters: convertGRPCRouteFilters(grInvalidFilter.Spec.Rules[0].Filters),
@@ -978,6 +1113,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   false,
 								Filters: convertGRPCRouteFilters(grInvalidSnippetsFilter.Spec.Rules[0].Filters),
@@ -1060,6 +1196,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   false,
 								Filters: convertGRPCRouteFilters(grInvalidAndUnresolvableSnippetsFilter.Spec.Rules[0].Filters),
@@ -1084,7 +1221,14 @@ func TestBuildGRPCRoute(t *testing.T) {
 			snippetsFilters := map[types.NamespacedName]*SnippetsFilter{
 				{Namespace: "test", Name: "sf"}: {Valid: true},
 			}
-			route := buildGRPCRoute(test.validator, test.gr, gatewayNsNames, test.http2disabled, snippetsFilters)
+			route := buildGRPCRoute(
+				test.validator,
+				test.gr,
+				gatewayNsNames,
+				test.http2disabled,
+				test.regexPathMatchDisabled,
+				snippetsFilters,
+			)
 			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
 		})
 	}
@@ -1098,6 +1242,9 @@ func TestConvertGRPCMatches(t *testing.T) {
 
 	headerMatchRegularExp := createGRPCHeadersMatch("RegularExpression", "HeaderRegex", "headers-[a-z]+").Matches
 
+	regexMethodMatch := createGRPCMethodMatch("helloworld\\.Greeter", "Say.*", "RegularExpression").Matches
+	regexServiceMatch := createGRPCMethodMatch("helloworld\\.Greeter", "", "RegularExpression").Matches
+
 	expectedHTTPMatches := []v1.HTTPRouteMatch{
 		{
 			Path: &v1.HTTPPathMatch{
@@ -1140,6 +1287,26 @@ func TestConvertGRPCMatches(t *testing.T) {
 		},
 	}
 
+	expectedRegexMethodMatches := []v1.HTTPRouteMatch{
+		{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(v1.PathMatchRegularExpression),
+				Value: helpers.GetPointer("/(?:helloworld\\.Greeter)/(?:Say.*)"),
+			},
+			Headers: []v1.HTTPHeaderMatch{},
+		},
+	}
+
+	expectedRegexServiceMatches := []v1.HTTPRouteMatch{
+		{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(v1.PathMatchRegularExpression),
+				Value: helpers.GetPointer("/(?:helloworld\\.Greeter)/[^/]+"),
+			},
+			Headers: []v1.HTTPHeaderMatch{},
+		},
+	}
+
 	expectedEmptyMatches := []v1.HTTPRouteMatch{
 		{
 			Path: &v1.HTTPPathMatch{
@@ -1159,6 +1326,16 @@ func TestConvertGRPCMatches(t *testing.T) {
 			methodMatches: methodMatch,
 			expected:      expectedHTTPMatches,
 		},
+		{
+			name:          "regular expression match",
+			methodMatches: regexMethodMatch,
+			expected:      expectedRegexMethodMatches,
+		},
+		{
+			name:          "regular expression match of any method",
+			methodMatches: regexServiceMatch,
+			expected:      expectedRegexServiceMatches,
+		},
 		{
 			name:          "headers matches exact",
 			methodMatches: headersMatch,
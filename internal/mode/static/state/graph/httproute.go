@@ -1,14 +1,20 @@
 package graph
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -25,7 +31,10 @@ func buildHTTPRoute(
 	validator validation.HTTPFieldsValidator,
 	ghr *v1.HTTPRoute,
 	gatewayNsNames []types.NamespacedName,
+	regexPathMatchDisabled bool,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	bodyTransforms map[types.NamespacedName]*HTTPBodyTransform,
+	directResponses map[types.NamespacedName]*DirectResponse,
 ) *L7Route {
 	r := &L7Route{
 		Source:    ghr,
@@ -54,13 +63,40 @@ func buildHTTPRoute(
 		return r
 	}
 
+	pathMatchOpts, errs := buildPathMatchOptions(ghr.Annotations)
+	if len(errs) > 0 {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(errs.ToAggregate().Error()))
+
+		return r
+	}
+
+	annotationSettings, errs, deprecationMsg := buildAnnotationSettings(kinds.HTTPRoute, ghr.Annotations)
+	if len(errs) > 0 {
+		r.Valid = false
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(errs.ToAggregate().Error()))
+
+		return r
+	}
+
+	if deprecationMsg != "" {
+		r.Conditions = append(r.Conditions, staticConds.NewRouteAnnotationsDeprecated(deprecationMsg))
+	}
+
 	r.Spec.Hostnames = ghr.Spec.Hostnames
+	r.Spec.PathMatchOptions = pathMatchOpts
+	r.Spec.Streaming = annotationSettings.Streaming
 	r.Attachable = true
 
 	rules, valid, conds := processHTTPRouteRules(
-		ghr.Spec.Rules,
+		applyAnnotationSettings(ghr.Spec.Rules, annotationSettings),
 		validator,
-		getSnippetsFilterResolverForNamespace(snippetsFilters, r.Source.GetNamespace()),
+		regexPathMatchDisabled,
+		chainExtRefFilterResolvers(
+			getSnippetsFilterResolverForNamespace(snippetsFilters, r.Source.GetNamespace()),
+			getHTTPBodyTransformResolverForNamespace(bodyTransforms, r.Source.GetNamespace()),
+			getDirectResponseResolverForNamespace(directResponses, r.Source.GetNamespace()),
+		),
 	)
 
 	r.Spec.Rules = rules
@@ -74,6 +110,7 @@ func processHTTPRouteRule(
 	specRule v1.HTTPRouteRule,
 	rulePath *field.Path,
 	validator validation.HTTPFieldsValidator,
+	regexPathMatchDisabled bool,
 	resolveExtRefFunc resolveExtRefFilter,
 ) (RouteRule, routeRuleErrors) {
 	var errors routeRuleErrors
@@ -83,7 +120,7 @@ func processHTTPRouteRule(
 	for j, match := range specRule.Matches {
 		matchPath := rulePath.Child("matches").Index(j)
 
-		matchesErrs := validateMatch(validator, match, matchPath)
+		matchesErrs := validateMatch(validator, match, matchPath, regexPathMatchDisabled)
 		if len(matchesErrs) > 0 {
 			validMatches = false
 			errors.invalid = append(errors.invalid, matchesErrs...)
@@ -98,6 +135,13 @@ func processHTTPRouteRule(
 	)
 
 	errors = errors.append(filterErrors)
+	validFilters := len(filterErrors.invalid) == 0
+
+	if refErrs := validateRewriteCaptureRefs(specRule, rulePath); len(refErrs) > 0 {
+		routeFilters.Valid = false
+		validFilters = false
+		errors.invalid = append(errors.invalid, refErrs...)
+	}
 
 	backendRefs := make([]RouteBackendRef, 0, len(specRule.BackendRefs))
 
@@ -117,74 +161,169 @@ func processHTTPRouteRule(
 		backendRefs = append(backendRefs, rbr)
 	}
 
+	var timeouts *v1.HTTPRouteTimeouts
+	if specRule.Timeouts != nil {
+		// Invalid timeouts don't invalidate the rule; the NGINX default timeouts are used instead.
+		timeoutsErrs := validateTimeouts(*specRule.Timeouts, rulePath.Child("timeouts"))
+		if len(timeoutsErrs) > 0 {
+			errors.ignored = append(errors.ignored, timeoutsErrs...)
+		} else {
+			timeouts = specRule.Timeouts
+		}
+	}
+
+	var retry *v1.HTTPRouteRetry
+	if specRule.Retry != nil {
+		// Invalid retry doesn't invalidate the rule; the NGINX default retry behavior is used instead.
+		retryErrs := validateRetry(*specRule.Retry, rulePath.Child("retry"))
+		if len(retryErrs) > 0 {
+			errors.ignored = append(errors.ignored, retryErrs...)
+		} else {
+			retry = specRule.Retry
+		}
+	}
+
 	return RouteRule{
 		ValidMatches:     validMatches,
+		Dropped:          !validMatches || !validFilters,
 		Matches:          specRule.Matches,
 		Filters:          routeFilters,
 		RouteBackendRefs: backendRefs,
+		Timeouts:         timeouts,
+		Retry:            retry,
 	}, errors
 }
 
 func processHTTPRouteRules(
 	specRules []v1.HTTPRouteRule,
 	validator validation.HTTPFieldsValidator,
+	regexPathMatchDisabled bool,
 	resolveExtRefFunc resolveExtRefFilter,
 ) (rules []RouteRule, valid bool, conds []conditions.Condition) {
 	rules = make([]RouteRule, len(specRules))
 
-	var (
-		allRulesErrors  routeRuleErrors
-		atLeastOneValid bool
-	)
+	var allRulesErrors routeRuleErrors
 
 	for i, rule := range specRules {
 		rulePath := field.NewPath("spec").Child("rules").Index(i)
 
-		rr, errors := processHTTPRouteRule(rule, rulePath, validator, resolveExtRefFunc)
-
-		if rr.ValidMatches && rr.Filters.Valid {
-			atLeastOneValid = true
-		}
+		rr, errors := processHTTPRouteRule(rule, rulePath, validator, regexPathMatchDisabled, resolveExtRefFunc)
 
 		allRulesErrors = allRulesErrors.append(errors)
 
 		rules[i] = rr
 	}
 
-	conds = make([]conditions.Condition, 0, 2)
+	valid, conds = buildRouteRulesConditions(rules, allRulesErrors)
 
-	valid = true
+	return rules, valid, conds
+}
 
-	if len(allRulesErrors.invalid) > 0 {
-		msg := allRulesErrors.invalid.ToAggregate().Error()
+// durationFmt is the format of a Gateway API Duration, as defined by GEP-2257.
+const durationFmt = `^([0-9]{1,5}(h|m|s|ms)){1,4}$`
 
-		if atLeastOneValid {
-			conds = append(conds, staticConds.NewRoutePartiallyInvalid(msg))
-		} else {
-			msg = "All rules are invalid: " + msg
-			conds = append(conds, staticConds.NewRouteUnsupportedValue(msg))
-			valid = false
+var durationFmtRegexp = regexp.MustCompile(durationFmt)
+
+func validateTimeouts(timeouts v1.HTTPRouteTimeouts, timeoutsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	request, err := parseDuration(timeouts.Request)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(timeoutsPath.Child("request"), *timeouts.Request, err.Error()))
+	}
+
+	backendRequest, err := parseDuration(timeouts.BackendRequest)
+	if err != nil {
+		valErr := field.Invalid(timeoutsPath.Child("backendRequest"), *timeouts.BackendRequest, err.Error())
+		allErrs = append(allErrs, valErr)
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// A request timeout of zero disables the timeout, so any backendRequest timeout is shorter.
+	if request != 0 && backendRequest > request {
+		valErr := field.Invalid(
+			timeoutsPath.Child("backendRequest"),
+			*timeouts.BackendRequest,
+			"backendRequest timeout cannot be longer than request timeout",
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+// supportedRetryCodes are the HTTP response status codes for which NGINX can retry a request.
+// See the http_xxx parameters of https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream.
+var supportedRetryCodes = map[v1.HTTPRouteRetryStatusCode]struct{}{
+	403: {},
+	404: {},
+	429: {},
+	500: {},
+	502: {},
+	503: {},
+	504: {},
+}
+
+func validateRetry(retry v1.HTTPRouteRetry, retryPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, code := range retry.Codes {
+		if _, ok := supportedRetryCodes[code]; !ok {
+			valErr := field.NotSupported(
+				retryPath.Child("codes").Index(i),
+				code,
+				[]string{"403", "404", "429", "500", "502", "503", "504"},
+			)
+			allErrs = append(allErrs, valErr)
 		}
 	}
 
-	// resolve errors do not invalidate routes
-	if len(allRulesErrors.resolve) > 0 {
-		msg := allRulesErrors.resolve.ToAggregate().Error()
-		conds = append(conds, staticConds.NewRouteResolvedRefsInvalidFilter(msg))
+	if retry.Attempts != nil && *retry.Attempts < 0 {
+		allErrs = append(allErrs, field.Invalid(retryPath.Child("attempts"), *retry.Attempts, "must be non-negative"))
 	}
 
-	return rules, valid, conds
+	backoff, err := parseDuration(retry.Backoff)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(retryPath.Child("backoff"), *retry.Backoff, err.Error()))
+	} else if backoff != 0 {
+		// NGINX passes a failed request to the next backend immediately.
+		valErr := field.Invalid(
+			retryPath.Child("backoff"),
+			*retry.Backoff,
+			"backoff between retry attempts is not supported; only a zero backoff is allowed",
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+// parseDuration parses a Gateway API Duration. A nil duration is parsed as zero.
+func parseDuration(duration *v1.Duration) (time.Duration, error) {
+	if duration == nil {
+		return 0, nil
+	}
+
+	if !durationFmtRegexp.MatchString(string(*duration)) {
+		return 0, fmt.Errorf("must match the format %s", durationFmt)
+	}
+
+	return time.ParseDuration(string(*duration))
 }
 
 func validateMatch(
 	validator validation.HTTPFieldsValidator,
 	match v1.HTTPRouteMatch,
 	matchPath *field.Path,
+	regexPathMatchDisabled bool,
 ) field.ErrorList {
 	var allErrs field.ErrorList
 
 	pathPath := matchPath.Child("path")
-	allErrs = append(allErrs, validatePathMatch(validator, match.Path, pathPath)...)
+	allErrs = append(allErrs, validatePathMatch(validator, match.Path, pathPath, regexPathMatchDisabled)...)
 
 	for j, h := range match.Headers {
 		headerPath := matchPath.Child("headers").Index(j)
@@ -246,7 +385,12 @@ func validateQueryParamMatch(
 		allErrs = append(allErrs, valErr)
 	}
 
-	if err := validator.ValidateQueryParamValueInMatch(q.Value); err != nil {
+	validateValue := validator.ValidateQueryParamValueInMatch
+	if q.Type != nil && *q.Type == v1.QueryParamMatchRegularExpression {
+		validateValue = validator.ValidateQueryParamRegexInMatch
+	}
+
+	if err := validateValue(q.Value); err != nil {
 		valErr := field.Invalid(queryParamPath.Child("value"), q.Value, err.Error())
 		allErrs = append(allErrs, valErr)
 	}
@@ -258,6 +402,7 @@ func validatePathMatch(
 	validator validation.HTTPFieldsValidator,
 	path *v1.HTTPPathMatch,
 	fieldPath *field.Path,
+	regexPathMatchDisabled bool,
 ) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -280,16 +425,23 @@ func validatePathMatch(
 		return field.ErrorList{field.Invalid(fieldPath.Child("value"), *path.Value, msg)}
 	}
 
-	if *path.Type != v1.PathMatchPathPrefix && *path.Type != v1.PathMatchExact {
-		valErr := field.NotSupported(
-			fieldPath.Child("type"),
-			*path.Type,
-			[]string{string(v1.PathMatchExact), string(v1.PathMatchPathPrefix)},
-		)
+	supportedTypes := []string{string(v1.PathMatchExact), string(v1.PathMatchPathPrefix)}
+	// RegularExpression is an implementation-specific path match type, which can be disabled in the NginxProxy.
+	if !regexPathMatchDisabled {
+		supportedTypes = append(supportedTypes, string(v1.PathMatchRegularExpression))
+	}
+
+	if !slices.Contains(supportedTypes, string(*path.Type)) {
+		valErr := field.NotSupported(fieldPath.Child("type"), *path.Type, supportedTypes)
 		allErrs = append(allErrs, valErr)
 	}
 
-	if err := validator.ValidatePathInMatch(*path.Value); err != nil {
+	validatePath := validator.ValidatePathInMatch
+	if *path.Type == v1.PathMatchRegularExpression {
+		validatePath = validator.ValidatePathRegexInMatch
+	}
+
+	if err := validatePath(*path.Value); err != nil {
 		valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
 		allErrs = append(allErrs, valErr)
 	}
@@ -384,7 +536,8 @@ func validateFilterRewrite(
 		var path string
 		switch rewrite.Path.Type {
 		case v1.FullPathHTTPPathModifier:
-			path = *rewrite.Path.ReplaceFullPath
+			// the capture references are validated against the matches of the rule in validateRewriteCaptureRefs.
+			path = captureRefRegexp.ReplaceAllString(*rewrite.Path.ReplaceFullPath, "")
 		case v1.PrefixMatchHTTPPathModifier:
 			path = *rewrite.Path.ReplacePrefixMatch
 		default:
@@ -401,3 +554,119 @@ func validateFilterRewrite(
 
 	return allErrs
 }
+
+// captureRefRegexp matches the references to the capture groups of a RegularExpression path match
+// in the full path of a URLRewrite filter, for example $1 or ${name}.
+var captureRefRegexp = regexp.MustCompile(`\$(?:[1-9]|\{[A-Za-z_][A-Za-z0-9_]*\})`)
+
+// validateRewriteCaptureRefs validates the capture references in the full path of the URLRewrite filters of the rule.
+// The references are only supported when all matches of the rule are RegularExpression path matches, and every
+// expression must define the referenced capture groups.
+func validateRewriteCaptureRefs(specRule v1.HTTPRouteRule, rulePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, filter := range specRule.Filters {
+		if filter.Type != v1.HTTPRouteFilterURLRewrite || filter.URLRewrite == nil || filter.URLRewrite.Path == nil ||
+			filter.URLRewrite.Path.ReplaceFullPath == nil {
+			continue
+		}
+
+		fullPath := *filter.URLRewrite.Path.ReplaceFullPath
+
+		refs := captureRefRegexp.FindAllString(fullPath, -1)
+		if len(refs) == 0 {
+			continue
+		}
+
+		fullPathPath := rulePath.Child("filters").Index(i).Child("urlRewrite", "path", "replaceFullPath")
+
+		if len(specRule.Matches) == 0 {
+			msg := "capture references require RegularExpression path matches"
+			allErrs = append(allErrs, field.Invalid(fullPathPath, fullPath, msg))
+			continue
+		}
+
+		for _, match := range specRule.Matches {
+			if err := validateCaptureRefsForMatch(refs, match); err != nil {
+				allErrs = append(allErrs, field.Invalid(fullPathPath, fullPath, err.Error()))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateCaptureRefsForMatch validates that the path of the match defines the referenced capture groups.
+func validateCaptureRefsForMatch(refs []string, match v1.HTTPRouteMatch) error {
+	if match.Path == nil || match.Path.Type == nil || *match.Path.Type != v1.PathMatchRegularExpression ||
+		match.Path.Value == nil {
+		return errors.New("capture references require RegularExpression path matches")
+	}
+
+	re, err := regexp.Compile(*match.Path.Value)
+	if err != nil {
+		return nil //nolint:nilerr // the error is reported by validateMatch
+	}
+
+	for _, ref := range refs {
+		name := strings.TrimSuffix(strings.TrimPrefix(ref[1:], "{"), "}")
+
+		defined := slices.Contains(re.SubexpNames(), name)
+		if idx, err := strconv.Atoi(name); err == nil {
+			defined = idx <= re.NumSubexp()
+		}
+
+		if !defined {
+			return fmt.Errorf("%s is not a capture group of the path %q", ref, *match.Path.Value)
+		}
+	}
+
+	return nil
+}
+
+func validateFilterMirror(mirror *v1.HTTPRequestMirrorFilter, filterPath *field.Path) field.ErrorList {
+	mirrorPath := filterPath.Child("requestMirror")
+
+	if mirror == nil {
+		return field.ErrorList{field.Required(mirrorPath, "requestMirror cannot be nil")}
+	}
+
+	// The backendRef is validated when it is resolved, because it requires the Services and ReferenceGrants.
+
+	if mirror.Percent != nil && mirror.Fraction != nil {
+		return field.ErrorList{field.Invalid(mirrorPath, *mirror, "only one of percent or fraction can be set")}
+	}
+
+	var allErrs field.ErrorList
+
+	if mirror.Percent != nil && (*mirror.Percent < 0 || *mirror.Percent > 100) {
+		valErr := field.Invalid(mirrorPath.Child("percent"), *mirror.Percent, "must be in the range [0, 100]")
+		allErrs = append(allErrs, valErr)
+	}
+
+	if mirror.Fraction != nil {
+		fractionPath := mirrorPath.Child("fraction")
+
+		denominator := int32(100)
+		if mirror.Fraction.Denominator != nil {
+			denominator = *mirror.Fraction.Denominator
+		}
+
+		if denominator <= 0 {
+			valErr := field.Invalid(fractionPath.Child("denominator"), denominator, "must be greater than 0")
+			return append(allErrs, valErr)
+		}
+
+		if mirror.Fraction.Numerator < 0 || mirror.Fraction.Numerator > denominator {
+			valErr := field.Invalid(
+				fractionPath.Child("numerator"),
+				mirror.Fraction.Numerator,
+				"must be in the range [0, denominator]",
+			)
+			allErrs = append(allErrs, valErr)
+		}
+	}
+
+	return allErrs
+}
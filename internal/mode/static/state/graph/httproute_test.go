@@ -222,6 +222,8 @@ func TestBuildHTTPRoutes(t *testing.T) {
 				test.gwNsNames,
 				nil,
 				snippetsFilters,
+				nil,
+				nil,
 			)
 			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
 		})
@@ -327,6 +329,25 @@ func TestBuildHTTPRoute(t *testing.T) {
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", invalidSnippetsFilterExtRef)
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", unresolvableSnippetsFilterExtRef)
 
+	// route with the annotations of the escape hatch
+	hrAnnotations := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/")
+	hrAnnotations.Annotations = map[string]string{
+		UseRegexAnnotation:  "true",
+		StreamingAnnotation: "true",
+	}
+	annotationsMatches := []gatewayv1.HTTPRouteMatch{
+		{
+			Path: &gatewayv1.HTTPPathMatch{
+				Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+				Value: helpers.GetPointer("/"),
+			},
+		},
+	}
+
+	// route with an invalid annotation of the escape hatch
+	hrInvalidAnnotations := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/")
+	hrInvalidAnnotations.Annotations = map[string]string{MirrorAnnotation: "mirror-svc"}
+
 	validatorInvalidFieldsInRule := &validationfakes.FakeHTTPFieldsValidator{
 		ValidatePathInMatchStub: func(path string) error {
 			if path == invalidPath {
@@ -389,6 +410,69 @@ func TestBuildHTTPRoute(t *testing.T) {
 			},
 			name: "normal case",
 		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrAnnotations,
+			expected: &L7Route{
+				RouteType: RouteTypeHTTP,
+				Source:    hrAnnotations,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrAnnotations.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteAnnotationsDeprecated(
+						"Some annotations are deprecated and will be removed in a future release: " +
+							"use the RegularExpression type of spec.rules[].matches[].path instead of " +
+							"gateway.nginx.org/use-regex; " +
+							"use spec.buffering.enable of a ClientSettingsPolicy instead of gateway.nginx.org/streaming",
+					),
+				},
+				Valid:      true,
+				Attachable: true,
+				Spec: L7RouteSpec{
+					Hostnames: hrAnnotations.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Filters: RouteRuleFilters{
+								Valid:   true,
+								Filters: []Filter{},
+							},
+							Matches:          annotationsMatches,
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+					Streaming: true,
+				},
+			},
+			name: "escape hatch annotations",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrInvalidAnnotations,
+			expected: &L7Route{
+				RouteType: RouteTypeHTTP,
+				Source:    hrInvalidAnnotations,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidAnnotations.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`metadata.annotations[gateway.nginx.org/mirror]: Invalid value: "mirror-svc": ` +
+							"must be the name and the port of a Service, for example, mirror-svc:8080",
+					),
+				},
+			},
+			name: "invalid escape hatch annotation",
+		},
 		{
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			hr:        hrInvalidMatchesEmptyPathType,
@@ -414,6 +498,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -460,6 +545,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -526,6 +612,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -564,6 +651,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   false,
 								Filters: convertHTTPRouteFilters(hrInvalidFilters.Spec.Rules[0].Filters),
@@ -593,7 +681,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 				},
 				Conditions: []conditions.Condition{
 					staticConds.NewRoutePartiallyInvalid(
-						`spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path`,
+						`0. Invalid fields: spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path`,
 					),
 				},
 				Spec: L7RouteSpec{
@@ -601,6 +689,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -640,7 +729,8 @@ func TestBuildHTTPRoute(t *testing.T) {
 				},
 				Conditions: []conditions.Condition{
 					staticConds.NewRoutePartiallyInvalid(
-						`[spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path, ` +
+						`0, 1. Invalid fields: ` +
+							`[spec.rules[0].matches[0].path.value: Invalid value: "/invalid": invalid path, ` +
 							`spec.rules[1].filters[0].requestRedirect.hostname: Invalid value: ` +
 							`"invalid.example.com": invalid hostname]`,
 					),
@@ -650,6 +740,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: false,
+							Dropped:      true,
 							Filters: RouteRuleFilters{
 								Valid:   true,
 								Filters: []Filter{},
@@ -659,6 +750,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 						},
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Matches:      hrDroppedInvalidMatchesAndInvalidFilters.Spec.Rules[1].Matches,
 							Filters: RouteRuleFilters{
 								Valid: false,
@@ -699,7 +791,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 				},
 				Conditions: []conditions.Condition{
 					staticConds.NewRoutePartiallyInvalid(
-						`spec.rules[1].filters[0].requestRedirect.hostname: Invalid value: ` +
+						`1. Invalid fields: spec.rules[1].filters[0].requestRedirect.hostname: Invalid value: ` +
 							`"invalid.example.com": invalid hostname`,
 					),
 				},
@@ -717,6 +809,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 						},
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Matches:      hrDroppedInvalidFilters.Spec.Rules[1].Matches,
 							Filters: RouteRuleFilters{
 								Filters: convertHTTPRouteFilters(hrDroppedInvalidFilters.Spec.Rules[1].Filters),
@@ -797,6 +890,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Matches:      hrInvalidSnippetsFilter.Spec.Rules[0].Matches,
 							Filters: RouteRuleFilters{
 								Filters: convertHTTPRouteFilters(hrInvalidSnippetsFilter.Spec.Rules[0].Filters),
@@ -879,6 +973,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 					Rules: []RouteRule{
 						{
 							ValidMatches: true,
+							Dropped:      true,
 							Matches:      hrInvalidAndUnresolvableSnippetsFilter.Spec.Rules[0].Matches,
 							Filters: RouteRuleFilters{
 								Filters: convertHTTPRouteFilters(
@@ -906,7 +1001,7 @@ func TestBuildHTTPRoute(t *testing.T) {
 				{Namespace: "test", Name: "sf"}: {Valid: true},
 			}
 
-			route := buildHTTPRoute(test.validator, test.hr, gatewayNsNames, snippetsFilters)
+			route := buildHTTPRoute(test.validator, test.hr, gatewayNsNames, false, snippetsFilters, nil, nil)
 			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
 		})
 	}
@@ -921,10 +1016,11 @@ func TestValidateMatch(t *testing.T) {
 	}
 
 	tests := []struct {
-		match          gatewayv1.HTTPRouteMatch
-		validator      *validationfakes.FakeHTTPFieldsValidator
-		name           string
-		expectErrCount int
+		match                  gatewayv1.HTTPRouteMatch
+		validator              *validationfakes.FakeHTTPFieldsValidator
+		name                   string
+		expectErrCount         int
+		regexPathMatchDisabled bool
 	}{
 		{
 			validator: createAllValidValidator(),
@@ -967,13 +1063,51 @@ func TestValidateMatch(t *testing.T) {
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("Wrong")),
 					Value: helpers.GetPointer("/"),
 				},
 			},
 			expectErrCount: 1,
 			name:           "wrong path type",
 		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/coffee/[0-9]+"),
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid regular expression match",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/coffee/[0-9]+"),
+				},
+			},
+			regexPathMatchDisabled: true,
+			expectErrCount:         1,
+			name:                   "regular expression match is disabled",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidatePathRegexInMatchReturns(errors.New("invalid path regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/coffee/[0-9"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "regular expression is invalid",
+		},
 		{
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
@@ -1128,6 +1262,24 @@ func TestValidateMatch(t *testing.T) {
 			expectErrCount: 1,
 			name:           "query param value is invalid",
 		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidateQueryParamRegexInMatchReturns(errors.New("invalid query param regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				QueryParams: []gatewayv1.HTTPQueryParamMatch{
+					{
+						Type:  helpers.GetPointer(gatewayv1.QueryParamMatchRegularExpression),
+						Name:  "version",
+						Value: "v[0-9]+", // any value is invalid by the validator
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "query param regex is invalid",
+		},
 		{
 			validator: func() *validationfakes.FakeHTTPFieldsValidator {
 				validator := createAllValidValidator()
@@ -1144,7 +1296,7 @@ func TestValidateMatch(t *testing.T) {
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression), // invalid
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("Wrong")), // invalid
 					Value: helpers.GetPointer("/"),
 				},
 				Headers: []gatewayv1.HTTPHeaderMatch{
@@ -1171,7 +1323,7 @@ func TestValidateMatch(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			allErrs := validateMatch(test.validator, test.match, field.NewPath("test"))
+			allErrs := validateMatch(test.validator, test.match, field.NewPath("test"), test.regexPathMatchDisabled)
 			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
 		})
 	}
@@ -1462,3 +1614,336 @@ func TestValidateFilterRewrite(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRewriteCaptureRefs(t *testing.T) {
+	t.Parallel()
+
+	regexMatch := func(regex string) gatewayv1.HTTPRouteMatch {
+		return gatewayv1.HTTPRouteMatch{
+			Path: &gatewayv1.HTTPPathMatch{
+				Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+				Value: helpers.GetPointer(regex),
+			},
+		}
+	}
+
+	prefixMatch := gatewayv1.HTTPRouteMatch{
+		Path: &gatewayv1.HTTPPathMatch{
+			Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+			Value: helpers.GetPointer("/users"),
+		},
+	}
+
+	fullPathRewrite := func(fullPath string) gatewayv1.HTTPRouteFilter {
+		return gatewayv1.HTTPRouteFilter{
+			Type: gatewayv1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:            gatewayv1.FullPathHTTPPathModifier,
+					ReplaceFullPath: helpers.GetPointer(fullPath),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		expErrSubstring string
+		rule            gatewayv1.HTTPRouteRule
+	}{
+		{
+			name: "no capture references",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{prefixMatch},
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles")},
+			},
+		},
+		{
+			name: "numbered and named capture references",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					regexMatch(`/users/(\d+)/(?P<tab>[a-z]+)`),
+					regexMatch(`/members/(\d+)/(?P<tab>[a-z]+)`),
+				},
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles/$1/${tab}")},
+			},
+		},
+		{
+			name: "capture references with a prefix match",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{regexMatch(`/users/(\d+)`), prefixMatch},
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles/$1")},
+			},
+			expErrSubstring: "capture references require RegularExpression path matches",
+		},
+		{
+			name: "capture references without matches",
+			rule: gatewayv1.HTTPRouteRule{
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles/$1")},
+			},
+			expErrSubstring: "capture references require RegularExpression path matches",
+		},
+		{
+			name: "undefined numbered capture group",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{regexMatch(`/users/(\d+)`)},
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles/$2")},
+			},
+			expErrSubstring: "$2 is not a capture group of the path",
+		},
+		{
+			name: "undefined named capture group",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{regexMatch(`/users/(?P<id>\d+)`)},
+				Filters: []gatewayv1.HTTPRouteFilter{fullPathRewrite("/profiles/${host}")},
+			},
+			expErrSubstring: "${host} is not a capture group of the path",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateRewriteCaptureRefs(test.rule, field.NewPath("test"))
+			if test.expErrSubstring == "" {
+				g.Expect(allErrs).To(BeEmpty())
+				return
+			}
+
+			g.Expect(allErrs).To(HaveLen(1))
+			g.Expect(allErrs[0].Error()).To(ContainSubstring(test.expErrSubstring))
+		})
+	}
+}
+
+func TestValidateFilterMirror(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		requestMirror  *gatewayv1.HTTPRequestMirrorFilter
+		name           string
+		expectErrCount int
+	}{
+		{
+			requestMirror:  nil,
+			name:           "nil filter",
+			expectErrCount: 1,
+		},
+		{
+			requestMirror:  &gatewayv1.HTTPRequestMirrorFilter{},
+			name:           "valid mirror filter with no percentage",
+			expectErrCount: 0,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](50),
+			},
+			name:           "valid mirror filter with percent",
+			expectErrCount: 0,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Fraction: &gatewayv1.Fraction{
+					Numerator:   1,
+					Denominator: helpers.GetPointer[int32](3),
+				},
+			},
+			name:           "valid mirror filter with fraction",
+			expectErrCount: 0,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Fraction: &gatewayv1.Fraction{
+					Numerator: 100,
+				},
+			},
+			name:           "valid mirror filter with fraction and default denominator",
+			expectErrCount: 0,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](50),
+				Fraction: &gatewayv1.Fraction{
+					Numerator: 1,
+				},
+			},
+			name:           "mirror filter with both percent and fraction",
+			expectErrCount: 1,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Percent: helpers.GetPointer[int32](101),
+			},
+			name:           "mirror filter with invalid percent",
+			expectErrCount: 1,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Fraction: &gatewayv1.Fraction{
+					Numerator:   1,
+					Denominator: helpers.GetPointer[int32](0),
+				},
+			},
+			name:           "mirror filter with invalid denominator",
+			expectErrCount: 1,
+		},
+		{
+			requestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+				Fraction: &gatewayv1.Fraction{
+					Numerator:   4,
+					Denominator: helpers.GetPointer[int32](3),
+				},
+			},
+			name:           "mirror filter with numerator greater than denominator",
+			expectErrCount: 1,
+		},
+	}
+
+	filterPath := field.NewPath("test")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			allErrs := validateFilterMirror(test.requestMirror, filterPath)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateTimeouts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		timeouts       gatewayv1.HTTPRouteTimeouts
+		name           string
+		expectErrCount int
+	}{
+		{
+			timeouts:       gatewayv1.HTTPRouteTimeouts{},
+			name:           "no timeouts",
+			expectErrCount: 0,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("1h30m"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s500ms"),
+			},
+			name:           "valid timeouts",
+			expectErrCount: 0,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s"),
+			},
+			name:           "disabled request timeout",
+			expectErrCount: 0,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("10s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s"),
+			},
+			name:           "backendRequest timeout equal to request timeout",
+			expectErrCount: 0,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("1.5s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1d"),
+			},
+			name:           "invalid durations",
+			expectErrCount: 2,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request: helpers.GetPointer[gatewayv1.Duration]("123456s"),
+			},
+			name:           "duration with too many digits",
+			expectErrCount: 1,
+		},
+		{
+			timeouts: gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("10s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1m"),
+			},
+			name:           "backendRequest timeout longer than request timeout",
+			expectErrCount: 1,
+		},
+	}
+
+	timeoutsPath := field.NewPath("test")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			allErrs := validateTimeouts(test.timeouts, timeoutsPath)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateRetry(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		retry          gatewayv1.HTTPRouteRetry
+		name           string
+		expectErrCount int
+	}{
+		{
+			retry:          gatewayv1.HTTPRouteRetry{},
+			name:           "empty retry",
+			expectErrCount: 0,
+		},
+		{
+			retry: gatewayv1.HTTPRouteRetry{
+				Codes:    []gatewayv1.HTTPRouteRetryStatusCode{500, 502, 503, 504, 403, 404, 429},
+				Attempts: helpers.GetPointer(3),
+				Backoff:  helpers.GetPointer[gatewayv1.Duration]("0s"),
+			},
+			name:           "valid retry",
+			expectErrCount: 0,
+		},
+		{
+			retry: gatewayv1.HTTPRouteRetry{
+				Codes: []gatewayv1.HTTPRouteRetryStatusCode{500, 501, 400},
+			},
+			name:           "unsupported codes",
+			expectErrCount: 2,
+		},
+		{
+			retry: gatewayv1.HTTPRouteRetry{
+				Attempts: helpers.GetPointer(-1),
+			},
+			name:           "negative attempts",
+			expectErrCount: 1,
+		},
+		{
+			retry: gatewayv1.HTTPRouteRetry{
+				Backoff: helpers.GetPointer[gatewayv1.Duration]("1.5s"),
+			},
+			name:           "invalid backoff",
+			expectErrCount: 1,
+		},
+		{
+			retry: gatewayv1.HTTPRouteRetry{
+				Backoff: helpers.GetPointer[gatewayv1.Duration]("100ms"),
+			},
+			name:           "non-zero backoff",
+			expectErrCount: 1,
+		},
+	}
+
+	retryPath := field.NewPath("test")
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			allErrs := validateRetry(test.retry, retryPath)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
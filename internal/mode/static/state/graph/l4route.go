@@ -0,0 +1,77 @@
+package graph
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// portL4Route is a Route that routes the connections of a port, without hostnames, to the single backend
+// of its single rule, such as a TCPRoute or a UDPRoute.
+type portL4Route struct {
+	// source is the Route.
+	source client.Object
+	// protocol is the protocol of the connections that the Route routes.
+	protocol apiv1.Protocol
+	// parentRefs are the parentRefs of the Route.
+	parentRefs []v1.ParentReference
+	// ruleBackendRefs are the backendRefs of each rule of the Route.
+	ruleBackendRefs [][]v1.BackendRef
+}
+
+// buildPortL4Route builds the L4Route of a portL4Route. The Route is only valid if it has exactly one rule
+// with exactly one backendRef.
+func buildPortL4Route(
+	route portL4Route,
+	gatewayNsNames []types.NamespacedName,
+	services map[types.NamespacedName]*apiv1.Service,
+	npCfg *NginxProxy,
+	refGrantResolver func(resource toResource) bool,
+) *L4Route {
+	r := &L4Route{
+		Source: route.source,
+	}
+
+	sectionNameRefs, err := buildSectionNameRefs(route.parentRefs, route.source.GetNamespace(), gatewayNsNames)
+	if err != nil {
+		r.Valid = false
+
+		return r
+	}
+	// route doesn't belong to any of the Gateways
+	if len(sectionNameRefs) == 0 {
+		return nil
+	}
+	r.ParentRefs = sectionNameRefs
+
+	if len(route.ruleBackendRefs) != 1 || len(route.ruleBackendRefs[0]) != 1 {
+		r.Valid = false
+		cond := staticConds.NewRouteBackendRefUnsupportedValue(
+			"Must have exactly one Rule and BackendRef",
+		)
+		r.Conditions = append(r.Conditions, cond)
+		return r
+	}
+
+	br, cond := validateBackendRefL4Route(
+		route.ruleBackendRefs[0][0],
+		route.source.GetNamespace(),
+		services,
+		npCfg,
+		refGrantResolver,
+		route.protocol,
+	)
+
+	r.Spec.BackendRef = br
+	r.Valid = true
+	r.Attachable = true
+
+	if cond != nil {
+		r.Conditions = append(r.Conditions, *cond)
+	}
+
+	return r
+}
@@ -10,12 +10,12 @@ import (
 // a label that matches any of the Gateway Listener's label selector.
 func buildReferencedNamespaces(
 	clusterNamespaces map[types.NamespacedName]*v1.Namespace,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 ) map[types.NamespacedName]*v1.Namespace {
 	referencedNamespaces := make(map[types.NamespacedName]*v1.Namespace)
 
 	for name, ns := range clusterNamespaces {
-		if isNamespaceReferenced(ns, gw) {
+		if isNamespaceReferenced(ns, gws) {
 			referencedNamespaces[name] = ns
 		}
 	}
@@ -28,19 +28,21 @@ func buildReferencedNamespaces(
 
 // isNamespaceReferenced returns true if a given Namespace resource has a label
 // that matches any of the Gateway Listener's label selector.
-func isNamespaceReferenced(ns *v1.Namespace, gw *Gateway) bool {
-	if gw == nil || ns == nil {
+func isNamespaceReferenced(ns *v1.Namespace, gws map[types.NamespacedName]*Gateway) bool {
+	if ns == nil {
 		return false
 	}
 
 	nsLabels := labels.Set(ns.GetLabels())
-	for _, listener := range gw.Listeners {
-		if listener.AllowedRouteLabelSelector == nil {
-			// Can have listeners with AllowedRouteLabelSelector not set.
-			continue
-		}
-		if listener.AllowedRouteLabelSelector.Matches(nsLabels) {
-			return true
+	for _, gw := range gws {
+		for _, listener := range gw.Listeners {
+			if listener.AllowedRouteLabelSelector == nil {
+				// Can have listeners with AllowedRouteLabelSelector not set.
+				continue
+			}
+			if listener.AllowedRouteLabelSelector.Matches(nsLabels) {
+				return true
+			}
 		}
 	}
 
@@ -45,6 +45,7 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 
 	tests := []struct {
 		gw            *Gateway
+		mergedGw      *Gateway
 		expectedRefNS map[types.NamespacedName]*v1.Namespace
 		name          string
 	}{
@@ -167,13 +168,48 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 			},
 			name: "gateway has two listeners, one with a matching AllowedRouteLabelSelector and one without the field set",
 		},
+		{
+			gw: &Gateway{
+				Listeners: []*Listener{
+					{
+						Name:                      "listener-1",
+						Valid:                     true,
+						AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"apples": "oranges"}),
+					},
+				},
+				Valid: true,
+			},
+			mergedGw: &Gateway{
+				Listeners: []*Listener{
+					{
+						Name:                      "listener-1",
+						Valid:                     true,
+						AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"peaches": "bananas"}),
+					},
+				},
+				Valid: true,
+			},
+			expectedRefNS: map[types.NamespacedName]*v1.Namespace{
+				{Name: "ns2"}: ns2,
+				{Name: "ns3"}: ns3,
+			},
+			name: "gateway and merged gateway match labels with different namespaces",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(buildReferencedNamespaces(clusterNamespaces, test.gw)).To(Equal(test.expectedRefNS))
+
+			gws := map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gateway"}: test.gw,
+			}
+			if test.mergedGw != nil {
+				gws[types.NamespacedName{Namespace: "test", Name: "merged-gateway"}] = test.mergedGw
+			}
+
+			g.Expect(buildReferencedNamespaces(clusterNamespaces, gws)).To(Equal(test.expectedRefNS))
 		})
 	}
 }
@@ -182,15 +218,15 @@ func TestIsNamespaceReferenced(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		ns   *v1.Namespace
-		gw   *Gateway
+		gws  map[types.NamespacedName]*Gateway
 		name string
 		exp  bool
 	}{
 		{
 			ns:   nil,
-			gw:   nil,
+			gws:  nil,
 			exp:  false,
-			name: "namespace and gateway are nil",
+			name: "namespace and gateways are nil",
 		},
 		{
 			ns: &v1.Namespace{
@@ -198,15 +234,17 @@ func TestIsNamespaceReferenced(t *testing.T) {
 					Name: "ns1",
 				},
 			},
-			gw:   nil,
+			gws:  nil,
 			exp:  false,
-			name: "namespace is valid but gateway is nil",
+			name: "namespace is valid but gateways are nil",
 		},
 		{
 			ns: nil,
-			gw: &Gateway{
-				Listeners: []*Listener{},
-				Valid:     true,
+			gws: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gateway"}: {
+					Listeners: []*Listener{},
+					Valid:     true,
+				},
 			},
 			exp:  false,
 			name: "gateway is valid but namespace is nil",
@@ -218,7 +256,7 @@ func TestIsNamespaceReferenced(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(isNamespaceReferenced(test.ns, test.gw)).To(Equal(test.exp))
+			g.Expect(isNamespaceReferenced(test.ns, test.gws)).To(Equal(test.exp))
 		})
 	}
 }
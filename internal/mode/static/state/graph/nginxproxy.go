@@ -1,56 +1,667 @@
 package graph
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"regexp"
 	"slices"
+	"strings"
 
+	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
 )
 
 // NginxProxy represents the NginxProxy resource.
 type NginxProxy struct {
-	// Source is the source resource.
+	// Source is the source resource, with the settings inherited from its bases merged into the spec.
 	Source *ngfAPI.NginxProxy
+	// TemplateOverrides holds the templates from the ConfigMap referenced by the NginxProxy, keyed by
+	// template name.
+	TemplateOverrides map[ngfAPI.TemplateOverrideKey]string
+	// Bases are the names of the base NginxProxies that the NginxProxy inherits the settings from, starting with
+	// the base referenced by the NginxProxy. If a base doesn't exist, its name ends the list.
+	Bases []string
+	// EffectiveValues report the NginxProxy that provides the effective value of every setting.
+	EffectiveValues []ngfAPI.NginxProxyEffectiveValue
+	// Conditions define the conditions to be reported in the status of the NginxProxy.
+	Conditions []conditions.Condition
 	// ErrMsgs contains the validation errors if they exist, to be included in the GatewayClass condition.
 	ErrMsgs field.ErrorList
 	// Valid shows whether the NginxProxy is valid.
 	Valid bool
 }
 
+// maxNginxProxyBases is the maximum number of the bases in the chain of bases of an NginxProxy.
+const maxNginxProxyBases = 16
+
 // buildNginxProxy validates and returns the NginxProxy associated with the GatewayClass (if it exists).
 func buildNginxProxy(
 	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
 	gc *v1.GatewayClass,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
 	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
 ) *NginxProxy {
 	if gcReferencesAnyNginxProxy(gc) {
 		npCfg := nps[types.NamespacedName{Name: gc.Spec.ParametersRef.Name}]
 		if npCfg != nil {
-			errs := validateNginxProxy(validator, npCfg)
+			np := newNginxProxy(npCfg, nps, configMaps, validator, allowTemplateOverrides)
 
-			return &NginxProxy{
-				Source:  npCfg,
-				Valid:   len(errs) == 0,
-				ErrMsgs: errs,
+			if np.Source.Spec.IPFamily == nil {
+				np.Source.Spec.IPFamily = helpers.GetPointer[ngfAPI.IPFamilyType](ngfAPI.Dual)
 			}
+
+			return np
 		}
 	}
 
 	return nil
 }
 
+// newNginxProxy merges the settings of the bases of the NginxProxy into a copy of it, and validates the result.
+func newNginxProxy(
+	npCfg *ngfAPI.NginxProxy,
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
+) *NginxProxy {
+	bases, baseErr := getNginxProxyBases(npCfg, nps)
+
+	resolved := npCfg.DeepCopy()
+	for _, base := range bases {
+		mergeNginxProxySpec(&resolved.Spec, &base.Spec)
+	}
+
+	SetNginxProxyDefaults(resolved)
+
+	// The validation defaults the IP family, which must not override the IP family of the GatewayClass NginxProxy
+	// when the NginxProxies are merged, so a copy is validated.
+	errs := validateNginxProxy(validator, resolved.DeepCopy())
+
+	overrides, overrideErrs := resolveTemplateOverrides(resolved, configMaps, validator, allowTemplateOverrides)
+	errs = append(errs, overrideErrs...)
+
+	var conds []conditions.Condition
+
+	if baseErr != nil {
+		errs = append(field.ErrorList{baseErr}, errs...)
+	}
+
+	switch {
+	case baseErr != nil && baseErr.Type == field.ErrorTypeNotFound:
+		conds = append(conds, staticConds.NewNginxProxyBaseNotFound(baseErr.Error()))
+	case baseErr != nil && baseErr.Type == field.ErrorTypeInvalid:
+		conds = append(conds, staticConds.NewNginxProxyBaseCycle(baseErr.Error()))
+	case len(errs) > 0:
+		conds = append(conds, staticConds.NewNginxProxyInvalid(errs.ToAggregate().Error()))
+	}
+
+	var baseNames []string
+	for _, base := range bases {
+		baseNames = append(baseNames, base.Name)
+	}
+
+	if baseErr != nil && baseErr.Type == field.ErrorTypeNotFound {
+		baseNames = append(baseNames, fmt.Sprint(baseErr.BadValue))
+	}
+
+	return &NginxProxy{
+		Source:            resolved,
+		TemplateOverrides: overrides,
+		Bases:             baseNames,
+		EffectiveValues:   getEffectiveValues(append([]*ngfAPI.NginxProxy{npCfg}, bases...)),
+		Conditions:        conds,
+		Valid:             len(errs) == 0,
+		ErrMsgs:           errs,
+	}
+}
+
+// getNginxProxyBases returns the chain of the base NginxProxies of the NginxProxy, starting with the base
+// referenced by the NginxProxy. If a base doesn't exist, or the chain contains a cycle or is too long,
+// the bases up to the failure are returned with an error.
+func getNginxProxyBases(
+	npCfg *ngfAPI.NginxProxy,
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+) ([]*ngfAPI.NginxProxy, *field.Error) {
+	path := field.NewPath("spec", "baseRef", "name")
+
+	var bases []*ngfAPI.NginxProxy
+	chain := []string{npCfg.Name}
+
+	for current := npCfg; current.Spec.BaseRef != nil; {
+		name := current.Spec.BaseRef.Name
+
+		if slices.Contains(chain, name) {
+			msg := "the chain of bases contains a cycle: " + strings.Join(append(chain, name), " -> ")
+			return bases, field.Invalid(path, npCfg.Spec.BaseRef.Name, msg)
+		}
+
+		if len(bases) == maxNginxProxyBases {
+			return bases, field.TooMany(path, len(bases)+1, maxNginxProxyBases)
+		}
+
+		base, exists := nps[types.NamespacedName{Name: name}]
+		if !exists {
+			err := field.NotFound(path, name)
+			if current != npCfg {
+				err.Detail = "the base of NginxProxy " + current.Name
+			}
+
+			return bases, err
+		}
+
+		bases = append(bases, base)
+		chain = append(chain, name)
+		current = base
+	}
+
+	return bases, nil
+}
+
+// nginxProxyHardeningField is the name of the hardening settings in the spec of the NginxProxy.
+const nginxProxyHardeningField = "hardening"
+
+// getEffectiveValues returns the NginxProxy that provides the effective value of every setting that is set in
+// the chain of NginxProxies, which starts with the NginxProxy itself and continues with its bases.
+// The hardening settings are reported one by one, because they are inherited one by one.
+func getEffectiveValues(chain []*ngfAPI.NginxProxy) []ngfAPI.NginxProxyEffectiveValue {
+	sources := make(map[string]string)
+
+	// the settings of an NginxProxy override the settings of its bases
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, f := range getSetFields(chain[i].Spec) {
+			sources[f] = chain[i].Name
+		}
+	}
+
+	fields := slices.Sorted(maps.Keys(sources))
+
+	var values []ngfAPI.NginxProxyEffectiveValue
+	for _, f := range fields {
+		values = append(values, ngfAPI.NginxProxyEffectiveValue{
+			Field:  f,
+			Source: sources[f],
+		})
+	}
+
+	return values
+}
+
+// getSetFields returns the paths of the settings that are set in the spec. The unset settings are omitted
+// in the JSON representation of the spec.
+func getSetFields(spec ngfAPI.NginxProxySpec) []string {
+	// the base is not a setting
+	spec.BaseRef = nil
+
+	settings := jsonFields(spec)
+
+	fields := make([]string, 0, len(settings))
+	for name, value := range settings {
+		if name != nginxProxyHardeningField {
+			fields = append(fields, name)
+			continue
+		}
+
+		for hardeningName := range jsonFields(value) {
+			fields = append(fields, nginxProxyHardeningField+"."+hardeningName)
+		}
+	}
+
+	return fields
+}
+
+func jsonFields(value any) map[string]json.RawMessage {
+	data, err := json.Marshal(value)
+	if err != nil {
+		// panic is safe here because the API types can always be marshaled.
+		panic(fmt.Errorf("could not marshal the NginxProxy settings: %w", err))
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		panic(fmt.Errorf("could not unmarshal the NginxProxy settings: %w", err))
+	}
+
+	return fields
+}
+
 // isNginxProxyReferenced returns whether or not a specific NginxProxy is referenced in the GatewayClass.
 func isNginxProxyReferenced(npNSName types.NamespacedName, gc *GatewayClass) bool {
 	return gc != nil && gcReferencesAnyNginxProxy(gc.Source) && gc.Source.Spec.ParametersRef.Name == npNSName.Name
 }
 
+// isNginxProxyReferencedByGateways returns whether a specific NginxProxy is referenced by the infrastructure
+// parametersRef of any of the Gateways.
+func isNginxProxyReferencedByGateways(npNSName types.NamespacedName, gws map[types.NamespacedName]*Gateway) bool {
+	for _, gw := range gws {
+		if ref := getGatewayParametersRef(gw.Source); ref != nil && gwReferencesAnyNginxProxy(ref) &&
+			ref.Name == npNSName.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildGatewayNginxProxies validates the NginxProxies referenced by the infrastructure parametersRef of the
+// Gateways and adds the corresponding conditions to the Gateways. The NginxProxy of the winning Gateway configures
+// the data plane, while the NginxProxies of the merged Gateways only configure the servers of their Listeners
+// (see buildMergedGatewayEffectiveNginxProxies).
+func buildGatewayNginxProxies(
+	gw *Gateway,
+	mergedGws map[types.NamespacedName]*Gateway,
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
+) {
+	if gw == nil {
+		return
+	}
+
+	buildGatewayNginxProxy(gw, nps, configMaps, validator, allowTemplateOverrides)
+
+	for _, mergedGw := range mergedGws {
+		buildGatewayNginxProxy(mergedGw, nps, configMaps, validator, allowTemplateOverrides)
+	}
+}
+
+// buildGatewayNginxProxy validates and sets the NginxProxy referenced by the infrastructure parametersRef of
+// the Gateway (if it exists).
+func buildGatewayNginxProxy(
+	gw *Gateway,
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
+) {
+	ref := getGatewayParametersRef(gw.Source)
+	if !gw.Valid || ref == nil {
+		return
+	}
+
+	path := field.NewPath("spec", "infrastructure", "parametersRef")
+
+	if !gwReferencesAnyNginxProxy(ref) {
+		err := field.NotSupported(
+			path.Child("kind"),
+			fmt.Sprintf("%s/%s", ref.Group, ref.Kind),
+			[]string{fmt.Sprintf("%s/%s", ngfAPI.GroupName, kinds.NginxProxy)},
+		)
+		gw.Conditions = append(gw.Conditions, staticConds.NewGatewayInvalidParameters(err.Error()))
+
+		return
+	}
+
+	npCfg, exists := nps[types.NamespacedName{Name: ref.Name}]
+	if !exists {
+		err := field.NotFound(path.Child("name"), ref.Name)
+		gw.Conditions = append(
+			gw.Conditions,
+			staticConds.NewGatewayRefNotFound(),
+			staticConds.NewGatewayInvalidParameters(err.Error()),
+		)
+
+		return
+	}
+
+	gw.NginxProxy = newNginxProxy(npCfg, nps, configMaps, validator, allowTemplateOverrides)
+
+	errs := gw.NginxProxy.ErrMsgs
+
+	if len(errs) > 0 {
+		gw.Conditions = append(
+			gw.Conditions,
+			staticConds.NewGatewayInvalidParameters(errs.ToAggregate().Error()),
+		)
+
+		return
+	}
+
+	gw.Conditions = append(gw.Conditions, staticConds.NewGatewayResolvedRefs())
+}
+
+// buildReferencedNginxProxies returns the NginxProxies referenced by the GatewayClass and the Gateways, and
+// their bases. Every base is built with its own bases, so that its status reports its own settings.
+// The bases that do not exist are included with nil values.
+func buildReferencedNginxProxies(
+	gcNpCfg *NginxProxy,
+	gws map[types.NamespacedName]*Gateway,
+	nps map[types.NamespacedName]*ngfAPI.NginxProxy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
+) map[types.NamespacedName]*NginxProxy {
+	referenced := make(map[types.NamespacedName]*NginxProxy)
+
+	var addBases func(np *NginxProxy)
+	addBases = func(np *NginxProxy) {
+		for _, name := range np.Bases {
+			nsname := types.NamespacedName{Name: name}
+			if _, exists := referenced[nsname]; exists {
+				continue
+			}
+
+			npCfg, exists := nps[nsname]
+			if !exists {
+				referenced[nsname] = nil
+				continue
+			}
+
+			base := newNginxProxy(npCfg, nps, configMaps, validator, allowTemplateOverrides)
+			referenced[nsname] = base
+			addBases(base)
+		}
+	}
+
+	add := func(np *NginxProxy) {
+		if np == nil {
+			return
+		}
+
+		referenced[client.ObjectKeyFromObject(np.Source)] = np
+		addBases(np)
+	}
+
+	add(gcNpCfg)
+
+	for _, gw := range gws {
+		add(gw.NginxProxy)
+	}
+
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	return referenced
+}
+
+// isNginxProxyReferencedAsBase returns whether a specific NginxProxy is a base of any referenced NginxProxy,
+// including the case when the base doesn't exist in the cluster.
+func isNginxProxyReferencedAsBase(npNSName types.NamespacedName, nps map[types.NamespacedName]*NginxProxy) bool {
+	for _, np := range nps {
+		if np != nil && slices.Contains(np.Bases, npNSName.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildEffectiveNginxProxy returns the NginxProxy that configures the data plane. The settings of the valid
+// NginxProxy referenced by the winning Gateway override the settings of the NginxProxy of the GatewayClass.
+func buildEffectiveNginxProxy(gcNpCfg *NginxProxy, gw *Gateway) *NginxProxy {
+	if gw == nil || gw.NginxProxy == nil || !gw.NginxProxy.Valid {
+		return gcNpCfg
+	}
+
+	gwNpCfg := gw.NginxProxy
+
+	effective := &NginxProxy{
+		Source:            gwNpCfg.Source.DeepCopy(),
+		TemplateOverrides: gwNpCfg.TemplateOverrides,
+		Valid:             true,
+	}
+
+	spec := &effective.Source.Spec
+
+	if gcNpCfg != nil && gcNpCfg.Valid {
+		if spec.TemplateOverrides == nil {
+			effective.TemplateOverrides = gcNpCfg.TemplateOverrides
+		}
+
+		mergeNginxProxySpec(spec, &gcNpCfg.Source.Spec)
+	}
+
+	if spec.IPFamily == nil {
+		spec.IPFamily = helpers.GetPointer[ngfAPI.IPFamilyType](ngfAPI.Dual)
+	}
+
+	return effective
+}
+
+// perGatewayNginxProxySettings are the settings of an NginxProxy that only configure the servers of the Listeners
+// of a Gateway, so the merged Gateways can override them. The other settings configure the NGINX configuration
+// that the merged Gateways share with the winning Gateway.
+var perGatewayNginxProxySettings = []string{"ipFamily", "rewriteClientIP"}
+
+// buildMergedGatewayEffectiveNginxProxies sets the effective NginxProxies of the merged Gateways that reference
+// a valid NginxProxy. The effective NginxProxy of a merged Gateway is the NginxProxy of the data plane with the
+// per-Gateway settings of the NginxProxy of the Gateway. The other settings of the NginxProxy of the Gateway
+// can't be applied, so the ones that differ from the settings of the data plane are reported in the conditions of
+// the Gateway. The PROXY protocol is enabled for all the servers of a port, so the rewriteClientIP settings are
+// ignored too if they enable or disable the PROXY protocol differently than the data plane.
+func buildMergedGatewayEffectiveNginxProxies(
+	npCfg *NginxProxy,
+	gw *Gateway,
+	mergedGws map[types.NamespacedName]*Gateway,
+) {
+	dataPlaneNp := npCfg
+	if dataPlaneNp == nil || !dataPlaneNp.Valid {
+		// the data plane uses the default settings
+		dataPlaneNp = &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{IPFamily: helpers.GetPointer[ngfAPI.IPFamilyType](ngfAPI.Dual)},
+			},
+			Valid: true,
+		}
+	}
+
+	dataPlaneSettings := jsonFields(dataPlaneNp.Source.Spec)
+
+	for _, mergedGw := range mergedGws {
+		if !mergedGw.Valid || mergedGw.NginxProxy == nil || !mergedGw.NginxProxy.Valid {
+			continue
+		}
+
+		gwSpec := buildEffectiveNginxProxy(dataPlaneNp, mergedGw).Source.Spec
+		gwSpec.BaseRef = dataPlaneNp.Source.Spec.BaseRef
+
+		var ignored []string
+		for name, value := range jsonFields(gwSpec) {
+			if !slices.Contains(perGatewayNginxProxySettings, name) && string(value) != string(dataPlaneSettings[name]) {
+				ignored = append(ignored, name)
+			}
+		}
+
+		effective := &NginxProxy{
+			Source:            dataPlaneNp.Source.DeepCopy(),
+			TemplateOverrides: dataPlaneNp.TemplateOverrides,
+			Valid:             true,
+		}
+		effective.Source.ObjectMeta = *mergedGw.NginxProxy.Source.ObjectMeta.DeepCopy()
+		effective.Source.Spec.IPFamily = gwSpec.IPFamily
+
+		sameProxyProtocol := isProxyProtocolEnabled(gwSpec.RewriteClientIP) ==
+			isProxyProtocolEnabled(dataPlaneNp.Source.Spec.RewriteClientIP)
+
+		if sameProxyProtocol {
+			effective.Source.Spec.RewriteClientIP = gwSpec.RewriteClientIP
+		} else {
+			ignored = append(ignored, "rewriteClientIP")
+		}
+
+		mergedGw.EffectiveNginxProxy = effective
+
+		if len(ignored) > 0 {
+			slices.Sort(ignored)
+
+			msg := fmt.Sprintf(
+				"The settings %s of NginxProxy %s are ignored, because the Gateway shares the NGINX configuration "+
+					"with Gateway %s, which configures them differently; only the settings %s can be set per Gateway, "+
+					"and rewriteClientIP must enable the PROXY protocol like the shared configuration",
+				strings.Join(ignored, ", "),
+				mergedGw.NginxProxy.Source.Name,
+				client.ObjectKeyFromObject(gw.Source),
+				strings.Join(perGatewayNginxProxySettings, ", "),
+			)
+			mergedGw.Conditions = append(mergedGw.Conditions, staticConds.NewGatewayNginxProxySettingsIgnored(msg))
+		}
+	}
+}
+
+// isProxyProtocolEnabled returns whether the rewriteClientIP settings enable the PROXY protocol.
+func isProxyProtocolEnabled(rewriteClientIP *ngfAPI.RewriteClientIP) bool {
+	return rewriteClientIP != nil && rewriteClientIP.Mode != nil &&
+		*rewriteClientIP.Mode == ngfAPI.RewriteClientIPModeProxyProtocol
+}
+
+// mergeNginxProxySpec merges the settings of the base spec into the spec. The settings that are set in the spec
+// override the settings of the base, the hardening settings are merged one by one, and the boolean settings are
+// enabled if they are enabled in either spec.
+func mergeNginxProxySpec(spec, base *ngfAPI.NginxProxySpec) {
+	base = base.DeepCopy()
+
+	if spec.IPFamily == nil {
+		spec.IPFamily = base.IPFamily
+	}
+
+	if spec.Telemetry == nil {
+		spec.Telemetry = base.Telemetry
+	}
+
+	if spec.RewriteClientIP == nil {
+		spec.RewriteClientIP = base.RewriteClientIP
+	}
+
+	if spec.Logging == nil {
+		spec.Logging = base.Logging
+	}
+
+	if spec.NginxPlus == nil {
+		spec.NginxPlus = base.NginxPlus
+	}
+
+	if spec.TemplateOverrides == nil {
+		spec.TemplateOverrides = base.TemplateOverrides
+	}
+
+	if spec.HashTables == nil {
+		spec.HashTables = base.HashTables
+	}
+
+	if spec.HTTPMatchMode == nil {
+		spec.HTTPMatchMode = base.HTTPMatchMode
+	}
+
+	spec.Hardening = mergeHardening(spec.Hardening, base.Hardening)
+
+	if spec.SocketOptions == nil {
+		spec.SocketOptions = base.SocketOptions
+	}
+
+	if spec.Worker == nil {
+		spec.Worker = base.Worker
+	}
+
+	if spec.TLSPassthrough == nil {
+		spec.TLSPassthrough = base.TLSPassthrough
+	}
+
+	if spec.TLS == nil {
+		spec.TLS = base.TLS
+	}
+
+	if spec.AddressPublication == nil {
+		spec.AddressPublication = base.AddressPublication
+	}
+
+	if spec.Resolver == nil {
+		spec.Resolver = base.Resolver
+	}
+
+	if spec.HTTP2 == nil {
+		spec.HTTP2 = base.HTTP2
+	}
+
+	if spec.Status == nil {
+		spec.Status = base.Status
+	}
+
+	spec.DisableHTTP2 = spec.DisableHTTP2 || base.DisableHTTP2
+	spec.EnableHTTP3 = spec.EnableHTTP3 || base.EnableHTTP3
+	spec.DisableRegexPathMatch = spec.DisableRegexPathMatch || base.DisableRegexPathMatch
+}
+
+// mergeHardening merges the hardening settings of an NginxProxy with the settings of its base, for example,
+// the NginxProxy of the Gateway with the NginxProxy of the GatewayClass. The settings of the NginxProxy override
+// the settings of the base one by one, so that an NginxProxy can change a single setting without repeating
+// the others.
+func mergeHardening(hardening, baseHardening *ngfAPI.Hardening) *ngfAPI.Hardening {
+	if hardening == nil {
+		return baseHardening
+	}
+
+	if baseHardening == nil {
+		return hardening
+	}
+
+	merged := hardening.DeepCopy()
+
+	if merged.ClientHeaderTimeout == nil {
+		merged.ClientHeaderTimeout = baseHardening.ClientHeaderTimeout
+	}
+
+	if merged.ClientBodyTimeout == nil {
+		merged.ClientBodyTimeout = baseHardening.ClientBodyTimeout
+	}
+
+	if merged.SendTimeout == nil {
+		merged.SendTimeout = baseHardening.SendTimeout
+	}
+
+	if merged.ResetTimedOutConnection == nil {
+		merged.ResetTimedOutConnection = baseHardening.ResetTimedOutConnection
+	}
+
+	if merged.RequestSmugglingProtection == nil {
+		merged.RequestSmugglingProtection = baseHardening.RequestSmugglingProtection
+	}
+
+	return merged
+}
+
+// getGatewayParametersRef returns the infrastructure parametersRef of the Gateway (if it exists).
+func getGatewayParametersRef(gw *v1.Gateway) *v1.LocalParametersReference {
+	if gw == nil || gw.Spec.Infrastructure == nil {
+		return nil
+	}
+
+	return gw.Spec.Infrastructure.ParametersRef
+}
+
+// gwReferencesAnyNginxProxy returns whether a Gateway parametersRef references any NginxProxy resource.
+func gwReferencesAnyNginxProxy(ref *v1.LocalParametersReference) bool {
+	return ref.Group == ngfAPI.GroupName && ref.Kind == v1.Kind(kinds.NginxProxy)
+}
+
+// isTemplateOverridesConfigMapReferenced returns whether the ConfigMap is referenced by the NginxProxy
+// for template overrides.
+func isTemplateOverridesConfigMapReferenced(cmNsName types.NamespacedName, np *NginxProxy) bool {
+	if np == nil || np.Source == nil || np.Source.Spec.TemplateOverrides == nil {
+		return false
+	}
+
+	ref := np.Source.Spec.TemplateOverrides.ConfigMapRef
+
+	return ref.Namespace == cmNsName.Namespace && ref.Name == cmNsName.Name
+}
+
 // gcReferencesNginxProxy returns whether a GatewayClass references any NginxProxy resource.
 func gcReferencesAnyNginxProxy(gc *v1.GatewayClass) bool {
 	if gc != nil {
@@ -131,10 +742,436 @@ func validateNginxProxy(
 
 	allErrs = append(allErrs, validateLogging(npCfg)...)
 
+	allErrs = append(allErrs, validateAccessLog(npCfg)...)
+
 	allErrs = append(allErrs, validateRewriteClientIP(npCfg)...)
 
 	allErrs = append(allErrs, validateNginxPlus(npCfg)...)
 
+	allErrs = append(allErrs, validateHardening(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateSocketOptions(validator, npCfg)...)
+
+	allErrs = append(allErrs, validatePortMappings(npCfg)...)
+
+	allErrs = append(allErrs, validateWorkerProcesses(npCfg)...)
+
+	allErrs = append(allErrs, validateTLSProfile(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateResolver(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateHTTP2Settings(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateStatus(npCfg)...)
+
+	return allErrs
+}
+
+var (
+	// logFormatNameRegexp matches the name of a log format.
+	logFormatNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+	// accessLogFileRegexp matches the absolute path of an access log file.
+	accessLogFileRegexp = regexp.MustCompile(`^/[^\s;{}'"$\\]*$`)
+	// syslogServerRegexp matches the address of a syslog server, which is a hostname or an IP address,
+	// with an optional port.
+	syslogServerRegexp = regexp.MustCompile(`^(\[[0-9a-fA-F:.]+\]|[a-zA-Z0-9.-]+)(:[0-9]{1,5})?$`)
+	// syslogTagRegexp matches the tag of the syslog messages.
+	syslogTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+)
+
+// DefaultAccessLogFormat is the name of the predefined NGINX log format, which is the default format
+// of the access logs.
+const DefaultAccessLogFormat = "combined"
+
+// SetNginxProxyDefaults sets the default values of the unset access log settings of the NginxProxy.
+// It modifies the NginxProxy in place.
+//
+// The defaulting webhook sets the defaults when an NginxProxy is admitted, and the Graph sets them when
+// an NginxProxy is processed, so that NginxProxies that were created before the webhook was enabled
+// get the same defaults.
+func SetNginxProxyDefaults(npCfg *ngfAPI.NginxProxy) {
+	if npCfg.Spec.Logging == nil || npCfg.Spec.Logging.AccessLog == nil {
+		return
+	}
+
+	accessLog := npCfg.Spec.Logging.AccessLog
+
+	if accessLog.Default == nil {
+		accessLog.Default = &ngfAPI.AccessLogSettings{}
+	}
+
+	if accessLog.Default.Format == nil {
+		accessLog.Default.Format = helpers.GetPointer(DefaultAccessLogFormat)
+	}
+
+	if accessLog.Default.Destination == nil {
+		accessLog.Default.Destination = &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStdout}
+	}
+
+	for i := range accessLog.Formats {
+		if accessLog.Formats[i].Escape == nil {
+			accessLog.Formats[i].Escape = helpers.GetPointer(ngfAPI.NginxLogFormatEscapeDefault)
+		}
+	}
+}
+
+// validateAccessLog validates the access log settings, which are written to the NGINX configuration as is.
+func validateAccessLog(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	if npCfg.Spec.Logging == nil || npCfg.Spec.Logging.AccessLog == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	accessLog := npCfg.Spec.Logging.AccessLog
+	accessLogPath := field.NewPath("spec", "logging", "accessLog")
+
+	formats := map[string]struct{}{DefaultAccessLogFormat: {}}
+
+	for i, format := range accessLog.Formats {
+		formatPath := accessLogPath.Child("formats").Index(i)
+		allErrs = append(allErrs, validateLogFormat(format, formats, formatPath)...)
+		formats[format.Name] = struct{}{}
+	}
+
+	if accessLog.Default != nil {
+		allErrs = append(
+			allErrs,
+			validateAccessLogSettings(*accessLog.Default, formats, accessLogPath.Child("default"))...,
+		)
+	}
+
+	for i, listener := range accessLog.Listeners {
+		settingsPath := accessLogPath.Child("listeners").Index(i).Child("settings")
+		allErrs = append(allErrs, validateAccessLogSettings(listener.Settings, formats, settingsPath)...)
+	}
+
+	return allErrs
+}
+
+// validateLogFormat validates a log format against the formats that were defined before it.
+func validateLogFormat(
+	format ngfAPI.NginxLogFormat,
+	formats map[string]struct{},
+	formatPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !logFormatNameRegexp.MatchString(format.Name) {
+		allErrs = append(allErrs, field.Invalid(
+			formatPath.Child("name"),
+			format.Name,
+			"must contain 1 to 64 alphanumeric, '_', or '-' characters",
+		))
+	} else if _, exists := formats[format.Name]; exists {
+		if format.Name == DefaultAccessLogFormat {
+			allErrs = append(allErrs, field.Invalid(formatPath.Child("name"), format.Name, "name is reserved"))
+		} else {
+			allErrs = append(allErrs, field.Duplicate(formatPath.Child("name"), format.Name))
+		}
+	}
+
+	if format.Format == "" || strings.ContainsAny(format.Format, `'\`) {
+		allErrs = append(allErrs, field.Invalid(
+			formatPath.Child("format"),
+			format.Format,
+			`must be non-empty and must not contain the ' or \ characters`,
+		))
+	}
+
+	if format.Escape != nil {
+		supportedEscapes := []string{
+			string(ngfAPI.NginxLogFormatEscapeDefault),
+			string(ngfAPI.NginxLogFormatEscapeJSON),
+			string(ngfAPI.NginxLogFormatEscapeNone),
+		}
+
+		if !slices.Contains(supportedEscapes, string(*format.Escape)) {
+			allErrs = append(allErrs, field.NotSupported(formatPath.Child("escape"), *format.Escape, supportedEscapes))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAccessLogSettings(
+	settings ngfAPI.AccessLogSettings,
+	formats map[string]struct{},
+	settingsPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if settings.Format != nil {
+		if _, exists := formats[*settings.Format]; !exists {
+			allErrs = append(allErrs, field.NotFound(settingsPath.Child("format"), *settings.Format))
+		}
+	}
+
+	if settings.Destination != nil {
+		allErrs = append(
+			allErrs,
+			validateAccessLogDestination(*settings.Destination, settingsPath.Child("destination"))...,
+		)
+	}
+
+	return allErrs
+}
+
+func validateAccessLogDestination(dest ngfAPI.AccessLogDestination, destPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch dest.Type {
+	case ngfAPI.AccessLogDestinationStdout, ngfAPI.AccessLogDestinationStderr:
+	case ngfAPI.AccessLogDestinationFile:
+		if dest.File == nil {
+			allErrs = append(allErrs, field.Required(destPath.Child("file"), "file is required when type is File"))
+		} else if !accessLogFileRegexp.MatchString(*dest.File) {
+			allErrs = append(allErrs, field.Invalid(
+				destPath.Child("file"),
+				*dest.File,
+				`must be an absolute path without whitespace, ';', '{', '}', ''', '"', '$', or '\' characters`,
+			))
+		}
+	case ngfAPI.AccessLogDestinationSyslog:
+		if dest.Syslog == nil {
+			allErrs = append(allErrs, field.Required(destPath.Child("syslog"), "syslog is required when type is Syslog"))
+			break
+		}
+
+		syslogPath := destPath.Child("syslog")
+
+		if !syslogServerRegexp.MatchString(dest.Syslog.Server) {
+			allErrs = append(allErrs, field.Invalid(
+				syslogPath.Child("server"),
+				dest.Syslog.Server,
+				`must be a hostname or an IP address with an optional port, for example, "syslog.example.com:514"`,
+			))
+		}
+
+		if dest.Syslog.Tag != nil && !syslogTagRegexp.MatchString(*dest.Syslog.Tag) {
+			allErrs = append(allErrs, field.Invalid(
+				syslogPath.Child("tag"),
+				*dest.Syslog.Tag,
+				"must contain 1 to 32 alphanumeric or '_' characters",
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			destPath.Child("type"),
+			dest.Type,
+			[]string{
+				string(ngfAPI.AccessLogDestinationStdout),
+				string(ngfAPI.AccessLogDestinationStderr),
+				string(ngfAPI.AccessLogDestinationFile),
+				string(ngfAPI.AccessLogDestinationSyslog),
+			},
+		))
+	}
+
+	return allErrs
+}
+
+// workerProcessesRegexp matches the number of the worker processes, which is either "auto" or a number.
+var workerProcessesRegexp = regexp.MustCompile(`^(auto|[1-9][0-9]{0,3})$`)
+
+// validateWorkerProcesses validates the number of the worker processes, which is written to the NGINX configuration
+// as is.
+func validateWorkerProcesses(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	worker := npCfg.Spec.Worker
+	if worker == nil || worker.Processes == nil {
+		return nil
+	}
+
+	if !workerProcessesRegexp.MatchString(*worker.Processes) {
+		path := field.NewPath("spec", "worker", "processes")
+		return field.ErrorList{
+			field.Invalid(path, *worker.Processes, `must be "auto" or a number between 1 and 9999`),
+		}
+	}
+
+	return nil
+}
+
+// validateTLSProfile validates the settings of the TLS profile, which are written to the NGINX configuration as is.
+func validateTLSProfile(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	tls := npCfg.Spec.TLS
+	if tls == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	tlsPath := field.NewPath("spec", "tls")
+	protocolsPath := tlsPath.Child("protocols")
+
+	supportedProtocols := []string{string(ngfAPI.TLSProtocolTLSv12), string(ngfAPI.TLSProtocolTLSv13)}
+
+	for i, protocol := range tls.Protocols {
+		if !slices.Contains(supportedProtocols, string(protocol)) {
+			allErrs = append(allErrs, field.NotSupported(protocolsPath.Index(i), protocol, supportedProtocols))
+		}
+	}
+
+	if tls.Ciphers != nil {
+		if err := validator.ValidateSSLCiphers(*tls.Ciphers); err != nil {
+			allErrs = append(allErrs, field.Invalid(tlsPath.Child("ciphers"), *tls.Ciphers, err.Error()))
+		}
+	}
+
+	if session := tls.Session; session != nil {
+		sessionPath := tlsPath.Child("session")
+
+		if session.CacheSize != nil {
+			if err := validator.ValidateNginxSize(string(*session.CacheSize)); err != nil {
+				allErrs = append(allErrs, field.Invalid(sessionPath.Child("cacheSize"), *session.CacheSize, err.Error()))
+			}
+		}
+
+		if session.Timeout != nil {
+			if err := validator.ValidateNginxDuration(string(*session.Timeout)); err != nil {
+				allErrs = append(allErrs, field.Invalid(sessionPath.Child("timeout"), *session.Timeout, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateResolver validates the nameservers of the resolver, which are written to the NGINX configuration as is.
+func validateResolver(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	resolver := npCfg.Spec.Resolver
+	if resolver == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	resolverPath := field.NewPath("spec", "resolver")
+
+	if len(resolver.Addresses) == 0 {
+		allErrs = append(allErrs, field.Required(resolverPath.Child("addresses"), "at least one address is required"))
+	}
+
+	for i, addr := range resolver.Addresses {
+		if err := validateNameserverAddress(addr); err != nil {
+			allErrs = append(allErrs, field.Invalid(resolverPath.Child("addresses").Index(i), addr, err.Error()))
+		}
+	}
+
+	if resolver.Valid != nil {
+		if err := validator.ValidateNginxDuration(string(*resolver.Valid)); err != nil {
+			allErrs = append(allErrs, field.Invalid(resolverPath.Child("valid"), *resolver.Valid, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNameserverAddress validates the address of a nameserver, which is an IP address with an optional port.
+func validateNameserverAddress(addr string) error {
+	const msg = "must be an IP address with an optional port, for example, 10.96.0.10 or [fd00::10]:53"
+
+	if ip, err := netip.ParseAddr(addr); err == nil {
+		if ip.Zone() != "" {
+			return errors.New(msg)
+		}
+
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(addr)
+	if err != nil || addrPort.Port() == 0 || addrPort.Addr().Zone() != "" {
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+// validatePortMappings validates that every Listener port is mapped once, and that NGINX doesn't listen on
+// the same container port for two Listener ports.
+func validatePortMappings(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	worker := npCfg.Spec.Worker
+	if worker == nil || worker.PortBinding == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	mappingsPath := field.NewPath("spec", "worker", "portBinding", "portMappings")
+
+	listenerPorts := make(map[int32]struct{})
+	containerPorts := make(map[int32]struct{})
+
+	for i, m := range worker.PortBinding.PortMappings {
+		if _, exists := listenerPorts[m.ListenerPort]; exists {
+			allErrs = append(allErrs, field.Duplicate(mappingsPath.Index(i).Child("listenerPort"), m.ListenerPort))
+		}
+		listenerPorts[m.ListenerPort] = struct{}{}
+
+		if _, exists := containerPorts[m.ContainerPort]; exists {
+			allErrs = append(allErrs, field.Duplicate(mappingsPath.Index(i).Child("containerPort"), m.ContainerPort))
+		}
+		containerPorts[m.ContainerPort] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateHardening(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	hardening := npCfg.Spec.Hardening
+	if hardening == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	hardeningPath := field.NewPath("spec", "hardening")
+
+	timeouts := []struct {
+		value *ngfAPI.Duration
+		name  string
+	}{
+		{value: hardening.ClientHeaderTimeout, name: "clientHeaderTimeout"},
+		{value: hardening.ClientBodyTimeout, name: "clientBodyTimeout"},
+		{value: hardening.SendTimeout, name: "sendTimeout"},
+	}
+
+	for _, timeout := range timeouts {
+		if timeout.value == nil {
+			continue
+		}
+
+		if err := validator.ValidateNginxDuration(string(*timeout.value)); err != nil {
+			allErrs = append(allErrs, field.Invalid(hardeningPath.Child(timeout.name), *timeout.value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHTTP2Settings(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	http2 := npCfg.Spec.HTTP2
+	if http2 == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	http2Path := field.NewPath("spec", "http2")
+
+	sizes := []struct {
+		value *ngfAPI.Size
+		name  string
+	}{
+		{value: http2.RecvBufferSize, name: "recvBufferSize"},
+		{value: http2.ChunkSize, name: "chunkSize"},
+		{value: http2.BodyPrereadSize, name: "bodyPrereadSize"},
+	}
+
+	for _, size := range sizes {
+		if size.value == nil {
+			continue
+		}
+
+		if err := validator.ValidateNginxSize(string(*size.value)); err != nil {
+			allErrs = append(allErrs, field.Invalid(http2Path.Child(size.name), *size.value, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -256,37 +1293,187 @@ func validateNginxPlus(npCfg *ngfAPI.NginxProxy) field.ErrorList {
 	spec := field.NewPath("spec")
 
 	if npCfg.Spec.NginxPlus != nil {
-		nginxPlus := npCfg.Spec.NginxPlus
-		nginxPlusPath := spec.Child("nginxPlus")
+		allErrs = append(
+			allErrs,
+			validateAllowAddresses(spec.Child("nginxPlus"), npCfg.Spec.NginxPlus.AllowedAddresses)...,
+		)
+	}
+
+	return allErrs
+}
 
-		if nginxPlus.AllowedAddresses != nil {
-			for _, addr := range nginxPlus.AllowedAddresses {
-				valuePath := nginxPlusPath.Child("value")
+func validateStatus(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	status := npCfg.Spec.Status
+	if status == nil {
+		return nil
+	}
 
-				switch addr.Type {
-				case ngfAPI.NginxPlusAllowCIDRAddressType:
-					if err := k8svalidation.IsValidCIDR(valuePath, addr.Value); err != nil {
-						allErrs = append(allErrs, err...)
-					}
-				case ngfAPI.NginxPlusAllowIPAddressType:
-					if err := k8svalidation.IsValidIP(valuePath, addr.Value); err != nil {
-						allErrs = append(allErrs, err...)
-					}
-				default:
-					allErrs = append(
-						allErrs,
-						field.NotSupported(nginxPlusPath.Child("type"),
-							addr.Type,
-							[]string{
-								string(ngfAPI.NginxPlusAllowCIDRAddressType),
-								string(ngfAPI.NginxPlusAllowIPAddressType),
-							},
-						),
-					)
-				}
+	var allErrs field.ErrorList
+	statusPath := field.NewPath("spec", "status")
+
+	if status.Port < 1 || status.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(statusPath.Child("port"), status.Port, "must be between 1-65535"))
+	}
+
+	addressesPath := statusPath.Child("allowedAddresses")
+
+	if len(status.AllowedAddresses) == 0 {
+		allErrs = append(allErrs, field.Required(addressesPath, "at least one address is required"))
+	}
+
+	return append(allErrs, validateAllowAddresses(addressesPath, status.AllowedAddresses)...)
+}
+
+// validateAllowAddresses validates the addresses of an allow list of NGINX.
+func validateAllowAddresses(path *field.Path, addresses []ngfAPI.NginxPlusAllowAddress) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, addr := range addresses {
+		valuePath := path.Child("value")
+
+		switch addr.Type {
+		case ngfAPI.NginxPlusAllowCIDRAddressType:
+			if err := k8svalidation.IsValidCIDR(valuePath, addr.Value); err != nil {
+				allErrs = append(allErrs, err...)
+			}
+		case ngfAPI.NginxPlusAllowIPAddressType:
+			if err := k8svalidation.IsValidIP(valuePath, addr.Value); err != nil {
+				allErrs = append(allErrs, err...)
 			}
+		default:
+			allErrs = append(
+				allErrs,
+				field.NotSupported(path.Child("type"),
+					addr.Type,
+					[]string{
+						string(ngfAPI.NginxPlusAllowCIDRAddressType),
+						string(ngfAPI.NginxPlusAllowIPAddressType),
+					},
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func validateSocketOptions(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	socketOptions := npCfg.Spec.SocketOptions
+	if socketOptions == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	socketOptionsPath := field.NewPath("spec", "socketOptions")
+
+	if socketOptions.Default != nil {
+		allErrs = append(
+			allErrs,
+			validateSocketSettings(validator, *socketOptions.Default, socketOptionsPath.Child("default"))...,
+		)
+	}
+
+	for i, listener := range socketOptions.Listeners {
+		settingsPath := socketOptionsPath.Child("listeners").Index(i).Child("settings")
+		allErrs = append(allErrs, validateSocketSettings(validator, listener.Settings, settingsPath)...)
+	}
+
+	return allErrs
+}
+
+func validateSocketSettings(
+	validator validation.GenericValidator,
+	settings ngfAPI.SocketSettings,
+	settingsPath *field.Path,
+) field.ErrorList {
+	if settings.KeepAlive == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	keepAlivePath := settingsPath.Child("keepAlive")
+
+	// the keepalive timeouts of the socket are set in seconds, so milliseconds are not supported.
+	validateTimeout := func(timeout *ngfAPI.Duration, name string) {
+		if timeout == nil {
+			return
+		}
+
+		err := validator.ValidateNginxDuration(string(*timeout))
+		if err == nil && strings.HasSuffix(string(*timeout), "ms") {
+			err = errors.New("must be in seconds, minutes, or hours")
+		}
+
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(keepAlivePath.Child(name), *timeout, err.Error()))
 		}
 	}
 
+	validateTimeout(settings.KeepAlive.Idle, "idle")
+	validateTimeout(settings.KeepAlive.Interval, "interval")
+
 	return allErrs
 }
+
+// resolveTemplateOverrides returns the validated templates from the ConfigMap referenced by the NginxProxy.
+// The template overrides are only allowed if allowTemplateOverrides is true.
+func resolveTemplateOverrides(
+	npCfg *ngfAPI.NginxProxy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+	allowTemplateOverrides bool,
+) (map[ngfAPI.TemplateOverrideKey]string, field.ErrorList) {
+	if npCfg.Spec.TemplateOverrides == nil {
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+	overridesPath := field.NewPath("spec").Child("templateOverrides")
+
+	if !allowTemplateOverrides {
+		return nil, append(
+			allErrs,
+			field.Forbidden(
+				overridesPath,
+				"template overrides are not allowed; they must be enabled in the configuration of NGINX Gateway Fabric",
+			),
+		)
+	}
+
+	refPath := overridesPath.Child("configMapRef")
+
+	ref := npCfg.Spec.TemplateOverrides.ConfigMapRef
+	cmNsName := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+
+	cm, exists := configMaps[cmNsName]
+	if !exists {
+		return nil, append(allErrs, field.NotFound(refPath, cmNsName.String()))
+	}
+
+	supportedKeys := []string{
+		string(ngfAPI.TemplateOverrideKeyServer),
+		string(ngfAPI.TemplateOverrideKeyUpstream),
+		string(ngfAPI.TemplateOverrideKeyLocation),
+	}
+
+	overrides := make(map[ngfAPI.TemplateOverrideKey]string, len(cm.Data))
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		if !slices.Contains(supportedKeys, key) {
+			allErrs = append(allErrs, field.NotSupported(refPath.Key(key), key, supportedKeys))
+			continue
+		}
+
+		if err := validator.ValidateTemplateOverride(key, cm.Data[key]); err != nil {
+			allErrs = append(allErrs, field.Invalid(refPath.Key(key), cmNsName.String(), err.Error()))
+			continue
+		}
+
+		overrides[ngfAPI.TemplateOverrideKey(key)] = cm.Data[key]
+	}
+
+	if len(allErrs) > 0 {
+		return nil, allErrs
+	}
+
+	return overrides, nil
+}
@@ -2,26 +2,32 @@ package graph
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
 )
 
 func TestGetNginxProxy(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		nps   map[types.NamespacedName]*ngfAPI.NginxProxy
-		gc    *v1.GatewayClass
-		expNP *NginxProxy
-		name  string
+		nps        map[types.NamespacedName]*ngfAPI.NginxProxy
+		configMaps map[types.NamespacedName]*apiv1.ConfigMap
+		gc         *v1.GatewayClass
+		expNP      *NginxProxy
+		name       string
 	}{
 		{
 			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
@@ -80,6 +86,118 @@ func TestGetNginxProxy(t *testing.T) {
 			},
 			name: "returns correct resource",
 		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np1"}: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "np1",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						TemplateOverrides: &ngfAPI.TemplateOverrides{
+							ConfigMapRef: ngfAPI.ConfigMapReference{Namespace: "test", Name: "templates"},
+						},
+					},
+				},
+			},
+			configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+				{Namespace: "test", Name: "templates"}: {
+					Data: map[string]string{
+						"location": "location {{ .Path }} {}",
+					},
+				},
+			},
+			gc: &v1.GatewayClass{
+				Spec: v1.GatewayClassSpec{
+					ParametersRef: &v1.ParametersReference{
+						Group: ngfAPI.GroupName,
+						Kind:  v1.Kind(kinds.NginxProxy),
+						Name:  "np1",
+					},
+				},
+			},
+			expNP: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "np1",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						IPFamily: helpers.GetPointer(ngfAPI.Dual),
+						TemplateOverrides: &ngfAPI.TemplateOverrides{
+							ConfigMapRef: ngfAPI.ConfigMapReference{Namespace: "test", Name: "templates"},
+						},
+					},
+				},
+				TemplateOverrides: map[ngfAPI.TemplateOverrideKey]string{
+					ngfAPI.TemplateOverrideKeyLocation: "location {{ .Path }} {}",
+				},
+				EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+					{Field: "templateOverrides", Source: "np1"},
+				},
+				Valid: true,
+			},
+			name: "returns resource with template overrides",
+		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np1"}: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "np1",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						BaseRef:   &ngfAPI.NginxProxyReference{Name: "base"},
+						IPFamily:  helpers.GetPointer(ngfAPI.IPv4),
+						Hardening: &ngfAPI.Hardening{SendTimeout: helpers.GetPointer[ngfAPI.Duration]("10s")},
+					},
+				},
+				{Name: "base"}: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "base",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						IPFamily: helpers.GetPointer(ngfAPI.IPv6),
+						Hardening: &ngfAPI.Hardening{
+							SendTimeout:       helpers.GetPointer[ngfAPI.Duration]("30s"),
+							ClientBodyTimeout: helpers.GetPointer[ngfAPI.Duration]("20s"),
+						},
+						EnableHTTP3: true,
+					},
+				},
+			},
+			gc: &v1.GatewayClass{
+				Spec: v1.GatewayClassSpec{
+					ParametersRef: &v1.ParametersReference{
+						Group: ngfAPI.GroupName,
+						Kind:  v1.Kind(kinds.NginxProxy),
+						Name:  "np1",
+					},
+				},
+			},
+			expNP: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "np1",
+					},
+					Spec: ngfAPI.NginxProxySpec{
+						BaseRef:  &ngfAPI.NginxProxyReference{Name: "base"},
+						IPFamily: helpers.GetPointer(ngfAPI.IPv4),
+						Hardening: &ngfAPI.Hardening{
+							SendTimeout:       helpers.GetPointer[ngfAPI.Duration]("10s"),
+							ClientBodyTimeout: helpers.GetPointer[ngfAPI.Duration]("20s"),
+						},
+						EnableHTTP3: true,
+					},
+				},
+				Bases: []string{"base"},
+				EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+					{Field: "enableHTTP3", Source: "base"},
+					{Field: "hardening.clientBodyTimeout", Source: "base"},
+					{Field: "hardening.sendTimeout", Source: "np1"},
+					{Field: "ipFamily", Source: "np1"},
+				},
+				Valid: true,
+			},
+			name: "returns resource merged with its base",
+		},
 	}
 
 	for _, test := range tests {
@@ -87,7 +205,13 @@ func TestGetNginxProxy(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			g.Expect(buildNginxProxy(test.nps, test.gc, &validationfakes.FakeGenericValidator{})).To(Equal(test.expNP))
+			g.Expect(buildNginxProxy(
+				test.nps,
+				test.gc,
+				test.configMaps,
+				&validationfakes.FakeGenericValidator{},
+				true,
+			)).To(Equal(test.expNP))
 		})
 	}
 }
@@ -244,6 +368,8 @@ func createInvalidValidator() *validationfakes.FakeGenericValidator {
 	v.ValidateEndpointReturns(errors.New("error"))
 	v.ValidateServiceNameReturns(errors.New("error"))
 	v.ValidateNginxDurationReturns(errors.New("error"))
+	v.ValidateNginxSizeReturns(errors.New("error"))
+	v.ValidateSSLCiphersReturns(errors.New("error"))
 
 	return v
 }
@@ -367,6 +493,190 @@ func TestValidateNginxProxy(t *testing.T) {
 			expErrSubstring: "spec.ipFamily",
 			expectErrCount:  1,
 		},
+		{
+			name:      "invalid hardening timeouts",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Hardening: &ngfAPI.Hardening{
+						ClientHeaderTimeout: helpers.GetPointer[ngfAPI.Duration]("header"),
+						ClientBodyTimeout:   helpers.GetPointer[ngfAPI.Duration]("body"),
+						SendTimeout:         helpers.GetPointer[ngfAPI.Duration]("send"),
+					}, // any value is invalid by the validator
+				},
+			},
+			expErrSubstring: "spec.hardening",
+			expectErrCount:  3,
+		},
+		{
+			name:      "invalid socket options keepalive timeouts",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					SocketOptions: &ngfAPI.SocketOptions{
+						Default: &ngfAPI.SocketSettings{
+							KeepAlive: &ngfAPI.TCPKeepAlive{
+								Enabled: true,
+								Idle:    helpers.GetPointer[ngfAPI.Duration]("idle"),
+							}, // any value is invalid by the validator
+						},
+						Listeners: []ngfAPI.ListenerSocketSettings{
+							{
+								Name: "http",
+								Settings: ngfAPI.SocketSettings{
+									KeepAlive: &ngfAPI.TCPKeepAlive{
+										Enabled:  true,
+										Interval: helpers.GetPointer[ngfAPI.Duration]("interval"),
+									}, // any value is invalid by the validator
+								},
+							},
+						},
+					},
+				},
+			},
+			expErrSubstring: "spec.socketOptions",
+			expectErrCount:  2,
+		},
+		{
+			name:      "socket options keepalive timeout in milliseconds",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					SocketOptions: &ngfAPI.SocketOptions{
+						Default: &ngfAPI.SocketSettings{
+							KeepAlive: &ngfAPI.TCPKeepAlive{
+								Enabled: true,
+								Idle:    helpers.GetPointer[ngfAPI.Duration]("500ms"),
+							},
+						},
+					},
+				},
+			},
+			expErrSubstring: "spec.socketOptions.default.keepAlive.idle",
+			expectErrCount:  1,
+		},
+		{
+			name:      "valid port mappings",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Worker: &ngfAPI.NginxWorker{
+						PortBinding: &ngfAPI.PortBinding{
+							PortMappings: []ngfAPI.PortMapping{
+								{ListenerPort: 80, ContainerPort: 8080},
+								{ListenerPort: 8443, ContainerPort: 8443},
+							},
+						},
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "duplicate port mappings",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Worker: &ngfAPI.NginxWorker{
+						PortBinding: &ngfAPI.PortBinding{
+							PortMappings: []ngfAPI.PortMapping{
+								{ListenerPort: 80, ContainerPort: 8080},
+								{ListenerPort: 80, ContainerPort: 9080},
+								{ListenerPort: 8080, ContainerPort: 8080},
+							},
+						},
+					},
+				},
+			},
+			expErrSubstring: "spec.worker.portBinding.portMappings[2].containerPort: Duplicate value: 8080",
+			expectErrCount:  2,
+		},
+		{
+			name:      "valid worker processes",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Worker: &ngfAPI.NginxWorker{
+						Processes:   helpers.GetPointer("auto"),
+						Connections: helpers.GetPointer[int32](4096),
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid worker processes",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Worker: &ngfAPI.NginxWorker{
+						Processes: helpers.GetPointer("4; daemon off"),
+					},
+				},
+			},
+			expErrSubstring: "spec.worker.processes",
+			expectErrCount:  1,
+		},
+		{
+			name:      "valid tls protocols",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TLS: &ngfAPI.NginxTLS{
+						Protocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv12, ngfAPI.TLSProtocolTLSv13},
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid tls protocols",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TLS: &ngfAPI.NginxTLS{
+						Protocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13, "TLSv1; ssl_ciphers NULL"},
+					},
+				},
+			},
+			expErrSubstring: "spec.tls.protocols[1]",
+			expectErrCount:  1,
+		},
+		{
+			name:      "valid tls ciphers and session",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TLS: &ngfAPI.NginxTLS{
+						Ciphers:             helpers.GetPointer("ECDHE-ECDSA-AES256-GCM-SHA384"),
+						PreferServerCiphers: helpers.GetPointer(true),
+						Session: &ngfAPI.TLSSession{
+							CacheSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+							Timeout:   helpers.GetPointer[ngfAPI.Duration]("1h"),
+							Tickets:   helpers.GetPointer(false),
+						},
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid tls ciphers and session",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					TLS: &ngfAPI.NginxTLS{
+						Ciphers: helpers.GetPointer("HIGH; ssl_protocols TLSv1"),
+						Session: &ngfAPI.TLSSession{
+							CacheSize: helpers.GetPointer[ngfAPI.Size]("10M"),
+							Timeout:   helpers.GetPointer[ngfAPI.Duration]("1d"),
+						},
+					},
+				},
+			},
+			expErrSubstring: "spec.tls.ciphers",
+			expectErrCount:  3,
+		},
 	}
 
 	for _, test := range tests {
@@ -774,86 +1084,351 @@ func TestValidateLogging(t *testing.T) {
 	}
 }
 
-func TestValidateNginxPlus(t *testing.T) {
+func TestValidateAccessLog(t *testing.T) {
 	t.Parallel()
 
+	createNginxProxy := func(accessLog *ngfAPI.NginxAccessLog) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				Logging: &ngfAPI.NginxLogging{AccessLog: accessLog},
+			},
+		}
+	}
+
 	tests := []struct {
-		np             *ngfAPI.NginxProxy
-		name           string
-		errorString    string
-		expectErrCount int
+		np          *ngfAPI.NginxProxy
+		name        string
+		errorString string
 	}{
 		{
-			np: &ngfAPI.NginxProxy{
-				Spec: ngfAPI.NginxProxySpec{
-					NginxPlus: &ngfAPI.NginxPlus{
-						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
-							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "2001:db8:a0b:12f0::1"},
-							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "2001:db8:a0b:12f0::1/32"},
-							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
-							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "127.0.0.3/32"},
-						},
+			name: "valid",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Formats: []ngfAPI.NginxLogFormat{
+					{
+						Name:   "json",
+						Format: `{"remote_addr": "$remote_addr", "status": "$status"}`,
+						Escape: helpers.GetPointer(ngfAPI.NginxLogFormatEscapeJSON),
 					},
 				},
-			},
-			name:           "valid NginxPlus",
-			errorString:    "",
-			expectErrCount: 0,
-		},
-		{
-			np: &ngfAPI.NginxProxy{
-				Spec: ngfAPI.NginxProxySpec{
-					NginxPlus: &ngfAPI.NginxPlus{
-						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
-							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "2001:db8:a0b:12f0::1/32"},
-							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "127.0.0.3/37"},
+				Default: &ngfAPI.AccessLogSettings{
+					Format: helpers.GetPointer("json"),
+					Destination: &ngfAPI.AccessLogDestination{
+						Type: ngfAPI.AccessLogDestinationSyslog,
+						Syslog: &ngfAPI.SyslogDestination{
+							Server: "[::1]:514",
+							Tag:    helpers.GetPointer("nginx_gateway"),
 						},
 					},
 				},
-			},
-			name: "invalid CIDR in AllowedAddresses",
-			errorString: "spec.nginxPlus.value: Invalid value: \"127.0.0.3/37\": must be a valid CIDR value, " +
-				"(e.g. 10.9.8.0/24 or 2001:db8::/64)",
-			expectErrCount: 1,
-		},
-		{
-			np: &ngfAPI.NginxProxy{
-				Spec: ngfAPI.NginxProxySpec{
-					NginxPlus: &ngfAPI.NginxPlus{
-						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
-							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
-							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3.5/32"},
+				Listeners: []ngfAPI.ListenerAccessLog{
+					{
+						Name: "http",
+						Settings: ngfAPI.AccessLogSettings{
+							Format: helpers.GetPointer("combined"),
+							Destination: &ngfAPI.AccessLogDestination{
+								Type: ngfAPI.AccessLogDestinationFile,
+								File: helpers.GetPointer("/var/log/nginx/http.log"),
+							},
 						},
 					},
+					{
+						Name:     "https",
+						Settings: ngfAPI.AccessLogSettings{Disable: helpers.GetPointer(true)},
+					},
 				},
-			},
-			name: "invalid IP address in AllowedAddresses",
-			errorString: "spec.nginxPlus.value: Invalid value: \"127.0.0.3.5/32\": must be a valid IP address, " +
-				"(e.g. 10.9.8.7 or 2001:db8::ffff)",
-			expectErrCount: 1,
+			}),
 		},
 		{
-			np: &ngfAPI.NginxProxy{
-				Spec: ngfAPI.NginxProxySpec{
-					NginxPlus: &ngfAPI.NginxPlus{
-						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
-							{Type: ngfAPI.NginxPlusAllowAddressType("Hostname"), Value: "example.com"},
-						},
-					},
+			name: "no access log",
+			np:   createNginxProxy(nil),
+		},
+		{
+			name: "invalid formats",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Formats: []ngfAPI.NginxLogFormat{
+					{Name: "combined", Format: "$status"},
+					{Name: "main", Format: `$status'; return 200 '`},
+					{Name: "main", Format: "$status", Escape: helpers.GetPointer[ngfAPI.NginxLogFormatEscape]("xml")},
+					{Name: "main format", Format: "$status"},
 				},
-			},
-			name: "hostname type in AllowedAddresses",
-			errorString: "spec.nginxPlus.type: Unsupported value: \"Hostname\": supported " +
-				"values: \"CIDR\", \"IPAddress\"",
-			expectErrCount: 1,
+			}),
+			errorString: "[spec.logging.accessLog.formats[0].name: Invalid value: \"combined\": name is reserved, " +
+				"spec.logging.accessLog.formats[1].format: Invalid value: \"$status'; return 200 '\": " +
+				"must be non-empty and must not contain the ' or \\ characters, " +
+				"spec.logging.accessLog.formats[2].name: Duplicate value: \"main\", " +
+				"spec.logging.accessLog.formats[2].escape: Unsupported value: \"xml\": " +
+				"supported values: \"Default\", \"JSON\", \"None\", " +
+				"spec.logging.accessLog.formats[3].name: Invalid value: \"main format\": " +
+				"must contain 1 to 64 alphanumeric, '_', or '-' characters]",
 		},
 		{
-			np: &ngfAPI.NginxProxy{
-				Spec: ngfAPI.NginxProxySpec{
-					NginxPlus: &ngfAPI.NginxPlus{
-						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
-							{Type: ngfAPI.NginxPlusAllowAddressType("invalid"), Value: "example.com"},
-						},
+			name: "unknown format",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{Format: helpers.GetPointer("main")},
+			}),
+			errorString: "spec.logging.accessLog.default.format: Not found: \"main\"",
+		},
+		{
+			name: "invalid destinations",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Destination: &ngfAPI.AccessLogDestination{
+						Type: ngfAPI.AccessLogDestinationFile,
+						File: helpers.GetPointer("/var/log/nginx/access.log; return 200"),
+					},
+				},
+				Listeners: []ngfAPI.ListenerAccessLog{
+					{
+						Name: "http",
+						Settings: ngfAPI.AccessLogSettings{
+							Destination: &ngfAPI.AccessLogDestination{
+								Type: ngfAPI.AccessLogDestinationSyslog,
+								Syslog: &ngfAPI.SyslogDestination{
+									Server: "syslog.example.com,tag=nginx",
+									Tag:    helpers.GetPointer("nginx-gateway"),
+								},
+							},
+						},
+					},
+					{
+						Name: "https",
+						Settings: ngfAPI.AccessLogSettings{
+							Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationSyslog},
+						},
+					},
+					{
+						Name: "tls",
+						Settings: ngfAPI.AccessLogSettings{
+							Destination: &ngfAPI.AccessLogDestination{Type: "Kafka"},
+						},
+					},
+				},
+			}),
+			errorString: "[spec.logging.accessLog.default.destination.file: Invalid value: " +
+				"\"/var/log/nginx/access.log; return 200\": must be an absolute path without whitespace, " +
+				"';', '{', '}', ''', '\"', '$', or '\\' characters, " +
+				"spec.logging.accessLog.listeners[0].settings.destination.syslog.server: Invalid value: " +
+				"\"syslog.example.com,tag=nginx\": must be a hostname or an IP address with an optional port, " +
+				"for example, \"syslog.example.com:514\", " +
+				"spec.logging.accessLog.listeners[0].settings.destination.syslog.tag: Invalid value: " +
+				"\"nginx-gateway\": must contain 1 to 32 alphanumeric or '_' characters, " +
+				"spec.logging.accessLog.listeners[1].settings.destination.syslog: Required value: " +
+				"syslog is required when type is Syslog, " +
+				"spec.logging.accessLog.listeners[2].settings.destination.type: Unsupported value: \"Kafka\": " +
+				"supported values: \"Stdout\", \"Stderr\", \"File\", \"Syslog\"]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateAccessLog(test.np)
+			if test.errorString == "" {
+				g.Expect(allErrs).To(BeEmpty())
+			} else {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestSetNginxProxyDefaults(t *testing.T) {
+	t.Parallel()
+
+	createNginxProxy := func(accessLog *ngfAPI.NginxAccessLog) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				Logging: &ngfAPI.NginxLogging{AccessLog: accessLog},
+			},
+		}
+	}
+
+	tests := []struct {
+		np    *ngfAPI.NginxProxy
+		expNp *ngfAPI.NginxProxy
+		name  string
+	}{
+		{
+			name:  "no logging",
+			np:    &ngfAPI.NginxProxy{},
+			expNp: &ngfAPI.NginxProxy{},
+		},
+		{
+			name:  "no access log",
+			np:    createNginxProxy(nil),
+			expNp: createNginxProxy(nil),
+		},
+		{
+			name: "empty access log",
+			np:   createNginxProxy(&ngfAPI.NginxAccessLog{}),
+			expNp: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Format:      helpers.GetPointer(DefaultAccessLogFormat),
+					Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStdout},
+				},
+			}),
+		},
+		{
+			name: "unset settings and escapes are defaulted",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Disable: helpers.GetPointer(false),
+				},
+				Formats: []ngfAPI.NginxLogFormat{
+					{Name: "main", Format: "$remote_addr"},
+					{Name: "json", Format: `{"addr":"$remote_addr"}`},
+				},
+				Listeners: []ngfAPI.ListenerAccessLog{
+					{Name: "http"},
+				},
+			}),
+			expNp: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Format:      helpers.GetPointer(DefaultAccessLogFormat),
+					Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStdout},
+					Disable:     helpers.GetPointer(false),
+				},
+				Formats: []ngfAPI.NginxLogFormat{
+					{
+						Name:   "main",
+						Format: "$remote_addr",
+						Escape: helpers.GetPointer(ngfAPI.NginxLogFormatEscapeDefault),
+					},
+					{
+						Name:   "json",
+						Format: `{"addr":"$remote_addr"}`,
+						Escape: helpers.GetPointer(ngfAPI.NginxLogFormatEscapeDefault),
+					},
+				},
+				Listeners: []ngfAPI.ListenerAccessLog{
+					{Name: "http"},
+				},
+			}),
+		},
+		{
+			name: "set settings and escapes are not changed",
+			np: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Format:      helpers.GetPointer("main"),
+					Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStderr},
+				},
+				Formats: []ngfAPI.NginxLogFormat{
+					{
+						Name:   "main",
+						Format: "$remote_addr",
+						Escape: helpers.GetPointer(ngfAPI.NginxLogFormatEscapeJSON),
+					},
+				},
+			}),
+			expNp: createNginxProxy(&ngfAPI.NginxAccessLog{
+				Default: &ngfAPI.AccessLogSettings{
+					Format:      helpers.GetPointer("main"),
+					Destination: &ngfAPI.AccessLogDestination{Type: ngfAPI.AccessLogDestinationStderr},
+				},
+				Formats: []ngfAPI.NginxLogFormat{
+					{
+						Name:   "main",
+						Format: "$remote_addr",
+						Escape: helpers.GetPointer(ngfAPI.NginxLogFormatEscapeJSON),
+					},
+				},
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			SetNginxProxyDefaults(test.np)
+			g.Expect(test.np).To(Equal(test.expNp))
+		})
+	}
+}
+
+func TestValidateNginxPlus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np             *ngfAPI.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					NginxPlus: &ngfAPI.NginxPlus{
+						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "2001:db8:a0b:12f0::1"},
+							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "2001:db8:a0b:12f0::1/32"},
+							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
+							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "127.0.0.3/32"},
+						},
+					},
+				},
+			},
+			name:           "valid NginxPlus",
+			errorString:    "",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					NginxPlus: &ngfAPI.NginxPlus{
+						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "2001:db8:a0b:12f0::1/32"},
+							{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "127.0.0.3/37"},
+						},
+					},
+				},
+			},
+			name: "invalid CIDR in AllowedAddresses",
+			errorString: "spec.nginxPlus.value: Invalid value: \"127.0.0.3/37\": must be a valid CIDR value, " +
+				"(e.g. 10.9.8.0/24 or 2001:db8::/64)",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					NginxPlus: &ngfAPI.NginxPlus{
+						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3"},
+							{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "127.0.0.3.5/32"},
+						},
+					},
+				},
+			},
+			name: "invalid IP address in AllowedAddresses",
+			errorString: "spec.nginxPlus.value: Invalid value: \"127.0.0.3.5/32\": must be a valid IP address, " +
+				"(e.g. 10.9.8.7 or 2001:db8::ffff)",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					NginxPlus: &ngfAPI.NginxPlus{
+						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+							{Type: ngfAPI.NginxPlusAllowAddressType("Hostname"), Value: "example.com"},
+						},
+					},
+				},
+			},
+			name: "hostname type in AllowedAddresses",
+			errorString: "spec.nginxPlus.type: Unsupported value: \"Hostname\": supported " +
+				"values: \"CIDR\", \"IPAddress\"",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					NginxPlus: &ngfAPI.NginxPlus{
+						AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+							{Type: ngfAPI.NginxPlusAllowAddressType("invalid"), Value: "example.com"},
+						},
 					},
 				},
 			},
@@ -877,3 +1452,1075 @@ func TestValidateNginxPlus(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status         *ngfAPI.NginxStatus
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name: "no status endpoint",
+		},
+		{
+			name: "valid status endpoint",
+			status: &ngfAPI.NginxStatus{
+				Port: 8080,
+				AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+					{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "10.0.0.0/8"},
+					{Type: ngfAPI.NginxPlusAllowIPAddressType, Value: "fd00::10"},
+				},
+			},
+		},
+		{
+			name:   "no allowed addresses",
+			status: &ngfAPI.NginxStatus{Port: 8080},
+			errorString: "spec.status.allowedAddresses: Required value: " +
+				"at least one address is required",
+			expectErrCount: 1,
+		},
+		{
+			name: "invalid port and address",
+			status: &ngfAPI.NginxStatus{
+				Port: 0,
+				AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+					{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "10.0.0.0/33"},
+				},
+			},
+			errorString: "[spec.status.port: Invalid value: 0: must be between 1-65535, " +
+				"spec.status.allowedAddresses.value: Invalid value: \"10.0.0.0/33\": must be a valid CIDR value, " +
+				"(e.g. 10.9.8.0/24 or 2001:db8::/64)]",
+			expectErrCount: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{Spec: ngfAPI.NginxProxySpec{Status: test.status}}
+
+			allErrs := validateStatus(np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		resolver       *ngfAPI.NginxResolver
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:      "no resolver",
+			validator: createValidValidator(),
+		},
+		{
+			name:      "valid resolver",
+			validator: createValidValidator(),
+			resolver: &ngfAPI.NginxResolver{
+				Addresses: []string{"10.96.0.10", "10.96.0.11:5353", "fd00::10", "[fd00::11]:53"},
+				Valid:     helpers.GetPointer[ngfAPI.Duration]("30s"),
+				IPv6:      helpers.GetPointer(false),
+			},
+		},
+		{
+			name:           "no addresses",
+			validator:      createValidValidator(),
+			resolver:       &ngfAPI.NginxResolver{},
+			errorString:    "spec.resolver.addresses: Required value: at least one address is required",
+			expectErrCount: 1,
+		},
+		{
+			name:      "invalid addresses",
+			validator: createValidValidator(),
+			resolver: &ngfAPI.NginxResolver{
+				Addresses: []string{"kube-dns.kube-system", "10.96.0.10:0", "fe80::1%eth0", "10.96.0.10 valid=1s"},
+			},
+			expectErrCount: 4,
+		},
+		{
+			name:      "invalid valid",
+			validator: createInvalidValidator(),
+			resolver: &ngfAPI.NginxResolver{
+				Addresses: []string{"10.96.0.10"},
+				Valid:     helpers.GetPointer[ngfAPI.Duration]("1d"),
+			},
+			errorString:    `spec.resolver.valid: Invalid value: "1d": error`,
+			expectErrCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{Spec: ngfAPI.NginxProxySpec{Resolver: test.resolver}}
+
+			allErrs := validateResolver(test.validator, np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateHTTP2Settings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		http2          *ngfAPI.HTTP2Settings
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:      "no settings",
+			validator: createInvalidValidator(),
+		},
+		{
+			name:      "valid settings",
+			validator: createValidValidator(),
+			http2: &ngfAPI.HTTP2Settings{
+				MaxConcurrentStreams: helpers.GetPointer[int32](512),
+				RecvBufferSize:       helpers.GetPointer[ngfAPI.Size]("512k"),
+				ChunkSize:            helpers.GetPointer[ngfAPI.Size]("16k"),
+				BodyPrereadSize:      helpers.GetPointer[ngfAPI.Size]("128k"),
+			},
+		},
+		{
+			name:      "invalid sizes",
+			validator: createInvalidValidator(),
+			http2: &ngfAPI.HTTP2Settings{
+				MaxConcurrentStreams: helpers.GetPointer[int32](512),
+				RecvBufferSize:       helpers.GetPointer[ngfAPI.Size]("512K"),
+				BodyPrereadSize:      helpers.GetPointer[ngfAPI.Size]("1t"),
+			},
+			errorString: `[spec.http2.recvBufferSize: Invalid value: "512K": error, ` +
+				`spec.http2.bodyPrereadSize: Invalid value: "1t": error]`,
+			expectErrCount: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{Spec: ngfAPI.NginxProxySpec{HTTP2: test.http2}}
+
+			allErrs := validateHTTP2Settings(test.validator, np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestResolveTemplateOverrides(t *testing.T) {
+	t.Parallel()
+
+	cmNsName := types.NamespacedName{Namespace: "test", Name: "templates"}
+
+	createNP := func() *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				TemplateOverrides: &ngfAPI.TemplateOverrides{
+					ConfigMapRef: ngfAPI.ConfigMapReference{
+						Namespace: cmNsName.Namespace,
+						Name:      cmNsName.Name,
+					},
+				},
+			},
+		}
+	}
+
+	validator := &validationfakes.FakeGenericValidator{
+		ValidateTemplateOverrideStub: func(_, text string) error {
+			if text == "invalid-template" {
+				return errors.New("error")
+			}
+
+			return nil
+		},
+	}
+
+	tests := []struct {
+		np           *ngfAPI.NginxProxy
+		configMaps   map[types.NamespacedName]*apiv1.ConfigMap
+		expOverrides map[ngfAPI.TemplateOverrideKey]string
+		name         string
+		errorString  string
+		notAllowed   bool
+	}{
+		{
+			np:   &ngfAPI.NginxProxy{},
+			name: "no template overrides",
+		},
+		{
+			np:         &ngfAPI.NginxProxy{},
+			name:       "no template overrides when not allowed",
+			notAllowed: true,
+		},
+		{
+			np:         createNP(),
+			name:       "template overrides not allowed",
+			notAllowed: true,
+			errorString: "spec.templateOverrides: Forbidden: template overrides are not allowed; " +
+				"they must be enabled in the configuration of NGINX Gateway Fabric",
+		},
+		{
+			np:          createNP(),
+			name:        "configmap does not exist",
+			errorString: "spec.templateOverrides.configMapRef: Not found: \"test/templates\"",
+		},
+		{
+			np: createNP(),
+			configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+				cmNsName: {
+					Data: map[string]string{
+						"server":   "server-template",
+						"upstream": "upstream-template",
+						"location": "location-template",
+					},
+				},
+			},
+			expOverrides: map[ngfAPI.TemplateOverrideKey]string{
+				ngfAPI.TemplateOverrideKeyServer:   "server-template",
+				ngfAPI.TemplateOverrideKeyUpstream: "upstream-template",
+				ngfAPI.TemplateOverrideKeyLocation: "location-template",
+			},
+			name: "all supported keys",
+		},
+		{
+			np: createNP(),
+			configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+				cmNsName: {
+					Data: map[string]string{
+						"server": "server-template",
+						"main":   "main-template",
+					},
+				},
+			},
+			name: "unsupported key",
+			errorString: "spec.templateOverrides.configMapRef[main]: Unsupported value: \"main\": supported " +
+				"values: \"server\", \"upstream\", \"location\"",
+		},
+		{
+			np: createNP(),
+			configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+				cmNsName: {
+					Data: map[string]string{
+						"server":   "server-template",
+						"location": "invalid-template",
+					},
+				},
+			},
+			name:        "invalid template",
+			errorString: "spec.templateOverrides.configMapRef[location]: Invalid value: \"test/templates\": error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			overrides, errs := resolveTemplateOverrides(test.np, test.configMaps, validator, !test.notAllowed)
+			g.Expect(overrides).To(Equal(test.expOverrides))
+			if test.errorString != "" {
+				g.Expect(errs.ToAggregate().Error()).To(Equal(test.errorString))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestBuildGatewayNginxProxies(t *testing.T) {
+	t.Parallel()
+
+	createGateway := func(name string, ref *v1.LocalParametersReference) *Gateway {
+		gw := &Gateway{
+			Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
+			Valid:  true,
+		}
+
+		if ref != nil {
+			gw.Source.Spec.Infrastructure = &v1.GatewayInfrastructure{ParametersRef: ref}
+		}
+
+		return gw
+	}
+
+	npRef := func(name string) *v1.LocalParametersReference {
+		return &v1.LocalParametersReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.NginxProxy,
+			Name:  name,
+		}
+	}
+
+	validNp := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+		Spec: ngfAPI.NginxProxySpec{
+			DisableHTTP2: true,
+		},
+	}
+	invalidNp := &ngfAPI.NginxProxy{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec: ngfAPI.NginxProxySpec{
+			IPFamily: helpers.GetPointer[ngfAPI.IPFamilyType]("ipv5"),
+		},
+	}
+
+	nps := map[types.NamespacedName]*ngfAPI.NginxProxy{
+		{Name: "valid"}:   validNp,
+		{Name: "invalid"}: invalidNp,
+	}
+
+	tests := []struct {
+		gw               *Gateway
+		mergedGws        map[types.NamespacedName]*Gateway
+		expNginxProxy    *NginxProxy
+		expMergedConds   []conditions.Condition
+		name             string
+		expConds         []conditions.Condition
+		expMergedNpValid bool
+	}{
+		{
+			gw:   createGateway("gw", nil),
+			name: "no parametersRef",
+		},
+		{
+			gw: createGateway("gw", &v1.LocalParametersReference{
+				Group: "",
+				Kind:  "ConfigMap",
+				Name:  "config",
+			}),
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayInvalidParameters(
+					`spec.infrastructure.parametersRef.kind: Unsupported value: "/ConfigMap": ` +
+						`supported values: "gateway.nginx.org/NginxProxy"`,
+				),
+			},
+			name: "unsupported kind",
+		},
+		{
+			gw: createGateway("gw", npRef("missing")),
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayRefNotFound(),
+				staticConds.NewGatewayInvalidParameters(
+					`spec.infrastructure.parametersRef.name: Not found: "missing"`,
+				),
+			},
+			name: "NginxProxy doesn't exist",
+		},
+		{
+			gw: createGateway("gw", npRef("invalid")),
+			expNginxProxy: &NginxProxy{
+				Source: invalidNp,
+				EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+					{Field: "ipFamily", Source: "invalid"},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewNginxProxyInvalid(
+						`spec.ipFamily: Unsupported value: "ipv5": supported values: "dual", "ipv4", "ipv6"`,
+					),
+				},
+				ErrMsgs: field.ErrorList{
+					field.NotSupported(
+						field.NewPath("spec", "ipFamily"),
+						invalidNp.Spec.IPFamily,
+						[]string{string(ngfAPI.Dual), string(ngfAPI.IPv4), string(ngfAPI.IPv6)},
+					),
+				},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayInvalidParameters(
+					`spec.ipFamily: Unsupported value: "ipv5": supported values: "dual", "ipv4", "ipv6"`,
+				),
+			},
+			name: "invalid NginxProxy",
+		},
+		{
+			gw: createGateway("gw", npRef("valid")),
+			mergedGws: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "merged"}: createGateway("merged", npRef("valid")),
+			},
+			expNginxProxy: &NginxProxy{
+				Source: validNp,
+				EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+					{Field: "disableHTTP2", Source: "valid"},
+				},
+				Valid: true,
+			},
+			expConds:         []conditions.Condition{staticConds.NewGatewayResolvedRefs()},
+			expMergedConds:   []conditions.Condition{staticConds.NewGatewayResolvedRefs()},
+			expMergedNpValid: true,
+			name:             "merged Gateway references the same NginxProxy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			buildGatewayNginxProxies(test.gw, test.mergedGws, nps, nil, createValidValidator(), false)
+
+			g.Expect(test.gw.NginxProxy).To(Equal(test.expNginxProxy))
+			g.Expect(test.gw.Conditions).To(Equal(test.expConds))
+
+			for _, mergedGw := range test.mergedGws {
+				g.Expect(mergedGw.NginxProxy.Valid).To(Equal(test.expMergedNpValid))
+				g.Expect(mergedGw.Conditions).To(Equal(test.expMergedConds))
+			}
+
+			// the validation must not default the IP family of the referenced NginxProxy
+			g.Expect(validNp.Spec.IPFamily).To(BeNil())
+		})
+	}
+}
+
+func TestBuildEffectiveNginxProxy(t *testing.T) {
+	t.Parallel()
+
+	gcNpCfg := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "gc-np"},
+			Spec: ngfAPI.NginxProxySpec{
+				IPFamily: helpers.GetPointer(ngfAPI.IPv4),
+				Telemetry: &ngfAPI.Telemetry{
+					ServiceName: helpers.GetPointer("gc-service"),
+				},
+				Logging: &ngfAPI.NginxLogging{
+					ErrorLevel: helpers.GetPointer(ngfAPI.NginxLogLevelDebug),
+				},
+				TemplateOverrides: &ngfAPI.TemplateOverrides{
+					ConfigMapRef: ngfAPI.ConfigMapReference{Namespace: "test", Name: "templates"},
+				},
+				HashTables: &ngfAPI.HashTables{
+					ServerNamesBucketSize: helpers.GetPointer[int32](512),
+				},
+				SocketOptions: &ngfAPI.SocketOptions{
+					Default: &ngfAPI.SocketSettings{ReusePort: helpers.GetPointer(true)},
+				},
+				HTTPMatchMode:      helpers.GetPointer(ngfAPI.HTTPMatchModeNative),
+				AddressPublication: helpers.GetPointer(ngfAPI.AddressPublicationPreferHostname),
+				TLSPassthrough:     &ngfAPI.TLSPassthrough{SendProxyProtocol: helpers.GetPointer(true)},
+				TLS:                &ngfAPI.NginxTLS{Protocols: []ngfAPI.TLSProtocolType{ngfAPI.TLSProtocolTLSv13}},
+				Resolver:           &ngfAPI.NginxResolver{Addresses: []string{"10.96.0.10"}},
+				HTTP2:              &ngfAPI.HTTP2Settings{MaxConcurrentStreams: helpers.GetPointer[int32](512)},
+				Status: &ngfAPI.NginxStatus{
+					Port: 8080,
+					AllowedAddresses: []ngfAPI.NginxPlusAllowAddress{
+						{Type: ngfAPI.NginxPlusAllowCIDRAddressType, Value: "10.0.0.0/8"},
+					},
+				},
+				Hardening: &ngfAPI.Hardening{
+					ClientHeaderTimeout:     helpers.GetPointer[ngfAPI.Duration]("5s"),
+					SendTimeout:             helpers.GetPointer[ngfAPI.Duration]("20s"),
+					ResetTimedOutConnection: helpers.GetPointer(false),
+				},
+				DisableHTTP2:          true,
+				EnableHTTP3:           true,
+				DisableRegexPathMatch: true,
+			},
+		},
+		TemplateOverrides: map[ngfAPI.TemplateOverrideKey]string{
+			ngfAPI.TemplateOverrideKeyServer: "server-template",
+		},
+		Valid: true,
+	}
+
+	gwNpCfg := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+			Spec: ngfAPI.NginxProxySpec{
+				Telemetry: &ngfAPI.Telemetry{
+					ServiceName: helpers.GetPointer("gw-service"),
+				},
+				RewriteClientIP: &ngfAPI.RewriteClientIP{
+					Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeProxyProtocol),
+				},
+				Hardening: &ngfAPI.Hardening{
+					ClientHeaderTimeout: helpers.GetPointer[ngfAPI.Duration]("3s"),
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	invalidNpCfg := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{ObjectMeta: metav1.ObjectMeta{Name: "invalid"}},
+	}
+
+	tests := []struct {
+		gcNpCfg *NginxProxy
+		gw      *Gateway
+		expNp   *NginxProxy
+		name    string
+	}{
+		{
+			gcNpCfg: gcNpCfg,
+			gw:      nil,
+			expNp:   gcNpCfg,
+			name:    "nil gateway",
+		},
+		{
+			gcNpCfg: gcNpCfg,
+			gw:      &Gateway{},
+			expNp:   gcNpCfg,
+			name:    "gateway doesn't reference an NginxProxy",
+		},
+		{
+			gcNpCfg: gcNpCfg,
+			gw:      &Gateway{NginxProxy: invalidNpCfg},
+			expNp:   gcNpCfg,
+			name:    "gateway NginxProxy is invalid",
+		},
+		{
+			gcNpCfg: nil,
+			gw:      &Gateway{NginxProxy: gwNpCfg},
+			expNp: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+					Spec: ngfAPI.NginxProxySpec{
+						IPFamily:        helpers.GetPointer(ngfAPI.Dual),
+						Telemetry:       gwNpCfg.Source.Spec.Telemetry,
+						RewriteClientIP: gwNpCfg.Source.Spec.RewriteClientIP,
+						Hardening:       gwNpCfg.Source.Spec.Hardening,
+					},
+				},
+				Valid: true,
+			},
+			name: "no gatewayclass NginxProxy",
+		},
+		{
+			gcNpCfg: invalidNpCfg,
+			gw:      &Gateway{NginxProxy: gwNpCfg},
+			expNp: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+					Spec: ngfAPI.NginxProxySpec{
+						IPFamily:        helpers.GetPointer(ngfAPI.Dual),
+						Telemetry:       gwNpCfg.Source.Spec.Telemetry,
+						RewriteClientIP: gwNpCfg.Source.Spec.RewriteClientIP,
+						Hardening:       gwNpCfg.Source.Spec.Hardening,
+					},
+				},
+				Valid: true,
+			},
+			name: "invalid gatewayclass NginxProxy",
+		},
+		{
+			gcNpCfg: gcNpCfg,
+			gw:      &Gateway{NginxProxy: gwNpCfg},
+			expNp: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+					Spec: ngfAPI.NginxProxySpec{
+						IPFamily:           gcNpCfg.Source.Spec.IPFamily,
+						Telemetry:          gwNpCfg.Source.Spec.Telemetry,
+						RewriteClientIP:    gwNpCfg.Source.Spec.RewriteClientIP,
+						Logging:            gcNpCfg.Source.Spec.Logging,
+						TemplateOverrides:  gcNpCfg.Source.Spec.TemplateOverrides,
+						HashTables:         gcNpCfg.Source.Spec.HashTables,
+						HTTPMatchMode:      gcNpCfg.Source.Spec.HTTPMatchMode,
+						SocketOptions:      gcNpCfg.Source.Spec.SocketOptions,
+						AddressPublication: gcNpCfg.Source.Spec.AddressPublication,
+						TLSPassthrough:     gcNpCfg.Source.Spec.TLSPassthrough,
+						TLS:                gcNpCfg.Source.Spec.TLS,
+						Resolver:           gcNpCfg.Source.Spec.Resolver,
+						HTTP2:              gcNpCfg.Source.Spec.HTTP2,
+						Status:             gcNpCfg.Source.Spec.Status,
+						Hardening: &ngfAPI.Hardening{
+							ClientHeaderTimeout:     helpers.GetPointer[ngfAPI.Duration]("3s"),
+							SendTimeout:             helpers.GetPointer[ngfAPI.Duration]("20s"),
+							ResetTimedOutConnection: helpers.GetPointer(false),
+						},
+						DisableHTTP2:          true,
+						EnableHTTP3:           true,
+						DisableRegexPathMatch: true,
+					},
+				},
+				TemplateOverrides: gcNpCfg.TemplateOverrides,
+				Valid:             true,
+			},
+			name: "gateway NginxProxy overrides gatewayclass NginxProxy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildEffectiveNginxProxy(test.gcNpCfg, test.gw)).To(Equal(test.expNp))
+		})
+	}
+}
+
+func TestBuildMergedGatewayEffectiveNginxProxies(t *testing.T) {
+	t.Parallel()
+
+	dataPlaneNp := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-plane"},
+			Spec: ngfAPI.NginxProxySpec{
+				IPFamily: helpers.GetPointer(ngfAPI.Dual),
+				Telemetry: &ngfAPI.Telemetry{
+					ServiceName: helpers.GetPointer("data-plane-service"),
+				},
+				RewriteClientIP: &ngfAPI.RewriteClientIP{
+					Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeXForwardedFor),
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	newMergedGateway := func(spec ngfAPI.NginxProxySpec) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "merged"}},
+			NginxProxy: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+					Spec:       spec,
+				},
+				Valid: true,
+			},
+			Valid: true,
+		}
+	}
+
+	newEffectiveNp := func(spec ngfAPI.NginxProxySpec) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				ObjectMeta: metav1.ObjectMeta{Name: "gw-np"},
+				Spec:       spec,
+			},
+			Valid: true,
+		}
+	}
+
+	winner := &Gateway{Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "winner"}}}
+
+	ignoredMsg := func(settings string) string {
+		return "The settings " + settings + " of NginxProxy gw-np are ignored, because the Gateway shares the NGINX " +
+			"configuration with Gateway test/winner, which configures them differently; only the settings ipFamily, " +
+			"rewriteClientIP can be set per Gateway, and rewriteClientIP must enable the PROXY protocol like " +
+			"the shared configuration"
+	}
+
+	xffRewriteClientIP := &ngfAPI.RewriteClientIP{
+		Mode:             helpers.GetPointer(ngfAPI.RewriteClientIPModeXForwardedFor),
+		TrustedAddresses: []ngfAPI.RewriteClientIPAddress{{Type: ngfAPI.RewriteClientIPCIDRAddressType, Value: "10.0.0.0/8"}},
+	}
+
+	tests := []struct {
+		npCfg    *NginxProxy
+		mergedGw *Gateway
+		expNp    *NginxProxy
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name:     "merged Gateway doesn't reference an NginxProxy",
+			npCfg:    dataPlaneNp,
+			mergedGw: &Gateway{Source: &v1.Gateway{}, Valid: true},
+		},
+		{
+			name:  "merged Gateway references an invalid NginxProxy",
+			npCfg: dataPlaneNp,
+			mergedGw: &Gateway{
+				Source:     &v1.Gateway{},
+				NginxProxy: &NginxProxy{Source: &ngfAPI.NginxProxy{}},
+				Valid:      true,
+			},
+		},
+		{
+			name:  "merged Gateway overrides the per-Gateway settings",
+			npCfg: dataPlaneNp,
+			mergedGw: newMergedGateway(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.IPv6),
+				RewriteClientIP: xffRewriteClientIP,
+				Telemetry:       dataPlaneNp.Source.Spec.Telemetry,
+			}),
+			expNp: newEffectiveNp(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.IPv6),
+				Telemetry:       dataPlaneNp.Source.Spec.Telemetry,
+				RewriteClientIP: xffRewriteClientIP,
+			}),
+		},
+		{
+			name:  "merged Gateway sets the shared settings differently",
+			npCfg: dataPlaneNp,
+			mergedGw: newMergedGateway(ngfAPI.NginxProxySpec{
+				IPFamily: helpers.GetPointer(ngfAPI.IPv4),
+				Telemetry: &ngfAPI.Telemetry{
+					ServiceName: helpers.GetPointer("gw-service"),
+				},
+				DisableHTTP2: true,
+			}),
+			expNp: newEffectiveNp(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.IPv4),
+				Telemetry:       dataPlaneNp.Source.Spec.Telemetry,
+				RewriteClientIP: dataPlaneNp.Source.Spec.RewriteClientIP,
+			}),
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayNginxProxySettingsIgnored(ignoredMsg("disableHTTP2, telemetry")),
+			},
+		},
+		{
+			name:  "merged Gateway enables the PROXY protocol",
+			npCfg: dataPlaneNp,
+			mergedGw: newMergedGateway(ngfAPI.NginxProxySpec{
+				RewriteClientIP: &ngfAPI.RewriteClientIP{
+					Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeProxyProtocol),
+				},
+			}),
+			expNp: newEffectiveNp(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.Dual),
+				Telemetry:       dataPlaneNp.Source.Spec.Telemetry,
+				RewriteClientIP: dataPlaneNp.Source.Spec.RewriteClientIP,
+			}),
+			expConds: []conditions.Condition{
+				staticConds.NewGatewayNginxProxySettingsIgnored(ignoredMsg("rewriteClientIP")),
+			},
+		},
+		{
+			name:  "data plane uses the default settings",
+			npCfg: nil,
+			mergedGw: newMergedGateway(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.IPv4),
+				RewriteClientIP: xffRewriteClientIP,
+			}),
+			expNp: newEffectiveNp(ngfAPI.NginxProxySpec{
+				IPFamily:        helpers.GetPointer(ngfAPI.IPv4),
+				RewriteClientIP: xffRewriteClientIP,
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mergedGws := map[types.NamespacedName]*Gateway{{Namespace: "test", Name: "merged"}: test.mergedGw}
+
+			buildMergedGatewayEffectiveNginxProxies(test.npCfg, winner, mergedGws)
+
+			g.Expect(test.mergedGw.EffectiveNginxProxy).To(Equal(test.expNp))
+			g.Expect(test.mergedGw.Conditions).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestIsNginxProxyReferencedByGateways(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gws := map[types.NamespacedName]*Gateway{
+		{Namespace: "test", Name: "gw"}: {
+			Source: &v1.Gateway{
+				Spec: v1.GatewaySpec{
+					Infrastructure: &v1.GatewayInfrastructure{
+						ParametersRef: &v1.LocalParametersReference{
+							Group: ngfAPI.GroupName,
+							Kind:  kinds.NginxProxy,
+							Name:  "nginx-proxy",
+						},
+					},
+				},
+			},
+		},
+		{Namespace: "test", Name: "no-infrastructure"}: {
+			Source: &v1.Gateway{},
+		},
+	}
+
+	g.Expect(isNginxProxyReferencedByGateways(types.NamespacedName{Name: "nginx-proxy"}, gws)).To(BeTrue())
+	g.Expect(isNginxProxyReferencedByGateways(types.NamespacedName{Name: "other"}, gws)).To(BeFalse())
+	g.Expect(isNginxProxyReferencedByGateways(types.NamespacedName{Name: "nginx-proxy"}, nil)).To(BeFalse())
+}
+
+func TestNewNginxProxyWithBases(t *testing.T) {
+	t.Parallel()
+
+	createNp := func(name, baseName string) *ngfAPI.NginxProxy {
+		np := &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}
+		if baseName != "" {
+			np.Spec.BaseRef = &ngfAPI.NginxProxyReference{Name: baseName}
+		}
+
+		return np
+	}
+
+	basePath := field.NewPath("spec", "baseRef", "name")
+
+	tests := []struct {
+		nps      map[types.NamespacedName]*ngfAPI.NginxProxy
+		expErr   *field.Error
+		name     string
+		expConds []conditions.Condition
+		expBases []string
+	}{
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np"}:    createNp("np", "base1"),
+				{Name: "base1"}: createNp("base1", "base2"),
+				{Name: "base2"}: createNp("base2", ""),
+			},
+			expBases: []string{"base1", "base2"},
+			name:     "chain of bases",
+		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np"}: createNp("np", "missing"),
+			},
+			expBases: []string{"missing"},
+			expErr:   field.NotFound(basePath, "missing"),
+			expConds: []conditions.Condition{
+				staticConds.NewNginxProxyBaseNotFound(`spec.baseRef.name: Not found: "missing"`),
+			},
+			name: "base doesn't exist",
+		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np"}:    createNp("np", "base1"),
+				{Name: "base1"}: createNp("base1", "missing"),
+			},
+			expBases: []string{"base1", "missing"},
+			expErr: &field.Error{
+				Type:     field.ErrorTypeNotFound,
+				Field:    basePath.String(),
+				BadValue: "missing",
+				Detail:   "the base of NginxProxy base1",
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewNginxProxyBaseNotFound(
+					`spec.baseRef.name: Not found: "missing": the base of NginxProxy base1`,
+				),
+			},
+			name: "base of a base doesn't exist",
+		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np"}:    createNp("np", "base1"),
+				{Name: "base1"}: createNp("base1", "base2"),
+				{Name: "base2"}: createNp("base2", "np"),
+			},
+			expBases: []string{"base1", "base2"},
+			expErr: field.Invalid(
+				basePath,
+				"base1",
+				"the chain of bases contains a cycle: np -> base1 -> base2 -> np",
+			),
+			expConds: []conditions.Condition{
+				staticConds.NewNginxProxyBaseCycle(
+					`spec.baseRef.name: Invalid value: "base1": ` +
+						`the chain of bases contains a cycle: np -> base1 -> base2 -> np`,
+				),
+			},
+			name: "cycle",
+		},
+		{
+			nps: map[types.NamespacedName]*ngfAPI.NginxProxy{
+				{Name: "np"}: createNp("np", "np"),
+			},
+			expErr: field.Invalid(basePath, "np", "the chain of bases contains a cycle: np -> np"),
+			expConds: []conditions.Condition{
+				staticConds.NewNginxProxyBaseCycle(
+					`spec.baseRef.name: Invalid value: "np": the chain of bases contains a cycle: np -> np`,
+				),
+			},
+			name: "NginxProxy is its own base",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := newNginxProxy(
+				test.nps[types.NamespacedName{Name: "np"}],
+				test.nps,
+				nil,
+				createValidValidator(),
+				false,
+			)
+
+			g.Expect(np.Bases).To(Equal(test.expBases))
+			g.Expect(np.Conditions).To(Equal(test.expConds))
+
+			if test.expErr == nil {
+				g.Expect(np.Valid).To(BeTrue())
+				g.Expect(np.ErrMsgs).To(BeEmpty())
+			} else {
+				g.Expect(np.Valid).To(BeFalse())
+				g.Expect(np.ErrMsgs).To(Equal(field.ErrorList{test.expErr}))
+			}
+		})
+	}
+}
+
+func TestGetNginxProxyBasesTooLong(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	nps := make(map[types.NamespacedName]*ngfAPI.NginxProxy)
+	for i := range maxNginxProxyBases + 2 {
+		np := &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("np%d", i)},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: fmt.Sprintf("np%d", i+1)},
+			},
+		}
+		nps[types.NamespacedName{Name: np.Name}] = np
+	}
+
+	bases, err := getNginxProxyBases(nps[types.NamespacedName{Name: "np0"}], nps)
+	g.Expect(bases).To(HaveLen(maxNginxProxyBases))
+	g.Expect(err).To(Equal(field.TooMany(
+		field.NewPath("spec", "baseRef", "name"),
+		maxNginxProxyBases+1,
+		maxNginxProxyBases,
+	)))
+}
+
+func TestGetEffectiveValues(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	chain := []*ngfAPI.NginxProxy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "team"},
+				Logging: &ngfAPI.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPI.NginxLogLevelDebug)},
+				Hardening: &ngfAPI.Hardening{
+					ResetTimedOutConnection: helpers.GetPointer(true),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "team"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "platform"},
+				Logging: &ngfAPI.NginxLogging{ErrorLevel: helpers.GetPointer(ngfAPI.NginxLogLevelWarn)},
+				Hardening: &ngfAPI.Hardening{
+					SendTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+			Spec: ngfAPI.NginxProxySpec{
+				IPFamily:     helpers.GetPointer(ngfAPI.IPv4),
+				DisableHTTP2: true,
+				Hardening: &ngfAPI.Hardening{
+					SendTimeout:             helpers.GetPointer[ngfAPI.Duration]("30s"),
+					ResetTimedOutConnection: helpers.GetPointer(false),
+				},
+			},
+		},
+	}
+
+	g.Expect(getEffectiveValues(chain)).To(Equal([]ngfAPI.NginxProxyEffectiveValue{
+		{Field: "disableHTTP2", Source: "platform"},
+		{Field: "hardening.resetTimedOutConnection", Source: "gateway"},
+		{Field: "hardening.sendTimeout", Source: "team"},
+		{Field: "ipFamily", Source: "platform"},
+		{Field: "logging", Source: "gateway"},
+	}))
+
+	g.Expect(getEffectiveValues(chain[2:3])).To(HaveLen(4))
+	g.Expect(getEffectiveValues([]*ngfAPI.NginxProxy{{}})).To(BeNil())
+}
+
+func TestBuildReferencedNginxProxies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	nps := map[types.NamespacedName]*ngfAPI.NginxProxy{
+		{Name: "gc"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "gc"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "platform"},
+			},
+		},
+		{Name: "gw"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "gw"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "team"},
+			},
+		},
+		{Name: "team"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "team"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "platform"},
+			},
+		},
+		{Name: "platform"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+			Spec: ngfAPI.NginxProxySpec{
+				BaseRef: &ngfAPI.NginxProxyReference{Name: "missing"},
+			},
+		},
+		{Name: "unreferenced"}: {
+			ObjectMeta: metav1.ObjectMeta{Name: "unreferenced"},
+		},
+	}
+
+	validator := createValidValidator()
+
+	gcNp := newNginxProxy(nps[types.NamespacedName{Name: "gc"}], nps, nil, validator, false)
+	gws := map[types.NamespacedName]*Gateway{
+		{Namespace: "test", Name: "gw"}: {
+			NginxProxy: newNginxProxy(nps[types.NamespacedName{Name: "gw"}], nps, nil, validator, false),
+		},
+		{Namespace: "test", Name: "no-np"}: {},
+	}
+
+	referenced := buildReferencedNginxProxies(gcNp, gws, nps, nil, validator, false)
+
+	g.Expect(referenced).To(HaveLen(5))
+	g.Expect(referenced).To(HaveKeyWithValue(types.NamespacedName{Name: "gc"}, gcNp))
+	g.Expect(referenced).To(HaveKeyWithValue(types.NamespacedName{Name: "missing"}, BeNil()))
+	g.Expect(referenced).ToNot(HaveKey(types.NamespacedName{Name: "unreferenced"}))
+
+	team := referenced[types.NamespacedName{Name: "team"}]
+	g.Expect(team.Bases).To(Equal([]string{"platform", "missing"}))
+	g.Expect(team.Valid).To(BeFalse())
+
+	platform := referenced[types.NamespacedName{Name: "platform"}]
+	g.Expect(platform.Bases).To(Equal([]string{"missing"}))
+
+	g.Expect(isNginxProxyReferencedAsBase(types.NamespacedName{Name: "team"}, referenced)).To(BeTrue())
+	g.Expect(isNginxProxyReferencedAsBase(types.NamespacedName{Name: "missing"}, referenced)).To(BeTrue())
+	g.Expect(isNginxProxyReferencedAsBase(types.NamespacedName{Name: "unreferenced"}, referenced)).To(BeFalse())
+
+	g.Expect(buildReferencedNginxProxies(nil, nil, nps, nil, validator, false)).To(BeNil())
+}
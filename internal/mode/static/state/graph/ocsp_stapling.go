@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// OCSPStaplingAnnotation is the annotation of the Secret of an HTTPS Listener that enables the stapling of
+// the OCSP responses of its certificate. The value is either "true" or "false".
+const OCSPStaplingAnnotation = "gateway.nginx.org/ocsp-stapling"
+
+// OCSPStapling holds the OCSP stapling settings of an HTTPS Listener.
+type OCSPStapling struct {
+	// Verify specifies whether NGINX verifies the OCSP responses with the CA certificate (ca.crt)
+	// of the Secret of the Listener.
+	Verify bool
+}
+
+// configureListenerOCSPStapling configures the OCSP stapling of the Listener from the annotation of its Secret.
+// If the annotation is invalid, the Listener is invalid.
+func configureListenerOCSPStapling(l *Listener, secret Secret) {
+	value, exists := secret.Source.Annotations[OCSPStaplingAnnotation]
+	if !exists {
+		return
+	}
+
+	switch value {
+	case "true":
+		l.OCSPStapling = &OCSPStapling{
+			Verify: secret.CertBundle != nil && len(secret.CertBundle.Cert.CACert) > 0,
+		}
+	case "false":
+	default:
+		path := field.NewPath("metadata", "annotations").Key(OCSPStaplingAnnotation)
+		valErr := field.NotSupported(path, value, []string{"true", "false"})
+		msg := fmt.Sprintf("Secret %s: %s", *l.ResolvedSecret, valErr)
+
+		l.Conditions = append(l.Conditions, staticConds.NewListenerInvalidCertificateRef(msg)...)
+		l.Valid = false
+	}
+}
+
+// validateOCSPStaplingListeners invalidates the listeners that enable OCSP stapling if the NginxProxy doesn't
+// configure a resolver. NGINX requires a resolver to resolve the hostnames of the OCSP responders.
+func validateOCSPStaplingListeners(gws map[types.NamespacedName]*Gateway, npCfg *NginxProxy) {
+	if npCfg != nil && npCfg.Valid && npCfg.Source != nil && npCfg.Source.Spec.Resolver != nil {
+		return
+	}
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid || l.Source.Protocol != v1.HTTPSProtocolType || l.OCSPStapling == nil {
+				continue
+			}
+
+			msg := "OCSP stapling is enabled in the Secret of the listener, but the NginxProxy doesn't configure " +
+				"a resolver, which NGINX requires to resolve the hostnames of the OCSP responders"
+			l.Valid = false
+			l.Conditions = append(l.Conditions, staticConds.NewListenerUnsupportedValue(msg)...)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestConfigureListenerOCSPStapling(t *testing.T) {
+	t.Parallel()
+
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+
+	createSecret := func(annotations map[string]string, caCert []byte) Secret {
+		return Secret{
+			Source: &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   secretNsName.Namespace,
+					Name:        secretNsName.Name,
+					Annotations: annotations,
+				},
+			},
+			CertBundle: NewCertificateBundle(secretNsName, "Secret", &Certificate{CACert: caCert}),
+		}
+	}
+
+	tests := []struct {
+		expOCSPStapling *OCSPStapling
+		name            string
+		secret          Secret
+		expConditions   []conditions.Condition
+		expValid        bool
+	}{
+		{
+			name:     "no annotation",
+			secret:   createSecret(nil, []byte("ca")),
+			expValid: true,
+		},
+		{
+			name:     "disabled",
+			secret:   createSecret(map[string]string{OCSPStaplingAnnotation: "false"}, []byte("ca")),
+			expValid: true,
+		},
+		{
+			name:            "enabled without CA certificate",
+			secret:          createSecret(map[string]string{OCSPStaplingAnnotation: "true"}, nil),
+			expOCSPStapling: &OCSPStapling{},
+			expValid:        true,
+		},
+		{
+			name:            "enabled with CA certificate",
+			secret:          createSecret(map[string]string{OCSPStaplingAnnotation: "true"}, []byte("ca")),
+			expOCSPStapling: &OCSPStapling{Verify: true},
+			expValid:        true,
+		},
+		{
+			name:   "invalid value",
+			secret: createSecret(map[string]string{OCSPStaplingAnnotation: "on"}, []byte("ca")),
+			expConditions: staticConds.NewListenerInvalidCertificateRef(
+				`Secret test/secret: metadata.annotations[gateway.nginx.org/ocsp-stapling]: ` +
+					`Unsupported value: "on": supported values: "true", "false"`,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			l := &Listener{
+				ResolvedSecret: &secretNsName,
+				Valid:          true,
+			}
+
+			configureListenerOCSPStapling(l, test.secret)
+
+			g.Expect(l.OCSPStapling).To(Equal(test.expOCSPStapling))
+			g.Expect(l.Valid).To(Equal(test.expValid))
+			g.Expect(l.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidateOCSPStaplingListeners(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(name string, protocol v1.ProtocolType, stapling *OCSPStapling) *Listener {
+		return &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+			},
+			OCSPStapling: stapling,
+			Valid:        true,
+		}
+	}
+
+	createNginxProxy := func(resolver *ngfAPI.NginxResolver, valid bool) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{Resolver: resolver},
+			},
+			Valid: valid,
+		}
+	}
+
+	resolver := &ngfAPI.NginxResolver{Addresses: []string{"10.96.0.10"}}
+
+	resolverConds := staticConds.NewListenerUnsupportedValue(
+		"OCSP stapling is enabled in the Secret of the listener, but the NginxProxy doesn't configure " +
+			"a resolver, which NGINX requires to resolve the hostnames of the OCSP responders",
+	)
+
+	tests := []struct {
+		npCfg         *NginxProxy
+		expConditions []conditions.Condition
+		msg           string
+		expValid      bool
+	}{
+		{
+			msg:           "no NginxProxy",
+			expConditions: resolverConds,
+		},
+		{
+			msg:           "no resolver",
+			npCfg:         createNginxProxy(nil, true),
+			expConditions: resolverConds,
+		},
+		{
+			msg:           "resolver in an invalid NginxProxy",
+			npCfg:         createNginxProxy(resolver, false),
+			expConditions: resolverConds,
+		},
+		{
+			msg:      "resolver",
+			npCfg:    createNginxProxy(resolver, true),
+			expValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			stapling := createListener("stapling", v1.HTTPSProtocolType, &OCSPStapling{Verify: true})
+			noStapling := createListener("no-stapling", v1.HTTPSProtocolType, nil)
+			http := createListener("http", v1.HTTPProtocolType, nil)
+
+			gws := map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gateway"}: {
+					Listeners: []*Listener{stapling, noStapling, http},
+				},
+			}
+
+			validateOCSPStaplingListeners(gws, test.npCfg)
+
+			g.Expect(stapling.Valid).To(Equal(test.expValid))
+			g.Expect(stapling.Conditions).To(Equal(test.expConditions))
+			g.Expect(noStapling.Valid).To(BeTrue())
+			g.Expect(noStapling.Conditions).To(BeEmpty())
+			g.Expect(http.Valid).To(BeTrue())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// CaseInsensitivePathMatchAnnotation is the annotation of an HTTPRoute that makes the path matches of the
+	// HTTPRoute case-insensitive. The value is either "true" or "false".
+	CaseInsensitivePathMatchAnnotation = "gateway.nginx.org/case-insensitive-path-match"
+	// TrailingSlashAnnotation is the annotation of an HTTPRoute that configures how the requests for a matched
+	// path with an added or a removed trailing slash are handled. See TrailingSlashMode for the supported values.
+	TrailingSlashAnnotation = "gateway.nginx.org/trailing-slash"
+)
+
+// TrailingSlashMode defines how the requests for a matched path with an added or a removed trailing slash
+// are handled. For example, for the Exact path match "/coffee", the requests for "/coffee/".
+type TrailingSlashMode string
+
+const (
+	// TrailingSlashModeRedirect permanently redirects the requests to the matched path.
+	TrailingSlashModeRedirect TrailingSlashMode = "redirect"
+	// TrailingSlashModeNormalize handles the requests as the requests for the matched path,
+	// so that the backend receives the matched path.
+	TrailingSlashModeNormalize TrailingSlashMode = "normalize"
+)
+
+// PathMatchOptions are the NGINX-specific options for matching the paths of the requests
+// to the path matches of a Route.
+type PathMatchOptions struct {
+	// TrailingSlash defines how the requests for a matched path with an added or a removed trailing slash are handled.
+	// If empty, such requests don't match the path.
+	TrailingSlash TrailingSlashMode
+	// CaseInsensitive indicates that the paths are matched case-insensitively.
+	CaseInsensitive bool
+}
+
+// buildPathMatchOptions builds the PathMatchOptions from the annotations of a Route.
+func buildPathMatchOptions(annotations map[string]string) (PathMatchOptions, field.ErrorList) {
+	var (
+		opts    PathMatchOptions
+		allErrs field.ErrorList
+	)
+
+	annotationsPath := field.NewPath("metadata").Child("annotations")
+
+	if value, exists := annotations[CaseInsensitivePathMatchAnnotation]; exists {
+		switch value {
+		case "true":
+			opts.CaseInsensitive = true
+		case "false":
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				annotationsPath.Key(CaseInsensitivePathMatchAnnotation),
+				value,
+				[]string{"true", "false"},
+			))
+		}
+	}
+
+	if value, exists := annotations[TrailingSlashAnnotation]; exists {
+		switch mode := TrailingSlashMode(value); mode {
+		case TrailingSlashModeRedirect, TrailingSlashModeNormalize:
+			opts.TrailingSlash = mode
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				annotationsPath.Key(TrailingSlashAnnotation),
+				value,
+				[]string{string(TrailingSlashModeRedirect), string(TrailingSlashModeNormalize)},
+			))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return PathMatchOptions{}, allErrs
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBuildPathMatchOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		annotations map[string]string
+		name        string
+		expErr      string
+		expOpts     PathMatchOptions
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name: "all options",
+			annotations: map[string]string{
+				CaseInsensitivePathMatchAnnotation: "true",
+				TrailingSlashAnnotation:            "redirect",
+			},
+			expOpts: PathMatchOptions{
+				CaseInsensitive: true,
+				TrailingSlash:   TrailingSlashModeRedirect,
+			},
+		},
+		{
+			name: "case-sensitive and normalize",
+			annotations: map[string]string{
+				CaseInsensitivePathMatchAnnotation: "false",
+				TrailingSlashAnnotation:            "normalize",
+			},
+			expOpts: PathMatchOptions{
+				TrailingSlash: TrailingSlashModeNormalize,
+			},
+		},
+		{
+			name: "invalid values",
+			annotations: map[string]string{
+				CaseInsensitivePathMatchAnnotation: "yes",
+				TrailingSlashAnnotation:            "Redirect",
+			},
+			expErr: `[metadata.annotations[gateway.nginx.org/case-insensitive-path-match]: ` +
+				`Unsupported value: "yes": supported values: "true", "false", ` +
+				`metadata.annotations[gateway.nginx.org/trailing-slash]: ` +
+				`Unsupported value: "Redirect": supported values: "redirect", "normalize"]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			opts, errs := buildPathMatchOptions(test.annotations)
+			g.Expect(opts).To(Equal(test.expOpts))
+
+			if test.expErr == "" {
+				g.Expect(errs).To(BeEmpty())
+			} else {
+				g.Expect(errs.ToAggregate().Error()).To(Equal(test.expErr))
+			}
+		})
+	}
+}
@@ -63,6 +63,8 @@ const (
 	gatewayGroupKind = v1.GroupName + "/" + kinds.Gateway
 	hrGroupKind      = v1.GroupName + "/" + kinds.HTTPRoute
 	grpcGroupKind    = v1.GroupName + "/" + kinds.GRPCRoute
+	tlsGroupKind     = v1.GroupName + "/" + kinds.TLSRoute
+	tcpGroupKind     = v1.GroupName + "/" + kinds.TCPRoute
 	serviceGroupKind = "core" + "/" + kinds.Service
 )
 
@@ -76,7 +78,7 @@ func (g *Graph) attachPolicies(ctlrName string) {
 		for _, ref := range policy.TargetRefs {
 			switch ref.Kind {
 			case kinds.Gateway:
-				attachPolicyToGateway(policy, ref, g.Gateway, g.IgnoredGateways, ctlrName)
+				attachPolicyToGateway(policy, ref, g.Gateway, g.MergedGateways, ctlrName)
 			case kinds.HTTPRoute, kinds.GRPCRoute:
 				route, exists := g.Routes[routeKeyForKind(ref.Kind, ref.Nsname)]
 				if !exists {
@@ -84,6 +86,13 @@ func (g *Graph) attachPolicies(ctlrName string) {
 				}
 
 				attachPolicyToRoute(policy, route, ctlrName)
+			case kinds.TLSRoute, kinds.TCPRoute:
+				route, exists := g.L4Routes[l4RouteKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				attachPolicyToL4Route(policy, route, ref.Kind, ctlrName)
 			case kinds.Service:
 				svc, exists := g.ReferencedServices[ref.Nsname]
 				if !exists {
@@ -154,16 +163,37 @@ func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
 	route.Policies = append(route.Policies, policy)
 }
 
+func attachPolicyToL4Route(policy *Policy, route *L4Route, kind v1.Kind, ctlrName string) {
+	routeNsName := types.NamespacedName{Namespace: route.Source.GetNamespace(), Name: route.Source.GetName()}
+
+	ancestor := PolicyAncestor{
+		Ancestor: createParentReference(v1.GroupName, kind, routeNsName),
+	}
+
+	if ngfPolicyAncestorsFull(policy, ctlrName) {
+		return
+	}
+
+	if !route.Valid || !route.Attachable || len(route.ParentRefs) == 0 {
+		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	policy.Ancestors = append(policy.Ancestors, ancestor)
+	route.Policies = append(route.Policies, policy)
+}
+
 func attachPolicyToGateway(
 	policy *Policy,
 	ref PolicyTargetRef,
 	gw *Gateway,
-	ignoredGateways map[types.NamespacedName]*v1.Gateway,
+	mergedGateways map[types.NamespacedName]*Gateway,
 	ctlrName string,
 ) {
-	_, ignored := ignoredGateways[ref.Nsname]
+	_, merged := mergedGateways[ref.Nsname]
 
-	if !ignored && ref.Nsname != client.ObjectKeyFromObject(gw.Source) {
+	if !merged && ref.Nsname != client.ObjectKeyFromObject(gw.Source) {
 		return
 	}
 
@@ -176,8 +206,12 @@ func attachPolicyToGateway(
 		return
 	}
 
-	if ignored {
-		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is ignored")}
+	// Policies attached to the winning Gateway apply to the NGINX configuration shared by all the Gateways,
+	// so the merged Gateways can't have their own policies.
+	if merged {
+		ancestor.Conditions = []conditions.Condition{
+			staticConds.NewPolicyTargetNotFound("TargetRef is a merged Gateway; only the oldest Gateway can be targeted"),
+		}
 		policy.Ancestors = append(policy.Ancestors, ancestor)
 		return
 	}
@@ -195,8 +229,10 @@ func attachPolicyToGateway(
 func processPolicies(
 	pols map[PolicyKey]policies.Policy,
 	validator validation.PolicyValidator,
+	defaulter validation.PolicyDefaulter,
 	gateways processedGateways,
 	routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
 	services map[types.NamespacedName]*ReferencedService,
 	globalSettings *policies.GlobalSettings,
 ) map[PolicyKey]*Policy {
@@ -217,7 +253,7 @@ func processPolicies(
 
 			switch refGroupKind(ref.Group, ref.Kind) {
 			case gatewayGroupKind:
-				if !gatewayExists(refNsName, gateways.Winner, gateways.Ignored) {
+				if !gateways.exists(refNsName) {
 					continue
 				}
 			case hrGroupKind, grpcGroupKind:
@@ -226,6 +262,10 @@ func processPolicies(
 				} else {
 					continue
 				}
+			case tlsGroupKind, tcpGroupKind:
+				if _, exists := l4Routes[l4RouteKeyForKind(ref.Kind, refNsName)]; !exists {
+					continue
+				}
 			case serviceGroupKind:
 				if _, exists := services[refNsName]; !exists {
 					continue
@@ -246,6 +286,12 @@ func processPolicies(
 			continue
 		}
 
+		// The defaulting webhook sets the same defaults when the policy is admitted, but policies that were
+		// admitted without the webhook need them as well.
+		if defaulter != nil {
+			policy = defaulter.Default(policy)
+		}
+
 		overlapConds := checkTargetRoutesForOverlap(targetedRoutes, routes)
 		conds = append(conds, overlapConds...)
 
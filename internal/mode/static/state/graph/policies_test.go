@@ -18,6 +18,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
 )
 
 var testNs = "test"
@@ -378,11 +379,144 @@ func TestAttachPolicyToRoute(t *testing.T) {
 	}
 }
 
+func TestAttachPolicyToL4Route(t *testing.T) {
+	t.Parallel()
+	routeNsName := types.NamespacedName{Namespace: testNs, Name: "l4-route"}
+
+	createRoute := func(valid, attachable, parentRefs bool) *L4Route {
+		route := &L4Route{
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      routeNsName.Name,
+					Namespace: routeNsName.Namespace,
+				},
+			},
+			Valid:      valid,
+			Attachable: attachable,
+		}
+
+		if parentRefs {
+			route.ParentRefs = []ParentRef{
+				{
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			}
+		}
+
+		return route
+	}
+
+	createExpAncestor := func(kind v1.Kind) v1.ParentReference {
+		return v1.ParentReference{
+			Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+			Kind:      helpers.GetPointer[v1.Kind](kind),
+			Namespace: (*v1.Namespace)(&routeNsName.Namespace),
+			Name:      v1.ObjectName(routeNsName.Name),
+		}
+	}
+
+	tests := []struct {
+		route        *L4Route
+		policy       *Policy
+		name         string
+		kind         v1.Kind
+		expAncestors []PolicyAncestor
+		expAttached  bool
+	}{
+		{
+			name:   "policy attaches to tls route",
+			route:  createRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			kind:   kinds.TLSRoute,
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpAncestor(kinds.TLSRoute)},
+			},
+			expAttached: true,
+		},
+		{
+			name:   "policy attaches to tcp route",
+			route:  createRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			kind:   kinds.TCPRoute,
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: createExpAncestor(kinds.TCPRoute)},
+			},
+			expAttached: true,
+		},
+		{
+			name:   "no attachment; unattachable route",
+			route:  createRoute(true /*valid*/, false /*attachable*/, true /*parentRefs*/),
+			kind:   kinds.TLSRoute,
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   createExpAncestor(kinds.TLSRoute),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:   "no attachment; missing parentRefs",
+			route:  createRoute(true /*valid*/, true /*attachable*/, false /*parentRefs*/),
+			kind:   kinds.TLSRoute,
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   createExpAncestor(kinds.TLSRoute),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:   "no attachment; invalid route",
+			route:  createRoute(false /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			kind:   kinds.TCPRoute,
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   createExpAncestor(kinds.TCPRoute),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:         "no attachment; max ancestors",
+			route:        createRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			kind:         kinds.TLSRoute,
+			policy:       &Policy{Source: createTestPolicyWithAncestors(16)},
+			expAncestors: nil,
+			expAttached:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			attachPolicyToL4Route(test.policy, test.route, test.kind, "nginx-gateway")
+
+			if test.expAttached {
+				g.Expect(test.route.Policies).To(HaveLen(1))
+			} else {
+				g.Expect(test.route.Policies).To(BeEmpty())
+			}
+
+			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+		})
+	}
+}
+
 func TestAttachPolicyToGateway(t *testing.T) {
 	t.Parallel()
 	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
 	gateway2NsName := types.NamespacedName{Namespace: testNs, Name: "gateway2"}
-	ignoredGatewayNsName := types.NamespacedName{Namespace: testNs, Name: "ignored"}
+	mergedGatewayNsName := types.NamespacedName{Namespace: testNs, Name: "merged"}
 
 	newGateway := func(valid bool, nsname types.NamespacedName) *Gateway {
 		return &Gateway{
@@ -442,12 +576,12 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			expAttached: true,
 		},
 		{
-			name: "not attached; gateway ignored",
+			name: "not attached; gateway merged",
 			policy: &Policy{
 				Source: &policiesfakes.FakePolicy{},
 				TargetRefs: []PolicyTargetRef{
 					{
-						Nsname: ignoredGatewayNsName,
+						Nsname: mergedGatewayNsName,
 						Kind:   "Gateway",
 					},
 				},
@@ -455,8 +589,12 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			gw: newGateway(true, gatewayNsName),
 			expAncestors: []PolicyAncestor{
 				{
-					Ancestor:   getGatewayParentRef(ignoredGatewayNsName),
-					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is ignored")},
+					Ancestor: getGatewayParentRef(mergedGatewayNsName),
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyTargetNotFound(
+							"TargetRef is a merged Gateway; only the oldest Gateway can be targeted",
+						),
+					},
 				},
 			},
 			expAttached: false,
@@ -514,15 +652,15 @@ func TestAttachPolicyToGateway(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		ignoredGateways := map[types.NamespacedName]*v1.Gateway{
-			ignoredGatewayNsName: nil,
+		mergedGateways := map[types.NamespacedName]*Gateway{
+			mergedGatewayNsName: nil,
 		}
 
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToGateway(test.policy, test.policy.TargetRefs[0], test.gw, ignoredGateways, "nginx-gateway")
+			attachPolicyToGateway(test.policy, test.policy.TargetRefs[0], test.gw, mergedGateways, "nginx-gateway")
 
 			if test.expAttached {
 				g.Expect(test.gw.Policies).To(HaveLen(1))
@@ -663,8 +801,10 @@ func TestProcessPolicies(t *testing.T) {
 	hrRef := createTestRef(kinds.HTTPRoute, v1.GroupName, "hr")
 	grpcRef := createTestRef(kinds.GRPCRoute, v1.GroupName, "grpc")
 	gatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
-	ignoredGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "ignored")
+	mergedGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "merged")
 	svcRef := createTestRef(kinds.Service, "core", "svc")
+	tlsRef := createTestRef(kinds.TLSRoute, v1.GroupName, "tls")
+	tcpRef := createTestRef(kinds.TCPRoute, v1.GroupName, "tcp")
 
 	// These refs reference objects that do not belong to NGF.
 	// Policies that contain these refs should NOT be processed.
@@ -673,24 +813,36 @@ func TestProcessPolicies(t *testing.T) {
 	gatewayWrongGroupRef := createTestRef(kinds.Gateway, "WrongGroup", "gw")
 	nonNGFGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "not-ours")
 	svcDoesNotExistRef := createTestRef(kinds.Service, "core", "dne")
+	tlsDoesNotExistRef := createTestRef(kinds.TLSRoute, v1.GroupName, "dne")
 
 	pol1, pol1Key := createTestPolicyAndKey(policyGVK, "pol1", hrRef)
 	pol2, pol2Key := createTestPolicyAndKey(policyGVK, "pol2", grpcRef)
 	pol3, pol3Key := createTestPolicyAndKey(policyGVK, "pol3", gatewayRef)
-	pol4, pol4Key := createTestPolicyAndKey(policyGVK, "pol4", ignoredGatewayRef)
+	pol4, pol4Key := createTestPolicyAndKey(policyGVK, "pol4", mergedGatewayRef)
 	pol5, pol5Key := createTestPolicyAndKey(policyGVK, "pol5", hrDoesNotExistRef)
 	pol6, pol6Key := createTestPolicyAndKey(policyGVK, "pol6", hrWrongGroup)
 	pol7, pol7Key := createTestPolicyAndKey(policyGVK, "pol7", gatewayWrongGroupRef)
 	pol8, pol8Key := createTestPolicyAndKey(policyGVK, "pol8", nonNGFGatewayRef)
 	pol9, pol9Key := createTestPolicyAndKey(policyGVK, "pol9", svcDoesNotExistRef)
 	pol10, pol10Key := createTestPolicyAndKey(policyGVK, "pol10", svcRef)
+	pol11, pol11Key := createTestPolicyAndKey(policyGVK, "pol11", tlsRef)
+	pol12, pol12Key := createTestPolicyAndKey(policyGVK, "pol12", tcpRef)
+	pol13, pol13Key := createTestPolicyAndKey(policyGVK, "pol13", tlsDoesNotExistRef)
 
 	pol1Conflict, pol1ConflictKey := createTestPolicyAndKey(policyGVK, "pol1-conflict", hrRef)
 
 	allValidValidator := &policiesfakes.FakeValidator{}
 
+	pol1Defaulted := createTestPolicy(policyGVK, "pol1", hrRef)
+	pol1Defaulter := &validationfakes.FakePolicyDefaulter{
+		DefaultStub: func(_ policies.Policy) policies.Policy {
+			return pol1Defaulted
+		},
+	}
+
 	tests := []struct {
 		validator            validation.PolicyValidator
+		defaulter            validation.PolicyDefaulter
 		policies             map[PolicyKey]policies.Policy
 		expProcessedPolicies map[PolicyKey]*Policy
 		name                 string
@@ -713,6 +865,9 @@ func TestProcessPolicies(t *testing.T) {
 				pol8Key:  pol8,
 				pol9Key:  pol9,
 				pol10Key: pol10,
+				pol11Key: pol11,
+				pol12Key: pol12,
+				pol13Key: pol13,
 			},
 			expProcessedPolicies: map[PolicyKey]*Policy{
 				pol1Key: {
@@ -755,7 +910,7 @@ func TestProcessPolicies(t *testing.T) {
 					Source: pol4,
 					TargetRefs: []PolicyTargetRef{
 						{
-							Nsname: types.NamespacedName{Namespace: testNs, Name: "ignored"},
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "merged"},
 							Kind:   kinds.Gateway,
 							Group:  v1.GroupName,
 						},
@@ -775,6 +930,52 @@ func TestProcessPolicies(t *testing.T) {
 					Ancestors: []PolicyAncestor{},
 					Valid:     true,
 				},
+				pol11Key: {
+					Source: pol11,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "tls"},
+							Kind:   kinds.TLSRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
+				pol12Key: {
+					Source: pol12,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "tcp"},
+							Kind:   kinds.TCPRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
+			},
+		},
+		{
+			name:      "defaulted policy",
+			validator: allValidValidator,
+			defaulter: pol1Defaulter,
+			policies: map[PolicyKey]policies.Policy{
+				pol1Key: pol1,
+			},
+			expProcessedPolicies: map[PolicyKey]*Policy{
+				pol1Key: {
+					Source: pol1Defaulted,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "hr"},
+							Kind:   kinds.HTTPRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
 			},
 		},
 		{
@@ -875,10 +1076,10 @@ func TestProcessPolicies(t *testing.T) {
 				Namespace: testNs,
 			},
 		},
-		Ignored: map[types.NamespacedName]*v1.Gateway{
-			{Namespace: testNs, Name: "ignored"}: {
+		Merged: []*v1.Gateway{
+			{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gw",
+					Name:      "merged",
 					Namespace: testNs,
 				},
 			},
@@ -904,6 +1105,25 @@ func TestProcessPolicies(t *testing.T) {
 		},
 	}
 
+	l4Routes := map[L4RouteKey]*L4Route{
+		{RouteType: RouteTypeTLS, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tls"}}: {
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls",
+					Namespace: testNs,
+				},
+			},
+		},
+		{RouteType: RouteTypeTCP, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tcp"}}: {
+			Source: &v1alpha2.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tcp",
+					Namespace: testNs,
+				},
+			},
+		},
+	}
+
 	services := map[types.NamespacedName]*ReferencedService{
 		{Namespace: testNs, Name: "svc"}: {},
 	}
@@ -913,7 +1133,16 @@ func TestProcessPolicies(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processPolicies(test.policies, test.validator, gateways, routes, services, nil)
+			processed := processPolicies(
+				test.policies,
+				test.validator,
+				test.defaulter,
+				gateways,
+				routes,
+				l4Routes,
+				services,
+				nil,
+			)
 			g.Expect(processed).To(BeEquivalentTo(test.expProcessedPolicies))
 		})
 	}
@@ -1053,7 +1282,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processPolicies(test.policies, test.validator, gateways, test.routes, nil, nil)
+			processed := processPolicies(test.policies, test.validator, nil, gateways, test.routes, nil, nil, nil)
 			g.Expect(processed).To(HaveLen(1))
 
 			for _, pol := range processed {
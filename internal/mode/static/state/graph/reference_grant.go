@@ -49,6 +49,14 @@ func toSecret(nsname types.NamespacedName) toResource {
 	}
 }
 
+func toConfigMap(nsname types.NamespacedName) toResource {
+	return toResource{
+		kind:      "ConfigMap",
+		name:      nsname.Name,
+		namespace: nsname.Namespace,
+	}
+}
+
 func toService(nsname types.NamespacedName) toResource {
 	return toResource{
 		kind:      "Service",
@@ -89,6 +97,30 @@ func fromTLSRoute(namespace string) fromResource {
 	}
 }
 
+func fromTCPRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      kinds.TCPRoute,
+		namespace: namespace,
+	}
+}
+
+func fromUDPRoute(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      kinds.UDPRoute,
+		namespace: namespace,
+	}
+}
+
+func fromBackendTLSPolicy(namespace string) fromResource {
+	return fromResource{
+		group:     v1.GroupName,
+		kind:      kinds.BackendTLSPolicy,
+		namespace: namespace,
+	}
+}
+
 // newReferenceGrantResolver creates a new referenceGrantResolver.
 func newReferenceGrantResolver(refGrants map[types.NamespacedName]*v1beta1.ReferenceGrant) *referenceGrantResolver {
 	allowed := make(map[allowedReference]struct{})
@@ -256,6 +256,36 @@ func TestFromTLSRoute(t *testing.T) {
 	g.Expect(ref).To(Equal(exp))
 }
 
+func TestFromTCPRoute(t *testing.T) {
+	t.Parallel()
+
+	ref := fromTCPRoute("ns")
+
+	exp := fromResource{
+		group:     v1beta1.GroupName,
+		kind:      kinds.TCPRoute,
+		namespace: "ns",
+	}
+
+	g := NewWithT(t)
+	g.Expect(ref).To(Equal(exp))
+}
+
+func TestFromUDPRoute(t *testing.T) {
+	t.Parallel()
+
+	ref := fromUDPRoute("ns")
+
+	exp := fromResource{
+		group:     v1beta1.GroupName,
+		kind:      kinds.UDPRoute,
+		namespace: "ns",
+	}
+
+	g := NewWithT(t)
+	g.Expect(ref).To(Equal(exp))
+}
+
 func TestRefAllowedFrom(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package graph
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -14,6 +15,7 @@ import (
 	v1alpha "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	ngfSort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -45,6 +47,9 @@ type ParentRefAttachmentStatus struct {
 	// FailedCondition is the condition that describes why the ParentRef is not attached to the Gateway. It is set
 	// when Attached is false.
 	FailedCondition conditions.Condition
+	// Conditions are the conditions that only apply to the ParentRef when it is attached to the Gateway.
+	// For example, when the Route is attached to some, but not all, of the Listeners of the ParentRef.
+	Conditions []conditions.Condition
 	// ListenerPort is the port on the Listener that the Route is attached to.
 	ListenerPort v1.PortNumber
 	// Attached indicates if the ParentRef is attached to the Gateway.
@@ -58,12 +63,20 @@ const (
 	RouteTypeHTTP RouteType = "http"
 	// RouteTypeGRPC indicates that the RouteType of the L7Route is gRPC.
 	RouteTypeGRPC RouteType = "grpc"
+	// RouteTypeTLS indicates that the RouteType of the L4Route is TLS.
+	RouteTypeTLS RouteType = "tls"
+	// RouteTypeTCP indicates that the RouteType of the L4Route is TCP.
+	RouteTypeTCP RouteType = "tcp"
+	// RouteTypeUDP indicates that the RouteType of the L4Route is UDP.
+	RouteTypeUDP RouteType = "udp"
 )
 
 // L4RouteKey is the unique identifier for a L4Route.
 type L4RouteKey struct {
 	// NamespacedName is the NamespacedName of the Route.
 	NamespacedName types.NamespacedName
+	// RouteType is the type of the Route.
+	RouteType RouteType
 }
 
 // RouteKey is the unique identifier for a L7Route.
@@ -81,6 +94,8 @@ type L4Route struct {
 	ParentRefs []ParentRef
 	// Conditions define the conditions to be reported in the status of the Route.
 	Conditions []conditions.Condition
+	// Policies holds the policies that are attached to the Route.
+	Policies []*Policy
 	// Spec is the L4RouteSpec of the Route
 	Spec L4RouteSpec
 	// Valid indicates if the Route is valid.
@@ -101,6 +116,9 @@ type L4RouteSpec struct {
 type L7Route struct {
 	// Source is the source Gateway API object of the Route.
 	Source client.Object
+	// DebugCapture is the capture of the routing decisions of the requests of the Route.
+	// Will be nil if the capture is not enabled or the debug capture annotations are invalid.
+	DebugCapture *DebugCapture
 	// RouteType is the type (http or grpc) of the Route.
 	RouteType RouteType
 	// Spec is the L7RouteSpec of the Route
@@ -122,9 +140,20 @@ type L7RouteSpec struct {
 	Hostnames []v1.Hostname
 	// Rules are the list of HTTP matchers, filters and actions.
 	Rules []RouteRule
+	// PathMatchOptions are the options for matching the paths of the requests. Only set for HTTPRoutes.
+	PathMatchOptions PathMatchOptions
+	// Streaming indicates that the responses of the backends are passed to the clients without buffering.
+	// Only set for HTTPRoutes.
+	Streaming bool
 }
 
 type RouteRule struct {
+	// Timeouts define the timeouts for the requests of the rule. Only set for HTTPRoutes.
+	// Will be nil if the timeouts are not set or are invalid.
+	Timeouts *v1.HTTPRouteTimeouts
+	// Retry defines when and how many times to retry the requests of the rule. Only set for HTTPRoutes.
+	// Will be nil if the retry is not set or is invalid.
+	Retry *v1.HTTPRouteRetry
 	// Matches define the predicate used to match requests to a given action.
 	Matches []v1.HTTPRouteMatch
 	// RouteBackendRefs are a wrapper for v1.BackendRef and any BackendRef filters from the HTTPRoute or GRPCRoute.
@@ -135,6 +164,10 @@ type RouteRule struct {
 	Filters RouteRuleFilters
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
 	ValidMatches bool
+	// Dropped indicates that the rule is invalid, so it is not configured and the requests that it would match are
+	// handled by the other rules of the Route. The rules with invalid matches or filters are dropped. The rules with
+	// filters that can't be resolved are not dropped; instead, they respond with the 500 status code.
+	Dropped bool
 }
 
 // RouteBackendRef is a wrapper for v1.BackendRef and any BackendRef filters from the HTTPRoute or GRPCRoute.
@@ -166,25 +199,97 @@ func CreateRouteKey(obj client.Object) RouteKey {
 
 // CreateRouteKeyL4 takes a client.Object and creates a L4RouteKey.
 func CreateRouteKeyL4(obj client.Object) L4RouteKey {
+	var routeType RouteType
+	switch obj.(type) {
+	case *v1alpha.TLSRoute:
+		routeType = RouteTypeTLS
+	case *v1alpha.TCPRoute:
+		routeType = RouteTypeTCP
+	case *v1alpha.UDPRoute:
+		routeType = RouteTypeUDP
+	default:
+		panic(fmt.Sprintf("Unknown type: %T", obj))
+	}
 	return L4RouteKey{
 		NamespacedName: client.ObjectKeyFromObject(obj),
+		RouteType:      routeType,
 	}
 }
 
 type routeRuleErrors struct {
+	// invalid are the errors that drop the rule.
 	invalid field.ErrorList
 	resolve field.ErrorList
+	// ignored are the errors of the invalid fields that are ignored. They don't drop the rule.
+	ignored field.ErrorList
+	// conflicts are the conflicts between the SnippetsFilters of a rule. They don't invalidate the rule.
+	conflicts []string
 }
 
 func (e routeRuleErrors) append(newErrors routeRuleErrors) routeRuleErrors {
 	return routeRuleErrors{
-		invalid: append(e.invalid, newErrors.invalid...),
-		resolve: append(e.resolve, newErrors.resolve...),
+		invalid:   append(e.invalid, newErrors.invalid...),
+		resolve:   append(e.resolve, newErrors.resolve...),
+		ignored:   append(e.ignored, newErrors.ignored...),
+		conflicts: append(e.conflicts, newErrors.conflicts...),
+	}
+}
+
+// buildRouteRulesConditions returns whether the Route is valid and its Conditions, based on its rules and
+// the errors of the rules. The Route is invalid if all of its rules are dropped. Otherwise, the valid rules still
+// receive traffic, and the dropped rules are reported by their indexes in the PartiallyInvalid Condition.
+// The invalid fields that are ignored don't drop their rules, so they are reported in the IgnoredFields Condition.
+func buildRouteRulesConditions(rules []RouteRule, allRulesErrors routeRuleErrors) (bool, []conditions.Condition) {
+	conds := make([]conditions.Condition, 0, 2)
+	valid := true
+
+	droppedIdxs := make([]string, 0, len(rules))
+	for i, rule := range rules {
+		if rule.Dropped {
+			droppedIdxs = append(droppedIdxs, strconv.Itoa(i))
+		}
+	}
+
+	switch {
+	case len(rules) > 0 && len(droppedIdxs) == len(rules):
+		msg := "All rules are invalid: " + allRulesErrors.invalid.ToAggregate().Error()
+		conds = append(conds, staticConds.NewRouteUnsupportedValue(msg))
+		valid = false
+	case len(droppedIdxs) > 0:
+		msg := strings.Join(droppedIdxs, ", ") + ". Invalid fields: " + allRulesErrors.invalid.ToAggregate().Error()
+		conds = append(conds, staticConds.NewRoutePartiallyInvalid(msg))
+	}
+
+	// ignored fields do not drop rules
+	if len(allRulesErrors.ignored) > 0 {
+		msg := "Ignored invalid fields: " + allRulesErrors.ignored.ToAggregate().Error()
+		conds = append(conds, staticConds.NewRouteIgnoredFields(msg))
+	}
+
+	// resolve errors do not invalidate routes
+	if len(allRulesErrors.resolve) > 0 {
+		msg := allRulesErrors.resolve.ToAggregate().Error()
+		conds = append(conds, staticConds.NewRouteResolvedRefsInvalidFilter(msg))
+	}
+
+	if len(allRulesErrors.conflicts) > 0 {
+		conds = append(conds, newSnippetsConflictedCondition(allRulesErrors.conflicts))
 	}
+
+	return valid, conds
+}
+
+// newSnippetsConflictedCondition returns the condition that reports the conflicts between the SnippetsFilters of
+// the rules of a Route.
+func newSnippetsConflictedCondition(conflicts []string) conditions.Condition {
+	msg := "SnippetsFilters of the same rule set the same directives: " + strings.Join(conflicts, "; ")
+	return staticConds.NewRouteSnippetsConflicted(msg)
 }
 
 func buildL4RoutesForGateways(
 	tlsRoutes map[types.NamespacedName]*v1alpha.TLSRoute,
+	tcpRoutes map[types.NamespacedName]*v1alpha.TCPRoute,
+	udpRoutes map[types.NamespacedName]*v1alpha.UDPRoute,
 	gatewayNsNames []types.NamespacedName,
 	services map[types.NamespacedName]*apiv1.Service,
 	npCfg *NginxProxy,
@@ -207,9 +312,100 @@ func buildL4RoutesForGateways(
 			routes[CreateRouteKeyL4(route)] = r
 		}
 	}
+
+	for _, route := range tcpRoutes {
+		r := buildTCPRoute(
+			route,
+			gatewayNsNames,
+			services,
+			npCfg,
+			resolver.refAllowedFrom(fromTCPRoute(route.Namespace)),
+		)
+		if r != nil {
+			routes[CreateRouteKeyL4(route)] = r
+		}
+	}
+
+	for _, route := range udpRoutes {
+		r := buildUDPRoute(
+			route,
+			gatewayNsNames,
+			services,
+			npCfg,
+			resolver.refAllowedFrom(fromUDPRoute(route.Namespace)),
+		)
+		if r != nil {
+			routes[CreateRouteKeyL4(route)] = r
+		}
+	}
+
 	return routes
 }
 
+// validateBackendRefL4Route validates the single BackendRef of a L4Route.
+func validateBackendRefL4Route(
+	ref v1.BackendRef,
+	routeNamespace string,
+	services map[types.NamespacedName]*apiv1.Service,
+	npCfg *NginxProxy,
+	refGrantResolver func(resource toResource) bool,
+	protocol apiv1.Protocol,
+) (BackendRef, *conditions.Condition) {
+	// Length of BackendRefs and Rules is guaranteed to be one due to earlier check when building the L4Route
+	refPath := field.NewPath("spec").Child("rules").Index(0).Child("backendRefs").Index(0)
+
+	if valid, cond := validateBackendRef(
+		ref,
+		routeNamespace,
+		refGrantResolver,
+		refPath,
+	); !valid {
+		backendRef := BackendRef{
+			Valid: false,
+		}
+
+		return backendRef, &cond
+	}
+
+	ns := routeNamespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+
+	svcNsName := types.NamespacedName{
+		Namespace: ns,
+		Name:      string(ref.Name),
+	}
+
+	svcIPFamily, svcPort, err := getIPFamilyAndPortFromRef(
+		ref,
+		svcNsName,
+		services,
+		protocol,
+		refPath,
+	)
+
+	backendRef := BackendRef{
+		SvcNsName:   svcNsName,
+		ServicePort: svcPort,
+		Valid:       true,
+	}
+
+	if err != nil {
+		backendRef.Valid = false
+
+		return backendRef, helpers.GetPointer(staticConds.NewRouteBackendRefRefBackendNotFound(err.Error()))
+	}
+
+	if err := verifyIPFamily(npCfg, svcIPFamily); err != nil {
+		backendRef.Valid = false
+
+		return backendRef, helpers.GetPointer(staticConds.NewRouteInvalidIPFamily(err.Error()))
+	}
+
+	return backendRef, nil
+}
+
 // buildGRPCRoutesForGateways builds routes from HTTP/GRPCRoutes that reference any of the specified Gateways.
 func buildRoutesForGateways(
 	validator validation.HTTPFieldsValidator,
@@ -218,6 +414,8 @@ func buildRoutesForGateways(
 	gatewayNsNames []types.NamespacedName,
 	npCfg *NginxProxy,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	bodyTransforms map[types.NamespacedName]*HTTPBodyTransform,
+	directResponses map[types.NamespacedName]*DirectResponse,
 ) map[RouteKey]*L7Route {
 	if len(gatewayNsNames) == 0 {
 		return nil
@@ -226,17 +424,28 @@ func buildRoutesForGateways(
 	routes := make(map[RouteKey]*L7Route)
 
 	http2disabled := isHTTP2Disabled(npCfg)
+	regexPathMatchDisabled := isRegexPathMatchDisabled(npCfg)
 
 	for _, route := range httpRoutes {
-		r := buildHTTPRoute(validator, route, gatewayNsNames, snippetsFilters)
+		r := buildHTTPRoute(
+			validator,
+			route,
+			gatewayNsNames,
+			regexPathMatchDisabled,
+			snippetsFilters,
+			bodyTransforms,
+			directResponses,
+		)
 		if r != nil {
+			setDebugCapture(r)
 			routes[CreateRouteKey(route)] = r
 		}
 	}
 
 	for _, route := range grpcRoutes {
-		r := buildGRPCRoute(validator, route, gatewayNsNames, http2disabled, snippetsFilters)
+		r := buildGRPCRoute(validator, route, gatewayNsNames, http2disabled, regexPathMatchDisabled, snippetsFilters)
 		if r != nil {
+			setDebugCapture(r)
 			routes[CreateRouteKey(route)] = r
 		}
 	}
@@ -244,6 +453,16 @@ func buildRoutesForGateways(
 	return routes
 }
 
+// setDebugCapture sets the DebugCapture of the Route from its annotations.
+func setDebugCapture(r *L7Route) {
+	capture, cond := buildDebugCapture(r.Source.GetAnnotations())
+	if cond != nil {
+		r.Conditions = append(r.Conditions, *cond)
+	}
+
+	r.DebugCapture = capture
+}
+
 func isHTTP2Disabled(npCfg *NginxProxy) bool {
 	if npCfg == nil {
 		return false
@@ -251,6 +470,13 @@ func isHTTP2Disabled(npCfg *NginxProxy) bool {
 	return npCfg.Source.Spec.DisableHTTP2
 }
 
+func isRegexPathMatchDisabled(npCfg *NginxProxy) bool {
+	if npCfg == nil {
+		return false
+	}
+	return npCfg.Source.Spec.DisableRegexPathMatch
+}
+
 func buildSectionNameRefs(
 	parentRefs []v1.ParentReference,
 	routeNamespace string,
@@ -326,15 +552,15 @@ func findGatewayForParentRef(
 func bindRoutesToListeners(
 	l7Routes map[RouteKey]*L7Route,
 	l4Routes map[L4RouteKey]*L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 ) {
-	if gw == nil {
+	if len(gws) == 0 {
 		return
 	}
 
 	for _, r := range l7Routes {
-		bindL7RouteToListeners(r, gw, namespaces)
+		bindL7RouteToListeners(r, gws, namespaces)
 	}
 
 	routes := make([]*L7Route, 0, len(l7Routes))
@@ -342,7 +568,12 @@ func bindRoutesToListeners(
 		routes = append(routes, r)
 	}
 
-	isolateL7RouteListeners(routes, gw.Listeners)
+	var listeners []*Listener
+	for _, gw := range gws {
+		listeners = append(listeners, gw.Listeners...)
+	}
+
+	isolateL7RouteListeners(routes, listeners)
 
 	l4RouteSlice := make([]*L4Route, 0, len(l4Routes))
 	for _, r := range l4Routes {
@@ -356,20 +587,32 @@ func bindRoutesToListeners(
 
 	// portHostnamesMap exists to detect duplicate hostnames on the same port
 	portHostnamesMap := make(map[string]struct{})
+	listenerHostnameMap := buildListenerHostnameMap(listeners)
 
 	for _, r := range l4RouteSlice {
-		bindL4RouteToListeners(r, gw, namespaces, portHostnamesMap)
+		bindL4RouteToListeners(r, gws, namespaces, portHostnamesMap, listenerHostnameMap)
 	}
 
-	isolateL4RouteListeners(l4RouteSlice, gw.Listeners)
+	isolateL4RouteListeners(l4RouteSlice, listeners)
+}
+
+// listenerKey uniquely identifies a Listener across the Gateways.
+type listenerKey struct {
+	gateway types.NamespacedName
+	name    string
+}
+
+// listenerHostname is the hostname of a Listener and the port the Listener is on.
+type listenerHostname struct {
+	hostname string
+	port     v1.PortNumber
 }
 
 // isolateL7RouteListeners ensures listener isolation for all L7Routes.
+// Because the listeners of all the Gateways share the same NGINX configuration, the listeners are isolated
+// across the Gateways.
 func isolateL7RouteListeners(routes []*L7Route, listeners []*Listener) {
-	listenerHostnameMap := make(map[string]string, len(listeners))
-	for _, l := range listeners {
-		listenerHostnameMap[l.Name] = getHostname(l.Source.Hostname)
-	}
+	listenerHostnameMap := buildListenerHostnameMap(listeners)
 
 	for _, route := range routes {
 		isolateHostnamesForParentRefs(route.ParentRefs, listenerHostnameMap)
@@ -378,37 +621,56 @@ func isolateL7RouteListeners(routes []*L7Route, listeners []*Listener) {
 
 // isolateL4RouteListeners ensures listener isolation for all L4Routes.
 func isolateL4RouteListeners(routes []*L4Route, listeners []*Listener) {
-	listenerHostnameMap := make(map[string]string, len(listeners))
-	for _, l := range listeners {
-		listenerHostnameMap[l.Name] = getHostname(l.Source.Hostname)
-	}
+	listenerHostnameMap := buildListenerHostnameMap(listeners)
 
 	for _, route := range routes {
 		isolateHostnamesForParentRefs(route.ParentRefs, listenerHostnameMap)
 	}
 }
 
+// buildListenerHostnameMap returns the hostnames and the ports of the valid listeners. Invalid listeners don't
+// receive any traffic, so they don't take hostnames from other listeners.
+func buildListenerHostnameMap(listeners []*Listener) map[listenerKey]listenerHostname {
+	listenerHostnameMap := make(map[listenerKey]listenerHostname, len(listeners))
+	for _, l := range listeners {
+		if !l.Valid {
+			continue
+		}
+
+		listenerHostnameMap[listenerKey{gateway: l.GatewayName, name: l.Name}] = listenerHostname{
+			hostname: getHostname(l.Source.Hostname),
+			port:     l.Source.Port,
+		}
+	}
+
+	return listenerHostnameMap
+}
+
 // isolateHostnamesForParentRefs iterates through the parentRefs of a route to identify the list of accepted hostnames
-// for each listener. If any accepted hostname belongs to another listener,
-// it removes those hostnames to ensure listener isolation.
-func isolateHostnamesForParentRefs(parentRef []ParentRef, listenerHostnameMap map[string]string) {
+// for each listener. As defined by the Gateway API, a request must be handled by the listener with the most specific
+// hostname that matches the request, among the listeners on the same port. Because of that, if an accepted hostname
+// also matches a more specific listener on the same port, it removes the hostname to ensure listener isolation.
+// For example, for the listeners "*.example.com" and "foo.example.com", the Routes attached to "*.example.com" don't
+// receive the requests for "foo.example.com".
+func isolateHostnamesForParentRefs(parentRef []ParentRef, listenerHostnameMap map[listenerKey]listenerHostname) {
 	for _, ref := range parentRef {
 		acceptedHostnames := ref.Attachment.AcceptedHostnames
 
-		hostnamesToRemoves := make(map[string]struct{})
 		for listenerName, hostnames := range acceptedHostnames {
 			if len(hostnames) == 0 {
 				continue
 			}
+
+			key := listenerKey{gateway: ref.Gateway, name: listenerName}
+
+			if _, exists := listenerHostnameMap[key]; !exists {
+				continue
+			}
+
+			hostnamesToRemoves := make(map[string]struct{})
 			for _, h := range hostnames {
-				for lName, lHostname := range listenerHostnameMap {
-					// skip comparison if it is a catch all listener block
-					if lHostname == "" {
-						continue
-					}
-					if h == lHostname && listenerName != lName {
-						hostnamesToRemoves[h] = struct{}{}
-					}
+				if hostnameIsolatedFromListener(h, key, listenerHostnameMap) {
+					hostnamesToRemoves[h] = struct{}{}
 				}
 			}
 
@@ -418,6 +680,56 @@ func isolateHostnamesForParentRefs(parentRef []ParentRef, listenerHostnameMap ma
 	}
 }
 
+// hostnameIsolatedFromListener returns true if the hostname belongs to another listener on the same port, which has
+// a more specific hostname than the listener with the key.
+func hostnameIsolatedFromListener(
+	hostname string,
+	key listenerKey,
+	listenerHostnameMap map[listenerKey]listenerHostname,
+) bool {
+	listener, exists := listenerHostnameMap[key]
+	if !exists {
+		return false
+	}
+
+	for lKey, l := range listenerHostnameMap {
+		if lKey == key || l.port != listener.port {
+			continue
+		}
+
+		if hostnameMatchesListener(hostname, l.hostname) && listenerHostnameMoreSpecific(l.hostname, listener.hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hostnameMatchesListener returns true if all the requests for the accepted hostname match the listener hostname.
+// A catch-all listener hostname is ignored, because it is never more specific than another listener hostname.
+func hostnameMatchesListener(hostname, listenerHostname string) bool {
+	if listenerHostname == "" {
+		return false
+	}
+
+	if hostname == listenerHostname {
+		return true
+	}
+
+	return strings.HasPrefix(listenerHostname, "*.") &&
+		strings.HasSuffix(hostname, strings.TrimPrefix(listenerHostname, "*"))
+}
+
+// listenerHostnameMoreSpecific returns true if the listener hostname1 is more specific than the listener hostname2.
+// It assumes that both hostnames match the same hostname.
+func listenerHostnameMoreSpecific(hostname1, hostname2 string) bool {
+	if hostname1 == hostname2 {
+		return false
+	}
+
+	return GetMoreSpecificHostname(hostname1, hostname2) == hostname1
+}
+
 // removeHostnames removes the hostnames that are part of toRemove slice.
 func removeHostnames(hostnames []string, toRemove map[string]struct{}) []string {
 	result := make([]string, 0, len(hostnames))
@@ -439,38 +751,20 @@ func validateParentRef(
 
 	ref.Attachment = attachment
 
-	path := field.NewPath("spec").Child("parentRefs").Index(ref.Idx)
-
 	attachableListeners, listenerExists := findAttachableListeners(
 		getSectionName(ref.SectionName),
+		ref.Port,
 		gw.Listeners,
 	)
 
-	// Case 1: Attachment is not possible because the specified SectionName does not match any Listeners in the
+	// Case 1: Attachment is not possible because the specified SectionName and Port do not match any Listeners in the
 	// Gateway.
 	if !listenerExists {
 		attachment.FailedCondition = staticConds.NewRouteNoMatchingParent()
 		return attachment, nil
 	}
 
-	// Case 2: Attachment is not possible due to unsupported configuration.
-
-	if ref.Port != nil {
-		valErr := field.Forbidden(path.Child("port"), "cannot be set")
-		attachment.FailedCondition = staticConds.NewRouteUnsupportedValue(valErr.Error())
-		return attachment, attachableListeners
-	}
-
-	// Case 3: the parentRef references an ignored Gateway resource.
-
-	referencesWinningGw := ref.Gateway.Namespace == gw.Source.Namespace && ref.Gateway.Name == gw.Source.Name
-
-	if !referencesWinningGw {
-		attachment.FailedCondition = staticConds.NewRouteNotAcceptedGatewayIgnored()
-		return attachment, attachableListeners
-	}
-
-	// Case 4: Attachment is not possible because Gateway is invalid
+	// Case 2: Attachment is not possible because Gateway is invalid
 
 	if !gw.Valid {
 		attachment.FailedCondition = staticConds.NewRouteInvalidGateway()
@@ -481,9 +775,10 @@ func validateParentRef(
 
 func bindL4RouteToListeners(
 	route *L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 	portHostnamesMap map[string]struct{},
+	listenerHostnameMap map[listenerKey]listenerHostname,
 ) {
 	if !route.Attachable {
 		return
@@ -492,13 +787,15 @@ func bindL4RouteToListeners(
 	for i := range route.ParentRefs {
 		ref := &(route.ParentRefs)[i]
 
+		// parentRefs only reference the Gateways that belong to NGF.
+		gw := gws[ref.Gateway]
+
 		attachment, attachableListeners := validateParentRef(ref, gw)
 
 		if attachment.FailedCondition != (conditions.Condition{}) {
 			continue
 		}
 
-		// Winning Gateway
 		// Try to attach Route to all matching listeners
 
 		cond, attached := tryToAttachL4RouteToListeners(
@@ -508,13 +805,14 @@ func bindL4RouteToListeners(
 			gw,
 			namespaces,
 			portHostnamesMap,
+			listenerHostnameMap,
 		)
 		if !attached {
 			attachment.FailedCondition = cond
 			continue
 		}
 		if cond != (conditions.Condition{}) {
-			route.Conditions = append(route.Conditions, cond)
+			attachment.Conditions = append(attachment.Conditions, cond)
 		}
 
 		attachment.Attached = true
@@ -533,6 +831,7 @@ func tryToAttachL4RouteToListeners(
 	gw *Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 	portHostnamesMap map[string]struct{},
+	listenerHostnameMap map[listenerKey]listenerHostname,
 ) (conditions.Condition, bool) {
 	if len(attachableListeners) == 0 {
 		return staticConds.NewRouteInvalidListener(), false
@@ -563,6 +862,7 @@ func tryToAttachL4RouteToListeners(
 			gw,
 			namespaces,
 			portHostnamesMap,
+			listenerHostnameMap,
 			refStatus,
 		)
 		allowed = allowed || routeAllowed
@@ -594,22 +894,45 @@ func bindToListenerL4(
 	gw *Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 	portHostnamesMap map[string]struct{},
+	listenerHostnameMap map[listenerKey]listenerHostname,
 	refStatus *ParentRefAttachmentStatus,
 ) (allowed, attached, notConflicting bool) {
 	if !isRouteNamespaceAllowedByListener(l, route.Source.GetNamespace(), gw.Source.Namespace, namespaces) {
 		return false, false, false
 	}
 
-	if !isRouteTypeAllowedByListener(l, kinds.TLSRoute) {
+	routeKey := CreateRouteKeyL4(route.Source)
+
+	if !isRouteTypeAllowedByListener(l, convertRouteType(routeKey.RouteType)) {
 		return false, false, false
 	}
 
-	acceptedListenerHostnames := findAcceptedHostnames(l.Source.Hostname, route.Spec.Hostnames)
+	listenerHostname := l.Source.Hostname
+	if routeKey.RouteType == RouteTypeTCP || routeKey.RouteType == RouteTypeUDP {
+		// TCP and UDP traffic carries no hostname, so the Listener hostname is ignored and only a single
+		// TCPRoute or UDPRoute can be attached per port.
+		listenerHostname = nil
+	}
+
+	acceptedListenerHostnames := findAcceptedHostnames(listenerHostname, route.Spec.Hostnames)
 
 	hostnames := make([]string, 0)
+	key := listenerKey{gateway: l.GatewayName, name: l.Name}
 
 	for _, h := range acceptedListenerHostnames {
+		// A hostname that belongs to a more specific listener on the same port is removed from this listener
+		// during the listener isolation. Such a hostname must not be reserved for the port, otherwise the Routes
+		// attached to the more specific listener would conflict with this Route.
+		if hostnameIsolatedFromListener(h, key, listenerHostnameMap) {
+			hostnames = append(hostnames, h)
+			continue
+		}
+
 		portHostname := fmt.Sprintf("%s:%d", h, l.Source.Port)
+		if routeKey.RouteType == RouteTypeUDP {
+			// UDP ports don't overlap with TCP ports
+			portHostname += "/udp"
+		}
 		_, ok := portHostnamesMap[portHostname]
 		if !ok {
 			portHostnamesMap[portHostname] = struct{}{}
@@ -628,14 +951,14 @@ func bindToListenerL4(
 	}
 
 	refStatus.AcceptedHostnames[string(l.Source.Name)] = hostnames
-	l.L4Routes[CreateRouteKeyL4(route.Source)] = route
+	l.L4Routes[routeKey] = route
 
 	return true, true, true
 }
 
 func bindL7RouteToListeners(
 	route *L7Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 ) {
 	if !route.Attachable {
@@ -645,13 +968,15 @@ func bindL7RouteToListeners(
 	for i := range route.ParentRefs {
 		ref := &(route.ParentRefs)[i]
 
+		// parentRefs only reference the Gateways that belong to NGF.
+		gw := gws[ref.Gateway]
+
 		attachment, attachableListeners := validateParentRef(ref, gw)
 
 		if attachment.FailedCondition != (conditions.Condition{}) {
 			continue
 		}
 
-		// Winning Gateway
 		// Try to attach Route to all matching listeners
 
 		cond, attached := tryToAttachL7RouteToListeners(
@@ -666,7 +991,7 @@ func bindL7RouteToListeners(
 			continue
 		}
 		if cond != (conditions.Condition{}) {
-			route.Conditions = append(route.Conditions, cond)
+			attachment.Conditions = append(attachment.Conditions, cond)
 		}
 
 		attachment.Attached = true
@@ -737,12 +1062,20 @@ func tryToAttachL7RouteToListeners(
 	return conditions.Condition{}, true
 }
 
-// findAttachableListeners returns a list of attachable listeners and whether the listener exists for a non-empty
-// sectionName.
-func findAttachableListeners(sectionName string, listeners []*Listener) ([]*Listener, bool) {
+// findAttachableListeners returns the attachable listeners that match the sectionName and the port of a parentRef.
+// If both are set, a listener must match both of them. It also returns false if no listener matches the
+// sectionName and the port, including the non-attachable listeners.
+func findAttachableListeners(sectionName string, port *v1.PortNumber, listeners []*Listener) ([]*Listener, bool) {
+	portMatches := func(l *Listener) bool {
+		return port == nil || l.Source.Port == *port
+	}
+
 	if sectionName != "" {
 		for _, l := range listeners {
 			if l.Name == sectionName {
+				if !portMatches(l) {
+					return nil, false
+				}
 				if l.Attachable {
 					return []*Listener{l}, true
 				}
@@ -752,8 +1085,17 @@ func findAttachableListeners(sectionName string, listeners []*Listener) ([]*List
 		return nil, false
 	}
 
+	// without a port, the parentRef matches the Gateway even if it has no listeners
+	listenerExists := port == nil
+
 	attachableListeners := make([]*Listener, 0, len(listeners))
 	for _, l := range listeners {
+		if !portMatches(l) {
+			continue
+		}
+
+		listenerExists = true
+
 		if !l.Attachable {
 			continue
 		}
@@ -761,7 +1103,7 @@ func findAttachableListeners(sectionName string, listeners []*Listener) ([]*List
 		attachableListeners = append(attachableListeners, l)
 	}
 
-	return attachableListeners, true
+	return attachableListeners, listenerExists
 }
 
 func findAcceptedHostnames(listenerHostname *v1.Hostname, routeHostnames []v1.Hostname) []string {
@@ -891,6 +1233,12 @@ func convertRouteType(routeType RouteType) v1.Kind {
 		return kinds.HTTPRoute
 	case RouteTypeGRPC:
 		return kinds.GRPCRoute
+	case RouteTypeTLS:
+		return kinds.TLSRoute
+	case RouteTypeTCP:
+		return kinds.TCPRoute
+	case RouteTypeUDP:
+		return kinds.UDPRoute
 	default:
 		panic(fmt.Sprintf("unsupported route type: %s", routeType))
 	}
@@ -978,3 +1326,17 @@ func routeKeyForKind(kind v1.Kind, nsname types.NamespacedName) RouteKey {
 
 	return key
 }
+
+func l4RouteKeyForKind(kind v1.Kind, nsname types.NamespacedName) L4RouteKey {
+	key := L4RouteKey{NamespacedName: nsname}
+	switch kind {
+	case kinds.TLSRoute:
+		key.RouteType = RouteTypeTLS
+	case kinds.TCPRoute:
+		key.RouteType = RouteTypeTCP
+	default:
+		panic(fmt.Sprintf("unsupported route kind: %s", kind))
+	}
+
+	return key
+}
@@ -302,6 +302,7 @@ func TestBindRouteToListeners(t *testing.T) {
 		helpers.GetPointer[gatewayv1.SectionName]("listener-80-2"),
 		nil,
 	)
+	hrWithPortOnly := createHTTPRouteWithSectionNameAndPort(nil, helpers.GetPointer[gatewayv1.PortNumber](80))
 
 	var normalHTTPRoute *L7Route
 	createNormalHTTPRoute := func(gateway *gatewayv1.Gateway) *L7Route {
@@ -394,22 +395,40 @@ func TestBindRouteToListeners(t *testing.T) {
 			},
 		},
 	}
-	routeWithPort := &L7Route{
-		RouteType:  RouteTypeHTTP,
-		Source:     hrWithPort,
-		Valid:      true,
-		Attachable: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:         0,
-				Gateway:     client.ObjectKeyFromObject(gw),
-				SectionName: hrWithPort.Spec.ParentRefs[0].SectionName,
-				Port:        hrWithPort.Spec.ParentRefs[0].Port,
+	createRouteWithPort := func(source *gatewayv1.HTTPRoute) *L7Route {
+		return &L7Route{
+			RouteType: RouteTypeHTTP,
+			Source:    source,
+			Spec: L7RouteSpec{
+				Hostnames: source.Spec.Hostnames,
+			},
+			Valid:      true,
+			Attachable: true,
+			ParentRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: source.Spec.ParentRefs[0].SectionName,
+					Port:        source.Spec.ParentRefs[0].Port,
+				},
 			},
+		}
+	}
+	routeWithPort := createRouteWithPort(hrWithPort)
+	routeWithPortOnly := createRouteWithPort(hrWithPortOnly)
+	routeWithWrongPort := createRouteWithPort(hrWithPort)
+	routeWithPortOnlyNoListener := createRouteWithPort(hrWithPortOnly)
+
+	setPort80 := func(l *Listener) {
+		l.Source.Port = 80
+	}
+	mergedGw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "merged-gateway",
 		},
 	}
-	ignoredGwNsName := types.NamespacedName{Namespace: "test", Name: "ignored-gateway"}
-	routeWithIgnoredGateway := &L7Route{
+	routeWithMergedGateway := &L7Route{
 		RouteType:  RouteTypeHTTP,
 		Source:     hr,
 		Valid:      true,
@@ -417,7 +436,7 @@ func TestBindRouteToListeners(t *testing.T) {
 		ParentRefs: []ParentRef{
 			{
 				Idx:         0,
-				Gateway:     ignoredGwNsName,
+				Gateway:     client.ObjectKeyFromObject(mergedGw),
 				SectionName: hr.Spec.ParentRefs[0].SectionName,
 			},
 		},
@@ -500,12 +519,14 @@ func TestBindRouteToListeners(t *testing.T) {
 	}
 
 	tests := []struct {
-		route                    *L7Route
-		gateway                  *Gateway
-		expectedGatewayListeners []*Listener
-		name                     string
-		expectedSectionNameRefs  []ParentRef
-		expectedConditions       []conditions.Condition
+		route                          *L7Route
+		gateway                        *Gateway
+		mergedGateway                  *Gateway
+		expectedGatewayListeners       []*Listener
+		expectedMergedGatewayListeners []*Listener
+		name                           string
+		expectedSectionNameRefs        []ParentRef
+		expectedConditions             []conditions.Condition
 	}{
 		{
 			route: createNormalHTTPRoute(gw),
@@ -635,6 +656,40 @@ func TestBindRouteToListeners(t *testing.T) {
 		},
 		{
 			route: routeWithPort,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", setPort80),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: hrWithPort.Spec.ParentRefs[0].SectionName,
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
+						ListenerPort: 80,
+					},
+					Port: hrWithPort.Spec.ParentRefs[0].Port,
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					setPort80(l)
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hrWithPort): routeWithPort,
+					}
+				}),
+			},
+			name: "section name and port are configured",
+		},
+		{
+			route: routeWithWrongPort,
 			gateway: &Gateway{
 				Source: gw,
 				Valid:  true,
@@ -648,10 +703,8 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: hrWithPort.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached: false,
-						FailedCondition: staticConds.NewRouteUnsupportedValue(
-							`spec.parentRefs[0].port: Forbidden: cannot be set`,
-						),
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNoMatchingParent(),
 						AcceptedHostnames: map[string][]string{},
 					},
 					Port: hrWithPort.Spec.ParentRefs[0].Port,
@@ -660,7 +713,76 @@ func TestBindRouteToListeners(t *testing.T) {
 			expectedGatewayListeners: []*Listener{
 				createListener("listener-80-1"),
 			},
-			name: "port is configured",
+			name: "port doesn't match the port of the section name listener",
+		},
+		{
+			route: routeWithPortOnly,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createModifiedListener("listener-80-1", setPort80),
+					createModifiedListener("listener-80-2", setPort80),
+					createListener("listener-8080"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+							"listener-80-2": {"foo.example.com"},
+						},
+						ListenerPort: 80,
+					},
+					Port: hrWithPortOnly.Spec.ParentRefs[0].Port,
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					setPort80(l)
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hrWithPortOnly): routeWithPortOnly,
+					}
+				}),
+				createModifiedListener("listener-80-2", func(l *Listener) {
+					setPort80(l)
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hrWithPortOnly): routeWithPortOnly,
+					}
+				}),
+				createListener("listener-8080"),
+			},
+			name: "port is configured without section name",
+		},
+		{
+			route: routeWithPortOnlyNoListener,
+			gateway: &Gateway{
+				Source: gw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-8080"),
+				},
+			},
+			expectedSectionNameRefs: []ParentRef{
+				{
+					Idx:     0,
+					Gateway: client.ObjectKeyFromObject(gw),
+					Attachment: &ParentRefAttachmentStatus{
+						Attached:          false,
+						FailedCondition:   staticConds.NewRouteNoMatchingParent(),
+						AcceptedHostnames: map[string][]string{},
+					},
+					Port: hrWithPortOnly.Spec.ParentRefs[0].Port,
+				},
+			},
+			expectedGatewayListeners: []*Listener{
+				createListener("listener-8080"),
+			},
+			name: "no listener on the port",
 		},
 		{
 			route: routeWithNonExistingListener,
@@ -741,7 +863,7 @@ func TestBindRouteToListeners(t *testing.T) {
 			name: "no matching listener hostname",
 		},
 		{
-			route: routeWithIgnoredGateway,
+			route: routeWithMergedGateway,
 			gateway: &Gateway{
 				Source: gw,
 				Valid:  true,
@@ -749,22 +871,37 @@ func TestBindRouteToListeners(t *testing.T) {
 					createListener("listener-80-1"),
 				},
 			},
+			mergedGateway: &Gateway{
+				Source: mergedGw,
+				Valid:  true,
+				Listeners: []*Listener{
+					createListener("listener-80-1"),
+				},
+			},
 			expectedSectionNameRefs: []ParentRef{
 				{
 					Idx:         0,
-					Gateway:     ignoredGwNsName,
+					Gateway:     client.ObjectKeyFromObject(mergedGw),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-						AcceptedHostnames: map[string][]string{},
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
 					},
 				},
 			},
 			expectedGatewayListeners: []*Listener{
 				createListener("listener-80-1"),
 			},
-			name: "gateway is ignored",
+			expectedMergedGatewayListeners: []*Listener{
+				createModifiedListener("listener-80-1", func(l *Listener) {
+					l.Routes = map[RouteKey]*L7Route{
+						CreateRouteKey(hr): routeWithMergedGateway,
+					}
+				}),
+			},
+			name: "route attaches to a merged gateway",
 		},
 		{
 			route: invalidRoute,
@@ -831,7 +968,8 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
+						Attached:   true,
+						Conditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
 						AcceptedHostnames: map[string][]string{
 							"listener-80-1": {"foo.example.com"},
 						},
@@ -846,8 +984,7 @@ func TestBindRouteToListeners(t *testing.T) {
 					}
 				}),
 			},
-			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
-			name:               "invalid attachable listener",
+			name: "invalid attachable listener",
 		},
 		{
 			route: invalidAttachableRoute1,
@@ -897,7 +1034,8 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached: true,
+						Attached:   true,
+						Conditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
 						AcceptedHostnames: map[string][]string{
 							"listener-80-1": {"foo.example.com"},
 						},
@@ -912,8 +1050,7 @@ func TestBindRouteToListeners(t *testing.T) {
 					}
 				}),
 			},
-			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
-			name:               "invalid attachable listener with invalid attachable route",
+			name: "invalid attachable listener with invalid attachable route",
 		},
 		{
 			route: createNormalHTTPRoute(gw),
@@ -1224,14 +1361,24 @@ func TestBindRouteToListeners(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
+			gws := map[types.NamespacedName]*Gateway{
+				client.ObjectKeyFromObject(test.gateway.Source): test.gateway,
+			}
+			if test.mergedGateway != nil {
+				gws[client.ObjectKeyFromObject(test.mergedGateway.Source)] = test.mergedGateway
+			}
+
 			bindL7RouteToListeners(
 				test.route,
-				test.gateway,
+				gws,
 				namespaces,
 			)
 
 			g.Expect(test.route.ParentRefs).To(Equal(test.expectedSectionNameRefs))
 			g.Expect(helpers.Diff(test.gateway.Listeners, test.expectedGatewayListeners)).To(BeEmpty())
+			if test.mergedGateway != nil {
+				g.Expect(helpers.Diff(test.mergedGateway.Listeners, test.expectedMergedGatewayListeners)).To(BeEmpty())
+			}
 			g.Expect(helpers.Diff(test.route.Conditions, test.expectedConditions)).To(BeEmpty())
 		})
 	}
@@ -1405,6 +1552,88 @@ func TestValidateHostnames(t *testing.T) {
 	}
 }
 
+func TestBuildRouteRulesConditions(t *testing.T) {
+	t.Parallel()
+
+	invalidErr := field.Invalid(field.NewPath("spec", "rules").Index(1).Child("matches"), "bad", "invalid match")
+	ignoredErr := field.Invalid(field.NewPath("spec", "rules").Index(0).Child("timeouts"), "bad", "invalid timeout")
+	resolveErr := field.NotFound(field.NewPath("spec", "rules").Index(0).Child("filters"), "filter")
+
+	tests := []struct {
+		name     string
+		rules    []RouteRule
+		errors   routeRuleErrors
+		expConds []conditions.Condition
+		expValid bool
+	}{
+		{
+			name:     "no rules",
+			expConds: []conditions.Condition{},
+			expValid: true,
+		},
+		{
+			name:     "all rules valid",
+			rules:    []RouteRule{{}, {}},
+			expConds: []conditions.Condition{},
+			expValid: true,
+		},
+		{
+			name:   "some rules dropped",
+			rules:  []RouteRule{{}, {Dropped: true}, {Dropped: true}},
+			errors: routeRuleErrors{invalid: field.ErrorList{invalidErr}},
+			expConds: []conditions.Condition{
+				staticConds.NewRoutePartiallyInvalid("1, 2. Invalid fields: " + invalidErr.Error()),
+			},
+			expValid: true,
+		},
+		{
+			name:   "all rules dropped",
+			rules:  []RouteRule{{Dropped: true}, {Dropped: true}},
+			errors: routeRuleErrors{invalid: field.ErrorList{invalidErr}},
+			expConds: []conditions.Condition{
+				staticConds.NewRouteUnsupportedValue("All rules are invalid: " + invalidErr.Error()),
+			},
+			expValid: false,
+		},
+		{
+			name:   "no rules dropped, with ignored fields",
+			rules:  []RouteRule{{}, {}},
+			errors: routeRuleErrors{ignored: field.ErrorList{ignoredErr}},
+			expConds: []conditions.Condition{
+				staticConds.NewRouteIgnoredFields("Ignored invalid fields: " + ignoredErr.Error()),
+			},
+			expValid: true,
+		},
+		{
+			name:  "some rules dropped, with ignored fields and unresolved filters",
+			rules: []RouteRule{{}, {Dropped: true}},
+			errors: routeRuleErrors{
+				invalid: field.ErrorList{invalidErr},
+				ignored: field.ErrorList{ignoredErr},
+				resolve: field.ErrorList{resolveErr},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewRoutePartiallyInvalid("1. Invalid fields: " + invalidErr.Error()),
+				staticConds.NewRouteIgnoredFields("Ignored invalid fields: " + ignoredErr.Error()),
+				staticConds.NewRouteResolvedRefsInvalidFilter(resolveErr.Error()),
+			},
+			expValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			valid, conds := buildRouteRulesConditions(test.rules, test.errors)
+
+			g.Expect(valid).To(Equal(test.expValid))
+			g.Expect(conds).To(Equal(test.expConds))
+		})
+	}
+}
+
 func TestRouteKeyForKind(t *testing.T) {
 	t.Parallel()
 	nsname := types.NamespacedName{Namespace: testNs, Name: "route"}
@@ -1620,21 +1849,6 @@ func TestBindL4RouteToListeners(t *testing.T) {
 		},
 		Attachable: true,
 	}
-	routeReferencesWrongNamespace := &L4Route{
-		Source: tr,
-		Spec: L4RouteSpec{
-			Hostnames: tr.Spec.Hostnames,
-		},
-		Valid: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:         0,
-				Gateway:     client.ObjectKeyFromObject(gwWrongNamespace),
-				SectionName: tr.Spec.ParentRefs[0].SectionName,
-			},
-		},
-		Attachable: true,
-	}
 
 	tests := []struct {
 		route                    *L4Route
@@ -1731,13 +1945,8 @@ func TestBindL4RouteToListeners(t *testing.T) {
 				{
 					Attachment: &ParentRefAttachmentStatus{
 						AcceptedHostnames: map[string][]string{},
-						FailedCondition: conditions.Condition{
-							Type:    "Accepted",
-							Status:  "False",
-							Reason:  "UnsupportedValue",
-							Message: "spec.parentRefs[0].port: Forbidden: cannot be set",
-						},
-						Attached: false,
+						FailedCondition:   staticConds.NewRouteNoMatchingParent(),
+						Attached:          false,
 					},
 					SectionName: tr.Spec.ParentRefs[0].SectionName,
 					Gateway:     client.ObjectKeyFromObject(gw),
@@ -1748,38 +1957,7 @@ func TestBindL4RouteToListeners(t *testing.T) {
 			expectedGatewayListeners: []*Listener{
 				createListener("listener-443"),
 			},
-			name: "port is not nil",
-		},
-		{
-			route: routeReferencesWrongNamespace,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-443"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Attachment: &ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition: conditions.Condition{
-							Type:    "Accepted",
-							Status:  "False",
-							Reason:  "GatewayIgnored",
-							Message: "The Gateway is ignored by the controller",
-						},
-						Attached: false,
-					},
-					SectionName: tr.Spec.ParentRefs[0].SectionName,
-					Gateway:     client.ObjectKeyFromObject(gwWrongNamespace),
-					Idx:         0,
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-443"),
-			},
-			name: "ignored gateway",
+			name: "port doesn't match the listener",
 		},
 		{
 			route: createNormalRoute(gw),
@@ -1874,7 +2052,8 @@ func TestBindL4RouteToListeners(t *testing.T) {
 						AcceptedHostnames: map[string][]string{
 							"listener-443": {"foo.example.com"},
 						},
-						Attached: true,
+						Attached:   true,
+						Conditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
 					},
 				},
 			},
@@ -1882,7 +2061,6 @@ func TestBindL4RouteToListeners(t *testing.T) {
 				createModifiedListener("listener-443", func(l *Listener) {
 					l.Valid = false
 					r := createNormalRoute(gw)
-					r.Conditions = append(r.Conditions, staticConds.NewRouteInvalidListener())
 					r.ParentRefs = []ParentRef{
 						{
 							Idx:         0,
@@ -1892,7 +2070,8 @@ func TestBindL4RouteToListeners(t *testing.T) {
 								AcceptedHostnames: map[string][]string{
 									"listener-443": {"foo.example.com"},
 								},
-								Attached: true,
+								Attached:   true,
+								Conditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
 							},
 						},
 					}
@@ -1901,8 +2080,7 @@ func TestBindL4RouteToListeners(t *testing.T) {
 					}
 				}),
 			},
-			expectedConditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
-			name:               "invalid attachable listener",
+			name: "invalid attachable listener",
 		},
 		{
 			route: createNormalRoute(gw),
@@ -2095,9 +2273,12 @@ func TestBindL4RouteToListeners(t *testing.T) {
 
 			bindL4RouteToListeners(
 				test.route,
-				test.gateway,
+				map[types.NamespacedName]*Gateway{
+					client.ObjectKeyFromObject(test.gateway.Source): test.gateway,
+				},
 				namespaces,
 				map[string]struct{}{},
+				nil,
 			)
 
 			g.Expect(test.route.ParentRefs).To(Equal(test.expectedSectionNameRefs))
@@ -2107,6 +2288,383 @@ func TestBindL4RouteToListeners(t *testing.T) {
 	}
 }
 
+func TestBindL4RouteToListeners_TCPAndUDP(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	tcpListener := &Listener{
+		Name: "tcp-5432",
+		Source: gatewayv1.Listener{
+			Name:     "tcp-5432",
+			Hostname: (*gatewayv1.Hostname)(helpers.GetPointer("foo.example.com")),
+			Port:     5432,
+			Protocol: gatewayv1.TCPProtocolType,
+		},
+		SupportedKinds: []gatewayv1.RouteGroupKind{
+			{Kind: kinds.TCPRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+		},
+		Valid:      true,
+		Attachable: true,
+		Routes:     map[RouteKey]*L7Route{},
+		L4Routes:   map[L4RouteKey]*L4Route{},
+	}
+
+	udpListener := &Listener{
+		Name: "udp-5432",
+		Source: gatewayv1.Listener{
+			Name:     "udp-5432",
+			Port:     5432,
+			Protocol: gatewayv1.UDPProtocolType,
+		},
+		SupportedKinds: []gatewayv1.RouteGroupKind{
+			{Kind: kinds.UDPRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+		},
+		Valid:      true,
+		Attachable: true,
+		Routes:     map[RouteKey]*L7Route{},
+		L4Routes:   map[L4RouteKey]*L4Route{},
+	}
+
+	gateway := &Gateway{
+		Source:    gw,
+		Valid:     true,
+		Listeners: []*Listener{tcpListener, udpListener},
+	}
+
+	parentRefs := []gatewayv1.ParentReference{
+		{
+			Name:        gatewayv1.ObjectName(gw.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("tcp-5432"),
+		},
+	}
+	udpParentRefs := []gatewayv1.ParentReference{
+		{
+			Name:        gatewayv1.ObjectName(gw.Name),
+			SectionName: helpers.GetPointer[gatewayv1.SectionName]("udp-5432"),
+		},
+	}
+
+	createRoute := func(source client.Object, sectionName *gatewayv1.SectionName) *L4Route {
+		return &L4Route{
+			Source:     source,
+			Valid:      true,
+			Attachable: true,
+			ParentRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: sectionName,
+				},
+			},
+		}
+	}
+
+	tcpr1 := createRoute(&v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tcpr-1"},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+		},
+	}, parentRefs[0].SectionName)
+	tcpr2 := createRoute(&v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tcpr-2"},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+		},
+	}, parentRefs[0].SectionName)
+	tr := createRoute(&v1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tr"},
+		Spec: v1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: parentRefs},
+		},
+	}, parentRefs[0].SectionName)
+	tr.Spec.Hostnames = []gatewayv1.Hostname{"foo.example.com"}
+	udpr := createRoute(&v1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "udpr"},
+		Spec: v1alpha2.UDPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: udpParentRefs},
+		},
+	}, udpParentRefs[0].SectionName)
+
+	l4Routes := map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(tcpr1.Source): tcpr1,
+		CreateRouteKeyL4(tcpr2.Source): tcpr2,
+		CreateRouteKeyL4(tr.Source):    tr,
+		CreateRouteKeyL4(udpr.Source):  udpr,
+	}
+
+	bindRoutesToListeners(nil, l4Routes, map[types.NamespacedName]*Gateway{client.ObjectKeyFromObject(gw): gateway}, nil)
+
+	// the listener hostname is ignored for TCP, so the first route claims the whole port
+	g.Expect(tcpr1.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{"tcp-5432": {wildcardHostname}},
+		Attached:          true,
+	}))
+	g.Expect(tcpr2.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{},
+		FailedCondition:   staticConds.NewRouteHostnameConflict(),
+	}))
+	g.Expect(tr.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{},
+		FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+	}))
+
+	// the UDP port doesn't overlap with the TCP port
+	g.Expect(udpr.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{"udp-5432": {wildcardHostname}},
+		Attached:          true,
+	}))
+
+	g.Expect(tcpListener.L4Routes).To(Equal(map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(tcpr1.Source): tcpr1,
+	}))
+	g.Expect(udpListener.L4Routes).To(Equal(map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(udpr.Source): udpr,
+	}))
+}
+
+func TestBindL4RouteToListeners_WildcardHostnames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	createListener := func(name, hostname string) *Listener {
+		return &Listener{
+			Name:        name,
+			GatewayName: client.ObjectKeyFromObject(gw),
+			Source: gatewayv1.Listener{
+				Name:     gatewayv1.SectionName(name),
+				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer(hostname)),
+				Port:     443,
+				Protocol: gatewayv1.TLSProtocolType,
+			},
+			SupportedKinds: []gatewayv1.RouteGroupKind{
+				{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+			},
+			Valid:      true,
+			Attachable: true,
+			Routes:     map[RouteKey]*L7Route{},
+			L4Routes:   map[L4RouteKey]*L4Route{},
+		}
+	}
+
+	wildcardListener := createListener("wildcard-example-com", "*.example.com")
+	appListener := createListener("app-example-com", "app.example.com")
+
+	gateway := &Gateway{
+		Source:    gw,
+		Valid:     true,
+		Listeners: []*Listener{wildcardListener, appListener},
+	}
+
+	createRoute := func(name string, sectionName *gatewayv1.SectionName, hostnames ...gatewayv1.Hostname) *L4Route {
+		return &L4Route{
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			Spec: L4RouteSpec{
+				Hostnames: hostnames,
+			},
+			Valid:      true,
+			Attachable: true,
+			ParentRefs: []ParentRef{
+				{
+					Idx:         0,
+					Gateway:     client.ObjectKeyFromObject(gw),
+					SectionName: sectionName,
+				},
+			},
+		}
+	}
+
+	wildcardSectionName := helpers.GetPointer[gatewayv1.SectionName]("wildcard-example-com")
+	appSectionName := helpers.GetPointer[gatewayv1.SectionName]("app-example-com")
+
+	// the route hostname app.example.com matches the wildcard listener, but belongs to the more specific listener
+	tr1 := createRoute("tr1", wildcardSectionName, "app.example.com", "cafe.example.com")
+	// the wildcard route hostname matches the more specific listener hostname
+	tr2 := createRoute("tr2", appSectionName, "*.example.com")
+	tr3 := createRoute("tr3", wildcardSectionName, "*.example.com")
+	// all hostnames are already taken by tr2 and tr3
+	tr4 := createRoute("tr4", nil, "*.example.com")
+
+	l4Routes := map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(tr1.Source): tr1,
+		CreateRouteKeyL4(tr2.Source): tr2,
+		CreateRouteKeyL4(tr3.Source): tr3,
+		CreateRouteKeyL4(tr4.Source): tr4,
+	}
+
+	bindRoutesToListeners(nil, l4Routes, map[types.NamespacedName]*Gateway{client.ObjectKeyFromObject(gw): gateway}, nil)
+
+	g.Expect(tr1.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{"wildcard-example-com": {"cafe.example.com"}},
+		Attached:          true,
+	}))
+	g.Expect(tr2.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{"app-example-com": {"app.example.com"}},
+		Attached:          true,
+	}))
+	g.Expect(tr3.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{"wildcard-example-com": {"*.example.com"}},
+		Attached:          true,
+	}))
+	g.Expect(tr4.ParentRefs[0].Attachment).To(Equal(&ParentRefAttachmentStatus{
+		AcceptedHostnames: map[string][]string{},
+		FailedCondition:   staticConds.NewRouteHostnameConflict(),
+	}))
+
+	g.Expect(wildcardListener.L4Routes).To(Equal(map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(tr1.Source): tr1,
+		CreateRouteKeyL4(tr3.Source): tr3,
+	}))
+	g.Expect(appListener.L4Routes).To(Equal(map[L4RouteKey]*L4Route{
+		CreateRouteKeyL4(tr2.Source): tr2,
+	}))
+}
+
+func TestBindL4RouteToListeners_WildcardIntersection(t *testing.T) {
+	t.Parallel()
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	tests := []struct {
+		expAttachment    *ParentRefAttachmentStatus
+		name             string
+		listenerHostname string
+		routeHostnames   []gatewayv1.Hostname
+	}{
+		{
+			name:             "wildcard listener and identical wildcard route",
+			listenerHostname: "*.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"tls": {"*.example.com"}},
+				Attached:          true,
+			},
+		},
+		{
+			name:             "wildcard listener and more specific wildcard route",
+			listenerHostname: "*.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"*.app.example.com"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"tls": {"*.app.example.com"}},
+				Attached:          true,
+			},
+		},
+		{
+			name:             "wildcard route and more specific wildcard listener",
+			listenerHostname: "*.app.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"tls": {"*.app.example.com"}},
+				Attached:          true,
+			},
+		},
+		{
+			name:             "wildcard listener and exact route",
+			listenerHostname: "*.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"app.example.com", "app.example.org"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"tls": {"app.example.com"}},
+				Attached:          true,
+			},
+		},
+		{
+			name:             "exact listener and wildcard route",
+			listenerHostname: "app.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"*.example.com"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"tls": {"app.example.com"}},
+				Attached:          true,
+			},
+		},
+		{
+			name:             "wildcard listener and wildcard route of another domain",
+			listenerHostname: "*.example.com",
+			routeHostnames:   []gatewayv1.Hostname{"*.example.org"},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{},
+				FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			listener := &Listener{
+				Name:        "tls",
+				GatewayName: client.ObjectKeyFromObject(gw),
+				Source: gatewayv1.Listener{
+					Name:     "tls",
+					Hostname: (*gatewayv1.Hostname)(helpers.GetPointer(test.listenerHostname)),
+					Port:     443,
+					Protocol: gatewayv1.TLSProtocolType,
+				},
+				SupportedKinds: []gatewayv1.RouteGroupKind{
+					{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+				},
+				Valid:      true,
+				Attachable: true,
+				Routes:     map[RouteKey]*L7Route{},
+				L4Routes:   map[L4RouteKey]*L4Route{},
+			}
+
+			route := &L4Route{
+				Source: &v1alpha2.TLSRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tr"},
+				},
+				Spec: L4RouteSpec{
+					Hostnames: test.routeHostnames,
+				},
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:     0,
+						Gateway: client.ObjectKeyFromObject(gw),
+					},
+				},
+			}
+
+			gateway := &Gateway{
+				Source:    gw,
+				Valid:     true,
+				Listeners: []*Listener{listener},
+			}
+
+			bindRoutesToListeners(
+				nil,
+				map[L4RouteKey]*L4Route{CreateRouteKeyL4(route.Source): route},
+				map[types.NamespacedName]*Gateway{client.ObjectKeyFromObject(gw): gateway},
+				nil,
+			)
+
+			g.Expect(route.ParentRefs[0].Attachment).To(Equal(test.expAttachment))
+		})
+	}
+}
+
 func TestBuildL4RoutesForGateways_NoGateways(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -2134,6 +2692,8 @@ func TestBuildL4RoutesForGateways_NoGateways(t *testing.T) {
 	g.Expect(buildL4RoutesForGateways(
 		tlsRoutes,
 		nil,
+		nil,
+		nil,
 		services,
 		nil,
 		refGrantResolver,
@@ -2171,6 +2731,7 @@ func TestTryToAttachL4RouteToListeners_NoAttachableListeners(t *testing.T) {
 		gw,
 		nil,
 		map[string]struct{}{},
+		nil,
 	)
 	g.Expect(cond).To(Equal(staticConds.NewRouteInvalidListener()))
 	g.Expect(attachable).To(BeFalse())
@@ -2272,7 +2833,8 @@ func TestIsolateL4Listeners(t *testing.T) {
 
 	createListener := func(name string, hostname string) *Listener {
 		return &Listener{
-			Name: name,
+			Name:        name,
+			GatewayName: client.ObjectKeyFromObject(gw),
 			Source: gatewayv1.Listener{
 				Name:     gatewayv1.SectionName(name),
 				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer(hostname)),
@@ -2522,7 +3084,8 @@ func TestIsolateL7Listeners(t *testing.T) {
 
 	createListener := func(name string, hostname string) *Listener {
 		return &Listener{
-			Name: name,
+			Name:        name,
+			GatewayName: client.ObjectKeyFromObject(gw),
 			Source: gatewayv1.Listener{
 				Name:     gatewayv1.SectionName(name),
 				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer(hostname)),
@@ -2678,6 +3241,117 @@ func TestIsolateL7Listeners(t *testing.T) {
 	g.Expect(helpers.Diff(result, expectedResult)).To(BeEmpty())
 }
 
+func TestIsolateL7ListenersAcrossGateways(t *testing.T) {
+	t.Parallel()
+
+	gw1 := types.NamespacedName{Namespace: "test", Name: "gateway-1"}
+	gw2 := types.NamespacedName{Namespace: "test", Name: "gateway-2"}
+
+	listeners := []*Listener{
+		{
+			Name:        "http",
+			GatewayName: gw1,
+			Source:      gatewayv1.Listener{Hostname: helpers.GetPointer[gatewayv1.Hostname]("foo.example.com")},
+			Valid:       true,
+		},
+		{
+			Name:        "http",
+			GatewayName: gw2,
+			Source:      gatewayv1.Listener{Hostname: helpers.GetPointer[gatewayv1.Hostname]("*.example.com")},
+			Valid:       true,
+		},
+	}
+
+	createRoute := func(gw types.NamespacedName, hostnames ...string) *L7Route {
+		return &L7Route{
+			ParentRefs: []ParentRef{
+				{
+					Gateway: gw,
+					Attachment: &ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{
+							"http": hostnames,
+						},
+						Attached: true,
+					},
+				},
+			},
+		}
+	}
+
+	route1 := createRoute(gw1, "foo.example.com")
+	route2 := createRoute(gw2, "foo.example.com", "bar.example.com")
+
+	g := NewWithT(t)
+	isolateL7RouteListeners([]*L7Route{route1, route2}, listeners)
+
+	// the listener of the same name on another Gateway does not own the hostname of the listener of the route
+	g.Expect(route1.ParentRefs[0].Attachment.AcceptedHostnames).To(Equal(map[string][]string{
+		"http": {"foo.example.com"},
+	}))
+	g.Expect(route2.ParentRefs[0].Attachment.AcceptedHostnames).To(Equal(map[string][]string{
+		"http": {"bar.example.com"},
+	}))
+}
+
+func TestIsolateL7ListenersMostSpecificHostname(t *testing.T) {
+	t.Parallel()
+
+	gw := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createListener := func(name, hostname string, port gatewayv1.PortNumber, valid bool) *Listener {
+		return &Listener{
+			Name:        name,
+			GatewayName: gw,
+			Source: gatewayv1.Listener{
+				Hostname: (*gatewayv1.Hostname)(helpers.GetPointer(hostname)),
+				Port:     port,
+			},
+			Valid: valid,
+		}
+	}
+
+	listeners := []*Listener{
+		createListener("empty", "", 80, true),
+		createListener("wildcard", "*.example.com", 80, true),
+		createListener("foo-wildcard", "*.foo.example.com", 80, true),
+		createListener("bar", "bar.example.com", 80, true),
+		createListener("baz-other-port", "baz.example.com", 8080, true),
+		createListener("qux-invalid", "qux.example.com", 80, false),
+	}
+
+	route := &L7Route{
+		ParentRefs: []ParentRef{
+			{
+				Gateway: gw,
+				Attachment: &ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"empty": {"cafe.com", "abc.foo.example.com", "bar.example.com", "baz.example.com"},
+						"wildcard": {
+							"*.example.com",
+							"*.foo.example.com",
+							"*.abc.foo.example.com",
+							"bar.example.com",
+							"baz.example.com",
+							"qux.example.com",
+						},
+						"foo-wildcard": {"*.foo.example.com", "abc.foo.example.com"},
+					},
+					Attached: true,
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	isolateL7RouteListeners([]*L7Route{route}, listeners)
+
+	g.Expect(route.ParentRefs[0].Attachment.AcceptedHostnames).To(Equal(map[string][]string{
+		"empty":        {"cafe.com"},
+		"wildcard":     {"*.example.com", "baz.example.com", "qux.example.com"},
+		"foo-wildcard": {"*.foo.example.com", "abc.foo.example.com"},
+	}))
+}
+
 func TestRemoveHostnames(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,204 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// routeMatchKey identifies a match of a Route rule by everything NGINX uses to select a rule for a request.
+type routeMatchKey struct {
+	hostname string
+	match    string
+	port     v1.PortNumber
+}
+
+// routeMatchOwner is the Route rule that receives the traffic for a routeMatchKey.
+type routeMatchOwner struct {
+	route   *L7Route
+	ruleIdx int
+}
+
+// detectShadowedRouteMatches finds the matches of the Route rules that are identical to a match of a rule with
+// a higher precedence for the same hostname and port. Such matches never receive traffic, which is hard to notice,
+// so a condition that lists them is added to the Routes. Returns the number of shadowed matches.
+//
+// As defined by the Gateway API, ties between identical matches are resolved in favor of the oldest Route, then
+// the Route that comes first in alphabetical order by namespace/name, then the first rule within the Route.
+func detectShadowedRouteMatches(gws map[types.NamespacedName]*Gateway) int {
+	listenersForRoute := make(map[*L7Route][]*Listener)
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			for _, r := range l.Routes {
+				if r.Valid {
+					listenersForRoute[r] = append(listenersForRoute[r], l)
+				}
+			}
+		}
+	}
+
+	routes := make([]*L7Route, 0, len(listenersForRoute))
+	for r := range listenersForRoute {
+		routes = append(routes, r)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if ngfsort.LessClientObject(routes[i].Source, routes[j].Source) {
+			return true
+		}
+		if ngfsort.LessClientObject(routes[j].Source, routes[i].Source) {
+			return false
+		}
+		// an HTTPRoute and a GRPCRoute can have the same name
+		return routes[i].RouteType < routes[j].RouteType
+	})
+
+	owners := make(map[routeMatchKey]routeMatchOwner)
+	shadowedCount := 0
+
+	for _, r := range routes {
+		var shadowed []string
+
+		for ruleIdx, rule := range r.Spec.Rules {
+			if rule.Dropped {
+				continue
+			}
+
+			for matchIdx, m := range rule.Matches {
+				match := routeMatchString(m)
+
+				for _, l := range listenersForRoute[r] {
+					for _, h := range acceptedHostnamesForListener(r, l) {
+						key := routeMatchKey{hostname: h, port: l.Source.Port, match: match}
+
+						owner, exists := owners[key]
+						if !exists {
+							owners[key] = routeMatchOwner{route: r, ruleIdx: ruleIdx}
+							continue
+						}
+
+						if owner.route == r && owner.ruleIdx == ruleIdx {
+							// the same rule reaches the hostname and port through another listener
+							continue
+						}
+
+						shadowed = append(shadowed, fmt.Sprintf(
+							"spec.rules[%d].matches[%d] for %s:%d by %s",
+							ruleIdx,
+							matchIdx,
+							h,
+							l.Source.Port,
+							describeRouteRule(owner),
+						))
+					}
+				}
+			}
+		}
+
+		if len(shadowed) > 0 {
+			shadowedCount += len(shadowed)
+			msg := "Matches are shadowed by identical matches with a higher precedence " +
+				"and will not receive traffic: " + strings.Join(shadowed, "; ")
+			r.Conditions = append(r.Conditions, staticConds.NewRouteRulesShadowed(msg))
+		}
+	}
+
+	return shadowedCount
+}
+
+// acceptedHostnamesForListener returns the hostnames of the Route accepted by the listener.
+func acceptedHostnamesForListener(route *L7Route, l *Listener) []string {
+	for _, ref := range route.ParentRefs {
+		if ref.Gateway != l.GatewayName || ref.Attachment == nil {
+			continue
+		}
+
+		if hostnames, exist := ref.Attachment.AcceptedHostnames[l.Name]; exist {
+			return hostnames
+		}
+	}
+
+	return nil
+}
+
+// routeMatchString returns a string that is the same for the matches that select the same requests.
+func routeMatchString(m v1.HTTPRouteMatch) string {
+	var b strings.Builder
+
+	pathType, pathValue := v1.PathMatchPathPrefix, "/"
+	if m.Path != nil {
+		if m.Path.Type != nil {
+			pathType = *m.Path.Type
+		}
+		if m.Path.Value != nil {
+			pathValue = *m.Path.Value
+		}
+	}
+
+	fmt.Fprintf(&b, "%s:%s", pathType, pathValue)
+
+	if m.Method != nil {
+		fmt.Fprintf(&b, " method=%s", *m.Method)
+	}
+
+	headers := make([]string, 0, len(m.Headers))
+	for _, h := range m.Headers {
+		matchType := v1.HeaderMatchExact
+		if h.Type != nil {
+			matchType = *h.Type
+		}
+		// header names are case-insensitive
+		headers = append(headers, fmt.Sprintf("%s:%s=%s", strings.ToLower(string(h.Name)), matchType, h.Value))
+	}
+
+	sort.Strings(headers)
+
+	for _, h := range headers {
+		fmt.Fprintf(&b, " header=%s", h)
+	}
+
+	params := make([]string, 0, len(m.QueryParams))
+	for _, p := range m.QueryParams {
+		matchType := v1.QueryParamMatchExact
+		if p.Type != nil {
+			matchType = *p.Type
+		}
+		params = append(params, fmt.Sprintf("%s:%s=%s", p.Name, matchType, p.Value))
+	}
+
+	sort.Strings(params)
+
+	for _, p := range params {
+		fmt.Fprintf(&b, " query=%s", p)
+	}
+
+	return b.String()
+}
+
+// describeRouteRule returns a description of the rule for the condition message.
+func describeRouteRule(owner routeMatchOwner) string {
+	kind := kinds.HTTPRoute
+	if owner.route.RouteType == RouteTypeGRPC {
+		kind = kinds.GRPCRoute
+	}
+
+	return fmt.Sprintf(
+		"%s %s/%s spec.rules[%d]",
+		kind,
+		owner.route.Source.GetNamespace(),
+		owner.route.Source.GetName(),
+		owner.ruleIdx,
+	)
+}
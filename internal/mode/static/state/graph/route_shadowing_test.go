@@ -0,0 +1,338 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestDetectShadowedRouteMatches(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	now := time.Now()
+
+	pathMatch := func(path string) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+				Value: helpers.GetPointer(path),
+			},
+		}
+	}
+
+	headerMatch := func(path string, headers ...v1.HTTPHeaderMatch) v1.HTTPRouteMatch {
+		m := pathMatch(path)
+		m.Headers = headers
+		return m
+	}
+
+	type routeCfg struct {
+		hostnames map[string][]string
+		name      string
+		matches   [][]v1.HTTPRouteMatch
+		age       time.Duration
+		invalid   bool
+	}
+
+	createRoute := func(cfg routeCfg) *L7Route {
+		rules := make([]RouteRule, 0, len(cfg.matches))
+		for _, m := range cfg.matches {
+			rules = append(rules, RouteRule{Matches: m, ValidMatches: true})
+		}
+
+		return &L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "test",
+					Name:              cfg.name,
+					CreationTimestamp: metav1.NewTime(now.Add(-cfg.age)),
+				},
+			},
+			RouteType: RouteTypeHTTP,
+			Spec:      L7RouteSpec{Rules: rules},
+			ParentRefs: []ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &ParentRefAttachmentStatus{
+						AcceptedHostnames: cfg.hostnames,
+						Attached:          true,
+					},
+				},
+			},
+			Valid: !cfg.invalid,
+		}
+	}
+
+	createListener := func(name string, port v1.PortNumber) *Listener {
+		return &Listener{
+			Name:        name,
+			GatewayName: gwNsName,
+			Source:      v1.Listener{Name: v1.SectionName(name), Port: port},
+			Routes:      map[RouteKey]*L7Route{},
+			Valid:       true,
+		}
+	}
+
+	fooHostname := map[string][]string{"http": {"foo.example.com"}}
+
+	tests := []struct {
+		expConditions map[string][]conditions.Condition
+		name          string
+		routes        []routeCfg
+		expCount      int
+	}{
+		{
+			name: "different paths",
+			routes: []routeCfg{
+				{name: "hr1", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}},
+				{name: "hr2", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/tea")}}},
+			},
+		},
+		{
+			name: "identical paths with different hostnames",
+			routes: []routeCfg{
+				{name: "hr1", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}},
+				{
+					name:      "hr2",
+					hostnames: map[string][]string{"http": {"bar.example.com"}},
+					matches:   [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}},
+				},
+			},
+		},
+		{
+			name: "identical paths on different ports",
+			routes: []routeCfg{
+				{name: "hr1", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}},
+				{
+					name:      "hr2",
+					hostnames: map[string][]string{"http-8080": {"foo.example.com"}},
+					matches:   [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}},
+				},
+			},
+		},
+		{
+			name: "identical paths with different headers",
+			routes: []routeCfg{
+				{
+					name:      "hr1",
+					hostnames: fooHostname,
+					matches: [][]v1.HTTPRouteMatch{
+						{headerMatch("/coffee", v1.HTTPHeaderMatch{Name: "version", Value: "v1"})},
+					},
+				},
+				{
+					name:      "hr2",
+					hostnames: fooHostname,
+					matches: [][]v1.HTTPRouteMatch{
+						{headerMatch("/coffee", v1.HTTPHeaderMatch{Name: "version", Value: "v2"})},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid route is ignored",
+			routes: []routeCfg{
+				{name: "hr1", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}, age: time.Hour},
+				{name: "hr2", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}, invalid: true},
+			},
+		},
+		{
+			name: "newer route is shadowed",
+			routes: []routeCfg{
+				{name: "hr1", hostnames: fooHostname, matches: [][]v1.HTTPRouteMatch{{pathMatch("/coffee")}}},
+				{
+					name:      "hr2",
+					hostnames: fooHostname,
+					matches:   [][]v1.HTTPRouteMatch{{pathMatch("/tea")}, {pathMatch("/coffee")}},
+					age:       time.Hour,
+				},
+			},
+			expConditions: map[string][]conditions.Condition{
+				"hr1": {
+					staticConds.NewRouteRulesShadowed(
+						"Matches are shadowed by identical matches with a higher precedence and will not receive " +
+							"traffic: spec.rules[0].matches[0] for foo.example.com:80 by HTTPRoute test/hr2 spec.rules[1]",
+					),
+				},
+			},
+			expCount: 1,
+		},
+		{
+			name: "route with the same age is shadowed by the route that comes first alphabetically",
+			routes: []routeCfg{
+				{
+					name:      "hr2",
+					hostnames: fooHostname,
+					matches: [][]v1.HTTPRouteMatch{
+						{
+							headerMatch(
+								"/coffee",
+								v1.HTTPHeaderMatch{Name: "Version", Value: "v1"},
+								v1.HTTPHeaderMatch{Name: "env", Value: "prod"},
+							),
+						},
+					},
+				},
+				{
+					name:      "hr1",
+					hostnames: fooHostname,
+					matches: [][]v1.HTTPRouteMatch{
+						{
+							headerMatch(
+								"/coffee",
+								v1.HTTPHeaderMatch{Name: "env", Value: "prod"},
+								v1.HTTPHeaderMatch{Name: "version", Value: "v1"},
+							),
+						},
+					},
+				},
+			},
+			expConditions: map[string][]conditions.Condition{
+				"hr2": {
+					staticConds.NewRouteRulesShadowed(
+						"Matches are shadowed by identical matches with a higher precedence and will not receive " +
+							"traffic: spec.rules[0].matches[0] for foo.example.com:80 by HTTPRoute test/hr1 spec.rules[0]",
+					),
+				},
+			},
+			expCount: 1,
+		},
+		{
+			name: "rules of the same route",
+			routes: []routeCfg{
+				{
+					name: "hr1",
+					hostnames: map[string][]string{
+						"http":      {"foo.example.com", "bar.example.com"},
+						"http-8080": {"foo.example.com"},
+					},
+					matches: [][]v1.HTTPRouteMatch{
+						{pathMatch("/coffee")},
+						{pathMatch("/tea"), pathMatch("/coffee")},
+					},
+				},
+			},
+			expConditions: map[string][]conditions.Condition{
+				"hr1": {
+					staticConds.NewRouteRulesShadowed(
+						"Matches are shadowed by identical matches with a higher precedence and will not receive " +
+							"traffic: spec.rules[1].matches[1] for foo.example.com:80 by HTTPRoute test/hr1 spec.rules[0]; " +
+							"spec.rules[1].matches[1] for bar.example.com:80 by HTTPRoute test/hr1 spec.rules[0]; " +
+							"spec.rules[1].matches[1] for foo.example.com:8080 by HTTPRoute test/hr1 spec.rules[0]",
+					),
+				},
+			},
+			expCount: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			httpListener := createListener("http", 80)
+			http8080Listener := createListener("http-8080", 8080)
+
+			routes := make(map[string]*L7Route, len(test.routes))
+			for _, cfg := range test.routes {
+				r := createRoute(cfg)
+				routes[cfg.name] = r
+
+				key := CreateRouteKey(r.Source)
+				if _, ok := cfg.hostnames["http"]; ok {
+					httpListener.Routes[key] = r
+				}
+				if _, ok := cfg.hostnames["http-8080"]; ok {
+					http8080Listener.Routes[key] = r
+				}
+			}
+
+			gws := map[types.NamespacedName]*Gateway{
+				gwNsName: {Listeners: []*Listener{httpListener, http8080Listener}},
+			}
+
+			g.Expect(detectShadowedRouteMatches(gws)).To(Equal(test.expCount))
+
+			for name, r := range routes {
+				g.Expect(r.Conditions).To(Equal(test.expConditions[name]), name)
+			}
+		})
+	}
+}
+
+func TestDetectShadowedRouteMatches_SnippetsConflicted(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	match := v1.HTTPRouteMatch{
+		Path: &v1.HTTPPathMatch{
+			Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+			Value: helpers.GetPointer("/coffee"),
+		},
+	}
+
+	rules := []RouteRule{
+		{Matches: []v1.HTTPRouteMatch{match}, ValidMatches: true, Filters: RouteRuleFilters{Valid: true}},
+		{Matches: []v1.HTTPRouteMatch{match}, ValidMatches: true, Filters: RouteRuleFilters{Valid: true}},
+	}
+
+	conflict := `spec.rules[1].filters[1].extensionRef: directive "proxy_read_timeout" in context ` +
+		`http.server.location is already set by SnippetsFilter "timeouts"`
+
+	valid, conds := buildRouteRulesConditions(rules, routeRuleErrors{conflicts: []string{conflict}})
+	g.Expect(valid).To(BeTrue())
+
+	route := &L7Route{
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+		},
+		RouteType:  RouteTypeHTTP,
+		Spec:       L7RouteSpec{Rules: rules},
+		Conditions: conds,
+		ParentRefs: []ParentRef{
+			{
+				Gateway: gwNsName,
+				Attachment: &ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"http": {"foo.example.com"}},
+					Attached:          true,
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	listener := &Listener{
+		Name:        "http",
+		GatewayName: gwNsName,
+		Source:      v1.Listener{Name: "http", Port: 80},
+		Routes:      map[RouteKey]*L7Route{CreateRouteKey(route.Source): route},
+		Valid:       true,
+	}
+
+	gws := map[types.NamespacedName]*Gateway{
+		gwNsName: {Listeners: []*Listener{listener}},
+	}
+
+	g.Expect(detectShadowedRouteMatches(gws)).To(Equal(1))
+
+	// both conflicts must survive the deduplication of the conditions when the status is built
+	g.Expect(conditions.DeduplicateConditions(route.Conditions)).To(ConsistOf(
+		newSnippetsConflictedCondition([]string{conflict}),
+		staticConds.NewRouteRulesShadowed(
+			"Matches are shadowed by identical matches with a higher precedence and will not receive "+
+				"traffic: spec.rules[1].matches[0] for foo.example.com:80 by HTTPRoute test/hr spec.rules[0]",
+		),
+	))
+}
@@ -0,0 +1,539 @@
+package graph
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
+)
+
+// RouteTest represents a RouteTest with the results of its tests.
+type RouteTest struct {
+	// Source is the RouteTest.
+	Source *ngfAPI.RouteTest
+	// Results are the results of the tests, in the order of the tests.
+	Results []RouteTestResult
+}
+
+// RouteTestResult is the result of a test of a RouteTest.
+type RouteTestResult struct {
+	// Name is the name of the test.
+	Name string
+	// Message describes the routing of the request and the expectations that are not met.
+	Message string
+	// Passed indicates whether the routing of the request meets the expectations.
+	Passed bool
+}
+
+// routeTestTarget is a Route that receives the requests for a hostname on a port.
+type routeTestTarget struct {
+	route    *L7Route
+	hostname string
+	port     v1.PortNumber
+}
+
+// routeTestMatch is a match of a Route rule that matches the request of a test.
+type routeTestMatch struct {
+	route    *L7Route
+	match    v1.HTTPRouteMatch
+	ruleIdx  int
+	matchIdx int
+}
+
+// testRequest is the request of a test.
+type testRequest struct {
+	headers  map[string]string
+	query    url.Values
+	method   v1.HTTPMethod
+	hostname string
+	path     string
+	port     v1.PortNumber
+}
+
+// processRouteTests evaluates the tests of the RouteTests against the Routes attached to the Listeners of
+// the Gateways.
+func processRouteTests(
+	routeTests map[types.NamespacedName]*ngfAPI.RouteTest,
+	gws map[types.NamespacedName]*Gateway,
+) map[types.NamespacedName]*RouteTest {
+	if len(routeTests) == 0 {
+		return nil
+	}
+
+	targets := buildRouteTestTargets(gws)
+	processed := make(map[types.NamespacedName]*RouteTest, len(routeTests))
+
+	for nsname, rt := range routeTests {
+		results := make([]RouteTestResult, 0, len(rt.Spec.Tests))
+
+		for _, test := range rt.Spec.Tests {
+			results = append(results, evaluateRouteTest(rt.Namespace, test, targets))
+		}
+
+		processed[nsname] = &RouteTest{
+			Source:  rt,
+			Results: results,
+		}
+	}
+
+	return processed
+}
+
+func buildRouteTestTargets(gws map[types.NamespacedName]*Gateway) []routeTestTarget {
+	var targets []routeTestTarget
+
+	for _, gw := range gws {
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			for _, r := range l.Routes {
+				if !r.Valid {
+					continue
+				}
+
+				for _, h := range acceptedHostnamesForListener(r, l) {
+					targets = append(targets, routeTestTarget{route: r, hostname: h, port: l.Source.Port})
+				}
+			}
+		}
+	}
+
+	return targets
+}
+
+// evaluateRouteTest finds the Route rule that receives the request of the test and checks the expectations of
+// the test against it.
+func evaluateRouteTest(namespace string, test ngfAPI.RouteTestCase, targets []routeTestTarget) RouteTestResult {
+	req := newTestRequest(test.Request)
+	expect := test.Expect
+
+	m := findRouteTestMatch(req, targets)
+	if m == nil {
+		if expect.Unmatched {
+			return RouteTestResult{Name: test.Name, Message: "No Route matched the request", Passed: true}
+		}
+
+		return RouteTestResult{
+			Name:    test.Name,
+			Message: "No Route matched the request, but a Route was expected to match it",
+		}
+	}
+
+	rule := m.route.Spec.Rules[m.ruleIdx]
+	matched := describeRouteRule(routeMatchOwner{route: m.route, ruleIdx: m.ruleIdx})
+
+	var failures []string
+
+	if expect.Unmatched {
+		failures = append(failures, "no Route was expected to match it")
+	}
+
+	if expect.Route != nil && !routeTestRouteMatches(*expect.Route, namespace, m.route) {
+		failures = append(failures, fmt.Sprintf(
+			"%s %s/%s was expected to match it",
+			routeTestRouteKind(*expect.Route),
+			defaultNamespace(expect.Route.Namespace, namespace),
+			expect.Route.Name,
+		))
+	}
+
+	if expect.Backend != nil && !routeTestBackendMatches(*expect.Backend, namespace, rule.BackendRefs) {
+		failures = append(failures, fmt.Sprintf(
+			"the request was expected to be routed to %s, but the rule routes it to %s",
+			describeExpectedBackend(*expect.Backend, namespace),
+			describeBackendRefs(rule.BackendRefs),
+		))
+	}
+
+	if expect.Filters != nil {
+		if actual := ruleFilterTypes(rule); !sameFilterTypes(expect.Filters, actual) {
+			failures = append(failures, fmt.Sprintf(
+				"the filters %s were expected to be applied, but the rule applies %s",
+				describeFilterTypes(expect.Filters),
+				describeFilterTypes(actual),
+			))
+		}
+	}
+
+	msg := fmt.Sprintf("The request matched %s", matched)
+	if len(failures) > 0 {
+		msg += ", but " + strings.Join(failures, "; ")
+	}
+
+	return RouteTestResult{
+		Name:    test.Name,
+		Message: msg,
+		Passed:  len(failures) == 0,
+	}
+}
+
+func newTestRequest(r ngfAPI.RouteTestRequest) testRequest {
+	req := testRequest{
+		hostname: string(r.Hostname),
+		method:   v1.HTTPMethodGet,
+		path:     "/",
+		port:     80,
+		headers:  make(map[string]string, len(r.Headers)),
+	}
+
+	if r.Method != nil {
+		req.method = *r.Method
+	}
+
+	if r.Port != nil {
+		req.port = *r.Port
+	}
+
+	if r.Path != nil {
+		path, rawQuery, _ := strings.Cut(*r.Path, "?")
+		req.path = path
+		// the invalid query parameters are ignored, the same way NGINX ignores them
+		req.query, _ = url.ParseQuery(rawQuery)
+	}
+
+	for _, h := range r.Headers {
+		name := strings.ToLower(string(h.Name))
+		if _, exists := req.headers[name]; !exists {
+			req.headers[name] = h.Value
+		}
+	}
+
+	return req
+}
+
+// findRouteTestMatch finds the match of a Route rule that receives the request. Returns nil if no Route rule
+// receives the request.
+//
+// The hostnames are selected the way NGINX selects a server: an exact hostname first, then the longest wildcard
+// hostname, then the hostname of the Routes without hostnames. Among the matches of the Route rules for the
+// hostname, the Exact path matches take precedence over the RegularExpression path matches, which take precedence
+// over the PathPrefix path matches, like the locations of NGINX. The rest of the ties are resolved as
+// defined by the Gateway API.
+func findRouteTestMatch(req testRequest, targets []routeTestTarget) *routeTestMatch {
+	hostname, found := selectRouteTestHostname(req, targets)
+	if !found {
+		return nil
+	}
+
+	var best *routeTestMatch
+
+	for _, t := range targets {
+		if t.port != req.port || t.hostname != hostname {
+			continue
+		}
+
+		for ruleIdx, rule := range t.route.Spec.Rules {
+			if rule.Dropped || !rule.ValidMatches {
+				continue
+			}
+
+			matches := rule.Matches
+			if len(matches) == 0 {
+				matches = []v1.HTTPRouteMatch{{}}
+			}
+
+			for matchIdx, m := range matches {
+				if !requestMatches(req, m, t.route.Spec.PathMatchOptions.CaseInsensitive) {
+					continue
+				}
+
+				candidate := &routeTestMatch{route: t.route, match: m, ruleIdx: ruleIdx, matchIdx: matchIdx}
+				if best == nil || higherRouteTestPrecedence(candidate, best) {
+					best = candidate
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+func selectRouteTestHostname(req testRequest, targets []routeTestTarget) (string, bool) {
+	var (
+		best      string
+		bestScore int
+	)
+
+	for _, t := range targets {
+		if t.port != req.port {
+			continue
+		}
+
+		score := hostnameScore(t.hostname, req.hostname)
+		if score > bestScore {
+			best, bestScore = t.hostname, score
+		}
+	}
+
+	return best, bestScore > 0
+}
+
+// hostnameScore returns a positive score if the hostname of a server matches the hostname of the request.
+// The more specific hostname has the higher score.
+func hostnameScore(serverHostname, hostname string) int {
+	switch {
+	case serverHostname == hostname:
+		// the length of a hostname is at most 253
+		return 1000
+	case strings.HasPrefix(serverHostname, "*."):
+		suffix := serverHostname[1:]
+		if len(hostname) > len(suffix) && strings.HasSuffix(hostname, suffix) {
+			return 1 + len(suffix)
+		}
+	case serverHostname == wildcardHostname:
+		return 1
+	}
+
+	return 0
+}
+
+func requestMatches(req testRequest, m v1.HTTPRouteMatch, caseInsensitive bool) bool {
+	pathType, pathValue := pathMatchTypeAndValue(m)
+	if !pathMatches(req.path, pathType, pathValue, caseInsensitive) {
+		return false
+	}
+
+	if m.Method != nil && *m.Method != req.method {
+		return false
+	}
+
+	for _, h := range m.Headers {
+		value, exists := req.headers[strings.ToLower(string(h.Name))]
+		if !exists || !valueMatches(value, h.Value, h.Type != nil && *h.Type == v1.HeaderMatchRegularExpression) {
+			return false
+		}
+	}
+
+	for _, q := range m.QueryParams {
+		if !req.query.Has(string(q.Name)) {
+			return false
+		}
+
+		regex := q.Type != nil && *q.Type == v1.QueryParamMatchRegularExpression
+		if !valueMatches(req.query.Get(string(q.Name)), q.Value, regex) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func pathMatchTypeAndValue(m v1.HTTPRouteMatch) (v1.PathMatchType, string) {
+	pathType, pathValue := v1.PathMatchPathPrefix, "/"
+
+	if m.Path != nil {
+		if m.Path.Type != nil {
+			pathType = *m.Path.Type
+		}
+		if m.Path.Value != nil {
+			pathValue = *m.Path.Value
+		}
+	}
+
+	return pathType, pathValue
+}
+
+func pathMatches(path string, pathType v1.PathMatchType, value string, caseInsensitive bool) bool {
+	if caseInsensitive && pathType != v1.PathMatchRegularExpression {
+		path, value = strings.ToLower(path), strings.ToLower(value)
+	}
+
+	switch pathType {
+	case v1.PathMatchExact:
+		return path == value
+	case v1.PathMatchRegularExpression:
+		flags := ""
+		if caseInsensitive {
+			flags = "(?i)"
+		}
+
+		re, err := regexp.Compile(flags + "^(?:" + value + ")$")
+		return err == nil && re.MatchString(path)
+	default:
+		// the prefix matches the whole elements of the path
+		prefix := strings.TrimSuffix(value, "/")
+		return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+	}
+}
+
+// valueMatches matches a value of a header or a query parameter. Like NGINX, a regular expression matches
+// a part of the value.
+func valueMatches(value, expected string, regex bool) bool {
+	if !regex {
+		return value == expected
+	}
+
+	re, err := regexp.Compile(expected)
+	return err == nil && re.MatchString(value)
+}
+
+// higherRouteTestPrecedence returns true if the match m1 takes precedence over the match m2.
+func higherRouteTestPrecedence(m1, m2 *routeTestMatch) bool {
+	type1, value1 := pathMatchTypeAndValue(m1.match)
+	type2, value2 := pathMatchTypeAndValue(m2.match)
+
+	if rank1, rank2 := pathTypeRank(type1), pathTypeRank(type2); rank1 != rank2 {
+		return rank1 > rank2
+	}
+
+	if len(value1) != len(value2) {
+		return len(value1) > len(value2)
+	}
+
+	if (m1.match.Method != nil) != (m2.match.Method != nil) {
+		return m1.match.Method != nil
+	}
+
+	if len(m1.match.Headers) != len(m2.match.Headers) {
+		return len(m1.match.Headers) > len(m2.match.Headers)
+	}
+
+	if len(m1.match.QueryParams) != len(m2.match.QueryParams) {
+		return len(m1.match.QueryParams) > len(m2.match.QueryParams)
+	}
+
+	if m1.route != m2.route {
+		if ngfsort.LessClientObject(m1.route.Source, m2.route.Source) {
+			return true
+		}
+		if ngfsort.LessClientObject(m2.route.Source, m1.route.Source) {
+			return false
+		}
+		// an HTTPRoute and a GRPCRoute can have the same name
+		return m1.route.RouteType < m2.route.RouteType
+	}
+
+	if m1.ruleIdx != m2.ruleIdx {
+		return m1.ruleIdx < m2.ruleIdx
+	}
+
+	return m1.matchIdx < m2.matchIdx
+}
+
+func pathTypeRank(pathType v1.PathMatchType) int {
+	switch pathType {
+	case v1.PathMatchExact:
+		return 2
+	case v1.PathMatchRegularExpression:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func routeTestRouteMatches(ref ngfAPI.RouteTestRouteReference, namespace string, route *L7Route) bool {
+	routeKind := v1.Kind(kinds.HTTPRoute)
+	if route.RouteType == RouteTypeGRPC {
+		routeKind = kinds.GRPCRoute
+	}
+
+	return routeTestRouteKind(ref) == routeKind &&
+		route.Source.GetNamespace() == defaultNamespace(ref.Namespace, namespace) &&
+		route.Source.GetName() == string(ref.Name)
+}
+
+func routeTestRouteKind(ref ngfAPI.RouteTestRouteReference) v1.Kind {
+	if ref.Kind != nil {
+		return *ref.Kind
+	}
+
+	return kinds.HTTPRoute
+}
+
+func routeTestBackendMatches(ref ngfAPI.RouteTestBackendReference, namespace string, refs []BackendRef) bool {
+	svcNsName := types.NamespacedName{Namespace: defaultNamespace(ref.Namespace, namespace), Name: string(ref.Name)}
+
+	for _, br := range refs {
+		if br.SvcNsName != svcNsName {
+			continue
+		}
+
+		if ref.Port == nil || br.ServicePort.Port == int32(*ref.Port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func describeExpectedBackend(ref ngfAPI.RouteTestBackendReference, namespace string) string {
+	backend := fmt.Sprintf("Service %s/%s", defaultNamespace(ref.Namespace, namespace), ref.Name)
+	if ref.Port != nil {
+		backend += fmt.Sprintf(":%d", *ref.Port)
+	}
+
+	return backend
+}
+
+func describeBackendRefs(refs []BackendRef) string {
+	if len(refs) == 0 {
+		return "no backends"
+	}
+
+	backends := make([]string, 0, len(refs))
+	for _, br := range refs {
+		backends = append(backends, fmt.Sprintf("%s:%d", br.SvcNsName, br.ServicePort.Port))
+	}
+
+	return "Services " + strings.Join(backends, ", ")
+}
+
+func ruleFilterTypes(rule RouteRule) []v1.HTTPRouteFilterType {
+	filterTypes := make([]v1.HTTPRouteFilterType, 0, len(rule.Filters.Filters))
+	for _, f := range rule.Filters.Filters {
+		filterTypes = append(filterTypes, v1.HTTPRouteFilterType(f.FilterType))
+	}
+
+	return filterTypes
+}
+
+// sameFilterTypes returns true if the lists have the same filter types, regardless of the order and
+// the duplicates.
+func sameFilterTypes(expected, actual []v1.HTTPRouteFilterType) bool {
+	for _, ft := range expected {
+		if !slices.Contains(actual, ft) {
+			return false
+		}
+	}
+
+	for _, ft := range actual {
+		if !slices.Contains(expected, ft) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func describeFilterTypes(filterTypes []v1.HTTPRouteFilterType) string {
+	if len(filterTypes) == 0 {
+		return "no filters"
+	}
+
+	names := make([]string, 0, len(filterTypes))
+	for _, ft := range filterTypes {
+		names = append(names, string(ft))
+	}
+	sort.Strings(names)
+
+	return "[" + strings.Join(slices.Compact(names), ", ") + "]"
+}
+
+func defaultNamespace(namespace *v1.Namespace, defaultNs string) string {
+	if namespace != nil {
+		return string(*namespace)
+	}
+
+	return defaultNs
+}
@@ -0,0 +1,401 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func TestProcessRouteTests(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	now := time.Now()
+
+	pathMatch := func(pathType v1.PathMatchType, path string) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(pathType),
+				Value: helpers.GetPointer(path),
+			},
+		}
+	}
+
+	backendRef := func(name string, port int32) []BackendRef {
+		return []BackendRef{
+			{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
+				ServicePort: apiv1.ServicePort{Port: port},
+				Valid:       true,
+			},
+		}
+	}
+
+	createRoute := func(
+		name string,
+		routeType RouteType,
+		hostname string,
+		age time.Duration,
+		rules ...RouteRule,
+	) *L7Route {
+		meta := metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(now.Add(-age)),
+		}
+
+		var source client.Object
+		if routeType == RouteTypeGRPC {
+			source = &v1.GRPCRoute{ObjectMeta: meta}
+		} else {
+			source = &v1.HTTPRoute{ObjectMeta: meta}
+		}
+
+		return &L7Route{
+			Source:    source,
+			RouteType: routeType,
+			Spec:      L7RouteSpec{Rules: rules},
+			ParentRefs: []ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"http": {hostname}},
+						Attached:          true,
+					},
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	coffeeRoute := createRoute(
+		"coffee",
+		RouteTypeHTTP,
+		"cafe.example.com",
+		time.Hour,
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{pathMatch(v1.PathMatchPathPrefix, "/coffee")},
+			BackendRefs:  backendRef("coffee", 80),
+			ValidMatches: true,
+		},
+		RouteRule{
+			Matches:     []v1.HTTPRouteMatch{pathMatch(v1.PathMatchExact, "/coffee/latte")},
+			BackendRefs: backendRef("latte", 8080),
+			Filters: RouteRuleFilters{
+				Filters: []Filter{{FilterType: FilterRequestHeaderModifier}},
+				Valid:   true,
+			},
+			ValidMatches: true,
+		},
+	)
+
+	headerMatch := pathMatch(v1.PathMatchPathPrefix, "/coffee")
+	headerMatch.Headers = []v1.HTTPHeaderMatch{{Name: "Version", Value: "v2"}}
+
+	queryMatch := pathMatch(v1.PathMatchPathPrefix, "/search")
+	queryMatch.QueryParams = []v1.HTTPQueryParamMatch{{Name: "q", Value: "nginx"}}
+
+	cafeRoute := createRoute(
+		"cafe",
+		RouteTypeHTTP,
+		"cafe.example.com",
+		time.Minute,
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{pathMatch(v1.PathMatchRegularExpression, "/tea/[0-9]+")},
+			BackendRefs:  backendRef("tea", 80),
+			ValidMatches: true,
+		},
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{headerMatch},
+			BackendRefs:  backendRef("coffee-v2", 80),
+			ValidMatches: true,
+		},
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{queryMatch},
+			BackendRefs:  backendRef("search", 80),
+			ValidMatches: true,
+		},
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{pathMatch(v1.PathMatchPathPrefix, "/dropped")},
+			BackendRefs:  backendRef("dropped", 80),
+			ValidMatches: true,
+			Dropped:      true,
+		},
+	)
+
+	wildcardRoute := createRoute(
+		"wildcard",
+		RouteTypeHTTP,
+		"*.example.com",
+		time.Minute,
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{pathMatch(v1.PathMatchPathPrefix, "/")},
+			BackendRefs:  backendRef("default", 80),
+			ValidMatches: true,
+		},
+	)
+
+	grpcRoute := createRoute(
+		"cafe",
+		RouteTypeGRPC,
+		"grpc.example.com",
+		time.Minute,
+		RouteRule{
+			Matches:      []v1.HTTPRouteMatch{pathMatch(v1.PathMatchExact, "/cafe.Barista/Order")},
+			BackendRefs:  backendRef("barista", 9000),
+			ValidMatches: true,
+		},
+	)
+
+	gws := map[types.NamespacedName]*Gateway{
+		gwNsName: {
+			Listeners: []*Listener{
+				{
+					Name:        "http",
+					GatewayName: gwNsName,
+					Source:      v1.Listener{Name: "http", Port: 80},
+					Routes: map[RouteKey]*L7Route{
+						CreateRouteKey(coffeeRoute.Source):   coffeeRoute,
+						CreateRouteKey(cafeRoute.Source):     cafeRoute,
+						CreateRouteKey(wildcardRoute.Source): wildcardRoute,
+						CreateRouteKey(grpcRoute.Source):     grpcRoute,
+					},
+					Valid: true,
+				},
+			},
+		},
+	}
+
+	request := func(hostname, path string) ngfAPI.RouteTestRequest {
+		return ngfAPI.RouteTestRequest{
+			Hostname: v1.PreciseHostname(hostname),
+			Path:     helpers.GetPointer(path),
+		}
+	}
+
+	routeRef := func(name string) *ngfAPI.RouteTestRouteReference {
+		return &ngfAPI.RouteTestRouteReference{Name: v1.ObjectName(name)}
+	}
+
+	backend := func(name string) *ngfAPI.RouteTestBackendReference {
+		return &ngfAPI.RouteTestBackendReference{Name: v1.ObjectName(name)}
+	}
+
+	tests := []struct {
+		name      string
+		expResult RouteTestResult
+		test      ngfAPI.RouteTestCase
+	}{
+		{
+			name: "prefix match",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/coffee/mocha"),
+				Expect: ngfAPI.RouteTestExpectation{
+					Route:   routeRef("coffee"),
+					Backend: backend("coffee"),
+					Filters: []v1.HTTPRouteFilterType{},
+				},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/coffee spec.rules[0]",
+				Passed:  true,
+			},
+		},
+		{
+			name: "prefix matches whole path elements",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/coffeehouse"),
+				Expect:  ngfAPI.RouteTestExpectation{Route: routeRef("wildcard")},
+			},
+			expResult: RouteTestResult{
+				Message: "No Route matched the request, but a Route was expected to match it",
+			},
+		},
+		{
+			name: "exact match takes precedence over prefix match",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/coffee/latte"),
+				Expect: ngfAPI.RouteTestExpectation{
+					Backend: &ngfAPI.RouteTestBackendReference{
+						Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						Name:      "latte",
+						Port:      helpers.GetPointer[v1.PortNumber](8080),
+					},
+					Filters: []v1.HTTPRouteFilterType{v1.HTTPRouteFilterRequestHeaderModifier},
+				},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/coffee spec.rules[1]",
+				Passed:  true,
+			},
+		},
+		{
+			name: "header match takes precedence over older Route",
+			test: ngfAPI.RouteTestCase{
+				Request: ngfAPI.RouteTestRequest{
+					Hostname: "cafe.example.com",
+					Path:     helpers.GetPointer("/coffee"),
+					Headers:  []v1.HTTPHeader{{Name: "version", Value: "v2"}},
+				},
+				Expect: ngfAPI.RouteTestExpectation{
+					Route:   routeRef("coffee"),
+					Backend: backend("coffee"),
+				},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/cafe spec.rules[1], " +
+					"but HTTPRoute test/coffee was expected to match it; " +
+					"the request was expected to be routed to Service test/coffee, " +
+					"but the rule routes it to Services test/coffee-v2:80",
+			},
+		},
+		{
+			name: "regular expression match",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/tea/12"),
+				Expect: ngfAPI.RouteTestExpectation{
+					Backend: backend("tea"),
+					Filters: []v1.HTTPRouteFilterType{v1.HTTPRouteFilterURLRewrite},
+				},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/cafe spec.rules[0], but the filters [URLRewrite] " +
+					"were expected to be applied, but the rule applies no filters",
+			},
+		},
+		{
+			name: "query parameter match",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/search?q=nginx"),
+				Expect:  ngfAPI.RouteTestExpectation{Backend: backend("search")},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/cafe spec.rules[2]",
+				Passed:  true,
+			},
+		},
+		{
+			name: "dropped rule",
+			test: ngfAPI.RouteTestCase{
+				Request: request("cafe.example.com", "/dropped"),
+				Expect:  ngfAPI.RouteTestExpectation{Unmatched: true},
+			},
+			expResult: RouteTestResult{
+				Message: "No Route matched the request",
+				Passed:  true,
+			},
+		},
+		{
+			name: "wildcard hostname",
+			test: ngfAPI.RouteTestCase{
+				Request: request("foo.example.com", "/"),
+				Expect:  ngfAPI.RouteTestExpectation{Route: routeRef("wildcard")},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/wildcard spec.rules[0]",
+				Passed:  true,
+			},
+		},
+		{
+			name: "GRPCRoute",
+			test: ngfAPI.RouteTestCase{
+				Request: ngfAPI.RouteTestRequest{
+					Hostname: "grpc.example.com",
+					Method:   helpers.GetPointer(v1.HTTPMethodPost),
+					Path:     helpers.GetPointer("/cafe.Barista/Order"),
+				},
+				Expect: ngfAPI.RouteTestExpectation{
+					Route: &ngfAPI.RouteTestRouteReference{
+						Kind: helpers.GetPointer[v1.Kind](kinds.GRPCRoute),
+						Name: "cafe",
+					},
+					Backend: &ngfAPI.RouteTestBackendReference{
+						Name: "barista",
+						Port: helpers.GetPointer[v1.PortNumber](9000),
+					},
+				},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched GRPCRoute test/cafe spec.rules[0]",
+				Passed:  true,
+			},
+		},
+		{
+			name: "matched, but expected unmatched",
+			test: ngfAPI.RouteTestCase{
+				Request: request("foo.example.com", "/"),
+				Expect:  ngfAPI.RouteTestExpectation{Unmatched: true},
+			},
+			expResult: RouteTestResult{
+				Message: "The request matched HTTPRoute test/wildcard spec.rules[0], " +
+					"but no Route was expected to match it",
+			},
+		},
+		{
+			name: "unknown hostname",
+			test: ngfAPI.RouteTestCase{
+				Request: request("example.org", "/"),
+				Expect:  ngfAPI.RouteTestExpectation{Unmatched: true},
+			},
+			expResult: RouteTestResult{
+				Message: "No Route matched the request",
+				Passed:  true,
+			},
+		},
+		{
+			name: "port without Listeners",
+			test: ngfAPI.RouteTestCase{
+				Request: ngfAPI.RouteTestRequest{
+					Hostname: "cafe.example.com",
+					Port:     helpers.GetPointer[v1.PortNumber](8080),
+				},
+				Expect: ngfAPI.RouteTestExpectation{Route: routeRef("coffee")},
+			},
+			expResult: RouteTestResult{
+				Message: "No Route matched the request, but a Route was expected to match it",
+			},
+		},
+	}
+
+	routeTests := make(map[types.NamespacedName]*ngfAPI.RouteTest, len(tests))
+	for _, test := range tests {
+		test.test.Name = test.name
+		routeTests[types.NamespacedName{Namespace: "test", Name: test.name}] = &ngfAPI.RouteTest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: test.name},
+			Spec:       ngfAPI.RouteTestSpec{Tests: []ngfAPI.RouteTestCase{test.test}},
+		}
+	}
+
+	processed := processRouteTests(routeTests, gws)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			rt := processed[types.NamespacedName{Namespace: "test", Name: test.name}]
+			g.Expect(rt).ToNot(BeNil())
+			g.Expect(rt.Source).To(Equal(routeTests[types.NamespacedName{Namespace: "test", Name: test.name}]))
+
+			test.expResult.Name = test.name
+			g.Expect(rt.Results).To(Equal([]RouteTestResult{test.expResult}))
+		})
+	}
+}
+
+func TestProcessRouteTestsNoRouteTests(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(processRouteTests(nil, nil)).To(BeNil())
+}
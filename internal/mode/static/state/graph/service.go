@@ -2,11 +2,10 @@ package graph
 
 import (
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// A ReferencedService represents a Kubernetes Service that is referenced by a Route and that belongs to the
-// winning Gateway. It does not contain the v1.Service object, because Services are resolved when building
+// A ReferencedService represents a Kubernetes Service that is referenced by a Route and that belongs to one of
+// the Gateways. It does not contain the v1.Service object, because Services are resolved when building
 // the dataplane.Configuration.
 type ReferencedService struct {
 	// Policies is a list of NGF Policies that target this Service.
@@ -16,17 +15,17 @@ type ReferencedService struct {
 func buildReferencedServices(
 	l7routes map[RouteKey]*L7Route,
 	l4Routes map[L4RouteKey]*L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 ) map[types.NamespacedName]*ReferencedService {
-	if gw == nil {
+	if len(gws) == 0 {
 		return nil
 	}
 
 	referencedServices := make(map[types.NamespacedName]*ReferencedService)
 
-	belongsToWinningGw := func(refs []ParentRef) bool {
+	belongsToGws := func(refs []ParentRef) bool {
 		for _, ref := range refs {
-			if ref.Gateway == client.ObjectKeyFromObject(gw.Source) {
+			if _, exists := gws[ref.Gateway]; exists {
 				return true
 			}
 		}
@@ -45,6 +44,14 @@ func buildReferencedServices(
 					}
 				}
 			}
+
+			for _, filter := range rule.Filters.Filters {
+				if filter.MirrorBackendRef != nil && filter.MirrorBackendRef.SvcNsName != (types.NamespacedName{}) {
+					referencedServices[filter.MirrorBackendRef.SvcNsName] = &ReferencedService{
+						Policies: nil,
+					}
+				}
+			}
 		}
 	}
 
@@ -65,7 +72,7 @@ func buildReferencedServices(
 			continue
 		}
 
-		if !belongsToWinningGw(route.ParentRefs) {
+		if !belongsToGws(route.ParentRefs) {
 			continue
 		}
 
@@ -77,7 +84,7 @@ func buildReferencedServices(
 			continue
 		}
 
-		if !belongsToWinningGw(route.ParentRefs) {
+		if !belongsToGws(route.ParentRefs) {
 			continue
 		}
 
@@ -21,7 +21,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 	}
-	ignoredGw := types.NamespacedName{Namespace: "test", Name: "ignoredGw"}
+	unknownGw := types.NamespacedName{Namespace: "test", Name: "unknownGw"}
 
 	getNormalL7Route := func() *L7Route {
 		return &L7Route{
@@ -107,6 +107,22 @@ func TestBuildReferencedServices(t *testing.T) {
 		return route
 	})
 
+	validRouteWithMirrorService := getModifiedL7Route(func(route *L7Route) *L7Route {
+		route.Spec.Rules[0].Filters = RouteRuleFilters{
+			Filters: []Filter{
+				{
+					FilterType: FilterRequestMirror,
+					MirrorBackendRef: &BackendRef{
+						SvcNsName: types.NamespacedName{Namespace: "mirror-ns", Name: "mirror"},
+					},
+				},
+			},
+			Valid: true,
+		}
+
+		return route
+	})
+
 	normalL4Route2 := getModifiedL4Route(func(route *L4Route) *L4Route {
 		route.Spec.BackendRef.SvcNsName = types.NamespacedName{Namespace: "tlsroute-ns", Name: "service2"}
 		return route
@@ -137,13 +153,13 @@ func TestBuildReferencedServices(t *testing.T) {
 		return route
 	})
 
-	normalL4RouteWinningAndIgnoredGws := getModifiedL4Route(func(route *L4Route) *L4Route {
+	normalL4RouteWinningAndUnknownGws := getModifiedL4Route(func(route *L4Route) *L4Route {
 		route.ParentRefs = []ParentRef{
 			{
-				Gateway: ignoredGw,
+				Gateway: unknownGw,
 			},
 			{
-				Gateway: ignoredGw,
+				Gateway: unknownGw,
 			},
 			{
 				Gateway: gwNsname,
@@ -152,28 +168,28 @@ func TestBuildReferencedServices(t *testing.T) {
 		return route
 	})
 
-	normalRouteWinningAndIgnoredGws := getModifiedL7Route(func(route *L7Route) *L7Route {
+	normalRouteWinningAndUnknownGws := getModifiedL7Route(func(route *L7Route) *L7Route {
 		route.ParentRefs = []ParentRef{
 			{
-				Gateway: ignoredGw,
+				Gateway: unknownGw,
 			},
 			{
 				Gateway: gwNsname,
 			},
 			{
-				Gateway: ignoredGw,
+				Gateway: unknownGw,
 			},
 		}
 		return route
 	})
 
-	normalL4RouteIgnoredGw := getModifiedL4Route(func(route *L4Route) *L4Route {
-		route.ParentRefs[0].Gateway = ignoredGw
+	normalL4RouteUnknownGw := getModifiedL4Route(func(route *L4Route) *L4Route {
+		route.ParentRefs[0].Gateway = unknownGw
 		return route
 	})
 
-	normalL7RouteIgnoredGw := getModifiedL7Route(func(route *L7Route) *L7Route {
-		route.ParentRefs[0].Gateway = ignoredGw
+	normalL7RouteUnknownGw := getModifiedL7Route(func(route *L7Route) *L7Route {
+		route.ParentRefs[0].Gateway = unknownGw
 		return route
 	})
 
@@ -220,6 +236,17 @@ func TestBuildReferencedServices(t *testing.T) {
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
 		},
+		{
+			name: "route with a request mirror service",
+			gw:   gw,
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "mirror-svc"}}: validRouteWithMirrorService,
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}: {},
+				{Namespace: "mirror-ns", Name: "mirror"}:  {},
+			},
+		},
 		{
 			name: "multiple valid routes with same services",
 			gw:   gw,
@@ -240,24 +267,24 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 		{
-			name: "valid routes that do not belong to winning gateway",
+			name: "valid routes that do not belong to any gateway",
 			gw:   gw,
 			l7Routes: map[RouteKey]*L7Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalL7RouteIgnoredGw,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-unknown-gws"}}: normalL7RouteUnknownGw,
 			},
 			l4Routes: map[L4RouteKey]*L4Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gw"}}: normalL4RouteIgnoredGw,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-unknown-gw"}}: normalL4RouteUnknownGw,
 			},
 			exp: nil,
 		},
 		{
-			name: "valid routes that belong to both winning and ignored gateways",
+			name: "valid routes that belong to both winning and unknown gateways",
 			gw:   gw,
 			l7Routes: map[RouteKey]*L7Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalRouteWinningAndIgnoredGws,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-unknown-gws"}}: normalRouteWinningAndUnknownGws,
 			},
 			l4Routes: map[L4RouteKey]*L4Route{
-				{NamespacedName: types.NamespacedName{Name: "ignored-gw"}}: normalL4RouteWinningAndIgnoredGws,
+				{NamespacedName: types.NamespacedName{Name: "unknown-gw"}}: normalL4RouteWinningAndUnknownGws,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}:   {},
@@ -337,7 +364,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes, test.gw)).To(Equal(test.exp))
+			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes, allGateways(test.gw, nil))).To(Equal(test.exp))
 		})
 	}
 }
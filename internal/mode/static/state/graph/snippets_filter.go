@@ -1,6 +1,15 @@
 package graph
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -8,9 +17,25 @@ import (
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/artifacts"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
+const (
+	// CosignPublicKeyKey is the key of the PEM-encoded cosign public key in the Secret of the verification
+	// of the signatures of OCI artifacts.
+	CosignPublicKeyKey = "cosign.pub"
+	// RekorPublicKeyKey is the key of the PEM-encoded public key of the Rekor transparency log in the Secret of
+	// the keyless verification of the signatures of OCI artifacts.
+	RekorPublicKeyKey = "rekor.pub"
+)
+
+// ArtifactFetcher fetches the contents of OCI artifacts.
+type ArtifactFetcher interface {
+	// Get returns the content of the artifact, or artifacts.ErrPending if the artifact is being fetched.
+	Get(ref artifacts.Reference) ([]byte, error)
+}
+
 // SnippetsFilter represents a ngfAPI.SnippetsFilter.
 type SnippetsFilter struct {
 	// Source is the SnippetsFilter.
@@ -52,14 +77,20 @@ func getSnippetsFilterResolverForNamespace(
 	}
 }
 
+// processSnippetsFilters processes the SnippetsFilters. It returns the processed SnippetsFilters and
+// the pull and verification Secrets referenced by the OCI artifacts of their snippets, including the Secrets
+// that do not exist.
 func processSnippetsFilters(
 	snippetsFilters map[types.NamespacedName]*ngfAPI.SnippetsFilter,
-) map[types.NamespacedName]*SnippetsFilter {
+	secrets map[types.NamespacedName]*apiv1.Secret,
+	fetcher ArtifactFetcher,
+) (map[types.NamespacedName]*SnippetsFilter, map[types.NamespacedName]*apiv1.Secret) {
 	if len(snippetsFilters) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	processed := make(map[types.NamespacedName]*SnippetsFilter)
+	artifactSecrets := &artifactSecretResolver{secrets: secrets}
 
 	for nsname, sf := range snippetsFilters {
 		if cond := validateSnippetsFilter(sf); cond != nil {
@@ -72,14 +103,760 @@ func processSnippetsFilters(
 			continue
 		}
 
+		snippets, cond := resolveSnippetArtifacts(sf, artifactSecrets, fetcher)
+		if cond != nil {
+			processed[nsname] = &SnippetsFilter{
+				Source:     sf,
+				Conditions: []conditions.Condition{*cond},
+				Valid:      false,
+			}
+
+			continue
+		}
+
 		processed[nsname] = &SnippetsFilter{
 			Source:   sf,
 			Valid:    true,
-			Snippets: createSnippetsMap(sf.Spec.Snippets),
+			Snippets: createSnippetsMap(snippets),
+		}
+	}
+
+	invalidateMapVariableCollisions(processed)
+
+	return processed, artifactSecrets.referenced
+}
+
+// artifactSecretResolver resolves the pull and verification Secrets of OCI artifacts and records
+// the referenced Secrets.
+type artifactSecretResolver struct {
+	secrets    map[types.NamespacedName]*apiv1.Secret
+	referenced map[types.NamespacedName]*apiv1.Secret
+}
+
+func (r *artifactSecretResolver) resolve(nsname types.NamespacedName) *apiv1.Secret {
+	if r.referenced == nil {
+		r.referenced = make(map[types.NamespacedName]*apiv1.Secret)
+	}
+
+	secret := r.secrets[nsname]
+	r.referenced[nsname] = secret
+
+	return secret
+}
+
+// resolveSnippetArtifacts returns the snippets of the SnippetsFilter with the values of the snippets that reference
+// OCI artifacts set to the contents of the artifacts. It returns a condition if an artifact is invalid, fails
+// the verification of its signatures, or is not fetched yet. The artifacts of all snippets are requested,
+// so that they are fetched concurrently.
+func resolveSnippetArtifacts(
+	sf *ngfAPI.SnippetsFilter,
+	artifactSecrets *artifactSecretResolver,
+	fetcher ArtifactFetcher,
+) ([]ngfAPI.Snippet, *conditions.Condition) {
+	var (
+		allErrs          field.ErrorList
+		verificationErrs field.ErrorList
+		pending          []string
+	)
+
+	snippets := sf.Spec.Snippets
+	cloned := false
+
+	for i, snippet := range sf.Spec.Snippets {
+		if snippet.Artifact == nil {
+			continue
+		}
+
+		artifactPath := field.NewPath("spec.snippets").Index(i).Child("artifact")
+
+		content, status, err := fetchSnippetArtifact(
+			*snippet.Artifact,
+			sf.Namespace,
+			artifactPath,
+			artifactSecrets,
+			fetcher,
+		)
+
+		switch status {
+		case artifactInvalid:
+			allErrs = append(allErrs, err)
+			continue
+		case artifactVerificationFailed:
+			verificationErrs = append(verificationErrs, err)
+			continue
+		case artifactPending:
+			pending = append(pending, artifactPath.String())
+			continue
+		}
+
+		if !cloned {
+			snippets = slices.Clone(snippets)
+			cloned = true
+		}
+
+		snippets[i].Value = content
+	}
+
+	if allErrs != nil {
+		cond := staticConds.NewSnippetsFilterInvalid(allErrs.ToAggregate().Error())
+		return nil, &cond
+	}
+
+	if verificationErrs != nil {
+		cond := staticConds.NewSnippetsFilterArtifactVerificationFailed(verificationErrs.ToAggregate().Error())
+		return nil, &cond
+	}
+
+	if pending != nil {
+		cond := staticConds.NewSnippetsFilterArtifactPending(
+			fmt.Sprintf("OCI artifacts are being fetched: %s", strings.Join(pending, ", ")),
+		)
+		return nil, &cond
+	}
+
+	return snippets, nil
+}
+
+// artifactStatus is the status of the fetch of an OCI artifact.
+type artifactStatus int
+
+const (
+	// artifactFetched means that the artifact is fetched, and verified if it has a verification.
+	artifactFetched artifactStatus = iota
+	// artifactPending means that the artifact is being fetched.
+	artifactPending
+	// artifactInvalid means that the artifact is invalid or failed to be fetched.
+	artifactInvalid
+	// artifactVerificationFailed means that the signatures of the artifact are not verified, or that
+	// the verification is required but the artifact has none.
+	artifactVerificationFailed
+)
+
+// fetchSnippetArtifact returns the content and the status of the OCI artifact of a snippet. The error is
+// returned for the artifactInvalid and artifactVerificationFailed statuses.
+func fetchSnippetArtifact(
+	artifact ngfAPI.OCIArtifact,
+	namespace string,
+	artifactPath *field.Path,
+	artifactSecrets *artifactSecretResolver,
+	fetcher ArtifactFetcher,
+) (string, artifactStatus, *field.Error) {
+	if fetcher == nil {
+		return "", artifactInvalid, field.Forbidden(artifactPath, "OCI artifacts are not supported")
+	}
+
+	ref := artifacts.Reference{
+		Repository: artifact.Repository,
+		Digest:     artifact.Digest,
+	}
+
+	if artifact.PullSecret != nil {
+		credentials, err := resolvePullSecretCredentials(artifact, namespace, artifactPath, artifactSecrets)
+		if err != nil {
+			return "", artifactInvalid, err
+		}
+
+		ref.Credentials = credentials
+	}
+
+	if artifact.Verification != nil {
+		verification, err := resolveArtifactVerification(
+			*artifact.Verification,
+			namespace,
+			artifactPath.Child("verification"),
+			artifactSecrets,
+		)
+		if err != nil {
+			return "", artifactInvalid, err
 		}
+
+		ref.Verification = verification
+	}
+
+	content, err := fetcher.Get(ref)
+
+	var verificationErr *artifacts.VerificationError
+
+	switch {
+	case errors.Is(err, artifacts.ErrPending):
+		return "", artifactPending, nil
+	case errors.Is(err, artifacts.ErrVerificationRequired):
+		return "", artifactVerificationFailed, field.Required(
+			artifactPath.Child("verification"),
+			"signature verification of OCI artifacts is required",
+		)
+	case errors.As(err, &verificationErr):
+		return "", artifactVerificationFailed, field.Invalid(artifactPath, ref.String(), err.Error())
+	case err != nil:
+		return "", artifactInvalid, field.Invalid(
+			artifactPath,
+			ref.String(),
+			fmt.Sprintf("failed to fetch artifact: %v", err),
+		)
+	}
+
+	if len(content) == 0 || !utf8.Valid(content) {
+		return "", artifactInvalid, field.Invalid(artifactPath, ref.String(), "content must be non-empty UTF-8 text")
+	}
+
+	return string(content), artifactFetched, nil
+}
+
+func resolvePullSecretCredentials(
+	artifact ngfAPI.OCIArtifact,
+	namespace string,
+	artifactPath *field.Path,
+	artifactSecrets *artifactSecretResolver,
+) (*artifacts.Credentials, *field.Error) {
+	secretPath := artifactPath.Child("pullSecret")
+	name := *artifact.PullSecret
+
+	secret := artifactSecrets.resolve(types.NamespacedName{Namespace: namespace, Name: name})
+	if secret == nil {
+		return nil, field.NotFound(secretPath, name)
+	}
+
+	if secret.Type != apiv1.SecretTypeDockerConfigJson {
+		return nil, field.Invalid(
+			secretPath,
+			name,
+			fmt.Sprintf("Secret must be of type %s", apiv1.SecretTypeDockerConfigJson),
+		)
+	}
+
+	credentials, err := artifacts.CredentialsFromDockerConfig(secret.Data[apiv1.DockerConfigJsonKey], artifact.Repository)
+	if err != nil {
+		return nil, field.Invalid(secretPath, name, err.Error())
+	}
+
+	if credentials == nil {
+		return nil, field.Invalid(secretPath, name, "Secret has no credentials for the registry of the repository")
+	}
+
+	return credentials, nil
+}
+
+// resolveArtifactVerification returns the Verification of the signatures of an OCI artifact with the public key
+// or the trust root from the Secret of the verification.
+func resolveArtifactVerification(
+	verification ngfAPI.ArtifactVerification,
+	namespace string,
+	verificationPath *field.Path,
+	artifactSecrets *artifactSecretResolver,
+) (*artifacts.Verification, *field.Error) {
+	if verification.Key != nil {
+		secretPath := verificationPath.Child("key", "secretName")
+		name := verification.Key.SecretName
+
+		data, err := resolveVerificationSecretData(namespace, name, secretPath, artifactSecrets, CosignPublicKeyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		v, verr := artifacts.NewKeyVerification(data[CosignPublicKeyKey])
+		if verr != nil {
+			return nil, field.Invalid(secretPath, name, fmt.Sprintf("invalid %s: %v", CosignPublicKeyKey, verr))
+		}
+
+		return v, nil
+	}
+
+	keyless := verification.Keyless
+	keylessPath := verificationPath.Child("keyless")
+	identity := artifacts.Identity{Issuer: keyless.Issuer}
+
+	switch {
+	case keyless.Subject != nil:
+		identity.Subject = *keyless.Subject
+	case keyless.SubjectRegex != nil:
+		subjectRegexp, err := regexp.Compile(*keyless.SubjectRegex)
+		if err != nil {
+			return nil, field.Invalid(keylessPath.Child("subjectRegex"), *keyless.SubjectRegex, err.Error())
+		}
+
+		identity.SubjectRegexp = subjectRegexp
 	}
 
-	return processed
+	secretPath := keylessPath.Child("trustRootSecretName")
+	name := keyless.TrustRootSecretName
+
+	data, err := resolveVerificationSecretData(namespace, name, secretPath, artifactSecrets, CAKey, RekorPublicKeyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	v, verr := artifacts.NewKeylessVerification(
+		artifacts.TrustRoot{FulcioCertificates: data[CAKey], RekorPublicKey: data[RekorPublicKeyKey]},
+		identity,
+	)
+	if verr != nil {
+		return nil, field.Invalid(secretPath, name, verr.Error())
+	}
+
+	return v, nil
+}
+
+// resolveVerificationSecretData returns the data of the verification Secret, which must have the keys.
+func resolveVerificationSecretData(
+	namespace,
+	name string,
+	secretPath *field.Path,
+	artifactSecrets *artifactSecretResolver,
+	keys ...string,
+) (map[string][]byte, *field.Error) {
+	secret := artifactSecrets.resolve(types.NamespacedName{Namespace: namespace, Name: name})
+	if secret == nil {
+		return nil, field.NotFound(secretPath, name)
+	}
+
+	for _, key := range keys {
+		if len(secret.Data[key]) == 0 {
+			return nil, field.Invalid(secretPath, name, fmt.Sprintf("Secret must have the data field %q", key))
+		}
+	}
+
+	return secret.Data, nil
+}
+
+// mapVariableRegexp matches the variable defined by a map block: map <source> $<variable> {.
+var mapVariableRegexp = regexp.MustCompile(`(?:^|[;{}\s])map\s+\S+\s+\$(\w+)\s*\{`)
+
+// invalidateMapVariableCollisions invalidates the SnippetsFilters with map snippets that define a variable that
+// is already defined by the map snippet of another SnippetsFilter. All map snippets are inserted into the same
+// http context, so NGINX would fail to reload with duplicate variables. The SnippetsFilters are processed in
+// the order of their namespace and name, so the same SnippetsFilter wins on every rebuild of the Graph.
+func invalidateMapVariableCollisions(snippetsFilters map[types.NamespacedName]*SnippetsFilter) {
+	nsnames := make([]types.NamespacedName, 0, len(snippetsFilters))
+	for nsname, sf := range snippetsFilters {
+		if _, ok := sf.Snippets[ngfAPI.NginxContextHTTPMap]; sf.Valid && ok {
+			nsnames = append(nsnames, nsname)
+		}
+	}
+
+	slices.SortFunc(nsnames, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	definedBy := make(map[string]types.NamespacedName)
+
+	for _, nsname := range nsnames {
+		sf := snippetsFilters[nsname]
+		variables := getMapVariables(sf.Snippets[ngfAPI.NginxContextHTTPMap])
+
+		var collisions []string
+		for _, variable := range variables {
+			if owner, exists := definedBy[variable]; exists {
+				collisions = append(collisions, fmt.Sprintf("$%s is already defined by SnippetsFilter %s", variable, owner))
+			}
+		}
+
+		if len(collisions) > 0 {
+			path := field.NewPath("spec.snippets")
+			cond := staticConds.NewSnippetsFilterInvalid(
+				field.Invalid(path, ngfAPI.NginxContextHTTPMap, strings.Join(collisions, "; ")).Error(),
+			)
+
+			sf.Valid = false
+			sf.Snippets = nil
+			sf.Conditions = append(sf.Conditions, cond)
+
+			continue
+		}
+
+		for _, variable := range variables {
+			definedBy[variable] = nsname
+		}
+	}
+}
+
+// getMapVariables returns the names of the variables defined by the map blocks in the snippet.
+func getMapVariables(snippet string) []string {
+	matches := mapVariableRegexp.FindAllStringSubmatch(snippet, -1)
+
+	variables := make([]string, 0, len(matches))
+	for _, match := range matches {
+		variables = append(variables, match[1])
+	}
+
+	return variables
+}
+
+// ruleSnippetsContexts are the NGINX contexts of the snippets that are inserted for a routing rule, in the order
+// of their conflict detection.
+var ruleSnippetsContexts = []ngfAPI.NginxContext{
+	ngfAPI.NginxContextHTTPServer,
+	ngfAPI.NginxContextHTTPServerLocation,
+	ngfAPI.NginxContextHTTPUpstream,
+}
+
+// repeatableDirectives are the directives that NGINX allows to repeat in the same context, so the SnippetsFilters
+// of a rule that set them don't conflict.
+var repeatableDirectives = map[string]struct{}{
+	"add_header":        {},
+	"add_trailer":       {},
+	"allow":             {},
+	"deny":              {},
+	"error_page":        {},
+	"grpc_hide_header":  {},
+	"grpc_set_header":   {},
+	"if":                {},
+	"include":           {},
+	"location":          {},
+	"mirror":            {},
+	"proxy_hide_header": {},
+	"proxy_set_header":  {},
+	"rewrite":           {},
+	"set":               {},
+	"sub_filter":        {},
+}
+
+// headerDirectives are the repeatable directives whose first argument is the name of a header. The directives
+// of a rule that set the same header conflict.
+var headerDirectives = map[string]struct{}{
+	"add_header":        {},
+	"grpc_hide_header":  {},
+	"grpc_set_header":   {},
+	"proxy_hide_header": {},
+	"proxy_set_header":  {},
+}
+
+// findSnippetsFilterConflicts returns the conflicts between the SnippetsFilters referenced by the filters of
+// a routing rule. The snippets of the SnippetsFilters are inserted in the order of the filters, and a SnippetsFilter
+// referenced several times is only inserted once. Two SnippetsFilters conflict when they set the same directive in
+// the same context, which NGINX either rejects or resolves in favor of one of them.
+func findSnippetsFilterConflicts(filters []Filter, path *field.Path) []string {
+	type owner struct {
+		name   string
+		filter *SnippetsFilter
+	}
+
+	owners := make(map[ngfAPI.NginxContext]map[string]owner)
+
+	var conflicts []string
+
+	for i, f := range filters {
+		if f.ResolvedExtensionRef == nil || f.ResolvedExtensionRef.SnippetsFilter == nil {
+			continue
+		}
+
+		sf := f.ResolvedExtensionRef.SnippetsFilter
+
+		for _, ctx := range ruleSnippetsContexts {
+			snippet, ok := sf.Snippets[ctx]
+			if !ok {
+				continue
+			}
+
+			if owners[ctx] == nil {
+				owners[ctx] = make(map[string]owner)
+			}
+
+			for _, directive := range getTopLevelDirectives(snippet) {
+				if _, repeatable := repeatableDirectives[directive]; repeatable {
+					continue
+				}
+
+				o, exists := owners[ctx][directive]
+				if !exists {
+					owners[ctx][directive] = owner{name: sf.Source.Name, filter: sf}
+					continue
+				}
+
+				if o.filter != sf {
+					conflicts = append(conflicts, fmt.Sprintf(
+						"%s: directive %q in context %s is already set by SnippetsFilter %q",
+						path.Index(i).Child("extensionRef"),
+						directive,
+						ctx,
+						o.name,
+					))
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// loadBalancingDirectives are the directives that set the load balancing method of an upstream.
+var loadBalancingDirectives = map[string]struct{}{
+	"hash":       {},
+	"ip_hash":    {},
+	"least_conn": {},
+	"least_time": {},
+	"random":     {},
+}
+
+// findLoadBalancingDirective returns the index of the first filter of a routing rule that references
+// a SnippetsFilter whose upstream snippet sets the load balancing method, and the directive that sets it.
+// Returns -1 if none of the upstream snippets sets the load balancing method.
+func findLoadBalancingDirective(filters []Filter) (int, string) {
+	for i, f := range filters {
+		if f.ResolvedExtensionRef == nil || f.ResolvedExtensionRef.SnippetsFilter == nil {
+			continue
+		}
+
+		snippet, ok := f.ResolvedExtensionRef.SnippetsFilter.Snippets[ngfAPI.NginxContextHTTPUpstream]
+		if !ok {
+			continue
+		}
+
+		for _, directive := range getTopLevelDirectives(snippet) {
+			if _, exists := loadBalancingDirectives[directive]; exists {
+				return i, directive
+			}
+		}
+	}
+
+	return -1, ""
+}
+
+// findFilterDirectiveConflicts returns the conflicts between the location snippets of the SnippetsFilters referenced
+// by the filters of a routing rule and the directives that are generated for the other filters of the rule.
+// Regardless of the order of the filters, the location snippets are included before the generated directives,
+// so, for example, a location snippet that returns a response takes precedence over a RequestRedirect filter,
+// while a location snippet that sets a request header that a RequestHeaderModifier filter also sets makes NGINX
+// send the header twice.
+func findFilterDirectiveConflicts(filters []Filter, path *field.Path) []string {
+	type owner struct {
+		path       *field.Path
+		filterType FilterType
+	}
+
+	owners := make(map[string]owner)
+
+	for i, f := range filters {
+		for _, d := range getGeneratedDirectives(f) {
+			key := getDirectiveConflictKey(d)
+			if _, exists := owners[key]; !exists {
+				owners[key] = owner{path: path.Index(i), filterType: f.FilterType}
+			}
+		}
+	}
+
+	if len(owners) == 0 {
+		return nil
+	}
+
+	var conflicts []string
+
+	seen := make(map[*SnippetsFilter]struct{})
+
+	for i, f := range filters {
+		if f.ResolvedExtensionRef == nil || f.ResolvedExtensionRef.SnippetsFilter == nil {
+			continue
+		}
+
+		sf := f.ResolvedExtensionRef.SnippetsFilter
+		if _, exists := seen[sf]; exists {
+			continue
+		}
+		seen[sf] = struct{}{}
+
+		snippet, ok := sf.Snippets[ngfAPI.NginxContextHTTPServerLocation]
+		if !ok {
+			continue
+		}
+
+		for _, d := range getTopLevelStatements(snippet) {
+			o, exists := owners[getDirectiveConflictKey(d)]
+			if !exists {
+				continue
+			}
+
+			directive := d.name
+			if _, isHeader := headerDirectives[d.name]; isHeader && len(d.args) > 0 {
+				directive += " " + d.args[0]
+			}
+
+			conflicts = append(conflicts, fmt.Sprintf(
+				"%s: directive %q in context %s is already set by the %s filter %s",
+				path.Index(i).Child("extensionRef"),
+				directive,
+				ngfAPI.NginxContextHTTPServerLocation,
+				o.filterType,
+				o.path,
+			))
+		}
+	}
+
+	return conflicts
+}
+
+// getDirectiveConflictKey returns the key under which the directives conflict. The directives that set a header
+// conflict only when they set the same header, the other repeatable directives never conflict.
+func getDirectiveConflictKey(d snippetStatement) string {
+	if _, isHeader := headerDirectives[d.name]; isHeader && len(d.args) > 0 {
+		return d.name + " " + strings.ToLower(d.args[0])
+	}
+
+	if _, repeatable := repeatableDirectives[d.name]; repeatable {
+		return ""
+	}
+
+	return d.name
+}
+
+// getGeneratedDirectives returns the directives that are generated in the location context for the filter,
+// with the name of the header as the only argument of the directives that set a header. Only the directives that
+// a location snippet can conflict with are returned.
+func getGeneratedDirectives(f Filter) []snippetStatement {
+	proxyOrGRPC := "proxy"
+	if f.RouteType == RouteTypeGRPC {
+		proxyOrGRPC = "grpc"
+	}
+
+	var directives []snippetStatement
+
+	headerDirective := func(name string, header string) {
+		directives = append(directives, snippetStatement{name: name, args: []string{header}})
+	}
+
+	switch f.FilterType {
+	case FilterRequestRedirect:
+		directives = append(directives, snippetStatement{name: "return"})
+	case FilterURLRewrite:
+		if f.URLRewrite != nil && f.URLRewrite.Hostname != nil {
+			headerDirective(proxyOrGRPC+"_set_header", "Host")
+		}
+	case FilterRequestMirror:
+		directives = append(directives, snippetStatement{name: "mirror_request_body"})
+	case FilterRequestHeaderModifier:
+		if f.RequestHeaderModifier == nil {
+			break
+		}
+
+		for _, h := range slices.Concat(f.RequestHeaderModifier.Set, f.RequestHeaderModifier.Add) {
+			headerDirective(proxyOrGRPC+"_set_header", string(h.Name))
+		}
+
+		for _, h := range f.RequestHeaderModifier.Remove {
+			headerDirective(proxyOrGRPC+"_set_header", h)
+		}
+	case FilterExtensionRef:
+		directives = append(directives, getBodyTransformDirectives(f.ResolvedExtensionRef)...)
+		directives = append(directives, getDirectResponseDirectives(f.ResolvedExtensionRef)...)
+	case FilterResponseHeaderModifier:
+		if f.ResponseHeaderModifier == nil {
+			break
+		}
+
+		for _, h := range slices.Concat(f.ResponseHeaderModifier.Set, f.ResponseHeaderModifier.Add) {
+			headerDirective("add_header", string(h.Name))
+		}
+
+		for _, h := range f.ResponseHeaderModifier.Set {
+			headerDirective(proxyOrGRPC+"_hide_header", string(h.Name))
+		}
+
+		for _, h := range f.ResponseHeaderModifier.Remove {
+			headerDirective(proxyOrGRPC+"_hide_header", h)
+		}
+	}
+
+	return directives
+}
+
+// getTopLevelDirectives returns the names of the directives at the top level of the snippet, without duplicates.
+// The directives inside blocks, comments and quoted strings are ignored.
+func getTopLevelDirectives(snippet string) []string {
+	var directives []string
+
+	for _, d := range getTopLevelStatements(snippet) {
+		if !slices.Contains(directives, d.name) {
+			directives = append(directives, d.name)
+		}
+	}
+
+	return directives
+}
+
+// snippetStatement is a directive at the top level of a snippet.
+type snippetStatement struct {
+	// name is the name of the directive.
+	name string
+	// args are the arguments of the directive, without quotes. The arguments of a block directive are the ones
+	// before the block.
+	args []string
+}
+
+// getTopLevelStatements returns the directives at the top level of the snippet, in order.
+// The directives inside blocks and comments are ignored.
+func getTopLevelStatements(snippet string) []snippetStatement {
+	var (
+		statements []snippetStatement
+		words      []string
+		word       strings.Builder
+		depth      int
+		quote      rune
+		escaped    bool
+		comment    bool
+		quoted     bool
+	)
+
+	endWord := func() {
+		if word.Len() == 0 && !quoted {
+			return
+		}
+
+		if depth == 0 {
+			words = append(words, word.String())
+		}
+
+		word.Reset()
+		quoted = false
+	}
+
+	endStatement := func() {
+		endWord()
+
+		if depth == 0 && len(words) > 0 {
+			statements = append(statements, snippetStatement{name: words[0], args: words[1:]})
+		}
+
+		words = nil
+	}
+
+	for _, r := range snippet {
+		switch {
+		case comment:
+			comment = r != '\n'
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+				word.WriteRune(r)
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			default:
+				word.WriteRune(r)
+			}
+		case r == '#':
+			endWord()
+			comment = true
+		case r == '"' || r == '\'':
+			quote = r
+			quoted = true
+		case r == '{':
+			endStatement()
+			depth++
+		case r == '}':
+			endStatement()
+			depth--
+		case r == ';':
+			endStatement()
+		case unicode.IsSpace(r):
+			endWord()
+		default:
+			word.WriteRune(r)
+		}
+	}
+
+	endStatement()
+
+	return statements
 }
 
 func createSnippetsMap(snippets []ngfAPI.Snippet) map[ngfAPI.NginxContext]string {
@@ -108,7 +885,15 @@ func validateSnippetsFilter(filter *ngfAPI.SnippetsFilter) *conditions.Condition
 
 	for i, snippet := range filter.Spec.Snippets {
 		valuePath := snippetsPath.Index(i).Child("value")
-		if snippet.Value == "" {
+		if snippet.Artifact != nil && snippet.Value != "" {
+			cond := staticConds.NewSnippetsFilterInvalid(
+				field.Forbidden(snippetsPath.Index(i), "only one of value or artifact can be set").Error(),
+			)
+
+			return &cond
+		}
+
+		if snippet.Artifact == nil && snippet.Value == "" {
 			cond := staticConds.NewSnippetsFilterInvalid(
 				field.Required(valuePath, "value cannot be empty").Error(),
 			)
@@ -122,7 +907,9 @@ func validateSnippetsFilter(filter *ngfAPI.SnippetsFilter) *conditions.Condition
 		case ngfAPI.NginxContextMain,
 			ngfAPI.NginxContextHTTP,
 			ngfAPI.NginxContextHTTPServer,
-			ngfAPI.NginxContextHTTPServerLocation:
+			ngfAPI.NginxContextHTTPServerLocation,
+			ngfAPI.NginxContextHTTPUpstream,
+			ngfAPI.NginxContextHTTPMap:
 		default:
 			err := field.NotSupported(
 				ctxPath,
@@ -132,6 +919,8 @@ func validateSnippetsFilter(filter *ngfAPI.SnippetsFilter) *conditions.Condition
 					ngfAPI.NginxContextHTTP,
 					ngfAPI.NginxContextHTTPServer,
 					ngfAPI.NginxContextHTTPServerLocation,
+					ngfAPI.NginxContextHTTPUpstream,
+					ngfAPI.NginxContextHTTPMap,
 				},
 			)
 
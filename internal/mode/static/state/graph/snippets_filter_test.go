@@ -1,16 +1,26 @@
 package graph
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/artifacts"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
@@ -62,6 +72,27 @@ func TestProcessSnippetsFilters(t *testing.T) {
 		},
 	}
 
+	mapFilter1NsName := types.NamespacedName{Namespace: "test", Name: "map-1"}
+	mapFilter2NsName := types.NamespacedName{Namespace: "test", Name: "map-2"}
+	mapFilter3NsName := types.NamespacedName{Namespace: "test", Name: "map-3"}
+
+	createMapFilter := func(value string) *ngfAPI.SnippetsFilter {
+		return &ngfAPI.SnippetsFilter{
+			Spec: ngfAPI.SnippetsFilterSpec{
+				Snippets: []ngfAPI.Snippet{
+					{
+						Context: ngfAPI.NginxContextHTTPMap,
+						Value:   value,
+					},
+				},
+			},
+		}
+	}
+
+	mapFilter1 := createMapFilter("map $uri $is_api {\n default 0;\n ~^/api 1;\n}\nmap $host $tenant { default none; }")
+	mapFilter2 := createMapFilter("map $http_user_agent $is_api { default 0; }")
+	mapFilter3 := createMapFilter("map $http_user_agent $is_mobile { default 0; }")
+
 	tests := []struct {
 		snippetsFilters      map[types.NamespacedName]*ngfAPI.SnippetsFilter
 		expProcessedSnippets map[types.NamespacedName]*SnippetsFilter
@@ -104,11 +135,46 @@ func TestProcessSnippetsFilters(t *testing.T) {
 					Conditions: []conditions.Condition{
 						staticConds.NewSnippetsFilterInvalid(
 							"spec.snippets[1].context: Unsupported value: \"invalid context\": " +
-								"supported values: \"main\", \"http\", \"http.server\", \"http.server.location\"",
+								"supported values: \"main\", \"http\", \"http.server\", \"http.server.location\", " +
+								"\"http.upstream\", \"http.map\"",
+						),
+					},
+					Valid: false,
+				},
+			},
+		},
+		{
+			msg: "map snippets with colliding variables",
+			snippetsFilters: map[types.NamespacedName]*ngfAPI.SnippetsFilter{
+				mapFilter1NsName: mapFilter1,
+				mapFilter2NsName: mapFilter2,
+				mapFilter3NsName: mapFilter3,
+			},
+			expProcessedSnippets: map[types.NamespacedName]*SnippetsFilter{
+				mapFilter1NsName: {
+					Source: mapFilter1,
+					Valid:  true,
+					Snippets: map[ngfAPI.NginxContext]string{
+						ngfAPI.NginxContextHTTPMap: mapFilter1.Spec.Snippets[0].Value,
+					},
+				},
+				mapFilter2NsName: {
+					Source: mapFilter2,
+					Conditions: []conditions.Condition{
+						staticConds.NewSnippetsFilterInvalid(
+							"spec.snippets: Invalid value: \"http.map\": " +
+								"$is_api is already defined by SnippetsFilter test/map-1",
 						),
 					},
 					Valid: false,
 				},
+				mapFilter3NsName: {
+					Source: mapFilter3,
+					Valid:  true,
+					Snippets: map[ngfAPI.NginxContext]string{
+						ngfAPI.NginxContextHTTPMap: mapFilter3.Spec.Snippets[0].Value,
+					},
+				},
 			},
 		},
 	}
@@ -118,8 +184,9 @@ func TestProcessSnippetsFilters(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processedSnippetsFilters := processSnippetsFilters(test.snippetsFilters)
+			processedSnippetsFilters, artifactSecrets := processSnippetsFilters(test.snippetsFilters, nil, nil)
 			g.Expect(processedSnippetsFilters).To(BeEquivalentTo(test.expProcessedSnippets))
+			g.Expect(artifactSecrets).To(BeNil())
 		})
 	}
 }
@@ -145,6 +212,14 @@ func TestValidateSnippetsFilter(t *testing.T) {
 							Context: ngfAPI.NginxContextHTTP,
 							Value:   "http snippet",
 						},
+						{
+							Context: ngfAPI.NginxContextHTTPUpstream,
+							Value:   "upstream snippet",
+						},
+						{
+							Context: ngfAPI.NginxContextHTTPMap,
+							Value:   "map snippet",
+						},
 					},
 				},
 			},
@@ -179,7 +254,8 @@ func TestValidateSnippetsFilter(t *testing.T) {
 			},
 			expCond: staticConds.NewSnippetsFilterInvalid(
 				"spec.snippets[2].context: Unsupported value: \"invalid context\": " +
-					"supported values: \"main\", \"http\", \"http.server\", \"http.server.location\"",
+					"supported values: \"main\", \"http\", \"http.server\", \"http.server.location\", " +
+					"\"http.upstream\", \"http.map\"",
 			),
 		},
 		{
@@ -204,9 +280,9 @@ func TestValidateSnippetsFilter(t *testing.T) {
 			},
 			expCond: staticConds.NewSnippetsFilterInvalid(
 				"[spec.snippets[1].context: Unsupported value: \"invalid context\": supported values: " +
-					"\"main\", \"http\", \"http.server\", \"http.server.location\", spec.snippets[2].context: " +
-					"Unsupported value: \"\": supported values: \"main\", \"http\", " +
-					"\"http.server\", \"http.server.location\"]",
+					"\"main\", \"http\", \"http.server\", \"http.server.location\", \"http.upstream\", " +
+					"\"http.map\", spec.snippets[2].context: Unsupported value: \"\": supported values: " +
+					"\"main\", \"http\", \"http.server\", \"http.server.location\", \"http.upstream\", \"http.map\"]",
 			),
 		},
 		{
@@ -260,7 +336,7 @@ func TestValidateSnippetsFilter(t *testing.T) {
 			expCond: staticConds.NewSnippetsFilterInvalid(
 				"[spec.snippets[2].context: Invalid value: \"main\": only one snippet is allowed per context, " +
 					"spec.snippets[3].context: Unsupported value: \"invalid context\": supported values: \"main\", " +
-					"\"http\", \"http.server\", \"http.server.location\"]",
+					"\"http\", \"http.server\", \"http.server.location\", \"http.upstream\", \"http.map\"]",
 			),
 		},
 		{
@@ -283,6 +359,26 @@ func TestValidateSnippetsFilter(t *testing.T) {
 				"spec.snippets[1].value: Required value: value cannot be empty",
 			),
 		},
+		{
+			msg: "invalid filter; both value and artifact",
+			filter: &ngfAPI.SnippetsFilter{
+				Spec: ngfAPI.SnippetsFilterSpec{
+					Snippets: []ngfAPI.Snippet{
+						{
+							Context: ngfAPI.NginxContextMain,
+							Value:   "main snippet",
+							Artifact: &ngfAPI.OCIArtifact{
+								Repository: "registry.example.com/team/snippets",
+								Digest:     "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+							},
+						},
+					},
+				},
+			},
+			expCond: staticConds.NewSnippetsFilterInvalid(
+				"spec.snippets[0]: Forbidden: only one of value or artifact can be set",
+			),
+		},
 	}
 
 	for _, test := range tests {
@@ -445,3 +541,795 @@ func TestGetSnippetsFilterResolverForNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSnippetsFilterConflicts(t *testing.T) {
+	t.Parallel()
+
+	createSnippetsFilter := func(name string, snippets map[ngfAPI.NginxContext]string) *SnippetsFilter {
+		return &SnippetsFilter{
+			Source: &ngfAPI.SnippetsFilter{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			Valid:    true,
+			Snippets: snippets,
+		}
+	}
+
+	createFilter := func(sf *SnippetsFilter) Filter {
+		return Filter{
+			RouteType:            RouteTypeHTTP,
+			FilterType:           FilterExtensionRef,
+			ResolvedExtensionRef: &ExtensionRefFilter{SnippetsFilter: sf, Valid: true},
+		}
+	}
+
+	timeouts := createSnippetsFilter("timeouts", map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPServerLocation: "proxy_read_timeout 10s; add_header X-Timeout 10s;",
+		ngfAPI.NginxContextHTTPServer:         "client_max_body_size 1m;",
+	})
+	otherTimeouts := createSnippetsFilter("other-timeouts", map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPServerLocation: "# proxy_send_timeout\nproxy_read_timeout 20s;\nadd_header X-Other 1;",
+	})
+	serverSize := createSnippetsFilter("server-size", map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPServerLocation: "client_max_body_size 2m;",
+		ngfAPI.NginxContextHTTPServer:         "client_max_body_size 3m;",
+	})
+
+	path := field.NewPath("spec").Child("rules").Index(0).Child("filters")
+
+	tests := []struct {
+		name         string
+		filters      []Filter
+		expConflicts []string
+	}{
+		{
+			name: "no snippets filters",
+			filters: []Filter{
+				{RouteType: RouteTypeHTTP, FilterType: FilterRequestHeaderModifier},
+			},
+		},
+		{
+			name:    "same snippets filter referenced twice",
+			filters: []Filter{createFilter(timeouts), createFilter(timeouts)},
+		},
+		{
+			name:    "same directive in different contexts",
+			filters: []Filter{createFilter(otherTimeouts), createFilter(serverSize)},
+		},
+		{
+			name:    "same directives in the same context",
+			filters: []Filter{createFilter(timeouts), createFilter(otherTimeouts), createFilter(serverSize)},
+			expConflicts: []string{
+				`spec.rules[0].filters[1].extensionRef: directive "proxy_read_timeout" in context ` +
+					`http.server.location is already set by SnippetsFilter "timeouts"`,
+				`spec.rules[0].filters[2].extensionRef: directive "client_max_body_size" in context ` +
+					`http.server is already set by SnippetsFilter "timeouts"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(findSnippetsFilterConflicts(test.filters, path)).To(Equal(test.expConflicts))
+		})
+	}
+}
+
+func TestFindLoadBalancingDirective(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(snippets map[ngfAPI.NginxContext]string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterExtensionRef,
+			ResolvedExtensionRef: &ExtensionRefFilter{
+				SnippetsFilter: &SnippetsFilter{
+					Source: &ngfAPI.SnippetsFilter{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "sf"},
+					},
+					Valid:    true,
+					Snippets: snippets,
+				},
+				Valid: true,
+			},
+		}
+	}
+
+	keepalive := createFilter(map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPUpstream:       "keepalive 16;",
+		ngfAPI.NginxContextHTTPServerLocation: "random;",
+	})
+	leastConn := createFilter(map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPUpstream: "# hash $remote_addr;\nkeepalive 16;\nleast_conn;",
+	})
+	ipHash := createFilter(map[ngfAPI.NginxContext]string{
+		ngfAPI.NginxContextHTTPUpstream: "ip_hash;",
+	})
+
+	tests := []struct {
+		name         string
+		expDirective string
+		filters      []Filter
+		expIdx       int
+	}{
+		{
+			name:   "no snippets filters",
+			expIdx: -1,
+			filters: []Filter{
+				{RouteType: RouteTypeHTTP, FilterType: FilterRequestHeaderModifier},
+			},
+		},
+		{
+			name:    "snippets don't set the load balancing method",
+			expIdx:  -1,
+			filters: []Filter{keepalive},
+		},
+		{
+			name:         "first upstream snippet that sets the load balancing method",
+			expIdx:       1,
+			expDirective: "least_conn",
+			filters:      []Filter{keepalive, leastConn, ipHash},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			idx, directive := findLoadBalancingDirective(test.filters)
+			g.Expect(idx).To(Equal(test.expIdx))
+			g.Expect(directive).To(Equal(test.expDirective))
+		})
+	}
+}
+
+func TestFindFilterDirectiveConflicts(t *testing.T) {
+	t.Parallel()
+
+	createSnippetsFilterRef := func(name, locationSnippet string) Filter {
+		return Filter{
+			RouteType:  RouteTypeHTTP,
+			FilterType: FilterExtensionRef,
+			ResolvedExtensionRef: &ExtensionRefFilter{
+				SnippetsFilter: &SnippetsFilter{
+					Source: &ngfAPI.SnippetsFilter{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+					},
+					Valid: true,
+					Snippets: map[ngfAPI.NginxContext]string{
+						ngfAPI.NginxContextHTTPServerLocation: locationSnippet,
+					},
+				},
+				Valid: true,
+			},
+		}
+	}
+
+	requestHeaders := Filter{
+		RouteType:  RouteTypeHTTP,
+		FilterType: FilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1.HTTPHeaderFilter{
+			Set:    []v1.HTTPHeader{{Name: "X-Set", Value: "set"}},
+			Remove: []string{"X-Remove"},
+		},
+	}
+	responseHeaders := Filter{
+		RouteType:  RouteTypeHTTP,
+		FilterType: FilterResponseHeaderModifier,
+		ResponseHeaderModifier: &v1.HTTPHeaderFilter{
+			Add: []v1.HTTPHeader{{Name: "X-Add", Value: "add"}},
+		},
+	}
+	redirect := Filter{
+		RouteType:       RouteTypeHTTP,
+		FilterType:      FilterRequestRedirect,
+		RequestRedirect: &v1.HTTPRequestRedirectFilter{},
+	}
+	rewrite := Filter{
+		RouteType:  RouteTypeHTTP,
+		FilterType: FilterURLRewrite,
+		URLRewrite: &v1.HTTPURLRewriteFilter{Hostname: helpers.GetPointer[v1.PreciseHostname]("example.com")},
+	}
+	mirror := Filter{
+		RouteType:     RouteTypeHTTP,
+		FilterType:    FilterRequestMirror,
+		RequestMirror: &v1.HTTPRequestMirrorFilter{},
+	}
+
+	bodyTransform := Filter{
+		RouteType:  RouteTypeHTTP,
+		FilterType: FilterExtensionRef,
+		ResolvedExtensionRef: &ExtensionRefFilter{
+			HTTPBodyTransform: &HTTPBodyTransform{
+				Source: &ngfAPI.HTTPBodyTransform{
+					Spec: ngfAPI.HTTPBodyTransformSpec{
+						Request:  &ngfAPI.RequestBodyTransform{Script: "return body;"},
+						Response: &ngfAPI.ResponseBodyTransform{Script: "return body;"},
+					},
+				},
+				Valid: true,
+			},
+			Valid: true,
+		},
+	}
+
+	// referenced twice, but its conflicts are only reported once.
+	collidingHeaders := createSnippetsFilterRef("headers", "proxy_set_header x-set 1;\nproxy_set_header X-Remove '';")
+
+	path := field.NewPath("spec").Child("rules").Index(0).Child("filters")
+
+	tests := []struct {
+		name         string
+		filters      []Filter
+		expConflicts []string
+	}{
+		{
+			name:    "no snippets filters",
+			filters: []Filter{requestHeaders, redirect},
+		},
+		{
+			name: "no core filters",
+			filters: []Filter{
+				createSnippetsFilterRef("return", "return 200;"),
+			},
+		},
+		{
+			name: "snippets and core filters don't collide",
+			filters: []Filter{
+				createSnippetsFilterRef("headers", "proxy_set_header X-Other 1; add_header X-Set 1; mirror /m;"),
+				requestHeaders,
+				responseHeaders,
+				rewrite,
+				mirror,
+			},
+		},
+		{
+			name: "snippets collide with core filters",
+			filters: []Filter{
+				requestHeaders,
+				collidingHeaders,
+				createSnippetsFilterRef("return", "if ($x) { return 404; } return 200;"),
+				collidingHeaders,
+				responseHeaders,
+				redirect,
+				rewrite,
+				mirror,
+				createSnippetsFilterRef("others", "add_header X-Add 1; proxy_set_header Host a; mirror_request_body off;"),
+			},
+			expConflicts: []string{
+				`spec.rules[0].filters[1].extensionRef: directive "proxy_set_header x-set" in context ` +
+					`http.server.location is already set by the RequestHeaderModifier filter spec.rules[0].filters[0]`,
+				`spec.rules[0].filters[1].extensionRef: directive "proxy_set_header X-Remove" in context ` +
+					`http.server.location is already set by the RequestHeaderModifier filter spec.rules[0].filters[0]`,
+				`spec.rules[0].filters[2].extensionRef: directive "return" in context ` +
+					`http.server.location is already set by the RequestRedirect filter spec.rules[0].filters[5]`,
+				`spec.rules[0].filters[8].extensionRef: directive "add_header X-Add" in context ` +
+					`http.server.location is already set by the ResponseHeaderModifier filter spec.rules[0].filters[4]`,
+				`spec.rules[0].filters[8].extensionRef: directive "proxy_set_header Host" in context ` +
+					`http.server.location is already set by the URLRewrite filter spec.rules[0].filters[6]`,
+				`spec.rules[0].filters[8].extensionRef: directive "mirror_request_body" in context ` +
+					`http.server.location is already set by the RequestMirror filter spec.rules[0].filters[7]`,
+			},
+		},
+		{
+			name: "snippets collide with body transform",
+			filters: []Filter{
+				bodyTransform,
+				createSnippetsFilterRef(
+					"body",
+					"proxy_set_body $x; client_body_buffer_size 1k; js_body_filter m.f; proxy_set_header X-Body 1;",
+				),
+			},
+			expConflicts: []string{
+				`spec.rules[0].filters[1].extensionRef: directive "proxy_set_body" in context ` +
+					`http.server.location is already set by the ExtensionRef filter spec.rules[0].filters[0]`,
+				`spec.rules[0].filters[1].extensionRef: directive "client_body_buffer_size" in context ` +
+					`http.server.location is already set by the ExtensionRef filter spec.rules[0].filters[0]`,
+				`spec.rules[0].filters[1].extensionRef: directive "js_body_filter" in context ` +
+					`http.server.location is already set by the ExtensionRef filter spec.rules[0].filters[0]`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(findFilterDirectiveConflicts(test.filters, path)).To(Equal(test.expConflicts))
+		})
+	}
+}
+
+func TestGetTopLevelStatements(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	snippet := "proxy_set_header X-A \"a; {b}\"; # add_header X-B b;\n" +
+		"location /a { return 200; }\nadd_header X-C '' always"
+
+	g.Expect(getTopLevelStatements(snippet)).To(Equal([]snippetStatement{
+		{name: "proxy_set_header", args: []string{"X-A", "a; {b}"}},
+		{name: "location", args: []string{"/a"}},
+		{name: "add_header", args: []string{"X-C", "", "always"}},
+	}))
+}
+
+func TestGetTopLevelDirectives(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		snippet       string
+		expDirectives []string
+	}{
+		{
+			name:    "empty snippet",
+			snippet: "",
+		},
+		{
+			name:          "simple directives",
+			snippet:       "proxy_read_timeout 10s;\n  keepalive_timeout 5s;",
+			expDirectives: []string{"proxy_read_timeout", "keepalive_timeout"},
+		},
+		{
+			name:          "duplicate directives",
+			snippet:       "add_header X-A 1; add_header X-B 2;",
+			expDirectives: []string{"add_header"},
+		},
+		{
+			name:          "directives in blocks are ignored",
+			snippet:       "location /a { return 200; } if ($x) { set $y 1; } gzip on;",
+			expDirectives: []string{"location", "if", "gzip"},
+		},
+		{
+			name:          "comments and quoted strings are ignored",
+			snippet:       "# gzip on;\nreturn 200 \"a; b { \\\" c\";\nadd_header X-A 'x; y';",
+			expDirectives: []string{"return", "add_header"},
+		},
+		{
+			name:          "last directive without semicolon",
+			snippet:       "gzip on",
+			expDirectives: []string{"gzip"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getTopLevelDirectives(test.snippet)).To(Equal(test.expDirectives))
+		})
+	}
+}
+
+type fakeArtifactFetcher struct {
+	contents map[string][]byte
+	errs     map[string]error
+	refs     []artifacts.Reference
+}
+
+func (f *fakeArtifactFetcher) Get(ref artifacts.Reference) ([]byte, error) {
+	f.refs = append(f.refs, ref)
+
+	if err, ok := f.errs[ref.String()]; ok {
+		return nil, err
+	}
+
+	if content, ok := f.contents[ref.String()]; ok {
+		return content, nil
+	}
+
+	return nil, artifacts.ErrPending
+}
+
+func TestProcessSnippetsFilters_Artifacts(t *testing.T) {
+	t.Parallel()
+
+	const (
+		repository = "registry.example.com/team/snippets"
+		digest1    = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		digest2    = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	)
+
+	pullSecretNsName := types.NamespacedName{Namespace: "test", Name: "pull-secret"}
+	pullSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: pullSecretNsName.Namespace, Name: pullSecretNsName.Name},
+		Type:       apiv1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			apiv1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"username":"user","password":"pass"}}}`),
+		},
+	}
+	opaqueSecretNsName := types.NamespacedName{Namespace: "test", Name: "opaque-secret"}
+	opaqueSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: opaqueSecretNsName.Namespace, Name: opaqueSecretNsName.Name},
+		Type:       apiv1.SecretTypeOpaque,
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: keyDER})
+
+	keyVerification, err := artifacts.NewKeyVerification(publicKeyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keySecretNsName := types.NamespacedName{Namespace: "test", Name: "cosign-key"}
+	keySecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: keySecretNsName.Namespace, Name: keySecretNsName.Name},
+		Data:       map[string][]byte{CosignPublicKeyKey: publicKeyPEM},
+	}
+	trustRootSecretNsName := types.NamespacedName{Namespace: "test", Name: "trust-root"}
+	trustRootSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: trustRootSecretNsName.Namespace, Name: trustRootSecretNsName.Name},
+		Data:       map[string][]byte{CAKey: []byte("ca")},
+	}
+
+	secrets := map[types.NamespacedName]*apiv1.Secret{
+		pullSecretNsName:      pullSecret,
+		opaqueSecretNsName:    opaqueSecret,
+		keySecretNsName:       keySecret,
+		trustRootSecretNsName: trustRootSecret,
+	}
+
+	createFilter := func(artifact ngfAPI.OCIArtifact) *ngfAPI.SnippetsFilter {
+		return &ngfAPI.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "filter"},
+			Spec: ngfAPI.SnippetsFilterSpec{
+				Snippets: []ngfAPI.Snippet{
+					{
+						Context: ngfAPI.NginxContextMain,
+						Value:   "main snippet",
+					},
+					{
+						Context:  ngfAPI.NginxContextHTTP,
+						Artifact: &artifact,
+					},
+				},
+			},
+		}
+	}
+
+	filterNsName := types.NamespacedName{Namespace: "test", Name: "filter"}
+
+	tests := []struct {
+		fetcher            ArtifactFetcher
+		filter             *ngfAPI.SnippetsFilter
+		expArtifactSecrets map[types.NamespacedName]*apiv1.Secret
+		expFilter          *SnippetsFilter
+		msg                string
+		expRefs            []artifacts.Reference
+	}{
+		{
+			msg: "fetched artifact with pull secret",
+			fetcher: &fakeArtifactFetcher{
+				contents: map[string][]byte{repository + "@" + digest1: []byte("http snippet")},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				PullSecret: helpers.GetPointer(pullSecretNsName.Name),
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{pullSecretNsName: pullSecret},
+			expFilter: &SnippetsFilter{
+				Valid: true,
+				Snippets: map[ngfAPI.NginxContext]string{
+					ngfAPI.NginxContextMain: "main snippet",
+					ngfAPI.NginxContextHTTP: "http snippet",
+				},
+			},
+			expRefs: []artifacts.Reference{
+				{
+					Credentials: &artifacts.Credentials{Username: "user", Password: "pass"},
+					Repository:  repository,
+					Digest:      digest1,
+				},
+			},
+		},
+		{
+			msg:     "pending artifact",
+			fetcher: &fakeArtifactFetcher{},
+			filter:  createFilter(ngfAPI.OCIArtifact{Repository: repository, Digest: digest1}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterArtifactPending(
+						"OCI artifacts are being fetched: spec.snippets[1].artifact",
+					),
+				},
+			},
+			expRefs: []artifacts.Reference{{Repository: repository, Digest: digest1}},
+		},
+		{
+			msg: "failed artifact",
+			fetcher: &fakeArtifactFetcher{
+				errs: map[string]error{repository + "@" + digest2: errors.New("not found")},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{Repository: repository, Digest: digest2}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact: Invalid value: \"" + repository + "@" + digest2 + "\": " +
+							"failed to fetch artifact: not found",
+					),
+				},
+			},
+			expRefs: []artifacts.Reference{{Repository: repository, Digest: digest2}},
+		},
+		{
+			msg: "artifact with invalid content",
+			fetcher: &fakeArtifactFetcher{
+				contents: map[string][]byte{repository + "@" + digest1: {0xff, 0xfe}},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{Repository: repository, Digest: digest1}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact: Invalid value: \"" + repository + "@" + digest1 + "\": " +
+							"content must be non-empty UTF-8 text",
+					),
+				},
+			},
+			expRefs: []artifacts.Reference{{Repository: repository, Digest: digest1}},
+		},
+		{
+			msg:     "pull secret not found",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				PullSecret: helpers.GetPointer("missing"),
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{{Namespace: "test", Name: "missing"}: nil},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.pullSecret: Not found: \"missing\"",
+					),
+				},
+			},
+		},
+		{
+			msg:     "pull secret of wrong type",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				PullSecret: helpers.GetPointer(opaqueSecretNsName.Name),
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{opaqueSecretNsName: opaqueSecret},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.pullSecret: Invalid value: \"opaque-secret\": " +
+							"Secret must be of type kubernetes.io/dockerconfigjson",
+					),
+				},
+			},
+		},
+		{
+			msg:     "pull secret without credentials for registry",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				PullSecret: helpers.GetPointer(pullSecretNsName.Name),
+				Repository: "other.example.com/snippets",
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{pullSecretNsName: pullSecret},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.pullSecret: Invalid value: \"pull-secret\": " +
+							"Secret has no credentials for the registry of the repository",
+					),
+				},
+			},
+		},
+		{
+			msg: "verified artifact",
+			fetcher: &fakeArtifactFetcher{
+				contents: map[string][]byte{repository + "@" + digest1: []byte("http snippet")},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Key: &ngfAPI.CosignKey{SecretName: keySecretNsName.Name},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{keySecretNsName: keySecret},
+			expFilter: &SnippetsFilter{
+				Valid: true,
+				Snippets: map[ngfAPI.NginxContext]string{
+					ngfAPI.NginxContextMain: "main snippet",
+					ngfAPI.NginxContextHTTP: "http snippet",
+				},
+			},
+			expRefs: []artifacts.Reference{
+				{
+					Verification: keyVerification,
+					Repository:   repository,
+					Digest:       digest1,
+				},
+			},
+		},
+		{
+			msg: "artifact failed verification",
+			fetcher: &fakeArtifactFetcher{
+				errs: map[string]error{
+					repository + "@" + digest1: &artifacts.VerificationError{Err: errors.New("invalid ECDSA signature")},
+				},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Key: &ngfAPI.CosignKey{SecretName: keySecretNsName.Name},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{keySecretNsName: keySecret},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterArtifactVerificationFailed(
+						"spec.snippets[1].artifact: Invalid value: \"" + repository + "@" + digest1 + "\": " +
+							"signature verification failed: invalid ECDSA signature",
+					),
+				},
+			},
+			expRefs: []artifacts.Reference{
+				{
+					Verification: keyVerification,
+					Repository:   repository,
+					Digest:       digest1,
+				},
+			},
+		},
+		{
+			msg: "verification required",
+			fetcher: &fakeArtifactFetcher{
+				errs: map[string]error{repository + "@" + digest1: artifacts.ErrVerificationRequired},
+			},
+			filter: createFilter(ngfAPI.OCIArtifact{Repository: repository, Digest: digest1}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterArtifactVerificationFailed(
+						"spec.snippets[1].artifact.verification: Required value: " +
+							"signature verification of OCI artifacts is required",
+					),
+				},
+			},
+			expRefs: []artifacts.Reference{{Repository: repository, Digest: digest1}},
+		},
+		{
+			msg:     "verification key secret not found",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Key: &ngfAPI.CosignKey{SecretName: "missing"},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{{Namespace: "test", Name: "missing"}: nil},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.verification.key.secretName: Not found: \"missing\"",
+					),
+				},
+			},
+		},
+		{
+			msg:     "verification key secret without key",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Key: &ngfAPI.CosignKey{SecretName: opaqueSecretNsName.Name},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{opaqueSecretNsName: opaqueSecret},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.verification.key.secretName: Invalid value: \"opaque-secret\": " +
+							"Secret must have the data field \"cosign.pub\"",
+					),
+				},
+			},
+		},
+		{
+			msg:     "keyless verification with invalid subject regex",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Keyless: &ngfAPI.CosignKeyless{
+						SubjectRegex:        helpers.GetPointer("[a-z"),
+						TrustRootSecretName: trustRootSecretNsName.Name,
+						Issuer:              "https://issuer.example.com",
+					},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.verification.keyless.subjectRegex: Invalid value: \"[a-z\": " +
+							"error parsing regexp: missing closing ]: `[a-z`",
+					),
+				},
+			},
+		},
+		{
+			msg:     "keyless verification trust root secret without Rekor key",
+			fetcher: &fakeArtifactFetcher{},
+			filter: createFilter(ngfAPI.OCIArtifact{
+				Verification: &ngfAPI.ArtifactVerification{
+					Keyless: &ngfAPI.CosignKeyless{
+						Subject:             helpers.GetPointer("dev@example.com"),
+						TrustRootSecretName: trustRootSecretNsName.Name,
+						Issuer:              "https://issuer.example.com",
+					},
+				},
+				Repository: repository,
+				Digest:     digest1,
+			}),
+			expArtifactSecrets: map[types.NamespacedName]*apiv1.Secret{trustRootSecretNsName: trustRootSecret},
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact.verification.keyless.trustRootSecretName: " +
+							"Invalid value: \"trust-root\": Secret must have the data field \"rekor.pub\"",
+					),
+				},
+			},
+		},
+		{
+			msg:    "artifacts not supported",
+			filter: createFilter(ngfAPI.OCIArtifact{Repository: repository, Digest: digest1}),
+			expFilter: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewSnippetsFilterInvalid(
+						"spec.snippets[1].artifact: Forbidden: OCI artifacts are not supported",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			processed, artifactSecrets := processSnippetsFilters(
+				map[types.NamespacedName]*ngfAPI.SnippetsFilter{filterNsName: test.filter},
+				secrets,
+				test.fetcher,
+			)
+
+			test.expFilter.Source = test.filter
+			g.Expect(processed).To(Equal(map[types.NamespacedName]*SnippetsFilter{filterNsName: test.expFilter}))
+			g.Expect(artifactSecrets).To(Equal(test.expArtifactSecrets))
+
+			if fetcher, ok := test.fetcher.(*fakeArtifactFetcher); ok {
+				g.Expect(fetcher.refs).To(Equal(test.expRefs))
+			}
+		})
+	}
+}
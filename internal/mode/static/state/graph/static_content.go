@@ -0,0 +1,53 @@
+package graph
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// resolveStaticContentConfigMaps resolves the ConfigMaps referenced by the valid StaticContentPolicies.
+// The policies that reference a ConfigMap that doesn't exist are invalidated.
+// It returns the referenced ConfigMaps, including nil entries for the ConfigMaps that do not exist in the cluster,
+// so that we can query the Graph to determine if a ConfigMap is referenced, including the case when the ConfigMap
+// is newly created.
+func resolveStaticContentConfigMaps(
+	pols map[PolicyKey]*Policy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) map[types.NamespacedName]*apiv1.ConfigMap {
+	var referenced map[types.NamespacedName]*apiv1.ConfigMap
+
+	for key, policy := range pols {
+		if key.GVK.Kind != kinds.StaticContentPolicy || !policy.Valid {
+			continue
+		}
+
+		scp, ok := policy.Source.(*ngfAPI.StaticContentPolicy)
+		if !ok || scp.Spec.Source.ConfigMap == nil {
+			continue
+		}
+
+		nsname := types.NamespacedName{Namespace: scp.Namespace, Name: scp.Spec.Source.ConfigMap.Name}
+		cm := configMaps[nsname]
+
+		if referenced == nil {
+			referenced = make(map[types.NamespacedName]*apiv1.ConfigMap)
+		}
+		referenced[nsname] = cm
+
+		if cm == nil {
+			path := field.NewPath("spec", "source", "configMap", "name")
+			policy.Valid = false
+			policy.Conditions = append(
+				policy.Conditions,
+				staticConds.NewPolicyInvalid(field.NotFound(path, nsname.Name).Error()),
+			)
+		}
+	}
+
+	return referenced
+}
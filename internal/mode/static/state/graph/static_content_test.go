@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestResolveStaticContentConfigMaps(t *testing.T) {
+	t.Parallel()
+
+	scpGVK := schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: kinds.StaticContentPolicy}
+
+	createPolicy := func(name string, source ngfAPI.StaticContentSource, valid bool) (PolicyKey, *Policy) {
+		key := PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    scpGVK,
+		}
+
+		policy := &Policy{
+			Source: &ngfAPI.StaticContentPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				Spec:       ngfAPI.StaticContentPolicySpec{Source: source},
+			},
+			Valid: valid,
+		}
+
+		return key, policy
+	}
+
+	configMapSource := func(name string) ngfAPI.StaticContentSource {
+		return ngfAPI.StaticContentSource{
+			Type:      ngfAPI.StaticContentSourceConfigMap,
+			ConfigMap: &ngfAPI.StaticContentConfigMap{Name: name},
+		}
+	}
+
+	existingCM := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "existing"},
+		Data:       map[string]string{"index.html": "<html></html>"},
+	}
+	existingNsName := types.NamespacedName{Namespace: "test", Name: "existing"}
+	missingNsName := types.NamespacedName{Namespace: "test", Name: "missing"}
+	invalidNsName := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	existingKey, existingPolicy := createPolicy("existing", configMapSource("existing"), true)
+	missingKey, missingPolicy := createPolicy("missing", configMapSource("missing"), true)
+	invalidKey, invalidPolicy := createPolicy("invalid", configMapSource("invalid"), false)
+	volumeKey, volumePolicy := createPolicy(
+		"volume",
+		ngfAPI.StaticContentSource{
+			Type:   ngfAPI.StaticContentSourceVolume,
+			Volume: &ngfAPI.StaticContentVolume{Path: "/usr/share/nginx/static/content"},
+		},
+		true,
+	)
+	otherKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: "test", Name: "other"},
+		GVK:    schema.GroupVersionKind{Group: ngfAPI.GroupName, Version: "v1alpha1", Kind: kinds.CacheControlPolicy},
+	}
+
+	pols := map[PolicyKey]*Policy{
+		existingKey: existingPolicy,
+		missingKey:  missingPolicy,
+		invalidKey:  invalidPolicy,
+		volumeKey:   volumePolicy,
+		otherKey:    {Source: &ngfAPI.CacheControlPolicy{}, Valid: true},
+	}
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		existingNsName: existingCM,
+		invalidNsName:  {},
+	}
+
+	g := NewWithT(t)
+
+	referenced := resolveStaticContentConfigMaps(pols, configMaps)
+	g.Expect(referenced).To(Equal(map[types.NamespacedName]*apiv1.ConfigMap{
+		existingNsName: existingCM,
+		missingNsName:  nil,
+	}))
+
+	g.Expect(existingPolicy.Valid).To(BeTrue())
+	g.Expect(existingPolicy.Conditions).To(BeEmpty())
+
+	g.Expect(missingPolicy.Valid).To(BeFalse())
+	g.Expect(missingPolicy.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyInvalid("spec.source.configMap.name: Not found: \"missing\""),
+	}))
+
+	g.Expect(invalidPolicy.Conditions).To(BeEmpty())
+	g.Expect(volumePolicy.Valid).To(BeTrue())
+
+	g.Expect(resolveStaticContentConfigMaps(nil, configMaps)).To(BeNil())
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createTCPRoute(
+	rules []v1alpha2.TCPRouteRule,
+	parentRefs []gatewayv1.ParentReference,
+) *v1alpha2.TCPRoute {
+	return &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "tcpr",
+		},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func TestBuildTCPRoute(t *testing.T) {
+	t.Parallel()
+
+	parentRef := gatewayv1.ParentReference{
+		Namespace:   helpers.GetPointer[gatewayv1.Namespace]("test"),
+		Name:        "gateway",
+		SectionName: helpers.GetPointer[gatewayv1.SectionName]("l1"),
+	}
+	gatewayNsName := types.NamespacedName{
+		Namespace: "test",
+		Name:      "gateway",
+	}
+	parentRefGraph := ParentRef{
+		SectionName: helpers.GetPointer[gatewayv1.SectionName]("l1"),
+		Gateway:     gatewayNsName,
+	}
+
+	createRules := func(refs ...gatewayv1.BackendRef) []v1alpha2.TCPRouteRule {
+		return []v1alpha2.TCPRouteRule{
+			{
+				BackendRefs: refs,
+			},
+		}
+	}
+
+	backendRef := gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: "hi",
+			Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+		},
+	}
+
+	diffNsBackendRef := gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name:      "hi",
+			Port:      helpers.GetPointer[gatewayv1.PortNumber](80),
+			Namespace: helpers.GetPointer[gatewayv1.Namespace]("diff"),
+		},
+	}
+
+	duplicateParentRefsTcpr := createTCPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{parentRef, parentRef},
+	)
+	noParentRefsTcpr := createTCPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{},
+	)
+	noRulesTcpr := createTCPRoute(
+		nil,
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	multipleBackendRefsTcpr := createTCPRoute(
+		createRules(backendRef, backendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	validTcpr := createTCPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	diffNsTcpr := createTCPRoute(
+		createRules(diffNsBackendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+
+	svcNsName := types.NamespacedName{
+		Namespace: "test",
+		Name:      "hi",
+	}
+	diffSvcNsName := types.NamespacedName{
+		Namespace: "diff",
+		Name:      "hi",
+	}
+
+	createSvc := func(nsname types.NamespacedName) *apiv1.Service {
+		return &apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: nsname.Namespace,
+				Name:      nsname.Name,
+			},
+			Spec: apiv1.ServiceSpec{
+				Ports: []apiv1.ServicePort{
+					{Port: 80},
+				},
+			},
+		}
+	}
+
+	alwaysTrueRefGrantResolver := func(_ toResource) bool { return true }
+	alwaysFalseRefGrantResolver := func(_ toResource) bool { return false }
+
+	tests := []struct {
+		expected       *L4Route
+		tcpr           *v1alpha2.TCPRoute
+		services       map[types.NamespacedName]*apiv1.Service
+		resolver       func(resource toResource) bool
+		name           string
+		gatewayNsNames []types.NamespacedName
+	}{
+		{
+			tcpr: duplicateParentRefsTcpr,
+			expected: &L4Route{
+				Source: duplicateParentRefsTcpr,
+				Valid:  false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "duplicate parent refs",
+		},
+		{
+			tcpr:           noParentRefsTcpr,
+			expected:       nil,
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "no parent refs",
+		},
+		{
+			tcpr: noRulesTcpr,
+			expected: &L4Route{
+				Source:     noRulesTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"Must have exactly one Rule and BackendRef",
+				)},
+				Valid: false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "no rules",
+		},
+		{
+			tcpr: multipleBackendRefsTcpr,
+			expected: &L4Route{
+				Source:     multipleBackendRefsTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"Must have exactly one Rule and BackendRef",
+				)},
+				Valid: false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "multiple backendRefs",
+		},
+		{
+			tcpr: validTcpr,
+			expected: &L4Route{
+				Source:     validTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName: svcNsName,
+						Valid:     false,
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefBackendNotFound(
+					"spec.rules[0].backendRefs[0].name: Not found: \"hi\"",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "BackendRef not found",
+		},
+		{
+			tcpr: diffNsTcpr,
+			expected: &L4Route{
+				Source:     diffNsTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						Valid: false,
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefNotPermitted(
+					"Backend ref to Service diff/hi not permitted by any ReferenceGrant",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				diffSvcNsName: createSvc(diffSvcNsName),
+			},
+			resolver: alwaysFalseRefGrantResolver,
+			name:     "BackendRef in diff namespace not permitted by a reference grant",
+		},
+		{
+			tcpr: diffNsTcpr,
+			expected: &L4Route{
+				Source:     diffNsTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName:   diffSvcNsName,
+						ServicePort: apiv1.ServicePort{Port: 80},
+						Valid:       true,
+					},
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				diffSvcNsName: createSvc(diffSvcNsName),
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; backendRef in diff namespace permitted by a reference grant",
+		},
+		{
+			tcpr: validTcpr,
+			expected: &L4Route{
+				Source:     validTcpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName:   svcNsName,
+						ServicePort: apiv1.ServicePort{Port: 80},
+						Valid:       true,
+					},
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName: createSvc(svcNsName),
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; same namespace",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			r := buildTCPRoute(
+				test.tcpr,
+				test.gatewayNsNames,
+				test.services,
+				&NginxProxy{},
+				test.resolver,
+			)
+			g.Expect(helpers.Diff(test.expected, r)).To(BeEmpty())
+		})
+	}
+}
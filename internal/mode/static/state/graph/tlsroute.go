@@ -6,8 +6,6 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 
-	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
-	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
@@ -54,7 +52,14 @@ func buildTLSRoute(
 		return r
 	}
 
-	br, cond := validateBackendRefTLSRoute(gtr, services, npCfg, refGrantResolver)
+	br, cond := validateBackendRefL4Route(
+		gtr.Spec.Rules[0].BackendRefs[0],
+		gtr.Namespace,
+		services,
+		npCfg,
+		refGrantResolver,
+		apiv1.ProtocolTCP,
+	)
 
 	r.Spec.BackendRef = br
 	r.Valid = true
@@ -66,64 +71,3 @@ func buildTLSRoute(
 
 	return r
 }
-
-func validateBackendRefTLSRoute(gtr *v1alpha2.TLSRoute,
-	services map[types.NamespacedName]*apiv1.Service,
-	npCfg *NginxProxy,
-	refGrantResolver func(resource toResource) bool,
-) (BackendRef, *conditions.Condition) {
-	// Length of BackendRefs and Rules is guaranteed to be one due to earlier check in buildTLSRoute
-	refPath := field.NewPath("spec").Child("rules").Index(0).Child("backendRefs").Index(0)
-
-	ref := gtr.Spec.Rules[0].BackendRefs[0]
-
-	if valid, cond := validateBackendRef(
-		ref,
-		gtr.Namespace,
-		refGrantResolver,
-		refPath,
-	); !valid {
-		backendRef := BackendRef{
-			Valid: false,
-		}
-
-		return backendRef, &cond
-	}
-
-	ns := gtr.Namespace
-	if ref.Namespace != nil {
-		ns = string(*ref.Namespace)
-	}
-
-	svcNsName := types.NamespacedName{
-		Namespace: ns,
-		Name:      string(gtr.Spec.Rules[0].BackendRefs[0].Name),
-	}
-
-	svcIPFamily, svcPort, err := getIPFamilyAndPortFromRef(
-		ref,
-		svcNsName,
-		services,
-		refPath,
-	)
-
-	backendRef := BackendRef{
-		SvcNsName:   svcNsName,
-		ServicePort: svcPort,
-		Valid:       true,
-	}
-
-	if err != nil {
-		backendRef.Valid = false
-
-		return backendRef, helpers.GetPointer(staticConds.NewRouteBackendRefRefBackendNotFound(err.Error()))
-	}
-
-	if err := verifyIPFamily(npCfg, svcIPFamily); err != nil {
-		backendRef.Valid = false
-
-		return backendRef, helpers.GetPointer(staticConds.NewRouteInvalidIPFamily(err.Error()))
-	}
-
-	return backendRef, nil
-}
@@ -0,0 +1,30 @@
+package graph
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+func buildUDPRoute(
+	udpRoute *v1alpha2.UDPRoute,
+	gatewayNsNames []types.NamespacedName,
+	services map[types.NamespacedName]*apiv1.Service,
+	npCfg *NginxProxy,
+	refGrantResolver func(resource toResource) bool,
+) *L4Route {
+	ruleBackendRefs := make([][]v1.BackendRef, 0, len(udpRoute.Spec.Rules))
+	for _, rule := range udpRoute.Spec.Rules {
+		ruleBackendRefs = append(ruleBackendRefs, rule.BackendRefs)
+	}
+
+	route := portL4Route{
+		source:          udpRoute,
+		protocol:        apiv1.ProtocolUDP,
+		parentRefs:      udpRoute.Spec.ParentRefs,
+		ruleBackendRefs: ruleBackendRefs,
+	}
+
+	return buildPortL4Route(route, gatewayNsNames, services, npCfg, refGrantResolver)
+}
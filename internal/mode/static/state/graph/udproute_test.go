@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createUDPRoute(
+	rules []v1alpha2.UDPRouteRule,
+	parentRefs []gatewayv1.ParentReference,
+) *v1alpha2.UDPRoute {
+	return &v1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "udpr",
+		},
+		Spec: v1alpha2.UDPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: parentRefs,
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func TestBuildUDPRoute(t *testing.T) {
+	t.Parallel()
+
+	parentRef := gatewayv1.ParentReference{
+		Namespace:   helpers.GetPointer[gatewayv1.Namespace]("test"),
+		Name:        "gateway",
+		SectionName: helpers.GetPointer[gatewayv1.SectionName]("l1"),
+	}
+	gatewayNsName := types.NamespacedName{
+		Namespace: "test",
+		Name:      "gateway",
+	}
+	parentRefGraph := ParentRef{
+		SectionName: helpers.GetPointer[gatewayv1.SectionName]("l1"),
+		Gateway:     gatewayNsName,
+	}
+
+	createRules := func(refs ...gatewayv1.BackendRef) []v1alpha2.UDPRouteRule {
+		return []v1alpha2.UDPRouteRule{
+			{
+				BackendRefs: refs,
+			},
+		}
+	}
+
+	backendRef := gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: "hi",
+			Port: helpers.GetPointer[gatewayv1.PortNumber](53),
+		},
+	}
+
+	diffNsBackendRef := gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name:      "hi",
+			Port:      helpers.GetPointer[gatewayv1.PortNumber](53),
+			Namespace: helpers.GetPointer[gatewayv1.Namespace]("diff"),
+		},
+	}
+
+	duplicateParentRefsUdpr := createUDPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{parentRef, parentRef},
+	)
+	noParentRefsUdpr := createUDPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{},
+	)
+	noRulesUdpr := createUDPRoute(
+		nil,
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	multipleBackendRefsUdpr := createUDPRoute(
+		createRules(backendRef, backendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	validUdpr := createUDPRoute(
+		createRules(backendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+	diffNsUdpr := createUDPRoute(
+		createRules(diffNsBackendRef),
+		[]gatewayv1.ParentReference{parentRef},
+	)
+
+	svcNsName := types.NamespacedName{
+		Namespace: "test",
+		Name:      "hi",
+	}
+	diffSvcNsName := types.NamespacedName{
+		Namespace: "diff",
+		Name:      "hi",
+	}
+
+	createSvc := func(nsname types.NamespacedName) *apiv1.Service {
+		return &apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: nsname.Namespace,
+				Name:      nsname.Name,
+			},
+			Spec: apiv1.ServiceSpec{
+				Ports: []apiv1.ServicePort{
+					{Port: 53, Protocol: apiv1.ProtocolUDP},
+				},
+			},
+		}
+	}
+
+	alwaysTrueRefGrantResolver := func(_ toResource) bool { return true }
+	alwaysFalseRefGrantResolver := func(_ toResource) bool { return false }
+
+	tests := []struct {
+		expected       *L4Route
+		udpr           *v1alpha2.UDPRoute
+		services       map[types.NamespacedName]*apiv1.Service
+		resolver       func(resource toResource) bool
+		name           string
+		gatewayNsNames []types.NamespacedName
+	}{
+		{
+			udpr: duplicateParentRefsUdpr,
+			expected: &L4Route{
+				Source: duplicateParentRefsUdpr,
+				Valid:  false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "duplicate parent refs",
+		},
+		{
+			udpr:           noParentRefsUdpr,
+			expected:       nil,
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "no parent refs",
+		},
+		{
+			udpr: noRulesUdpr,
+			expected: &L4Route{
+				Source:     noRulesUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"Must have exactly one Rule and BackendRef",
+				)},
+				Valid: false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "no rules",
+		},
+		{
+			udpr: multipleBackendRefsUdpr,
+			expected: &L4Route{
+				Source:     multipleBackendRefsUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"Must have exactly one Rule and BackendRef",
+				)},
+				Valid: false,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "multiple backendRefs",
+		},
+		{
+			udpr: validUdpr,
+			expected: &L4Route{
+				Source:     validUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName: svcNsName,
+						Valid:     false,
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefBackendNotFound(
+					"spec.rules[0].backendRefs[0].name: Not found: \"hi\"",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services:       map[types.NamespacedName]*apiv1.Service{},
+			resolver:       alwaysTrueRefGrantResolver,
+			name:           "BackendRef not found",
+		},
+		{
+			udpr: diffNsUdpr,
+			expected: &L4Route{
+				Source:     diffNsUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						Valid: false,
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefNotPermitted(
+					"Backend ref to Service diff/hi not permitted by any ReferenceGrant",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				diffSvcNsName: createSvc(diffSvcNsName),
+			},
+			resolver: alwaysFalseRefGrantResolver,
+			name:     "BackendRef in diff namespace not permitted by a reference grant",
+		},
+		{
+			udpr: diffNsUdpr,
+			expected: &L4Route{
+				Source:     diffNsUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName:   diffSvcNsName,
+						ServicePort: apiv1.ServicePort{Port: 53, Protocol: apiv1.ProtocolUDP},
+						Valid:       true,
+					},
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				diffSvcNsName: createSvc(diffSvcNsName),
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; backendRef in diff namespace permitted by a reference grant",
+		},
+		{
+			udpr: validUdpr,
+			expected: &L4Route{
+				Source:     validUdpr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRef: BackendRef{
+						SvcNsName:   svcNsName,
+						ServicePort: apiv1.ServicePort{Port: 53, Protocol: apiv1.ProtocolUDP},
+						Valid:       true,
+					},
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName: createSvc(svcNsName),
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; same namespace",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			r := buildUDPRoute(
+				test.udpr,
+				test.gatewayNsNames,
+				test.services,
+				&NginxProxy{},
+				test.resolver,
+			)
+			g.Expect(helpers.Diff(test.expected, r)).To(BeEmpty())
+		})
+	}
+}
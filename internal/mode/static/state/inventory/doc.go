@@ -0,0 +1,9 @@
+/*
+Package inventory builds the inventory of the HTTP APIs that the Gateways expose: the hostnames, ports, paths and
+methods that the Routes attached to the Listeners of every Gateway match. API governance tooling can consume
+the inventory as an edge API catalog.
+
+The inventory is serialized into a versioned JSON schema. Within a version, fields are only ever added; removing,
+renaming or changing the meaning of a field requires a new version.
+*/
+package inventory
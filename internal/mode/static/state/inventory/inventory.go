@@ -0,0 +1,199 @@
+package inventory
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// Build builds the Inventory of the Gateways of the Graph.
+func Build(g *graph.Graph) Inventory {
+	inv := Inventory{
+		SchemaVersion: SchemaVersion,
+		Gateways:      make([]Gateway, 0),
+	}
+
+	if g == nil || g.Gateway == nil {
+		return inv
+	}
+
+	inv.Gateways = append(inv.Gateways, buildGateway(g.Gateway))
+	for _, gw := range g.MergedGateways {
+		inv.Gateways = append(inv.Gateways, buildGateway(gw))
+	}
+
+	slices.SortFunc(inv.Gateways, func(a, b Gateway) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return inv
+}
+
+// Marshal builds the Inventory of the Gateways of the Graph and serializes it into indented JSON.
+func Marshal(g *graph.Graph) ([]byte, error) {
+	data, err := json.MarshalIndent(Build(g), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal route inventory: %w", err)
+	}
+
+	return data, nil
+}
+
+func buildGateway(gw *graph.Gateway) Gateway {
+	result := Gateway{
+		Namespace: gw.Source.Namespace,
+		Name:      gw.Source.Name,
+		Endpoints: make([]Endpoint, 0),
+	}
+
+	// the same match can be reachable through several ParentRefs of the Route
+	seen := make(map[string]struct{})
+
+	for _, l := range gw.Listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, r := range l.Routes {
+			if !r.Valid {
+				continue
+			}
+
+			for _, ep := range buildEndpoints(r, l) {
+				key := fmt.Sprintf("%+v", ep)
+				if _, exists := seen[key]; exists {
+					continue
+				}
+
+				seen[key] = struct{}{}
+				result.Endpoints = append(result.Endpoints, ep)
+			}
+		}
+	}
+
+	slices.SortFunc(result.Endpoints, compareEndpoints)
+
+	return result
+}
+
+func buildEndpoints(r *graph.L7Route, l *graph.Listener) []Endpoint {
+	hostnames := acceptedHostnames(r, l)
+	if len(hostnames) == 0 {
+		return nil
+	}
+
+	routeRef := RouteRef{
+		Kind:      kinds.HTTPRoute,
+		Namespace: r.Source.GetNamespace(),
+		Name:      r.Source.GetName(),
+	}
+	if r.RouteType == graph.RouteTypeGRPC {
+		routeRef.Kind = kinds.GRPCRoute
+	}
+
+	var endpoints []Endpoint
+
+	for ruleIdx, rule := range r.Spec.Rules {
+		if rule.Dropped {
+			continue
+		}
+
+		matches := rule.Matches
+		if len(matches) == 0 {
+			// a rule without matches matches all requests
+			matches = []v1.HTTPRouteMatch{{}}
+		}
+
+		for _, m := range matches {
+			for _, h := range hostnames {
+				ep := newEndpoint(m)
+				ep.Route = routeRef
+				ep.Rule = ruleIdx
+				ep.Hostname = h
+				ep.Listener = l.Name
+				ep.Protocol = string(l.Source.Protocol)
+				ep.Port = int32(l.Source.Port)
+
+				endpoints = append(endpoints, ep)
+			}
+		}
+	}
+
+	return endpoints
+}
+
+// acceptedHostnames returns the hostnames of the Route accepted by the Listener.
+func acceptedHostnames(r *graph.L7Route, l *graph.Listener) []string {
+	var hostnames []string
+
+	for _, ref := range r.ParentRefs {
+		if ref.Gateway != l.GatewayName || ref.Attachment == nil || !ref.Attachment.Attached {
+			continue
+		}
+
+		hostnames = append(hostnames, ref.Attachment.AcceptedHostnames[l.Name]...)
+	}
+
+	slices.Sort(hostnames)
+
+	return slices.Compact(hostnames)
+}
+
+// newEndpoint creates an Endpoint from the match of a rule. The path defaults to the prefix "/".
+func newEndpoint(m v1.HTTPRouteMatch) Endpoint {
+	ep := Endpoint{
+		Path:     "/",
+		PathType: string(v1.PathMatchPathPrefix),
+	}
+
+	if m.Path != nil {
+		if m.Path.Type != nil {
+			ep.PathType = string(*m.Path.Type)
+		}
+		if m.Path.Value != nil {
+			ep.Path = *m.Path.Value
+		}
+	}
+
+	if m.Method != nil {
+		ep.Method = string(*m.Method)
+	}
+
+	for _, h := range m.Headers {
+		// header names are case-insensitive
+		ep.Headers = append(ep.Headers, strings.ToLower(string(h.Name)))
+	}
+
+	for _, p := range m.QueryParams {
+		ep.QueryParams = append(ep.QueryParams, string(p.Name))
+	}
+
+	slices.Sort(ep.Headers)
+	slices.Sort(ep.QueryParams)
+
+	return ep
+}
+
+func compareEndpoints(a, b Endpoint) int {
+	return cmp.Or(
+		cmp.Compare(a.Hostname, b.Hostname),
+		cmp.Compare(a.Port, b.Port),
+		cmp.Compare(a.Path, b.Path),
+		cmp.Compare(a.PathType, b.PathType),
+		cmp.Compare(a.Method, b.Method),
+		slices.Compare(a.Headers, b.Headers),
+		slices.Compare(a.QueryParams, b.QueryParams),
+		cmp.Compare(a.Route.Namespace, b.Route.Namespace),
+		cmp.Compare(a.Route.Name, b.Route.Name),
+		cmp.Compare(a.Route.Kind, b.Route.Kind),
+		cmp.Compare(a.Rule, b.Rule),
+		cmp.Compare(a.Listener, b.Listener),
+	)
+}
@@ -0,0 +1,308 @@
+package inventory
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	attachment := func(hostnames map[string][]string) *graph.ParentRefAttachmentStatus {
+		return &graph.ParentRefAttachmentStatus{
+			AcceptedHostnames: hostnames,
+			Attached:          true,
+		}
+	}
+
+	cafeRoute := &graph.L7Route{
+		RouteType: graph.RouteTypeHTTP,
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cafe"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					Matches: []v1.HTTPRouteMatch{
+						{
+							Path: &v1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1.PathMatchExact),
+								Value: helpers.GetPointer("/coffee"),
+							},
+							Method: helpers.GetPointer(v1.HTTPMethodPost),
+							Headers: []v1.HTTPHeaderMatch{
+								{Name: "X-Version", Value: "2"},
+								{Name: "Accept", Value: "application/json"},
+							},
+							QueryParams: []v1.HTTPQueryParamMatch{
+								{Name: "size", Value: "large"},
+							},
+						},
+					},
+				},
+				{
+					// dropped rules are not exposed
+					Matches: []v1.HTTPRouteMatch{
+						{Path: &v1.HTTPPathMatch{Value: helpers.GetPointer("/invalid")}},
+					},
+					Dropped: true,
+				},
+				{},
+			},
+		},
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: gwNsName,
+				Attachment: attachment(map[string][]string{
+					"http":  {"cafe.example.com"},
+					"https": {"cafe.example.com"},
+				}),
+			},
+			{
+				// the same hostnames through another ParentRef are not duplicated
+				Gateway:    gwNsName,
+				Idx:        1,
+				Attachment: attachment(map[string][]string{"http": {"cafe.example.com"}}),
+			},
+		},
+		Valid: true,
+	}
+
+	grpcRoute := &graph.L7Route{
+		RouteType: graph.RouteTypeGRPC,
+		Source: &v1.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "grpc"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					Matches: []v1.HTTPRouteMatch{
+						{
+							Path: &v1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1.PathMatchExact),
+								Value: helpers.GetPointer("/helloworld.Greeter/SayHello"),
+							},
+							Method: helpers.GetPointer(v1.HTTPMethodPost),
+						},
+					},
+				},
+			},
+		},
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {"grpc.example.com"}}),
+			},
+		},
+		Valid: true,
+	}
+
+	invalidRoute := &graph.L7Route{
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{{}},
+		},
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {"invalid.example.com"}}),
+			},
+		},
+	}
+
+	notAttachedRoute := &graph.L7Route{
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "not-attached"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{{}},
+		},
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: gwNsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"http": {"other.example.com"}},
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	gw := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+		},
+		Listeners: []*graph.Listener{
+			{
+				Name:        "http",
+				GatewayName: gwNsName,
+				Source:      v1.Listener{Protocol: v1.HTTPProtocolType, Port: 80},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(cafeRoute.Source):        cafeRoute,
+					graph.CreateRouteKey(grpcRoute.Source):        grpcRoute,
+					graph.CreateRouteKey(invalidRoute.Source):     invalidRoute,
+					graph.CreateRouteKey(notAttachedRoute.Source): notAttachedRoute,
+				},
+				Valid: true,
+			},
+			{
+				Name:        "https",
+				GatewayName: gwNsName,
+				Source:      v1.Listener{Protocol: v1.HTTPSProtocolType, Port: 443},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(cafeRoute.Source): cafeRoute,
+				},
+				Valid: true,
+			},
+			{
+				Name:        "invalid",
+				GatewayName: gwNsName,
+				Source:      v1.Listener{Protocol: v1.HTTPProtocolType, Port: 8080},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(cafeRoute.Source): cafeRoute,
+				},
+			},
+		},
+	}
+
+	mergedGw := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "another", Name: "gateway"},
+		},
+	}
+
+	cafeRef := RouteRef{Kind: kinds.HTTPRoute, Namespace: "test", Name: "cafe"}
+
+	expInventory := Inventory{
+		SchemaVersion: SchemaVersion,
+		Gateways: []Gateway{
+			{
+				Namespace: "another",
+				Name:      "gateway",
+				Endpoints: []Endpoint{},
+			},
+			{
+				Namespace: "test",
+				Name:      "gateway",
+				Endpoints: []Endpoint{
+					{
+						Route:    cafeRef,
+						Hostname: "cafe.example.com",
+						Listener: "http",
+						Protocol: "HTTP",
+						Path:     "/",
+						PathType: "PathPrefix",
+						Port:     80,
+						Rule:     2,
+					},
+					{
+						Route:       cafeRef,
+						Hostname:    "cafe.example.com",
+						Listener:    "http",
+						Protocol:    "HTTP",
+						Path:        "/coffee",
+						PathType:    "Exact",
+						Method:      "POST",
+						Headers:     []string{"accept", "x-version"},
+						QueryParams: []string{"size"},
+						Port:        80,
+					},
+					{
+						Route:    cafeRef,
+						Hostname: "cafe.example.com",
+						Listener: "https",
+						Protocol: "HTTPS",
+						Path:     "/",
+						PathType: "PathPrefix",
+						Port:     443,
+						Rule:     2,
+					},
+					{
+						Route:       cafeRef,
+						Hostname:    "cafe.example.com",
+						Listener:    "https",
+						Protocol:    "HTTPS",
+						Path:        "/coffee",
+						PathType:    "Exact",
+						Method:      "POST",
+						Headers:     []string{"accept", "x-version"},
+						QueryParams: []string{"size"},
+						Port:        443,
+					},
+					{
+						Route:    RouteRef{Kind: kinds.GRPCRoute, Namespace: "test", Name: "grpc"},
+						Hostname: "grpc.example.com",
+						Listener: "http",
+						Protocol: "HTTP",
+						Path:     "/helloworld.Greeter/SayHello",
+						PathType: "Exact",
+						Method:   "POST",
+						Port:     80,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		graph        *graph.Graph
+		name         string
+		expInventory Inventory
+	}{
+		{
+			name: "gateways",
+			graph: &graph.Graph{
+				Gateway: gw,
+				MergedGateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "another", Name: "gateway"}: mergedGw,
+				},
+			},
+			expInventory: expInventory,
+		},
+		{
+			name:  "no gateway",
+			graph: &graph.Graph{},
+			expInventory: Inventory{
+				SchemaVersion: SchemaVersion,
+				Gateways:      []Gateway{},
+			},
+		},
+		{
+			name: "nil graph",
+			expInventory: Inventory{
+				SchemaVersion: SchemaVersion,
+				Gateways:      []Gateway{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(Build(test.graph)).To(Equal(test.expInventory))
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	data, err := Marshal(&graph.Graph{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("{\n  \"schemaVersion\": \"v1\",\n  \"gateways\": []\n}"))
+}
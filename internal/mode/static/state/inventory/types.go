@@ -0,0 +1,58 @@
+package inventory
+
+// SchemaVersion is the version of the schema of the Inventory.
+const SchemaVersion = "v1"
+
+// Inventory is the inventory of the HTTP APIs that the Gateways expose.
+type Inventory struct {
+	// SchemaVersion is the version of the schema of the Inventory.
+	SchemaVersion string `json:"schemaVersion"`
+	// Gateways are the Gateways, sorted by namespace and name.
+	Gateways []Gateway `json:"gateways"`
+}
+
+// Gateway is the inventory of the HTTP APIs of a Gateway.
+type Gateway struct {
+	// Namespace is the namespace of the Gateway.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Gateway.
+	Name string `json:"name"`
+	// Endpoints are the endpoints of the Gateway, sorted by hostname, port, path and method.
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+// Endpoint is a match of a Route rule for a hostname of a Listener.
+type Endpoint struct {
+	// Route is the Route of the rule.
+	Route RouteRef `json:"route"`
+	// Hostname is the hostname that the endpoint is exposed on. A wildcard hostname starts with "*.".
+	Hostname string `json:"hostname"`
+	// Listener is the name of the Listener.
+	Listener string `json:"listener"`
+	// Protocol is the protocol of the Listener, HTTP or HTTPS.
+	Protocol string `json:"protocol"`
+	// Path is the path of the match.
+	Path string `json:"path"`
+	// PathType is the type of the path match: Exact, PathPrefix or RegularExpression.
+	PathType string `json:"pathType"`
+	// Method is the HTTP method of the match. Empty value means any method.
+	Method string `json:"method,omitempty"`
+	// Headers are the names of the headers that the match requires.
+	Headers []string `json:"headers,omitempty"`
+	// QueryParams are the names of the query parameters that the match requires.
+	QueryParams []string `json:"queryParams,omitempty"`
+	// Rule is the index of the rule in the Route.
+	Rule int `json:"rule"`
+	// Port is the port of the Listener.
+	Port int32 `json:"port"`
+}
+
+// RouteRef references a Route.
+type RouteRef struct {
+	// Kind is the kind of the Route, HTTPRoute or GRPCRoute.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Route.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Route.
+	Name string `json:"name"`
+}
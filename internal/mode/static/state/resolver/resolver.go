@@ -1,9 +1,12 @@
 package resolver
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	v1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
@@ -18,6 +21,7 @@ import (
 //counterfeiter:generate . ServiceResolver
 
 // ServiceResolver resolves a Service's NamespacedName and ServicePort to a list of Endpoints.
+// It also returns the summary of the ready and not ready endpoints of the ServicePort.
 // Returns an error if the Service or Service Port cannot be resolved.
 type ServiceResolver interface {
 	Resolve(
@@ -25,19 +29,88 @@ type ServiceResolver interface {
 		svcNsName types.NamespacedName,
 		svcPort v1.ServicePort,
 		allowedAddressType []discoveryV1.AddressType,
-	) ([]Endpoint, error)
+	) ([]Endpoint, EndpointSummary, error)
 }
 
 // Endpoint is the internal representation of a Kubernetes endpoint.
 type Endpoint struct {
 	// Address is the IP address of the endpoint.
 	Address string
+	// PodName is the name of the Pod of the endpoint. Empty if the endpoint doesn't belong to a Pod.
+	PodName string
 	// Port is the port of the endpoint.
 	Port int32
 	// IPv6 is true if the endpoint is an IPv6 address.
 	IPv6 bool
 }
 
+// EndpointSummary holds the number of the ready and not ready endpoints of a ServicePort by zone.
+// The endpoints without a zone are counted under the empty zone.
+type EndpointSummary struct {
+	// Ready holds the number of the ready endpoints by zone.
+	Ready map[string]int
+	// NotReady holds the number of the not ready endpoints by zone.
+	NotReady map[string]int
+}
+
+// TotalReady returns the number of the ready endpoints in all zones.
+func (s EndpointSummary) TotalReady() int {
+	return sumCounts(s.Ready)
+}
+
+// TotalNotReady returns the number of the not ready endpoints in all zones.
+func (s EndpointSummary) TotalNotReady() int {
+	return sumCounts(s.NotReady)
+}
+
+// String returns the summary in a human-readable form, for example,
+// "0 ready, 3 not ready endpoints (not ready in zones: us-east-1a=2, us-east-1b=1)".
+func (s EndpointSummary) String() string {
+	msg := fmt.Sprintf("%d ready, %d not ready endpoints", s.TotalReady(), s.TotalNotReady())
+
+	zones := make([]string, 0, len(s.NotReady))
+	for zone := range s.NotReady {
+		if zone != "" {
+			zones = append(zones, zone)
+		}
+	}
+
+	if len(zones) == 0 {
+		return msg
+	}
+
+	slices.Sort(zones)
+
+	zoneCounts := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		zoneCounts = append(zoneCounts, fmt.Sprintf("%s=%d", zone, s.NotReady[zone]))
+	}
+
+	return fmt.Sprintf("%s (not ready in zones: %s)", msg, strings.Join(zoneCounts, ", "))
+}
+
+func (s *EndpointSummary) add(zone string, ready bool) {
+	counts := &s.NotReady
+	if ready {
+		counts = &s.Ready
+	}
+
+	if *counts == nil {
+		*counts = make(map[string]int)
+	}
+
+	(*counts)[zone]++
+}
+
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+
+	return total
+}
+
 // ServiceResolverImpl implements ServiceResolver.
 type ServiceResolverImpl struct {
 	client client.Client
@@ -49,13 +122,16 @@ func NewServiceResolverImpl(c client.Client) *ServiceResolverImpl {
 }
 
 // Resolve resolves a Service's NamespacedName and ServicePort to a list of Endpoints.
+// It also returns the summary of the ready and not ready endpoints of the ServicePort.
 // Returns an error if the Service or ServicePort cannot be resolved.
+// The Endpoints are sorted by their addresses and ports, so that the order of the upstream servers
+// doesn't change when the endpoints of the Service churn.
 func (e *ServiceResolverImpl) Resolve(
 	ctx context.Context,
 	svcNsName types.NamespacedName,
 	svcPort v1.ServicePort,
 	allowedAddressType []discoveryV1.AddressType,
-) ([]Endpoint, error) {
+) ([]Endpoint, EndpointSummary, error) {
 	if svcPort.Port == 0 || svcNsName.Name == "" || svcNsName.Namespace == "" {
 		panic(fmt.Errorf("expected the following fields to be non-empty: name: %s, ns: %s, port: %d",
 			svcNsName.Name, svcNsName.Namespace, svcPort.Port))
@@ -72,7 +148,7 @@ func (e *ServiceResolverImpl) Resolve(
 	)
 
 	if err != nil || len(endpointSliceList.Items) == 0 {
-		return nil, fmt.Errorf("no endpoints found for Service %s", svcNsName)
+		return nil, EndpointSummary{}, fmt.Errorf("no endpoints found for Service %s", svcNsName)
 	}
 
 	return resolveEndpoints(
@@ -113,41 +189,86 @@ func resolveEndpoints(
 	endpointSliceList discoveryV1.EndpointSliceList,
 	initEndpointsSet initEndpointSetFunc,
 	allowedAddressType []discoveryV1.AddressType,
-) ([]Endpoint, error) {
+) ([]Endpoint, EndpointSummary, error) {
 	filteredSlices := filterEndpointSliceList(endpointSliceList, svcPort, allowedAddressType)
 
 	if len(filteredSlices) == 0 {
-		return nil, fmt.Errorf("no valid endpoints found for Service %s and port %d", svcNsName, svcPort.Port)
+		port := strconv.Itoa(int(svcPort.Port))
+		if svcPort.Name != "" {
+			port += fmt.Sprintf(" (%s)", svcPort.Name)
+		}
+
+		return nil, EndpointSummary{}, fmt.Errorf("no valid endpoints found for Service %s and port %s", svcNsName, port)
 	}
 
 	// Endpoints may be duplicated across multiple EndpointSlices.
 	// Using a set to prevent returning duplicate endpoints.
 	endpointSet := initEndpointsSet(filteredSlices)
+	notReadySet := make(map[Endpoint]struct{})
+	// podNames holds the Pod names of the ready endpoints. The Pod names are not part of the keys of the sets,
+	// so that an address is never duplicated, even if the EndpointSlices disagree about its Pod.
+	podNames := make(map[Endpoint]string)
+
+	var summary EndpointSummary
 
 	for _, eps := range filteredSlices {
 		ipv6 := eps.AddressType == discoveryV1.AddressTypeIPv6
+
+		// We don't check for a zero port value here because we are only working with EndpointSlices
+		// that have a matching port.
+		endpointPort := findPort(eps.Ports, svcPort)
+
 		for _, endpoint := range eps.Endpoints {
-			if !endpointReady(endpoint) {
-				continue
+			ready := endpointReady(endpoint)
+
+			set := notReadySet
+			if ready {
+				set = endpointSet
 			}
 
-			// We don't check for a zero port value here because we are only working with EndpointSlices
-			// that have a matching port.
-			endpointPort := findPort(eps.Ports, svcPort)
+			var zone string
+			if endpoint.Zone != nil {
+				zone = *endpoint.Zone
+			}
 
 			for _, address := range endpoint.Addresses {
 				ep := Endpoint{Address: address, Port: endpointPort, IPv6: ipv6}
-				endpointSet[ep] = struct{}{}
+				if _, exists := set[ep]; exists {
+					continue
+				}
+
+				set[ep] = struct{}{}
+				summary.add(zone, ready)
+
+				if podName := getPodName(endpoint); ready && podName != "" {
+					podNames[ep] = podName
+				}
 			}
 		}
 	}
 
 	endpoints := make([]Endpoint, 0, len(endpointSet))
 	for ep := range endpointSet {
+		ep.PodName = podNames[ep]
 		endpoints = append(endpoints, ep)
 	}
 
-	return endpoints, nil
+	slices.SortFunc(endpoints, compareEndpoints)
+
+	return endpoints, summary, nil
+}
+
+// getPodName returns the name of the Pod of the endpoint or an empty string if the endpoint doesn't belong to a Pod.
+func getPodName(endpoint discoveryV1.Endpoint) string {
+	if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+		return ""
+	}
+
+	return endpoint.TargetRef.Name
+}
+
+func compareEndpoints(a, b Endpoint) int {
+	return cmp.Or(cmp.Compare(a.Address, b.Address), cmp.Compare(a.Port, b.Port))
 }
 
 // getDefaultPort returns the default port for a ServicePort.
@@ -202,24 +323,38 @@ func filterEndpointSliceList(
 
 // findPort locates the port in the slice of EndpointPort that matches the ServicePort name.
 // The Kubernetes EndpointSlice controller handles matching the TargetPort of a ServicePort to the container port of
-// an endpoint. All we have to do is find the port with the same name as the ServicePort.
-// If a ServicePort is unnamed, then the EndpointPort will also be unnamed (empty string).
+// an endpoint, including the TargetPorts that refer to the named container ports. All we have to do is find
+// the port with the same name as the ServicePort. The EndpointSlices of a multi-port Service hold a port for each
+// ServicePort.
+// If a ServicePort is unnamed, then the EndpointPort will also be unnamed (empty string or nil).
 //
-// If an EndpointPort port is nil -- indicating all ports are valid --
-// the default port for the ServicePort is returned.
+// If an EndpointPort port is nil -- indicating all ports are valid -- and no EndpointPort with a port matches
+// the name, the default port for the ServicePort is returned.
 // If no matching port is found, 0 is returned.
 func findPort(ports []discoveryV1.EndpointPort, svcPort v1.ServicePort) int32 {
 	portName := svcPort.Name
 
+	var allPortsValid bool
+
 	for _, p := range ports {
 		if p.Port == nil {
-			return getDefaultPort(svcPort)
+			allPortsValid = true
+			continue
+		}
+
+		var name string
+		if p.Name != nil {
+			name = *p.Name
 		}
 
-		if p.Name != nil && *p.Name == portName {
+		if name == portName {
 			return *p.Port
 		}
 	}
 
+	if allPortsValid {
+		return getDefaultPort(svcPort)
+	}
+
 	return 0
 }
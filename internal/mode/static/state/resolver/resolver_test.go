@@ -370,6 +370,38 @@ func TestEndpointReady(t *testing.T) {
 	}
 }
 
+func TestGetPodName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		targetRef  *v1.ObjectReference
+		msg        string
+		expPodName string
+	}{
+		{
+			msg: "no target ref",
+		},
+		{
+			msg:        "pod",
+			targetRef:  &v1.ObjectReference{Kind: "Pod", Name: "coffee-6b8d5f9c7-x2x4q"},
+			expPodName: "coffee-6b8d5f9c7-x2x4q",
+		},
+		{
+			msg:       "not a pod",
+			targetRef: &v1.ObjectReference{Kind: "Node", Name: "node-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getPodName(discoveryV1.Endpoint{TargetRef: tc.targetRef})).To(Equal(tc.expPodName))
+		})
+	}
+}
+
 func TestFindPort(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -406,6 +438,24 @@ func TestFindPort(t *testing.T) {
 			},
 			expPort: 80,
 		},
+		{
+			msg: "nil endpoint port before the matching endpoint name",
+			ports: []discoveryV1.EndpointPort{
+				{
+					Port: nil,
+				},
+				{
+					Name: &svcPortName,
+					Port: helpers.GetPointer[int32](9113),
+				},
+			},
+			svcPort: v1.ServicePort{
+				Port:       9090,
+				TargetPort: intstr.FromString("metrics"),
+				Name:       svcPortName,
+			},
+			expPort: 9113,
+		},
 		{
 			msg: "nil endpoint port; nil target port",
 			ports: []discoveryV1.EndpointPort{
@@ -434,6 +484,20 @@ func TestFindPort(t *testing.T) {
 			},
 			expPort: 0,
 		},
+		{
+			msg: "nil endpoint port name; unnamed service port",
+			ports: []discoveryV1.EndpointPort{
+				{
+					Name: nil,
+					Port: helpers.GetPointer[int32](8080),
+				},
+			},
+			svcPort: v1.ServicePort{
+				Port:       80,
+				TargetPort: intstr.FromString("target-port"),
+			},
+			expPort: 8080,
+		},
 		{
 			msg: "no matching endpoint name",
 			ports: []discoveryV1.EndpointPort{
@@ -626,7 +690,7 @@ func bench(b *testing.B, svcNsName types.NamespacedName,
 ) {
 	b.Helper()
 	for range b.N {
-		res, err := resolveEndpoints(svcNsName, v1.ServicePort{Port: 80}, list, initSet, dualAddressType)
+		res, _, err := resolveEndpoints(svcNsName, v1.ServicePort{Port: 80}, list, initSet, dualAddressType)
 		if len(res) != n {
 			b.Fatalf("expected %d endpoints, got %d", n, len(res))
 		}
@@ -635,3 +699,49 @@ func bench(b *testing.B, svcNsName types.NamespacedName,
 		}
 	}
 }
+
+func TestEndpointSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg         string
+		summary     EndpointSummary
+		expReady    int
+		expNotReady int
+		expString   string
+	}{
+		{
+			msg:       "empty",
+			expString: "0 ready, 0 not ready endpoints",
+		},
+		{
+			msg: "not ready endpoints without zones",
+			summary: EndpointSummary{
+				Ready:    map[string]int{"": 1},
+				NotReady: map[string]int{"": 2},
+			},
+			expReady:    1,
+			expNotReady: 2,
+			expString:   "1 ready, 2 not ready endpoints",
+		},
+		{
+			msg: "not ready endpoints in zones",
+			summary: EndpointSummary{
+				NotReady: map[string]int{"zone-b": 1, "zone-a": 2, "": 1},
+			},
+			expNotReady: 4,
+			expString:   "0 ready, 4 not ready endpoints (not ready in zones: zone-a=2, zone-b=1)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(test.summary.TotalReady()).To(Equal(test.expReady))
+			g.Expect(test.summary.TotalNotReady()).To(Equal(test.expNotReady))
+			g.Expect(test.summary.String()).To(Equal(test.expString))
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 )
 
 type FakeServiceResolver struct {
-	ResolveStub        func(context.Context, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, error)
+	ResolveStub        func(context.Context, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, resolver.EndpointSummary, error)
 	resolveMutex       sync.RWMutex
 	resolveArgsForCall []struct {
 		arg1 context.Context
@@ -22,17 +22,19 @@ type FakeServiceResolver struct {
 	}
 	resolveReturns struct {
 		result1 []resolver.Endpoint
-		result2 error
+		result2 resolver.EndpointSummary
+		result3 error
 	}
 	resolveReturnsOnCall map[int]struct {
 		result1 []resolver.Endpoint
-		result2 error
+		result2 resolver.EndpointSummary
+		result3 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeServiceResolver) Resolve(arg1 context.Context, arg2 types.NamespacedName, arg3 v1.ServicePort, arg4 []v1a.AddressType) ([]resolver.Endpoint, error) {
+func (fake *FakeServiceResolver) Resolve(arg1 context.Context, arg2 types.NamespacedName, arg3 v1.ServicePort, arg4 []v1a.AddressType) ([]resolver.Endpoint, resolver.EndpointSummary, error) {
 	var arg4Copy []v1a.AddressType
 	if arg4 != nil {
 		arg4Copy = make([]v1a.AddressType, len(arg4))
@@ -54,9 +56,9 @@ func (fake *FakeServiceResolver) Resolve(arg1 context.Context, arg2 types.Namesp
 		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeServiceResolver) ResolveCallCount() int {
@@ -65,7 +67,7 @@ func (fake *FakeServiceResolver) ResolveCallCount() int {
 	return len(fake.resolveArgsForCall)
 }
 
-func (fake *FakeServiceResolver) ResolveCalls(stub func(context.Context, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, error)) {
+func (fake *FakeServiceResolver) ResolveCalls(stub func(context.Context, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, resolver.EndpointSummary, error)) {
 	fake.resolveMutex.Lock()
 	defer fake.resolveMutex.Unlock()
 	fake.ResolveStub = stub
@@ -78,30 +80,33 @@ func (fake *FakeServiceResolver) ResolveArgsForCall(i int) (context.Context, typ
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeServiceResolver) ResolveReturns(result1 []resolver.Endpoint, result2 error) {
+func (fake *FakeServiceResolver) ResolveReturns(result1 []resolver.Endpoint, result2 resolver.EndpointSummary, result3 error) {
 	fake.resolveMutex.Lock()
 	defer fake.resolveMutex.Unlock()
 	fake.ResolveStub = nil
 	fake.resolveReturns = struct {
 		result1 []resolver.Endpoint
-		result2 error
-	}{result1, result2}
+		result2 resolver.EndpointSummary
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeServiceResolver) ResolveReturnsOnCall(i int, result1 []resolver.Endpoint, result2 error) {
+func (fake *FakeServiceResolver) ResolveReturnsOnCall(i int, result1 []resolver.Endpoint, result2 resolver.EndpointSummary, result3 error) {
 	fake.resolveMutex.Lock()
 	defer fake.resolveMutex.Unlock()
 	fake.ResolveStub = nil
 	if fake.resolveReturnsOnCall == nil {
 		fake.resolveReturnsOnCall = make(map[int]struct {
 			result1 []resolver.Endpoint
-			result2 error
+			result2 resolver.EndpointSummary
+			result3 error
 		})
 	}
 	fake.resolveReturnsOnCall[i] = struct {
 		result1 []resolver.Endpoint
-		result2 error
-	}{result1, result2}
+		result2 resolver.EndpointSummary
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeServiceResolver) Invocations() map[string][][]interface{} {
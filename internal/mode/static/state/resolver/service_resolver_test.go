@@ -48,6 +48,7 @@ func createSlice(
 					"1.0.0.2",
 					"1.0.0.3",
 				}, // these endpoints should be ignored because they are not ready
+				Zone: helpers.GetPointer("zone-a"),
 				Conditions: discoveryV1.EndpointConditions{
 					Serving:     helpers.GetPointer(true),
 					Terminating: helpers.GetPointer(true),
@@ -162,6 +163,8 @@ var _ = Describe("ServiceResolver", func() {
 	)
 	Describe("Resolve", Ordered, func() {
 		BeforeAll(func() {
+			slice2.Endpoints[0].TargetRef = &v1.ObjectReference{Kind: "Pod", Name: "pod-2"}
+
 			var err error
 			fakeK8sClient, err = createFakeK8sClient(
 				slice1,
@@ -175,31 +178,35 @@ var _ = Describe("ServiceResolver", func() {
 			serviceResolver = resolver.NewServiceResolverImpl(fakeK8sClient)
 		})
 		It("resolves a service for a given port", func() {
+			// the endpoints are sorted by their addresses and ports
 			expectedEndpoints := []resolver.Endpoint{
-				{
-					Address: "9.0.0.1",
-					Port:    8080,
-				},
-				{
-					Address: "9.0.0.2",
-					Port:    8080,
-				},
 				{
 					Address: "10.0.0.1",
+					PodName: "pod-2",
 					Port:    8081,
 				},
 				{
 					Address: "10.0.0.2",
+					PodName: "pod-2",
 					Port:    8081,
 				},
 				{
 					Address: "10.0.0.3",
+					PodName: "pod-2",
 					Port:    8081,
 				},
 				{
 					Address: "12.0.0.1",
 					Port:    8080,
 				},
+				{
+					Address: "9.0.0.1",
+					Port:    8080,
+				},
+				{
+					Address: "9.0.0.2",
+					Port:    8080,
+				},
 				{
 					Address: "FE80:CD00:0:CDE:1257:0:211E:729C",
 					Port:    8080,
@@ -207,9 +214,16 @@ var _ = Describe("ServiceResolver", func() {
 				},
 			}
 
-			endpoints, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
+			// the not ready endpoints are counted once per port and IP family
+			expectedSummary := resolver.EndpointSummary{
+				Ready:    map[string]int{"": 7},
+				NotReady: map[string]int{"zone-a": 9, "": 9},
+			}
+
+			endpoints, summary, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(endpoints).To(ConsistOf(expectedEndpoints))
+			Expect(endpoints).To(Equal(expectedEndpoints))
+			Expect(summary).To(Equal(expectedSummary))
 		})
 		It("returns an error if there are no valid endpoint slices for the service and port", func() {
 			// delete valid endpoint slices
@@ -218,27 +232,29 @@ var _ = Describe("ServiceResolver", func() {
 			Expect(fakeK8sClient.Delete(context.TODO(), dupeEndpointSlice)).To(Succeed())
 			Expect(fakeK8sClient.Delete(context.TODO(), sliceIPV6)).To(Succeed())
 
-			endpoints, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
+			endpoints, summary, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
 			Expect(err).To(HaveOccurred())
 			Expect(endpoints).To(BeNil())
+			Expect(summary).To(BeZero())
 		})
 		It("returns an error if there are no endpoint slices for the service", func() {
 			// delete remaining endpoint slices
 			Expect(fakeK8sClient.Delete(context.TODO(), sliceNoMatchingPortName)).To(Succeed())
 
-			endpoints, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
+			endpoints, summary, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
 			Expect(err).To(HaveOccurred())
 			Expect(endpoints).To(BeNil())
+			Expect(summary).To(BeZero())
 		})
 		It("panics if the service NamespacedName is empty", func() {
 			resolve := func() {
-				_, _ = serviceResolver.Resolve(context.TODO(), types.NamespacedName{}, svcPort, dualAddressType)
+				_, _, _ = serviceResolver.Resolve(context.TODO(), types.NamespacedName{}, svcPort, dualAddressType)
 			}
 			Expect(resolve).Should(Panic())
 		})
 		It("panics if the ServicePort is empty", func() {
 			resolve := func() {
-				_, _ = serviceResolver.Resolve(context.TODO(), types.NamespacedName{}, v1.ServicePort{}, dualAddressType)
+				_, _, _ = serviceResolver.Resolve(context.TODO(), types.NamespacedName{}, v1.ServicePort{}, dualAddressType)
 			}
 			Expect(resolve).Should(Panic())
 		})
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
@@ -185,6 +186,10 @@ type changeTrackingUpdater struct {
 	store                  *multiObjectStore
 	stateChangedPredicates map[schema.GroupVersionKind]stateChangedPredicate
 
+	// endpointSlicePorts holds the ports of the EndpointSlices, so that we can detect the changes of the mapping
+	// of the Service ports to the ports of the endpoints.
+	endpointSlicePorts map[types.NamespacedName][]discoveryV1.EndpointPort
+
 	extractGVK    kinds.MustExtractGVK
 	supportedGVKs gvkList
 
@@ -221,6 +226,7 @@ func newChangeTrackingUpdater(
 		extractGVK:             extractGVK,
 		supportedGVKs:          supportedGVKs,
 		stateChangedPredicates: stateChangedPredicates,
+		endpointSlicePorts:     make(map[types.NamespacedName][]discoveryV1.EndpointPort),
 		changeType:             NoChange,
 	}
 }
@@ -255,9 +261,75 @@ func (s *changeTrackingUpdater) Upsert(obj client.Object) {
 
 	changingUpsert := s.upsert(obj)
 
+	// The ports of the endpoints are resolved from the ports of the EndpointSlices. If the port mapping changes,
+	// for example, when a named targetPort of a Service is renamed or moved to another container port, the ports
+	// of the upstreams change even if the endpoints stay the same, so the configuration must be regenerated.
+	if s.updateEndpointSlicePorts(obj) && changingUpsert {
+		s.changeType = ClusterStateChange
+		return
+	}
+
 	s.setChangeType(obj, changingUpsert)
 }
 
+// updateEndpointSlicePorts records the ports of the EndpointSlice and returns true if they changed since
+// the previous upsert of the EndpointSlice. It returns false for the other objects and for new EndpointSlices.
+func (s *changeTrackingUpdater) updateEndpointSlicePorts(obj client.Object) (portsChanged bool) {
+	slice, ok := obj.(*discoveryV1.EndpointSlice)
+	if !ok {
+		return false
+	}
+
+	nsname := client.ObjectKeyFromObject(slice)
+
+	oldPorts, exists := s.endpointSlicePorts[nsname]
+
+	newPorts := make([]discoveryV1.EndpointPort, 0, len(slice.Ports))
+	for _, p := range slice.Ports {
+		newPorts = append(newPorts, *p.DeepCopy())
+	}
+	s.endpointSlicePorts[nsname] = newPorts
+
+	return exists && !endpointPortsEqual(oldPorts, newPorts)
+}
+
+// endpointPortsEqual returns true if the ports have the same mapping of the port names to the port numbers,
+// protocols, and application protocols, regardless of the order of the ports.
+func endpointPortsEqual(ports1, ports2 []discoveryV1.EndpointPort) bool {
+	if len(ports1) != len(ports2) {
+		return false
+	}
+
+	byName := make(map[string]discoveryV1.EndpointPort, len(ports1))
+	for _, p := range ports1 {
+		byName[endpointPortName(p)] = p
+	}
+
+	for _, p := range ports2 {
+		other, exists := byName[endpointPortName(p)]
+		if !exists {
+			return false
+		}
+
+		if !helpers.EqualPointers(p.Port, other.Port) ||
+			!helpers.EqualPointers(p.Protocol, other.Protocol) ||
+			!helpers.EqualPointers(p.AppProtocol, other.AppProtocol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endpointPortName returns the name of the port. Unnamed ports have either an empty or a nil name.
+func endpointPortName(p discoveryV1.EndpointPort) string {
+	if p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
 func (s *changeTrackingUpdater) delete(objType ngftypes.ObjectType, nsname types.NamespacedName) (changed bool) {
 	objTypeGVK := s.extractGVK(objType)
 
@@ -282,6 +354,10 @@ func (s *changeTrackingUpdater) Delete(objType ngftypes.ObjectType, nsname types
 
 	changingDelete := s.delete(objType, nsname)
 
+	if _, ok := objType.(*discoveryV1.EndpointSlice); ok {
+		delete(s.endpointSlicePorts, nsname)
+	}
+
 	s.setChangeType(objType, changingDelete)
 }
 
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 )
 
 //nolint:paralleltest,tparallel // Order matters for these tests.
@@ -53,3 +56,91 @@ func TestSetChangeType(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointPortsEqual(t *testing.T) {
+	t.Parallel()
+
+	tcp := apiv1.ProtocolTCP
+	udp := apiv1.ProtocolUDP
+
+	ports := []discoveryV1.EndpointPort{
+		{Name: helpers.GetPointer("http"), Port: helpers.GetPointer[int32](8080), Protocol: &tcp},
+		{Name: helpers.GetPointer("dns"), Port: helpers.GetPointer[int32](53), Protocol: &udp},
+	}
+
+	tests := []struct {
+		name   string
+		ports1 []discoveryV1.EndpointPort
+		ports2 []discoveryV1.EndpointPort
+		exp    bool
+	}{
+		{
+			name: "no ports",
+			exp:  true,
+		},
+		{
+			name:   "same ports in a different order",
+			ports1: ports,
+			ports2: []discoveryV1.EndpointPort{ports[1], ports[0]},
+			exp:    true,
+		},
+		{
+			name:   "unnamed ports with nil and empty names",
+			ports1: []discoveryV1.EndpointPort{{Port: helpers.GetPointer[int32](8080)}},
+			ports2: []discoveryV1.EndpointPort{{Name: helpers.GetPointer(""), Port: helpers.GetPointer[int32](8080)}},
+			exp:    true,
+		},
+		{
+			name:   "different number of ports",
+			ports1: ports,
+			ports2: ports[:1],
+			exp:    false,
+		},
+		{
+			name:   "renamed port",
+			ports1: ports[:1],
+			ports2: []discoveryV1.EndpointPort{
+				{Name: helpers.GetPointer("web"), Port: helpers.GetPointer[int32](8080), Protocol: &tcp},
+			},
+			exp: false,
+		},
+		{
+			name:   "different port number",
+			ports1: ports[:1],
+			ports2: []discoveryV1.EndpointPort{
+				{Name: helpers.GetPointer("http"), Port: helpers.GetPointer[int32](9090), Protocol: &tcp},
+			},
+			exp: false,
+		},
+		{
+			name:   "different protocol",
+			ports1: ports[:1],
+			ports2: []discoveryV1.EndpointPort{
+				{Name: helpers.GetPointer("http"), Port: helpers.GetPointer[int32](8080), Protocol: &udp},
+			},
+			exp: false,
+		},
+		{
+			name:   "different app protocol",
+			ports1: ports[:1],
+			ports2: []discoveryV1.EndpointPort{
+				{
+					Name:        helpers.GetPointer("http"),
+					Port:        helpers.GetPointer[int32](8080),
+					Protocol:    &tcp,
+					AppProtocol: helpers.GetPointer("kubernetes.io/h2c"),
+				},
+			},
+			exp: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(endpointPortsEqual(test.ports1, test.ports2)).To(Equal(test.exp))
+		})
+	}
+}
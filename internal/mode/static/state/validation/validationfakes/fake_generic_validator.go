@@ -52,6 +52,17 @@ type FakeGenericValidator struct {
 	validateNginxSizeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateSSLCiphersStub        func(string) error
+	validateSSLCiphersMutex       sync.RWMutex
+	validateSSLCiphersArgsForCall []struct {
+		arg1 string
+	}
+	validateSSLCiphersReturns struct {
+		result1 error
+	}
+	validateSSLCiphersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateServiceNameStub        func(string) error
 	validateServiceNameMutex       sync.RWMutex
 	validateServiceNameArgsForCall []struct {
@@ -63,6 +74,18 @@ type FakeGenericValidator struct {
 	validateServiceNameReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateTemplateOverrideStub        func(string, string) error
+	validateTemplateOverrideMutex       sync.RWMutex
+	validateTemplateOverrideArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateTemplateOverrideReturns struct {
+		result1 error
+	}
+	validateTemplateOverrideReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -311,6 +334,67 @@ func (fake *FakeGenericValidator) ValidateNginxSizeReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateSSLCiphers(arg1 string) error {
+	fake.validateSSLCiphersMutex.Lock()
+	ret, specificReturn := fake.validateSSLCiphersReturnsOnCall[len(fake.validateSSLCiphersArgsForCall)]
+	fake.validateSSLCiphersArgsForCall = append(fake.validateSSLCiphersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateSSLCiphersStub
+	fakeReturns := fake.validateSSLCiphersReturns
+	fake.recordInvocation("ValidateSSLCiphers", []interface{}{arg1})
+	fake.validateSSLCiphersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateSSLCiphersCallCount() int {
+	fake.validateSSLCiphersMutex.RLock()
+	defer fake.validateSSLCiphersMutex.RUnlock()
+	return len(fake.validateSSLCiphersArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateSSLCiphersCalls(stub func(string) error) {
+	fake.validateSSLCiphersMutex.Lock()
+	defer fake.validateSSLCiphersMutex.Unlock()
+	fake.ValidateSSLCiphersStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateSSLCiphersArgsForCall(i int) string {
+	fake.validateSSLCiphersMutex.RLock()
+	defer fake.validateSSLCiphersMutex.RUnlock()
+	argsForCall := fake.validateSSLCiphersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateSSLCiphersReturns(result1 error) {
+	fake.validateSSLCiphersMutex.Lock()
+	defer fake.validateSSLCiphersMutex.Unlock()
+	fake.ValidateSSLCiphersStub = nil
+	fake.validateSSLCiphersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateSSLCiphersReturnsOnCall(i int, result1 error) {
+	fake.validateSSLCiphersMutex.Lock()
+	defer fake.validateSSLCiphersMutex.Unlock()
+	fake.ValidateSSLCiphersStub = nil
+	if fake.validateSSLCiphersReturnsOnCall == nil {
+		fake.validateSSLCiphersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSSLCiphersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateServiceName(arg1 string) error {
 	fake.validateServiceNameMutex.Lock()
 	ret, specificReturn := fake.validateServiceNameReturnsOnCall[len(fake.validateServiceNameArgsForCall)]
@@ -372,6 +456,68 @@ func (fake *FakeGenericValidator) ValidateServiceNameReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateTemplateOverride(arg1 string, arg2 string) error {
+	fake.validateTemplateOverrideMutex.Lock()
+	ret, specificReturn := fake.validateTemplateOverrideReturnsOnCall[len(fake.validateTemplateOverrideArgsForCall)]
+	fake.validateTemplateOverrideArgsForCall = append(fake.validateTemplateOverrideArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ValidateTemplateOverrideStub
+	fakeReturns := fake.validateTemplateOverrideReturns
+	fake.recordInvocation("ValidateTemplateOverride", []interface{}{arg1, arg2})
+	fake.validateTemplateOverrideMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateTemplateOverrideCallCount() int {
+	fake.validateTemplateOverrideMutex.RLock()
+	defer fake.validateTemplateOverrideMutex.RUnlock()
+	return len(fake.validateTemplateOverrideArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateTemplateOverrideCalls(stub func(string, string) error) {
+	fake.validateTemplateOverrideMutex.Lock()
+	defer fake.validateTemplateOverrideMutex.Unlock()
+	fake.ValidateTemplateOverrideStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateTemplateOverrideArgsForCall(i int) (string, string) {
+	fake.validateTemplateOverrideMutex.RLock()
+	defer fake.validateTemplateOverrideMutex.RUnlock()
+	argsForCall := fake.validateTemplateOverrideArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeGenericValidator) ValidateTemplateOverrideReturns(result1 error) {
+	fake.validateTemplateOverrideMutex.Lock()
+	defer fake.validateTemplateOverrideMutex.Unlock()
+	fake.ValidateTemplateOverrideStub = nil
+	fake.validateTemplateOverrideReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateTemplateOverrideReturnsOnCall(i int, result1 error) {
+	fake.validateTemplateOverrideMutex.Lock()
+	defer fake.validateTemplateOverrideMutex.Unlock()
+	fake.ValidateTemplateOverrideStub = nil
+	if fake.validateTemplateOverrideReturnsOnCall == nil {
+		fake.validateTemplateOverrideReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateTemplateOverrideReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -383,8 +529,12 @@ func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateNginxDurationMutex.RUnlock()
 	fake.validateNginxSizeMutex.RLock()
 	defer fake.validateNginxSizeMutex.RUnlock()
+	fake.validateSSLCiphersMutex.RLock()
+	defer fake.validateSSLCiphersMutex.RUnlock()
 	fake.validateServiceNameMutex.RLock()
 	defer fake.validateServiceNameMutex.RUnlock()
+	fake.validateTemplateOverrideMutex.RLock()
+	defer fake.validateTemplateOverrideMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
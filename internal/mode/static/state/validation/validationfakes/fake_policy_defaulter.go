@@ -0,0 +1,112 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package validationfakes
+
+import (
+	"sync"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+type FakePolicyDefaulter struct {
+	DefaultStub        func(policies.Policy) policies.Policy
+	defaultMutex       sync.RWMutex
+	defaultArgsForCall []struct {
+		arg1 policies.Policy
+	}
+	defaultReturns struct {
+		result1 policies.Policy
+	}
+	defaultReturnsOnCall map[int]struct {
+		result1 policies.Policy
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePolicyDefaulter) Default(arg1 policies.Policy) policies.Policy {
+	fake.defaultMutex.Lock()
+	ret, specificReturn := fake.defaultReturnsOnCall[len(fake.defaultArgsForCall)]
+	fake.defaultArgsForCall = append(fake.defaultArgsForCall, struct {
+		arg1 policies.Policy
+	}{arg1})
+	stub := fake.DefaultStub
+	fakeReturns := fake.defaultReturns
+	fake.recordInvocation("Default", []interface{}{arg1})
+	fake.defaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyDefaulter) DefaultCallCount() int {
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	return len(fake.defaultArgsForCall)
+}
+
+func (fake *FakePolicyDefaulter) DefaultCalls(stub func(policies.Policy) policies.Policy) {
+	fake.defaultMutex.Lock()
+	defer fake.defaultMutex.Unlock()
+	fake.DefaultStub = stub
+}
+
+func (fake *FakePolicyDefaulter) DefaultArgsForCall(i int) policies.Policy {
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	argsForCall := fake.defaultArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyDefaulter) DefaultReturns(result1 policies.Policy) {
+	fake.defaultMutex.Lock()
+	defer fake.defaultMutex.Unlock()
+	fake.DefaultStub = nil
+	fake.defaultReturns = struct {
+		result1 policies.Policy
+	}{result1}
+}
+
+func (fake *FakePolicyDefaulter) DefaultReturnsOnCall(i int, result1 policies.Policy) {
+	fake.defaultMutex.Lock()
+	defer fake.defaultMutex.Unlock()
+	fake.DefaultStub = nil
+	if fake.defaultReturnsOnCall == nil {
+		fake.defaultReturnsOnCall = make(map[int]struct {
+			result1 policies.Policy
+		})
+	}
+	fake.defaultReturnsOnCall[i] = struct {
+		result1 policies.Policy
+	}{result1}
+}
+
+func (fake *FakePolicyDefaulter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.defaultMutex.RLock()
+	defer fake.defaultMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePolicyDefaulter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ validation.PolicyDefaulter = new(FakePolicyDefaulter)
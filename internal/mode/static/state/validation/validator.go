@@ -15,6 +15,8 @@ type Validators struct {
 	HTTPFieldsValidator HTTPFieldsValidator
 	GenericValidator    GenericValidator
 	PolicyValidator     PolicyValidator
+	// PolicyDefaulter is optional. If nil, no defaults are set on NGF Policies.
+	PolicyDefaulter PolicyDefaulter
 }
 
 // HTTPFieldsValidator validates the HTTP-related fields of Gateway API resources from the perspective of
@@ -23,10 +25,12 @@ type Validators struct {
 //counterfeiter:generate . HTTPFieldsValidator
 type HTTPFieldsValidator interface {
 	ValidatePathInMatch(path string) error
+	ValidatePathRegexInMatch(path string) error
 	ValidateHeaderNameInMatch(name string) error
 	ValidateHeaderValueInMatch(value string) error
 	ValidateQueryParamNameInMatch(name string) error
 	ValidateQueryParamValueInMatch(name string) error
+	ValidateQueryParamRegexInMatch(value string) error
 	ValidateMethodInMatch(method string) (valid bool, supportedValues []string)
 	ValidateRedirectScheme(scheme string) (valid bool, supportedValues []string)
 	ValidateRedirectPort(port int32) error
@@ -47,6 +51,8 @@ type GenericValidator interface {
 	ValidateNginxDuration(duration string) error
 	ValidateNginxSize(size string) error
 	ValidateEndpoint(endpoint string) error
+	ValidateSSLCiphers(ciphers string) error
+	ValidateTemplateOverride(name, text string) error
 }
 
 // PolicyValidator validates an NGF Policy.
@@ -58,3 +64,12 @@ type PolicyValidator interface {
 	// Conflicts returns true if the two Policies conflict.
 	Conflicts(a, b policies.Policy) bool
 }
+
+// PolicyDefaulter sets the default values of an NGF Policy.
+//
+//counterfeiter:generate . PolicyDefaulter
+type PolicyDefaulter interface {
+	// Default returns a copy of the Policy with the default values set. If there are no defaults to set,
+	// the Policy is returned as is.
+	Default(policy policies.Policy) policies.Policy
+}
@@ -2,6 +2,9 @@ package status
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,21 +20,67 @@ import (
 	frameworkStatus "github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 // NginxReloadResult describes the result of an NGINX reload.
 type NginxReloadResult struct {
 	// Error is the error that occurred during the reload.
 	Error error
+	// PlusAPIFallback indicates that the NGINX Plus API repeatedly failed to update the upstream servers,
+	// so NGINX was reloaded to update them instead.
+	PlusAPIFallback bool
+	// SmokeTestFailures are the failures of the smoke test probes that were sent through NGINX after
+	// the configuration was updated.
+	SmokeTestFailures []string
+}
+
+// GatewayOwner returns true if this instance of NGF writes the statuses of the Gateway. The instances that select
+// different Gateways of the GatewayClass with a label selector share the controller name, so an instance keeps
+// the parent and ancestor statuses of the Gateways of the other instances.
+type GatewayOwner func(gateway types.NamespacedName) bool
+
+// NewGatewayOwner returns the GatewayOwner of this instance. If the instance selects its Gateways, it owns
+// the Gateways of the graph and the Gateways of the previous graph, whose statuses were written by the instance,
+// so that the statuses of the Gateways that were deleted or are no longer selected are removed. Otherwise, it owns
+// all Gateways, so that the statuses of the deleted Gateways are removed.
+func NewGatewayOwner(gr, prevGr *graph.Graph, selectsGateways bool) GatewayOwner {
+	if !selectsGateways {
+		return func(types.NamespacedName) bool { return true }
+	}
+
+	owned := make(map[types.NamespacedName]struct{})
+	for _, g := range []*graph.Graph{gr, prevGr} {
+		if g == nil {
+			continue
+		}
+
+		if g.Gateway != nil {
+			owned[client.ObjectKeyFromObject(g.Gateway.Source)] = struct{}{}
+		}
+
+		for nsname := range g.MergedGateways {
+			owned[nsname] = struct{}{}
+		}
+	}
+
+	return func(gateway types.NamespacedName) bool {
+		_, exists := owned[gateway]
+		return exists
+	}
 }
 
 // PrepareRouteRequests prepares status UpdateRequests for the given Routes.
+// endpointSummaries are the summaries of the endpoints of the upstreams, keyed by the name of the upstream.
+// They are used to report the backends of a Route that don't have any ready endpoints.
 func PrepareRouteRequests(
 	l4routes map[graph.L4RouteKey]*graph.L4Route,
 	routes map[graph.RouteKey]*graph.L7Route,
 	transitionTime metav1.Time,
 	nginxReloadRes NginxReloadResult,
 	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+	endpointSummaries map[string]resolver.EndpointSummary,
 ) []frameworkStatus.UpdateRequest {
 	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routes))
 
@@ -43,22 +92,24 @@ func PrepareRouteRequests(
 			nginxReloadRes,
 			transitionTime,
 			r.Source.GetGeneration(),
+			buildNoReadyEndpointsMessage([]graph.BackendRef{r.Spec.BackendRef}, endpointSummaries),
 		)
 
-		status := v1alpha2.TLSRouteStatus{
-			RouteStatus: routeStatus,
-		}
-
 		req := frameworkStatus.UpdateRequest{
 			NsName:       routeKey.NamespacedName,
-			ResourceType: &v1alpha2.TLSRoute{},
-			Setter:       newTLSRouteStatusSetter(status, gatewayCtlrName),
+			ResourceType: newL4RouteObject(routeKey.RouteType),
+			Setter:       newL4RouteStatusSetter(routeStatus, gatewayCtlrName, ownsGateway),
 		}
 
 		reqs = append(reqs, req)
 	}
 
 	for routeKey, r := range routes {
+		var backendRefs []graph.BackendRef
+		for _, rule := range r.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+
 		routeStatus := prepareRouteStatus(
 			gatewayCtlrName,
 			r.ParentRefs,
@@ -66,6 +117,7 @@ func PrepareRouteRequests(
 			nginxReloadRes,
 			transitionTime,
 			r.Source.GetGeneration(),
+			buildNoReadyEndpointsMessage(backendRefs, endpointSummaries),
 		)
 
 		switch r.RouteType {
@@ -77,7 +129,7 @@ func PrepareRouteRequests(
 			req := frameworkStatus.UpdateRequest{
 				NsName:       routeKey.NamespacedName,
 				ResourceType: &v1.HTTPRoute{},
-				Setter:       newHTTPRouteStatusSetter(status, gatewayCtlrName),
+				Setter:       newHTTPRouteStatusSetter(status, gatewayCtlrName, ownsGateway),
 			}
 
 			reqs = append(reqs, req)
@@ -90,7 +142,7 @@ func PrepareRouteRequests(
 			req := frameworkStatus.UpdateRequest{
 				NsName:       routeKey.NamespacedName,
 				ResourceType: &v1.GRPCRoute{},
-				Setter:       newGRPCRouteStatusSetter(status, gatewayCtlrName),
+				Setter:       newGRPCRouteStatusSetter(status, gatewayCtlrName, ownsGateway),
 			}
 
 			reqs = append(reqs, req)
@@ -103,6 +155,103 @@ func PrepareRouteRequests(
 	return reqs
 }
 
+// PrepareDetachedRouteRequests prepares status UpdateRequests that remove the parent statuses of this instance
+// from the Routes of the previous graph that are no longer in the graph, because none of the Gateways that they
+// reference belong to this instance anymore.
+func PrepareDetachedRouteRequests(
+	gr, prevGr *graph.Graph,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) []frameworkStatus.UpdateRequest {
+	if prevGr == nil {
+		return nil
+	}
+
+	var reqs []frameworkStatus.UpdateRequest
+
+	for routeKey := range prevGr.L4Routes {
+		if _, exists := gr.L4Routes[routeKey]; exists {
+			continue
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       routeKey.NamespacedName,
+			ResourceType: newL4RouteObject(routeKey.RouteType),
+			Setter:       newL4RouteStatusSetter(v1alpha2.RouteStatus{}, gatewayCtlrName, ownsGateway),
+		})
+	}
+
+	for routeKey := range prevGr.Routes {
+		if _, exists := gr.Routes[routeKey]; exists {
+			continue
+		}
+
+		req := frameworkStatus.UpdateRequest{NsName: routeKey.NamespacedName}
+
+		switch routeKey.RouteType {
+		case graph.RouteTypeHTTP:
+			req.ResourceType = &v1.HTTPRoute{}
+			req.Setter = newHTTPRouteStatusSetter(v1.HTTPRouteStatus{}, gatewayCtlrName, ownsGateway)
+		case graph.RouteTypeGRPC:
+			req.ResourceType = &v1.GRPCRoute{}
+			req.Setter = newGRPCRouteStatusSetter(v1.GRPCRouteStatus{}, gatewayCtlrName, ownsGateway)
+		default:
+			panic(fmt.Sprintf("Unknown route type: %s", routeKey.RouteType))
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs
+}
+
+// newL4RouteObject returns an empty object of the type of the L4 Routes of the route type.
+func newL4RouteObject(routeType graph.RouteType) client.Object {
+	switch routeType {
+	case graph.RouteTypeTLS:
+		return &v1alpha2.TLSRoute{}
+	case graph.RouteTypeTCP:
+		return &v1alpha2.TCPRoute{}
+	case graph.RouteTypeUDP:
+		return &v1alpha2.UDPRoute{}
+	default:
+		panic(fmt.Sprintf("Unknown route type: %s", routeType))
+	}
+}
+
+// buildNoReadyEndpointsMessage builds a message that lists the valid backendRefs that don't have any ready endpoints
+// along with the summary of their endpoints. It returns an empty string if all the backendRefs have ready endpoints.
+func buildNoReadyEndpointsMessage(
+	backendRefs []graph.BackendRef,
+	endpointSummaries map[string]resolver.EndpointSummary,
+) string {
+	var msgs []string
+	seen := make(map[string]struct{})
+
+	for _, br := range backendRefs {
+		if !br.Valid {
+			continue
+		}
+
+		name := br.ServicePortReference()
+		if _, exists := seen[name]; exists {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		summary, exists := endpointSummaries[name]
+		if !exists || summary.TotalReady() > 0 {
+			continue
+		}
+
+		msgs = append(msgs, fmt.Sprintf("%s: %s", name, summary))
+	}
+
+	sort.Strings(msgs)
+
+	return strings.Join(msgs, "; ")
+}
+
 func prepareRouteStatus(
 	gatewayCtlrName string,
 	parentRefs []graph.ParentRef,
@@ -110,25 +259,34 @@ func prepareRouteStatus(
 	nginxReloadRes NginxReloadResult,
 	transitionTime metav1.Time,
 	srcGeneration int64,
+	noReadyEndpointsMsg string,
 ) v1.RouteStatus {
 	parents := make([]v1.RouteParentStatus, 0, len(parentRefs))
 
 	defaultConds := staticConds.NewDefaultRouteConditions()
+	if noReadyEndpointsMsg != "" {
+		// The condition replaces the default ResolvedRefs condition, but is overridden by the conditions of the Route
+		// when some references are not resolved.
+		defaultConds = append(defaultConds, staticConds.NewRouteResolvedRefsNoReadyEndpoints(noReadyEndpointsMsg))
+	}
 
 	for _, ref := range parentRefs {
-		failedAttachmentCondCount := 0
-		if ref.Attachment != nil && !ref.Attachment.Attached {
-			failedAttachmentCondCount = 1
+		var parentConds []conditions.Condition
+		if ref.Attachment != nil {
+			if ref.Attachment.Attached {
+				parentConds = ref.Attachment.Conditions
+			} else {
+				parentConds = []conditions.Condition{ref.Attachment.FailedCondition}
+			}
 		}
-		allConds := make([]conditions.Condition, 0, len(conds)+len(defaultConds)+failedAttachmentCondCount)
+		allConds := make([]conditions.Condition, 0, len(conds)+len(defaultConds)+len(parentConds))
 
 		// We add defaultConds first, so that any additional conditions will override them, which is
-		// ensured by DeduplicateConditions.
+		// ensured by DeduplicateConditions. The conditions of the ParentRef are added last, because they
+		// are more specific than the conditions of the Route.
 		allConds = append(allConds, defaultConds...)
 		allConds = append(allConds, conds...)
-		if failedAttachmentCondCount == 1 {
-			allConds = append(allConds, ref.Attachment.FailedCondition)
-		}
+		allConds = append(allConds, parentConds...)
 
 		if nginxReloadRes.Error != nil {
 			allConds = append(
@@ -145,6 +303,7 @@ func prepareRouteStatus(
 				Namespace:   helpers.GetPointer(v1.Namespace(ref.Gateway.Namespace)),
 				Name:        v1.ObjectName(ref.Gateway.Name),
 				SectionName: ref.SectionName,
+				Port:        ref.Port,
 			},
 			ControllerName: v1.GatewayController(gatewayCtlrName),
 			Conditions:     apiConds,
@@ -211,26 +370,19 @@ func PrepareGatewayClassRequests(
 // PrepareGatewayRequests prepares status UpdateRequests for the given Gateways.
 func PrepareGatewayRequests(
 	gateway *graph.Gateway,
-	ignoredGateways map[types.NamespacedName]*v1.Gateway,
+	mergedGateways map[types.NamespacedName]*graph.Gateway,
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
 	nginxReloadRes NginxReloadResult,
 ) []frameworkStatus.UpdateRequest {
-	reqs := make([]frameworkStatus.UpdateRequest, 0, 1+len(ignoredGateways))
+	reqs := make([]frameworkStatus.UpdateRequest, 0, 1+len(mergedGateways))
 
 	if gateway != nil {
 		reqs = append(reqs, prepareGatewayRequest(gateway, transitionTime, gwAddresses, nginxReloadRes))
 	}
 
-	for nsname, gw := range ignoredGateways {
-		apiConds := conditions.ConvertConditions(staticConds.NewGatewayConflict(), gw.Generation, transitionTime)
-		reqs = append(reqs, frameworkStatus.UpdateRequest{
-			NsName:       nsname,
-			ResourceType: &v1.Gateway{},
-			Setter: newGatewayStatusSetter(v1.GatewayStatus{
-				Conditions: apiConds,
-			}),
-		})
+	for _, gw := range mergedGateways {
+		reqs = append(reqs, prepareGatewayRequest(gw, transitionTime, gwAddresses, nginxReloadRes))
 	}
 
 	return reqs
@@ -265,7 +417,8 @@ func prepareGatewayRequest(
 		var conds []conditions.Condition
 
 		if l.Valid {
-			conds = staticConds.NewDefaultListenerConditions()
+			// the Conditions of a valid Listener are warnings, such as a certificate that doesn't cover its hostname.
+			conds = append(staticConds.NewDefaultListenerConditions(), l.Conditions...)
 			validListenerCount++
 		} else {
 			conds = l.Conditions
@@ -293,6 +446,8 @@ func prepareGatewayRequest(
 	}
 
 	gwConds := staticConds.NewDefaultGatewayConditions()
+	gwConds = append(gwConds, gateway.Conditions...)
+
 	if validListenerCount == 0 {
 		gwConds = append(gwConds, staticConds.NewGatewayNotAcceptedListenersNotValid()...)
 	} else if validListenerCount < len(gateway.Listeners) {
@@ -306,6 +461,19 @@ func prepareGatewayRequest(
 		)
 	}
 
+	if nginxReloadRes.PlusAPIFallback {
+		gwConds = append(gwConds, staticConds.NewGatewayPlusAPIDegraded())
+	}
+
+	if len(nginxReloadRes.SmokeTestFailures) > 0 {
+		gwConds = append(gwConds, staticConds.NewGatewaySmokeTestFailed(nginxReloadRes.SmokeTestFailures))
+	}
+
+	if unassigned := getUnassignedAddresses(gateway.Source.Spec.Addresses, gwAddresses); len(unassigned) > 0 {
+		msg := "Requested addresses are not assigned to the Service of the Gateway: " + strings.Join(unassigned, ", ")
+		gwConds = append(gwConds, staticConds.NewGatewayNotProgrammedAddressNotUsable(msg))
+	}
+
 	apiGwConds := conditions.ConvertConditions(
 		conditions.DeduplicateConditions(gwConds),
 		gateway.Source.Generation,
@@ -323,10 +491,29 @@ func prepareGatewayRequest(
 	}
 }
 
+// getUnassignedAddresses returns the values of the requested addresses that are not among the addresses
+// of the Gateway.
+func getUnassignedAddresses(requested []v1.GatewayAddress, gwAddresses []v1.GatewayStatusAddress) []string {
+	var unassigned []string
+
+	for _, addr := range requested {
+		assigned := slices.ContainsFunc(gwAddresses, func(gwAddr v1.GatewayStatusAddress) bool {
+			return gwAddr.Value == addr.Value
+		})
+
+		if !assigned {
+			unassigned = append(unassigned, addr.Value)
+		}
+	}
+
+	return unassigned
+}
+
 func PrepareNGFPolicyRequests(
 	policies map[graph.PolicyKey]*graph.Policy,
 	transitionTime metav1.Time,
 	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
 ) []frameworkStatus.UpdateRequest {
 	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
 
@@ -362,7 +549,7 @@ func PrepareNGFPolicyRequests(
 		reqs = append(reqs, frameworkStatus.UpdateRequest{
 			NsName:       key.NsName,
 			ResourceType: pol.Source,
-			Setter:       newNGFPolicyStatusSetter(status, gatewayCtlrName),
+			Setter:       newNGFPolicyStatusSetter(status, gatewayCtlrName, ownsGateway),
 		})
 	}
 
@@ -374,6 +561,7 @@ func PrepareBackendTLSPolicyRequests(
 	policies map[types.NamespacedName]*graph.BackendTLSPolicy,
 	transitionTime metav1.Time,
 	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
 ) []frameworkStatus.UpdateRequest {
 	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
 
@@ -403,7 +591,48 @@ func PrepareBackendTLSPolicyRequests(
 		reqs = append(reqs, frameworkStatus.UpdateRequest{
 			NsName:       nsname,
 			ResourceType: &v1alpha3.BackendTLSPolicy{},
-			Setter:       newBackendTLSPolicyStatusSetter(status, gatewayCtlrName),
+			Setter:       newBackendTLSPolicyStatusSetter(status, gatewayCtlrName, ownsGateway),
+		})
+	}
+	return reqs
+}
+
+// PrepareBackendLBPolicyRequests prepares status UpdateRequests for the given BackendLBPolicies.
+func PrepareBackendLBPolicyRequests(
+	policies map[types.NamespacedName]*graph.BackendLBPolicy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
+
+	for nsname, pol := range policies {
+		if !pol.IsReferenced || pol.Ignored {
+			continue
+		}
+
+		conds := conditions.DeduplicateConditions(pol.Conditions)
+		apiConds := conditions.ConvertConditions(conds, pol.Source.Generation, transitionTime)
+
+		status := v1alpha2.PolicyStatus{
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: (*v1.Namespace)(&pol.Gateway.Namespace),
+						Name:      v1alpha2.ObjectName(pol.Gateway.Name),
+						Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:      helpers.GetPointer[v1.Kind](kinds.Gateway),
+					},
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+					Conditions:     apiConds,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &v1alpha2.BackendLBPolicy{},
+			Setter:       newBackendLBPolicyStatusSetter(status, gatewayCtlrName, ownsGateway),
 		})
 	}
 	return reqs
@@ -447,10 +676,196 @@ func PrepareSnippetsFilterRequests(
 	return reqs
 }
 
+// PrepareHTTPBodyTransformRequests prepares status UpdateRequests for the given HTTPBodyTransforms.
+func PrepareHTTPBodyTransformRequests(
+	bodyTransforms map[types.NamespacedName]*graph.HTTPBodyTransform,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(bodyTransforms))
+
+	for nsname, bodyTransform := range bodyTransforms {
+		allConds := make([]conditions.Condition, 0, len(bodyTransform.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, followed by the bodyTransform conditions.
+		// DeduplicateConditions will ensure the last condition wins.
+		allConds = append(allConds, staticConds.NewHTTPBodyTransformAccepted())
+		allConds = append(allConds, bodyTransform.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, bodyTransform.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.HTTPBodyTransformStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: bodyTransform.Source,
+			Setter:       newHTTPBodyTransformStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareDirectResponseRequests prepares status UpdateRequests for the given DirectResponses.
+func PrepareDirectResponseRequests(
+	directResponses map[types.NamespacedName]*graph.DirectResponse,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(directResponses))
+
+	for nsname, directResponse := range directResponses {
+		allConds := make([]conditions.Condition, 0, len(directResponse.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, followed by the directResponse conditions.
+		// DeduplicateConditions will ensure the last condition wins.
+		allConds = append(allConds, staticConds.NewDirectResponseAccepted())
+		allConds = append(allConds, directResponse.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, directResponse.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.DirectResponseStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: directResponse.Source,
+			Setter:       newDirectResponseStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareRouteTestRequests prepares status UpdateRequests for the given RouteTests.
+func PrepareRouteTestRequests(
+	routeTests map[types.NamespacedName]*graph.RouteTest,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(routeTests))
+
+	for nsname, routeTest := range routeTests {
+		results := make([]ngfAPI.RouteTestResult, 0, len(routeTest.Results))
+		var failed []string
+
+		for _, result := range routeTest.Results {
+			outcome := ngfAPI.RouteTestOutcomePassed
+			if !result.Passed {
+				outcome = ngfAPI.RouteTestOutcomeFailed
+				failed = append(failed, result.Name)
+			}
+
+			results = append(results, ngfAPI.RouteTestResult{
+				Name:    result.Name,
+				Outcome: outcome,
+				Message: result.Message,
+			})
+		}
+
+		cond := staticConds.NewRouteTestPassed()
+		if len(failed) > 0 {
+			cond = staticConds.NewRouteTestFailed(fmt.Sprintf(
+				"%d of %d tests failed: %s",
+				len(failed),
+				len(results),
+				strings.Join(failed, ", "),
+			))
+		}
+
+		apiConds := conditions.ConvertConditions(
+			[]conditions.Condition{cond},
+			routeTest.Source.GetGeneration(),
+			transitionTime,
+		)
+		status := ngfAPI.RouteTestStatus{
+			Controllers: []ngfAPI.RouteTestControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+					Results:        results,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: routeTest.Source,
+			Setter:       newRouteTestStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareNginxProxyRequests prepares status UpdateRequests for the given NginxProxies.
+// The NginxProxies that do not exist are skipped.
+func PrepareNginxProxyRequests(
+	nginxProxies map[types.NamespacedName]*graph.NginxProxy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(nginxProxies))
+
+	for nsname, np := range nginxProxies {
+		if np == nil {
+			continue
+		}
+
+		allConds := make([]conditions.Condition, 0, len(np.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, so that it will be overwritten by the NginxProxy condition
+		// if it has the same type.
+		allConds = append(allConds, staticConds.NewNginxProxyAccepted())
+		allConds = append(allConds, np.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, np.Source.GetGeneration(), transitionTime)
+
+		status := ngfAPI.NginxProxyStatus{
+			Controllers: []ngfAPI.NginxProxyControllerStatus{
+				{
+					ControllerName:  v1.GatewayController(gatewayCtlrName),
+					Conditions:      apiConds,
+					Bases:           np.Bases,
+					EffectiveValues: np.EffectiveValues,
+				},
+			},
+		}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.NginxProxy{},
+			Setter:       newNginxProxyStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
 // ControlPlaneUpdateResult describes the result of a control plane update.
 type ControlPlaneUpdateResult struct {
 	// Error is the error that occurred during the update.
 	Error error
+	// PendingChanges are the changes of the configuration that are not applied, because the configuration is
+	// in the Manual apply mode.
+	PendingChanges []string
 }
 
 // PrepareNginxGatewayStatus prepares a status UpdateRequest for the given NginxGateway.
@@ -472,6 +887,12 @@ func PrepareNginxGatewayStatus(
 		}
 	} else {
 		conds = []conditions.Condition{staticConds.NewNginxGatewayValid()}
+
+		if len(cpUpdateRes.PendingChanges) > 0 {
+			conds = append(conds, staticConds.NewNginxGatewayPending(cpUpdateRes.PendingChanges))
+		} else {
+			conds = append(conds, staticConds.NewNginxGatewayApplied())
+		}
 	}
 
 	return &frameworkStatus.UpdateRequest{
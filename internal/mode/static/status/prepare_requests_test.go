@@ -3,10 +3,12 @@ package status
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +28,7 @@ import (
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func createK8sClientFor(resourceType ngftypes.ObjectType) client.Client {
@@ -49,6 +52,10 @@ func createK8sClientFor(resourceType ngftypes.ObjectType) client.Client {
 
 const gatewayCtlrName = "controller"
 
+func ownsAllGateways(types.NamespacedName) bool {
+	return true
+}
+
 var (
 	gwNsName       = types.NamespacedName{Namespace: "test", Name: "gateway"}
 	transitionTime = helpers.PrepareTimeForFakeClient(metav1.Now())
@@ -211,6 +218,130 @@ var (
 	}
 )
 
+func TestNewGatewayOwner(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mergedGwNsName := types.NamespacedName{Namespace: "test", Name: "merged"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other"}
+
+	gr := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name}},
+		},
+		MergedGateways: map[types.NamespacedName]*graph.Gateway{
+			mergedGwNsName: {},
+		},
+	}
+
+	ownsGateway := NewGatewayOwner(gr, nil, true)
+	g.Expect(ownsGateway(gwNsName)).To(BeTrue())
+	g.Expect(ownsGateway(mergedGwNsName)).To(BeTrue())
+	g.Expect(ownsGateway(otherGwNsName)).To(BeFalse())
+
+	ownsGateway = NewGatewayOwner(gr, nil, false)
+	g.Expect(ownsGateway(otherGwNsName)).To(BeTrue())
+
+	ownsGateway = NewGatewayOwner(&graph.Graph{}, nil, true)
+	g.Expect(ownsGateway(gwNsName)).To(BeFalse())
+
+	// the Gateways of the previous graph are owned until their statuses are removed
+	ownsGateway = NewGatewayOwner(&graph.Graph{}, gr, true)
+	g.Expect(ownsGateway(gwNsName)).To(BeTrue())
+	g.Expect(ownsGateway(mergedGwNsName)).To(BeTrue())
+	g.Expect(ownsGateway(otherGwNsName)).To(BeFalse())
+}
+
+func TestDeselectedGatewayStatusesAreRemoved(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deselectedGwNsName := types.NamespacedName{Namespace: "test", Name: "deselected"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other"}
+
+	createParentStatus := func(gateway types.NamespacedName) v1.RouteParentStatus {
+		return v1.RouteParentStatus{
+			ParentRef: v1.ParentReference{
+				Namespace: helpers.GetPointer(v1.Namespace(gateway.Namespace)),
+				Name:      v1.ObjectName(gateway.Name),
+			},
+			ControllerName: gatewayCtlrName,
+		}
+	}
+
+	createRoute := func(name string) *v1.HTTPRoute {
+		return &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Status: v1.HTTPRouteStatus{
+				RouteStatus: v1.RouteStatus{
+					Parents: []v1.RouteParentStatus{
+						createParentStatus(gwNsName),
+						createParentStatus(deselectedGwNsName),
+						createParentStatus(otherGwNsName),
+					},
+				},
+			},
+		}
+	}
+
+	attachedRoute := createRoute("attached")
+	detachedRoute := createRoute("detached")
+
+	attachedKey := graph.CreateRouteKey(attachedRoute)
+	detachedKey := graph.CreateRouteKey(detachedRoute)
+
+	createGraph := func(mergedGws map[types.NamespacedName]*graph.Gateway, routeKeys ...graph.RouteKey) *graph.Graph {
+		routes := make(map[graph.RouteKey]*graph.L7Route, len(routeKeys))
+		for _, key := range routeKeys {
+			routes[key] = &graph.L7Route{RouteType: graph.RouteTypeHTTP}
+		}
+
+		return &graph.Graph{
+			Gateway: &graph.Gateway{
+				Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name}},
+			},
+			MergedGateways: mergedGws,
+			Routes:         routes,
+		}
+	}
+
+	// the detached Route only references the deselected Gateway, so it is no longer in the graph
+	prevGr := createGraph(
+		map[types.NamespacedName]*graph.Gateway{deselectedGwNsName: {}},
+		attachedKey,
+		detachedKey,
+	)
+	gr := createGraph(nil, attachedKey)
+
+	ownsGateway := NewGatewayOwner(gr, prevGr, true)
+
+	setter := newHTTPRouteStatusSetter(
+		v1.HTTPRouteStatus{
+			RouteStatus: v1.RouteStatus{Parents: []v1.RouteParentStatus{createParentStatus(gwNsName)}},
+		},
+		gatewayCtlrName,
+		ownsGateway,
+	)
+	g.Expect(setter(attachedRoute)).To(BeTrue())
+	g.Expect(attachedRoute.Status.Parents).To(ConsistOf(
+		createParentStatus(gwNsName),
+		createParentStatus(otherGwNsName),
+	))
+
+	reqs := PrepareDetachedRouteRequests(gr, prevGr, gatewayCtlrName, ownsGateway)
+	g.Expect(reqs).To(HaveLen(1))
+	g.Expect(reqs[0].NsName).To(Equal(detachedKey.NamespacedName))
+	g.Expect(reqs[0].ResourceType).To(Equal(&v1.HTTPRoute{}))
+
+	g.Expect(reqs[0].Setter(detachedRoute)).To(BeTrue())
+	g.Expect(detachedRoute.Status.Parents).To(ConsistOf(createParentStatus(otherGwNsName)))
+
+	// once the statuses are removed, the deselected Gateway is no longer owned
+	g.Expect(NewGatewayOwner(gr, gr, true)(deselectedGwNsName)).To(BeFalse())
+	g.Expect(PrepareDetachedRouteRequests(gr, gr, gatewayCtlrName, ownsGateway)).To(BeEmpty())
+	g.Expect(PrepareDetachedRouteRequests(gr, nil, gatewayCtlrName, ownsGateway)).To(BeEmpty())
+}
+
 func TestBuildHTTPRouteStatuses(t *testing.T) {
 	t.Parallel()
 	hrValid := &v1.HTTPRoute{
@@ -268,7 +399,7 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 	reqs := PrepareRouteRequests(
 		map[graph.L4RouteKey]*graph.L4Route{},
@@ -276,6 +407,8 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 		transitionTime,
 		NginxReloadResult{},
 		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
 	)
 
 	updater.Update(context.Background(), reqs...)
@@ -347,7 +480,7 @@ func TestBuildGRPCRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 	reqs := PrepareRouteRequests(
 		map[graph.L4RouteKey]*graph.L4Route{},
@@ -355,6 +488,8 @@ func TestBuildGRPCRouteStatuses(t *testing.T) {
 		transitionTime,
 		NginxReloadResult{},
 		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
 	)
 
 	updater.Update(context.Background(), reqs...)
@@ -424,7 +559,7 @@ func TestBuildTLSRouteStatuses(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 	reqs := PrepareRouteRequests(
 		routes,
@@ -432,6 +567,8 @@ func TestBuildTLSRouteStatuses(t *testing.T) {
 		transitionTime,
 		NginxReloadResult{},
 		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
 	)
 
 	updater.Update(context.Background(), reqs...)
@@ -447,6 +584,164 @@ func TestBuildTLSRouteStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildTCPRouteStatuses(t *testing.T) {
+	t.Parallel()
+	tcprValid := &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       "tcpr-valid",
+			Generation: 3,
+		},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: commonRouteSpecValid,
+		},
+	}
+	tcprInvalid := &v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       "tcpr-invalid",
+			Generation: 3,
+		},
+		Spec: v1alpha2.TCPRouteSpec{
+			CommonRouteSpec: commonRouteSpecInvalid,
+		},
+	}
+	routes := map[graph.L4RouteKey]*graph.L4Route{
+		graph.CreateRouteKeyL4(tcprValid): {
+			Valid:      true,
+			Source:     tcprValid,
+			ParentRefs: parentRefsValid,
+		},
+		graph.CreateRouteKeyL4(tcprInvalid): {
+			Valid:      false,
+			Conditions: []conditions.Condition{invalidRouteCondition},
+			Source:     tcprInvalid,
+			ParentRefs: parentRefsInvalid,
+		},
+	}
+
+	expectedStatuses := map[types.NamespacedName]v1alpha2.TCPRouteStatus{
+		{Namespace: "test", Name: "tcpr-valid"}: {
+			RouteStatus: routeStatusValid,
+		},
+		{Namespace: "test", Name: "tcpr-invalid"}: {
+			RouteStatus: routeStatusInvalid,
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.TCPRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+	reqs := PrepareRouteRequests(
+		routes,
+		map[graph.RouteKey]*graph.L7Route{},
+		transitionTime,
+		NginxReloadResult{},
+		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
+	)
+
+	updater.Update(context.Background(), reqs...)
+
+	g.Expect(reqs).To(HaveLen(len(expectedStatuses)))
+
+	for nsname, expected := range expectedStatuses {
+		var tcpr v1alpha2.TCPRoute
+
+		err := k8sClient.Get(context.Background(), nsname, &tcpr)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(expected, tcpr.Status)).To(BeEmpty())
+	}
+}
+
+func TestBuildUDPRouteStatuses(t *testing.T) {
+	t.Parallel()
+	udprValid := &v1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       "udpr-valid",
+			Generation: 3,
+		},
+		Spec: v1alpha2.UDPRouteSpec{
+			CommonRouteSpec: commonRouteSpecValid,
+		},
+	}
+	udprInvalid := &v1alpha2.UDPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "test",
+			Name:       "udpr-invalid",
+			Generation: 3,
+		},
+		Spec: v1alpha2.UDPRouteSpec{
+			CommonRouteSpec: commonRouteSpecInvalid,
+		},
+	}
+	routes := map[graph.L4RouteKey]*graph.L4Route{
+		graph.CreateRouteKeyL4(udprValid): {
+			Valid:      true,
+			Source:     udprValid,
+			ParentRefs: parentRefsValid,
+		},
+		graph.CreateRouteKeyL4(udprInvalid): {
+			Valid:      false,
+			Conditions: []conditions.Condition{invalidRouteCondition},
+			Source:     udprInvalid,
+			ParentRefs: parentRefsInvalid,
+		},
+	}
+
+	expectedStatuses := map[types.NamespacedName]v1alpha2.UDPRouteStatus{
+		{Namespace: "test", Name: "udpr-valid"}: {
+			RouteStatus: routeStatusValid,
+		},
+		{Namespace: "test", Name: "udpr-invalid"}: {
+			RouteStatus: routeStatusInvalid,
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.UDPRoute{})
+
+	for _, r := range routes {
+		err := k8sClient.Create(context.Background(), r.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+	reqs := PrepareRouteRequests(
+		routes,
+		map[graph.RouteKey]*graph.L7Route{},
+		transitionTime,
+		NginxReloadResult{},
+		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
+	)
+
+	updater.Update(context.Background(), reqs...)
+
+	g.Expect(reqs).To(HaveLen(len(expectedStatuses)))
+
+	for nsname, expected := range expectedStatuses {
+		var udpr v1alpha2.UDPRoute
+
+		err := k8sClient.Get(context.Background(), nsname, &udpr)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(expected, udpr.Status)).To(BeEmpty())
+	}
+}
+
 func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	t.Parallel()
 	const gatewayCtlrName = "controller"
@@ -528,7 +823,7 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 	}
 
-	updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 	reqs := PrepareRouteRequests(
 		map[graph.L4RouteKey]*graph.L4Route{},
@@ -536,6 +831,8 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 		transitionTime,
 		NginxReloadResult{Error: errors.New("test error")},
 		gatewayCtlrName,
+		ownsAllGateways,
+		nil,
 	)
 
 	g.Expect(reqs).To(HaveLen(1))
@@ -549,95 +846,63 @@ func TestBuildRouteStatusesNginxErr(t *testing.T) {
 	g.Expect(helpers.Diff(expectedStatus, hr.Status)).To(BeEmpty())
 }
 
-func TestBuildGatewayClassStatuses(t *testing.T) {
+func TestBuildNoReadyEndpointsMessage(t *testing.T) {
 	t.Parallel()
-	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	createBackendRef := func(name string, valid bool) graph.BackendRef {
+		return graph.BackendRef{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Valid:       valid,
+		}
+	}
+
+	summaries := map[string]resolver.EndpointSummary{
+		"test_ready_80": {
+			Ready:    map[string]int{"zone-a": 1},
+			NotReady: map[string]int{"zone-b": 1},
+		},
+		"test_not-ready_80": {
+			NotReady: map[string]int{"zone-a": 2},
+		},
+		"test_empty_80": {},
+	}
 
 	tests := []struct {
-		gc             *graph.GatewayClass
-		ignoredClasses map[types.NamespacedName]*v1.GatewayClass
-		expected       map[types.NamespacedName]v1.GatewayClassStatus
-		name           string
+		name        string
+		expected    string
+		backendRefs []graph.BackendRef
 	}{
 		{
-			name:     "nil gatewayclass and no ignored gatewayclasses",
-			expected: map[types.NamespacedName]v1.GatewayClassStatus{},
+			name:        "no backendRefs",
+			backendRefs: nil,
+			expected:    "",
 		},
 		{
-			name: "nil gatewayclass and ignored gatewayclasses",
-			ignoredClasses: map[types.NamespacedName]*v1.GatewayClass{
-				{Name: "ignored-1"}: {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-1",
-						Generation: 1,
-					},
-				},
-				{Name: "ignored-2"}: {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-2",
-						Generation: 2,
-					},
-				},
-			},
-			expected: map[types.NamespacedName]v1.GatewayClassStatus{
-				{Name: "ignored-1"}: {
-					Conditions: []metav1.Condition{
-						{
-							Type:               string(v1.GatewayClassConditionStatusAccepted),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 1,
-							LastTransitionTime: transitionTime,
-							Reason:             string(conditions.GatewayClassReasonGatewayClassConflict),
-							Message:            conditions.GatewayClassMessageGatewayClassConflict,
-						},
-					},
-				},
-				{Name: "ignored-2"}: {
-					Conditions: []metav1.Condition{
-						{
-							Type:               string(v1.GatewayClassConditionStatusAccepted),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 2,
-							LastTransitionTime: transitionTime,
-							Reason:             string(conditions.GatewayClassReasonGatewayClassConflict),
-							Message:            conditions.GatewayClassMessageGatewayClassConflict,
-						},
-					},
-				},
+			name: "all backendRefs have ready endpoints",
+			backendRefs: []graph.BackendRef{
+				createBackendRef("ready", true),
 			},
+			expected: "",
 		},
 		{
-			name: "valid gatewayclass",
-			gc: &graph.GatewayClass{
-				Source: &v1.GatewayClass{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "valid-gc",
-						Generation: 1,
-					},
-				},
+			name: "invalid and unknown backendRefs are ignored",
+			backendRefs: []graph.BackendRef{
+				createBackendRef("not-ready", false),
+				createBackendRef("unknown", true),
 			},
-			expected: map[types.NamespacedName]v1.GatewayClassStatus{
-				{Name: "valid-gc"}: {
-					Conditions: []metav1.Condition{
-						{
-							Type:               string(v1.GatewayClassConditionStatusAccepted),
-							Status:             metav1.ConditionTrue,
-							ObservedGeneration: 1,
-							LastTransitionTime: transitionTime,
-							Reason:             string(v1.GatewayClassReasonAccepted),
-							Message:            "GatewayClass is accepted",
-						},
-						{
-							Type:               string(v1.GatewayClassReasonSupportedVersion),
-							Status:             metav1.ConditionTrue,
-							ObservedGeneration: 1,
-							LastTransitionTime: transitionTime,
-							Reason:             string(v1.GatewayClassReasonSupportedVersion),
-							Message:            "Gateway API CRD versions are supported",
-						},
-					},
-				},
+			expected: "",
+		},
+		{
+			name: "backendRefs without ready endpoints",
+			backendRefs: []graph.BackendRef{
+				createBackendRef("not-ready", true),
+				createBackendRef("ready", true),
+				createBackendRef("empty", true),
+				createBackendRef("not-ready", true),
 			},
+			expected: "test_empty_80: 0 ready, 0 not ready endpoints; " +
+				"test_not-ready_80: 0 ready, 2 not ready endpoints (not ready in zones: zone-a=2)",
 		},
 	}
 
@@ -646,9 +911,245 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			k8sClient := createK8sClientFor(&v1.GatewayClass{})
+			g.Expect(buildNoReadyEndpointsMessage(test.backendRefs, summaries)).To(Equal(test.expected))
+		})
+	}
+}
 
-			var expectedTotalReqs int
+func TestPrepareRouteStatusNoReadyEndpoints(t *testing.T) {
+	t.Parallel()
+
+	parentRefs := []graph.ParentRef{
+		{
+			Gateway:     gwNsName,
+			SectionName: commonRouteSpecValid.ParentRefs[0].SectionName,
+			Attachment:  &graph.ParentRefAttachmentStatus{Attached: true},
+		},
+	}
+
+	invalidBackendRefCond := staticConds.NewRouteBackendRefRefBackendNotFound("backend not found")
+
+	tests := []struct {
+		name     string
+		conds    []conditions.Condition
+		expected conditions.Condition
+	}{
+		{
+			name:     "no ready endpoints are reported in the ResolvedRefs condition",
+			expected: staticConds.NewRouteResolvedRefsNoReadyEndpoints("test_svc_80: 0 ready, 0 not ready endpoints"),
+		},
+		{
+			name:     "unresolved references override the condition",
+			conds:    []conditions.Condition{invalidBackendRefCond},
+			expected: invalidBackendRefCond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			status := prepareRouteStatus(
+				gatewayCtlrName,
+				parentRefs,
+				test.conds,
+				NginxReloadResult{},
+				transitionTime,
+				1,
+				"test_svc_80: 0 ready, 0 not ready endpoints",
+			)
+
+			g.Expect(status.Parents).To(HaveLen(1))
+
+			expected := conditions.ConvertConditions([]conditions.Condition{test.expected}, 1, transitionTime)[0]
+			g.Expect(status.Parents[0].Conditions).To(ContainElement(expected))
+			g.Expect(status.Parents[0].Conditions).To(HaveLen(2))
+		})
+	}
+}
+
+func TestPrepareRouteStatusPerParentRef(t *testing.T) {
+	t.Parallel()
+
+	gw2NsName := types.NamespacedName{Namespace: "test", Name: "gateway-2"}
+
+	parentRefs := []graph.ParentRef{
+		{
+			Idx:         0,
+			Gateway:     gwNsName,
+			SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+			Port:        helpers.GetPointer[v1.PortNumber](80),
+			Attachment: &graph.ParentRefAttachmentStatus{
+				Attached:   true,
+				Conditions: []conditions.Condition{staticConds.NewRouteInvalidListener()},
+			},
+		},
+		{
+			Idx:     1,
+			Gateway: gw2NsName,
+			Attachment: &graph.ParentRefAttachmentStatus{
+				Attached: true,
+			},
+		},
+	}
+
+	routeConds := []conditions.Condition{
+		staticConds.NewRouteBackendRefRefBackendNotFound("backend not found"),
+	}
+
+	expected := v1.RouteStatus{
+		Parents: []v1.RouteParentStatus{
+			{
+				ParentRef: v1.ParentReference{
+					Namespace:   helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+					Name:        v1.ObjectName(gwNsName.Name),
+					SectionName: helpers.GetPointer[v1.SectionName]("listener-80-1"),
+					Port:        helpers.GetPointer[v1.PortNumber](80),
+				},
+				ControllerName: gatewayCtlrName,
+				Conditions: conditions.ConvertConditions(
+					[]conditions.Condition{
+						staticConds.NewRouteBackendRefRefBackendNotFound("backend not found"),
+						staticConds.NewRouteInvalidListener(),
+					},
+					3,
+					transitionTime,
+				),
+			},
+			{
+				ParentRef: v1.ParentReference{
+					Namespace: helpers.GetPointer(v1.Namespace(gw2NsName.Namespace)),
+					Name:      v1.ObjectName(gw2NsName.Name),
+				},
+				ControllerName: gatewayCtlrName,
+				Conditions: conditions.ConvertConditions(
+					[]conditions.Condition{
+						staticConds.NewRouteAccepted(),
+						staticConds.NewRouteBackendRefRefBackendNotFound("backend not found"),
+					},
+					3,
+					transitionTime,
+				),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	status := prepareRouteStatus(
+		gatewayCtlrName,
+		parentRefs,
+		routeConds,
+		NginxReloadResult{},
+		transitionTime,
+		3,
+		"",
+	)
+
+	g.Expect(helpers.Diff(expected, status)).To(BeEmpty())
+}
+
+func TestBuildGatewayClassStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	tests := []struct {
+		gc             *graph.GatewayClass
+		ignoredClasses map[types.NamespacedName]*v1.GatewayClass
+		expected       map[types.NamespacedName]v1.GatewayClassStatus
+		name           string
+	}{
+		{
+			name:     "nil gatewayclass and no ignored gatewayclasses",
+			expected: map[types.NamespacedName]v1.GatewayClassStatus{},
+		},
+		{
+			name: "nil gatewayclass and ignored gatewayclasses",
+			ignoredClasses: map[types.NamespacedName]*v1.GatewayClass{
+				{Name: "ignored-1"}: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "ignored-1",
+						Generation: 1,
+					},
+				},
+				{Name: "ignored-2"}: {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "ignored-2",
+						Generation: 2,
+					},
+				},
+			},
+			expected: map[types.NamespacedName]v1.GatewayClassStatus{
+				{Name: "ignored-1"}: {
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayClassConditionStatusAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayClassReasonGatewayClassConflict),
+							Message:            conditions.GatewayClassMessageGatewayClassConflict,
+						},
+					},
+				},
+				{Name: "ignored-2"}: {
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayClassConditionStatusAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayClassReasonGatewayClassConflict),
+							Message:            conditions.GatewayClassMessageGatewayClassConflict,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gatewayclass",
+			gc: &graph.GatewayClass{
+				Source: &v1.GatewayClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "valid-gc",
+						Generation: 1,
+					},
+				},
+			},
+			expected: map[types.NamespacedName]v1.GatewayClassStatus{
+				{Name: "valid-gc"}: {
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayClassConditionStatusAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayClassReasonAccepted),
+							Message:            "GatewayClass is accepted",
+						},
+						{
+							Type:               string(v1.GatewayClassReasonSupportedVersion),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayClassReasonSupportedVersion),
+							Message:            "Gateway API CRD versions are supported",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			k8sClient := createK8sClientFor(&v1.GatewayClass{})
+
+			var expectedTotalReqs int
 
 			if test.gc != nil {
 				err := k8sClient.Create(context.Background(), test.gc.Source)
@@ -662,7 +1163,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 				expectedTotalReqs++
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 			reqs := PrepareGatewayClassRequests(test.gc, test.ignoredClasses, transitionTime)
 
@@ -730,6 +1231,25 @@ func TestBuildGatewayStatuses(t *testing.T) {
 		},
 	}
 
+	validGatewayConditions := []metav1.Condition{
+		{
+			Type:               string(v1.GatewayConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+			LastTransitionTime: transitionTime,
+			Reason:             string(v1.GatewayReasonAccepted),
+			Message:            "Gateway is accepted",
+		},
+		{
+			Type:               string(v1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+			LastTransitionTime: transitionTime,
+			Reason:             string(v1.GatewayReasonProgrammed),
+			Message:            "Gateway is programmed",
+		},
+	}
+
 	addr := []v1.GatewayStatusAddress{
 		{
 			Type:  helpers.GetPointer(v1.IPAddressType),
@@ -740,89 +1260,80 @@ func TestBuildGatewayStatuses(t *testing.T) {
 	routeKey := graph.RouteKey{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}
 
 	tests := []struct {
-		nginxReloadRes  NginxReloadResult
-		gateway         *graph.Gateway
-		ignoredGateways map[types.NamespacedName]*v1.Gateway
-		expected        map[types.NamespacedName]v1.GatewayStatus
-		name            string
+		nginxReloadRes NginxReloadResult
+		gateway        *graph.Gateway
+		mergedGateways map[types.NamespacedName]*graph.Gateway
+		expected       map[types.NamespacedName]v1.GatewayStatus
+		name           string
 	}{
 		{
-			name:     "nil gateway and no ignored gateways",
+			name:     "nil gateway and no merged gateways",
 			expected: map[types.NamespacedName]v1.GatewayStatus{},
 		},
 		{
-			name: "nil gateway and ignored gateways",
-			ignoredGateways: map[types.NamespacedName]*v1.Gateway{
-				{Namespace: "test", Name: "ignored-1"}: {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-1",
-						Namespace:  "test",
-						Generation: 1,
-					},
-				},
-				{Namespace: "test", Name: "ignored-2"}: {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-2",
-						Namespace:  "test",
-						Generation: 2,
+			name: "valid gateway and merged gateway",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 					},
 				},
+				Valid: true,
 			},
-			expected: map[types.NamespacedName]v1.GatewayStatus{
-				{Namespace: "test", Name: "ignored-1"}: {
-					Conditions: []metav1.Condition{
-						{
-							Type:               string(v1.GatewayConditionAccepted),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 1,
-							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+			mergedGateways: map[types.NamespacedName]*graph.Gateway{
+				{Namespace: "test", Name: "merged"}: {
+					Source: &v1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:  "test",
+							Name:       "merged",
+							Generation: 2,
 						},
+					},
+					Listeners: []*graph.Listener{
 						{
-							Type:               string(v1.GatewayConditionProgrammed),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 1,
-							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Name:   "listener-valid",
+							Valid:  true,
+							Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 						},
 					},
+					Valid: true,
 				},
-				{Namespace: "test", Name: "ignored-2"}: {
-					Conditions: []metav1.Condition{
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses:  addr,
+					Conditions: validGatewayConditions,
+					Listeners: []v1.ListenerStatus{
 						{
-							Type:               string(v1.GatewayConditionAccepted),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 2,
-							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
 						},
+					},
+				},
+				{Namespace: "test", Name: "merged"}: {
+					Addresses:  addr,
+					Conditions: validGatewayConditions,
+					Listeners: []v1.ListenerStatus{
 						{
-							Type:               string(v1.GatewayConditionProgrammed),
-							Status:             metav1.ConditionFalse,
-							ObservedGeneration: 2,
-							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "valid gateway; all valid listeners",
+			name: "valid gateway; NGINX Plus API fallback",
 			gateway: &graph.Gateway{
 				Source: createGateway(),
 				Listeners: []*graph.Listener{
 					{
-						Name:   "listener-valid-1",
-						Valid:  true,
-						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
-					},
-					{
-						Name:   "listener-valid-2",
+						Name:   "listener-valid",
 						Valid:  true,
 						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 					},
@@ -832,17 +1343,222 @@ func TestBuildGatewayStatuses(t *testing.T) {
 			expected: map[types.NamespacedName]v1.GatewayStatus{
 				{Namespace: "test", Name: "gateway"}: {
 					Addresses: addr,
-					Conditions: []metav1.Condition{
-						{
-							Type:               string(v1.GatewayConditionAccepted),
+					Conditions: append(
+						slices.Clone(validGatewayConditions),
+						metav1.Condition{
+							Type:               string(staticConds.GatewayPlusAPIDegraded),
 							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 2,
 							LastTransitionTime: transitionTime,
-							Reason:             string(v1.GatewayReasonAccepted),
-							Message:            "Gateway is accepted",
+							Reason:             string(staticConds.GatewayReasonPlusAPIUpdateFailed),
+							Message: "The NGINX Plus API repeatedly failed to update the upstream servers; " +
+								"the upstream servers are updated by reloading NGINX instead",
 						},
+					),
+					Listeners: []v1.ListenerStatus{
 						{
-							Type:               string(v1.GatewayConditionProgrammed),
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+			nginxReloadRes: NginxReloadResult{PlusAPIFallback: true},
+		},
+		{
+			name: "valid gateway; smoke test failed",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: append(
+						slices.Clone(validGatewayConditions),
+						metav1.Condition{
+							Type:               string(staticConds.GatewaySmokeTestFailed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonProbesFailed),
+							Message: "Smoke test probes failed after the NGINX configuration was updated: " +
+								"probe cafe: expected status 200, got 502; probe tea: missing header X-Version",
+						},
+					),
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+			nginxReloadRes: NginxReloadResult{
+				SmokeTestFailures: []string{
+					"probe cafe: expected status 200, got 502",
+					"probe tea: missing header X-Version",
+				},
+			},
+		},
+		{
+			name: "valid gateway; listener with certificate that doesn't cover its hostname",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+						Conditions: []conditions.Condition{
+							staticConds.NewListenerCertificateMismatch("certificate mismatch"),
+						},
+						CertificateMismatch: true,
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses:  addr,
+					Conditions: validGatewayConditions,
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions: append(
+								slices.Clone(validListenerConditions),
+								metav1.Condition{
+									Type:               string(staticConds.ListenerConditionCertificateMismatch),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(staticConds.ListenerReasonHostnameNotInCertificate),
+									Message:            "certificate mismatch",
+								},
+							),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; resolved parametersRef",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewGatewayResolvedRefs()},
+				Valid:      true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: append(
+						validGatewayConditions,
+						metav1.Condition{
+							Type:               string(staticConds.GatewayResolvedRefs),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonResolvedRefs),
+							Message:            "parametersRef resource is resolved",
+						},
+					),
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; invalid parametersRef",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewGatewayInvalidParameters("error")},
+				Valid:      true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						validGatewayConditions[1],
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonInvalidParameters),
+							Message:            "Gateway is accepted, but parametersRef is ignored due to an error: error",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; all valid listeners",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+					{
+						Name:   "listener-valid-2",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
 							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 2,
 							LastTransitionTime: transitionTime,
@@ -1055,6 +1771,53 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid gateway; requested address not assigned",
+			gateway: &graph.Gateway{
+				Source: func() *v1.Gateway {
+					gw := createGateway()
+					gw.Spec.Addresses = []v1.GatewayAddress{{Value: "1.2.3.4"}, {Value: "5.6.7.8"}}
+					return gw
+				}(),
+				Listeners: []*graph.Listener{
+					{
+						Name:  "listener-valid",
+						Valid: true,
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAddressNotUsable),
+							Message:            "Requested addresses are not assigned to the Service of the Gateway: 5.6.7.8",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 0,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "error reloading nginx; gateway/listener not programmed",
 			gateway: &graph.Gateway{
@@ -1152,17 +1915,18 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				expectedTotalReqs++
 			}
 
-			for _, gw := range test.ignoredGateways {
-				err := k8sClient.Create(context.Background(), gw)
+			for _, gw := range test.mergedGateways {
+				gw.Source.ResourceVersion = ""
+				err := k8sClient.Create(context.Background(), gw.Source)
 				g.Expect(err).ToNot(HaveOccurred())
 				expectedTotalReqs++
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 			reqs := PrepareGatewayRequests(
 				test.gateway,
-				test.ignoredGateways,
+				test.mergedGateways,
 				transitionTime,
 				addr,
 				test.nginxReloadRes,
@@ -1376,9 +2140,14 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
-			reqs := PrepareBackendTLSPolicyRequests(test.backendTLSPolicies, transitionTime, gatewayCtlrName)
+			reqs := PrepareBackendTLSPolicyRequests(
+				test.backendTLSPolicies,
+				transitionTime,
+				gatewayCtlrName,
+				ownsAllGateways,
+			)
 
 			g.Expect(reqs).To(HaveLen(test.expectedReqs))
 
@@ -1395,6 +2164,86 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildBackendLBPolicyStatuses(t *testing.T) {
+	t.Parallel()
+	const gatewayCtlrName = "controller"
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	getBackendLBPolicy := func(name string, isReferenced bool) *graph.BackendLBPolicy {
+		return &graph.BackendLBPolicy{
+			Source: &v1alpha2.BackendLBPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "test",
+					Name:       name,
+					Generation: 1,
+				},
+			},
+			Valid:        true,
+			IsReferenced: isReferenced,
+			Conditions:   []conditions.Condition{staticConds.NewPolicyAccepted()},
+			Gateway:      types.NamespacedName{Name: "gateway", Namespace: "test"},
+		}
+	}
+
+	backendLBPolicies := map[types.NamespacedName]*graph.BackendLBPolicy{
+		{Namespace: "test", Name: "valid-lb"}:       getBackendLBPolicy("valid-lb", true),
+		{Namespace: "test", Name: "not-referenced"}: getBackendLBPolicy("not-referenced", false),
+	}
+
+	expected := map[types.NamespacedName]v1alpha2.PolicyStatus{
+		{Name: "not-referenced", Namespace: "test"}: {},
+		{Name: "valid-lb", Namespace: "test"}: {
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef: v1.ParentReference{
+						Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						Name:      "gateway",
+						Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:      helpers.GetPointer[v1.Kind](kinds.Gateway),
+					},
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1alpha2.PolicyReasonAccepted),
+							Message:            "Policy is accepted",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.BackendLBPolicy{})
+
+	for _, pol := range backendLBPolicies {
+		err := k8sClient.Create(context.Background(), pol.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+	reqs := PrepareBackendLBPolicyRequests(backendLBPolicies, transitionTime, gatewayCtlrName, ownsAllGateways)
+
+	g.Expect(reqs).To(HaveLen(1))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, expected := range expected {
+		var pol v1alpha2.BackendLBPolicy
+
+		err := k8sClient.Get(context.Background(), nsname, &pol)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(expected, pol.Status)).To(BeEmpty())
+	}
+}
+
 func TestBuildNginxGatewayStatus(t *testing.T) {
 	t.Parallel()
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
@@ -1428,11 +2277,19 @@ func TestBuildNginxGatewayStatus(t *testing.T) {
 						Reason:             string(ngfAPI.NginxGatewayReasonValid),
 						Message:            "NginxGateway is valid",
 					},
+					{
+						Type:               string(ngfAPI.NginxGatewayConditionApplied),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 3,
+						LastTransitionTime: transitionTime,
+						Reason:             string(ngfAPI.NginxGatewayReasonApplied),
+						Message:            "NginxGateway is applied",
+					},
 				},
 			},
 		},
 		{
-			name: "NginxGateway with update error",
+			name: "NginxGateway with pending changes",
 			nginxGateway: &ngfAPI.NginxGateway{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:       "nginx-gateway",
@@ -1441,13 +2298,46 @@ func TestBuildNginxGatewayStatus(t *testing.T) {
 				},
 			},
 			cpUpdateResult: ControlPlaneUpdateResult{
-				Error: errors.New("test error"),
+				PendingChanges: []string{"logging.level: info -> debug"},
 			},
 			expected: &ngfAPI.NginxGatewayStatus{
 				Conditions: []metav1.Condition{
 					{
 						Type:               string(ngfAPI.NginxGatewayConditionValid),
-						Status:             metav1.ConditionFalse,
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 3,
+						LastTransitionTime: transitionTime,
+						Reason:             string(ngfAPI.NginxGatewayReasonValid),
+						Message:            "NginxGateway is valid",
+					},
+					{
+						Type:               string(ngfAPI.NginxGatewayConditionApplied),
+						Status:             metav1.ConditionFalse,
+						ObservedGeneration: 3,
+						LastTransitionTime: transitionTime,
+						Reason:             string(ngfAPI.NginxGatewayReasonPending),
+						Message:            "NginxGateway changes are pending: logging.level: info -> debug",
+					},
+				},
+			},
+		},
+		{
+			name: "NginxGateway with update error",
+			nginxGateway: &ngfAPI.NginxGateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "nginx-gateway",
+					Namespace:  "test",
+					Generation: 3,
+				},
+			},
+			cpUpdateResult: ControlPlaneUpdateResult{
+				Error: errors.New("test error"),
+			},
+			expected: &ngfAPI.NginxGatewayStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(ngfAPI.NginxGatewayConditionValid),
+						Status:             metav1.ConditionFalse,
 						ObservedGeneration: 3,
 						LastTransitionTime: transitionTime,
 						Reason:             string(ngfAPI.NginxGatewayReasonInvalid),
@@ -1470,7 +2360,7 @@ func TestBuildNginxGatewayStatus(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 			req := PrepareNginxGatewayStatus(test.nginxGateway, transitionTime, test.cpUpdateResult)
 
@@ -1764,9 +2654,9 @@ func TestBuildNGFPolicyStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
-			reqs := PrepareNGFPolicyRequests(test.policies, transitionTime, gatewayCtlrName)
+			reqs := PrepareNGFPolicyRequests(test.policies, transitionTime, gatewayCtlrName, ownsAllGateways)
 
 			g.Expect(reqs).To(HaveLen(len(test.expected)))
 
@@ -1894,7 +2784,7 @@ func TestBuildSnippetsFilterStatuses(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 			}
 
-			updater := statusFramework.NewUpdater(k8sClient, logr.Discard())
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
 
 			reqs := PrepareSnippetsFilterRequests(test.snippetsFilters, transitionTime, gatewayCtlrName)
 
@@ -1912,3 +2802,458 @@ func TestBuildSnippetsFilterStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildHTTPBodyTransformStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+	const gatewayCtlrName = "controller"
+
+	createBodyTransform := func(name string, conds ...conditions.Condition) *graph.HTTPBodyTransform {
+		return &graph.HTTPBodyTransform{
+			Source: &ngfAPI.HTTPBodyTransform{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  "test",
+					Generation: 1,
+				},
+			},
+			Conditions: conds,
+			Valid:      len(conds) == 0,
+		}
+	}
+
+	createStatus := func(status metav1.ConditionStatus, reason, msg string) ngfAPI.HTTPBodyTransformStatus {
+		return ngfAPI.HTTPBodyTransformStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.HTTPBodyTransformConditionTypeAccepted),
+							Status:             status,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             reason,
+							Message:            msg,
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		bodyTransforms map[types.NamespacedName]*graph.HTTPBodyTransform
+		expected       map[types.NamespacedName]ngfAPI.HTTPBodyTransformStatus
+		name           string
+		expectedReqs   int
+	}{
+		{
+			name:         "nil bodyTransforms",
+			expectedReqs: 0,
+			expected:     map[types.NamespacedName]ngfAPI.HTTPBodyTransformStatus{},
+		},
+		{
+			name: "valid and invalid bodyTransforms",
+			bodyTransforms: map[types.NamespacedName]*graph.HTTPBodyTransform{
+				{Namespace: "test", Name: "valid"}: createBodyTransform("valid"),
+				{Namespace: "test", Name: "invalid"}: createBodyTransform(
+					"invalid",
+					staticConds.NewHTTPBodyTransformInvalid("invalid script"),
+				),
+			},
+			expectedReqs: 2,
+			expected: map[types.NamespacedName]ngfAPI.HTTPBodyTransformStatus{
+				{Namespace: "test", Name: "valid"}: createStatus(
+					metav1.ConditionTrue,
+					string(ngfAPI.HTTPBodyTransformConditionReasonAccepted),
+					"HTTPBodyTransform is accepted",
+				),
+				{Namespace: "test", Name: "invalid"}: createStatus(
+					metav1.ConditionFalse,
+					string(ngfAPI.HTTPBodyTransformConditionReasonInvalid),
+					"invalid script",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			k8sClient := createK8sClientFor(&ngfAPI.HTTPBodyTransform{})
+
+			for _, bodyTransform := range test.bodyTransforms {
+				err := k8sClient.Create(context.Background(), bodyTransform.Source)
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+			reqs := PrepareHTTPBodyTransformRequests(test.bodyTransforms, transitionTime, gatewayCtlrName)
+
+			g.Expect(reqs).To(HaveLen(test.expectedReqs))
+
+			updater.Update(context.Background(), reqs...)
+
+			for nsname, expected := range test.expected {
+				var bodyTransform ngfAPI.HTTPBodyTransform
+
+				err := k8sClient.Get(context.Background(), nsname, &bodyTransform)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(helpers.Diff(expected, bodyTransform.Status)).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestBuildDirectResponseStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+	const gatewayCtlrName = "controller"
+
+	createDirectResponse := func(name string, conds ...conditions.Condition) *graph.DirectResponse {
+		return &graph.DirectResponse{
+			Source: &ngfAPI.DirectResponse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  "test",
+					Generation: 1,
+				},
+			},
+			Conditions: conds,
+			Valid:      len(conds) == 0,
+		}
+	}
+
+	createStatus := func(status metav1.ConditionStatus, reason, msg string) ngfAPI.DirectResponseStatus {
+		return ngfAPI.DirectResponseStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.DirectResponseConditionTypeAccepted),
+							Status:             status,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             reason,
+							Message:            msg,
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		directResponses map[types.NamespacedName]*graph.DirectResponse
+		expected        map[types.NamespacedName]ngfAPI.DirectResponseStatus
+		name            string
+		expectedReqs    int
+	}{
+		{
+			name:         "nil directResponses",
+			expectedReqs: 0,
+			expected:     map[types.NamespacedName]ngfAPI.DirectResponseStatus{},
+		},
+		{
+			name: "valid and invalid directResponses",
+			directResponses: map[types.NamespacedName]*graph.DirectResponse{
+				{Namespace: "test", Name: "valid"}: createDirectResponse("valid"),
+				{Namespace: "test", Name: "invalid"}: createDirectResponse(
+					"invalid",
+					staticConds.NewDirectResponseInvalid("invalid status code"),
+				),
+			},
+			expectedReqs: 2,
+			expected: map[types.NamespacedName]ngfAPI.DirectResponseStatus{
+				{Namespace: "test", Name: "valid"}: createStatus(
+					metav1.ConditionTrue,
+					string(ngfAPI.DirectResponseConditionReasonAccepted),
+					"DirectResponse is accepted",
+				),
+				{Namespace: "test", Name: "invalid"}: createStatus(
+					metav1.ConditionFalse,
+					string(ngfAPI.DirectResponseConditionReasonInvalid),
+					"invalid status code",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			k8sClient := createK8sClientFor(&ngfAPI.DirectResponse{})
+
+			for _, directResponse := range test.directResponses {
+				err := k8sClient.Create(context.Background(), directResponse.Source)
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+			reqs := PrepareDirectResponseRequests(test.directResponses, transitionTime, gatewayCtlrName)
+
+			g.Expect(reqs).To(HaveLen(test.expectedReqs))
+
+			updater.Update(context.Background(), reqs...)
+
+			for nsname, expected := range test.expected {
+				var directResponse ngfAPI.DirectResponse
+
+				err := k8sClient.Get(context.Background(), nsname, &directResponse)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(helpers.Diff(expected, directResponse.Status)).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestBuildRouteTestStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+	const gatewayCtlrName = "controller"
+
+	createRouteTest := func(name string, results ...graph.RouteTestResult) *graph.RouteTest {
+		return &graph.RouteTest{
+			Source: &ngfAPI.RouteTest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  "test",
+					Generation: 1,
+				},
+			},
+			Results: results,
+		}
+	}
+
+	passedRouteTest := createRouteTest(
+		"passed",
+		graph.RouteTestResult{
+			Name:    "coffee",
+			Message: "The request matched HTTPRoute test/coffee spec.rules[0]",
+			Passed:  true,
+		},
+	)
+
+	failedRouteTest := createRouteTest(
+		"failed",
+		graph.RouteTestResult{Name: "coffee", Message: "No Route matched the request", Passed: true},
+		graph.RouteTestResult{Name: "tea", Message: "No Route matched the request, but a Route was expected to match it"},
+	)
+
+	tests := []struct {
+		routeTests   map[types.NamespacedName]*graph.RouteTest
+		expected     map[types.NamespacedName]ngfAPI.RouteTestStatus
+		name         string
+		expectedReqs int
+	}{
+		{
+			name:         "nil routeTests",
+			expectedReqs: 0,
+			expected:     map[types.NamespacedName]ngfAPI.RouteTestStatus{},
+		},
+		{
+			name: "passed and failed routeTests",
+			routeTests: map[types.NamespacedName]*graph.RouteTest{
+				{Namespace: "test", Name: "passed"}: passedRouteTest,
+				{Namespace: "test", Name: "failed"}: failedRouteTest,
+			},
+			expectedReqs: 2,
+			expected: map[types.NamespacedName]ngfAPI.RouteTestStatus{
+				{Namespace: "test", Name: "passed"}: {
+					Controllers: []ngfAPI.RouteTestControllerStatus{
+						{
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(ngfAPI.RouteTestConditionTypePassed),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 1,
+									LastTransitionTime: transitionTime,
+									Reason:             string(ngfAPI.RouteTestConditionReasonPassed),
+									Message:            "All tests passed",
+								},
+							},
+							ControllerName: gatewayCtlrName,
+							Results: []ngfAPI.RouteTestResult{
+								{
+									Name:    "coffee",
+									Outcome: ngfAPI.RouteTestOutcomePassed,
+									Message: "The request matched HTTPRoute test/coffee spec.rules[0]",
+								},
+							},
+						},
+					},
+				},
+				{Namespace: "test", Name: "failed"}: {
+					Controllers: []ngfAPI.RouteTestControllerStatus{
+						{
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(ngfAPI.RouteTestConditionTypePassed),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 1,
+									LastTransitionTime: transitionTime,
+									Reason:             string(ngfAPI.RouteTestConditionReasonFailed),
+									Message:            "1 of 2 tests failed: tea",
+								},
+							},
+							ControllerName: gatewayCtlrName,
+							Results: []ngfAPI.RouteTestResult{
+								{
+									Name:    "coffee",
+									Outcome: ngfAPI.RouteTestOutcomePassed,
+									Message: "No Route matched the request",
+								},
+								{
+									Name:    "tea",
+									Outcome: ngfAPI.RouteTestOutcomeFailed,
+									Message: "No Route matched the request, but a Route was expected to match it",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			k8sClient := createK8sClientFor(&ngfAPI.RouteTest{})
+
+			for _, routeTest := range test.routeTests {
+				err := k8sClient.Create(context.Background(), routeTest.Source)
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+			reqs := PrepareRouteTestRequests(test.routeTests, transitionTime, gatewayCtlrName)
+
+			g.Expect(reqs).To(HaveLen(test.expectedReqs))
+
+			updater.Update(context.Background(), reqs...)
+
+			for nsname, expected := range test.expected {
+				var routeTest ngfAPI.RouteTest
+
+				err := k8sClient.Get(context.Background(), nsname, &routeTest)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(helpers.Diff(expected, routeTest.Status)).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestBuildNginxProxyStatuses(t *testing.T) {
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+	const gatewayCtlrName = "controller"
+
+	createNginxProxy := func(name string) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 2,
+			},
+		}
+	}
+
+	nginxProxies := map[types.NamespacedName]*graph.NginxProxy{
+		{Name: "gateway"}: {
+			Source: createNginxProxy("gateway"),
+			Bases:  []string{"platform"},
+			EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+				{Field: "ipFamily", Source: "platform"},
+				{Field: "logging", Source: "gateway"},
+			},
+			Valid: true,
+		},
+		{Name: "platform"}: {
+			Source: createNginxProxy("platform"),
+			Bases:  []string{"missing"},
+			EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+				{Field: "ipFamily", Source: "platform"},
+			},
+			Conditions: []conditions.Condition{
+				staticConds.NewNginxProxyBaseNotFound(`spec.baseRef.name: Not found: "missing"`),
+			},
+		},
+		{Name: "missing"}: nil,
+	}
+
+	expected := map[types.NamespacedName]ngfAPI.NginxProxyStatus{
+		{Name: "gateway"}: {
+			Controllers: []ngfAPI.NginxProxyControllerStatus{
+				{
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.NginxProxyConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.NginxProxyConditionReasonAccepted),
+							Message:            "NginxProxy is accepted",
+						},
+					},
+					Bases: []string{"platform"},
+					EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+						{Field: "ipFamily", Source: "platform"},
+						{Field: "logging", Source: "gateway"},
+					},
+				},
+			},
+		},
+		{Name: "platform"}: {
+			Controllers: []ngfAPI.NginxProxyControllerStatus{
+				{
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.NginxProxyConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.NginxProxyConditionReasonBaseNotFound),
+							Message:            `spec.baseRef.name: Not found: "missing"`,
+						},
+					},
+					Bases: []string{"missing"},
+					EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{
+						{Field: "ipFamily", Source: "platform"},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&ngfAPI.NginxProxy{})
+
+	for _, np := range nginxProxies {
+		if np != nil {
+			g.Expect(k8sClient.Create(context.Background(), np.Source)).To(Succeed())
+		}
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, logr.Discard(), statusFramework.NewNoopMetricsCollector())
+
+	g.Expect(PrepareNginxProxyRequests(nil, transitionTime, gatewayCtlrName)).To(BeEmpty())
+
+	reqs := PrepareNginxProxyRequests(nginxProxies, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, expected := range expected {
+		var np ngfAPI.NginxProxy
+
+		g.Expect(k8sClient.Get(context.Background(), nsname, &np)).To(Succeed())
+		g.Expect(helpers.Diff(expected, np.Status)).To(BeEmpty())
+	}
+}
@@ -1,8 +1,10 @@
 package status
 
 import (
+	"fmt"
 	"slices"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -10,6 +12,7 @@ import (
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 	frameworkStatus "github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 )
@@ -80,13 +83,17 @@ func gwStatusEqual(prev, cur gatewayv1.GatewayStatus) bool {
 	})
 }
 
-func newHTTPRouteStatusSetter(status gatewayv1.HTTPRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+func newHTTPRouteStatusSetter(
+	status gatewayv1.HTTPRouteStatus,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) frameworkStatus.Setter {
 	return func(object client.Object) (wasSet bool) {
 		hr := helpers.MustCastObject[*gatewayv1.HTTPRoute](object)
 
-		// keep all the parent statuses that belong to other controllers
+		// keep all the parent statuses that belong to other controllers or other instances of this controller
 		for _, os := range hr.Status.Parents {
-			if string(os.ControllerName) != gatewayCtlrName {
+			if !ownsRouteParentStatus(os, hr.Namespace, gatewayCtlrName, ownsGateway) {
 				status.Parents = append(status.Parents, os)
 			}
 		}
@@ -101,34 +108,58 @@ func newHTTPRouteStatusSetter(status gatewayv1.HTTPRouteStatus, gatewayCtlrName
 	}
 }
 
-func newTLSRouteStatusSetter(status v1alpha2.TLSRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+// newL4RouteStatusSetter returns the status setter of a TLSRoute, a TCPRoute, or a UDPRoute. The status types
+// of the L4 Routes only differ in their names, so the same status is set on any of them.
+func newL4RouteStatusSetter(
+	status v1alpha2.RouteStatus,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) frameworkStatus.Setter {
 	return func(object client.Object) (wasSet bool) {
-		tr := helpers.MustCastObject[*v1alpha2.TLSRoute](object)
+		routeStatus := getL4RouteStatus(object)
 
-		// keep all the parent statuses that belong to other controllers
-		for _, os := range tr.Status.Parents {
-			if string(os.ControllerName) != gatewayCtlrName {
+		// keep all the parent statuses that belong to other controllers or other instances of this controller
+		for _, os := range routeStatus.Parents {
+			if !ownsRouteParentStatus(os, object.GetNamespace(), gatewayCtlrName, ownsGateway) {
 				status.Parents = append(status.Parents, os)
 			}
 		}
 
-		if routeStatusEqual(gatewayCtlrName, tr.Status.Parents, status.Parents) {
+		if routeStatusEqual(gatewayCtlrName, routeStatus.Parents, status.Parents) {
 			return false
 		}
 
-		tr.Status = status
+		*routeStatus = status
 
 		return true
 	}
 }
 
-func newGRPCRouteStatusSetter(status gatewayv1.GRPCRouteStatus, gatewayCtlrName string) frameworkStatus.Setter {
+// getL4RouteStatus returns the status of a TLSRoute, a TCPRoute, or a UDPRoute.
+func getL4RouteStatus(object client.Object) *v1alpha2.RouteStatus {
+	switch r := object.(type) {
+	case *v1alpha2.TLSRoute:
+		return &r.Status.RouteStatus
+	case *v1alpha2.TCPRoute:
+		return &r.Status.RouteStatus
+	case *v1alpha2.UDPRoute:
+		return &r.Status.RouteStatus
+	default:
+		panic(fmt.Sprintf("unsupported L4 Route type %T", object))
+	}
+}
+
+func newGRPCRouteStatusSetter(
+	status gatewayv1.GRPCRouteStatus,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) frameworkStatus.Setter {
 	return func(object client.Object) (wasSet bool) {
 		gr := helpers.MustCastObject[*gatewayv1.GRPCRoute](object)
 
-		// keep all the parent statuses that belong to other controllers
+		// keep all the parent statuses that belong to other controllers or other instances of this controller
 		for _, os := range gr.Status.Parents {
-			if string(os.ControllerName) != gatewayCtlrName {
+			if !ownsRouteParentStatus(os, gr.Namespace, gatewayCtlrName, ownsGateway) {
 				status.Parents = append(status.Parents, os)
 			}
 		}
@@ -143,6 +174,25 @@ func newGRPCRouteStatusSetter(status gatewayv1.GRPCRouteStatus, gatewayCtlrName
 	}
 }
 
+// ownsRouteParentStatus returns true if the parent status of a Route was written by this instance of the controller.
+func ownsRouteParentStatus(
+	parentStatus gatewayv1.RouteParentStatus,
+	routeNamespace string,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) bool {
+	if string(parentStatus.ControllerName) != gatewayCtlrName {
+		return false
+	}
+
+	namespace := routeNamespace
+	if parentStatus.ParentRef.Namespace != nil {
+		namespace = string(*parentStatus.ParentRef.Namespace)
+	}
+
+	return ownsGateway(types.NamespacedName{Namespace: namespace, Name: string(parentStatus.ParentRef.Name)})
+}
+
 func routeStatusEqual(gatewayCtlrName string, prevParents, curParents []gatewayv1.RouteParentStatus) bool {
 	// Since other controllers may update HTTPRoute status we can't assume anything about the order of the statuses,
 	// and we have to ignore statuses written by other controllers when checking for equality.
@@ -194,6 +244,10 @@ func routeParentStatusEqual(p1, p2 gatewayv1.RouteParentStatus) bool {
 		return false
 	}
 
+	if !helpers.EqualPointers(p1.ParentRef.Port, p2.ParentRef.Port) {
+		return false
+	}
+
 	// we ignore the rest of the ParentRef fields because we do not set them
 
 	return frameworkStatus.ConditionsEqual(p1.Conditions, p2.Conditions)
@@ -215,6 +269,7 @@ func newGatewayClassStatusSetter(status gatewayv1.GatewayClassStatus) frameworkS
 func newBackendTLSPolicyStatusSetter(
 	status v1alpha2.PolicyStatus,
 	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
 ) frameworkStatus.Setter {
 	return func(object client.Object) (wasSet bool) {
 		btp := helpers.MustCastObject[*v1alpha3.BackendTLSPolicy](object)
@@ -224,9 +279,9 @@ func newBackendTLSPolicyStatusSetter(
 		maxAncestors := 1 + len(btp.Status.Ancestors)
 		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
 
-		// keep all the ancestor statuses that belong to other controllers
+		// keep all the ancestor statuses that belong to other controllers or other instances of this controller
 		for _, os := range btp.Status.Ancestors {
-			if string(os.ControllerName) != gatewayCtlrName {
+			if !ownsAncestorStatus(os, btp.Namespace, gatewayCtlrName, ownsGateway) {
 				ancestors = append(ancestors, os)
 			}
 		}
@@ -243,9 +298,42 @@ func newBackendTLSPolicyStatusSetter(
 	}
 }
 
+func newBackendLBPolicyStatusSetter(
+	status v1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		blp := helpers.MustCastObject[*v1alpha2.BackendLBPolicy](object)
+
+		// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
+		// ancestor statuses.
+		maxAncestors := 1 + len(blp.Status.Ancestors)
+		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
+
+		// keep all the ancestor statuses that belong to other controllers or other instances of this controller
+		for _, os := range blp.Status.Ancestors {
+			if !ownsAncestorStatus(os, blp.Namespace, gatewayCtlrName, ownsGateway) {
+				ancestors = append(ancestors, os)
+			}
+		}
+
+		ancestors = append(ancestors, status.Ancestors...)
+		status.Ancestors = ancestors
+
+		if policyStatusEqual(gatewayCtlrName, blp.Status, status) {
+			return false
+		}
+
+		blp.Status = status
+		return true
+	}
+}
+
 func newNGFPolicyStatusSetter(
 	status v1alpha2.PolicyStatus,
 	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
 ) frameworkStatus.Setter {
 	return func(object client.Object) (wasSet bool) {
 		policy := helpers.MustCastObject[policies.Policy](object)
@@ -256,9 +344,9 @@ func newNGFPolicyStatusSetter(
 		maxAncestors := len(status.Ancestors) + len(prevStatus.Ancestors)
 		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
 
-		// keep all the ancestor statuses that belong to other controllers
+		// keep all the ancestor statuses that belong to other controllers or other instances of this controller
 		for _, as := range prevStatus.Ancestors {
-			if string(as.ControllerName) != gatewayCtlrName {
+			if !ownsAncestorStatus(as, policy.GetNamespace(), gatewayCtlrName, ownsGateway) {
 				ancestors = append(ancestors, as)
 			}
 		}
@@ -275,6 +363,31 @@ func newNGFPolicyStatusSetter(
 	}
 }
 
+// ownsAncestorStatus returns true if the ancestor status of a Policy was written by this instance of the controller.
+// The instances only select their Gateways, so the ancestors of other kinds, like Routes, are owned by every instance.
+func ownsAncestorStatus(
+	ancestorStatus v1alpha2.PolicyAncestorStatus,
+	policyNamespace string,
+	gatewayCtlrName string,
+	ownsGateway GatewayOwner,
+) bool {
+	if string(ancestorStatus.ControllerName) != gatewayCtlrName {
+		return false
+	}
+
+	ref := ancestorStatus.AncestorRef
+	if ref.Kind != nil && *ref.Kind != kinds.Gateway {
+		return true
+	}
+
+	namespace := policyNamespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+
+	return ownsGateway(types.NamespacedName{Namespace: namespace, Name: string(ref.Name)})
+}
+
 func policyStatusEqual(gatewayCtlrName string, prev, cur v1alpha2.PolicyStatus) bool {
 	// Since other controllers may update Policy status we can't assume anything about the order of the
 	// statuses, and we have to ignore statuses written by other controllers when checking for equality.
@@ -364,6 +477,62 @@ func newSnippetsFilterStatusSetter(
 	}
 }
 
+func newHTTPBodyTransformStatusSetter(
+	bodyTransformStatus ngfAPI.HTTPBodyTransformStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(obj client.Object) (wasSet bool) {
+		bt := helpers.MustCastObject[*ngfAPI.HTTPBodyTransform](obj)
+
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, 1+len(bt.Status.Controllers))
+
+		for _, status := range bt.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, bodyTransformStatus.Controllers...)
+		bodyTransformStatus.Controllers = controllerStatuses
+
+		// HTTPBodyTransforms have the same controller statuses as SnippetsFilters.
+		if snippetsFilterStatusEqual(gatewayCtlrName, bodyTransformStatus.Controllers, bt.Status.Controllers) {
+			return false
+		}
+
+		bt.Status = bodyTransformStatus
+		return true
+	}
+}
+
+func newDirectResponseStatusSetter(
+	directResponseStatus ngfAPI.DirectResponseStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(obj client.Object) (wasSet bool) {
+		dr := helpers.MustCastObject[*ngfAPI.DirectResponse](obj)
+
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, 1+len(dr.Status.Controllers))
+
+		for _, status := range dr.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, directResponseStatus.Controllers...)
+		directResponseStatus.Controllers = controllerStatuses
+
+		// DirectResponses have the same controller statuses as SnippetsFilters.
+		if snippetsFilterStatusEqual(gatewayCtlrName, directResponseStatus.Controllers, dr.Status.Controllers) {
+			return false
+		}
+
+		dr.Status = directResponseStatus
+		return true
+	}
+}
+
 func snippetsFilterStatusEqual(gatewayCtlrName string, currStatus, prevStatus []ngfAPI.ControllerStatus) bool {
 	// Since other controllers may update snippetsFilter status we can't assume anything about the order of the statuses,
 	// and we have to ignore statuses written by other controllers when checking for equality.
@@ -405,3 +574,147 @@ func snippetsStatusEqual(status1, status2 ngfAPI.ControllerStatus) bool {
 
 	return frameworkStatus.ConditionsEqual(status1.Conditions, status2.Conditions)
 }
+
+func newRouteTestStatusSetter(
+	routeTestStatus ngfAPI.RouteTestStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(obj client.Object) (wasSet bool) {
+		rt := helpers.MustCastObject[*ngfAPI.RouteTest](obj)
+
+		// maxControllerStatus is the max number of controller statuses which is the sum of all new controller statuses
+		// and all old controller statuses.
+		maxControllerStatus := 1 + len(rt.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.RouteTestControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range rt.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, routeTestStatus.Controllers...)
+		routeTestStatus.Controllers = controllerStatuses
+
+		if routeTestStatusEqual(gatewayCtlrName, routeTestStatus.Controllers, rt.Status.Controllers) {
+			return false
+		}
+
+		rt.Status = routeTestStatus
+		return true
+	}
+}
+
+func routeTestStatusEqual(gatewayCtlrName string, currStatus, prevStatus []ngfAPI.RouteTestControllerStatus) bool {
+	// Like with SnippetsFilters, the statuses written by other controllers are ignored and the order
+	// of the statuses doesn't matter.
+	for _, prev := range prevStatus {
+		if prev.ControllerName != gatewayv1.GatewayController(gatewayCtlrName) {
+			continue
+		}
+
+		exists := slices.ContainsFunc(currStatus, func(currStatus ngfAPI.RouteTestControllerStatus) bool {
+			return routeTestControllerStatusEqual(currStatus, prev)
+		})
+
+		if !exists {
+			return false
+		}
+	}
+
+	for _, curr := range currStatus {
+		exists := slices.ContainsFunc(prevStatus, func(prevStatus ngfAPI.RouteTestControllerStatus) bool {
+			return routeTestControllerStatusEqual(curr, prevStatus)
+		})
+
+		if !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+func routeTestControllerStatusEqual(status1, status2 ngfAPI.RouteTestControllerStatus) bool {
+	if status1.ControllerName != status2.ControllerName {
+		return false
+	}
+
+	if !slices.Equal(status1.Results, status2.Results) {
+		return false
+	}
+
+	return frameworkStatus.ConditionsEqual(status1.Conditions, status2.Conditions)
+}
+
+func newNginxProxyStatusSetter(
+	nginxProxyStatus ngfAPI.NginxProxyStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(obj client.Object) (wasSet bool) {
+		np := helpers.MustCastObject[*ngfAPI.NginxProxy](obj)
+
+		// maxControllerStatus is the max number of controller statuses which is the sum of all new controller statuses
+		// and all old controller statuses.
+		maxControllerStatus := 1 + len(np.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.NginxProxyControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range np.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, nginxProxyStatus.Controllers...)
+		nginxProxyStatus.Controllers = controllerStatuses
+
+		if nginxProxyStatusEqual(gatewayCtlrName, nginxProxyStatus.Controllers, np.Status.Controllers) {
+			return false
+		}
+
+		np.Status = nginxProxyStatus
+		return true
+	}
+}
+
+func nginxProxyStatusEqual(gatewayCtlrName string, currStatus, prevStatus []ngfAPI.NginxProxyControllerStatus) bool {
+	// Like with RouteTests, the statuses written by other controllers are ignored and the order
+	// of the statuses doesn't matter.
+	for _, prev := range prevStatus {
+		if prev.ControllerName != gatewayv1.GatewayController(gatewayCtlrName) {
+			continue
+		}
+
+		exists := slices.ContainsFunc(currStatus, func(currStatus ngfAPI.NginxProxyControllerStatus) bool {
+			return nginxProxyControllerStatusEqual(currStatus, prev)
+		})
+
+		if !exists {
+			return false
+		}
+	}
+
+	for _, curr := range currStatus {
+		exists := slices.ContainsFunc(prevStatus, func(prevStatus ngfAPI.NginxProxyControllerStatus) bool {
+			return nginxProxyControllerStatusEqual(curr, prevStatus)
+		})
+
+		if !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+func nginxProxyControllerStatusEqual(status1, status2 ngfAPI.NginxProxyControllerStatus) bool {
+	if status1.ControllerName != status2.ControllerName {
+		return false
+	}
+
+	if !slices.Equal(status1.Bases, status2.Bases) || !slices.Equal(status1.EffectiveValues, status2.EffectiveValues) {
+		return false
+	}
+
+	return frameworkStatus.ConditionsEqual(status1.Conditions, status2.Conditions)
+}
@@ -5,6 +5,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
@@ -15,6 +17,13 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
 )
 
+// otherInstanceGateway is a Gateway of another instance of the controller.
+var otherInstanceGateway = types.NamespacedName{Namespace: "other", Name: "other-instance-gateway"}
+
+func ownsGatewaysExceptOtherInstance(gateway types.NamespacedName) bool {
+	return gateway != otherInstanceGateway
+}
+
 func TestNewNginxGatewayStatusSetter(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -253,6 +262,59 @@ func TestNewHTTPRouteStatusSetter(t *testing.T) {
 			},
 			expStatusSet: true,
 		},
+		{
+			name: "HTTPRoute has old status, keep the statuses of the Gateways of other instances",
+			newStatus: gatewayv1.HTTPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{Name: "gateway"},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+					},
+				},
+			},
+			status: gatewayv1.HTTPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef: gatewayv1.ParentReference{
+								Namespace: helpers.GetPointer[gatewayv1.Namespace](gatewayv1.Namespace(otherInstanceGateway.Namespace)),
+								Name:      gatewayv1.ObjectName(otherInstanceGateway.Name),
+							},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "other instance condition"}},
+						},
+						{
+							ParentRef:      gatewayv1.ParentReference{Name: "gateway"},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "old condition"}},
+						},
+					},
+				},
+			},
+			expStatus: gatewayv1.HTTPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{
+					Parents: []gatewayv1.RouteParentStatus{
+						{
+							ParentRef:      gatewayv1.ParentReference{Name: "gateway"},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "new condition"}},
+						},
+						{
+							ParentRef: gatewayv1.ParentReference{
+								Namespace: helpers.GetPointer[gatewayv1.Namespace](gatewayv1.Namespace(otherInstanceGateway.Namespace)),
+								Name:      gatewayv1.ObjectName(otherInstanceGateway.Name),
+							},
+							ControllerName: gatewayv1.GatewayController(controllerName),
+							Conditions:     []metav1.Condition{{Message: "other instance condition"}},
+						},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
 		{
 			name: "HTTPRoute has same status",
 			newStatus: gatewayv1.HTTPRouteStatus{
@@ -297,7 +359,7 @@ func TestNewHTTPRouteStatusSetter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			setter := newHTTPRouteStatusSetter(test.newStatus, controllerName)
+			setter := newHTTPRouteStatusSetter(test.newStatus, controllerName, ownsGatewaysExceptOtherInstance)
 			obj := &gatewayv1.HTTPRoute{Status: test.status}
 
 			statusSet := setter(obj)
@@ -474,7 +536,7 @@ func TestNewGRPCRouteStatusSetter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			setter := newGRPCRouteStatusSetter(test.newStatus, controllerName)
+			setter := newGRPCRouteStatusSetter(test.newStatus, controllerName, ownsGatewaysExceptOtherInstance)
 			obj := &gatewayv1.GRPCRoute{Status: test.status}
 
 			statusSet := setter(obj)
@@ -485,180 +547,114 @@ func TestNewGRPCRouteStatusSetter(t *testing.T) {
 	}
 }
 
-func TestNewTLSRouteStatusSetter(t *testing.T) {
+func TestNewL4RouteStatusSetter(t *testing.T) {
 	t.Parallel()
 	const (
 		controllerName      = "controller"
 		otherControllerName = "different"
 	)
 
-	tests := []struct {
-		name                         string
-		status, newStatus, expStatus v1alpha2.TLSRouteStatus
-		expStatusSet                 bool
+	createStatus := func(parents ...v1alpha2.RouteParentStatus) v1alpha2.RouteStatus {
+		return v1alpha2.RouteStatus{Parents: parents}
+	}
+
+	createParentStatus := func(ctlrName, msg string) v1alpha2.RouteParentStatus {
+		return v1alpha2.RouteParentStatus{
+			ParentRef:      gatewayv1.ParentReference{},
+			ControllerName: gatewayv1.GatewayController(ctlrName),
+			Conditions:     []metav1.Condition{{Message: msg}},
+		}
+	}
+
+	routes := []struct {
+		newRoute  func(status v1alpha2.RouteStatus) client.Object
+		getStatus func(obj client.Object) v1alpha2.RouteStatus
+		kind      string
 	}{
 		{
-			name: "TLSRoute has no status",
-			newStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: v1alpha2.RouteStatus{
-					Parents: []v1alpha2.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-					},
-				},
+			kind: "TLSRoute",
+			newRoute: func(status v1alpha2.RouteStatus) client.Object {
+				return &v1alpha2.TLSRoute{Status: v1alpha2.TLSRouteStatus{RouteStatus: status}}
 			},
-			expStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-					},
-				},
+			getStatus: func(obj client.Object) v1alpha2.RouteStatus {
+				return obj.(*v1alpha2.TLSRoute).Status.RouteStatus
 			},
-			expStatusSet: true,
 		},
 		{
-			name: "TLSRoute has old status",
-			newStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-					},
-				},
+			kind: "TCPRoute",
+			newRoute: func(status v1alpha2.RouteStatus) client.Object {
+				return &v1alpha2.TCPRoute{Status: v1alpha2.TCPRouteStatus{RouteStatus: status}}
 			},
-			status: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "old condition"}},
-						},
-					},
-				},
-			},
-			expStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-					},
-				},
+			getStatus: func(obj client.Object) v1alpha2.RouteStatus {
+				return obj.(*v1alpha2.TCPRoute).Status.RouteStatus
 			},
-			expStatusSet: true,
 		},
 		{
-			name: "TLSRoute has old status, keep other controller statuses",
-			newStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-					},
-				},
-			},
-			status: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(otherControllerName),
-							Conditions:     []metav1.Condition{{Message: "some condition"}},
-						},
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "old condition"}},
-						},
-					},
-				},
+			kind: "UDPRoute",
+			newRoute: func(status v1alpha2.RouteStatus) client.Object {
+				return &v1alpha2.UDPRoute{Status: v1alpha2.UDPRouteStatus{RouteStatus: status}}
 			},
-			expStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "new condition"}},
-						},
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(otherControllerName),
-							Conditions:     []metav1.Condition{{Message: "some condition"}},
-						},
-					},
-				},
+			getStatus: func(obj client.Object) v1alpha2.RouteStatus {
+				return obj.(*v1alpha2.UDPRoute).Status.RouteStatus
 			},
+		},
+	}
+
+	tests := []struct {
+		name                         string
+		status, newStatus, expStatus v1alpha2.RouteStatus
+		expStatusSet                 bool
+	}{
+		{
+			name:         "Route has no status",
+			newStatus:    createStatus(createParentStatus(controllerName, "new condition")),
+			expStatus:    createStatus(createParentStatus(controllerName, "new condition")),
 			expStatusSet: true,
 		},
 		{
-			name: "TLSRoute has same status",
-			newStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "same condition"}},
-						},
-					},
-				},
-			},
-			status: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "same condition"}},
-						},
-					},
-				},
-			},
-			expStatus: v1alpha2.TLSRouteStatus{
-				RouteStatus: gatewayv1.RouteStatus{
-					Parents: []gatewayv1.RouteParentStatus{
-						{
-							ParentRef:      gatewayv1.ParentReference{},
-							ControllerName: gatewayv1.GatewayController(controllerName),
-							Conditions:     []metav1.Condition{{Message: "same condition"}},
-						},
-					},
-				},
-			},
+			name:         "Route has old status",
+			newStatus:    createStatus(createParentStatus(controllerName, "new condition")),
+			status:       createStatus(createParentStatus(controllerName, "old condition")),
+			expStatus:    createStatus(createParentStatus(controllerName, "new condition")),
+			expStatusSet: true,
+		},
+		{
+			name:      "Route has old status, keep other controller statuses",
+			newStatus: createStatus(createParentStatus(controllerName, "new condition")),
+			status: createStatus(
+				createParentStatus(otherControllerName, "some condition"),
+				createParentStatus(controllerName, "old condition"),
+			),
+			expStatus: createStatus(
+				createParentStatus(controllerName, "new condition"),
+				createParentStatus(otherControllerName, "some condition"),
+			),
+			expStatusSet: true,
+		},
+		{
+			name:         "Route has same status",
+			newStatus:    createStatus(createParentStatus(controllerName, "same condition")),
+			status:       createStatus(createParentStatus(controllerName, "same condition")),
+			expStatus:    createStatus(createParentStatus(controllerName, "same condition")),
 			expStatusSet: false,
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
+	for _, route := range routes {
+		for _, test := range tests {
+			t.Run(route.kind+" "+test.name, func(t *testing.T) {
+				t.Parallel()
+				g := NewWithT(t)
 
-			setter := newTLSRouteStatusSetter(test.newStatus, controllerName)
-			obj := &v1alpha2.TLSRoute{Status: test.status}
+				setter := newL4RouteStatusSetter(test.newStatus, controllerName, ownsGatewaysExceptOtherInstance)
+				obj := route.newRoute(test.status)
 
-			statusSet := setter(obj)
+				statusSet := setter(obj)
 
-			g.Expect(statusSet).To(Equal(test.expStatusSet))
-			g.Expect(obj.Status).To(Equal(test.expStatus))
-		})
+				g.Expect(statusSet).To(Equal(test.expStatusSet))
+				g.Expect(route.getStatus(obj)).To(Equal(test.expStatus))
+			})
+		}
 	}
 }
 
@@ -845,7 +841,7 @@ func TestNewBackendTLSPolicyStatusSetter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			setter := newBackendTLSPolicyStatusSetter(test.newStatus, controllerName)
+			setter := newBackendTLSPolicyStatusSetter(test.newStatus, controllerName, ownsGatewaysExceptOtherInstance)
 			obj := &v1alpha3.BackendTLSPolicy{Status: test.status}
 
 			statusSet := setter(obj)
@@ -952,6 +948,55 @@ func TestNewNGFPolicyStatusSetter(t *testing.T) {
 			},
 			expStatusSet: true,
 		},
+		{
+			name: "Policy has old status and the status of the Gateway of another instance",
+			newStatus: v1alpha2.PolicyStatus{
+				Ancestors: []v1alpha2.PolicyAncestorStatus{
+					{
+						AncestorRef:    v1alpha2.ParentReference{Kind: helpers.GetPointer[gatewayv1.Kind](kinds.Gateway), Name: "gateway"},
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "new condition"}},
+					},
+				},
+			},
+			status: v1alpha2.PolicyStatus{
+				Ancestors: []v1alpha2.PolicyAncestorStatus{
+					{
+						AncestorRef:    v1alpha2.ParentReference{Kind: helpers.GetPointer[gatewayv1.Kind](kinds.Gateway), Name: "gateway"},
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "old condition"}},
+					},
+					{
+						AncestorRef: v1alpha2.ParentReference{
+							Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.Gateway),
+							Namespace: helpers.GetPointer[gatewayv1.Namespace](gatewayv1.Namespace(otherInstanceGateway.Namespace)),
+							Name:      gatewayv1.ObjectName(otherInstanceGateway.Name),
+						},
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "other instance condition"}},
+					},
+				},
+			},
+			expStatus: v1alpha2.PolicyStatus{
+				Ancestors: []v1alpha2.PolicyAncestorStatus{
+					{
+						AncestorRef: v1alpha2.ParentReference{
+							Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.Gateway),
+							Namespace: helpers.GetPointer[gatewayv1.Namespace](gatewayv1.Namespace(otherInstanceGateway.Namespace)),
+							Name:      gatewayv1.ObjectName(otherInstanceGateway.Name),
+						},
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "other instance condition"}},
+					},
+					{
+						AncestorRef:    v1alpha2.ParentReference{Kind: helpers.GetPointer[gatewayv1.Kind](kinds.Gateway), Name: "gateway"},
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "new condition"}},
+					},
+				},
+			},
+			expStatusSet: true,
+		},
 		{
 			name: "Policy has same status",
 			newStatus: v1alpha2.PolicyStatus{
@@ -987,7 +1032,7 @@ func TestNewNGFPolicyStatusSetter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			setter := newNGFPolicyStatusSetter(test.newStatus, controllerName)
+			setter := newNGFPolicyStatusSetter(test.newStatus, controllerName, ownsGatewaysExceptOtherInstance)
 			obj := &policiesfakes.FakePolicy{
 				GetPolicyStatusStub: func() v1alpha2.PolicyStatus {
 					return test.status
@@ -1410,6 +1455,15 @@ func TestRouteParentStatusEqual(t *testing.T) {
 			}),
 			expEqual: false,
 		},
+		{
+			name: "different parentRef port",
+			p1:   getDefaultStatus(),
+			p2: getModifiedStatus(func(status gatewayv1.RouteParentStatus) gatewayv1.RouteParentStatus {
+				status.ParentRef.Port = helpers.GetPointer[gatewayv1.PortNumber](80)
+				return status
+			}),
+			expEqual: false,
+		},
 		{
 			name: "different conditions",
 			p1:   getDefaultStatus(),
@@ -1726,3 +1780,313 @@ func TestNewSnippetsFilterStatusSetter(t *testing.T) {
 		})
 	}
 }
+
+func TestNewHTTPBodyTransformStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	newStatus := ngfAPI.HTTPBodyTransformStatus{
+		Controllers: []ngfAPI.ControllerStatus{
+			{
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+				ControllerName: controllerName,
+			},
+		},
+	}
+	otherStatus := ngfAPI.ControllerStatus{
+		Conditions:     []metav1.Condition{{Message: "other condition"}},
+		ControllerName: otherControllerName,
+	}
+
+	tests := []struct {
+		name              string
+		status, expStatus ngfAPI.HTTPBodyTransformStatus
+		expStatusSet      bool
+	}{
+		{
+			name:         "HTTPBodyTransform has no status",
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "HTTPBodyTransform has the status of another controller",
+			status: ngfAPI.HTTPBodyTransformStatus{
+				Controllers: []ngfAPI.ControllerStatus{otherStatus},
+			},
+			expStatusSet: true,
+			expStatus: ngfAPI.HTTPBodyTransformStatus{
+				Controllers: []ngfAPI.ControllerStatus{otherStatus, newStatus.Controllers[0]},
+			},
+		},
+		{
+			name: "HTTPBodyTransform has the same status",
+			status: ngfAPI.HTTPBodyTransformStatus{
+				Controllers: []ngfAPI.ControllerStatus{newStatus.Controllers[0], otherStatus},
+			},
+			expStatusSet: false,
+			expStatus: ngfAPI.HTTPBodyTransformStatus{
+				Controllers: []ngfAPI.ControllerStatus{newStatus.Controllers[0], otherStatus},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newHTTPBodyTransformStatusSetter(newStatus, controllerName)
+			bt := &ngfAPI.HTTPBodyTransform{Status: test.status}
+
+			statusSet := setter(bt)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(bt.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewDirectResponseStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	newStatus := ngfAPI.DirectResponseStatus{
+		Controllers: []ngfAPI.ControllerStatus{
+			{
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+				ControllerName: controllerName,
+			},
+		},
+	}
+	otherStatus := ngfAPI.ControllerStatus{
+		Conditions:     []metav1.Condition{{Message: "other condition"}},
+		ControllerName: otherControllerName,
+	}
+
+	tests := []struct {
+		name              string
+		status, expStatus ngfAPI.DirectResponseStatus
+		expStatusSet      bool
+	}{
+		{
+			name:         "DirectResponse has no status",
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "DirectResponse has the status of another controller",
+			status: ngfAPI.DirectResponseStatus{
+				Controllers: []ngfAPI.ControllerStatus{otherStatus},
+			},
+			expStatusSet: true,
+			expStatus: ngfAPI.DirectResponseStatus{
+				Controllers: []ngfAPI.ControllerStatus{otherStatus, newStatus.Controllers[0]},
+			},
+		},
+		{
+			name: "DirectResponse has the same status",
+			status: ngfAPI.DirectResponseStatus{
+				Controllers: []ngfAPI.ControllerStatus{newStatus.Controllers[0], otherStatus},
+			},
+			expStatusSet: false,
+			expStatus: ngfAPI.DirectResponseStatus{
+				Controllers: []ngfAPI.ControllerStatus{newStatus.Controllers[0], otherStatus},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newDirectResponseStatusSetter(newStatus, controllerName)
+			dr := &ngfAPI.DirectResponse{Status: test.status}
+
+			statusSet := setter(dr)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(dr.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewRouteTestStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	newStatus := ngfAPI.RouteTestStatus{
+		Controllers: []ngfAPI.RouteTestControllerStatus{
+			{
+				Conditions:     []metav1.Condition{{Message: "new condition"}},
+				ControllerName: controllerName,
+				Results:        []ngfAPI.RouteTestResult{{Name: "coffee", Outcome: ngfAPI.RouteTestOutcomePassed}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		status, expStatus ngfAPI.RouteTestStatus
+		expStatusSet      bool
+	}{
+		{
+			name:         "RouteTest has no status",
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "RouteTest has old results",
+			status: ngfAPI.RouteTestStatus{
+				Controllers: []ngfAPI.RouteTestControllerStatus{
+					{
+						Conditions:     []metav1.Condition{{Message: "new condition"}},
+						ControllerName: controllerName,
+						Results:        []ngfAPI.RouteTestResult{{Name: "coffee", Outcome: ngfAPI.RouteTestOutcomeFailed}},
+					},
+				},
+			},
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "RouteTest has old status and other controller status",
+			status: ngfAPI.RouteTestStatus{
+				Controllers: []ngfAPI.RouteTestControllerStatus{
+					{
+						ControllerName: otherControllerName,
+						Conditions:     []metav1.Condition{{Message: "some condition"}},
+					},
+					{
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "old condition"}},
+					},
+				},
+			},
+			expStatus: ngfAPI.RouteTestStatus{
+				Controllers: []ngfAPI.RouteTestControllerStatus{
+					{
+						ControllerName: otherControllerName,
+						Conditions:     []metav1.Condition{{Message: "some condition"}},
+					},
+					newStatus.Controllers[0],
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name:         "RouteTest has same status",
+			status:       newStatus,
+			expStatusSet: false,
+			expStatus:    newStatus,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newRouteTestStatusSetter(newStatus, controllerName)
+			rt := &ngfAPI.RouteTest{Status: test.status}
+
+			statusSet := setter(rt)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(rt.Status).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestNewNginxProxyStatusSetter(t *testing.T) {
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	newStatus := ngfAPI.NginxProxyStatus{
+		Controllers: []ngfAPI.NginxProxyControllerStatus{
+			{
+				ControllerName:  controllerName,
+				Conditions:      []metav1.Condition{{Message: "new condition"}},
+				Bases:           []string{"platform"},
+				EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{{Field: "ipFamily", Source: "platform"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		status, expStatus ngfAPI.NginxProxyStatus
+		expStatusSet      bool
+	}{
+		{
+			name:         "NginxProxy has no status",
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "NginxProxy has old effective values",
+			status: ngfAPI.NginxProxyStatus{
+				Controllers: []ngfAPI.NginxProxyControllerStatus{
+					{
+						ControllerName:  controllerName,
+						Conditions:      []metav1.Condition{{Message: "new condition"}},
+						Bases:           []string{"platform"},
+						EffectiveValues: []ngfAPI.NginxProxyEffectiveValue{{Field: "ipFamily", Source: "gateway"}},
+					},
+				},
+			},
+			expStatusSet: true,
+			expStatus:    newStatus,
+		},
+		{
+			name: "NginxProxy has old status and other controller status",
+			status: ngfAPI.NginxProxyStatus{
+				Controllers: []ngfAPI.NginxProxyControllerStatus{
+					{
+						ControllerName: otherControllerName,
+						Conditions:     []metav1.Condition{{Message: "some condition"}},
+					},
+					{
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "old condition"}},
+					},
+				},
+			},
+			expStatus: ngfAPI.NginxProxyStatus{
+				Controllers: []ngfAPI.NginxProxyControllerStatus{
+					{
+						ControllerName: otherControllerName,
+						Conditions:     []metav1.Condition{{Message: "some condition"}},
+					},
+					newStatus.Controllers[0],
+				},
+			},
+			expStatusSet: true,
+		},
+		{
+			name:         "NginxProxy has same status",
+			status:       newStatus,
+			expStatusSet: false,
+			expStatus:    newStatus,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			setter := newNginxProxyStatusSetter(newStatus, controllerName)
+			np := &ngfAPI.NginxProxy{Status: test.status}
+
+			statusSet := setter(np)
+
+			g.Expect(statusSet).To(Equal(test.expStatusSet))
+			g.Expect(np.Status).To(Equal(test.expStatus))
+		})
+	}
+}
@@ -76,6 +76,10 @@ type NGFResourceCounts struct {
 	HTTPRouteCount int64
 	// TLSRouteCount is the number of relevant TLSRoutes.
 	TLSRouteCount int64
+	// TCPRouteCount is the number of relevant TCPRoutes.
+	TCPRouteCount int64
+	// UDPRouteCount is the number of relevant UDPRoutes.
+	UDPRouteCount int64
 	// SecretCount is the number of relevant Secrets.
 	SecretCount int64
 	// ServiceCount is the number of relevant Services.
@@ -99,6 +103,22 @@ type NGFResourceCounts struct {
 	SnippetsFilterCount int64
 	// UpstreamSettingsPolicyCount is the number of UpstreamSettingsPolicies.
 	UpstreamSettingsPolicyCount int64
+	// CacheControlPolicyCount is the number of CacheControlPolicies.
+	CacheControlPolicyCount int64
+	// StaticContentPolicyCount is the number of StaticContentPolicies.
+	StaticContentPolicyCount int64
+	// ListenerTLSPolicyCount is the number of ListenerTLSPolicies.
+	ListenerTLSPolicyCount int64
+	// ErrorPagePolicyCount is the number of ErrorPagePolicies.
+	ErrorPagePolicyCount int64
+	// ResponseCompressionPolicyCount is the number of ResponseCompressionPolicies.
+	ResponseCompressionPolicyCount int64
+	// CachePolicyCount is the number of CachePolicies.
+	CachePolicyCount int64
+	// RateLimitPolicyCount is the number of RateLimitPolicies.
+	RateLimitPolicyCount int64
+	// ConnectionLimitPolicyCount is the number of ConnectionLimitPolicies.
+	ConnectionLimitPolicyCount int64
 }
 
 // DataCollectorConfig holds configuration parameters for DataCollectorImpl.
@@ -206,7 +226,7 @@ func collectGraphResourceCount(
 		ngfResourceCounts.GatewayClassCount++
 	}
 
-	ngfResourceCounts.GatewayCount = int64(len(g.IgnoredGateways))
+	ngfResourceCounts.GatewayCount = int64(len(g.MergedGateways))
 	if g.Gateway != nil {
 		ngfResourceCounts.GatewayCount++
 	}
@@ -215,6 +235,8 @@ func collectGraphResourceCount(
 	ngfResourceCounts.HTTPRouteCount = routeCounts.HTTPRouteCount
 	ngfResourceCounts.GRPCRouteCount = routeCounts.GRPCRouteCount
 	ngfResourceCounts.TLSRouteCount = routeCounts.TLSRouteCount
+	ngfResourceCounts.TCPRouteCount = routeCounts.TCPRouteCount
+	ngfResourceCounts.UDPRouteCount = routeCounts.UDPRouteCount
 
 	ngfResourceCounts.SecretCount = int64(len(g.ReferencedSecrets))
 	ngfResourceCounts.ServiceCount = int64(len(g.ReferencedServices))
@@ -243,6 +265,22 @@ func collectGraphResourceCount(
 			ngfResourceCounts.ObservabilityPolicyCount++
 		case kinds.UpstreamSettingsPolicy:
 			ngfResourceCounts.UpstreamSettingsPolicyCount++
+		case kinds.CacheControlPolicy:
+			ngfResourceCounts.CacheControlPolicyCount++
+		case kinds.StaticContentPolicy:
+			ngfResourceCounts.StaticContentPolicyCount++
+		case kinds.ListenerTLSPolicy:
+			ngfResourceCounts.ListenerTLSPolicyCount++
+		case kinds.ErrorPagePolicy:
+			ngfResourceCounts.ErrorPagePolicyCount++
+		case kinds.ResponseCompressionPolicy:
+			ngfResourceCounts.ResponseCompressionPolicyCount++
+		case kinds.CachePolicy:
+			ngfResourceCounts.CachePolicyCount++
+		case kinds.RateLimitPolicy:
+			ngfResourceCounts.RateLimitPolicyCount++
+		case kinds.ConnectionLimitPolicy:
+			ngfResourceCounts.ConnectionLimitPolicyCount++
 		}
 	}
 
@@ -259,6 +297,8 @@ type RouteCounts struct {
 	HTTPRouteCount int64
 	GRPCRouteCount int64
 	TLSRouteCount  int64
+	TCPRouteCount  int64
+	UDPRouteCount  int64
 }
 
 func computeRouteCount(
@@ -267,6 +307,9 @@ func computeRouteCount(
 ) RouteCounts {
 	httpRouteCount := int64(0)
 	grpcRouteCount := int64(0)
+	tlsRouteCount := int64(0)
+	tcpRouteCount := int64(0)
+	udpRouteCount := int64(0)
 
 	for _, r := range routes {
 		if r.RouteType == graph.RouteTypeHTTP {
@@ -277,10 +320,24 @@ func computeRouteCount(
 		}
 	}
 
+	for key := range l4routes {
+		if key.RouteType == graph.RouteTypeTLS {
+			tlsRouteCount++
+		}
+		if key.RouteType == graph.RouteTypeTCP {
+			tcpRouteCount++
+		}
+		if key.RouteType == graph.RouteTypeUDP {
+			udpRouteCount++
+		}
+	}
+
 	return RouteCounts{
 		HTTPRouteCount: httpRouteCount,
 		GRPCRouteCount: grpcRouteCount,
-		TLSRouteCount:  int64(len(l4routes)),
+		TLSRouteCount:  tlsRouteCount,
+		TCPRouteCount:  tcpRouteCount,
+		UDPRouteCount:  udpRouteCount,
 	}
 }
 
@@ -432,6 +489,10 @@ func collectSnippetsFilterDirectives(g *graph.Graph) ([]string, []int64) {
 				parsedContext = "server"
 			case ngfAPI.NginxContextHTTPServerLocation:
 				parsedContext = "location"
+			case ngfAPI.NginxContextHTTPUpstream:
+				parsedContext = "upstream"
+			case ngfAPI.NginxContextHTTPMap:
+				parsedContext = "map"
 			default:
 				parsedContext = "unknown"
 			}
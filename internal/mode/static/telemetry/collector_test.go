@@ -277,9 +277,9 @@ var _ = Describe("Collector", Ordered, func() {
 						{Name: "ignoredGC1"}: {},
 						{Name: "ignoredGC2"}: {},
 					},
-					IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-						{Name: "ignoredGw1"}: {},
-						{Name: "ignoredGw2"}: {},
+					MergedGateways: map[types.NamespacedName]*graph.Gateway{
+						{Name: "mergedGw1"}: {},
+						{Name: "mergedGw2"}: {},
 					},
 					Routes: map[graph.RouteKey]*graph.L7Route{
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
@@ -289,9 +289,12 @@ var _ = Describe("Collector", Ordered, func() {
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr-2"}}: {RouteType: graph.RouteTypeGRPC},
 					},
 					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-1"}}: {},
-						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-2"}}: {},
-						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-3"}}: {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-1"}, RouteType: graph.RouteTypeTLS}:   {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-2"}, RouteType: graph.RouteTypeTLS}:   {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-3"}, RouteType: graph.RouteTypeTLS}:   {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tcpr-1"}, RouteType: graph.RouteTypeTCP}: {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tcpr-2"}, RouteType: graph.RouteTypeTCP}: {},
+						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "udpr-1"}, RouteType: graph.RouteTypeUDP}: {},
 					},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 						client.ObjectKeyFromObject(secret1): {
@@ -333,6 +336,38 @@ var _ = Describe("Collector", Ordered, func() {
 							NsName: types.NamespacedName{Namespace: "test", Name: "UpstreamSettingsPolicy-1"},
 							GVK:    schema.GroupVersionKind{Kind: kinds.UpstreamSettingsPolicy},
 						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "CacheControlPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.CacheControlPolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "StaticContentPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.StaticContentPolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "ListenerTLSPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.ListenerTLSPolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "ErrorPagePolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.ErrorPagePolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "ResponseCompressionPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.ResponseCompressionPolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "CachePolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "RateLimitPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+						}: {},
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "ConnectionLimitPolicy-1"},
+							GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+						}: {},
 					},
 					NginxProxy: &graph.NginxProxy{},
 					SnippetsFilters: map[types.NamespacedName]*graph.SnippetsFilter{
@@ -406,6 +441,8 @@ var _ = Describe("Collector", Ordered, func() {
 					GatewayClassCount:                        3,
 					HTTPRouteCount:                           3,
 					TLSRouteCount:                            3,
+					TCPRouteCount:                            2,
+					UDPRouteCount:                            1,
 					SecretCount:                              3,
 					ServiceCount:                             3,
 					EndpointCount:                            4,
@@ -417,6 +454,14 @@ var _ = Describe("Collector", Ordered, func() {
 					NginxProxyCount:                          1,
 					SnippetsFilterCount:                      3,
 					UpstreamSettingsPolicyCount:              1,
+					CacheControlPolicyCount:                  1,
+					StaticContentPolicyCount:                 1,
+					ListenerTLSPolicyCount:                   1,
+					ErrorPagePolicyCount:                     1,
+					ResponseCompressionPolicyCount:           1,
+					CachePolicyCount:                         1,
+					RateLimitPolicyCount:                     1,
+					ConnectionLimitPolicyCount:               1,
 				}
 				expData.ClusterVersion = "1.29.2"
 				expData.ClusterPlatform = "kind"
@@ -581,7 +626,7 @@ var _ = Describe("Collector", Ordered, func() {
 					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
 				},
 				L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-1"}}: {},
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr-1"}, RouteType: graph.RouteTypeTLS}: {},
 				},
 				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
 					client.ObjectKeyFromObject(secret): {
@@ -612,6 +657,38 @@ var _ = Describe("Collector", Ordered, func() {
 						NsName: types.NamespacedName{Namespace: "test", Name: "UpstreamSettingsPolicy-1"},
 						GVK:    schema.GroupVersionKind{Kind: kinds.UpstreamSettingsPolicy},
 					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "CacheControlPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.CacheControlPolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "StaticContentPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.StaticContentPolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "ListenerTLSPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.ListenerTLSPolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "ErrorPagePolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.ErrorPagePolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "ResponseCompressionPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.ResponseCompressionPolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "CachePolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "RateLimitPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+					}: {},
+					{
+						NsName: types.NamespacedName{Namespace: "test", Name: "ConnectionLimitPolicy-1"},
+						GVK:    schema.GroupVersionKind{Kind: kinds.ConnectionLimitPolicy},
+					}: {},
 				},
 				NginxProxy: &graph.NginxProxy{},
 				SnippetsFilters: map[types.NamespacedName]*graph.SnippetsFilter{
@@ -692,6 +769,14 @@ var _ = Describe("Collector", Ordered, func() {
 					NginxProxyCount:                          1,
 					SnippetsFilterCount:                      1,
 					UpstreamSettingsPolicyCount:              1,
+					CacheControlPolicyCount:                  1,
+					StaticContentPolicyCount:                 1,
+					ListenerTLSPolicyCount:                   1,
+					ErrorPagePolicyCount:                     1,
+					ResponseCompressionPolicyCount:           1,
+					CachePolicyCount:                         1,
+					RateLimitPolicyCount:                     1,
+					ConnectionLimitPolicyCount:               1,
 				}
 
 				data, err := dataCollector.Collect(ctx)
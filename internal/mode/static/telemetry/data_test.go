@@ -33,6 +33,8 @@ func TestDataAttributes(t *testing.T) {
 			EndpointCount:                            6,
 			GRPCRouteCount:                           7,
 			TLSRouteCount:                            5,
+			TCPRouteCount:                            6,
+			UDPRouteCount:                            7,
 			BackendTLSPolicyCount:                    8,
 			GatewayAttachedClientSettingsPolicyCount: 9,
 			RouteAttachedClientSettingsPolicyCount:   10,
@@ -40,6 +42,14 @@ func TestDataAttributes(t *testing.T) {
 			NginxProxyCount:                          12,
 			SnippetsFilterCount:                      13,
 			UpstreamSettingsPolicyCount:              14,
+			CacheControlPolicyCount:                  15,
+			StaticContentPolicyCount:                 16,
+			ListenerTLSPolicyCount:                   17,
+			ErrorPagePolicyCount:                     18,
+			ResponseCompressionPolicyCount:           19,
+			CachePolicyCount:                         20,
+			RateLimitPolicyCount:                     21,
+			ConnectionLimitPolicyCount:               22,
 		},
 		NGFReplicaCount:                3,
 		SnippetsFiltersDirectives:      []string{"main-three-count", "http-two-count", "server-one-count"},
@@ -68,6 +78,8 @@ func TestDataAttributes(t *testing.T) {
 		attribute.Int64("GatewayClassCount", 2),
 		attribute.Int64("HTTPRouteCount", 3),
 		attribute.Int64("TLSRouteCount", 5),
+		attribute.Int64("TCPRouteCount", 6),
+		attribute.Int64("UDPRouteCount", 7),
 		attribute.Int64("SecretCount", 4),
 		attribute.Int64("ServiceCount", 5),
 		attribute.Int64("EndpointCount", 6),
@@ -79,6 +91,14 @@ func TestDataAttributes(t *testing.T) {
 		attribute.Int64("NginxProxyCount", 12),
 		attribute.Int64("SnippetsFilterCount", 13),
 		attribute.Int64("UpstreamSettingsPolicyCount", 14),
+		attribute.Int64("CacheControlPolicyCount", 15),
+		attribute.Int64("StaticContentPolicyCount", 16),
+		attribute.Int64("ListenerTLSPolicyCount", 17),
+		attribute.Int64("ErrorPagePolicyCount", 18),
+		attribute.Int64("ResponseCompressionPolicyCount", 19),
+		attribute.Int64("CachePolicyCount", 20),
+		attribute.Int64("RateLimitPolicyCount", 21),
+		attribute.Int64("ConnectionLimitPolicyCount", 22),
 		attribute.Int64("NGFReplicaCount", 3),
 	}
 
@@ -111,6 +131,8 @@ func TestDataAttributesWithEmptyData(t *testing.T) {
 		attribute.Int64("GatewayClassCount", 0),
 		attribute.Int64("HTTPRouteCount", 0),
 		attribute.Int64("TLSRouteCount", 0),
+		attribute.Int64("TCPRouteCount", 0),
+		attribute.Int64("UDPRouteCount", 0),
 		attribute.Int64("SecretCount", 0),
 		attribute.Int64("ServiceCount", 0),
 		attribute.Int64("EndpointCount", 0),
@@ -122,6 +144,14 @@ func TestDataAttributesWithEmptyData(t *testing.T) {
 		attribute.Int64("NginxProxyCount", 0),
 		attribute.Int64("SnippetsFilterCount", 0),
 		attribute.Int64("UpstreamSettingsPolicyCount", 0),
+		attribute.Int64("CacheControlPolicyCount", 0),
+		attribute.Int64("StaticContentPolicyCount", 0),
+		attribute.Int64("ListenerTLSPolicyCount", 0),
+		attribute.Int64("ErrorPagePolicyCount", 0),
+		attribute.Int64("ResponseCompressionPolicyCount", 0),
+		attribute.Int64("CachePolicyCount", 0),
+		attribute.Int64("RateLimitPolicyCount", 0),
+		attribute.Int64("ConnectionLimitPolicyCount", 0),
 		attribute.Int64("NGFReplicaCount", 0),
 	}
 
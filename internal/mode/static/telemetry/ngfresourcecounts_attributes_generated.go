@@ -16,6 +16,8 @@ func (d *NGFResourceCounts) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, attribute.Int64("GatewayClassCount", d.GatewayClassCount))
 	attrs = append(attrs, attribute.Int64("HTTPRouteCount", d.HTTPRouteCount))
 	attrs = append(attrs, attribute.Int64("TLSRouteCount", d.TLSRouteCount))
+	attrs = append(attrs, attribute.Int64("TCPRouteCount", d.TCPRouteCount))
+	attrs = append(attrs, attribute.Int64("UDPRouteCount", d.UDPRouteCount))
 	attrs = append(attrs, attribute.Int64("SecretCount", d.SecretCount))
 	attrs = append(attrs, attribute.Int64("ServiceCount", d.ServiceCount))
 	attrs = append(attrs, attribute.Int64("EndpointCount", d.EndpointCount))
@@ -27,6 +29,14 @@ func (d *NGFResourceCounts) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, attribute.Int64("NginxProxyCount", d.NginxProxyCount))
 	attrs = append(attrs, attribute.Int64("SnippetsFilterCount", d.SnippetsFilterCount))
 	attrs = append(attrs, attribute.Int64("UpstreamSettingsPolicyCount", d.UpstreamSettingsPolicyCount))
+	attrs = append(attrs, attribute.Int64("CacheControlPolicyCount", d.CacheControlPolicyCount))
+	attrs = append(attrs, attribute.Int64("StaticContentPolicyCount", d.StaticContentPolicyCount))
+	attrs = append(attrs, attribute.Int64("ListenerTLSPolicyCount", d.ListenerTLSPolicyCount))
+	attrs = append(attrs, attribute.Int64("ErrorPagePolicyCount", d.ErrorPagePolicyCount))
+	attrs = append(attrs, attribute.Int64("ResponseCompressionPolicyCount", d.ResponseCompressionPolicyCount))
+	attrs = append(attrs, attribute.Int64("CachePolicyCount", d.CachePolicyCount))
+	attrs = append(attrs, attribute.Int64("RateLimitPolicyCount", d.RateLimitPolicyCount))
+	attrs = append(attrs, attribute.Int64("ConnectionLimitPolicyCount", d.ConnectionLimitPolicyCount))
 
 	return attrs
 }
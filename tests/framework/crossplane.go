@@ -176,6 +176,10 @@ func injectCrossplaneContainer(
 								MountPath: "/etc/nginx/main-includes",
 								Name:      "nginx-main-includes",
 							},
+							{
+								MountPath: "/etc/nginx/events-includes",
+								Name:      "nginx-events-includes",
+							},
 							{
 								MountPath: "/etc/nginx/secrets",
 								Name:      "nginx-secrets",